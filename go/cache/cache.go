@@ -58,15 +58,17 @@ func NewDefaultCacheImpl(cfg *Config) Cache {
 		}
 		return NewRistrettoCache(cfg.MaxEntries, cfg.MaxMemoryUsage, func(val any) int64 {
 			return val.(cachedObject).CachedSize(true)
-		})
+		}, cfg.OnEvict)
 
 	default:
 		if cfg.MaxEntries == 0 {
 			return &nullCache{}
 		}
-		return NewLRUCache(cfg.MaxEntries, func(_ any) int64 {
+		lru := NewLRUCache(cfg.MaxEntries, func(_ any) int64 {
 			return 1
 		})
+		lru.onEvict = cfg.OnEvict
+		return lru
 	}
 }
 
@@ -78,6 +80,9 @@ type Config struct {
 	MaxMemoryUsage int64
 	// LFU toggles whether to use a new cache implementation with a TinyLFU admission policy
 	LFU bool
+	// OnEvict, if set, is called with the values that the cache evicts. The LFU
+	// cache also calls it with the values that it drops when it is cleared.
+	OnEvict func(value any)
 }
 
 // DefaultConfig is the default configuration for a cache instance in Vitess
@@ -45,3 +45,15 @@ func TestNewDefaultCacheImpl(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDefaultCacheImplOnEvict(t *testing.T) {
+	var evicted []any
+	cache := NewDefaultCacheImpl(&Config{MaxEntries: 2, OnEvict: func(value any) {
+		evicted = append(evicted, value)
+	}})
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+	require.Empty(t, evicted)
+	cache.Set("key3", 3)
+	require.Equal(t, []any{1}, evicted)
+}
@@ -42,6 +42,8 @@ type LRUCache struct {
 	list  *list.List
 	table map[string]*list.Element
 	cost  func(any) int64
+	// onEvict is called with the evicted values, with the lock held.
+	onEvict func(any)
 
 	size      int64
 	capacity  int64
@@ -248,5 +250,8 @@ func (lru *LRUCache) checkCapacity() {
 		delete(lru.table, delValue.key)
 		lru.size -= delValue.size
 		lru.evictions++
+		if lru.onEvict != nil {
+			lru.onEvict(delValue.value)
+		}
 	}
 }
@@ -6,8 +6,9 @@ import (
 
 var _ Cache = &ristretto.Cache{}
 
-// NewRistrettoCache returns a Cache implementation based on Ristretto
-func NewRistrettoCache(maxEntries, maxCost int64, cost func(any) int64) *ristretto.Cache {
+// NewRistrettoCache returns a Cache implementation based on Ristretto.
+// If onEvict is not nil, it is called with the values that the cache evicts.
+func NewRistrettoCache(maxEntries, maxCost int64, cost func(any) int64, onEvict func(any)) *ristretto.Cache {
 	// The TinyLFU paper recommends to allocate 10x times the max entries amount as counters
 	// for the admission policy; since our caches are small and we're very interested on admission
 	// accuracy, we're a bit more greedy than 10x
@@ -20,6 +21,11 @@ func NewRistrettoCache(maxEntries, maxCost int64, cost func(any) int64) *ristret
 		Metrics:     true,
 		Cost:        cost,
 	}
+	if onEvict != nil {
+		config.OnEvict = func(item *ristretto.Item) {
+			onEvict(item.Value)
+		}
+	}
 	cache, err := ristretto.NewCache(&config)
 	if err != nil {
 		panic(err)
@@ -21,6 +21,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"vitess.io/vitess/go/exit"
 	"vitess.io/vitess/go/vt/log"
@@ -47,6 +50,10 @@ var (
 	doShardReplications = flag.Bool("do-shard-replications", false, "copies the shard replication information")
 	doTablets           = flag.Bool("do-tablets", false, "copies the tablet information")
 	doRoutingRules      = flag.Bool("do-routing-rules", false, "copies the routing rules")
+
+	syncTopos    = flag.Bool("sync", false, "keeps copying the changes of the 'from' topo to the 'to' topo, until interrupted")
+	syncInterval = flag.Duration("sync_interval", 30*time.Second, "with -sync, how often everything is copied again, to pick up the new objects that the watches don't see")
+	cutover      = flag.Bool("cutover", false, "locks all the keyspaces of the 'from' topo, copies the last changes, verifies that both topos match, and prints the topo flags that the components must switch to")
 )
 
 func main() {
@@ -70,60 +77,54 @@ func main() {
 	}
 
 	ctx := context.Background()
+	options := helpers.SyncOptions{
+		Keyspaces:         *doKeyspaces,
+		Shards:            *doShards,
+		ShardReplications: *doShardReplications,
+		Tablets:           *doTablets,
+		RoutingRules:      *doRoutingRules,
+	}
 
-	if *compare {
-		compareTopos(ctx, fromTS, toTS)
-		return
+	switch {
+	case *compare:
+		compareTopos(ctx, fromTS, toTS, options)
+	case *syncTopos:
+		runSync(ctx, fromTS, toTS, options)
+	case *cutover:
+		runCutover(ctx, fromTS, toTS, options)
+	default:
+		helpers.CopyTopos(ctx, fromTS, toTS, options)
 	}
-	copyTopos(ctx, fromTS, toTS)
 }
 
-func copyTopos(ctx context.Context, fromTS, toTS *topo.Server) {
-	if *doKeyspaces {
-		helpers.CopyKeyspaces(ctx, fromTS, toTS)
-	}
-	if *doShards {
-		helpers.CopyShards(ctx, fromTS, toTS)
-	}
-	if *doShardReplications {
-		helpers.CopyShardReplications(ctx, fromTS, toTS)
-	}
-	if *doTablets {
-		helpers.CopyTablets(ctx, fromTS, toTS)
-	}
-	if *doRoutingRules {
-		helpers.CopyRoutingRules(ctx, fromTS, toTS)
+func compareTopos(ctx context.Context, fromTS, toTS *topo.Server, options helpers.SyncOptions) {
+	if err := helpers.CompareTopos(ctx, fromTS, toTS, options); err != nil {
+		log.Exitf("%v", err)
 	}
+	fmt.Println("Topologies are in sync")
+	os.Exit(0)
 }
 
-func compareTopos(ctx context.Context, fromTS, toTS *topo.Server) {
-	var err error
-	if *doKeyspaces {
-		err = helpers.CompareKeyspaces(ctx, fromTS, toTS)
-		if err != nil {
-			log.Exitf("Compare keyspaces failed: %v", err)
-		}
-	}
-	if *doShards {
-		err = helpers.CompareShards(ctx, fromTS, toTS)
-		if err != nil {
-			log.Exitf("Compare shards failed: %v", err)
-		}
-	}
-	if *doShardReplications {
-		err = helpers.CompareShardReplications(ctx, fromTS, toTS)
-		if err != nil {
-			log.Exitf("Compare shard replications failed: %v", err)
-		}
-	}
-	if *doTablets {
-		err = helpers.CompareTablets(ctx, fromTS, toTS)
-		if err != nil {
-			log.Exitf("Compare tablets failed: %v", err)
-		}
-	}
-	if err == nil {
-		fmt.Println("Topologies are in sync")
-		os.Exit(0)
+func runSync(ctx context.Context, fromTS, toTS *topo.Server, options helpers.SyncOptions) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		<-c
+		cancel()
+	}()
+
+	syncer := helpers.NewSyncer(fromTS, toTS, options, *syncInterval)
+	log.Infof("syncing the %v topo at %v to the %v topo at %v", *fromImplementation, *fromServerAddress, *toImplementation, *toServerAddress)
+	syncer.Run(ctx)
+	log.Infof("stopped syncing after %v copies", syncer.Passes())
+}
+
+func runCutover(ctx context.Context, fromTS, toTS *topo.Server, options helpers.SyncOptions) {
+	if err := helpers.Cutover(ctx, fromTS, toTS, options); err != nil {
+		log.Exitf("Cutover failed, the components must keep using the %v topo: %v", *fromImplementation, err)
 	}
+	fmt.Println("Topologies are in sync, the components can switch to:")
+	fmt.Printf("-topo_implementation %v -topo_global_server_address %v -topo_global_root %v\n", *toImplementation, *toServerAddress, *toRoot)
 }
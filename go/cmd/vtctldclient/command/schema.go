@@ -59,6 +59,21 @@ For --sql, semi-colons and repeated values may be mixed, for example:
 		Args:                  cobra.ExactArgs(1),
 		RunE:                  commandApplySchema,
 	}
+	// ApplyDeclarativeSchema makes an ApplyDeclarativeSchema gRPC call to a vtctld.
+	ApplyDeclarativeSchema = &cobra.Command{
+		Use:   "ApplyDeclarativeSchema [--execute] [--allow-destructive] [--ddl-strategy <strategy>] [--migration-context <context>] [--caller-id <caller_id>] {--sql-file <file> | --sql <sql>} <keyspace>",
+		Short: "Plans the changes that turn the schema of the keyspace into the given desired schema, and optionally runs them as online DDL.",
+		Long: `Plans the changes that turn the schema of the keyspace into the given desired schema, and optionally runs them as online DDL.
+
+The desired schema is the full list of CREATE TABLE and CREATE VIEW statements of the keyspace. The tables and views that it does not list are dropped.
+The plan is printed as JSON. The changes that lose data, such as dropping a table or a column, or narrowing the type of a column, are marked as destructive, with their warnings.
+
+If --execute is set, the plan is submitted as online DDL migrations with --ddl-strategy, and their UUIDs are printed along with the plan.
+A destructive plan is only executed if --allow-destructive is also set.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplyDeclarativeSchema,
+	}
 	// GetSchema makes a GetSchema gRPC call to a vtctld.
 	GetSchema = &cobra.Command{
 		Use:                   "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
@@ -155,6 +170,63 @@ func commandApplySchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var applyDeclarativeSchemaOptions = struct {
+	SQL              []string
+	SQLFile          string
+	Execute          bool
+	AllowDestructive bool
+	DDLStrategy      string
+	MigrationContext string
+	CallerID         string
+}{}
+
+func commandApplyDeclarativeSchema(cmd *cobra.Command, args []string) error {
+	var allSQL string
+	if applyDeclarativeSchemaOptions.SQLFile != "" {
+		if len(applyDeclarativeSchemaOptions.SQL) != 0 {
+			return errors.New("Exactly one of --sql and --sql-file must be specified, not both.") // nolint
+		}
+
+		data, err := ioutil.ReadFile(applyDeclarativeSchemaOptions.SQLFile)
+		if err != nil {
+			return err
+		}
+
+		allSQL = string(data)
+	} else {
+		allSQL = strings.Join(applyDeclarativeSchemaOptions.SQL, ";")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	var cid *vtrpc.CallerID
+	if applyDeclarativeSchemaOptions.CallerID != "" {
+		cid = &vtrpc.CallerID{Principal: applyDeclarativeSchemaOptions.CallerID}
+	}
+
+	resp, err := client.ApplyDeclarativeSchema(commandCtx, &vtctldatapb.ApplyDeclarativeSchemaRequest{
+		Keyspace:         cmd.Flags().Arg(0),
+		Sql:              allSQL,
+		Execute:          applyDeclarativeSchemaOptions.Execute,
+		AllowDestructive: applyDeclarativeSchemaOptions.AllowDestructive,
+		DdlStrategy:      applyDeclarativeSchemaOptions.DDLStrategy,
+		MigrationContext: applyDeclarativeSchemaOptions.MigrationContext,
+		CallerId:         cid,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var getSchemaOptions = struct {
 	Tables         []string
 	ExcludeTables  []string
@@ -296,6 +368,15 @@ func init() {
 
 	Root.AddCommand(ApplySchema)
 
+	ApplyDeclarativeSchema.Flags().BoolVar(&applyDeclarativeSchemaOptions.Execute, "execute", false, "Run the planned changes as online DDL migrations. By default, the plan is only displayed.")
+	ApplyDeclarativeSchema.Flags().BoolVar(&applyDeclarativeSchemaOptions.AllowDestructive, "allow-destructive", false, "Allow --execute to run a plan that drops tables, views or columns, or narrows the type of columns.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.DDLStrategy, "ddl-strategy", string(schema.DDLStrategyVitess), "Online DDL strategy of the migrations, compatible with @@ddl_strategy session variable. Must not be 'direct'.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.MigrationContext, "migration-context", "", "Optionally supply a custom unique string used as context for the migrations. By default a unique context is auto-generated by Vitess.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.CallerID, "caller-id", "", "Effective caller ID used for the operation and should map to an ACL name which grants this identity the necessary permissions to perform the operation (this is only necessary when strict table ACLs are used).")
+	ApplyDeclarativeSchema.Flags().StringSliceVar(&applyDeclarativeSchemaOptions.SQL, "sql", nil, "Semicolon-delimited, repeatable CREATE TABLE and CREATE VIEW statements of the desired schema. Exactly one of --sql|--sql-file is required.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.SQLFile, "sql-file", "", "Path to a file containing the CREATE TABLE and CREATE VIEW statements of the desired schema. Exactly one of --sql|--sql-file is required.")
+	Root.AddCommand(ApplyDeclarativeSchema)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "List of tables to display the schema for. Each is either an exact match, or a regular expression of the form `/regexp/`.")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "List of tables to exclude from the result. Each is either an exact match, or a regular expression of the form `/regexp/`.")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "Includes views in the output in addition to base tables.")
@@ -64,10 +64,26 @@ func (c fallbackClient) ResolveTransaction(ctx context.Context, dtid string) err
 	return c.fallback.ResolveTransaction(ctx, dtid)
 }
 
+func (c fallbackClient) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	return c.fallback.ReadTransactions(ctx, keyspace, dtid)
+}
+
+func (c fallbackClient) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return c.fallback.ForceResolveTransaction(ctx, dtid, decision)
+}
+
 func (c fallbackClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	return c.fallback.VStream(ctx, tabletType, vgtid, filter, flags, send)
 }
 
+func (c fallbackClient) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return c.fallback.VStreamAck(ctx, checkpointName, vgtid)
+}
+
+func (c fallbackClient) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	return c.fallback.VStreamControl(ctx, streamName, limits)
+}
+
 func (c fallbackClient) HandlePanic(err *error) {
 	c.fallback.HandlePanic(err)
 }
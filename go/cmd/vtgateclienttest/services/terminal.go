@@ -74,10 +74,26 @@ func (c *terminalClient) ResolveTransaction(ctx context.Context, dtid string) er
 	return errTerminal
 }
 
+func (c *terminalClient) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	return nil, errTerminal
+}
+
+func (c *terminalClient) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return errTerminal
+}
+
 func (c *terminalClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	return errTerminal
 }
 
+func (c *terminalClient) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return errTerminal
+}
+
+func (c *terminalClient) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	return 0, errTerminal
+}
+
 func (c *terminalClient) HandlePanic(err *error) {
 	if x := recover(); x != nil {
 		log.Errorf("Uncaught panic:\n%v\n%s", x, tb.Stack(4))
@@ -27,6 +27,8 @@ import (
 
 	"vitess.io/vitess/go/vt/binlog"
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dynamicconfig"
+	"vitess.io/vitess/go/vt/featureflags"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/servenv"
@@ -36,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -95,6 +98,7 @@ func main() {
 	if err != nil {
 		log.Exitf("failed to parse --tablet-path: %v", err)
 	}
+	vreEngine := vreplication.NewEngine(config, ts, tabletAlias.Cell, mysqld, qsc.LagThrottler())
 	tm = &tabletmanager.TabletManager{
 		BatchCtx:            context.Background(),
 		TopoServer:          ts,
@@ -103,13 +107,20 @@ func main() {
 		DBConfigs:           config.DB.Clone(),
 		QueryServiceControl: qsc,
 		UpdateStream:        binlog.NewUpdateStream(ts, tablet.Keyspace, tabletAlias.Cell, qsc.SchemaEngine()),
-		VREngine:            vreplication.NewEngine(config, ts, tabletAlias.Cell, mysqld, qsc.LagThrottler()),
+		VREngine:            vreEngine,
+		VDiffEngine:         vdiff.NewEngine(ts, tablet, mysqld, vreEngine, qsc.LagThrottler()),
 		MetadataManager:     &mysqlctl.MetadataManager{},
 	}
 	if err := tm.Start(tablet, config.Healthcheck.IntervalSeconds.Get()); err != nil {
 		log.Exitf("failed to parse --tablet-path or initialize DB credentials: %v", err)
 	}
+	featureFlagsCtx, cancelFeatureFlags := context.WithCancel(context.Background())
+	servenv.OnRun(func() {
+		go featureflags.Watch(featureFlagsCtx, ts, tm.Tablet().Keyspace)
+	})
 	servenv.OnClose(func() {
+		cancelFeatureFlags()
+
 		// Close the tm so that our topo entry gets pruned properly and any
 		// background goroutines that use the topo connection are stopped.
 		tm.Close()
@@ -205,10 +216,13 @@ func createTabletServer(config *tabletenv.TabletConfig, ts *topo.Server, tabletA
 	}
 	// creates and registers the query service
 	qsc := tabletserver.NewTabletServer("", config, ts, tabletAlias)
+	dynamicConfigCtx, cancelDynamicConfig := context.WithCancel(context.Background())
 	servenv.OnRun(func() {
 		qsc.Register()
 		addStatusParts(qsc)
+		go dynamicconfig.WatchTopo(dynamicConfigCtx, ts, "vttablet")
 	})
+	servenv.OnClose(cancelDynamicConfig)
 	servenv.OnClose(qsc.StopService)
 	qsc.InitACL(*tableACLConfig, *enforceTableACLConfig, *tableACLConfigReloadInterval)
 	return qsc
@@ -129,6 +129,11 @@ type Conn struct {
 	// It is set during the initial handshake.
 	UserData Getter
 
+	// Attributes are the connection attributes sent by the client,
+	// like program_name or _client_name. It is set during the initial
+	// handshake, and is nil if the client did not send any.
+	Attributes map[string]string
+
 	bufferedReader *bufio.Reader
 	flushTimer     *time.Timer
 	header         [packetHeaderSize]byte
@@ -215,6 +220,9 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+	// HandlerData is the data that the handler keeps for the statement,
+	// such as what it planned, and that lives as long as the statement.
+	HandlerData any
 }
 
 // execResult is an enum signifying the result of executing a query
@@ -1067,7 +1075,7 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 					lastInsertID:     qr.InsertID,
 					statusFlags:      c.StatusFlags,
 					warnings:         0,
-					info:             "",
+					info:             qr.Info,
 					sessionStateData: qr.SessionStateChanges,
 				}
 				return c.writeOKPacket(&ok)
@@ -1315,7 +1323,7 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) execResult {
 					lastInsertID:     qr.InsertID,
 					statusFlags:      flag,
 					warnings:         handler.WarningCount(c),
-					info:             "",
+					info:             qr.Info,
 					sessionStateData: qr.SessionStateChanges,
 				}
 				return c.writeOKPacket(&ok)
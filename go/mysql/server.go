@@ -754,9 +754,11 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 
 	// Decode connection attributes send by the client
 	if clientFlags&CapabilityClientConnAttr != 0 {
-		if _, _, err := parseConnAttrs(data, pos); err != nil {
+		attrs, _, err := parseConnAttrs(data, pos)
+		if err != nil {
 			log.Warningf("Decode connection attributes send by the client: %v", err)
 		}
+		c.Attributes = attrs
 	}
 
 	return username, AuthMethodDescription(authMethod), authResponse, nil
@@ -775,12 +777,13 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 	attrs := make(map[string]string)
 
 	for attrLenRead < attrLen {
-		var keyLen byte
-		keyLen, pos, ok = readByte(data, pos)
+		start := pos
+
+		var keyLen uint64
+		keyLen, pos, ok = readLenEncInt(data, pos)
 		if !ok {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute key length")
 		}
-		attrLenRead += uint64(keyLen) + 1
 
 		var connAttrKey []byte
 		connAttrKey, pos, ok = readBytes(data, pos, int(keyLen))
@@ -788,18 +791,18 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute key")
 		}
 
-		var valLen byte
-		valLen, pos, ok = readByte(data, pos)
+		var valLen uint64
+		valLen, pos, ok = readLenEncInt(data, pos)
 		if !ok {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute value length")
 		}
-		attrLenRead += uint64(valLen) + 1
 
 		var connAttrVal []byte
 		connAttrVal, pos, ok = readBytes(data, pos, int(valLen))
 		if !ok {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute value")
 		}
+		attrLenRead += uint64(pos - start)
 
 		attrs[string(connAttrKey[:])] = string(connAttrVal[:])
 	}
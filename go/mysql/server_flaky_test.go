@@ -1367,6 +1367,24 @@ func TestParseConnAttrs(t *testing.T) {
 	}
 }
 
+func TestParseConnAttrsLongValue(t *testing.T) {
+	// Values of 251 bytes or more have a length encoded on more
+	// than one byte.
+	long := strings.Repeat("x", 300)
+	attrsLen := lenEncStringSize("program_name") + lenEncStringSize(long) + lenEncStringSize("_os") + lenEncStringSize("linux")
+	data := make([]byte, lenEncIntSize(uint64(attrsLen))+attrsLen)
+	pos := writeLenEncInt(data, 0, uint64(attrsLen))
+	pos = writeLenEncString(data, pos, "program_name")
+	pos = writeLenEncString(data, pos, long)
+	pos = writeLenEncString(data, pos, "_os")
+	pos = writeLenEncString(data, pos, "linux")
+
+	attrs, end, err := parseConnAttrs(data, 0)
+	require.NoError(t, err)
+	require.Equal(t, pos, end)
+	require.Equal(t, map[string]string{"program_name": long, "_os": "linux"}, attrs)
+}
+
 func TestServerFlush(t *testing.T) {
 	defer func(saved time.Duration) { *mysqlServerFlushDelay = saved }(*mysqlServerFlushDelay)
 	*mysqlServerFlushDelay = 10 * time.Millisecond
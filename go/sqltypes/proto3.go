@@ -17,6 +17,8 @@ limitations under the License.
 package sqltypes
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/vterrors"
@@ -100,11 +102,12 @@ func ResultToProto3(qr *Result) *querypb.QueryResult {
 		return nil
 	}
 	return &querypb.QueryResult{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertId:     qr.InsertID,
-		Rows:         RowsToProto3(qr.Rows),
-		Info:         qr.Info,
+		Fields:           qr.Fields,
+		RowsAffected:     qr.RowsAffected,
+		InsertId:         qr.InsertID,
+		Rows:             RowsToProto3(qr.Rows),
+		Info:             qr.Info,
+		ReplicationLagNs: qr.ReplicationLag.Nanoseconds(),
 	}
 }
 
@@ -115,11 +118,12 @@ func Proto3ToResult(qr *querypb.QueryResult) *Result {
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(qr.Fields, qr.Rows),
-		Info:         qr.Info,
+		Fields:         qr.Fields,
+		RowsAffected:   qr.RowsAffected,
+		InsertID:       qr.InsertId,
+		Rows:           proto3ToRows(qr.Fields, qr.Rows),
+		Info:           qr.Info,
+		ReplicationLag: time.Duration(qr.ReplicationLagNs),
 	}
 }
 
@@ -131,10 +135,11 @@ func CustomProto3ToResult(fields []*querypb.Field, qr *querypb.QueryResult) *Res
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(fields, qr.Rows),
+		Fields:         qr.Fields,
+		RowsAffected:   qr.RowsAffected,
+		InsertID:       qr.InsertId,
+		Rows:           proto3ToRows(fields, qr.Rows),
+		ReplicationLag: time.Duration(qr.ReplicationLagNs),
 	}
 }
 
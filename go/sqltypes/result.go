@@ -20,6 +20,9 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -35,6 +38,9 @@ type Result struct {
 	SessionStateChanges string           `json:"session_state_changes"`
 	StatusFlags         uint16           `json:"status_flags"`
 	Info                string           `json:"info"`
+	// ReplicationLag is the replication lag of the replica that ran the
+	// query, if the query asked for it.
+	ReplicationLag time.Duration `json:"replication_lag,omitempty"`
 }
 
 //goland:noinspection GoUnusedConst
@@ -292,6 +298,9 @@ func (result *Result) StripMetadata(incl querypb.ExecuteOptions_IncludedFields)
 // AppendResult will combine the Results Objects of one result
 // to another result.Note currently it doesn't handle cases like
 // if two results have different fields.We will enhance this function.
+// The combined InsertID is the smallest one of the results, like the
+// LAST_INSERT_ID() of MySQL is the first id that a statement generated,
+// whatever the order the results are appended in.
 func (result *Result) AppendResult(src *Result) {
 	if src.RowsAffected == 0 && len(src.Rows) == 0 && len(src.Fields) == 0 {
 		return
@@ -300,12 +309,71 @@ func (result *Result) AppendResult(src *Result) {
 		result.Fields = src.Fields
 	}
 	result.RowsAffected += src.RowsAffected
-	if src.InsertID != 0 {
-		result.InsertID = src.InsertID
-	}
+	result.InsertID = FirstInsertID(result.InsertID, src.InsertID)
 	result.Rows = append(result.Rows, src.Rows...)
 }
 
+// FirstInsertID returns the smallest non-zero insert id of a and b, or 0 if
+// they are both 0.
+func FirstInsertID(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// MergeInfo adds up the counts of the info of two DML results, like
+// "Rows matched: 3  Changed: 2  Warnings: 0" for an UPDATE, or
+// "Records: 3  Duplicates: 1  Warnings: 0" for an INSERT. An empty info is
+// ignored. It returns false if the infos don't have the same counts.
+func MergeInfo(a, b string) (string, bool) {
+	if a == "" || b == "" {
+		return a + b, true
+	}
+	aCounts := strings.Split(a, "  ")
+	bCounts := strings.Split(b, "  ")
+	if len(aCounts) != len(bCounts) {
+		return "", false
+	}
+	merged := make([]string, 0, len(aCounts))
+	for i := range aCounts {
+		aName, aValue, aOk := parseInfoCount(aCounts[i])
+		bName, bValue, bOk := parseInfoCount(bCounts[i])
+		if !aOk || !bOk || aName != bName {
+			return "", false
+		}
+		merged = append(merged, fmt.Sprintf("%s: %d", aName, aValue+bValue))
+	}
+	return strings.Join(merged, "  "), true
+}
+
+// InfoCount returns a count of the info of a DML result, like the
+// "Rows matched" of an UPDATE, and false if the info doesn't have it.
+func InfoCount(info, name string) (uint64, bool) {
+	if info == "" {
+		return 0, false
+	}
+	for _, count := range strings.Split(info, "  ") {
+		if countName, value, ok := parseInfoCount(count); ok && countName == name {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// parseInfoCount parses a count of the info of a DML result, like "Changed: 2".
+func parseInfoCount(count string) (string, uint64, bool) {
+	name, value, ok := strings.Cut(count, ": ")
+	if !ok {
+		return "", 0, false
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return name, n, true
+}
+
 // Named returns a NamedResult based on this struct
 func (result *Result) Named() *NamedResult {
 	return ToNamedResult(result)
@@ -344,3 +344,72 @@ func TestAppendResult(t *testing.T) {
 		t.Errorf("Got:\n%#v, want:\n%#v", result, want)
 	}
 }
+
+func TestMergeInfo(t *testing.T) {
+	testcases := []struct {
+		a, b string
+		want string
+		ok   bool
+	}{{
+		a:    "Rows matched: 3  Changed: 2  Warnings: 0",
+		b:    "Rows matched: 1  Changed: 1  Warnings: 1",
+		want: "Rows matched: 4  Changed: 3  Warnings: 1",
+		ok:   true,
+	}, {
+		a:    "",
+		b:    "Records: 2  Duplicates: 1  Warnings: 0",
+		want: "Records: 2  Duplicates: 1  Warnings: 0",
+		ok:   true,
+	}, {
+		a:  "Records: 2  Duplicates: 1  Warnings: 0",
+		b:  "Rows matched: 1  Changed: 1  Warnings: 1",
+		ok: false,
+	}, {
+		a:  "Records: 2  Duplicates: 1  Warnings: 0",
+		b:  "Records: 2  Deleted: 0  Skipped: 0  Warnings: 0",
+		ok: false,
+	}, {
+		a:  "Records: 2  Duplicates: x  Warnings: 0",
+		b:  "Records: 2  Duplicates: 1  Warnings: 0",
+		ok: false,
+	}}
+	for _, tc := range testcases {
+		got, ok := MergeInfo(tc.a, tc.b)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("MergeInfo(%q, %q): %q, %v, want %q, %v", tc.a, tc.b, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInfoCount(t *testing.T) {
+	testcases := []struct {
+		info  string
+		name  string
+		count uint64
+		ok    bool
+	}{{
+		info:  "Rows matched: 3  Changed: 2  Warnings: 0",
+		name:  "Rows matched",
+		count: 3,
+		ok:    true,
+	}, {
+		info:  "Rows matched: 3  Changed: 2  Warnings: 0",
+		name:  "Changed",
+		count: 2,
+		ok:    true,
+	}, {
+		info: "Records: 2  Duplicates: 1  Warnings: 0",
+		name: "Rows matched",
+		ok:   false,
+	}, {
+		info: "",
+		name: "Rows matched",
+		ok:   false,
+	}}
+	for _, tc := range testcases {
+		count, ok := InfoCount(tc.info, tc.name)
+		if count != tc.count || ok != tc.ok {
+			t.Errorf("InfoCount(%q, %q): %d, %v, want %d, %v", tc.info, tc.name, count, ok, tc.count, tc.ok)
+		}
+	}
+}
@@ -48,6 +48,7 @@ type Result struct {
 	executing    sync.RWMutex
 	consolidator *Consolidator
 	query        string
+	fingerprint  string
 	Result       any
 	Err          error
 }
@@ -56,12 +57,19 @@ type Result struct {
 // lock on its Result if it is not already present. If the query is
 // a duplicate, Create returns false.
 func (co *Consolidator) Create(query string) (r *Result, created bool) {
+	return co.CreateWithFingerprint(query, query)
+}
+
+// CreateWithFingerprint is like Create, but the duplicates are counted under
+// fingerprint instead of query, e.g. the normalized query shared by the
+// queries that only differ by their values.
+func (co *Consolidator) CreateWithFingerprint(query, fingerprint string) (r *Result, created bool) {
 	co.mu.Lock()
 	defer co.mu.Unlock()
 	if r, ok := co.queries[query]; ok {
 		return r, false
 	}
-	r = &Result{consolidator: co, query: query}
+	r = &Result{consolidator: co, query: query, fingerprint: fingerprint}
 	r.executing.Lock()
 	co.queries[query] = r
 	return r, true
@@ -80,7 +88,7 @@ func (rs *Result) Broadcast() {
 // Wait waits for the original query to complete execution. Wait should
 // be invoked for duplicate queries.
 func (rs *Result) Wait() {
-	rs.consolidator.Record(rs.query)
+	rs.consolidator.Record(rs.fingerprint)
 	rs.executing.RLock()
 }
 
@@ -82,3 +82,22 @@ func TestConsolidator(t *testing.T) {
 	}
 
 }
+
+func TestConsolidatorFingerprint(t *testing.T) {
+	con := NewConsolidator()
+	orig, added := con.CreateWithFingerprint("select * from t where id = 1", "select * from t where id = :id")
+	if !added {
+		t.Fatalf("expected consolidator to register a new query")
+	}
+	dup, added := con.CreateWithFingerprint("select * from t where id = 1", "select * from t where id = :id")
+	if added {
+		t.Fatalf("did not expect consolidator to register a duplicate query")
+	}
+	go orig.Broadcast()
+	dup.Wait()
+
+	want := []ConsolidatorCacheItem{{Query: "select * from t where id = :id", Count: 1}}
+	if !reflect.DeepEqual(con.Items(), want) {
+		t.Fatalf("expected the consolidation to be counted under the fingerprint, got %v", con.Items())
+	}
+}
@@ -106,8 +106,8 @@ func (c *cloneGen) basicMethod(t types.Type, basic *types.Basic, spi generatorSP
 
 func (c *cloneGen) copySliceElement(t types.Type, elType types.Type, spi generatorSPI) jen.Code {
 	if !isNamed(t) && isBasic(elType) {
-		//	copy(res, n)
-		return jen.Id("copy").Call(jen.Id("res"), jen.Id("n"))
+		//	res = append(res, n...)
+		return jen.Id("res").Op("=").Id("append").Call(jen.Id("res"), jen.Id("n").Op("..."))
 	}
 
 	//for _, x := range n {
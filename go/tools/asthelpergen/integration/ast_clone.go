@@ -194,7 +194,7 @@ func CloneSliceOfInt(n []int) []int {
 		return nil
 	}
 	res := make([]int, 0, len(n))
-	copy(res, n)
+	res = append(res, n...)
 	return res
 }
 
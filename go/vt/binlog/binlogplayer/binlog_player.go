@@ -101,6 +101,11 @@ type Stats struct {
 
 	VReplicationLags     *stats.Timings
 	VReplicationLagRates *stats.Rates
+
+	// When the copy phase started, and the rows copied before it
+	copyStartMutex sync.Mutex
+	copyStartTime  time.Time
+	copyStartRows  int64
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -131,6 +136,33 @@ func (bps *Stats) LastPosition() mysql.Position {
 	return bps.lastPosition
 }
 
+// RecordCopyStart records the start of the copy phase. Only the first call
+// counts, so that CopyRowsPerSecond averages the rate over the whole copy
+// phase, including its catch-ups.
+func (bps *Stats) RecordCopyStart() {
+	bps.copyStartMutex.Lock()
+	defer bps.copyStartMutex.Unlock()
+	if bps.copyStartTime.IsZero() {
+		bps.copyStartTime = time.Now()
+		bps.copyStartRows = bps.CopyRowCount.Get()
+	}
+}
+
+// CopyRowsPerSecond returns the average number of rows copied per second
+// since the copy phase started, or 0 if it didn't.
+func (bps *Stats) CopyRowsPerSecond() float64 {
+	bps.copyStartMutex.Lock()
+	defer bps.copyStartMutex.Unlock()
+	if bps.copyStartTime.IsZero() {
+		return 0
+	}
+	elapsed := time.Since(bps.copyStartTime).Seconds()
+	if elapsed < 1 {
+		return 0
+	}
+	return float64(bps.CopyRowCount.Get()-bps.copyStartRows) / elapsed
+}
+
 // MessageHistory gets all the messages, we store 3 at a time
 func (bps *Stats) MessageHistory() []string {
 	strs := make([]string, 0, 3)
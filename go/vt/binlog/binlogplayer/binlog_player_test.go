@@ -394,3 +394,27 @@ func TestReadVReplicationStatus(t *testing.T) {
 		t.Errorf("ReadVReplicationStatus(482821) = %#v, want %#v", got, want)
 	}
 }
+
+func TestCopyRowsPerSecond(t *testing.T) {
+	stats := NewStats()
+	if got := stats.CopyRowsPerSecond(); got != 0 {
+		t.Errorf("CopyRowsPerSecond() before the copy = %v, want 0", got)
+	}
+
+	stats.CopyRowCount.Add(100)
+	stats.RecordCopyStart()
+	startTime := stats.copyStartTime
+	stats.RecordCopyStart()
+	if stats.copyStartTime != startTime || stats.copyStartRows != 100 {
+		t.Errorf("RecordCopyStart() recorded a second start: %v, %d", stats.copyStartTime, stats.copyStartRows)
+	}
+	if got := stats.CopyRowsPerSecond(); got != 0 {
+		t.Errorf("CopyRowsPerSecond() right after the start = %v, want 0", got)
+	}
+
+	stats.copyStartTime = time.Now().Add(-10 * time.Second)
+	stats.CopyRowCount.Add(50)
+	if got := stats.CopyRowsPerSecond(); got < 4.9 || got > 5 {
+		t.Errorf("CopyRowsPerSecond() = %v, want 5", got)
+	}
+}
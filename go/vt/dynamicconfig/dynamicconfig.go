@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dynamicconfig lets the flags that tune a binary, such as pool
+// sizes, timeouts and limits, be changed at runtime instead of requiring a
+// restart. The values can be changed on /debug/dynamic_config or through the
+// DynamicConfig RPC service, or for all the binaries of a component through
+// the topo, and every change is logged and kept in an audit trail.
+package dynamicconfig
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Sources of the changes.
+const (
+	SourceHTTP = "http"
+	SourceRPC  = "rpc"
+	SourceTopo = "topo"
+)
+
+// maxChanges is the number of changes kept in the audit trail.
+const maxChanges = 100
+
+var (
+	dynamicConfigChanges = stats.NewCountersWithSingleLabel(
+		"DynamicConfigChanges",
+		"Number of changes of the flags at runtime, by flag",
+		"Flag")
+	dynamicConfigErrors = stats.NewCountersWithSingleLabel(
+		"DynamicConfigErrors",
+		"Number of changes of the flags at runtime that were refused, by flag",
+		"Flag")
+)
+
+// variable is a flag that can be changed at runtime.
+type variable struct {
+	name string
+	help string
+	get  func() string
+	set  func(string) error
+
+	// initial is the value of the flag before its first change, which a
+	// reset restores.
+	initial *string
+	// source is the source of the last change.
+	source string
+}
+
+// Variable is the state of a flag that can be changed at runtime.
+type Variable struct {
+	Name    string
+	Help    string
+	Value   string
+	Initial string
+	Source  string `json:",omitempty"`
+}
+
+// Change is an entry of the audit trail.
+type Change struct {
+	Time     time.Time
+	Name     string
+	OldValue string
+	NewValue string
+	Source   string
+	User     string `json:",omitempty"`
+}
+
+var (
+	mu        sync.Mutex
+	variables = make(map[string]*variable)
+	changes   []Change
+)
+
+// Register makes a flag changeable at runtime: get returns its current
+// value, and set changes it, or returns an error if the value is invalid.
+// Both must be safe to call concurrently with the readers of the flag. A
+// later registration of the same flag replaces the previous one, for the
+// components that are created again.
+func Register(name, help string, get func() string, set func(string) error) {
+	mu.Lock()
+	defer mu.Unlock()
+	variables[name] = &variable{name: name, help: help, get: get, set: set}
+}
+
+// RegisterInt registers an int setting of a component that can be changed
+// at runtime, such as a limit. Negative values are refused.
+func RegisterInt(name, help string, get func() int, set func(int)) {
+	registerInt(name, help, 0, get, set)
+}
+
+// RegisterPoolSize registers the size of a pool of a component that can be
+// changed at runtime. Values below 1 are refused, since a pool without
+// connections would block all its callers.
+func RegisterPoolSize(name, help string, get func() int, set func(int)) {
+	registerInt(name, help, 1, get, set)
+}
+
+func registerInt(name, help string, min int, get func() int, set func(int)) {
+	Register(name, help, func() string {
+		return strconv.Itoa(get())
+	}, func(s string) error {
+		value, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		switch {
+		case value < 0:
+			return fmt.Errorf("%d is negative", value)
+		case value < min:
+			return fmt.Errorf("%d is less than %d", value, min)
+		}
+		set(value)
+		return nil
+	})
+}
+
+// RegisterDuration registers a time.Duration setting of a component that
+// can be changed at runtime, such as a timeout. Negative values are refused.
+func RegisterDuration(name, help string, get func() time.Duration, set func(time.Duration)) {
+	Register(name, help, func() string {
+		return get().String()
+	}, func(s string) error {
+		value, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		if value < 0 {
+			return fmt.Errorf("%v is negative", value)
+		}
+		set(value)
+		return nil
+	})
+}
+
+// Set changes a flag at runtime. The change is recorded in the audit trail
+// with its source and the user who made it, if known.
+func Set(name, value, source, user string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	return setLocked(name, value, source, user)
+}
+
+func setLocked(name, value, source, user string) error {
+	v, ok := variables[name]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown dynamic config variable %s", name)
+	}
+	old := v.get()
+	if err := v.set(value); err != nil {
+		dynamicConfigErrors.Add(name, 1)
+		return vterrors.Wrapf(err, "cannot set %s to %q", name, value)
+	}
+	if v.initial == nil {
+		v.initial = &old
+	}
+	v.source = source
+	recordLocked(Change{
+		Time:     time.Now(),
+		Name:     name,
+		OldValue: old,
+		NewValue: v.get(),
+		Source:   source,
+		User:     user,
+	})
+	return nil
+}
+
+// Reset restores the value that a flag had before it was changed at
+// runtime, and records it in the audit trail.
+func Reset(name, source, user string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	return resetLocked(name, source, user)
+}
+
+func resetLocked(name, source, user string) error {
+	v, ok := variables[name]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown dynamic config variable %s", name)
+	}
+	if v.initial == nil {
+		return nil
+	}
+	if err := setLocked(name, *v.initial, source, user); err != nil {
+		return err
+	}
+	v.source = ""
+	return nil
+}
+
+func recordLocked(change Change) {
+	log.Infof("dynamic config: %s changed from %q to %q by %s %s", change.Name, change.OldValue, change.NewValue, change.Source, change.User)
+	dynamicConfigChanges.Add(change.Name, 1)
+	changes = append(changes, change)
+	if len(changes) > maxChanges {
+		changes = changes[len(changes)-maxChanges:]
+	}
+}
+
+// Variables returns the state of the flags that can be changed at runtime,
+// sorted by name.
+func Variables() []Variable {
+	mu.Lock()
+	defer mu.Unlock()
+	result := make([]Variable, 0, len(variables))
+	for _, v := range variables {
+		value := v.get()
+		initial := value
+		if v.initial != nil {
+			initial = *v.initial
+		}
+		result = append(result, Variable{
+			Name:    v.name,
+			Help:    v.help,
+			Value:   value,
+			Initial: initial,
+			Source:  v.source,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Changes returns the audit trail of the last changes, oldest first.
+func Changes() []Change {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]Change(nil), changes...)
+}
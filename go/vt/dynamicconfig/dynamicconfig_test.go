@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func TestSetAndReset(t *testing.T) {
+	poolSize := 10
+	RegisterInt("test_pool_size", "pool size", func() int { return poolSize }, func(v int) { poolSize = v })
+	timeout := &Duration{}
+	timeout.SetValue(time.Second)
+	Register("test_timeout", "timeout", timeout.String, timeout.Set)
+
+	require.NoError(t, Set("test_pool_size", "20", SourceHTTP, "admin"))
+	assert.Equal(t, 20, poolSize)
+	require.NoError(t, Set("test_timeout", "5s", SourceHTTP, "admin"))
+	assert.Equal(t, 5*time.Second, timeout.Get())
+
+	err := Set("test_pool_size", "-1", SourceHTTP, "admin")
+	assert.EqualError(t, err, `cannot set test_pool_size to "-1": -1 is negative`)
+	err = Set("test_pool_size", "many", SourceHTTP, "admin")
+	assert.Contains(t, err.Error(), `cannot set test_pool_size to "many"`)
+	assert.Equal(t, 20, poolSize)
+	err = Set("test_unknown", "1", SourceHTTP, "admin")
+	assert.EqualError(t, err, "unknown dynamic config variable test_unknown")
+
+	want := Variable{Name: "test_pool_size", Help: "pool size", Value: "20", Initial: "10", Source: SourceHTTP}
+	assert.Contains(t, Variables(), want)
+
+	last := Changes()[len(Changes())-1]
+	assert.Equal(t, "test_timeout", last.Name)
+	assert.Equal(t, "1s", last.OldValue)
+	assert.Equal(t, "5s", last.NewValue)
+	assert.Equal(t, "admin", last.User)
+
+	require.NoError(t, Reset("test_pool_size", SourceHTTP, "admin"))
+	assert.Equal(t, 10, poolSize)
+	want = Variable{Name: "test_pool_size", Help: "pool size", Value: "10", Initial: "10"}
+	assert.Contains(t, Variables(), want)
+}
+
+func TestPoolSize(t *testing.T) {
+	poolSize := 10
+	RegisterPoolSize("test_pool", "pool", func() int { return poolSize }, func(v int) { poolSize = v })
+
+	err := Set("test_pool", "0", SourceHTTP, "admin")
+	assert.EqualError(t, err, `cannot set test_pool to "0": 0 is less than 1`)
+	err = Set("test_pool", "-1", SourceHTTP, "admin")
+	assert.EqualError(t, err, `cannot set test_pool to "-1": -1 is negative`)
+	assert.Equal(t, 10, poolSize)
+	require.NoError(t, Set("test_pool", "1", SourceHTTP, "admin"))
+	assert.Equal(t, 1, poolSize)
+}
+
+func TestChangesAreBounded(t *testing.T) {
+	value := 0
+	RegisterInt("test_bounded", "bounded", func() int { return value }, func(v int) { value = v })
+	for i := 0; i < 2*maxChanges; i++ {
+		require.NoError(t, Set("test_bounded", "1", SourceHTTP, ""))
+	}
+	assert.Len(t, Changes(), maxChanges)
+}
+
+func TestWatchTopo(t *testing.T) {
+	saved := watchRetryDelay
+	watchRetryDelay = 10 * time.Millisecond
+	defer func() { watchRetryDelay = saved }()
+
+	var rows sync2.AtomicInt64
+	rows.Set(100)
+	RegisterInt("test_rows", "rows", func() int { return int(rows.Get()) }, func(v int) { rows.Set(int64(v)) })
+	getRows := func() int { return int(rows.Get()) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("cell1")
+	go WatchTopo(ctx, ts, "vtgate")
+
+	err := ts.UpdateDynamicConfig(ctx, "vtgate", func(values map[string]string) error {
+		values["test_rows"] = "200"
+		values["test_unknown"] = "1"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return getRows() == 200 }, 5*time.Second, 10*time.Millisecond)
+
+	// Another component is not affected.
+	values, err := ts.GetDynamicConfig(ctx, "vttablet")
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	// Removing the override restores the initial value.
+	err = ts.UpdateDynamicConfig(ctx, "vtgate", func(values map[string]string) error {
+		delete(values, "test_rows")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return getRows() == 100 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHandleDynamicConfig(t *testing.T) {
+	value := 1
+	RegisterInt("test_http", "http", func() int { return value }, func(v int) { value = v })
+
+	w := httptest.NewRecorder()
+	form := url.Values{"name": {"test_http"}, "value": {"7"}}
+	r := httptest.NewRequest(http.MethodPost, pathDynamicConfig, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	handleDynamicConfig(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, 7, value)
+
+	var got struct {
+		Variables []Variable
+		Changes   []Change
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Contains(t, got.Variables, Variable{Name: "test_http", Help: "http", Value: "7", Initial: "1", Source: SourceHTTP})
+
+	w = httptest.NewRecorder()
+	form = url.Values{"name": {"test_http"}, "value": {"-7"}}
+	r = httptest.NewRequest(http.MethodPost, pathDynamicConfig, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	handleDynamicConfig(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 7, value)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dynamicconfigclient defines the generic RPC client interface for
+// the dynamic configuration service. It has to be implemented for the
+// different RPC frameworks e.g. gRPC.
+package dynamicconfigclient
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/log"
+
+	dynamicconfigdatapb "vitess.io/vitess/go/vt/proto/dynamicconfigdata"
+)
+
+// protocol specifies which RPC client implementation should be used.
+var protocol = flag.String("dynamic_config_client_protocol", "grpc", "the protocol to use to talk to the dynamic configuration service of vtgate and vttablet")
+
+// Client defines the generic RPC interface for the dynamic configuration
+// service.
+type Client interface {
+	// GetDynamicConfig returns the flags of the process that can be changed
+	// at runtime, sorted by name, and the last changes, oldest first.
+	GetDynamicConfig(ctx context.Context) ([]*dynamicconfigdatapb.Variable, []*dynamicconfigdatapb.Change, error)
+
+	// SetDynamicConfig changes a flag of the process, and returns its new
+	// state.
+	SetDynamicConfig(ctx context.Context, name, value string) (*dynamicconfigdatapb.Variable, error)
+
+	// ResetDynamicConfig restores the value that a flag of the process had
+	// before it was changed at runtime, and returns its new state.
+	ResetDynamicConfig(ctx context.Context, name string) (*dynamicconfigdatapb.Variable, error)
+
+	// Close will terminate the connection and free resources.
+	Close()
+}
+
+// Factory has to be implemented and must create a new RPC client for a given
+// "addr".
+type Factory func(addr string) (Client, error)
+
+var factories = make(map[string]Factory)
+
+// RegisterFactory allows a client implementation to register itself.
+func RegisterFactory(name string, factory Factory) {
+	if _, ok := factories[name]; ok {
+		log.Fatalf("RegisterFactory: %s already exists", name)
+	}
+	factories[name] = factory
+}
+
+// New will return a client for the selected RPC implementation.
+func New(addr string) (Client, error) {
+	factory, ok := factories[*protocol]
+	if !ok {
+		return nil, fmt.Errorf("unknown dynamic config client protocol: %v", *protocol)
+	}
+	return factory(addr)
+}
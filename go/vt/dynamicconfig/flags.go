@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicconfig
+
+import (
+	"flag"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sync2"
+)
+
+// Int is an int flag that can be changed at runtime.
+type Int struct {
+	v sync2.AtomicInt64
+}
+
+// NewInt defines an int flag that can be changed at runtime.
+func NewInt(name string, value int, usage string) *Int {
+	i := &Int{}
+	i.v.Set(int64(value))
+	flag.Var(i, name, usage)
+	Register(name, usage, i.String, i.Set)
+	return i
+}
+
+// Get returns the current value of the flag.
+func (i *Int) Get() int {
+	return int(i.v.Get())
+}
+
+// SetValue changes the value of the flag, without recording it.
+func (i *Int) SetValue(value int) {
+	i.v.Set(int64(value))
+}
+
+// String is part of the flag.Value interface.
+func (i *Int) String() string {
+	return strconv.FormatInt(i.v.Get(), 10)
+}
+
+// Set is part of the flag.Value interface.
+func (i *Int) Set(s string) error {
+	value, err := strconv.ParseInt(s, 0, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	i.v.Set(value)
+	return nil
+}
+
+// Duration is a time.Duration flag that can be changed at runtime.
+type Duration struct {
+	v sync2.AtomicDuration
+}
+
+// NewDuration defines a time.Duration flag that can be changed at runtime.
+func NewDuration(name string, value time.Duration, usage string) *Duration {
+	d := &Duration{}
+	d.v.Set(value)
+	flag.Var(d, name, usage)
+	Register(name, usage, d.String, d.Set)
+	return d
+}
+
+// Get returns the current value of the flag.
+func (d *Duration) Get() time.Duration {
+	return d.v.Get()
+}
+
+// SetValue changes the value of the flag, without recording it.
+func (d *Duration) SetValue(value time.Duration) {
+	d.v.Set(value)
+}
+
+// String is part of the flag.Value interface.
+func (d *Duration) String() string {
+	return d.v.Get().String()
+}
+
+// Set is part of the flag.Value interface.
+func (d *Duration) Set(s string) error {
+	value, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.v.Set(value)
+	return nil
+}
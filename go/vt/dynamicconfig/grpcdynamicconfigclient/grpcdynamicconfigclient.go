@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcdynamicconfigclient contains the gRPC version of the dynamic
+// configuration client protocol.
+package grpcdynamicconfigclient
+
+import (
+	"context"
+	"flag"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/dynamicconfig/dynamicconfigclient"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	dynamicconfigdatapb "vitess.io/vitess/go/vt/proto/dynamicconfigdata"
+	dynamicconfigservicepb "vitess.io/vitess/go/vt/proto/dynamicconfigservice"
+)
+
+var (
+	cert = flag.String("dynamic_config_client_grpc_cert", "", "the cert to use to connect")
+	key  = flag.String("dynamic_config_client_grpc_key", "", "the key to use to connect")
+	ca   = flag.String("dynamic_config_client_grpc_ca", "", "the server ca to use to validate servers when connecting")
+	crl  = flag.String("dynamic_config_client_grpc_crl", "", "the server crl to use to validate server certificates when connecting")
+	name = flag.String("dynamic_config_client_grpc_server_name", "", "the server name to use to validate server certificate")
+)
+
+type client struct {
+	conn       *grpc.ClientConn
+	gRPCClient dynamicconfigservicepb.DynamicConfigClient
+}
+
+func factory(addr string) (dynamicconfigclient.Client, error) {
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *crl, *name)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcclient.Dial(addr, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &client{conn, dynamicconfigservicepb.NewDynamicConfigClient(conn)}, nil
+}
+
+// GetDynamicConfig is part of the dynamicconfigclient.Client interface.
+func (c *client) GetDynamicConfig(ctx context.Context) ([]*dynamicconfigdatapb.Variable, []*dynamicconfigdatapb.Change, error) {
+	response, err := c.gRPCClient.GetDynamicConfig(ctx, &dynamicconfigdatapb.GetDynamicConfigRequest{})
+	if err != nil {
+		return nil, nil, vterrors.FromGRPC(err)
+	}
+	return response.Variables, response.Changes, nil
+}
+
+// SetDynamicConfig is part of the dynamicconfigclient.Client interface.
+func (c *client) SetDynamicConfig(ctx context.Context, name, value string) (*dynamicconfigdatapb.Variable, error) {
+	response, err := c.gRPCClient.SetDynamicConfig(ctx, &dynamicconfigdatapb.SetDynamicConfigRequest{
+		Name:  name,
+		Value: value,
+	})
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response.Variable, nil
+}
+
+// ResetDynamicConfig is part of the dynamicconfigclient.Client interface.
+func (c *client) ResetDynamicConfig(ctx context.Context, name string) (*dynamicconfigdatapb.Variable, error) {
+	response, err := c.gRPCClient.SetDynamicConfig(ctx, &dynamicconfigdatapb.SetDynamicConfigRequest{
+		Name:   name,
+		Reset_: true,
+	})
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response.Variable, nil
+}
+
+// Close is part of the dynamicconfigclient.Client interface.
+func (c *client) Close() {
+	c.conn.Close()
+}
+
+func init() {
+	dynamicconfigclient.RegisterFactory("grpc", factory)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcdynamicconfigclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/dynamicconfig"
+	"vitess.io/vitess/go/vt/dynamicconfig/grpcdynamicconfigserver"
+)
+
+// TestDynamicConfigServer tests the gRPC implementation using a dynamic
+// configuration client and server.
+func TestDynamicConfigServer(t *testing.T) {
+	poolSize := 10
+	dynamicconfig.RegisterPoolSize("test_grpc_pool_size", "pool size", func() int { return poolSize }, func(v int) { poolSize = v })
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	grpcdynamicconfigserver.RegisterServer(s)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	client, err := factory(fmt.Sprintf("localhost:%v", listener.Addr().(*net.TCPAddr).Port))
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+
+	v, err := client.SetDynamicConfig(ctx, "test_grpc_pool_size", "20")
+	require.NoError(t, err)
+	assert.Equal(t, 20, poolSize)
+	assert.Equal(t, "20", v.Value)
+	assert.Equal(t, "10", v.Initial)
+	assert.Equal(t, dynamicconfig.SourceRPC, v.Source)
+
+	_, err = client.SetDynamicConfig(ctx, "test_grpc_pool_size", "0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "0 is less than 1")
+	_, err = client.SetDynamicConfig(ctx, "test_grpc_unknown", "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown dynamic config variable test_grpc_unknown")
+
+	variables, changes, err := client.GetDynamicConfig(ctx)
+	require.NoError(t, err)
+	require.Len(t, variables, 1)
+	assert.Equal(t, "test_grpc_pool_size", variables[0].Name)
+	require.NotEmpty(t, changes)
+	last := changes[len(changes)-1]
+	assert.Equal(t, "20", last.NewValue)
+	assert.Equal(t, dynamicconfig.SourceRPC, last.Source)
+	assert.NotEmpty(t, last.User)
+
+	v, err = client.ResetDynamicConfig(ctx, "test_grpc_pool_size")
+	require.NoError(t, err)
+	assert.Equal(t, 10, poolSize)
+	assert.Equal(t, "10", v.Value)
+}
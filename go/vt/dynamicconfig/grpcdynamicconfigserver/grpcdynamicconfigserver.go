@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcdynamicconfigserver contains the gRPC implementation of the
+// server side of the dynamic configuration service.
+package grpcdynamicconfigserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/dynamicconfig"
+	"vitess.io/vitess/go/vt/servenv"
+
+	dynamicconfigdatapb "vitess.io/vitess/go/vt/proto/dynamicconfigdata"
+	dynamicconfigservicepb "vitess.io/vitess/go/vt/proto/dynamicconfigservice"
+)
+
+// Server is the gRPC server implementation of the DynamicConfig service.
+type Server struct {
+	dynamicconfigservicepb.UnimplementedDynamicConfigServer
+}
+
+// GetDynamicConfig implements the gRPC server interface. It returns the
+// flags of the process that can be changed at runtime, and the audit trail
+// of their changes.
+func (s *Server) GetDynamicConfig(_ context.Context, request *dynamicconfigdatapb.GetDynamicConfigRequest) (_ *dynamicconfigdatapb.GetDynamicConfigResponse, err error) {
+	defer servenv.HandlePanic("dynamicconfig", &err)
+
+	response := &dynamicconfigdatapb.GetDynamicConfigResponse{}
+	for _, v := range dynamicconfig.Variables() {
+		response.Variables = append(response.Variables, variableToProto(v))
+	}
+	for _, c := range dynamicconfig.Changes() {
+		response.Changes = append(response.Changes, &dynamicconfigdatapb.Change{
+			Time:     protoutil.TimeToProto(c.Time),
+			Name:     c.Name,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+			Source:   c.Source,
+			User:     c.User,
+		})
+	}
+	return response, nil
+}
+
+// SetDynamicConfig implements the gRPC server interface. It changes a flag
+// of the process, or resets it, and records the change with the address of
+// the caller.
+func (s *Server) SetDynamicConfig(ctx context.Context, request *dynamicconfigdatapb.SetDynamicConfigRequest) (_ *dynamicconfigdatapb.SetDynamicConfigResponse, err error) {
+	defer servenv.HandlePanic("dynamicconfig", &err)
+
+	var user string
+	if p, ok := peer.FromContext(ctx); ok {
+		user = p.Addr.String()
+	}
+	if request.Reset_ {
+		err = dynamicconfig.Reset(request.Name, dynamicconfig.SourceRPC, user)
+	} else {
+		err = dynamicconfig.Set(request.Name, request.Value, dynamicconfig.SourceRPC, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dynamicconfigdatapb.SetDynamicConfigResponse{}
+	for _, v := range dynamicconfig.Variables() {
+		if v.Name == request.Name {
+			response.Variable = variableToProto(v)
+		}
+	}
+	return response, nil
+}
+
+func variableToProto(v dynamicconfig.Variable) *dynamicconfigdatapb.Variable {
+	return &dynamicconfigdatapb.Variable{
+		Name:    v.Name,
+		Help:    v.Help,
+		Value:   v.Value,
+		Initial: v.Initial,
+		Source:  v.Source,
+	}
+}
+
+// RegisterServer registers a new dynamic configuration server instance with
+// the gRPC server.
+func RegisterServer(s *grpc.Server) {
+	dynamicconfigservicepb.RegisterDynamicConfigServer(s, &Server{})
+}
+
+func init() {
+	servenv.OnRun(func() {
+		if servenv.GRPCCheckServiceMap("dynamicconfig") {
+			RegisterServer(servenv.GRPCServer)
+		}
+	})
+}
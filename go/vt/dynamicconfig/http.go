@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"vitess.io/vitess/go/acl"
+)
+
+const pathDynamicConfig = "/debug/dynamic_config"
+
+func init() {
+	http.HandleFunc(pathDynamicConfig, handleDynamicConfig)
+}
+
+// handleDynamicConfig returns the flags that can be changed at runtime and
+// the audit trail of their changes as JSON. A POST with a name and a value
+// changes a flag, and a POST with a name and reset=true restores its value
+// from before the changes:
+//
+//	curl -d name=max_memory_rows -d value=500000 http://vtgate/debug/dynamic_config
+func handleDynamicConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		name := r.FormValue("name")
+		var err error
+		if r.FormValue("reset") == "true" {
+			err = Reset(name, SourceHTTP, r.RemoteAddr)
+		} else {
+			err = Set(name, r.FormValue("value"), SourceHTTP, r.RemoteAddr)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Variables []Variable
+		Changes   []Change
+	}{
+		Variables: Variables(),
+		Changes:   Changes(),
+	})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicconfig
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// watchRetryDelay is how long WatchTopo waits before it watches the topo
+// again, after an error or when the configuration doesn't exist yet.
+var watchRetryDelay = 30 * time.Second
+
+// WatchTopo applies the values that the dynamic configuration of the
+// component in the topo sets for the flags, until the context is done. The
+// flags whose value is removed from the topo are reset. The values of the
+// flags that this binary doesn't have, for example because it runs another
+// version, are ignored.
+func WatchTopo(ctx context.Context, ts *topo.Server, component string) {
+	for {
+		current, changes, cancel := ts.WatchDynamicConfig(ctx, component)
+		switch {
+		case current.Err == nil:
+			applyTopo(current.Value)
+			for change := range changes {
+				if change.Err != nil {
+					current = change
+					break
+				}
+				applyTopo(change.Value)
+			}
+			cancel()
+		case topo.IsErrType(current.Err, topo.NoNode):
+			applyTopo(nil)
+		}
+		if current.Err != nil && !topo.IsErrType(current.Err, topo.NoNode) && ctx.Err() == nil {
+			log.Warningf("dynamic config: cannot watch the configuration of %s in the topo: %v", component, current.Err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// applyTopo sets the flags to the values of the topo, and resets the ones
+// that were set by the topo and are not anymore.
+func applyTopo(values map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, ok := variables[name]
+		if !ok {
+			log.Warningf("dynamic config: ignoring unknown variable %s from the topo", name)
+			continue
+		}
+		if v.get() == values[name] && v.source == SourceTopo {
+			continue
+		}
+		if err := setLocked(name, values[name], SourceTopo, ""); err != nil {
+			log.Errorf("dynamic config: %v", err)
+		}
+	}
+	for name, v := range variables {
+		if _, ok := values[name]; !ok && v.source == SourceTopo {
+			if err := resetLocked(name, SourceTopo, ""); err != nil {
+				log.Errorf("dynamic config: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package featureflags lets operators enable or disable features keyspace
+// by keyspace, to ramp them up and roll them back without a restart. The
+// flags of a keyspace are key/value pairs stored in the global topo, which
+// vtgate and vttablet watch, and which the subsystems consult when they
+// decide whether to use a feature for a keyspace.
+package featureflags
+
+import (
+	"strconv"
+	"sync"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// The feature flags that the subsystems consult.
+const (
+	// Gen4Planner makes vtgate plan the queries of the sessions that target
+	// the keyspace with the Gen4 planner when it's true, and with the V3
+	// planner when it's false, instead of the planner of -planner_version.
+	Gen4Planner = "gen4_planner"
+	// ResultCache disables the result cache of vtgate for the queries that
+	// use the keyspace when it's false.
+	ResultCache = "result_cache"
+)
+
+var featureFlagChanges = stats.NewCountersWithSingleLabel(
+	"FeatureFlagChanges",
+	"Number of changes of the feature flags seen in the topo, by keyspace",
+	"Keyspace")
+
+var (
+	mu sync.RWMutex
+	// flags are keyed by keyspace, then by flag name.
+	flags = make(map[string]map[string]string)
+)
+
+// Get returns the value of a feature flag of a keyspace, and whether it's
+// set.
+func Get(keyspace, name string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	value, ok := flags[keyspace][name]
+	return value, ok
+}
+
+// Bool returns the value of a boolean feature flag of a keyspace, and
+// whether it's set to a valid boolean.
+func Bool(keyspace, name string) (bool, bool) {
+	value, ok := Get(keyspace, name)
+	if !ok {
+		return false, false
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, false
+	}
+	return enabled, true
+}
+
+// Enabled returns whether a boolean feature flag is enabled for a keyspace,
+// or defaultValue if it's not set.
+func Enabled(keyspace, name string, defaultValue bool) bool {
+	if enabled, ok := Bool(keyspace, name); ok {
+		return enabled
+	}
+	return defaultValue
+}
+
+// Set replaces the feature flags of a keyspace. A nil map removes them.
+func Set(keyspace string, values map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	old := flags[keyspace]
+	changed := len(old) != len(values)
+	for name, value := range values {
+		if oldValue, ok := old[name]; !ok || oldValue != value {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	featureFlagChanges.Add(keyspace, 1)
+	log.Infof("feature flags of keyspace %s changed from %v to %v", keyspace, old, values)
+	if len(values) == 0 {
+		delete(flags, keyspace)
+		return
+	}
+	copied := make(map[string]string, len(values))
+	for name, value := range values {
+		copied[name] = value
+	}
+	flags[keyspace] = copied
+}
+
+// All returns a copy of the feature flags of all the keyspaces.
+func All() map[string]map[string]string {
+	mu.RLock()
+	defer mu.RUnlock()
+	result := make(map[string]map[string]string, len(flags))
+	for keyspace, values := range flags {
+		copied := make(map[string]string, len(values))
+		for name, value := range values {
+			copied[name] = value
+		}
+		result[keyspace] = copied
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featureflags
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestFlags(t *testing.T) {
+	defer Set("ks1", nil)
+	Set("ks1", map[string]string{Gen4Planner: "true", ResultCache: "off", "other": "value"})
+
+	enabled, ok := Bool("ks1", Gen4Planner)
+	assert.True(t, ok)
+	assert.True(t, enabled)
+	_, ok = Bool("ks1", ResultCache)
+	assert.False(t, ok, "off is not a boolean")
+	assert.True(t, Enabled("ks1", ResultCache, true))
+	assert.False(t, Enabled("ks2", Gen4Planner, false))
+
+	value, ok := Get("ks1", "other")
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	// The returned flags are a copy.
+	all := All()
+	all["ks1"][Gen4Planner] = "false"
+	assert.True(t, Enabled("ks1", Gen4Planner, false))
+
+	Set("ks1", nil)
+	assert.Empty(t, All())
+}
+
+func TestWatchKeyspaces(t *testing.T) {
+	savedRetry, savedRefresh := watchRetryDelay, keyspacesRefreshInterval
+	watchRetryDelay, keyspacesRefreshInterval = 10*time.Millisecond, 10*time.Millisecond
+	defer func() { watchRetryDelay, keyspacesRefreshInterval = savedRetry, savedRefresh }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks1", &topodatapb.Keyspace{}))
+	go WatchKeyspaces(ctx, ts, nil)
+
+	require.NoError(t, ts.UpdateFeatureFlags(ctx, "ks1", func(values map[string]string) error {
+		values[Gen4Planner] = "true"
+		return nil
+	}))
+	assert.Eventually(t, func() bool { return Enabled("ks1", Gen4Planner, false) }, 5*time.Second, 10*time.Millisecond)
+
+	// A keyspace created later is watched too.
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.UpdateFeatureFlags(ctx, "ks2", func(values map[string]string) error {
+		values[ResultCache] = "false"
+		return nil
+	}))
+	assert.Eventually(t, func() bool { return !Enabled("ks2", ResultCache, true) }, 5*time.Second, 10*time.Millisecond)
+
+	// Rolling back a flag is seen right away.
+	require.NoError(t, ts.UpdateFeatureFlags(ctx, "ks1", func(values map[string]string) error {
+		delete(values, Gen4Planner)
+		return nil
+	}))
+	assert.Eventually(t, func() bool { _, ok := Get("ks1", Gen4Planner); return !ok }, 5*time.Second, 10*time.Millisecond)
+
+	// The flags of a deleted keyspace are removed.
+	require.NoError(t, ts.DeleteKeyspace(ctx, "ks2"))
+	assert.Eventually(t, func() bool { _, ok := All()["ks2"]; return !ok }, 5*time.Second, 10*time.Millisecond)
+}
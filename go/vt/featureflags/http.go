@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featureflags
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"vitess.io/vitess/go/acl"
+)
+
+const pathFeatureFlags = "/debug/feature_flags"
+
+func init() {
+	http.HandleFunc(pathFeatureFlags, handleFeatureFlags)
+}
+
+// handleFeatureFlags returns the feature flags that this binary sees, by
+// keyspace, as JSON. They are changed in the topo, with vtctl SetFeatureFlag.
+func handleFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(All())
+}
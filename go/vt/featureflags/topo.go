@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featureflags
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	// watchRetryDelay is how long Watch waits before it watches the topo
+	// again, after an error or when the flags don't exist yet.
+	watchRetryDelay = 30 * time.Second
+	// keyspacesRefreshInterval is how often WatchKeyspaces looks for new
+	// keyspaces in the topo.
+	keyspacesRefreshInterval = 30 * time.Second
+)
+
+// Watch keeps the feature flags of a keyspace up to date with the topo,
+// until the context is done. The flags of the keyspace are then removed.
+func Watch(ctx context.Context, ts *topo.Server, keyspace string) {
+	defer Set(keyspace, nil)
+	for {
+		current, changes, cancel := ts.WatchFeatureFlags(ctx, keyspace)
+		if current.Err == nil {
+			Set(keyspace, current.Value)
+			for change := range changes {
+				if change.Err != nil {
+					current = change
+					break
+				}
+				Set(keyspace, change.Value)
+			}
+			cancel()
+		}
+		switch {
+		case ctx.Err() != nil:
+		case topo.IsErrType(current.Err, topo.NoNode):
+			// The flags were never set, or were deleted.
+			Set(keyspace, nil)
+		case current.Err != nil:
+			log.Warningf("cannot watch the feature flags of keyspace %s: %v", keyspace, current.Err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// WatchKeyspaces watches the feature flags of the given keyspaces, or of all
+// the keyspaces of the topo if none is given, until the context is done.
+// The keyspaces that are created or deleted later are picked up.
+func WatchKeyspaces(ctx context.Context, ts *topo.Server, keyspaces []string) {
+	watches := make(map[string]context.CancelFunc)
+	defer func() {
+		for _, cancel := range watches {
+			cancel()
+		}
+	}()
+
+	for {
+		names := keyspaces
+		if len(names) == 0 {
+			var err error
+			if names, err = ts.GetKeyspaces(ctx); err != nil {
+				if ctx.Err() == nil {
+					log.Warningf("cannot list the keyspaces to watch their feature flags: %v", err)
+				}
+				names = nil
+			}
+		}
+		if names != nil {
+			found := make(map[string]bool, len(names))
+			for _, keyspace := range names {
+				found[keyspace] = true
+				if _, ok := watches[keyspace]; !ok {
+					watchCtx, cancel := context.WithCancel(ctx)
+					watches[keyspace] = cancel
+					go Watch(watchCtx, ts, keyspace)
+				}
+			}
+			for keyspace, cancel := range watches {
+				if !found[keyspace] {
+					cancel()
+					delete(watches, keyspace)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keyspacesRefreshInterval):
+		}
+	}
+}
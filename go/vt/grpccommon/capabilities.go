@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpccommon
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"vitess.io/vitess/go/stats"
+)
+
+// The gRPC servers announce their version and the features they understand
+// in the headers of every response, so that the clients don't send requests
+// that an older server would silently misinterpret, for example by ignoring
+// an ExecuteOptions field it doesn't know about.
+const (
+	// VersionHeader is the header with the version of the server.
+	VersionHeader = "vitess-version"
+	// CapabilitiesHeader is the header with the comma-separated
+	// capabilities of the server.
+	CapabilitiesHeader = "vitess-capabilities"
+)
+
+// The capabilities of this version. A server that doesn't announce a
+// capability must be assumed not to have it.
+const (
+	// CapabilityReadAfterWrite is for tablets that wait for the
+	// read_after_write_gtid of the ExecuteOptions before they read.
+	CapabilityReadAfterWrite = "read_after_write"
+	// CapabilityResultCompression is for tablets that compress the rows of
+	// the results with the result_compression of the ExecuteOptions.
+	CapabilityResultCompression = "result_compression"
+	// CapabilityReadAtTimestamp is for tablets that wait for the
+	// read_at_timestamp of the ExecuteOptions before they read.
+	CapabilityReadAtTimestamp = "read_at_timestamp"
+)
+
+var capabilities = []string{
+	CapabilityReadAfterWrite,
+	CapabilityResultCompression,
+	CapabilityReadAtTimestamp,
+}
+
+// Capabilities returns the capabilities of this version.
+func Capabilities() []string {
+	return append([]string(nil), capabilities...)
+}
+
+// CapabilitiesFromHeader returns the capabilities a server announced in the
+// headers of a response. It is empty for servers that predate the
+// announcement.
+func CapabilitiesFromHeader(md metadata.MD) map[string]bool {
+	result := make(map[string]bool)
+	for _, value := range md.Get(CapabilitiesHeader) {
+		for _, capability := range strings.Split(value, ",") {
+			if capability = strings.TrimSpace(capability); capability != "" {
+				result[capability] = true
+			}
+		}
+	}
+	return result
+}
+
+func init() {
+	stats.NewString("Capabilities").Set(strings.Join(capabilities, ","))
+}
@@ -78,6 +78,11 @@ const (
 	HOOK_TIMEOUT_ERROR = -7
 )
 
+// SwapMysqldBinariesHook is the optional hook that the UpgradeMysqld RPC of
+// vttablet runs while mysqld is stopped, to install the new mysqld binaries,
+// and move the data directory if the new version needs it.
+const SwapMysqldBinariesHook = "swap_mysqld_binaries"
+
 // WaitFunc is a return type for the Pipe methods.
 // It returns the process stderr and an error, if any.
 type WaitFunc func() (string, error)
@@ -18,11 +18,11 @@ package key
 
 import (
 	"bytes"
-	"encoding/hex"
 	"math/rand"
 	"sort"
 	"strings"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -277,7 +277,7 @@ func (d DestinationKeyspaceID) Resolve(allShards []*topodatapb.ShardReference, a
 
 // String is part of the Destination interface.
 func (d DestinationKeyspaceID) String() string {
-	return "DestinationKeyspaceID(" + hex.EncodeToString(d) + ")"
+	return "DestinationKeyspaceID(" + obfuscation.KeyspaceID(d) + ")"
 }
 
 // GetShardForKeyspaceID finds the right shard for a keyspace id.
@@ -291,7 +291,7 @@ func GetShardForKeyspaceID(allShards []*topodatapb.ShardReference, keyspaceID []
 			return shardReference.Name, nil
 		}
 	}
-	return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "KeyspaceId %v didn't match any shards %+v", hex.EncodeToString(keyspaceID), allShards)
+	return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "KeyspaceId %v didn't match any shards %+v", obfuscation.KeyspaceID(keyspaceID), allShards)
 }
 
 //
@@ -324,7 +324,7 @@ func (d DestinationKeyspaceIDs) String() string {
 		if i > 0 {
 			buffer.WriteByte(',')
 		}
-		buffer.WriteString(hex.EncodeToString(ksid))
+		buffer.WriteString(obfuscation.KeyspaceID(ksid))
 	}
 	buffer.WriteByte(')')
 	return buffer.String()
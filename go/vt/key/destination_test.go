@@ -17,9 +17,13 @@ limitations under the License.
 package key
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"vitess.io/vitess/go/vt/obfuscation"
+
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -259,3 +263,30 @@ func TestDestinationKeyRange(t *testing.T) {
 		}
 	}
 }
+
+// lengthObfuscator obfuscates the ids into their length.
+type lengthObfuscator struct{}
+
+func (lengthObfuscator) Obfuscate(id []byte) string {
+	return fmt.Sprintf("len%d", len(id))
+}
+
+func TestDestinationKeyspaceIDObfuscation(t *testing.T) {
+	ksid := []byte{0x10, 0x20}
+	if got, want := DestinationKeyspaceID(ksid).String(), "DestinationKeyspaceID(1020)"; got != want {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	obfuscation.Set(lengthObfuscator{})
+	defer obfuscation.Set(nil)
+	if got, want := DestinationKeyspaceID(ksid).String(), "DestinationKeyspaceID(len2)"; got != want {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if got, want := DestinationKeyspaceIDs([][]byte{ksid, {0x30}}).String(), "DestinationKeyspaceIDs(len2,len1)"; got != want {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	_, err := GetShardForKeyspaceID([]*topodatapb.ShardReference{{Name: "-10", KeyRange: &topodatapb.KeyRange{End: []byte{0x10}}}}, ksid)
+	if want := "KeyspaceId len2 didn't match any shards"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("want %v, got %v", want, err)
+	}
+}
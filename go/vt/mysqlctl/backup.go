@@ -97,10 +97,19 @@ var (
 // - uses the BackupStorage service to store a new backup
 // - shuts down Mysqld during the backup
 // - remember if we were replicating, restore the exact same state
+// - rejects the replicas with broken or lagging replication, or errant GTIDs
 func Backup(ctx context.Context, params BackupParams) error {
 	startTs := time.Now()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 	name := fmt.Sprintf("%v.%v", params.BackupTime.UTC().Format(BackupTimestampFormat), params.TabletAlias)
+	// Check that a replica can be backed up before starting the backup, and
+	// record its replication state in the MANIFEST.
+	replicationSource, err := checkReplicaBackup(ctx, params)
+	if err != nil {
+		return err
+	}
+	params.ReplicationSource = replicationSource
+
 	// Start the backup with the BackupStorage.
 	bs, err := backupstorage.GetBackupStorage()
 	if err != nil {
@@ -117,8 +126,26 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
 
+	// The engines that drain the tablet stop its replication themselves.
+	restartReplication := func() error { return nil }
+	if !be.ShouldDrainForBackup() {
+		restartReplication, err = stopReplicationForBackup(params)
+		if err != nil {
+			if restartErr := restartReplication(); restartErr != nil {
+				params.Logger.Errorf2(restartErr, "failed to restart replication")
+			}
+			if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+				params.Logger.Errorf2(abortErr, "failed to abort backup")
+			}
+			return err
+		}
+	}
+
 	// Take the backup, and either AbortBackup or EndBackup.
 	usable, err := be.ExecuteBackup(ctx, params, bh)
+	if restartErr := restartReplication(); restartErr != nil && err == nil {
+		err = restartErr
+	}
 	logger := params.Logger
 	var finishErr error
 	if usable {
@@ -320,6 +347,13 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 	if err != nil {
 		return nil, err
 	}
+	if src := manifest.ReplicationSource; src != nil {
+		params.Logger.Infof("Restore: backup of replica %v taken at position %v, primary %v at %v, replication stopped: %v",
+			manifest.TabletAlias, manifest.Position, src.PrimaryAlias, src.PrimaryPosition, src.ReplicationStopped)
+		if src.ErrantGTIDs != "" {
+			params.Logger.Warningf("Restore: backup has errant GTIDs %v that its primary didn't have", src.ErrantGTIDs)
+		}
+	}
 
 	// mysqld needs to be running in order for mysql_upgrade to work.
 	// If we've just restored from a backup from previous MySQL version then mysqld
@@ -65,6 +65,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// ReplicationSource is the replication state of the tablet, if it is a
+	// replica, to record in the MANIFEST. It is set by Backup.
+	ReplicationSource *BackupReplicationSource
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -187,6 +190,31 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// TabletAlias is the tablet that took the backup, and Keyspace and Shard its shard.
+	// Backups created before these fields were added don't set them.
+	TabletAlias string `json:",omitempty"`
+	Keyspace    string `json:",omitempty"`
+	Shard       string `json:",omitempty"`
+
+	// ReplicationSource is the replication state of the tablet when it was backed up,
+	// if it was a replica.
+	ReplicationSource *BackupReplicationSource `json:",omitempty"`
+}
+
+// newBackupManifest returns the common fields of the MANIFEST of a backup
+// that finishes now at the given position.
+func newBackupManifest(backupMethod string, params BackupParams, position mysql.Position) BackupManifest {
+	return BackupManifest{
+		BackupMethod:      backupMethod,
+		Position:          position,
+		BackupTime:        params.BackupTime.UTC().Format(time.RFC3339),
+		FinishedTime:      time.Now().UTC().Format(time.RFC3339),
+		TabletAlias:       params.TabletAlias,
+		Keyspace:          params.Keyspace,
+		Shard:             params.Shard,
+		ReplicationSource: params.ReplicationSource,
+	}
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
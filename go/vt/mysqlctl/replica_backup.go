@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+var (
+	backupMaxReplicationLag = flag.Duration("backup_max_replication_lag", 0, "if set, backups of replicas that lag more than this behind their primary are rejected")
+	backupStopReplication   = flag.Bool("backup_stop_replication", false, "if set, the replication of a replica is stopped while an online backup engine, such as xtrabackup, takes its snapshot, and restarted after. The builtin engine always stops it.")
+	backupAllowErrantGTIDs  = flag.Bool("backup_allow_errant_gtids", false, "if set, replicas that executed GTIDs that their primary doesn't have are backed up, with the errant GTIDs recorded in the MANIFEST, instead of rejected")
+)
+
+// BackupReplicationSource is the replication state of a replica when it was
+// backed up, which is recorded in the MANIFEST of its backups.
+type BackupReplicationSource struct {
+	// PrimaryAlias is the primary of the shard when the backup started, if known.
+	PrimaryAlias string `json:",omitempty"`
+
+	// PrimaryPosition is the position of the primary when the backup started.
+	// Unless there are ErrantGTIDs, it contains the position of the backup,
+	// which is then a consistent point of the primary.
+	PrimaryPosition mysql.Position
+
+	// SourceFilePosition is the binlog file position of the primary that the
+	// replica executed up to, when its replication was stopped for the snapshot.
+	SourceFilePosition string `json:",omitempty"`
+
+	// ReplicationStopped is true if the replication was stopped during the
+	// snapshot, so that the position of the backup is exact.
+	ReplicationStopped bool
+
+	// ReplicationLagSeconds is the replication lag of the replica when the
+	// backup started.
+	ReplicationLagSeconds uint
+
+	// ErrantGTIDs are the GTIDs of the replica that its primary doesn't have,
+	// when -backup_allow_errant_gtids let it be backed up anyway.
+	ErrantGTIDs string `json:",omitempty"`
+}
+
+// recordStoppedReplication records the state of the replication once it is
+// stopped for the snapshot.
+func (src *BackupReplicationSource) recordStoppedReplication(status mysql.ReplicationStatus) {
+	src.ReplicationStopped = true
+	if !status.FilePosition.IsZero() {
+		src.SourceFilePosition = status.FilePosition.String()
+	}
+}
+
+// checkReplicaBackup checks that a replica can be backed up: its replication
+// isn't broken, it doesn't lag more than -backup_max_replication_lag, and it
+// didn't execute GTIDs that its primary doesn't have. It returns the
+// replication state to record in the MANIFEST, or nil if the tablet isn't a
+// replica.
+func checkReplicaBackup(ctx context.Context, params BackupParams) (*BackupReplicationSource, error) {
+	status, err := params.Mysqld.ReplicationStatus()
+	switch err {
+	case nil:
+	case mysql.ErrNotReplica:
+		return nil, nil
+	default:
+		return nil, vterrors.Wrap(err, "can't get replica status")
+	}
+	if status.LastIOError != "" || status.LastSQLError != "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "replication is broken, can't back up the replica: io error: %q, sql error: %q", status.LastIOError, status.LastSQLError)
+	}
+	if *backupMaxReplicationLag > 0 && status.Running() {
+		if status.ReplicationLagUnknown {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "replication lag is unknown, can't back up the replica with -backup_max_replication_lag %v", *backupMaxReplicationLag)
+		}
+		if lag := time.Duration(status.ReplicationLagSeconds) * time.Second; lag > *backupMaxReplicationLag {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "replication lag %v is higher than -backup_max_replication_lag %v, can't back up the replica", lag, *backupMaxReplicationLag)
+		}
+	}
+	src := &BackupReplicationSource{
+		ReplicationLagSeconds: status.ReplicationLagSeconds,
+	}
+	if !status.Running() {
+		src.recordStoppedReplication(status)
+	}
+
+	// The position of the primary is read after the one of the replica, so
+	// that it contains it unless the replica has errant GTIDs.
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	remoteCtx, remoteCancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer remoteCancel()
+	primaryAlias, primaryPosition, err := getPrimaryPosition(remoteCtx, tmc, params.TopoServer, params.Keyspace, params.Shard)
+	if err != nil {
+		// The backups of a shard without a reachable primary are still worth
+		// taking, only their GTIDs can't be checked.
+		params.Logger.Warningf("can't check the replica for errant GTIDs: %v", err)
+		return src, nil
+	}
+	src.PrimaryAlias = topoproto.TabletAliasString(primaryAlias)
+	src.PrimaryPosition = primaryPosition
+	if primaryPosition.AtLeast(status.Position) {
+		return src, nil
+	}
+	errant := errantGTIDs(status.Position, primaryPosition)
+	if !*backupAllowErrantGTIDs {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "replica has errant GTIDs %v that its primary %v doesn't have, can't back it up without -backup_allow_errant_gtids", errant, src.PrimaryAlias)
+	}
+	params.Logger.Warningf("backing up a replica with errant GTIDs %v that its primary %v doesn't have", errant, src.PrimaryAlias)
+	src.ErrantGTIDs = errant
+	return src, nil
+}
+
+// errantGTIDs returns the GTIDs of the position of a replica that the
+// position of its primary doesn't contain, or the position of the replica if
+// its flavor can't subtract them.
+func errantGTIDs(replica, primary mysql.Position) string {
+	replicaSet, ok := replica.GTIDSet.(mysql.Mysql56GTIDSet)
+	if !ok {
+		return replica.String()
+	}
+	primarySet, ok := primary.GTIDSet.(mysql.Mysql56GTIDSet)
+	if !ok {
+		return replica.String()
+	}
+	return replicaSet.Difference(primarySet).String()
+}
+
+// stopReplicationForBackup stops the replication of a replica for the
+// snapshot of an online backup engine, if -backup_stop_replication is set and
+// the replication is running. It returns a function that restarts it.
+func stopReplicationForBackup(params BackupParams) (func() error, error) {
+	noop := func() error { return nil }
+	if !*backupStopReplication || params.ReplicationSource == nil {
+		return noop, nil
+	}
+	status, err := params.Mysqld.ReplicationStatus()
+	if err != nil {
+		return noop, vterrors.Wrap(err, "can't get replica status")
+	}
+	if !status.Running() {
+		return noop, nil
+	}
+	params.Logger.Infof("stopping replication for the backup")
+	if err := params.Mysqld.StopReplication(params.HookExtraEnv); err != nil {
+		return noop, vterrors.Wrap(err, "can't stop replica")
+	}
+	restart := func() error {
+		params.Logger.Infof("restarting replication after the backup")
+		if err := params.Mysqld.StartReplication(params.HookExtraEnv); err != nil {
+			return vterrors.Wrap(err, "cannot restart replica")
+		}
+		return nil
+	}
+	status, err = params.Mysqld.ReplicationStatus()
+	if err != nil {
+		return restart, vterrors.Wrap(err, "can't get replica status")
+	}
+	params.ReplicationSource.recordStoppedReplication(status)
+	return restart, nil
+}
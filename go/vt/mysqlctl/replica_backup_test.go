@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+func TestErrantGTIDs(t *testing.T) {
+	primary := mysql.MustParsePosition(mysql.Mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	replica := mysql.MustParsePosition(mysql.Mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-90:101,00010203-0405-0607-0809-0a0b0c0d0eff:1-2")
+	assert.Equal(t, "00010203-0405-0607-0809-0a0b0c0d0e0f:101,00010203-0405-0607-0809-0a0b0c0d0eff:1-2", errantGTIDs(replica, primary))
+
+	// The GTIDs of the other flavors aren't subtracted.
+	primary = mysql.MustParsePosition(mysql.MariadbFlavorID, "0-1-100")
+	replica = mysql.MustParsePosition(mysql.MariadbFlavorID, "0-2-101")
+	assert.Equal(t, "0-2-101", errantGTIDs(replica, primary))
+}
+
+func TestNewBackupManifest(t *testing.T) {
+	src := &BackupReplicationSource{PrimaryAlias: "cell1-0000000100"}
+	position := mysql.MustParsePosition(mysql.Mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	bm := newBackupManifest(builtinBackupEngineName, BackupParams{
+		Keyspace:          "ks",
+		Shard:             "-80",
+		TabletAlias:       "cell1-0000000101",
+		ReplicationSource: src,
+	}, position)
+	assert.Equal(t, builtinBackupEngineName, bm.BackupMethod)
+	assert.Equal(t, position, bm.Position)
+	assert.Equal(t, "ks", bm.Keyspace)
+	assert.Equal(t, "-80", bm.Shard)
+	assert.Equal(t, "cell1-0000000101", bm.TabletAlias)
+	assert.Equal(t, src, bm.ReplicationSource)
+}
@@ -89,19 +89,16 @@ func NewTableFilter(tables, excludeTables []string, includeViews bool) (*TableFi
 	}
 
 	// Build a list of regexp to match table names against.
-	// We only use regexps if the name starts and ends with '/'.
-	// Otherwise the entry in the arrays is nil, and we use the original
-	// table name.
+	// We use regexps if the name starts and ends with '/', or if it is a
+	// glob pattern. Otherwise we use the original table name.
 	if len(tables) > 0 {
 		f.filterTables = true
 		for _, table := range tables {
-			if strings.HasPrefix(table, "/") {
-				table = strings.Trim(table, "/")
-				re, err := regexp.Compile(table)
-				if err != nil {
-					return nil, fmt.Errorf("cannot compile regexp %v for table: %v", table, err)
-				}
-
+			re, err := tablePattern(table)
+			if err != nil {
+				return nil, fmt.Errorf("cannot compile regexp %v for table: %v", strings.Trim(table, "/"), err)
+			}
+			if re != nil {
 				f.tableREs = append(f.tableREs, re)
 			} else {
 				f.tableNames = append(f.tableNames, table)
@@ -112,14 +109,12 @@ func NewTableFilter(tables, excludeTables []string, includeViews bool) (*TableFi
 	if len(excludeTables) > 0 {
 		f.filterExcludeTables = true
 		for _, table := range excludeTables {
-			if strings.HasPrefix(table, "/") {
-				table = strings.Trim(table, "/")
-				re, err := regexp.Compile(table)
-				if err != nil {
-					return nil, fmt.Errorf("cannot compile regexp %v for excludeTable: %v", table, err)
-				}
-
-				f.excludeTableREs = append(f.tableREs, re)
+			re, err := tablePattern(table)
+			if err != nil {
+				return nil, fmt.Errorf("cannot compile regexp %v for excludeTable: %v", strings.Trim(table, "/"), err)
+			}
+			if re != nil {
+				f.excludeTableREs = append(f.excludeTableREs, re)
 			} else {
 				f.excludeTableNames = append(f.excludeTableNames, table)
 			}
@@ -129,6 +124,34 @@ func NewTableFilter(tables, excludeTables []string, includeViews bool) (*TableFi
 	return f, nil
 }
 
+// tablePattern returns the regexp of a table pattern, which is either a
+// regular expression of the form /regexp/, or a glob pattern where '*'
+// matches any sequence of characters and '?' any single character. Like the
+// exact table names, the glob patterns are case-insensitive. It returns nil
+// for an exact table name.
+func tablePattern(table string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(table, "/") {
+		return regexp.Compile(strings.Trim(table, "/"))
+	}
+	if !strings.ContainsAny(table, "*?") {
+		return nil, nil
+	}
+	var pattern strings.Builder
+	pattern.WriteString("(?i)^")
+	for _, r := range table {
+		switch r {
+		case '*':
+			pattern.WriteString(".*")
+		case '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.Compile(pattern.String())
+}
+
 // Includes returns whether a tableName/tableType should be included in this TableFilter.
 func (f *TableFilter) Includes(tableName string, tableType string) bool {
 	if f.filterTables {
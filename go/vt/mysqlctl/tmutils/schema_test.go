@@ -433,6 +433,55 @@ func TestTableFilter(t *testing.T) {
 
 			included: false,
 		},
+		{
+			desc:         "table glob list includes matching table",
+			tables:       []string{"T?"},
+			includeViews: false,
+
+			tableName: includedTable,
+			tableType: TableBaseTable,
+
+			included: true,
+		},
+		{
+			desc:         "table glob list excludes non-matching table",
+			tables:       []string{"t*"},
+			includeViews: false,
+
+			tableName: excludedTable,
+			tableType: TableBaseTable,
+
+			included: false,
+		},
+		{
+			desc:         "table glob list quotes regexp characters",
+			tables:       []string{"t.*"},
+			includeViews: false,
+
+			tableName: includedTable,
+			tableType: TableBaseTable,
+
+			included: false,
+		},
+		{
+			desc:          "exclude table glob list excludes matching table",
+			excludeTables: []string{"e*"},
+
+			tableName: excludedTable,
+			tableType: TableBaseTable,
+
+			included: false,
+		},
+		{
+			desc:          "table regexp list with exclude table regexp list includes matching table",
+			tables:        []string{includedTableRE},
+			excludeTables: []string{excludedTableRE},
+
+			tableName: includedTable,
+			tableType: TableBaseTable,
+
+			included: true,
+		},
 		{
 			desc:   "bad table regexp",
 			tables: []string{"/*/"},
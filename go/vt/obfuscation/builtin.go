@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obfuscation
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Redacted replaces the ids with the redact obfuscation.
+	Redacted = "redacted"
+
+	hashPrefix    = "h:"
+	encryptPrefix = "e:"
+)
+
+func init() {
+	Register("redact", func([]byte) (Obfuscator, error) {
+		return redactor{}, nil
+	})
+	Register("hash", newHasher)
+	Register("encrypt", newEncrypter)
+}
+
+// deriveKey derives a key of the secret key for a purpose, so that the
+// hash and the encryption don't share their key.
+func deriveKey(key []byte, purpose string) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("-id_obfuscation_key_file is required")
+	}
+	sum := sha256.Sum256(append([]byte("vitess-id-obfuscation-"+purpose+":"), key...))
+	return sum[:], nil
+}
+
+// redactor replaces all the ids with Redacted.
+type redactor struct{}
+
+// Obfuscate is part of the Obfuscator interface.
+func (redactor) Obfuscate([]byte) string {
+	return Redacted
+}
+
+// hasher replaces the ids with their keyed hash, which can't be decoded,
+// but is the same for the same id.
+type hasher struct {
+	key []byte
+}
+
+func newHasher(key []byte) (Obfuscator, error) {
+	hashKey, err := deriveKey(key, "hash")
+	if err != nil {
+		return nil, err
+	}
+	return &hasher{key: hashKey}, nil
+}
+
+// Obfuscate is part of the Obfuscator interface.
+func (h *hasher) Obfuscate(id []byte) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write(id)
+	return hashPrefix + hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// encrypter encrypts the ids with AES-GCM, with a nonce that is the keyed
+// hash of the id, so that the same id is encrypted the same way. The
+// encrypted ids can be decoded with the key.
+type encrypter struct {
+	hashKey []byte
+	aead    cipher.AEAD
+}
+
+func newEncrypter(key []byte) (Obfuscator, error) {
+	hashKey, err := deriveKey(key, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	encryptionKey, err := deriveKey(key, "encrypt")
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &encrypter{hashKey: hashKey, aead: aead}, nil
+}
+
+// Obfuscate is part of the Obfuscator interface.
+func (e *encrypter) Obfuscate(id []byte) string {
+	mac := hmac.New(sha256.New, e.hashKey)
+	mac.Write(id)
+	nonce := mac.Sum(nil)[:e.aead.NonceSize()]
+	return encryptPrefix + base64.RawURLEncoding.EncodeToString(e.aead.Seal(nonce, nonce, id, nil))
+}
+
+// Decode is part of the Decoder interface.
+func (e *encrypter) Decode(obfuscated string) ([]byte, error) {
+	if !strings.HasPrefix(obfuscated, encryptPrefix) {
+		return nil, fmt.Errorf("%s is not an encrypted id, which starts with %s", obfuscated, encryptPrefix)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(obfuscated, encryptPrefix))
+	if err != nil || len(sealed) < e.aead.NonceSize() {
+		return nil, fmt.Errorf("%s is not an encrypted id", obfuscated)
+	}
+	nonce, ciphertext := sealed[:e.aead.NonceSize()], sealed[e.aead.NonceSize():]
+	id, err := e.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt %s, it was not encrypted with this key: %v", obfuscated, err)
+	}
+	return id, nil
+}
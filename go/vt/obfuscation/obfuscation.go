@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package obfuscation obfuscates the keyspace ids and the key values that
+// the vindexes and the keyspace id routing print in their errors and logs,
+// in vtgate and in the vstreamer of vttablet, since they often hold personal
+// data, such as user ids. The queries and their bind variables, which the
+// query logs, the traces and the MySQL errors hold, are not obfuscated.
+//
+// The obfuscation is chosen by the -id_obfuscation flag, among the ones that
+// are registered: redact, hash and encrypt are built in. The encrypted ids can
+// be decoded by the operators, with the DecodeObfuscatedIds vtctl command.
+package obfuscation
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	mode    = flag.String("id_obfuscation", "", "How the keyspace ids and the key values are obfuscated in the errors and logs of the vindexes and the keyspace id routing, but not in the queries: empty to print them as they are, redact, hash, or encrypt, whose ids can be decoded with the DecodeObfuscatedIds vtctl command")
+	keyFile = flag.String("id_obfuscation_key_file", "", "File that holds the secret key of the hash and encrypt id obfuscations, which must be the same for the vtgates, vttablets and vtctlds")
+)
+
+// An Obfuscator obfuscates ids.
+type Obfuscator interface {
+	// Obfuscate returns the obfuscated id. The same id must always be
+	// obfuscated the same way, so that it can be followed through the logs.
+	Obfuscate(id []byte) string
+}
+
+// A Decoder is an Obfuscator whose obfuscated ids can be decoded.
+type Decoder interface {
+	Obfuscator
+	// Decode returns the id that was obfuscated into obfuscated.
+	Decode(obfuscated string) ([]byte, error)
+}
+
+// A Factory returns an Obfuscator, given the secret key of the
+// -id_obfuscation_key_file, which is nil without a key file.
+type Factory func(key []byte) (Obfuscator, error)
+
+var (
+	factoriesMu sync.Mutex
+	factories   = make(map[string]Factory)
+
+	mu          sync.RWMutex
+	initialized bool
+	current     Obfuscator
+)
+
+// Register registers the Factory of an obfuscation, for -id_obfuscation.
+// It panics if the obfuscation is already registered.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("id obfuscation %s is already registered", name))
+	}
+	factories[name] = factory
+}
+
+// Get returns the Obfuscator of -id_obfuscation, or nil if the ids are not
+// obfuscated. It's created on first use. If it can't be created, the ids
+// are redacted rather than printed.
+func Get() Obfuscator {
+	mu.RLock()
+	if initialized {
+		defer mu.RUnlock()
+		return current
+	}
+	mu.RUnlock()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !initialized {
+		current = fromFlags()
+		initialized = true
+	}
+	return current
+}
+
+// Set replaces the Obfuscator, e.g. in tests. A nil Obfuscator prints the
+// ids as they are.
+func Set(obfuscator Obfuscator) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = obfuscator
+	initialized = true
+}
+
+// fromFlags returns the Obfuscator of the flags.
+func fromFlags() Obfuscator {
+	if *mode == "" {
+		return nil
+	}
+	factoriesMu.Lock()
+	factory, ok := factories[*mode]
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	factoriesMu.Unlock()
+	if !ok {
+		sort.Strings(names)
+		log.Errorf("Unknown -id_obfuscation %s, the ids are redacted. The id obfuscations are: %s", *mode, strings.Join(names, ", "))
+		return redactor{}
+	}
+
+	var key []byte
+	if *keyFile != "" {
+		var err error
+		if key, err = os.ReadFile(*keyFile); err != nil {
+			log.Errorf("Cannot read -id_obfuscation_key_file, the ids are redacted: %v", err)
+			return redactor{}
+		}
+	}
+	obfuscator, err := factory(key)
+	if err != nil {
+		log.Errorf("Cannot set up the %s id obfuscation, the ids are redacted: %v", *mode, err)
+		return redactor{}
+	}
+	return obfuscator
+}
+
+// KeyspaceID returns the keyspace id as it can be printed: in hex, or
+// obfuscated.
+func KeyspaceID(ksid []byte) string {
+	obfuscator := Get()
+	if obfuscator == nil {
+		return fmt.Sprintf("%x", ksid)
+	}
+	return obfuscator.Obfuscate(ksid)
+}
+
+// Value returns the key value as it can be printed, like sqltypes.Value's
+// String: its type and its value, which is obfuscated.
+func Value(v sqltypes.Value) string {
+	obfuscator := Get()
+	if obfuscator == nil || v.IsNull() {
+		return v.String()
+	}
+	return fmt.Sprintf("%v(%s)", v.Type(), obfuscator.Obfuscate(v.Raw()))
+}
+
+// Values returns the key values as they can be printed, like the %v of a
+// slice of values.
+func Values(values []sqltypes.Value) string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(Value(v))
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// Rows returns the rows of key values as they can be printed, like the %v
+// of a slice of rows.
+func Rows(rows [][]sqltypes.Value) string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(Values(row))
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// Decode returns the id that was obfuscated into obfuscated, if the
+// obfuscation can be decoded. The obfuscated key values can be given with
+// their type, as Value prints them.
+func Decode(obfuscated string) ([]byte, error) {
+	if open := strings.IndexByte(obfuscated, '('); open >= 0 && strings.HasSuffix(obfuscated, ")") {
+		obfuscated = obfuscated[open+1 : len(obfuscated)-1]
+	}
+	obfuscator := Get()
+	if obfuscator == nil {
+		return nil, fmt.Errorf("the ids are not obfuscated, -id_obfuscation is not set")
+	}
+	decoder, ok := obfuscator.(Decoder)
+	if !ok {
+		return nil, fmt.Errorf("the %s id obfuscation can't be decoded", *mode)
+	}
+	return decoder.Decode(obfuscated)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obfuscation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestNoObfuscation(t *testing.T) {
+	defer Set(nil)
+	Set(nil)
+	assert.Equal(t, "0102ff", KeyspaceID([]byte{1, 2, 255}))
+	assert.Equal(t, "[INT64(1) VARCHAR(\"a\") NULL]", Values([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NULL}))
+	assert.Equal(t, "[[INT64(1)] [INT64(2)]]", Rows([][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}}))
+	_, err := Decode("e:abc")
+	assert.EqualError(t, err, "the ids are not obfuscated, -id_obfuscation is not set")
+}
+
+func TestRedact(t *testing.T) {
+	defer Set(nil)
+	Set(redactor{})
+	assert.Equal(t, "redacted", KeyspaceID([]byte{1, 2, 255}))
+	assert.Equal(t, "[INT64(redacted) NULL]", Values([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NULL}))
+}
+
+func TestHash(t *testing.T) {
+	defer Set(nil)
+	hash, err := newHasher([]byte("secret"))
+	require.NoError(t, err)
+	Set(hash)
+
+	obfuscated := KeyspaceID([]byte("user-1"))
+	assert.True(t, strings.HasPrefix(obfuscated, "h:"), obfuscated)
+	assert.Len(t, obfuscated, 18)
+	assert.Equal(t, obfuscated, KeyspaceID([]byte("user-1")))
+	assert.NotEqual(t, obfuscated, KeyspaceID([]byte("user-2")))
+	assert.Equal(t, "INT64("+hash.Obfuscate([]byte("1"))+")", Value(sqltypes.NewInt64(1)))
+
+	// The hash depends on the key.
+	other, err := newHasher([]byte("other secret"))
+	require.NoError(t, err)
+	assert.NotEqual(t, obfuscated, other.Obfuscate([]byte("user-1")))
+
+	_, err = Decode(obfuscated)
+	assert.Error(t, err)
+	_, err = newHasher(nil)
+	assert.EqualError(t, err, "-id_obfuscation_key_file is required")
+}
+
+func TestEncrypt(t *testing.T) {
+	defer Set(nil)
+	encrypt, err := newEncrypter([]byte("secret"))
+	require.NoError(t, err)
+	Set(encrypt)
+
+	obfuscated := KeyspaceID([]byte("user-1"))
+	assert.True(t, strings.HasPrefix(obfuscated, "e:"), obfuscated)
+	assert.Equal(t, obfuscated, KeyspaceID([]byte("user-1")))
+	assert.NotEqual(t, obfuscated, KeyspaceID([]byte("user-2")))
+	id, err := Decode(obfuscated)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("user-1"), id)
+
+	// The key values are decoded with or without their type.
+	value := Value(sqltypes.NewInt64(42))
+	assert.True(t, strings.HasPrefix(value, "INT64(e:"), value)
+	id, err = Decode(value)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("42"), id)
+
+	other, err := newEncrypter([]byte("other secret"))
+	require.NoError(t, err)
+	Set(other)
+	_, err = Decode(obfuscated)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "it was not encrypted with this key")
+	_, err = Decode("h:0102")
+	assert.EqualError(t, err, "h:0102 is not an encrypted id, which starts with e:")
+	_, err = Decode("e:!!")
+	assert.EqualError(t, err, "e:!! is not an encrypted id")
+}
+
+func TestFromFlags(t *testing.T) {
+	defer func(m, k string) {
+		*mode, *keyFile = m, k
+	}(*mode, *keyFile)
+
+	*mode = ""
+	assert.Nil(t, fromFlags())
+
+	// The ids are redacted when the obfuscation can't be set up.
+	*mode = "nope"
+	assert.Equal(t, redactor{}, fromFlags())
+	*mode = "encrypt"
+	*keyFile = ""
+	assert.Equal(t, redactor{}, fromFlags())
+	*keyFile = filepath.Join(t.TempDir(), "missing")
+	assert.Equal(t, redactor{}, fromFlags())
+
+	*keyFile = filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(*keyFile, []byte("secret"), 0600))
+	assert.IsType(t, &encrypter{}, fromFlags())
+	*mode = "hash"
+	assert.IsType(t, &hasher{}, fromFlags())
+
+	assert.Panics(t, func() {
+		Register("hash", newHasher)
+	})
+}
@@ -207,6 +207,8 @@ type Configuration struct {
 	PostPrimaryFailoverProcesses                []string          // Processes to execute after doing a primary failover (order of execution undefined). Uses same placeholders as PostFailoverProcesses
 	PostIntermediatePrimaryFailoverProcesses    []string          // Processes to execute after doing a primary failover (order of execution undefined). Uses same placeholders as PostFailoverProcesses
 	PostTakePrimaryProcesses                    []string          // Processes to execute after a successful Take-Primary event has taken place
+	RecoveryWebhookURLs                         []string          // URLs that every detected failure and every executed recovery are POSTed to, as JSON
+	RecoveryWebhookTimeoutSeconds               int               // Timeout of each call to a recovery webhook
 	CoPrimaryRecoveryMustPromoteOtherCoPrimary  bool              // When 'false', anything can get promoted (and candidates are prefered over others). When 'true', orchestrator will promote the other co-primary or else fail
 	DetachLostReplicasAfterPrimaryFailover      bool              // Should replicas that are not to be lost in primary recovery (i.e. were more up-to-date than promoted replica) be forcibly detached
 	ApplyMySQLPromotionAfterPrimaryFailover     bool              // Should orchestrator take upon itself to apply MySQL primary promotion: set read_only=0, detach replication, etc.
@@ -367,6 +369,8 @@ func newConfiguration() *Configuration {
 		PostFailoverProcesses:                       []string{},
 		PostUnsuccessfulFailoverProcesses:           []string{},
 		PostTakePrimaryProcesses:                    []string{},
+		RecoveryWebhookURLs:                         []string{},
+		RecoveryWebhookTimeoutSeconds:               10,
 		CoPrimaryRecoveryMustPromoteOtherCoPrimary:  true,
 		DetachLostReplicasAfterPrimaryFailover:      true,
 		ApplyMySQLPromotionAfterPrimaryFailover:     true,
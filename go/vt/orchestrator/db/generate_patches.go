@@ -617,4 +617,17 @@ var generateSQLPatches = []string{
 		database_instance
 			ADD COLUMN replication_group_primary_port smallint(5) unsigned NOT NULL DEFAULT 0 AFTER replication_group_primary_host
 	`,
+	`
+		ALTER TABLE
+			topology_recovery
+			ADD COLUMN keyspace varchar(128) CHARACTER SET ascii NOT NULL DEFAULT '' AFTER cluster_alias
+	`,
+	`
+		ALTER TABLE
+			topology_recovery
+			ADD COLUMN shard varchar(128) CHARACTER SET ascii NOT NULL DEFAULT '' AFTER keyspace
+	`,
+	`
+		CREATE INDEX keyspace_shard_idx_topology_recovery ON topology_recovery (keyspace, shard)
+	`,
 }
@@ -2495,6 +2495,52 @@ func (httpAPI *API) AuditRecovery(params martini.Params, r render.Render, req *h
 	r.JSON(http.StatusOK, audits)
 }
 
+// RecoveryHistory returns the recoveries that match the keyspace, shard,
+// analysis, since, until, successful and limit query parameters. since and
+// until are RFC 3339 times.
+func (httpAPI *API) RecoveryHistory(params martini.Params, r render.Render, req *http.Request) {
+	query := req.URL.Query()
+	filter := &logic.RecoveryHistoryFilter{
+		Keyspace:   query.Get("keyspace"),
+		Shard:      query.Get("shard"),
+		Analysis:   inst.AnalysisCode(query.Get("analysis")),
+		Successful: query.Get("successful"),
+	}
+	var err error
+	if since := query.Get("since"); since != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			Respond(r, &APIResponse{Code: ERROR, Message: fmt.Sprintf("invalid since: %+v", err)})
+			return
+		}
+	}
+	if until := query.Get("until"); until != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			Respond(r, &APIResponse{Code: ERROR, Message: fmt.Sprintf("invalid until: %+v", err)})
+			return
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil {
+			Respond(r, &APIResponse{Code: ERROR, Message: fmt.Sprintf("invalid limit: %+v", err)})
+			return
+		}
+	}
+	switch filter.Successful {
+	case "", "true", "false":
+	default:
+		Respond(r, &APIResponse{Code: ERROR, Message: "successful must be true or false"})
+		return
+	}
+
+	recoveries, err := logic.ReadRecoveryHistory(filter)
+	if err != nil {
+		Respond(r, &APIResponse{Code: ERROR, Message: fmt.Sprintf("%+v", err)})
+		return
+	}
+
+	r.JSON(http.StatusOK, recoveries)
+}
+
 // ActiveClusterRecovery returns recoveries in-progress for a given cluster
 func (httpAPI *API) ActiveClusterRecovery(params martini.Params, r render.Render, req *http.Request) {
 	recoveries, err := logic.ReadActiveClusterRecovery(params["clusterName"])
@@ -2953,6 +2999,7 @@ func (httpAPI *API) RegisterRequests(m *martini.ClassicMartini) {
 	httpAPI.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias", httpAPI.AuditRecovery)
 	httpAPI.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias/:page", httpAPI.AuditRecovery)
 	httpAPI.registerAPIRequest(m, "audit-recovery-steps/:uid", httpAPI.AuditRecoverySteps)
+	httpAPI.registerAPIRequest(m, "recovery-history", httpAPI.RecoveryHistory)
 	httpAPI.registerAPIRequest(m, "active-cluster-recovery/:clusterName", httpAPI.ActiveClusterRecovery)
 	httpAPI.registerAPIRequest(m, "recently-active-cluster-recovery/:clusterName", httpAPI.RecentlyActiveClusterRecovery)
 	httpAPI.registerAPIRequest(m, "recently-active-instance-recovery/:host/:port", httpAPI.RecentlyActiveInstanceRecovery)
@@ -32,4 +32,5 @@ func TestKnownPaths(t *testing.T) {
 	test.S(t).ExpectTrue(pathsMap["lb-check"])
 	test.S(t).ExpectTrue(pathsMap["relocate"])
 	test.S(t).ExpectTrue(pathsMap["relocate-replicas"])
+	test.S(t).ExpectTrue(pathsMap["recovery-history"])
 }
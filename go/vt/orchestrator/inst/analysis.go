@@ -130,6 +130,8 @@ const (
 type ReplicationAnalysis struct {
 	AnalyzedInstanceKey                       InstanceKey
 	AnalyzedInstancePrimaryKey                InstanceKey
+	AnalyzedKeyspace                          string
+	AnalyzedShard                             string
 	TabletType                                topodatapb.TabletType
 	PrimaryTimeStamp                          time.Time
 	SuggestedClusterAlias                     string
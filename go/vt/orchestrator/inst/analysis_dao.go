@@ -385,6 +385,8 @@ func GetReplicationAnalysis(clusterName string, hints *ReplicationAnalysisHints)
 		}
 
 		a.TabletType = tablet.Type
+		a.AnalyzedKeyspace = tablet.Keyspace
+		a.AnalyzedShard = tablet.Shard
 		a.PrimaryTimeStamp = m.GetTime("primary_timestamp")
 
 		a.IsPrimary = m.GetBool("is_primary")
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+)
+
+// The types of the recovery events.
+const (
+	// RecoveryEventDetection is sent when a failure is detected, before
+	// deciding whether to recover from it.
+	RecoveryEventDetection = "detection"
+	// RecoveryEventRecovery is sent when a recovery completes, whether it
+	// succeeded or not.
+	RecoveryEventRecovery = "recovery"
+)
+
+// RecoveryEvent is what the recovery webhooks receive, as JSON, for every
+// detected failure and every executed recovery.
+type RecoveryEvent struct {
+	Type        string
+	Keyspace    string
+	Shard       string
+	ClusterName string
+	Instance    string
+	Analysis    inst.AnalysisCode
+	Description string
+	// The fields of the recovery events.
+	RecoveryUID     string
+	IsSuccessful    bool
+	SuccessorAlias  string
+	Actions         []string
+	Errors          []string
+	StartTime       time.Time
+	DurationSeconds float64
+}
+
+var recoveryWebhookCounter = metrics.NewCounter()
+var recoveryWebhookFailureCounter = metrics.NewCounter()
+
+func init() {
+	metrics.Register("recover.webhook.sent", recoveryWebhookCounter)
+	metrics.Register("recover.webhook.fail", recoveryWebhookFailureCounter)
+}
+
+func newDetectionEvent(analysisEntry *inst.ReplicationAnalysis) *RecoveryEvent {
+	return &RecoveryEvent{
+		Type:        RecoveryEventDetection,
+		Keyspace:    analysisEntry.AnalyzedKeyspace,
+		Shard:       analysisEntry.AnalyzedShard,
+		ClusterName: analysisEntry.ClusterDetails.ClusterName,
+		Instance:    analysisEntry.AnalyzedInstanceKey.StringCode(),
+		Analysis:    analysisEntry.Analysis,
+		Description: analysisEntry.Description,
+	}
+}
+
+// newRecoveryEvent returns the event of a recovery that started at start,
+// and took the audited steps.
+func newRecoveryEvent(topologyRecovery *TopologyRecovery, start time.Time, steps []TopologyRecoveryStep) *RecoveryEvent {
+	event := newDetectionEvent(&topologyRecovery.AnalysisEntry)
+	event.Type = RecoveryEventRecovery
+	event.RecoveryUID = topologyRecovery.UID
+	event.IsSuccessful = topologyRecovery.IsSuccessful
+	event.SuccessorAlias = topologyRecovery.SuccessorAlias
+	event.Actions = []string{}
+	for _, step := range steps {
+		event.Actions = append(event.Actions, step.Message)
+	}
+	event.Errors = topologyRecovery.AllErrors
+	event.StartTime = start
+	event.DurationSeconds = time.Since(start).Seconds()
+	return event
+}
+
+// notifyDetection sends the detected failure to the recovery webhooks.
+func notifyDetection(analysisEntry *inst.ReplicationAnalysis) {
+	if len(config.Config.RecoveryWebhookURLs) == 0 {
+		return
+	}
+	sendRecoveryEvent(newDetectionEvent(analysisEntry))
+}
+
+// notifyRecovery sends the completed recovery to the recovery webhooks.
+func notifyRecovery(topologyRecovery *TopologyRecovery, start time.Time) {
+	if len(config.Config.RecoveryWebhookURLs) == 0 {
+		return
+	}
+	steps, err := ReadTopologyRecoverySteps(topologyRecovery.UID)
+	if err != nil {
+		log.Errorf("notifyRecovery: cannot read the steps of recovery %s: %v", topologyRecovery.UID, err)
+	}
+	sendRecoveryEvent(newRecoveryEvent(topologyRecovery, start, steps))
+}
+
+// sendRecoveryEvent POSTs the event to every recovery webhook in the
+// background, so that a slow webhook never delays a recovery.
+func sendRecoveryEvent(event *RecoveryEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("sendRecoveryEvent: cannot marshal %+v: %v", event, err)
+		return
+	}
+	timeout := time.Duration(config.Config.RecoveryWebhookTimeoutSeconds) * time.Second
+	for _, url := range config.Config.RecoveryWebhookURLs {
+		go func(url string) {
+			if err := postRecoveryEvent(url, body, timeout); err != nil {
+				recoveryWebhookFailureCounter.Inc(1)
+				log.Errorf("sendRecoveryEvent: %s event of %s on %s: %v", event.Type, event.Analysis, event.Instance, err)
+				return
+			}
+			recoveryWebhookCounter.Inc(1)
+		}(url)
+	}
+}
+
+func postRecoveryEvent(url string, body []byte, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s returned %s", url, resp.Status)
+	}
+	return nil
+}
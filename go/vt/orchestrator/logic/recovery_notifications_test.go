@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+)
+
+func TestRecoveryEvents(t *testing.T) {
+	analysisEntry := inst.ReplicationAnalysis{
+		AnalyzedInstanceKey: inst.InstanceKey{Hostname: "host1", Port: 3306},
+		AnalyzedKeyspace:    "commerce",
+		AnalyzedShard:       "-80",
+		Analysis:            inst.DeadPrimary,
+		Description:         "Primary cannot be reached by orchestrator and none of its replicas is replicating",
+	}
+	topologyRecovery := NewTopologyRecovery(analysisEntry)
+	topologyRecovery.IsSuccessful = true
+	topologyRecovery.SuccessorAlias = "zone1-0000000101"
+	topologyRecovery.AddError(errors.New("replica host2:3306 was lost"))
+
+	events := make(chan *RecoveryEvent, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		event := &RecoveryEvent{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		events <- event
+	}))
+	defer server.Close()
+	defer func(urls []string) { config.Config.RecoveryWebhookURLs = urls }(config.Config.RecoveryWebhookURLs)
+	config.Config.RecoveryWebhookURLs = []string{server.URL}
+
+	notifyDetection(&analysisEntry)
+	event := <-events
+	assert.Equal(t, &RecoveryEvent{
+		Type:        RecoveryEventDetection,
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		Instance:    "host1:3306",
+		Analysis:    inst.DeadPrimary,
+		Description: analysisEntry.Description,
+	}, event)
+
+	start := time.Now().Add(-time.Minute)
+	steps := []TopologyRecoveryStep{{Message: "Running 1 PreFailoverProcesses hooks"}, {Message: "promoted zone1-0000000101"}}
+	sendRecoveryEvent(newRecoveryEvent(topologyRecovery, start, steps))
+	event = <-events
+	assert.Equal(t, RecoveryEventRecovery, event.Type)
+	assert.Equal(t, "commerce", event.Keyspace)
+	assert.Equal(t, inst.DeadPrimary, event.Analysis)
+	assert.Equal(t, topologyRecovery.UID, event.RecoveryUID)
+	assert.True(t, event.IsSuccessful)
+	assert.Equal(t, "zone1-0000000101", event.SuccessorAlias)
+	assert.Equal(t, []string{"Running 1 PreFailoverProcesses hooks", "promoted zone1-0000000101"}, event.Actions)
+	assert.Equal(t, []string{"replica host2:3306 was lost"}, event.Errors)
+	assert.True(t, start.Equal(event.StartTime))
+	assert.GreaterOrEqual(t, event.DurationSeconds, 60.0)
+}
+
+func TestPostRecoveryEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	err := postRecoveryEvent(server.URL, []byte(`{}`), time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503 Service Unavailable")
+}
@@ -19,6 +19,7 @@ package logic
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/orchestrator/config"
 	"vitess.io/vitess/go/vt/orchestrator/db"
@@ -153,6 +154,8 @@ func writeTopologyRecovery(topologyRecovery *TopologyRecovery) (*TopologyRecover
 					analysis,
 					cluster_name,
 					cluster_alias,
+					keyspace,
+					shard,
 					count_affected_replicas,
 					replica_hosts,
 					last_detection_id
@@ -171,6 +174,8 @@ func writeTopologyRecovery(topologyRecovery *TopologyRecovery) (*TopologyRecover
 					?,
 					?,
 					?,
+					?,
+					?,
 					(select ifnull(max(detection_id), 0) from topology_failure_detection where hostname=? and port=?)
 				)
 			`,
@@ -181,6 +186,7 @@ func writeTopologyRecovery(topologyRecovery *TopologyRecovery) (*TopologyRecover
 		string(analysisEntry.Analysis),
 		analysisEntry.ClusterDetails.ClusterName,
 		analysisEntry.ClusterDetails.ClusterAlias,
+		analysisEntry.AnalyzedKeyspace, analysisEntry.AnalyzedShard,
 		analysisEntry.CountReplicas, analysisEntry.Replicas.ToCommaDelimitedList(),
 		analysisEntry.AnalyzedInstanceKey.Hostname, analysisEntry.AnalyzedInstanceKey.Port,
 	)
@@ -517,6 +523,8 @@ func readRecoveries(whereCondition string, limit string, args []any) ([]*Topolog
       analysis,
       cluster_name,
       cluster_alias,
+      keyspace,
+      shard,
       count_affected_replicas,
       replica_hosts,
       participating_instances,
@@ -551,6 +559,8 @@ func readRecoveries(whereCondition string, limit string, args []any) ([]*Topolog
 		topologyRecovery.AnalysisEntry.Analysis = inst.AnalysisCode(m.GetString("analysis"))
 		topologyRecovery.AnalysisEntry.ClusterDetails.ClusterName = m.GetString("cluster_name")
 		topologyRecovery.AnalysisEntry.ClusterDetails.ClusterAlias = m.GetString("cluster_alias")
+		topologyRecovery.AnalysisEntry.AnalyzedKeyspace = m.GetString("keyspace")
+		topologyRecovery.AnalysisEntry.AnalyzedShard = m.GetString("shard")
 		topologyRecovery.AnalysisEntry.CountReplicas = m.GetUint("count_affected_replicas")
 		topologyRecovery.AnalysisEntry.ReadReplicaHostsFromString(m.GetString("replica_hosts"))
 
@@ -683,6 +693,63 @@ func ReadRecentRecoveries(clusterName string, clusterAlias string, unacknowledge
 	return readRecoveries(whereClause, limit, args)
 }
 
+// RecoveryHistoryFilter selects the recoveries of ReadRecoveryHistory. Its zero
+// values match all the recoveries.
+type RecoveryHistoryFilter struct {
+	Keyspace string
+	Shard    string
+	Analysis inst.AnalysisCode
+	// Since and Until bound the start of the recoveries.
+	Since time.Time
+	Until time.Time
+	// Successful is "true" or "false" to only read the successful or the
+	// failed recoveries.
+	Successful string
+	Limit      int
+}
+
+// ReadRecoveryHistory reads the recoveries that match the filter from
+// topology_recovery, the latest first.
+func ReadRecoveryHistory(filter *RecoveryHistoryFilter) ([]*TopologyRecovery, error) {
+	whereConditions := []string{}
+	args := sqlutils.Args()
+	if filter.Keyspace != "" {
+		whereConditions = append(whereConditions, `keyspace=?`)
+		args = append(args, filter.Keyspace)
+	}
+	if filter.Shard != "" {
+		whereConditions = append(whereConditions, `shard=?`)
+		args = append(args, filter.Shard)
+	}
+	if filter.Analysis != "" {
+		whereConditions = append(whereConditions, `analysis=?`)
+		args = append(args, string(filter.Analysis))
+	}
+	if !filter.Since.IsZero() {
+		whereConditions = append(whereConditions, `start_active_period >= ?`)
+		args = append(args, filter.Since.UTC().Format(sqlutils.DateTimeFormat))
+	}
+	if !filter.Until.IsZero() {
+		whereConditions = append(whereConditions, `start_active_period < ?`)
+		args = append(args, filter.Until.UTC().Format(sqlutils.DateTimeFormat))
+	}
+	switch filter.Successful {
+	case "true":
+		whereConditions = append(whereConditions, `is_successful=1`)
+	case "false":
+		whereConditions = append(whereConditions, `is_successful=0 and end_recovery is not null`)
+	}
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = fmt.Sprintf("where %s", strings.Join(whereConditions, " and "))
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = config.AuditPageSize
+	}
+	return readRecoveries(whereClause, `limit ?`, append(args, limit))
+}
+
 // readRecoveries reads recovery entry/audit entries from topology_recovery
 func readFailureDetections(whereCondition string, limit string, args []any) ([]*TopologyRecovery, error) {
 	res := []*TopologyRecovery{}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consulpublisher publishes the shard primaries as Consul services,
+// registered with the Consul agent: one service per shard, e.g.
+// vitess-primary-commerce-x-80, which Consul also serves as DNS records.
+package consulpublisher
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+)
+
+var (
+	consulAddress = flag.String("publish_primaries_consul_address", "", "The address of the Consul agent with which the consul primary publisher registers the services of the shard primaries. Empty for the default of the Consul client, e.g. CONSUL_HTTP_ADDR")
+	servicePrefix = flag.String("publish_primaries_consul_service_prefix", "vitess-primary", "The prefix of the names of the Consul services of the shard primaries, which are named <prefix>-<keyspace>-<shard>")
+)
+
+func init() {
+	primarypublisher.RegisterBackend("consul", func() (primarypublisher.Backend, error) {
+		cfg := api.DefaultConfig()
+		if *consulAddress != "" {
+			cfg.Address = *consulAddress
+		}
+		client, err := api.NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublisher(client.Agent(), *servicePrefix), nil
+	})
+}
+
+// Agent is the part of the Consul agent API that the publisher uses.
+type Agent interface {
+	ServiceRegister(service *api.AgentServiceRegistration) error
+	ServiceDeregister(serviceID string) error
+}
+
+// Publisher registers the shard primaries as Consul services.
+type Publisher struct {
+	agent  Agent
+	prefix string
+}
+
+// NewPublisher creates a Publisher.
+func NewPublisher(agent Agent, prefix string) *Publisher {
+	return &Publisher{
+		agent:  agent,
+		prefix: prefix,
+	}
+}
+
+// Publish is part of the primarypublisher.Backend interface. Registering a
+// service again replaces its address.
+func (p *Publisher) Publish(ctx context.Context, endpoint *primarypublisher.Endpoint) error {
+	name := primarypublisher.ServiceName(p.prefix, endpoint.Keyspace, endpoint.Shard)
+	return p.agent.ServiceRegister(&api.AgentServiceRegistration{
+		ID:      name,
+		Name:    name,
+		Address: endpoint.Host,
+		Port:    int(endpoint.Port),
+		Tags:    []string{"vitess", "primary"},
+		Meta: map[string]string{
+			"keyspace":     endpoint.Keyspace,
+			"shard":        endpoint.Shard,
+			"tablet_alias": endpoint.TabletAlias,
+		},
+	})
+}
+
+// Unpublish is part of the primarypublisher.Backend interface.
+func (p *Publisher) Unpublish(ctx context.Context, keyspace, shard string) error {
+	err := p.agent.ServiceDeregister(primarypublisher.ServiceName(p.prefix, keyspace, shard))
+	if err != nil && strings.Contains(err.Error(), "404") {
+		// The agent does not know the service.
+		return nil
+	}
+	return err
+}
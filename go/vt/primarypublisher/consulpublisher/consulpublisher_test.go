@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consulpublisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+)
+
+type fakeAgent struct {
+	services map[string]*api.AgentServiceRegistration
+}
+
+func (a *fakeAgent) ServiceRegister(service *api.AgentServiceRegistration) error {
+	a.services[service.ID] = service
+	return nil
+}
+
+func (a *fakeAgent) ServiceDeregister(serviceID string) error {
+	if _, ok := a.services[serviceID]; !ok {
+		return errors.New("Unexpected response code: 404 (Unknown service ID)")
+	}
+	delete(a.services, serviceID)
+	return nil
+}
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+	agent := &fakeAgent{services: make(map[string]*api.AgentServiceRegistration)}
+	p := NewPublisher(agent, "vitess-primary")
+
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "zone1-0000000100",
+		Host:        "10.0.0.1",
+		Port:        3306,
+	}))
+	assert.Equal(t, map[string]*api.AgentServiceRegistration{
+		"vitess-primary-commerce-x-80": {
+			ID:      "vitess-primary-commerce-x-80",
+			Name:    "vitess-primary-commerce-x-80",
+			Address: "10.0.0.1",
+			Port:    3306,
+			Tags:    []string{"vitess", "primary"},
+			Meta: map[string]string{
+				"keyspace":     "commerce",
+				"shard":        "-80",
+				"tablet_alias": "zone1-0000000100",
+			},
+		},
+	}, agent.services)
+
+	require.NoError(t, p.Unpublish(ctx, "commerce", "-80"))
+	assert.Empty(t, agent.services)
+	// the services that the agent does not know are already unpublished
+	require.NoError(t, p.Unpublish(ctx, "commerce", "-80"))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dnspublisher publishes the shard primaries as DNS SRV records, in
+// a zone file that a DNS server serves, e.g. with the file plugin of CoreDNS
+// or an $INCLUDE of a BIND zone, and reloads when it changes.
+package dnspublisher
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+var (
+	zoneFile = flag.String("publish_primaries_dns_zone_file", "", "The zone file in which the dns primary publisher writes the SRV records of the shard primaries. It is rewritten on every reparent")
+	domain   = flag.String("publish_primaries_dns_domain", "", "The domain of the SRV records of the shard primaries, e.g. vitess.example.com: the primary of the shard -80 of keyspace commerce is published as _mysql._tcp.x-80.commerce.vitess.example.com")
+	ttl      = flag.Duration("publish_primaries_dns_ttl", 30*time.Second, "The TTL of the SRV records of the shard primaries. Keep it short, for the clients to follow the reparents")
+)
+
+func init() {
+	primarypublisher.RegisterBackend("dns", func() (primarypublisher.Backend, error) {
+		if *zoneFile == "" || *domain == "" {
+			return nil, fmt.Errorf("-publish_primaries_dns_zone_file and -publish_primaries_dns_domain are required")
+		}
+		return NewPublisher(*zoneFile, *domain, *ttl), nil
+	})
+}
+
+// Publisher writes the SRV records of the shard primaries to a zone file.
+type Publisher struct {
+	zoneFile string
+	// domain is fully qualified, with its trailing dot.
+	domain string
+	ttl    time.Duration
+
+	mu sync.Mutex
+	// endpoints are keyed by keyspace/shard.
+	endpoints map[string]*primarypublisher.Endpoint
+}
+
+// NewPublisher creates a Publisher.
+func NewPublisher(zoneFile, domain string, ttl time.Duration) *Publisher {
+	return &Publisher{
+		zoneFile:  zoneFile,
+		domain:    strings.TrimSuffix(domain, ".") + ".",
+		ttl:       ttl,
+		endpoints: make(map[string]*primarypublisher.Endpoint),
+	}
+}
+
+// Publish is part of the primarypublisher.Backend interface.
+func (p *Publisher) Publish(ctx context.Context, endpoint *primarypublisher.Endpoint) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := topoproto.KeyspaceShardString(endpoint.Keyspace, endpoint.Shard)
+	if previous, ok := p.endpoints[key]; ok && *previous == *endpoint {
+		return nil
+	}
+	e := *endpoint
+	p.endpoints[key] = &e
+	return p.writeLocked()
+}
+
+// Unpublish is part of the primarypublisher.Backend interface.
+func (p *Publisher) Unpublish(ctx context.Context, keyspace, shard string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	if _, ok := p.endpoints[key]; !ok {
+		return nil
+	}
+	delete(p.endpoints, key)
+	return p.writeLocked()
+}
+
+// records returns the records of the zone file. The SRV records of the
+// primaries whose host is an IP address target an A or AAAA record, since
+// the target of a SRV record must be a name.
+func (p *Publisher) records() []byte {
+	keys := make([]string, 0, len(p.endpoints))
+	for key := range p.endpoints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ttl := int64(p.ttl.Seconds())
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "; The primaries of the Vitess shards. Generated by vtctld, do not edit.\n")
+	for _, key := range keys {
+		endpoint := p.endpoints[key]
+		name := fmt.Sprintf("%s.%s.%s", primarypublisher.ShardLabel(endpoint.Shard), strings.ToLower(endpoint.Keyspace), p.domain)
+		target := strings.TrimSuffix(endpoint.Host, ".") + "."
+		fmt.Fprintf(buf, "; %s: %s\n", key, endpoint.TabletAlias)
+		if primarypublisher.IsIP(endpoint.Host) {
+			target = "primary." + name
+			recordType := "A"
+			if strings.Contains(endpoint.Host, ":") {
+				recordType = "AAAA"
+			}
+			fmt.Fprintf(buf, "%s %d IN %s %s\n", target, ttl, recordType, endpoint.Host)
+		}
+		fmt.Fprintf(buf, "_mysql._tcp.%s %d IN SRV 0 0 %d %s\n", name, ttl, endpoint.Port, target)
+	}
+	return buf.Bytes()
+}
+
+// writeLocked replaces the zone file, atomically: the DNS server never
+// reads a partial file.
+func (p *Publisher) writeLocked() error {
+	tmp, err := os.CreateTemp(filepath.Dir(p.zoneFile), filepath.Base(p.zoneFile)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(p.records()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p.zoneFile)
+}
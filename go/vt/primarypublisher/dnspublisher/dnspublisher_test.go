@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnspublisher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+)
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+	zoneFile := filepath.Join(t.TempDir(), "vitess.zone")
+	p := NewPublisher(zoneFile, "vitess.example.com", 30*time.Second)
+
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "zone1-0000000100",
+		Host:        "db1.example.com",
+		Port:        3306,
+	}))
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "80-",
+		TabletAlias: "zone1-0000000200",
+		Host:        "10.0.0.2",
+		Port:        3307,
+	}))
+	content, err := os.ReadFile(zoneFile)
+	require.NoError(t, err)
+	assert.Equal(t, `; The primaries of the Vitess shards. Generated by vtctld, do not edit.
+; commerce/-80: zone1-0000000100
+_mysql._tcp.x-80.commerce.vitess.example.com. 30 IN SRV 0 0 3306 db1.example.com.
+; commerce/80-: zone1-0000000200
+primary.80-x.commerce.vitess.example.com. 30 IN A 10.0.0.2
+_mysql._tcp.80-x.commerce.vitess.example.com. 30 IN SRV 0 0 3307 primary.80-x.commerce.vitess.example.com.
+`, string(content))
+	info, err := os.Stat(zoneFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	// a reparent replaces the primary, and an unpublished shard is removed
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "zone1-0000000101",
+		Host:        "fd00::1",
+		Port:        3306,
+	}))
+	require.NoError(t, p.Unpublish(ctx, "commerce", "80-"))
+	require.NoError(t, p.Unpublish(ctx, "commerce", "80-"))
+	content, err = os.ReadFile(zoneFile)
+	require.NoError(t, err)
+	assert.Equal(t, `; The primaries of the Vitess shards. Generated by vtctld, do not edit.
+; commerce/-80: zone1-0000000101
+primary.x-80.commerce.vitess.example.com. 30 IN AAAA fd00::1
+_mysql._tcp.x-80.commerce.vitess.example.com. 30 IN SRV 0 0 3306 primary.x-80.commerce.vitess.example.com.
+`, string(content))
+
+	// no temporary file is left behind
+	entries, err := os.ReadDir(filepath.Dir(zoneFile))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
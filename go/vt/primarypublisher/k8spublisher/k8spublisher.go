@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package k8spublisher publishes the shard primaries as Kubernetes
+// Endpoints: one Endpoints object per shard, e.g.
+// vitess-primary-commerce-x-80, which backs a Service without selector of
+// the same name.
+package k8spublisher
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+)
+
+var (
+	kubeconfigPath = flag.String("publish_primaries_k8s_kubeconfig", "", "The kubeconfig of the kubernetes primary publisher. Empty for the in-cluster config, when vtctld runs in the cluster")
+	namespace      = flag.String("publish_primaries_k8s_namespace", "default", "The namespace of the Kubernetes Endpoints of the shard primaries")
+	namePrefix     = flag.String("publish_primaries_k8s_name_prefix", "vitess-primary", "The prefix of the names of the Kubernetes Endpoints of the shard primaries, which are named <prefix>-<keyspace>-<shard>")
+)
+
+const (
+	keyspaceLabel = "vitess.io/keyspace"
+	shardLabel    = "vitess.io/shard"
+	// tabletAliasAnnotation is an annotation, since the tablet aliases
+	// are not valid label values.
+	tabletAliasAnnotation = "vitess.io/tablet-alias"
+)
+
+func init() {
+	primarypublisher.RegisterBackend("kubernetes", func() (primarypublisher.Backend, error) {
+		var config *rest.Config
+		var err error
+		if *kubeconfigPath == "" {
+			config, err = rest.InClusterConfig()
+		} else {
+			config, err = clientcmd.BuildConfigFromFlags("", *kubeconfigPath)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the Kubernetes client config: %v", err)
+		}
+		client, err := corev1client.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublisher(client.Endpoints(*namespace), *namePrefix), nil
+	})
+}
+
+// Publisher publishes the shard primaries as Kubernetes Endpoints.
+type Publisher struct {
+	endpoints corev1client.EndpointsInterface
+	prefix    string
+	// lookupIP resolves the host names of the primaries, since the
+	// Endpoints only take IP addresses.
+	lookupIP func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewPublisher creates a Publisher.
+func NewPublisher(endpoints corev1client.EndpointsInterface, prefix string) *Publisher {
+	return &Publisher{
+		endpoints: endpoints,
+		prefix:    prefix,
+		lookupIP:  net.DefaultResolver.LookupIPAddr,
+	}
+}
+
+// Publish is part of the primarypublisher.Backend interface.
+func (p *Publisher) Publish(ctx context.Context, endpoint *primarypublisher.Endpoint) error {
+	ip := endpoint.Host
+	if !primarypublisher.IsIP(ip) {
+		addrs, err := p.lookupIP(ctx, endpoint.Host)
+		if err != nil {
+			return err
+		}
+		if len(addrs) == 0 {
+			return fmt.Errorf("host %s of primary %s has no IP address", endpoint.Host, endpoint.TabletAlias)
+		}
+		ip = addrs[0].IP.String()
+	}
+
+	name := primarypublisher.ServiceName(p.prefix, endpoint.Keyspace, endpoint.Shard)
+	labels := map[string]string{
+		keyspaceLabel: endpoint.Keyspace,
+		shardLabel:    primarypublisher.ShardLabel(endpoint.Shard),
+	}
+	annotations := map[string]string{
+		tabletAliasAnnotation: endpoint.TabletAlias,
+	}
+	subsets := []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: ip}},
+		Ports: []corev1.EndpointPort{{
+			Name:     "mysql",
+			Port:     endpoint.Port,
+			Protocol: corev1.ProtocolTCP,
+		}},
+	}}
+
+	existing, err := p.endpoints.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = p.endpoints.Create(ctx, &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Subsets: subsets,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if existing.Labels == nil {
+		existing.Labels = make(map[string]string)
+	}
+	for k, v := range labels {
+		existing.Labels[k] = v
+	}
+	if existing.Annotations == nil {
+		existing.Annotations = make(map[string]string)
+	}
+	for k, v := range annotations {
+		existing.Annotations[k] = v
+	}
+	existing.Subsets = subsets
+	_, err = p.endpoints.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// Unpublish is part of the primarypublisher.Backend interface.
+func (p *Publisher) Unpublish(ctx context.Context, keyspace, shard string) error {
+	err := p.endpoints.Delete(ctx, primarypublisher.ServiceName(p.prefix, keyspace, shard), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
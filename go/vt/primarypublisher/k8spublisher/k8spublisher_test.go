@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8spublisher
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"vitess.io/vitess/go/vt/primarypublisher"
+)
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+	endpoints := fake.NewSimpleClientset().CoreV1().Endpoints("vitess")
+	p := NewPublisher(endpoints, "vitess-primary")
+	p.lookupIP = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		assert.Equal(t, "db2.example.com", host)
+		return []net.IPAddr{{IP: net.ParseIP("10.0.0.2")}}, nil
+	}
+
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "zone1-0000000100",
+		Host:        "10.0.0.1",
+		Port:        3306,
+	}))
+	e, err := endpoints.Get(ctx, "vitess-primary-commerce-x-80", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{keyspaceLabel: "commerce", shardLabel: "x-80"}, e.Labels)
+	assert.Equal(t, map[string]string{tabletAliasAnnotation: "zone1-0000000100"}, e.Annotations)
+	assert.Equal(t, []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+		Ports:     []corev1.EndpointPort{{Name: "mysql", Port: 3306, Protocol: corev1.ProtocolTCP}},
+	}}, e.Subsets)
+
+	// a reparent updates the Endpoints, and keeps the labels set by others
+	e.Labels["app"] = "legacy"
+	_, err = endpoints.Update(ctx, e, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, p.Publish(ctx, &primarypublisher.Endpoint{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "zone1-0000000101",
+		Host:        "db2.example.com",
+		Port:        3306,
+	}))
+	e, err = endpoints.Get(ctx, "vitess-primary-commerce-x-80", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", e.Labels["app"])
+	assert.Equal(t, "zone1-0000000101", e.Annotations[tabletAliasAnnotation])
+	assert.Equal(t, "10.0.0.2", e.Subsets[0].Addresses[0].IP)
+
+	require.NoError(t, p.Unpublish(ctx, "commerce", "-80"))
+	_, err = endpoints.Get(ctx, "vitess-primary-commerce-x-80", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, p.Unpublish(ctx, "commerce", "-80"))
+}
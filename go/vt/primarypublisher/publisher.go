@@ -0,0 +1,411 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package primarypublisher publishes the addresses of the primaries of the
+// shards to external service discovery systems, and updates them on every
+// reparent. It is meant for the legacy systems that connect to the shard
+// primaries directly, outside of the vtgate routing.
+//
+// The service discovery systems are pluggable backends, which register
+// themselves with RegisterBackend.
+package primarypublisher
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	publishPrimariesTo              = flag.String("publish_primaries_to", "", "Comma separated list of the service discovery backends to which the addresses of the shard primaries are published on every reparent, e.g. dns,consul,kubernetes. Empty to not publish them")
+	publishPrimariesKeyspaces       = flag.String("publish_primaries_keyspaces", "", "Comma separated list of the keyspaces whose primaries are published. Empty for all the keyspaces")
+	publishPrimariesRefreshInterval = flag.Duration("publish_primaries_refresh_interval", time.Minute, "How often the new keyspaces and shards are looked for, and all the primaries published again, to retry the failed publications and repair the changes made behind our back")
+)
+
+var (
+	publishCounts  = stats.NewCountersWithMultiLabels("PrimaryPublisherPublishes", "Publications of the shard primaries to the service discovery backends, by backend and result", []string{"Backend", "Result"})
+	publishedCount = stats.NewGauge("PrimaryPublisherShards", "Number of shards whose primary is published")
+)
+
+// Endpoint is the address of the primary of a shard.
+type Endpoint struct {
+	Keyspace    string
+	Shard       string
+	TabletAlias string
+	// Host and Port are the MySQL address of the primary.
+	Host string
+	Port int32
+}
+
+// Backend publishes the primaries to a service discovery system.
+// Publications must be idempotent: the same endpoint is published again on
+// every refresh.
+type Backend interface {
+	// Publish publishes the primary of a shard, replacing the primary
+	// published before, if any.
+	Publish(ctx context.Context, endpoint *Endpoint) error
+	// Unpublish removes the primary of a shard that has none anymore, or
+	// that was deleted. It succeeds if nothing was published.
+	Unpublish(ctx context.Context, keyspace, shard string) error
+}
+
+// Factory creates a backend, once the flags are parsed.
+type Factory func() (Backend, error)
+
+var factories = make(map[string]Factory)
+
+// RegisterBackend registers a backend under a name, for -publish_primaries_to.
+// It is called in the init function of the backend packages.
+func RegisterBackend(name string, factory Factory) {
+	if _, ok := factories[name]; ok {
+		log.Fatalf("primary publisher backend %s is already registered", name)
+	}
+	factories[name] = factory
+}
+
+// Init creates the backends of -publish_primaries_to, and publishes the
+// primaries until the context is done. It returns a nil Publisher if
+// -publish_primaries_to is empty.
+func Init(ctx context.Context, ts *topo.Server) (*Publisher, error) {
+	if *publishPrimariesTo == "" {
+		return nil, nil
+	}
+	backends := make(map[string]Backend)
+	for _, name := range strings.Split(*publishPrimariesTo, ",") {
+		name = strings.TrimSpace(name)
+		factory, ok := factories[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown primary publisher backend %q, the known backends are %v", name, registeredBackends())
+		}
+		backend, err := factory()
+		if err != nil {
+			return nil, fmt.Errorf("cannot create primary publisher backend %s: %v", name, err)
+		}
+		backends[name] = backend
+	}
+	var keyspaces []string
+	if *publishPrimariesKeyspaces != "" {
+		for _, keyspace := range strings.Split(*publishPrimariesKeyspaces, ",") {
+			keyspaces = append(keyspaces, strings.TrimSpace(keyspace))
+		}
+	}
+	p := NewPublisher(ts, backends, keyspaces)
+	go p.Run(ctx, *publishPrimariesRefreshInterval)
+	return p, nil
+}
+
+func registeredBackends() []string {
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Publisher watches the shard records, and publishes the primary of each
+// shard to the backends when it changes.
+type Publisher struct {
+	ts       *topo.Server
+	backends map[string]Backend
+	// keyspaces are the keyspaces whose primaries are published, or all
+	// the keyspaces if empty.
+	keyspaces []string
+
+	mu sync.Mutex
+	// watches are the cancel functions of the watches of the shards,
+	// keyed by keyspace/shard.
+	watches map[string]context.CancelFunc
+	// endpoints are the last known primaries, keyed by keyspace/shard.
+	// They are published again on every refresh, which retries the
+	// publications that failed.
+	endpoints map[string]*Endpoint
+}
+
+// NewPublisher creates a Publisher. Run starts it.
+func NewPublisher(ts *topo.Server, backends map[string]Backend, keyspaces []string) *Publisher {
+	return &Publisher{
+		ts:        ts,
+		backends:  backends,
+		keyspaces: keyspaces,
+		watches:   make(map[string]context.CancelFunc),
+		endpoints: make(map[string]*Endpoint),
+	}
+}
+
+// Run refreshes the publisher every refresh interval, until the context is
+// done.
+func (p *Publisher) Run(ctx context.Context, refreshInterval time.Duration) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := p.Refresh(ctx); err != nil {
+			log.Warningf("primary publisher: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			p.mu.Lock()
+			for _, cancel := range p.watches {
+				cancel()
+			}
+			p.mu.Unlock()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh watches the shards that are not watched yet, unpublishes the
+// primaries of the deleted shards, and publishes all the known primaries
+// again.
+func (p *Publisher) Refresh(ctx context.Context) error {
+	shards, err := p.listShards(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, cancel := range p.watches {
+		if !shards[key] {
+			cancel()
+			delete(p.watches, key)
+		}
+	}
+	for key := range p.endpoints {
+		if !shards[key] {
+			keyspace, shard := splitKey(key)
+			p.unpublishLocked(ctx, keyspace, shard)
+		}
+	}
+	for key := range shards {
+		if _, ok := p.watches[key]; ok {
+			continue
+		}
+		keyspace, shard := splitKey(key)
+		watchCtx, cancel := context.WithCancel(ctx)
+		p.watches[key] = cancel
+		go p.watch(watchCtx, keyspace, shard)
+	}
+	for _, endpoint := range p.endpoints {
+		p.publishLocked(ctx, endpoint)
+	}
+	return nil
+}
+
+// listShards returns the shards of the published keyspaces, keyed by
+// keyspace/shard.
+func (p *Publisher) listShards(ctx context.Context) (map[string]bool, error) {
+	keyspaces := p.keyspaces
+	if len(keyspaces) == 0 {
+		var err error
+		if keyspaces, err = p.ts.GetKeyspaces(ctx); err != nil {
+			return nil, fmt.Errorf("cannot list the keyspaces: %v", err)
+		}
+	}
+	shards := make(map[string]bool)
+	for _, keyspace := range keyspaces {
+		names, err := p.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				continue
+			}
+			return nil, fmt.Errorf("cannot list the shards of keyspace %s: %v", keyspace, err)
+		}
+		for _, shard := range names {
+			shards[topoproto.KeyspaceShardString(keyspace, shard)] = true
+		}
+	}
+	return shards, nil
+}
+
+// watch follows the changes of the primary of a shard, until the watch
+// fails or the context is done. The next refresh watches the shard again
+// after a failure.
+func (p *Publisher) watch(ctx context.Context, keyspace, shard string) {
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	defer func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if cancel, ok := p.watches[key]; ok && ctx.Err() == nil {
+			cancel()
+			delete(p.watches, key)
+		}
+	}()
+
+	current, changes, cancel := p.ts.WatchShard(ctx, keyspace, shard)
+	if current.Err != nil {
+		if topo.IsErrType(current.Err, topo.NoNode) {
+			p.onShardChange(ctx, keyspace, shard, nil)
+		} else {
+			log.Warningf("primary publisher: cannot watch shard %s: %v", key, current.Err)
+		}
+		return
+	}
+	defer cancel()
+	p.onShardChange(ctx, keyspace, shard, current.Value)
+	for change := range changes {
+		if change.Err != nil {
+			if topo.IsErrType(change.Err, topo.NoNode) {
+				p.onShardChange(ctx, keyspace, shard, nil)
+			} else if !topo.IsErrType(change.Err, topo.Interrupted) {
+				log.Warningf("primary publisher: the watch of shard %s failed: %v", key, change.Err)
+			}
+			return
+		}
+		p.onShardChange(ctx, keyspace, shard, change.Value)
+	}
+}
+
+// onShardChange publishes the primary of a shard if it changed, and
+// unpublishes it if the shard has no primary, or is nil because it was
+// deleted.
+func (p *Publisher) onShardChange(ctx context.Context, keyspace, shard string, si *topodatapb.Shard) {
+	var endpoint *Endpoint
+	if si != nil && si.PrimaryAlias != nil {
+		tablet, err := p.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			log.Warningf("primary publisher: cannot read primary %s of shard %s/%s: %v", topoproto.TabletAliasString(si.PrimaryAlias), keyspace, shard, err)
+			return
+		}
+		endpoint = tabletEndpoint(keyspace, shard, tablet.Tablet)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+	if endpoint == nil {
+		p.unpublishLocked(ctx, keyspace, shard)
+		return
+	}
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	if previous, ok := p.endpoints[key]; ok && *previous == *endpoint {
+		// The shard record changed, but not its primary.
+		return
+	}
+	log.Infof("primary publisher: publishing %s (%s:%d) as the primary of shard %s", endpoint.TabletAlias, endpoint.Host, endpoint.Port, key)
+	p.endpoints[key] = endpoint
+	publishedCount.Set(int64(len(p.endpoints)))
+	p.publishLocked(ctx, endpoint)
+}
+
+// tabletEndpoint returns the MySQL address of a tablet, or its hostname if
+// it does not know its MySQL hostname.
+func tabletEndpoint(keyspace, shard string, tablet *topodatapb.Tablet) *Endpoint {
+	host := tablet.MysqlHostname
+	if host == "" {
+		host = tablet.Hostname
+	}
+	return &Endpoint{
+		Keyspace:    keyspace,
+		Shard:       shard,
+		TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+		Host:        host,
+		Port:        tablet.MysqlPort,
+	}
+}
+
+func (p *Publisher) publishLocked(ctx context.Context, endpoint *Endpoint) {
+	for name, backend := range p.backends {
+		if err := backend.Publish(ctx, endpoint); err != nil {
+			log.Warningf("primary publisher: cannot publish the primary of shard %s/%s to %s: %v", endpoint.Keyspace, endpoint.Shard, name, err)
+			publishCounts.Add([]string{name, "Error"}, 1)
+			continue
+		}
+		publishCounts.Add([]string{name, "OK"}, 1)
+	}
+}
+
+func (p *Publisher) unpublishLocked(ctx context.Context, keyspace, shard string) {
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	if _, ok := p.endpoints[key]; !ok {
+		return
+	}
+	log.Infof("primary publisher: unpublishing the primary of shard %s", key)
+	for name, backend := range p.backends {
+		if err := backend.Unpublish(ctx, keyspace, shard); err != nil {
+			log.Warningf("primary publisher: cannot unpublish the primary of shard %s from %s: %v", key, name, err)
+			publishCounts.Add([]string{name, "Error"}, 1)
+			// The endpoint is kept, so that the next refresh tries again.
+			return
+		}
+	}
+	delete(p.endpoints, key)
+	publishedCount.Set(int64(len(p.endpoints)))
+}
+
+// Endpoints returns the last known primaries, sorted by keyspace and shard.
+func (p *Publisher) Endpoints() []*Endpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	endpoints := make([]*Endpoint, 0, len(p.endpoints))
+	for _, endpoint := range p.endpoints {
+		e := *endpoint
+		endpoints = append(endpoints, &e)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Keyspace != endpoints[j].Keyspace {
+			return endpoints[i].Keyspace < endpoints[j].Keyspace
+		}
+		return endpoints[i].Shard < endpoints[j].Shard
+	})
+	return endpoints
+}
+
+func splitKey(key string) (keyspace, shard string) {
+	keyspace, shard, _ = topoproto.ParseKeyspaceShard(key)
+	return keyspace, shard
+}
+
+// ShardLabel returns a shard name that can be used in a DNS label, or a
+// service name: the empty key range bounds are replaced by x, so that -80
+// is x-80 and 80- is 80-x.
+func ShardLabel(shard string) string {
+	if strings.HasPrefix(shard, "-") {
+		shard = "x" + shard
+	}
+	if strings.HasSuffix(shard, "-") {
+		shard = shard + "x"
+	}
+	return strings.ToLower(shard)
+}
+
+// ServiceName returns the name of the service of the primary of a shard,
+// e.g. vitess-primary-commerce-x-80 for the shard -80 of keyspace commerce
+// and the prefix vitess-primary. The underscores of the keyspace names,
+// which the DNS names don't allow, are replaced by dashes.
+func ServiceName(prefix, keyspace, shard string) string {
+	return strings.ToLower(strings.ReplaceAll(fmt.Sprintf("%s-%s-%s", prefix, keyspace, ShardLabel(shard)), "_", "-"))
+}
+
+// IsIP returns true if a host is an IP address rather than a host name.
+func IsIP(host string) bool {
+	return net.ParseIP(host) != nil
+}
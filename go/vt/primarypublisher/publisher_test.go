@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package primarypublisher
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeBackend records the published primaries.
+type fakeBackend struct {
+	mu        sync.Mutex
+	published map[string]Endpoint
+	publishes int
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{published: make(map[string]Endpoint)}
+}
+
+func (b *fakeBackend) Publish(ctx context.Context, endpoint *Endpoint) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.published[endpoint.Keyspace+"/"+endpoint.Shard] = *endpoint
+	b.publishes++
+	return nil
+}
+
+func (b *fakeBackend) Unpublish(ctx context.Context, keyspace, shard string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.published, keyspace+"/"+shard)
+	return nil
+}
+
+func (b *fakeBackend) get(key string) (Endpoint, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	endpoint, ok := b.published[key]
+	return endpoint, ok
+}
+
+func createPrimaryTablet(t *testing.T, ts *topo.Server, uid uint32, keyspace, shard, host string) *topodatapb.TabletAlias {
+	t.Helper()
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: uid}
+	require.NoError(t, ts.CreateTablet(context.Background(), &topodatapb.Tablet{
+		Alias:         alias,
+		Keyspace:      keyspace,
+		Shard:         shard,
+		Hostname:      "tablet-" + host,
+		MysqlHostname: host,
+		MysqlPort:     3306,
+	}))
+	return alias
+}
+
+func setPrimary(t *testing.T, ts *topo.Server, keyspace, shard string, alias *topodatapb.TabletAlias) {
+	t.Helper()
+	_, err := ts.UpdateShardFields(context.Background(), keyspace, shard, func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = alias
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPublisher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "commerce", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "commerce", "-80"))
+	require.NoError(t, ts.CreateShard(ctx, "commerce", "80-"))
+	require.NoError(t, ts.CreateKeyspace(ctx, "customer", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "customer", "0"))
+
+	primary1 := createPrimaryTablet(t, ts, 1, "commerce", "-80", "10.0.0.1")
+	primary2 := createPrimaryTablet(t, ts, 2, "commerce", "-80", "10.0.0.2")
+	setPrimary(t, ts, "commerce", "-80", primary1)
+	setPrimary(t, ts, "customer", "0", createPrimaryTablet(t, ts, 3, "customer", "0", "10.0.0.3"))
+
+	backend := newFakeBackend()
+	p := NewPublisher(ts, map[string]Backend{"fake": backend}, []string{"commerce"})
+	require.NoError(t, p.Refresh(ctx))
+
+	require.Eventually(t, func() bool {
+		endpoint, ok := backend.get("commerce/-80")
+		return ok && endpoint.TabletAlias == "cell1-0000000001"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []*Endpoint{{
+		Keyspace:    "commerce",
+		Shard:       "-80",
+		TabletAlias: "cell1-0000000001",
+		Host:        "10.0.0.1",
+		Port:        3306,
+	}}, p.Endpoints())
+	// the shard 80- has no primary, and keyspace customer is not published
+	_, ok := backend.get("commerce/80-")
+	assert.False(t, ok)
+	_, ok = backend.get("customer/0")
+	assert.False(t, ok)
+
+	// a reparent publishes the new primary
+	setPrimary(t, ts, "commerce", "-80", primary2)
+	require.Eventually(t, func() bool {
+		endpoint, _ := backend.get("commerce/-80")
+		return endpoint.TabletAlias == "cell1-0000000002" && endpoint.Host == "10.0.0.2"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the changes of the shard record that keep the primary do not publish it again
+	backend.mu.Lock()
+	publishes := backend.publishes
+	backend.mu.Unlock()
+	_, err := ts.UpdateShardFields(ctx, "commerce", "-80", func(si *topo.ShardInfo) error {
+		si.IsPrimaryServing = false
+		return nil
+	})
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	backend.mu.Lock()
+	assert.Equal(t, publishes, backend.publishes)
+	backend.mu.Unlock()
+
+	// a refresh publishes all the primaries again
+	require.NoError(t, p.Refresh(ctx))
+	backend.mu.Lock()
+	assert.Equal(t, publishes+1, backend.publishes)
+	backend.mu.Unlock()
+
+	// the primaries of the shards without a primary are unpublished
+	setPrimary(t, ts, "commerce", "-80", nil)
+	require.Eventually(t, func() bool {
+		_, ok := backend.get("commerce/-80")
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, p.Endpoints())
+}
+
+func TestShardLabel(t *testing.T) {
+	tests := []struct {
+		shard string
+		label string
+	}{
+		{"0", "0"},
+		{"-", "x-x"},
+		{"-80", "x-80"},
+		{"80-", "80-x"},
+		{"40-80", "40-80"},
+		{"-C0", "x-c0"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.label, ShardLabel(tt.shard), tt.shard)
+	}
+}
+
+func TestServiceName(t *testing.T) {
+	assert.Equal(t, "vitess-primary-commerce-x-80", ServiceName("vitess-primary", "commerce", "-80"))
+	assert.Equal(t, "vitess-primary-user-data-0", ServiceName("vitess-primary", "User_Data", "0"))
+}
@@ -50,6 +50,9 @@ type QueryClient interface {
 	ConcludeTransaction(ctx context.Context, in *query.ConcludeTransactionRequest, opts ...grpc.CallOption) (*query.ConcludeTransactionResponse, error)
 	// ReadTransaction returns the 2pc transaction info.
 	ReadTransaction(ctx context.Context, in *query.ReadTransactionRequest, opts ...grpc.CallOption) (*query.ReadTransactionResponse, error)
+	// UnresolvedTransactions returns the 2pc transactions that are
+	// in flight on the shard, as a coordinator or a participant.
+	UnresolvedTransactions(ctx context.Context, in *query.UnresolvedTransactionsRequest, opts ...grpc.CallOption) (*query.UnresolvedTransactionsResponse, error)
 	// BeginExecute executes a begin and the specified SQL query.
 	BeginExecute(ctx context.Context, in *query.BeginExecuteRequest, opts ...grpc.CallOption) (*query.BeginExecuteResponse, error)
 	// BeginStreamExecute executes a begin and the specified SQL query.
@@ -58,6 +61,9 @@ type QueryClient interface {
 	MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error)
 	// MessageAck acks messages for a table.
 	MessageAck(ctx context.Context, in *query.MessageAckRequest, opts ...grpc.CallOption) (*query.MessageAckResponse, error)
+	// MessageStreamWindow grows the window of a MessageStream, for the tablet
+	// to send it more messages.
+	MessageStreamWindow(ctx context.Context, in *query.MessageStreamWindowRequest, opts ...grpc.CallOption) (*query.MessageStreamWindowResponse, error)
 	// ReserveExecute executes a query on a reserved connection
 	ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error)
 	// ReserveBeginExecute starts a transaction and executes a query in the transaction on a reserved connection
@@ -227,6 +233,15 @@ func (c *queryClient) ReadTransaction(ctx context.Context, in *query.ReadTransac
 	return out, nil
 }
 
+func (c *queryClient) UnresolvedTransactions(ctx context.Context, in *query.UnresolvedTransactionsRequest, opts ...grpc.CallOption) (*query.UnresolvedTransactionsResponse, error) {
+	out := new(query.UnresolvedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/UnresolvedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BeginExecute(ctx context.Context, in *query.BeginExecuteRequest, opts ...grpc.CallOption) (*query.BeginExecuteResponse, error) {
 	out := new(query.BeginExecuteResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/BeginExecute", in, out, opts...)
@@ -309,6 +324,15 @@ func (c *queryClient) MessageAck(ctx context.Context, in *query.MessageAckReques
 	return out, nil
 }
 
+func (c *queryClient) MessageStreamWindow(ctx context.Context, in *query.MessageStreamWindowRequest, opts ...grpc.CallOption) (*query.MessageStreamWindowResponse, error) {
+	out := new(query.MessageStreamWindowResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/MessageStreamWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error) {
 	out := new(query.ReserveExecuteResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveExecute", in, out, opts...)
@@ -562,6 +586,9 @@ type QueryServer interface {
 	ConcludeTransaction(context.Context, *query.ConcludeTransactionRequest) (*query.ConcludeTransactionResponse, error)
 	// ReadTransaction returns the 2pc transaction info.
 	ReadTransaction(context.Context, *query.ReadTransactionRequest) (*query.ReadTransactionResponse, error)
+	// UnresolvedTransactions returns the 2pc transactions that are
+	// in flight on the shard, as a coordinator or a participant.
+	UnresolvedTransactions(context.Context, *query.UnresolvedTransactionsRequest) (*query.UnresolvedTransactionsResponse, error)
 	// BeginExecute executes a begin and the specified SQL query.
 	BeginExecute(context.Context, *query.BeginExecuteRequest) (*query.BeginExecuteResponse, error)
 	// BeginStreamExecute executes a begin and the specified SQL query.
@@ -570,6 +597,9 @@ type QueryServer interface {
 	MessageStream(*query.MessageStreamRequest, Query_MessageStreamServer) error
 	// MessageAck acks messages for a table.
 	MessageAck(context.Context, *query.MessageAckRequest) (*query.MessageAckResponse, error)
+	// MessageStreamWindow grows the window of a MessageStream, for the tablet
+	// to send it more messages.
+	MessageStreamWindow(context.Context, *query.MessageStreamWindowRequest) (*query.MessageStreamWindowResponse, error)
 	// ReserveExecute executes a query on a reserved connection
 	ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error)
 	// ReserveBeginExecute starts a transaction and executes a query in the transaction on a reserved connection
@@ -635,6 +665,9 @@ func (UnimplementedQueryServer) ConcludeTransaction(context.Context, *query.Conc
 func (UnimplementedQueryServer) ReadTransaction(context.Context, *query.ReadTransactionRequest) (*query.ReadTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadTransaction not implemented")
 }
+func (UnimplementedQueryServer) UnresolvedTransactions(context.Context, *query.UnresolvedTransactionsRequest) (*query.UnresolvedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnresolvedTransactions not implemented")
+}
 func (UnimplementedQueryServer) BeginExecute(context.Context, *query.BeginExecuteRequest) (*query.BeginExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginExecute not implemented")
 }
@@ -647,6 +680,9 @@ func (UnimplementedQueryServer) MessageStream(*query.MessageStreamRequest, Query
 func (UnimplementedQueryServer) MessageAck(context.Context, *query.MessageAckRequest) (*query.MessageAckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageAck not implemented")
 }
+func (UnimplementedQueryServer) MessageStreamWindow(context.Context, *query.MessageStreamWindowRequest) (*query.MessageStreamWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageStreamWindow not implemented")
+}
 func (UnimplementedQueryServer) ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveExecute not implemented")
 }
@@ -924,6 +960,24 @@ func _Query_ReadTransaction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnresolvedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.UnresolvedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnresolvedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/UnresolvedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnresolvedTransactions(ctx, req.(*query.UnresolvedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BeginExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.BeginExecuteRequest)
 	if err := dec(in); err != nil {
@@ -1002,6 +1056,24 @@ func _Query_MessageAck_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageStreamWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.MessageStreamWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageStreamWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/MessageStreamWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageStreamWindow(ctx, req.(*query.MessageStreamWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ReserveExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.ReserveExecuteRequest)
 	if err := dec(in); err != nil {
@@ -1237,6 +1309,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadTransaction",
 			Handler:    _Query_ReadTransaction_Handler,
 		},
+		{
+			MethodName: "UnresolvedTransactions",
+			Handler:    _Query_UnresolvedTransactions_Handler,
+		},
 		{
 			MethodName: "BeginExecute",
 			Handler:    _Query_BeginExecute_Handler,
@@ -1245,6 +1321,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageAck",
 			Handler:    _Query_MessageAck_Handler,
 		},
+		{
+			MethodName: "MessageStreamWindow",
+			Handler:    _Query_MessageStreamWindow_Handler,
+		},
 		{
 			MethodName: "ReserveExecute",
 			Handler:    _Query_ReserveExecute_Handler,
@@ -71,6 +71,8 @@ type TabletManagerClient interface {
 	// VReplication API
 	VReplicationExec(ctx context.Context, in *tabletmanagerdata.VReplicationExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(ctx context.Context, in *tabletmanagerdata.VReplicationWaitForPosRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VReplicationProgress reports the copy progress, the throughput and the lag of the streams of a workflow
+	VReplicationProgress(ctx context.Context, in *tabletmanagerdata.VReplicationProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationProgressResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// Deprecated, use InitPrimary instead
@@ -108,6 +110,12 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// GetTableGCStatus lists the tables in the lifecycle of table GC
+	GetTableGCStatus(ctx context.Context, in *tabletmanagerdata.GetTableGCStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetTableGCStatusResponse, error)
+	// AdvanceTableGC moves a GC table on to its next state right away, or takes it out of its lifecycle
+	AdvanceTableGC(ctx context.Context, in *tabletmanagerdata.AdvanceTableGCRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AdvanceTableGCResponse, error)
+	// UpgradeMysqld upgrades the mysqld of a DRAINED tablet in place, and rejoins replication
+	UpgradeMysqld(ctx context.Context, in *tabletmanagerdata.UpgradeMysqldRequest, opts ...grpc.CallOption) (*tabletmanagerdata.UpgradeMysqldResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -406,6 +414,15 @@ func (c *tabletManagerClient) VReplicationWaitForPos(ctx context.Context, in *ta
 	return out, nil
 }
 
+func (c *tabletManagerClient) VReplicationProgress(ctx context.Context, in *tabletmanagerdata.VReplicationProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationProgressResponse, error) {
+	out := new(tabletmanagerdata.VReplicationProgressResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VReplicationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	out := new(tabletmanagerdata.ResetReplicationResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetReplication", in, out, opts...)
@@ -614,6 +631,33 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetTableGCStatus(ctx context.Context, in *tabletmanagerdata.GetTableGCStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetTableGCStatusResponse, error) {
+	out := new(tabletmanagerdata.GetTableGCStatusResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetTableGCStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) AdvanceTableGC(ctx context.Context, in *tabletmanagerdata.AdvanceTableGCRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AdvanceTableGCResponse, error) {
+	out := new(tabletmanagerdata.AdvanceTableGCResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/AdvanceTableGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) UpgradeMysqld(ctx context.Context, in *tabletmanagerdata.UpgradeMysqldRequest, opts ...grpc.CallOption) (*tabletmanagerdata.UpgradeMysqldResponse, error) {
+	out := new(tabletmanagerdata.UpgradeMysqldResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/UpgradeMysqld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 // All implementations must embed UnimplementedTabletManagerServer
 // for forward compatibility
@@ -670,6 +714,8 @@ type TabletManagerServer interface {
 	// VReplication API
 	VReplicationExec(context.Context, *tabletmanagerdata.VReplicationExecRequest) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(context.Context, *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VReplicationProgress reports the copy progress, the throughput and the lag of the streams of a workflow
+	VReplicationProgress(context.Context, *tabletmanagerdata.VReplicationProgressRequest) (*tabletmanagerdata.VReplicationProgressResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// Deprecated, use InitPrimary instead
@@ -707,6 +753,12 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// GetTableGCStatus lists the tables in the lifecycle of table GC
+	GetTableGCStatus(context.Context, *tabletmanagerdata.GetTableGCStatusRequest) (*tabletmanagerdata.GetTableGCStatusResponse, error)
+	// AdvanceTableGC moves a GC table on to its next state right away, or takes it out of its lifecycle
+	AdvanceTableGC(context.Context, *tabletmanagerdata.AdvanceTableGCRequest) (*tabletmanagerdata.AdvanceTableGCResponse, error)
+	// UpgradeMysqld upgrades the mysqld of a DRAINED tablet in place, and rejoins replication
+	UpgradeMysqld(context.Context, *tabletmanagerdata.UpgradeMysqldRequest) (*tabletmanagerdata.UpgradeMysqldResponse, error)
 	mustEmbedUnimplementedTabletManagerServer()
 }
 
@@ -810,6 +862,9 @@ func (UnimplementedTabletManagerServer) VReplicationExec(context.Context, *table
 func (UnimplementedTabletManagerServer) VReplicationWaitForPos(context.Context, *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VReplicationWaitForPos not implemented")
 }
+func (UnimplementedTabletManagerServer) VReplicationProgress(context.Context, *tabletmanagerdata.VReplicationProgressRequest) (*tabletmanagerdata.VReplicationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VReplicationProgress not implemented")
+}
 func (UnimplementedTabletManagerServer) ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetReplication not implemented")
 }
@@ -864,6 +919,15 @@ func (UnimplementedTabletManagerServer) RestoreFromBackup(*tabletmanagerdata.Res
 func (UnimplementedTabletManagerServer) VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (UnimplementedTabletManagerServer) GetTableGCStatus(context.Context, *tabletmanagerdata.GetTableGCStatusRequest) (*tabletmanagerdata.GetTableGCStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTableGCStatus not implemented")
+}
+func (UnimplementedTabletManagerServer) AdvanceTableGC(context.Context, *tabletmanagerdata.AdvanceTableGCRequest) (*tabletmanagerdata.AdvanceTableGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceTableGC not implemented")
+}
+func (UnimplementedTabletManagerServer) UpgradeMysqld(context.Context, *tabletmanagerdata.UpgradeMysqldRequest) (*tabletmanagerdata.UpgradeMysqldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeMysqld not implemented")
+}
 func (UnimplementedTabletManagerServer) mustEmbedUnimplementedTabletManagerServer() {}
 
 // UnsafeTabletManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -1453,6 +1517,24 @@ func _TabletManager_VReplicationWaitForPos_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_VReplicationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VReplicationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VReplicationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VReplicationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VReplicationProgress(ctx, req.(*tabletmanagerdata.VReplicationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ResetReplicationRequest)
 	if err := dec(in); err != nil {
@@ -1783,6 +1865,60 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetTableGCStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetTableGCStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetTableGCStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetTableGCStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetTableGCStatus(ctx, req.(*tabletmanagerdata.GetTableGCStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_AdvanceTableGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.AdvanceTableGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).AdvanceTableGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/AdvanceTableGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).AdvanceTableGC(ctx, req.(*tabletmanagerdata.AdvanceTableGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_UpgradeMysqld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.UpgradeMysqldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).UpgradeMysqld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/UpgradeMysqld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).UpgradeMysqld(ctx, req.(*tabletmanagerdata.UpgradeMysqldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TabletManager_ServiceDesc is the grpc.ServiceDesc for TabletManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1918,6 +2054,10 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VReplicationWaitForPos",
 			Handler:    _TabletManager_VReplicationWaitForPos_Handler,
 		},
+		{
+			MethodName: "VReplicationProgress",
+			Handler:    _TabletManager_VReplicationProgress_Handler,
+		},
 		{
 			MethodName: "ResetReplication",
 			Handler:    _TabletManager_ResetReplication_Handler,
@@ -1982,6 +2122,18 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "GetTableGCStatus",
+			Handler:    _TabletManager_GetTableGCStatus_Handler,
+		},
+		{
+			MethodName: "AdvanceTableGC",
+			Handler:    _TabletManager_AdvanceTableGC_Handler,
+		},
+		{
+			MethodName: "UpgradeMysqld",
+			Handler:    _TabletManager_UpgradeMysqld_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
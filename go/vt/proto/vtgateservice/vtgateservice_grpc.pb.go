@@ -38,8 +38,22 @@ type VitessClient interface {
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error)
+	// ReadTransactions lists the unresolved distributed transactions,
+	// with the state of each participant.
+	// API group: Transactions
+	ReadTransactions(ctx context.Context, in *vtgate.ReadTransactionsRequest, opts ...grpc.CallOption) (*vtgate.ReadTransactionsResponse, error)
+	// ForceResolveTransaction commits or rolls back a distributed
+	// transaction, as decided by the operator.
+	// API group: Transactions
+	ForceResolveTransaction(ctx context.Context, in *vtgate.ForceResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ForceResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error)
+	// VStreamAck saves the vgtid that a VStream consumer processed in its
+	// checkpoint, for the streams to resume from it.
+	VStreamAck(ctx context.Context, in *vtgate.VStreamAckRequest, opts ...grpc.CallOption) (*vtgate.VStreamAckResponse, error)
+	// VStreamControl changes the copy limits of the running VStreams of a
+	// name.
+	VStreamControl(ctx context.Context, in *vtgate.VStreamControlRequest, opts ...grpc.CallOption) (*vtgate.VStreamControlResponse, error)
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
 	Prepare(ctx context.Context, in *vtgate.PrepareRequest, opts ...grpc.CallOption) (*vtgate.PrepareResponse, error)
 	// CloseSession closes the session, rolling back any implicit transactions.
@@ -115,6 +129,24 @@ func (c *vitessClient) ResolveTransaction(ctx context.Context, in *vtgate.Resolv
 	return out, nil
 }
 
+func (c *vitessClient) ReadTransactions(ctx context.Context, in *vtgate.ReadTransactionsRequest, opts ...grpc.CallOption) (*vtgate.ReadTransactionsResponse, error) {
+	out := new(vtgate.ReadTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ReadTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vitessClient) ForceResolveTransaction(ctx context.Context, in *vtgate.ForceResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ForceResolveTransactionResponse, error) {
+	out := new(vtgate.ForceResolveTransactionResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ForceResolveTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vitessClient) VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[1], "/vtgateservice.Vitess/VStream", opts...)
 	if err != nil {
@@ -147,6 +179,24 @@ func (x *vitessVStreamClient) Recv() (*vtgate.VStreamResponse, error) {
 	return m, nil
 }
 
+func (c *vitessClient) VStreamAck(ctx context.Context, in *vtgate.VStreamAckRequest, opts ...grpc.CallOption) (*vtgate.VStreamAckResponse, error) {
+	out := new(vtgate.VStreamAckResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/VStreamAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vitessClient) VStreamControl(ctx context.Context, in *vtgate.VStreamControlRequest, opts ...grpc.CallOption) (*vtgate.VStreamControlResponse, error) {
+	out := new(vtgate.VStreamControlResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/VStreamControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vitessClient) Prepare(ctx context.Context, in *vtgate.PrepareRequest, opts ...grpc.CallOption) (*vtgate.PrepareResponse, error) {
 	out := new(vtgate.PrepareResponse)
 	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/Prepare", in, out, opts...)
@@ -188,8 +238,22 @@ type VitessServer interface {
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error)
+	// ReadTransactions lists the unresolved distributed transactions,
+	// with the state of each participant.
+	// API group: Transactions
+	ReadTransactions(context.Context, *vtgate.ReadTransactionsRequest) (*vtgate.ReadTransactionsResponse, error)
+	// ForceResolveTransaction commits or rolls back a distributed
+	// transaction, as decided by the operator.
+	// API group: Transactions
+	ForceResolveTransaction(context.Context, *vtgate.ForceResolveTransactionRequest) (*vtgate.ForceResolveTransactionResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error
+	// VStreamAck saves the vgtid that a VStream consumer processed in its
+	// checkpoint, for the streams to resume from it.
+	VStreamAck(context.Context, *vtgate.VStreamAckRequest) (*vtgate.VStreamAckResponse, error)
+	// VStreamControl changes the copy limits of the running VStreams of a
+	// name.
+	VStreamControl(context.Context, *vtgate.VStreamControlRequest) (*vtgate.VStreamControlResponse, error)
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
 	Prepare(context.Context, *vtgate.PrepareRequest) (*vtgate.PrepareResponse, error)
 	// CloseSession closes the session, rolling back any implicit transactions.
@@ -215,9 +279,21 @@ func (UnimplementedVitessServer) StreamExecute(*vtgate.StreamExecuteRequest, Vit
 func (UnimplementedVitessServer) ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransaction not implemented")
 }
+func (UnimplementedVitessServer) ReadTransactions(context.Context, *vtgate.ReadTransactionsRequest) (*vtgate.ReadTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTransactions not implemented")
+}
+func (UnimplementedVitessServer) ForceResolveTransaction(context.Context, *vtgate.ForceResolveTransactionRequest) (*vtgate.ForceResolveTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceResolveTransaction not implemented")
+}
 func (UnimplementedVitessServer) VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStream not implemented")
 }
+func (UnimplementedVitessServer) VStreamAck(context.Context, *vtgate.VStreamAckRequest) (*vtgate.VStreamAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VStreamAck not implemented")
+}
+func (UnimplementedVitessServer) VStreamControl(context.Context, *vtgate.VStreamControlRequest) (*vtgate.VStreamControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VStreamControl not implemented")
+}
 func (UnimplementedVitessServer) Prepare(context.Context, *vtgate.PrepareRequest) (*vtgate.PrepareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prepare not implemented")
 }
@@ -312,6 +388,42 @@ func _Vitess_ResolveTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vitess_ReadTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.ReadTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).ReadTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/ReadTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).ReadTransactions(ctx, req.(*vtgate.ReadTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vitess_ForceResolveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.ForceResolveTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).ForceResolveTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/ForceResolveTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).ForceResolveTransaction(ctx, req.(*vtgate.ForceResolveTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vitess_VStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(vtgate.VStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -333,6 +445,42 @@ func (x *vitessVStreamServer) Send(m *vtgate.VStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Vitess_VStreamAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.VStreamAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).VStreamAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/VStreamAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).VStreamAck(ctx, req.(*vtgate.VStreamAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vitess_VStreamControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.VStreamControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).VStreamControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/VStreamControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).VStreamControl(ctx, req.(*vtgate.VStreamControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vitess_Prepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtgate.PrepareRequest)
 	if err := dec(in); err != nil {
@@ -388,6 +536,22 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveTransaction",
 			Handler:    _Vitess_ResolveTransaction_Handler,
 		},
+		{
+			MethodName: "ReadTransactions",
+			Handler:    _Vitess_ReadTransactions_Handler,
+		},
+		{
+			MethodName: "ForceResolveTransaction",
+			Handler:    _Vitess_ForceResolveTransaction_Handler,
+		},
+		{
+			MethodName: "VStreamAck",
+			Handler:    _Vitess_VStreamAck_Handler,
+		},
+		{
+			MethodName: "VStreamControl",
+			Handler:    _Vitess_VStreamControl_Handler,
+		},
 		{
 			MethodName: "Prepare",
 			Handler:    _Vitess_Prepare_Handler,
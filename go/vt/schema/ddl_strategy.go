@@ -19,6 +19,8 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/google/shlex"
 )
@@ -34,7 +36,9 @@ const (
 	singletonContextFlag   = "singleton-context"
 	allowZeroInDateFlag    = "allow-zero-in-date"
 	postponeCompletionFlag = "postpone-completion"
+	postponeCutOverFlag    = "postpone-cut-over"
 	allowConcurrentFlag    = "allow-concurrent"
+	revertWindowFlag       = "revert-window"
 	vreplicationTestSuite  = "vreplication-test-suite"
 )
 
@@ -95,6 +99,24 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if setting.IsPostponeCutOver() {
+		switch setting.Strategy {
+		case DDLStrategyVitess, DDLStrategyOnline:
+		default:
+			return nil, fmt.Errorf("-%s is only supported by the %s and %s strategies, found: '%v'", postponeCutOverFlag, DDLStrategyVitess, DDLStrategyOnline, setting.Strategy)
+		}
+	}
+	revertWindow, err := setting.RevertWindow()
+	if err != nil {
+		return nil, err
+	}
+	if revertWindow > 0 {
+		switch setting.Strategy {
+		case DDLStrategyVitess, DDLStrategyOnline:
+		default:
+			return nil, fmt.Errorf("-%s is only supported by the %s and %s strategies, found: '%v'", revertWindowFlag, DDLStrategyVitess, DDLStrategyOnline, setting.Strategy)
+		}
+	}
 	return setting, nil
 }
 
@@ -109,6 +131,17 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// isFlagWithValue returns the value of the given string if it is a CLI flag of the
+// given name with a value, e.g. -name=value
+func isFlagWithValue(s string, name string) (value string, ok bool) {
+	for _, prefix := range []string{fmt.Sprintf("-%s=", name), fmt.Sprintf("--%s=", name)} {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimPrefix(s, prefix), true
+		}
+	}
+	return "", false
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	opts, _ := shlex.Split(setting.Options)
@@ -145,11 +178,34 @@ func (setting *DDLStrategySetting) IsPostponeCompletion() bool {
 	return setting.hasFlag(postponeCompletionFlag)
 }
 
+// IsPostponeCutOver checks if strategy options include -postpone-cut-over
+func (setting *DDLStrategySetting) IsPostponeCutOver() bool {
+	return setting.hasFlag(postponeCutOverFlag)
+}
+
 // IsAllowConcurrent checks if strategy options include -allow-concurrent
 func (setting *DDLStrategySetting) IsAllowConcurrent() bool {
 	return setting.hasFlag(allowConcurrentFlag)
 }
 
+// RevertWindow returns the duration of -revert-window, or 0 if strategy options do not
+// include it
+func (setting *DDLStrategySetting) RevertWindow() (time.Duration, error) {
+	opts, _ := shlex.Split(setting.Options)
+	for _, opt := range opts {
+		value, ok := isFlagWithValue(opt, revertWindowFlag)
+		if !ok {
+			continue
+		}
+		window, err := time.ParseDuration(value)
+		if err != nil || window <= 0 {
+			return 0, fmt.Errorf("invalid value for -%s: '%s', expected a positive duration", revertWindowFlag, value)
+		}
+		return window, nil
+	}
+	return 0, nil
+}
+
 // IsVreplicationTestSuite checks if strategy options include -vreplicatoin-test-suite
 func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
@@ -173,9 +229,13 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, singletonContextFlag):
 		case isFlag(opt, allowZeroInDateFlag):
 		case isFlag(opt, postponeCompletionFlag):
+		case isFlag(opt, postponeCutOverFlag):
 		case isFlag(opt, allowConcurrentFlag):
 		case isFlag(opt, vreplicationTestSuite):
 		default:
+			if _, ok := isFlagWithValue(opt, revertWindowFlag); ok {
+				continue
+			}
 			validOpts = append(validOpts, opt)
 		}
 	}
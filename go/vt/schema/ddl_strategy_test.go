@@ -19,6 +19,7 @@ package schema
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -45,7 +46,9 @@ func TestParseDDLStrategy(t *testing.T) {
 		isDeclarative        bool
 		isSingleton          bool
 		isPostponeCompletion bool
+		isPostponeCutOver    bool
 		isAllowConcurrent    bool
+		revertWindow         time.Duration
 		runtimeOptions       string
 		err                  error
 	}{
@@ -107,6 +110,13 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:       "",
 			isPostponeCompletion: true,
 		},
+		{
+			strategyVariable:  "vitess --postpone-cut-over",
+			strategy:          DDLStrategyVitess,
+			options:           "--postpone-cut-over",
+			runtimeOptions:    "",
+			isPostponeCutOver: true,
+		},
 		{
 			strategyVariable:  "online -allow-concurrent",
 			strategy:          DDLStrategyOnline,
@@ -121,6 +131,14 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:    "",
 			isAllowConcurrent: true,
 		},
+		{
+			strategyVariable:  "vitess --revert-window=1h -allow-concurrent",
+			strategy:          DDLStrategyVitess,
+			options:           "--revert-window=1h -allow-concurrent",
+			runtimeOptions:    "",
+			revertWindow:      time.Hour,
+			isAllowConcurrent: true,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -130,7 +148,11 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.isDeclarative, setting.IsDeclarative())
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
 		assert.Equal(t, ts.isPostponeCompletion, setting.IsPostponeCompletion())
+		assert.Equal(t, ts.isPostponeCutOver, setting.IsPostponeCutOver())
 		assert.Equal(t, ts.isAllowConcurrent, setting.IsAllowConcurrent())
+		revertWindow, err := setting.RevertWindow()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.revertWindow, revertWindow)
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -139,4 +161,20 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("gh-ost -postpone-cut-over")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("gh-ost -revert-window=1h")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess -revert-window=soon")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess -revert-window=0s")
+		assert.Error(t, err)
+	}
 }
@@ -416,6 +416,20 @@ func (onlineDDL *OnlineDDL) IsView() bool {
 	return false
 }
 
+// IsPostponeCompletion returns 'true' when the migration must not complete until the user completes it: with
+// -postpone-completion, or with -postpone-cut-over for the ALTERs of tables, which are the migrations that cut over.
+func (onlineDDL *OnlineDDL) IsPostponeCompletion() bool {
+	setting := onlineDDL.StrategySetting()
+	if setting.IsPostponeCompletion() {
+		return true
+	}
+	if !setting.IsPostponeCutOver() || onlineDDL.IsView() {
+		return false
+	}
+	action, err := onlineDDL.GetAction()
+	return err == nil && action == sqlparser.AlterDDLAction
+}
+
 // GetActionStr returns a string representation of the DDL action
 func (onlineDDL *OnlineDDL) GetActionStr() (action sqlparser.DDLAction, actionStr string, err error) {
 	action, err = onlineDDL.GetAction()
@@ -99,6 +99,51 @@ func TestGetActionStr(t *testing.T) {
 	}
 }
 
+func TestIsPostponeCompletion(t *testing.T) {
+	tt := []struct {
+		statement          string
+		options            string
+		postponeCompletion bool
+	}{
+		{
+			statement: "alter table t drop column c",
+		},
+		{
+			statement:          "alter table t drop column c",
+			options:            "-postpone-completion",
+			postponeCompletion: true,
+		},
+		{
+			statement:          "create table t (id int primary key)",
+			options:            "-postpone-completion",
+			postponeCompletion: true,
+		},
+		{
+			statement:          "alter table t drop column c",
+			options:            "-postpone-cut-over",
+			postponeCompletion: true,
+		},
+		{
+			statement: "create table t (id int primary key)",
+			options:   "-postpone-cut-over",
+		},
+		{
+			statement: "drop table t",
+			options:   "-postpone-cut-over",
+		},
+		{
+			statement: "alter view v as select * from t",
+			options:   "-postpone-cut-over",
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.statement+" "+ts.options, func(t *testing.T) {
+			onlineDDL := &OnlineDDL{SQL: ts.statement, Strategy: DDLStrategyVitess, Options: ts.options}
+			assert.Equal(t, ts.postponeCompletion, onlineDDL.IsPostponeCompletion())
+		})
+	}
+}
+
 func TestIsOnlineDDLTableName(t *testing.T) {
 	names := []string{
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho",
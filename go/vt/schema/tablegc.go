@@ -127,3 +127,97 @@ func ParseGCLifecycle(gcLifecycle string) (states map[TableGCState]bool, err err
 	states[DropTableGCState] = true
 	return states, nil
 }
+
+// The settings of the table GC configuration of a keyspace. A setting
+// applies to all the tables of the keyspace, unless a table overrides it.
+const (
+	// TableGCLifecycleSetting is the lifecycle of the dropped tables, in the
+	// format of -table_gc_lifecycle.
+	TableGCLifecycleSetting = "lifecycle"
+	// TableGCHoldSetting is how long the dropped tables are held, like
+	// -retain_online_ddl_tables.
+	TableGCHoldSetting = "hold"
+	// TableGCEvacSetting is how long the dropped tables stay in EVAC state.
+	TableGCEvacSetting = "evac"
+)
+
+// TableGCConfig is the table GC configuration of a keyspace, stored in the
+// topo, which overrides the flags of its vttablets. It maps the settings of
+// the keyspace, and the ones of its tables, keyed by TableGCConfigKey, to
+// their values.
+type TableGCConfig map[string]string
+
+// TableGCConfigKey returns the key of a setting in a TableGCConfig: the
+// setting for table, or for the keyspace if table is empty.
+func TableGCConfigKey(table, setting string) string {
+	if table == "" {
+		return setting
+	}
+	return table + "/" + setting
+}
+
+// ValidateTableGCSetting returns an error if value is not a valid value for
+// the setting.
+func ValidateTableGCSetting(setting, value string) error {
+	switch setting {
+	case TableGCLifecycleSetting:
+		_, err := ParseGCLifecycle(value)
+		return err
+	case TableGCHoldSetting, TableGCEvacSetting:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		if d < 0 {
+			return fmt.Errorf("%s must not be negative: %s", setting, value)
+		}
+		return nil
+	}
+	return fmt.Errorf("Unknown table GC setting: %s", setting)
+}
+
+// HasTableSettings answers 'true' when a table overrides a setting of the
+// keyspace.
+func (c TableGCConfig) HasTableSettings() bool {
+	for key := range c {
+		if strings.Contains(key, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the value of a setting for table: its own, or else the one of
+// the keyspace.
+func (c TableGCConfig) get(table, setting string) (string, bool) {
+	if table != "" {
+		if value, ok := c[TableGCConfigKey(table, setting)]; ok {
+			return value, true
+		}
+	}
+	value, ok := c[setting]
+	return value, ok
+}
+
+// Lifecycle returns the states of the GC lifecycle of table, which are the
+// ones of defaultLifecycle if neither the table nor the keyspace set them.
+func (c TableGCConfig) Lifecycle(table, defaultLifecycle string) (map[TableGCState]bool, error) {
+	lifecycle, ok := c.get(table, TableGCLifecycleSetting)
+	if !ok {
+		lifecycle = defaultLifecycle
+	}
+	return ParseGCLifecycle(lifecycle)
+}
+
+// Duration returns the value of a duration setting for table, which is
+// defaultDuration if neither the table nor the keyspace set it.
+func (c TableGCConfig) Duration(table, setting string, defaultDuration time.Duration) (time.Duration, error) {
+	value, ok := c.get(table, setting)
+	if !ok {
+		return defaultDuration, nil
+	}
+	if err := ValidateTableGCSetting(setting, value); err != nil {
+		return defaultDuration, err
+	}
+	return time.ParseDuration(value)
+}
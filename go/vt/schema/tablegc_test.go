@@ -166,3 +166,43 @@ func TestGenerateRenameStatementWithUUID(t *testing.T) {
 	}
 	assert.Equal(t, countIterations, len(toTableNames))
 }
+
+func TestTableGCConfig(t *testing.T) {
+	config := TableGCConfig{
+		TableGCLifecycleSetting:                           "hold,purge,drop",
+		TableGCHoldSetting:                                "24h",
+		TableGCConfigKey("big", TableGCHoldSetting):       "168h",
+		TableGCConfigKey("tiny", TableGCLifecycleSetting): "drop",
+		TableGCConfigKey("bad", TableGCEvacSetting):       "soon",
+	}
+	assert.True(t, config.HasTableSettings())
+	assert.False(t, TableGCConfig{TableGCHoldSetting: "1h"}.HasTableSettings())
+
+	hold, err := config.Duration("big", TableGCHoldSetting, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 168*time.Hour, hold)
+	hold, err = config.Duration("other", TableGCHoldSetting, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, hold)
+	evac, err := config.Duration("", TableGCEvacSetting, 72*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 72*time.Hour, evac)
+	evac, err = config.Duration("bad", TableGCEvacSetting, 72*time.Hour)
+	assert.Error(t, err)
+	assert.Equal(t, 72*time.Hour, evac)
+
+	states, err := config.Lifecycle("tiny", "hold,purge,evac,drop")
+	require.NoError(t, err)
+	assert.Equal(t, map[TableGCState]bool{DropTableGCState: true}, states)
+	states, err = config.Lifecycle("big", "hold,purge,evac,drop")
+	require.NoError(t, err)
+	assert.Equal(t, map[TableGCState]bool{HoldTableGCState: true, PurgeTableGCState: true, DropTableGCState: true}, states)
+	states, err = TableGCConfig{}.Lifecycle("big", "hold,drop")
+	require.NoError(t, err)
+	assert.Equal(t, map[TableGCState]bool{HoldTableGCState: true, DropTableGCState: true}, states)
+
+	assert.NoError(t, ValidateTableGCSetting(TableGCEvacSetting, "0s"))
+	assert.Error(t, ValidateTableGCSetting(TableGCEvacSetting, "-1h"))
+	assert.Error(t, ValidateTableGCSetting(TableGCLifecycleSetting, "hold,other"))
+	assert.Error(t, ValidateTableGCSetting("purge", "1h"))
+}
@@ -0,0 +1,482 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Schema is a set of tables and views, as their CREATE statements.
+type Schema struct {
+	tables map[string]*CreateTableEntity
+	views  map[string]*CreateViewEntity
+}
+
+// NewSchemaFromSQL creates a Schema from a script of `CREATE TABLE` and `CREATE VIEW`
+// statements, separated by semicolons.
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	pieces, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchemaFromQueries(pieces)
+}
+
+// NewSchemaFromQueries creates a Schema from `CREATE TABLE` and `CREATE VIEW` queries.
+// The empty queries are ignored.
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	statements := make([]sqlparser.Statement, 0, len(queries))
+	for _, query := range queries {
+		if strings.TrimSpace(query) == "" {
+			continue
+		}
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	return NewSchemaFromStatements(statements)
+}
+
+// NewSchemaFromStatements creates a Schema from `CREATE TABLE` and `CREATE VIEW` statements.
+// Any other statement is an error, and so is a name that is used twice. The tables are
+// normalized, so that a table written by hand compares equal to the same table as MySQL
+// shows it.
+func NewSchemaFromStatements(statements []sqlparser.Statement) (*Schema, error) {
+	s := &Schema{
+		tables: make(map[string]*CreateTableEntity),
+		views:  make(map[string]*CreateViewEntity),
+	}
+	for _, stmt := range statements {
+		switch stmt := stmt.(type) {
+		case *sqlparser.CreateTable:
+			if !stmt.IsFullyParsed() {
+				return nil, fmt.Errorf("%w: %s", ErrNotFullyParsed, sqlparser.String(stmt))
+			}
+			if stmt.TableSpec == nil {
+				return nil, fmt.Errorf("%w: %s", ErrUnexpectedTableSpec, sqlparser.String(stmt))
+			}
+			name := stmt.Table.Name.String()
+			if err := s.checkNewName(name); err != nil {
+				return nil, err
+			}
+			s.tables[name] = NewCreateTableEntity(normalizeCreateTable(stmt))
+		case *sqlparser.CreateView:
+			if !stmt.IsFullyParsed() {
+				return nil, fmt.Errorf("%w: %s", ErrNotFullyParsed, sqlparser.String(stmt))
+			}
+			name := stmt.ViewName.Name.String()
+			if err := s.checkNewName(name); err != nil {
+				return nil, err
+			}
+			s.views[name] = NewCreateViewEntity(stmt)
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(stmt))
+		}
+	}
+	return s, nil
+}
+
+func (s *Schema) checkNewName(name string) error {
+	_, isTable := s.tables[name]
+	_, isView := s.views[name]
+	if isTable || isView {
+		return fmt.Errorf("%w: %s", ErrDuplicateName, name)
+	}
+	return nil
+}
+
+// Tables returns the sorted names of the tables of the schema.
+func (s *Schema) Tables() []string {
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Views returns the sorted names of the views of the schema.
+func (s *Schema) Views() []string {
+	names := make([]string, 0, len(s.views))
+	for name := range s.views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SchemaDiff is the change of one table or view, in the diff of two schemas.
+type SchemaDiff struct {
+	// Name is the name of the table or view that the change creates, alters or drops.
+	Name string
+	Diff EntityDiff
+	// Warnings explain how the change loses data, if it does.
+	Warnings []string
+}
+
+// IsDestructive returns true if the change loses data, or drops a view.
+func (d *SchemaDiff) IsDestructive() bool {
+	return len(d.Warnings) > 0
+}
+
+// Diff returns the changes that turn this schema into the other schema, in an order that
+// they can be applied in: the views are dropped first, since they may select from the
+// tables that change, then the tables are created, altered and dropped, and last the
+// views are created and altered. Within each step, the changes are sorted by name.
+func (s *Schema) Diff(other *Schema, hints *DiffHints) ([]*SchemaDiff, error) {
+	var dropViews, createTables, alterTables, dropTables, createViews, alterViews []*SchemaDiff
+
+	for _, name := range s.Views() {
+		if _, ok := other.views[name]; !ok {
+			d, err := DiffViews(&s.views[name].CreateView, nil, hints)
+			if err != nil {
+				return nil, err
+			}
+			dropViews = append(dropViews, &SchemaDiff{
+				Name:     name,
+				Diff:     d,
+				Warnings: []string{fmt.Sprintf("drops view %s", name)},
+			})
+		}
+	}
+	for _, name := range other.Tables() {
+		to := other.tables[name]
+		from, ok := s.tables[name]
+		if !ok {
+			d, err := DiffTables(nil, &to.CreateTable, hints)
+			if err != nil {
+				return nil, err
+			}
+			createTables = append(createTables, &SchemaDiff{Name: name, Diff: d})
+			continue
+		}
+		d, err := from.TableDiff(to, hints)
+		if err != nil {
+			return nil, err
+		}
+		if d.IsEmpty() {
+			continue
+		}
+		alterTables = append(alterTables, &SchemaDiff{
+			Name:     name,
+			Diff:     d,
+			Warnings: alterTableWarnings(&from.CreateTable, d.alterTable),
+		})
+	}
+	for _, name := range s.Tables() {
+		if _, ok := other.tables[name]; !ok {
+			d, err := DiffTables(&s.tables[name].CreateTable, nil, hints)
+			if err != nil {
+				return nil, err
+			}
+			dropTables = append(dropTables, &SchemaDiff{
+				Name:     name,
+				Diff:     d,
+				Warnings: []string{fmt.Sprintf("drops table %s and all its rows", name)},
+			})
+		}
+	}
+	for _, name := range other.Views() {
+		to := other.views[name]
+		from, ok := s.views[name]
+		if !ok {
+			d, err := DiffViews(nil, &to.CreateView, hints)
+			if err != nil {
+				return nil, err
+			}
+			createViews = append(createViews, &SchemaDiff{Name: name, Diff: d})
+			continue
+		}
+		d, err := from.ViewDiff(to, hints)
+		if err != nil {
+			return nil, err
+		}
+		if d.IsEmpty() {
+			continue
+		}
+		alterViews = append(alterViews, &SchemaDiff{Name: name, Diff: d})
+	}
+
+	var diffs []*SchemaDiff
+	for _, step := range [][]*SchemaDiff{dropViews, createTables, alterTables, dropTables, createViews, alterViews} {
+		diffs = append(diffs, step...)
+	}
+	return diffs, nil
+}
+
+// alterTableWarnings returns how an ALTER TABLE of a table loses data: the dropped columns,
+// the columns whose type changes to one that may not hold all their values, and the added
+// unique keys, which an online migration enforces by dropping the duplicate rows.
+func alterTableWarnings(from *sqlparser.CreateTable, alterTable *sqlparser.AlterTable) (warnings []string) {
+	columns := make(map[string]*sqlparser.ColumnDefinition)
+	for _, col := range from.TableSpec.Columns {
+		columns[col.Name.Lowered()] = col
+	}
+	for _, option := range alterTable.AlterOptions {
+		switch option := option.(type) {
+		case *sqlparser.DropColumn:
+			warnings = append(warnings, fmt.Sprintf("drops column %s of table %s", option.Name.Name.String(), from.Table.Name.String()))
+		case *sqlparser.AddIndexDefinition:
+			if option.IndexDefinition.Info.Unique {
+				warnings = append(warnings, fmt.Sprintf("adds unique key %s to table %s, which loses the rows whose values it duplicates",
+					option.IndexDefinition.Info.Name.String(), from.Table.Name.String()))
+			}
+		case *sqlparser.ModifyColumn:
+			col, ok := columns[option.NewColDefinition.Name.Lowered()]
+			if !ok || isWideningColumnType(col.Type, option.NewColDefinition.Type) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("changes column %s of table %s from %s to %s, which may truncate or convert its values",
+				col.Name.String(), from.Table.Name.String(), columnDataType(col.Type), columnDataType(option.NewColDefinition.Type)))
+		}
+	}
+	return warnings
+}
+
+// columnDataType returns the type of a column, without the options such as the
+// nullability and the default value, which don't change the values the column holds.
+func columnDataType(ct sqlparser.ColumnType) string {
+	ct.Options = &sqlparser.ColumnTypeOptions{}
+	return sqlparser.String(&ct)
+}
+
+// columnTypeFamilies are the types that hold all the values of the types that come
+// before them in their family.
+var columnTypeFamilies = [][]string{
+	{"tinyint", "smallint", "mediumint", "int", "integer", "bigint"},
+	{"tinytext", "text", "mediumtext", "longtext"},
+	{"tinyblob", "blob", "mediumblob", "longblob"},
+	{"float", "double"},
+}
+
+func columnTypeRank(typ string) (family int, rank int) {
+	for i, types := range columnTypeFamilies {
+		for j, t := range types {
+			if t == typ {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+// isWideningColumnType returns true if the type to holds all the values of the type from,
+// e.g. from INT to BIGINT, or from VARCHAR(32) to VARCHAR(64).
+func isWideningColumnType(from, to sqlparser.ColumnType) bool {
+	if columnDataType(from) == columnDataType(to) {
+		return true
+	}
+	fromType, toType := strings.ToLower(from.Type), strings.ToLower(to.Type)
+	if from.Unsigned != to.Unsigned || !strings.EqualFold(from.Charset, to.Charset) {
+		return false
+	}
+	if fromFamily, fromRank := columnTypeRank(fromType); fromFamily >= 0 {
+		toFamily, toRank := columnTypeRank(toType)
+		if fromFamily != toFamily || toRank < fromRank {
+			return false
+		}
+		// The display width of the integers does not limit their values.
+		return fromFamily == 0 || fromType != toType || literalInt(to.Length) >= literalInt(from.Length)
+	}
+	if fromType != toType {
+		return false
+	}
+	switch fromType {
+	case "enum", "set":
+		// The values can only be added at the end.
+		if len(to.EnumValues) < len(from.EnumValues) {
+			return false
+		}
+		for i, value := range from.EnumValues {
+			if to.EnumValues[i] != value {
+				return false
+			}
+		}
+		return true
+	case "decimal", "numeric":
+		fromScale, toScale := literalInt(from.Scale), literalInt(to.Scale)
+		return toScale >= fromScale && literalInt(to.Length)-toScale >= literalInt(from.Length)-fromScale
+	case "char", "varchar", "binary", "varbinary", "bit":
+		return literalInt(to.Length) >= literalInt(from.Length) && literalInt(to.Scale) == literalInt(from.Scale)
+	}
+	return false
+}
+
+func literalInt(l *sqlparser.Literal) int {
+	if l == nil {
+		return 0
+	}
+	i, _ := strconv.Atoi(l.Val)
+	return i
+}
+
+// normalizeCreateTable returns a copy of the table in the form that MySQL shows it in:
+//   - the column-level PRIMARY KEY and UNIQUE are keys of the table, and the unnamed keys
+//     are named after their first column;
+//   - the integer types have no display width, unless they are ZEROFILL;
+//   - the nullable columns have no explicit NULL, nor DEFAULT NULL;
+//   - the charsets and collations are lowercase, utf8mb3 is utf8, and the charset and
+//     collation of a column are omitted if they are the ones of the table, as is the
+//     collation of the table if it's the default of its charset.
+func normalizeCreateTable(stmt *sqlparser.CreateTable) *sqlparser.CreateTable {
+	stmt = sqlparser.CloneRefOfCreateTable(stmt)
+	spec := stmt.TableSpec
+
+	var tableCharset, tableCollation string
+	options := spec.Options[:0]
+	for _, option := range spec.Options {
+		option.Name = strings.ToUpper(option.Name)
+		switch option.Name {
+		case "CHARSET":
+			option.String = normalizeCharset(option.String)
+			tableCharset = option.String
+		case "COLLATE":
+			option.String = normalizeCollation(option.String)
+			tableCollation = option.String
+		}
+		options = append(options, option)
+	}
+	spec.Options = options
+	if tableCollation != "" && tableCollation == defaultCollation(tableCharset) {
+		options := spec.Options[:0]
+		for _, option := range spec.Options {
+			if option.Name != "COLLATE" {
+				options = append(options, option)
+			}
+		}
+		spec.Options = options
+	}
+	if tableCollation == "" {
+		tableCollation = defaultCollation(tableCharset)
+	}
+
+	var primary, keys []*sqlparser.IndexDefinition
+	for _, col := range spec.Columns {
+		normalizeColumn(col, tableCharset, tableCollation)
+		keyOpt := col.Type.Options.KeyOpt
+		switch {
+		case keyOpt.IsPrimary():
+			primary = append(primary, &sqlparser.IndexDefinition{
+				Info:    &sqlparser.IndexInfo{Type: "primary key", Name: sqlparser.NewColIdent("PRIMARY"), Primary: true, Unique: true},
+				Columns: []*sqlparser.IndexColumn{{Column: col.Name}},
+			})
+		case keyOpt.IsUnique():
+			keys = append(keys, &sqlparser.IndexDefinition{
+				Info:    &sqlparser.IndexInfo{Type: "unique key", Unique: true},
+				Columns: []*sqlparser.IndexColumn{{Column: col.Name}},
+			})
+		default:
+			continue
+		}
+		col.Type.Options.KeyOpt = 0
+	}
+	spec.Indexes = append(append(primary, spec.Indexes...), keys...)
+
+	names := make(map[string]bool)
+	for _, key := range spec.Indexes {
+		key.Info.Type = strings.Replace(strings.ToLower(key.Info.Type), "index", "key", 1)
+		if key.Info.Type == "unique" {
+			key.Info.Type = "unique key"
+		}
+		names[key.Info.Name.Lowered()] = true
+	}
+	for _, key := range spec.Indexes {
+		if !key.Info.Name.IsEmpty() || key.Info.Primary || len(key.Columns) == 0 {
+			continue
+		}
+		name := key.Columns[0].Column.String()
+		for i := 2; names[strings.ToLower(name)]; i++ {
+			name = fmt.Sprintf("%s_%d", key.Columns[0].Column.String(), i)
+		}
+		key.Info.Name = sqlparser.NewColIdent(name)
+		names[strings.ToLower(name)] = true
+	}
+	return stmt
+}
+
+func normalizeColumn(col *sqlparser.ColumnDefinition, tableCharset, tableCollation string) {
+	ct := &col.Type
+	ct.Type = strings.ToLower(ct.Type)
+	if ct.Type == "integer" {
+		ct.Type = "int"
+	}
+	if family, _ := columnTypeRank(ct.Type); family == 0 && !ct.Zerofill {
+		ct.Length = nil
+	}
+
+	nullable := ct.Options.Null == nil || *ct.Options.Null
+	if nullable && ct.Type != "timestamp" {
+		ct.Options.Null = nil
+		if _, isNull := ct.Options.Default.(*sqlparser.NullVal); isNull {
+			ct.Options.Default = nil
+		}
+	}
+
+	if ct.Charset != "" {
+		ct.Charset = normalizeCharset(ct.Charset)
+	}
+	if ct.Options.Collate != "" {
+		ct.Options.Collate = normalizeCollation(ct.Options.Collate)
+	}
+	collation := tableCollation
+	if ct.Charset != "" && ct.Charset != tableCharset {
+		collation = defaultCollation(ct.Charset)
+	} else {
+		ct.Charset = ""
+	}
+	if ct.Options.Collate == collation {
+		ct.Options.Collate = ""
+	}
+}
+
+// normalizeCharset returns the lowercase name of the charset, with utf8 for utf8mb3.
+func normalizeCharset(charset string) string {
+	charset = strings.ToLower(charset)
+	if charset == "utf8mb3" {
+		return "utf8"
+	}
+	return charset
+}
+
+// normalizeCollation returns the lowercase name of the collation, with the utf8 prefix
+// for the utf8mb3 collations.
+func normalizeCollation(collation string) string {
+	collation = strings.ToLower(collation)
+	if strings.HasPrefix(collation, "utf8mb3_") {
+		return "utf8_" + strings.TrimPrefix(collation, "utf8mb3_")
+	}
+	return collation
+}
+
+// defaultCollation returns the default collation of the charset, or "" if it's unknown.
+func defaultCollation(charset string) string {
+	if charset == "" {
+		return ""
+	}
+	if coll := collations.Local().DefaultCollationForCharset(charset); coll != nil {
+		return coll.Name()
+	}
+	return ""
+}
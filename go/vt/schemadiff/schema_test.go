@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSchemaFromSQL(t *testing.T) {
+	s, err := NewSchemaFromSQL(`
+		create table t2 (id int primary key);
+		create view v1 as select id from t2;
+		create table t1 (id int primary key);
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2"}, s.Tables())
+	assert.Equal(t, []string{"v1"}, s.Views())
+
+	tt := []struct {
+		name string
+		sql  string
+		err  error
+	}{
+		{
+			name: "not a create",
+			sql:  "create table t1 (id int primary key); insert into t1 values (1)",
+			err:  ErrUnsupportedStatement,
+		},
+		{
+			name: "duplicate table",
+			sql:  "create table t1 (id int primary key); create table t1 (id bigint primary key)",
+			err:  ErrDuplicateName,
+		},
+		{
+			name: "view and table",
+			sql:  "create table t1 (id int primary key); create view t1 as select 1 from dual",
+			err:  ErrDuplicateName,
+		},
+		{
+			name: "create like",
+			sql:  "create table t2 like t1",
+			err:  ErrUnexpectedTableSpec,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			_, err := NewSchemaFromSQL(ts.sql)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ts.err), "%v", err)
+		})
+	}
+}
+
+func TestSchemaDiff(t *testing.T) {
+	from, err := NewSchemaFromSQL(`
+		create table t1 (id int primary key, name varchar(32), note varchar(64), amount decimal(10,2));
+		create table t2 (id int primary key);
+		create table t3 (id int primary key, n int);
+		create view v1 as select id from t2;
+		create view v2 as select id from t1;
+	`)
+	require.NoError(t, err)
+	to, err := NewSchemaFromSQL(`
+		create table t1 (id bigint primary key, name varchar(16), amount decimal(12,2), created timestamp);
+		create table t3 (id int primary key, n int);
+		create table t4 (id int primary key);
+		create view v2 as select id, name from t1;
+		create view v3 as select id from t4;
+	`)
+	require.NoError(t, err)
+
+	diffs, err := from.Diff(to, &DiffHints{})
+	require.NoError(t, err)
+	type change struct {
+		name      string
+		statement string
+		warnings  []string
+	}
+	var changes []change
+	for _, d := range diffs {
+		changes = append(changes, change{d.Name, d.Diff.StatementString(), d.Warnings})
+	}
+	assert.Equal(t, []change{
+		{"v1", "drop view v1", []string{"drops view v1"}},
+		{"t4", "create table t4 (\n\tid int,\n\tprimary key (id)\n)", nil},
+		{"t1", "alter table t1 drop column note, modify column id bigint, modify column `name` varchar(16), modify column amount decimal(12,2), add column created timestamp", []string{
+			"drops column note of table t1",
+			"changes column name of table t1 from varchar(32) to varchar(16), which may truncate or convert its values",
+		}},
+		{"t2", "drop table t2", []string{"drops table t2 and all its rows"}},
+		{"v3", "create view v3 as select id from t4", nil},
+		{"v2", "alter view v2 as select id, `name` from t1", nil},
+	}, changes)
+	assert.True(t, diffs[0].IsDestructive())
+	assert.False(t, diffs[1].IsDestructive())
+
+	// the same schema has no diff
+	diffs, err = to.Diff(to, &DiffHints{})
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestSchemaDiffNormalized(t *testing.T) {
+	// The table as MySQL shows it.
+	from, err := NewSchemaFromSQL("CREATE TABLE `customer` (\n" +
+		"  `id` bigint NOT NULL,\n" +
+		"  `age` int DEFAULT NULL,\n" +
+		"  `email` varchar(128) CHARACTER SET utf8mb3 COLLATE utf8mb3_bin DEFAULT NULL,\n" +
+		"  `name` varchar(64) DEFAULT NULL,\n" +
+		"  `code` int(4) unsigned zerofill NOT NULL,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `name` (`name`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci")
+	require.NoError(t, err)
+	// The same table, as written by hand.
+	to, err := NewSchemaFromSQL(`create table customer (
+		id bigint(20) not null primary key,
+		age integer(11) null default null,
+		email varchar(128) character set utf8 collate utf8_bin,
+		name varchar(64) unique,
+		code int(4) unsigned zerofill not null
+	) engine InnoDB default charset utf8mb4`)
+	require.NoError(t, err)
+	diffs, err := from.Diff(to, &DiffHints{})
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	// Adding a unique key loses the duplicate rows.
+	to, err = NewSchemaFromSQL(`create table customer (
+		id bigint not null primary key,
+		age int,
+		email varchar(128) character set utf8 collate utf8_bin unique key,
+		name varchar(64) unique,
+		code int(4) unsigned zerofill not null
+	) engine InnoDB default charset utf8mb4`)
+	require.NoError(t, err)
+	diffs, err = from.Diff(to, &DiffHints{})
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "alter table customer add unique key email (email)", diffs[0].Diff.StatementString())
+	assert.Equal(t, []string{"adds unique key email to table customer, which loses the rows whose values it duplicates"}, diffs[0].Warnings)
+}
+
+func TestIsWideningColumnType(t *testing.T) {
+	tt := []struct {
+		from     string
+		to       string
+		widening bool
+	}{
+		{"int", "int not null default 0", true},
+		{"int", "bigint", true},
+		{"int(11)", "int(4)", true},
+		{"bigint", "int", false},
+		{"int", "int unsigned", false},
+		{"varchar(32)", "varchar(64)", true},
+		{"varchar(32)", "varchar(16)", false},
+		{"varchar(32)", "text", false},
+		{"text", "mediumtext", true},
+		{"longblob", "blob", false},
+		{"decimal(10,2)", "decimal(12,2)", true},
+		{"decimal(10,2)", "decimal(10,3)", false},
+		{"decimal(10,2)", "decimal(11,3)", true},
+		{"enum('a','b')", "enum('a','b','c')", true},
+		{"enum('a','b')", "enum('b','a')", false},
+		{"varchar(32) character set utf8mb4", "varchar(32) character set latin1", false},
+		{"datetime", "timestamp", false},
+	}
+	for _, ts := range tt {
+		t.Run(ts.from+" to "+ts.to, func(t *testing.T) {
+			from, err := NewSchemaFromSQL("create table t (c " + ts.from + ")")
+			require.NoError(t, err)
+			to, err := NewSchemaFromSQL("create table t (c " + ts.to + ")")
+			require.NoError(t, err)
+			fromType := from.tables["t"].TableSpec.Columns[0].Type
+			toType := to.tables["t"].TableSpec.Columns[0].Type
+			assert.Equal(t, ts.widening, isWideningColumnType(fromType, toType))
+		})
+	}
+}
@@ -252,6 +252,9 @@ func (c *CreateTableEntity) diffOptions(alterTable *sqlparser.AlterTable,
 				// skip
 			case "AVG_ROW_LENGTH":
 				// skip. MyISAM only, not interesting
+			case "CHARSET":
+				// skip. the table keeps its charset, which is the default of the database
+				// unless it was set explicitly
 			case "CHECKSUM":
 				tableOption = &sqlparser.TableOption{Value: sqlparser.NewIntLiteral("0")}
 			case "COLLATE":
@@ -496,7 +499,7 @@ func (c *CreateTableEntity) diffKeys(alterTable *sqlparser.AlterTable,
 		if t1Key, ok := t1KeysMap[t2KeyName]; ok {
 			// key exists in both tables
 			// check diff between before/after columns:
-			if sqlparser.String(t2Key) != sqlparser.String(t1Key) {
+			if keyString(t2Key) != keyString(t1Key) {
 				// keys with same name have different definition. There is no ALTER INDEX statement,
 				// we're gonna drop and create.
 				dropKey := dropKeyStatement(t1Key.Info.Name)
@@ -516,6 +519,16 @@ func (c *CreateTableEntity) diffKeys(alterTable *sqlparser.AlterTable,
 	}
 }
 
+// keyString returns the definition of a key, regardless of the case its type is written in,
+// e.g. PRIMARY KEY or primary key.
+func keyString(key *sqlparser.IndexDefinition) string {
+	info := *key.Info
+	info.Type = strings.ToLower(info.Type)
+	k := *key
+	k.Info = &info
+	return sqlparser.String(&k)
+}
+
 // evaluateColumnReordering produces a minimal uni-directional reordering set of columns. To elaborate:
 // The function receives two sets of columns. the two must be permutations of one another. Specifically,
 // these are the columns shared between the from&to tables.
@@ -250,6 +250,11 @@ func TestCreateTableDiff(t *testing.T) {
 			from: "create table t1 (`id` int, i int, primary key(id), key i_idx(i), key i2_idx(i, `id`))",
 			to:   "create table t2 (`id` int, i int, key i2_idx (`i`, id), key i_idx ( i ), primary key(id) )",
 		},
+		{
+			name: "key case change, no diff",
+			from: "create table t1 (`id` int, i int, PRIMARY KEY (`id`), KEY `i_idx` (`i`))",
+			to:   "create table t2 (`id` int, i int, primary key(id), key i_idx(i))",
+		},
 		{
 			name: "reordered key, add key",
 			from: "create table t1 (`id` int primary key, i int, key i_idx(i), key i2_idx(i, `id`))",
@@ -425,6 +430,11 @@ func TestCreateTableDiff(t *testing.T) {
 			to:   "create table t2 (id int auto_increment primary key)",
 			diff: "alter table t1 KEY_BLOCK_SIZE 0 COMPRESSION ''",
 		},
+		{
+			name: "ignore CHARSET removal",
+			from: "create table t1 (id int primary key) engine=innodb default charset=utf8mb4",
+			to:   "create table t1 (id int primary key) engine=innodb",
+		},
 		{
 			name: "add, modify and remove table option",
 			from: "create table t1 (id int primary key) engine=innodb, charset=utf8, checksum=1",
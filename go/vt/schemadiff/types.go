@@ -32,6 +32,8 @@ var (
 	ErrNotFullyParsed                 = errors.New("unable to fully parse statement")
 	ErrExpectedCreateTable            = errors.New("expected a CREATE TABLE statement")
 	ErrExpectedCreateView             = errors.New("expected a CREATE VIEW statement")
+	ErrUnsupportedStatement           = errors.New("expected a CREATE TABLE or CREATE VIEW statement")
+	ErrDuplicateName                  = errors.New("duplicate table or view name")
 )
 
 type Entity interface {
@@ -116,6 +116,7 @@ func init() {
 		goArch:             runtime.GOARCH,
 		version:            versionName,
 	}
+	stats.NewString("Version").Set(AppVersion.version)
 	stats.NewString("BuildHost").Set(AppVersion.buildHost)
 	stats.NewString("BuildUser").Set(AppVersion.buildUser)
 	stats.NewGauge("BuildTimestamp", "build timestamp").Set(AppVersion.buildTime)
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"strings"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -34,6 +35,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 
 	"context"
@@ -196,6 +198,7 @@ func createGRPCServer() {
 // We can only set a ServerInterceptor once, so we chain multiple interceptors into one
 func interceptors() []grpc.ServerOption {
 	interceptors := &serverInterceptorBuilder{}
+	interceptors.Add(capabilitiesStreamInterceptor, capabilitiesUnaryInterceptor)
 
 	if *GRPCAuth != "" {
 		log.Infof("enabling auth plugin %v", *GRPCAuth)
@@ -299,6 +302,26 @@ func authenticatingUnaryInterceptor(ctx context.Context, req any, info *grpc.Una
 	return handler(newCtx, req)
 }
 
+// capabilitiesHeader announces the version and the capabilities of the
+// binary to the clients.
+func capabilitiesHeader() metadata.MD {
+	return metadata.Pairs(
+		grpccommon.VersionHeader, AppVersion.version,
+		grpccommon.CapabilitiesHeader, strings.Join(grpccommon.Capabilities(), ","),
+	)
+}
+
+func capabilitiesStreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	// The header is sent with the first response, so this cannot fail.
+	_ = stream.SetHeader(capabilitiesHeader())
+	return handler(srv, stream)
+}
+
+func capabilitiesUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	_ = grpc.SetHeader(ctx, capabilitiesHeader())
+	return handler(ctx, req)
+}
+
 // WrappedServerStream is based on the service stream wrapper from: https://github.com/grpc-ecosystem/go-grpc-middleware
 type WrappedServerStream struct {
 	grpc.ServerStream
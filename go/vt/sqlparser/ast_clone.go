@@ -2621,7 +2621,7 @@ func CloneSliceOfString(n []string) []string {
 		return nil
 	}
 	res := make([]string, 0, len(n))
-	copy(res, n)
+	res = append(res, n...)
 	return res
 }
 
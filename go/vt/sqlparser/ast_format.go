@@ -269,6 +269,8 @@ func (node *AlterMigration) Format(buf *TrackedBuffer) {
 		alterType = "cleanup"
 	case CompleteMigrationType:
 		alterType = "complete"
+	case CutOverMigrationType:
+		alterType = "cutover"
 	case CancelMigrationType:
 		alterType = "cancel"
 	case CancelAllMigrationType:
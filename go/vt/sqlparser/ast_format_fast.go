@@ -398,6 +398,8 @@ func (node *AlterMigration) formatFast(buf *TrackedBuffer) {
 		alterType = "cleanup"
 	case CompleteMigrationType:
 		alterType = "complete"
+	case CutOverMigrationType:
+		alterType = "cutover"
 	case CancelMigrationType:
 		alterType = "cancel"
 	case CancelAllMigrationType:
@@ -106,6 +106,17 @@ const (
 	colKey
 )
 
+// IsPrimary returns true if the option declares the column as the primary key
+// of its table, with PRIMARY KEY or KEY.
+func (opt ColumnKeyOption) IsPrimary() bool {
+	return opt == colKeyPrimary || opt == colKey
+}
+
+// IsUnique returns true if the option declares a unique key on the column.
+func (opt ColumnKeyOption) IsUnique() bool {
+	return opt == colKeyUnique || opt == colKeyUniqueKey
+}
+
 // ReferenceAction indicates the action takes by a referential constraint e.g.
 // the `CASCADE` in a `FOREIGN KEY .. ON DELETE CASCADE` table definition.
 type ReferenceAction int
@@ -1061,11 +1072,31 @@ func (lock Lock) ToString() string {
 		return ForUpdateStr
 	case ShareModeLock:
 		return ShareModeStr
+	case ForUpdateLockNoWait:
+		return ForUpdateNoWaitStr
+	case ForUpdateLockSkipLocked:
+		return ForUpdateSkipLockedStr
+	case ForShareLock:
+		return ForShareStr
+	case ForShareLockNoWait:
+		return ForShareNoWaitStr
+	case ForShareLockSkipLocked:
+		return ForShareSkipLockedStr
 	default:
 		return "Unknown lock"
 	}
 }
 
+// DoesNotWait returns true if the lock makes the query skip the rows that
+// are locked by other transactions, or fail instead of waiting for them.
+func (lock Lock) DoesNotWait() bool {
+	switch lock {
+	case ForUpdateLockNoWait, ForUpdateLockSkipLocked, ForShareLockNoWait, ForShareLockSkipLocked:
+		return true
+	}
+	return false
+}
+
 // ToString returns the string associated with WhereType
 func (whereType WhereType) ToString() string {
 	switch whereType {
@@ -218,11 +218,18 @@ func PrepareAST(
 func RewriteAST(in Statement, keyspace string, selectLimit int, setVarComment string, sysVars map[string]string) (*RewriteASTResult, error) {
 	er := newASTRewriter(keyspace, selectLimit, setVarComment, sysVars)
 	er.shouldRewriteDatabaseFunc = shouldRewriteDatabaseFunc(in)
+	switch in.(type) {
+	case *Insert, *Update:
+		er.allowLastInsertIDExpr = true
+	}
 	setRewriter := &setNormalizer{}
 	result := Rewrite(in, er.rewrite, setRewriter.rewriteSetComingUp)
 	if setRewriter.err != nil {
 		return nil, setRewriter.err
 	}
+	if er.err != nil {
+		return nil, er.err
+	}
 
 	out, ok := result.(Statement)
 	if !ok {
@@ -260,6 +267,11 @@ type astRewriter struct {
 	shouldRewriteDatabaseFunc bool
 	err                       error
 
+	// allowLastInsertIDExpr is set for the statements whose LAST_INSERT_ID(expr)
+	// is left to MySQL, which returns the value of expr as the insert id of
+	// the statement, for vtgate to record it in the session.
+	allowLastInsertIDExpr bool
+
 	// we need to know this to make a decision if we can safely rewrite JOIN USING => JOIN ON
 	hasStarInSelect bool
 
@@ -300,6 +312,9 @@ func (er *astRewriter) rewriteAliasedExpr(node *AliasedExpr) (*BindVarNeeds, err
 	inner := newASTRewriter(er.keyspace, er.selectLimit, er.setVarComment, er.sysVars)
 	inner.shouldRewriteDatabaseFunc = er.shouldRewriteDatabaseFunc
 	tmp := Rewrite(node.Expr, inner.rewrite, nil)
+	if inner.err != nil {
+		return nil, inner.err
+	}
 	newExpr, ok := tmp.(Expr)
 	if !ok {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "failed to rewrite AST. function expected to return Expr returned a %s", String(tmp))
@@ -450,17 +465,23 @@ func (er *astRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 	}
 
 	switch lowered {
-	case sysvars.Autocommit.Name,
+	case sysvars.AllowCrossCellWrites.Name,
+		sysvars.Autocommit.Name,
 		sysvars.Charset.Name,
 		sysvars.ClientFoundRows.Name,
 		sysvars.DDLStrategy.Name,
+		sysvars.IncludeReplicationLag.Name,
 		sysvars.Names.Name,
+		sysvars.QueryTags.Name,
 		sysvars.TransactionMode.Name,
 		sysvars.ReadAfterWriteGTID.Name,
 		sysvars.ReadAfterWriteTimeOut.Name,
+		sysvars.ReadYourWrites.Name,
+		sysvars.ReadAtTimestamp.Name,
 		sysvars.SessionEnableSystemSettings.Name,
 		sysvars.SessionTrackGTIDs.Name,
 		sysvars.SessionUUID.Name,
+		sysvars.SkipQueryConsolidation.Name,
 		sysvars.SkipQueryPlanCache.Name,
 		sysvars.Socket.Name,
 		sysvars.SQLSelectLimit.Name,
@@ -497,6 +518,9 @@ func (er *astRewriter) funcRewrite(cursor *Cursor, node *FuncExpr) {
 			return
 		}
 		if len(node.Exprs) > 0 {
+			if bindVar == LastInsertIDName && len(node.Exprs) == 1 && er.allowLastInsertIDExpr {
+				return
+			}
 			er.err = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "Argument to %s() not supported", node.Name.Lowered())
 			return
 		}
@@ -37,12 +37,12 @@ type testCaseSysVar struct {
 }
 
 type myTestCase struct {
-	in, expected                                                       string
-	liid, db, foundRows, rowCount, rawGTID, rawTimeout, sessTrackGTID  bool
-	ddlStrategy, sessionUUID, sessionEnableSystemSettings              bool
-	udv                                                                int
-	autocommit, clientFoundRows, skipQueryPlanCache, socket            bool
-	sqlSelectLimit, transactionMode, workload, version, versionComment bool
+	in, expected                                                          string
+	liid, db, foundRows, rowCount, rawGTID, rawTimeout, sessTrackGTID     bool
+	ddlStrategy, sessionUUID, sessionEnableSystemSettings, readYourWrites bool
+	udv                                                                   int
+	autocommit, clientFoundRows, skipQueryPlanCache, socket               bool
+	sqlSelectLimit, transactionMode, workload, version, versionComment    bool
 }
 
 func TestRewrites(in *testing.T) {
@@ -66,6 +66,10 @@ func TestRewrites(in *testing.T) {
 		in:       "SELECT last_insert_id()",
 		expected: "SELECT :__lastInsertId as `last_insert_id()`",
 		liid:     true,
+	}, {
+		in:       "UPDATE seq SET id = last_insert_id(id + 1)",
+		expected: "UPDATE seq SET id = last_insert_id(id + 1)",
+		// the argument form is left to MySQL in INSERT and UPDATE
 	}, {
 		in:       "SELECT database()",
 		expected: "SELECT :__vtdbname as `database()`",
@@ -178,9 +182,9 @@ func TestRewrites(in *testing.T) {
 		expected:    "select * from user where col = :__vtddl_strategy",
 		ddlStrategy: true,
 	}, {
-		in:       `select * from user where col = @@read_after_write_gtid OR col = @@read_after_write_timeout OR col = @@session_track_gtids`,
-		expected: "select * from user where col = :__vtread_after_write_gtid or col = :__vtread_after_write_timeout or col = :__vtsession_track_gtids",
-		rawGTID:  true, rawTimeout: true, sessTrackGTID: true,
+		in:       `select * from user where col = @@read_after_write_gtid OR col = @@read_after_write_timeout OR col = @@session_track_gtids OR col = @@read_your_writes`,
+		expected: "select * from user where col = :__vtread_after_write_gtid or col = :__vtread_after_write_timeout or col = :__vtsession_track_gtids or col = :__vtread_your_writes",
+		rawGTID:  true, rawTimeout: true, sessTrackGTID: true, readYourWrites: true,
 	}, {
 		in:       "SELECT * FROM tbl WHERE id IN (SELECT 1 FROM dual)",
 		expected: "SELECT * FROM tbl WHERE id IN (1)",
@@ -273,6 +277,7 @@ func TestRewrites(in *testing.T) {
 		rawGTID:                     true,
 		rawTimeout:                  true,
 		sessTrackGTID:               true,
+		readYourWrites:              true,
 		socket:                      true,
 	}, {
 		in:                          "SHOW GLOBAL VARIABLES",
@@ -291,6 +296,7 @@ func TestRewrites(in *testing.T) {
 		rawGTID:                     true,
 		rawTimeout:                  true,
 		sessTrackGTID:               true,
+		readYourWrites:              true,
 		socket:                      true,
 	}}
 
@@ -326,6 +332,7 @@ func TestRewrites(in *testing.T) {
 			assert.Equal(tc.rawGTID, result.NeedsSysVar(sysvars.ReadAfterWriteGTID.Name), "should need rawGTID")
 			assert.Equal(tc.rawTimeout, result.NeedsSysVar(sysvars.ReadAfterWriteTimeOut.Name), "should need rawTimeout")
 			assert.Equal(tc.sessTrackGTID, result.NeedsSysVar(sysvars.SessionTrackGTIDs.Name), "should need sessTrackGTID")
+			assert.Equal(tc.readYourWrites, result.NeedsSysVar(sysvars.ReadYourWrites.Name), "should need readYourWrites")
 			assert.Equal(tc.version, result.NeedsSysVar(sysvars.Version.Name), "should need Vitess version")
 			assert.Equal(tc.versionComment, result.NeedsSysVar(sysvars.VersionComment.Name), "should need Vitess version")
 			assert.Equal(tc.socket, result.NeedsSysVar(sysvars.Socket.Name), "should need :__vtsocket")
@@ -333,6 +340,21 @@ func TestRewrites(in *testing.T) {
 	}
 }
 
+func TestRewritesUnsupportedArgument(t *testing.T) {
+	for _, query := range []string{
+		"SELECT last_insert_id(5)",
+		"SELECT * FROM t WHERE id = last_insert_id(5)",
+		"DELETE FROM t WHERE id = last_insert_id(5)",
+		"UPDATE t SET a = found_rows(1)",
+	} {
+		stmt, err := Parse(query)
+		require.NoError(t, err)
+		_, err = RewriteAST(stmt, "ks", SQLSelectLimitUnset, "", nil)
+		require.Error(t, err, query)
+		assert.Contains(t, err.Error(), "() not supported", query)
+	}
+}
+
 func TestRewritesWithSetVarComment(in *testing.T) {
 	tests := []testCaseSetVar{{
 		in:            "select 1",
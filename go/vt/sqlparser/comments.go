@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -43,6 +44,14 @@ const (
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 	// DirectiveQueryPlanner lets the user specify per query which planner should be used
 	DirectiveQueryPlanner = "PLANNER"
+	// DirectiveMaxLag sets the maximum replication lag, e.g. 5s, of the replica that serves a SELECT.
+	DirectiveMaxLag = "MAX_LAG"
+	// DirectiveSkipResultCache makes vtgate neither read nor fill its result cache for a SELECT.
+	DirectiveSkipResultCache = "SKIP_RESULT_CACHE"
+	// DirectiveThrottle makes vtgate wait for the throttlers of the shards of a DML before executing it.
+	DirectiveThrottle = "THROTTLE"
+	// DirectiveOCCColumn names the version column that an UPDATE checks for optimistic locking.
+	DirectiveOCCColumn = "OCC_COLUMN"
 )
 
 func isNonSpace(r rune) bool {
@@ -412,3 +421,114 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// SkipResultCacheDirective returns true if the skip result cache directive
+// is set on a SELECT or a UNION.
+func SkipResultCacheDirective(stmt Statement) bool {
+	sel, ok := stmt.(SelectStatement)
+	if !ok {
+		return false
+	}
+	directives := ExtractCommentDirectives(GetFirstSelect(sel).Comments)
+	return directives.IsSet(DirectiveSkipResultCache)
+}
+
+// MaxLagDirective returns the maximum replication lag set by the MAX_LAG
+// directive of a SELECT or a UNION, or 0 if there is none. The value is
+// either a duration, like 500ms or 5s, or a number of seconds.
+func MaxLagDirective(stmt Statement) (time.Duration, error) {
+	sel, ok := stmt.(SelectStatement)
+	if !ok {
+		return 0, nil
+	}
+	directives := ExtractCommentDirectives(GetFirstSelect(sel).Comments)
+	if directives == nil {
+		return 0, nil
+	}
+	val, ok := directives[DirectiveMaxLag]
+	if !ok {
+		return 0, nil
+	}
+	if seconds, ok := val.(int); ok {
+		if seconds < 0 {
+			return 0, fmt.Errorf("invalid %s directive: %d", DirectiveMaxLag, seconds)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	maxLag, err := time.ParseDuration(directives.GetString(DirectiveMaxLag, ""))
+	if err != nil || maxLag < 0 {
+		return 0, fmt.Errorf("invalid %s directive: %v", DirectiveMaxLag, val)
+	}
+	return maxLag, nil
+}
+
+// ThrottleDirectiveDefaultApp is the app that the throttlers check for a
+// THROTTLE directive without a workload name.
+const ThrottleDirectiveDefaultApp = "vtgate"
+
+// ThrottleDirective returns the workload name of the THROTTLE directive of
+// an INSERT, UPDATE or DELETE, or "" if the statement isn't throttled. A
+// directive without a name throttles the statement as the vtgate app.
+func ThrottleDirective(stmt Statement) string {
+	var directives CommentDirectives
+	switch stmt := stmt.(type) {
+	case *Insert:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Update:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Delete:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	default:
+		return ""
+	}
+	val, ok := directives[DirectiveThrottle]
+	if !ok {
+		return ""
+	}
+	switch val.(type) {
+	case bool, int:
+		if directives.IsSet(DirectiveThrottle) {
+			return ThrottleDirectiveDefaultApp
+		}
+		return ""
+	}
+	return directives.GetString(DirectiveThrottle, "")
+}
+
+// OCCOldValueBindVar is the bind variable that holds the value that the
+// version column of an UPDATE with an OCC_COLUMN directive must still have.
+const OCCOldValueBindVar = "old"
+
+// OCCColumnDirective returns the version column named by the OCC_COLUMN
+// directive of an UPDATE, or an empty ColIdent if the statement doesn't
+// use optimistic locking.
+func OCCColumnDirective(stmt Statement) (ColIdent, error) {
+	upd, ok := stmt.(*Update)
+	if !ok {
+		return ColIdent{}, nil
+	}
+	directives := ExtractCommentDirectives(upd.Comments)
+	val, ok := directives[DirectiveOCCColumn]
+	if !ok {
+		return ColIdent{}, nil
+	}
+	if _, ok := val.(string); !ok {
+		return ColIdent{}, fmt.Errorf("invalid %s directive: %v is not a column name", DirectiveOCCColumn, val)
+	}
+	return NewColIdent(directives.GetString(DirectiveOCCColumn, "")), nil
+}
+
+// AddOCCCondition makes an UPDATE only change the rows whose version column
+// still has the value of the OCCOldValueBindVar bind variable.
+func AddOCCCondition(upd *Update, column ColIdent) {
+	cond := &ComparisonExpr{
+		Operator: EqualOp,
+		Left:     &ColName{Name: column},
+		Right:    NewArgument(OCCOldValueBindVar),
+	}
+	if upd.Where == nil {
+		upd.Where = NewWhere(WhereClause, cond)
+		return
+	}
+	upd.Where.Expr = AndExpressions(upd.Where.Expr, cond)
+}
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -479,3 +481,125 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxLagDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected time.Duration
+		err      string
+	}{
+		{"select /*vt+ MAX_LAG=5s */ * from users", 5 * time.Second, ""},
+		{"select /*vt+ MAX_LAG=500ms */ * from users", 500 * time.Millisecond, ""},
+		{"select /*vt+ MAX_LAG=10 */ * from users", 10 * time.Second, ""},
+		{"select /*vt+ MAX_LAG=5s */ * from users union select * from admins", 5 * time.Second, ""},
+		{"select * from users", 0, ""},
+		{"update /*vt+ MAX_LAG=5s */ users set name=1", 0, ""},
+		{"select /*vt+ MAX_LAG=soon */ * from users", 0, "invalid MAX_LAG directive: soon"},
+		{"select /*vt+ MAX_LAG=-1s */ * from users", 0, "invalid MAX_LAG directive: -1s"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			got, err := MaxLagDirective(stmt)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestSkipResultCacheDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"select /*vt+ SKIP_RESULT_CACHE */ * from users", true},
+		{"select /*vt+ SKIP_RESULT_CACHE */ * from users union select * from admins", true},
+		{"select * from users", false},
+		{"update /*vt+ SKIP_RESULT_CACHE */ users set name=1", false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, SkipResultCacheDirective(stmt))
+		})
+	}
+}
+
+func TestThrottleDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"insert /*vt+ THROTTLE=nightly_purge */ into user(id) values (1), (2)", "nightly_purge"},
+		{"update /*vt+ THROTTLE=backfill */ users set name=1", "backfill"},
+		{"delete /*vt+ THROTTLE */ from users", "vtgate"},
+		{"delete /*vt+ THROTTLE=1 */ from users", "vtgate"},
+		{"delete /*vt+ THROTTLE=false */ from users", ""},
+		{"delete from users", ""},
+		{"select /*vt+ THROTTLE=backfill */ * from users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ThrottleDirective(stmt))
+		})
+	}
+}
+
+func TestOCCColumnDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+		err      string
+	}{
+		{"update /*vt+ OCC_COLUMN=version */ users set name=1, version=version+1 where id=1", "version", ""},
+		{"update users set name=1 where id=1", "", ""},
+		{"delete /*vt+ OCC_COLUMN=version */ from users where id=1", "", ""},
+		{"update /*vt+ OCC_COLUMN */ users set name=1 where id=1", "", "invalid OCC_COLUMN directive: true is not a column name"},
+		{"update /*vt+ OCC_COLUMN=1 */ users set name=1 where id=1", "", "invalid OCC_COLUMN directive: 1 is not a column name"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			got, err := OCCColumnDirective(stmt)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got.String())
+		})
+	}
+}
+
+func TestAddOCCCondition(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"update users set val = 1, version = version + 1 where id = 1", "update users set val = 1, version = version + 1 where id = 1 and version = :old"},
+		{"update users set val = 1 where id = 1 or id = 2", "update users set val = 1 where (id = 1 or id = 2) and version = :old"},
+		{"update users set val = 1", "update users set val = 1 where version = :old"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			AddOCCCondition(stmt.(*Update), NewColIdent("version"))
+			assert.Equal(t, test.expected, String(stmt))
+		})
+	}
+}
@@ -182,6 +182,7 @@ var keywords = []keyword{
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"cutover", CUTOVER},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -346,6 +347,7 @@ var keywords = []keyword{
 	{"localtime", LOCALTIME},
 	{"localtimestamp", LOCALTIMESTAMP},
 	{"lock", LOCK},
+	{"locked", LOCKED},
 	{"logs", LOGS},
 	{"long", UNUSED},
 	{"longblob", LONGBLOB},
@@ -386,6 +388,7 @@ var keywords = []keyword{
 	{"no", NO},
 	{"none", NONE},
 	{"not", NOT},
+	{"nowait", NOWAIT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
 	{"nth_value", UNUSED},
 	{"ntile", UNUSED},
@@ -480,6 +483,7 @@ var keywords = []keyword{
 	{"show", SHOW},
 	{"signal", UNUSED},
 	{"signed", SIGNED},
+	{"skip", SKIP},
 	{"slow", SLOW},
 	{"smallint", SMALLINT},
 	{"spatial", SPATIAL},
@@ -38,6 +38,35 @@ func Normalize(stmt Statement, reserved *ReservedVars, bindVars map[string]*quer
 	return nz.err
 }
 
+// NormalizeFilters is like Normalize, but only changes the literals of the
+// WHERE and LIMIT clauses of the selects of stmt, including the values of
+// their IN lists. The literals of the select expressions are left alone,
+// since they name the columns of the result.
+func NormalizeFilters(stmt SelectStatement, reserved *ReservedVars, bindVars map[string]*querypb.BindVariable) error {
+	nz := newNormalizer(reserved, bindVars)
+	_ = Rewrite(stmt, func(cursor *Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *Select:
+			if node.Where != nil {
+				_ = Rewrite(node.Where, nz.WalkSelect, nil)
+			}
+			if node.Limit != nil {
+				_ = Rewrite(node.Limit, nz.WalkSelect, nil)
+			}
+		case *Union:
+			if node.Limit != nil {
+				_ = Rewrite(node.Limit, nz.WalkSelect, nil)
+			}
+		case *ColName, TableName:
+			// Common node types that never contain selects but create a lot of object
+			// allocations.
+			return false
+		}
+		return nz.err == nil
+	}, nil)
+	return nz.err
+}
+
 type normalizer struct {
 	bindVars map[string]*querypb.BindVariable
 	reserved *ReservedVars
@@ -238,3 +267,90 @@ func GetBindvars(stmt Statement) map[string]struct{} {
 	}, stmt)
 	return bindvars
 }
+
+// BucketValTuples pads the tuples of values on the right of the IN and
+// NOT IN comparisons of a normalized stmt, the ones that Normalize could
+// not turn into a list bind var, to the next power of two by repeating
+// their last value. The repeated values use new bind vars, added to
+// bindVars, so that the normalized queries that only differ by the number
+// of values of such tuples share a plan. Repeating a value doesn't change
+// the result of the comparisons. Tuples with anything else than values,
+// bind vars and tuples of those are left alone.
+// It returns the largest size that a tuple was padded to, or 0 if there
+// was no tuple to pad.
+func BucketValTuples(stmt Statement, reserved *ReservedVars, bindVars map[string]*querypb.BindVariable) int {
+	bucket := 0
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName, TableName:
+			// Common node types that never contain tuples but create a lot of object
+			// allocations.
+			return false, nil
+		case *ComparisonExpr:
+			if node.Operator != InOp && node.Operator != NotInOp {
+				return true, nil
+			}
+			tuple, ok := node.Right.(ValTuple)
+			if !ok || len(tuple) == 0 || !isValTupleOfValues(tuple) {
+				return true, nil
+			}
+			size := 1
+			for size < len(tuple) {
+				size *= 2
+			}
+			if size > len(tuple) {
+				padded := make(ValTuple, 0, size)
+				padded = append(padded, tuple...)
+				for len(padded) < size {
+					padded = append(padded, copyValue(tuple[len(tuple)-1], reserved, bindVars))
+				}
+				node.Right = padded
+			}
+			if size > bucket {
+				bucket = size
+			}
+		}
+		return true, nil
+	}, stmt)
+	return bucket
+}
+
+// isValTupleOfValues returns true if the tuple only contains values, bind
+// vars and tuples of those, which can be repeated without changing the
+// result of a query.
+func isValTupleOfValues(tuple ValTuple) bool {
+	for _, expr := range tuple {
+		switch expr := expr.(type) {
+		case *Literal, Argument, *NullVal, BoolVal:
+		case ValTuple:
+			if !isValTupleOfValues(expr) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// copyValue returns a copy of a value of a tuple, with new bind vars that
+// have the same values as its bind vars.
+func copyValue(expr Expr, reserved *ReservedVars, bindVars map[string]*querypb.BindVariable) Expr {
+	switch expr := expr.(type) {
+	case Argument:
+		bval, ok := bindVars[string(expr)]
+		if !ok {
+			return expr
+		}
+		bvname := reserved.nextUnusedVar()
+		bindVars[bvname] = bval
+		return NewArgument(bvname)
+	case ValTuple:
+		tuple := make(ValTuple, 0, len(expr))
+		for _, val := range expr {
+			tuple = append(tuple, copyValue(val, reserved, bindVars))
+		}
+		return tuple
+	}
+	return expr
+}
@@ -265,6 +265,45 @@ func TestNormalize(t *testing.T) {
 	}
 }
 
+func TestNormalizeFilters(t *testing.T) {
+	testcases := []struct {
+		in      string
+		outstmt string
+		outbv   map[string]*querypb.BindVariable
+	}{{
+		in:      "select 01, 'a' as x from t where a = 01 and b in (1, 2) limit 10",
+		outstmt: "select 01, 'a' as x from t where a = :bv1 and b in ::bv2 limit :bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Int64, Value: []byte("01")},
+			"bv2": sqltypes.TestBindVariable([]any{1, 2}),
+			"bv3": sqltypes.Int64BindVariable(10),
+		},
+	}, {
+		in:      "select a in (1, 2) from t where a = 1 union select 1 from u limit 5",
+		outstmt: "select a in (1, 2) from t where a = :bv2 union select 1 from u limit :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(5),
+			"bv2": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		in:      "select 1 from (select 2 from t where a = 'x') as d",
+		outstmt: "select 1 from (select 2 from t where a = :bv1) as d",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.StringBindVariable("x"),
+		},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			bv := make(map[string]*querypb.BindVariable)
+			require.NoError(t, NormalizeFilters(stmt.(SelectStatement), NewReservedVars("bv", nil), bv))
+			require.Equal(t, tc.outstmt, String(stmt))
+			require.Equal(t, tc.outbv, bv)
+		})
+	}
+}
+
 func TestGetBindVars(t *testing.T) {
 	stmt, err := Parse("select * from t where :v1 = :v2 and :v2 = :v3 and :v4 in ::v5")
 	if err != nil {
@@ -289,6 +328,55 @@ BenchmarkNormalize-8     1000000              2205 ns/op             821 B/op
 Prior to skip:
 BenchmarkNormalize-8      500000              3620 ns/op            1461 B/op         55 allocs/op
 */
+func TestBucketValTuples(t *testing.T) {
+	testcases := []struct {
+		in     string
+		out    string
+		bucket int
+	}{{
+		in:     "select * from t where (a, b) in ((1, 2), (3, 4), (5, 6))",
+		out:    "select * from t where (a, b) in ((:bv1, :bv2), (:bv3, :bv4), (:bv5, :bv6), (:bv7, :bv8))",
+		bucket: 4,
+	}, {
+		// the repeated values get their own bind vars
+		in:     "select * from t where a in (1, null, 3)",
+		out:    "select * from t where a in (:bv1, null, :bv2, :bv3)",
+		bucket: 4,
+	}, {
+		// a tuple that is already of the size of its bucket is left alone
+		in:     "delete from t where a not in (1, null)",
+		out:    "delete from t where a not in (:bv1, null)",
+		bucket: 2,
+	}, {
+		// the largest bucket is returned
+		in:     "select * from t where a in (1, null) and b in (1, null, 2, 3, 4)",
+		out:    "select * from t where a in (:bv1, null) and b in (:bv1, null, :bv2, :bv3, :bv4, :bv5, :bv6, :bv7)",
+		bucket: 8,
+	}, {
+		// the normalized tuples don't need padding
+		in:     "select * from t where a in (1, 2, 3)",
+		out:    "select * from t where a in ::bv1",
+		bucket: 0,
+	}, {
+		// expressions are not repeated
+		in:     "select * from t where a in (1, null, rand())",
+		out:    "select * from t where a in (:bv1, null, rand())",
+		bucket: 0,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			reserved := NewReservedVars("bv", nil)
+			bindVars := map[string]*querypb.BindVariable{}
+			err = Normalize(stmt, reserved, bindVars)
+			require.NoError(t, err)
+			require.Equal(t, tc.bucket, BucketValTuples(stmt, reserved, bindVars))
+			require.Equal(t, tc.out, String(stmt))
+		})
+	}
+}
+
 func BenchmarkNormalize(b *testing.B) {
 	sql := "select 'abcd', 20, 30.0, eid from a where 1=eid and name='3'"
 	ast, reservedVars, err := Parse2(sql)
@@ -342,6 +342,18 @@ var (
 		input: "select /* for update */ 1 from t for update",
 	}, {
 		input: "select /* lock in share mode */ 1 from t lock in share mode",
+	}, {
+		input: "select /* for update nowait */ 1 from t for update nowait",
+	}, {
+		input: "select /* for update skip locked */ 1 from t for update skip locked",
+	}, {
+		input: "select /* for share */ 1 from t for share",
+	}, {
+		input: "select /* for share nowait */ 1 from t for share nowait",
+	}, {
+		input: "select /* for share skip locked */ 1 from t for share skip locked",
+	}, {
+		input: "select /* union for update skip locked */ 1 from t union select 1 from t limit 1 for update skip locked",
 	}, {
 		input: "select /* select list */ 1, 2 from t",
 	}, {
@@ -1888,6 +1900,8 @@ var (
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cleanup",
 	}, {
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' complete",
+	}, {
+		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cutover",
 	}, {
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cancel",
 	}, {
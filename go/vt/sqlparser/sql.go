@@ -311,283 +311,284 @@ const CANCEL = 57608
 const RETRY = 57609
 const COMPLETE = 57610
 const CLEANUP = 57611
-const BEGIN = 57612
-const START = 57613
-const TRANSACTION = 57614
-const COMMIT = 57615
-const ROLLBACK = 57616
-const SAVEPOINT = 57617
-const RELEASE = 57618
-const WORK = 57619
-const BIT = 57620
-const TINYINT = 57621
-const SMALLINT = 57622
-const MEDIUMINT = 57623
-const INT = 57624
-const INTEGER = 57625
-const BIGINT = 57626
-const INTNUM = 57627
-const REAL = 57628
-const DOUBLE = 57629
-const FLOAT_TYPE = 57630
-const DECIMAL_TYPE = 57631
-const NUMERIC = 57632
-const TIME = 57633
-const TIMESTAMP = 57634
-const DATETIME = 57635
-const YEAR = 57636
-const CHAR = 57637
-const VARCHAR = 57638
-const BOOL = 57639
-const CHARACTER = 57640
-const VARBINARY = 57641
-const NCHAR = 57642
-const TEXT = 57643
-const TINYTEXT = 57644
-const MEDIUMTEXT = 57645
-const LONGTEXT = 57646
-const BLOB = 57647
-const TINYBLOB = 57648
-const MEDIUMBLOB = 57649
-const LONGBLOB = 57650
-const JSON = 57651
-const ENUM = 57652
-const GEOMETRY = 57653
-const POINT = 57654
-const LINESTRING = 57655
-const POLYGON = 57656
-const GEOMETRYCOLLECTION = 57657
-const MULTIPOINT = 57658
-const MULTILINESTRING = 57659
-const MULTIPOLYGON = 57660
-const ASCII = 57661
-const UNICODE = 57662
-const NULLX = 57663
-const AUTO_INCREMENT = 57664
-const APPROXNUM = 57665
-const SIGNED = 57666
-const UNSIGNED = 57667
-const ZEROFILL = 57668
-const CODE = 57669
-const COLLATION = 57670
-const COLUMNS = 57671
-const DATABASES = 57672
-const ENGINES = 57673
-const EVENT = 57674
-const EXTENDED = 57675
-const FIELDS = 57676
-const FULL = 57677
-const FUNCTION = 57678
-const GTID_EXECUTED = 57679
-const KEYSPACES = 57680
-const OPEN = 57681
-const PLUGINS = 57682
-const PRIVILEGES = 57683
-const PROCESSLIST = 57684
-const SCHEMAS = 57685
-const TABLES = 57686
-const TRIGGERS = 57687
-const USER = 57688
-const VGTID_EXECUTED = 57689
-const VITESS_KEYSPACES = 57690
-const VITESS_METADATA = 57691
-const VITESS_MIGRATIONS = 57692
-const VITESS_REPLICATION_STATUS = 57693
-const VITESS_SHARDS = 57694
-const VITESS_TABLETS = 57695
-const VSCHEMA = 57696
-const NAMES = 57697
-const GLOBAL = 57698
-const SESSION = 57699
-const ISOLATION = 57700
-const LEVEL = 57701
-const READ = 57702
-const WRITE = 57703
-const ONLY = 57704
-const REPEATABLE = 57705
-const COMMITTED = 57706
-const UNCOMMITTED = 57707
-const SERIALIZABLE = 57708
-const CURRENT_TIMESTAMP = 57709
-const DATABASE = 57710
-const CURRENT_DATE = 57711
-const CURRENT_TIME = 57712
-const LOCALTIME = 57713
-const LOCALTIMESTAMP = 57714
-const CURRENT_USER = 57715
-const UTC_DATE = 57716
-const UTC_TIME = 57717
-const UTC_TIMESTAMP = 57718
-const DAY = 57719
-const DAY_HOUR = 57720
-const DAY_MICROSECOND = 57721
-const DAY_MINUTE = 57722
-const DAY_SECOND = 57723
-const HOUR = 57724
-const HOUR_MICROSECOND = 57725
-const HOUR_MINUTE = 57726
-const HOUR_SECOND = 57727
-const MICROSECOND = 57728
-const MINUTE = 57729
-const MINUTE_MICROSECOND = 57730
-const MINUTE_SECOND = 57731
-const MONTH = 57732
-const QUARTER = 57733
-const SECOND = 57734
-const SECOND_MICROSECOND = 57735
-const YEAR_MONTH = 57736
-const WEEK = 57737
-const REPLACE = 57738
-const CONVERT = 57739
-const CAST = 57740
-const SUBSTR = 57741
-const SUBSTRING = 57742
-const GROUP_CONCAT = 57743
-const SEPARATOR = 57744
-const TIMESTAMPADD = 57745
-const TIMESTAMPDIFF = 57746
-const WEIGHT_STRING = 57747
-const LTRIM = 57748
-const RTRIM = 57749
-const TRIM = 57750
-const MATCH = 57751
-const AGAINST = 57752
-const BOOLEAN = 57753
-const LANGUAGE = 57754
-const WITH = 57755
-const QUERY = 57756
-const EXPANSION = 57757
-const WITHOUT = 57758
-const VALIDATION = 57759
-const UNUSED = 57760
-const ARRAY = 57761
-const CUME_DIST = 57762
-const DESCRIPTION = 57763
-const DENSE_RANK = 57764
-const EMPTY = 57765
-const EXCEPT = 57766
-const FIRST_VALUE = 57767
-const GROUPING = 57768
-const GROUPS = 57769
-const JSON_TABLE = 57770
-const LAG = 57771
-const LAST_VALUE = 57772
-const LATERAL = 57773
-const LEAD = 57774
-const MEMBER = 57775
-const NTH_VALUE = 57776
-const NTILE = 57777
-const OF = 57778
-const OVER = 57779
-const PERCENT_RANK = 57780
-const RANK = 57781
-const RECURSIVE = 57782
-const ROW_NUMBER = 57783
-const SYSTEM = 57784
-const WINDOW = 57785
-const ACTIVE = 57786
-const ADMIN = 57787
-const BUCKETS = 57788
-const CLONE = 57789
-const COMPONENT = 57790
-const DEFINITION = 57791
-const ENFORCED = 57792
-const EXCLUDE = 57793
-const FOLLOWING = 57794
-const GEOMCOLLECTION = 57795
-const GET_MASTER_PUBLIC_KEY = 57796
-const HISTOGRAM = 57797
-const HISTORY = 57798
-const INACTIVE = 57799
-const INVISIBLE = 57800
-const LOCKED = 57801
-const MASTER_COMPRESSION_ALGORITHMS = 57802
-const MASTER_PUBLIC_KEY_PATH = 57803
-const MASTER_TLS_CIPHERSUITES = 57804
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57805
-const NESTED = 57806
-const NETWORK_NAMESPACE = 57807
-const NOWAIT = 57808
-const NULLS = 57809
-const OJ = 57810
-const OLD = 57811
-const OPTIONAL = 57812
-const ORDINALITY = 57813
-const ORGANIZATION = 57814
-const OTHERS = 57815
-const PATH = 57816
-const PERSIST = 57817
-const PERSIST_ONLY = 57818
-const PRECEDING = 57819
-const PRIVILEGE_CHECKS_USER = 57820
-const PROCESS = 57821
-const RANDOM = 57822
-const REFERENCE = 57823
-const REQUIRE_ROW_FORMAT = 57824
-const RESOURCE = 57825
-const RESPECT = 57826
-const RESTART = 57827
-const RETAIN = 57828
-const REUSE = 57829
-const ROLE = 57830
-const SECONDARY = 57831
-const SECONDARY_ENGINE = 57832
-const SECONDARY_LOAD = 57833
-const SECONDARY_UNLOAD = 57834
-const SKIP = 57835
-const SRID = 57836
-const THREAD_PRIORITY = 57837
-const TIES = 57838
-const UNBOUNDED = 57839
-const VCPU = 57840
-const VISIBLE = 57841
-const FORMAT = 57842
-const TREE = 57843
-const VITESS = 57844
-const TRADITIONAL = 57845
-const LOCAL = 57846
-const LOW_PRIORITY = 57847
-const NO_WRITE_TO_BINLOG = 57848
-const LOGS = 57849
-const ERROR = 57850
-const GENERAL = 57851
-const HOSTS = 57852
-const OPTIMIZER_COSTS = 57853
-const USER_RESOURCES = 57854
-const SLOW = 57855
-const CHANNEL = 57856
-const RELAY = 57857
-const EXPORT = 57858
-const AVG_ROW_LENGTH = 57859
-const CONNECTION = 57860
-const CHECKSUM = 57861
-const DELAY_KEY_WRITE = 57862
-const ENCRYPTION = 57863
-const ENGINE = 57864
-const INSERT_METHOD = 57865
-const MAX_ROWS = 57866
-const MIN_ROWS = 57867
-const PACK_KEYS = 57868
-const PASSWORD = 57869
-const FIXED = 57870
-const DYNAMIC = 57871
-const COMPRESSED = 57872
-const REDUNDANT = 57873
-const COMPACT = 57874
-const ROW_FORMAT = 57875
-const STATS_AUTO_RECALC = 57876
-const STATS_PERSISTENT = 57877
-const STATS_SAMPLE_PAGES = 57878
-const STORAGE = 57879
-const MEMORY = 57880
-const DISK = 57881
-const PARTITIONS = 57882
-const LINEAR = 57883
-const RANGE = 57884
-const LIST = 57885
-const SUBPARTITION = 57886
-const SUBPARTITIONS = 57887
-const HASH = 57888
+const CUTOVER = 57612
+const BEGIN = 57613
+const START = 57614
+const TRANSACTION = 57615
+const COMMIT = 57616
+const ROLLBACK = 57617
+const SAVEPOINT = 57618
+const RELEASE = 57619
+const WORK = 57620
+const BIT = 57621
+const TINYINT = 57622
+const SMALLINT = 57623
+const MEDIUMINT = 57624
+const INT = 57625
+const INTEGER = 57626
+const BIGINT = 57627
+const INTNUM = 57628
+const REAL = 57629
+const DOUBLE = 57630
+const FLOAT_TYPE = 57631
+const DECIMAL_TYPE = 57632
+const NUMERIC = 57633
+const TIME = 57634
+const TIMESTAMP = 57635
+const DATETIME = 57636
+const YEAR = 57637
+const CHAR = 57638
+const VARCHAR = 57639
+const BOOL = 57640
+const CHARACTER = 57641
+const VARBINARY = 57642
+const NCHAR = 57643
+const TEXT = 57644
+const TINYTEXT = 57645
+const MEDIUMTEXT = 57646
+const LONGTEXT = 57647
+const BLOB = 57648
+const TINYBLOB = 57649
+const MEDIUMBLOB = 57650
+const LONGBLOB = 57651
+const JSON = 57652
+const ENUM = 57653
+const GEOMETRY = 57654
+const POINT = 57655
+const LINESTRING = 57656
+const POLYGON = 57657
+const GEOMETRYCOLLECTION = 57658
+const MULTIPOINT = 57659
+const MULTILINESTRING = 57660
+const MULTIPOLYGON = 57661
+const ASCII = 57662
+const UNICODE = 57663
+const NULLX = 57664
+const AUTO_INCREMENT = 57665
+const APPROXNUM = 57666
+const SIGNED = 57667
+const UNSIGNED = 57668
+const ZEROFILL = 57669
+const CODE = 57670
+const COLLATION = 57671
+const COLUMNS = 57672
+const DATABASES = 57673
+const ENGINES = 57674
+const EVENT = 57675
+const EXTENDED = 57676
+const FIELDS = 57677
+const FULL = 57678
+const FUNCTION = 57679
+const GTID_EXECUTED = 57680
+const KEYSPACES = 57681
+const OPEN = 57682
+const PLUGINS = 57683
+const PRIVILEGES = 57684
+const PROCESSLIST = 57685
+const SCHEMAS = 57686
+const TABLES = 57687
+const TRIGGERS = 57688
+const USER = 57689
+const VGTID_EXECUTED = 57690
+const VITESS_KEYSPACES = 57691
+const VITESS_METADATA = 57692
+const VITESS_MIGRATIONS = 57693
+const VITESS_REPLICATION_STATUS = 57694
+const VITESS_SHARDS = 57695
+const VITESS_TABLETS = 57696
+const VSCHEMA = 57697
+const NAMES = 57698
+const GLOBAL = 57699
+const SESSION = 57700
+const ISOLATION = 57701
+const LEVEL = 57702
+const READ = 57703
+const WRITE = 57704
+const ONLY = 57705
+const REPEATABLE = 57706
+const COMMITTED = 57707
+const UNCOMMITTED = 57708
+const SERIALIZABLE = 57709
+const CURRENT_TIMESTAMP = 57710
+const DATABASE = 57711
+const CURRENT_DATE = 57712
+const CURRENT_TIME = 57713
+const LOCALTIME = 57714
+const LOCALTIMESTAMP = 57715
+const CURRENT_USER = 57716
+const UTC_DATE = 57717
+const UTC_TIME = 57718
+const UTC_TIMESTAMP = 57719
+const DAY = 57720
+const DAY_HOUR = 57721
+const DAY_MICROSECOND = 57722
+const DAY_MINUTE = 57723
+const DAY_SECOND = 57724
+const HOUR = 57725
+const HOUR_MICROSECOND = 57726
+const HOUR_MINUTE = 57727
+const HOUR_SECOND = 57728
+const MICROSECOND = 57729
+const MINUTE = 57730
+const MINUTE_MICROSECOND = 57731
+const MINUTE_SECOND = 57732
+const MONTH = 57733
+const QUARTER = 57734
+const SECOND = 57735
+const SECOND_MICROSECOND = 57736
+const YEAR_MONTH = 57737
+const WEEK = 57738
+const REPLACE = 57739
+const CONVERT = 57740
+const CAST = 57741
+const SUBSTR = 57742
+const SUBSTRING = 57743
+const GROUP_CONCAT = 57744
+const SEPARATOR = 57745
+const TIMESTAMPADD = 57746
+const TIMESTAMPDIFF = 57747
+const WEIGHT_STRING = 57748
+const LTRIM = 57749
+const RTRIM = 57750
+const TRIM = 57751
+const MATCH = 57752
+const AGAINST = 57753
+const BOOLEAN = 57754
+const LANGUAGE = 57755
+const WITH = 57756
+const QUERY = 57757
+const EXPANSION = 57758
+const WITHOUT = 57759
+const VALIDATION = 57760
+const UNUSED = 57761
+const ARRAY = 57762
+const CUME_DIST = 57763
+const DESCRIPTION = 57764
+const DENSE_RANK = 57765
+const EMPTY = 57766
+const EXCEPT = 57767
+const FIRST_VALUE = 57768
+const GROUPING = 57769
+const GROUPS = 57770
+const JSON_TABLE = 57771
+const LAG = 57772
+const LAST_VALUE = 57773
+const LATERAL = 57774
+const LEAD = 57775
+const MEMBER = 57776
+const NTH_VALUE = 57777
+const NTILE = 57778
+const OF = 57779
+const OVER = 57780
+const PERCENT_RANK = 57781
+const RANK = 57782
+const RECURSIVE = 57783
+const ROW_NUMBER = 57784
+const SYSTEM = 57785
+const WINDOW = 57786
+const ACTIVE = 57787
+const ADMIN = 57788
+const BUCKETS = 57789
+const CLONE = 57790
+const COMPONENT = 57791
+const DEFINITION = 57792
+const ENFORCED = 57793
+const EXCLUDE = 57794
+const FOLLOWING = 57795
+const GEOMCOLLECTION = 57796
+const GET_MASTER_PUBLIC_KEY = 57797
+const HISTOGRAM = 57798
+const HISTORY = 57799
+const INACTIVE = 57800
+const INVISIBLE = 57801
+const LOCKED = 57802
+const MASTER_COMPRESSION_ALGORITHMS = 57803
+const MASTER_PUBLIC_KEY_PATH = 57804
+const MASTER_TLS_CIPHERSUITES = 57805
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57806
+const NESTED = 57807
+const NETWORK_NAMESPACE = 57808
+const NOWAIT = 57809
+const NULLS = 57810
+const OJ = 57811
+const OLD = 57812
+const OPTIONAL = 57813
+const ORDINALITY = 57814
+const ORGANIZATION = 57815
+const OTHERS = 57816
+const PATH = 57817
+const PERSIST = 57818
+const PERSIST_ONLY = 57819
+const PRECEDING = 57820
+const PRIVILEGE_CHECKS_USER = 57821
+const PROCESS = 57822
+const RANDOM = 57823
+const REFERENCE = 57824
+const REQUIRE_ROW_FORMAT = 57825
+const RESOURCE = 57826
+const RESPECT = 57827
+const RESTART = 57828
+const RETAIN = 57829
+const REUSE = 57830
+const ROLE = 57831
+const SECONDARY = 57832
+const SECONDARY_ENGINE = 57833
+const SECONDARY_LOAD = 57834
+const SECONDARY_UNLOAD = 57835
+const SKIP = 57836
+const SRID = 57837
+const THREAD_PRIORITY = 57838
+const TIES = 57839
+const UNBOUNDED = 57840
+const VCPU = 57841
+const VISIBLE = 57842
+const FORMAT = 57843
+const TREE = 57844
+const VITESS = 57845
+const TRADITIONAL = 57846
+const LOCAL = 57847
+const LOW_PRIORITY = 57848
+const NO_WRITE_TO_BINLOG = 57849
+const LOGS = 57850
+const ERROR = 57851
+const GENERAL = 57852
+const HOSTS = 57853
+const OPTIMIZER_COSTS = 57854
+const USER_RESOURCES = 57855
+const SLOW = 57856
+const CHANNEL = 57857
+const RELAY = 57858
+const EXPORT = 57859
+const AVG_ROW_LENGTH = 57860
+const CONNECTION = 57861
+const CHECKSUM = 57862
+const DELAY_KEY_WRITE = 57863
+const ENCRYPTION = 57864
+const ENGINE = 57865
+const INSERT_METHOD = 57866
+const MAX_ROWS = 57867
+const MIN_ROWS = 57868
+const PACK_KEYS = 57869
+const PASSWORD = 57870
+const FIXED = 57871
+const DYNAMIC = 57872
+const COMPRESSED = 57873
+const REDUNDANT = 57874
+const COMPACT = 57875
+const ROW_FORMAT = 57876
+const STATS_AUTO_RECALC = 57877
+const STATS_PERSISTENT = 57878
+const STATS_SAMPLE_PAGES = 57879
+const STORAGE = 57880
+const MEMORY = 57881
+const DISK = 57882
+const PARTITIONS = 57883
+const LINEAR = 57884
+const RANGE = 57885
+const LIST = 57886
+const SUBPARTITION = 57887
+const SUBPARTITIONS = 57888
+const HASH = 57889
 
 var yyToknames = [...]string{
 	"$end",
@@ -876,6 +877,7 @@ var yyToknames = [...]string{
 	"RETRY",
 	"COMPLETE",
 	"CLEANUP",
+	"CUTOVER",
 	"BEGIN",
 	"START",
 	"TRANSACTION",
@@ -1173,29 +1175,29 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 47,
 	1, 140,
-	564, 140,
+	565, 140,
 	-2, 146,
 	-1, 48,
 	119, 146,
 	159, 146,
-	315, 146,
+	316, 146,
 	-2, 447,
 	-1, 55,
-	33, 626,
-	219, 626,
-	230, 626,
-	265, 640,
-	266, 640,
-	-2, 628,
+	33, 627,
+	219, 627,
+	230, 627,
+	265, 641,
+	266, 641,
+	-2, 629,
 	-1, 60,
-	221, 651,
-	-2, 649,
+	221, 652,
+	-2, 650,
 	-1, 114,
-	218, 1130,
+	218, 1136,
 	-2, 119,
 	-1, 116,
 	1, 141,
-	564, 141,
+	565, 141,
 	-2, 146,
 	-1, 126,
 	120, 350,
@@ -1204,1652 +1206,1727 @@ var yyExca = [...]int{
 	-1, 145,
 	119, 146,
 	159, 146,
-	315, 146,
+	316, 146,
 	-2, 456,
-	-1, 617,
-	203, 1151,
-	-2, 1147,
 	-1, 618,
-	203, 1152,
-	-2, 1148,
-	-1, 692,
-	57, 719,
-	-2, 734,
-	-1, 729,
-	135, 1518,
+	203, 1157,
+	-2, 1153,
+	-1, 619,
+	203, 1158,
+	-2, 1154,
+	-1, 693,
+	57, 720,
+	-2, 735,
+	-1, 731,
+	135, 1525,
 	-2, 112,
-	-1, 730,
-	135, 1392,
+	-1, 732,
+	135, 1399,
 	-2, 113,
-	-1, 736,
-	135, 1447,
-	-2, 1124,
-	-1, 881,
-	135, 1323,
-	-2, 1121,
-	-1, 919,
+	-1, 738,
+	135, 1454,
+	-2, 1130,
+	-1, 883,
+	135, 1329,
+	-2, 1127,
+	-1, 921,
 	229, 41,
 	234, 41,
 	-2, 361,
-	-1, 996,
+	-1, 998,
 	1, 495,
-	564, 495,
+	565, 495,
 	-2, 146,
-	-1, 1201,
-	57, 720,
-	-2, 739,
-	-1, 1202,
+	-1, 1203,
 	57, 721,
 	-2, 740,
-	-1, 1254,
+	-1, 1204,
+	57, 722,
+	-2, 741,
+	-1, 1260,
 	119, 146,
 	159, 146,
-	315, 146,
+	316, 146,
 	-2, 391,
-	-1, 1331,
+	-1, 1337,
 	120, 350,
 	224, 350,
 	-2, 441,
-	-1, 1340,
+	-1, 1346,
 	229, 42,
 	234, 42,
 	-2, 362,
-	-1, 1599,
-	203, 1156,
-	-2, 1150,
-	-1, 1681,
+	-1, 1605,
+	203, 1162,
+	-2, 1156,
+	-1, 1689,
 	119, 146,
 	159, 146,
-	315, 146,
+	316, 146,
 	-2, 392,
-	-1, 1688,
+	-1, 1696,
 	23, 165,
 	-2, 167,
-	-1, 1886,
+	-1, 1895,
 	84, 39,
-	-2, 775,
-	-1, 1937,
+	-2, 776,
+	-1, 1946,
 	75, 94,
 	84, 94,
-	-2, 795,
-	-1, 2109,
-	47, 1092,
-	-2, 1086,
-	-1, 2279,
+	-2, 796,
+	-1, 2118,
+	47, 1098,
+	-2, 1092,
+	-1, 2288,
 	84, 39,
-	-2, 776,
-	-1, 2317,
+	-2, 777,
+	-1, 2326,
 	5, 53,
 	16, 53,
 	18, 53,
 	85, 53,
-	-2, 767,
+	-2, 768,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 36157
+const yyLast = 36392
 
 var yyAct = [...]int{
-	617, 2582, 2576, 1634, 2377, 2547, 2231, 2533, 2202, 2161,
-	2168, 2460, 2123, 2403, 612, 1060, 707, 96, 1180, 569,
-	3, 2474, 2214, 2120, 685, 1874, 2170, 1741, 565, 2213,
-	1216, 2288, 2124, 2408, 1613, 1909, 2121, 620, 611, 37,
-	591, 2282, 2308, 1638, 1653, 2118, 609, 610, 2216, 2110,
-	1932, 182, 1901, 1711, 182, 2274, 529, 182, 563, 1969,
-	561, 2039, 545, 1998, 182, 1731, 1716, 1971, 1970, 1185,
-	884, 1667, 182, 1921, 154, 1658, 36, 1893, 38, 708,
-	1657, 1876, 734, 1203, 927, 140, 557, 182, 949, 1593,
-	1750, 1678, 1730, 1495, 687, 1783, 2055, 1008, 1718, 1963,
-	909, 1543, 914, 1246, 1939, 1225, 1615, 1660, 1183, 545,
-	1338, 91, 545, 182, 545, 574, 689, 95, 693, 1446,
-	1142, 1555, 1079, 499, 731, 1513, 891, 1728, 1442, 1354,
-	1428, 1536, 1345, 548, 888, 1245, 710, 1037, 920, 915,
-	916, 680, 1229, 1645, 1058, 917, 892, 1053, 699, 98,
-	562, 1451, 1306, 157, 721, 123, 694, 1707, 695, 117,
-	1243, 118, 697, 1639, 97, 124, 992, 1330, 76, 552,
-	1145, 2505, 1991, 85, 89, 2583, 1606, 2199, 1743, 1149,
-	2018, 2017, 890, 1311, 1743, 1744, 1745, 1989, 1781, 2047,
-	1080, 2048, 1502, 696, 77, 8, 7, 6, 1610, 1611,
-	1596, 119, 701, 1501, 715, 1500, 720, 184, 185, 186,
-	1499, 1498, 931, 90, 885, 1497, 125, 1484, 1489, 502,
-	954, 1414, 555, 2561, 556, 1872, 2106, 1903, 1080, 2342,
-	895, 951, 2185, 2456, 2455, 553, 900, 2370, 962, 953,
-	2371, 686, 952, 2592, 965, 966, 688, 969, 970, 971,
-	972, 2543, 1821, 975, 976, 977, 978, 979, 980, 981,
-	982, 983, 984, 985, 986, 987, 988, 989, 702, 728,
-	119, 709, 735, 930, 78, 1111, 906, 80, 905, 2586,
-	2516, 2575, 102, 907, 2378, 1723, 2534, 78, 78, 1769,
-	178, 2542, 955, 956, 957, 1090, 2465, 1112, 1113, 1114,
-	1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122, 2515, 1721,
-	2054, 1912, 2264, 1320, 120, 2026, 1819, 2158, 2159, 2025,
-	78, 967, 1948, 104, 105, 1947, 108, 162, 1949, 114,
-	1673, 1674, 179, 1090, 119, 497, 1913, 2421, 1873, 1247,
-	1044, 1248, 1046, 1612, 2157, 2046, 1818, 1672, 1032, 1033,
-	1056, 87, 1027, 679, 678, 681, 682, 683, 684, 1992,
-	904, 692, 1001, 1002, 87, 87, 1015, 597, 1584, 1953,
-	995, 1016, 1960, 1691, 1690, 2285, 178, 717, 2255, 532,
-	1043, 1045, 2253, 1488, 159, 2081, 160, 1994, 1086, 723,
-	724, 1078, 543, 1015, 1004, 1028, 177, 87, 1016, 547,
-	120, 541, 142, 1999, 1021, 2233, 1014, 1187, 1013, 1434,
-	1490, 1491, 1492, 162, 1751, 1784, 991, 2226, 902, 532,
-	1795, 1792, 1794, 1793, 2021, 2227, 1086, 2585, 899, 1429,
-	1797, 901, 1798, 1789, 1799, 1030, 1031, 1034, 1720, 1050,
-	1036, 997, 2034, 1404, 152, 558, 532, 1035, 1800, 141,
-	1055, 1576, 1565, 1566, 1567, 1568, 1578, 1569, 1570, 1571,
-	1583, 1579, 1572, 1573, 1580, 1581, 1582, 1574, 1575, 1577,
-	159, 1041, 160, 974, 1029, 1042, 2562, 711, 1332, 1333,
-	151, 150, 177, 1022, 2234, 1047, 968, 1405, 1790, 1406,
-	973, 1788, 2363, 2235, 1786, 938, 1754, 182, 532, 182,
-	2210, 87, 182, 1654, 163, 936, 911, 1040, 910, 904,
-	990, 947, 911, 168, 929, 946, 945, 944, 943, 2580,
-	1048, 942, 1190, 941, 940, 904, 935, 896, 903, 1323,
-	545, 545, 545, 1787, 898, 897, 948, 2082, 908, 2174,
-	1123, 889, 1123, 1819, 2587, 2573, 923, 889, 545, 545,
-	889, 887, 964, 922, 1085, 1082, 1083, 1084, 1089, 1091,
-	1088, 2038, 1087, 1025, 1344, 1443, 999, 1729, 1005, 1081,
-	1072, 1007, 2184, 722, 994, 533, 929, 2035, 146, 1334,
-	153, 2466, 1331, 902, 147, 148, 1877, 1879, 37, 1775,
-	163, 1439, 1085, 1082, 1083, 1084, 1089, 1091, 1088, 168,
-	1087, 1011, 1435, 1017, 1018, 1019, 1020, 1081, 1066, 958,
-	2192, 2209, 928, 2504, 1990, 533, 2020, 932, 922, 2023,
-	1318, 1317, 1993, 2050, 155, 1832, 1057, 933, 1316, 1126,
-	1127, 1128, 1129, 2010, 1440, 1314, 1640, 1641, 939, 1134,
-	1722, 1137, 533, 501, 2286, 1049, 1173, 934, 937, 613,
-	929, 592, 594, 614, 615, 929, 590, 593, 616, 496,
-	2495, 1051, 1820, 993, 1771, 2514, 1433, 1178, 1343, 2033,
-	2323, 2452, 2032, 2304, 928, 1062, 1063, 903, 1944, 182,
-	1123, 81, 1908, 545, 545, 595, 596, 2434, 2435, 2436,
-	2437, 2041, 1130, 903, 533, 1864, 2040, 1605, 86, 182,
-	2056, 1233, 1191, 1160, 929, 1179, 687, 1196, 1006, 2041,
-	155, 86, 86, 1194, 2040, 1124, 1125, 116, 545, 1679,
-	1122, 2578, 182, 2156, 2579, 1193, 2577, 545, 1000, 1197,
-	1038, 1452, 1012, 545, 1003, 689, 1878, 1416, 1415, 1417,
-	1418, 1419, 731, 77, 86, 1075, 1073, 1074, 928, 1024,
-	963, 704, 1054, 928, 111, 1010, 1518, 2510, 932, 922,
-	1026, 1147, 2553, 1148, 2359, 2298, 2551, 1179, 933, 1195,
-	1519, 1520, 1517, 95, 1151, 2555, 2556, 950, 1785, 1436,
-	1249, 2058, 1076, 2172, 2173, 149, 1184, 2074, 1556, 1982,
-	2552, 1238, 2569, 1166, 1167, 1168, 1169, 1556, 1430, 1846,
-	1431, 143, 928, 1432, 144, 98, 1094, 1095, 922, 925,
-	926, 1095, 889, 2417, 112, 2334, 919, 923, 2571, 156,
-	161, 158, 164, 165, 166, 167, 169, 170, 171, 172,
-	184, 185, 186, 2333, 1538, 173, 174, 175, 176, 1768,
-	1766, 1758, 1770, 2068, 2067, 2066, 2060, 1181, 2064, 1854,
-	2059, 2261, 2057, 686, 938, 1353, 1093, 2062, 1094, 1095,
-	1192, 1215, 1352, 1039, 1453, 688, 2061, 1342, 1763, 1763,
-	936, 1212, 1117, 1118, 1120, 1119, 1121, 1122, 1239, 1240,
-	2324, 2063, 2065, 1837, 182, 1009, 1234, 2171, 1307, 2590,
-	735, 1093, 1836, 1094, 1095, 1767, 1765, 1315, 996, 2174,
-	2076, 1508, 1510, 1511, 1539, 156, 161, 158, 164, 165,
-	166, 167, 169, 170, 171, 172, 1210, 2447, 545, 2393,
-	1340, 173, 174, 175, 176, 1509, 1646, 1647, 1349, 2521,
-	1223, 1093, 1351, 1094, 1095, 545, 545, 1096, 545, 2392,
-	545, 545, 2341, 545, 545, 545, 545, 545, 545, 1093,
-	1350, 1094, 1095, 1256, 1093, 1244, 1094, 1095, 545, 2259,
-	1210, 2522, 182, 1387, 2340, 1143, 1115, 1116, 1117, 1118,
-	1120, 1119, 1121, 1122, 1198, 1382, 1383, 2488, 182, 1099,
-	1100, 1101, 1102, 1103, 1104, 1105, 1097, 1210, 1336, 545,
-	929, 182, 1423, 1859, 1560, 1222, 1321, 1322, 1093, 1329,
-	1094, 1095, 1441, 2570, 2200, 545, 558, 182, 2190, 2489,
-	1346, 1346, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1120,
-	1119, 1121, 1122, 182, 1093, 1967, 1094, 1095, 1384, 1966,
-	182, 1386, 1726, 1842, 1093, 2588, 1094, 1095, 1348, 182,
-	182, 182, 182, 182, 182, 182, 182, 182, 545, 545,
-	545, 1093, 1424, 1094, 1095, 1422, 1390, 1391, 1409, 1347,
-	1427, 1313, 1396, 1397, 726, 1356, 1210, 1357, 1327, 1359,
-	1361, 87, 1339, 1365, 1367, 1369, 1371, 1373, 1325, 182,
-	1326, 1824, 1825, 1826, 1400, 1516, 1408, 1407, 928, 1448,
-	1226, 1398, 1457, 1392, 922, 925, 926, 2164, 889, 1461,
-	1421, 1389, 919, 923, 1093, 1841, 1094, 1095, 1388, 2589,
-	1472, 1473, 1474, 1475, 1476, 1477, 1478, 1363, 1411, 1456,
-	1219, 1514, 1385, 918, 1537, 2492, 1460, 2491, 1462, 1463,
-	1464, 1465, 1444, 1884, 2490, 1469, 1883, 1546, 545, 2501,
-	2416, 2414, 2165, 2389, 1093, 2338, 1094, 1095, 1496, 1483,
-	119, 1454, 1455, 545, 545, 2330, 906, 1976, 905, 1512,
-	1964, 1210, 1779, 1420, 1557, 1459, 2445, 2167, 1522, 1220,
-	1778, 2162, 1466, 1467, 1468, 1458, 1597, 1093, 2267, 1094,
-	1095, 1410, 1637, 1319, 1210, 2360, 182, 1619, 2172, 2173,
-	2230, 545, 1479, 1480, 1481, 2163, 1835, 1541, 1482, 1093,
-	1540, 1094, 1095, 1485, 1093, 1618, 1094, 1095, 1449, 2266,
-	1412, 1624, 1399, 1625, 1395, 182, 1394, 1093, 545, 1094,
-	1095, 1393, 1515, 1093, 1221, 1094, 1095, 2169, 182, 1052,
-	1065, 545, 631, 632, 633, 92, 182, 2473, 182, 2472,
-	182, 182, 545, 1599, 1968, 545, 93, 2441, 1093, 1597,
-	1094, 1095, 1601, 1602, 1899, 2584, 545, 731, 95, 1521,
-	731, 1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531,
-	1532, 1533, 1534, 1535, 184, 185, 186, 95, 2331, 2440,
-	1598, 1910, 1093, 94, 1094, 1095, 2376, 1630, 1899, 2540,
-	1656, 2000, 2171, 184, 185, 186, 1093, 1648, 1094, 1095,
-	1210, 1899, 2527, 1910, 2174, 1652, 1979, 1655, 1899, 2525,
-	1496, 545, 1697, 1698, 1699, 1700, 1599, 1732, 1733, 1734,
-	101, 2151, 1736, 1738, 701, 2119, 1683, 2517, 1210, 1682,
-	1819, 100, 1542, 99, 1665, 2297, 545, 1899, 2506, 1548,
-	1549, 1210, 545, 1349, 2368, 2503, 1349, 2297, 1349, 1688,
-	1899, 2448, 1918, 1651, 1762, 2368, 1210, 1687, 1686, 1713,
-	1752, 2299, 1600, 1632, 1092, 1603, 1604, 184, 185, 186,
-	2509, 1951, 1899, 1649, 2297, 1719, 1899, 2366, 1670, 184,
-	185, 186, 1918, 1739, 545, 2180, 1537, 2094, 1450, 1763,
-	1210, 1537, 1537, 2302, 1210, 1685, 1684, 2182, 2181, 2559,
-	1210, 1629, 1669, 2178, 2179, 735, 1749, 2166, 735, 1113,
-	1114, 1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122, 931,
-	184, 185, 186, 1671, 1737, 2178, 2177, 182, 618, 1346,
-	1918, 1210, 1833, 1210, 182, 1819, 2019, 1310, 2004, 182,
-	182, 1714, 1940, 182, 1727, 182, 1692, 1735, 1693, 1694,
-	1695, 1696, 182, 1757, 1772, 1725, 1760, 1724, 1761, 182,
-	1709, 1710, 1996, 1997, 1703, 1704, 1705, 1706, 1756, 1764,
-	1503, 1504, 1505, 1506, 1714, 1773, 1940, 1759, 1755, 183,
-	930, 100, 183, 1899, 1898, 183, 92, 182, 545, 1917,
-	546, 101, 183, 94, 1092, 1210, 1496, 93, 1310, 1309,
-	183, 1895, 100, 1791, 99, 1941, 1255, 1254, 1801, 1802,
-	1544, 1545, 1806, 94, 1943, 183, 94, 1774, 1550, 1833,
-	1782, 1809, 1776, 1777, 2343, 1851, 1763, 1850, 1812, 1763,
-	1746, 1644, 1514, 1585, 1586, 1587, 1589, 546, 1214, 1941,
-	546, 183, 546, 1918, 1608, 1493, 1438, 1378, 1819, 1833,
-	2530, 1210, 1810, 1811, 1241, 691, 1815, 1813, 913, 912,
-	1111, 87, 2462, 1107, 558, 1108, 1814, 1217, 2438, 1803,
-	2428, 1210, 2232, 1833, 2344, 2345, 2346, 2358, 1829, 1109,
-	1110, 1106, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1120,
-	1119, 1121, 1122, 2355, 2336, 2270, 2269, 1379, 1380, 1381,
-	1642, 1643, 1312, 1712, 1923, 1926, 1927, 1928, 1924, 182,
-	1925, 1929, 2228, 2205, 2309, 2310, 1817, 182, 2201, 2005,
-	1708, 1870, 1111, 545, 2049, 1702, 1887, 1677, 1701, 545,
-	1426, 1341, 87, 1515, 1337, 1308, 113, 2347, 2203, 1973,
-	545, 995, 1972, 1827, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1120, 1119, 1121, 1122, 1375, 2309, 2310, 2463, 1723,
-	1622, 2566, 2548, 182, 2312, 182, 2197, 2196, 2195, 1828,
-	2119, 1830, 1983, 1804, 1914, 1486, 1845, 2141, 2139, 2315,
-	1950, 1900, 2142, 2140, 2348, 2349, 1715, 2314, 1599, 1973,
-	2564, 2138, 37, 1923, 1926, 1927, 1928, 1924, 2137, 1925,
-	1929, 1934, 1376, 1377, 2143, 2541, 1927, 1928, 1636, 1857,
-	1218, 2398, 1628, 2397, 1896, 2303, 1184, 1871, 2111, 2113,
-	2099, 2098, 2002, 1881, 545, 1598, 2487, 2114, 2407, 182,
-	2409, 2293, 2290, 2108, 1937, 705, 182, 1961, 1962, 1933,
-	2289, 1892, 1437, 706, 677, 2176, 1958, 1977, 545, 1897,
-	1907, 1995, 1843, 1552, 960, 545, 1954, 959, 1938, 1349,
-	1349, 2396, 92, 92, 545, 2242, 1972, 1553, 2044, 94,
-	1064, 1942, 2295, 93, 93, 1945, 2016, 2012, 2011, 120,
-	94, 1952, 2193, 1719, 1646, 1647, 1955, 182, 182, 182,
-	182, 182, 1807, 2502, 2458, 1861, 1862, 2175, 1981, 101,
-	1931, 1633, 1965, 2097, 182, 182, 713, 714, 1796, 1975,
-	100, 2096, 99, 2275, 99, 1823, 1974, 1111, 101, 1831,
-	182, 94, 2479, 1980, 2478, 1984, 1985, 1986, 2415, 100,
-	2404, 99, 1329, 2413, 2412, 1208, 1204, 2014, 1537, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122,
-	1205, 2405, 2356, 2294, 2292, 2206, 2027, 2028, 2029, 2030,
-	2031, 687, 1747, 2013, 1324, 545, 2015, 101, 2073, 2006,
-	2007, 1208, 1204, 1496, 2037, 1626, 1627, 1207, 100, 1206,
-	2088, 545, 712, 100, 2283, 1910, 1205, 2568, 2567, 2045,
-	1895, 2052, 2051, 2083, 1852, 1620, 182, 2036, 1235, 1227,
-	545, 106, 107, 2568, 2493, 2329, 703, 103, 88, 545,
-	1, 1201, 1202, 1207, 626, 1206, 545, 545, 2550, 182,
-	182, 182, 182, 182, 2088, 183, 2116, 183, 2125, 2101,
-	183, 182, 2122, 2069, 1847, 2053, 182, 2122, 182, 514,
-	182, 2131, 1609, 182, 182, 182, 2102, 2042, 1182, 528,
-	2043, 2546, 1413, 2087, 693, 1403, 2379, 2089, 546, 546,
-	546, 2459, 2090, 2091, 2092, 2001, 1143, 1753, 2354, 2070,
-	2100, 1717, 2150, 921, 1197, 1934, 546, 546, 2191, 145,
-	2103, 1680, 1681, 2536, 182, 110, 882, 109, 924, 1023,
-	2152, 1748, 694, 2153, 695, 2133, 2134, 545, 2136, 2132,
-	2369, 1959, 2135, 2144, 1689, 1261, 545, 1259, 1260, 2212,
-	2093, 182, 2148, 2149, 95, 2154, 1258, 1263, 1262, 2208,
-	1257, 182, 1226, 1853, 1487, 542, 1930, 1448, 2160, 180,
-	1250, 1228, 961, 504, 2183, 1780, 182, 510, 2186, 182,
-	2187, 1135, 2095, 1946, 732, 725, 2130, 1621, 1885, 2243,
-	2127, 2287, 2107, 2194, 2109, 1902, 2112, 2105, 2486, 2220,
-	2219, 2188, 2189, 2406, 2528, 1956, 1224, 2207, 1844, 1554,
-	1719, 2211, 1661, 1617, 1507, 567, 566, 2223, 564, 1888,
-	2215, 1911, 1098, 621, 1875, 1236, 1922, 1920, 1919, 1805,
-	2224, 1666, 2311, 1111, 2307, 545, 182, 2238, 2237, 1659,
-	1894, 575, 568, 560, 619, 2236, 2326, 183, 2239, 2245,
-	2244, 546, 546, 2218, 2251, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1120, 1119, 1121, 1122, 2022, 183, 2229, 2024,
-	1957, 2225, 1077, 1200, 554, 894, 2281, 1551, 2464, 2450,
-	2240, 2241, 1822, 2263, 1199, 1563, 546, 2276, 2277, 1564,
-	183, 2198, 182, 1740, 63, 546, 41, 1588, 2284, 2291,
-	549, 546, 2560, 1068, 719, 2280, 2296, 2306, 32, 31,
-	2332, 30, 29, 28, 23, 182, 2316, 22, 2313, 21,
-	20, 19, 25, 18, 17, 16, 115, 50, 2321, 2322,
-	178, 47, 45, 182, 122, 2319, 182, 182, 182, 121,
-	48, 44, 2320, 998, 42, 2327, 545, 545, 27, 2361,
-	2362, 2220, 2219, 2328, 120, 26, 15, 14, 13, 2071,
-	2072, 2337, 12, 2339, 2075, 2364, 11, 162, 2077, 2078,
-	2079, 10, 9, 545, 545, 545, 545, 2084, 5, 4,
-	2248, 2249, 35, 2250, 2335, 34, 2252, 33, 2254, 1071,
-	2256, 24, 2, 1988, 1742, 2375, 0, 0, 0, 2373,
-	2374, 0, 2350, 0, 0, 2351, 2352, 2353, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 182,
-	2388, 0, 0, 0, 159, 0, 160, 0, 2117, 0,
-	0, 0, 0, 2385, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 545, 0, 545, 0, 0, 0, 0,
-	0, 0, 183, 2401, 2125, 0, 2424, 2122, 2125, 2411,
-	2410, 0, 2422, 2402, 687, 0, 0, 0, 0, 1209,
-	0, 2426, 2420, 0, 2418, 0, 0, 0, 0, 0,
-	37, 0, 0, 0, 0, 0, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 2430, 2431, 0, 2433, 545,
-	0, 0, 2446, 546, 546, 0, 546, 0, 546, 546,
-	2204, 546, 546, 546, 546, 546, 546, 0, 2449, 545,
-	0, 2442, 0, 0, 2444, 2443, 546, 0, 0, 0,
-	183, 2454, 0, 2453, 2461, 0, 545, 2384, 0, 0,
-	545, 545, 0, 0, 163, 0, 183, 0, 0, 0,
-	0, 0, 0, 168, 0, 0, 2483, 546, 0, 183,
-	0, 2480, 2481, 0, 2485, 0, 0, 2482, 0, 545,
-	0, 0, 2497, 546, 2494, 183, 2125, 0, 0, 545,
-	0, 0, 2500, 2496, 0, 0, 0, 602, 687, 0,
-	0, 183, 0, 0, 2265, 2498, 0, 0, 183, 0,
-	0, 2271, 0, 545, 182, 0, 2511, 183, 183, 183,
-	183, 183, 183, 183, 183, 183, 546, 546, 546, 2508,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 545, 37, 0, 0,
-	0, 0, 0, 0, 558, 0, 0, 183, 0, 544,
-	545, 545, 0, 2122, 2531, 2526, 0, 2523, 545, 0,
-	0, 2535, 0, 2529, 155, 2461, 2537, 0, 0, 0,
-	0, 0, 0, 2512, 0, 0, 0, 2557, 2549, 2554,
-	0, 37, 0, 0, 0, 0, 0, 2563, 0, 0,
-	0, 0, 2565, 0, 0, 0, 733, 0, 0, 886,
-	545, 893, 0, 2572, 0, 0, 546, 0, 2574, 0,
-	0, 2581, 0, 0, 0, 0, 0, 0, 0, 2357,
-	0, 546, 546, 0, 0, 0, 2591, 0, 0, 0,
-	0, 0, 0, 0, 0, 2372, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 0, 0, 0, 546,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2386, 0, 2387,
-	0, 0, 0, 183, 2390, 2391, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 0, 0, 546,
-	0, 0, 0, 0, 183, 0, 183, 0, 183, 183,
-	546, 0, 0, 546, 0, 0, 2419, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 0, 2427, 0, 0,
-	2429, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2432, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2439, 0, 0, 0, 0, 156,
-	161, 158, 164, 165, 166, 167, 169, 170, 171, 172,
-	178, 0, 0, 0, 0, 173, 174, 175, 176, 546,
-	0, 0, 0, 558, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 120, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 0, 162, 0, 0,
-	546, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2484, 558, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 160, 0, 0, 558,
-	0, 0, 129, 130, 151, 150, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	618, 2591, 2585, 2386, 2556, 2240, 2542, 2469, 2170, 2177,
+	612, 37, 3, 2211, 2132, 1182, 613, 2412, 1062, 2223,
+	2179, 1749, 2483, 1883, 566, 96, 2222, 709, 2133, 2297,
+	2129, 1640, 1218, 2130, 1619, 686, 2417, 2291, 592, 621,
+	610, 1661, 2283, 611, 1646, 2225, 563, 1918, 2317, 2119,
+	2127, 182, 1910, 1719, 182, 570, 530, 182, 2007, 1941,
+	564, 1978, 546, 2048, 182, 1739, 1724, 1187, 1979, 1010,
+	886, 1980, 182, 562, 154, 736, 1665, 36, 1675, 1930,
+	710, 1902, 1205, 558, 1317, 38, 951, 182, 690, 1885,
+	694, 1542, 1738, 1501, 1599, 1758, 1666, 1726, 2064, 140,
+	1686, 1791, 688, 1344, 911, 1549, 1972, 1948, 712, 546,
+	1252, 1621, 546, 182, 546, 916, 1231, 1144, 95, 575,
+	1668, 500, 91, 1185, 1561, 1519, 1360, 733, 1081, 1452,
+	1448, 549, 893, 922, 1434, 890, 1351, 1736, 1653, 681,
+	917, 918, 894, 919, 1251, 1235, 1060, 1457, 1055, 700,
+	723, 929, 157, 117, 698, 1312, 1336, 695, 994, 1715,
+	98, 76, 553, 1647, 1151, 696, 2514, 1249, 123, 1147,
+	1039, 124, 97, 89, 1612, 2592, 118, 85, 2208, 2000,
+	892, 1751, 1752, 1753, 1751, 2027, 2026, 1789, 1998, 1082,
+	2056, 2057, 697, 77, 8, 7, 1508, 6, 1616, 1617,
+	119, 1507, 184, 185, 186, 125, 1506, 1505, 717, 1504,
+	722, 90, 1503, 702, 1490, 556, 1495, 557, 1881, 503,
+	956, 1420, 2570, 2115, 1912, 1643, 1642, 887, 554, 953,
+	2194, 2351, 902, 897, 2465, 2464, 955, 2379, 954, 689,
+	2380, 1222, 967, 968, 2601, 971, 972, 973, 974, 687,
+	1220, 977, 978, 979, 980, 981, 982, 983, 984, 985,
+	986, 987, 988, 989, 990, 991, 703, 1602, 1223, 119,
+	908, 932, 737, 102, 1731, 711, 730, 1221, 2552, 933,
+	1082, 1830, 2595, 909, 2525, 2584, 2387, 2543, 178, 1777,
+	957, 958, 959, 907, 1092, 2551, 78, 78, 1729, 80,
+	2524, 2063, 78, 2273, 1326, 964, 2035, 2167, 2168, 1253,
+	2034, 1254, 120, 1957, 104, 105, 1956, 108, 1882, 1958,
+	114, 969, 2166, 179, 2055, 162, 498, 1681, 1682, 1827,
+	1680, 1029, 1113, 119, 901, 1034, 1035, 903, 680, 679,
+	2001, 78, 1058, 1618, 1030, 1828, 682, 683, 684, 685,
+	1023, 2430, 693, 2474, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1122, 1121, 1123, 1124, 1921, 1017, 1962, 1969, 1590,
+	2242, 1018, 2090, 87, 87, 1699, 1698, 2264, 178, 87,
+	725, 726, 159, 2262, 160, 1092, 2294, 544, 1088, 1494,
+	1922, 1080, 533, 533, 177, 548, 533, 533, 1046, 542,
+	1048, 1017, 120, 1440, 142, 1189, 1018, 2008, 1496, 1497,
+	1498, 1759, 993, 2030, 1016, 162, 1015, 1792, 87, 1804,
+	1800, 1802, 1801, 1803, 1031, 1036, 2235, 1728, 2594, 1410,
+	1024, 906, 970, 898, 2236, 1037, 1797, 910, 1045, 1047,
+	900, 899, 1052, 1057, 1435, 1806, 152, 1807, 1038, 1808,
+	2243, 141, 1582, 1571, 1572, 1573, 1574, 1584, 1575, 1576,
+	1577, 1589, 1585, 1578, 1579, 1586, 1587, 1588, 1580, 1581,
+	1583, 999, 159, 1411, 160, 1412, 2571, 2244, 2043, 1088,
+	129, 130, 151, 150, 177, 906, 1809, 1003, 1004, 904,
+	976, 1798, 1032, 1033, 975, 1794, 2372, 1796, 182, 2219,
+	182, 912, 163, 182, 1762, 913, 87, 1662, 913, 940,
+	949, 168, 938, 948, 947, 1125, 946, 945, 944, 1006,
+	943, 1050, 997, 598, 942, 2091, 937, 1192, 2183, 1329,
+	1043, 546, 546, 546, 1044, 950, 2596, 891, 1828, 1795,
+	2047, 1125, 925, 904, 1049, 2582, 891, 2589, 891, 546,
+	546, 924, 889, 1350, 1087, 1084, 1085, 1086, 1091, 1093,
+	1090, 37, 1089, 1074, 1449, 1001, 1042, 1007, 931, 1083,
+	1009, 2193, 1737, 1027, 1013, 724, 1019, 1020, 1021, 1022,
+	146, 127, 153, 134, 126, 2044, 147, 148, 1783, 534,
+	534, 1445, 163, 534, 534, 1068, 1441, 960, 2059, 1059,
+	905, 168, 135, 2201, 2002, 1886, 1888, 966, 931, 2513,
+	2218, 2032, 2029, 1324, 1648, 1649, 138, 136, 131, 132,
+	133, 137, 1999, 155, 1841, 1323, 1322, 2019, 128, 1446,
+	1730, 1320, 1128, 1129, 1130, 1131, 502, 139, 497, 2475,
+	1180, 1051, 1136, 2504, 1139, 1087, 1084, 1085, 1086, 1091,
+	1093, 1090, 941, 1089, 905, 939, 2295, 1349, 2523, 116,
+	1083, 906, 992, 1126, 1127, 2042, 930, 2332, 2041, 2313,
+	1779, 934, 924, 1953, 1917, 1873, 2461, 1611, 1064, 1065,
+	182, 935, 1239, 1175, 546, 546, 2050, 1162, 2050, 931,
+	1125, 2049, 1829, 2049, 1008, 1053, 1687, 1132, 1195, 1124,
+	182, 936, 1199, 2165, 81, 931, 930, 1181, 690, 706,
+	1056, 2519, 2368, 155, 1193, 688, 1198, 2307, 1439, 1012,
+	546, 86, 86, 952, 182, 1196, 996, 86, 1040, 546,
+	1014, 1005, 1002, 1793, 1442, 546, 111, 1255, 1422, 1421,
+	1423, 1424, 1425, 77, 1078, 1077, 1075, 733, 1076, 2587,
+	2083, 1562, 2588, 931, 2586, 1887, 2426, 1991, 1524, 1026,
+	1153, 1149, 1097, 1150, 2562, 1776, 86, 1458, 2560, 1181,
+	1028, 1197, 1525, 1526, 1523, 95, 2343, 2564, 2565, 1168,
+	1169, 1170, 1171, 1096, 1097, 2181, 2182, 930, 149, 2342,
+	931, 1244, 2561, 924, 927, 928, 112, 891, 1766, 1359,
+	1186, 921, 925, 930, 143, 965, 614, 144, 593, 595,
+	615, 616, 1358, 591, 594, 617, 995, 98, 156, 161,
+	158, 164, 165, 166, 167, 169, 170, 171, 172, 1562,
+	905, 1855, 1348, 2276, 173, 174, 175, 176, 1774, 940,
+	998, 1183, 596, 597, 2443, 2444, 2445, 2446, 1778, 1011,
+	1436, 930, 1437, 938, 1217, 1438, 934, 924, 2599, 689,
+	1771, 1041, 687, 2578, 2333, 1194, 935, 184, 185, 186,
+	1214, 1544, 1095, 1250, 1096, 1097, 1245, 1246, 1117, 1118,
+	1119, 1120, 1122, 1121, 1123, 1124, 182, 1775, 930, 2180,
+	1313, 2580, 737, 1240, 924, 927, 928, 2270, 891, 1321,
+	1459, 2183, 921, 925, 1514, 1516, 1517, 2530, 156, 161,
+	158, 164, 165, 166, 167, 169, 170, 171, 172, 2497,
+	546, 2597, 1346, 920, 173, 174, 175, 176, 1515, 1095,
+	1355, 1096, 1097, 1095, 1357, 1096, 1097, 546, 546, 2531,
+	546, 1545, 546, 546, 2456, 546, 546, 546, 546, 546,
+	546, 2498, 1771, 1262, 1119, 1120, 1122, 1121, 1123, 1124,
+	546, 2402, 1212, 2401, 182, 1393, 1200, 1114, 1115, 1116,
+	1117, 1118, 1119, 1120, 1122, 1121, 1123, 1124, 1846, 1773,
+	182, 2350, 728, 1095, 2579, 1096, 1097, 1845, 1356, 2349,
+	1342, 546, 2085, 182, 87, 2598, 1327, 1328, 2268, 1212,
+	1095, 2209, 1096, 1097, 1447, 2199, 1851, 546, 1522, 182,
+	1833, 1834, 1835, 1388, 1389, 1335, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1099, 1868, 182, 1095, 1429, 1096, 1097,
+	1390, 1392, 182, 1354, 632, 633, 634, 1095, 1427, 1096,
+	1097, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	546, 546, 546, 1976, 1212, 1319, 1396, 1397, 1417, 1975,
+	1433, 1566, 1402, 1403, 1362, 1353, 1363, 1734, 1365, 1367,
+	1430, 1332, 1371, 1373, 1375, 1377, 1379, 1345, 1850, 1352,
+	1352, 182, 1415, 1333, 1454, 1414, 1331, 1413, 1404, 2510,
+	1428, 1406, 1463, 1462, 1654, 1655, 1225, 1212, 1398, 1467,
+	1466, 1426, 1468, 1469, 1470, 1471, 1212, 1395, 1394, 1475,
+	1478, 1479, 1480, 1481, 1482, 1483, 1484, 1095, 1369, 1096,
+	1097, 1416, 2501, 1489, 1391, 1520, 1543, 1095, 1893, 1096,
+	1097, 1892, 2500, 2499, 2425, 1095, 1450, 1096, 1097, 1552,
+	546, 2423, 2398, 2275, 1095, 1226, 1096, 1097, 1502, 1229,
+	2347, 119, 908, 2339, 1985, 546, 546, 1527, 1973, 1529,
+	1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539,
+	1540, 1541, 1528, 1464, 1518, 907, 1325, 1787, 2454, 1863,
+	2173, 1603, 1095, 1844, 1096, 1097, 1460, 1461, 182, 2369,
+	1786, 1645, 1095, 546, 1096, 1097, 1485, 1486, 1487, 1625,
+	1465, 1488, 1563, 1547, 1624, 1546, 1491, 1472, 1473, 1474,
+	1455, 184, 185, 186, 1228, 2340, 1095, 182, 1096, 1097,
+	546, 184, 185, 186, 1630, 2174, 1631, 1095, 1521, 1096,
+	1097, 1418, 1405, 1401, 182, 1977, 1400, 546, 1605, 184,
+	185, 186, 182, 1960, 182, 1399, 182, 182, 546, 1227,
+	2176, 546, 1054, 1095, 2171, 1096, 1097, 2239, 1603, 1607,
+	1608, 95, 546, 184, 185, 186, 733, 1747, 1067, 733,
+	1212, 2181, 2182, 1095, 2482, 1096, 1097, 2481, 2172, 1095,
+	95, 1096, 1097, 1095, 2450, 1096, 1097, 1904, 1636, 184,
+	185, 186, 1604, 1745, 1908, 2593, 92, 92, 1908, 2549,
+	2449, 1656, 94, 94, 2385, 1664, 2009, 93, 93, 1660,
+	2178, 1663, 1908, 2536, 1502, 1605, 1949, 546, 94, 1705,
+	1706, 1707, 1708, 1740, 1741, 1742, 1908, 2534, 1744, 1746,
+	2526, 1212, 1691, 101, 2160, 1690, 1988, 702, 1908, 2515,
+	1673, 1695, 546, 1828, 100, 1919, 99, 2306, 546, 1355,
+	2377, 2512, 1355, 1700, 1355, 1701, 1702, 1703, 1704, 1842,
+	1770, 1212, 1212, 1760, 1696, 1721, 1638, 101, 2308, 1659,
+	1094, 1711, 1712, 1713, 1714, 2180, 1212, 1694, 100, 1950,
+	99, 1727, 1657, 1908, 2457, 2377, 1212, 2183, 1952, 94,
+	546, 1919, 1543, 1678, 1908, 2375, 1113, 1543, 1543, 1771,
+	1212, 1548, 1693, 1692, 2311, 1212, 2191, 2190, 1554, 1555,
+	619, 737, 1757, 1212, 737, 1677, 1927, 2065, 1114, 1115,
+	1116, 1117, 1118, 1119, 1120, 1122, 1121, 1123, 1124, 2187,
+	2188, 1606, 1949, 182, 1609, 1610, 2187, 2186, 1927, 1212,
+	182, 1842, 1212, 1828, 2028, 182, 182, 1212, 1722, 182,
+	2518, 182, 1765, 100, 1735, 1768, 1733, 1769, 182, 1732,
+	1780, 183, 2306, 1743, 183, 182, 1926, 183, 1717, 1718,
+	1635, 1908, 547, 1927, 183, 1316, 2013, 2005, 2006, 1763,
+	1764, 1722, 183, 1782, 932, 2189, 1781, 1767, 1784, 1785,
+	1772, 2175, 933, 182, 546, 1950, 2103, 183, 2067, 1679,
+	1502, 1842, 1352, 1860, 1828, 1908, 1907, 1799, 1094, 1212,
+	1316, 1315, 1810, 1811, 1261, 1260, 1815, 1859, 2128, 547,
+	1927, 1842, 547, 183, 547, 1818, 1790, 1113, 2306, 2058,
+	1819, 1820, 1821, 1771, 1754, 1822, 1652, 1216, 1614, 1499,
+	1520, 1444, 2241, 1247, 1823, 692, 915, 1771, 914, 1114,
+	1115, 1116, 1117, 1118, 1119, 1120, 1122, 1121, 1123, 1124,
+	1824, 2077, 2076, 2075, 2069, 2568, 2073, 2352, 2068, 2539,
+	2066, 1837, 87, 1839, 1384, 2071, 2471, 1812, 1219, 2447,
+	2437, 2367, 1113, 2364, 2070, 1109, 1838, 1110, 1115, 1116,
+	1117, 1118, 1119, 1120, 1122, 1121, 1123, 1124, 2345, 2072,
+	2074, 1111, 1112, 1108, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1122, 1121, 1123, 1124, 182, 2279, 2353, 2354, 2355,
+	2278, 2356, 87, 182, 1385, 1386, 1387, 1318, 1826, 546,
+	1879, 1720, 2237, 2214, 2575, 546, 2210, 2014, 1716, 1710,
+	1709, 1432, 1347, 1521, 1343, 1314, 113, 1981, 546, 1836,
+	2212, 1932, 1935, 1936, 1937, 1933, 1982, 1934, 1938, 997,
+	1896, 2318, 2319, 1381, 2318, 2319, 2472, 2557, 2357, 2358,
+	1731, 182, 37, 182, 1923, 1113, 1628, 1840, 2321, 2206,
+	2205, 1943, 2204, 2128, 1992, 1854, 1813, 1605, 1959, 1492,
+	1909, 2150, 2324, 2407, 1982, 2406, 2151, 1114, 1115, 1116,
+	1117, 1118, 1119, 1120, 1122, 1121, 1123, 1124, 2148, 2323,
+	1382, 1383, 1866, 2149, 1932, 1935, 1936, 1937, 1933, 1905,
+	1934, 1938, 2152, 2147, 1936, 1937, 2146, 2573, 2550, 1644,
+	704, 1224, 546, 1634, 1890, 1186, 2312, 182, 1880, 2108,
+	1946, 1604, 2107, 2405, 182, 2011, 1970, 1971, 1942, 2299,
+	2496, 2120, 2122, 2416, 2302, 2418, 546, 2298, 1901, 1906,
+	2123, 1210, 1206, 546, 1963, 707, 1947, 1355, 1355, 1916,
+	2117, 1443, 546, 708, 678, 2185, 1207, 1967, 1986, 705,
+	1558, 962, 1951, 961, 2025, 2251, 603, 2004, 1981, 92,
+	1954, 1727, 2053, 1961, 1559, 182, 182, 182, 182, 182,
+	93, 1632, 1633, 1209, 1990, 1208, 1066, 1964, 101, 1210,
+	1206, 92, 2021, 182, 182, 1984, 2020, 1974, 94, 100,
+	2304, 99, 93, 1983, 1207, 1852, 120, 1654, 1655, 182,
+	94, 94, 2202, 1993, 1994, 1995, 1816, 1989, 2511, 2467,
+	2023, 2184, 1940, 1639, 715, 716, 1805, 1543, 545, 1203,
+	1204, 1209, 2106, 1208, 1335, 2284, 1832, 99, 2488, 2487,
+	2105, 2424, 2036, 2037, 2038, 2039, 2040, 2422, 1870, 1871,
+	2015, 2016, 2421, 101, 546, 2414, 2022, 2365, 688, 2303,
+	1502, 2046, 2301, 2024, 100, 100, 99, 2215, 2082, 1755,
+	546, 1330, 2097, 101, 714, 735, 2054, 2413, 888, 2292,
+	895, 1904, 2061, 1919, 100, 182, 2045, 2092, 183, 546,
+	183, 2577, 2576, 183, 2060, 1861, 1626, 1241, 546, 1233,
+	106, 107, 2577, 2502, 2338, 546, 546, 103, 182, 182,
+	182, 182, 182, 88, 1, 694, 2097, 627, 2079, 2134,
+	182, 547, 547, 547, 2078, 182, 2125, 182, 2062, 182,
+	2110, 2559, 182, 182, 182, 1199, 1943, 2140, 515, 547,
+	547, 1615, 1184, 529, 2111, 2555, 1419, 2096, 1409, 2388,
+	2468, 2010, 1761, 2363, 2098, 1725, 923, 2131, 145, 1688,
+	1689, 2159, 2131, 2109, 2545, 110, 884, 2200, 2112, 2051,
+	109, 926, 2052, 182, 1025, 1756, 2378, 1968, 1697, 1267,
+	1265, 1266, 695, 1264, 2141, 1269, 546, 2144, 2161, 1268,
+	696, 2162, 1263, 1862, 1493, 546, 2102, 543, 2153, 1939,
+	182, 180, 2157, 2158, 95, 2142, 2143, 1256, 2145, 1454,
+	182, 2163, 1234, 963, 505, 2192, 1788, 2169, 2099, 2100,
+	2101, 511, 1137, 2104, 1955, 182, 2221, 734, 182, 2196,
+	727, 2195, 2139, 1627, 1894, 2136, 2296, 2116, 2252, 2118,
+	2203, 1911, 2229, 2121, 2217, 2228, 2114, 2495, 2197, 2198,
+	2415, 2537, 1965, 1230, 1853, 1560, 2216, 1669, 1623, 1727,
+	2220, 2232, 1513, 568, 567, 565, 1897, 2224, 1920, 1100,
+	183, 622, 1884, 1242, 547, 547, 1931, 2233, 1929, 1928,
+	1814, 1674, 2320, 2316, 546, 182, 1667, 1903, 2247, 2246,
+	183, 576, 2245, 569, 561, 2248, 620, 2335, 2227, 2031,
+	2254, 2249, 2250, 2260, 2238, 2033, 1966, 2234, 1079, 1202,
+	547, 555, 2253, 896, 183, 1557, 2473, 2459, 1831, 547,
+	2272, 1201, 1569, 2285, 2286, 547, 1570, 2207, 1748, 63,
+	2257, 2258, 41, 2259, 1594, 550, 2261, 2290, 2263, 2569,
+	2265, 182, 1070, 721, 32, 31, 30, 2293, 2300, 29,
+	28, 23, 2289, 22, 21, 20, 19, 25, 18, 2341,
+	2305, 17, 16, 115, 182, 50, 47, 2325, 2315, 45,
+	122, 121, 48, 2322, 44, 1000, 42, 27, 26, 15,
+	14, 2328, 182, 13, 12, 182, 182, 182, 11, 2330,
+	2331, 10, 2336, 9, 2229, 546, 546, 2228, 5, 2337,
+	2329, 4, 35, 34, 33, 1073, 24, 2, 1997, 1750,
+	0, 2346, 0, 2348, 0, 0, 2373, 0, 0, 0,
+	0, 0, 546, 546, 546, 546, 2370, 2371, 0, 0,
+	0, 2344, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2384, 0, 0, 2359,
+	2382, 2383, 2360, 2361, 2362, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
+	2397, 0, 2393, 2394, 0, 0, 0, 0, 0, 0,
+	0, 0, 546, 0, 546, 0, 0, 735, 735, 735,
+	0, 37, 2410, 2431, 0, 2134, 2420, 2433, 2411, 2134,
+	547, 0, 2419, 0, 0, 1069, 1071, 2429, 0, 0,
+	2427, 688, 0, 0, 0, 0, 0, 547, 547, 0,
+	547, 2435, 547, 547, 0, 547, 547, 547, 547, 547,
+	547, 0, 2131, 2442, 0, 0, 0, 0, 546, 0,
+	547, 0, 0, 0, 183, 0, 2439, 2440, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2453, 546, 2458,
+	183, 2452, 2451, 0, 0, 0, 2462, 2463, 0, 2455,
+	0, 547, 0, 183, 0, 546, 2470, 0, 0, 546,
+	546, 0, 0, 0, 1178, 0, 0, 547, 0, 183,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2492,
+	2494, 2489, 2490, 0, 2491, 183, 0, 0, 546, 0,
+	0, 0, 183, 0, 0, 2503, 0, 2134, 546, 0,
+	0, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+	547, 547, 547, 2505, 0, 688, 0, 0, 0, 2506,
+	1190, 1191, 546, 182, 0, 2507, 0, 0, 37, 2509,
+	2517, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2520, 0, 546, 1237, 0, 0, 0,
+	0, 0, 0, 0, 0, 735, 0, 0, 0, 546,
+	546, 1257, 37, 0, 2538, 0, 2544, 546, 2540, 0,
+	0, 0, 0, 2532, 0, 2535, 0, 2470, 2546, 0,
+	2521, 0, 0, 0, 0, 2566, 2558, 2563, 0, 0,
+	547, 0, 0, 0, 0, 2572, 0, 0, 2131, 178,
+	2574, 0, 0, 0, 0, 547, 547, 0, 0, 546,
+	0, 0, 0, 0, 0, 0, 2583, 0, 0, 0,
+	2590, 0, 0, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2600, 162, 0, 183, 0,
+	2581, 0, 0, 547, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 0, 0,
+	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 0, 0, 547, 0, 0,
+	0, 0, 183, 159, 183, 160, 183, 183, 547, 0,
+	0, 547, 0, 0, 0, 177, 0, 0, 0, 0,
+	0, 0, 547, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 719, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 888, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1178,
+	0, 0, 0, 1361, 1361, 0, 1361, 547, 1361, 1361,
+	0, 1370, 1361, 1361, 1361, 1361, 1361, 0, 0, 0,
+	0, 0, 0, 0, 1178, 1178, 888, 0, 0, 0,
+	0, 0, 547, 0, 0, 0, 0, 0, 547, 0,
+	559, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 1431, 0, 0,
+	0, 0, 168, 0, 0, 0, 0, 0, 0, 184,
+	185, 186, 713, 1451, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 533, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 735, 735, 735, 0,
+	0, 0, 0, 183, 0, 0, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 183, 183, 0, 0, 183,
+	0, 183, 0, 520, 0, 0, 0, 0, 183, 0,
 	0, 0, 0, 0, 0, 183, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 0, 183, 183, 0,
-	0, 183, 0, 183, 0, 0, 0, 0, 0, 0,
-	183, 0, 0, 0, 0, 0, 0, 183, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2558, 0,
-	0, 0, 0, 0, 0, 183, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 127, 153, 134, 126, 0, 147, 148,
-	0, 0, 0, 0, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 168, 135, 0, 0, 0, 0, 0,
-	0, 628, 79, 0, 0, 0, 0, 0, 138, 136,
-	131, 132, 133, 137, 0, 0, 0, 733, 733, 733,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 139,
-	0, 0, 0, 0, 0, 1067, 1069, 0, 0, 0,
+	0, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 547, 0, 0, 0, 0, 519,
+	0, 604, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 517, 0, 0, 0, 0, 1553, 0, 0, 0,
+	0, 0, 0, 1178, 0, 0, 0, 0, 0, 0,
+	0, 1567, 1568, 0, 0, 0, 0, 0, 0, 735,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 514, 181, 0, 0, 501, 0, 0, 541, 0,
+	528, 0, 0, 0, 0, 501, 0, 0, 0, 1629,
+	0, 0, 0, 501, 0, 525, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 701, 0,
+	0, 0, 0, 0, 0, 0, 1641, 0, 0, 0,
+	0, 0, 0, 0, 720, 0, 720, 0, 0, 534,
+	0, 0, 0, 1237, 501, 183, 735, 0, 0, 0,
+	0, 0, 0, 183, 735, 0, 0, 735, 0, 547,
+	0, 0, 0, 0, 0, 547, 0, 0, 888, 504,
+	0, 506, 521, 0, 536, 0, 535, 510, 547, 508,
+	512, 522, 513, 0, 507, 0, 518, 0, 0, 509,
+	523, 524, 526, 540, 539, 527, 0, 516, 537, 0,
+	1211, 183, 0, 183, 0, 0, 0, 0, 0, 156,
+	161, 158, 164, 165, 166, 167, 169, 170, 171, 172,
+	0, 0, 0, 895, 0, 173, 174, 175, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 888, 0,
+	0, 0, 0, 0, 895, 0, 0, 0, 0, 0,
+	0, 0, 547, 0, 0, 0, 0, 183, 629, 79,
+	0, 0, 0, 0, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 0,
+	0, 0, 0, 547, 0, 0, 888, 0, 0, 0,
+	0, 0, 547, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1284, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 183, 183, 183, 183,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 183, 538, 691, 0, 79, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	0, 0, 0, 531, 0, 0, 691, 0, 0, 0,
+	0, 0, 0, 1098, 0, 0, 0, 0, 532, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1825, 1145, 0, 0, 547, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 0, 0, 0, 547,
+	0, 0, 559, 1272, 0, 0, 0, 0, 547, 0,
+	0, 0, 0, 0, 0, 547, 547, 0, 183, 183,
+	183, 183, 183, 0, 0, 0, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 183, 0, 183, 0, 183,
+	0, 0, 183, 183, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 735, 0,
+	0, 0, 0, 183, 1285, 0, 0, 1232, 0, 501,
+	0, 501, 0, 0, 501, 1641, 547, 0, 0, 0,
+	0, 1898, 0, 0, 0, 547, 0, 0, 0, 0,
+	183, 0, 0, 0, 1913, 0, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 0, 0, 183, 1298,
+	1301, 1302, 1303, 1304, 1305, 1306, 0, 1307, 1308, 1309,
+	1310, 1311, 1286, 1287, 1288, 1289, 1270, 1271, 1299, 0,
+	1273, 0, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 547, 183, 0, 0, 1987, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1179,
+	0, 0, 1641, 0, 0, 0, 0, 0, 0, 2012,
+	0, 0, 0, 0, 0, 0, 0, 0, 2017, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 501, 1300, 0, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 701, 183, 0, 0, 183, 183, 183, 0, 0,
+	0, 0, 0, 0, 0, 547, 547, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 547, 547, 547, 547, 0, 0, 0, 0,
+	735, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1361, 0, 0, 1061,
+	1061, 1061, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2113, 0, 0, 183, 79,
+	0, 0, 0, 0, 735, 1456, 0, 0, 1178, 0,
+	0, 2138, 1361, 1178, 0, 0, 0, 0, 0, 0,
+	0, 0, 547, 0, 547, 0, 691, 1133, 1134, 1135,
+	0, 1138, 0, 1140, 1141, 1142, 1143, 0, 1146, 1148,
+	1148, 0, 1148, 1152, 1152, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 0, 1163, 1164, 1165, 1166, 1167, 0,
+	0, 0, 0, 1152, 1152, 1152, 1152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1213, 1215, 547, 0,
+	0, 0, 0, 0, 0, 0, 0, 1509, 1510, 1511,
+	1512, 0, 888, 0, 0, 1178, 0, 501, 547, 0,
+	0, 1641, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 0, 0, 547,
+	547, 0, 0, 0, 0, 0, 0, 1550, 1551, 0,
+	1188, 0, 0, 0, 0, 1556, 691, 0, 0, 0,
+	691, 0, 0, 0, 1179, 0, 691, 0, 547, 0,
+	1591, 1592, 1593, 1595, 0, 0, 0, 0, 547, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1179,
+	1179, 0, 0, 0, 0, 501, 0, 0, 0, 0,
+	0, 559, 547, 183, 0, 0, 0, 0, 0, 0,
+	2113, 1407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 0, 0, 0,
+	1453, 1650, 1651, 0, 0, 0, 0, 0, 0, 547,
+	547, 0, 0, 0, 0, 0, 501, 547, 0, 0,
+	0, 0, 0, 501, 0, 0, 0, 0, 1685, 0,
+	0, 0, 1476, 1477, 501, 501, 501, 501, 501, 501,
+	501, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 547,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 501, 0, 0, 0, 0, 0, 0, 0,
+	0, 1641, 1641, 0, 0, 0, 0, 1723, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2389, 2390,
+	2391, 2392, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 720, 0, 0, 0, 0,
+	0, 0, 720, 720, 0, 0, 0, 0, 1179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 720, 1453, 720, 720, 720, 720, 720,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1178, 0, 0, 0, 0, 2432, 1407,
+	2434, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 720, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 701, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 0, 0, 0, 0,
+	0, 1453, 0, 501, 1641, 501, 0, 501, 1676, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 735, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2485, 0, 0, 0, 2485, 2485, 0, 1061, 1061,
+	1061, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1641, 0, 0, 0, 178, 1564,
+	0, 0, 0, 1565, 1641, 0, 0, 0, 0, 2003,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 120, 0, 142, 1856, 0, 0, 1641, 0,
+	0, 0, 1213, 1613, 0, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1145, 0, 1178,
+	0, 2533, 0, 0, 0, 1637, 152, 0, 0, 0,
+	0, 141, 0, 0, 0, 735, 735, 0, 0, 0,
+	0, 0, 0, 2553, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 0, 160, 0, 0, 0, 0, 0,
+	1338, 1339, 151, 150, 177, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 1232, 0, 0, 0, 0,
+	0, 501, 0, 0, 0, 1641, 501, 501, 0, 0,
+	501, 0, 1817, 0, 0, 0, 0, 0, 0, 501,
+	0, 0, 0, 0, 0, 0, 501, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1334, 0,
+	0, 0, 0, 0, 1670, 0, 0, 0, 0, 0,
+	0, 120, 0, 142, 501, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	146, 1340, 153, 0, 1337, 0, 147, 148, 0, 0,
+	0, 0, 163, 0, 0, 152, 0, 0, 0, 0,
+	141, 168, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 720,
+	0, 159, 0, 160, 0, 0, 0, 0, 0, 1338,
+	1339, 151, 150, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 720, 720, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1453, 0, 0, 501, 0, 0, 0,
+	0, 0, 0, 0, 1407, 0, 0, 0, 0, 0,
+	0, 0, 0, 2080, 2081, 0, 0, 0, 2084, 0,
+	0, 0, 2086, 2087, 2088, 0, 0, 0, 0, 0,
+	0, 2093, 0, 155, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 146,
+	1340, 153, 501, 1337, 501, 147, 148, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2126, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1843,
+	0, 0, 0, 1847, 0, 1848, 1849, 0, 149, 0,
+	0, 0, 0, 0, 1857, 0, 0, 1858, 501, 0,
+	0, 0, 0, 0, 143, 1996, 0, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1864, 1865, 0, 1867, 0, 0, 0,
+	1869, 0, 0, 0, 0, 0, 0, 1874, 1875, 1876,
+	1877, 1878, 0, 1637, 2213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1891, 0, 501, 501, 501, 501,
+	501, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 501, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1872, 0, 720, 0, 0, 0, 156, 161,
+	158, 164, 165, 166, 167, 169, 170, 171, 172, 1889,
+	0, 0, 0, 0, 173, 174, 175, 176, 2274, 0,
+	0, 0, 0, 0, 720, 2280, 0, 149, 691, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 144, 0, 0, 0,
+	1924, 1925, 0, 0, 0, 0, 501, 0, 0, 1944,
+	1945, 0, 0, 0, 0, 0, 0, 0, 559, 0,
+	0, 0, 0, 1179, 0, 0, 0, 0, 1179, 501,
+	501, 501, 501, 501, 0, 0, 0, 0, 0, 0,
+	0, 2154, 0, 0, 0, 0, 501, 0, 1407, 0,
+	501, 0, 0, 501, 2164, 1453, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2366, 501, 0, 0, 156, 161, 158,
+	164, 165, 166, 167, 169, 170, 171, 172, 0, 2381,
+	1179, 2018, 0, 173, 174, 175, 176, 0, 0, 0,
+	0, 501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 501, 0, 2089, 0, 0, 0, 0, 0, 0,
+	0, 2094, 2095, 0, 0, 0, 501, 0, 0, 501,
+	0, 2395, 0, 2396, 0, 0, 0, 0, 2399, 2400,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2428, 0, 0, 0, 0, 2137, 0, 0, 0, 0,
+	0, 2436, 0, 0, 2438, 0, 501, 0, 0, 0,
+	0, 0, 2155, 2156, 0, 0, 0, 2441, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2448, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1670, 0, 0, 0, 0, 0, 0,
+	0, 78, 39, 40, 80, 0, 0, 559, 0, 0,
+	0, 2135, 501, 79, 0, 0, 1670, 1670, 1670, 1670,
+	1670, 84, 0, 0, 0, 43, 69, 70, 0, 67,
+	71, 0, 0, 1944, 691, 501, 0, 1670, 68, 0,
+	1670, 0, 0, 0, 0, 0, 2493, 559, 0, 0,
+	0, 0, 0, 501, 0, 0, 501, 501, 501, 0,
+	0, 0, 0, 0, 0, 0, 0, 56, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
+	0, 0, 0, 0, 0, 2256, 0, 0, 0, 0,
+	0, 0, 0, 559, 0, 0, 0, 0, 0, 2266,
+	2267, 2269, 2271, 0, 0, 0, 0, 0, 0, 2277,
+	2226, 0, 0, 0, 2281, 0, 0, 2282, 0, 0,
+	0, 0, 0, 2287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2255, 0, 0, 0, 2309, 2310, 1179, 0,
+	2314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2567, 0, 0, 0, 0, 0, 2326, 2327,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 46, 49, 52, 51, 54, 0, 66, 0, 0,
+	75, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 55, 83, 82, 0, 0, 64, 65,
+	53, 0, 0, 2376, 0, 0, 73, 74, 0, 1670,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2334, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 58, 0, 59, 60, 61, 62,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2403, 0, 0, 0, 0, 0, 0, 2374, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2404, 0, 2408, 2409, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2135, 0, 79,
+	0, 2135, 2466, 0, 0, 0, 0, 0, 0, 81,
+	0, 0, 0, 0, 0, 0, 2476, 2477, 2478, 0,
+	2479, 2480, 0, 0, 0, 2484, 86, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2460, 2508, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2522, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2527, 0, 0, 0, 0, 0,
+	2528, 2529, 0, 0, 0, 0, 0, 0, 0, 2135,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1157, 1158, 1159, 1160, 0, 2541, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1211, 1213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 0, 690,
-	0, 79, 0, 0, 0, 183, 0, 0, 0, 0,
-	0, 546, 0, 0, 0, 0, 0, 546, 0, 690,
-	0, 0, 0, 0, 155, 0, 0, 0, 546, 0,
-	0, 0, 0, 0, 1176, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	79, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 2547,
+	0, 2548, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1188, 1189, 0, 0, 603, 0, 0, 0, 0, 149,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 546, 0, 0, 143, 0, 183, 144, 0,
-	0, 0, 0, 0, 183, 1231, 0, 0, 0, 0,
-	0, 0, 0, 0, 733, 0, 546, 0, 0, 0,
-	1251, 0, 0, 546, 0, 181, 0, 0, 500, 0,
-	0, 540, 546, 0, 0, 0, 0, 0, 500, 0,
-	0, 0, 0, 0, 0, 0, 500, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 183, 183, 183, 183,
-	0, 700, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 183, 0, 0, 0, 718, 0, 718,
-	0, 0, 0, 0, 0, 0, 0, 500, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
-	161, 158, 164, 165, 166, 167, 169, 170, 171, 172,
-	0, 0, 0, 0, 0, 173, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 546,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 0, 0, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 0, 0,
-	0, 0, 0, 0, 546, 546, 0, 183, 183, 183,
-	183, 183, 0, 0, 0, 0, 0, 0, 0, 183,
-	0, 0, 0, 0, 183, 886, 183, 0, 183, 0,
-	0, 183, 183, 183, 0, 0, 0, 0, 1176, 0,
-	0, 0, 1355, 1355, 0, 1355, 0, 1355, 1355, 0,
-	1364, 1355, 1355, 1355, 1355, 1355, 0, 0, 0, 0,
-	0, 0, 0, 1176, 1176, 886, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 1425, 0, 0, 183,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	0, 0, 1445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 0, 183, 0, 0,
-	0, 0, 0, 0, 0, 0, 1558, 0, 0, 0,
-	1559, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1059, 1059, 1059, 0, 733, 733, 733, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1211,
-	1607, 79, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 546, 183, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 690, 1131,
-	1132, 1133, 1631, 1136, 0, 1138, 1139, 1140, 1141, 0,
-	1144, 1146, 1146, 0, 1146, 1150, 1150, 1152, 1153, 1154,
-	1155, 1156, 1157, 1158, 1159, 0, 1161, 1162, 1163, 1164,
-	1165, 0, 0, 0, 0, 1150, 1150, 1150, 1150, 0,
-	183, 0, 0, 0, 0, 1547, 0, 0, 0, 0,
-	0, 0, 1176, 0, 0, 0, 0, 0, 0, 0,
-	1561, 1562, 0, 183, 0, 0, 0, 0, 733, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 183, 183, 183, 0, 0, 0,
-	0, 500, 0, 500, 546, 546, 500, 0, 1623, 0,
-	0, 0, 1186, 0, 0, 0, 0, 0, 690, 0,
-	0, 0, 690, 0, 0, 0, 0, 0, 690, 0,
-	0, 546, 546, 546, 546, 1635, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1231, 0,
-	0, 733, 0, 0, 0, 0, 0, 0, 0, 733,
-	0, 0, 733, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 886, 0, 0, 0, 183, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 546, 0, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 78, 39,
-	40, 80, 0, 0, 0, 0, 0, 0, 893, 0,
-	0, 1177, 0, 0, 0, 0, 0, 0, 84, 0,
-	0, 0, 43, 69, 70, 0, 67, 71, 0, 0,
-	0, 0, 0, 886, 0, 68, 0, 546, 0, 893,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 0, 0,
-	0, 0, 0, 500, 56, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 546, 87, 0, 0, 546, 546,
-	0, 886, 0, 700, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 500, 546, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 0, 0,
-	0, 0, 1834, 0, 0, 0, 1838, 0, 1839, 1840,
-	0, 0, 0, 0, 0, 0, 0, 1848, 0, 0,
-	1849, 546, 183, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1855, 1856, 0, 1858,
-	0, 0, 0, 1860, 546, 0, 0, 0, 0, 0,
-	1865, 1866, 1867, 1868, 1869, 1816, 1631, 0, 546, 546,
-	0, 0, 0, 0, 0, 0, 546, 1882, 46, 49,
-	52, 51, 54, 0, 66, 0, 0, 75, 72, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	55, 83, 82, 0, 0, 64, 65, 53, 546, 0,
-	0, 0, 0, 73, 74, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1059,
-	1059, 1059, 0, 0, 0, 0, 0, 0, 500, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 57,
-	58, 0, 59, 60, 61, 62, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 733, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1177, 0, 0, 0, 0,
-	1635, 0, 0, 0, 0, 0, 1889, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1904, 0, 0,
-	1177, 1177, 0, 0, 0, 0, 500, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 500, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1447, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 81, 0, 500, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 0, 0, 0,
-	0, 1978, 86, 1470, 1471, 500, 500, 500, 500, 500,
-	500, 500, 0, 2080, 0, 0, 0, 0, 0, 0,
-	0, 2085, 2086, 0, 0, 1635, 0, 0, 0, 0,
-	0, 1662, 2003, 0, 0, 0, 0, 0, 0, 0,
-	0, 2008, 0, 500, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 2128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2146, 2147, 0, 0, 718, 0, 532, 0,
-	0, 0, 0, 718, 718, 0, 0, 0, 0, 1177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 718, 1447, 718, 718, 718, 718,
-	718, 0, 0, 0, 0, 0, 0, 0, 0, 519,
-	0, 0, 733, 0, 0, 0, 0, 0, 0, 0,
-	1401, 0, 0, 0, 0, 0, 0, 0, 1355, 0,
-	0, 0, 0, 0, 0, 718, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2104, 0, 700,
-	0, 0, 0, 0, 0, 518, 733, 0, 0, 0,
-	1176, 0, 500, 2129, 1355, 1176, 0, 516, 1447, 0,
-	500, 0, 500, 0, 500, 1668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2247, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2257,
-	2258, 2260, 2262, 0, 0, 0, 0, 513, 0, 2268,
-	0, 0, 0, 0, 2272, 0, 527, 2273, 0, 0,
-	0, 0, 0, 2278, 0, 0, 0, 0, 0, 0,
-	0, 524, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 886, 0, 0, 1176, 0, 0,
-	0, 0, 0, 1635, 0, 0, 2300, 2301, 0, 0,
-	2305, 0, 0, 0, 533, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2317, 2318,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 503, 0, 505, 520, 0, 535,
-	0, 534, 509, 0, 507, 511, 521, 512, 0, 506,
-	0, 517, 0, 0, 508, 522, 523, 525, 539, 538,
-	526, 0, 515, 536, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2104, 2367, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1863,
-	0, 500, 0, 0, 0, 0, 0, 0, 500, 0,
-	0, 0, 0, 500, 500, 0, 1880, 500, 0, 1808,
-	0, 0, 0, 0, 0, 0, 500, 0, 0, 0,
-	0, 0, 0, 500, 0, 690, 0, 0, 0, 0,
-	0, 2394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1915, 1916, 0, 0, 0,
-	0, 500, 0, 0, 1935, 1936, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1635, 1635, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	537, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2380, 2381, 2382, 2383, 0, 0, 718, 0, 530, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2457, 531, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2467, 2468, 2469, 0,
-	2470, 2471, 0, 0, 0, 2475, 2009, 0, 0, 718,
-	718, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1447, 0, 0, 500, 0, 1176, 0, 0, 0, 0,
-	2423, 1401, 2425, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2499, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2513, 0, 0, 500, 0, 500,
-	0, 0, 0, 0, 2518, 0, 1635, 0, 0, 0,
-	2519, 2520, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 733, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2532, 0, 0,
-	0, 0, 0, 2476, 0, 0, 0, 2476, 2476, 0,
-	0, 0, 0, 0, 0, 0, 0, 1662, 0, 0,
-	0, 0, 0, 500, 0, 0, 0, 0, 0, 0,
-	1987, 0, 0, 0, 0, 2126, 1635, 79, 0, 0,
-	1662, 1662, 1662, 1662, 1662, 0, 1635, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1935, 690, 0,
-	0, 1662, 0, 0, 1662, 0, 0, 0, 178, 1278,
-	1635, 0, 0, 0, 0, 0, 0, 0, 0, 1328,
-	0, 500, 500, 500, 500, 500, 0, 0, 0, 0,
-	0, 0, 120, 0, 142, 0, 0, 0, 500, 500,
-	0, 1176, 0, 2524, 0, 162, 0, 0, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 733, 733, 0,
-	0, 0, 0, 0, 0, 2544, 0, 0, 718, 0,
-	0, 0, 0, 0, 2217, 0, 152, 0, 0, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 718, 0,
-	0, 0, 159, 0, 160, 0, 0, 1635, 0, 0,
-	1332, 1333, 151, 150, 177, 0, 2246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 0, 0, 0, 0, 0, 0, 0, 1266,
-	0, 0, 0, 0, 0, 0, 0, 1177, 0, 0,
-	0, 0, 1177, 500, 500, 500, 500, 500, 0, 0,
-	0, 0, 0, 0, 0, 2145, 0, 0, 0, 0,
-	500, 0, 1401, 0, 500, 0, 0, 500, 2155, 1447,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	146, 1334, 153, 1662, 1331, 0, 147, 148, 500, 0,
-	1279, 0, 163, 0, 0, 0, 2325, 0, 0, 0,
-	0, 168, 0, 0, 1177, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 500, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 500, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 2365, 500, 1292, 1295, 1296, 1297, 1298, 1299,
-	1300, 0, 1301, 1302, 1303, 1304, 1305, 1280, 1281, 1282,
-	1283, 1264, 1265, 1293, 0, 1267, 0, 1268, 1269, 1270,
-	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 0, 0, 0, 0, 2395, 0, 2399, 2400,
-	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2126, 87, 79, 0, 2126, 0, 0, 622, 629,
-	630, 631, 632, 633, 623, 625, 0, 0, 0, 624,
-	0, 0, 627, 634, 635, 0, 500, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1294, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 149, 0, 0,
-	0, 0, 0, 0, 0, 2451, 0, 500, 2221, 2222,
-	500, 500, 500, 143, 0, 0, 144, 0, 0, 0,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 0, 0, 2126, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1155, 1156, 1157, 1158,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1401, 0, 0, 0, 0, 0, 0,
-	79, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1177, 0, 0, 0, 0, 156, 161, 158,
-	164, 165, 166, 167, 169, 170, 171, 172, 0, 0,
-	0, 0, 0, 173, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 79, 864, 849, 421, 0, 796,
-	867, 766, 784, 877, 787, 790, 831, 745, 810, 342,
-	781, 0, 770, 740, 776, 741, 768, 798, 244, 765,
-	851, 814, 866, 297, 241, 747, 771, 356, 786, 193,
-	833, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 873, 301, 820, 0, 406,
-	327, 0, 0, 0, 800, 855, 808, 845, 795, 832,
-	755, 819, 868, 782, 828, 869, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	2538, 0, 2539, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 778, 825, 863, 779, 827, 239, 285, 246,
-	238, 425, 874, 854, 744, 807, 862, 0, 0, 209,
-	865, 802, 0, 830, 0, 880, 739, 822, 0, 742,
-	746, 876, 858, 774, 249, 0, 0, 0, 500, 0,
-	0, 0, 799, 809, 842, 793, 0, 0, 0, 0,
-	0, 0, 0, 772, 0, 818, 0, 0, 0, 751,
-	743, 0, 0, 0, 0, 0, 0, 0, 1177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 797, 0, 0, 0, 754, 0, 773, 843,
-	0, 737, 268, 748, 328, 231, 0, 847, 857, 794,
-	459, 861, 792, 791, 837, 752, 853, 785, 296, 750,
-	293, 188, 205, 0, 783, 338, 379, 385, 852, 769,
-	777, 229, 775, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 817, 835, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 1061, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 764, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	848, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 840, 879, 351,
-	384, 219, 445, 405, 759, 763, 757, 758, 812, 813,
-	760, 870, 871, 872, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 844, 753, 0, 761, 762, 0, 850,
-	859, 860, 495, 313, 396, 442, 816, 187, 202, 299,
-	875, 373, 261, 473, 453, 449, 738, 756, 236, 767,
-	0, 0, 780, 788, 789, 801, 803, 804, 805, 806,
-	324, 823, 824, 826, 834, 836, 839, 841, 846, 856,
-	878, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 815, 821, 310, 254, 273, 284, 829, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 811, 838,
-	306, 422, 423, 280, 864, 849, 421, 0, 796, 867,
-	766, 784, 877, 787, 790, 831, 745, 810, 342, 781,
-	0, 770, 740, 776, 741, 768, 798, 244, 765, 851,
-	814, 866, 297, 241, 747, 771, 356, 786, 193, 833,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 873, 301, 820, 0, 406, 327,
-	0, 0, 0, 800, 855, 808, 845, 795, 832, 755,
-	819, 868, 782, 828, 869, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 778, 825, 863, 779, 827, 239, 285, 246, 238,
-	425, 874, 854, 744, 807, 862, 0, 0, 209, 865,
-	802, 0, 830, 0, 880, 739, 822, 0, 742, 746,
-	876, 858, 774, 249, 0, 0, 0, 0, 0, 0,
-	0, 799, 809, 842, 793, 0, 0, 0, 0, 0,
-	2156, 0, 772, 0, 818, 0, 0, 0, 751, 743,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 797, 0, 0, 0, 754, 0, 773, 843, 0,
-	737, 268, 748, 328, 231, 0, 847, 857, 794, 459,
-	861, 792, 791, 837, 752, 853, 785, 296, 750, 293,
-	188, 205, 0, 783, 338, 379, 385, 852, 769, 777,
-	229, 775, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 817, 835, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 1061, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 764, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 848,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 840, 879, 351, 384,
-	219, 445, 405, 759, 763, 757, 758, 812, 813, 760,
-	870, 871, 872, 477, 478, 479, 480, 481, 482, 483,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	2165, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
 	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 844, 753, 0, 761, 762, 0, 850, 859,
-	860, 495, 313, 396, 442, 816, 187, 202, 299, 875,
-	373, 261, 473, 453, 449, 738, 756, 236, 767, 0,
-	0, 780, 788, 789, 801, 803, 804, 805, 806, 324,
-	823, 824, 826, 834, 836, 839, 841, 846, 856, 878,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 815, 821, 310, 254, 273, 284, 829, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 811, 838, 306,
-	422, 423, 280, 864, 849, 421, 0, 796, 867, 766,
-	784, 877, 787, 790, 831, 745, 810, 342, 781, 0,
-	770, 740, 776, 741, 768, 798, 244, 765, 851, 814,
-	866, 297, 241, 747, 771, 356, 786, 193, 833, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 873, 301, 820, 0, 406, 327, 0,
-	0, 0, 800, 855, 808, 845, 795, 832, 755, 819,
-	868, 782, 828, 869, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	778, 825, 863, 779, 827, 239, 285, 246, 238, 425,
-	874, 854, 744, 807, 862, 0, 0, 209, 865, 802,
-	0, 830, 0, 880, 739, 822, 0, 742, 746, 876,
-	858, 774, 249, 0, 0, 0, 0, 0, 0, 0,
-	799, 809, 842, 793, 0, 0, 0, 0, 0, 2115,
-	0, 772, 0, 818, 0, 0, 0, 751, 743, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	797, 0, 0, 0, 754, 0, 773, 843, 0, 737,
-	268, 748, 328, 231, 0, 847, 857, 794, 459, 861,
-	792, 791, 837, 752, 853, 785, 296, 750, 293, 188,
-	205, 0, 783, 338, 379, 385, 852, 769, 777, 229,
-	775, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 817, 835, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 1061, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 764, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 848, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 840, 879, 351, 384, 219,
-	445, 405, 759, 763, 757, 758, 812, 813, 760, 870,
-	871, 872, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
-	0, 844, 753, 0, 761, 762, 0, 850, 859, 860,
-	495, 313, 396, 442, 816, 187, 202, 299, 875, 373,
-	261, 473, 453, 449, 738, 756, 236, 767, 0, 0,
-	780, 788, 789, 801, 803, 804, 805, 806, 324, 823,
-	824, 826, 834, 836, 839, 841, 846, 856, 878, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	815, 821, 310, 254, 273, 284, 829, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 811, 838, 306, 422,
-	423, 280, 864, 849, 421, 0, 796, 867, 766, 784,
-	877, 787, 790, 831, 745, 810, 342, 781, 0, 770,
-	740, 776, 741, 768, 798, 244, 765, 851, 814, 866,
-	297, 241, 747, 771, 356, 786, 193, 833, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 873, 301, 820, 0, 406, 327, 0, 0,
-	0, 800, 855, 808, 845, 795, 832, 755, 819, 868,
-	782, 828, 869, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 778,
-	825, 863, 779, 827, 239, 285, 246, 238, 425, 874,
-	854, 744, 807, 862, 0, 0, 209, 865, 802, 0,
-	830, 0, 880, 739, 822, 0, 742, 746, 876, 858,
-	774, 249, 0, 0, 0, 0, 0, 0, 0, 799,
-	809, 842, 793, 0, 0, 0, 0, 0, 1650, 0,
-	772, 0, 818, 0, 0, 0, 751, 743, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 797,
-	0, 0, 0, 754, 0, 773, 843, 0, 737, 268,
-	748, 328, 231, 0, 847, 857, 794, 459, 861, 792,
-	791, 837, 752, 853, 785, 296, 750, 293, 188, 205,
-	0, 783, 338, 379, 385, 852, 769, 777, 229, 775,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	817, 835, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 1061, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 764, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 848, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 840, 879, 351, 384, 219, 445,
-	405, 759, 763, 757, 758, 812, 813, 760, 870, 871,
-	872, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	844, 753, 0, 761, 762, 0, 850, 859, 860, 495,
-	313, 396, 442, 816, 187, 202, 299, 875, 373, 261,
-	473, 453, 449, 738, 756, 236, 767, 0, 0, 780,
-	788, 789, 801, 803, 804, 805, 806, 324, 823, 824,
-	826, 834, 836, 839, 841, 846, 856, 878, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 815,
-	821, 310, 254, 273, 284, 829, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 811, 838, 306, 422, 423,
-	280, 864, 849, 421, 0, 796, 867, 766, 784, 877,
-	787, 790, 831, 745, 810, 342, 781, 0, 770, 740,
-	776, 741, 768, 798, 244, 765, 851, 814, 866, 297,
-	241, 747, 771, 356, 786, 193, 833, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 873, 301, 820, 0, 406, 327, 0, 0, 0,
-	800, 855, 808, 845, 795, 832, 755, 819, 868, 782,
-	828, 869, 287, 226, 192, 339, 407, 259, 0, 87,
-	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 778, 825,
-	863, 779, 827, 239, 285, 246, 238, 425, 874, 854,
-	744, 807, 862, 0, 0, 209, 865, 802, 0, 830,
-	0, 880, 739, 822, 0, 742, 746, 876, 858, 774,
-	249, 0, 0, 0, 0, 0, 0, 0, 799, 809,
-	842, 793, 0, 0, 0, 0, 0, 0, 0, 772,
-	0, 818, 0, 0, 0, 751, 743, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 797, 0,
-	0, 0, 754, 0, 773, 843, 0, 737, 268, 748,
-	328, 231, 0, 847, 857, 794, 459, 861, 792, 791,
-	837, 752, 853, 785, 296, 750, 293, 188, 205, 0,
-	783, 338, 379, 385, 852, 769, 777, 229, 775, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 817,
-	835, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 1061, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 764, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 848, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 840, 879, 351, 384, 219, 445, 405,
-	759, 763, 757, 758, 812, 813, 760, 870, 871, 872,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 844,
-	753, 0, 761, 762, 0, 850, 859, 860, 495, 313,
-	396, 442, 816, 187, 202, 299, 875, 373, 261, 473,
-	453, 449, 738, 756, 236, 767, 0, 0, 780, 788,
-	789, 801, 803, 804, 805, 806, 324, 823, 824, 826,
-	834, 836, 839, 841, 846, 856, 878, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 815, 821,
-	310, 254, 273, 284, 829, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 811, 838, 306, 422, 423, 280,
-	864, 849, 421, 0, 796, 867, 766, 784, 877, 787,
-	790, 831, 745, 810, 342, 781, 0, 770, 740, 776,
-	741, 768, 798, 244, 765, 851, 814, 866, 297, 241,
-	747, 771, 356, 786, 193, 833, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	873, 301, 820, 0, 406, 327, 0, 0, 0, 800,
-	855, 808, 845, 795, 832, 755, 819, 868, 782, 828,
-	869, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 224, 778, 825, 863,
-	779, 827, 239, 285, 246, 238, 425, 874, 854, 744,
-	807, 862, 0, 0, 209, 865, 802, 0, 830, 0,
-	880, 739, 822, 0, 742, 746, 876, 858, 774, 249,
-	0, 0, 0, 0, 0, 0, 0, 799, 809, 842,
-	793, 0, 0, 0, 0, 0, 0, 0, 772, 0,
-	818, 0, 0, 0, 751, 743, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 797, 0, 0,
-	0, 754, 0, 773, 843, 0, 737, 268, 748, 328,
-	231, 0, 847, 857, 794, 459, 861, 792, 791, 837,
-	752, 853, 785, 296, 750, 293, 188, 205, 0, 783,
-	338, 379, 385, 852, 769, 777, 229, 775, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 817, 835,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	1061, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 764, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 848, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 840, 879, 351, 384, 219, 445, 405, 759,
-	763, 757, 758, 812, 813, 760, 870, 871, 872, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 844, 753,
-	0, 761, 762, 0, 850, 859, 860, 495, 313, 396,
-	442, 816, 187, 202, 299, 875, 373, 261, 473, 453,
-	449, 738, 756, 236, 767, 0, 0, 780, 788, 789,
-	801, 803, 804, 805, 806, 324, 823, 824, 826, 834,
-	836, 839, 841, 846, 856, 878, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 815, 821, 310,
-	254, 273, 284, 829, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 811, 838, 306, 422, 423, 280, 864,
-	849, 421, 0, 796, 867, 766, 784, 877, 787, 790,
-	831, 745, 810, 342, 781, 0, 770, 740, 776, 741,
-	768, 798, 244, 765, 851, 814, 866, 297, 241, 747,
-	771, 356, 786, 193, 833, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 873,
-	301, 820, 0, 406, 327, 0, 0, 0, 800, 855,
-	808, 845, 795, 832, 755, 819, 868, 782, 828, 869,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 778, 825, 863, 779,
-	827, 239, 285, 246, 238, 425, 874, 854, 744, 807,
-	862, 0, 0, 881, 865, 802, 0, 830, 0, 880,
-	739, 822, 0, 742, 746, 876, 858, 774, 249, 0,
-	0, 0, 0, 0, 0, 0, 799, 809, 842, 793,
-	0, 0, 0, 0, 0, 0, 0, 772, 0, 818,
-	0, 0, 0, 751, 743, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 797, 0, 0, 0,
-	754, 0, 773, 843, 0, 737, 268, 748, 328, 231,
-	0, 847, 857, 794, 459, 861, 792, 791, 837, 752,
-	853, 785, 296, 750, 293, 188, 205, 0, 783, 338,
-	379, 385, 852, 769, 777, 229, 775, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 817, 835, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 749,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 764, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 848, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 736, 730, 729, 294,
-	303, 840, 879, 351, 384, 219, 445, 405, 759, 763,
-	757, 758, 812, 813, 760, 870, 871, 872, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 844, 753, 0,
-	761, 762, 0, 850, 859, 860, 495, 313, 396, 442,
-	816, 187, 202, 299, 875, 373, 261, 473, 453, 449,
-	738, 756, 236, 767, 0, 0, 780, 788, 789, 801,
-	803, 804, 805, 806, 324, 823, 824, 826, 834, 836,
-	839, 841, 846, 856, 878, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 815, 821, 310, 254,
-	273, 284, 829, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 811, 838, 306, 422, 423, 280, 864, 849,
-	421, 0, 796, 867, 766, 784, 877, 787, 790, 831,
-	745, 810, 342, 781, 0, 770, 740, 776, 741, 768,
-	798, 244, 765, 851, 814, 866, 297, 241, 747, 771,
-	356, 786, 193, 833, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 873, 301,
-	820, 0, 406, 327, 0, 0, 0, 800, 855, 808,
-	845, 795, 832, 755, 819, 868, 782, 828, 869, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 778, 825, 863, 779, 827,
-	239, 285, 246, 238, 425, 874, 854, 744, 807, 862,
-	0, 0, 881, 865, 802, 0, 830, 0, 880, 739,
-	822, 0, 742, 746, 876, 858, 774, 249, 0, 0,
-	0, 0, 0, 0, 0, 799, 809, 842, 793, 0,
-	0, 0, 0, 0, 0, 0, 772, 0, 818, 0,
-	0, 0, 751, 743, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 797, 0, 0, 0, 754,
-	0, 773, 843, 0, 737, 268, 748, 328, 231, 0,
-	847, 857, 794, 459, 861, 792, 791, 837, 752, 853,
-	785, 296, 750, 293, 188, 205, 0, 783, 338, 379,
-	385, 852, 769, 777, 229, 775, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 817, 835, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 1242, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 749, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	764, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 848, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 736, 730, 729, 294, 303,
-	840, 879, 351, 384, 219, 445, 405, 759, 763, 757,
-	758, 812, 813, 760, 870, 871, 872, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 844, 753, 0, 761,
-	762, 0, 850, 859, 860, 495, 313, 396, 442, 816,
-	187, 202, 299, 875, 373, 261, 473, 453, 449, 738,
-	756, 236, 767, 0, 0, 780, 788, 789, 801, 803,
-	804, 805, 806, 324, 823, 824, 826, 834, 836, 839,
-	841, 846, 856, 878, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 815, 821, 310, 254, 273,
-	284, 829, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 811, 838, 306, 422, 423, 280, 864, 849, 421,
-	0, 796, 867, 766, 784, 877, 787, 790, 831, 745,
-	810, 342, 781, 0, 770, 740, 776, 741, 768, 798,
-	244, 765, 851, 814, 866, 297, 241, 747, 771, 356,
-	786, 193, 833, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 873, 301, 820,
-	0, 406, 327, 0, 0, 0, 800, 855, 808, 845,
-	795, 832, 755, 819, 868, 782, 828, 869, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 778, 825, 863, 779, 827, 239,
-	285, 246, 238, 425, 874, 854, 744, 807, 862, 0,
-	0, 881, 865, 802, 0, 830, 0, 880, 739, 822,
-	0, 742, 746, 876, 858, 774, 249, 0, 0, 0,
-	0, 0, 0, 0, 799, 809, 842, 793, 0, 0,
-	0, 0, 0, 0, 0, 772, 0, 818, 0, 0,
-	0, 751, 743, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 797, 0, 0, 0, 754, 0,
-	773, 843, 0, 737, 268, 748, 328, 231, 0, 847,
-	857, 794, 459, 861, 792, 791, 837, 752, 853, 785,
-	296, 750, 293, 188, 205, 0, 783, 338, 379, 385,
-	852, 769, 777, 229, 775, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 817, 835, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 727, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 749, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 764,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 848, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 736, 730, 729, 294, 303, 840,
-	879, 351, 384, 219, 445, 405, 759, 763, 757, 758,
-	812, 813, 760, 870, 871, 872, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 844, 753, 0, 761, 762,
-	0, 850, 859, 860, 495, 313, 396, 442, 816, 187,
-	202, 299, 875, 373, 261, 473, 453, 449, 738, 756,
-	236, 767, 0, 0, 780, 788, 789, 801, 803, 804,
-	805, 806, 324, 823, 824, 826, 834, 836, 839, 841,
-	846, 856, 878, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 815, 821, 310, 254, 273, 284,
-	829, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	811, 838, 306, 422, 423, 280, 421, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 1594, 0, 576, 0, 0, 0, 244, 581, 0,
-	0, 0, 297, 241, 0, 1595, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 588, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 583, 584, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 87, 0, 0, 184, 185, 186, 622, 629,
-	630, 631, 632, 633, 623, 625, 0, 0, 217, 624,
-	224, 597, 627, 634, 635, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 559, 573, 0, 587,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 570, 571,
-	716, 0, 0, 0, 607, 0, 572, 0, 0, 580,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 582, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 606, 0, 0, 459,
-	0, 0, 604, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 613, 605, 592, 594, 614, 615, 589,
-	590, 593, 616, 477, 478, 479, 480, 481, 482, 483,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	2124, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	1658, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 87, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 209, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 1063, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 883, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 751, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 738, 732, 731, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 883, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 1248,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 751, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 738, 732, 731, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 866, 851, 422, 0, 798, 869,
+	768, 786, 879, 789, 792, 833, 747, 812, 343, 783,
+	0, 772, 742, 778, 743, 770, 800, 245, 767, 853,
+	816, 868, 298, 242, 749, 773, 357, 788, 193, 835,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 875, 302, 822, 0, 407, 328,
+	0, 0, 0, 802, 857, 810, 847, 797, 834, 757,
+	821, 870, 784, 830, 871, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 780, 827, 865, 781, 829, 240, 286, 247, 239,
+	426, 876, 856, 746, 809, 864, 0, 0, 883, 867,
+	804, 0, 832, 0, 882, 741, 824, 0, 744, 748,
+	878, 860, 776, 250, 0, 0, 0, 0, 0, 0,
+	0, 801, 811, 844, 795, 0, 0, 0, 0, 0,
+	0, 0, 774, 0, 820, 0, 0, 0, 753, 745,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 799, 0, 0, 0, 756, 0, 775, 845, 0,
+	739, 269, 750, 329, 232, 0, 849, 859, 796, 460,
+	863, 794, 793, 839, 754, 855, 787, 297, 752, 294,
+	188, 205, 0, 785, 339, 380, 386, 854, 771, 779,
+	230, 777, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 819, 837, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 729,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 751, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 766, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	850, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 738, 732, 731, 295, 304, 842, 881, 352,
+	385, 219, 446, 406, 761, 765, 759, 760, 814, 815,
+	762, 872, 873, 874, 478, 479, 480, 481, 482, 483,
 	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 608, 579, 578, 0, 585, 586, 0, 595,
-	596, 598, 599, 600, 601, 577, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 576, 0, 0, 0, 244, 581,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 588, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 583, 584, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 87, 0, 0, 184, 185, 186, 622,
-	629, 630, 631, 632, 633, 623, 625, 0, 0, 217,
-	624, 224, 597, 627, 634, 635, 0, 239, 285, 246,
-	238, 425, 0, 0, 1590, 1591, 1592, 0, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 559, 573, 0,
-	587, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 570,
-	571, 0, 0, 0, 0, 607, 0, 572, 0, 0,
-	580, 636, 637, 638, 639, 640, 641, 642, 643, 644,
+	494, 495, 0, 846, 755, 0, 763, 764, 0, 852,
+	861, 862, 496, 314, 397, 443, 818, 187, 202, 300,
+	877, 374, 262, 474, 454, 450, 740, 758, 237, 769,
+	0, 0, 782, 790, 791, 803, 805, 806, 807, 808,
+	325, 825, 826, 828, 836, 838, 841, 843, 848, 858,
+	880, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 817, 823, 311, 255, 274, 285, 831, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 813, 840,
+	307, 423, 424, 281, 422, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 343, 0, 0, 1600,
+	0, 577, 0, 0, 0, 245, 582, 0, 0, 0,
+	298, 242, 0, 1601, 357, 0, 193, 0, 398, 229,
+	308, 305, 429, 256, 248, 244, 227, 282, 315, 355,
+	416, 349, 589, 302, 0, 0, 407, 328, 0, 0,
+	0, 0, 0, 584, 585, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 226, 192, 340, 408, 260, 0,
+	87, 0, 0, 184, 185, 186, 623, 630, 631, 632,
+	633, 634, 624, 626, 0, 0, 217, 625, 224, 598,
+	628, 635, 636, 0, 240, 286, 247, 239, 426, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 560, 574, 0, 588, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 571, 572, 718, 0,
+	0, 0, 608, 0, 573, 0, 0, 581, 637, 638,
+	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
+	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
+	669, 670, 671, 672, 673, 674, 675, 676, 677, 583,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 329, 232, 0, 607, 0, 0, 460, 0, 0,
+	605, 0, 0, 0, 0, 297, 0, 294, 188, 205,
+	0, 0, 339, 380, 386, 0, 0, 0, 230, 0,
+	384, 353, 444, 213, 258, 377, 358, 382, 365, 261,
+	0, 0, 383, 303, 431, 372, 441, 461, 462, 238,
+	333, 451, 420, 457, 473, 206, 235, 347, 413, 447,
+	404, 326, 427, 428, 293, 403, 267, 191, 301, 467,
+	204, 392, 221, 211, 228, 197, 415, 439, 218, 395,
+	0, 0, 475, 199, 437, 412, 322, 290, 291, 198,
+	0, 376, 243, 265, 233, 342, 434, 435, 231, 476,
+	208, 456, 201, 0, 455, 335, 430, 438, 323, 313,
+	200, 436, 321, 312, 296, 254, 276, 370, 306, 371,
+	277, 331, 330, 332, 194, 448, 0, 195, 0, 409,
+	449, 477, 214, 215, 216, 0, 253, 257, 264, 266,
+	272, 273, 280, 299, 346, 369, 367, 373, 0, 425,
+	442, 452, 459, 465, 466, 468, 469, 470, 471, 472,
+	334, 279, 405, 295, 304, 0, 0, 352, 385, 219,
+	446, 406, 614, 606, 593, 595, 615, 616, 590, 591,
+	594, 617, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	0, 609, 580, 579, 0, 586, 587, 0, 596, 597,
+	599, 600, 601, 602, 578, 187, 202, 300, 0, 374,
+	262, 474, 454, 450, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 203, 212, 222, 236, 251, 259, 270, 275, 278,
+	283, 284, 287, 292, 310, 316, 317, 318, 319, 336,
+	337, 338, 341, 344, 345, 348, 350, 351, 354, 361,
+	362, 363, 364, 366, 368, 375, 379, 387, 388, 389,
+	390, 391, 393, 394, 399, 400, 401, 402, 410, 414,
+	432, 433, 445, 458, 463, 271, 440, 464, 0, 309,
+	0, 0, 311, 255, 274, 285, 0, 453, 411, 207,
+	381, 263, 196, 225, 210, 234, 249, 252, 289, 320,
+	327, 356, 360, 268, 246, 223, 378, 220, 396, 417,
+	418, 419, 421, 324, 241, 359, 422, 0, 307, 423,
+	424, 281, 0, 0, 0, 0, 0, 0, 343, 0,
+	0, 0, 0, 577, 0, 0, 0, 245, 582, 0,
+	0, 0, 298, 242, 0, 0, 357, 0, 193, 0,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 589, 302, 0, 0, 407, 328,
+	0, 0, 0, 0, 0, 584, 585, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 226, 192, 340, 408,
+	260, 0, 87, 0, 0, 184, 185, 186, 623, 630,
+	631, 632, 633, 634, 624, 626, 0, 0, 217, 625,
+	224, 598, 628, 635, 636, 0, 240, 286, 247, 239,
+	426, 0, 0, 1596, 1597, 1598, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 560, 574, 0, 588,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 571, 572,
+	0, 0, 0, 0, 608, 0, 573, 0, 0, 581,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
+	677, 583, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 269, 0, 329, 232, 0, 607, 0, 0, 460,
+	0, 0, 605, 0, 0, 0, 0, 297, 0, 294,
+	188, 205, 0, 0, 339, 380, 386, 0, 0, 0,
+	230, 0, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 0, 0, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 0, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 0, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	0, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 0, 0, 352,
+	385, 219, 446, 406, 614, 606, 593, 595, 615, 616,
+	590, 591, 594, 617, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 609, 580, 579, 0, 586, 587, 0,
+	596, 597, 599, 600, 601, 602, 578, 187, 202, 300,
+	0, 374, 262, 474, 454, 450, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 0, 0, 311, 255, 274, 285, 0, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 422, 0,
+	307, 423, 424, 281, 0, 0, 0, 0, 0, 0,
+	343, 0, 0, 0, 0, 577, 0, 0, 0, 245,
+	582, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 589, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 584, 585, 0,
+	0, 0, 0, 0, 0, 1683, 0, 288, 226, 192,
+	340, 408, 260, 0, 87, 0, 0, 184, 185, 186,
+	623, 630, 631, 632, 633, 634, 624, 626, 0, 0,
+	217, 625, 224, 598, 628, 635, 636, 1684, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 560, 574,
+	0, 588, 0, 0, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	571, 572, 0, 0, 0, 0, 608, 0, 573, 0,
+	0, 581, 637, 638, 639, 640, 641, 642, 643, 644,
 	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
 	655, 656, 657, 658, 659, 660, 661, 662, 663, 664,
 	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 582, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 606, 0, 0,
-	459, 0, 0, 604, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 613, 605, 592, 594, 614, 615,
-	589, 590, 593, 616, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 608, 579, 578, 0, 585, 586, 0,
-	595, 596, 598, 599, 600, 601, 577, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 576, 0, 0, 0, 244,
-	581, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 588, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 583, 584, 0,
-	0, 0, 0, 0, 0, 1675, 0, 287, 226, 192,
-	339, 407, 259, 0, 87, 0, 0, 184, 185, 186,
-	622, 629, 630, 631, 632, 633, 623, 625, 0, 0,
-	217, 624, 224, 597, 627, 634, 635, 1676, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 559, 573,
-	0, 587, 0, 0, 0, 249, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	570, 571, 0, 0, 0, 0, 607, 0, 572, 0,
-	0, 580, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 668, 669, 670, 671, 672, 673,
-	674, 675, 676, 582, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 606, 0,
-	0, 459, 0, 0, 604, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 613, 605, 592, 594, 614,
-	615, 589, 590, 593, 616, 477, 478, 479, 480, 481,
+	675, 676, 677, 583, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 269, 0, 329, 232, 0, 607, 0,
+	0, 460, 0, 0, 605, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 614, 606, 593, 595,
+	615, 616, 590, 591, 594, 617, 478, 479, 480, 481,
 	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 608, 579, 578, 0, 585, 586,
-	0, 595, 596, 598, 599, 600, 601, 577, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 78,
-	421, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 576, 0, 0,
-	0, 244, 581, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 588, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 583,
-	584, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 87, 0, 0, 184,
-	185, 186, 622, 629, 630, 631, 632, 633, 623, 625,
-	0, 0, 217, 624, 224, 597, 627, 634, 635, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	559, 573, 0, 587, 0, 0, 0, 249, 0, 0,
+	492, 493, 494, 495, 0, 609, 580, 579, 0, 586,
+	587, 0, 596, 597, 599, 600, 601, 602, 578, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	78, 422, 307, 423, 424, 281, 0, 0, 0, 0,
+	0, 0, 0, 343, 0, 0, 0, 0, 577, 0,
+	0, 0, 245, 582, 0, 0, 0, 298, 242, 0,
+	0, 357, 0, 193, 0, 398, 229, 308, 305, 429,
+	256, 248, 244, 227, 282, 315, 355, 416, 349, 589,
+	302, 0, 0, 407, 328, 0, 0, 0, 0, 0,
+	584, 585, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 226, 192, 340, 408, 260, 0, 87, 0, 0,
+	184, 185, 186, 623, 630, 631, 632, 633, 634, 624,
+	626, 0, 0, 217, 625, 224, 598, 628, 635, 636,
+	0, 240, 286, 247, 239, 426, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 560, 574, 0, 588, 0, 0, 0, 250, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 570, 571, 0, 0, 0, 0, 607, 0,
-	572, 0, 0, 580, 636, 637, 638, 639, 640, 641,
+	0, 0, 0, 571, 572, 0, 0, 0, 0, 608,
+	0, 573, 0, 0, 581, 637, 638, 639, 640, 641,
 	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
 	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
 	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
-	672, 673, 674, 675, 676, 582, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	606, 0, 0, 459, 0, 0, 604, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 613, 605, 592,
-	594, 614, 615, 589, 590, 593, 616, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 608, 579, 578, 0,
-	585, 586, 0, 595, 596, 598, 599, 600, 601, 577,
-	187, 202, 299, 86, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 576, 0,
-	0, 0, 244, 581, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 588,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	583, 584, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 87, 0, 0,
-	184, 185, 186, 622, 629, 630, 631, 632, 633, 623,
-	625, 0, 0, 217, 624, 224, 597, 627, 634, 635,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 559, 573, 0, 587, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 570, 571, 0, 0, 0, 0, 607,
-	0, 572, 0, 0, 580, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
-	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
-	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
-	671, 672, 673, 674, 675, 676, 582, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 606, 0, 0, 459, 0, 0, 604, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 2507, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 613, 605,
-	592, 594, 614, 615, 589, 590, 593, 616, 477, 478,
+	672, 673, 674, 675, 676, 677, 583, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 329, 232,
+	0, 607, 0, 0, 460, 0, 0, 605, 0, 0,
+	0, 0, 297, 0, 294, 188, 205, 0, 0, 339,
+	380, 386, 0, 0, 0, 230, 0, 384, 353, 444,
+	213, 258, 377, 358, 382, 365, 261, 0, 0, 383,
+	303, 431, 372, 441, 461, 462, 238, 333, 451, 420,
+	457, 473, 206, 235, 347, 413, 447, 404, 326, 427,
+	428, 293, 403, 267, 191, 301, 467, 204, 392, 221,
+	211, 228, 197, 415, 439, 218, 395, 0, 0, 475,
+	199, 437, 412, 322, 290, 291, 198, 0, 376, 243,
+	265, 233, 342, 434, 435, 231, 476, 208, 456, 201,
+	0, 455, 335, 430, 438, 323, 313, 200, 436, 321,
+	312, 296, 254, 276, 370, 306, 371, 277, 331, 330,
+	332, 194, 448, 0, 195, 0, 409, 449, 477, 214,
+	215, 216, 0, 253, 257, 264, 266, 272, 273, 280,
+	299, 346, 369, 367, 373, 0, 425, 442, 452, 459,
+	465, 466, 468, 469, 470, 471, 472, 334, 279, 405,
+	295, 304, 0, 0, 352, 385, 219, 446, 406, 614,
+	606, 593, 595, 615, 616, 590, 591, 594, 617, 478,
 	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 608, 579, 578,
-	0, 585, 586, 0, 595, 596, 598, 599, 600, 601,
-	577, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 576,
-	0, 0, 0, 244, 581, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	588, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 583, 584, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 87, 0,
-	1210, 184, 185, 186, 622, 629, 630, 631, 632, 633,
-	623, 625, 0, 0, 217, 624, 224, 597, 627, 634,
-	635, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 559, 573, 0, 587, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 570, 571, 0, 0, 0, 0,
-	607, 0, 572, 0, 0, 580, 636, 637, 638, 639,
+	489, 490, 491, 492, 493, 494, 495, 0, 609, 580,
+	579, 0, 586, 587, 0, 596, 597, 599, 600, 601,
+	602, 578, 187, 202, 300, 86, 374, 262, 474, 454,
+	450, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 325, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
+	222, 236, 251, 259, 270, 275, 278, 283, 284, 287,
+	292, 310, 316, 317, 318, 319, 336, 337, 338, 341,
+	344, 345, 348, 350, 351, 354, 361, 362, 363, 364,
+	366, 368, 375, 379, 387, 388, 389, 390, 391, 393,
+	394, 399, 400, 401, 402, 410, 414, 432, 433, 445,
+	458, 463, 271, 440, 464, 0, 309, 0, 0, 311,
+	255, 274, 285, 0, 453, 411, 207, 381, 263, 196,
+	225, 210, 234, 249, 252, 289, 320, 327, 356, 360,
+	268, 246, 223, 378, 220, 396, 417, 418, 419, 421,
+	324, 241, 359, 422, 0, 307, 423, 424, 281, 0,
+	0, 0, 0, 0, 0, 343, 0, 0, 0, 0,
+	577, 0, 0, 0, 245, 582, 0, 0, 0, 298,
+	242, 0, 0, 357, 0, 193, 0, 398, 229, 308,
+	305, 429, 256, 248, 244, 227, 282, 315, 355, 416,
+	349, 589, 302, 0, 0, 407, 328, 0, 0, 0,
+	0, 0, 584, 585, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 226, 192, 340, 408, 260, 0, 87,
+	0, 0, 184, 185, 186, 623, 630, 631, 632, 633,
+	634, 624, 626, 0, 0, 217, 625, 224, 598, 628,
+	635, 636, 0, 240, 286, 247, 239, 426, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 560, 574, 0, 588, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 571, 572, 0, 0, 0,
+	0, 608, 0, 573, 0, 0, 581, 637, 638, 639,
 	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
 	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
 	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
-	670, 671, 672, 673, 674, 675, 676, 582, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 606, 0, 0, 459, 0, 0, 604, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 613,
-	605, 592, 594, 614, 615, 589, 590, 593, 616, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 608, 579,
-	578, 0, 585, 586, 0, 595, 596, 598, 599, 600,
-	601, 577, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	576, 0, 0, 0, 244, 581, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 588, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 583, 584, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 87,
-	0, 0, 184, 185, 186, 622, 629, 630, 631, 632,
-	633, 623, 625, 0, 0, 217, 624, 224, 597, 627,
-	634, 635, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 559, 573, 0, 587, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 570, 571, 716, 0, 0,
-	0, 607, 0, 572, 0, 0, 580, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
-	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
-	669, 670, 671, 672, 673, 674, 675, 676, 582, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 606, 0, 0, 459, 0, 0, 604,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
-	613, 605, 592, 594, 614, 615, 589, 590, 593, 616,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 608,
-	579, 578, 0, 585, 586, 0, 595, 596, 598, 599,
-	600, 601, 577, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 576, 0, 0, 0, 244, 581, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 588, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 583, 584, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	87, 0, 0, 184, 185, 186, 622, 629, 630, 631,
-	632, 633, 623, 625, 0, 0, 217, 624, 224, 597,
-	627, 634, 635, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 559, 573, 0, 587, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 570, 571, 0, 0,
-	0, 0, 607, 0, 572, 0, 0, 580, 636, 637,
+	670, 671, 672, 673, 674, 675, 676, 677, 583, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	329, 232, 0, 607, 0, 0, 460, 0, 0, 605,
+	0, 0, 0, 0, 297, 0, 294, 188, 205, 0,
+	0, 339, 380, 386, 0, 0, 0, 230, 0, 384,
+	353, 444, 213, 258, 377, 358, 382, 365, 261, 2516,
+	0, 383, 303, 431, 372, 441, 461, 462, 238, 333,
+	451, 420, 457, 473, 206, 235, 347, 413, 447, 404,
+	326, 427, 428, 293, 403, 267, 191, 301, 467, 204,
+	392, 221, 211, 228, 197, 415, 439, 218, 395, 0,
+	0, 475, 199, 437, 412, 322, 290, 291, 198, 0,
+	376, 243, 265, 233, 342, 434, 435, 231, 476, 208,
+	456, 201, 0, 455, 335, 430, 438, 323, 313, 200,
+	436, 321, 312, 296, 254, 276, 370, 306, 371, 277,
+	331, 330, 332, 194, 448, 0, 195, 0, 409, 449,
+	477, 214, 215, 216, 0, 253, 257, 264, 266, 272,
+	273, 280, 299, 346, 369, 367, 373, 0, 425, 442,
+	452, 459, 465, 466, 468, 469, 470, 471, 472, 334,
+	279, 405, 295, 304, 0, 0, 352, 385, 219, 446,
+	406, 614, 606, 593, 595, 615, 616, 590, 591, 594,
+	617, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 0,
+	609, 580, 579, 0, 586, 587, 0, 596, 597, 599,
+	600, 601, 602, 578, 187, 202, 300, 0, 374, 262,
+	474, 454, 450, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	203, 212, 222, 236, 251, 259, 270, 275, 278, 283,
+	284, 287, 292, 310, 316, 317, 318, 319, 336, 337,
+	338, 341, 344, 345, 348, 350, 351, 354, 361, 362,
+	363, 364, 366, 368, 375, 379, 387, 388, 389, 390,
+	391, 393, 394, 399, 400, 401, 402, 410, 414, 432,
+	433, 445, 458, 463, 271, 440, 464, 0, 309, 0,
+	0, 311, 255, 274, 285, 0, 453, 411, 207, 381,
+	263, 196, 225, 210, 234, 249, 252, 289, 320, 327,
+	356, 360, 268, 246, 223, 378, 220, 396, 417, 418,
+	419, 421, 324, 241, 359, 422, 0, 307, 423, 424,
+	281, 0, 0, 0, 0, 0, 0, 343, 0, 0,
+	0, 0, 577, 0, 0, 0, 245, 582, 0, 0,
+	0, 298, 242, 0, 0, 357, 0, 193, 0, 398,
+	229, 308, 305, 429, 256, 248, 244, 227, 282, 315,
+	355, 416, 349, 589, 302, 0, 0, 407, 328, 0,
+	0, 0, 0, 0, 584, 585, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 226, 192, 340, 408, 260,
+	0, 87, 0, 1212, 184, 185, 186, 623, 630, 631,
+	632, 633, 634, 624, 626, 0, 0, 217, 625, 224,
+	598, 628, 635, 636, 0, 240, 286, 247, 239, 426,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 560, 574, 0, 588, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 571, 572, 0,
+	0, 0, 0, 608, 0, 573, 0, 0, 581, 637,
 	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
 	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
 	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	668, 669, 670, 671, 672, 673, 674, 675, 676, 582,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 606, 0, 0, 459, 0, 0,
-	604, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 613, 605, 592, 594, 614, 615, 589, 590, 593,
-	616, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	608, 579, 578, 0, 585, 586, 0, 595, 596, 598,
-	599, 600, 601, 577, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
+	668, 669, 670, 671, 672, 673, 674, 675, 676, 677,
+	583, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 0, 329, 232, 0, 607, 0, 0, 460, 0,
+	0, 605, 0, 0, 0, 0, 297, 0, 294, 188,
+	205, 0, 0, 339, 380, 386, 0, 0, 0, 230,
+	0, 384, 353, 444, 213, 258, 377, 358, 382, 365,
+	261, 0, 0, 383, 303, 431, 372, 441, 461, 462,
+	238, 333, 451, 420, 457, 473, 206, 235, 347, 413,
+	447, 404, 326, 427, 428, 293, 403, 267, 191, 301,
+	467, 204, 392, 221, 211, 228, 197, 415, 439, 218,
+	395, 0, 0, 475, 199, 437, 412, 322, 290, 291,
+	198, 0, 376, 243, 265, 233, 342, 434, 435, 231,
+	476, 208, 456, 201, 0, 455, 335, 430, 438, 323,
+	313, 200, 436, 321, 312, 296, 254, 276, 370, 306,
+	371, 277, 331, 330, 332, 194, 448, 0, 195, 0,
+	409, 449, 477, 214, 215, 216, 0, 253, 257, 264,
+	266, 272, 273, 280, 299, 346, 369, 367, 373, 0,
+	425, 442, 452, 459, 465, 466, 468, 469, 470, 471,
+	472, 334, 279, 405, 295, 304, 0, 0, 352, 385,
+	219, 446, 406, 614, 606, 593, 595, 615, 616, 590,
+	591, 594, 617, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 0, 609, 580, 579, 0, 586, 587, 0, 596,
+	597, 599, 600, 601, 602, 578, 187, 202, 300, 0,
+	374, 262, 474, 454, 450, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 203, 212, 222, 236, 251, 259, 270, 275,
+	278, 283, 284, 287, 292, 310, 316, 317, 318, 319,
+	336, 337, 338, 341, 344, 345, 348, 350, 351, 354,
+	361, 362, 363, 364, 366, 368, 375, 379, 387, 388,
+	389, 390, 391, 393, 394, 399, 400, 401, 402, 410,
+	414, 432, 433, 445, 458, 463, 271, 440, 464, 0,
+	309, 0, 0, 311, 255, 274, 285, 0, 453, 411,
+	207, 381, 263, 196, 225, 210, 234, 249, 252, 289,
+	320, 327, 356, 360, 268, 246, 223, 378, 220, 396,
+	417, 418, 419, 421, 324, 241, 359, 422, 0, 307,
+	423, 424, 281, 0, 0, 0, 0, 0, 0, 343,
+	0, 0, 0, 0, 577, 0, 0, 0, 245, 582,
+	0, 0, 0, 298, 242, 0, 0, 357, 0, 193,
+	0, 398, 229, 308, 305, 429, 256, 248, 244, 227,
+	282, 315, 355, 416, 349, 589, 302, 0, 0, 407,
+	328, 0, 0, 0, 0, 0, 584, 585, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 226, 192, 340,
+	408, 260, 0, 87, 0, 0, 184, 185, 186, 623,
+	630, 631, 632, 633, 634, 624, 626, 0, 0, 217,
+	625, 224, 598, 628, 635, 636, 0, 240, 286, 247,
+	239, 426, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 560, 574, 0,
+	588, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 571,
+	572, 718, 0, 0, 0, 608, 0, 573, 0, 0,
+	581, 637, 638, 639, 640, 641, 642, 643, 644, 645,
+	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
+	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
+	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
+	676, 677, 583, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 269, 0, 329, 232, 0, 607, 0, 0,
+	460, 0, 0, 605, 0, 0, 0, 0, 297, 0,
+	294, 188, 205, 0, 0, 339, 380, 386, 0, 0,
+	0, 230, 0, 384, 353, 444, 213, 258, 377, 358,
+	382, 365, 261, 0, 0, 383, 303, 431, 372, 441,
+	461, 462, 238, 333, 451, 420, 457, 473, 206, 235,
+	347, 413, 447, 404, 326, 427, 428, 293, 403, 267,
+	191, 301, 467, 204, 392, 221, 211, 228, 197, 415,
+	439, 218, 395, 0, 0, 475, 199, 437, 412, 322,
+	290, 291, 198, 0, 376, 243, 265, 233, 342, 434,
+	435, 231, 476, 208, 456, 201, 0, 455, 335, 430,
+	438, 323, 313, 200, 436, 321, 312, 296, 254, 276,
+	370, 306, 371, 277, 331, 330, 332, 194, 448, 0,
+	195, 0, 409, 449, 477, 214, 215, 216, 0, 253,
+	257, 264, 266, 272, 273, 280, 299, 346, 369, 367,
+	373, 0, 425, 442, 452, 459, 465, 466, 468, 469,
+	470, 471, 472, 334, 279, 405, 295, 304, 0, 0,
+	352, 385, 219, 446, 406, 614, 606, 593, 595, 615,
+	616, 590, 591, 594, 617, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 0, 609, 580, 579, 0, 586, 587,
+	0, 596, 597, 599, 600, 601, 602, 578, 187, 202,
+	300, 0, 374, 262, 474, 454, 450, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 203, 212, 222, 236, 251, 259,
+	270, 275, 278, 283, 284, 287, 292, 310, 316, 317,
+	318, 319, 336, 337, 338, 341, 344, 345, 348, 350,
+	351, 354, 361, 362, 363, 364, 366, 368, 375, 379,
+	387, 388, 389, 390, 391, 393, 394, 399, 400, 401,
+	402, 410, 414, 432, 433, 445, 458, 463, 271, 440,
+	464, 0, 309, 0, 0, 311, 255, 274, 285, 0,
+	453, 411, 207, 381, 263, 196, 225, 210, 234, 249,
+	252, 289, 320, 327, 356, 360, 268, 246, 223, 378,
+	220, 396, 417, 418, 419, 421, 324, 241, 359, 422,
+	0, 307, 423, 424, 281, 0, 0, 0, 0, 0,
+	0, 343, 0, 0, 0, 0, 577, 0, 0, 0,
+	245, 582, 0, 0, 0, 298, 242, 0, 0, 357,
+	0, 193, 0, 398, 229, 308, 305, 429, 256, 248,
+	244, 227, 282, 315, 355, 416, 349, 589, 302, 0,
+	0, 407, 328, 0, 0, 0, 0, 0, 584, 585,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 226,
+	192, 340, 408, 260, 0, 87, 0, 0, 184, 185,
+	186, 623, 630, 631, 632, 633, 634, 624, 626, 0,
+	0, 217, 625, 224, 598, 628, 635, 636, 0, 240,
+	286, 247, 239, 426, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 560,
+	574, 0, 588, 0, 0, 0, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 571, 572, 0, 0, 0, 0, 608, 0, 573,
+	0, 0, 581, 637, 638, 639, 640, 641, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
+	664, 665, 666, 667, 668, 669, 670, 671, 672, 673,
+	674, 675, 676, 677, 583, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 329, 232, 0, 607,
+	0, 0, 460, 0, 0, 605, 0, 0, 0, 0,
+	297, 0, 294, 188, 205, 0, 0, 339, 380, 386,
+	0, 0, 0, 230, 0, 384, 353, 444, 213, 258,
+	377, 358, 382, 365, 261, 0, 0, 383, 303, 431,
+	372, 441, 461, 462, 238, 333, 451, 420, 457, 473,
+	206, 235, 347, 413, 447, 404, 326, 427, 428, 293,
+	403, 267, 191, 301, 467, 204, 392, 221, 211, 228,
+	197, 415, 439, 218, 395, 0, 0, 475, 199, 437,
+	412, 322, 290, 291, 198, 0, 376, 243, 265, 233,
+	342, 434, 435, 231, 476, 208, 456, 201, 0, 455,
+	335, 430, 438, 323, 313, 200, 436, 321, 312, 296,
+	254, 276, 370, 306, 371, 277, 331, 330, 332, 194,
+	448, 0, 195, 0, 409, 449, 477, 214, 215, 216,
+	0, 253, 257, 264, 266, 272, 273, 280, 299, 346,
+	369, 367, 373, 0, 425, 442, 452, 459, 465, 466,
+	468, 469, 470, 471, 472, 334, 279, 405, 295, 304,
+	0, 0, 352, 385, 219, 446, 406, 614, 606, 593,
+	595, 615, 616, 590, 591, 594, 617, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 0, 609, 580, 579, 0,
+	586, 587, 0, 596, 597, 599, 600, 601, 602, 578,
+	187, 202, 300, 0, 374, 262, 474, 454, 450, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 203, 212, 222, 236,
+	251, 259, 270, 275, 278, 283, 284, 287, 292, 310,
+	316, 317, 318, 319, 336, 337, 338, 341, 344, 345,
+	348, 350, 351, 354, 361, 362, 363, 364, 366, 368,
+	375, 379, 387, 388, 389, 390, 391, 393, 394, 399,
+	400, 401, 402, 410, 414, 432, 433, 445, 458, 463,
+	271, 440, 464, 0, 309, 0, 0, 311, 255, 274,
+	285, 0, 453, 411, 207, 381, 263, 196, 225, 210,
+	234, 249, 252, 289, 320, 327, 356, 360, 268, 246,
+	223, 378, 220, 396, 417, 418, 419, 421, 324, 241,
+	359, 422, 0, 307, 423, 424, 281, 0, 0, 0,
+	0, 0, 0, 343, 0, 0, 0, 0, 577, 0,
+	0, 0, 245, 582, 0, 0, 0, 298, 242, 0,
+	0, 357, 0, 193, 0, 398, 229, 308, 305, 429,
+	256, 248, 244, 227, 282, 315, 355, 416, 349, 589,
+	302, 0, 0, 407, 328, 0, 0, 0, 0, 0,
+	584, 585, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 226, 192, 340, 408, 260, 0, 87, 0, 0,
+	184, 185, 186, 623, 630, 631, 632, 633, 634, 624,
+	626, 0, 0, 217, 625, 224, 598, 628, 635, 636,
+	0, 240, 286, 247, 239, 426, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 574, 0, 588, 0, 0, 0, 250, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 571, 572, 0, 0, 0, 0, 608,
+	0, 573, 0, 0, 581, 637, 638, 639, 640, 641,
+	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 583, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 329, 232,
+	0, 607, 0, 0, 460, 0, 0, 605, 0, 0,
+	0, 0, 297, 0, 294, 188, 205, 0, 0, 339,
+	380, 386, 0, 0, 0, 230, 0, 384, 353, 444,
+	213, 258, 377, 358, 382, 365, 261, 0, 0, 383,
+	303, 431, 372, 441, 461, 462, 238, 333, 451, 420,
+	457, 473, 206, 235, 347, 413, 447, 404, 326, 427,
+	428, 293, 403, 267, 191, 301, 467, 204, 392, 221,
+	211, 228, 197, 415, 439, 218, 395, 0, 0, 475,
+	199, 437, 412, 322, 290, 291, 198, 0, 376, 243,
+	265, 233, 342, 434, 435, 231, 476, 208, 456, 201,
+	0, 455, 335, 430, 438, 323, 313, 200, 436, 321,
+	312, 296, 254, 276, 370, 306, 371, 277, 331, 330,
+	332, 194, 448, 0, 195, 0, 409, 449, 477, 214,
+	215, 216, 0, 253, 257, 264, 266, 272, 273, 280,
+	299, 346, 369, 367, 373, 0, 425, 442, 452, 459,
+	465, 466, 468, 469, 470, 471, 472, 334, 279, 405,
+	295, 304, 0, 0, 352, 385, 219, 446, 406, 614,
+	606, 593, 595, 615, 616, 590, 591, 594, 617, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 0, 609, 580,
+	579, 0, 586, 587, 0, 596, 597, 599, 600, 601,
+	602, 578, 187, 202, 300, 0, 374, 262, 474, 454,
+	450, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 325, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
+	222, 236, 251, 259, 270, 275, 278, 283, 284, 287,
+	292, 310, 316, 317, 318, 319, 336, 337, 338, 341,
+	344, 345, 348, 350, 351, 354, 361, 362, 363, 364,
+	366, 368, 375, 379, 387, 388, 389, 390, 391, 393,
+	394, 399, 400, 401, 402, 410, 414, 432, 433, 445,
+	458, 463, 271, 440, 464, 0, 309, 0, 0, 311,
+	255, 274, 285, 0, 453, 411, 207, 381, 263, 196,
+	225, 210, 234, 249, 252, 289, 320, 327, 356, 360,
+	268, 246, 223, 378, 220, 396, 417, 418, 419, 421,
+	324, 241, 359, 422, 0, 307, 423, 424, 281, 0,
+	0, 0, 0, 0, 0, 343, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 298,
+	242, 0, 0, 357, 0, 193, 0, 398, 229, 308,
+	305, 429, 256, 248, 244, 227, 282, 315, 355, 416,
+	349, 0, 302, 0, 0, 407, 328, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 226, 192, 340, 408, 260, 0, 0,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 240, 286, 247, 239, 426, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 931, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	329, 232, 0, 0, 0, 930, 460, 0, 0, 0,
+	0, 0, 927, 928, 297, 891, 294, 188, 205, 921,
+	925, 339, 380, 386, 0, 0, 0, 230, 0, 384,
+	353, 444, 213, 258, 377, 358, 382, 365, 261, 0,
+	0, 383, 303, 431, 372, 441, 461, 462, 238, 333,
+	451, 420, 457, 473, 206, 235, 347, 413, 447, 404,
+	326, 427, 428, 293, 403, 267, 191, 301, 467, 204,
+	392, 221, 211, 228, 197, 415, 439, 218, 395, 0,
+	0, 475, 199, 437, 412, 322, 290, 291, 198, 0,
+	376, 243, 265, 233, 342, 434, 435, 231, 476, 208,
+	456, 201, 0, 455, 335, 430, 438, 323, 313, 200,
+	436, 321, 312, 296, 254, 276, 370, 306, 371, 277,
+	331, 330, 332, 194, 448, 0, 195, 0, 409, 449,
+	477, 214, 215, 216, 0, 253, 257, 264, 266, 272,
+	273, 280, 299, 346, 369, 367, 373, 0, 425, 442,
+	452, 459, 465, 466, 468, 469, 470, 471, 472, 334,
+	279, 405, 295, 304, 0, 0, 352, 385, 219, 446,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	314, 397, 443, 0, 187, 202, 300, 0, 374, 262,
+	474, 454, 450, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 576, 0, 0, 0, 244, 581, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 588, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 583, 584, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 87, 0, 0, 184, 185, 186, 622, 629, 630,
-	631, 632, 633, 623, 625, 0, 0, 217, 624, 224,
-	597, 627, 634, 635, 0, 239, 285, 246, 238, 425,
+	203, 212, 222, 236, 251, 259, 270, 275, 278, 283,
+	284, 287, 292, 310, 316, 317, 318, 319, 336, 337,
+	338, 341, 344, 345, 348, 350, 351, 354, 361, 362,
+	363, 364, 366, 368, 375, 379, 387, 388, 389, 390,
+	391, 393, 394, 399, 400, 401, 402, 410, 414, 432,
+	433, 445, 458, 463, 271, 440, 464, 0, 309, 0,
+	0, 311, 255, 274, 285, 0, 453, 411, 207, 381,
+	263, 196, 225, 210, 234, 249, 252, 289, 320, 327,
+	356, 360, 268, 246, 223, 378, 220, 396, 417, 418,
+	419, 421, 324, 241, 359, 422, 0, 307, 423, 424,
+	281, 0, 0, 0, 0, 0, 0, 343, 0, 0,
+	0, 1236, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 298, 242, 0, 0, 357, 0, 193, 0, 398,
+	229, 308, 305, 429, 256, 248, 244, 227, 282, 315,
+	355, 416, 349, 0, 302, 0, 0, 407, 328, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 226, 192, 340, 408, 260,
+	0, 0, 0, 0, 184, 185, 186, 0, 1238, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 240, 286, 247, 239, 426,
 	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 0, 573, 0, 587, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 570, 571, 0,
-	0, 0, 0, 607, 0, 572, 0, 0, 580, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
-	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
-	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
-	582, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 606, 0, 0, 459, 0,
-	0, 604, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 613, 605, 592, 594, 614, 615, 589, 590,
-	593, 616, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
-	0, 608, 579, 578, 0, 585, 586, 0, 595, 596,
-	598, 599, 600, 601, 577, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	929, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
+	0, 1095, 0, 1096, 1097, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2857,164 +2934,109 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 928, 459,
-	0, 0, 0, 0, 0, 925, 926, 296, 889, 293,
-	188, 205, 919, 923, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 1230, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	1232, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
-	0, 0, 0, 1093, 0, 1094, 1095, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
+	269, 0, 329, 232, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 294, 188,
+	205, 0, 0, 339, 380, 386, 0, 0, 0, 230,
+	0, 384, 353, 444, 213, 258, 377, 358, 382, 365,
+	261, 0, 0, 383, 303, 431, 372, 441, 461, 462,
+	238, 333, 451, 420, 457, 473, 206, 235, 347, 413,
+	447, 404, 326, 427, 428, 293, 403, 267, 191, 301,
+	467, 204, 392, 221, 211, 228, 197, 415, 439, 218,
+	395, 0, 0, 475, 199, 437, 412, 322, 290, 291,
+	198, 0, 376, 243, 265, 233, 342, 434, 435, 231,
+	476, 208, 456, 201, 0, 455, 335, 430, 438, 323,
+	313, 200, 436, 321, 312, 296, 254, 276, 370, 306,
+	371, 277, 331, 330, 332, 194, 448, 0, 195, 0,
+	409, 449, 477, 214, 215, 216, 0, 253, 257, 264,
+	266, 272, 273, 280, 299, 346, 369, 367, 373, 0,
+	425, 442, 452, 459, 465, 466, 468, 469, 470, 471,
+	472, 334, 279, 405, 295, 304, 0, 0, 352, 385,
+	219, 446, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 314, 397, 443, 0, 187, 202, 300, 0,
+	374, 262, 474, 454, 450, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 203, 212, 222, 236, 251, 259, 270, 275,
+	278, 283, 284, 287, 292, 310, 316, 317, 318, 319,
+	336, 337, 338, 341, 344, 345, 348, 350, 351, 354,
+	361, 362, 363, 364, 366, 368, 375, 379, 387, 388,
+	389, 390, 391, 393, 394, 399, 400, 401, 402, 410,
+	414, 432, 433, 445, 458, 463, 271, 440, 464, 0,
+	309, 0, 0, 311, 255, 274, 285, 0, 453, 411,
+	207, 381, 263, 196, 225, 210, 234, 249, 252, 289,
+	320, 327, 356, 360, 268, 246, 223, 378, 220, 396,
+	417, 418, 419, 421, 324, 241, 359, 422, 0, 307,
+	423, 424, 281, 0, 0, 0, 0, 0, 0, 343,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 298, 242, 0, 0, 357, 0, 193,
+	0, 398, 229, 308, 305, 429, 256, 248, 244, 227,
+	282, 315, 355, 416, 349, 0, 302, 0, 0, 407,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 226, 192, 340,
+	408, 260, 0, 0, 0, 0, 184, 185, 186, 1174,
+	1177, 0, 0, 0, 0, 1173, 1176, 0, 0, 217,
+	1172, 224, 0, 0, 0, 0, 0, 240, 286, 247,
+	239, 426, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 269, 0, 329, 232, 0, 0, 0, 0,
+	460, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	294, 188, 205, 0, 0, 339, 380, 386, 0, 0,
+	0, 230, 0, 384, 353, 444, 213, 258, 377, 358,
+	382, 365, 261, 0, 0, 383, 303, 431, 372, 441,
+	461, 462, 238, 333, 451, 420, 457, 473, 206, 235,
+	347, 413, 447, 404, 326, 427, 428, 293, 403, 267,
+	191, 301, 467, 204, 392, 221, 211, 228, 197, 415,
+	439, 218, 395, 0, 0, 475, 199, 437, 412, 322,
+	290, 291, 198, 0, 376, 243, 265, 233, 342, 434,
+	435, 231, 476, 208, 456, 201, 0, 455, 335, 430,
+	438, 323, 313, 200, 436, 321, 312, 296, 254, 276,
+	370, 306, 371, 277, 331, 330, 332, 194, 448, 0,
+	195, 0, 409, 449, 477, 214, 215, 216, 0, 253,
+	257, 264, 266, 272, 273, 280, 299, 346, 369, 367,
+	373, 0, 425, 442, 452, 459, 465, 466, 468, 469,
+	470, 471, 472, 334, 279, 405, 295, 304, 0, 0,
+	352, 385, 219, 446, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 478, 479, 480, 481, 482,
 	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	1172, 1175, 0, 0, 0, 0, 1171, 1174, 0, 0,
-	217, 1170, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 78,
-	421, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 87, 0, 0, 184,
+	493, 494, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 314, 397, 443, 0, 187, 202,
+	300, 0, 374, 262, 474, 454, 450, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 203, 212, 222, 236, 251, 259,
+	270, 275, 278, 283, 284, 287, 292, 310, 316, 317,
+	318, 319, 336, 337, 338, 341, 344, 345, 348, 350,
+	351, 354, 361, 362, 363, 364, 366, 368, 375, 379,
+	387, 388, 389, 390, 391, 393, 394, 399, 400, 401,
+	402, 410, 414, 432, 433, 445, 458, 463, 271, 440,
+	464, 0, 309, 0, 0, 311, 255, 274, 285, 0,
+	453, 411, 207, 381, 263, 196, 225, 210, 234, 249,
+	252, 289, 320, 327, 356, 360, 268, 246, 223, 378,
+	220, 396, 417, 418, 419, 421, 324, 241, 359, 78,
+	422, 307, 423, 424, 281, 0, 0, 0, 0, 0,
+	0, 0, 343, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 298, 242, 0, 0,
+	357, 0, 193, 0, 398, 229, 308, 305, 429, 256,
+	248, 244, 227, 282, 315, 355, 416, 349, 0, 302,
+	0, 0, 407, 328, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	226, 192, 340, 408, 260, 0, 87, 0, 0, 184,
 	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
+	240, 286, 247, 239, 426, 0, 0, 0, 0, 0,
 	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3022,220 +3044,110 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
+	0, 0, 0, 0, 0, 269, 0, 329, 232, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 294, 188, 205, 0, 0, 339, 380,
+	386, 0, 0, 0, 230, 0, 384, 353, 444, 213,
+	258, 377, 358, 382, 365, 261, 0, 0, 383, 303,
+	431, 372, 441, 461, 462, 238, 333, 451, 420, 457,
+	473, 206, 235, 347, 413, 447, 404, 326, 427, 428,
+	293, 403, 267, 191, 301, 467, 204, 392, 221, 211,
+	228, 197, 415, 439, 218, 395, 0, 0, 475, 199,
+	437, 412, 322, 290, 291, 198, 0, 376, 243, 265,
+	233, 342, 434, 435, 231, 476, 208, 456, 201, 0,
+	455, 335, 430, 438, 323, 313, 200, 436, 321, 312,
+	296, 254, 276, 370, 306, 371, 277, 331, 330, 332,
+	194, 448, 0, 195, 0, 409, 449, 477, 214, 215,
+	216, 0, 253, 257, 264, 266, 272, 273, 280, 299,
+	346, 369, 367, 373, 0, 425, 442, 452, 459, 465,
+	466, 468, 469, 470, 471, 472, 334, 279, 405, 295,
+	304, 0, 0, 352, 385, 219, 446, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
 	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 86, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1663, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 78, 421, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 87, 0,
-	1210, 184, 185, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 86, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 1616,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 1402, 0, 0, 0,
+	490, 491, 492, 493, 494, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 314, 397, 443,
+	0, 187, 202, 300, 86, 374, 262, 474, 454, 450,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1671, 0, 325, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
+	236, 251, 259, 270, 275, 278, 283, 284, 287, 292,
+	310, 316, 317, 318, 319, 336, 337, 338, 341, 344,
+	345, 348, 350, 351, 354, 361, 362, 363, 364, 366,
+	368, 375, 379, 387, 388, 389, 390, 391, 393, 394,
+	399, 400, 401, 402, 410, 414, 432, 433, 445, 458,
+	463, 271, 440, 464, 0, 309, 0, 0, 311, 255,
+	274, 285, 0, 453, 411, 207, 381, 263, 196, 225,
+	210, 234, 249, 252, 289, 320, 327, 356, 360, 268,
+	246, 223, 378, 220, 396, 417, 418, 419, 421, 324,
+	241, 359, 78, 422, 307, 423, 424, 281, 0, 0,
+	0, 0, 0, 0, 0, 343, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 298,
+	242, 0, 0, 357, 0, 193, 0, 398, 229, 308,
+	305, 429, 256, 248, 244, 227, 282, 315, 355, 416,
+	349, 0, 302, 0, 0, 407, 328, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 226, 192, 340, 408, 260, 0, 87,
+	0, 1212, 184, 185, 186, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
+	0, 0, 0, 240, 286, 247, 239, 426, 0, 0,
 	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	1614, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	883, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 889, 293, 188, 205,
-	887, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	329, 232, 0, 0, 0, 0, 460, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 294, 188, 205, 0,
+	0, 339, 380, 386, 0, 0, 0, 230, 0, 384,
+	353, 444, 213, 258, 377, 358, 382, 365, 261, 0,
+	0, 383, 303, 431, 372, 441, 461, 462, 238, 333,
+	451, 420, 457, 473, 206, 235, 347, 413, 447, 404,
+	326, 427, 428, 293, 403, 267, 191, 301, 467, 204,
+	392, 221, 211, 228, 197, 415, 439, 218, 395, 0,
+	0, 475, 199, 437, 412, 322, 290, 291, 198, 0,
+	376, 243, 265, 233, 342, 434, 435, 231, 476, 208,
+	456, 201, 0, 455, 335, 430, 438, 323, 313, 200,
+	436, 321, 312, 296, 254, 276, 370, 306, 371, 277,
+	331, 330, 332, 194, 448, 0, 195, 0, 409, 449,
+	477, 214, 215, 216, 0, 253, 257, 264, 266, 272,
+	273, 280, 299, 346, 369, 367, 373, 0, 425, 442,
+	452, 459, 465, 466, 468, 469, 470, 471, 472, 334,
+	279, 405, 295, 304, 0, 0, 352, 385, 219, 446,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	314, 397, 443, 0, 187, 202, 300, 86, 374, 262,
+	474, 454, 450, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 1210, 184, 185, 186, 0, 0, 0,
+	203, 212, 222, 236, 251, 259, 270, 275, 278, 283,
+	284, 287, 292, 310, 316, 317, 318, 319, 336, 337,
+	338, 341, 344, 345, 348, 350, 351, 354, 361, 362,
+	363, 364, 366, 368, 375, 379, 387, 388, 389, 390,
+	391, 393, 394, 399, 400, 401, 402, 410, 414, 432,
+	433, 445, 458, 463, 271, 440, 464, 0, 309, 0,
+	0, 311, 255, 274, 285, 0, 453, 411, 207, 381,
+	263, 196, 225, 210, 234, 249, 252, 289, 320, 327,
+	356, 360, 268, 246, 223, 378, 220, 396, 417, 418,
+	419, 421, 324, 241, 359, 422, 0, 307, 423, 424,
+	281, 0, 0, 0, 0, 0, 0, 343, 0, 0,
+	0, 1622, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 298, 242, 0, 0, 357, 0, 193, 0, 398,
+	229, 308, 305, 429, 256, 248, 244, 227, 282, 315,
+	355, 416, 349, 0, 302, 0, 0, 407, 328, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 226, 192, 340, 408, 260,
+	0, 0, 0, 0, 184, 185, 186, 0, 1408, 0,
 	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
+	0, 0, 0, 0, 0, 240, 286, 247, 239, 426,
 	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3243,274 +3155,385 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 2477, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
+	269, 0, 329, 232, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 294, 188,
+	205, 0, 0, 339, 380, 386, 0, 0, 0, 230,
+	0, 384, 353, 444, 213, 258, 377, 358, 382, 365,
+	261, 0, 1620, 383, 303, 431, 372, 441, 461, 462,
+	238, 333, 451, 420, 457, 473, 206, 235, 347, 413,
+	447, 404, 326, 427, 428, 293, 403, 267, 191, 301,
+	467, 204, 392, 221, 211, 228, 197, 415, 439, 218,
+	395, 0, 0, 475, 199, 437, 412, 322, 290, 291,
+	198, 0, 376, 243, 265, 233, 342, 434, 435, 231,
+	476, 208, 456, 201, 0, 455, 335, 430, 438, 323,
+	313, 200, 436, 321, 312, 296, 254, 276, 370, 306,
+	371, 277, 331, 330, 332, 194, 448, 0, 195, 0,
+	409, 449, 477, 214, 215, 216, 0, 253, 257, 264,
+	266, 272, 273, 280, 299, 346, 369, 367, 373, 0,
+	425, 442, 452, 459, 465, 466, 468, 469, 470, 471,
+	472, 334, 279, 405, 295, 304, 0, 0, 352, 385,
+	219, 446, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 478, 479, 480, 481, 482, 483, 484,
 	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 314, 397, 443, 0, 187, 202, 300, 0,
+	374, 262, 474, 454, 450, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 203, 212, 222, 236, 251, 259, 270, 275,
+	278, 283, 284, 287, 292, 310, 316, 317, 318, 319,
+	336, 337, 338, 341, 344, 345, 348, 350, 351, 354,
+	361, 362, 363, 364, 366, 368, 375, 379, 387, 388,
+	389, 390, 391, 393, 394, 399, 400, 401, 402, 410,
+	414, 432, 433, 445, 458, 463, 271, 440, 464, 0,
+	309, 0, 0, 311, 255, 274, 285, 0, 453, 411,
+	207, 381, 263, 196, 225, 210, 234, 249, 252, 289,
+	320, 327, 356, 360, 268, 246, 223, 378, 220, 396,
+	417, 418, 419, 421, 324, 241, 359, 422, 0, 307,
+	423, 424, 281, 0, 0, 0, 0, 0, 0, 343,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 298, 242, 0, 0, 357, 0, 193,
+	0, 398, 229, 308, 305, 429, 256, 248, 244, 227,
+	282, 315, 355, 416, 349, 0, 302, 0, 0, 407,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 226, 192, 340,
+	408, 260, 0, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 224, 0, 0, 0, 0, 0, 240, 286, 247,
+	239, 426, 0, 0, 0, 0, 0, 0, 0, 209,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 1616, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 1402,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 885, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 269, 0, 329, 232, 0, 0, 0, 0,
+	460, 0, 0, 0, 0, 0, 0, 0, 297, 891,
+	294, 188, 205, 889, 0, 339, 380, 386, 0, 0,
+	0, 230, 0, 384, 353, 444, 213, 258, 377, 358,
+	382, 365, 261, 0, 0, 383, 303, 431, 372, 441,
+	461, 462, 238, 333, 451, 420, 457, 473, 206, 235,
+	347, 413, 447, 404, 326, 427, 428, 293, 403, 267,
+	191, 301, 467, 204, 392, 221, 211, 228, 197, 415,
+	439, 218, 395, 0, 0, 475, 199, 437, 412, 322,
+	290, 291, 198, 0, 376, 243, 265, 233, 342, 434,
+	435, 231, 476, 208, 456, 201, 0, 455, 335, 430,
+	438, 323, 313, 200, 436, 321, 312, 296, 254, 276,
+	370, 306, 371, 277, 331, 330, 332, 194, 448, 0,
+	195, 0, 409, 449, 477, 214, 215, 216, 0, 253,
+	257, 264, 266, 272, 273, 280, 299, 346, 369, 367,
+	373, 0, 425, 442, 452, 459, 465, 466, 468, 469,
+	470, 471, 472, 334, 279, 405, 295, 304, 0, 0,
+	352, 385, 219, 446, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 314, 397, 443, 0, 187, 202,
+	300, 0, 374, 262, 474, 454, 450, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 203, 212, 222, 236, 251, 259,
+	270, 275, 278, 283, 284, 287, 292, 310, 316, 317,
+	318, 319, 336, 337, 338, 341, 344, 345, 348, 350,
+	351, 354, 361, 362, 363, 364, 366, 368, 375, 379,
+	387, 388, 389, 390, 391, 393, 394, 399, 400, 401,
+	402, 410, 414, 432, 433, 445, 458, 463, 271, 440,
+	464, 0, 309, 0, 0, 311, 255, 274, 285, 0,
+	453, 411, 207, 381, 263, 196, 225, 210, 234, 249,
+	252, 289, 320, 327, 356, 360, 268, 246, 223, 378,
+	220, 396, 417, 418, 419, 421, 324, 241, 359, 422,
+	0, 307, 423, 424, 281, 0, 0, 0, 0, 0,
+	0, 343, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 298, 242, 0, 0, 357,
+	0, 193, 0, 398, 229, 308, 305, 429, 256, 248,
+	244, 227, 282, 315, 355, 416, 349, 0, 302, 0,
+	0, 407, 328, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 226,
+	192, 340, 408, 260, 0, 0, 0, 1212, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 0, 0, 0, 0, 0, 240,
+	286, 247, 239, 426, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 87, 0, 0, 184, 185, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 329, 232, 0, 0,
+	0, 0, 460, 0, 0, 0, 2486, 0, 0, 0,
+	297, 0, 294, 188, 205, 0, 0, 339, 380, 386,
+	0, 0, 0, 230, 0, 384, 353, 444, 213, 258,
+	377, 358, 382, 365, 261, 0, 0, 383, 303, 431,
+	372, 441, 461, 462, 238, 333, 451, 420, 457, 473,
+	206, 235, 347, 413, 447, 404, 326, 427, 428, 293,
+	403, 267, 191, 301, 467, 204, 392, 221, 211, 228,
+	197, 415, 439, 218, 395, 0, 0, 475, 199, 437,
+	412, 322, 290, 291, 198, 0, 376, 243, 265, 233,
+	342, 434, 435, 231, 476, 208, 456, 201, 0, 455,
+	335, 430, 438, 323, 313, 200, 436, 321, 312, 296,
+	254, 276, 370, 306, 371, 277, 331, 330, 332, 194,
+	448, 0, 195, 0, 409, 449, 477, 214, 215, 216,
+	0, 253, 257, 264, 266, 272, 273, 280, 299, 346,
+	369, 367, 373, 0, 425, 442, 452, 459, 465, 466,
+	468, 469, 470, 471, 472, 334, 279, 405, 295, 304,
+	0, 0, 352, 385, 219, 446, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 496, 314, 397, 443, 0,
+	187, 202, 300, 0, 374, 262, 474, 454, 450, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 203, 212, 222, 236,
+	251, 259, 270, 275, 278, 283, 284, 287, 292, 310,
+	316, 317, 318, 319, 336, 337, 338, 341, 344, 345,
+	348, 350, 351, 354, 361, 362, 363, 364, 366, 368,
+	375, 379, 387, 388, 389, 390, 391, 393, 394, 399,
+	400, 401, 402, 410, 414, 432, 433, 445, 458, 463,
+	271, 440, 464, 0, 309, 0, 0, 311, 255, 274,
+	285, 0, 453, 411, 207, 381, 263, 196, 225, 210,
+	234, 249, 252, 289, 320, 327, 356, 360, 268, 246,
+	223, 378, 220, 396, 417, 418, 419, 421, 324, 241,
+	359, 422, 0, 307, 423, 424, 281, 0, 0, 0,
+	0, 0, 0, 343, 0, 0, 0, 1622, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 298, 242, 0,
+	0, 357, 0, 193, 0, 398, 229, 308, 305, 429,
+	256, 248, 244, 227, 282, 315, 355, 416, 349, 0,
+	302, 0, 0, 407, 328, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 226, 192, 340, 408, 260, 0, 0, 0, 0,
+	184, 185, 186, 0, 1408, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
+	0, 240, 286, 247, 239, 426, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1663, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 1905, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1906, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 269, 0, 329, 232,
+	0, 0, 0, 0, 460, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 294, 188, 205, 0, 0, 339,
+	380, 386, 0, 0, 0, 230, 0, 384, 353, 444,
+	213, 258, 377, 358, 382, 365, 261, 0, 0, 383,
+	303, 431, 372, 441, 461, 462, 238, 333, 451, 420,
+	457, 473, 206, 235, 347, 413, 447, 404, 326, 427,
+	428, 293, 403, 267, 191, 301, 467, 204, 392, 221,
+	211, 228, 197, 415, 439, 218, 395, 0, 0, 475,
+	199, 437, 412, 322, 290, 291, 198, 0, 376, 243,
+	265, 233, 342, 434, 435, 231, 476, 208, 456, 201,
+	0, 455, 335, 430, 438, 323, 313, 200, 436, 321,
+	312, 296, 254, 276, 370, 306, 371, 277, 331, 330,
+	332, 194, 448, 0, 195, 0, 409, 449, 477, 214,
+	215, 216, 0, 253, 257, 264, 266, 272, 273, 280,
+	299, 346, 369, 367, 373, 0, 425, 442, 452, 459,
+	465, 466, 468, 469, 470, 471, 472, 334, 279, 405,
+	295, 304, 0, 0, 352, 385, 219, 446, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 496, 314, 397,
+	443, 0, 187, 202, 300, 0, 374, 262, 474, 454,
+	450, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 325, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
+	222, 236, 251, 259, 270, 275, 278, 283, 284, 287,
+	292, 310, 316, 317, 318, 319, 336, 337, 338, 341,
+	344, 345, 348, 350, 351, 354, 361, 362, 363, 364,
+	366, 368, 375, 379, 387, 388, 389, 390, 391, 393,
+	394, 399, 400, 401, 402, 410, 414, 432, 433, 445,
+	458, 463, 271, 440, 464, 0, 309, 0, 0, 311,
+	255, 274, 285, 0, 453, 411, 207, 381, 263, 196,
+	225, 210, 234, 249, 252, 289, 320, 327, 356, 360,
+	268, 246, 223, 378, 220, 396, 417, 418, 419, 421,
+	324, 241, 359, 422, 0, 307, 423, 424, 281, 0,
+	0, 0, 0, 0, 0, 343, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 298,
+	242, 0, 0, 357, 0, 193, 0, 398, 229, 308,
+	305, 429, 256, 248, 244, 227, 282, 315, 355, 416,
+	349, 0, 302, 0, 0, 407, 328, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 226, 192, 340, 408, 260, 0, 87,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 240, 286, 247, 239, 426, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 1890, 0, 0, 0, 1891, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	329, 232, 0, 0, 0, 0, 460, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 294, 188, 205, 0,
+	0, 339, 380, 386, 0, 0, 0, 230, 0, 384,
+	353, 444, 213, 258, 377, 358, 382, 365, 261, 0,
+	0, 383, 303, 431, 372, 441, 461, 462, 238, 333,
+	451, 420, 457, 473, 206, 235, 347, 413, 447, 404,
+	326, 427, 428, 293, 403, 267, 191, 301, 467, 204,
+	392, 221, 211, 228, 197, 415, 439, 218, 395, 0,
+	0, 475, 199, 437, 412, 322, 290, 291, 198, 0,
+	376, 243, 265, 233, 342, 434, 435, 231, 476, 208,
+	456, 201, 0, 455, 335, 430, 438, 323, 313, 200,
+	436, 321, 312, 296, 254, 276, 370, 306, 371, 277,
+	331, 330, 332, 194, 448, 0, 195, 0, 409, 449,
+	477, 214, 215, 216, 0, 253, 257, 264, 266, 272,
+	273, 280, 299, 346, 369, 367, 373, 0, 425, 442,
+	452, 459, 465, 466, 468, 469, 470, 471, 472, 334,
+	279, 405, 295, 304, 0, 0, 352, 385, 219, 446,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	314, 397, 443, 0, 187, 202, 300, 0, 374, 262,
+	474, 454, 450, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1671, 0, 325, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	203, 212, 222, 236, 251, 259, 270, 275, 278, 283,
+	284, 287, 292, 310, 316, 317, 318, 319, 336, 337,
+	338, 341, 344, 345, 348, 350, 351, 354, 361, 362,
+	363, 364, 366, 368, 375, 379, 387, 388, 389, 390,
+	391, 393, 394, 399, 400, 401, 402, 410, 414, 432,
+	433, 445, 458, 463, 271, 440, 464, 0, 309, 0,
+	0, 311, 255, 274, 285, 0, 453, 411, 207, 381,
+	263, 196, 225, 210, 234, 249, 252, 289, 320, 327,
+	356, 360, 268, 246, 223, 378, 220, 396, 417, 418,
+	419, 421, 324, 241, 359, 422, 0, 307, 423, 424,
+	281, 0, 0, 0, 0, 0, 0, 343, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 298, 242, 0, 0, 357, 0, 193, 0, 398,
+	229, 308, 305, 429, 256, 248, 244, 227, 282, 315,
+	355, 416, 349, 0, 302, 0, 0, 407, 328, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 226, 192, 340, 408, 260,
+	0, 0, 0, 0, 184, 185, 186, 0, 1914, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 240, 286, 247, 239, 426,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1915, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 1253, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 1252, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	269, 0, 329, 232, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 294, 188,
+	205, 0, 0, 339, 380, 386, 0, 0, 0, 230,
+	0, 384, 353, 444, 213, 258, 377, 358, 382, 365,
+	261, 0, 0, 383, 303, 431, 372, 441, 461, 462,
+	238, 333, 451, 420, 457, 473, 206, 235, 347, 413,
+	447, 404, 326, 427, 428, 293, 403, 267, 191, 301,
+	467, 204, 392, 221, 211, 228, 197, 415, 439, 218,
+	395, 0, 0, 475, 199, 437, 412, 322, 290, 291,
+	198, 0, 376, 243, 265, 233, 342, 434, 435, 231,
+	476, 208, 456, 201, 0, 455, 335, 430, 438, 323,
+	313, 200, 436, 321, 312, 296, 254, 276, 370, 306,
+	371, 277, 331, 330, 332, 194, 448, 0, 195, 0,
+	409, 449, 477, 214, 215, 216, 0, 253, 257, 264,
+	266, 272, 273, 280, 299, 346, 369, 367, 373, 0,
+	425, 442, 452, 459, 465, 466, 468, 469, 470, 471,
+	472, 334, 279, 405, 295, 304, 0, 0, 352, 385,
+	219, 446, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 314, 397, 443, 0, 187, 202, 300, 0,
+	374, 262, 474, 454, 450, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 203, 212, 222, 236, 251, 259, 270, 275,
+	278, 283, 284, 287, 292, 310, 316, 317, 318, 319,
+	336, 337, 338, 341, 344, 345, 348, 350, 351, 354,
+	361, 362, 363, 364, 366, 368, 375, 379, 387, 388,
+	389, 390, 391, 393, 394, 399, 400, 401, 402, 410,
+	414, 432, 433, 445, 458, 463, 271, 440, 464, 0,
+	309, 0, 0, 311, 255, 274, 285, 0, 453, 411,
+	207, 381, 263, 196, 225, 210, 234, 249, 252, 289,
+	320, 327, 356, 360, 268, 246, 223, 378, 220, 396,
+	417, 418, 419, 421, 324, 241, 359, 422, 0, 307,
+	423, 424, 281, 0, 0, 0, 0, 0, 0, 343,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 298, 242, 0, 0, 357, 0, 193,
+	0, 398, 229, 308, 305, 429, 256, 248, 244, 227,
+	282, 315, 355, 416, 349, 0, 302, 0, 0, 407,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 226, 192, 340,
+	408, 260, 0, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 1899, 0, 0, 0, 1900, 0, 0, 217,
+	0, 224, 0, 0, 0, 0, 0, 240, 286, 247,
+	239, 426, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 269, 0, 329, 232, 0, 0, 0, 0,
+	460, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	294, 188, 205, 0, 0, 339, 380, 386, 0, 0,
+	0, 230, 0, 384, 353, 444, 213, 258, 377, 358,
+	382, 365, 261, 0, 0, 383, 303, 431, 372, 441,
+	461, 462, 238, 333, 451, 420, 457, 473, 206, 235,
+	347, 413, 447, 404, 326, 427, 428, 293, 403, 267,
+	191, 301, 467, 204, 392, 221, 211, 228, 197, 415,
+	439, 218, 395, 0, 0, 475, 199, 437, 412, 322,
+	290, 291, 198, 0, 376, 243, 265, 233, 342, 434,
+	435, 231, 476, 208, 456, 201, 0, 455, 335, 430,
+	438, 323, 313, 200, 436, 321, 312, 296, 254, 276,
+	370, 306, 371, 277, 331, 330, 332, 194, 448, 0,
+	195, 0, 409, 449, 477, 214, 215, 216, 0, 253,
+	257, 264, 266, 272, 273, 280, 299, 346, 369, 367,
+	373, 0, 425, 442, 452, 459, 465, 466, 468, 469,
+	470, 471, 472, 334, 279, 405, 295, 304, 0, 0,
+	352, 385, 219, 446, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 314, 397, 443, 0, 187, 202,
+	300, 0, 374, 262, 474, 454, 450, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 203, 212, 222, 236, 251, 259,
+	270, 275, 278, 283, 284, 287, 292, 310, 316, 317,
+	318, 319, 336, 337, 338, 341, 344, 345, 348, 350,
+	351, 354, 361, 362, 363, 364, 366, 368, 375, 379,
+	387, 388, 389, 390, 391, 393, 394, 399, 400, 401,
+	402, 410, 414, 432, 433, 445, 458, 463, 271, 440,
+	464, 0, 309, 0, 0, 311, 255, 274, 285, 0,
+	453, 411, 207, 381, 263, 196, 225, 210, 234, 249,
+	252, 289, 320, 327, 356, 360, 268, 246, 223, 378,
+	220, 396, 417, 418, 419, 421, 324, 241, 359, 422,
+	0, 307, 423, 424, 281, 0, 0, 0, 0, 0,
+	0, 343, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 1259, 0, 0, 0, 298, 242, 0, 0, 357,
+	0, 193, 0, 398, 229, 308, 305, 429, 256, 248,
+	244, 227, 282, 315, 355, 416, 349, 0, 302, 0,
+	0, 407, 328, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 226,
+	192, 340, 408, 260, 0, 0, 0, 0, 184, 185,
+	186, 0, 1258, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 0, 0, 0, 0, 0, 240,
+	286, 247, 239, 426, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3518,54 +3541,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 329, 232, 0, 0,
+	0, 0, 460, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 294, 188, 205, 0, 0, 339, 380, 386,
+	0, 0, 0, 230, 0, 384, 353, 444, 213, 258,
+	377, 358, 382, 365, 261, 0, 0, 383, 303, 431,
+	372, 441, 461, 462, 238, 333, 451, 420, 457, 473,
+	206, 235, 347, 413, 447, 404, 326, 427, 428, 293,
+	403, 267, 191, 301, 467, 204, 392, 221, 211, 228,
+	197, 415, 439, 218, 395, 0, 0, 475, 199, 437,
+	412, 322, 290, 291, 198, 0, 376, 243, 265, 233,
+	342, 434, 435, 231, 476, 208, 456, 201, 0, 455,
+	335, 430, 438, 323, 313, 200, 436, 321, 312, 296,
+	254, 276, 370, 306, 371, 277, 331, 330, 332, 194,
+	448, 0, 195, 0, 409, 449, 477, 214, 215, 216,
+	0, 253, 257, 264, 266, 272, 273, 280, 299, 346,
+	369, 367, 373, 0, 425, 442, 452, 459, 465, 466,
+	468, 469, 470, 471, 472, 334, 279, 405, 295, 304,
+	0, 0, 352, 385, 219, 446, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 496, 314, 397, 443, 0,
+	187, 202, 300, 0, 374, 262, 474, 454, 450, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 203, 212, 222, 236,
+	251, 259, 270, 275, 278, 283, 284, 287, 292, 310,
+	316, 317, 318, 319, 336, 337, 338, 341, 344, 345,
+	348, 350, 351, 354, 361, 362, 363, 364, 366, 368,
+	375, 379, 387, 388, 389, 390, 391, 393, 394, 399,
+	400, 401, 402, 410, 414, 432, 433, 445, 458, 463,
+	271, 440, 464, 0, 309, 0, 0, 311, 255, 274,
+	285, 0, 453, 411, 207, 381, 263, 196, 225, 210,
+	234, 249, 252, 289, 320, 327, 356, 360, 268, 246,
+	223, 378, 220, 396, 417, 418, 419, 421, 324, 241,
+	359, 422, 0, 307, 423, 424, 281, 0, 0, 0,
+	0, 0, 0, 343, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 298, 242, 0,
+	0, 357, 0, 193, 0, 398, 229, 308, 305, 429,
+	256, 248, 244, 227, 282, 315, 355, 416, 349, 0,
+	302, 0, 0, 407, 328, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 226, 192, 340, 408, 260, 0, 0, 0, 0,
 	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
+	0, 240, 286, 247, 239, 426, 0, 0, 0, 0,
 	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3573,165 +3596,220 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 2545, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
+	0, 0, 0, 0, 0, 0, 269, 0, 329, 232,
+	0, 0, 0, 0, 460, 0, 0, 0, 2554, 0,
+	0, 0, 297, 0, 294, 188, 205, 0, 0, 339,
+	380, 386, 0, 0, 0, 230, 0, 384, 353, 444,
+	213, 258, 377, 358, 382, 365, 261, 0, 0, 383,
+	303, 431, 372, 441, 461, 462, 238, 333, 451, 420,
+	457, 473, 206, 235, 347, 413, 447, 404, 326, 427,
+	428, 293, 403, 267, 191, 301, 467, 204, 392, 221,
+	211, 228, 197, 415, 439, 218, 395, 0, 0, 475,
+	199, 437, 412, 322, 290, 291, 198, 0, 376, 243,
+	265, 233, 342, 434, 435, 231, 476, 208, 456, 201,
+	0, 455, 335, 430, 438, 323, 313, 200, 436, 321,
+	312, 296, 254, 276, 370, 306, 371, 277, 331, 330,
+	332, 194, 448, 0, 195, 0, 409, 449, 477, 214,
+	215, 216, 0, 253, 257, 264, 266, 272, 273, 280,
+	299, 346, 369, 367, 373, 0, 425, 442, 452, 459,
+	465, 466, 468, 469, 470, 471, 472, 334, 279, 405,
+	295, 304, 0, 0, 352, 385, 219, 446, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 478,
 	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	489, 490, 491, 492, 493, 494, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 496, 314, 397,
+	443, 0, 187, 202, 300, 0, 374, 262, 474, 454,
+	450, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 325, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
+	222, 236, 251, 259, 270, 275, 278, 283, 284, 287,
+	292, 310, 316, 317, 318, 319, 336, 337, 338, 341,
+	344, 345, 348, 350, 351, 354, 361, 362, 363, 364,
+	366, 368, 375, 379, 387, 388, 389, 390, 391, 393,
+	394, 399, 400, 401, 402, 410, 414, 432, 433, 445,
+	458, 463, 271, 440, 464, 0, 309, 0, 0, 311,
+	255, 274, 285, 0, 453, 411, 207, 381, 263, 196,
+	225, 210, 234, 249, 252, 289, 320, 327, 356, 360,
+	268, 246, 223, 378, 220, 396, 417, 418, 419, 421,
+	324, 241, 359, 422, 0, 307, 423, 424, 281, 0,
+	0, 0, 0, 0, 0, 343, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 298,
+	242, 0, 0, 357, 0, 193, 0, 398, 229, 308,
+	305, 429, 256, 248, 244, 227, 282, 315, 355, 416,
+	349, 0, 302, 0, 0, 407, 328, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 226, 192, 340, 408, 260, 0, 0,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 240, 286, 247, 239, 426, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
+	329, 232, 0, 0, 0, 0, 460, 0, 0, 0,
+	2486, 0, 0, 0, 297, 0, 294, 188, 205, 0,
+	0, 339, 380, 386, 0, 0, 0, 230, 0, 384,
+	353, 444, 213, 258, 377, 358, 382, 365, 261, 0,
+	0, 383, 303, 431, 372, 441, 461, 462, 238, 333,
+	451, 420, 457, 473, 206, 235, 347, 413, 447, 404,
+	326, 427, 428, 293, 403, 267, 191, 301, 467, 204,
+	392, 221, 211, 228, 197, 415, 439, 218, 395, 0,
+	0, 475, 199, 437, 412, 322, 290, 291, 198, 0,
+	376, 243, 265, 233, 342, 434, 435, 231, 476, 208,
+	456, 201, 0, 455, 335, 430, 438, 323, 313, 200,
+	436, 321, 312, 296, 254, 276, 370, 306, 371, 277,
+	331, 330, 332, 194, 448, 0, 195, 0, 409, 449,
+	477, 214, 215, 216, 0, 253, 257, 264, 266, 272,
+	273, 280, 299, 346, 369, 367, 373, 0, 425, 442,
+	452, 459, 465, 466, 468, 469, 470, 471, 472, 334,
+	279, 405, 295, 304, 0, 0, 352, 385, 219, 446,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	314, 397, 443, 0, 187, 202, 300, 0, 374, 262,
+	474, 454, 450, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	203, 212, 222, 236, 251, 259, 270, 275, 278, 283,
+	284, 287, 292, 310, 316, 317, 318, 319, 336, 337,
+	338, 341, 344, 345, 348, 350, 351, 354, 361, 362,
+	363, 364, 366, 368, 375, 379, 387, 388, 389, 390,
+	391, 393, 394, 399, 400, 401, 402, 410, 414, 432,
+	433, 445, 458, 463, 271, 440, 464, 0, 309, 0,
+	0, 311, 255, 274, 285, 0, 453, 411, 207, 381,
+	263, 196, 225, 210, 234, 249, 252, 289, 320, 327,
+	356, 360, 268, 246, 223, 378, 220, 396, 417, 418,
+	419, 421, 324, 241, 359, 422, 0, 307, 423, 424,
+	281, 0, 0, 0, 0, 0, 0, 343, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 298, 242, 0, 0, 357, 0, 193, 0, 398,
+	229, 308, 305, 429, 256, 248, 244, 227, 282, 315,
+	355, 416, 349, 0, 302, 0, 0, 407, 328, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 226, 192, 340, 408, 260,
+	0, 0, 0, 0, 184, 185, 186, 0, 1408, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 240, 286, 247, 239, 426,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 2477,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 1402, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 0, 329, 232, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 294, 188,
+	205, 0, 0, 339, 380, 386, 0, 0, 0, 230,
+	0, 384, 353, 444, 213, 258, 377, 358, 382, 365,
+	261, 0, 0, 383, 303, 431, 372, 441, 461, 462,
+	238, 333, 451, 420, 457, 473, 206, 235, 347, 413,
+	447, 404, 326, 427, 428, 293, 403, 267, 191, 301,
+	467, 204, 392, 221, 211, 228, 197, 415, 439, 218,
+	395, 0, 0, 475, 199, 437, 412, 322, 290, 291,
+	198, 0, 376, 243, 265, 233, 342, 434, 435, 231,
+	476, 208, 456, 201, 0, 455, 335, 430, 438, 323,
+	313, 200, 436, 321, 312, 296, 254, 276, 370, 306,
+	371, 277, 331, 330, 332, 194, 448, 0, 195, 0,
+	409, 449, 477, 214, 215, 216, 0, 253, 257, 264,
+	266, 272, 273, 280, 299, 346, 369, 367, 373, 0,
+	425, 442, 452, 459, 465, 466, 468, 469, 470, 471,
+	472, 334, 279, 405, 295, 304, 0, 0, 352, 385,
+	219, 446, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 314, 397, 443, 0, 187, 202, 300, 0,
+	374, 262, 474, 454, 450, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 203, 212, 222, 236, 251, 259, 270, 275,
+	278, 283, 284, 287, 292, 310, 316, 317, 318, 319,
+	336, 337, 338, 341, 344, 345, 348, 350, 351, 354,
+	361, 362, 363, 364, 366, 368, 375, 379, 387, 388,
+	389, 390, 391, 393, 394, 399, 400, 401, 402, 410,
+	414, 432, 433, 445, 458, 463, 271, 440, 464, 0,
+	309, 0, 0, 311, 255, 274, 285, 0, 453, 411,
+	207, 381, 263, 196, 225, 210, 234, 249, 252, 289,
+	320, 327, 356, 360, 268, 246, 223, 378, 220, 396,
+	417, 418, 419, 421, 324, 241, 359, 0, 422, 307,
+	423, 424, 281, 1672, 0, 0, 0, 0, 0, 0,
+	343, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 0, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 226, 192,
+	340, 408, 260, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 0, 421, 306, 422, 423, 280,
-	1664, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 269, 0, 329, 232, 0, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 314, 397, 443, 0, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	422, 0, 307, 423, 424, 281, 0, 0, 0, 0,
+	0, 0, 343, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 298, 242, 0, 0,
+	357, 0, 193, 0, 398, 229, 308, 305, 429, 256,
+	248, 244, 227, 282, 315, 355, 416, 349, 0, 302,
+	0, 0, 407, 328, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	226, 192, 340, 408, 260, 0, 0, 0, 0, 184,
+	185, 186, 0, 1238, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	240, 286, 247, 239, 426, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3739,164 +3817,165 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	0, 0, 0, 0, 0, 269, 0, 329, 232, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 294, 188, 205, 0, 0, 339, 380,
+	386, 0, 0, 0, 230, 0, 384, 353, 444, 213,
+	258, 377, 358, 382, 365, 261, 0, 0, 383, 303,
+	431, 372, 441, 461, 462, 238, 333, 451, 420, 457,
+	473, 206, 235, 347, 413, 447, 404, 326, 427, 428,
+	293, 403, 267, 191, 301, 467, 204, 392, 221, 211,
+	228, 197, 415, 439, 218, 395, 0, 0, 475, 199,
+	437, 412, 322, 290, 291, 198, 0, 376, 243, 265,
+	233, 342, 434, 435, 231, 476, 208, 456, 201, 0,
+	455, 335, 430, 438, 323, 313, 200, 436, 321, 312,
+	296, 254, 276, 370, 306, 371, 277, 331, 330, 332,
+	194, 448, 0, 195, 0, 409, 449, 477, 214, 215,
+	216, 0, 253, 257, 264, 266, 272, 273, 280, 299,
+	346, 369, 367, 373, 0, 425, 442, 452, 459, 465,
+	466, 468, 469, 470, 471, 472, 334, 279, 405, 295,
+	304, 0, 0, 352, 385, 219, 446, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 314, 397, 443,
+	0, 187, 202, 300, 0, 374, 262, 474, 454, 450,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 325, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
+	236, 251, 259, 270, 275, 278, 283, 284, 287, 292,
+	310, 316, 317, 318, 319, 336, 337, 338, 341, 344,
+	345, 348, 350, 351, 354, 361, 362, 363, 364, 366,
+	368, 375, 379, 387, 388, 389, 390, 391, 393, 394,
+	399, 400, 401, 402, 410, 414, 432, 433, 445, 458,
+	463, 271, 440, 464, 0, 309, 0, 0, 311, 255,
+	274, 285, 0, 453, 411, 207, 381, 263, 196, 225,
+	210, 234, 249, 252, 289, 320, 327, 356, 360, 268,
+	246, 223, 378, 220, 396, 417, 418, 419, 421, 324,
+	241, 359, 422, 0, 307, 423, 424, 281, 0, 0,
+	0, 0, 0, 0, 343, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 298, 242,
+	0, 0, 357, 0, 193, 0, 398, 229, 308, 305,
+	429, 256, 248, 244, 227, 282, 315, 355, 416, 349,
+	0, 302, 0, 0, 407, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 226, 192, 340, 408, 260, 0, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 240, 286, 247, 239, 426, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 1232,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1125, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 0, 329,
+	232, 0, 0, 0, 0, 460, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 294, 188, 205, 0, 0,
+	339, 380, 386, 0, 0, 0, 230, 0, 384, 353,
+	444, 213, 258, 377, 358, 382, 365, 261, 0, 0,
+	383, 303, 431, 372, 441, 461, 462, 238, 333, 451,
+	420, 457, 473, 206, 235, 347, 413, 447, 404, 326,
+	427, 428, 293, 403, 267, 191, 301, 467, 204, 392,
+	221, 211, 228, 197, 415, 439, 218, 395, 0, 0,
+	475, 199, 437, 412, 322, 290, 291, 198, 0, 376,
+	243, 265, 233, 342, 434, 435, 231, 476, 208, 456,
+	201, 0, 455, 335, 430, 438, 323, 313, 200, 436,
+	321, 312, 296, 254, 276, 370, 306, 371, 277, 331,
+	330, 332, 194, 448, 0, 195, 0, 409, 449, 477,
+	214, 215, 216, 0, 253, 257, 264, 266, 272, 273,
+	280, 299, 346, 369, 367, 373, 0, 425, 442, 452,
+	459, 465, 466, 468, 469, 470, 471, 472, 334, 279,
+	405, 295, 304, 0, 0, 352, 385, 219, 446, 406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 314,
+	397, 443, 0, 187, 202, 300, 0, 374, 262, 474,
+	454, 450, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
+	212, 222, 236, 251, 259, 270, 275, 278, 283, 284,
+	287, 292, 310, 316, 317, 318, 319, 336, 337, 338,
+	341, 344, 345, 348, 350, 351, 354, 361, 362, 363,
+	364, 366, 368, 375, 379, 387, 388, 389, 390, 391,
+	393, 394, 399, 400, 401, 402, 410, 414, 432, 433,
+	445, 458, 463, 271, 440, 464, 0, 309, 0, 0,
+	311, 255, 274, 285, 0, 453, 411, 207, 381, 263,
+	196, 225, 210, 234, 249, 252, 289, 320, 327, 356,
+	360, 268, 246, 223, 378, 220, 396, 417, 418, 419,
+	421, 324, 241, 359, 422, 0, 307, 423, 424, 281,
+	0, 0, 0, 0, 0, 0, 343, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	298, 242, 0, 0, 357, 0, 193, 0, 398, 229,
+	308, 305, 429, 256, 248, 244, 227, 282, 315, 355,
+	416, 349, 0, 302, 0, 0, 407, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 226, 192, 340, 408, 260, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
+	0, 0, 0, 0, 240, 286, 247, 239, 426, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1123,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 329, 232, 0, 0, 0, 0, 460, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 294, 188, 205,
+	0, 0, 339, 380, 386, 0, 0, 0, 230, 0,
+	384, 353, 444, 213, 258, 377, 358, 382, 365, 261,
+	0, 0, 383, 303, 431, 372, 441, 461, 462, 238,
+	333, 451, 420, 457, 473, 206, 235, 347, 413, 447,
+	404, 326, 427, 428, 293, 403, 267, 191, 301, 467,
+	204, 392, 221, 211, 228, 197, 415, 439, 218, 395,
+	0, 0, 475, 199, 437, 412, 322, 290, 291, 198,
+	0, 376, 243, 265, 233, 342, 434, 435, 231, 476,
+	208, 456, 201, 0, 455, 335, 430, 438, 323, 313,
+	200, 436, 321, 312, 296, 254, 276, 370, 306, 371,
+	277, 331, 330, 332, 194, 448, 0, 195, 0, 409,
+	449, 477, 214, 215, 216, 0, 253, 257, 264, 266,
+	272, 273, 280, 299, 346, 369, 367, 373, 0, 425,
+	442, 452, 459, 465, 466, 468, 469, 470, 471, 472,
+	334, 279, 405, 295, 304, 0, 0, 352, 385, 219,
+	446, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	496, 314, 397, 443, 0, 187, 202, 300, 1500, 374,
+	262, 474, 454, 450, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 203, 212, 222, 236, 251, 259, 270, 275, 278,
+	283, 284, 287, 292, 310, 316, 317, 318, 319, 336,
+	337, 338, 341, 344, 345, 348, 350, 351, 354, 361,
+	362, 363, 364, 366, 368, 375, 379, 387, 388, 389,
+	390, 391, 393, 394, 399, 400, 401, 402, 410, 414,
+	432, 433, 445, 458, 463, 271, 440, 464, 0, 309,
+	0, 0, 311, 255, 274, 285, 0, 453, 411, 207,
+	381, 263, 196, 225, 210, 234, 249, 252, 289, 320,
+	327, 356, 360, 268, 246, 223, 378, 220, 396, 417,
+	418, 419, 421, 324, 241, 359, 422, 0, 307, 423,
+	424, 281, 0, 0, 0, 0, 0, 0, 343, 0,
+	1380, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 298, 242, 0, 0, 357, 0, 193, 0,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 0, 302, 0, 0, 407, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 240, 286, 247, 239,
+	426, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3904,54 +3983,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 1494, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 1374, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 269, 0, 329, 232, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 294,
+	188, 205, 0, 0, 339, 380, 386, 0, 0, 0,
+	230, 0, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 0, 0, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 0, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 0, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	0, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 0, 0, 352,
+	385, 219, 446, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 314, 397, 443, 0, 187, 202, 300,
+	0, 374, 262, 474, 454, 450, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 0, 0, 311, 255, 274, 285, 0, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 422, 0,
+	307, 423, 424, 281, 0, 0, 0, 0, 0, 0,
+	343, 0, 1378, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 0, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 226, 192,
+	340, 408, 260, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3959,54 +4038,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 1372, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
+	0, 0, 0, 269, 0, 329, 232, 0, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 314, 397, 443, 0, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	422, 0, 307, 423, 424, 281, 0, 0, 0, 0,
+	0, 0, 343, 0, 1376, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 298, 242, 0, 0,
+	357, 0, 193, 0, 398, 229, 308, 305, 429, 256,
+	248, 244, 227, 282, 315, 355, 416, 349, 0, 302,
+	0, 0, 407, 328, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	226, 192, 340, 408, 260, 0, 0, 0, 0, 184,
 	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
+	240, 286, 247, 239, 426, 0, 0, 0, 0, 0,
 	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4014,109 +4093,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
+	0, 0, 0, 0, 0, 269, 0, 329, 232, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 294, 188, 205, 0, 0, 339, 380,
+	386, 0, 0, 0, 230, 0, 384, 353, 444, 213,
+	258, 377, 358, 382, 365, 261, 0, 0, 383, 303,
+	431, 372, 441, 461, 462, 238, 333, 451, 420, 457,
+	473, 206, 235, 347, 413, 447, 404, 326, 427, 428,
+	293, 403, 267, 191, 301, 467, 204, 392, 221, 211,
+	228, 197, 415, 439, 218, 395, 0, 0, 475, 199,
+	437, 412, 322, 290, 291, 198, 0, 376, 243, 265,
+	233, 342, 434, 435, 231, 476, 208, 456, 201, 0,
+	455, 335, 430, 438, 323, 313, 200, 436, 321, 312,
+	296, 254, 276, 370, 306, 371, 277, 331, 330, 332,
+	194, 448, 0, 195, 0, 409, 449, 477, 214, 215,
+	216, 0, 253, 257, 264, 266, 272, 273, 280, 299,
+	346, 369, 367, 373, 0, 425, 442, 452, 459, 465,
+	466, 468, 469, 470, 471, 472, 334, 279, 405, 295,
+	304, 0, 0, 352, 385, 219, 446, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
 	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 1370, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
+	490, 491, 492, 493, 494, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 314, 397, 443,
+	0, 187, 202, 300, 0, 374, 262, 474, 454, 450,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 325, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 1368, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
+	236, 251, 259, 270, 275, 278, 283, 284, 287, 292,
+	310, 316, 317, 318, 319, 336, 337, 338, 341, 344,
+	345, 348, 350, 351, 354, 361, 362, 363, 364, 366,
+	368, 375, 379, 387, 388, 389, 390, 391, 393, 394,
+	399, 400, 401, 402, 410, 414, 432, 433, 445, 458,
+	463, 271, 440, 464, 0, 309, 0, 0, 311, 255,
+	274, 285, 0, 453, 411, 207, 381, 263, 196, 225,
+	210, 234, 249, 252, 289, 320, 327, 356, 360, 268,
+	246, 223, 378, 220, 396, 417, 418, 419, 421, 324,
+	241, 359, 422, 0, 307, 423, 424, 281, 0, 0,
+	0, 0, 0, 0, 343, 0, 1374, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 298, 242,
+	0, 0, 357, 0, 193, 0, 398, 229, 308, 305,
+	429, 256, 248, 244, 227, 282, 315, 355, 416, 349,
+	0, 302, 0, 0, 407, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 226, 192, 340, 408, 260, 0, 0, 0,
 	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
+	0, 0, 240, 286, 247, 239, 426, 0, 0, 0,
 	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4124,165 +4148,110 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 1366, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 0, 329,
+	232, 0, 0, 0, 0, 460, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 294, 188, 205, 0, 0,
+	339, 380, 386, 0, 0, 0, 230, 0, 384, 353,
+	444, 213, 258, 377, 358, 382, 365, 261, 0, 0,
+	383, 303, 431, 372, 441, 461, 462, 238, 333, 451,
+	420, 457, 473, 206, 235, 347, 413, 447, 404, 326,
+	427, 428, 293, 403, 267, 191, 301, 467, 204, 392,
+	221, 211, 228, 197, 415, 439, 218, 395, 0, 0,
+	475, 199, 437, 412, 322, 290, 291, 198, 0, 376,
+	243, 265, 233, 342, 434, 435, 231, 476, 208, 456,
+	201, 0, 455, 335, 430, 438, 323, 313, 200, 436,
+	321, 312, 296, 254, 276, 370, 306, 371, 277, 331,
+	330, 332, 194, 448, 0, 195, 0, 409, 449, 477,
+	214, 215, 216, 0, 253, 257, 264, 266, 272, 273,
+	280, 299, 346, 369, 367, 373, 0, 425, 442, 452,
+	459, 465, 466, 468, 469, 470, 471, 472, 334, 279,
+	405, 295, 304, 0, 0, 352, 385, 219, 446, 406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 314,
+	397, 443, 0, 187, 202, 300, 0, 374, 262, 474,
+	454, 450, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
+	212, 222, 236, 251, 259, 270, 275, 278, 283, 284,
+	287, 292, 310, 316, 317, 318, 319, 336, 337, 338,
+	341, 344, 345, 348, 350, 351, 354, 361, 362, 363,
+	364, 366, 368, 375, 379, 387, 388, 389, 390, 391,
+	393, 394, 399, 400, 401, 402, 410, 414, 432, 433,
+	445, 458, 463, 271, 440, 464, 0, 309, 0, 0,
+	311, 255, 274, 285, 0, 453, 411, 207, 381, 263,
+	196, 225, 210, 234, 249, 252, 289, 320, 327, 356,
+	360, 268, 246, 223, 378, 220, 396, 417, 418, 419,
+	421, 324, 241, 359, 422, 0, 307, 423, 424, 281,
+	0, 0, 0, 0, 0, 0, 343, 0, 1372, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	298, 242, 0, 0, 357, 0, 193, 0, 398, 229,
+	308, 305, 429, 256, 248, 244, 227, 282, 315, 355,
+	416, 349, 0, 302, 0, 0, 407, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 226, 192, 340, 408, 260, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
+	0, 0, 0, 0, 240, 286, 247, 239, 426, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 1362, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 329, 232, 0, 0, 0, 0, 460, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 294, 188, 205,
+	0, 0, 339, 380, 386, 0, 0, 0, 230, 0,
+	384, 353, 444, 213, 258, 377, 358, 382, 365, 261,
+	0, 0, 383, 303, 431, 372, 441, 461, 462, 238,
+	333, 451, 420, 457, 473, 206, 235, 347, 413, 447,
+	404, 326, 427, 428, 293, 403, 267, 191, 301, 467,
+	204, 392, 221, 211, 228, 197, 415, 439, 218, 395,
+	0, 0, 475, 199, 437, 412, 322, 290, 291, 198,
+	0, 376, 243, 265, 233, 342, 434, 435, 231, 476,
+	208, 456, 201, 0, 455, 335, 430, 438, 323, 313,
+	200, 436, 321, 312, 296, 254, 276, 370, 306, 371,
+	277, 331, 330, 332, 194, 448, 0, 195, 0, 409,
+	449, 477, 214, 215, 216, 0, 253, 257, 264, 266,
+	272, 273, 280, 299, 346, 369, 367, 373, 0, 425,
+	442, 452, 459, 465, 466, 468, 469, 470, 471, 472,
+	334, 279, 405, 295, 304, 0, 0, 352, 385, 219,
+	446, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 1360,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
+	496, 314, 397, 443, 0, 187, 202, 300, 0, 374,
+	262, 474, 454, 450, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 203, 212, 222, 236, 251, 259, 270, 275, 278,
+	283, 284, 287, 292, 310, 316, 317, 318, 319, 336,
+	337, 338, 341, 344, 345, 348, 350, 351, 354, 361,
+	362, 363, 364, 366, 368, 375, 379, 387, 388, 389,
+	390, 391, 393, 394, 399, 400, 401, 402, 410, 414,
+	432, 433, 445, 458, 463, 271, 440, 464, 0, 309,
+	0, 0, 311, 255, 274, 285, 0, 453, 411, 207,
+	381, 263, 196, 225, 210, 234, 249, 252, 289, 320,
+	327, 356, 360, 268, 246, 223, 378, 220, 396, 417,
+	418, 419, 421, 324, 241, 359, 422, 0, 307, 423,
+	424, 281, 0, 0, 0, 0, 0, 0, 343, 0,
+	1368, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 298, 242, 0, 0, 357, 0, 193, 0,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 0, 302, 0, 0, 407, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 240, 286, 247, 239,
+	426, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4290,274 +4259,275 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	0, 269, 0, 329, 232, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 294,
+	188, 205, 0, 0, 339, 380, 386, 0, 0, 0,
+	230, 0, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 0, 0, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 0, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 0, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	0, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 0, 0, 352,
+	385, 219, 446, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 314, 397, 443, 0, 187, 202, 300,
+	0, 374, 262, 474, 454, 450, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 0, 0, 311, 255, 274, 285, 0, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 422, 0,
+	307, 423, 424, 281, 0, 0, 0, 0, 0, 0,
+	343, 0, 1366, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 0, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 226, 192,
+	340, 408, 260, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	1358, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 269, 0, 329, 232, 0, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 314, 397, 443, 0, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	422, 0, 307, 423, 424, 281, 0, 0, 0, 0,
+	0, 0, 343, 0, 1364, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 298, 242, 0, 0,
+	357, 0, 193, 0, 398, 229, 308, 305, 429, 256,
+	248, 244, 227, 282, 315, 355, 416, 349, 0, 302,
+	0, 0, 407, 328, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	226, 192, 340, 408, 260, 0, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	240, 286, 247, 239, 426, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 1335, 0, 0, 184, 185, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 269, 0, 329, 232, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 294, 188, 205, 0, 0, 339, 380,
+	386, 0, 0, 0, 230, 0, 384, 353, 444, 213,
+	258, 377, 358, 382, 365, 261, 0, 0, 383, 303,
+	431, 372, 441, 461, 462, 238, 333, 451, 420, 457,
+	473, 206, 235, 347, 413, 447, 404, 326, 427, 428,
+	293, 403, 267, 191, 301, 467, 204, 392, 221, 211,
+	228, 197, 415, 439, 218, 395, 0, 0, 475, 199,
+	437, 412, 322, 290, 291, 198, 0, 376, 243, 265,
+	233, 342, 434, 435, 231, 476, 208, 456, 201, 0,
+	455, 335, 430, 438, 323, 313, 200, 436, 321, 312,
+	296, 254, 276, 370, 306, 371, 277, 331, 330, 332,
+	194, 448, 0, 195, 0, 409, 449, 477, 214, 215,
+	216, 0, 253, 257, 264, 266, 272, 273, 280, 299,
+	346, 369, 367, 373, 0, 425, 442, 452, 459, 465,
+	466, 468, 469, 470, 471, 472, 334, 279, 405, 295,
+	304, 0, 0, 352, 385, 219, 446, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 314, 397, 443,
+	0, 187, 202, 300, 0, 374, 262, 474, 454, 450,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 325, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
+	236, 251, 259, 270, 275, 278, 283, 284, 287, 292,
+	310, 316, 317, 318, 319, 336, 337, 338, 341, 344,
+	345, 348, 350, 351, 354, 361, 362, 363, 364, 366,
+	368, 375, 379, 387, 388, 389, 390, 391, 393, 394,
+	399, 400, 401, 402, 410, 414, 432, 433, 445, 458,
+	463, 271, 440, 464, 0, 309, 0, 0, 311, 255,
+	274, 285, 0, 453, 411, 207, 381, 263, 196, 225,
+	210, 234, 249, 252, 289, 320, 327, 356, 360, 268,
+	246, 223, 378, 220, 396, 417, 418, 419, 421, 324,
+	241, 359, 422, 0, 307, 423, 424, 281, 0, 0,
+	0, 0, 0, 0, 343, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 298, 242,
+	0, 0, 357, 0, 193, 0, 398, 229, 308, 305,
+	429, 256, 248, 244, 227, 282, 315, 355, 416, 349,
+	0, 302, 0, 0, 407, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 226, 192, 340, 408, 260, 0, 1341, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 240, 286, 247, 239, 426, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 1237, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 0, 329,
+	232, 0, 0, 0, 0, 460, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 294, 188, 205, 0, 0,
+	339, 380, 386, 0, 0, 0, 230, 0, 384, 353,
+	444, 213, 258, 377, 358, 382, 365, 261, 0, 0,
+	383, 303, 431, 372, 441, 461, 462, 238, 333, 451,
+	420, 457, 473, 206, 235, 347, 413, 447, 404, 326,
+	427, 428, 293, 403, 267, 191, 301, 467, 204, 392,
+	221, 211, 228, 197, 415, 439, 218, 395, 0, 0,
+	475, 199, 437, 412, 322, 290, 291, 198, 0, 376,
+	243, 265, 233, 342, 434, 435, 231, 476, 208, 456,
+	201, 0, 455, 335, 430, 438, 323, 313, 200, 436,
+	321, 312, 296, 254, 276, 370, 306, 371, 277, 331,
+	330, 332, 194, 448, 0, 195, 0, 409, 449, 477,
+	214, 215, 216, 0, 253, 257, 264, 266, 272, 273,
+	280, 299, 346, 369, 367, 373, 0, 425, 442, 452,
+	459, 465, 466, 468, 469, 470, 471, 472, 334, 279,
+	405, 295, 304, 0, 0, 352, 385, 219, 446, 406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 314,
+	397, 443, 0, 187, 202, 300, 0, 374, 262, 474,
+	454, 450, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
+	212, 222, 236, 251, 259, 270, 275, 278, 283, 284,
+	287, 292, 310, 316, 317, 318, 319, 336, 337, 338,
+	341, 344, 345, 348, 350, 351, 354, 361, 362, 363,
+	364, 366, 368, 375, 379, 387, 388, 389, 390, 391,
+	393, 394, 399, 400, 401, 402, 410, 414, 432, 433,
+	445, 458, 463, 271, 440, 464, 0, 309, 0, 0,
+	311, 255, 274, 285, 0, 453, 411, 207, 381, 263,
+	196, 225, 210, 234, 249, 252, 289, 320, 327, 356,
+	360, 268, 246, 223, 378, 220, 396, 417, 418, 419,
+	421, 324, 241, 359, 422, 0, 307, 423, 424, 281,
+	0, 0, 0, 0, 0, 0, 343, 0, 0, 0,
+	0, 0, 0, 0, 1243, 245, 0, 0, 0, 0,
+	298, 242, 0, 0, 357, 0, 193, 0, 398, 229,
+	308, 305, 429, 256, 248, 244, 227, 282, 315, 355,
+	416, 349, 0, 302, 0, 0, 407, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 226, 192, 340, 408, 260, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
+	0, 0, 0, 0, 240, 286, 247, 239, 426, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 1070, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 329, 232, 0, 0, 0, 0, 460, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 294, 188, 205,
+	0, 0, 339, 380, 386, 0, 0, 0, 230, 0,
+	384, 353, 444, 213, 258, 377, 358, 382, 365, 261,
+	0, 0, 383, 303, 431, 372, 441, 461, 462, 238,
+	333, 451, 420, 457, 473, 206, 235, 347, 413, 447,
+	404, 326, 427, 428, 293, 403, 267, 191, 301, 467,
+	204, 392, 221, 211, 228, 197, 415, 439, 218, 395,
+	0, 0, 475, 199, 437, 412, 322, 290, 291, 198,
+	0, 376, 243, 265, 233, 342, 434, 435, 231, 476,
+	208, 456, 201, 0, 455, 335, 430, 438, 323, 313,
+	200, 436, 321, 312, 296, 254, 276, 370, 306, 371,
+	277, 331, 330, 332, 194, 448, 0, 195, 0, 409,
+	449, 477, 214, 215, 216, 0, 253, 257, 264, 266,
+	272, 273, 280, 299, 346, 369, 367, 373, 0, 425,
+	442, 452, 459, 465, 466, 468, 469, 470, 471, 472,
+	334, 279, 405, 295, 304, 0, 0, 352, 385, 219,
+	446, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	496, 314, 397, 443, 0, 187, 202, 300, 0, 374,
+	262, 474, 454, 450, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 203, 212, 222, 236, 251, 259, 270, 275, 278,
+	283, 284, 287, 292, 310, 316, 317, 318, 319, 336,
+	337, 338, 341, 344, 345, 348, 350, 351, 354, 361,
+	362, 363, 364, 366, 368, 375, 379, 387, 388, 389,
+	390, 391, 393, 394, 399, 400, 401, 402, 410, 414,
+	432, 433, 445, 458, 463, 271, 440, 464, 0, 309,
+	0, 0, 311, 255, 274, 285, 0, 453, 411, 207,
+	381, 263, 196, 225, 210, 234, 249, 252, 289, 320,
+	327, 356, 360, 268, 246, 223, 378, 220, 396, 417,
+	418, 419, 421, 324, 241, 359, 422, 0, 307, 423,
+	424, 281, 0, 0, 0, 0, 0, 0, 343, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 298, 242, 0, 0, 357, 0, 193, 0,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 0, 302, 0, 0, 407, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 185, 186, 0, 1072,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 240, 286, 247, 239,
+	426, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4565,54 +4535,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	698, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
+	0, 269, 0, 329, 232, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 294,
+	188, 205, 0, 0, 339, 380, 386, 0, 0, 0,
+	230, 0, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 0, 0, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 0, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 0, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	0, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 0, 0, 352,
+	385, 219, 446, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 314, 397, 443, 0, 187, 202, 300,
+	0, 374, 262, 474, 454, 450, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 0, 0, 311, 255, 274, 285, 0, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 422, 0,
+	307, 423, 424, 281, 0, 0, 0, 0, 0, 0,
+	343, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 0, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 226, 192,
+	340, 408, 260, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4620,54 +4590,54 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 551, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 550, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	0, 0, 0, 269, 0, 329, 232, 0, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 314, 397, 443, 0, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 699,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	422, 0, 307, 423, 424, 281, 0, 0, 0, 0,
+	0, 0, 343, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 298, 242, 0, 0,
+	357, 0, 193, 0, 398, 229, 308, 305, 429, 256,
+	248, 244, 227, 282, 315, 355, 416, 349, 0, 302,
+	0, 0, 407, 328, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	226, 192, 340, 408, 260, 0, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	240, 286, 247, 239, 426, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4675,221 +4645,220 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 498, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 552, 0, 269, 0, 329, 232, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 294, 188, 205, 0, 0, 339, 380,
+	386, 0, 0, 0, 230, 0, 384, 353, 444, 213,
+	258, 377, 358, 382, 365, 261, 0, 0, 383, 303,
+	431, 372, 441, 461, 462, 238, 333, 451, 420, 457,
+	473, 206, 235, 347, 413, 447, 404, 326, 427, 428,
+	293, 403, 267, 191, 301, 467, 204, 392, 221, 211,
+	228, 197, 415, 439, 218, 395, 0, 0, 475, 199,
+	437, 412, 322, 290, 291, 198, 0, 376, 243, 265,
+	233, 342, 434, 435, 231, 476, 208, 456, 201, 0,
+	455, 335, 430, 438, 323, 313, 200, 436, 321, 312,
+	296, 254, 276, 370, 306, 371, 277, 331, 330, 332,
+	194, 448, 0, 195, 0, 409, 449, 477, 214, 215,
+	216, 0, 253, 257, 264, 266, 272, 273, 280, 299,
+	346, 369, 367, 373, 0, 425, 442, 452, 459, 465,
+	466, 468, 469, 470, 471, 472, 334, 279, 405, 295,
+	304, 0, 0, 352, 385, 219, 446, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 314, 397, 443,
+	0, 187, 202, 300, 0, 374, 262, 474, 454, 450,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 325, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
+	236, 251, 259, 270, 275, 278, 283, 284, 287, 292,
+	310, 316, 317, 318, 319, 336, 337, 338, 341, 344,
+	345, 348, 350, 351, 354, 361, 362, 363, 364, 366,
+	368, 375, 379, 387, 388, 389, 390, 391, 393, 394,
+	399, 400, 401, 402, 410, 414, 432, 433, 445, 458,
+	463, 551, 440, 464, 0, 309, 0, 0, 311, 255,
+	274, 285, 0, 453, 411, 207, 381, 263, 196, 225,
+	210, 234, 249, 252, 289, 320, 327, 356, 360, 268,
+	246, 223, 378, 220, 396, 417, 418, 419, 421, 324,
+	241, 359, 422, 0, 307, 423, 424, 281, 0, 0,
+	0, 0, 0, 0, 343, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 298, 242,
+	0, 0, 357, 0, 193, 0, 398, 229, 308, 305,
+	429, 256, 248, 244, 227, 282, 315, 355, 416, 349,
+	0, 302, 0, 0, 407, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 226, 192, 340, 408, 260, 0, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 240, 286, 247, 239, 426, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
+	0, 0, 0, 0, 0, 0, 0, 269, 0, 329,
+	232, 0, 0, 499, 0, 460, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 294, 188, 205, 0, 0,
+	339, 380, 386, 0, 0, 0, 230, 0, 384, 353,
+	444, 213, 258, 377, 358, 382, 365, 261, 0, 0,
+	383, 303, 431, 372, 441, 461, 462, 238, 333, 451,
+	420, 457, 473, 206, 235, 347, 413, 447, 404, 326,
+	427, 428, 293, 403, 267, 191, 301, 467, 204, 392,
+	221, 211, 228, 197, 415, 439, 218, 395, 0, 0,
+	475, 199, 437, 412, 322, 290, 291, 198, 0, 376,
+	243, 265, 233, 342, 434, 435, 231, 476, 208, 456,
+	201, 0, 455, 335, 430, 438, 323, 313, 200, 436,
+	321, 312, 296, 254, 276, 370, 306, 371, 277, 331,
+	330, 332, 194, 448, 0, 195, 0, 409, 449, 477,
+	214, 215, 216, 0, 253, 257, 264, 266, 272, 273,
+	280, 299, 346, 369, 367, 373, 0, 425, 442, 452,
+	459, 465, 466, 468, 469, 470, 471, 472, 334, 279,
+	405, 295, 304, 0, 0, 352, 385, 219, 446, 406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 314,
+	397, 443, 0, 187, 202, 300, 0, 374, 262, 474,
+	454, 450, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 2279, 186, 0, 0, 0, 0,
+	212, 222, 236, 251, 259, 270, 275, 278, 283, 284,
+	287, 292, 310, 316, 317, 318, 319, 336, 337, 338,
+	341, 344, 345, 348, 350, 351, 354, 361, 362, 363,
+	364, 366, 368, 375, 379, 387, 388, 389, 390, 391,
+	393, 394, 399, 400, 401, 402, 410, 414, 432, 433,
+	445, 458, 463, 271, 440, 464, 0, 309, 0, 0,
+	311, 255, 274, 285, 0, 453, 411, 207, 381, 263,
+	196, 225, 210, 234, 249, 252, 289, 320, 327, 356,
+	360, 268, 246, 223, 378, 220, 396, 417, 418, 419,
+	421, 324, 241, 359, 422, 0, 307, 423, 424, 281,
+	0, 0, 0, 0, 0, 0, 343, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	298, 242, 0, 0, 357, 0, 193, 0, 398, 229,
+	308, 305, 429, 256, 248, 244, 227, 282, 315, 355,
+	416, 349, 0, 302, 0, 0, 407, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 226, 192, 340, 408, 260, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
+	0, 0, 0, 0, 240, 286, 247, 239, 426, 0,
 	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 1886, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	0, 329, 232, 0, 0, 0, 0, 460, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 294, 188, 205,
+	0, 0, 339, 380, 386, 0, 0, 0, 230, 0,
+	384, 353, 444, 213, 258, 377, 358, 382, 365, 261,
+	0, 0, 383, 303, 431, 372, 441, 461, 462, 238,
+	333, 451, 420, 457, 473, 206, 235, 347, 413, 447,
+	404, 326, 427, 428, 293, 403, 267, 191, 301, 467,
+	204, 392, 221, 211, 228, 197, 415, 439, 218, 395,
+	0, 0, 475, 199, 437, 412, 322, 290, 291, 198,
+	0, 376, 243, 265, 233, 342, 434, 435, 231, 476,
+	208, 456, 201, 0, 455, 335, 430, 438, 323, 313,
+	200, 436, 321, 312, 296, 254, 276, 370, 306, 371,
+	277, 331, 330, 332, 194, 448, 0, 195, 0, 409,
+	449, 477, 214, 215, 216, 0, 253, 257, 264, 266,
+	272, 273, 280, 299, 346, 369, 367, 373, 0, 425,
+	442, 452, 459, 465, 466, 468, 469, 470, 471, 472,
+	334, 279, 405, 295, 304, 0, 0, 352, 385, 219,
+	446, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	496, 314, 397, 443, 0, 187, 202, 300, 0, 374,
+	262, 474, 454, 450, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 203, 212, 222, 236, 251, 259, 270, 275, 278,
+	283, 284, 287, 292, 310, 316, 317, 318, 319, 336,
+	337, 338, 341, 344, 345, 348, 350, 351, 354, 361,
+	362, 363, 364, 366, 368, 375, 379, 387, 388, 389,
+	390, 391, 393, 394, 399, 400, 401, 402, 410, 414,
+	432, 433, 445, 458, 463, 271, 440, 464, 0, 309,
+	0, 0, 311, 255, 274, 285, 0, 453, 411, 207,
+	381, 263, 196, 225, 210, 234, 249, 252, 289, 320,
+	327, 356, 360, 268, 246, 223, 378, 220, 396, 417,
+	418, 419, 421, 324, 241, 359, 422, 0, 307, 423,
+	424, 281, 0, 0, 0, 0, 0, 0, 343, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 298, 242, 0, 0, 357, 0, 193, 0,
+	398, 229, 308, 305, 429, 256, 248, 244, 227, 282,
+	315, 355, 416, 349, 0, 302, 0, 0, 407, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 226, 192, 340, 408,
+	260, 0, 0, 0, 0, 184, 2288, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 240, 286, 247, 239,
+	426, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	0, 0, 0, 250, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 87, 0, 306, 422,
-	423, 280, 622, 629, 630, 631, 632, 633, 623, 625,
-	0, 0, 0, 624, 0, 0, 627, 634, 635, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2221, 2222, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 636, 637, 638, 639, 640, 641,
-	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
-	672, 673, 674, 675, 676, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 269, 0, 329, 232, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 294,
+	188, 205, 0, 0, 339, 380, 386, 0, 0, 0,
+	230, 0, 384, 353, 444, 213, 258, 377, 358, 382,
+	365, 261, 0, 0, 383, 303, 431, 372, 441, 461,
+	462, 238, 333, 451, 420, 457, 473, 206, 235, 347,
+	413, 447, 404, 326, 427, 428, 293, 403, 267, 191,
+	301, 467, 204, 392, 221, 211, 228, 197, 415, 439,
+	218, 395, 0, 0, 475, 199, 437, 412, 322, 290,
+	291, 198, 0, 376, 243, 265, 233, 342, 434, 435,
+	231, 476, 208, 456, 201, 0, 455, 335, 430, 438,
+	323, 313, 200, 436, 321, 312, 296, 254, 276, 370,
+	306, 371, 277, 331, 330, 332, 194, 448, 0, 195,
+	0, 409, 449, 477, 214, 215, 216, 0, 253, 257,
+	264, 266, 272, 273, 280, 299, 346, 369, 367, 373,
+	0, 425, 442, 452, 459, 465, 466, 468, 469, 470,
+	471, 472, 334, 279, 405, 295, 304, 0, 0, 352,
+	385, 219, 446, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 314, 397, 443, 0, 187, 202, 300,
+	0, 374, 262, 474, 454, 450, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 203, 212, 222, 236, 251, 259, 270,
+	275, 278, 283, 284, 287, 292, 310, 316, 317, 318,
+	319, 336, 337, 338, 341, 344, 345, 348, 350, 351,
+	354, 361, 362, 363, 364, 366, 368, 375, 379, 387,
+	388, 389, 390, 391, 393, 394, 399, 400, 401, 402,
+	410, 414, 432, 433, 445, 458, 463, 271, 440, 464,
+	0, 309, 0, 0, 311, 255, 274, 285, 0, 453,
+	411, 207, 381, 263, 196, 225, 210, 234, 249, 252,
+	289, 320, 327, 356, 360, 268, 246, 223, 378, 220,
+	396, 417, 418, 419, 421, 324, 241, 359, 422, 0,
+	307, 423, 424, 281, 0, 0, 0, 0, 0, 0,
+	343, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 298, 242, 0, 0, 357, 0,
+	193, 0, 398, 229, 308, 305, 429, 256, 248, 244,
+	227, 282, 315, 355, 416, 349, 0, 302, 0, 0,
+	407, 328, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 226, 192,
+	340, 408, 260, 0, 0, 0, 0, 184, 1895, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 240, 286,
+	247, 239, 426, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4897,38 +4866,95 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 269, 0, 329, 232, 0, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 294, 188, 205, 0, 0, 339, 380, 386, 0,
+	0, 0, 230, 0, 384, 353, 444, 213, 258, 377,
+	358, 382, 365, 261, 0, 0, 383, 303, 431, 372,
+	441, 461, 462, 238, 333, 451, 420, 457, 473, 206,
+	235, 347, 413, 447, 404, 326, 427, 428, 293, 403,
+	267, 191, 301, 467, 204, 392, 221, 211, 228, 197,
+	415, 439, 218, 395, 0, 0, 475, 199, 437, 412,
+	322, 290, 291, 198, 0, 376, 243, 265, 233, 342,
+	434, 435, 231, 476, 208, 456, 201, 0, 455, 335,
+	430, 438, 323, 313, 200, 436, 321, 312, 296, 254,
+	276, 370, 306, 371, 277, 331, 330, 332, 194, 448,
+	0, 195, 0, 409, 449, 477, 214, 215, 216, 0,
+	253, 257, 264, 266, 272, 273, 280, 299, 346, 369,
+	367, 373, 0, 425, 442, 452, 459, 465, 466, 468,
+	469, 470, 471, 472, 334, 279, 405, 295, 304, 0,
+	0, 352, 385, 219, 446, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 314, 397, 443, 0, 187,
+	202, 300, 0, 374, 262, 474, 454, 450, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 203, 212, 222, 236, 251,
+	259, 270, 275, 278, 283, 284, 287, 292, 310, 316,
+	317, 318, 319, 336, 337, 338, 341, 344, 345, 348,
+	350, 351, 354, 361, 362, 363, 364, 366, 368, 375,
+	379, 387, 388, 389, 390, 391, 393, 394, 399, 400,
+	401, 402, 410, 414, 432, 433, 445, 458, 463, 271,
+	440, 464, 0, 309, 0, 0, 311, 255, 274, 285,
+	0, 453, 411, 207, 381, 263, 196, 225, 210, 234,
+	249, 252, 289, 320, 327, 356, 360, 268, 246, 223,
+	378, 220, 396, 417, 418, 419, 421, 324, 241, 359,
+	87, 0, 307, 423, 424, 281, 623, 630, 631, 632,
+	633, 634, 624, 626, 0, 0, 0, 625, 0, 0,
+	628, 635, 636, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2230, 2231, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 637, 638,
+	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
+	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
+	669, 670, 671, 672, 673, 674, 675, 676, 677, 87,
+	0, 0, 0, 0, 0, 623, 630, 631, 632, 633,
+	634, 624, 626, 0, 0, 0, 625, 0, 0, 628,
+	635, 636, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2230, 2231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 637, 638, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
+	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
+	670, 671, 672, 673, 674, 675, 676, 677, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 613, 0, 0,
-	0, 614, 615, 0, 0, 0, 616,
+	0, 0, 614, 0, 0, 0, 615, 616, 0, 0,
+	0, 617,
 }
 
 var yyPact = [...]int{
-	3742, -1000, -390, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5055, -1000, -392, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1742, 1794, -1000, -1000,
-	-1000, -1000, 1891, -1000, 671, 1553, -1000, 1750, 2725, -1000,
-	34186, 441, -1000, 33635, 425, 4149, 34186, -1000, 112, -1000,
-	98, 34186, 107, 33084, -1000, -1000, -299, 14347, 1702, -7,
-	-8, 34186, -1000, -1000, -1000, -1000, 1862, 1549, -1000, 314,
-	-1000, -1000, -1000, -1000, -1000, -1000, 32533, -1000, -1000, -1000,
-	1753, 1743, 1896, 606, 1695, -1000, 1796, 1549, -1000, 14347,
-	1865, 1785, 13796, -1000, 13796, 351, -1000, -1000, 9932, -1000,
-	-1000, 18757, 34186, 34186, 308, -1000, 1750, -1000, -1000, 379,
-	-1000, 258, 1475, -1000, 1474, -1000, 869, 393, 272, 394,
-	384, 270, 269, 267, 264, 263, 262, 261, 257, 287,
-	-1000, 642, 642, -192, -195, 2185, 328, 328, 328, 388,
-	1724, 1721, -1000, 529, -1000, 642, 642, 327, 642, 642,
-	642, 642, 228, 211, 642, 642, 642, 642, 642, 642,
-	642, 642, 642, 642, 642, 642, 642, 642, 642, 292,
-	1750, 177, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1791, 1803, -1000, -1000,
+	-1000, -1000, 1910, -1000, 653, 1563, -1000, 1797, 373, -1000,
+	34417, 420, -1000, 33865, 418, 2703, 34417, -1000, 109, -1000,
+	92, 34417, 102, 33313, -1000, -1000, -307, 14542, 1732, -23,
+	-24, 34417, -1000, -1000, -1000, -1000, 1888, 1539, -1000, 291,
+	-1000, -1000, -1000, -1000, -1000, -1000, 32761, -1000, -1000, -1000,
+	1804, 1769, 1720, 564, 1725, -1000, 1839, 1539, -1000, 14542,
+	1877, 1823, 13990, -1000, 13990, 353, -1000, -1000, 10119, -1000,
+	-1000, 18960, 34417, 34417, 214, -1000, 1797, -1000, -1000, 278,
+	-1000, 251, 1464, -1000, 1462, -1000, 669, 447, 272, 401,
+	398, 270, 266, 264, 263, 262, 260, 259, 256, 286,
+	-1000, 588, 588, -197, -199, 2554, 326, 326, 326, 376,
+	1750, 1748, -1000, 584, -1000, 588, 588, 273, 588, 588,
+	588, 588, 232, 228, 588, 588, 588, 588, 588, 588,
+	588, 588, 588, 588, 588, 588, 588, 588, 588, 444,
+	1797, 207, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4959,33 +4985,33 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 34186, 143, 34186, -1000,
-	505, 34186, 742, 742, 47, 742, 742, 742, 742, 139,
-	530, -9, -1000, 130, 170, 83, 175, 718, 122, 121,
-	-1000, -1000, 173, 718, 1139, 609, 89, -1000, 742, 7696,
-	7696, 7696, -1000, 1740, -1000, -1000, -1000, -1000, -1000, -1000,
-	1143, -1000, 387, -1000, -1000, -1000, -1000, 34186, 31982, 268,
-	647, -1000, -1000, -1000, 30, -1000, -1000, 1280, 928, 14347,
-	844, -1000, 1436, 521, -1000, -1000, -1000, -1000, -1000, 511,
-	14898, 14898, 14898, 14898, -1000, -1000, 1478, 1478, 1478, 1478,
-	14898, 1478, 14898, 1478, 1478, 1478, 1478, 14347, 1478, 1478,
-	1478, -1000, 1478, 1478, 1478, 1478, 1478, 1478, 1478, 1478,
-	1478, 1478, 1478, 500, 1478, 1478, 1478, 1478, 1478, -1000,
-	-1000, -1000, -1000, 1478, 1478, 1478, 1478, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 16551, -1000, 12143, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 34417, 268, 34417,
+	-1000, 491, 34417, 706, 706, 54, 706, 706, 706, 706,
+	85, 540, -31, -1000, 79, 227, 70, 183, 716, 180,
+	116, -1000, -1000, 176, 716, 1162, 567, 81, -1000, 706,
+	7879, 7879, 7879, -1000, 1776, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1181, -1000, 374, -1000, -1000, -1000, -1000, 34417, 32209,
+	290, 609, -1000, -1000, -1000, 29, -1000, -1000, 1286, 810,
+	14542, 881, -1000, 1458, 531, -1000, -1000, -1000, -1000, -1000,
+	459, 15094, 15094, 15094, 15094, -1000, -1000, 1489, 1489, 1489,
+	1489, 15094, 1489, 15094, 1489, 1489, 1489, 1489, 14542, 1489,
+	1489, 1489, -1000, 1489, 1489, 1489, 1489, 1489, 1489, 1489,
+	1489, 1489, 1489, 1489, 484, 1489, 1489, 1489, 1489, 1489,
+	-1000, -1000, -1000, -1000, 1489, 1489, 1489, 1489, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 16750, -1000, 12334,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 34186, -1000,
-	1478, 125, 34186, 34186, 271, 1796, 1549, -1000, 1862, 1813,
-	314, -1000, 1850, 1486, 1466, 1099, 1549, 1454, 34186, -1000,
-	1484, -1000, -1000, -1000, 1651, 1079, 1134, -1000, -1000, -1000,
-	-1000, 911, 14347, -1000, -1000, 1887, -1000, 16000, 498, 788,
-	1886, 31431, -1000, 351, 351, 1470, 9373, -36, -1000, -1000,
-	-1000, 645, 22063, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1740,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 34417,
+	-1000, 1489, 123, 34417, 34417, 276, 1839, 1539, -1000, 1888,
+	1868, 291, -1000, 1788, 1362, 1276, 1185, 1539, 1453, 34417,
+	-1000, 1495, -1000, -1000, -234, -243, 1672, 1055, 1159, -1000,
+	-1000, -1000, -1000, 1130, 14542, -1000, -1000, 1907, -1000, 16198,
+	479, 795, 1905, 31657, -1000, 353, 353, 1459, 9559, -67,
+	-1000, -1000, -1000, 602, 22272, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1776, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4998,218 +5024,219 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1422, 34186, -1000, -1000, 4879, 1207, -1000, 1552,
-	-1000, 1414, -1000, 1519, 1563, 417, 1207, 409, 402, 401,
-	-1000, -100, -1000, -1000, -1000, -1000, -1000, 642, 642, -1000,
-	280, 1847, 2725, 4903, -1000, -1000, -1000, 30880, 1551, 1207,
-	-1000, 1548, -1000, 746, 444, 455, 455, 1207, -1000, -1000,
-	34186, 1207, 741, 734, 34186, 34186, -1000, 30329, -1000, 29778,
-	29227, 1025, 34186, 28676, 28125, 27574, 27023, 26472, -1000, 1626,
-	-1000, 1518, -1000, -1000, -1000, 34186, 34186, 34186, 260, -1000,
-	-1000, 34186, 1207, -1000, -1000, 1016, 1009, 642, 642, 1001,
-	1131, 1126, 1124, 642, 642, 999, 1122, 23716, 208, 995,
-	994, 966, 1089, 1120, 192, 1071, 963, 960, 34186, 1547,
-	34186, -1000, 160, 592, 319, 644, 1750, 1700, 1462, 370,
-	416, 1207, 342, 342, 34186, -1000, 8255, -1000, -1000, 1118,
-	14347, -1000, 719, 718, 718, -1000, -1000, -1000, -1000, -1000,
-	-1000, 742, 34186, 719, -1000, -1000, -1000, 718, 742, 34186,
-	742, 742, 742, 742, 718, 718, 718, 742, 34186, 34186,
-	34186, 34186, 34186, 34186, 34186, 34186, 34186, 7696, 7696, 7696,
-	609, 742, -307, -1000, 1113, -1000, 1601, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 91, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -108, 1461, 25921, -1000,
-	-309, -313, -314, -319, -1000, -1000, -1000, -321, -332, -1000,
-	-1000, -1000, 14347, 14347, 14347, 14347, -1000, 798, 14898, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 988, 627, 14898, 14898,
-	14898, 14898, 14898, 14898, 14898, 14898, 14898, 14898, 14898, 14898,
-	14898, 14898, 14898, 744, 1110, 1107, 521, 521, 521, 521,
-	-1000, 13796, 14347, 14347, 521, -1000, 1207, 25370, 13796, 13796,
-	14347, 1731, 657, 928, 34186, -1000, 1099, -1000, -1000, -1000,
-	902, -1000, 34186, 34186, 57, 14347, 14347, 14347, 11040, 10489,
-	8255, 13796, 13796, 13796, 13796, 13796, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 494, 1315, 1215,
-	1410, -1000, 1460, -1000, -179, 18206, 14347, 1097, 1883, 1585,
-	34186, -1000, -1000, -1000, 1796, -1000, 1796, 1315, 1814, 1655,
-	13796, -1000, -1000, 1814, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1256, -1000, 34186, 1454, 1778, 34186, 1648, 1092,
-	300, -1000, 14347, 14347, 1447, -1000, 901, 34186, -1000, -1000,
-	24819, -1000, -1000, 7137, -1000, 34186, 249, 34186, -1000, 20410,
-	24268, 8814, -36, -1000, 8814, 1339, -1000, -29, -48, 11591,
-	560, -1000, -1000, -1000, 2185, 15449, 1272, 560, 32, -1000,
-	-1000, -1000, 1519, -1000, 1519, 1519, 1519, 1519, 300, 300,
-	300, 300, -1000, -1000, -1000, -1000, -1000, 1545, 1542, -1000,
-	1519, 1519, 1519, 1519, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1430, 34417, -1000, -1000, 3163, 1135,
+	-1000, 1562, -1000, 1426, -1000, 1544, 1581, 413, 1135, 407,
+	406, 394, -1000, -110, -1000, -1000, -1000, -1000, -1000, 588,
+	588, -1000, 280, 1874, 373, 4372, -1000, -1000, -1000, 31105,
+	1561, 1135, -1000, 1559, -1000, 711, 433, 487, 487, 1135,
+	-1000, -1000, 34417, 1135, 691, 678, 34417, 34417, -1000, 30553,
+	-1000, 30001, 29449, 1026, 34417, 28897, 28345, 27793, 27241, 26689,
+	-1000, 1634, -1000, 1545, -1000, -1000, -1000, 34417, 34417, 34417,
+	274, -1000, -1000, 34417, 1135, -1000, -1000, 1016, 1015, 588,
+	588, 1006, 1155, 1146, 1143, 588, 588, 996, 1142, 23928,
+	194, 995, 993, 990, 1029, 1141, 192, 1009, 998, 978,
+	34417, 1558, 34417, -1000, 175, 644, 313, 599, 1797, 1729,
+	1457, 370, 411, 1135, 341, 341, 34417, -1000, 8439, -1000,
+	-1000, 1120, 14542, -1000, 755, 716, 716, -1000, -1000, -1000,
+	-1000, -1000, -1000, 706, 34417, 755, -1000, -1000, -1000, 716,
+	706, 34417, 706, 706, 706, 706, 716, 716, 716, 706,
+	34417, 34417, 34417, 34417, 34417, 34417, 34417, 34417, 34417, 7879,
+	7879, 7879, 567, 706, -311, -1000, 1116, -1000, 1615, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 96, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -111, 1455,
+	26137, -1000, -313, -316, -318, -319, -1000, -1000, -1000, -324,
+	-329, -1000, -1000, -1000, 14542, 14542, 14542, 14542, -1000, 801,
+	15094, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 911, 629,
+	15094, 15094, 15094, 15094, 15094, 15094, 15094, 15094, 15094, 15094,
+	15094, 15094, 15094, 15094, 15094, 781, 1115, 1113, 531, 531,
+	531, 531, -1000, 13990, 14542, 14542, 531, -1000, 1135, 25585,
+	13990, 13990, 14542, 1758, 620, 810, 34417, -1000, 1185, -1000,
+	-1000, -1000, 969, -1000, 34417, 34417, 57, 14542, 14542, 14542,
+	11229, 10677, 8439, 13990, 13990, 13990, 13990, 13990, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 474,
+	1328, 1277, 1424, -1000, 1454, -1000, -180, 18408, 14542, 1109,
+	1904, 1601, 34417, -1000, -1000, -1000, 1839, -1000, 1839, 1328,
+	1740, 1676, 13990, -1000, -1000, 1740, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1291, -1000, 34417, 1453, 1820, 34417,
+	-1000, -251, -1000, -252, 1669, 1101, 277, -1000, 14542, 14542,
+	1452, -1000, 1069, 34417, -1000, -1000, 25033, -1000, -1000, 7319,
+	-1000, 34417, 253, 34417, -1000, 20616, 24481, 8999, -67, -1000,
+	8999, 1415, -1000, -47, -52, 11781, 537, -1000, -1000, -1000,
+	2554, 15646, 1256, 537, 33, -1000, -1000, -1000, 1544, -1000,
+	1544, 1544, 1544, 1544, 277, 277, 277, 277, -1000, -1000,
+	-1000, -1000, -1000, 1557, 1556, -1000, 1544, 1544, 1544, 1544,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1537, 1537, 1537, 1520, 1520, 321, -1000, 14347, 210,
-	34186, 1759, 940, 160, 344, 1584, 1207, 1207, 1207, 344,
-	-1000, 1334, 1293, -1000, -374, 1446, -1000, -1000, 1845, -1000,
-	-1000, 583, 759, 743, 534, 34186, 135, 242, -1000, 312,
-	-1000, 34186, 1207, 720, 455, 1207, -1000, 1207, -1000, -1000,
-	-1000, -1000, -1000, 1207, 1445, -1000, 1442, 785, 729, 784,
-	728, 1445, -1000, -1000, -141, 1445, -1000, 1445, -1000, 1445,
-	-1000, 1445, -1000, 1445, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 634, 34186, 135, 744, -1000, 368, -1000, -1000,
-	744, 744, -1000, -1000, -1000, -1000, 1080, 1072, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1555, 1555, 1555,
+	1548, 1548, 317, -1000, 14542, 199, 34417, 1802, 975, 175,
+	349, 1595, 1135, 1135, 1135, 349, -1000, 1203, 1177, -1000,
+	-378, 1450, -1000, -1000, 1872, -1000, -1000, 568, 742, 728,
+	632, 34417, 132, 250, -1000, 308, -1000, 34417, 1135, 677,
+	487, 1135, -1000, 1135, -1000, -1000, -1000, -1000, -1000, 1135,
+	1449, -1000, 1463, 868, 727, 776, 654, 1449, -1000, -1000,
+	-142, 1449, -1000, 1449, -1000, 1449, -1000, 1449, -1000, 1449,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 640, 34417,
+	132, 781, -1000, 367, -1000, -1000, 781, 781, -1000, -1000,
+	-1000, -1000, 1100, 1087, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -366, 34186, -1000, 144, 643,
-	234, 273, 215, 34186, 137, 1787, 158, 186, 34186, 34186,
-	342, 1599, 34186, 1768, 34186, -1000, -1000, -1000, -1000, -1000,
-	928, 34186, -1000, -1000, 742, 742, -1000, -1000, 34186, 742,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 742, -1000,
+	-1000, -368, 34417, -1000, 146, 598, 235, 279, 218, 34417,
+	136, 1825, 173, 224, 34417, 34417, 341, 1612, 34417, 1812,
+	34417, -1000, -1000, -1000, -1000, -1000, 810, 34417, -1000, -1000,
+	706, 706, -1000, -1000, 34417, 706, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 706, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 34186, 34186, -1000, -1000,
-	-1000, -1000, -1000, 68, -31, 232, -1000, -1000, -1000, -1000,
-	-1000, 1795, -1000, 928, 681, 685, -1000, -1000, -1000, 978,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 988, 14898, 14898,
-	14898, 1693, 383, 1969, 866, 1262, 817, 817, 721, 721,
-	564, 564, 564, 564, 564, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1348, -1000, 1173, 808, 1099, -1000, 1348, 1348,
-	1021, 13796, -1000, -1000, 666, -1000, 14347, 1099, -1000, -1000,
-	1099, 1443, 1441, 1882, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 34417, 34417, -1000, -1000, -1000, -1000, -1000, 120,
+	-49, 261, -1000, -1000, -1000, -1000, -1000, 1836, -1000, 810,
+	658, 636, -1000, -1000, -1000, 907, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 911, 15094, 15094, 15094, 1551, 382, 1272,
+	821, 1441, 729, 729, 803, 803, 543, 543, 543, 543,
+	543, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1357, -1000,
+	1160, 903, 1185, -1000, 1357, 1357, 994, 13990, -1000, -1000,
+	698, -1000, 14542, 1185, -1000, -1000, 1185, 1433, 1419, 1903,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 826, 1076, 1076, 14347, 981,
-	-1000, -1000, -1000, 1099, 13796, 13796, 1435, 1478, 492, -1000,
-	1348, 1099, 1099, 1348, 1348, 8255, 1099, -1000, 34186, -1000,
-	-296, -1000, -40, 514, 1478, -1000, 23716, 1099, 1280, -1000,
-	1046, -1000, 35288, -1000, -1000, -1000, -1000, -1000, 21512, 1489,
-	1814, -1000, -1000, 1478, 1399, -1000, -1000, 300, 20, 20961,
-	-1000, -1000, 928, 928, 14347, -1000, -1000, -1000, -1000, -1000,
-	-1000, 479, 1872, 281, 1478, -1000, 1459, 1629, -1000, -1000,
-	-1000, 1777, 17103, 1478, 34186, 1464, 1430, -1000, 475, -1000,
-	1339, -36, -55, -1000, -1000, -1000, -1000, 928, -1000, 1281,
-	252, 285, -1000, 318, -1000, -1000, -1000, -1000, 1708, 29,
-	-1000, -1000, -1000, 300, 300, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1070, 1070, -1000, -1000, -1000, -1000, -1000, 937, -1000,
-	-1000, -1000, 933, -1000, -1000, 1159, 1611, 210, -1000, -1000,
-	642, 1067, 1710, 34186, -1000, -1000, 1221, 144, 34186, 662,
-	1598, -1000, 1584, 1584, 1584, 34186, -1000, -1000, -1000, -1000,
-	-370, 51, 276, -1000, -1000, -1000, 371, 34186, 1378, -1000,
-	123, -1000, 1206, 1667, 34186, -1000, 1353, 1536, 1207, 1207,
-	-1000, -1000, -1000, 34186, 1478, -1000, -1000, -1000, -1000, 415,
-	1749, 1748, 135, 123, 560, 1207, -1000, -1000, -1000, -1000,
-	-1000, -375, 1351, 396, 146, 229, 34186, 34186, 34186, 34186,
-	34186, 461, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	179, 356, -1000, 34186, 34186, 477, -1000, -1000, -1000, 718,
-	-1000, -1000, 718, -1000, -1000, -1000, -1000, -1000, 1737, 34186,
-	-32, -344, -1000, -340, -1000, -1000, -1000, -1000, 1498, 381,
-	1969, 14898, 14898, 13796, -117, 540, 540, 744, -1000, -1000,
-	-1000, 14347, 14347, 1465, 653, -1000, 14347, 768, -1000, -1000,
-	14347, 14347, 14347, 1099, 225, -1000, -1000, 1881, -1000, 14347,
-	-1000, 1348, 1348, 13796, 8255, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 514, -1000, 455, 455, 455,
-	34186, -1000, -1000, -1000, -1000, -1000, -1000, 1303, 1791, -1000,
-	1666, 1665, 1878, 1872, -1000, 20410, 1814, -1000, -1000, 34186,
-	-291, -1000, 1689, 1673, -1000, -1000, -1000, -1000, 6578, 1796,
-	14347, 1596, 34186, 1478, -1000, 17655, 34186, 34186, 20410, 20410,
-	20410, 20410, 20410, -1000, 1634, 1627, -1000, 1614, 1613, 1640,
-	34186, -1000, 1346, 1099, 1813, 17103, 314, 19859, 1246, 20410,
-	-1000, -1000, 20410, 34186, 6019, -1000, -1000, -33, -64, -1000,
-	-1000, -1000, -1000, 2185, -1000, -1000, 1068, 1774, 1706, -1000,
-	-1000, -1000, -1000, 1341, -1000, 1319, 1301, 1313, 105, -1000,
-	1561, 1735, 642, 642, -1000, 916, -1000, 1207, -1000, -1000,
-	390, -1000, 1758, 34186, 1594, 1593, 1592, -1000, -384, 912,
-	1535, 1560, 14347, 1530, 1838, 1288, 34186, -1000, -1000, 34186,
-	-1000, -1000, 355, -1000, 210, 34186, -1000, -1000, -1000, 242,
-	34186, -1000, 5179, 123, -1000, -1000, -1000, -1000, -1000, -1000,
-	34186, 148, -1000, 1529, 1103, -1000, -1000, 1497, -1000, -1000,
-	-1000, -1000, 145, 233, -1000, 34186, 459, 1611, 34186, -1000,
-	-1000, -1000, 742, 742, -1000, -1000, 1734, -1000, 1207, 14898,
-	14898, -1000, 521, -1000, 1478, 1099, 1519, 1519, -1000, 1519,
-	1520, -1000, 1519, 82, 1519, 78, 1519, -1000, -1000, 1099,
-	1099, 875, 831, -107, -1000, 928, 14347, 1125, 1094, 1076,
-	-1000, 1513, 1512, 14347, 1076, -1000, -1000, 1099, -1000, -1000,
-	1793, 1793, 1793, 1305, 34737, 34186, -1000, -1000, -1000, -1000,
-	1872, 1870, 1298, -1000, -1000, 20, 326, -1000, 1687, 1673,
-	-1000, 1837, 1684, 1836, -1000, -1000, -1000, 928, -1000, 1744,
-	1251, -1000, 630, 1277, -1000, -1000, 13245, 1309, 1660, 470,
-	1305, 1290, 1629, 1582, 1590, 1540, -1000, -1000, -1000, -1000,
-	1623, -1000, 1615, -1000, -1000, 1484, -1000, -1000, 1215, 1099,
-	249, 20410, 1268, 1268, -1000, 467, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 777, 35763, 1895, -1000, 1065, -1000, 1188,
-	-1000, 712, 694, -1000, 34186, 1511, -1000, 300, 1055, 300,
-	872, -1000, 850, -1000, -1000, -238, -1000, -1000, 1495, 1608,
-	-1000, -1000, 34186, -1000, -1000, 34186, 34186, 34186, 1510, 1835,
-	-1000, 14347, 1494, 629, 1100, 34186, 34186, -1000, -1000, 235,
-	1478, -1000, 1292, 1271, -1000, -193, -1000, 14347, -1000, -1000,
-	-1000, 1140, 1140, -1000, 1484, -1000, -1000, -1000, 1201, -1000,
-	-1000, -146, 34186, 34186, 34186, 34186, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 521, 14898, -1000, -1000, 300,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14347,
-	-1000, 14347, -1000, 1796, 1053, 928, 14347, 14347, -1000, 847,
-	827, 1076, -1000, -1000, 1478, 1697, 1478, 1478, 19859, -1000,
-	-1000, 1870, 1815, 1834, 1677, 1680, 1680, 1687, -1000, 1817,
-	1816, -1000, 1051, 1811, 1050, 692, -1000, 34186, 14347, 1478,
-	-1000, 282, 34186, 1478, 34186, -1000, 1867, -1000, -1000, 14347,
-	1487, -1000, 14347, -1000, -1000, -1000, -1000, -1000, -1000, 1872,
-	1268, -1000, -1000, 572, -1000, 14347, -1000, -1000, -1000, 265,
-	-1000, -1000, -1000, -1000, -1000, 1485, 14347, -1000, -1000, -1000,
-	1194, 1162, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1484, -1000, -1000, -1000, -1000, 242, -380, 1081, 34186, 825,
-	-1000, 1266, 1288, 418, -1000, 14347, 303, -1000, 242, -1000,
-	-200, -201, 1076, -1000, -1000, 1771, -1000, -1000, 8255, -1000,
-	-1000, 1479, 1583, -1000, 151, -1000, 1076, 1076, 1099, -1000,
-	1076, 1076, 1154, 1152, -1000, 19308, -1000, 1807, 1805, 23165,
-	23165, 514, 1815, -1000, 14347, 14347, 1674, 903, -1000, -1000,
-	-1000, -1000, 1044, 1037, -1000, 1035, -1000, 1894, -1000, 928,
-	-1000, 1478, -1000, 457, 1277, -1000, 1796, 928, 34186, 928,
-	1867, -1000, 1076, -1000, 1478, 1478, 1478, 1478, 34186, 1054,
-	-1000, -1000, 1770, 1260, 50, -1000, 1253, -1000, -1000, -1000,
-	-1000, 12694, -1000, -1000, -1000, -1000, -1000, -1000, 314, 1286,
-	-1000, 622, 34186, 34186, 1099, 237, -151, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1243, -1000, -1000, -1000, -1000, -1000,
-	1243, 1243, -1000, -1000, 928, 1280, -1000, 855, -1000, -1000,
-	-1000, -1000, -1000, 34186, 1277, 34186, -1000, 1224, 1796, -1000,
-	1217, -1000, 314, -1000, 1467, 1560, -1000, 1099, -144, 8255,
-	5460, 1204, -1000, -1000, 1645, -138, -181, 22614, -1000, -1000,
-	-1000, -1000, -1000, 1263, -1000, -1000, -1000, 1588, 663, -144,
-	14347, 1316, -1000, -1000, -45, -1000, -1000, -1000, -1000, -1000,
-	1497, -1000, 1630, -1000, -1000, -1000, 1587, -1000, 1877, -1000,
-	-1000, -1000, 689, 913, -1000, -1000, -1000, -1000, 733, 34186,
-	316, -1000, -1000, -146, -149, -1000, 1893, 489, 489, -1000,
-	-1000, -387, 1170, 157, -1000, -152, -1000, -1000, -1000, 313,
-	1006, -1000, -1000, 797, -387, -1000, -189, -1000, -1000, -1000,
-	-1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1156, 1021, 1021, 14542, 1012, -1000, -1000, -1000, 1185,
+	13990, 13990, 1417, 1489, 472, -1000, 1357, 1185, 1185, 1357,
+	1357, 8439, 1185, -1000, 34417, -1000, -304, -1000, -61, 533,
+	1489, -1000, 23928, 1185, 1286, -1000, 1041, -1000, 35521, -1000,
+	-1000, -1000, -1000, -1000, 21720, 1275, 1740, -1000, -1000, 1489,
+	1421, -1000, -1000, -1000, -1000, 277, 19, 21168, -1000, -1000,
+	810, 810, 14542, -1000, -1000, -1000, -1000, -1000, -1000, 471,
+	1890, 335, 1489, -1000, 1436, 1650, -1000, -1000, -1000, 1819,
+	17303, 1489, 34417, 1420, 1304, -1000, 470, -1000, 1415, -67,
+	-65, -1000, -1000, -1000, -1000, 810, -1000, 1153, 254, 283,
+	-1000, 319, -1000, -1000, -1000, -1000, 1739, 24, -1000, -1000,
+	-1000, 277, 277, -1000, -1000, -1000, -1000, -1000, -1000, 1068,
+	1068, -1000, -1000, -1000, -1000, -1000, 967, -1000, -1000, -1000,
+	961, -1000, -1000, 1150, 1616, 199, -1000, -1000, 588, 1064,
+	1741, 34417, -1000, -1000, 1251, 146, 34417, 630, 1610, -1000,
+	1595, 1595, 1595, 34417, -1000, -1000, -1000, -1000, -370, 58,
+	257, -1000, -1000, -1000, 4223, 34417, 1393, -1000, 127, -1000,
+	1221, 1690, 34417, -1000, 1391, 1554, 1135, 1135, -1000, -1000,
+	-1000, 34417, 1489, -1000, -1000, -1000, -1000, 409, 1787, 1783,
+	132, 127, 537, 1135, -1000, -1000, -1000, -1000, -1000, -371,
+	1359, 392, 135, 220, 34417, 34417, 34417, 34417, 34417, 457,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 215,
+	364, -1000, 34417, 34417, 456, -1000, -1000, -1000, 716, -1000,
+	-1000, 716, -1000, -1000, -1000, -1000, -1000, 1761, 34417, -54,
+	-344, -1000, -341, -1000, -1000, -1000, -1000, 1403, 356, 1272,
+	15094, 15094, 13990, -127, 1257, 1257, 781, -1000, -1000, -1000,
+	14542, 14542, 1437, 616, -1000, 14542, 860, -1000, -1000, 14542,
+	14542, 14542, 1185, 212, -1000, -1000, 1895, -1000, 14542, -1000,
+	1357, 1357, 13990, 8439, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 533, -1000, 487, 487, 487, 34417,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1412, 1840, -1000, 1687,
+	1684, 1889, 1890, -1000, 20616, 1740, -1000, -1000, 34417, -295,
+	-1000, 1726, 1706, -1000, -1000, -1000, -1000, 6759, 1839, 14542,
+	1609, 34417, 1489, -1000, 17856, 34417, 34417, 20616, 20616, 20616,
+	20616, 20616, -1000, 1662, 1659, -1000, 1644, 1627, 1658, 34417,
+	-1000, 1354, 1185, 1868, 17303, 291, 20064, 1259, 20616, -1000,
+	-1000, 20616, 34417, 6199, -1000, -1000, -56, -75, -1000, -1000,
+	-1000, -1000, 2554, -1000, -1000, 1151, 1818, 1736, -1000, -1000,
+	-1000, -1000, 1352, -1000, 1345, 1401, 1322, 103, -1000, 1578,
+	1757, 588, 588, -1000, 913, -1000, 1135, -1000, -1000, 383,
+	-1000, 1808, 34417, 1608, 1606, 1605, -1000, -384, 909, 1553,
+	1572, 14542, 1550, 1870, 1387, 34417, -1000, -1000, 34417, -1000,
+	-1000, 354, -1000, 199, 34417, -1000, -1000, -1000, 250, 34417,
+	-1000, 36116, 127, -1000, -1000, -1000, -1000, -1000, -1000, 34417,
+	157, -1000, 1549, 1170, -1000, -1000, 1467, -1000, -1000, -1000,
+	-1000, 110, 217, -1000, 34417, 454, 1616, 34417, -1000, -1000,
+	-1000, 706, 706, -1000, -1000, 1754, -1000, 1135, 15094, 15094,
+	-1000, 531, -1000, 1489, 1185, 1544, 1544, -1000, 1544, 1548,
+	-1000, 1544, 82, 1544, 76, 1544, -1000, -1000, 1185, 1185,
+	914, 877, -117, -1000, 810, 14542, 1059, 749, 1021, -1000,
+	1537, 1533, 14542, 1021, -1000, -1000, 1185, -1000, -1000, 1835,
+	1835, 1835, 1315, 34969, 34417, -1000, -1000, -1000, -1000, 1890,
+	1885, 1389, -1000, -1000, 19, 337, -1000, 1704, 1706, -1000,
+	1865, 1707, 1862, -1000, -1000, -1000, 810, -1000, 1792, 1444,
+	-1000, 582, 1284, -1000, -1000, 13438, 1320, 1681, 466, 1315,
+	1378, 1650, 1590, 1604, 1587, -1000, -1000, -1000, -1000, 1645,
+	-1000, 1628, -1000, -1000, 1495, -1000, -1000, 1277, 1185, 253,
+	20616, 1332, 1332, -1000, 464, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 761, 35997, 1914, -1000, 1063, -1000, 1125, -1000,
+	668, 655, -1000, 34417, 1515, -1000, 277, 1060, 277, 897,
+	-1000, 889, -1000, -1000, -237, -1000, -1000, 1538, 1592, -1000,
+	-1000, 34417, -1000, -1000, 34417, 34417, 34417, 1500, 1860, -1000,
+	14542, 1498, 577, 1104, 34417, 34417, -1000, -1000, 239, 1489,
+	-1000, 1310, 1301, -1000, -194, -1000, 14542, -1000, -1000, -1000,
+	942, 942, -1000, 1495, -1000, -1000, -1000, 1219, -1000, -1000,
+	-145, 34417, 34417, 34417, 34417, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 531, 15094, -1000, -1000, 277, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14542, -1000,
+	14542, -1000, 1839, 1052, 810, 14542, 14542, -1000, 871, 869,
+	1021, -1000, -1000, 1489, 1679, 1489, 1489, 20064, -1000, -1000,
+	1885, 1882, 1858, 1702, 1705, 1705, 1704, -1000, 1855, 1850,
+	-1000, 1051, 1844, 1044, 635, -1000, 34417, 14542, 1489, -1000,
+	296, 34417, 1489, 34417, -1000, 1869, -1000, -1000, 14542, 1497,
+	-1000, 14542, -1000, -1000, -1000, -1000, -1000, -1000, 1890, 1332,
+	-1000, -1000, 552, -1000, 14542, -1000, -1000, -1000, 421, -1000,
+	-1000, -1000, -1000, -1000, 1496, 14542, -1000, -1000, -1000, 1215,
+	1199, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1495,
+	-1000, -1000, -1000, -1000, 250, -375, 1093, 34417, 852, -1000,
+	1299, 1387, 423, -1000, 14542, 292, -1000, 250, -1000, -200,
+	-201, 1021, -1000, -1000, 1816, -1000, -1000, 8439, -1000, -1000,
+	1493, 1591, -1000, 208, -1000, 1021, 1021, 1185, -1000, 1021,
+	1021, 1192, 1189, -1000, 19512, -1000, 1842, 1841, 23376, 23376,
+	533, 1882, -1000, 14542, 14542, 1698, 845, -1000, -1000, -1000,
+	-1000, 1043, 1042, -1000, 1032, -1000, 1913, -1000, 810, -1000,
+	1489, -1000, 440, 1284, -1000, 1839, 810, 34417, 810, 1869,
+	-1000, 1021, -1000, 1489, 1489, 1489, 1489, 34417, 1004, -1000,
+	-1000, 1815, 1266, 45, -1000, 1254, -1000, -1000, -1000, -1000,
+	12886, -1000, -1000, -1000, -1000, -1000, -1000, 291, 1366, -1000,
+	576, 34417, 34417, 1185, 229, -148, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1246, -1000, -1000, -1000, -1000, -1000, 1246,
+	1246, -1000, -1000, 810, 1286, -1000, 833, -1000, -1000, -1000,
+	-1000, -1000, 34417, 1284, 34417, -1000, 1242, 1839, -1000, 1228,
+	-1000, 291, -1000, 1486, 1572, -1000, 1185, -144, 8439, 5639,
+	1214, -1000, -1000, 1668, -135, -155, 22824, -1000, -1000, -1000,
+	-1000, -1000, 1263, -1000, -1000, -1000, 1593, 665, -144, 14542,
+	1482, -1000, -1000, -46, -1000, -1000, -1000, -1000, -1000, 1467,
+	-1000, 1667, -1000, -1000, -1000, 1560, -1000, 1901, -1000, -1000,
+	-1000, 760, 894, -1000, -1000, -1000, -1000, 806, 34417, 316,
+	-1000, -1000, -145, -146, -1000, 1912, 517, 517, -1000, -1000,
+	-388, 1210, 158, -1000, -150, -1000, -1000, -1000, 305, 892,
+	-1000, -1000, 766, -388, -1000, -189, -1000, -1000, -1000, -1000,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2254, 2253, 8, 1, 2252, 38, 76, 168, 20,
-	173, 78, 2251, 2249, 2247, 2245, 2242, 2239, 2238, 197,
-	196, 195, 2232, 2231, 2226, 2222, 2218, 2217, 2216, 2215,
-	2208, 2204, 193, 148, 162, 2203, 2201, 2200, 85, 167,
-	68, 67, 165, 2199, 2194, 59, 2192, 2191, 2187, 161,
-	159, 717, 2186, 153, 90, 27, 2185, 2184, 2183, 2182,
-	2181, 2180, 2179, 2177, 2174, 2173, 2172, 2171, 2169, 2168,
-	282, 2164, 2163, 7, 2162, 61, 2160, 2157, 2156, 2154,
-	100, 2153, 2151, 2149, 2145, 2144, 2143, 122, 2142, 2139,
-	2138, 2137, 164, 2135, 2134, 200, 89, 101, 2133, 2132,
-	83, 166, 2131, 95, 2130, 2129, 2128, 130, 2126, 377,
-	2113, 48, 47, 2106, 150, 58, 46, 37, 2104, 2103,
-	2102, 40, 60, 2101, 77, 50, 2100, 80, 75, 2099,
-	42, 2094, 2092, 93, 2091, 2089, 2088, 73, 2087, 2086,
-	69, 2085, 71, 107, 81, 55, 2084, 25, 35, 2083,
-	2082, 2081, 2079, 2078, 28, 2076, 2075, 2074, 125, 18,
-	2073, 12, 14, 32, 115, 2072, 19, 63, 2069, 121,
-	120, 2068, 41, 13, 24, 2066, 17, 105, 143, 16,
-	79, 111, 2065, 2064, 33, 52, 2063, 2058, 2057, 2056,
-	2055, 2054, 49, 2052, 31, 2051, 176, 2050, 3, 2048,
-	30, 21, 2047, 44, 129, 45, 23, 2045, 163, 2044,
-	36, 160, 103, 135, 2043, 2042, 2041, 154, 227, 2037,
-	2035, 97, 147, 128, 127, 2034, 230, 2033, 2032, 82,
-	1428, 2447, 15, 142, 2031, 2030, 3134, 119, 106, 34,
-	2029, 137, 2026, 2025, 2024, 169, 144, 131, 955, 96,
-	2023, 2020, 2018, 2017, 2016, 2008, 2007, 2005, 183, 179,
-	43, 91, 157, 53, 2004, 2001, 2000, 99, 70, 1991,
-	140, 139, 102, 84, 1989, 151, 132, 110, 1988, 88,
-	1987, 1986, 1985, 1983, 74, 1982, 1981, 1979, 1973, 146,
-	126, 98, 65, 1971, 66, 92, 138, 134, 29, 1968,
-	22, 1967, 1965, 155, 11, 1961, 4, 0, 1956, 6,
-	152, 236, 145, 1955, 1952, 2, 1951, 5, 1949, 1948,
-	108, 1942, 1939, 1918, 10, 26, 9, 1914, 1910, 1908,
-	2961, 2329, 104, 1907, 170,
-}
-
-//line sql.y:6254
+	0, 2229, 2228, 13, 1, 2227, 10, 77, 161, 12,
+	177, 85, 2226, 2225, 2224, 2223, 2222, 2221, 2218, 197,
+	195, 194, 2213, 2211, 2208, 2204, 2203, 2200, 2199, 2198,
+	2197, 2196, 192, 149, 154, 2195, 2194, 2192, 99, 156,
+	68, 71, 171, 2191, 2190, 61, 2189, 2186, 2185, 176,
+	153, 659, 2183, 152, 95, 21, 2182, 2181, 2178, 2177,
+	2176, 2175, 2174, 2173, 2171, 2170, 2169, 2166, 2165, 2164,
+	273, 2163, 2162, 6, 2159, 63, 2155, 2154, 2152, 2149,
+	104, 2148, 2147, 2146, 2142, 2141, 2140, 128, 2138, 2137,
+	2136, 2135, 172, 2133, 2131, 267, 94, 105, 2129, 2128,
+	82, 158, 2127, 101, 2126, 2125, 2124, 134, 2119, 2692,
+	2118, 45, 43, 2117, 46, 60, 40, 39, 2116, 2114,
+	2113, 38, 73, 2111, 81, 59, 2107, 76, 96, 2106,
+	48, 2103, 2102, 93, 2101, 2100, 2099, 79, 2098, 2096,
+	67, 2093, 78, 120, 89, 42, 2092, 23, 47, 2091,
+	2089, 2088, 2086, 2085, 24, 2084, 2083, 2082, 125, 15,
+	2078, 14, 16, 28, 119, 2077, 55, 58, 2075, 124,
+	117, 2074, 37, 17, 35, 2073, 25, 116, 138, 27,
+	80, 122, 2072, 2071, 36, 52, 2070, 2067, 2066, 2063,
+	2061, 2059, 49, 2057, 29, 2056, 174, 2055, 31, 2054,
+	32, 22, 2053, 41, 126, 50, 30, 2050, 163, 2047,
+	33, 167, 110, 144, 2044, 2043, 2042, 150, 224, 2041,
+	2036, 69, 148, 130, 137, 2035, 233, 2034, 2033, 75,
+	1410, 1786, 18, 145, 2032, 2027, 2911, 129, 111, 34,
+	2021, 170, 2019, 2017, 2014, 162, 146, 91, 873, 98,
+	2013, 2012, 2009, 2005, 2003, 2001, 2000, 1999, 84, 164,
+	44, 100, 159, 53, 1998, 1997, 1996, 106, 70, 1995,
+	141, 140, 115, 151, 1994, 147, 136, 103, 1991, 86,
+	1990, 1986, 1985, 1984, 74, 1980, 1979, 1978, 1976, 142,
+	132, 97, 65, 1975, 66, 92, 133, 135, 26, 1973,
+	19, 1972, 1971, 168, 7, 1970, 3, 0, 1969, 5,
+	155, 232, 143, 1968, 1966, 2, 1965, 4, 1963, 1962,
+	123, 1961, 1958, 1951, 9, 20, 8, 1937, 1934, 1933,
+	3148, 3080, 107, 1927, 169,
+}
+
+//line sql.y:6282
 type yySymType struct {
 	union             any
 	empty             struct{}
@@ -5773,72 +5800,72 @@ var yyR1 = [...]int{
 	44, 39, 39, 39, 39, 39, 39, 39, 39, 39,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	80, 80, 81, 81, 81, 81, 82, 82, 82, 299,
-	299, 55, 55, 3, 3, 1, 1, 2, 2, 4,
-	4, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	25, 80, 80, 81, 81, 81, 81, 82, 82, 82,
+	299, 299, 55, 55, 3, 3, 1, 1, 2, 2,
+	4, 4, 303, 303, 303, 303, 303, 303, 303, 303,
 	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
-	303, 303, 303, 266, 266, 266, 298, 298, 300, 302,
-	302, 302, 302, 301, 89, 89, 26, 35, 35, 27,
-	27, 27, 27, 28, 28, 56, 57, 57, 57, 57,
+	303, 303, 303, 303, 266, 266, 266, 298, 298, 300,
+	302, 302, 302, 302, 301, 89, 89, 26, 35, 35,
+	27, 27, 27, 27, 28, 28, 56, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 318, 318, 219, 219, 227, 227,
-	218, 218, 241, 241, 241, 221, 221, 221, 222, 222,
-	322, 322, 322, 58, 58, 58, 60, 60, 61, 62,
-	62, 243, 243, 244, 244, 63, 64, 76, 76, 76,
-	76, 76, 76, 79, 79, 79, 13, 13, 13, 13,
-	72, 72, 72, 12, 12, 59, 59, 66, 319, 319,
-	320, 321, 321, 321, 321, 67, 69, 29, 29, 29,
-	29, 29, 29, 99, 99, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 94, 94, 94,
-	88, 88, 333, 70, 71, 71, 92, 92, 92, 85,
-	85, 85, 91, 91, 91, 14, 14, 15, 202, 202,
-	16, 16, 96, 96, 98, 98, 98, 98, 98, 100,
-	100, 100, 100, 100, 100, 100, 95, 95, 97, 97,
-	97, 97, 234, 234, 234, 233, 233, 124, 124, 126,
-	125, 125, 127, 127, 128, 128, 128, 165, 165, 143,
-	143, 200, 200, 198, 198, 199, 199, 201, 201, 201,
-	201, 204, 204, 129, 129, 129, 129, 130, 130, 131,
-	131, 132, 132, 242, 242, 239, 239, 239, 238, 238,
-	136, 136, 136, 138, 137, 137, 137, 137, 139, 139,
-	141, 141, 140, 140, 142, 147, 147, 146, 146, 144,
-	144, 144, 144, 145, 145, 145, 145, 148, 148, 109,
-	109, 109, 109, 109, 109, 119, 119, 119, 119, 122,
+	57, 57, 57, 57, 57, 318, 318, 219, 219, 227,
+	227, 218, 218, 241, 241, 241, 221, 221, 221, 222,
+	222, 322, 322, 322, 58, 58, 58, 60, 60, 61,
+	62, 62, 243, 243, 244, 244, 63, 64, 76, 76,
+	76, 76, 76, 76, 79, 79, 79, 13, 13, 13,
+	13, 72, 72, 72, 12, 12, 59, 59, 66, 319,
+	319, 320, 321, 321, 321, 321, 67, 69, 29, 29,
+	29, 29, 29, 29, 99, 99, 87, 87, 87, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 94, 94,
+	94, 88, 88, 333, 70, 71, 71, 92, 92, 92,
+	85, 85, 85, 91, 91, 91, 14, 14, 15, 202,
+	202, 16, 16, 96, 96, 98, 98, 98, 98, 98,
+	100, 100, 100, 100, 100, 100, 100, 95, 95, 97,
+	97, 97, 97, 234, 234, 234, 233, 233, 124, 124,
+	126, 125, 125, 127, 127, 128, 128, 128, 165, 165,
+	143, 143, 200, 200, 198, 198, 199, 199, 201, 201,
+	201, 201, 204, 204, 129, 129, 129, 129, 130, 130,
+	131, 131, 132, 132, 242, 242, 239, 239, 239, 238,
+	238, 136, 136, 136, 138, 137, 137, 137, 137, 139,
+	139, 141, 141, 140, 140, 142, 147, 147, 146, 146,
+	144, 144, 144, 144, 145, 145, 145, 145, 148, 148,
+	109, 109, 109, 109, 109, 109, 119, 119, 119, 119,
 	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 115, 115, 115, 115, 115, 115, 115,
+	122, 114, 114, 114, 114, 114, 114, 114, 114, 114,
+	114, 114, 114, 114, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 77, 77, 77, 216,
-	216, 149, 149, 157, 157, 157, 157, 150, 150, 150,
-	150, 150, 150, 150, 158, 158, 158, 164, 159, 159,
-	155, 155, 155, 155, 153, 153, 153, 153, 153, 153,
-	153, 153, 153, 153, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 154, 154, 154, 154, 84, 84,
+	115, 115, 115, 115, 115, 115, 115, 77, 77, 77,
+	216, 216, 149, 149, 157, 157, 157, 157, 150, 150,
+	150, 150, 150, 150, 150, 158, 158, 158, 164, 159,
+	159, 155, 155, 155, 155, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 84,
 	84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
-	83, 83, 83, 83, 83, 83, 83, 83, 83, 334,
-	334, 259, 259, 259, 156, 156, 156, 156, 156, 90,
-	90, 90, 90, 90, 247, 247, 247, 250, 250, 250,
-	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
-	249, 249, 249, 249, 249, 170, 170, 86, 86, 168,
-	168, 169, 171, 171, 166, 166, 166, 152, 152, 152,
-	172, 172, 173, 173, 174, 174, 176, 175, 175, 177,
-	178, 178, 178, 179, 179, 180, 180, 180, 45, 45,
-	45, 45, 45, 40, 40, 40, 40, 41, 41, 41,
-	101, 101, 101, 101, 103, 103, 102, 102, 73, 73,
-	74, 74, 74, 107, 107, 108, 108, 108, 105, 105,
-	106, 106, 196, 196, 181, 181, 181, 188, 188, 188,
-	184, 184, 186, 186, 186, 187, 187, 187, 185, 193,
-	193, 195, 195, 194, 194, 190, 190, 191, 191, 192,
-	192, 192, 189, 189, 151, 151, 151, 151, 151, 197,
-	197, 197, 197, 205, 205, 161, 161, 163, 163, 162,
-	123, 206, 206, 210, 207, 207, 211, 211, 211, 211,
-	211, 208, 208, 209, 209, 235, 235, 235, 215, 215,
-	226, 226, 223, 223, 224, 224, 217, 217, 228, 228,
-	228, 68, 160, 160, 295, 295, 292, 231, 231, 232,
-	232, 236, 236, 240, 240, 237, 237, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	84, 83, 83, 83, 83, 83, 83, 83, 83, 83,
+	334, 334, 259, 259, 259, 156, 156, 156, 156, 156,
+	90, 90, 90, 90, 90, 247, 247, 247, 250, 250,
+	250, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+	249, 249, 249, 249, 249, 249, 170, 170, 86, 86,
+	168, 168, 169, 171, 171, 166, 166, 166, 152, 152,
+	152, 172, 172, 173, 173, 174, 174, 176, 175, 175,
+	177, 178, 178, 178, 179, 179, 180, 180, 180, 45,
+	45, 45, 45, 45, 40, 40, 40, 40, 41, 41,
+	41, 101, 101, 101, 101, 103, 103, 102, 102, 73,
+	73, 74, 74, 74, 107, 107, 108, 108, 108, 105,
+	105, 106, 106, 196, 196, 196, 196, 196, 196, 196,
+	181, 181, 181, 188, 188, 188, 184, 184, 186, 186,
+	186, 187, 187, 187, 185, 193, 193, 195, 195, 194,
+	194, 190, 190, 191, 191, 192, 192, 192, 189, 189,
+	151, 151, 151, 151, 151, 197, 197, 197, 197, 205,
+	205, 161, 161, 163, 163, 162, 123, 206, 206, 210,
+	207, 207, 211, 211, 211, 211, 211, 208, 208, 209,
+	209, 235, 235, 235, 215, 215, 226, 226, 223, 223,
+	224, 224, 217, 217, 228, 228, 228, 68, 160, 160,
+	295, 295, 292, 231, 231, 232, 232, 236, 236, 240,
+	240, 237, 237, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
@@ -5852,7 +5879,7 @@ var yyR1 = [...]int{
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	229, 229, 229, 229, 229, 229, 229, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
@@ -5883,7 +5910,8 @@ var yyR1 = [...]int{
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	330, 331, 245, 246, 246, 246,
+	230, 230, 230, 230, 230, 230, 230, 330, 331, 245,
+	246, 246, 246,
 }
 
 var yyR2 = [...]int{
@@ -5938,73 +5966,71 @@ var yyR2 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
 	3, 4, 4, 2, 11, 3, 6, 8, 6, 6,
 	6, 13, 8, 6, 10, 5, 5, 5, 5, 5,
-	0, 6, 5, 6, 4, 5, 0, 8, 9, 0,
-	3, 0, 1, 0, 3, 1, 1, 0, 2, 0,
-	2, 5, 3, 7, 4, 4, 4, 4, 3, 3,
-	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 0, 2, 2, 1, 3, 2, 0,
-	4, 4, 3, 2, 1, 3, 3, 3, 5, 7,
-	7, 6, 5, 3, 2, 3, 3, 3, 7, 3,
-	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
-	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
-	4, 4, 4, 4, 4, 3, 3, 3, 5, 2,
-	3, 3, 3, 3, 1, 1, 0, 1, 0, 1,
-	1, 1, 0, 2, 2, 0, 2, 2, 0, 2,
-	0, 1, 1, 2, 1, 3, 1, 2, 1, 1,
-	5, 0, 1, 0, 1, 2, 3, 0, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 1, 3, 3, 2, 2, 3, 1, 3,
-	2, 1, 2, 1, 2, 2, 4, 3, 3, 6,
-	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
-	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
-	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 1, 5, 5, 4, 0, 2,
-	4, 4, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 1, 2,
-	1, 3, 1, 1, 1, 4, 3, 3, 4, 3,
-	7, 0, 3, 1, 3, 1, 3, 1, 1, 3,
-	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
-	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
-	2, 1, 1, 3, 3, 0, 1, 1, 2, 6,
-	5, 6, 6, 0, 2, 3, 3, 0, 2, 3,
-	3, 3, 2, 3, 1, 3, 4, 3, 1, 3,
-	4, 5, 6, 3, 4, 5, 6, 3, 4, 1,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 3, 1, 1,
-	2, 2, 2, 2, 1, 1, 2, 9, 6, 6,
-	6, 2, 2, 3, 3, 3, 1, 1, 1, 0,
-	3, 1, 1, 1, 2, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	4, 5, 5, 6, 4, 4, 8, 6, 8, 6,
-	8, 5, 4, 2, 2, 1, 2, 2, 2, 8,
-	8, 6, 5, 4, 4, 7, 4, 6, 1, 1,
+	5, 0, 6, 5, 6, 4, 5, 0, 8, 9,
+	0, 3, 0, 1, 0, 3, 1, 1, 0, 2,
+	0, 2, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 2,
+	0, 4, 4, 3, 2, 1, 3, 3, 3, 5,
+	7, 7, 6, 5, 3, 2, 3, 3, 3, 7,
+	3, 3, 3, 3, 4, 7, 5, 2, 4, 4,
+	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 2, 4, 2, 4,
+	5, 4, 4, 4, 4, 4, 3, 3, 3, 5,
+	2, 3, 3, 3, 3, 1, 1, 0, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	2, 0, 1, 1, 2, 1, 3, 1, 2, 1,
+	1, 5, 0, 1, 0, 1, 2, 3, 0, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 3, 3, 2, 2, 3, 1,
+	3, 2, 1, 2, 1, 2, 2, 4, 3, 3,
+	6, 4, 7, 6, 1, 3, 2, 2, 2, 2,
+	1, 1, 1, 3, 2, 1, 1, 1, 0, 1,
+	1, 0, 3, 0, 2, 0, 2, 1, 2, 2,
+	0, 1, 1, 0, 1, 1, 5, 5, 4, 0,
+	2, 4, 4, 0, 1, 0, 1, 2, 3, 4,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 1, 0, 1,
+	2, 1, 3, 1, 1, 1, 4, 3, 3, 4,
+	3, 7, 0, 3, 1, 3, 1, 3, 1, 1,
+	3, 3, 1, 3, 4, 4, 4, 3, 2, 4,
+	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
+	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
+	2, 2, 1, 1, 3, 3, 0, 1, 1, 2,
+	6, 5, 6, 6, 0, 2, 3, 3, 0, 2,
+	3, 3, 3, 2, 3, 1, 3, 4, 3, 1,
+	3, 4, 5, 6, 3, 4, 5, 6, 3, 4,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 3, 1,
+	1, 2, 2, 2, 2, 1, 1, 2, 9, 6,
+	6, 6, 2, 2, 3, 3, 3, 1, 1, 1,
+	0, 3, 1, 1, 1, 2, 1, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	2, 0, 2, 3, 4, 4, 4, 4, 4, 0,
-	3, 4, 7, 3, 1, 1, 1, 0, 5, 5,
-	2, 3, 1, 2, 2, 1, 2, 1, 2, 2,
-	1, 2, 2, 1, 1, 0, 1, 0, 2, 1,
-	2, 4, 0, 2, 1, 3, 5, 1, 2, 2,
-	0, 3, 0, 2, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 0, 1, 2, 4, 4, 0, 2,
-	2, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	0, 3, 3, 3, 0, 3, 1, 1, 0, 4,
-	0, 1, 1, 0, 3, 1, 3, 2, 1, 1,
-	0, 1, 2, 4, 9, 3, 5, 0, 3, 3,
-	0, 1, 0, 2, 2, 0, 2, 2, 2, 0,
-	2, 1, 2, 3, 3, 0, 2, 1, 2, 3,
-	4, 3, 0, 1, 2, 1, 5, 4, 4, 1,
-	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 3,
-	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 0, 2, 0, 3, 0, 1, 0, 1,
-	1, 5, 0, 1, 0, 1, 2, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
+	3, 4, 5, 5, 6, 4, 4, 8, 6, 8,
+	6, 8, 5, 4, 2, 2, 1, 2, 2, 2,
+	8, 8, 6, 5, 4, 4, 7, 4, 6, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 2, 3, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 1, 0, 5,
+	5, 2, 3, 1, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 2, 1, 1, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 2,
+	2, 0, 3, 0, 2, 0, 1, 3, 1, 3,
+	2, 0, 1, 1, 0, 1, 2, 4, 4, 0,
+	2, 2, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 0, 3, 3, 3, 0, 3, 1, 1, 0,
+	4, 0, 1, 1, 0, 3, 1, 3, 2, 1,
+	1, 0, 1, 2, 3, 4, 2, 3, 4, 4,
+	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
+	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
+	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
+	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
+	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6048,7 +6074,10 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	0, 1, 1,
 }
 
 var yyChk = [...]int{
@@ -6057,524 +6086,526 @@ var yyChk = [...]int{
 	-61, -62, -63, -64, -12, -59, -29, -30, -65, -66,
 	-67, -68, -69, -14, -15, -16, -7, -6, -11, 7,
 	8, -78, -31, 30, -36, -46, 206, -47, -37, 207,
-	-48, 209, 208, 245, 210, 238, 72, 287, 288, 290,
-	291, 292, 293, -79, 243, 244, 212, 34, 43, 31,
+	-48, 209, 208, 245, 210, 238, 72, 288, 289, 291,
+	292, 293, 294, -79, 243, 244, 212, 34, 43, 31,
 	32, 35, 216, 251, 252, 215, -8, -32, 6, -330,
-	9, 413, 240, 239, 26, -10, 430, 83, -329, 564,
+	9, 414, 240, 239, 26, -10, 431, 83, -329, 565,
 	-196, -181, 20, 31, 27, -180, -176, -92, -181, 18,
 	16, 5, -70, -333, -70, -70, 10, 11, -70, -280,
 	-282, 83, 143, 83, -70, -52, -51, -50, -49, -53,
 	29, -43, -44, -303, -42, -39, 211, 208, 255, 107,
 	108, 245, 246, 247, 210, 229, 244, 248, 243, 264,
-	-38, 78, 31, 430, 433, -287, 207, 213, 214, 414,
-	110, 109, 73, 209, -284, 339, 534, -53, 536, 99,
-	101, 535, 42, 219, 537, 538, 539, 540, 228, 541,
-	542, 543, 544, 550, 551, 552, 553, 111, 5, -70,
-	-240, -236, -307, -230, 86, 87, 88, 427, 231, 461,
-	462, 280, 78, 39, 336, 339, 534, 287, 301, 295,
-	322, 314, 428, 463, 283, 232, 268, 531, 312, 119,
-	536, 286, 464, 246, 344, 345, 346, 99, 290, 381,
-	549, 285, 465, 547, 101, 535, 77, 49, 42, 241,
-	310, 215, 306, 537, 269, 466, 438, 262, 110, 107,
-	556, 34, 304, 48, 28, 546, 109, 47, 538, 134,
-	467, 539, 348, 327, 525, 46, 349, 247, 468, 81,
-	252, 432, 533, 350, 305, 351, 279, 545, 212, 469,
-	517, 352, 353, 526, 470, 328, 332, 471, 373, 354,
-	563, 50, 472, 473, 527, 108, 474, 76, 540, 299,
-	300, 475, 277, 230, 375, 326, 228, 33, 355, 429,
-	281, 56, 256, 376, 44, 330, 560, 43, 521, 476,
-	524, 325, 321, 423, 51, 477, 478, 479, 480, 541,
-	324, 298, 320, 555, 450, 274, 542, 60, 214, 334,
-	333, 335, 263, 372, 317, 481, 482, 483, 235, 79,
-	484, 307, 19, 485, 486, 356, 270, 487, 54, 488,
-	489, 379, 244, 490, 52, 543, 37, 249, 557, 544,
-	491, 492, 493, 494, 251, 495, 358, 496, 357, 329,
-	331, 258, 359, 431, 497, 303, 248, 548, 498, 236,
-	532, 250, 255, 243, 380, 237, 499, 500, 501, 502,
-	503, 284, 504, 505, 291, 550, 424, 41, 506, 507,
-	508, 509, 278, 273, 374, 383, 59, 80, 341, 510,
-	530, 297, 271, 511, 288, 53, 551, 552, 553, 265,
-	554, 7, 561, 562, 361, 111, 275, 276, 45, 318,
-	257, 512, 513, 308, 309, 323, 296, 319, 289, 518,
-	259, 362, 425, 245, 514, 382, 272, 337, 342, 435,
-	264, 363, 529, 434, 316, 313, 266, 515, 364, 220,
-	260, 261, 516, 519, 365, 366, 282, 367, 368, 369,
-	370, 371, 267, 433, 294, 311, 343, 394, 395, 396,
+	-38, 78, 31, 431, 434, -287, 207, 213, 214, 415,
+	110, 109, 73, 209, -284, 340, 535, -53, 537, 99,
+	101, 536, 42, 219, 538, 539, 540, 541, 228, 542,
+	543, 544, 545, 551, 552, 553, 554, 111, 5, -70,
+	-240, -236, -307, -230, 86, 87, 88, 428, 231, 462,
+	463, 280, 78, 39, 337, 340, 535, 288, 302, 296,
+	323, 315, 429, 464, 283, 232, 268, 532, 313, 119,
+	537, 286, 465, 246, 345, 346, 347, 99, 291, 382,
+	550, 285, 466, 548, 101, 536, 77, 49, 287, 42,
+	241, 311, 215, 307, 538, 269, 467, 439, 262, 110,
+	107, 557, 34, 305, 48, 28, 547, 109, 47, 539,
+	134, 468, 540, 349, 328, 526, 46, 350, 247, 469,
+	81, 252, 433, 534, 351, 306, 352, 279, 546, 212,
+	470, 518, 353, 354, 527, 471, 329, 333, 472, 374,
+	355, 564, 50, 473, 474, 528, 108, 475, 76, 541,
+	300, 301, 476, 277, 230, 376, 327, 228, 33, 356,
+	430, 281, 56, 256, 377, 44, 331, 561, 43, 522,
+	477, 525, 326, 322, 424, 51, 478, 479, 480, 481,
+	542, 325, 299, 321, 556, 451, 274, 543, 60, 214,
+	335, 334, 336, 263, 373, 318, 482, 483, 484, 235,
+	79, 485, 308, 19, 486, 487, 357, 270, 488, 54,
+	489, 490, 380, 244, 491, 52, 544, 37, 249, 558,
+	545, 492, 493, 494, 495, 251, 496, 359, 497, 358,
+	330, 332, 258, 360, 432, 498, 304, 248, 549, 499,
+	236, 533, 250, 255, 243, 381, 237, 500, 501, 502,
+	503, 504, 284, 505, 506, 292, 551, 425, 41, 507,
+	508, 509, 510, 278, 273, 375, 384, 59, 80, 342,
+	511, 531, 298, 271, 512, 289, 53, 552, 553, 554,
+	265, 555, 7, 562, 563, 362, 111, 275, 276, 45,
+	319, 257, 513, 514, 309, 310, 324, 297, 320, 290,
+	519, 259, 363, 426, 245, 515, 383, 272, 338, 343,
+	436, 264, 364, 530, 435, 317, 314, 266, 516, 365,
+	220, 260, 261, 517, 520, 366, 367, 282, 368, 369,
+	370, 371, 372, 267, 434, 295, 312, 344, 395, 396,
 	397, 398, 399, 400, 401, 402, 403, 404, 405, 406,
-	407, 408, 409, 410, 411, 422, 218, -70, 218, -140,
-	-236, 218, -208, 345, -227, 347, 360, 355, 365, 353,
-	-219, 356, 358, 258, -322, 373, 218, 362, 206, 160,
-	348, 357, 366, 367, 282, 368, 371, 267, -318, -307,
-	539, 554, 119, 315, 352, 350, 374, 521, 370, 369,
-	-236, 289, -243, 294, -231, -307, -230, 292, -140, -76,
-	517, 210, -245, -245, -94, 521, 523, -159, -109, 127,
-	-119, -122, -114, -115, -153, -154, -155, -156, -120, -166,
-	149, 150, 157, 128, -164, -123, 24, 426, 415, 414,
-	160, 29, 202, 66, 67, 417, 418, 130, 55, 390,
-	391, -121, 386, 392, 387, 420, 421, 102, 422, 423,
-	424, 425, -231, -236, 223, 385, 217, 155, 413, -116,
-	-112, -6, -162, 384, 388, 389, 393, -307, -230, -118,
-	-117, -149, 89, 95, 100, 96, -327, 103, -330, 90,
-	91, 92, 93, 94, 104, 105, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 42, 361, 361,
-	-140, -70, -70, -70, -70, -174, -92, -176, -8, -6,
-	-330, 6, -70, -6, -7, -11, -32, -34, 457, -33,
-	-236, -181, -196, 10, 145, 40, 48, -179, -180, -10,
-	-6, -109, 17, 21, 22, -97, 151, -109, -236, -71,
-	-97, -217, 222, -70, -70, -207, -248, 289, -211, 374,
-	373, -232, -209, -231, -229, -208, 372, 211, 436, 126,
-	23, 25, 129, 160, 114, 17, 130, 35, 213, 315,
-	229, 159, 225, 414, 206, 70, 437, 386, 387, 384,
-	390, 416, 417, 385, 347, 29, 11, 439, 26, 239,
-	22, 36, 153, 208, 133, 242, 24, 240, 102, 105,
-	442, 20, 73, 234, 12, 227, 38, 14, 443, 444,
-	15, 223, 222, 145, 219, 68, 9, 202, 27, 142,
-	64, 445, 121, 446, 447, 448, 449, 115, 66, 143,
-	18, 558, 388, 389, 31, 522, 426, 253, 155, 71,
-	57, 523, 127, 451, 452, 103, 453, 106, 74, 528,
-	123, 16, 69, 40, 454, 254, 455, 224, 559, 456,
-	377, 457, 144, 209, 413, 67, 458, 217, 360, 6,
-	419, 30, 238, 226, 113, 65, 459, 218, 132, 420,
-	421, 221, 116, 104, 5, 120, 32, 10, 72, 75,
-	391, 392, 393, 55, 112, 430, 131, 13, 460, 378,
-	125, 119, -281, 143, -268, -272, -231, 233, -297, 229,
-	-140, -290, -289, -231, -93, -226, 219, 227, 226, 120,
-	-311, 123, 275, 385, 217, -49, -50, -208, 159, -80,
-	250, 254, 84, 84, -272, -271, -270, -312, 254, 233,
-	-296, -288, 225, 234, -278, 226, 227, -273, 219, 121,
-	-312, -273, 224, 234, 254, 254, 111, 254, 111, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 249, -279,
-	135, -279, 434, 434, -284, -312, -312, -312, 221, 33,
-	33, -228, -273, 221, 23, -279, -279, -208, 159, -279,
-	-279, -279, -279, 262, 262, -279, -279, -279, -279, -279,
+	407, 408, 409, 410, 411, 412, 423, 218, -70, 218,
+	-140, -236, 218, -208, 346, -227, 348, 361, 356, 366,
+	354, -219, 357, 359, 258, -322, 374, 218, 363, 206,
+	160, 349, 358, 367, 368, 282, 369, 372, 267, -318,
+	-307, 540, 555, 119, 316, 353, 351, 375, 522, 371,
+	370, -236, 290, -243, 295, -231, -307, -230, 293, -140,
+	-76, 518, 210, -245, -245, -94, 522, 524, -159, -109,
+	127, -119, -122, -114, -115, -153, -154, -155, -156, -120,
+	-166, 149, 150, 157, 128, -164, -123, 24, 427, 416,
+	415, 160, 29, 202, 66, 67, 418, 419, 130, 55,
+	391, 392, -121, 387, 393, 388, 421, 422, 102, 423,
+	424, 425, 426, -231, -236, 223, 386, 217, 155, 414,
+	-116, -112, -6, -162, 385, 389, 390, 394, -307, -230,
+	-118, -117, -149, 89, 95, 100, 96, -327, 103, -330,
+	90, 91, 92, 93, 94, 104, 105, 161, 162, 163,
+	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 42, 362,
+	362, -140, -70, -70, -70, -70, -174, -92, -176, -8,
+	-6, -330, 6, -70, -6, -7, -11, -32, -34, 458,
+	-33, -236, -181, -196, 10, 59, 145, 40, 48, -179,
+	-180, -10, -6, -109, 17, 21, 22, -97, 151, -109,
+	-236, -71, -97, -217, 222, -70, -70, -207, -248, 290,
+	-211, 375, 374, -232, -209, -231, -229, -208, 373, 211,
+	437, 126, 23, 25, 129, 160, 114, 17, 130, 35,
+	213, 316, 229, 159, 225, 415, 206, 70, 438, 387,
+	388, 385, 391, 417, 418, 386, 348, 29, 11, 440,
+	26, 239, 22, 36, 153, 208, 133, 242, 24, 240,
+	102, 105, 443, 20, 73, 234, 12, 227, 38, 14,
+	444, 445, 15, 223, 222, 145, 219, 68, 9, 202,
+	27, 142, 64, 446, 121, 447, 448, 449, 450, 115,
+	66, 143, 18, 559, 389, 390, 31, 523, 427, 253,
+	155, 71, 57, 524, 127, 452, 453, 103, 454, 106,
+	74, 529, 123, 16, 69, 40, 455, 254, 456, 224,
+	560, 457, 378, 458, 144, 209, 414, 67, 459, 217,
+	361, 6, 420, 30, 238, 226, 113, 65, 460, 218,
+	132, 421, 422, 221, 116, 104, 5, 120, 32, 10,
+	72, 75, 392, 393, 394, 55, 112, 431, 131, 13,
+	461, 379, 125, 119, -281, 143, -268, -272, -231, 233,
+	-297, 229, -140, -290, -289, -231, -93, -226, 219, 227,
+	226, 120, -311, 123, 275, 386, 217, -49, -50, -208,
+	159, -80, 250, 254, 84, 84, -272, -271, -270, -312,
+	254, 233, -296, -288, 225, 234, -278, 226, 227, -273,
+	219, 121, -312, -273, 224, 234, 254, 254, 111, 254,
+	111, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	249, -279, 135, -279, 435, 435, -284, -312, -312, -312,
+	221, 33, 33, -228, -273, 221, 23, -279, -279, -208,
+	159, -279, -279, -279, -279, 262, 262, -279, -279, -279,
 	-279, -279, -279, -279, -279, -279, -279, -279, -279, -279,
-	218, -311, -101, 371, 282, 78, -51, 264, -35, -140,
-	-226, 219, 220, -311, 251, -140, 203, -140, -221, 143,
-	13, -221, -218, 361, 359, 346, 351, -221, -221, -221,
-	-221, 265, 344, -274, 219, 33, 230, 361, 265, 344,
-	265, 266, 265, 266, 354, 364, 265, -241, 12, 145,
-	385, 349, 353, 258, 218, 259, 220, 363, -307, 524,
-	266, -241, 90, -222, 143, 361, 261, -221, -246, -330,
-	-232, 315, -246, -246, 30, 87, 221, -231, -72, -231,
-	90, -13, -9, -20, -19, -21, 135, -99, 361, -87,
-	160, 539, 525, 526, 527, 524, 358, 532, 530, 528,
-	265, 529, 84, 123, 125, 126, -109, 142, -150, 135,
-	136, 137, 138, 139, 140, 141, 145, 127, 129, 143,
-	144, 124, 146, 147, 148, 149, 150, 151, 152, 154,
-	153, 155, 156, 159, 204, 205, -115, -115, -115, -115,
-	-164, -330, -330, -330, -115, -216, -330, -115, -330, -330,
-	-330, -330, -170, -109, -330, -334, -330, -334, -334, -259,
-	-330, -259, -330, -330, -330, -330, -330, -330, -330, -330,
-	203, -330, -330, -330, -330, -330, -259, -259, -259, -259,
-	100, 95, 89, -166, 96, 90, -231, -236, -6, -7,
-	-159, -245, -319, -320, -143, -140, -330, 282, -231, -231,
-	251, -179, -10, -6, -174, -180, -176, -6, -70, -85,
-	-98, 61, 62, -100, 22, 36, 65, 63, 21, -331,
-	85, -331, -196, -331, 84, -34, -200, 83, 59, 41,
-	90, 90, 84, 19, -175, -177, -109, 12, -234, -233,
-	23, -231, 90, 203, 98, 12, -141, 27, -140, -217,
-	-217, 84, 289, -211, -248, -213, -212, 375, 377, 135,
-	-235, -231, 90, 29, 85, 84, -140, -251, -254, -256,
-	-255, -257, -252, -253, 312, 313, 160, 316, 318, 319,
-	320, 321, 322, 323, 324, 325, 326, 327, 30, 241,
-	308, 309, 310, 311, 328, 329, 330, 331, 332, 333,
-	334, 335, 295, 314, 428, 296, 297, 298, 299, 300,
-	301, 303, 304, 305, 306, 307, -310, -307, 83, 85,
-	84, -258, 83, -101, 218, -307, 219, 219, 219, -70,
-	413, -279, -279, 249, 17, -42, -39, -303, 16, -38,
-	-39, 211, 107, 108, 208, 83, -268, 83, -277, -310,
-	-307, 83, 121, 224, 120, -276, -273, -276, -277, -307,
-	-166, -307, 121, 121, -204, -231, -204, -204, 21, -204,
-	21, -204, 21, 92, -231, -204, 21, -204, 21, -204,
-	21, -204, 21, -204, 21, 29, 76, 77, 29, 79,
-	80, 81, -166, -166, -268, -208, -140, -307, 92, 92,
-	-279, -279, 92, 90, 90, 90, -279, -279, 92, 90,
-	-238, -236, 90, -313, 235, 279, 281, 92, 92, 92,
-	92, 29, 90, -314, 29, 546, 545, 547, 548, 549,
-	92, 29, 92, 29, 92, -231, 83, -140, -107, 269,
-	206, 208, 211, 74, 90, 283, 135, 42, 84, 221,
-	218, -307, -223, 223, -223, -231, -237, -236, -229, 90,
-	-109, -275, 12, 145, -241, -241, -221, -140, -275, -241,
-	-221, -140, -221, -221, -221, -221, -241, -241, -241, -221,
-	-236, -236, -140, -140, -140, -140, -140, -140, -140, -246,
-	-246, -246, -222, -221, 524, 90, 74, -244, 292, 326,
-	518, 519, 520, 84, 430, -133, -140, 524, 524, 524,
-	524, 524, 524, -109, -109, -109, -109, -157, 103, 127,
-	104, 105, -122, -158, -162, -164, 97, 145, 129, 143,
-	144, -114, -115, -114, -114, -114, -114, -114, -114, -114,
-	-114, -114, -114, -114, -114, -114, -247, -307, 90, 160,
-	90, 90, -95, -97, -109, -109, -307, -231, -95, -95,
-	-109, -91, 22, 36, -168, -169, 131, -166, -331, -331,
-	92, -231, -231, -84, -83, 395, 396, 397, 398, 400,
-	401, 402, 405, 406, 410, 411, 394, 412, 399, 404,
-	407, 408, 409, 403, 311, -109, -109, -109, -77, -109,
-	114, 115, 116, -96, 22, 36, -95, -232, -237, -229,
-	-95, -96, -96, -95, -95, 203, -196, -331, 84, -321,
-	377, 378, 522, -239, 254, -238, 23, -160, -159, 90,
-	12, -202, 75, -231, -179, -179, 61, 62, 57, -95,
-	-100, -331, -33, 23, -198, -231, 60, 90, -260, -208,
-	336, 337, -109, -109, 84, -178, 25, 26, -140, -233,
-	151, -237, -140, -203, 254, -140, -125, -127, -128, -129,
-	-143, -165, -330, 448, 12, -133, -134, -142, -236, -211,
-	-213, 84, 376, 378, 379, 74, 106, -109, -261, 159,
-	-286, -285, -284, -268, -270, -271, -272, 85, -261, -264,
-	342, 341, -258, -258, -258, -258, -258, -260, -260, -260,
-	-260, 83, 83, -258, -258, -258, -258, -262, 83, -262,
-	-262, -263, 83, -263, -297, -109, -294, -293, -291, -292,
-	228, 99, 430, 75, -289, -178, 92, -107, -224, 223,
-	-295, -292, -307, -307, -307, -224, -307, 90, -307, 90,
-	-81, -55, -1, 558, 559, 560, 84, 17, -269, -268,
-	-54, 279, -300, -301, 254, -296, -290, -277, 121, -276,
-	-277, -277, -307, 84, 27, 111, 111, 111, 111, 430,
-	208, 30, -268, -54, -247, 221, -247, -247, 90, 90,
-	-220, 554, -133, -103, 271, 135, 260, 260, 218, 218,
-	273, -140, 284, 286, 285, 283, 21, 272, 274, 276,
-	262, -140, -140, -223, 74, -135, -140, 24, -236, -140,
-	-221, -221, -140, -221, -221, -140, -231, -87, 377, 84,
-	430, 20, -88, 20, 103, 104, 105, -158, -114, -115,
-	-114, 126, 242, 84, -331, 23, 84, 75, -331, -331,
-	-331, 84, 12, -95, -171, -169, 133, -109, -331, -331,
-	84, 84, 12, -250, 23, -331, -331, -170, -331, 12,
-	-331, -95, -95, -330, 203, -331, -331, -331, -331, -331,
-	-232, -320, 521, 378, -147, -146, -144, 72, 222, 73,
-	-330, -238, -331, 90, 87, -199, 87, -198, -152, -231,
-	92, 96, -181, -124, -126, 12, -100, -164, 85, 84,
-	-260, -185, -190, -218, -231, 90, 160, -177, 203, -148,
-	13, -151, 30, 55, -9, -330, -330, 30, 84, -136,
-	-138, -137, -139, 64, 68, 70, 65, 66, 67, 71,
-	-242, 23, -125, -7, -6, -330, -330, -140, -133, -332,
-	12, 75, -332, 84, 203, -212, -214, 380, 377, 383,
-	-307, 90, -80, 84, -284, -272, -182, -104, 38, -265,
-	343, -260, -260, -267, 90, -267, 92, 92, 85, -45,
-	-40, -41, 31, 78, -291, -279, 90, 37, -231, 85,
-	-103, -140, 127, 74, -295, -295, -295, -236, -2, 557,
-	563, 121, 83, 346, 16, -198, 84, 85, -167, 280,
-	85, -302, 55, -231, 85, 83, -277, -277, -231, -330,
-	218, 29, 29, -54, -167, -261, -307, 556, 555, 85,
-	220, 278, -108, 390, -105, 90, 86, -140, -140, -140,
-	-140, -140, 211, 208, 263, 221, -133, -140, 84, -75,
-	237, 232, -241, -241, 31, -140, 377, 533, 531, 126,
-	242, -122, -115, -97, 427, -249, 160, 312, 241, 310,
-	306, 326, 317, 341, 308, 342, 305, 304, 303, -249,
-	-247, -109, -109, -174, 134, -109, 132, -109, -109, -109,
-	-331, 160, 312, 12, -109, -331, -331, -96, -232, -144,
-	-273, -273, -273, -204, 84, -215, 20, 12, 55, 55,
-	-124, -148, -125, -100, -231, -188, 517, -193, 44, -191,
-	-192, 45, -189, 46, 54, 151, -179, -109, -205, 74,
-	-206, -210, -166, -161, -163, -162, -330, -197, -331, -231,
-	-204, -206, -127, -128, -128, -127, -128, 64, 64, 64,
-	69, 64, 69, 64, -137, -236, -331, -331, -7, -7,
-	-239, 75, -125, -125, -142, -236, 151, 377, 381, 382,
-	-284, -326, 103, 127, 29, 74, 339, 99, -324, 159,
-	-325, 224, 120, 121, 236, 23, 39, 85, 84, 85,
-	84, 85, 84, -225, 467, 127, -41, -40, -279, -279,
-	92, -307, 220, 24, -140, 74, 74, 74, -82, 561,
-	92, 83, -3, 78, -109, 83, 17, -268, -166, 256,
-	145, -294, -198, -298, -300, -140, -111, -330, -110, -112,
-	-116, 149, 150, -167, -140, -102, 269, 277, 83, -106,
-	87, -309, 75, 260, 339, 260, -140, -75, -45, -140,
-	-221, -221, 31, -307, -122, -115, -330, -331, -258, -258,
-	-258, -263, -258, 300, -258, 300, -258, -331, -331, 84,
-	-331, 20, -331, -86, 419, -109, 84, 84, -331, 83,
-	83, -109, -331, -331, -145, 20, -145, -145, -331, 87,
-	-140, -148, -172, 14, -185, 49, 318, -195, -194, 53,
-	45, -192, 17, 47, 17, 28, -205, 84, 135, 84,
-	-331, -331, 84, 55, 203, -331, -148, -131, -130, 74,
-	75, -132, 74, -130, 64, 64, -200, -331, -331, -203,
-	-125, -148, -148, 203, 103, -330, -113, -121, -111, 10,
-	90, 90, -307, 121, 121, -140, 83, -260, 90, -260,
-	92, 92, 467, 29, 79, 80, 81, 29, 76, 77,
-	-140, -140, -140, -140, -299, 83, 17, -109, 83, 135,
-	85, -198, -198, 257, -162, -330, 85, -331, 84, -266,
-	430, 433, -109, -117, -117, -200, 85, -306, 430, -308,
-	-231, -231, -231, -231, -114, -260, -109, -109, -179, 90,
-	-109, -109, 92, 92, -331, -330, 64, 16, 14, -330,
-	-330, -239, -172, -173, 15, 17, -186, 51, -184, 50,
-	-184, -194, 17, 17, 90, 17, 90, 121, -210, -109,
-	-163, 55, -9, -231, -161, -231, -174, -109, 83, -109,
-	-148, -148, -109, -154, 422, 423, 424, 425, 83, -109,
-	85, 85, -200, -298, -55, 85, -198, 92, 85, -162,
-	-89, -330, 253, -325, -300, 434, 434, -331, 23, -305,
-	-304, -232, 83, 75, -90, 145, 430, -331, -331, -331,
-	-331, -331, 85, 85, -201, -331, -231, 224, 17, 17,
-	-201, -201, -147, -173, -109, -159, -187, 52, 74, 106,
-	90, 90, 90, 10, -161, 203, -179, -198, -174, -331,
-	-198, 85, 23, 85, 563, 121, 85, 253, -9, 84,
-	135, -198, -140, -331, 428, 71, 431, 84, -331, -331,
-	-331, 74, 106, -206, -231, 85, -179, 85, -183, -9,
-	83, -3, -331, -73, 430, -304, -283, -232, 90, 92,
-	85, 60, 429, 432, -231, 224, -316, -317, 74, -326,
-	-323, 103, 127, 99, -324, 112, 113, -73, -109, 83,
-	-74, 268, 521, -309, 60, -317, 74, 11, 10, 103,
-	90, 85, -198, 229, -306, 430, -315, 237, 232, 235,
-	30, -315, -4, 562, 85, 270, 431, 231, 29, 103,
-	92, -4, 432,
+	-279, -279, 218, -311, -101, 372, 282, 78, -51, 264,
+	-35, -140, -226, 219, 220, -311, 251, -140, 203, -140,
+	-221, 143, 13, -221, -218, 362, 360, 347, 352, -221,
+	-221, -221, -221, 265, 345, -274, 219, 33, 230, 362,
+	265, 345, 265, 266, 265, 266, 355, 365, 265, -241,
+	12, 145, 386, 350, 354, 258, 218, 259, 220, 364,
+	-307, 525, 266, -241, 90, -222, 143, 362, 261, -221,
+	-246, -330, -232, 316, -246, -246, 30, 87, 221, -231,
+	-72, -231, 90, -13, -9, -20, -19, -21, 135, -99,
+	362, -87, 160, 540, 526, 527, 528, 525, 359, 533,
+	531, 529, 265, 530, 84, 123, 125, 126, -109, 142,
+	-150, 135, 136, 137, 138, 139, 140, 141, 145, 127,
+	129, 143, 144, 124, 146, 147, 148, 149, 150, 151,
+	152, 154, 153, 155, 156, 159, 204, 205, -115, -115,
+	-115, -115, -164, -330, -330, -330, -115, -216, -330, -115,
+	-330, -330, -330, -330, -170, -109, -330, -334, -330, -334,
+	-334, -259, -330, -259, -330, -330, -330, -330, -330, -330,
+	-330, -330, 203, -330, -330, -330, -330, -330, -259, -259,
+	-259, -259, 100, 95, 89, -166, 96, 90, -231, -236,
+	-6, -7, -159, -245, -319, -320, -143, -140, -330, 282,
+	-231, -231, 251, -179, -10, -6, -174, -180, -176, -6,
+	-70, -85, -98, 61, 62, -100, 22, 36, 65, 63,
+	21, -331, 85, -331, -196, -331, 84, -34, -200, 83,
+	484, 511, 484, 511, 59, 41, 90, 90, 84, 19,
+	-175, -177, -109, 12, -234, -233, 23, -231, 90, 203,
+	98, 12, -141, 27, -140, -217, -217, 84, 290, -211,
+	-248, -213, -212, 376, 378, 135, -235, -231, 90, 29,
+	85, 84, -140, -251, -254, -256, -255, -257, -252, -253,
+	313, 314, 160, 317, 319, 320, 321, 322, 323, 324,
+	325, 326, 327, 328, 30, 241, 309, 310, 311, 312,
+	329, 330, 331, 332, 333, 334, 335, 336, 296, 315,
+	429, 297, 298, 299, 300, 301, 302, 304, 305, 306,
+	307, 308, -310, -307, 83, 85, 84, -258, 83, -101,
+	218, -307, 219, 219, 219, -70, 414, -279, -279, 249,
+	17, -42, -39, -303, 16, -38, -39, 211, 107, 108,
+	208, 83, -268, 83, -277, -310, -307, 83, 121, 224,
+	120, -276, -273, -276, -277, -307, -166, -307, 121, 121,
+	-204, -231, -204, -204, 21, -204, 21, -204, 21, 92,
+	-231, -204, 21, -204, 21, -204, 21, -204, 21, -204,
+	21, 29, 76, 77, 29, 79, 80, 81, -166, -166,
+	-268, -208, -140, -307, 92, 92, -279, -279, 92, 90,
+	90, 90, -279, -279, 92, 90, -238, -236, 90, -313,
+	235, 279, 281, 92, 92, 92, 92, 29, 90, -314,
+	29, 547, 546, 548, 549, 550, 92, 29, 92, 29,
+	92, -231, 83, -140, -107, 269, 206, 208, 211, 74,
+	90, 283, 135, 42, 84, 221, 218, -307, -223, 223,
+	-223, -231, -237, -236, -229, 90, -109, -275, 12, 145,
+	-241, -241, -221, -140, -275, -241, -221, -140, -221, -221,
+	-221, -221, -241, -241, -241, -221, -236, -236, -140, -140,
+	-140, -140, -140, -140, -140, -246, -246, -246, -222, -221,
+	525, 90, 74, -244, 293, 327, 519, 520, 521, 84,
+	431, -133, -140, 525, 525, 525, 525, 525, 525, -109,
+	-109, -109, -109, -157, 103, 127, 104, 105, -122, -158,
+	-162, -164, 97, 145, 129, 143, 144, -114, -115, -114,
+	-114, -114, -114, -114, -114, -114, -114, -114, -114, -114,
+	-114, -114, -247, -307, 90, 160, 90, 90, -95, -97,
+	-109, -109, -307, -231, -95, -95, -109, -91, 22, 36,
+	-168, -169, 131, -166, -331, -331, 92, -231, -231, -84,
+	-83, 396, 397, 398, 399, 401, 402, 403, 406, 407,
+	411, 412, 395, 413, 400, 405, 408, 409, 410, 404,
+	312, -109, -109, -109, -77, -109, 114, 115, 116, -96,
+	22, 36, -95, -232, -237, -229, -95, -96, -96, -95,
+	-95, 203, -196, -331, 84, -321, 378, 379, 523, -239,
+	254, -238, 23, -160, -159, 90, 12, -202, 75, -231,
+	-179, -179, 61, 62, 57, -95, -100, -331, -33, 23,
+	-198, -231, 477, 477, 60, 90, -260, -208, 337, 338,
+	-109, -109, 84, -178, 25, 26, -140, -233, 151, -237,
+	-140, -203, 254, -140, -125, -127, -128, -129, -143, -165,
+	-330, 449, 12, -133, -134, -142, -236, -211, -213, 84,
+	377, 379, 380, 74, 106, -109, -261, 159, -286, -285,
+	-284, -268, -270, -271, -272, 85, -261, -264, 343, 342,
+	-258, -258, -258, -258, -258, -260, -260, -260, -260, 83,
+	83, -258, -258, -258, -258, -262, 83, -262, -262, -263,
+	83, -263, -297, -109, -294, -293, -291, -292, 228, 99,
+	431, 75, -289, -178, 92, -107, -224, 223, -295, -292,
+	-307, -307, -307, -224, -307, 90, -307, 90, -81, -55,
+	-1, 559, 560, 561, 84, 17, -269, -268, -54, 279,
+	-300, -301, 254, -296, -290, -277, 121, -276, -277, -277,
+	-307, 84, 27, 111, 111, 111, 111, 431, 208, 30,
+	-268, -54, -247, 221, -247, -247, 90, 90, -220, 555,
+	-133, -103, 271, 135, 260, 260, 218, 218, 273, -140,
+	284, 286, 285, 287, 283, 21, 272, 274, 276, 262,
+	-140, -140, -223, 74, -135, -140, 24, -236, -140, -221,
+	-221, -140, -221, -221, -140, -231, -87, 378, 84, 431,
+	20, -88, 20, 103, 104, 105, -158, -114, -115, -114,
+	126, 242, 84, -331, 23, 84, 75, -331, -331, -331,
+	84, 12, -95, -171, -169, 133, -109, -331, -331, 84,
+	84, 12, -250, 23, -331, -331, -170, -331, 12, -331,
+	-95, -95, -330, 203, -331, -331, -331, -331, -331, -232,
+	-320, 522, 379, -147, -146, -144, 72, 222, 73, -330,
+	-238, -331, 90, 87, -199, 87, -198, -152, -231, 92,
+	96, -181, -124, -126, 12, -100, -164, 85, 84, -260,
+	-185, -190, -218, -231, 90, 160, -177, 203, -148, 13,
+	-151, 30, 55, -9, -330, -330, 30, 84, -136, -138,
+	-137, -139, 64, 68, 70, 65, 66, 67, 71, -242,
+	23, -125, -7, -6, -330, -330, -140, -133, -332, 12,
+	75, -332, 84, 203, -212, -214, 381, 378, 384, -307,
+	90, -80, 84, -284, -272, -182, -104, 38, -265, 344,
+	-260, -260, -267, 90, -267, 92, 92, 85, -45, -40,
+	-41, 31, 78, -291, -279, 90, 37, -231, 85, -103,
+	-140, 127, 74, -295, -295, -295, -236, -2, 558, 564,
+	121, 83, 347, 16, -198, 84, 85, -167, 280, 85,
+	-302, 55, -231, 85, 83, -277, -277, -231, -330, 218,
+	29, 29, -54, -167, -261, -307, 557, 556, 85, 220,
+	278, -108, 391, -105, 90, 86, -140, -140, -140, -140,
+	-140, 211, 208, 263, 221, -133, -140, 84, -75, 237,
+	232, -241, -241, 31, -140, 378, 534, 532, 126, 242,
+	-122, -115, -97, 428, -249, 160, 313, 241, 311, 307,
+	327, 318, 342, 309, 343, 306, 305, 304, -249, -247,
+	-109, -109, -174, 134, -109, 132, -109, -109, -109, -331,
+	160, 313, 12, -109, -331, -331, -96, -232, -144, -273,
+	-273, -273, -204, 84, -215, 20, 12, 55, 55, -124,
+	-148, -125, -100, -231, -188, 518, -193, 44, -191, -192,
+	45, -189, 46, 54, 151, -179, -109, -205, 74, -206,
+	-210, -166, -161, -163, -162, -330, -197, -331, -231, -204,
+	-206, -127, -128, -128, -127, -128, 64, 64, 64, 69,
+	64, 69, 64, -137, -236, -331, -331, -7, -7, -239,
+	75, -125, -125, -142, -236, 151, 378, 382, 383, -284,
+	-326, 103, 127, 29, 74, 340, 99, -324, 159, -325,
+	224, 120, 121, 236, 23, 39, 85, 84, 85, 84,
+	85, 84, -225, 468, 127, -41, -40, -279, -279, 92,
+	-307, 220, 24, -140, 74, 74, 74, -82, 562, 92,
+	83, -3, 78, -109, 83, 17, -268, -166, 256, 145,
+	-294, -198, -298, -300, -140, -111, -330, -110, -112, -116,
+	149, 150, -167, -140, -102, 269, 277, 83, -106, 87,
+	-309, 75, 260, 340, 260, -140, -75, -45, -140, -221,
+	-221, 31, -307, -122, -115, -330, -331, -258, -258, -258,
+	-263, -258, 301, -258, 301, -258, -331, -331, 84, -331,
+	20, -331, -86, 420, -109, 84, 84, -331, 83, 83,
+	-109, -331, -331, -145, 20, -145, -145, -331, 87, -140,
+	-148, -172, 14, -185, 49, 319, -195, -194, 53, 45,
+	-192, 17, 47, 17, 28, -205, 84, 135, 84, -331,
+	-331, 84, 55, 203, -331, -148, -131, -130, 74, 75,
+	-132, 74, -130, 64, 64, -200, -331, -331, -203, -125,
+	-148, -148, 203, 103, -330, -113, -121, -111, 10, 90,
+	90, -307, 121, 121, -140, 83, -260, 90, -260, 92,
+	92, 468, 29, 79, 80, 81, 29, 76, 77, -140,
+	-140, -140, -140, -299, 83, 17, -109, 83, 135, 85,
+	-198, -198, 257, -162, -330, 85, -331, 84, -266, 431,
+	434, -109, -117, -117, -200, 85, -306, 431, -308, -231,
+	-231, -231, -231, -114, -260, -109, -109, -179, 90, -109,
+	-109, 92, 92, -331, -330, 64, 16, 14, -330, -330,
+	-239, -172, -173, 15, 17, -186, 51, -184, 50, -184,
+	-194, 17, 17, 90, 17, 90, 121, -210, -109, -163,
+	55, -9, -231, -161, -231, -174, -109, 83, -109, -148,
+	-148, -109, -154, 423, 424, 425, 426, 83, -109, 85,
+	85, -200, -298, -55, 85, -198, 92, 85, -162, -89,
+	-330, 253, -325, -300, 435, 435, -331, 23, -305, -304,
+	-232, 83, 75, -90, 145, 431, -331, -331, -331, -331,
+	-331, 85, 85, -201, -331, -231, 224, 17, 17, -201,
+	-201, -147, -173, -109, -159, -187, 52, 74, 106, 90,
+	90, 90, 10, -161, 203, -179, -198, -174, -331, -198,
+	85, 23, 85, 564, 121, 85, 253, -9, 84, 135,
+	-198, -140, -331, 429, 71, 432, 84, -331, -331, -331,
+	74, 106, -206, -231, 85, -179, 85, -183, -9, 83,
+	-3, -331, -73, 431, -304, -283, -232, 90, 92, 85,
+	60, 430, 433, -231, 224, -316, -317, 74, -326, -323,
+	103, 127, 99, -324, 112, 113, -73, -109, 83, -74,
+	268, 522, -309, 60, -317, 74, 11, 10, 103, 90,
+	85, -198, 229, -306, 431, -315, 237, 232, 235, 30,
+	-315, -4, 563, 85, 270, 432, 231, 29, 103, 92,
+	-4, 433,
 }
 
 var yyDef = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 34, 35, 36, 68, 70, 71, 712,
-	712, 712, 0, 712, 0, 0, 712, -2, -2, 712,
-	1153, 0, 712, 0, 0, -2, 644, 646, 0, 648,
-	-2, 0, 0, 657, 1612, 1612, 707, 0, 0, 0,
-	0, 0, 712, 712, 712, 712, 1014, 48, 712, 0,
-	83, 84, 663, 664, 665, 63, 0, 1610, 1, 3,
-	69, 73, 0, 0, 0, 56, 1023, 0, 76, 0,
-	0, 716, 0, 714, 0, 1136, 712, 712, 0, 114,
+	31, 32, 33, 34, 35, 36, 68, 70, 71, 713,
+	713, 713, 0, 713, 0, 0, 713, -2, -2, 713,
+	1159, 0, 713, 0, 0, -2, 645, 647, 0, 649,
+	-2, 0, 0, 658, 1619, 1619, 708, 0, 0, 0,
+	0, 0, 713, 713, 713, 713, 1015, 48, 713, 0,
+	83, 84, 664, 665, 666, 63, 0, 1617, 1, 3,
+	69, 73, 0, 0, 0, 56, 1024, 0, 76, 0,
+	0, 717, 0, 715, 0, 1142, 713, 713, 0, 114,
 	115, 0, 0, 0, -2, 118, -2, 142, 143, 0,
-	147, 510, 450, 493, 448, 479, -2, 441, 0, 0,
+	147, 511, 450, 493, 448, 479, -2, 441, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	453, 343, 343, 0, 0, -2, 441, 441, 441, 0,
-	0, 0, 476, 1138, 396, 343, 343, 0, 343, 343,
+	0, 0, 476, 1144, 396, 343, 343, 0, 343, 343,
 	343, 343, 0, 0, 343, 343, 343, 343, 343, 343,
-	343, 343, 343, 343, 343, 343, 343, 343, 343, 1040,
-	146, 1154, 1151, 1152, 38, 39, 40, 1301, 1302, 1303,
-	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
-	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
-	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353,
-	1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363,
-	1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373,
-	1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383,
-	1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393,
-	1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
-	1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413,
-	1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423,
-	1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433,
-	1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443,
-	1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453,
-	1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463,
-	1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473,
-	1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483,
-	1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493,
-	1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503,
-	1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513,
-	1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523,
-	1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533,
-	1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543,
-	1544, 1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553,
-	1554, 1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563,
-	1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573,
-	1574, 1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583,
-	1584, 1585, 1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593,
-	1594, 1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603,
-	1604, 1605, 1606, 1607, 1608, 1609, 0, 1130, 0, 574,
-	812, 0, 635, 635, 0, 635, 635, 635, 635, 0,
-	0, 0, 586, 0, 0, 0, 0, 632, 0, 0,
-	605, 607, 0, 632, 0, 638, 0, 619, 635, 1613,
-	1613, 1613, 1121, 0, 629, 627, 641, 642, 624, 625,
-	643, 647, 0, 652, 655, 1147, 1148, 0, 670, 47,
-	1384, 662, 675, 676, 0, 708, 709, 43, 908, 0,
-	834, 838, 849, 862, 863, 864, 865, 866, 868, 869,
-	0, 0, 0, 0, 874, 875, 0, 0, 0, 0,
-	0, 889, 0, 0, 0, 0, 0, 995, 0, 959,
-	959, 925, 959, 961, 961, 0, 0, 0, 1609, 1427,
-	1510, 1556, 1004, 0, 0, 0, 0, 0, 0, 251,
-	252, 907, 1110, 961, 961, 961, 961, -2, -2, 198,
-	199, 200, 201, 202, 203, 204, 0, 194, 0, 256,
-	257, 253, 254, 255, 891, 892, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 237, 238, 239, 240, 241, 242, 243,
-	244, 245, 246, 247, 248, 249, 250, 1612, 0, 685,
-	0, 0, 0, 0, 0, 1023, 0, 1015, 1014, 61,
-	0, 712, -2, 0, 0, 0, 0, 45, 0, 50,
-	771, 75, 74, 1062, 0, 0, 0, 57, 1024, 65,
-	67, 1025, 0, 717, 718, 0, 748, 752, 0, 713,
-	0, 0, 1137, 1136, 1136, 100, 0, 1552, 1114, -2,
-	-2, 0, 0, 1149, 1150, 1123, -2, 1157, 1158, 1159,
-	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
-	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
-	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
-	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
-	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
-	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
-	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
-	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
-	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
-	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
-	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
-	1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289,
-	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
-	1300, -2, 0, 0, 156, 157, 0, 41, 369, 0,
-	152, 0, 363, 313, 1040, 0, 0, 0, 0, 0,
-	712, 0, 1131, 137, 138, 144, 145, 343, 343, 490,
-	0, 0, 146, 146, 457, 458, 459, 0, 0, -2,
-	367, 0, 442, 0, 0, 357, 357, 361, 359, 360,
-	0, 0, 0, 0, 0, 0, 470, 0, 471, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 552, 0,
-	344, 0, 488, 489, 397, 0, 0, 0, 0, 468,
-	469, 0, 0, 1139, 1140, 0, 0, 343, 343, 0,
-	0, 0, 0, 343, 343, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 1053, 0, 0, 0, -2, 0, 566, 0,
-	0, 0, 1132, 1132, 0, 573, 0, 575, 576, 0,
-	0, 577, 0, 632, 632, 630, 631, 579, 580, 581,
-	582, 635, 0, 0, 352, 353, 354, 632, 635, 0,
-	635, 635, 635, 635, 632, 632, 632, 635, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1613, 1613, 1613,
-	638, 635, 0, 615, 0, 616, 617, 620, 621, 1614,
-	1615, 1169, 622, 623, 1122, 645, 653, 656, 673, 671,
-	672, 674, 666, 667, 668, 669, 0, 687, 688, 693,
-	0, 0, 0, 0, 699, 700, 701, 0, 0, 704,
-	705, 706, 0, 0, 0, 0, 832, 0, 0, 897,
-	898, 899, 900, 901, 902, 903, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 870, 871, 872, 873,
-	876, 0, 0, 0, 881, 882, 0, 0, 0, 0,
-	0, 722, 0, 996, 0, 923, 0, 924, 926, 927,
-	0, 928, 0, 0, 0, 0, 0, 0, 0, 732,
-	0, 0, 732, 732, 0, 0, 188, 189, 190, 191,
-	205, 206, 207, 208, 209, 258, 1004, 0, 907, 0,
-	0, 44, 677, 678, 0, 795, 1142, 0, 0, 728,
-	0, 55, 64, 66, 1023, 59, 1023, 0, 734, 0,
-	0, -2, -2, 735, 741, 742, 743, 744, 745, 52,
-	1611, 53, 0, 72, 0, 46, 0, 0, 0, 0,
-	325, 1065, 0, 0, 1016, 1017, 1020, 0, 749, 753,
-	0, 755, 756, 0, 715, 0, 98, 0, 811, 0,
-	0, 0, 1552, 1120, 0, 102, 103, 0, 0, 0,
-	331, 1125, 1126, 1127, -2, 350, 0, 331, 320, 264,
-	265, 266, 313, 268, 313, 313, 313, 313, 325, 325,
-	325, 325, 296, 297, 298, 299, 300, 0, 0, 283,
-	313, 313, 313, 313, 303, 304, 305, 306, 307, 308,
-	309, 310, 269, 270, 271, 272, 273, 274, 275, 276,
-	277, 315, 315, 315, 317, 317, 0, 42, 0, 335,
-	0, 1020, 0, 1053, 1134, 1144, 0, 0, 0, 1134,
-	120, 0, 0, 491, 521, 451, 480, 492, 0, 454,
-	455, -2, 0, 0, 441, 0, 443, 0, 351, 0,
-	-2, 0, 361, 0, 357, 361, 358, 361, 349, 362,
-	472, 473, 474, 0, 532, 781, 0, 0, 0, 0,
-	0, 538, 539, 540, 0, 542, 543, 544, 545, 546,
-	547, 548, 549, 550, 551, 481, 482, 483, 484, 485,
-	486, 487, 0, 0, 443, 0, 477, 0, 398, 399,
-	0, 0, 402, 403, 404, 405, 0, 0, 408, 409,
-	410, 798, 799, 411, 435, 436, 437, 412, 413, 414,
-	415, 416, 417, 418, 429, 430, 431, 432, 433, 434,
-	419, 420, 421, 422, 423, 426, 0, 130, 1044, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1132, 0, 0, 0, 0, 731, 813, 1155, 1156, 636,
-	637, 0, 355, 356, 635, 635, 583, 606, 0, 635,
-	587, 608, 588, 590, 589, 591, 610, 611, 635, 594,
-	633, 634, 595, 596, 597, 598, 599, 600, 601, 602,
-	603, 604, 612, 613, 614, 639, 0, 0, 654, 658,
-	659, 660, 661, 0, 0, 690, 94, 695, 696, 697,
-	698, 710, 703, 909, 829, 830, 831, 833, 835, 0,
-	893, 895, 837, 839, 904, 905, 906, 0, 0, 0,
-	0, 0, 843, 847, 850, 851, 852, 853, 854, 855,
-	856, 857, 858, 859, 860, 861, 867, 974, 975, 976,
-	884, 885, 0, 746, 0, 0, 0, 883, 0, 0,
-	0, 0, 723, 724, 1002, 999, 0, 0, 960, 962,
-	0, 0, 0, 0, 938, 939, 940, 941, 942, 943,
-	944, 945, 946, 947, 948, 949, 950, 951, 952, 953,
-	954, 955, 956, 957, 958, 977, 0, 0, 995, 0,
-	886, 887, 888, 0, 0, 0, 733, 1005, 0, -2,
-	0, 0, 0, 0, 0, 0, 0, 1109, 0, 680,
-	681, 683, 0, 815, 0, 796, 0, 0, 1143, 686,
-	0, 727, 0, 730, 58, 60, 739, 740, 0, 757,
-	736, 54, 49, 0, 0, 773, 1063, 325, 1085, 0,
-	329, 330, 1026, 1027, 0, 1019, 1021, 1022, 77, 754,
-	750, 0, 827, 0, 0, 810, 0, 760, 762, 763,
-	764, 793, 0, 0, 0, 0, 0, 96, 812, 1115,
-	101, 0, 0, 106, 107, 1116, 1117, 1118, 1119, 0,
-	510, -2, 393, 158, 160, 161, 162, 153, -2, 323,
-	321, 322, 267, 325, 325, 290, 291, 292, 293, 294,
-	295, 0, 0, 284, 285, 286, 287, 278, 0, 279,
-	280, 281, 0, 282, 368, 0, 1028, 336, 337, 339,
-	343, 0, 0, 0, 364, 365, 0, 1044, 0, 0,
-	0, 1145, 1144, 1144, 1144, 0, 148, 149, 150, 151,
-	527, 0, 0, 522, 525, 526, 146, 0, 0, 154,
-	445, 444, 0, 559, 0, 366, 0, 0, 361, 361,
-	346, 347, 475, 0, 0, 534, 535, 536, 537, 0,
-	0, 0, 443, 445, 331, 0, 400, 401, 406, 407,
-	424, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 505, 506, 507, 508, 509, 1041, 1042, 1043,
-	0, 0, 567, 0, 0, 385, 92, 1133, 572, 632,
-	593, 609, 632, 585, 592, 618, 650, 694, 0, 0,
-	0, 0, 702, 0, 836, 894, 896, 840, 0, 844,
-	848, 0, 0, 0, 0, 0, 0, 0, 890, 914,
-	915, 0, 0, 1014, 0, 1000, 0, 0, 922, 963,
-	0, 0, 0, 0, 0, 933, 934, 0, 936, 0,
-	910, 0, 0, 732, 0, 964, 965, 966, 967, 968,
-	1005, 679, 682, 684, 769, 816, 817, 0, 0, 0,
-	0, 797, 1141, 725, 726, 729, -2, 0, 0, 1007,
-	0, 0, 757, 827, 758, 0, 737, 51, 772, 0,
-	1067, 1066, 1079, 1092, 326, 327, 328, 1018, 0, 1023,
-	0, 1103, 0, 0, 1095, 0, 0, 0, 0, 0,
-	0, 0, 0, 800, 0, 0, 803, 0, 0, 0,
-	0, 794, 0, 0, 0, 0, 0, -2, 0, 0,
-	90, 91, 0, 0, 0, 104, 105, 0, 0, 111,
-	332, 333, 139, 146, 395, 159, 375, 0, 0, 263,
-	324, 288, 289, 0, 311, 0, 0, 0, 388, 116,
-	1032, 1031, 343, 343, 338, 0, 341, 0, 1146, 314,
-	0, 129, 0, 0, 0, 0, 0, 135, 516, 0,
-	0, 523, 0, 0, 0, 449, 0, 460, 461, 0,
-	531, 558, 0, 563, 335, 0, 345, 348, 782, 0,
-	0, 462, 0, 445, 466, 467, 478, 427, 428, 425,
-	0, 0, 1054, 1055, 1060, 1058, 1059, 121, 498, 500,
-	499, 503, 0, 0, 496, 0, 385, 1028, 0, 571,
-	386, 387, 635, 635, 689, 95, 0, 692, 0, 0,
-	0, 841, 845, 747, 0, 0, 313, 313, 982, 313,
-	317, 985, 313, 987, 313, 990, 313, 993, 994, 0,
-	0, 0, 0, 997, 921, 1003, 0, 0, 0, 0,
-	932, 0, 0, 0, 0, 911, 912, 0, 1006, 818,
-	823, 823, 823, 0, 0, 0, 1128, 1129, 1008, 1009,
-	827, 1010, 759, 738, 774, 1085, 0, 1078, 0, -2,
-	1087, 0, 0, 0, 1093, 751, 78, 828, 81, 0,
-	1103, 1111, 0, 1094, 1105, 1107, 0, 0, 0, 1099,
-	0, 827, 761, 789, 791, 0, 786, 801, 802, 804,
-	0, 806, 0, 808, 809, 771, 766, 767, 0, 0,
-	98, 0, 827, 827, 97, 0, 814, 108, 109, 110,
-	394, 163, 168, 0, 0, 0, 173, 0, 175, 0,
-	376, 0, 260, 262, 0, 0, 166, 325, 0, 325,
-	0, 318, 0, 377, 389, 0, 1029, 1030, 0, 0,
-	340, 342, 0, 1135, 131, 0, 0, 0, 519, 0,
-	528, 0, 0, 0, 0, 0, 0, 155, 446, 0,
-	0, 334, 0, 0, 556, 553, 463, 0, 192, 193,
-	195, 0, 0, 465, 771, 1045, 1046, 1047, 0, 1057,
-	1061, 124, 0, 0, 0, 0, 568, 569, 570, 93,
-	578, 584, 691, 711, 842, 846, 0, 878, 980, 325,
-	983, 984, 986, 988, 989, 991, 992, 879, 880, 0,
-	917, 0, 919, 1023, 0, 1001, 0, 0, 931, 0,
-	0, 0, 937, 913, 0, 0, 0, 0, 795, -2,
-	62, 1010, 1012, 0, 1072, 1070, 1070, 1080, 1081, 0,
-	0, 1088, 0, 0, 0, 0, 82, 0, 0, 0,
-	1108, 0, 0, 0, 0, 99, 1014, 783, 790, 0,
-	0, 784, 0, 785, 805, 807, 765, -2, 768, 827,
-	827, 88, 89, 0, 169, 0, 171, 186, 187, 0,
-	174, 176, 177, 259, 261, 0, 0, 301, 312, 302,
-	0, 0, 390, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	771, 132, 133, 134, 511, 0, 521, 0, 0, 0,
-	514, 0, 452, 0, 562, 0, 0, 533, 0, 541,
-	0, 0, 0, 196, 197, 0, 1056, 497, 0, 122,
-	123, 0, 0, 502, 969, 981, 0, 0, 0, 998,
-	0, 0, 0, 0, 935, 0, 824, 0, 0, 0,
-	0, 815, 1012, 80, 0, 0, 1075, 0, 1068, 1071,
-	1069, 1082, 0, 0, 1089, 0, 1091, 0, 1112, 1113,
-	1106, 0, 1098, 1101, 1097, 1100, 1023, 787, 0, 792,
-	1014, 87, 0, 172, 0, 0, 0, 0, 0, 0,
-	316, 319, 0, 0, 0, 512, 0, 524, 515, 560,
-	561, 0, 564, 370, 557, 554, 555, 464, 0, 125,
-	126, 0, 0, 0, 0, 0, 0, 916, 918, 920,
-	929, 930, 978, 979, 0, 820, 777, 778, 825, 826,
-	0, 0, 770, 79, 1013, 1011, 1064, 0, 1073, 1074,
-	1083, 1084, 1090, 0, 1096, 0, 85, 0, 1023, 170,
-	0, 180, 0, 520, 0, 523, 513, 0, 1048, 0,
-	0, 0, 504, 877, 0, 0, 0, 0, 819, 821,
-	822, 1076, 1077, 1104, 1102, 788, 86, 371, 375, 1048,
-	0, 0, 565, 494, 1050, 127, 128, 438, 439, 440,
-	121, 970, 0, 973, 779, 780, 372, 373, 0, 164,
-	181, 182, 0, 0, 185, 178, 179, 117, 0, 0,
-	0, 1051, 1052, 124, 971, 374, 0, 0, 0, 183,
-	184, 529, 0, 0, 501, 0, 378, 380, 381, 0,
-	0, 379, 517, 0, 529, 1049, 0, 382, 383, 384,
-	530, 518, 972,
+	343, 343, 343, 343, 343, 343, 343, 343, 343, 1041,
+	146, 1160, 1157, 1158, 38, 39, 40, 1307, 1308, 1309,
+	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
+	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
+	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349,
+	1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359,
+	1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369,
+	1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379,
+	1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389,
+	1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399,
+	1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409,
+	1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418, 1419,
+	1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429,
+	1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439,
+	1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449,
+	1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458, 1459,
+	1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468, 1469,
+	1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478, 1479,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488, 1489,
+	1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498, 1499,
+	1500, 1501, 1502, 1503, 1504, 1505, 1506, 1507, 1508, 1509,
+	1510, 1511, 1512, 1513, 1514, 1515, 1516, 1517, 1518, 1519,
+	1520, 1521, 1522, 1523, 1524, 1525, 1526, 1527, 1528, 1529,
+	1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539,
+	1540, 1541, 1542, 1543, 1544, 1545, 1546, 1547, 1548, 1549,
+	1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568, 1569,
+	1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579,
+	1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587, 1588, 1589,
+	1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597, 1598, 1599,
+	1600, 1601, 1602, 1603, 1604, 1605, 1606, 1607, 1608, 1609,
+	1610, 1611, 1612, 1613, 1614, 1615, 1616, 0, 1136, 0,
+	575, 813, 0, 636, 636, 0, 636, 636, 636, 636,
+	0, 0, 0, 587, 0, 0, 0, 0, 633, 0,
+	0, 606, 608, 0, 633, 0, 639, 0, 620, 636,
+	1620, 1620, 1620, 1127, 0, 630, 628, 642, 643, 625,
+	626, 644, 648, 0, 653, 656, 1153, 1154, 0, 671,
+	47, 1391, 663, 676, 677, 0, 709, 710, 43, 909,
+	0, 835, 839, 850, 863, 864, 865, 866, 867, 869,
+	870, 0, 0, 0, 0, 875, 876, 0, 0, 0,
+	0, 0, 890, 0, 0, 0, 0, 0, 996, 0,
+	960, 960, 926, 960, 962, 962, 0, 0, 0, 1616,
+	1434, 1517, 1563, 1005, 0, 0, 0, 0, 0, 0,
+	251, 252, 908, 1116, 962, 962, 962, 962, -2, -2,
+	198, 199, 200, 201, 202, 203, 204, 0, 194, 0,
+	256, 257, 253, 254, 255, 892, 893, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 1619, 0,
+	686, 0, 0, 0, 0, 0, 1024, 0, 1016, 1015,
+	61, 0, 713, -2, 0, 0, 0, 0, 45, 0,
+	50, 772, 75, 74, 1063, 1066, 0, 0, 0, 57,
+	1025, 65, 67, 1026, 0, 718, 719, 0, 749, 753,
+	0, 714, 0, 0, 1143, 1142, 1142, 100, 0, 1559,
+	1120, -2, -2, 0, 0, 1155, 1156, 1129, -2, 1163,
+	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
+	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
+	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
+	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
+	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
+	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
+	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
+	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
+	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
+	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
+	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
+	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
+	1304, 1305, 1306, -2, 0, 0, 156, 157, 0, 41,
+	369, 0, 152, 0, 363, 313, 1041, 0, 0, 0,
+	0, 0, 713, 0, 1137, 137, 138, 144, 145, 343,
+	343, 490, 0, 0, 146, 146, 457, 458, 459, 0,
+	0, -2, 367, 0, 442, 0, 0, 357, 357, 361,
+	359, 360, 0, 0, 0, 0, 0, 0, 470, 0,
+	471, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 0, 344, 0, 488, 489, 397, 0, 0, 0,
+	0, 468, 469, 0, 0, 1145, 1146, 0, 0, 343,
+	343, 0, 0, 0, 0, 343, 343, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 136, 1054, 0, 0, 0, -2, 0,
+	567, 0, 0, 0, 1138, 1138, 0, 574, 0, 576,
+	577, 0, 0, 578, 0, 633, 633, 631, 632, 580,
+	581, 582, 583, 636, 0, 0, 352, 353, 354, 633,
+	636, 0, 636, 636, 636, 636, 633, 633, 633, 636,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1620,
+	1620, 1620, 639, 636, 0, 616, 0, 617, 618, 621,
+	622, 1621, 1622, 1175, 623, 624, 1128, 646, 654, 657,
+	674, 672, 673, 675, 667, 668, 669, 670, 0, 688,
+	689, 694, 0, 0, 0, 0, 700, 701, 702, 0,
+	0, 705, 706, 707, 0, 0, 0, 0, 833, 0,
+	0, 898, 899, 900, 901, 902, 903, 904, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 871, 872,
+	873, 874, 877, 0, 0, 0, 882, 883, 0, 0,
+	0, 0, 0, 723, 0, 997, 0, 924, 0, 925,
+	927, 928, 0, 929, 0, 0, 0, 0, 0, 0,
+	0, 733, 0, 0, 733, 733, 0, 0, 188, 189,
+	190, 191, 205, 206, 207, 208, 209, 258, 1005, 0,
+	908, 0, 0, 44, 678, 679, 0, 796, 1148, 0,
+	0, 729, 0, 55, 64, 66, 1024, 59, 1024, 0,
+	735, 0, 0, -2, -2, 736, 742, 743, 744, 745,
+	746, 52, 1618, 53, 0, 72, 0, 46, 0, 0,
+	1064, 0, 1067, 0, 0, 0, 325, 1071, 0, 0,
+	1017, 1018, 1021, 0, 750, 754, 0, 756, 757, 0,
+	716, 0, 98, 0, 812, 0, 0, 0, 1559, 1126,
+	0, 102, 103, 0, 0, 0, 331, 1131, 1132, 1133,
+	-2, 350, 0, 331, 320, 264, 265, 266, 313, 268,
+	313, 313, 313, 313, 325, 325, 325, 325, 296, 297,
+	298, 299, 300, 0, 0, 283, 313, 313, 313, 313,
+	303, 304, 305, 306, 307, 308, 309, 310, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 315, 315, 315,
+	317, 317, 0, 42, 0, 335, 0, 1021, 0, 1054,
+	1140, 1150, 0, 0, 0, 1140, 120, 0, 0, 491,
+	522, 451, 480, 492, 0, 454, 455, -2, 0, 0,
+	441, 0, 443, 0, 351, 0, -2, 0, 361, 0,
+	357, 361, 358, 361, 349, 362, 472, 473, 474, 0,
+	533, 782, 0, 0, 0, 0, 0, 539, 540, 541,
+	0, 543, 544, 545, 546, 547, 548, 549, 550, 551,
+	552, 481, 482, 483, 484, 485, 486, 487, 0, 0,
+	443, 0, 477, 0, 398, 399, 0, 0, 402, 403,
+	404, 405, 0, 0, 408, 409, 410, 799, 800, 411,
+	435, 436, 437, 412, 413, 414, 415, 416, 417, 418,
+	429, 430, 431, 432, 433, 434, 419, 420, 421, 422,
+	423, 426, 0, 130, 1045, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1138, 0, 0, 0,
+	0, 732, 814, 1161, 1162, 637, 638, 0, 355, 356,
+	636, 636, 584, 607, 0, 636, 588, 609, 589, 591,
+	590, 592, 611, 612, 636, 595, 634, 635, 596, 597,
+	598, 599, 600, 601, 602, 603, 604, 605, 613, 614,
+	615, 640, 0, 0, 655, 659, 660, 661, 662, 0,
+	0, 691, 94, 696, 697, 698, 699, 711, 704, 910,
+	830, 831, 832, 834, 836, 0, 894, 896, 838, 840,
+	905, 906, 907, 0, 0, 0, 0, 0, 844, 848,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 868, 975, 976, 977, 885, 886, 0, 747,
+	0, 0, 0, 884, 0, 0, 0, 0, 724, 725,
+	1003, 1000, 0, 0, 961, 963, 0, 0, 0, 0,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
+	959, 978, 0, 0, 996, 0, 887, 888, 889, 0,
+	0, 0, 734, 1006, 0, -2, 0, 0, 0, 0,
+	0, 0, 0, 1115, 0, 681, 682, 684, 0, 816,
+	0, 797, 0, 0, 1149, 687, 0, 728, 0, 731,
+	58, 60, 740, 741, 0, 758, 737, 54, 49, 0,
+	0, 774, 1065, 1068, 1069, 325, 1091, 0, 329, 330,
+	1027, 1028, 0, 1020, 1022, 1023, 77, 755, 751, 0,
+	828, 0, 0, 811, 0, 761, 763, 764, 765, 794,
+	0, 0, 0, 0, 0, 96, 813, 1121, 101, 0,
+	0, 106, 107, 1122, 1123, 1124, 1125, 0, 511, -2,
+	393, 158, 160, 161, 162, 153, -2, 323, 321, 322,
+	267, 325, 325, 290, 291, 292, 293, 294, 295, 0,
+	0, 284, 285, 286, 287, 278, 0, 279, 280, 281,
+	0, 282, 368, 0, 1029, 336, 337, 339, 343, 0,
+	0, 0, 364, 365, 0, 1045, 0, 0, 0, 1151,
+	1150, 1150, 1150, 0, 148, 149, 150, 151, 528, 0,
+	0, 523, 526, 527, 146, 0, 0, 154, 445, 444,
+	0, 560, 0, 366, 0, 0, 361, 361, 346, 347,
+	475, 0, 0, 535, 536, 537, 538, 0, 0, 0,
+	443, 445, 331, 0, 400, 401, 406, 407, 424, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	505, 506, 507, 508, 509, 510, 1042, 1043, 1044, 0,
+	0, 568, 0, 0, 385, 92, 1139, 573, 633, 594,
+	610, 633, 586, 593, 619, 651, 695, 0, 0, 0,
+	0, 703, 0, 837, 895, 897, 841, 0, 845, 849,
+	0, 0, 0, 0, 0, 0, 0, 891, 915, 916,
+	0, 0, 1015, 0, 1001, 0, 0, 923, 964, 0,
+	0, 0, 0, 0, 934, 935, 0, 937, 0, 911,
+	0, 0, 733, 0, 965, 966, 967, 968, 969, 1006,
+	680, 683, 685, 770, 817, 818, 0, 0, 0, 0,
+	798, 1147, 726, 727, 730, -2, 0, 0, 1008, 0,
+	0, 758, 828, 759, 0, 738, 51, 773, 0, 1073,
+	1072, 1085, 1098, 326, 327, 328, 1019, 0, 1024, 0,
+	1109, 0, 0, 1101, 0, 0, 0, 0, 0, 0,
+	0, 0, 801, 0, 0, 804, 0, 0, 0, 0,
+	795, 0, 0, 0, 0, 0, -2, 0, 0, 90,
+	91, 0, 0, 0, 104, 105, 0, 0, 111, 332,
+	333, 139, 146, 395, 159, 375, 0, 0, 263, 324,
+	288, 289, 0, 311, 0, 0, 0, 388, 116, 1033,
+	1032, 343, 343, 338, 0, 341, 0, 1152, 314, 0,
+	129, 0, 0, 0, 0, 0, 135, 517, 0, 0,
+	524, 0, 0, 0, 449, 0, 460, 461, 0, 532,
+	559, 0, 564, 335, 0, 345, 348, 783, 0, 0,
+	462, 0, 445, 466, 467, 478, 427, 428, 425, 0,
+	0, 1055, 1056, 1061, 1059, 1060, 121, 498, 500, 499,
+	503, 0, 0, 496, 0, 385, 1029, 0, 572, 386,
+	387, 636, 636, 690, 95, 0, 693, 0, 0, 0,
+	842, 846, 748, 0, 0, 313, 313, 983, 313, 317,
+	986, 313, 988, 313, 991, 313, 994, 995, 0, 0,
+	0, 0, 998, 922, 1004, 0, 0, 0, 0, 933,
+	0, 0, 0, 0, 912, 913, 0, 1007, 819, 824,
+	824, 824, 0, 0, 0, 1134, 1135, 1009, 1010, 828,
+	1011, 760, 739, 775, 1091, 0, 1084, 0, -2, 1093,
+	0, 0, 0, 1099, 752, 78, 829, 81, 0, 1109,
+	1117, 0, 1100, 1111, 1113, 0, 0, 0, 1105, 0,
+	828, 762, 790, 792, 0, 787, 802, 803, 805, 0,
+	807, 0, 809, 810, 772, 767, 768, 0, 0, 98,
+	0, 828, 828, 97, 0, 815, 108, 109, 110, 394,
+	163, 168, 0, 0, 0, 173, 0, 175, 0, 376,
+	0, 260, 262, 0, 0, 166, 325, 0, 325, 0,
+	318, 0, 377, 389, 0, 1030, 1031, 0, 0, 340,
+	342, 0, 1141, 131, 0, 0, 0, 520, 0, 529,
+	0, 0, 0, 0, 0, 0, 155, 446, 0, 0,
+	334, 0, 0, 557, 554, 463, 0, 192, 193, 195,
+	0, 0, 465, 772, 1046, 1047, 1048, 0, 1058, 1062,
+	124, 0, 0, 0, 0, 569, 570, 571, 93, 579,
+	585, 692, 712, 843, 847, 0, 879, 981, 325, 984,
+	985, 987, 989, 990, 992, 993, 880, 881, 0, 918,
+	0, 920, 1024, 0, 1002, 0, 0, 932, 0, 0,
+	0, 938, 914, 0, 0, 0, 0, 796, -2, 62,
+	1011, 1013, 0, 1078, 1076, 1076, 1086, 1087, 0, 0,
+	1094, 0, 0, 0, 0, 82, 0, 0, 0, 1114,
+	0, 0, 0, 0, 99, 1015, 784, 791, 0, 0,
+	785, 0, 786, 806, 808, 766, -2, 769, 828, 828,
+	88, 89, 0, 169, 0, 171, 186, 187, 0, 174,
+	176, 177, 259, 261, 0, 0, 301, 312, 302, 0,
+	0, 390, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 772,
+	132, 133, 134, 512, 0, 522, 0, 0, 0, 515,
+	0, 452, 0, 563, 0, 0, 534, 0, 542, 0,
+	0, 0, 196, 197, 0, 1057, 497, 0, 122, 123,
+	0, 0, 502, 970, 982, 0, 0, 0, 999, 0,
+	0, 0, 0, 936, 0, 825, 0, 0, 0, 0,
+	816, 1013, 80, 0, 0, 1081, 0, 1074, 1077, 1075,
+	1088, 0, 0, 1095, 0, 1097, 0, 1118, 1119, 1112,
+	0, 1104, 1107, 1103, 1106, 1024, 788, 0, 793, 1015,
+	87, 0, 172, 0, 0, 0, 0, 0, 0, 316,
+	319, 0, 0, 0, 513, 0, 525, 516, 561, 562,
+	0, 565, 370, 558, 555, 556, 464, 0, 125, 126,
+	0, 0, 0, 0, 0, 0, 917, 919, 921, 930,
+	931, 979, 980, 0, 821, 778, 779, 826, 827, 0,
+	0, 771, 79, 1014, 1012, 1070, 0, 1079, 1080, 1089,
+	1090, 1096, 0, 1102, 0, 85, 0, 1024, 170, 0,
+	180, 0, 521, 0, 524, 514, 0, 1049, 0, 0,
+	0, 504, 878, 0, 0, 0, 0, 820, 822, 823,
+	1082, 1083, 1110, 1108, 789, 86, 371, 375, 1049, 0,
+	0, 566, 494, 1051, 127, 128, 438, 439, 440, 121,
+	971, 0, 974, 780, 781, 372, 373, 0, 164, 181,
+	182, 0, 0, 185, 178, 179, 117, 0, 0, 0,
+	1052, 1053, 124, 972, 374, 0, 0, 0, 183, 184,
+	530, 0, 0, 501, 0, 378, 380, 381, 0, 0,
+	379, 518, 0, 530, 1050, 0, 382, 383, 384, 531,
+	519, 973,
 }
 
 var yyTok1 = [...]int{
@@ -6583,7 +6614,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 128, 3, 3, 3, 154, 146, 3,
 	83, 85, 151, 149, 84, 150, 203, 152, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 564,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 565,
 	136, 135, 137, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -6680,7 +6711,8 @@ var yyTok3 = [...]int{
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
 	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
-	57885, 560, 57886, 561, 57887, 562, 57888, 563, 0,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -10511,7 +10543,7 @@ yydefault:
 //line sql.y:2775
 		{
 			yyLOCAL = &AlterMigration{
-				Type: CancelMigrationType,
+				Type: CutOverMigrationType,
 				UUID: string(yyDollar[4].str),
 			}
 		}
@@ -10522,22 +10554,33 @@ yydefault:
 //line sql.y:2782
 		{
 			yyLOCAL = &AlterMigration{
-				Type: CancelAllMigrationType,
+				Type: CancelMigrationType,
+				UUID: string(yyDollar[4].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
 	case 510:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2789
+		{
+			yyLOCAL = &AlterMigration{
+				Type: CancelAllMigrationType,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2789
+//line sql.y:2796
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 512:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2793
+//line sql.y:2800
 		{
 			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].integerUnion()
 			yyDollar[3].partitionOptionUnion().SubPartition = yyDollar[5].subPartitionUnion()
@@ -10545,10 +10588,10 @@ yydefault:
 			yyLOCAL = yyDollar[3].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2802
+//line sql.y:2809
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear: yyDollar[1].booleanUnion(),
@@ -10557,10 +10600,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 514:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2810
+//line sql.y:2817
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear:     yyDollar[1].booleanUnion(),
@@ -10570,10 +10613,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 515:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2819
+//line sql.y:2826
 		{
 			yyLOCAL = &PartitionOption{
 				Type: yyDollar[1].partitionByTypeUnion(),
@@ -10581,10 +10624,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 516:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2826
+//line sql.y:2833
 		{
 			yyLOCAL = &PartitionOption{
 				Type:    yyDollar[1].partitionByTypeUnion(),
@@ -10592,18 +10635,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2834
+//line sql.y:2841
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 518:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2838
+//line sql.y:2845
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10613,10 +10656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 519:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2847
+//line sql.y:2854
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10627,335 +10670,335 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2858
+//line sql.y:2865
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2862
+//line sql.y:2869
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2867
+//line sql.y:2874
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2871
+//line sql.y:2878
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2876
+//line sql.y:2883
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2880
+//line sql.y:2887
 		{
 			yyLOCAL = convertStringToInt(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2886
+//line sql.y:2893
 		{
 			yyLOCAL = RangeType
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2890
+//line sql.y:2897
 		{
 			yyLOCAL = ListType
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2895
+//line sql.y:2902
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2899
+//line sql.y:2906
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2904
+//line sql.y:2911
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2908
+//line sql.y:2915
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 532:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2914
+//line sql.y:2921
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2918
+//line sql.y:2925
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 534:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2922
+//line sql.y:2929
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2926
+//line sql.y:2933
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2930
+//line sql.y:2937
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2934
+//line sql.y:2941
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2938
+//line sql.y:2945
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2942
+//line sql.y:2949
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2946
+//line sql.y:2953
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2950
+//line sql.y:2957
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 542:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2954
+//line sql.y:2961
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2958
+//line sql.y:2965
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2962
+//line sql.y:2969
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2966
+//line sql.y:2973
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2970
+//line sql.y:2977
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2974
+//line sql.y:2981
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2978
+//line sql.y:2985
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2982
+//line sql.y:2989
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2986
+//line sql.y:2993
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2990
+//line sql.y:2997
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2994
+//line sql.y:3001
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2998
+//line sql.y:3005
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3003
+//line sql.y:3010
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3007
+//line sql.y:3014
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3011
+//line sql.y:3018
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:3018
+//line sql.y:3025
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3022
+//line sql.y:3029
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 558:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3028
+//line sql.y:3035
 		{
 			yyVAL.partDefUnion().ValueRange = yyDollar[2].partitionValueRangeUnion()
 		}
-	case 559:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3033
+//line sql.y:3040
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 561:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3037
+//line sql.y:3044
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  LessThanType,
@@ -10963,10 +11006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3044
+//line sql.y:3051
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:     LessThanType,
@@ -10974,10 +11017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3051
+//line sql.y:3058
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  InType,
@@ -10985,61 +11028,61 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:3060
+//line sql.y:3067
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3066
+//line sql.y:3073
 		{
 			yyVAL.str = ""
 		}
-	case 565:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3070
+//line sql.y:3077
 		{
 			yyVAL.str = ""
 		}
-	case 566:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3076
+//line sql.y:3083
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:3082
+//line sql.y:3089
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 569:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3086
+//line sql.y:3093
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 569:
+	case 570:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3092
+//line sql.y:3099
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 571:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3096
+//line sql.y:3103
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -11049,404 +11092,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 572:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3105
+//line sql.y:3112
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), Comments: Comments(yyDollar[2].strs), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3109
+//line sql.y:3116
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3115
+//line sql.y:3122
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3119
+//line sql.y:3126
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3124
+//line sql.y:3131
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3130
+//line sql.y:3137
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3134
+//line sql.y:3141
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 579:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3138
+//line sql.y:3145
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3142
+//line sql.y:3149
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3146
+//line sql.y:3153
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3150
+//line sql.y:3157
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3154
+//line sql.y:3161
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3158
+//line sql.y:3165
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 585:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3162
+//line sql.y:3169
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 586:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3166
+//line sql.y:3173
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3170
+//line sql.y:3177
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3174
+//line sql.y:3181
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3178
+//line sql.y:3185
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3182
+//line sql.y:3189
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3186
+//line sql.y:3193
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3190
+//line sql.y:3197
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 593:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3194
+//line sql.y:3201
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 594:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3198
+//line sql.y:3205
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3202
+//line sql.y:3209
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3206
+//line sql.y:3213
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3210
+//line sql.y:3217
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3214
+//line sql.y:3221
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3218
+//line sql.y:3225
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3222
+//line sql.y:3229
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3226
+//line sql.y:3233
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3230
+//line sql.y:3237
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3234
+//line sql.y:3241
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3238
+//line sql.y:3245
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3242
+//line sql.y:3249
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3246
+//line sql.y:3253
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3250
+//line sql.y:3257
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3254
+//line sql.y:3261
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3258
+//line sql.y:3265
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 610:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3262
+//line sql.y:3269
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3266
+//line sql.y:3273
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3270
+//line sql.y:3277
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3274
+//line sql.y:3281
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3279
+//line sql.y:3286
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 615:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3283
+//line sql.y:3290
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3287
+//line sql.y:3294
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3292
+//line sql.y:3299
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3296
+//line sql.y:3303
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 619:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3300
+//line sql.y:3307
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3304
+//line sql.y:3311
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3309
+//line sql.y:3316
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -11454,1329 +11497,1329 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3323
+//line sql.y:3330
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3327
+//line sql.y:3334
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3331
+//line sql.y:3338
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3337
+//line sql.y:3344
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 625:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3341
+//line sql.y:3348
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 626:
+	case 627:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3347
+//line sql.y:3354
 		{
 			yyVAL.str = ""
 		}
-	case 627:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3351
+//line sql.y:3358
 		{
 			yyVAL.str = "extended "
 		}
-	case 628:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3357
+//line sql.y:3364
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3361
+//line sql.y:3368
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3367
+//line sql.y:3374
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 631:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3371
+//line sql.y:3378
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 632:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3377
+//line sql.y:3384
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 633:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3381
+//line sql.y:3388
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 634:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3385
+//line sql.y:3392
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 635:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3391
+//line sql.y:3398
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3395
+//line sql.y:3402
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3399
+//line sql.y:3406
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3405
+//line sql.y:3412
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3409
+//line sql.y:3416
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 641:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3415
+//line sql.y:3422
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3419
+//line sql.y:3426
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3423
+//line sql.y:3430
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3429
+//line sql.y:3436
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3433
+//line sql.y:3440
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3437
+//line sql.y:3444
 		{
 			yyLOCAL = &Use{DBName: NewTableIdent(yyDollar[2].tableIdent.String() + "@" + string(yyDollar[3].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3443
+//line sql.y:3450
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3447
+//line sql.y:3454
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3453
+//line sql.y:3460
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3459
+//line sql.y:3466
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 651:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3463
+//line sql.y:3470
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3468
+//line sql.y:3475
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3470
+//line sql.y:3477
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3473
+//line sql.y:3480
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3475
+//line sql.y:3482
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3480
+//line sql.y:3487
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3486
+//line sql.y:3493
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3491
+//line sql.y:3498
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3495
+//line sql.y:3502
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3499
+//line sql.y:3506
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3503
+//line sql.y:3510
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3507
+//line sql.y:3514
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3511
+//line sql.y:3518
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3517
+//line sql.y:3524
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 664:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3521
+//line sql.y:3528
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 665:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:3532
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 666:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3531
+//line sql.y:3538
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3535
+//line sql.y:3542
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3539
+//line sql.y:3546
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3543
+//line sql.y:3550
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3548
+//line sql.y:3555
 		{
 			yyVAL.str = ""
 		}
-	case 671:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3552
+//line sql.y:3559
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 672:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3556
+//line sql.y:3563
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 673:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3562
+//line sql.y:3569
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3566
+//line sql.y:3573
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3572
+//line sql.y:3579
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3576
+//line sql.y:3583
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3582
+//line sql.y:3589
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3588
+//line sql.y:3595
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3592
+//line sql.y:3599
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 680:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3598
+//line sql.y:3605
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3604
+//line sql.y:3611
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3608
+//line sql.y:3615
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3612
+//line sql.y:3619
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3616
+//line sql.y:3623
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3622
+//line sql.y:3629
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 687:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3628
+//line sql.y:3635
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3634
+//line sql.y:3641
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3638
+//line sql.y:3645
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 690:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3642
+//line sql.y:3649
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 691:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3646
+//line sql.y:3653
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 692:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3650
+//line sql.y:3657
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 693:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3654
+//line sql.y:3661
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3660
+//line sql.y:3667
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 694:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3664
+//line sql.y:3671
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 695:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3670
-		{
-			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
-		}
 	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3674
+//line sql.y:3677
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3678
+//line sql.y:3681
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3682
+//line sql.y:3685
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 699:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3686
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3689
 		{
-			yyVAL.str = string(yyDollar[1].str)
+			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3690
+//line sql.y:3693
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3694
+//line sql.y:3697
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 702:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3701
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3698
+//line sql.y:3705
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 703:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3702
+//line sql.y:3709
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 704:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3706
+//line sql.y:3713
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 705:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3710
+//line sql.y:3717
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 706:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3714
+//line sql.y:3721
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 707:
+	case 708:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3719
+//line sql.y:3726
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3723
+//line sql.y:3730
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3727
+//line sql.y:3734
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3732
+//line sql.y:3739
 		{
 			yyVAL.str = ""
 		}
-	case 711:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3736
+//line sql.y:3743
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 712:
+	case 713:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3741
+//line sql.y:3748
 		{
 			setAllowComments(yylex, true)
 		}
-	case 713:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3745
+//line sql.y:3752
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 714:
+	case 715:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3751
+//line sql.y:3758
 		{
 			yyVAL.strs = nil
 		}
-	case 715:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3755
+//line sql.y:3762
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 716:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3761
+//line sql.y:3768
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3765
+//line sql.y:3772
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3769
+//line sql.y:3776
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 720:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3774
+//line sql.y:3781
 		{
 			yyVAL.str = ""
 		}
-	case 720:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3778
+//line sql.y:3785
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 721:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3782
+//line sql.y:3789
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 722:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3787
+//line sql.y:3794
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3791
+//line sql.y:3798
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3795
+//line sql.y:3802
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 726:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3801
+//line sql.y:3808
 		{
 			yyLOCAL = &PrepareStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, Statement: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 727:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3805
+//line sql.y:3812
 		{
 			yyLOCAL = &PrepareStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, StatementIdentifier: NewColIdentWithAt(string(yyDollar[5].str), SingleAt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 728:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3811
+//line sql.y:3818
 		{
 			yyLOCAL = &ExecuteStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, Arguments: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3816
+//line sql.y:3823
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3820
+//line sql.y:3827
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 731:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3826
+//line sql.y:3833
 		{
 			yyLOCAL = &DeallocateStmt{Type: DeallocateType, Comments: yyDollar[2].strs, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 732:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3830
+//line sql.y:3837
 		{
 			yyLOCAL = &DeallocateStmt{Type: DropType, Comments: yyDollar[2].strs, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 733:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3835
+//line sql.y:3842
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3839
+//line sql.y:3846
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 735:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3844
+//line sql.y:3851
 		{
 			yyVAL.strs = nil
 		}
-	case 735:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3848
+//line sql.y:3855
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 736:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3852
+//line sql.y:3859
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 737:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3856
+//line sql.y:3863
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 738:
+	case 739:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3860
+//line sql.y:3867
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 739:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3866
+//line sql.y:3873
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 740:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3870
+//line sql.y:3877
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 741:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3874
+//line sql.y:3881
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 742:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3878
+//line sql.y:3885
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 743:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3882
+//line sql.y:3889
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 744:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3886
+//line sql.y:3893
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 745:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3890
+//line sql.y:3897
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 746:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3896
+//line sql.y:3903
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3900
+//line sql.y:3907
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 748:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3906
+//line sql.y:3913
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3910
+//line sql.y:3917
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3914
+//line sql.y:3921
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 752:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3918
+//line sql.y:3925
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3923
+//line sql.y:3930
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 753:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3927
+//line sql.y:3934
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 754:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3931
+//line sql.y:3938
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 756:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3938
+//line sql.y:3945
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 757:
+	case 758:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3943
+//line sql.y:3950
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 759:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3947
+//line sql.y:3954
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3953
+//line sql.y:3960
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3959
+//line sql.y:3966
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3963
+//line sql.y:3970
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 764:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3973
+//line sql.y:3980
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 766:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3977
+//line sql.y:3984
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3981
+//line sql.y:3988
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3987
+//line sql.y:3994
 		{
 			yyLOCAL = &DerivedTable{Lateral: false, Select: yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 769:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3991
+//line sql.y:3998
 		{
 			yyLOCAL = &DerivedTable{Lateral: true, Select: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3997
+//line sql.y:4004
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 771:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:4001
+//line sql.y:4008
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 772:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4006
+//line sql.y:4013
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 773:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4010
+//line sql.y:4017
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4016
+//line sql.y:4023
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4020
+//line sql.y:4027
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 775:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4026
+//line sql.y:4033
 		{
 			yyLOCAL = Columns{NewColIdentWithAt(string(yyDollar[1].str), SingleAt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4030
+//line sql.y:4037
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdentWithAt(string(yyDollar[3].str), SingleAt))
 		}
-	case 777:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4036
+//line sql.y:4043
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4040
+//line sql.y:4047
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 780:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4044
+//line sql.y:4051
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 780:
+	case 781:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4048
+//line sql.y:4055
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 781:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:4054
+//line sql.y:4061
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 783:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4058
+//line sql.y:4065
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 783:
+	case 784:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4071
+//line sql.y:4078
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 785:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4075
+//line sql.y:4082
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 786:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4079
+//line sql.y:4086
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 787:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4083
+//line sql.y:4090
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4089
+//line sql.y:4096
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 788:
+	case 789:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4091
+//line sql.y:4098
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 789:
+	case 790:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4095
+//line sql.y:4102
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 790:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4097
+//line sql.y:4104
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 791:
+	case 792:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4101
+//line sql.y:4108
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 792:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4103
+//line sql.y:4110
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 793:
+	case 794:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4106
+//line sql.y:4113
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 794:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4108
+//line sql.y:4115
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 795:
+	case 796:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4111
+//line sql.y:4118
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 796:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4115
+//line sql.y:4122
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 797:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4119
+//line sql.y:4126
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 799:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4126
+//line sql.y:4133
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 800:
+	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4132
+//line sql.y:4139
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4136
+//line sql.y:4143
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4140
+//line sql.y:4147
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4146
+//line sql.y:4153
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4152
+//line sql.y:4159
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4156
+//line sql.y:4163
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4160
+//line sql.y:4167
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 808:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4164
+//line sql.y:4171
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4170
+//line sql.y:4177
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4174
+//line sql.y:4181
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -12785,567 +12828,567 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4184
+//line sql.y:4191
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 811:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4188
+//line sql.y:4195
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 812:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4194
+//line sql.y:4201
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 813:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4198
+//line sql.y:4205
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 814:
+	case 815:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4204
+//line sql.y:4211
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 815:
+	case 816:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4209
+//line sql.y:4216
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4213
+//line sql.y:4220
 		{
 			yyLOCAL = yyDollar[1].indexHintsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4219
+//line sql.y:4226
 		{
 			yyLOCAL = IndexHints{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 819:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4223
+//line sql.y:4230
 		{
 			yySLICE := (*IndexHints)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexHintUnion())
 		}
-	case 819:
+	case 820:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4229
+//line sql.y:4236
 		{
 			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 821:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4233
+//line sql.y:4240
 		{
 			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 822:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4237
+//line sql.y:4244
 		{
 			yyLOCAL = &IndexHint{Type: IgnoreOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 823:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4241
+//line sql.y:4248
 		{
 			yyLOCAL = &IndexHint{Type: ForceOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 824:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4246
+//line sql.y:4253
 		{
 			yyLOCAL = NoForType
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4250
+//line sql.y:4257
 		{
 			yyLOCAL = JoinForType
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 826:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4254
+//line sql.y:4261
 		{
 			yyLOCAL = OrderByForType
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4258
+//line sql.y:4265
 		{
 			yyLOCAL = GroupByForType
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 828:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4264
+//line sql.y:4271
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 829:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4268
+//line sql.y:4275
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4275
+//line sql.y:4282
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4279
+//line sql.y:4286
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4283
+//line sql.y:4290
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4287
+//line sql.y:4294
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4291
+//line sql.y:4298
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4295
+//line sql.y:4302
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4302
+//line sql.y:4309
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 837:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4306
+//line sql.y:4313
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4310
+//line sql.y:4317
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4314
+//line sql.y:4321
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4320
+//line sql.y:4327
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4324
+//line sql.y:4331
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 842:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4328
+//line sql.y:4335
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 843:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4332
+//line sql.y:4339
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 844:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4336
+//line sql.y:4343
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 845:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4340
+//line sql.y:4347
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 846:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4344
+//line sql.y:4351
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 847:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4348
+//line sql.y:4355
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4352
+//line sql.y:4359
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 849:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4356
+//line sql.y:4363
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4360
+//line sql.y:4367
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4366
+//line sql.y:4373
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4370
+//line sql.y:4377
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4374
+//line sql.y:4381
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 854:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4378
+//line sql.y:4385
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 855:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4382
+//line sql.y:4389
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4386
+//line sql.y:4393
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 857:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4390
+//line sql.y:4397
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 858:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4394
+//line sql.y:4401
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4398
+//line sql.y:4405
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 860:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4402
+//line sql.y:4409
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4406
+//line sql.y:4413
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 862:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4410
+//line sql.y:4417
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4414
+//line sql.y:4421
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4420
+//line sql.y:4427
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4424
+//line sql.y:4431
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4428
+//line sql.y:4435
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4432
+//line sql.y:4439
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 868:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4436
+//line sql.y:4443
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4440
+//line sql.y:4447
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4444
+//line sql.y:4451
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4448
+//line sql.y:4455
 		{
 			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4452
+//line sql.y:4459
 		{
 			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4456
+//line sql.y:4463
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4460
+//line sql.y:4467
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4464
+//line sql.y:4471
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4468
+//line sql.y:4475
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4472
+//line sql.y:4479
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 878:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4476
+//line sql.y:4483
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 879:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4480
+//line sql.y:4487
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 880:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4484
+//line sql.y:4491
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 881:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4488
+//line sql.y:4495
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4492
+//line sql.y:4499
 		{
 			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
 			// To convert a string expression to a binary string, these constructs are equivalent:
@@ -13354,18 +13397,18 @@ yydefault:
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[2].exprUnion(), Type: &ConvertType{Type: yyDollar[1].str}}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4500
+//line sql.y:4507
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4504
+//line sql.y:4511
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -13374,962 +13417,962 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4512
+//line sql.y:4519
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4516
+//line sql.y:4523
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TrimType
-//line sql.y:4522
+//line sql.y:4529
 		{
 			yyLOCAL = BothTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TrimType
-//line sql.y:4526
+//line sql.y:4533
 		{
 			yyLOCAL = LeadingTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TrimType
-//line sql.y:4530
+//line sql.y:4537
 		{
 			yyLOCAL = TrailingTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4536
+//line sql.y:4543
 		{
 			yyVAL.str = ""
 		}
-	case 890:
+	case 891:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4540
+//line sql.y:4547
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 891:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4546
+//line sql.y:4553
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4550
+//line sql.y:4557
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4557
+//line sql.y:4564
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4561
+//line sql.y:4568
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4565
+//line sql.y:4572
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 897:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4569
+//line sql.y:4576
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4575
+//line sql.y:4582
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4579
+//line sql.y:4586
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4583
+//line sql.y:4590
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4587
+//line sql.y:4594
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4591
+//line sql.y:4598
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4595
+//line sql.y:4602
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4599
+//line sql.y:4606
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4605
+//line sql.y:4612
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4609
+//line sql.y:4616
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4613
+//line sql.y:4620
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:4620
+//line sql.y:4627
 		{
 			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4626
+//line sql.y:4633
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4630
+//line sql.y:4637
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 910:
+	case 911:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4640
+//line sql.y:4647
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 912:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4644
+//line sql.y:4651
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 913:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4648
+//line sql.y:4655
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 914:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4652
+//line sql.y:4659
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 915:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4662
+//line sql.y:4669
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 916:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4666
+//line sql.y:4673
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 917:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4670
+//line sql.y:4677
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 918:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4674
+//line sql.y:4681
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 919:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4678
+//line sql.y:4685
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 920:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4682
+//line sql.y:4689
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 921:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4686
+//line sql.y:4693
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 922:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4690
+//line sql.y:4697
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 923:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4694
+//line sql.y:4701
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4698
+//line sql.y:4705
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 925:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4709
+//line sql.y:4716
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4713
+//line sql.y:4720
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4719
+//line sql.y:4726
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4723
+//line sql.y:4730
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 929:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4728
+//line sql.y:4735
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 930:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4732
+//line sql.y:4739
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 931:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4736
+//line sql.y:4743
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 932:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4740
+//line sql.y:4747
 		{
 			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 933:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4744
+//line sql.y:4751
 		{
 			yyLOCAL = &WeightStringFuncExpr{Expr: yyDollar[3].exprUnion(), As: yyDollar[4].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4748
+//line sql.y:4755
 		{
 			yyLOCAL = &TrimFuncExpr{TrimFuncType: LTrimType, StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 935:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4752
+//line sql.y:4759
 		{
 			yyLOCAL = &TrimFuncExpr{TrimFuncType: RTrimType, StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 936:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4756
+//line sql.y:4763
 		{
 			yyLOCAL = &TrimFuncExpr{Type: yyDollar[3].trimTypeUnion(), TrimArg: yyDollar[4].exprUnion(), StringArg: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 937:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4760
+//line sql.y:4767
 		{
 			yyLOCAL = &TrimFuncExpr{StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 938:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4764
+//line sql.y:4771
 		{
 			yyLOCAL = &TrimFuncExpr{TrimArg: yyDollar[3].exprUnion(), StringArg: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4770
+//line sql.y:4777
 		{
 		}
-	case 939:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4772
+//line sql.y:4779
 		{
 			yyLOCAL = IntervalDayHour
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4776
+//line sql.y:4783
 		{
 			yyLOCAL = IntervalDayMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4780
+//line sql.y:4787
 		{
 			yyLOCAL = IntervalDayMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4784
+//line sql.y:4791
 		{
 			yyLOCAL = IntervalDaySecond
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4788
+//line sql.y:4795
 		{
 			yyLOCAL = IntervalHourMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4792
+//line sql.y:4799
 		{
 			yyLOCAL = IntervalHourMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4796
+//line sql.y:4803
 		{
 			yyLOCAL = IntervalHourSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4800
+//line sql.y:4807
 		{
 			yyLOCAL = IntervalMinuteMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4804
+//line sql.y:4811
 		{
 			yyLOCAL = IntervalMinuteSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4808
+//line sql.y:4815
 		{
 			yyLOCAL = IntervalSecondMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4812
+//line sql.y:4819
 		{
 			yyLOCAL = IntervalYearMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4818
+//line sql.y:4825
 		{
 			yyLOCAL = IntervalDay
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4822
+//line sql.y:4829
 		{
 			yyLOCAL = IntervalWeek
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4826
+//line sql.y:4833
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4830
+//line sql.y:4837
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4834
+//line sql.y:4841
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4838
+//line sql.y:4845
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4842
+//line sql.y:4849
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4846
+//line sql.y:4853
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4850
+//line sql.y:4857
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4860
+//line sql.y:4867
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 963:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4864
+//line sql.y:4871
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4868
+//line sql.y:4875
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 965:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4878
+//line sql.y:4885
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 966:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4882
+//line sql.y:4889
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 967:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4886
+//line sql.y:4893
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 968:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4890
+//line sql.y:4897
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 969:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4894
+//line sql.y:4901
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 970:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4900
+//line sql.y:4907
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4904
+//line sql.y:4911
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4908
+//line sql.y:4915
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 973:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4912
+//line sql.y:4919
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4916
+//line sql.y:4923
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4922
+//line sql.y:4929
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 975:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4926
+//line sql.y:4933
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 976:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4930
+//line sql.y:4937
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 977:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4936
+//line sql.y:4943
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 979:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4940
+//line sql.y:4947
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 980:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4944
+//line sql.y:4951
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4950
+//line sql.y:4957
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4954
+//line sql.y:4961
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4958
+//line sql.y:4965
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 984:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4962
+//line sql.y:4969
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 985:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4966
+//line sql.y:4973
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4972
+//line sql.y:4979
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4976
+//line sql.y:4983
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4980
+//line sql.y:4987
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4984
+//line sql.y:4991
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4988
+//line sql.y:4995
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4992
+//line sql.y:4999
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4996
+//line sql.y:5003
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:5000
+//line sql.y:5007
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:5004
+//line sql.y:5011
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:5008
+//line sql.y:5015
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5014
+//line sql.y:5021
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5018
+//line sql.y:5025
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 998:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5023
+//line sql.y:5030
 		{
 			yyVAL.str = string("")
 		}
-	case 998:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5027
+//line sql.y:5034
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 999:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:5033
+//line sql.y:5040
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5037
+//line sql.y:5044
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 1001:
+	case 1002:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:5043
+//line sql.y:5050
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1003:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5048
+//line sql.y:5055
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5052
+//line sql.y:5059
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5058
+//line sql.y:5065
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1006:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5062
+//line sql.y:5069
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1007:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5066
+//line sql.y:5073
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5072
+//line sql.y:5079
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -14339,380 +14382,380 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1009:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5081
+//line sql.y:5088
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5085
+//line sql.y:5092
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5091
+//line sql.y:5098
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5095
+//line sql.y:5102
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1013:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5100
+//line sql.y:5107
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1014:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5104
+//line sql.y:5111
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5109
+//line sql.y:5116
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5113
+//line sql.y:5120
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1017:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5119
+//line sql.y:5126
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5125
+//line sql.y:5132
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5129
+//line sql.y:5136
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 1019:
+	case 1020:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:5135
+//line sql.y:5142
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1021:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5140
+//line sql.y:5147
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5144
+//line sql.y:5151
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5148
+//line sql.y:5155
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1024:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5153
+//line sql.y:5160
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5157
+//line sql.y:5164
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1026:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5163
+//line sql.y:5170
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1027:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5167
+//line sql.y:5174
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1028:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5171
+//line sql.y:5178
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1029:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5176
+//line sql.y:5183
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1030:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5180
+//line sql.y:5187
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1031:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5184
+//line sql.y:5191
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5188
+//line sql.y:5195
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5192
+//line sql.y:5199
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1034:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5199
+//line sql.y:5206
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5203
+//line sql.y:5210
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5207
+//line sql.y:5214
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5211
+//line sql.y:5218
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5217
+//line sql.y:5224
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5221
+//line sql.y:5228
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5225
+//line sql.y:5232
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1041:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5230
+//line sql.y:5237
 		{
 			yyVAL.str = ""
 		}
-	case 1041:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5234
+//line sql.y:5241
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1042:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5238
+//line sql.y:5245
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1043:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5242
+//line sql.y:5249
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1044:
+	case 1045:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5247
+//line sql.y:5254
 		{
 			yyVAL.str = ""
 		}
-	case 1045:
+	case 1046:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5251
+//line sql.y:5258
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1046:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5257
+//line sql.y:5264
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1047:
+	case 1048:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5261
+//line sql.y:5268
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1048:
+	case 1049:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5266
+//line sql.y:5273
 		{
 			yyVAL.str = ""
 		}
-	case 1049:
+	case 1050:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5270
+//line sql.y:5277
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1050:
+	case 1051:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5275
+//line sql.y:5282
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 1051:
+	case 1052:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5279
+//line sql.y:5286
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1052:
+	case 1053:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5283
+//line sql.y:5290
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1053:
+	case 1054:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5288
+//line sql.y:5295
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1055:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5292
+//line sql.y:5299
 		{
 			yyLOCAL = yyDollar[3].definerUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5298
+//line sql.y:5305
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1057:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5304
+//line sql.y:5311
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1058:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5310
+//line sql.y:5317
 		{
 			yyLOCAL = &Definer{
 				Name:    yyDollar[1].str,
@@ -14720,361 +14763,401 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5319
+//line sql.y:5326
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 1059:
+	case 1060:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5323
+//line sql.y:5330
 		{
 			yyVAL.str = formatIdentifier(yyDollar[1].str)
 		}
-	case 1060:
+	case 1061:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5328
+//line sql.y:5335
 		{
 			yyVAL.str = ""
 		}
-	case 1061:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5332
+//line sql.y:5339
 		{
 			yyVAL.str = formatAddress(yyDollar[1].str)
 		}
-	case 1062:
+	case 1063:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5338
+//line sql.y:5345
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1064:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5349
+		{
+			yyLOCAL = ForUpdateLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 1065:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5342
+//line sql.y:5353
+		{
+			yyLOCAL = ForUpdateLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 1066:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5357
+		{
+			yyLOCAL = ForShareLock
+		}
+		yyVAL.union = yyLOCAL
+	case 1067:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5361
+		{
+			yyLOCAL = ForShareLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 1068:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5365
+		{
+			yyLOCAL = ForShareLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 1069:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5369
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1070:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5348
+//line sql.y:5375
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1071:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5352
+//line sql.y:5379
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1072:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5356
+//line sql.y:5383
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1073:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5361
+//line sql.y:5388
 		{
 			yyVAL.str = ""
 		}
-	case 1068:
+	case 1074:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5365
+//line sql.y:5392
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 1069:
+	case 1075:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5369
+//line sql.y:5396
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 1070:
+	case 1076:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5374
+//line sql.y:5401
 		{
 			yyVAL.str = ""
 		}
-	case 1071:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5378
+//line sql.y:5405
 		{
 			yyVAL.str = " header"
 		}
-	case 1072:
+	case 1078:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5383
+//line sql.y:5410
 		{
 			yyVAL.str = ""
 		}
-	case 1073:
+	case 1079:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5387
+//line sql.y:5414
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 1074:
+	case 1080:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5391
+//line sql.y:5418
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 1075:
+	case 1081:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5396
+//line sql.y:5423
 		{
 			yyVAL.str = ""
 		}
-	case 1076:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5400
+//line sql.y:5427
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 1077:
+	case 1083:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5404
+//line sql.y:5431
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 1078:
+	case 1084:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5410
+//line sql.y:5437
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1079:
+	case 1085:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5415
+//line sql.y:5442
 		{
 			yyVAL.str = ""
 		}
-	case 1080:
+	case 1086:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5419
+//line sql.y:5446
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 1081:
+	case 1087:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5425
+//line sql.y:5452
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1082:
+	case 1088:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5429
+//line sql.y:5456
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1083:
+	case 1089:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5435
+//line sql.y:5462
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1084:
+	case 1090:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5439
+//line sql.y:5466
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1085:
+	case 1091:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5444
+//line sql.y:5471
 		{
 			yyVAL.str = ""
 		}
-	case 1086:
+	case 1092:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5448
+//line sql.y:5475
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1087:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5454
+//line sql.y:5481
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1088:
+	case 1094:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5458
+//line sql.y:5485
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1089:
+	case 1095:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5464
+//line sql.y:5491
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1090:
+	case 1096:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5468
+//line sql.y:5495
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1091:
+	case 1097:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5472
+//line sql.y:5499
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1092:
+	case 1098:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5477
+//line sql.y:5504
 		{
 			yyVAL.str = ""
 		}
-	case 1093:
+	case 1099:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5481
+//line sql.y:5508
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1094:
+	case 1100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5494
+//line sql.y:5521
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5498
+//line sql.y:5525
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1102:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5502
+//line sql.y:5529
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1103:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5506
+//line sql.y:5533
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1104:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5510
+//line sql.y:5537
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5516
+//line sql.y:5543
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1106:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5520
+//line sql.y:5547
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5524
+//line sql.y:5551
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1102:
+	case 1108:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5528
+//line sql.y:5555
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1103:
+	case 1109:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5533
+//line sql.y:5560
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1110:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5537
+//line sql.y:5564
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5543
+//line sql.y:5570
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5547
+//line sql.y:5574
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1107:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5553
+//line sql.y:5580
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5557
+//line sql.y:5584
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5563
+//line sql.y:5590
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5568
+//line sql.y:5595
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -15083,329 +15166,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5578
+//line sql.y:5605
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5582
+//line sql.y:5609
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1113:
+	case 1119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5588
+//line sql.y:5615
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5594
+//line sql.y:5621
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5598
+//line sql.y:5625
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1116:
+	case 1122:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5604
+//line sql.y:5631
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5608
+//line sql.y:5635
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5612
+//line sql.y:5639
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5616
+//line sql.y:5643
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5620
+//line sql.y:5647
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5628
+//line sql.y:5655
 		{
 			yyVAL.str = "charset"
 		}
-	case 1125:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5638
+//line sql.y:5665
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5642
+//line sql.y:5669
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5646
+//line sql.y:5673
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1136:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5655
+//line sql.y:5682
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5657
+//line sql.y:5684
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5660
+//line sql.y:5687
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5662
+//line sql.y:5689
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1140:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5665
+//line sql.y:5692
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5667
+//line sql.y:5694
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1142:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5670
+//line sql.y:5697
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5672
+//line sql.y:5699
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5675
+//line sql.y:5702
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1139:
+	case 1145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5677
+//line sql.y:5704
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1140:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5679
+//line sql.y:5706
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1141:
+	case 1147:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5683
+//line sql.y:5710
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1148:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5688
+//line sql.y:5715
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5692
+//line sql.y:5719
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1150:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5697
+//line sql.y:5724
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5699
+//line sql.y:5726
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5703
+//line sql.y:5730
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5709
+//line sql.y:5736
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1148:
+	case 1154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5713
+//line sql.y:5740
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1150:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5720
+//line sql.y:5747
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1151:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5726
+//line sql.y:5753
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1152:
+	case 1158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5730
+//line sql.y:5757
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1153:
+	case 1159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5736
+//line sql.y:5763
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1154:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5740
+//line sql.y:5767
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1156:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5747
+//line sql.y:5774
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1610:
+	case 1617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6225
+//line sql.y:6253
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1611:
+	case 1618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6234
+//line sql.y:6262
 		{
 			decNesting(yylex)
 		}
-	case 1612:
+	case 1619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6239
+//line sql.y:6267
 		{
 			skipToEnd(yylex)
 		}
-	case 1613:
+	case 1620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6244
+//line sql.y:6272
 		{
 			skipToEnd(yylex)
 		}
-	case 1614:
+	case 1621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6248
+//line sql.y:6276
 		{
 			skipToEnd(yylex)
 		}
-	case 1615:
+	case 1622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6252
+//line sql.y:6280
 		{
 			skipToEnd(yylex)
 		}
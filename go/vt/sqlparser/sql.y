@@ -249,7 +249,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
 
 // Migration tokens
-%token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE CLEANUP
+%token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE CLEANUP CUTOVER
 
 // Transaction Tokens
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
@@ -2771,6 +2771,13 @@ alter_statement:
       UUID: string($4),
     }
   }
+| ALTER comment_opt VITESS_MIGRATION STRING CUTOVER
+  {
+    $$ = &AlterMigration{
+      Type: CutOverMigrationType,
+      UUID: string($4),
+    }
+  }
 | ALTER comment_opt VITESS_MIGRATION STRING CANCEL
   {
     $$ = &AlterMigration{
@@ -5338,6 +5345,26 @@ FOR UPDATE
   {
     $$ = ForUpdateLock
   }
+| FOR UPDATE NOWAIT
+  {
+    $$ = ForUpdateLockNoWait
+  }
+| FOR UPDATE SKIP LOCKED
+  {
+    $$ = ForUpdateLockSkipLocked
+  }
+| FOR SHARE
+  {
+    $$ = ForShareLock
+  }
+| FOR SHARE NOWAIT
+  {
+    $$ = ForShareLockNoWait
+  }
+| FOR SHARE SKIP LOCKED
+  {
+    $$ = ForShareLockSkipLocked
+  }
 | LOCK IN SHARE MODE
   {
     $$ = ShareModeLock
@@ -5951,6 +5978,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CUTOVER
 | DATA
 | DATE
 | DATETIME
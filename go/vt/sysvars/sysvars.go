@@ -52,6 +52,7 @@ var (
 	Names                       = SystemVariable{Name: "names", Default: utf8mb4, IdentifierAsString: true}
 	SessionUUID                 = SystemVariable{Name: "session_uuid", IdentifierAsString: true}
 	SkipQueryPlanCache          = SystemVariable{Name: "skip_query_plan_cache", IsBoolean: true, Default: off}
+	SkipQueryConsolidation      = SystemVariable{Name: "skip_query_consolidation", IsBoolean: true, Default: off}
 	Socket                      = SystemVariable{Name: "socket", Default: off}
 	SQLSelectLimit              = SystemVariable{Name: "sql_select_limit", Default: off, SupportSetVar: true}
 	TransactionMode             = SystemVariable{Name: "transaction_mode", IdentifierAsString: true}
@@ -68,11 +69,21 @@ var (
 	ReadAfterWriteGTID    = SystemVariable{Name: "read_after_write_gtid"}
 	ReadAfterWriteTimeOut = SystemVariable{Name: "read_after_write_timeout"}
 	SessionTrackGTIDs     = SystemVariable{Name: "session_track_gtids", IdentifierAsString: true}
+	ReadYourWrites        = SystemVariable{Name: "read_your_writes", IsBoolean: true, Default: off}
+	ReadAtTimestamp       = SystemVariable{Name: "read_at_timestamp"}
+	IncludeReplicationLag = SystemVariable{Name: "include_replication_lag", IsBoolean: true, Default: off}
+
+	// AllowCrossCellWrites lets the session write to the primaries of the other cells
+	AllowCrossCellWrites = SystemVariable{Name: "allow_cross_cell_writes", IsBoolean: true, Default: off}
+
+	// QueryTags are the key=value tags that attribute the queries of the session to their owners
+	QueryTags = SystemVariable{Name: "vitess_query_tags"}
 
 	VitessAware = []SystemVariable{
 		Autocommit,
 		ClientFoundRows,
 		SkipQueryPlanCache,
+		SkipQueryConsolidation,
 		TxReadOnly,
 		TransactionReadOnly,
 		SQLSelectLimit,
@@ -86,6 +97,11 @@ var (
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
+		ReadYourWrites,
+		ReadAtTimestamp,
+		IncludeReplicationLag,
+		AllowCrossCellWrites,
+		QueryTags,
 	}
 
 	ReadOnly = []SystemVariable{
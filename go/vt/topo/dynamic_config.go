@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the utility methods to manage the dynamic configuration
+// of the components: the values of their flags that are changed at runtime,
+// stored as JSON in the global topo, by component (vtgate, vttablet, ...).
+
+// WatchDynamicConfigData is returned / streamed by WatchDynamicConfig.
+// The WatchDynamicConfig API guarantees exactly one of Value or Err will be set.
+type WatchDynamicConfigData struct {
+	Value map[string]string
+	Err   error
+}
+
+func dynamicConfigPath(component string) string {
+	return path.Join(DynamicConfigPath, component, DynamicConfigFile)
+}
+
+// unmarshalStringMap unpacks the string maps stored as JSON, like the
+// dynamic configuration and the feature flags.
+func unmarshalStringMap(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	if len(data) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, vterrors.Wrapf(err, "unmarshal failed: %s", data)
+	}
+	return values, nil
+}
+
+// getStringMap returns the string map stored in the global cell at
+// nodePath, which is empty if it doesn't exist.
+func (ts *Server) getStringMap(ctx context.Context, nodePath string) (map[string]string, error) {
+	data, _, err := ts.globalCell.Get(ctx, nodePath)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return make(map[string]string), nil
+		}
+		return nil, err
+	}
+	return unmarshalStringMap(data)
+}
+
+// updateStringMap reads the string map stored in the global cell at
+// nodePath, calls update on it, and writes it back. The update is retried if
+// the map was changed concurrently.
+func (ts *Server) updateStringMap(ctx context.Context, nodePath string, update func(map[string]string) error) error {
+	for {
+		values := make(map[string]string)
+		data, version, err := ts.globalCell.Get(ctx, nodePath)
+		switch {
+		case IsErrType(err, NoNode):
+			version = nil
+		case err != nil:
+			return err
+		default:
+			if values, err = unmarshalStringMap(data); err != nil {
+				return err
+			}
+		}
+		if err := update(values); err != nil {
+			return err
+		}
+		data, err = json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if version == nil {
+			_, err = ts.globalCell.Create(ctx, nodePath, data)
+		} else {
+			_, err = ts.globalCell.Update(ctx, nodePath, data, version)
+		}
+		if IsErrType(err, BadVersion) || IsErrType(err, NodeExists) {
+			continue
+		}
+		return err
+	}
+}
+
+// GetDynamicConfig returns the dynamic configuration of a component, which
+// is empty if it was never set.
+func (ts *Server) GetDynamicConfig(ctx context.Context, component string) (map[string]string, error) {
+	return ts.getStringMap(ctx, dynamicConfigPath(component))
+}
+
+// UpdateDynamicConfig reads the dynamic configuration of a component, calls
+// update on it, and writes it back. The update is retried if the
+// configuration was changed concurrently.
+func (ts *Server) UpdateDynamicConfig(ctx context.Context, component string, update func(map[string]string) error) error {
+	return ts.updateStringMap(ctx, dynamicConfigPath(component), update)
+}
+
+// WatchDynamicConfig will set a watch on the dynamic configuration of a
+// component. It has the same contract as Conn.Watch, but it also unpacks
+// the contents. It returns a NoNode error if the configuration was never set.
+func (ts *Server) WatchDynamicConfig(ctx context.Context, component string) (*WatchDynamicConfigData, <-chan *WatchDynamicConfigData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, dynamicConfigPath(component))
+	if current.Err != nil {
+		return &WatchDynamicConfigData{Err: current.Err}, nil, nil
+	}
+	value, err := unmarshalStringMap(current.Contents)
+	if err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchDynamicConfigData{Err: err}, nil, nil
+	}
+
+	changes := make(chan *WatchDynamicConfigData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchDynamicConfigData{Err: wd.Err}
+				return
+			}
+
+			value, err := unmarshalStringMap(wd.Contents)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchDynamicConfigData{Err: err}
+				return
+			}
+			changes <- &WatchDynamicConfigData{Value: value}
+		}
+	}()
+
+	return &WatchDynamicConfigData{Value: value}, changes, cancel
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+)
+
+// This file contains the utility methods to manage the feature flags of the
+// keyspaces, stored as JSON next to the Keyspace record in the global topo.
+
+// WatchFeatureFlagsData is returned / streamed by WatchFeatureFlags.
+// The WatchFeatureFlags API guarantees exactly one of Value or Err will be set.
+type WatchFeatureFlagsData struct {
+	Value map[string]string
+	Err   error
+}
+
+func featureFlagsPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, FeatureFlagsFile)
+}
+
+// GetFeatureFlags returns the feature flags of a keyspace, which are empty
+// if they were never set.
+func (ts *Server) GetFeatureFlags(ctx context.Context, keyspace string) (map[string]string, error) {
+	return ts.getStringMap(ctx, featureFlagsPath(keyspace))
+}
+
+// UpdateFeatureFlags reads the feature flags of a keyspace, calls update on
+// them, and writes them back. The update is retried if the flags were
+// changed concurrently.
+func (ts *Server) UpdateFeatureFlags(ctx context.Context, keyspace string, update func(map[string]string) error) error {
+	return ts.updateStringMap(ctx, featureFlagsPath(keyspace), update)
+}
+
+// DeleteFeatureFlags deletes the feature flags of a keyspace.
+func (ts *Server) DeleteFeatureFlags(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, featureFlagsPath(keyspace), nil)
+}
+
+// WatchFeatureFlags will set a watch on the feature flags of a keyspace. It
+// has the same contract as Conn.Watch, but it also unpacks the contents. It
+// returns a NoNode error if the flags were never set.
+func (ts *Server) WatchFeatureFlags(ctx context.Context, keyspace string) (*WatchFeatureFlagsData, <-chan *WatchFeatureFlagsData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, featureFlagsPath(keyspace))
+	if current.Err != nil {
+		return &WatchFeatureFlagsData{Err: current.Err}, nil, nil
+	}
+	value, err := unmarshalStringMap(current.Contents)
+	if err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchFeatureFlagsData{Err: err}, nil, nil
+	}
+
+	changes := make(chan *WatchFeatureFlagsData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchFeatureFlagsData{Err: wd.Err}
+				return
+			}
+
+			value, err := unmarshalStringMap(wd.Contents)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchFeatureFlagsData{Err: err}
+				return
+			}
+			changes <- &WatchFeatureFlagsData{Value: value}
+		}
+	}()
+
+	return &WatchFeatureFlagsData{Value: value}, changes, cancel
+}
@@ -0,0 +1,697 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// SyncOptions selects the objects that CopyTopos, CompareTopos, Syncer and
+// Cutover work on.
+type SyncOptions struct {
+	Keyspaces         bool
+	Shards            bool
+	ShardReplications bool
+	Tablets           bool
+	RoutingRules      bool
+}
+
+// CopyTopos copies the selected objects from fromTS to toTS. The tablets
+// that don't exist in fromTS anymore are deleted from toTS, with their
+// replication data, so that both topos can match again.
+func CopyTopos(ctx context.Context, fromTS, toTS *topo.Server, options SyncOptions) {
+	if options.Keyspaces {
+		CopyKeyspaces(ctx, fromTS, toTS)
+	}
+	if options.Shards {
+		CopyShards(ctx, fromTS, toTS)
+	}
+	if options.Tablets {
+		deleteRemovedTablets(ctx, fromTS, toTS)
+	}
+	if options.ShardReplications {
+		CopyShardReplications(ctx, fromTS, toTS)
+	}
+	if options.Tablets {
+		CopyTablets(ctx, fromTS, toTS)
+	}
+	if options.RoutingRules {
+		CopyRoutingRules(ctx, fromTS, toTS)
+	}
+}
+
+// CompareTopos compares the selected objects of fromTS and toTS.
+func CompareTopos(ctx context.Context, fromTS, toTS *topo.Server, options SyncOptions) error {
+	if options.Keyspaces {
+		if err := CompareKeyspaces(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "compare keyspaces failed")
+		}
+	}
+	if options.Shards {
+		if err := CompareShards(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "compare shards failed")
+		}
+	}
+	if options.ShardReplications {
+		if err := CompareShardReplications(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "compare shard replications failed")
+		}
+	}
+	if options.Tablets {
+		if err := CompareTablets(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "compare tablets failed")
+		}
+	}
+	if options.RoutingRules {
+		if err := CompareRoutingRules(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "compare routing rules failed")
+		}
+	}
+	return nil
+}
+
+// deleteRemovedTablets deletes the tablets of toTS that fromTS doesn't have.
+func deleteRemovedTablets(ctx context.Context, fromTS, toTS *topo.Server) {
+	cells, err := toTS.GetKnownCells(ctx)
+	if err != nil {
+		log.Fatalf("toTS.GetKnownCells: %v", err)
+	}
+
+	for _, cell := range cells {
+		tabletAliases, err := toTS.GetTabletAliasesByCell(ctx, cell)
+		if err != nil {
+			log.Fatalf("GetTabletsByCell(%v): %v", cell, err)
+		}
+		for _, tabletAlias := range tabletAliases {
+			_, err := fromTS.GetTablet(ctx, tabletAlias)
+			if err == nil {
+				continue
+			}
+			if !topo.IsErrType(err, topo.NoNode) {
+				log.Fatalf("GetTablet(%v): %v", tabletAlias, err)
+			}
+			ti, err := toTS.GetTablet(ctx, tabletAlias)
+			if err != nil {
+				log.Fatalf("GetTablet(%v): %v", tabletAlias, err)
+			}
+			log.Infof("tablet %v was deleted, deleting it", topoproto.TabletAliasString(tabletAlias))
+			if err := toTS.DeleteTablet(ctx, tabletAlias); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				log.Fatalf("DeleteTablet(%v): %v", tabletAlias, err)
+			}
+			if err := topo.DeleteTabletReplicationData(ctx, toTS, ti.Tablet); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				log.Warningf("DeleteTabletReplicationData(%v): %v", tabletAlias, err)
+			}
+		}
+	}
+}
+
+// SyncTopos makes the selected objects of toTS match the ones of fromTS:
+// it creates the new objects, updates the ones that differ, and deletes the
+// ones that fromTS doesn't have anymore. Unlike CopyTopos, it returns the
+// first error instead of exiting, so that Syncer can try again later.
+func SyncTopos(ctx context.Context, fromTS, toTS *topo.Server, options SyncOptions) error {
+	if options.Keyspaces {
+		if err := syncKeyspaces(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync keyspaces failed")
+		}
+	}
+	if options.Shards {
+		if err := syncShards(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync shards failed")
+		}
+	}
+	if options.Tablets {
+		if err := syncRemovedTablets(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync removed tablets failed")
+		}
+	}
+	if options.ShardReplications {
+		if err := syncShardReplications(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync shard replications failed")
+		}
+	}
+	if options.Tablets {
+		if err := syncTablets(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync tablets failed")
+		}
+	}
+	if options.RoutingRules {
+		if err := syncRoutingRules(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync routing rules failed")
+		}
+	}
+	// The shards and keyspaces are deleted once their tablets are.
+	if options.Shards {
+		if err := syncRemovedShards(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync removed shards failed")
+		}
+	}
+	if options.Keyspaces {
+		if err := syncRemovedKeyspaces(ctx, fromTS, toTS); err != nil {
+			return vterrors.Wrap(err, "sync removed keyspaces failed")
+		}
+	}
+	return nil
+}
+
+// syncKeyspaces creates or updates the keyspaces and their vschemas.
+func syncKeyspaces(ctx context.Context, fromTS, toTS *topo.Server) error {
+	keyspaces, err := fromTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	for _, keyspace := range keyspaces {
+		ki, err := fromTS.GetKeyspace(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetKeyspace(%v)", keyspace)
+		}
+		if err := syncKeyspace(ctx, toTS, keyspace, ki.Keyspace); err != nil {
+			return err
+		}
+
+		vs, err := fromTS.GetVSchema(ctx, keyspace)
+		switch {
+		case err == nil:
+			toVS, err := toTS.GetVSchema(ctx, keyspace)
+			if err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "GetVSchema(%v)", keyspace)
+			}
+			if err == nil && proto.Equal(vs, toVS) {
+				continue
+			}
+			if err := toTS.SaveVSchema(ctx, keyspace, vs); err != nil {
+				return vterrors.Wrapf(err, "SaveVSchema(%v)", keyspace)
+			}
+		case topo.IsErrType(err, topo.NoNode):
+			if err := toTS.DeleteVSchema(ctx, keyspace); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "DeleteVSchema(%v)", keyspace)
+			}
+		default:
+			return vterrors.Wrapf(err, "GetVSchema(%v)", keyspace)
+		}
+	}
+	return nil
+}
+
+// syncKeyspace creates the keyspace in toTS, or updates it under the lock
+// of the keyspace if it differs.
+func syncKeyspace(ctx context.Context, toTS *topo.Server, keyspace string, value *topodatapb.Keyspace) (err error) {
+	toKI, err := toTS.GetKeyspace(ctx, keyspace)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		if err := toTS.CreateKeyspace(ctx, keyspace, value); err != nil {
+			return vterrors.Wrapf(err, "CreateKeyspace(%v)", keyspace)
+		}
+		return nil
+	case err != nil:
+		return vterrors.Wrapf(err, "GetKeyspace(%v)", keyspace)
+	case proto.Equal(toKI.Keyspace, value):
+		return nil
+	}
+	ctx, unlock, lockErr := toTS.LockKeyspace(ctx, keyspace, "topo2topo sync")
+	if lockErr != nil {
+		return vterrors.Wrapf(lockErr, "LockKeyspace(%v)", keyspace)
+	}
+	defer unlock(&err)
+	toKI, err = toTS.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return vterrors.Wrapf(err, "GetKeyspace(%v)", keyspace)
+	}
+	toKI.Keyspace = proto.Clone(value).(*topodatapb.Keyspace)
+	if err := toTS.UpdateKeyspace(ctx, toKI); err != nil {
+		return vterrors.Wrapf(err, "UpdateKeyspace(%v)", keyspace)
+	}
+	return nil
+}
+
+// syncShards creates or updates the shards.
+func syncShards(ctx context.Context, fromTS, toTS *topo.Server) error {
+	keyspaces, err := fromTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	for _, keyspace := range keyspaces {
+		shards, err := fromTS.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetShardNames(%v)", keyspace)
+		}
+		for _, shard := range shards {
+			si, err := fromTS.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				return vterrors.Wrapf(err, "GetShard(%v, %v)", keyspace, shard)
+			}
+			if err := toTS.CreateShard(ctx, keyspace, shard); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+				return vterrors.Wrapf(err, "CreateShard(%v, %v)", keyspace, shard)
+			}
+			if _, err := toTS.UpdateShardFields(ctx, keyspace, shard, func(toSI *topo.ShardInfo) error {
+				if proto.Equal(toSI.Shard, si.Shard) {
+					return topo.NewError(topo.NoUpdateNeeded, shard)
+				}
+				toSI.Shard = proto.Clone(si.Shard).(*topodatapb.Shard)
+				return nil
+			}); err != nil {
+				return vterrors.Wrapf(err, "UpdateShardFields(%v, %v)", keyspace, shard)
+			}
+		}
+	}
+	return nil
+}
+
+// syncShardReplications replaces the replication data of the shards in all
+// the cells, and deletes the replication data that fromTS doesn't have.
+func syncShardReplications(ctx context.Context, fromTS, toTS *topo.Server) error {
+	keyspaces, err := fromTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	cells, err := fromTS.GetCellInfoNames(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetCellInfoNames")
+	}
+	for _, keyspace := range keyspaces {
+		shards, err := fromTS.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetShardNames(%v)", keyspace)
+		}
+		for _, shard := range shards {
+			for _, cell := range cells {
+				sri, err := fromTS.GetShardReplication(ctx, cell, keyspace, shard)
+				if topo.IsErrType(err, topo.NoNode) {
+					if err := toTS.DeleteShardReplication(ctx, cell, keyspace, shard); err != nil && !topo.IsErrType(err, topo.NoNode) {
+						return vterrors.Wrapf(err, "DeleteShardReplication(%v, %v, %v)", cell, keyspace, shard)
+					}
+					continue
+				}
+				if err != nil {
+					return vterrors.Wrapf(err, "GetShardReplication(%v, %v, %v)", cell, keyspace, shard)
+				}
+				if err := toTS.UpdateShardReplicationFields(ctx, cell, keyspace, shard, func(sr *topodatapb.ShardReplication) error {
+					if proto.Equal(sr, sri.ShardReplication) {
+						return topo.NewError(topo.NoUpdateNeeded, shard)
+					}
+					proto.Reset(sr)
+					proto.Merge(sr, sri.ShardReplication)
+					return nil
+				}); err != nil {
+					return vterrors.Wrapf(err, "UpdateShardReplicationFields(%v, %v, %v)", cell, keyspace, shard)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// syncTablets creates or replaces the tablets.
+func syncTablets(ctx context.Context, fromTS, toTS *topo.Server) error {
+	cells, err := fromTS.GetKnownCells(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKnownCells")
+	}
+	for _, cell := range cells {
+		tabletAliases, err := fromTS.GetTabletAliasesByCell(ctx, cell)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTabletAliasesByCell(%v)", cell)
+		}
+		for _, tabletAlias := range tabletAliases {
+			ti, err := fromTS.GetTablet(ctx, tabletAlias)
+			if topo.IsErrType(err, topo.NoNode) {
+				// The tablet was deleted since it was listed.
+				continue
+			}
+			if err != nil {
+				return vterrors.Wrapf(err, "GetTablet(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+			err = toTS.CreateTablet(ctx, ti.Tablet)
+			if topo.IsErrType(err, topo.NodeExists) {
+				_, err = toTS.UpdateTabletFields(ctx, tabletAlias, func(t *topodatapb.Tablet) error {
+					if proto.Equal(t, ti.Tablet) {
+						return topo.NewError(topo.NoUpdateNeeded, topoproto.TabletAliasString(tabletAlias))
+					}
+					proto.Reset(t)
+					proto.Merge(t, ti.Tablet)
+					return nil
+				})
+			}
+			if err != nil {
+				return vterrors.Wrapf(err, "CreateTablet(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+		}
+	}
+	return nil
+}
+
+// syncRemovedTablets deletes the tablets of toTS that fromTS doesn't have,
+// with their replication data.
+func syncRemovedTablets(ctx context.Context, fromTS, toTS *topo.Server) error {
+	cells, err := toTS.GetKnownCells(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKnownCells")
+	}
+	for _, cell := range cells {
+		tabletAliases, err := toTS.GetTabletAliasesByCell(ctx, cell)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTabletAliasesByCell(%v)", cell)
+		}
+		for _, tabletAlias := range tabletAliases {
+			_, err := fromTS.GetTablet(ctx, tabletAlias)
+			if err == nil {
+				continue
+			}
+			if !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "GetTablet(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+			ti, err := toTS.GetTablet(ctx, tabletAlias)
+			if err != nil {
+				return vterrors.Wrapf(err, "GetTablet(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+			log.Infof("tablet %v was deleted, deleting it", topoproto.TabletAliasString(tabletAlias))
+			if err := toTS.DeleteTablet(ctx, tabletAlias); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "DeleteTablet(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+			if err := topo.DeleteTabletReplicationData(ctx, toTS, ti.Tablet); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "DeleteTabletReplicationData(%v)", topoproto.TabletAliasString(tabletAlias))
+			}
+		}
+	}
+	return nil
+}
+
+// syncRemovedShards deletes the shards of toTS that fromTS doesn't have, with
+// their replication data.
+func syncRemovedShards(ctx context.Context, fromTS, toTS *topo.Server) error {
+	keyspaces, err := toTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	cells, err := toTS.GetCellInfoNames(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetCellInfoNames")
+	}
+	for _, keyspace := range keyspaces {
+		shards, err := toTS.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetShardNames(%v)", keyspace)
+		}
+		for _, shard := range shards {
+			_, err := fromTS.GetShard(ctx, keyspace, shard)
+			if err == nil {
+				continue
+			}
+			if !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "GetShard(%v, %v)", keyspace, shard)
+			}
+			log.Infof("shard %v/%v was deleted, deleting it", keyspace, shard)
+			for _, cell := range cells {
+				if err := toTS.DeleteShardReplication(ctx, cell, keyspace, shard); err != nil && !topo.IsErrType(err, topo.NoNode) {
+					return vterrors.Wrapf(err, "DeleteShardReplication(%v, %v, %v)", cell, keyspace, shard)
+				}
+			}
+			if err := toTS.DeleteShard(ctx, keyspace, shard); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return vterrors.Wrapf(err, "DeleteShard(%v, %v)", keyspace, shard)
+			}
+		}
+	}
+	return nil
+}
+
+// syncRemovedKeyspaces deletes the keyspaces of toTS that fromTS doesn't
+// have, once they have no shards left.
+func syncRemovedKeyspaces(ctx context.Context, fromTS, toTS *topo.Server) error {
+	keyspaces, err := toTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	for _, keyspace := range keyspaces {
+		_, err := fromTS.GetKeyspace(ctx, keyspace)
+		if err == nil {
+			continue
+		}
+		if !topo.IsErrType(err, topo.NoNode) {
+			return vterrors.Wrapf(err, "GetKeyspace(%v)", keyspace)
+		}
+		shards, err := toTS.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetShardNames(%v)", keyspace)
+		}
+		if len(shards) > 0 {
+			// The shards are only deleted when they are synced too.
+			continue
+		}
+		log.Infof("keyspace %v was deleted, deleting it", keyspace)
+		if err := toTS.DeleteKeyspace(ctx, keyspace); err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return vterrors.Wrapf(err, "DeleteKeyspace(%v)", keyspace)
+		}
+	}
+	return nil
+}
+
+// syncRoutingRules replaces the routing rules, if they differ.
+func syncRoutingRules(ctx context.Context, fromTS, toTS *topo.Server) error {
+	rr, err := fromTS.GetRoutingRules(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetRoutingRules")
+	}
+	toRR, err := toTS.GetRoutingRules(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetRoutingRules")
+	}
+	if proto.Equal(rr, toRR) {
+		return nil
+	}
+	if err := toTS.SaveRoutingRules(ctx, rr); err != nil {
+		return vterrors.Wrap(err, "SaveRoutingRules")
+	}
+	return nil
+}
+
+// Syncer keeps a topo in sync with another one, while the components
+// still use the old one. It copies everything once, then again every time
+// a watched file of the old topo changes: the keyspaces, shards, tablets
+// and routing rules that it copied. As the topo has no watches on
+// directories, it also copies everything every interval, to pick up the
+// new objects.
+type Syncer struct {
+	fromTS   *topo.Server
+	toTS     *topo.Server
+	options  SyncOptions
+	interval time.Duration
+
+	// changes has a pending change of a watched file.
+	changes chan struct{}
+
+	mu sync.Mutex
+	// watches are the cancel functions of the watches, by cell and path.
+	watches map[string]topo.CancelFunc
+	passes  int
+}
+
+// NewSyncer returns a Syncer from fromTS to toTS.
+func NewSyncer(fromTS, toTS *topo.Server, options SyncOptions, interval time.Duration) *Syncer {
+	return &Syncer{
+		fromTS:   fromTS,
+		toTS:     toTS,
+		options:  options,
+		interval: interval,
+		changes:  make(chan struct{}, 1),
+		watches:  make(map[string]topo.CancelFunc),
+	}
+}
+
+// Run syncs the topos until ctx is done.
+func (s *Syncer) Run(ctx context.Context) {
+	defer s.cancelWatches()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-s.changes:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+		s.pass(ctx)
+		timer.Reset(s.interval)
+	}
+}
+
+// Passes returns the number of copies that the Syncer made.
+func (s *Syncer) Passes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.passes
+}
+
+// pass syncs everything, and watches the files it didn't watch yet. A pass
+// that fails is logged, and the next one tries again.
+func (s *Syncer) pass(ctx context.Context) {
+	err := SyncTopos(ctx, s.fromTS, s.toTS, s.options)
+	s.mu.Lock()
+	s.passes++
+	s.mu.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		log.Warningf("cannot sync the topos, will try again: %v", err)
+	}
+
+	if s.options.RoutingRules {
+		s.watch(ctx, topo.GlobalCell, topo.RoutingRulesFile)
+	}
+	if s.options.Keyspaces || s.options.Shards {
+		keyspaces, err := s.fromTS.GetKeyspaces(ctx)
+		if err != nil {
+			log.Warningf("GetKeyspaces: %v", err)
+			return
+		}
+		for _, keyspace := range keyspaces {
+			if s.options.Keyspaces {
+				s.watch(ctx, topo.GlobalCell, path.Join(topo.KeyspacesPath, keyspace, topo.KeyspaceFile))
+			}
+			if !s.options.Shards {
+				continue
+			}
+			shards, err := s.fromTS.GetShardNames(ctx, keyspace)
+			if err != nil {
+				log.Warningf("GetShardNames(%v): %v", keyspace, err)
+				continue
+			}
+			for _, shard := range shards {
+				s.watch(ctx, topo.GlobalCell, path.Join(topo.KeyspacesPath, keyspace, topo.ShardsPath, shard, topo.ShardFile))
+			}
+		}
+	}
+	if s.options.Tablets {
+		cells, err := s.fromTS.GetKnownCells(ctx)
+		if err != nil {
+			log.Warningf("GetKnownCells: %v", err)
+			return
+		}
+		for _, cell := range cells {
+			tabletAliases, err := s.fromTS.GetTabletAliasesByCell(ctx, cell)
+			if err != nil {
+				log.Warningf("GetTabletsByCell(%v): %v", cell, err)
+				continue
+			}
+			for _, tabletAlias := range tabletAliases {
+				s.watch(ctx, cell, path.Join(topo.TabletsPath, topoproto.TabletAliasString(tabletAlias), topo.TabletFile))
+			}
+		}
+	}
+}
+
+// watch watches a file of fromTS, unless it is already watched, and
+// notifies changes of its changes. The watch ends when the file is
+// deleted, so that a file created again at the same path is watched again.
+func (s *Syncer) watch(ctx context.Context, cell, filePath string) {
+	key := path.Join(cell, filePath)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.watches[key]; ok {
+		return
+	}
+	conn, err := s.fromTS.ConnForCell(ctx, cell)
+	if err != nil {
+		log.Warningf("ConnForCell(%v): %v", cell, err)
+		return
+	}
+	current, changes, cancel := conn.Watch(ctx, filePath)
+	if current.Err != nil {
+		if !topo.IsErrType(current.Err, topo.NoNode) {
+			log.Warningf("cannot watch %v: %v", key, current.Err)
+		}
+		return
+	}
+	s.watches[key] = cancel
+	go func() {
+		for wd := range changes {
+			if wd.Err != nil {
+				break
+			}
+			s.notify()
+		}
+		s.mu.Lock()
+		delete(s.watches, key)
+		s.mu.Unlock()
+		// The file may have been deleted.
+		s.notify()
+	}()
+}
+
+// notify requests a copy, unless one is already pending.
+func (s *Syncer) notify() {
+	select {
+	case s.changes <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Syncer) cancelWatches() {
+	s.mu.Lock()
+	var cancels []topo.CancelFunc
+	for _, cancel := range s.watches {
+		cancels = append(cancels, cancel)
+	}
+	s.mu.Unlock()
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
+
+// Cutover freezes the changes of fromTS that are made under a keyspace
+// lock, like reparents, resharding and schema changes, by locking all its
+// keyspaces. While it holds the locks, it copies the last changes to toTS,
+// and verifies that both topos match. Once it returns without error, the
+// components can switch to toTS.
+func Cutover(ctx context.Context, fromTS, toTS *topo.Server, options SyncOptions) (err error) {
+	keyspaces, err := fromTS.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces")
+	}
+	// The copy doesn't run with the locks in its context: toTS has
+	// keyspaces of the same names, that CreateShard locks.
+	lockCtx := ctx
+	for _, keyspace := range keyspaces {
+		var unlock func(*error)
+		var lockErr error
+		lockCtx, unlock, lockErr = fromTS.LockKeyspace(lockCtx, keyspace, "topo2topo cutover")
+		if lockErr != nil {
+			return vterrors.Wrapf(lockErr, "cannot lock keyspace %v", keyspace)
+		}
+		defer unlock(&err)
+	}
+
+	if err := SyncTopos(ctx, fromTS, toTS, options); err != nil {
+		return err
+	}
+	return CompareTopos(ctx, fromTS, toTS, options)
+}
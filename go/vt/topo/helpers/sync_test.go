@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var allObjects = SyncOptions{
+	Keyspaces:         true,
+	Shards:            true,
+	ShardReplications: true,
+	Tablets:           true,
+	RoutingRules:      true,
+}
+
+func TestSyncer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fromTS, toTS := createSetup(ctx, t)
+	require.Error(t, CompareTopos(ctx, fromTS, toTS, allObjects))
+
+	// The interval is long enough for the changes to be copied only because
+	// of the watches.
+	syncer := NewSyncer(fromTS, toTS, allObjects, time.Hour)
+	done := make(chan struct{})
+	go func() {
+		syncer.Run(ctx)
+		close(done)
+	}()
+	inSync := func() bool {
+		return CompareTopos(ctx, fromTS, toTS, allObjects) == nil
+	}
+	require.Eventually(t, inSync, 10*time.Second, 10*time.Millisecond)
+
+	// A change of a shard is copied.
+	_, err := fromTS.UpdateShardFields(ctx, "test_keyspace", "0", func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = &topodatapb.TabletAlias{Cell: "test_cell", Uid: 123}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		si, err := toTS.GetShard(ctx, "test_keyspace", "0")
+		return err == nil && si.PrimaryAlias.GetUid() == 123
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// A deleted tablet is deleted, with its replication data.
+	replica := &topodatapb.TabletAlias{Cell: "test_cell", Uid: 234}
+	ti, err := fromTS.GetTablet(ctx, replica)
+	require.NoError(t, err)
+	require.NoError(t, fromTS.DeleteTablet(ctx, replica))
+	require.NoError(t, topo.DeleteTabletReplicationData(ctx, fromTS, ti.Tablet))
+	require.Eventually(t, func() bool {
+		_, err := toTS.GetTablet(ctx, replica)
+		return topo.IsErrType(err, topo.NoNode) && inSync()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	assert.GreaterOrEqual(t, syncer.Passes(), 3)
+}
+
+func TestCutover(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+
+	require.NoError(t, Cutover(ctx, fromTS, toTS, allObjects))
+	require.NoError(t, CompareTopos(ctx, fromTS, toTS, allObjects))
+
+	// The keyspaces are unlocked again.
+	_, unlock, err := fromTS.LockKeyspace(ctx, "test_keyspace", "test")
+	require.NoError(t, err)
+	unlock(&err)
+	require.NoError(t, err)
+}
+
+func TestSyncTopos(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+	require.NoError(t, fromTS.CreateKeyspace(ctx, "other_keyspace", &topodatapb.Keyspace{}))
+	require.NoError(t, fromTS.CreateShard(ctx, "other_keyspace", "0"))
+	require.NoError(t, topo.UpdateShardReplicationRecord(ctx, fromTS, "other_keyspace", "0", &topodatapb.TabletAlias{Cell: "test_cell", Uid: 345}))
+	require.NoError(t, SyncTopos(ctx, fromTS, toTS, allObjects))
+	require.NoError(t, CompareTopos(ctx, fromTS, toTS, allObjects))
+
+	// A change of a keyspace is copied.
+	ctx2, unlock, err := fromTS.LockKeyspace(ctx, "test_keyspace", "test")
+	require.NoError(t, err)
+	ki, err := fromTS.GetKeyspace(ctx2, "test_keyspace")
+	require.NoError(t, err)
+	ki.ShardingColumnName = "user_id"
+	require.NoError(t, fromTS.UpdateKeyspace(ctx2, ki))
+	unlock(&err)
+	require.NoError(t, err)
+
+	// A field removed from a tablet is removed.
+	primary := &topodatapb.TabletAlias{Cell: "test_cell", Uid: 123}
+	_, err = fromTS.UpdateTabletFields(ctx, primary, func(tablet *topodatapb.Tablet) error {
+		tablet.MysqlHostname = ""
+		return nil
+	})
+	require.NoError(t, err)
+
+	// A node removed from the replication data of a shard is removed.
+	require.NoError(t, topo.RemoveShardReplicationRecord(ctx, fromTS, "test_cell", "test_keyspace", "0", &topodatapb.TabletAlias{Cell: "test_cell", Uid: 234}))
+
+	// A deleted shard and keyspace are deleted.
+	require.NoError(t, fromTS.DeleteShardReplication(ctx, "test_cell", "other_keyspace", "0"))
+	require.NoError(t, fromTS.DeleteShard(ctx, "other_keyspace", "0"))
+	require.NoError(t, fromTS.DeleteKeyspace(ctx, "other_keyspace"))
+
+	require.NoError(t, SyncTopos(ctx, fromTS, toTS, allObjects))
+	require.NoError(t, CompareTopos(ctx, fromTS, toTS, allObjects))
+	ki, err = toTS.GetKeyspace(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Equal(t, "user_id", ki.ShardingColumnName)
+	ti, err := toTS.GetTablet(ctx, primary)
+	require.NoError(t, err)
+	assert.Empty(t, ti.MysqlHostname)
+	sri, err := toTS.GetShardReplication(ctx, "test_cell", "test_keyspace", "0")
+	require.NoError(t, err)
+	assert.Len(t, sri.Nodes, 1)
+	_, err = toTS.GetKeyspace(ctx, "other_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+	_, err = toTS.GetShard(ctx, "other_keyspace", "0")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
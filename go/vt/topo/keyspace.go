@@ -294,6 +294,12 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	if err := ts.DeleteVSchema(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
 		return err
 	}
+	if err := ts.DeleteFeatureFlags(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+	if err := ts.DeleteTableGCConfig(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
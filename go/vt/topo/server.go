@@ -77,16 +77,20 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	DynamicConfigFile    = "DynamicConfig"
+	FeatureFlagsFile     = "FeatureFlags"
+	TableGCConfigFile    = "TableGCConfig"
 )
 
 // Path for all object types.
 const (
-	CellsPath        = "cells"
-	CellsAliasesPath = "cells_aliases"
-	KeyspacesPath    = "keyspaces"
-	ShardsPath       = "shards"
-	TabletsPath      = "tablets"
-	MetadataPath     = "metadata"
+	CellsPath         = "cells"
+	CellsAliasesPath  = "cells_aliases"
+	KeyspacesPath     = "keyspaces"
+	ShardsPath        = "shards"
+	TabletsPath       = "tablets"
+	MetadataPath      = "metadata"
+	DynamicConfigPath = "dynamic_config"
 
 	ExternalClusterMySQL  = "mysql"
 	ExternalClusterVitess = "vitess"
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+)
+
+// This file contains the utility methods to manage the configuration of the
+// table garbage collection of the keyspaces, stored as JSON next to the
+// Keyspace record in the global topo.
+
+func tableGCConfigPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, TableGCConfigFile)
+}
+
+// GetTableGCConfig returns the table GC configuration of a keyspace, which
+// is empty if it was never set.
+func (ts *Server) GetTableGCConfig(ctx context.Context, keyspace string) (map[string]string, error) {
+	return ts.getStringMap(ctx, tableGCConfigPath(keyspace))
+}
+
+// UpdateTableGCConfig reads the table GC configuration of a keyspace, calls
+// update on it, and writes it back. The update is retried if the
+// configuration was changed concurrently.
+func (ts *Server) UpdateTableGCConfig(ctx context.Context, keyspace string, update func(map[string]string) error) error {
+	return ts.updateStringMap(ctx, tableGCConfigPath(keyspace), update)
+}
+
+// DeleteTableGCConfig deletes the table GC configuration of a keyspace.
+func (ts *Server) DeleteTableGCConfig(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, tableGCConfigPath(keyspace), nil)
+}
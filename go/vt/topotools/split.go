@@ -29,8 +29,15 @@ import (
 )
 
 // ValidateForReshard returns an error if sourceShards cannot reshard into
-// targetShards.
+// targetShards. It works the same for splits and merges: the shards of each
+// side must not overlap, and both sides must cover the same keyrange.
 func ValidateForReshard(sourceShards, targetShards []*topo.ShardInfo) error {
+	if err := validateNoOverlap("source", sourceShards); err != nil {
+		return err
+	}
+	if err := validateNoOverlap("target", targetShards); err != nil {
+		return err
+	}
 	for _, source := range sourceShards {
 		for _, target := range targetShards {
 			if key.KeyRangeEqual(source.KeyRange, target.KeyRange) {
@@ -52,6 +59,19 @@ func ValidateForReshard(sourceShards, targetShards []*topo.ShardInfo) error {
 	return nil
 }
 
+// validateNoOverlap returns an error if two of the shards overlap, since
+// the rows of the overlap would be copied twice.
+func validateNoOverlap(side string, shards []*topo.ShardInfo) error {
+	for i, first := range shards {
+		for _, second := range shards[i+1:] {
+			if key.KeyRangesIntersect(first.KeyRange, second.KeyRange) {
+				return fmt.Errorf("%s shards %v and %v overlap", side, first.ShardName(), second.ShardName())
+			}
+		}
+	}
+	return nil
+}
+
 func combineKeyRanges(shards []*topo.ShardInfo) (*topodatapb.KeyRange, error) {
 	if len(shards) == 0 {
 		return nil, fmt.Errorf("there are no shards to combine")
@@ -139,7 +139,19 @@ func TestValidateForReshard(t *testing.T) {
 		out:     "source and target keyranges don't match: -80 vs -",
 	}, {
 		sources: []string{"-30", "20-80"},
-		targets: []string{"-40", "40-"},
+		targets: []string{"-40", "40-80"},
+		out:     "source shards -30 and 20-80 overlap",
+	}, {
+		sources: []string{"-40", "40-80", "80-c0", "c0-"},
+		targets: []string{"-80", "40-"},
+		out:     "target shards -80 and 40- overlap",
+	}, {
+		sources: []string{"-40", "40-80", "80-c0", "c0-"},
+		targets: []string{"-80", "80-"},
+		out:     "",
+	}, {
+		sources: []string{"-40", "80-c0"},
+		targets: []string{"-"},
 		out:     "shards don't form a contiguous keyrange",
 	}}
 	buildShards := func(shards []string) []*topo.ShardInfo {
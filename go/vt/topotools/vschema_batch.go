@@ -0,0 +1,340 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// ApplyVSchemaBatch validates the vschemas of several keyspaces together,
+// and saves them, so that a change that spans keyspaces, such as a lookup
+// vindex whose lookup table moves to another keyspace, is never served half
+// applied. It returns the changes of the routing of the tables.
+//
+// The vschemas are validated with the vschemas of the other keyspaces, as
+// vtgate builds them: the sequences, the owners of the lookup vindexes and the
+// lookup tables must resolve. A keyspace of the batch can't have an error, and
+// the other keyspaces can't have a new one.
+//
+// The keyspaces are locked while their vschemas are saved, and the vschemas
+// that were saved are restored if one fails. Since the vtgates only see the
+// SrvVSchema, which holds the vschemas of all the keyspaces, the whole batch
+// is then published in a single rebuild of the SrvVSchema of every cell,
+// unless skipRebuild is set. Nothing is saved with dryRun.
+func ApplyVSchemaBatch(ctx context.Context, ts *topo.Server, vschemas map[string]*vschemapb.Keyspace, cells []string, dryRun, skipRebuild bool) (diffs []string, err error) {
+	if len(vschemas) == 0 {
+		return nil, fmt.Errorf("no vschema to apply")
+	}
+	keyspaces := make([]string, 0, len(vschemas))
+	for keyspace := range vschemas {
+		if _, err := ts.GetKeyspace(ctx, keyspace); err != nil {
+			return nil, fmt.Errorf("keyspace %s: %v", keyspace, err)
+		}
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+
+	if !dryRun {
+		// The keyspaces are locked in order, so that two batches can't
+		// deadlock.
+		for _, keyspace := range keyspaces {
+			var unlock func(*error)
+			ctx, unlock, err = ts.LockKeyspace(ctx, keyspace, "ApplyVSchemaBatch")
+			if err != nil {
+				return nil, err
+			}
+			defer unlock(&err)
+		}
+	}
+
+	current, err := GlobalSrvVSchema(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	next := proto.Clone(current).(*vschemapb.SrvVSchema)
+	for keyspace, vschema := range vschemas {
+		if err := vindexes.ValidateKeyspace(vschema); err != nil {
+			return nil, fmt.Errorf("keyspace %s: %v", keyspace, err)
+		}
+		next.Keyspaces[keyspace] = vschema
+	}
+	before, after := vindexes.BuildVSchema(current), vindexes.BuildVSchema(next)
+	if err := validateVSchemaBatch(current, next, before, after, vschemas); err != nil {
+		return nil, err
+	}
+	diffs = VSchemaRoutingDiffs(current, next, before, after)
+	if dryRun {
+		return diffs, nil
+	}
+
+	var saved []string
+	for _, keyspace := range keyspaces {
+		if err := ts.SaveVSchema(ctx, keyspace, vschemas[keyspace]); err != nil {
+			restoreVSchemas(ctx, ts, current, saved)
+			return nil, fmt.Errorf("cannot save the vschema of %s, the vschemas of %v were restored: %v", keyspace, saved, err)
+		}
+		saved = append(saved, keyspace)
+	}
+	if skipRebuild {
+		return diffs, nil
+	}
+	return diffs, ts.RebuildSrvVSchema(ctx, cells)
+}
+
+// GlobalSrvVSchema returns the vschemas of all the keyspaces and the routing
+// rules, as RebuildSrvVSchema builds them.
+func GlobalSrvVSchema(ctx context.Context, ts *topo.Server) (*vschemapb.SrvVSchema, error) {
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	srvVSchema := &vschemapb.SrvVSchema{Keyspaces: make(map[string]*vschemapb.Keyspace, len(keyspaces))}
+	for _, keyspace := range keyspaces {
+		vschema, err := ts.GetVSchema(ctx, keyspace)
+		if topo.IsErrType(err, topo.NoNode) {
+			vschema, err = &vschemapb.Keyspace{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("GetVSchema(%s) failed: %v", keyspace, err)
+		}
+		srvVSchema.Keyspaces[keyspace] = vschema
+	}
+	if srvVSchema.RoutingRules, err = ts.GetRoutingRules(ctx); err != nil {
+		return nil, err
+	}
+	return srvVSchema, nil
+}
+
+// restoreVSchemas saves back the vschemas of the keyspaces.
+func restoreVSchemas(ctx context.Context, ts *topo.Server, current *vschemapb.SrvVSchema, keyspaces []string) {
+	for _, keyspace := range keyspaces {
+		if err := ts.SaveVSchema(ctx, keyspace, current.Keyspaces[keyspace]); err != nil {
+			log.Errorf("Cannot restore the vschema of %s: %v", keyspace, err)
+		}
+	}
+}
+
+// validateVSchemaBatch returns the errors of the keyspaces of the batch, and
+// the new errors of the other keyspaces.
+func validateVSchemaBatch(current, next *vschemapb.SrvVSchema, before, after *vindexes.VSchema, batch map[string]*vschemapb.Keyspace) error {
+	previous := make(map[string]bool)
+	for _, problem := range vschemaProblems(current, before) {
+		previous[problem] = true
+	}
+	rec := concurrency.AllErrorRecorder{}
+	for _, problem := range vschemaProblems(next, after) {
+		keyspace := problem[:strings.IndexByte(problem, ':')]
+		if _, ok := batch[keyspace]; ok || !previous[problem] {
+			rec.RecordError(fmt.Errorf("%s", problem))
+		}
+	}
+	return rec.AggrError(func(errs []error) error {
+		messages := make([]string, len(errs))
+		for i, err := range errs {
+			messages[i] = err.Error()
+		}
+		return fmt.Errorf("invalid vschemas:\n%s", strings.Join(messages, "\n"))
+	})
+}
+
+// vschemaProblems returns the errors of the keyspaces of a vschema, and the
+// lookup tables that don't resolve, prefixed by their keyspace.
+func vschemaProblems(source *vschemapb.SrvVSchema, vschema *vindexes.VSchema) []string {
+	var problems []string
+	keyspaces := make(map[string]bool)
+	for keyspace := range source.Keyspaces {
+		keyspaces[keyspace] = true
+	}
+	for _, keyspace := range sortedNames(keyspaces) {
+		ks := vschema.Keyspaces[keyspace]
+		if ks.Error != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", keyspace, ks.Error))
+		}
+		vindexNames := make(map[string]bool)
+		for name := range source.Keyspaces[keyspace].Vindexes {
+			vindexNames[name] = true
+		}
+		for _, name := range sortedNames(vindexNames) {
+			if _, ok := ks.Vindexes[name].(vindexes.Lookup); !ok {
+				continue
+			}
+			if err := resolveLookupTable(vschema, source.Keyspaces[keyspace].Vindexes[name].Params["table"]); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: lookup vindex %s: %v", keyspace, name, err))
+			}
+		}
+	}
+	return problems
+}
+
+// resolveLookupTable returns an error if the keyspace of a lookup table
+// doesn't exist, or doesn't have the table if it is sharded.
+func resolveLookupTable(vschema *vindexes.VSchema, table string) error {
+	keyspace, name, err := sqlparser.ParseTable(table)
+	if err != nil {
+		return err
+	}
+	if keyspace == "" {
+		return nil
+	}
+	ks, ok := vschema.Keyspaces[keyspace]
+	if !ok {
+		return fmt.Errorf("the keyspace of the lookup table %s doesn't exist", table)
+	}
+	if _, ok := ks.Tables[name]; ks.Keyspace.Sharded && !ok {
+		return fmt.Errorf("the lookup table %s is not in the vschema of its sharded keyspace", table)
+	}
+	return nil
+}
+
+// VSchemaRoutingDiffs returns how the routing of the tables changes from a
+// SrvVSchema to another, and how their vindexes change, in the order of the
+// keyspaces and the tables.
+func VSchemaRoutingDiffs(current, next *vschemapb.SrvVSchema, before, after *vindexes.VSchema) []string {
+	var diffs []string
+	keyspaces := make(map[string]bool)
+	for keyspace := range current.Keyspaces {
+		keyspaces[keyspace] = true
+	}
+	for keyspace := range next.Keyspaces {
+		keyspaces[keyspace] = true
+	}
+	for _, keyspace := range sortedNames(keyspaces) {
+		oldKs, newKs := current.Keyspaces[keyspace], next.Keyspaces[keyspace]
+		if oldKs.GetSharded() != newKs.GetSharded() {
+			diffs = append(diffs, fmt.Sprintf("%s: sharded %v -> %v", keyspace, oldKs.GetSharded(), newKs.GetSharded()))
+		}
+
+		vindexNames := make(map[string]bool)
+		for name := range oldKs.GetVindexes() {
+			vindexNames[name] = true
+		}
+		for name := range newKs.GetVindexes() {
+			vindexNames[name] = true
+		}
+		for _, name := range sortedNames(vindexNames) {
+			oldVindex, newVindex := oldKs.GetVindexes()[name], newKs.GetVindexes()[name]
+			switch {
+			case oldVindex == nil:
+				diffs = append(diffs, fmt.Sprintf("%s: vindex %s added: %s", keyspace, name, formatVindex(newVindex)))
+			case newVindex == nil:
+				diffs = append(diffs, fmt.Sprintf("%s: vindex %s removed", keyspace, name))
+			case !proto.Equal(oldVindex, newVindex):
+				diffs = append(diffs, fmt.Sprintf("%s: vindex %s: %s -> %s", keyspace, name, formatVindex(oldVindex), formatVindex(newVindex)))
+			}
+		}
+
+		var oldTables, newTables map[string]*vindexes.Table
+		if ks := before.Keyspaces[keyspace]; ks != nil {
+			oldTables = ks.Tables
+		}
+		if ks := after.Keyspaces[keyspace]; ks != nil {
+			newTables = ks.Tables
+		}
+		tableNames := make(map[string]bool)
+		for name := range oldTables {
+			tableNames[name] = true
+		}
+		for name := range newTables {
+			tableNames[name] = true
+		}
+		delete(tableNames, "dual")
+		for _, name := range sortedNames(tableNames) {
+			oldRouting, newRouting := formatRouting(oldTables[name]), formatRouting(newTables[name])
+			switch {
+			case oldTables[name] == nil:
+				diffs = append(diffs, fmt.Sprintf("%s.%s: added: %s", keyspace, name, newRouting))
+			case newTables[name] == nil:
+				diffs = append(diffs, fmt.Sprintf("%s.%s: removed", keyspace, name))
+			case oldRouting != newRouting:
+				diffs = append(diffs, fmt.Sprintf("%s.%s: %s -> %s", keyspace, name, oldRouting, newRouting))
+			}
+		}
+	}
+	return diffs
+}
+
+func formatVindex(vindex *vschemapb.Vindex) string {
+	params := make([]string, 0, len(vindex.Params))
+	for name, value := range vindex.Params {
+		params = append(params, name+"="+value)
+	}
+	sort.Strings(params)
+	s := fmt.Sprintf("%s(%s)", vindex.Type, strings.Join(params, ", "))
+	if vindex.Owner != "" {
+		s += " owned by " + vindex.Owner
+	}
+	return s
+}
+
+// formatRouting returns how a table is routed: its type, and its column
+// vindexes, the first one being its primary vindex, or its keyspace id.
+func formatRouting(table *vindexes.Table) string {
+	if table == nil {
+		return ""
+	}
+	var parts []string
+	if table.Type != "" {
+		parts = append(parts, table.Type)
+	}
+	if table.Pinned != nil {
+		parts = append(parts, fmt.Sprintf("pinned %x", table.Pinned))
+	}
+	var columnVindexes []string
+	for _, cv := range table.ColumnVindexes {
+		// The subsets of the columns of the multi-column vindexes repeat them.
+		if cv.IgnoreInDML() {
+			continue
+		}
+		columns := make([]string, len(cv.Columns))
+		for i, column := range cv.Columns {
+			columns[i] = column.String()
+		}
+		columnVindexes = append(columnVindexes, fmt.Sprintf("%s(%s)", cv.Name, strings.Join(columns, ", ")))
+	}
+	if columnVindexes != nil {
+		parts = append(parts, "vindexes "+strings.Join(columnVindexes, " "))
+	}
+	if table.AutoIncrement != nil {
+		parts = append(parts, fmt.Sprintf("sequence %s.%s for %s", table.AutoIncrement.Sequence.Keyspace.Name, table.AutoIncrement.Sequence.Name.String(), table.AutoIncrement.Column.String()))
+	}
+	if parts == nil {
+		return "unsharded"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedNames(names map[string]bool) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
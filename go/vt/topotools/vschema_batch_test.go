@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func mustVSchema(t *testing.T, vschema string) *vschemapb.Keyspace {
+	t.Helper()
+	ks := &vschemapb.Keyspace{}
+	require.NoError(t, json2.Unmarshal([]byte(vschema), ks))
+	return ks
+}
+
+func TestApplyVSchemaBatch(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	for _, keyspace := range []string{"customer", "product", "lookup"} {
+		require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}))
+	}
+	product := mustVSchema(t, `{
+		"tables": {
+			"product": {},
+			"sku_lkp": {},
+			"order_seq": {"type": "sequence"}
+		}
+	}`)
+	customer := mustVSchema(t, `{
+		"sharded": true,
+		"vindexes": {
+			"hash": {"type": "hash"},
+			"sku_lkp": {"type": "consistent_lookup_unique", "params": {"table": "product.sku_lkp", "from": "sku", "to": "keyspace_id"}, "owner": "corder"}
+		},
+		"tables": {
+			"corder": {
+				"column_vindexes": [{"column": "customer_id", "name": "hash"}, {"column": "sku", "name": "sku_lkp"}],
+				"auto_increment": {"column": "order_id", "sequence": "product.order_seq"}
+			}
+		}
+	}`)
+	lookup := mustVSchema(t, `{"sharded": true, "vindexes": {"hash": {"type": "hash"}}}`)
+	require.NoError(t, ts.SaveVSchema(ctx, "product", product))
+	require.NoError(t, ts.SaveVSchema(ctx, "customer", customer))
+	require.NoError(t, ts.SaveVSchema(ctx, "lookup", lookup))
+	require.NoError(t, ts.RebuildSrvVSchema(ctx, nil))
+
+	// The lookup table moves to the sharded lookup keyspace, which doesn't
+	// have it yet.
+	movedCustomer := proto.Clone(customer).(*vschemapb.Keyspace)
+	movedCustomer.Vindexes["sku_lkp"].Params["table"] = "lookup.sku_lkp"
+	_, err := ApplyVSchemaBatch(ctx, ts, map[string]*vschemapb.Keyspace{"customer": movedCustomer}, nil, false, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "customer: lookup vindex sku_lkp: the lookup table lookup.sku_lkp is not in the vschema of its sharded keyspace")
+
+	// So it moves with the lookup keyspace.
+	movedLookup := mustVSchema(t, `{
+		"sharded": true,
+		"vindexes": {"hash": {"type": "hash"}},
+		"tables": {"sku_lkp": {"column_vindexes": [{"column": "sku", "name": "hash"}]}}
+	}`)
+	batch := map[string]*vschemapb.Keyspace{"customer": movedCustomer, "lookup": movedLookup}
+	diffs, err := ApplyVSchemaBatch(ctx, ts, batch, nil, true, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"customer: vindex sku_lkp: consistent_lookup_unique(from=sku, table=product.sku_lkp, to=keyspace_id) owned by corder -> consistent_lookup_unique(from=sku, table=lookup.sku_lkp, to=keyspace_id) owned by corder",
+		"lookup.sku_lkp: added: vindexes hash(sku)",
+	}, diffs)
+	vschema, err := ts.GetVSchema(ctx, "customer")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(customer, vschema), "nothing is saved in a dry run")
+
+	_, err = ApplyVSchemaBatch(ctx, ts, batch, nil, false, false)
+	require.NoError(t, err)
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(movedCustomer, srvVSchema.Keyspaces["customer"]))
+	assert.True(t, proto.Equal(movedLookup, srvVSchema.Keyspaces["lookup"]))
+
+	// The other keyspaces can't get a new error.
+	_, err = ApplyVSchemaBatch(ctx, ts, map[string]*vschemapb.Keyspace{"lookup": lookup}, nil, true, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "customer: lookup vindex sku_lkp: the lookup table lookup.sku_lkp is not in the vschema of its sharded keyspace")
+
+	// The owners must match their lookup vindexes.
+	twoColumns := proto.Clone(movedCustomer).(*vschemapb.Keyspace)
+	twoColumns.Tables["corder"].ColumnVindexes[1] = &vschemapb.ColumnVindex{Columns: []string{"sku", "color"}, Name: "sku_lkp"}
+	_, err = ApplyVSchemaBatch(ctx, ts, map[string]*vschemapb.Keyspace{"customer": twoColumns}, nil, true, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "customer: owner table column count does not match vindex sku_lkp")
+
+	_, err = ApplyVSchemaBatch(ctx, ts, map[string]*vschemapb.Keyspace{"nope": {}}, nil, true, false)
+	assert.Error(t, err)
+	_, err = ApplyVSchemaBatch(ctx, ts, nil, nil, true, false)
+	assert.EqualError(t, err, "no vschema to apply")
+}
@@ -165,10 +165,30 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// ReadTransactions is part of the VTGateService interface
+func (f *fakeVTGateService) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	return nil, nil
+}
+
+// ForceResolveTransaction is part of the VTGateService interface
+func (f *fakeVTGateService) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return nil
+}
+
 func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	return nil
 }
 
+// VStreamAck is part of the VTGateService interface
+func (f *fakeVTGateService) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return nil
+}
+
+// VStreamControl is part of the VTGateService interface
+func (f *fakeVTGateService) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	return 0, nil
+}
+
 // HandlePanic is part of the VTGateService interface
 func (f *fakeVTGateService) HandlePanic(err *error) {
 	if x := recover(); x != nil {
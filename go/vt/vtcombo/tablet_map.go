@@ -565,6 +565,12 @@ func (itc *internalTabletConn) ReadTransaction(ctx context.Context, target *quer
 	return metadata, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// UnresolvedTransactions is part of queryservice.QueryService
+func (itc *internalTabletConn) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	transactions, prepared, err = itc.tablet.qsc.QueryService().UnresolvedTransactions(ctx, target)
+	return transactions, prepared, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 // BeginExecute is part of queryservice.QueryService
 func (itc *internalTabletConn) BeginExecute(
 	ctx context.Context,
@@ -597,8 +603,8 @@ func (itc *internalTabletConn) BeginStreamExecute(
 }
 
 // MessageStream is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
-	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, callback)
+func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) error {
+	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, streamID, window, callback)
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
@@ -608,6 +614,12 @@ func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.T
 	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// MessageStreamWindow is part of queryservice.QueryService
+func (itc *internalTabletConn) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error {
+	err := itc.tablet.qsc.QueryService().MessageStreamWindow(ctx, target, name, streamID, increment)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 // HandlePanic is part of the QueryService interface.
 func (itc *internalTabletConn) HandlePanic(err *error) {
 }
@@ -888,6 +900,18 @@ func (itmc *internalTabletManagerClient) VExec(context.Context, *topodatapb.Tabl
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetTableGCStatus(context.Context, *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) AdvanceTableGC(context.Context, *topodatapb.Tablet, string, bool, string) (*tabletmanagerdatapb.GCTable, string, error) {
+	return nil, "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) UpgradeMysqld(context.Context, *topodatapb.Tablet, []string, bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) VReplicationExec(context.Context, *topodatapb.Tablet, string) (*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -896,6 +920,10 @@ func (itmc *internalTabletManagerClient) VReplicationWaitForPos(context.Context,
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) VReplicationProgress(context.Context, *topodatapb.Tablet, string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ResetReplication(context.Context, *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/http"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -39,11 +40,13 @@ import (
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/concurrency"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 
 	"vitess.io/vitess/go/vt/schemamanager"
 
@@ -140,6 +143,156 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// ApplyDeclarativeSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyDeclarativeSchema(ctx context.Context, req *vtctldatapb.ApplyDeclarativeSchemaRequest) (*vtctldatapb.ApplyDeclarativeSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyDeclarativeSchema")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("execute", req.Execute)
+	span.Annotate("allow_destructive", req.AllowDestructive)
+	span.Annotate("ddl_strategy", req.DdlStrategy)
+
+	desired, err := schemadiff.NewSchemaFromSQL(req.Sql)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid desired schema: %v", err)
+	}
+
+	ddlStrategy := req.DdlStrategy
+	if ddlStrategy == "" {
+		ddlStrategy = string(schema.DDLStrategyVitess)
+	}
+	setting, err := schema.ParseDDLStrategy(ddlStrategy)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid DdlStrategy: %s", ddlStrategy)
+	}
+	if setting.Strategy.IsDirect() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "DdlStrategy must be an online strategy, the plan is executed as online DDL migrations")
+	}
+	if setting.IsDeclarative() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "DdlStrategy must not be declarative, the plan is already computed from the desired schema")
+	}
+
+	sourceTablet, current, err := s.getDeclarativeSchemaSource(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := current.Diff(desired, &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementIgnore})
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot diff the schema of %s with the desired schema", topoproto.TabletAliasString(sourceTablet))
+	}
+
+	resp := &vtctldatapb.ApplyDeclarativeSchemaResponse{
+		SourceTablet: sourceTablet,
+	}
+	var (
+		statements  []string
+		destructive []string
+	)
+	for _, d := range diffs {
+		statement := d.Diff.StatementString()
+		resp.Changes = append(resp.Changes, &vtctldatapb.DeclarativeSchemaChange{
+			Name:        d.Name,
+			Statement:   statement,
+			Destructive: d.IsDestructive(),
+			Warnings:    d.Warnings,
+		})
+		statements = append(statements, statement)
+		destructive = append(destructive, d.Warnings...)
+	}
+	if !req.Execute || len(statements) == 0 {
+		return resp, nil
+	}
+	if len(destructive) > 0 && !req.AllowDestructive {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the plan is destructive, and AllowDestructive is not set: %s", strings.Join(destructive, "; "))
+	}
+
+	applyResp, err := s.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+		Keyspace:         req.Keyspace,
+		Sql:              statements,
+		DdlStrategy:      ddlStrategy,
+		MigrationContext: req.MigrationContext,
+		CallerId:         req.CallerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.UuidList = applyResp.UuidList
+	return resp, nil
+}
+
+// getDeclarativeSchemaSource returns the schema of a keyspace that ApplyDeclarativeSchema
+// diffs with the desired schema: the schema of the primary of its first shard, without the
+// internal tables of Online DDL and of the table lifecycle. The schemas of the primaries of
+// the other shards must be the same, since the plan is applied to all of them.
+func (s *VtctldServer) getDeclarativeSchemaSource(ctx context.Context, keyspace string) (*topodatapb.TabletAlias, *schemadiff.Schema, error) {
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(shards) == 0 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s has no shards", keyspace)
+	}
+	sort.Strings(shards)
+
+	var (
+		sourceTablet *topodatapb.TabletAlias
+		source       *schemadiff.Schema
+	)
+	for _, shard := range shards {
+		primary, current, err := s.getShardDeclarativeSchema(ctx, keyspace, shard)
+		if err != nil {
+			return nil, nil, err
+		}
+		if source == nil {
+			sourceTablet, source = primary, current
+			continue
+		}
+		diffs, err := source.Diff(current, &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementIgnore})
+		if err != nil {
+			return nil, nil, vterrors.Wrapf(err, "cannot diff the schema of %s with the schema of %s", topoproto.TabletAliasString(primary), topoproto.TabletAliasString(sourceTablet))
+		}
+		if len(diffs) > 0 {
+			names := make([]string, 0, len(diffs))
+			for _, d := range diffs {
+				names = append(names, d.Name)
+			}
+			return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the shards of keyspace %s don't have the same schema: %s differs from %s in %s",
+				keyspace, topoproto.TabletAliasString(primary), topoproto.TabletAliasString(sourceTablet), strings.Join(names, ", "))
+		}
+	}
+	return sourceTablet, source, nil
+}
+
+// getShardDeclarativeSchema returns the schema of the primary of a shard, without the
+// internal tables of Online DDL and of the table lifecycle.
+func (s *VtctldServer) getShardDeclarativeSchema(ctx context.Context, keyspace, shard string) (*topodatapb.TabletAlias, *schemadiff.Schema, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !si.HasPrimary() {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %s/%s has no primary", keyspace, shard)
+	}
+
+	sd, err := schematools.GetSchema(ctx, s.ts, s.tmc, si.PrimaryAlias, nil, nil, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	queries := make([]string, 0, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		if schema.IsInternalOperationTableName(td.Name) {
+			continue
+		}
+		queries = append(queries, td.Schema)
+	}
+	current, err := schemadiff.NewSchemaFromQueries(queries)
+	if err != nil {
+		return nil, nil, vterrors.Wrapf(err, "cannot read the schema of %s", topoproto.TabletAliasString(si.PrimaryAlias))
+	}
+	return si.PrimaryAlias, current, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -148,6 +301,10 @@ func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.A
 	span.Annotate("skip_rebuild", req.SkipRebuild)
 	span.Annotate("rebuild_cells", strings.Join(req.RebuildCells, ","))
 
+	if err := s.validateRoutingRuleWrites(ctx, req.RoutingRules); err != nil {
+		return nil, err
+	}
+
 	if err := s.ts.SaveRoutingRules(ctx, req.RoutingRules); err != nil {
 		return nil, err
 	}
@@ -166,6 +323,131 @@ func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.A
 	return resp, nil
 }
 
+// validateRoutingRuleWrites validates the routing rules that route the writes
+// of tables apart from their reads against the workflows that replicate the
+// tables into the keyspaces of their reads and writes.
+func (s *VtctldServer) validateRoutingRuleWrites(ctx context.Context, rules *vschemapb.RoutingRules) error {
+	workflows := make(map[string][]*vtctldatapb.Workflow)
+	for _, rule := range rules.GetRules() {
+		if len(rule.WriteTables) == 0 {
+			continue
+		}
+		tables := append(append([]string{}, rule.WriteTables...), rule.ToTables...)
+		for _, table := range tables {
+			keyspace, _ := splitRoutedTable(table)
+			if _, ok := workflows[keyspace]; ok || keyspace == "" {
+				continue
+			}
+			resp, err := s.ws.GetWorkflows(ctx, &vtctldatapb.GetWorkflowsRequest{
+				Keyspace:   keyspace,
+				ActiveOnly: true,
+			})
+			if err != nil {
+				return vterrors.Wrapf(err, "GetWorkflows(%s) failed", keyspace)
+			}
+			workflows[keyspace] = resp.Workflows
+		}
+	}
+	return validateRoutingRuleWrites(rules, workflows)
+}
+
+// validateRoutingRuleWrites validates the routing rules that route the writes
+// of tables apart from their reads, given the active workflows by target
+// keyspace. The writes of a table must reach the table that its reads are
+// routed to: either they are routed to it too, or a running workflow
+// replicates them into it. The writes that are dual-routed to two tables must
+// not also be replicated from one into the other, which would apply them twice.
+func validateRoutingRuleWrites(rules *vschemapb.RoutingRules, workflows map[string][]*vtctldatapb.Workflow) error {
+	for _, rule := range rules.GetRules() {
+		if len(rule.WriteTables) == 0 {
+			continue
+		}
+		if len(rule.WriteTables) == 2 {
+			for i, from := range rule.WriteTables {
+				to := rule.WriteTables[1-i]
+				if states := replicatedTableStates(workflows, from, to); len(states) != 0 {
+					return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the writes of %s can't be dual-routed to %s and %s while a workflow replicates %s into %s: stop the workflow first",
+						rule.FromTable, rule.WriteTables[0], rule.WriteTables[1], from, to)
+				}
+			}
+		}
+		if len(rule.ToTables) == 0 {
+			continue
+		}
+		read := rule.ToTables[0]
+		writesReachReads := false
+		for _, write := range rule.WriteTables {
+			if write == read {
+				writesReachReads = true
+				break
+			}
+			states := replicatedTableStates(workflows, write, read)
+			running := len(states) != 0
+			for _, state := range states {
+				if state != binlogplayer.BlpRunning {
+					running = false
+				}
+			}
+			if running {
+				writesReachReads = true
+				break
+			}
+		}
+		if !writesReachReads {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the reads of %s are routed to %s, which neither its writes nor a running workflow from %s reach",
+				rule.FromTable, read, strings.Join(rule.WriteTables, " or "))
+		}
+	}
+	return nil
+}
+
+// replicatedTableStates returns the states of the streams of the workflows
+// that replicate the from table into the to table, if they are in different
+// keyspaces.
+func replicatedTableStates(workflows map[string][]*vtctldatapb.Workflow, from, to string) []string {
+	fromKeyspace, fromTable := splitRoutedTable(from)
+	toKeyspace, toTable := splitRoutedTable(to)
+	if fromKeyspace == toKeyspace || fromTable != toTable {
+		return nil
+	}
+	var states []string
+	for _, wf := range workflows[toKeyspace] {
+		if wf.Source.GetKeyspace() != fromKeyspace {
+			continue
+		}
+		for _, shardStream := range wf.ShardStreams {
+			for _, stream := range shardStream.Streams {
+				for _, rule := range stream.BinlogSource.GetFilter().GetRules() {
+					if filterRuleMatches(rule.Match, toTable) {
+						states = append(states, stream.State)
+						break
+					}
+				}
+			}
+		}
+	}
+	return states
+}
+
+// filterRuleMatches returns true if the match of a filter rule, a table name
+// or a /regexp/, matches the table.
+func filterRuleMatches(match, table string) bool {
+	if !strings.HasPrefix(match, "/") {
+		return match == table
+	}
+	re, err := regexp.Compile(strings.Trim(match, "/"))
+	return err == nil && re.MatchString(table)
+}
+
+// splitRoutedTable splits the target of a routing rule into its keyspace,
+// which is empty if it's not qualified, and its table.
+func splitRoutedTable(table string) (string, string) {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		return table[:i], table[i+1:]
+	}
+	return "", table
+}
+
 // ApplySchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (resp *vtctldatapb.ApplySchemaResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplySchema")
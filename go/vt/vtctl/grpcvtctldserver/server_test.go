@@ -36,6 +36,7 @@ import (
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -44,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -215,6 +217,164 @@ func TestAddCellsAlias(t *testing.T) {
 	}
 }
 
+func TestApplyDeclarativeSchema(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	primary := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+	otherPrimary := &topodatapb.TabletAlias{Cell: "zone1", Uid: 200}
+	shardSchema := func(customerID string) *tabletmanagerdatapb.SchemaDefinition {
+		return &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				{
+					Name:   "customer",
+					Schema: "CREATE TABLE `customer` (\n  `id` " + customerID + " NOT NULL,\n  `email` varchar(128) DEFAULT NULL,\n  `note` text,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+					Type:   tmutils.TableBaseTable,
+				},
+				{
+					Name:   "corder",
+					Schema: "CREATE TABLE `corder` (\n  `id` bigint NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+					Type:   tmutils.TableBaseTable,
+				},
+				{
+					Name:   "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+					Schema: "CREATE TABLE `_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410` (\n  `id` bigint NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+					Type:   tmutils.TableBaseTable,
+				},
+			},
+		}
+	}
+	tmc := testutil.TabletManagerClient{
+		GetSchemaResults: map[string]struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{
+			topoproto.TabletAliasString(primary): {
+				Schema: shardSchema("bigint"),
+			},
+			// The display widths of MySQL 5.7 are not a difference.
+			topoproto.TabletAliasString(otherPrimary): {
+				Schema: shardSchema("bigint(20)"),
+			},
+		},
+	}
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    primary,
+		Keyspace: "commerce",
+		Shard:    "-80",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &testutil.AddTabletOptions{AlsoSetShardPrimary: true})
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    otherPrimary,
+		Keyspace: "commerce",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &testutil.AddTabletOptions{AlsoSetShardPrimary: true})
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	desired := `
+		create table customer (id bigint not null, email varchar(64), primary key (id));
+		create table corder (id bigint not null, customer_id bigint, primary key (id));
+		create view customer_emails as select id, email from customer;
+	`
+	resp, err := vtctld.ApplyDeclarativeSchema(ctx, &vtctldatapb.ApplyDeclarativeSchemaRequest{
+		Keyspace: "commerce",
+		Sql:      desired,
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.ApplyDeclarativeSchemaResponse{
+		SourceTablet: primary,
+		Changes: []*vtctldatapb.DeclarativeSchemaChange{
+			{
+				Name:      "corder",
+				Statement: "alter table corder add column customer_id bigint",
+			},
+			{
+				Name:        "customer",
+				Statement:   "alter table customer drop column note, modify column email varchar(64)",
+				Destructive: true,
+				Warnings: []string{
+					"drops column note of table customer",
+					"changes column email of table customer from varchar(128) to varchar(64), which may truncate or convert its values",
+				},
+			},
+			{
+				Name:      "customer_emails",
+				Statement: "create view customer_emails as select id, email from customer",
+			},
+		},
+	}, resp)
+
+	tests := []struct {
+		name string
+		req  *vtctldatapb.ApplyDeclarativeSchemaRequest
+		err  string
+	}{
+		{
+			name: "destructive plan",
+			req: &vtctldatapb.ApplyDeclarativeSchemaRequest{
+				Keyspace: "commerce",
+				Sql:      desired,
+				Execute:  true,
+			},
+			err: "the plan is destructive, and AllowDestructive is not set: drops column note of table customer; changes column email",
+		},
+		{
+			name: "not a schema",
+			req: &vtctldatapb.ApplyDeclarativeSchemaRequest{
+				Keyspace: "commerce",
+				Sql:      "create table t (id int primary key); drop table customer",
+			},
+			err: "invalid desired schema",
+		},
+		{
+			name: "direct strategy",
+			req: &vtctldatapb.ApplyDeclarativeSchemaRequest{
+				Keyspace:    "commerce",
+				Sql:         desired,
+				DdlStrategy: "direct",
+			},
+			err: "DdlStrategy must be an online strategy",
+		},
+		{
+			name: "declarative strategy",
+			req: &vtctldatapb.ApplyDeclarativeSchemaRequest{
+				Keyspace:    "commerce",
+				Sql:         desired,
+				DdlStrategy: "vitess -declarative",
+			},
+			err: "DdlStrategy must not be declarative",
+		},
+		{
+			name: "no keyspace",
+			req: &vtctldatapb.ApplyDeclarativeSchemaRequest{
+				Keyspace: "customer",
+				Sql:      desired,
+			},
+			err: "node doesn't exist: keyspaces/customer/shards",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vtctld.ApplyDeclarativeSchema(ctx, tt.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	// The shards must have the same schema.
+	tmc.GetSchemaResults[topoproto.TabletAliasString(otherPrimary)] = struct {
+		Schema *tabletmanagerdatapb.SchemaDefinition
+		Error  error
+	}{Schema: shardSchema("int")}
+	_, err = vtctld.ApplyDeclarativeSchema(ctx, &vtctldatapb.ApplyDeclarativeSchemaRequest{
+		Keyspace: "commerce",
+		Sql:      desired,
+	})
+	assert.EqualError(t, err, "the shards of keyspace commerce don't have the same schema: zone1-0000000200 differs from zone1-0000000100 in customer")
+}
+
 func TestApplyRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -330,6 +490,106 @@ func TestApplyRoutingRules(t *testing.T) {
 	}
 }
 
+func TestValidateRoutingRuleWrites(t *testing.T) {
+	t.Parallel()
+
+	// A MoveTables workflow from commerce to customer that replicates t1.
+	moveTables := func(state string) map[string][]*vtctldatapb.Workflow {
+		return map[string][]*vtctldatapb.Workflow{
+			"customer": {{
+				Name:   "commerce2customer",
+				Source: &vtctldatapb.Workflow_ReplicationLocation{Keyspace: "commerce"},
+				ShardStreams: map[string]*vtctldatapb.Workflow_ShardStream{
+					"-": {
+						Streams: []*vtctldatapb.Workflow_Stream{{
+							State: state,
+							BinlogSource: &binlogdatapb.BinlogSource{
+								Keyspace: "commerce",
+								Filter: &binlogdatapb.Filter{
+									Rules: []*binlogdatapb.Rule{{Match: "/.*/"}},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		}
+	}
+	tests := []struct {
+		name       string
+		rule       *vschemapb.RoutingRule
+		workflows  map[string][]*vtctldatapb.Workflow
+		shouldErr  bool
+		errMessage string
+	}{
+		{
+			name: "writes to the table of the reads",
+			rule: &vschemapb.RoutingRule{
+				FromTable:   "t1",
+				ToTables:    []string{"customer.t1"},
+				WriteTables: []string{"commerce.t1", "customer.t1"},
+			},
+		},
+		{
+			name: "source-only writes replicated to the reads",
+			rule: &vschemapb.RoutingRule{
+				FromTable:   "t1",
+				ToTables:    []string{"customer.t1"},
+				WriteTables: []string{"commerce.t1"},
+			},
+			workflows: moveTables("Running"),
+		},
+		{
+			name: "source-only writes without workflow",
+			rule: &vschemapb.RoutingRule{
+				FromTable:   "t1",
+				ToTables:    []string{"customer.t1"},
+				WriteTables: []string{"commerce.t1"},
+			},
+			shouldErr:  true,
+			errMessage: "neither its writes nor a running workflow",
+		},
+		{
+			name: "source-only writes with a stopped workflow",
+			rule: &vschemapb.RoutingRule{
+				FromTable:   "t1",
+				ToTables:    []string{"customer.t1"},
+				WriteTables: []string{"commerce.t1"},
+			},
+			workflows:  moveTables("Stopped"),
+			shouldErr:  true,
+			errMessage: "neither its writes nor a running workflow",
+		},
+		{
+			name: "dual writes replicated by a workflow",
+			rule: &vschemapb.RoutingRule{
+				FromTable:   "t1",
+				ToTables:    []string{"customer.t1"},
+				WriteTables: []string{"commerce.t1", "customer.t1"},
+			},
+			workflows:  moveTables("Running"),
+			shouldErr:  true,
+			errMessage: "stop the workflow first",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{tt.rule}}
+			err := validateRoutingRuleWrites(rules, tt.workflows)
+			if tt.shouldErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMessage)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestApplyVSchema(t *testing.T) {
 	t.Parallel()
 
@@ -39,6 +39,11 @@ func (client *localVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldat
 	return client.s.AddCellsAlias(ctx, in)
 }
 
+// ApplyDeclarativeSchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyDeclarativeSchema(ctx context.Context, in *vtctldatapb.ApplyDeclarativeSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyDeclarativeSchemaResponse, error) {
+	return client.s.ApplyDeclarativeSchema(ctx, in)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	return client.s.ApplyRoutingRules(ctx, in)
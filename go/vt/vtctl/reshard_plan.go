@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the PlanReshard command, which proposes the target
+// shards of a Reshard workflow from a sample of the keyspace ids of a table,
+// and can create them along with the workflow.
+
+func init() {
+	addCommand("Keyspaces", command{
+		name:   "PlanReshard",
+		method: commandPlanReshard,
+		params: "[--shards=2] [--source_shards=<source_shards>] [--table=<table>] [--sample_size=100000] [--boundary_bytes=2] [--create_shards] [--workflow=<workflow>] [--cells=<cells>] [--tablet_types=<source_tablet_types>] [--skip_schema_copy] <keyspace>",
+		help:   "Samples the keyspace ids of a table on the primaries of the source shards, all the serving shards by default, and proposes --shards target shards that get the same share of its rows. With --create_shards, creates the target shards. With --workflow, also creates them and then the Reshard workflow, once the target shards have primaries.",
+	})
+}
+
+func commandPlanReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	numShards := subFlags.Int("shards", 2, "The number of target shards")
+	sourceShards := subFlags.String("source_shards", "", "The source shards (comma-separated), all the serving shards of the keyspace by default")
+	table := subFlags.String("table", "", "The table whose keyspace ids are sampled, the largest table with a primary vindex that doesn't need lookups by default")
+	sampleSize := subFlags.Int("sample_size", 100000, "The number of rows to sample")
+	boundaryBytes := subFlags.Int("boundary_bytes", 2, "The number of bytes of the keyspace ids that the split points are rounded to")
+	createShards := subFlags.Bool("create_shards", false, "Create the target shards")
+	workflow := subFlags.String("workflow", "", "If set, create the target shards and then the Reshard workflow of this name")
+	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from, for --workflow")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from, for --workflow")
+	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Skip copying of schema to the target shards, for --workflow")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the PlanReshard command")
+	}
+	keyspace := subFlags.Arg(0)
+	var sources []string
+	if *sourceShards != "" {
+		sources = strings.Split(*sourceShards, ",")
+	}
+
+	plan, err := wr.PlanReshard(ctx, keyspace, sources, *numShards, *table, *sampleSize, *boundaryBytes)
+	if err != nil {
+		return err
+	}
+	if err := printJSON(wr.Logger(), plan); err != nil {
+		return err
+	}
+	if !*createShards && *workflow == "" {
+		return nil
+	}
+
+	targets := plan.TargetShardNames()
+	var withoutPrimary []string
+	for _, shard := range targets {
+		err := wr.TopoServer().CreateShard(ctx, keyspace, shard)
+		switch {
+		case err == nil:
+			wr.Logger().Printf("Created shard %s/%s\n", keyspace, shard)
+		case !topo.IsErrType(err, topo.NodeExists):
+			return err
+		}
+		si, err := wr.TopoServer().GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		if !si.HasPrimary() {
+			withoutPrimary = append(withoutPrimary, shard)
+		}
+	}
+	if *workflow == "" {
+		return nil
+	}
+	if len(withoutPrimary) > 0 {
+		wr.Logger().Printf("The target shards %s have no primary yet. Once their tablets are up, create the workflow with:\n"+
+			"Reshard --source_shards=%s --target_shards=%s Create %s.%s\n",
+			strings.Join(withoutPrimary, ","), strings.Join(plan.SourceShards, ","), strings.Join(targets, ","), keyspace, *workflow)
+		return nil
+	}
+	return wr.Reshard(ctx, keyspace, *workflow, plan.SourceShards, targets, *skipSchemaCopy, *cells, *tabletTypes, true, false)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the RestoreReshard command, which restores the backups
+// of a keyspace into a keyspace with another number of shards, through a
+// snapshot keyspace.
+
+func init() {
+	addCommand("Keyspaces", command{
+		name:   "RestoreReshard",
+		method: commandRestoreReshard,
+		params: "[--tables=<tables>] [--exclude=<tables>] [--cells=<cells>] [--tablet_types=<source_tablet_types>] <snapshot_keyspace> <target_keyspace.workflow>",
+		help:   "Copies the tables of a SNAPSHOT keyspace, whose tablets restored the backups of its base keyspace, into a target keyspace with another number of shards. Each target shard gets the rows of its key range, according to the vindexes of the target keyspace, which gets the vschema of the base keyspace if it has no tables. The workflow stops after its copy phase, see Workflow progress.",
+	})
+}
+
+func commandRestoreReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	tables := subFlags.String("tables", "", "The tables to restore (comma-separated), all the tables of the snapshot keyspace by default")
+	excludes := subFlags.String("exclude", "", "Tables to exclude (comma-separated)")
+	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to copy from")
+	tabletTypes := subFlags.String("tablet_types", "REPLICA,RDONLY,PRIMARY", "Tablet types of the snapshot keyspace to copy from")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <snapshot_keyspace> and <target_keyspace.workflow> arguments are required for the RestoreReshard command")
+	}
+	targetKeyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	var tableList, excludeList []string
+	if *tables != "" {
+		tableList = strings.Split(*tables, ",")
+	}
+	if *excludes != "" {
+		excludeList = strings.Split(*excludes, ",")
+	}
+	return wr.RestoreReshard(ctx, workflow, subFlags.Arg(0), targetKeyspace, tableList, excludeList, *cells, *tabletTypes)
+}
@@ -21,8 +21,10 @@ import (
 	"context"
 	"fmt"
 
+	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
@@ -89,3 +91,108 @@ func CopyShardMetadata(ctx context.Context, ts *topo.Server, tmc tmclient.Tablet
 
 	return nil
 }
+
+// maxSchemaObjects is the maximum number of routines or triggers that
+// CopyRoutines and CopyTriggers can copy.
+const maxSchemaObjects = 10000
+
+// CopyRoutines copies the stored procedures and functions of the database of
+// the source tablet to the database of the destination tablet. Like the
+// tables and the views, the routines are selected by their name with the
+// tables and excludeTables filters. The routines that already exist on the
+// destination tablet are left as they are.
+//
+// NOTE: This function assumes that the destination tablet is a primary with
+// binary logging enabled, like CopyShardMetadata.
+func CopyRoutines(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, source *topodatapb.TabletAlias, dest *topodatapb.TabletAlias, tables, excludeTables []string) (int, error) {
+	filter, err := tmutils.NewTableFilter(tables, excludeTables, true)
+	if err != nil {
+		return 0, err
+	}
+	sql := "SELECT routine_type, routine_name FROM information_schema.routines WHERE routine_schema = DATABASE() ORDER BY routine_type, routine_name"
+	return copySchemaObjects(ctx, ts, tmc, source, dest, sql, "routine", func(row sqltypes.Row) bool {
+		return filter.Includes(row[1].ToString(), row[0].ToString())
+	})
+}
+
+// CopyTriggers copies the triggers of the database of the source tablet to
+// the database of the destination tablet, which must already have their
+// tables. The triggers of the tables that the tables and excludeTables
+// filters leave out are not copied. The triggers that already exist on the
+// destination tablet are left as they are.
+//
+// NOTE: This function assumes that the destination tablet is a primary with
+// binary logging enabled, like CopyShardMetadata.
+func CopyTriggers(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, source *topodatapb.TabletAlias, dest *topodatapb.TabletAlias, tables, excludeTables []string) (int, error) {
+	filter, err := tmutils.NewTableFilter(tables, excludeTables, true)
+	if err != nil {
+		return 0, err
+	}
+	// The triggers of the same table and event are created in their order.
+	sql := "SELECT 'TRIGGER', trigger_name, event_object_table FROM information_schema.triggers WHERE trigger_schema = DATABASE() ORDER BY event_object_table, action_timing, event_manipulation, action_order"
+	return copySchemaObjects(ctx, ts, tmc, source, dest, sql, "trigger", func(row sqltypes.Row) bool {
+		return filter.Includes(row[2].ToString(), tmutils.TableBaseTable)
+	})
+}
+
+// copySchemaObjects copies the schema objects that listSQL returns, as rows
+// of their type and their name, with their SHOW CREATE statement. The objects
+// whose row include rejects are not copied. It returns the number of objects
+// that it created on the destination tablet.
+func copySchemaObjects(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, source *topodatapb.TabletAlias, dest *topodatapb.TabletAlias, listSQL string, kind string, include func(row sqltypes.Row) bool) (int, error) {
+	sourceTablet, err := ts.GetTablet(ctx, source)
+	if err != nil {
+		return 0, fmt.Errorf("GetTablet(%v) failed: %w", topoproto.TabletAliasString(source), err)
+	}
+
+	destTablet, err := ts.GetTablet(ctx, dest)
+	if err != nil {
+		return 0, fmt.Errorf("GetTablet(%v) failed: %w", topoproto.TabletAliasString(dest), err)
+	}
+
+	p3qr, err := tmc.ExecuteFetchAsDba(ctx, destTablet.Tablet, false, []byte(listSQL), maxSchemaObjects, false, false)
+	if err != nil {
+		return 0, fmt.Errorf("ExecuteFetchAsDba(%v, false, %v, %d, false, false) failed: %v", topoproto.TabletAliasString(dest), listSQL, maxSchemaObjects, err)
+	}
+	existing := map[string]bool{}
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		existing[row[0].ToString()+" "+row[1].ToString()] = true
+	}
+
+	p3qr, err = tmc.ExecuteFetchAsDba(ctx, sourceTablet.Tablet, false, []byte(listSQL), maxSchemaObjects, false, false)
+	if err != nil {
+		return 0, fmt.Errorf("ExecuteFetchAsDba(%v, false, %v, %d, false, false) failed: %v", topoproto.TabletAliasString(source), listSQL, maxSchemaObjects, err)
+	}
+
+	copied := 0
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		objectType, name := row[0].ToString(), row[1].ToString()
+		if !include(row) {
+			continue
+		}
+		if existing[objectType+" "+name] {
+			log.Infof("The %s %v already exists on the destination tablet %v, skipping its copy.", kind, name, topoproto.TabletAliasString(dest))
+			continue
+		}
+
+		sql := fmt.Sprintf("SHOW CREATE %s %s", objectType, sqlescape.EscapeID(name))
+		showqr, err := tmc.ExecuteFetchAsDba(ctx, sourceTablet.Tablet, false, []byte(sql), 1, false, false)
+		if err != nil {
+			return copied, fmt.Errorf("ExecuteFetchAsDba(%v, false, %v, 1, false, false) failed: %v", topoproto.TabletAliasString(source), sql, err)
+		}
+		// The statement is the third column of SHOW CREATE PROCEDURE,
+		// FUNCTION and TRIGGER.
+		qr := sqltypes.Proto3ToResult(showqr)
+		if len(qr.Rows) == 0 || len(qr.Rows[0]) < 3 || qr.Rows[0][2].IsNull() {
+			return copied, fmt.Errorf("empty create statement for the %s %v on %v", kind, name, topoproto.TabletAliasString(source))
+		}
+
+		create := qr.Rows[0][2].ToString()
+		if _, err := tmc.ExecuteFetchAsDba(ctx, destTablet.Tablet, false, []byte(create), 0, false, false); err != nil {
+			return copied, fmt.Errorf("ExecuteFetchAsDba(%v, false, %v, 0, false, false) failed: %v", topoproto.TabletAliasString(dest), create, err)
+		}
+		copied++
+	}
+
+	return copied, nil
+}
@@ -41,15 +41,12 @@ func CompareSchemas(
 	excludeTables []string,
 	includeViews bool,
 ) (diffs []string, err error) {
-	sourceSchema, err := GetSchema(ctx, ts, tmc, source, tables, excludeTables, includeViews)
+	// Get the schemas of both tablets concurrently.
+	schemas, err := GetSchemas(ctx, ts, tmc, []*topodatapb.TabletAlias{source, dest}, tables, excludeTables, includeViews)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get schema from tablet %v. err: %v", source, err)
-	}
-
-	destSchema, err := GetSchema(ctx, ts, tmc, dest, tables, excludeTables, includeViews)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get schema from tablet %v. err: %v", dest, err)
+		return nil, fmt.Errorf("failed to get schema from tablets %v and %v. err: %v", source, dest, err)
 	}
+	sourceSchema, destSchema := schemas[0], schemas[1]
 
 	return tmutils.DiffSchemaToArray("source", sourceSchema, "dest", destSchema), nil
 }
@@ -18,7 +18,9 @@ package schematools
 
 import (
 	"context"
+	"sync"
 
+	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
@@ -43,3 +45,28 @@ func GetSchema(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerC
 
 	return sd, nil
 }
+
+// GetSchemas gets the schemas of several tablets concurrently, in the order
+// of their aliases.
+func GetSchemas(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, aliases []*topodatapb.TabletAlias, tables []string, excludeTables []string, includeViews bool) ([]*tabletmanagerdatapb.SchemaDefinition, error) {
+	sds := make([]*tabletmanagerdatapb.SchemaDefinition, len(aliases))
+	var wg sync.WaitGroup
+	rec := &concurrency.AllErrorRecorder{}
+	for i, alias := range aliases {
+		wg.Add(1)
+		go func(i int, alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+			sd, err := GetSchema(ctx, ts, tmc, alias, tables, excludeTables, includeViews)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			sds[i] = sd
+		}(i, alias)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	return sds, nil
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// This file contains the ShardCapacityReport command, which gathers the data
+// size, growth and QPS of the shards of a keyspace to plan their resharding.
+// The data size and its growth rate come from the history of table sizes that
+// each tablet keeps across its schema reloads, and are reported in its health
+// stream along with its QPS.
+
+func init() {
+	addCommand("Keyspaces", command{
+		name:   "ShardCapacityReport",
+		method: commandShardCapacityReport,
+		params: "[--max_shard_size_bytes=<bytes>] [--warn_ratio=0.8] [--growth_horizon=720h] [--top_tables=5] [--wait_timeout=10s] <keyspace>",
+		help:   "Reports the data size, largest tables, QPS and growth rate of each shard of the keyspace, and flags the shards that are close to --max_shard_size_bytes or that will reach it within --growth_horizon.",
+	})
+}
+
+// tableCapacity is the size of one of the largest tables of a shard.
+type tableCapacity struct {
+	Name       string `json:"name"`
+	DataLength uint64 `json:"data_length"`
+	RowCount   uint64 `json:"row_count"`
+}
+
+// shardCapacity is the part of the report about one shard.
+type shardCapacity struct {
+	Shard              string           `json:"shard"`
+	Primary            string           `json:"primary,omitempty"`
+	DataSizeBytes      int64            `json:"data_size_bytes"`
+	GrowthBytesPerHour float64          `json:"growth_bytes_per_hour"`
+	QPS                float64          `json:"qps"`
+	LargestTables      []*tableCapacity `json:"largest_tables,omitempty"`
+	SizeRatio          float64          `json:"size_ratio"`
+	// HoursToMaxSize is empty when the shard doesn't grow.
+	HoursToMaxSize     *float64 `json:"hours_to_max_size,omitempty"`
+	ApproachingMaxSize bool     `json:"approaching_max_size"`
+	Errors             []string `json:"errors,omitempty"`
+}
+
+// keyspaceCapacityReport is the output of ShardCapacityReport.
+type keyspaceCapacityReport struct {
+	Keyspace          string           `json:"keyspace"`
+	MaxShardSizeBytes int64            `json:"max_shard_size_bytes"`
+	TotalDataSize     int64            `json:"total_data_size_bytes"`
+	TotalQPS          float64          `json:"total_qps"`
+	Shards            []*shardCapacity `json:"shards"`
+	// ApproachingShards lists the shards to reshard first, the closest to
+	// the maximum size first.
+	ApproachingShards []string `json:"approaching_shards"`
+}
+
+func commandShardCapacityReport(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	maxShardSize := subFlags.Int64("max_shard_size_bytes", 250*1024*1024*1024, "The data size that a shard should not exceed")
+	warnRatio := subFlags.Float64("warn_ratio", 0.8, "Flags the shards whose data size is above this ratio of --max_shard_size_bytes")
+	growthHorizon := subFlags.Duration("growth_horizon", 30*24*time.Hour, "Flags the shards that will reach --max_shard_size_bytes within this period at their current growth rate")
+	topTables := subFlags.Int("top_tables", 5, "The number of largest tables to report for each shard")
+	waitTimeout := subFlags.Duration("wait_timeout", 10*time.Second, "How long to wait for the health of each tablet")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ShardCapacityReport command")
+	}
+	if *maxShardSize <= 0 {
+		return fmt.Errorf("--max_shard_size_bytes must be positive")
+	}
+	keyspace := subFlags.Arg(0)
+	shardNames, err := wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+
+	shards := make([]*shardCapacity, len(shardNames))
+	wg := sync.WaitGroup{}
+	for i, shard := range shardNames {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+			shards[i] = collectShardCapacity(ctx, wr, keyspace, shard, *topTables, *waitTimeout)
+		}(i, shard)
+	}
+	wg.Wait()
+
+	report := buildCapacityReport(keyspace, shards, *maxShardSize, *warnRatio, *growthHorizon)
+	return printJSON(wr.Logger(), report)
+}
+
+// collectShardCapacity gathers the largest tables of the primary of the shard,
+// and the health of all its tablets. The errors are reported in the shard
+// rather than failing the whole report.
+func collectShardCapacity(ctx context.Context, wr *wrangler.Wrangler, keyspace, shard string, topTables int, waitTimeout time.Duration) *shardCapacity {
+	sc := &shardCapacity{Shard: shard}
+	tablets, err := wr.TopoServer().GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		sc.Errors = append(sc.Errors, err.Error())
+		return sc
+	}
+
+	var primary *topodatapb.TabletAlias
+	var primarySize, maxSize int64
+	var primaryGrowth, maxGrowth float64
+	hasPrimaryStats := false
+	for _, ti := range tablets {
+		alias := topoproto.TabletAliasString(ti.Alias)
+		if ti.Type == topodatapb.TabletType_PRIMARY {
+			primary = ti.Alias
+			sc.Primary = alias
+		}
+		stats, err := tabletRealtimeStats(ctx, ti.Tablet, waitTimeout)
+		if err != nil {
+			sc.Errors = append(sc.Errors, fmt.Sprintf("%v: %v", alias, err))
+			continue
+		}
+		sc.QPS += stats.Qps
+		if ti.Type == topodatapb.TabletType_PRIMARY {
+			hasPrimaryStats = true
+			primarySize, primaryGrowth = stats.DataSizeBytes, stats.DataGrowthBytesPerHour
+		}
+		if stats.DataSizeBytes > maxSize {
+			maxSize, maxGrowth = stats.DataSizeBytes, stats.DataGrowthBytesPerHour
+		}
+	}
+	// The replicas hold the same data, but the primary is the reference.
+	if hasPrimaryStats {
+		sc.DataSizeBytes, sc.GrowthBytesPerHour = primarySize, primaryGrowth
+	} else {
+		sc.DataSizeBytes, sc.GrowthBytesPerHour = maxSize, maxGrowth
+	}
+
+	if topTables > 0 && primary != nil {
+		tables, err := largestTables(ctx, wr, primary, topTables)
+		if err != nil {
+			sc.Errors = append(sc.Errors, fmt.Sprintf("%v: %v", sc.Primary, err))
+		}
+		sc.LargestTables = tables
+	}
+	sort.Strings(sc.Errors)
+	return sc
+}
+
+// tabletRealtimeStats returns the first health response of the tablet.
+func tabletRealtimeStats(ctx context.Context, tablet *topodatapb.Tablet, waitTimeout time.Duration) (*querypb.RealtimeStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to tablet: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	var stats *querypb.RealtimeStats
+	err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+		stats = shr.RealtimeStats
+		return io.EOF
+	})
+	if stats == nil {
+		if err == nil {
+			err = fmt.Errorf("health stream ended early")
+		}
+		return nil, err
+	}
+	return stats, nil
+}
+
+// largestTables returns the topTables largest tables of the primary.
+func largestTables(ctx context.Context, wr *wrangler.Wrangler, primary *topodatapb.TabletAlias, topTables int) ([]*tableCapacity, error) {
+	resp, err := wr.VtctldServer().GetSchema(ctx, &vtctldatapb.GetSchemaRequest{
+		TabletAlias:    primary,
+		TableSizesOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var tables []*tableCapacity
+	for _, td := range resp.Schema.TableDefinitions {
+		tables = append(tables, &tableCapacity{Name: td.Name, DataLength: td.DataLength, RowCount: td.RowCount})
+	}
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].DataLength > tables[j].DataLength
+	})
+	if len(tables) > topTables {
+		tables = tables[:topTables]
+	}
+	return tables, nil
+}
+
+// buildCapacityReport sums up the shards of the keyspace, and flags the shards
+// whose size is above warnRatio of maxShardSize, or that will reach
+// maxShardSize within growthHorizon.
+func buildCapacityReport(keyspace string, shards []*shardCapacity, maxShardSize int64, warnRatio float64, growthHorizon time.Duration) *keyspaceCapacityReport {
+	report := &keyspaceCapacityReport{
+		Keyspace:          keyspace,
+		MaxShardSizeBytes: maxShardSize,
+		Shards:            shards,
+		ApproachingShards: []string{},
+	}
+	var approaching []*shardCapacity
+	for _, sc := range shards {
+		report.TotalDataSize += sc.DataSizeBytes
+		report.TotalQPS += sc.QPS
+		sc.SizeRatio = float64(sc.DataSizeBytes) / float64(maxShardSize)
+		if sc.GrowthBytesPerHour > 0 {
+			hours := math.Max(0, float64(maxShardSize-sc.DataSizeBytes)/sc.GrowthBytesPerHour)
+			sc.HoursToMaxSize = &hours
+		}
+		sc.ApproachingMaxSize = sc.SizeRatio >= warnRatio ||
+			(sc.HoursToMaxSize != nil && *sc.HoursToMaxSize <= growthHorizon.Hours())
+		if sc.ApproachingMaxSize {
+			approaching = append(approaching, sc)
+		}
+	}
+	sort.SliceStable(approaching, func(i, j int) bool {
+		return approaching[i].SizeRatio > approaching[j].SizeRatio
+	})
+	for _, sc := range approaching {
+		report.ApproachingShards = append(report.ApproachingShards, sc.Shard)
+	}
+	return report
+}
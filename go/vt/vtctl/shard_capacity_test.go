@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCapacityReport(t *testing.T) {
+	shards := []*shardCapacity{{
+		// Small and not growing.
+		Shard:         "-40",
+		DataSizeBytes: 100,
+		QPS:           10,
+	}, {
+		// Above the warning ratio.
+		Shard:         "40-80",
+		DataSizeBytes: 850,
+		QPS:           20,
+	}, {
+		// Small, but will reach the maximum within the horizon.
+		Shard:              "80-c0",
+		DataSizeBytes:      400,
+		GrowthBytesPerHour: 1,
+		QPS:                30,
+	}, {
+		// Growing too slowly to matter within the horizon.
+		Shard:              "c0-",
+		DataSizeBytes:      400,
+		GrowthBytesPerHour: 0.1,
+		QPS:                40,
+	}}
+	report := buildCapacityReport("ks", shards, 1000, 0.8, 30*24*time.Hour)
+
+	assert.Equal(t, "ks", report.Keyspace)
+	assert.EqualValues(t, 1000, report.MaxShardSizeBytes)
+	assert.EqualValues(t, 1750, report.TotalDataSize)
+	assert.Equal(t, 100.0, report.TotalQPS)
+	assert.Equal(t, []string{"40-80", "80-c0"}, report.ApproachingShards)
+
+	assert.Equal(t, 0.1, shards[0].SizeRatio)
+	assert.Nil(t, shards[0].HoursToMaxSize)
+	assert.False(t, shards[0].ApproachingMaxSize)
+	assert.Equal(t, 0.85, shards[1].SizeRatio)
+	assert.True(t, shards[1].ApproachingMaxSize)
+	require.NotNil(t, shards[2].HoursToMaxSize)
+	assert.Equal(t, 600.0, *shards[2].HoursToMaxSize)
+	assert.True(t, shards[2].ApproachingMaxSize)
+	require.NotNil(t, shards[3].HoursToMaxSize)
+	assert.InDelta(t, 6000.0, *shards[3].HoursToMaxSize, 0.001)
+	assert.False(t, shards[3].ApproachingMaxSize)
+}
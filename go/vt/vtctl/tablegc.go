@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file contains the commands that list the tables in the lifecycle of
+// table GC (the _vt_HOLD_, _vt_PURGE_, _vt_EVAC_ and _vt_DROP_ tables) on a
+// tablet, and that move a table on to its next state without waiting for its
+// scheduled time, or take it out of its lifecycle.
+
+func init() {
+	addCommand("Schema, Version, Permissions", command{
+		name:   "GetTableGCStatus",
+		method: commandGetTableGCStatus,
+		params: "[--json] <tablet alias>",
+		help:   "Lists the tables in the lifecycle of table GC on a tablet, with their state, the time table GC moves them on to their next state, and the rows left to purge.",
+	})
+	addCommand("Schema, Version, Permissions", command{
+		name:   "AdvanceTableGC",
+		method: commandAdvanceTableGC,
+		params: "[--cancel --restore_table_name=<table>] <tablet alias> <GC table>",
+		help:   "Moves a GC table of a primary tablet on to the next state of its lifecycle right away, regardless of its scheduled time; a table in DROP state is dropped. With --cancel, takes the table out of its lifecycle instead, renaming it to --restore_table_name.",
+	})
+}
+
+// gcTablesResult formats the GC tables as a query result.
+func gcTablesResult(tables []*tabletmanagerdatapb.GCTable) *sqltypes.Result {
+	qr := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "table", Type: sqltypes.VarChar},
+			{Name: "state", Type: sqltypes.VarChar},
+			{Name: "scheduled_time", Type: sqltypes.VarChar},
+			{Name: "next_state", Type: sqltypes.VarChar},
+			{Name: "type", Type: sqltypes.VarChar},
+			{Name: "estimated_rows", Type: sqltypes.Int64},
+			{Name: "purging", Type: sqltypes.VarChar},
+		},
+	}
+	for _, table := range tables {
+		tableType := "BASE TABLE"
+		if table.IsView {
+			tableType = "VIEW"
+		}
+		purging := "no"
+		if table.Purging {
+			purging = "yes"
+		}
+		qr.Rows = append(qr.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(table.Name),
+			sqltypes.NewVarChar(table.State),
+			sqltypes.NewVarChar(logutil.ProtoToTime(table.ScheduledTime).UTC().Format("2006-01-02 15:04:05")),
+			sqltypes.NewVarChar(table.NextState),
+			sqltypes.NewVarChar(tableType),
+			sqltypes.NewInt64(table.EstimatedRows),
+			sqltypes.NewVarChar(purging),
+		})
+	}
+	return qr
+}
+
+func commandGetTableGCStatus(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetTableGCStatus command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	tables, err := wr.TabletManagerClient().GetTableGCStatus(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	if *json {
+		return printJSON(wr.Logger(), &tabletmanagerdatapb.GetTableGCStatusResponse{Tables: tables})
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, gcTablesResult(tables))
+	return nil
+}
+
+func commandAdvanceTableGC(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cancel := subFlags.Bool("cancel", false, "Takes the table out of its lifecycle, instead of moving it on to its next state")
+	restoreTableName := subFlags.String("restore_table_name", "", "The name the table is renamed to with --cancel")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <tablet alias> and <GC table> arguments are required for the AdvanceTableGC command")
+	}
+	if *cancel != (*restoreTableName != "") {
+		return fmt.Errorf("--cancel and --restore_table_name go together")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	table, newTableName, err := wr.TabletManagerClient().AdvanceTableGC(ctx, tabletInfo.Tablet, subFlags.Arg(1), *cancel, *restoreTableName)
+	if err != nil {
+		return err
+	}
+	switch {
+	case *cancel:
+		wr.Logger().Printf("Restored %s table %s to %s\n", table.State, table.Name, newTableName)
+	case newTableName == "":
+		wr.Logger().Printf("Dropped %s table %s\n", table.State, table.Name)
+	default:
+		wr.Logger().Printf("Moved %s table %s on to %s: %s\n", table.State, table.Name, table.NextState, newTableName)
+	}
+	return nil
+}
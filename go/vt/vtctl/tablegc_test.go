@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
+)
+
+func TestGCTablesResult(t *testing.T) {
+	qr := gcTablesResult([]*tabletmanagerdatapb.GCTable{
+		{
+			Name:          "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			State:         "PURGE",
+			ScheduledTime: &vttimepb.Time{Seconds: 1600171450},
+			NextState:     "EVAC",
+			EstimatedRows: 1000,
+			Purging:       true,
+		},
+		{
+			Name:          "_vt_DROP_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			State:         "DROP",
+			ScheduledTime: &vttimepb.Time{Seconds: 1600171450},
+			IsView:        true,
+		},
+	})
+	assert.Len(t, qr.Fields, 7)
+	assert.Equal(t, [][]sqltypes.Value{
+		{
+			sqltypes.NewVarChar("_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"),
+			sqltypes.NewVarChar("PURGE"),
+			sqltypes.NewVarChar("2020-09-15 12:04:10"),
+			sqltypes.NewVarChar("EVAC"),
+			sqltypes.NewVarChar("BASE TABLE"),
+			sqltypes.NewInt64(1000),
+			sqltypes.NewVarChar("yes"),
+		},
+		{
+			sqltypes.NewVarChar("_vt_DROP_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"),
+			sqltypes.NewVarChar("DROP"),
+			sqltypes.NewVarChar("2020-09-15 12:04:10"),
+			sqltypes.NewVarChar(""),
+			sqltypes.NewVarChar("VIEW"),
+			sqltypes.NewInt64(0),
+			sqltypes.NewVarChar("no"),
+		},
+	}, qr.Rows)
+}
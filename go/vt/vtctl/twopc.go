@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// These commands manage the prepared transactions of the 2PC redo log of a
+// tablet, which vttablet keeps in the _vt.redo_state and _vt.redo_statement
+// tables. A state of 1 is a prepared transaction, and 0 a failed one.
+//
+// The distributed transactions themselves, coordinated by the metadata
+// manager shard of each dtid, are read and resolved through a vtgate.
+
+const (
+	sqlListPreparedTransactions = `select t.dtid, if(t.state = 1, 'PREPARED', 'FAILED') as state, from_unixtime(t.time_created div 1000000000) as time_created, count(s.id) as statements
+	from _vt.redo_state as t left join _vt.redo_statement as s on t.dtid = s.dtid
+	group by t.dtid, t.state, t.time_created
+	order by t.time_created, t.dtid`
+
+	sqlListPreparedStatements = `select t.dtid, if(t.state = 1, 'PREPARED', 'FAILED') as state, s.id, s.statement
+	from _vt.redo_state as t join _vt.redo_statement as s on t.dtid = s.dtid
+	order by t.time_created, t.dtid, s.id`
+)
+
+func init() {
+	addCommand("Tablets", command{
+		name:   "ListPreparedTransactions",
+		method: commandListPreparedTransactions,
+		params: "[-statements] [-json] <tablet alias>",
+		help:   "Lists the prepared and failed transactions of the 2PC redo log of a tablet, with the number of their statements, or the statements themselves with -statements.",
+	})
+	addCommand("Tablets", command{
+		name:   "ResolvePreparedTransaction",
+		method: commandResolvePreparedTransaction,
+		params: "<tablet alias> <dtid> <commit|rollback>",
+		help:   "Commits or rolls back a prepared transaction of the 2PC redo log of a primary tablet, and removes it from the redo log. A failed transaction can only be rolled back.",
+	})
+	addCommand("Generic", command{
+		name:   "GetDistributedTransactions",
+		method: commandGetDistributedTransactions,
+		params: "--server <vtgate> [--keyspace <keyspace>] [--dtid <dtid>]",
+		help:   "Displays the unresolved distributed (2PC) transactions, with the prepared state of each participant shard, as seen by the vtgate server.",
+	})
+	addCommand("Generic", command{
+		name:   "ResolveDistributedTransaction",
+		method: commandResolveDistributedTransaction,
+		params: "--server <vtgate> <dtid> <commit|rollback>",
+		help:   "Commits or rolls back a stuck distributed (2PC) transaction through the vtgate server. A transaction can only be committed once the decision to commit was recorded, and cannot be rolled back after that.",
+	})
+}
+
+func commandListPreparedTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	statements := subFlags.Bool("statements", false, "List the statements of the transactions")
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the ListPreparedTransactions command")
+	}
+	alias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	query := sqlListPreparedTransactions
+	if *statements {
+		query = sqlListPreparedStatements
+	}
+	qrproto, err := wr.ExecuteFetchAsDba(ctx, alias, query, 10000, false, false)
+	if err != nil {
+		return err
+	}
+	qr := sqltypes.Proto3ToResult(qrproto)
+	if *json {
+		return printJSON(wr.Logger(), qr)
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
+func commandResolvePreparedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <tablet alias>, <dtid> and <commit|rollback> arguments are required for the ResolvePreparedTransaction command")
+	}
+	alias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	dtid := subFlags.Arg(1)
+	action := subFlags.Arg(2)
+	if action != "commit" && action != "rollback" {
+		return fmt.Errorf("invalid action %q for the ResolvePreparedTransaction command, expected commit or rollback", action)
+	}
+
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, alias)
+	if err != nil {
+		return err
+	}
+	conn, err := tabletconn.GetDialer()(tabletInfo.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return fmt.Errorf("cannot connect to tablet %v: %v", alias, err)
+	}
+	defer conn.Close(ctx)
+
+	target := &querypb.Target{
+		Keyspace:   tabletInfo.Tablet.Keyspace,
+		Shard:      tabletInfo.Tablet.Shard,
+		TabletType: tabletInfo.Tablet.Type,
+	}
+	if action == "commit" {
+		err = conn.CommitPrepared(ctx, target, dtid)
+	} else {
+		err = conn.RollbackPrepared(ctx, target, dtid, 0)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot %s the prepared transaction %s on tablet %v: %v", action, dtid, alias, err)
+	}
+	wr.Logger().Printf("%s of the prepared transaction %s on tablet %v succeeded\n", action, dtid, alias)
+	return nil
+}
+
+func commandGetDistributedTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "VtGate server to connect to")
+	keyspace := subFlags.String("keyspace", "", "Only displays the transactions coordinated by this keyspace")
+	dtid := subFlags.String("dtid", "", "Only displays this transaction")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the GetDistributedTransactions command does not take any argument")
+	}
+
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+
+	transactions, err := vtgateConn.ReadTransactions(ctx, *keyspace, *dtid)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), &vtgatepb.ReadTransactionsResponse{Transactions: transactions})
+}
+
+func commandResolveDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "VtGate server to connect to")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <dtid> and <commit|rollback> arguments are required for the ResolveDistributedTransaction command")
+	}
+	dtid := subFlags.Arg(0)
+	var decision querypb.TransactionState
+	switch strings.ToLower(subFlags.Arg(1)) {
+	case "commit":
+		decision = querypb.TransactionState_COMMIT
+	case "rollback":
+		decision = querypb.TransactionState_ROLLBACK
+	default:
+		return fmt.Errorf("invalid decision %q, expected commit or rollback", subFlags.Arg(1))
+	}
+
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+
+	if err := vtgateConn.ForceResolveTransaction(ctx, dtid, decision); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Distributed transaction %s resolved: %v\n", dtid, decision)
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the actions of VDiff -v2, whose diffs are run by the
+// primaries of the target shards of the workflow.
+
+// runVDiff2 runs an action of VDiff -v2 on a workflow. The uuid is the one
+// of the diff to create, optional, or to act on.
+func runVDiff2(ctx context.Context, wr *wrangler.Wrangler, keyspace, workflow, action, uuid string, options *vdiff.Options) error {
+	if action == "" {
+		action = "create"
+	}
+	if uuid == "" && action != "create" && action != "list" {
+		return fmt.Errorf("the uuid of the vdiff is required for the %s action", action)
+	}
+
+	var output any
+	switch action {
+	case "create":
+		uuid, err := wr.VDiff2Create(ctx, keyspace, workflow, uuid, options)
+		if err != nil {
+			return err
+		}
+		output = map[string]string{"UUID": uuid}
+	case "show":
+		summary, err := wr.VDiff2Show(ctx, keyspace, workflow, uuid)
+		if err != nil {
+			return err
+		}
+		output = summary
+	case "list":
+		summaries, err := wr.VDiff2List(ctx, keyspace, workflow)
+		if err != nil {
+			return err
+		}
+		output = summaries
+	case "stop":
+		if err := wr.VDiff2Stop(ctx, keyspace, workflow, uuid); err != nil {
+			return err
+		}
+		output = map[string]string{"UUID": uuid, "Status": "stopped"}
+	case "resume":
+		if err := wr.VDiff2Resume(ctx, keyspace, workflow, uuid); err != nil {
+			return err
+		}
+		output = map[string]string{"UUID": uuid, "Status": "resumed"}
+	case "delete":
+		if err := wr.VDiff2Delete(ctx, keyspace, workflow, uuid); err != nil {
+			return err
+		}
+		output = map[string]string{"UUID": uuid, "Status": "deleted"}
+	default:
+		return fmt.Errorf("unknown VDiff -v2 action: %s", action)
+	}
+	return printJSON(wr.Logger(), output)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// These commands manage the checkpoints of the named VStream consumers,
+// which vtgate saves in the _vt.vstream_checkpoints table of the primary of
+// its -vstream_checkpoints_keyspace.
+
+const (
+	sqlListVStreamCheckpoints = `select name, vgtid, from_unixtime(time_updated div 1000000000) as time_updated
+	from _vt.vstream_checkpoints
+	order by name`
+
+	sqlDeleteVStreamCheckpoint = "delete from _vt.vstream_checkpoints where name = %a"
+)
+
+func init() {
+	addCommand("Keyspaces", command{
+		name:   "ListVStreamCheckpoints",
+		method: commandListVStreamCheckpoints,
+		params: "[-json] <keyspace>",
+		help:   "Lists the named VStream consumers, with the last VGTID that each of them acknowledged, saved in the _vt database of the primary of the unsharded keyspace.",
+	})
+	addCommand("Keyspaces", command{
+		name:   "ResetVStreamCheckpoint",
+		method: commandResetVStreamCheckpoint,
+		params: "<keyspace> <name>",
+		help:   "Deletes the checkpoint of a named VStream consumer from the unsharded keyspace, so that its next stream starts from the VGTID it requests.",
+	})
+}
+
+// vstreamCheckpointsPrimary returns the primary of the single shard of the
+// keyspace that holds the checkpoints.
+func vstreamCheckpointsPrimary(ctx context.Context, wr *wrangler.Wrangler, keyspace string) (*topodatapb.TabletAlias, error) {
+	shards, err := wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if len(shards) != 1 {
+		return nil, fmt.Errorf("the VStream checkpoints keyspace %s must be unsharded, it has %d shards", keyspace, len(shards))
+	}
+	si, err := wr.TopoServer().GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasPrimary() {
+		return nil, fmt.Errorf("shard %s/%s has no primary", keyspace, shards[0])
+	}
+	return si.PrimaryAlias, nil
+}
+
+func commandListVStreamCheckpoints(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ListVStreamCheckpoints command")
+	}
+	alias, err := vstreamCheckpointsPrimary(ctx, wr, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	qrproto, err := wr.ExecuteFetchAsDba(ctx, alias, sqlListVStreamCheckpoints, 10000, false, false)
+	if err != nil {
+		return err
+	}
+	qr := sqltypes.Proto3ToResult(qrproto)
+	if *json {
+		return printJSON(wr.Logger(), qr)
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
+func commandResetVStreamCheckpoint(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <name> arguments are required for the ResetVStreamCheckpoint command")
+	}
+	name := subFlags.Arg(1)
+	alias, err := vstreamCheckpointsPrimary(ctx, wr, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	query, err := sqlparser.ParseAndBind(sqlDeleteVStreamCheckpoint, sqltypes.StringBindVariable(name))
+	if err != nil {
+		return err
+	}
+	qr, err := wr.ExecuteFetchAsDba(ctx, alias, query, 1, false, false)
+	if err != nil {
+		return err
+	}
+	if qr.RowsAffected == 0 {
+		return fmt.Errorf("VStream consumer %s has no checkpoint", name)
+	}
+	wr.Logger().Printf("The checkpoint of VStream consumer %s was reset\n", name)
+	return nil
+}
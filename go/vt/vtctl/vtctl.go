@@ -83,6 +83,7 @@ COMMAND ARGUMENT DEFINITIONS
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -95,6 +96,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"vitess.io/vitess/go/textutil"
 
@@ -106,10 +109,12 @@ import (
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dynamicconfig/dynamicconfigclient"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -117,8 +122,11 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	dynamicconfigdatapb "vitess.io/vitess/go/vt/proto/dynamicconfigdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -322,6 +330,13 @@ var commands = []commandGroup{
 				params: "<keyspace/shard>",
 				help:   "Lists all tablets in the specified shard.",
 			},
+			{
+				name:   "UpgradeShardMysql",
+				method: commandUpgradeShardMysql,
+				params: "[-include_primary] [-skip_mysql_upgrade] [-healthy_timeout=<duration>] [-max_replication_lag=<duration>] [-wait_replicas_timeout=<duration>] <keyspace/shard> [<hook param1> <hook param2> ...]",
+				help: "Upgrades the mysqld of the replica and rdonly tablets of the shard in place, one tablet at a time. Each tablet is drained, runs the swap_mysqld_binaries hook with the given parameters and mysql_upgrade, and goes back to serving once it replicates again. The upgrade stops at the first tablet that fails, and leaves it DRAINED.\n" +
+					"With -include_primary, the primary is then upgraded too, after a planned reparent away from it.",
+			},
 			{
 				name:   "SetShardIsPrimaryServing",
 				method: commandSetShardIsPrimaryServing,
@@ -478,8 +493,8 @@ var commands = []commandGroup{
 			{
 				name:   "CreateLookupVindex",
 				method: commandCreateLookupVindex,
-				params: "[-cell=<source_cells> DEPRECATED] [-cells=<source_cells>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
-				help:   `Create and backfill a lookup vindex. the json_spec must contain the vindex and colvindex specs for the new lookup.`,
+				params: "[-cell=<source_cells> DEPRECATED] [-cells=<source_cells>] [-tablet_types=<source_tablet_types>] [-externalize] [-check_interval=10s] <keyspace> <json_spec>",
+				help:   `Create and backfill a lookup vindex. the json_spec must contain the vindex and colvindex specs for the new lookup. With -externalize, wait for the backfill to complete and externalize the vindex once the lookup table is verified.`,
 			},
 			{
 				name:   "ExternalizeVindex",
@@ -487,6 +502,18 @@ var commands = []commandGroup{
 				params: "<keyspace>.<vindex>",
 				help:   `Externalize a backfilled vindex.`,
 			},
+			{
+				name:   "CompleteLookupVindex",
+				method: commandCompleteLookupVindex,
+				params: "[-check_interval=10s] <keyspace>.<vindex>",
+				help:   `Wait for the backfill of a lookup vindex to complete, logging its progress, then verify the lookup table against its owner table and externalize the vindex. The progress is also shown by Workflow show.`,
+			},
+			{
+				name:   "RebuildLookupVindex",
+				method: commandRebuildLookupVindex,
+				params: "[-cells=<source_cells>] [-tablet_types=<source_tablet_types>] [-check_interval=10s] [-converged_checks=3] <keyspace>.<vindex> <json_spec>",
+				help:   `Rebuild a lookup vindex into a new lookup table, e.g. to change its from columns. The json_spec is that of CreateLookupVindex for the new vindex, which must have the same owner. Both lookup tables are written while the new one is backfilled and verified against the owner table, until the new vindex replaces the old one in the vschema. Can be rerun to resume.`,
+			},
 			{
 				name:   "Materialize",
 				method: commandMaterialize,
@@ -510,8 +537,8 @@ var commands = []commandGroup{
 			{
 				name:   "VDiff",
 				method: commandVDiff,
-				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] [-max_extra_rows_to_compare=1000] <keyspace.workflow>",
-				help:   "Perform a diff of all tables in the workflow",
+				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] [-max_extra_rows_to_compare=1000] [-v2] [-chunk_size=100000] <keyspace.workflow> [create|show|list|stop|resume|delete] [<uuid>|last|all]",
+				help:   "Perform a diff of all tables in the workflow. With -v2, the diff is run by the primaries of the target shards, in chunks of -chunk_size rows whose progress is saved, and the action is one of create (the default), show, list, stop, resume and delete. show takes the uuid of the diff or last, delete its uuid or all.",
 			},
 			{
 				name:   "MigrateServedTypes",
@@ -597,6 +624,12 @@ var commands = []commandGroup{
 				params: "[-num_shards 2]",
 				help:   "Generates shard ranges assuming a keyspace with N shards.",
 			},
+			{
+				name:   "DecodeObfuscatedIds",
+				method: commandDecodeObfuscatedIds,
+				params: "<obfuscated_id> ...",
+				help:   "Decodes the keyspace ids and key values that vtgate and vttablet obfuscated in their errors, logs and traces with -id_obfuscation=encrypt. vtctld must run with the same -id_obfuscation and -id_obfuscation_key_file. Prints the ids in hex, and as text when they are printable.",
+			},
 			{
 				name:   "Panic",
 				method: commandPanic,
@@ -677,7 +710,7 @@ var commands = []commandGroup{
 			{
 				name:   "CopySchemaShard",
 				method: commandCopySchemaShard,
-				params: "[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
+				params: "[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-include-routines] [-include-triggers] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				help:   "Copies the schema from a source shard's primary (or a specific tablet) to a destination shard. The schema is applied directly on the primary of the destination shard, and it is propagated to the replicas through binlogs.",
 			},
 			{
@@ -691,7 +724,9 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cutover 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace abort 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
 			},
 			{
 				name:   "ValidateVersionShard",
@@ -705,6 +740,60 @@ var commands = []commandGroup{
 				params: "<keyspace name>",
 				help:   "Validates that the version on primary of shard 0 matches all of the other tablets in the keyspace.",
 			},
+			{
+				name:   "GetVersions",
+				method: commandGetVersions,
+				params: "[--cells=c1,c2,...]",
+				help:   "Displays the version, the git revision and the capabilities of vtctld and of all the tablets of the cells, to spot version skew across the fleet.",
+			},
+			{
+				name:   "GetDynamicConfig",
+				method: commandGetDynamicConfig,
+				params: "<vtgate|vttablet>",
+				help:   "Displays the flags that the dynamic configuration in the topo overrides at runtime for all the vtgates or all the vttablets.",
+			},
+			{
+				name:   "SetDynamicConfig",
+				method: commandSetDynamicConfig,
+				params: "[--reset] <vtgate|vttablet> <flag name> [<value>]",
+				help:   "Overrides a flag at runtime for all the vtgates or all the vttablets, or with --reset removes the override and restores the value the flag had at startup.",
+			},
+			{
+				name:   "GetServerDynamicConfig",
+				method: commandGetServerDynamicConfig,
+				params: "--server <vtgate or vttablet>",
+				help:   "Displays the flags that can be changed at runtime on a vtgate or a vttablet, with the last changes. The server must run with the grpc-dynamicconfig service.",
+			},
+			{
+				name:   "SetServerDynamicConfig",
+				method: commandSetServerDynamicConfig,
+				params: "--server <vtgate or vttablet> [--reset] <flag name> [<value>]",
+				help:   "Changes a flag at runtime on a vtgate or a vttablet, or with --reset restores the value the flag had before it was changed. The server must run with the grpc-dynamicconfig service.",
+			},
+			{
+				name:   "GetFeatureFlags",
+				method: commandGetFeatureFlags,
+				params: "<keyspace>",
+				help:   "Displays the feature flags of a keyspace.",
+			},
+			{
+				name:   "SetFeatureFlag",
+				method: commandSetFeatureFlag,
+				params: "[--reset] <keyspace> <flag name> [<value>]",
+				help:   "Sets a feature flag of a keyspace, such as gen4_planner=true, which the vtgates and the vttablets of the keyspace apply without a restart. With --reset, removes the flag, so that the features use their default.",
+			},
+			{
+				name:   "GetTableGCConfig",
+				method: commandGetTableGCConfig,
+				params: "<keyspace>",
+				help:   "Displays the table GC configuration of a keyspace.",
+			},
+			{
+				name:   "SetTableGCConfig",
+				method: commandSetTableGCConfig,
+				params: "[--reset] [--table=<table>] <keyspace> <lifecycle|hold|evac> [<value>]",
+				help:   "Sets the lifecycle (e.g. hold,purge,evac,drop) or the HOLD or EVAC period (e.g. 72h) of the tables dropped in a keyspace, or with --table of one of its tables, overriding -table_gc_lifecycle and -retain_online_ddl_tables of its vttablets without a restart. With --reset, removes the setting, so that the tables use the one of the keyspace, or the flags.",
+			},
 			{
 				name:   "GetPermissions",
 				method: commandGetPermissions,
@@ -735,6 +824,18 @@ var commands = []commandGroup{
 				params: "{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
 				help:   "Applies the VTGate routing schema to the provided keyspace. Shows the result after application.",
 			},
+			{
+				name:   "ApplyVSchemaBatch",
+				method: commandApplyVSchemaBatch,
+				params: "{-vschemas=<vschemas> || -vschemas_file=<vschemas file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run]",
+				help:   "Applies the VTGate routing schemas of several keyspaces at once, given as a JSON object keyed by keyspace. The vschemas are validated together with the vschemas of the other keyspaces, and published to the vtgates in a single rebuild. Shows the changes of the routing of the tables.",
+			},
+			{
+				name:   "LintVSchema",
+				method: commandLintVSchema,
+				params: "[-keyspaces=ks1,ks2,...] [-min_severity=INFO]",
+				help:   "Analyzes the VTGate routing schemas of all the keyspaces and the routing rules, and displays their mistakes and likely mistakes as JSON, with their severities. Fails if there is an ERROR.",
+			},
 			{
 				name:   "GetRoutingRules",
 				method: commandGetRoutingRules,
@@ -809,7 +910,7 @@ var commands = []commandGroup{
 				name:   "Workflow",
 				method: commandWorkflow,
 				params: "<ks.workflow> <action> --dry-run",
-				help:   "Start/Stop/Delete/Show/ListAll/Tags Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
+				help:   "Start/Stop/Delete/Show/ListAll/Tags/Progress Workflow on all target tablets in workflow. Progress prints, as JSON, the rows copied by each stream against the estimated total, its phase, throughput, lag and ETA. Example: Workflow merchant.morders Start",
 			},
 		},
 	},
@@ -1593,6 +1694,32 @@ func commandShardReplicationPositions(ctx context.Context, wr *wrangler.Wrangler
 	return nil
 }
 
+func commandUpgradeShardMysql(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	includePrimary := subFlags.Bool("include_primary", false, "Also upgrades the primary, after a planned reparent away from it")
+	skipMysqlUpgrade := subFlags.Bool("skip_mysql_upgrade", false, "Doesn't run mysql_upgrade, for the versions that upgrade their system tables on their own")
+	healthyTimeout := subFlags.Duration("healthy_timeout", 5*time.Minute, "How long an upgraded tablet has to replicate again before the upgrade stops")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 30*time.Second, "The replication lag under which an upgraded tablet is healthy")
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", *topo.RemoteOperationTimeout, "Time to wait for replicas to catch up in the planned reparent of -include_primary")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the UpgradeShardMysql command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.UpgradeShardMysql(ctx, keyspace, shard, wrangler.UpgradeShardMysqlOptions{
+		IncludePrimary:      *includePrimary,
+		SkipMysqlUpgrade:    *skipMysqlUpgrade,
+		SwapBinariesParams:  subFlags.Args()[1:],
+		HealthyTimeout:      *healthyTimeout,
+		MaxReplicationLag:   *maxReplicationLag,
+		WaitReplicasTimeout: *waitReplicasTimeout,
+	})
+}
+
 func commandListShardTablets(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2668,6 +2795,8 @@ func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFl
 	cell := subFlags.String("cell", "", "Cell to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
 	continueAfterCopyWithOwner := subFlags.Bool("continue_after_copy_with_owner", false, "Vindex will continue materialization after copy when an owner is provided")
+	externalize := subFlags.Bool("externalize", false, "Wait for the backfill to complete, verify the lookup table and externalize the vindex")
+	checkInterval := subFlags.Duration("check_interval", 10*time.Second, "With -externalize, how often to check the progress of the backfill")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2682,7 +2811,18 @@ func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFl
 	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
 		return err
 	}
-	return wr.CreateLookupVindex(ctx, keyspace, specs, *cells, *tabletTypes, *continueAfterCopyWithOwner)
+	if err := wr.CreateLookupVindex(ctx, keyspace, specs, *cells, *tabletTypes, *continueAfterCopyWithOwner); err != nil {
+		return err
+	}
+	if !*externalize {
+		return nil
+	}
+	for vindexName := range specs.Vindexes {
+		if err := wr.CompleteLookupVindex(ctx, keyspace+"."+vindexName, *checkInterval); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -2695,6 +2835,43 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandCompleteLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	checkInterval := subFlags.Duration("check_interval", 10*time.Second, "How often to check the progress of the backfill")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("one argument is required: keyspace.vindex")
+	}
+	return wr.CompleteLookupVindex(ctx, subFlags.Arg(0), *checkInterval)
+}
+
+func commandRebuildLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Source cells to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	checkInterval := subFlags.Duration("check_interval", 10*time.Second, "How often to check the progress of the backfill and to verify the lookup tables")
+	convergedChecks := subFlags.Int("converged_checks", 3, "How many successive verifications of the lookup tables must succeed before the new vindex replaces the old one")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are required: keyspace.vindex and json_spec")
+	}
+	splits := strings.Split(subFlags.Arg(0), ".")
+	if len(splits) != 2 {
+		return fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", subFlags.Arg(0))
+	}
+	keyspace, vindexName := splits[0], splits[1]
+	if *convergedChecks < 1 {
+		return fmt.Errorf("-converged_checks must be at least 1: %d", *convergedChecks)
+	}
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
+		return err
+	}
+	return wr.RebuildLookupVindex(ctx, keyspace, vindexName, specs, *cells, *tabletTypes, *checkInterval, *convergedChecks)
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
@@ -2750,11 +2927,17 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
 	maxExtraRowsToCompare := subFlags.Int("max_extra_rows_to_compare", 1000, "If there are collation differences between the soruce and target, you can have rows that are identical but simply returned in a different order from MySQL. We will do a second pass to compare the rows for any actual differences in this case and this flag allows you to control the resources used for this operation.")
+	v2 := subFlags.Bool("v2", false, "Run the diff on the primaries of the target shards, where it can be stopped, resumed and shown later")
+	chunkSize := subFlags.Int64("chunk_size", 100000, "With -v2, the number of rows compared between two saves of the progress")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
+	if *v2 {
+		if subFlags.NArg() < 1 || subFlags.NArg() > 3 {
+			return fmt.Errorf("<keyspace.workflow> is required, optionally followed by the action and the uuid")
+		}
+	} else if subFlags.NArg() != 1 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
@@ -2764,6 +2947,21 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if *v2 {
+		options := &vdiff.Options{
+			SourceCell:      *sourceCell,
+			TabletTypes:     *tabletTypes,
+			Tables:          *tables,
+			ChunkSize:       *chunkSize,
+			WaitTimeSeconds: int64(filteredReplicationWaitTime.Seconds()),
+			OnlyPKs:         *onlyPks,
+			DebugQuery:      *debugQuery,
+		}
+		if *maxRows != math.MaxInt64 {
+			options.MaxRows = *maxRows
+		}
+		return runVDiff2(ctx, wr, keyspace, workflow, subFlags.Arg(1), subFlags.Arg(2), options)
+	}
 	_, err = wr.
 		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, *debugQuery, *onlyPks, *maxExtraRowsToCompare)
 	if err != nil {
@@ -3105,8 +3303,8 @@ func commandListTablets(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 }
 
 func commandGetSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
-	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables for which we should gather information. Each is either an exact match, or a regular expression of the form /regexp/")
-	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
+	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables for which we should gather information. Each is either an exact match, a glob pattern like 'user_*', or a regular expression of the form /regexp/")
+	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, a glob pattern like 'user_*', or a regular expression of the form /regexp/")
 	includeViews := subFlags.Bool("include-views", false, "Includes views in the output")
 	tableNamesOnly := subFlags.Bool("table_names_only", false, "Only displays table names that match")
 	tableSizesOnly := subFlags.Bool("table_sizes_only", false, "Only displays size information for tables. Ignored if -table_names_only is passed.")
@@ -3419,12 +3617,24 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 		}
 		uuid = arg
 		query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='complete' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+	case "cutover":
+		if arg == "" {
+			return fmt.Errorf("UUID required")
+		}
+		uuid = arg
+		query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='cutover' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
 	case "cancel":
 		if arg == "" {
 			return fmt.Errorf("UUID required")
 		}
 		uuid = arg
 		query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='cancel' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+	case "abort":
+		if arg == "" {
+			return fmt.Errorf("UUID required")
+		}
+		uuid = arg
+		query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='abort' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
 	case "cancel-all":
 		if arg != "" {
 			return fmt.Errorf("UUID not allowed in %s", command)
@@ -3449,9 +3659,11 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 }
 
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
-	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
-	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
+	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, a glob pattern like 'user_*', or a regular expression of the form /regexp/")
+	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, a glob pattern like 'user_*', or a regular expression of the form /regexp/")
 	includeViews := subFlags.Bool("include-views", true, "Includes views in the output")
+	includeRoutines := subFlags.Bool("include-routines", false, "Copies the stored procedures and functions that don't exist on the destination, and that -tables and -exclude_tables select by their name")
+	includeTriggers := subFlags.Bool("include-triggers", false, "Copies the triggers that don't exist on the destination, of the tables that are copied")
 	skipVerify := subFlags.Bool("skip-verify", false, "Skip verification of source and target schema after copy")
 	// for backwards compatibility
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
@@ -3477,11 +3689,11 @@ func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags
 
 	sourceKeyspace, sourceShard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
 	if err == nil {
-		return wr.CopySchemaShardFromShard(ctx, tableArray, excludeTableArray, *includeViews, sourceKeyspace, sourceShard, destKeyspace, destShard, *waitReplicasTimeout, *skipVerify)
+		return wr.CopySchemaShardFromShard(ctx, tableArray, excludeTableArray, *includeViews, *includeRoutines, *includeTriggers, sourceKeyspace, sourceShard, destKeyspace, destShard, *waitReplicasTimeout, *skipVerify)
 	}
 	sourceTabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err == nil {
-		return wr.CopySchemaShard(ctx, sourceTabletAlias, tableArray, excludeTableArray, *includeViews, destKeyspace, destShard, *waitReplicasTimeout, *skipVerify)
+		return wr.CopySchemaShard(ctx, sourceTabletAlias, tableArray, excludeTableArray, *includeViews, *includeRoutines, *includeTriggers, destKeyspace, destShard, *waitReplicasTimeout, *skipVerify)
 	}
 	return err
 }
@@ -3527,6 +3739,238 @@ func commandValidateVersionKeyspace(ctx context.Context, wr *wrangler.Wrangler,
 	return nil
 }
 
+func commandGetVersions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Specifies a comma-separated list of cells to look for tablets in, all the cells by default")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the GetVersions command does not take any arguments")
+	}
+
+	var cellList []string
+	if *cells != "" {
+		cellList = strings.Split(*cells, ",")
+	}
+	versions, err := wr.GetVersions(ctx, cellList)
+	if err != nil && versions == nil {
+		return err
+	}
+	if err := printJSON(wr.Logger(), versions); err != nil {
+		return err
+	}
+	return err
+}
+
+// dynamicConfigComponents are the components that watch their dynamic
+// configuration in the topo.
+var dynamicConfigComponents = []string{"vtgate", "vttablet"}
+
+func parseDynamicConfigComponent(component string) error {
+	for _, c := range dynamicConfigComponents {
+		if c == component {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown component %q, expected one of %v", component, dynamicConfigComponents)
+}
+
+func commandGetDynamicConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <component> argument is required for the GetDynamicConfig command")
+	}
+	component := subFlags.Arg(0)
+	if err := parseDynamicConfigComponent(component); err != nil {
+		return err
+	}
+	values, err := wr.TopoServer().GetDynamicConfig(ctx, component)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), values)
+}
+
+func commandSetDynamicConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	reset := subFlags.Bool("reset", false, "Removes the override of the flag")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *reset && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <component> and <flag name> arguments are required for the SetDynamicConfig command with --reset")
+	}
+	if !*reset && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <component>, <flag name> and <value> arguments are required for the SetDynamicConfig command")
+	}
+	component, name := subFlags.Arg(0), subFlags.Arg(1)
+	if err := parseDynamicConfigComponent(component); err != nil {
+		return err
+	}
+	return wr.TopoServer().UpdateDynamicConfig(ctx, component, func(values map[string]string) error {
+		if *reset {
+			if _, ok := values[name]; !ok {
+				return fmt.Errorf("%s is not overridden for %s", name, component)
+			}
+			wr.Logger().Infof("Removing the override of %s for %s (was %q)", name, component, values[name])
+			delete(values, name)
+			return nil
+		}
+		wr.Logger().Infof("Overriding %s for %s: %q -> %q", name, component, values[name], subFlags.Arg(2))
+		values[name] = subFlags.Arg(2)
+		return nil
+	})
+}
+
+func commandGetFeatureFlags(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetFeatureFlags command")
+	}
+	values, err := wr.TopoServer().GetFeatureFlags(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), values)
+}
+
+func commandSetFeatureFlag(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	reset := subFlags.Bool("reset", false, "Removes the feature flag")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *reset && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <flag name> arguments are required for the SetFeatureFlag command with --reset")
+	}
+	if !*reset && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace>, <flag name> and <value> arguments are required for the SetFeatureFlag command")
+	}
+	keyspace, name := subFlags.Arg(0), subFlags.Arg(1)
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+	return wr.TopoServer().UpdateFeatureFlags(ctx, keyspace, func(values map[string]string) error {
+		if *reset {
+			if _, ok := values[name]; !ok {
+				return fmt.Errorf("feature flag %s is not set for keyspace %s", name, keyspace)
+			}
+			wr.Logger().Infof("Removing feature flag %s of keyspace %s (was %q)", name, keyspace, values[name])
+			delete(values, name)
+			return nil
+		}
+		wr.Logger().Infof("Setting feature flag %s of keyspace %s: %q -> %q", name, keyspace, values[name], subFlags.Arg(2))
+		values[name] = subFlags.Arg(2)
+		return nil
+	})
+}
+
+func commandGetTableGCConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetTableGCConfig command")
+	}
+	values, err := wr.TopoServer().GetTableGCConfig(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), values)
+}
+
+func commandSetTableGCConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	reset := subFlags.Bool("reset", false, "Removes the setting")
+	table := subFlags.String("table", "", "The table of the setting, instead of the keyspace")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *reset && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <setting> arguments are required for the SetTableGCConfig command with --reset")
+	}
+	if !*reset && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace>, <setting> and <value> arguments are required for the SetTableGCConfig command")
+	}
+	keyspace, key := subFlags.Arg(0), schema.TableGCConfigKey(*table, subFlags.Arg(1))
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+	if !*reset {
+		if err := schema.ValidateTableGCSetting(subFlags.Arg(1), subFlags.Arg(2)); err != nil {
+			return err
+		}
+	}
+	return wr.TopoServer().UpdateTableGCConfig(ctx, keyspace, func(values map[string]string) error {
+		if *reset {
+			if _, ok := values[key]; !ok {
+				return fmt.Errorf("table GC setting %s is not set for keyspace %s", key, keyspace)
+			}
+			wr.Logger().Infof("Removing table GC setting %s of keyspace %s (was %q)", key, keyspace, values[key])
+			delete(values, key)
+			return nil
+		}
+		wr.Logger().Infof("Setting table GC setting %s of keyspace %s: %q -> %q", key, keyspace, values[key], subFlags.Arg(2))
+		values[key] = subFlags.Arg(2)
+		return nil
+	})
+}
+
+func commandGetServerDynamicConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "vtgate or vttablet to connect to")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the GetServerDynamicConfig command does not accept any positional parameters")
+	}
+
+	client, err := dynamicconfigclient.New(*server)
+	if err != nil {
+		return fmt.Errorf("error creating a dynamic config client for server '%v': %v", *server, err)
+	}
+	defer client.Close()
+	variables, changes, err := client.GetDynamicConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the dynamic config of server '%v': %v", *server, err)
+	}
+	return printJSON(wr.Logger(), &dynamicconfigdatapb.GetDynamicConfigResponse{
+		Variables: variables,
+		Changes:   changes,
+	})
+}
+
+func commandSetServerDynamicConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "vtgate or vttablet to connect to")
+	reset := subFlags.Bool("reset", false, "Restores the value the flag had before it was changed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *reset && subFlags.NArg() != 1 {
+		return fmt.Errorf("the <flag name> argument is required for the SetServerDynamicConfig command with --reset")
+	}
+	if !*reset && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <flag name> and <value> arguments are required for the SetServerDynamicConfig command")
+	}
+
+	client, err := dynamicconfigclient.New(*server)
+	if err != nil {
+		return fmt.Errorf("error creating a dynamic config client for server '%v': %v", *server, err)
+	}
+	defer client.Close()
+	var variable *dynamicconfigdatapb.Variable
+	if *reset {
+		variable, err = client.ResetDynamicConfig(ctx, subFlags.Arg(0))
+	} else {
+		variable, err = client.SetDynamicConfig(ctx, subFlags.Arg(0), subFlags.Arg(1))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change the dynamic config of server '%v': %v", *server, err)
+	}
+	return printJSON(wr.Logger(), variable)
+}
+
 func commandGetPermissions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -3743,6 +4187,104 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
 }
 
+func commandApplyVSchemaBatch(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	vschemas := subFlags.String("vschemas", "", "The VTGate routing schemas, as a JSON object keyed by keyspace")
+	vschemasFile := subFlags.String("vschemas_file", "", "The file of the VTGate routing schemas, as a JSON object keyed by keyspace")
+	dryRun := subFlags.Bool("dry-run", false, "If set, validate the vschemas and show the changes of the routing, without saving them")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do not rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skip_rebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the ApplyVSchemaBatch command takes no arguments")
+	}
+	if (*vschemas != "") == (*vschemasFile != "") {
+		return fmt.Errorf("one of the vschemas or vschemas_file flags must be specified when calling the ApplyVSchemaBatch command")
+	}
+
+	data := []byte(*vschemas)
+	if *vschemasFile != "" {
+		var err error
+		if data, err = os.ReadFile(*vschemasFile); err != nil {
+			return err
+		}
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("the vschemas must be a JSON object keyed by keyspace: %v", err)
+	}
+	batch := make(map[string]*vschemapb.Keyspace, len(raw))
+	for keyspace, vschema := range raw {
+		batch[keyspace] = &vschemapb.Keyspace{}
+		if err := json2.Unmarshal(vschema, batch[keyspace]); err != nil {
+			return fmt.Errorf("vschema of %s: %v", keyspace, err)
+		}
+	}
+
+	diffs, err := topotools.ApplyVSchemaBatch(ctx, wr.TopoServer(), batch, cells, *dryRun, *skipRebuild)
+	if err != nil {
+		return err
+	}
+	if len(diffs) == 0 {
+		wr.Logger().Printf("The routing doesn't change.\n")
+	} else {
+		wr.Logger().Printf("Changes of the routing:\n%s\n", strings.Join(diffs, "\n"))
+	}
+	switch {
+	case *dryRun:
+		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
+	case *skipRebuild:
+		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+	}
+	return nil
+}
+
+func commandLintVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	var keyspaces flagutil.StringListValue
+	subFlags.Var(&keyspaces, "keyspaces", "If specified, only displays the findings of the keyspaces")
+	minSeverity := subFlags.String("min_severity", vindexes.LintInfo, "Only displays the findings at least as severe as ERROR, WARNING or INFO")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the LintVSchema command takes no arguments")
+	}
+
+	srvVSchema, err := topotools.GlobalSrvVSchema(ctx, wr.TopoServer())
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]bool, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		wanted[keyspace] = true
+	}
+	findings := []*vindexes.LintFinding{}
+	errors := 0
+	for _, finding := range vindexes.LintVSchema(srvVSchema) {
+		if len(wanted) > 0 && !wanted[finding.Keyspace] {
+			continue
+		}
+		if !vindexes.LintSeverityAtLeast(finding.Severity, *minSeverity) {
+			continue
+		}
+		if finding.Severity == vindexes.LintError {
+			errors++
+		}
+		findings = append(findings, finding)
+	}
+	if err := printJSON(wr.Logger(), findings); err != nil {
+		return err
+	}
+	if errors > 0 {
+		return fmt.Errorf("the vschemas have %d errors", errors)
+	}
+	return nil
+}
+
 func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	routingRules := subFlags.String("rules", "", "Specify rules as a string")
 	routingRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
@@ -3985,7 +4527,7 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		return err
 	}
 	if subFlags.NArg() < 2 {
-		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/show/listall/tags [<tags>]")
+		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/show/listall/tags/progress [<tags>]")
 	}
 	keyspace := subFlags.Arg(0)
 	action := strings.ToLower(subFlags.Arg(1))
@@ -4008,6 +4550,16 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	if err != nil {
 		wr.Logger().Errorf("Keyspace %s not found", keyspace)
 	}
+	if action == "progress" {
+		if subFlags.NArg() != 2 {
+			return fmt.Errorf("usage: Workflow keyspace.workflow progress")
+		}
+		progress, err := wr.WorkflowProgress(ctx, keyspace, workflow)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), progress)
+	}
 	var results map[*topo.TabletInfo]*sqltypes.Result
 	if action == "tags" {
 		tags := ""
@@ -4113,6 +4665,35 @@ func commandGenerateShardRanges(ctx context.Context, wr *wrangler.Wrangler, subF
 	return printJSON(wr.Logger(), shardRanges)
 }
 
+// decodedID is an id that DecodeObfuscatedIds decoded.
+type decodedID struct {
+	Obfuscated string
+	Hex        string
+	Text       string `json:",omitempty"`
+}
+
+func commandDecodeObfuscatedIds(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() == 0 {
+		return fmt.Errorf("at least one obfuscated id is required")
+	}
+	decoded := make([]decodedID, 0, subFlags.NArg())
+	for _, obfuscated := range subFlags.Args() {
+		id, err := obfuscation.Decode(obfuscated)
+		if err != nil {
+			return err
+		}
+		d := decodedID{Obfuscated: obfuscated, Hex: hex.EncodeToString(id)}
+		if utf8.Valid(id) && strings.IndexFunc(string(id), func(r rune) bool { return !unicode.IsPrint(r) }) < 0 {
+			d.Text = string(id)
+		}
+		decoded = append(decoded, d)
+	}
+	return printJSON(wr.Logger(), decoded)
+}
+
 func commandPanic(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	panic(fmt.Errorf("this command panics on purpose"))
 }
@@ -0,0 +1,445 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	clusterStatsVtgates        = flag.String("cluster_stats_vtgates", "", "Comma separated list of the web addresses (host:port) of the vtgates that vtctld scrapes, to add their query and error rates to the cluster stats.")
+	clusterStatsScrapeInterval = flag.Duration("cluster_stats_scrape_interval", 15*time.Second, "How often vtctld scrapes the vtgates of -cluster_stats_vtgates.")
+)
+
+// initClusterStats serves the cluster stats on /api/cluster_stats/, and
+// exports them as stats. The tablet stats need -enable_realtime_stats.
+func initClusterStats(realtimeStats *realtimeStats) {
+	var tablets *tabletStatsCache
+	if realtimeStats != nil {
+		tablets = realtimeStats.tabletStatsCache
+	}
+	var vtgates *vtgateScraper
+	if *clusterStatsVtgates != "" {
+		vtgates = newVtgateScraper(strings.Split(*clusterStatsVtgates, ","))
+		go vtgates.run(context.Background(), *clusterStatsScrapeInterval)
+	}
+	collector := newClusterStatsCollector(tablets, vtgates)
+	collector.publish()
+
+	handleCollection("cluster_stats", func(r *http.Request) (any, error) {
+		return collector.stats(), nil
+	})
+}
+
+// lagQuantiles are the quantiles of the replication lag of the replicas
+// of a keyspace, in seconds.
+type lagQuantiles struct {
+	P50 uint32
+	P90 uint32
+	P99 uint32
+	Max uint32
+}
+
+// keyspaceStats are the cluster stats of a keyspace. The tablet stats come
+// from the realtime stats, and the vtgate stats from the scrapes of the
+// vtgates.
+type keyspaceStats struct {
+	Keyspace         string
+	Tablets          int
+	ServingTablets   int
+	UnhealthyTablets int
+	// TabletQPS is the sum of the QPS of the serving tablets.
+	TabletQPS             float64
+	ReplicationLagSeconds lagQuantiles
+
+	// VtgateQPS and VtgateErrorsPerSecond are summed across vtgates, over
+	// their last two scrapes. VtgateErrorRate is their ratio.
+	VtgateQPS             float64
+	VtgateErrorsPerSecond float64
+	VtgateErrorRate       float64
+}
+
+// vtgateStatus is the status of the scrapes of a vtgate.
+type vtgateStatus struct {
+	Addr       string
+	LastScrape time.Time
+	Error      string `json:",omitempty"`
+}
+
+// clusterStats are the rollups of the stats of all the tablets and
+// vtgates, served on /api/cluster_stats/.
+type clusterStats struct {
+	Keyspaces []*keyspaceStats
+	Vtgates   []*vtgateStatus
+}
+
+// clusterStatsCollector computes the cluster stats. Either source can be
+// nil.
+type clusterStatsCollector struct {
+	tablets *tabletStatsCache
+	vtgates *vtgateScraper
+}
+
+func newClusterStatsCollector(tablets *tabletStatsCache, vtgates *vtgateScraper) *clusterStatsCollector {
+	return &clusterStatsCollector{
+		tablets: tablets,
+		vtgates: vtgates,
+	}
+}
+
+// publish exports the cluster stats as vtctld stats, so that one scrape of
+// vtctld gets them all.
+func (c *clusterStatsCollector) publish() {
+	stats.NewGaugesFuncWithMultiLabels(
+		"ClusterKeyspaceTablets",
+		"Number of tablets of each keyspace, by state",
+		[]string{"Keyspace", "State"},
+		func() map[string]int64 {
+			result := make(map[string]int64)
+			for _, ks := range c.stats().Keyspaces {
+				result[ks.Keyspace+".all"] = int64(ks.Tablets)
+				result[ks.Keyspace+".serving"] = int64(ks.ServingTablets)
+				result[ks.Keyspace+".unhealthy"] = int64(ks.UnhealthyTablets)
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"ClusterKeyspaceQPS",
+		"QPS of each keyspace, as served by its tablets or by the vtgates",
+		[]string{"Keyspace", "Source"},
+		func() map[string]int64 {
+			result := make(map[string]int64)
+			for _, ks := range c.stats().Keyspaces {
+				result[ks.Keyspace+".tablets"] = int64(math.Round(ks.TabletQPS))
+				result[ks.Keyspace+".vtgates"] = int64(math.Round(ks.VtgateQPS))
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"ClusterKeyspaceReplicationLagSeconds",
+		"Quantiles of the replication lag of the replicas of each keyspace",
+		[]string{"Keyspace", "Quantile"},
+		func() map[string]int64 {
+			result := make(map[string]int64)
+			for _, ks := range c.stats().Keyspaces {
+				result[ks.Keyspace+".p50"] = int64(ks.ReplicationLagSeconds.P50)
+				result[ks.Keyspace+".p90"] = int64(ks.ReplicationLagSeconds.P90)
+				result[ks.Keyspace+".p99"] = int64(ks.ReplicationLagSeconds.P99)
+				result[ks.Keyspace+".max"] = int64(ks.ReplicationLagSeconds.Max)
+			}
+			return result
+		})
+	stats.NewCountersFuncWithMultiLabels(
+		"ClusterKeyspaceVtgateQueries",
+		"Queries of each keyspace, summed across vtgates",
+		[]string{"Keyspace"},
+		func() map[string]int64 { return c.vtgates.totals().queries })
+	stats.NewCountersFuncWithMultiLabels(
+		"ClusterKeyspaceVtgateErrors",
+		"Errors of each keyspace, summed across vtgates",
+		[]string{"Keyspace"},
+		func() map[string]int64 { return c.vtgates.totals().errors })
+}
+
+// stats returns the cluster stats, the keyspaces sorted by name.
+func (c *clusterStatsCollector) stats() *clusterStats {
+	keyspaces := make(map[string]*keyspaceStats)
+	getKeyspace := func(keyspace string) *keyspaceStats {
+		ks, ok := keyspaces[keyspace]
+		if !ok {
+			ks = &keyspaceStats{Keyspace: keyspace}
+			keyspaces[keyspace] = ks
+		}
+		return ks
+	}
+
+	if c.tablets != nil {
+		lags := make(map[string][]uint32)
+		c.tablets.mu.Lock()
+		for _, tablet := range c.tablets.statusesByAlias {
+			ks := getKeyspace(tablet.Tablet.Keyspace)
+			ks.Tablets++
+			if health(tablet) == tabletUnhealthy {
+				ks.UnhealthyTablets++
+			}
+			if !tablet.Serving {
+				continue
+			}
+			ks.ServingTablets++
+			ks.TabletQPS += qps(tablet)
+			if tablet.Tablet.Type != topodatapb.TabletType_PRIMARY {
+				lags[ks.Keyspace] = append(lags[ks.Keyspace], tablet.Stats.ReplicationLagSeconds)
+			}
+		}
+		c.tablets.mu.Unlock()
+		for keyspace, lag := range lags {
+			keyspaces[keyspace].ReplicationLagSeconds = quantiles(lag)
+		}
+	}
+
+	result := &clusterStats{}
+	if c.vtgates != nil {
+		qps, errorsPerSecond := c.vtgates.rates()
+		for keyspace, rate := range qps {
+			getKeyspace(keyspace).VtgateQPS = rate
+		}
+		for keyspace, rate := range errorsPerSecond {
+			ks := getKeyspace(keyspace)
+			ks.VtgateErrorsPerSecond = rate
+			if ks.VtgateQPS > 0 {
+				ks.VtgateErrorRate = rate / ks.VtgateQPS
+			}
+		}
+		result.Vtgates = c.vtgates.statuses()
+	}
+
+	for _, ks := range keyspaces {
+		result.Keyspaces = append(result.Keyspaces, ks)
+	}
+	sort.Slice(result.Keyspaces, func(i, j int) bool {
+		return result.Keyspaces[i].Keyspace < result.Keyspaces[j].Keyspace
+	})
+	return result
+}
+
+// quantiles returns the nearest-rank quantiles of lags.
+func quantiles(lags []uint32) lagQuantiles {
+	sort.Slice(lags, func(i, j int) bool { return lags[i] < lags[j] })
+	quantile := func(q float64) uint32 {
+		return lags[int(math.Ceil(q*float64(len(lags))))-1]
+	}
+	return lagQuantiles{
+		P50: quantile(0.5),
+		P90: quantile(0.9),
+		P99: quantile(0.99),
+		Max: lags[len(lags)-1],
+	}
+}
+
+// vtgateTotals are the queries and errors of a vtgate per keyspace, since
+// it started.
+type vtgateTotals struct {
+	time    time.Time
+	queries map[string]int64
+	errors  map[string]int64
+}
+
+// vtgateScraper periodically reads the totals of the vtgates, from their
+// /debug/vars, and computes their rates from their last two scrapes.
+type vtgateScraper struct {
+	addrs  []string
+	client *http.Client
+
+	mu       sync.Mutex
+	last     map[string]*vtgateTotals
+	previous map[string]*vtgateTotals
+	status   map[string]*vtgateStatus
+}
+
+func newVtgateScraper(addrs []string) *vtgateScraper {
+	s := &vtgateScraper{
+		addrs:    addrs,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		last:     make(map[string]*vtgateTotals),
+		previous: make(map[string]*vtgateTotals),
+		status:   make(map[string]*vtgateStatus),
+	}
+	for _, addr := range addrs {
+		s.status[addr] = &vtgateStatus{Addr: addr}
+	}
+	return s
+}
+
+// run scrapes the vtgates every interval until ctx is done.
+func (s *vtgateScraper) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.scrapeAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *vtgateScraper) scrapeAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, addr := range s.addrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+			totals, err := s.scrape(ctx, addr)
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if err != nil {
+				log.Warningf("cannot scrape vtgate %v: %v", addr, err)
+				s.status[addr].Error = err.Error()
+				return
+			}
+			s.status[addr].Error = ""
+			s.status[addr].LastScrape = totals.time
+			if last, ok := s.last[addr]; ok {
+				s.previous[addr] = last
+			}
+			s.last[addr] = totals
+		}(addr)
+	}
+	wg.Wait()
+}
+
+// scrape reads the totals of a vtgate from the VtgateApi timings and the
+// VtgateApiErrorCounts counters of its /debug/vars.
+func (s *vtgateScraper) scrape(ctx context.Context, addr string) (*vtgateTotals, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("http://%v/debug/vars", addr), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v returned %v", req.URL, resp.Status)
+	}
+
+	var vars struct {
+		VtgateApi struct {
+			Histograms map[string]struct {
+				Count int64
+			}
+		}
+		VtgateApiErrorCounts map[string]int64
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&vars); err != nil {
+		return nil, fmt.Errorf("cannot decode the vars of %v: %v", addr, err)
+	}
+
+	totals := &vtgateTotals{
+		time:    time.Now(),
+		queries: make(map[string]int64),
+		errors:  make(map[string]int64),
+	}
+	// The keys are Operation.Keyspace.DbType, followed by .Code for the
+	// errors.
+	for key, histogram := range vars.VtgateApi.Histograms {
+		if keyspace, ok := keyspaceOfLabels(key, 3); ok {
+			totals.queries[keyspace] += histogram.Count
+		}
+	}
+	for key, count := range vars.VtgateApiErrorCounts {
+		if keyspace, ok := keyspaceOfLabels(key, 4); ok {
+			totals.errors[keyspace] += count
+		}
+	}
+	return totals, nil
+}
+
+// keyspaceOfLabels returns the keyspace of the labels of a vtgate stat,
+// which is the second of labelCount labels.
+func keyspaceOfLabels(key string, labelCount int) (string, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) < labelCount || parts[1] == "" {
+		return "", false
+	}
+	// The keyspace is the only label that can have dots.
+	return strings.Join(parts[1:len(parts)-labelCount+2], "."), true
+}
+
+// rates returns the QPS and errors per second of each keyspace, summed
+// across the vtgates that were scraped twice.
+func (s *vtgateScraper) rates() (qps, errorsPerSecond map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	qps = make(map[string]float64)
+	errorsPerSecond = make(map[string]float64)
+	for addr, last := range s.last {
+		previous, ok := s.previous[addr]
+		if !ok {
+			continue
+		}
+		seconds := last.time.Sub(previous.time).Seconds()
+		if seconds <= 0 {
+			continue
+		}
+		for keyspace, queries := range last.queries {
+			qps[keyspace] += rate(queries, previous.queries[keyspace], seconds)
+		}
+		for keyspace, errors := range last.errors {
+			errorsPerSecond[keyspace] += rate(errors, previous.errors[keyspace], seconds)
+		}
+	}
+	return qps, errorsPerSecond
+}
+
+func rate(last, previous int64, seconds float64) float64 {
+	if last < previous {
+		// The vtgate restarted.
+		return 0
+	}
+	return float64(last-previous) / seconds
+}
+
+// totals returns the last totals of each keyspace, summed across vtgates.
+func (s *vtgateScraper) totals() *vtgateTotals {
+	result := &vtgateTotals{
+		queries: make(map[string]int64),
+		errors:  make(map[string]int64),
+	}
+	if s == nil {
+		return result
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, last := range s.last {
+		for keyspace, queries := range last.queries {
+			result.queries[keyspace] += queries
+		}
+		for keyspace, errors := range last.errors {
+			result.errors[keyspace] += errors
+		}
+	}
+	return result
+}
+
+func (s *vtgateScraper) statuses() []*vtgateStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*vtgateStatus
+	for _, addr := range s.addrs {
+		status := *s.status[addr]
+		result = append(result, &status)
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestClusterStatsTablets(t *testing.T) {
+	cache := newTabletStatsCache()
+	primary := tabletStats("ks1", "cell1", "0", topodatapb.TabletType_PRIMARY, 100)
+	primary.Stats.ReplicationLagSeconds = 0
+	primary.Stats.Qps = 10
+	cache.StatsUpdate(primary)
+	for uid, lag := range map[uint32]uint32{101: 1, 102: 2, 103: 3, 104: 130} {
+		replica := tabletStats("ks1", "cell1", "0", topodatapb.TabletType_REPLICA, uid)
+		replica.Stats.ReplicationLagSeconds = lag
+		replica.Stats.Qps = 1.5
+		cache.StatsUpdate(replica)
+	}
+	notServing := tabletStats("ks1", "cell2", "0", topodatapb.TabletType_RDONLY, 105)
+	notServing.Serving = false
+	cache.StatsUpdate(notServing)
+	cache.StatsUpdate(tabletStats("ks2", "cell1", "0", topodatapb.TabletType_REPLICA, 5))
+
+	stats := newClusterStatsCollector(cache, nil).stats()
+	require.Len(t, stats.Keyspaces, 2)
+	assert.Equal(t, &keyspaceStats{
+		Keyspace:              "ks1",
+		Tablets:               6,
+		ServingTablets:        5,
+		UnhealthyTablets:      1,
+		TabletQPS:             16,
+		ReplicationLagSeconds: lagQuantiles{P50: 2, P90: 130, P99: 130, Max: 130},
+	}, stats.Keyspaces[0])
+	assert.Equal(t, "ks2", stats.Keyspaces[1].Keyspace)
+	assert.Equal(t, lagQuantiles{P50: 5, P90: 5, P99: 5, Max: 5}, stats.Keyspaces[1].ReplicationLagSeconds)
+	assert.Nil(t, stats.Vtgates)
+}
+
+func TestClusterStatsVtgates(t *testing.T) {
+	scrapes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/debug/vars", r.URL.Path)
+		scrapes++
+		fmt.Fprintf(w, `{
+			"VtgateApi": {"TotalCount": 0, "Histograms": {
+				"Execute.ks1.PRIMARY": {"500000": 1, "Count": %d, "Time": 1000},
+				"Execute.ks1.REPLICA": {"Count": %d},
+				"Execute.my.ks.PRIMARY": {"Count": 5},
+				"Execute..PRIMARY": {"Count": 1000}
+			}},
+			"VtgateApiErrorCounts": {"Execute.ks1.PRIMARY.INVALID_ARGUMENT": %d}
+		}`, 100*scrapes, 100*scrapes, 10*scrapes)
+	}))
+	defer server.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	defer down.Close()
+	gate := strings.TrimPrefix(server.URL, "http://")
+	downGate := strings.TrimPrefix(down.URL, "http://")
+
+	scraper := newVtgateScraper([]string{gate, downGate})
+	ctx := context.Background()
+	scraper.scrapeAll(ctx)
+	// There are no rates until two scrapes.
+	stats := newClusterStatsCollector(nil, scraper).stats()
+	assert.Empty(t, stats.Keyspaces)
+
+	scraper.scrapeAll(ctx)
+	scraper.previous[gate].time = scraper.last[gate].time.Add(-10 * time.Second)
+	stats = newClusterStatsCollector(nil, scraper).stats()
+	require.Len(t, stats.Keyspaces, 2)
+	assert.Equal(t, &keyspaceStats{
+		Keyspace:              "ks1",
+		VtgateQPS:             20,
+		VtgateErrorsPerSecond: 1,
+		VtgateErrorRate:       0.05,
+	}, stats.Keyspaces[0])
+	assert.Equal(t, "my.ks", stats.Keyspaces[1].Keyspace)
+
+	require.Len(t, stats.Vtgates, 2)
+	assert.Equal(t, gate, stats.Vtgates[0].Addr)
+	assert.Empty(t, stats.Vtgates[0].Error)
+	assert.Contains(t, stats.Vtgates[1].Error, "404 Not Found")
+
+	totals := scraper.totals()
+	assert.Equal(t, map[string]int64{"ks1": 400, "my.ks": 5}, totals.queries)
+	assert.Equal(t, map[string]int64{"ks1": 20}, totals.errors)
+}
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 
 	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/primarypublisher"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -156,6 +157,9 @@ func InitVtctld(ts *topo.Server) error {
 	// Serve the REST API for the vtctld web app.
 	initAPI(context.Background(), ts, actionRepo, realtimeStats)
 
+	// Serve the rollups of the stats of the tablets and vtgates.
+	initClusterStats(realtimeStats)
+
 	// Init redirects for explorers
 	initExplorer(ts)
 
@@ -165,6 +169,12 @@ func InitVtctld(ts *topo.Server) error {
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 
+	// Publish the shard primaries to the service discovery backends of -publish_primaries_to.
+	if _, err := primarypublisher.Init(context.Background(), ts); err != nil {
+		log.Errorf("Failed to start the primary publisher: %v", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -33,6 +33,36 @@ const WrongTablet = "wrong tablet type"
 // RxWrongTablet regex for invalid tablet type error
 var RxWrongTablet = regexp.MustCompile("(wrong|invalid) tablet type")
 
+// ReplicationLagTooHigh for reads that a tablet refuses because its replication lag exceeds their MAX_LAG
+const ReplicationLagTooHigh = "replication lag too high"
+
+// RxReplicationLagTooHigh regex for replication lag too high error
+var RxReplicationLagTooHigh = regexp.MustCompile(ReplicationLagTooHigh)
+
+// ReadAfterWriteTimeout for reads that a tablet refuses because it did not catch up with the writes of the session in time
+const ReadAfterWriteTimeout = "timed out waiting for the writes of the session"
+
+// RxReadAfterWriteTimeout regex for read after write timeout error
+var RxReadAfterWriteTimeout = regexp.MustCompile(ReadAfterWriteTimeout)
+
+// ReadAtTimestampTimeout for reads that a tablet refuses because it did not replicate up to their read_at_timestamp in time
+const ReadAtTimestampTimeout = "timed out waiting for the read timestamp"
+
+// RxReadAtTimestampTimeout regex for read at timestamp timeout error
+var RxReadAtTimestampTimeout = regexp.MustCompile(ReadAtTimestampTimeout)
+
+// UnsupportedByTablet for options that a tablet refuses because it runs a version that does not support them
+const UnsupportedByTablet = "option not supported by the tablet"
+
+// RxUnsupportedByTablet regex for option not supported by the tablet error
+var RxUnsupportedByTablet = regexp.MustCompile(UnsupportedByTablet)
+
+// OptimisticLockConflict for UPDATEs with an OCC_COLUMN directive whose rows changed version since they were read
+const OptimisticLockConflict = "optimistic lock conflict"
+
+// RxOptimisticLockConflict regex for optimistic lock conflict error
+var RxOptimisticLockConflict = regexp.MustCompile(OptimisticLockConflict)
+
 // Constants for error messages
 const (
 	// PrimaryVindexNotSet is the error message to be used when there is no primary vindex found on a table
@@ -268,6 +268,14 @@ func (t *explainTablet) ReadTransaction(ctx context.Context, target *querypb.Tar
 	return t.tsv.ReadTransaction(ctx, target, dtid)
 }
 
+// UnresolvedTransactions is part of the QueryService interface.
+func (t *explainTablet) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	t.mu.Lock()
+	t.currentTime = batchTime.Wait()
+	t.mu.Unlock()
+	return t.tsv.UnresolvedTransactions(ctx, target)
+}
+
 // BeginExecute is part of the QueryService interface.
 func (t *explainTablet) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	t.mu.Lock()
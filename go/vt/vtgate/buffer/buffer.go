@@ -88,6 +88,9 @@ type Buffer struct {
 	// bufferSizeSema limits how many requests can be buffered
 	// ("-buffer_size") and is shared by all shardBuffer instances.
 	bufferSizeSema *sync2.Semaphore
+	// keyspaceSizeSemas limit how many requests can be buffered for the
+	// keyspaces with a size in their KeyspaceConfig, on top of bufferSizeSema.
+	keyspaceSizeSemas map[string]*sync2.Semaphore
 
 	// mu guards all fields in this group.
 	// In particular, it is used to serialize the following Go routines:
@@ -106,10 +109,17 @@ type Buffer struct {
 
 // New creates a new Buffer object.
 func New(cfg *Config) *Buffer {
+	keyspaceSizeSemas := make(map[string]*sync2.Semaphore)
+	for keyspace, kc := range cfg.KeyspaceConfigs {
+		if kc.Size > 0 {
+			keyspaceSizeSemas[keyspace] = sync2.NewSemaphore(kc.Size, 0)
+		}
+	}
 	return &Buffer{
-		config:         cfg,
-		bufferSizeSema: sync2.NewSemaphore(cfg.Size, 0),
-		buffers:        make(map[string]*shardBuffer),
+		config:            cfg,
+		bufferSizeSema:    sync2.NewSemaphore(cfg.Size, 0),
+		keyspaceSizeSemas: keyspaceSizeSemas,
+		buffers:           make(map[string]*shardBuffer),
 	}
 }
 
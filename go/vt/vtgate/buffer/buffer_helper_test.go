@@ -197,6 +197,7 @@ func resetVariables() {
 
 	requestsBuffered.ResetAll()
 	requestsBufferedDryRun.ResetAll()
+	requestsBufferFullDryRun.ResetAll()
 	requestsDrained.ResetAll()
 	requestsEvicted.ResetAll()
 	requestsSkipped.ResetAll()
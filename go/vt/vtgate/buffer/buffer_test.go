@@ -244,6 +244,39 @@ func testDryRun1(t *testing.T, fail failover) {
 	}
 }
 
+func TestDryRunKeyspaceSize(t *testing.T) {
+	testAllImplementations(t, testDryRunKeyspaceSize1)
+}
+
+func testDryRunKeyspaceSize1(t *testing.T, fail failover) {
+	resetVariables()
+
+	cfg := NewDefaultConfig()
+	cfg.DryRun = true
+	cfg.KeyspaceConfigs = map[string]*KeyspaceConfig{keyspace: {Size: 1}}
+
+	b := New(cfg)
+
+	// The second request would not have fit in the buffer of the keyspace.
+	for i := 0; i < 2; i++ {
+		if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard, failoverErr); err != nil || retryDone != nil {
+			t.Fatalf("requests must not be buffered during dry-run. err: %v retryDone: %v", err, retryDone)
+		}
+	}
+	if got, want := requestsBufferFullDryRun.Counts()[statsKeyJoined], int64(1); got != want {
+		t.Fatalf("dry-run buffer full count did not increase: got = %v, want = %v", got, want)
+	}
+
+	fail(b, newPrimary, keyspace, shard, time.Unix(1, 0))
+
+	if err := waitForState(b, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := utilizationDryRunSum.Counts()[statsKeyJoined], int64(200); got != want {
+		t.Fatalf("wrong buffer utilization: got = %v, want = %v", got, want)
+	}
+}
+
 // TestPassthrough tests the case when no failover is in progress and
 // requests have no failover related error.
 func TestPassthrough(t *testing.T) {
@@ -651,6 +684,56 @@ func testEvictionNotPossible1(t *testing.T, fail failover) {
 	}
 }
 
+func TestKeyspaceSize(t *testing.T) {
+	testAllImplementations(t, testKeyspaceSize1)
+}
+
+func testKeyspaceSize1(t *testing.T, fail failover) {
+	resetVariables()
+	defer checkVariables(t)
+
+	cfg := NewDefaultConfig()
+	cfg.Enabled = true
+	cfg.KeyspaceConfigs = map[string]*KeyspaceConfig{keyspace: {Size: 1}}
+
+	b := New(cfg)
+
+	// The buffer of the keyspace is full, even though the buffer is not.
+	stoppedFirstFailover := issueRequest(context.Background(), t, b, failoverErr)
+	if err := waitForRequestsInFlight(b, 1); err != nil {
+		t.Fatal(err)
+	}
+	retryDone, bufferErr := b.WaitForFailoverEnd(context.Background(), keyspace, shard2, failoverErr)
+	if bufferErr == nil || retryDone != nil {
+		t.Fatalf("buffer should have returned an error because the keyspace is full: err: %v retryDone: %v", bufferErr, retryDone)
+	}
+	if got, want := bufferErr.Error(), bufferFullError.Error(); !strings.Contains(got, want) {
+		t.Fatalf("skipped buffered request should return a different error message. got = %v, want substring = %v", got, want)
+	}
+	if err := waitForPoolSlots(b, cfg.Size-1); err != nil {
+		t.Fatal(err)
+	}
+
+	fail(b, newPrimary, keyspace, shard, time.Unix(1, 0))
+
+	if err := <-stoppedFirstFailover; err != nil {
+		t.Fatalf("request should have been buffered and not returned an error: %v", err)
+	}
+	if err := waitForState(b, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitForPoolSlots(b, cfg.Size); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.keyspaceSizeSemas[keyspace].Size(), 1; got != want {
+		t.Fatalf("the slot of the keyspace was not returned: got = %v, want = %v", got, want)
+	}
+	statsKeyJoined := strings.Join([]string{keyspace, shard2, string(skippedBufferFull)}, ".")
+	if got, want := requestsSkipped.Counts()[statsKeyJoined], int64(1); got != want {
+		t.Fatalf("skipped request was not tracked: got = %v, want = %v", got, want)
+	}
+}
+
 func TestWindow(t *testing.T) {
 	testAllImplementations(t, testWindow1)
 }
@@ -20,9 +20,11 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 )
@@ -38,8 +40,19 @@ var (
 
 	bufferDrainConcurrency = flag.Int("buffer_drain_concurrency", 1, "Maximum number of requests retried simultaneously. More concurrency will increase the load on the PRIMARY vttablet when draining the buffer.")
 	bufferKeyspaceShards   = flag.String("buffer_keyspace_shards", "", "If not empty, limit buffering to these entries (comma separated). Entry format: keyspace or keyspace/shard. Requires --enable_buffer=true.")
+
+	// The per-keyspace flags override the global ones for the shards of a keyspace.
+	bufferKeyspaceSizes                = flagutil.StringMapValue{}
+	bufferKeyspaceWindows              = flagutil.StringMapValue{}
+	bufferKeyspaceMaxFailoverDurations = flagutil.StringMapValue{}
 )
 
+func init() {
+	flag.Var(&bufferKeyspaceSizes, "buffer_keyspace_sizes", "Maximum number of buffered requests in flight for the shards of a keyspace, in addition to -buffer_size (comma separated). Entry format: keyspace:size.")
+	flag.Var(&bufferKeyspaceWindows, "buffer_keyspace_windows", "Duration for how long a request to the shards of a keyspace should be buffered at most, instead of -buffer_window (comma separated). Entry format: keyspace:duration.")
+	flag.Var(&bufferKeyspaceMaxFailoverDurations, "buffer_keyspace_max_failover_durations", "Stop buffering the shards of a keyspace completely if a failover takes longer than this duration, instead of -buffer_max_failover_duration (comma separated). Entry format: keyspace:duration.")
+}
+
 func verifyFlags() error {
 	if *bufferWindow < 1*time.Second {
 		return fmt.Errorf("-buffer_window must be >= 1s (specified value: %v)", *bufferWindow)
@@ -76,9 +89,73 @@ func verifyFlags() error {
 		}
 	}
 
+	keyspaceConfigs, err := keyspaceConfigsFromFlags()
+	if err != nil {
+		return err
+	}
+	cfg := &Config{
+		Size:                    *bufferSize,
+		Window:                  *bufferWindow,
+		MaxFailoverDuration:     *bufferMaxFailoverDuration,
+		MinTimeBetweenFailovers: *bufferMinTimeBetweenFailovers,
+		KeyspaceConfigs:         keyspaceConfigs,
+	}
+	for keyspace, kc := range keyspaceConfigs {
+		if kc.Size > *bufferSize {
+			return fmt.Errorf("-buffer_keyspace_sizes must be <= -buffer_size for keyspace %v: %d vs. %d", keyspace, kc.Size, *bufferSize)
+		}
+		ksc := cfg.forKeyspace(keyspace)
+		if ksc.Window < 1*time.Second {
+			return fmt.Errorf("-buffer_keyspace_windows must be >= 1s for keyspace %v (specified value: %v)", keyspace, ksc.Window)
+		}
+		if ksc.Window > ksc.MaxFailoverDuration {
+			return fmt.Errorf("the buffer window must be <= the max failover duration for keyspace %v: %v vs. %v", keyspace, ksc.Window, ksc.MaxFailoverDuration)
+		}
+		if *bufferMinTimeBetweenFailovers < ksc.MaxFailoverDuration*time.Duration(2) {
+			return fmt.Errorf("-buffer_min_time_between_failovers should be at least twice the length of the max failover duration of keyspace %v: %v vs. %v", keyspace, *bufferMinTimeBetweenFailovers, ksc.MaxFailoverDuration)
+		}
+	}
+
 	return nil
 }
 
+// keyspaceConfigsFromFlags merges the per-keyspace flags into a
+// KeyspaceConfig per keyspace.
+func keyspaceConfigsFromFlags() (map[string]*KeyspaceConfig, error) {
+	configs := make(map[string]*KeyspaceConfig)
+	get := func(keyspace string) *KeyspaceConfig {
+		kc, ok := configs[keyspace]
+		if !ok {
+			kc = &KeyspaceConfig{}
+			configs[keyspace] = kc
+		}
+		return kc
+	}
+
+	for keyspace, value := range bufferKeyspaceSizes {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 {
+			return nil, fmt.Errorf("-buffer_keyspace_sizes has an invalid size for keyspace %v: %q", keyspace, value)
+		}
+		get(keyspace).Size = size
+	}
+	for keyspace, value := range bufferKeyspaceWindows {
+		window, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("-buffer_keyspace_windows has an invalid duration for keyspace %v: %v", keyspace, err)
+		}
+		get(keyspace).Window = window
+	}
+	for keyspace, value := range bufferKeyspaceMaxFailoverDurations {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("-buffer_keyspace_max_failover_durations has an invalid duration for keyspace %v: %v", keyspace, err)
+		}
+		get(keyspace).MaxFailoverDuration = duration
+	}
+	return configs, nil
+}
+
 // keyspaceShardsToSets converts a comma separated list of keyspace[/shard]
 // entries to two sets: keyspaces (if the shard is not specified) and shards (if
 // both keyspace and shard is specified).
@@ -111,6 +188,16 @@ func setToString(set map[string]bool) string {
 	return result
 }
 
+// KeyspaceConfig overrides the buffer configuration for the shards of a
+// keyspace. A zero value keeps the global configuration.
+type KeyspaceConfig struct {
+	// Size limits the number of requests buffered for all the shards of the
+	// keyspace. They still count towards the size of the whole buffer.
+	Size                int
+	Window              time.Duration
+	MaxFailoverDuration time.Duration
+}
+
 type Config struct {
 	Enabled bool
 	DryRun  bool
@@ -128,6 +215,9 @@ type Config struct {
 	// If empty (and *enabled==true), buffering is enabled for all shards.
 	Shards map[string]bool
 
+	// KeyspaceConfigs are the overrides of the configuration per keyspace.
+	KeyspaceConfigs map[string]*KeyspaceConfig
+
 	// internal: used for testing
 	now func() time.Time
 }
@@ -151,6 +241,14 @@ func NewConfigFromFlags() *Config {
 	}
 	bufferSizeStat.Set(int64(*bufferSize))
 	keyspaces, shards := keyspaceShardsToSets(*bufferKeyspaceShards)
+	// verifyFlags already checked the per-keyspace flags.
+	keyspaceConfigs, _ := keyspaceConfigsFromFlags()
+	for keyspace, kc := range keyspaceConfigs {
+		if kc.Size > 0 {
+			keyspaceBufferSizeStat.Set(keyspace, int64(kc.Size))
+		}
+		log.Infof("vtgate buffer configuration overridden for keyspace %v: size: %d, window: %v, max failover duration: %v", keyspace, kc.Size, kc.Window, kc.MaxFailoverDuration)
+	}
 
 	if *bufferEnabledDryRun {
 		log.Infof("vtgate buffer in dry-run mode enabled for all requests. Dry-run bufferings will log failovers but not buffer requests.")
@@ -199,10 +297,36 @@ func NewConfigFromFlags() *Config {
 		Keyspaces: keyspaces,
 		Shards:    shards,
 
+		KeyspaceConfigs: keyspaceConfigs,
+
 		now: time.Now,
 	}
 }
 
+// forKeyspace returns the configuration of the shards of a keyspace, with
+// the global configuration where it is not overridden.
+func (cfg *Config) forKeyspace(keyspace string) KeyspaceConfig {
+	ksc := KeyspaceConfig{
+		Size:                cfg.Size,
+		Window:              cfg.Window,
+		MaxFailoverDuration: cfg.MaxFailoverDuration,
+	}
+	kc, ok := cfg.KeyspaceConfigs[keyspace]
+	if !ok {
+		return ksc
+	}
+	if kc.Size > 0 {
+		ksc.Size = kc.Size
+	}
+	if kc.Window > 0 {
+		ksc.Window = kc.Window
+	}
+	if kc.MaxFailoverDuration > 0 {
+		ksc.MaxFailoverDuration = kc.MaxFailoverDuration
+	}
+	return ksc
+}
+
 func (cfg *Config) bufferingMode(keyspace, shard string) bufferMode {
 	// Actual buffering is enabled if
 	// a) no keyspaces and shards were listed in particular,
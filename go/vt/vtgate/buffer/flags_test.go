@@ -20,6 +20,7 @@ import (
 	"flag"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestVerifyFlags(t *testing.T) {
@@ -32,6 +33,9 @@ func TestVerifyFlags(t *testing.T) {
 		flag.Set("buffer_keyspace_shards", "")
 		flag.Set("buffer_max_failover_duration", "20s")
 		flag.Set("buffer_min_time_between_failovers", "1m")
+		flag.Set("buffer_keyspace_sizes", "")
+		flag.Set("buffer_keyspace_windows", "")
+		flag.Set("buffer_keyspace_max_failover_durations", "")
 	}
 
 	// Verify that the non-allowed (non-trivial) flag combinations are caught.
@@ -62,4 +66,52 @@ func TestVerifyFlags(t *testing.T) {
 	if err := verifyFlags(); err == nil || !strings.Contains(err.Error(), "has overlapping entries") {
 		t.Fatalf("Listed keyspaces and shards must not overlap. err: %v", err)
 	}
+
+	resetFlagsForTesting()
+	flag.Set("buffer_keyspace_sizes", "ks1:0")
+	if err := verifyFlags(); err == nil || !strings.Contains(err.Error(), "invalid size for keyspace ks1") {
+		t.Fatalf("Keyspace sizes must be positive. err: %v", err)
+	}
+
+	resetFlagsForTesting()
+	flag.Set("buffer_keyspace_sizes", "ks1:1001")
+	if err := verifyFlags(); err == nil || !strings.Contains(err.Error(), "must be <= -buffer_size") {
+		t.Fatalf("Keyspace sizes must not exceed the buffer size. err: %v", err)
+	}
+
+	resetFlagsForTesting()
+	flag.Set("buffer_keyspace_windows", "ks1:30s")
+	if err := verifyFlags(); err == nil || !strings.Contains(err.Error(), "must be <= the max failover duration for keyspace ks1") {
+		t.Fatalf("Keyspace windows must not exceed the max failover duration. err: %v", err)
+	}
+
+	resetFlagsForTesting()
+	flag.Set("buffer_keyspace_max_failover_durations", "ks1:1m")
+	if err := verifyFlags(); err == nil || !strings.Contains(err.Error(), "at least twice the length of the max failover duration of keyspace ks1") {
+		t.Fatalf("Keyspace max failover durations must be at most half of the min time between failovers. err: %v", err)
+	}
+
+	resetFlagsForTesting()
+	flag.Set("buffer_keyspace_sizes", "ks1:10")
+	flag.Set("buffer_keyspace_windows", "ks1:20s,ks2:5s")
+	flag.Set("buffer_keyspace_max_failover_durations", "ks1:25s")
+	if err := verifyFlags(); err != nil {
+		t.Fatalf("Valid keyspace overrides must be accepted. err: %v", err)
+	}
+}
+
+func TestConfigForKeyspace(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.KeyspaceConfigs = map[string]*KeyspaceConfig{
+		"ks1": {Size: 5, Window: 2 * time.Second},
+	}
+
+	want := KeyspaceConfig{Size: 5, Window: 2 * time.Second, MaxFailoverDuration: 20 * time.Second}
+	if got := cfg.forKeyspace("ks1"); got != want {
+		t.Fatalf("wrong configuration of the keyspace: got = %+v, want = %+v", got, want)
+	}
+	want = KeyspaceConfig{Size: 10, Window: 10 * time.Second, MaxFailoverDuration: 20 * time.Second}
+	if got := cfg.forKeyspace("ks2"); got != want {
+		t.Fatalf("keyspaces without overrides must use the global configuration: got = %+v, want = %+v", got, want)
+	}
 }
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -50,6 +51,8 @@ type entry struct {
 	// ShardBuffer queue such that nobody else tries to close it.
 	done chan struct{}
 
+	// start is the time when the request was buffered.
+	start time.Time
 	// deadline is the time when the entry is out of the buffering window and it
 	// must be canceled.
 	deadline time.Time
@@ -79,6 +82,9 @@ type shardBuffer struct {
 	mode     bufferMode
 	keyspace string
 	shard    string
+	// keyspaceSizeSema is set if the size of the buffer of the keyspace is
+	// limited. It is shared by the shardBuffer instances of the keyspace.
+	keyspaceSizeSema *sync2.Semaphore
 
 	// statsKey is used to update the stats variables.
 	statsKey []string
@@ -121,14 +127,15 @@ func newShardBufferHealthCheck(buf *Buffer, mode bufferMode, keyspace, shard str
 	initVariablesForShard(statsKey)
 
 	return &shardBuffer{
-		buf:            buf,
-		mode:           mode,
-		keyspace:       keyspace,
-		shard:          shard,
-		statsKey:       statsKey,
-		statsKeyJoined: fmt.Sprintf("%s.%s", keyspace, shard),
-		logTooRecent:   logutil.NewThrottledLogger(fmt.Sprintf("FailoverTooRecent-%v", topoproto.KeyspaceShardString(keyspace, shard)), 5*time.Second),
-		state:          stateIdle,
+		buf:              buf,
+		mode:             mode,
+		keyspace:         keyspace,
+		shard:            shard,
+		keyspaceSizeSema: buf.keyspaceSizeSemas[keyspace],
+		statsKey:         statsKey,
+		statsKeyJoined:   fmt.Sprintf("%s.%s", keyspace, shard),
+		logTooRecent:     logutil.NewThrottledLogger(fmt.Sprintf("FailoverTooRecent-%v", topoproto.KeyspaceShardString(keyspace, shard)), 5*time.Second),
+		state:            stateIdle,
 	}
 }
 
@@ -136,6 +143,11 @@ func (sb *shardBuffer) timeNow() time.Time {
 	return sb.buf.config.now()
 }
 
+// config returns the configuration of the keyspace of the shard.
+func (sb *shardBuffer) config() KeyspaceConfig {
+	return sb.buf.config.forKeyspace(sb.keyspace)
+}
+
 // disabled returns true if neither buffering nor the dry-run mode is enabled.
 func (sb *shardBuffer) disabled() bool {
 	return sb.mode == bufferModeDisabled
@@ -223,6 +235,9 @@ func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard s
 		// Dry-run. Do not actually buffer the request and return early.
 		lastRequestsDryRunMax.Add(sb.statsKey, 1)
 		requestsBufferedDryRun.Add(sb.statsKey, 1)
+		if lastRequestsDryRunMax.Counts()[sb.statsKeyJoined] > int64(sb.config().Size) {
+			requestsBufferFullDryRun.Add(sb.statsKey, 1)
+		}
 		return nil, nil
 	}
 
@@ -270,7 +285,8 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	sb.state = stateBuffering
 	sb.queue = make([]*entry, 0)
 
-	sb.timeoutThread = newTimeoutThread(sb, sb.buf.config.MaxFailoverDuration)
+	config := sb.config()
+	sb.timeoutThread = newTimeoutThread(sb, config.MaxFailoverDuration)
 	sb.timeoutThread.start()
 	msg := "Starting buffering"
 	if sb.mode == bufferModeDryRun {
@@ -280,9 +296,9 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	log.Infof("%v for shard: %s (window: %v, size: %v, max failover duration: %v) (A failover was detected by this seen error: %v.)",
 		msg,
 		topoproto.KeyspaceShardString(sb.keyspace, sb.shard),
-		sb.buf.config.Window,
-		sb.buf.config.Size,
-		sb.buf.config.MaxFailoverDuration,
+		config.Window,
+		config.Size,
+		config.MaxFailoverDuration,
 		err,
 	)
 }
@@ -305,7 +321,7 @@ func (sb *shardBuffer) logErrorIfStateNotLocked(state bufferState) {
 // give up their spot in the buffer. It also holds the "bufferCancel" function.
 // If buffering fails e.g. due to a full buffer, an error is returned.
 func (sb *shardBuffer) bufferRequestLocked(ctx context.Context) (*entry, error) {
-	if !sb.buf.bufferSizeSema.TryAcquire() {
+	if !sb.tryAcquireSlot() {
 		// Buffer is full. Evict the oldest entry and buffer this request instead.
 		if len(sb.queue) == 0 {
 			// Overall buffer is full, but this shard's queue is empty. That means
@@ -329,9 +345,11 @@ func (sb *shardBuffer) bufferRequestLocked(ctx context.Context) (*entry, error)
 		requestsEvicted.Add(statsKeyWithReason, 1)
 	}
 
+	now := sb.timeNow()
 	e := &entry{
 		done:     make(chan struct{}),
-		deadline: sb.timeNow().Add(sb.buf.config.Window),
+		start:    now,
+		deadline: now.Add(sb.config().Window),
 	}
 	e.bufferCtx, e.bufferCancel = context.WithCancel(ctx)
 	sb.queue = append(sb.queue, e)
@@ -347,6 +365,29 @@ func (sb *shardBuffer) bufferRequestLocked(ctx context.Context) (*entry, error)
 	return e, nil
 }
 
+// tryAcquireSlot takes a slot in the buffer, and in the buffer of the
+// keyspace if its size is limited. It returns false if either is full.
+func (sb *shardBuffer) tryAcquireSlot() bool {
+	if sb.keyspaceSizeSema != nil && !sb.keyspaceSizeSema.TryAcquire() {
+		return false
+	}
+	if !sb.buf.bufferSizeSema.TryAcquire() {
+		if sb.keyspaceSizeSema != nil {
+			sb.keyspaceSizeSema.Release()
+		}
+		return false
+	}
+	return true
+}
+
+// releaseSlot returns a slot taken by tryAcquireSlot.
+func (sb *shardBuffer) releaseSlot() {
+	sb.buf.bufferSizeSema.Release()
+	if sb.keyspaceSizeSema != nil {
+		sb.keyspaceSizeSema.Release()
+	}
+}
+
 // unblockAndWait unblocks a blocked request.
 // If releaseSlot is true, the buffer semaphore will be decreased by 1 when
 // the request retried and finished.
@@ -382,13 +423,17 @@ func (sb *shardBuffer) waitForRequestFinish(e *entry, releaseSlot, async bool) {
 	// the buffer full eviction or the timeout thread does not block on us.
 	// This way, the request's slot can only be reused after the request finished.
 	if releaseSlot {
-		sb.buf.bufferSizeSema.Release()
+		sb.releaseSlot()
 	}
 }
 
 // wait blocks while the request is buffered during the failover.
 // See Buffer.WaitForFailoverEnd() for the API contract of the return values.
 func (sb *shardBuffer) wait(ctx context.Context, e *entry) (RetryDoneFunc, error) {
+	defer func() {
+		requestsDuration.Add(sb.statsKey, sb.timeNow().Sub(e.start))
+	}()
+
 	select {
 	case <-ctx.Done():
 		sb.remove(e)
@@ -529,7 +574,7 @@ func (sb *shardBuffer) stopBufferingDueToMaxDuration() {
 	defer sb.mu.Unlock()
 
 	sb.stopBufferingLocked(stopMaxFailoverDurationExceeded,
-		fmt.Sprintf("stopping buffering because failover did not finish in time (%v)", sb.buf.config.MaxFailoverDuration))
+		fmt.Sprintf("stopping buffering because failover did not finish in time (%v)", sb.config().MaxFailoverDuration))
 }
 
 func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
@@ -546,13 +591,20 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 
 	lastFailoverDurationMs.Set(sb.statsKey, int64(d/time.Millisecond))
 	failoverDurationSumMs.Add(sb.statsKey, int64(d/time.Millisecond))
+	config := sb.config()
 	if sb.mode == bufferModeDryRun {
-		utilDryRunMax := int64(
-			float64(lastRequestsDryRunMax.Counts()[sb.statsKeyJoined]) / float64(sb.buf.config.Size) * 100.0)
+		seen := lastRequestsDryRunMax.Counts()[sb.statsKeyJoined]
+		utilDryRunMax := int64(float64(seen) / float64(config.Size) * 100.0)
 		utilizationDryRunSum.Add(sb.statsKey, utilDryRunMax)
+		full := seen - int64(config.Size)
+		if full < 0 {
+			full = 0
+		}
+		log.Infof("Dry-run: Would have buffered %d requests for shard: %s, and %d of them would not have fit in the buffer (size: %v, window: %v).",
+			seen, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), full, config.Size, config.Window)
 	} else {
 		utilMax := int64(
-			float64(lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]) / float64(sb.buf.config.Size) * 100.0)
+			float64(lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]) / float64(config.Size) * 100.0)
 		utilizationSum.Add(sb.statsKey, utilMax)
 	}
 
@@ -80,6 +80,19 @@ var (
 		"BufferRequestsBufferedDryRun",
 		"Buffered requests (dry-run)",
 		[]string{"Keyspace", "ShardName"})
+	// requestsBufferFullDryRun tracks how many requests would have been evicted
+	// or skipped because the buffer of the shard was full (dry-run mode).
+	// Concurrent failovers of other shards are not taken into account.
+	requestsBufferFullDryRun = stats.NewCountersWithMultiLabels(
+		"BufferRequestsBufferFullDryRun",
+		"Requests which would have been evicted or skipped because the buffer was full (dry-run)",
+		[]string{"Keyspace", "ShardName"})
+	// requestsDuration tracks how long requests were buffered, until they were
+	// drained, evicted or canceled.
+	requestsDuration = stats.NewMultiTimings(
+		"BufferRequestsDuration",
+		"How long requests were buffered",
+		[]string{"Keyspace", "ShardName"})
 	// requestsBuffered tracks how many requests were drained from the buffer.
 	// NOTE: The sum of the two counters "Drained" and "Evicted" should be
 	// identical to the "Buffered" counter value.
@@ -163,6 +176,7 @@ func initVariablesForShard(statsKey []string) {
 
 	requestsBuffered.Reset(statsKey)
 	requestsBufferedDryRun.Reset(statsKey)
+	requestsBufferFullDryRun.Reset(statsKey)
 	requestsDrained.Reset(statsKey)
 	for _, reason := range evictReasons {
 		key := append(statsKey, string(reason))
@@ -180,6 +194,10 @@ var (
 	// bufferSizeStat publishes the configured per vtgate buffer size. It can be used
 	// to calculate the utilization of the buffer.
 	bufferSizeStat         = stats.NewGauge("BufferSize", "The configured per vtgate buffer size")
+	keyspaceBufferSizeStat = stats.NewGaugesWithSingleLabel(
+		"BufferKeyspaceSize",
+		"The configured per vtgate buffer size of the keyspaces which override it",
+		"Keyspace")
 	lastFailoverDurationMs = stats.NewGaugesWithMultiLabels(
 		"BufferLastFailoverDurationMs",
 		"Buffered requests during the last failover. The value for a given shard will be reset at the next failover.",
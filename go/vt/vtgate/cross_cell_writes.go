@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// crossCellWriteAction is what vtgate does with a write to the primary of a
+// shard that is in another cell than vtgate, which pays the latency of the
+// round trips between the cells for every statement of the transaction.
+type crossCellWriteAction string
+
+const (
+	crossCellWriteAllow  = crossCellWriteAction("allow")
+	crossCellWriteWarn   = crossCellWriteAction("warn")
+	crossCellWriteReject = crossCellWriteAction("reject")
+)
+
+var crossCellWriteCounts = stats.NewCountersWithMultiLabels(
+	"VtgateCrossCellPrimaryWrites",
+	"Writes to the primaries of other cells than the one of vtgate, by what vtgate did with them",
+	[]string{"Keyspace", "Outcome"})
+
+// crossCellWritePolicy is the action of -cross_cell_primary_writes, and the
+// ones of -cross_cell_primary_writes_keyspaces that override it.
+type crossCellWritePolicy struct {
+	action    crossCellWriteAction
+	keyspaces map[string]crossCellWriteAction
+}
+
+func parseCrossCellWriteAction(s string) (crossCellWriteAction, error) {
+	switch action := crossCellWriteAction(strings.ToLower(strings.TrimSpace(s))); action {
+	case crossCellWriteAllow, crossCellWriteWarn, crossCellWriteReject:
+		return action, nil
+	}
+	return "", fmt.Errorf("invalid cross-cell write action %q, must be one of allow, warn or reject", s)
+}
+
+// parseCrossCellWritePolicy parses the action of all the keyspaces, and the
+// comma separated list of <keyspace>:<action> that override it.
+func parseCrossCellWritePolicy(action string, keyspaces string) (*crossCellWritePolicy, error) {
+	policy := &crossCellWritePolicy{keyspaces: make(map[string]crossCellWriteAction)}
+	var err error
+	if policy.action, err = parseCrossCellWriteAction(action); err != nil {
+		return nil, err
+	}
+	for _, spec := range strings.Split(keyspaces, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		parts := strings.Split(spec, ":")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid keyspace cross-cell write action %q, must be <keyspace>:<action>", spec)
+		}
+		keyspace := strings.TrimSpace(parts[0])
+		if _, ok := policy.keyspaces[keyspace]; ok {
+			return nil, fmt.Errorf("keyspace %s has several cross-cell write actions", keyspace)
+		}
+		if policy.keyspaces[keyspace], err = parseCrossCellWriteAction(parts[1]); err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+// keyspaceAction returns the action for the writes to the keyspace.
+func (p *crossCellWritePolicy) keyspaceAction(keyspace string) crossCellWriteAction {
+	if action, ok := p.keyspaces[keyspace]; ok {
+		return action
+	}
+	return p.action
+}
+
+// CheckCrossCellWrites applies the cross-cell write policy to a write to the
+// primaries of the shards. A write to a primary of another cell fails if the
+// policy of its keyspace rejects it, and gets a warning if the policy warns
+// about it, unless the session allows these writes with
+// allow_cross_cell_writes. Shards without a healthy primary are left to the
+// query, which fails or buffers as usual.
+func (e *Executor) CheckCrossCellWrites(session *SafeSession, rss []*srvtopo.ResolvedShard) error {
+	gw, ok := e.scatterConn.gateway.(*TabletGateway)
+	if !ok || e.cell == "" {
+		return nil
+	}
+	allowed := session.GetAllowCrossCellWrites()
+	// primaryCells has the cells of the primaries of the other cells, by keyspace and shard
+	primaryCells := make(map[string]map[string]string)
+	for _, rs := range rss {
+		if rs.Target.TabletType != topodatapb.TabletType_PRIMARY {
+			continue
+		}
+		for _, th := range gw.hc.GetHealthyTabletStats(rs.Target) {
+			if cell := th.Tablet.Alias.GetCell(); cell != "" && cell != e.cell {
+				if primaryCells[rs.Target.Keyspace] == nil {
+					primaryCells[rs.Target.Keyspace] = make(map[string]string)
+				}
+				primaryCells[rs.Target.Keyspace][rs.Target.Shard] = cell
+			}
+		}
+	}
+
+	keyspaces := make([]string, 0, len(primaryCells))
+	for keyspace := range primaryCells {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	for _, keyspace := range keyspaces {
+		action := e.crossCellPolicy.keyspaceAction(keyspace)
+		if allowed || action == crossCellWriteAllow {
+			outcome := "Allowed"
+			if allowed && action != crossCellWriteAllow {
+				outcome = "AllowedBySession"
+			}
+			crossCellWriteCounts.Add([]string{keyspace, outcome}, 1)
+			continue
+		}
+		shards := make([]string, 0, len(primaryCells[keyspace]))
+		for shard := range primaryCells[keyspace] {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+		shard := shards[0]
+		message := fmt.Sprintf("write to the primary of %s/%s in cell %s from vtgate in cell %s", keyspace, shard, primaryCells[keyspace][shard], e.cell)
+		if len(shards) > 1 {
+			message += fmt.Sprintf(" (and %d other shards)", len(shards)-1)
+		}
+		if action == crossCellWriteReject {
+			crossCellWriteCounts.Add([]string{keyspace, "Rejected"}, 1)
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s rejected by -cross_cell_primary_writes; set @@allow_cross_cell_writes = 1 to allow it", message)
+		}
+		crossCellWriteCounts.Add([]string{keyspace, "Warned"}, 1)
+		session.RecordWarning(&querypb.QueryWarning{Message: message + "; set @@allow_cross_cell_writes = 1 to allow it"})
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/key"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestParseCrossCellWritePolicy(t *testing.T) {
+	policy, err := parseCrossCellWritePolicy("warn", "ks1:reject, ks2:ALLOW")
+	require.NoError(t, err)
+	assert.Equal(t, crossCellWriteWarn, policy.keyspaceAction("ks"))
+	assert.Equal(t, crossCellWriteReject, policy.keyspaceAction("ks1"))
+	assert.Equal(t, crossCellWriteAllow, policy.keyspaceAction("ks2"))
+
+	policy, err = parseCrossCellWritePolicy("allow", "")
+	require.NoError(t, err)
+	assert.Equal(t, crossCellWriteAllow, policy.keyspaceAction("ks"))
+
+	for _, tc := range []struct{ action, keyspaces string }{
+		{action: "deny"},
+		{action: "allow", keyspaces: "ks1"},
+		{action: "allow", keyspaces: ":reject"},
+		{action: "allow", keyspaces: "ks1:refuse"},
+		{action: "allow", keyspaces: "ks1:reject,ks1:warn"},
+	} {
+		_, err := parseCrossCellWritePolicy(tc.action, tc.keyspaces)
+		assert.Error(t, err, "%+v", tc)
+	}
+}
+
+// crossCellExecutor returns an executor in another cell than its tablets.
+func crossCellExecutor(t *testing.T, action, keyspaces string) *Executor {
+	executor, _, _, _ := createExecutorEnv()
+	executor.cell = "bb"
+	policy, err := parseCrossCellWritePolicy(action, keyspaces)
+	require.NoError(t, err)
+	executor.crossCellPolicy = policy
+	return executor
+}
+
+func crossCellExec(executor *Executor, session *SafeSession, sql string) error {
+	_, err := executor.Execute(context.Background(), "TestCrossCellWrites", session, sql, nil)
+	return err
+}
+
+func TestCrossCellWritesReject(t *testing.T) {
+	executor := crossCellExecutor(t, "reject", "")
+	rejected := crossCellWriteCounts.Counts()["TestUnsharded.Rejected"]
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	err := crossCellExec(executor, session, "update main1 set name = 'a' where id = 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "write to the primary of TestUnsharded/0 in cell aa from vtgate in cell bb rejected by -cross_cell_primary_writes")
+	assert.EqualValues(t, 1, crossCellWriteCounts.Counts()["TestUnsharded.Rejected"]-rejected)
+
+	// The reads are not affected.
+	require.NoError(t, crossCellExec(executor, session, "select id from main1"))
+
+	// The sessions can opt in.
+	allowedBySession := crossCellWriteCounts.Counts()["TestUnsharded.AllowedBySession"]
+	require.NoError(t, crossCellExec(executor, session, "set @@allow_cross_cell_writes = 1"))
+	assert.True(t, session.GetAllowCrossCellWrites())
+	require.NoError(t, crossCellExec(executor, session, "update main1 set name = 'a' where id = 1"))
+	assert.EqualValues(t, 1, crossCellWriteCounts.Counts()["TestUnsharded.AllowedBySession"]-allowedBySession)
+}
+
+func TestCrossCellWritesWarn(t *testing.T) {
+	executor := crossCellExecutor(t, "warn", "")
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	require.NoError(t, crossCellExec(executor, session, "update main1 set name = 'a' where id = 1"))
+	assert.Equal(t, []*querypb.QueryWarning{{
+		Message: "write to the primary of TestUnsharded/0 in cell aa from vtgate in cell bb; set @@allow_cross_cell_writes = 1 to allow it",
+	}}, session.Warnings)
+
+	// The keyspaces can override the policy.
+	executor = crossCellExecutor(t, "reject", "TestUnsharded:warn")
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	require.NoError(t, crossCellExec(executor, session, "update main1 set name = 'a' where id = 1"))
+	assert.Len(t, session.Warnings, 1)
+	err := crossCellExec(executor, session, "update user set a = 1 where id = 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "write to the primary of TestExecutor/-20 in cell aa from vtgate in cell bb rejected")
+}
+
+func TestCrossCellWritesLocalCell(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	policy, err := parseCrossCellWritePolicy("reject", "")
+	require.NoError(t, err)
+	executor.crossCellPolicy = policy
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	require.NoError(t, crossCellExec(executor, session, "update main1 set name = 'a' where id = 1"))
+	assert.Empty(t, session.Warnings)
+}
+
+func TestCrossCellWritesOutsideOfTheStatement(t *testing.T) {
+	executor := crossCellExecutor(t, "warn", "")
+	warning := &querypb.QueryWarning{
+		Message: "write to the primary of TestUnsharded/0 in cell aa from vtgate in cell bb; set @@allow_cross_cell_writes = 1 to allow it",
+	}
+	newVCursor := func(stmtType string) (*vcursorImpl, *SafeSession) {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+		logStats := NewLogStats(context.Background(), "TestCrossCellWrites", "", nil)
+		logStats.StmtType = stmtType
+		vc, err := newVCursorImpl(context.Background(), session, makeComments(""), executor, logStats, executor.vm, executor.VSchema(), executor.resolver.resolver, executor.serv, false)
+		require.NoError(t, err)
+		return vc, session
+	}
+	rss, _, err := executor.resolver.resolver.ResolveDestinations(context.Background(), KsTestUnsharded, topodatapb.TabletType_PRIMARY, nil, []key.Destination{key.DestinationAnyShard{}})
+	require.NoError(t, err)
+
+	// The queries that a DML runs outside of its transaction.
+	vc, session := newVCursor("INSERT")
+	_, err = vc.ExecuteStandalone("select next :n values from user_seq", nil, rss[0])
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.QueryWarning{warning}, session.Warnings)
+
+	// The queries that a DML runs on the keyspace ids of the owner rows.
+	vc, session = newVCursor("DELETE")
+	_, err = vc.ExecuteKeyspaceID(KsTestUnsharded, []byte("\x16k@\xb4J\xbaK\xd6"), "select id from main1 where id = 1 for update", nil, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.QueryWarning{warning}, session.Warnings)
+
+	// The writes of the lookup vindexes that are autocommitted.
+	vc, session = newVCursor("INSERT")
+	_, err = vc.Execute("VindexCreate", "insert into main1(id, name) values (1, 'a')", nil, true, vtgatepb.CommitOrder_AUTOCOMMIT)
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.QueryWarning{warning}, session.Warnings)
+
+	// The reads are not checked.
+	vc, session = newVCursor("SELECT")
+	_, err = vc.ExecuteStandalone("select id from main1", nil, rss[0])
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+
+	// The rejected writes fail.
+	executor.crossCellPolicy, err = parseCrossCellWritePolicy("reject", "")
+	require.NoError(t, err)
+	vc, _ = newVCursor("INSERT")
+	_, err = vc.ExecuteStandalone("select next :n values from user_seq", nil, rss[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected by -cross_cell_primary_writes")
+	_, err = vc.Execute("VindexCreate", "insert into main1(id, name) values (1, 'a')", nil, true, vtgatepb.CommitOrder_AUTOCOMMIT)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected by -cross_cell_primary_writes")
+}
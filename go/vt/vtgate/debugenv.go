@@ -27,6 +27,7 @@ import (
 
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/dynamicconfig"
 	"vitess.io/vitess/go/vt/log"
 )
 
@@ -167,3 +168,11 @@ func debugEnvHandler(vtg *VTGate, w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write(endTable)
 }
+
+// registerDynamicConfig makes the discovery flags that /debug/env can change
+// also changeable through the dynamic configuration.
+func registerDynamicConfig() {
+	dynamicconfig.RegisterDuration("discovery_low_replication_lag", "the replication lag that is considered low enough to be healthy", discovery.GetLowReplicationLag, discovery.SetLowReplicationLag)
+	dynamicconfig.RegisterDuration("discovery_high_replication_lag_minimum_serving", "the replication lag that is considered too high when applying the min_number_serving_vttablets threshold", discovery.GetHighReplicationLagMinServing, discovery.SetHighReplicationLagMinServing)
+	dynamicconfig.RegisterInt("min_number_serving_vttablets", "the minimum number of vttablets for each replicating tablet_type that will be continue to be used even with replication lag above discovery_low_replication_lag", discovery.GetMinNumTablets, discovery.SetMinNumTablets)
+}
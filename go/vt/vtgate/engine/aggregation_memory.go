@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/hack"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	aggregationMemoryBytes = stats.NewHistogram(
+		"AggregationMemoryBytes",
+		"Peak size in bytes of the groups that the aggregations executed at vtgate held in memory, per query",
+		[]int64{1 << 10, 1 << 14, 1 << 17, 1 << 20, 1 << 24, 1 << 27, 1 << 30})
+	aggregationLimitExceeded = stats.NewCountersWithSingleLabel(
+		"AggregationLimitExceeded",
+		"Number of aggregations executed at vtgate that failed because they held too many groups in memory",
+		"Limit")
+)
+
+// aggregationMemory accounts for the groups that an aggregation holds in
+// memory until it returns them all, and fails it as soon as they exceed the
+// limits of the vcursor, before they can exhaust the memory of the vtgate.
+type aggregationMemory struct {
+	maxGroups int
+	maxBytes  int64
+	groups    int
+	bytes     int64
+}
+
+func newAggregationMemory(vcursor VCursor) *aggregationMemory {
+	maxGroups, maxBytes := vcursor.AggregationLimits()
+	return &aggregationMemory{maxGroups: maxGroups, maxBytes: maxBytes}
+}
+
+// add accounts for a group. It returns a RESOURCE_EXHAUSTED error if the
+// groups exceed a limit.
+func (m *aggregationMemory) add(row []sqltypes.Value) error {
+	m.groups++
+	m.bytes += hack.RuntimeAllocSize(int64(cap(row)) * int64(32))
+	for i := range row {
+		m.bytes += row[i].CachedSize(false)
+	}
+	if m.maxGroups > 0 && m.groups > m.maxGroups {
+		aggregationLimitExceeded.Add("Groups", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "the aggregation held more than %d groups in memory: filter the rows or group them by fewer values, or stream the query", m.maxGroups)
+	}
+	if m.maxBytes > 0 && m.bytes > m.maxBytes {
+		aggregationLimitExceeded.Add("Bytes", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "the aggregation held %d groups of more than %d bytes in memory: filter the rows or group them by fewer values, or stream the query", m.groups, m.maxBytes)
+	}
+	return nil
+}
+
+// done records the peak memory of the aggregation.
+func (m *aggregationMemory) done() {
+	aggregationMemoryBytes.Add(m.bytes)
+}
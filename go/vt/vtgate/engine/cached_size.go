@@ -164,6 +164,24 @@ func (cached *Distinct) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *DualWrite) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Primary vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Primary.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Secondary vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Secondary.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Filter) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -210,10 +228,16 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(96)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
+	// field TableName string
+	size += hack.RuntimeAllocSize(int64(len(cached.TableName)))
+	// field Pinned []byte
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Pinned)))
+	}
 	// field Query string
 	size += hack.RuntimeAllocSize(int64(len(cached.Query)))
 	// field Values vitess.io/vitess/go/vt/vtgate/evalengine.Expr
@@ -262,13 +286,15 @@ func (cached *HashJoin) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(224)
+		size += int64(320)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -328,6 +354,44 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Input.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field OnDupVindexValues map[string]*vitess.io/vitess/go/vt/vtgate/engine.OnDupVindexValues
+	if cached.OnDupVindexValues != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.OnDupVindexValues)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.OnDupVindexValues) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k, v := range cached.OnDupVindexValues {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			size += v.CachedSize(true)
+		}
+	}
+	// field OnDupColumns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OnDupColumns)) * int64(40))
+		for _, elem := range cached.OnDupColumns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field OnDupColumnValues [][]vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OnDupColumnValues)) * int64(24))
+		for _, elem := range cached.OnDupColumnValues {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(16))
+				for _, elem := range elem {
+					if cc, ok := elem.(cachedObject); ok {
+						size += cc.CachedSize(true)
+					}
+				}
+			}
+		}
+	}
+	// field onDupUniqueKeys vitess.io/vitess/go/vt/vtgate/engine.onDupUniqueKeys
+	size += cached.onDupUniqueKeys.CachedSize(false)
 	return size
 }
 
@@ -396,7 +460,7 @@ func (cached *Lock) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(80)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -408,6 +472,10 @@ func (cached *Lock) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Query)))
 	// field FieldQuery string
 	size += hack.RuntimeAllocSize(int64(len(cached.FieldQuery)))
+	// field ReleaseAllLocksColumns []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ReleaseAllLocksColumns)) * int64(8))
+	}
 	return size
 }
 func (cached *MStream) CachedSize(alloc bool) int64 {
@@ -473,6 +541,49 @@ func (cached *MergeSort) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *OnDupVindexValues) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field PvMap map[string]vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cached.PvMap != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.PvMap)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 272))
+		if len(cached.PvMap) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 272))
+		}
+		for k, v := range cached.PvMap {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			if cc, ok := v.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Inserted map[string]bool
+	if cached.Inserted != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Inserted)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 152))
+		if len(cached.Inserted) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 152))
+		}
+		for k := range cached.Inserted {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	return size
+}
 func (cached *OnlineDDL) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -544,10 +655,12 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(208)
 	}
 	// field Original string
 	size += hack.RuntimeAllocSize(int64(len(cached.Original)))
+	// field Target string
+	size += hack.RuntimeAllocSize(int64(len(cached.Target)))
 	// field Instructions vitess.io/vitess/go/vt/vtgate/engine.Primitive
 	if cc, ok := cached.Instructions.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1148,6 +1261,28 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *onDupUniqueKeys) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field keys [][]vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.keys)) * int64(24))
+		for _, elem := range cached.keys {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(40))
+				for _, elem := range elem {
+					size += elem.CachedSize(false)
+				}
+			}
+		}
+	}
+	return size
+}
 
 //go:nocheckptr
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
@@ -90,7 +90,20 @@ func (ddl *DDL) TryExecute(vcursor VCursor, bindVars map[string]*query.BindVaria
 	if ddl.CreateTempTable {
 		vcursor.Session().HasCreatedTempTable()
 		vcursor.Session().NeedsReservedConn()
-		return vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
+		result, err := vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
+		if err != nil {
+			return nil, err
+		}
+		// The temporary tables only live on the reserved connections of the
+		// session: keep track of them, to report them if a connection is lost.
+		for _, table := range ddl.DDL.AffectedTables() {
+			if ddl.DDL.GetAction() == sqlparser.DropDDLAction {
+				vcursor.Session().RemoveTempTable(ddl.Keyspace.Name, table.Name.String())
+			} else {
+				vcursor.Session().AddTempTable(ddl.Keyspace.Name, table.Name.String())
+			}
+		}
+		return result, nil
 	}
 
 	ddlStrategySetting, err := schema.ParseDDLStrategy(vcursor.Session().GetDDLStrategy())
@@ -37,39 +37,46 @@ type row = []sqltypes.Value
 type probeTable struct {
 	seenRows      map[evalengine.HashCode][]row
 	colCollations []collations.ID
+	// size is the number of rows in the table
+	size int
 }
 
 func (pt *probeTable) exists(inputRow row) (bool, error) {
+	code, found, err := pt.find(inputRow)
+	if err != nil || found {
+		return found, err
+	}
+	pt.add(code, inputRow)
+	return false, nil
+}
+
+// find looks for the row in the table, and returns its hash code.
+func (pt *probeTable) find(inputRow row) (evalengine.HashCode, bool, error) {
 	// the two prime numbers used here (17 and 31) are used to
 
 	// calculate hashcode from all column values in the input row
 	code, err := pt.hashCodeForRow(inputRow)
 	if err != nil {
-		return false, err
+		return 0, false, err
 	}
 
-	existingRows, found := pt.seenRows[code]
-	if !found {
-		// nothing with this hash code found, we can be sure it's a not seen row
-		pt.seenRows[code] = []row{inputRow}
-		return false, nil
-	}
-
-	// we found something in the map - still need to check all individual values
+	// if we find something in the map, we still need to check all individual values
 	// so we don't just fall for a hash collision
-	for _, existingRow := range existingRows {
+	for _, existingRow := range pt.seenRows[code] {
 		exists, err := equal(existingRow, inputRow, pt.colCollations)
 		if err != nil {
-			return false, err
+			return 0, false, err
 		}
 		if exists {
-			return true, nil
+			return code, true, nil
 		}
 	}
+	return code, false, nil
+}
 
-	pt.seenRows[code] = append(existingRows, inputRow)
-
-	return false, nil
+func (pt *probeTable) add(code evalengine.HashCode, inputRow row) {
+	pt.seenRows[code] = append(pt.seenRows[code], inputRow)
+	pt.size++
 }
 
 func (pt *probeTable) hashCodeForRow(inputRow row) (evalengine.HashCode, error) {
@@ -146,36 +153,52 @@ func newProbeTable(colCollations []collations.ID) *probeTable {
 	}
 }
 
-// TryExecute implements the Primitive interface
+// TryExecute implements the Primitive interface.
+// The rows that are seen are held in memory until they are all returned, so
+// the input is streamed to fail as soon as they exceed the limits of the
+// aggregations, without holding the whole input in memory too.
 func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	input, err := vcursor.ExecutePrimitive(d.Source, bindVars, wantfields)
-	if err != nil {
-		return nil, err
-	}
-
-	result := &sqltypes.Result{
-		Fields:   input.Fields,
-		InsertID: input.InsertID,
-	}
-
+	memory := newAggregationMemory(vcursor)
+	defer memory.done()
+	result := &sqltypes.Result{}
 	pt := newProbeTable(d.ColCollations)
 
-	for _, row := range input.Rows {
-		exists, err := pt.exists(row)
-		if err != nil {
-			return nil, err
+	err := vcursor.StreamExecutePrimitive(d.Source, bindVars, wantfields, func(input *sqltypes.Result) error {
+		if len(input.Fields) != 0 {
+			result.Fields = input.Fields
 		}
-		if !exists {
+		if input.InsertID != 0 {
+			result.InsertID = input.InsertID
+		}
+		for _, row := range input.Rows {
+			exists, err := pt.exists(row)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			if err := memory.add(row); err != nil {
+				return err
+			}
 			result.Rows = append(result.Rows, row)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return result, err
+	return result, nil
 }
 
-// TryStreamExecute implements the Primitive interface
+// TryStreamExecute implements the Primitive interface.
+// The rows that are seen are kept in memory up to the max memory rows limit.
+// After that, the rows that were not seen before are spilled to disk, and they
+// are deduplicated and sent once the input is exhausted.
 func (d *Distinct) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	pt := newProbeTable(d.ColCollations)
+	spill := &distinctSpill{}
+	defer spill.close()
 
 	err := vcursor.StreamExecutePrimitive(d.Source, bindVars, wantfields, func(input *sqltypes.Result) error {
 		result := &sqltypes.Result{
@@ -183,18 +206,31 @@ func (d *Distinct) TryStreamExecute(vcursor VCursor, bindVars map[string]*queryp
 			InsertID: input.InsertID,
 		}
 		for _, row := range input.Rows {
-			exists, err := pt.exists(row)
+			code, exists, err := pt.find(row)
 			if err != nil {
 				return err
 			}
-			if !exists {
-				result.Rows = append(result.Rows, row)
+			if exists {
+				continue
 			}
+			if vcursor.ExceedsMaxMemoryRows(pt.size + 1) {
+				if err := spill.add(code, row); err != nil {
+					return err
+				}
+				continue
+			}
+			pt.add(code, row)
+			result.Rows = append(result.Rows, row)
 		}
 		return callback(result)
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	// the spilled rows are not in memory, so they only need to be deduplicated among themselves
+	pt = nil
+	return spill.drain(d.ColCollations, vcursor.ExceedsMaxMemoryRows, callback)
 }
 
 // RouteType implements the Primitive interface
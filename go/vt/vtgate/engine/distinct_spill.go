@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math/bits"
+	"os"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// distinctSpillPartitions is the number of files that a streaming DISTINCT
+// spills its rows to once its probe table is full. Rows are assigned to the
+// files by their hash code, so all the copies of a row end up in the same file,
+// and the files can be deduplicated one at a time.
+const distinctSpillPartitions = 16
+
+// distinctSpillPartitionBits is the number of bits of the hash code that
+// assign a row to one of the distinctSpillPartitions files.
+const distinctSpillPartitionBits = 4
+
+// distinctSpill holds the rows of a streaming DISTINCT that did not fit in memory.
+// The files are only created when the first row is spilled.
+type distinctSpill struct {
+	files   []*os.File
+	writers []*bufio.Writer
+	// level is the number of times the rows were spilled. The partitions of
+	// each level are assigned by other bits of the hash code, so that the
+	// rows of a partition that does not fit in memory are split again.
+	level int
+}
+
+// add writes the row, with its hash code, to the file of its partition.
+func (s *distinctSpill) add(code evalengine.HashCode, r row) error {
+	if s.files == nil {
+		for i := 0; i < distinctSpillPartitions; i++ {
+			f, err := os.CreateTemp("", "vtgate-distinct-")
+			if err != nil {
+				return err
+			}
+			s.files = append(s.files, f)
+			s.writers = append(s.writers, bufio.NewWriter(f))
+		}
+	}
+	shift := uint(s.level*distinctSpillPartitionBits) % bits.UintSize
+	w := s.writers[(code>>shift)%distinctSpillPartitions]
+	var buf [binary.MaxVarintLen64]byte
+	// Errors of a bufio.Writer are sticky, they are checked when flushing it.
+	_, _ = w.Write(buf[:binary.PutUvarint(buf[:], uint64(len(r)))])
+	for _, v := range r {
+		raw := v.Raw()
+		_, _ = w.Write(buf[:binary.PutUvarint(buf[:], uint64(v.Type()))])
+		_, _ = w.Write(buf[:binary.PutUvarint(buf[:], uint64(len(raw)))])
+		_, _ = w.Write(raw)
+	}
+	return nil
+}
+
+// drain deduplicates the spilled rows, one partition at a time, and sends
+// the rows that were not seen before to the callback. Like the input of the
+// DISTINCT, a partition is only loaded in memory up to exceedsMaxRows: the
+// rows that do not fit are spilled to the partitions of the next level, which
+// are drained in turn.
+func (s *distinctSpill) drain(colCollations []collations.ID, exceedsMaxRows func(int) bool, callback func(*sqltypes.Result) error) error {
+	for i := range s.files {
+		if err := s.drainPartition(i, colCollations, exceedsMaxRows, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *distinctSpill) drainPartition(i int, colCollations []collations.ID, exceedsMaxRows func(int) bool, callback func(*sqltypes.Result) error) error {
+	f := s.files[i]
+	if err := s.writers[i].Flush(); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	next := &distinctSpill{level: s.level + 1}
+	defer next.close()
+
+	pt := newProbeTable(colCollations)
+	result := &sqltypes.Result{}
+	r := bufio.NewReader(f)
+	for {
+		spilled, err := readSpilledRow(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		code, exists, err := pt.find(spilled)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		// A row is always kept, so that every level makes progress even if
+		// the rows of the partition all have the same hash code.
+		if pt.size > 0 && exceedsMaxRows(pt.size+1) {
+			if err := next.add(code, spilled); err != nil {
+				return err
+			}
+			continue
+		}
+		pt.add(code, spilled)
+		result.Rows = append(result.Rows, spilled)
+	}
+	// The partition is read, its file is not needed anymore.
+	s.closeFile(i)
+	if len(result.Rows) > 0 {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	// the rows of the next level are not in memory, so they only need to be
+	// deduplicated among themselves
+	pt, result = nil, nil
+	return next.drain(colCollations, exceedsMaxRows, callback)
+}
+
+func readSpilledRow(r *bufio.Reader) (row, error) {
+	cols, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	spilled := make(row, cols)
+	for i := range spilled {
+		typ, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		raw := make([]byte, length)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		spilled[i] = sqltypes.MakeTrusted(querypb.Type(typ), raw)
+	}
+	return spilled, nil
+}
+
+// closeFile removes the file of a partition.
+func (s *distinctSpill) closeFile(i int) {
+	if f := s.files[i]; f != nil {
+		f.Close()
+		os.Remove(f.Name())
+		s.files[i] = nil
+	}
+}
+
+// close removes the files of the spill.
+func (s *distinctSpill) close() {
+	for i := range s.files {
+		s.closeFile(i)
+	}
+	s.files = nil
+	s.writers = nil
+}
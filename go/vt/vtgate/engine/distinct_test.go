@@ -18,6 +18,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 
 	"vitess.io/vitess/go/test/utils"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -111,3 +113,89 @@ func TestDistinct(t *testing.T) {
 		})
 	}
 }
+
+func TestDistinctStreamSpill(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	defer func() { testMaxMemoryRows = saveMax }()
+	testMaxMemoryRows = 2
+
+	distinct := &Distinct{
+		Source: &fakePrimitive{results: []*sqltypes.Result{
+			r("myid|name", "int64|varchar", "1|a", "2|b", "1|a", "3|c", "null|null", "3|c"),
+			r("myid|name", "int64|varchar", "4|", "2|b", "null|null", "4|", "5|e", "3|c"),
+		}, allResultsInOneCall: true},
+		ColCollations: []collations.ID{collations.Unknown, collations.ID(0x21)},
+	}
+	result, err := wrapStreamExecute(distinct, &noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+
+	// the first two rows are sent as they come, the others once the input is exhausted
+	require.Len(t, result.Rows, 6)
+	assert.Equal(t, `[[INT64(1) VARCHAR("a")] [INT64(2) VARCHAR("b")]]`, fmt.Sprintf("%v", result.Rows[:2]))
+	var got []string
+	for _, row := range result.Rows {
+		got = append(got, fmt.Sprintf("%v", row))
+	}
+	assert.ElementsMatch(t, []string{
+		`[INT64(1) VARCHAR("a")]`,
+		`[INT64(2) VARCHAR("b")]`,
+		`[INT64(3) VARCHAR("c")]`,
+		`[NULL NULL]`,
+		`[INT64(4) VARCHAR("")]`,
+		`[INT64(5) VARCHAR("e")]`,
+	}, got)
+}
+
+func TestDistinctSpillRepartition(t *testing.T) {
+	spill := &distinctSpill{}
+	defer spill.close()
+	pt := newProbeTable(nil)
+	var want []string
+	for i := 0; i < 200; i++ {
+		r := row{sqltypes.NewInt64(int64(i % 100))}
+		code, err := pt.hashCodeForRow(r)
+		require.NoError(t, err)
+		require.NoError(t, spill.add(code, r))
+		if i < 100 {
+			want = append(want, fmt.Sprintf("%v", r))
+		}
+	}
+
+	// The partitions of about 12 rows do not fit in memory, so that their
+	// rows are spilled again until they do.
+	var got []string
+	exceedsMaxRows := func(rows int) bool { return rows > 2 }
+	err := spill.drain(nil, exceedsMaxRows, func(result *sqltypes.Result) error {
+		assert.LessOrEqual(t, len(result.Rows), 2)
+		for _, row := range result.Rows {
+			got = append(got, fmt.Sprintf("%v", row))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, want, got)
+}
+
+func TestDistinctExecuteLimits(t *testing.T) {
+	newDistinct := func() *Distinct {
+		return &Distinct{
+			Source: &fakePrimitive{
+				results: []*sqltypes.Result{
+					r("myid|name", "int64|varchar", "1|a", "2|b", "1|a"),
+					r("myid|name", "int64|varchar", "3|c", "2|b"),
+					nil,
+				},
+				sendErr:             errors.New("input not consumed"),
+				allResultsInOneCall: true,
+			},
+			ColCollations: []collations.ID{collations.Unknown, collations.ID(0x21)},
+		}
+	}
+
+	_, err := newDistinct().TryExecute(&loggingVCursor{maxAggregationGroups: 3}, nil, true)
+	require.EqualError(t, err, "input not consumed")
+
+	// The limit fails the query before the whole input is read.
+	_, err = newDistinct().TryExecute(&loggingVCursor{maxAggregationGroups: 2}, nil, true)
+	require.EqualError(t, err, "the aggregation held more than 2 groups in memory: filter the rows or group them by fewer values, or stream the query")
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*DualWrite)(nil)
+
+// DualWrite is a primitive that executes a DML statement on the two tables
+// that the routing rules dual-route its writes to. Both writes run in the
+// same transaction, and the result of the primary one is returned.
+//
+// The dual write is only as atomic as the commit of that transaction: unless
+// its transaction mode is TWOPC, a failed commit can leave the write applied
+// to one table only.
+type DualWrite struct {
+	txNeeded
+
+	Primary   Primitive
+	Secondary Primitive
+}
+
+// RouteType implements the Primitive interface
+func (dw *DualWrite) RouteType() string {
+	return "DualWrite"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (dw *DualWrite) GetKeyspaceName() string {
+	return dw.Primary.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (dw *DualWrite) GetTableName() string {
+	return dw.Primary.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (dw *DualWrite) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	// A write that autocommits could not be rolled back if the other one fails.
+	vcursor.DisableAutocommit()
+	qr, err := vcursor.ExecutePrimitive(dw.Primary, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	secondary, err := vcursor.ExecutePrimitive(dw.Secondary, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	// The auto-increment columns of MySQL generate their own values in each
+	// table.
+	if qr.InsertID != 0 && secondary.InsertID != 0 && qr.InsertID != secondary.InsertID {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the dual write of %s generated the auto-increment value %d in the first table and %d in the second", dw.Primary.GetTableName(), qr.InsertID, secondary.InsertID)
+	}
+	return qr, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (dw *DualWrite) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	qr, err := dw.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(qr)
+}
+
+// GetFields implements the Primitive interface
+func (dw *DualWrite) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return dw.Primary.GetFields(vcursor, bindVars)
+}
+
+// Inputs implements the Primitive interface
+func (dw *DualWrite) Inputs() []Primitive {
+	return []Primitive{dw.Primary, dw.Secondary}
+}
+
+func (dw *DualWrite) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "DualWrite",
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDualWriteExecute(t *testing.T) {
+	primary := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 2}}}
+	secondary := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1}}}
+	dw := &DualWrite{Primary: primary, Secondary: secondary}
+	assert.True(t, dw.NeedsTransaction())
+
+	vc := &loggingVCursor{}
+	qr, err := dw.TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, &sqltypes.Result{RowsAffected: 2}, qr)
+	vc.ExpectLog(t, []string{"DisableAutocommit"})
+	primary.ExpectLog(t, []string{"Execute  true"})
+	secondary.ExpectLog(t, []string{"Execute  false"})
+
+	primary.rewind()
+	secondary.rewind()
+	qr, err = wrapStreamExecute(dw, vc, nil, true)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, qr.RowsAffected)
+}
+
+func TestDualWriteError(t *testing.T) {
+	primary := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 2}}}
+	secondary := &fakePrimitive{sendErr: errors.New("secondary failed")}
+	dw := &DualWrite{Primary: primary, Secondary: secondary}
+
+	_, err := dw.TryExecute(&loggingVCursor{}, nil, false)
+	assert.EqualError(t, err, "secondary failed")
+
+	// The secondary write isn't executed if the primary one fails.
+	primary = &fakePrimitive{sendErr: errors.New("primary failed")}
+	secondary = &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1}}}
+	dw = &DualWrite{Primary: primary, Secondary: secondary}
+	_, err = dw.TryExecute(&loggingVCursor{}, nil, false)
+	assert.EqualError(t, err, "primary failed")
+	secondary.ExpectLog(t, nil)
+}
+
+func TestDualWriteInsertID(t *testing.T) {
+	// The same inserted ids are fine.
+	primary := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1, InsertID: 5}}}
+	secondary := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1, InsertID: 5}}}
+	dw := &DualWrite{Primary: primary, Secondary: secondary}
+	qr, err := dw.TryExecute(&loggingVCursor{}, nil, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, qr.InsertID)
+
+	// The auto-increment columns of the two tables generated different ids.
+	primary = &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1, InsertID: 5}}}
+	secondary = &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1, InsertID: 9}}}
+	dw = &DualWrite{Primary: primary, Secondary: secondary}
+	_, err = dw.TryExecute(&loggingVCursor{}, nil, false)
+	assert.EqualError(t, err, "the dual write of fakeTable generated the auto-increment value 5 in the first table and 9 in the second")
+}
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -127,10 +128,38 @@ func (t *noopVCursor) SetSessionTrackGTIDs(b bool) {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetReadYourWrites(b bool) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetReadAtTimestamp(s string) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetIncludeReplicationLag(b bool) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetQueryTags(tags string) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetAllowCrossCellWrites(b bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) HasCreatedTempTable() {
 	panic("implement me")
 }
 
+func (t *noopVCursor) AddTempTable(keyspace, table string) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) RemoveTempTable(keyspace, table string) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -151,6 +180,10 @@ func (t *noopVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.Boun
 	panic("implement me")
 }
 
+func (t *noopVCursor) LockSessionShards() []*srvtopo.ResolvedShard {
+	return nil
+}
+
 func (t *noopVCursor) NeedsReservedConn() {
 }
 
@@ -190,6 +223,10 @@ func (t *noopVCursor) SetSkipQueryPlanCache(bool) error {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetSkipQueryConsolidation(bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
@@ -220,6 +257,18 @@ func (t *noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t *noopVCursor) MaxInClauseValues() int {
+	return 0
+}
+
+func (t *noopVCursor) SchemaTrackingEnabled() bool {
+	return false
+}
+
+func (t *noopVCursor) AggregationLimits() (int, int64) {
+	return 0, 0
+}
+
 func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
@@ -255,10 +304,17 @@ func (t *noopVCursor) AutocommitApproval() bool {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) DisableAutocommit() {
+}
+
 func (t *noopVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) NextSequenceValues(gen *Generate, rs *srvtopo.ResolvedShard, count int64) (int64, error) {
+	panic("unimplemented")
+}
+
 func (t *noopVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
 	panic("unimplemented")
 }
@@ -316,12 +372,31 @@ type loggingVCursor struct {
 
 	// map different shards to keyspaces in the test.
 	ksShardMap map[string][]string
+
+	maxInClauseValues int
+
+	schemaTracking bool
+
+	maxAggregationGroups int
+	maxAggregationBytes  int64
 }
 
 type tableRoutes struct {
 	tbl *vindexes.Table
 }
 
+func (f *loggingVCursor) MaxInClauseValues() int {
+	return f.maxInClauseValues
+}
+
+func (f *loggingVCursor) SchemaTrackingEnabled() bool {
+	return f.schemaTracking
+}
+
+func (f *loggingVCursor) AggregationLimits() (int, int64) {
+	return f.maxAggregationGroups, f.maxAggregationBytes
+}
+
 func (f *loggingVCursor) ExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	return primitive.TryExecute(f, bindVars, wantfields)
 }
@@ -368,6 +443,18 @@ func (f *loggingVCursor) NeedsReservedConn() {
 	f.inReservedConn = true
 }
 
+func (f *loggingVCursor) HasCreatedTempTable() {
+	f.log = append(f.log, "Has Created Temp Table")
+}
+
+func (f *loggingVCursor) AddTempTable(keyspace, table string) {
+	f.log = append(f.log, fmt.Sprintf("Temp Table added %s.%s", keyspace, table))
+}
+
+func (f *loggingVCursor) RemoveTempTable(keyspace, table string) {
+	f.log = append(f.log, fmt.Sprintf("Temp Table removed %s.%s", keyspace, table))
+}
+
 func (f *loggingVCursor) InReservedConn() bool {
 	return f.inReservedConn
 }
@@ -444,11 +531,23 @@ func (f *loggingVCursor) AutocommitApproval() bool {
 	return true
 }
 
+func (f *loggingVCursor) DisableAutocommit() {
+	f.log = append(f.log, "DisableAutocommit")
+}
+
 func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	f.log = append(f.log, fmt.Sprintf("ExecuteStandalone %s %v %s %s", query, printBindVars(bindvars), rs.Target.Keyspace, rs.Target.Shard))
 	return f.nextResult()
 }
 
+func (f *loggingVCursor) NextSequenceValues(gen *Generate, rs *srvtopo.ResolvedShard, count int64) (int64, error) {
+	qr, err := f.ExecuteStandalone(gen.Query, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}, rs)
+	if err != nil {
+		return 0, err
+	}
+	return evalengine.ToInt64(qr.Rows[0][0])
+}
+
 func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
 	f.mu.Lock()
 	f.log = append(f.log, fmt.Sprintf("StreamExecuteMulti %s %s", query, printResolvedShardsBindVars(rss, bindVars)))
@@ -638,6 +737,10 @@ func (f *loggingVCursor) SetSkipQueryPlanCache(bool) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetSkipQueryConsolidation(bool) error {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"regexp"
+
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// inClauseBatch is a round of the shard queries of a route, with at most
+// one query per shard.
+type inClauseBatch struct {
+	rss []*srvtopo.ResolvedShard
+	bvs []map[string]*querypb.BindVariable
+}
+
+// inClauseBatches splits the values of the IN clause that a route sends to
+// every shard into batches of at most vcursor.MaxInClauseValues() values.
+// The batches run one after the other, so that a shard never runs two of
+// them at the same time, which it couldn't do in a transaction. A route
+// that doesn't need to be batched runs in a single batch.
+func (route *Route) inClauseBatches(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) []inClauseBatch {
+	single := []inClauseBatch{{rss: rss, bvs: bvs}}
+	maxValues := vcursor.MaxInClauseValues()
+	if route.Opcode != IN || maxValues <= 0 {
+		return single
+	}
+	count := 1
+	for _, bv := range bvs {
+		if n := (len(bv[ListVarName].GetValues()) + maxValues - 1) / maxValues; n > count {
+			count = n
+		}
+	}
+	if count == 1 {
+		return single
+	}
+
+	// The shards only need the values of their batch, so the list of all
+	// the values is not sent to them, unless the query uses it elsewhere.
+	var unused string
+	if bv, ok := route.Values[0].(*evalengine.BindVariable); ok && bindVars[bv.Key].GetType() == querypb.Type_TUPLE && !usesBindVar(route.Query, bv.Key) {
+		unused = bv.Key
+	}
+
+	batches := make([]inClauseBatch, count)
+	for i, rs := range rss {
+		values := bvs[i][ListVarName].GetValues()
+		for b := 0; len(values) > 0; b++ {
+			n := len(values)
+			if n > maxValues {
+				n = maxValues
+			}
+			bv := make(map[string]*querypb.BindVariable, len(bvs[i]))
+			for k, v := range bvs[i] {
+				if k != unused {
+					bv[k] = v
+				}
+			}
+			bv[ListVarName] = &querypb.BindVariable{
+				Type:   querypb.Type_TUPLE,
+				Values: values[:n],
+			}
+			values = values[n:]
+			batches[b].rss = append(batches[b].rss, rs)
+			batches[b].bvs = append(batches[b].bvs, bv)
+		}
+	}
+	return batches
+}
+
+// usesBindVar returns true if query refers to the bind variable name.
+func usesBindVar(query, name string) bool {
+	return regexp.MustCompile(`:` + regexp.QuoteMeta(name) + `\b`).MatchString(query)
+}
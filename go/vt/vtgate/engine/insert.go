@@ -19,10 +19,14 @@ package engine
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/sqlparser"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -99,11 +103,56 @@ type (
 		// Input is a select query plan to retrieve results for inserting data.
 		Input Primitive `json:",omitempty"`
 
+		// OnDupVindexValues contains the values assigned by the ON DUPLICATE KEY UPDATE
+		// clause to the columns of owned vindexes, indexed by vindex name.
+		OnDupVindexValues map[string]*OnDupVindexValues
+
+		// OnDupColumns are the inserted columns, and OnDupColumnValues their values,
+		// indexed by column then row. The engine uses them to find the existing rows
+		// that the inserted rows conflict with on a unique key of the table. A value
+		// is nil if vtgate can't evaluate it.
+		OnDupColumns      sqlparser.Columns
+		OnDupColumnValues [][]evalengine.Expr
+
+		// OnDupSingleConflict makes the insert fail if an inserted row conflicts
+		// with more than one existing row. It is set when the insert stands for
+		// a REPLACE, which deletes all of them where an upsert updates one. The
+		// insert then reports the affected rows of the REPLACE.
+		OnDupSingleConflict bool
+
+		// onDupUniqueKeys caches the unique keys of the table, which are read
+		// from the schema on the first insert that needs them. They are only
+		// cached with the schema tracker, which evicts the plan when the schema
+		// of the table changes.
+		onDupUniqueKeys onDupUniqueKeys
+
 		// Insert needs tx handling
 		txNeeded
 	}
 
+	// onDupUniqueKeys caches the unique keys of the table of an insert for as
+	// long as its plan is cached. The plans of a table are evicted when the
+	// schema tracker reports that its schema changed.
+	onDupUniqueKeys struct {
+		mu     sync.Mutex
+		loaded bool
+		keys   [][]sqlparser.ColIdent
+	}
+
 	ksID = []byte
+
+	// OnDupVindexValues contains the values that an ON DUPLICATE KEY UPDATE
+	// clause assigns to the columns of an owned vindex.
+	OnDupVindexValues struct {
+		// PvMap contains the values of the columns that are set to an expression.
+		PvMap map[string]evalengine.Expr
+		// Inserted contains the columns that are set to the value they
+		// were going to be inserted with, using VALUES(col).
+		Inserted map[string]bool
+		// Offset is the offset of the vindex columns in the selected columns
+		// of the conflicting rows.
+		Offset int
+	}
 )
 
 func (ins *Insert) Inputs() []Primitive {
@@ -158,7 +207,12 @@ func NewInsert(
 // a value from a sequence.
 type Generate struct {
 	Keyspace *vindexes.Keyspace
-	Query    string
+	// TableName is the name of the sequence table.
+	TableName string
+	// Pinned is the keyspace id of the sequence table, if it is pinned
+	// in a sharded keyspace.
+	Pinned []byte
+	Query  string
 	// Values are the supplied values for the column, which
 	// will be stored as a list within the expression. New
 	// values will be generated based on how many were not
@@ -314,12 +368,20 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	if err != nil {
 		return nil, err
 	}
-	rss, queries, err := ins.getInsertShardedRoute(vcursor, bindVars)
+	rss, queries, replaceRowsAffected, err := ins.getInsertShardedRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
 	}
 
-	return ins.executeInsertQueries(vcursor, rss, queries, insertID)
+	result, err := ins.executeInsertQueries(vcursor, rss, queries, insertID)
+	if err != nil {
+		return nil, err
+	}
+	if ins.OnDupSingleConflict {
+		// Report the affected rows of the REPLACE rather than the ones of the upsert.
+		result.RowsAffected = replaceRowsAffected
+	}
+	return result, nil
 }
 
 func (ins *Insert) executeInsertQueries(
@@ -508,21 +570,7 @@ func (ins *Insert) processGenerateFromValues(vcursor VCursor, bindVars map[strin
 
 	// If generation is needed, generate the requested number of values (as one call).
 	if count != 0 {
-		rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-		if err != nil {
-			return 0, err
-		}
-		if len(rss) != 1 {
-			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
-		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
-		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+		insertID, err = ins.Generate.next(vcursor, count)
 		if err != nil {
 			return 0, err
 		}
@@ -541,6 +589,24 @@ func (ins *Insert) processGenerateFromValues(vcursor VCursor, bindVars map[strin
 	return insertID, nil
 }
 
+// next reserves count consecutive values of the sequence, and returns the
+// first one. A pinned sequence is read from the shard of its keyspace id,
+// an unpinned one from the single shard of its unsharded keyspace.
+func (gen *Generate) next(vcursor VCursor, count int64) (int64, error) {
+	var dest key.Destination = key.DestinationAnyShard{}
+	if gen.Pinned != nil {
+		dest = key.DestinationKeyspaceID(gen.Pinned)
+	}
+	rss, _, err := vcursor.ResolveDestinations(gen.Keyspace.Name, nil, []key.Destination{dest})
+	if err != nil {
+		return 0, err
+	}
+	if len(rss) != 1 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
+	}
+	return vcursor.NextSequenceValues(gen, rss[0], count)
+}
+
 // processGenerateFromRows generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -566,21 +632,7 @@ func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows []sqltypes.Row)
 	}
 
 	// If generation is needed, generate the requested number of values (as one call).
-	rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-	if err != nil {
-		return 0, err
-	}
-	if len(rss) != 1 {
-		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
-	}
-	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-	qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-	if err != nil {
-		return 0, err
-	}
-	// If no rows are returned, it's an internal error, and the code
-	// must panic, which will be caught and reported.
-	insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+	insertID, err = ins.Generate.next(vcursor, count)
 	if err != nil {
 		return 0, err
 	}
@@ -608,7 +660,9 @@ func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows []sqltypes.Row)
 // For unowned vindexes with no input values, it reverse maps.
 // For unowned vindexes with values, it validates.
 // If it's an IGNORE or ON DUPLICATE key insert, it drops unroutable rows.
-func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+// It also returns the number of rows that the insert affects if it stands
+// for a REPLACE.
+func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, uint64, error) {
 	// vindexRowsValues builds the values of all vindex columns.
 	// the 3-d structure indexes are colVindex, row, col. Note that
 	// ins.Values indexes are colVindex, col, row. So, the conversion
@@ -624,27 +678,27 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	}
 	for vIdx, vColValues := range ins.VindexValues {
 		if len(vColValues) != len(colVindexes[vIdx].Columns) {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] supplied vindex column values don't match vschema: %v %v", vColValues, colVindexes[vIdx].Columns)
+			return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] supplied vindex column values don't match vschema: %v %v", vColValues, colVindexes[vIdx].Columns)
 		}
 		for colIdx, colValues := range vColValues {
 			rowsResolvedValues := make(sqltypes.Row, 0, len(colValues))
 			for _, colValue := range colValues {
 				result, err := env.Evaluate(colValue)
 				if err != nil {
-					return nil, nil, err
+					return nil, nil, 0, err
 				}
 				rowsResolvedValues = append(rowsResolvedValues, result.Value())
 			}
 			// This is the first iteration: allocate for transpose.
 			if colIdx == 0 {
 				if len(rowsResolvedValues) == 0 {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] rowcount is zero for inserts: %v", rowsResolvedValues)
+					return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] rowcount is zero for inserts: %v", rowsResolvedValues)
 				}
 				if rowCount == 0 {
 					rowCount = len(rowsResolvedValues)
 				}
 				if rowCount != len(rowsResolvedValues) {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
+					return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
 				}
 				vindexRowsValues[vIdx] = make([]sqltypes.Row, rowCount)
 			}
@@ -660,23 +714,41 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	// results in an error. For 'ignore' type inserts, the keyspace
 	// id is returned as nil, which is used later to drop the corresponding rows.
 	if len(vindexRowsValues) == 0 || len(colVindexes) == 0 {
-		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
+		return nil, nil, 0, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
 	}
 	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], colVindexes[0])
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
+	}
+
+	conflicts, err := ins.updateOnDupVindexEntries(vcursor, bindVars, vindexRowsValues, colVindexes, keyspaceIDs)
+	if err != nil {
+		return nil, nil, 0, err
 	}
 
 	for vIdx := 1; vIdx < len(colVindexes); vIdx++ {
 		colVindex := colVindexes[vIdx]
 		var err error
 		if colVindex.Owned {
-			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+			err = ins.processOwnedWithConflicts(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs, conflicts)
 		} else {
 			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
 		}
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, 0, err
+		}
+	}
+
+	// A REPLACE affects each row it inserts, and each row it deletes, which is
+	// the row that the inserted row conflicts with.
+	var replaceRowsAffected uint64
+	for rowNum, ksid := range keyspaceIDs {
+		if ksid == nil {
+			continue
+		}
+		replaceRowsAffected++
+		if conflicts != nil && conflicts[rowNum] {
+			replaceRowsAffected++
 		}
 	}
 
@@ -712,12 +784,12 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	if len(destinations) == 0 {
 		// In this case, all we have is nil KeyspaceIds, we don't do
 		// anything at all.
-		return nil, nil, nil
+		return nil, nil, 0, nil
 	}
 
 	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
@@ -736,7 +808,7 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	return rss, queries, nil
+	return rss, queries, replaceRowsAffected, nil
 }
 
 // processPrimary maps the primary vindex values to the keyspace ids.
@@ -755,10 +827,10 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys []sqltypes.
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
 			if !ins.Ignore {
-				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
+				return nil, fmt.Errorf("could not map %s to a keyspace id", obfuscation.Values(vindexColumnsKeys[i]))
 			}
 		default:
-			return nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", vindexColumnsKeys[i], destination)
+			return nil, fmt.Errorf("could not map %s to a unique keyspace id: %v", obfuscation.Values(vindexColumnsKeys[i]), destination)
 		}
 	}
 
@@ -806,6 +878,372 @@ func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys []sqltypes.Ro
 	return nil
 }
 
+// processOwnedWithConflicts creates vindex entries for the values of an owned column,
+// except for the rows that conflict with an existing row. Those rows are not inserted,
+// and their vindex entries were already updated by updateOnDupVindexEntries.
+func (ins *Insert) processOwnedWithConflicts(vcursor VCursor, vindexColumnsKeys []sqltypes.Row, colVindex *vindexes.ColumnVindex, ksids []ksID, conflicts []bool) error {
+	if conflicts == nil {
+		return ins.processOwned(vcursor, vindexColumnsKeys, colVindex, ksids)
+	}
+	createKsids := make([]ksID, len(ksids))
+	for rowNum, ksid := range ksids {
+		if !conflicts[rowNum] {
+			createKsids[rowNum] = ksid
+		}
+	}
+	if err := ins.processOwned(vcursor, vindexColumnsKeys, colVindex, createKsids); err != nil {
+		return err
+	}
+	// Drop the rows that processOwned could not verify.
+	for rowNum, ksid := range createKsids {
+		if ksid == nil && !conflicts[rowNum] {
+			ksids[rowNum] = nil
+		}
+	}
+	return nil
+}
+
+// updateOnDupVindexEntries updates the owned vindex entries of the existing rows that
+// the inserted rows conflict with, for the vindexes changed by the ON DUPLICATE KEY UPDATE
+// clause. Like MySQL, it finds the conflicting rows by the values of the unique keys of
+// the table, which are read from the schema of the first shard. It returns which of the
+// rows conflict with an existing row, or nil if no vindex is changed and the insert
+// doesn't stand for a REPLACE.
+func (ins *Insert) updateOnDupVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][]sqltypes.Row, colVindexes []*vindexes.ColumnVindex, ksids []ksID) ([]bool, error) {
+	if len(ins.OnDupVindexValues) == 0 && !ins.OnDupSingleConflict {
+		return nil, nil
+	}
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for rowNum, ksid := range ksids {
+		if ksid == nil {
+			continue
+		}
+		indexes = append(indexes, &querypb.Value{
+			Value: strconv.AppendInt(nil, int64(rowNum), 10),
+		})
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	conflicts := make([]bool, len(ksids))
+	if len(destinations) == 0 {
+		return conflicts, nil
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return nil, err
+	}
+	uniqueKeys, err := ins.uniqueKeys(vcursor, rss[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(uniqueKeys) == 0 {
+		return conflicts, nil
+	}
+
+	// Select the columns of the changed vindexes, followed by the columns of the unique keys.
+	var columns []sqlparser.ColIdent
+	for _, colVindex := range colVindexes {
+		if values, ok := ins.OnDupVindexValues[colVindex.Name]; ok {
+			for colIdx, col := range colVindex.Columns {
+				for len(columns) <= values.Offset+colIdx {
+					columns = append(columns, sqlparser.ColIdent{})
+				}
+				columns[values.Offset+colIdx] = col
+			}
+		}
+	}
+	keyOffsets := make([][]int, len(uniqueKeys))
+	for keyIdx, uniqueKey := range uniqueKeys {
+		for _, col := range uniqueKey {
+			offset := -1
+			for i, selected := range columns {
+				if selected.Equal(col) {
+					offset = i
+					break
+				}
+			}
+			if offset == -1 {
+				offset = len(columns)
+				columns = append(columns, col)
+			}
+			keyOffsets[keyIdx] = append(keyOffsets[keyIdx], offset)
+		}
+	}
+
+	// insertedKeys contains the inserted values of the unique keys, indexed by
+	// key then row, or nil when the row can't conflict on the key.
+	insertedKeys, err := ins.insertedUniqueKeys(vcursor, bindVars, uniqueKeys, ksids)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryRss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	for i, rs := range rss {
+		var predicates []string
+		queryBindVars := make(map[string]*querypb.BindVariable)
+		for _, indexValue := range indexesPerRss[i] {
+			rowNum, _ := strconv.Atoi(string(indexValue.Value))
+			for keyIdx, uniqueKey := range uniqueKeys {
+				if insertedKeys[keyIdx][rowNum] == nil {
+					continue
+				}
+				buf := sqlparser.NewTrackedBuffer(nil)
+				for colIdx, col := range uniqueKey {
+					if colIdx > 0 {
+						buf.WriteString(" and ")
+					}
+					name := onDupVarName(col, rowNum)
+					queryBindVars[name] = sqltypes.ValueBindVariable(insertedKeys[keyIdx][rowNum][colIdx])
+					buf.Myprintf("%v = :%s", col, name)
+				}
+				predicates = append(predicates, "("+buf.String()+")")
+			}
+		}
+		if len(predicates) == 0 {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		for i, col := range columns {
+			if i == 0 {
+				buf.Myprintf("select %v", col)
+			} else {
+				buf.Myprintf(", %v", col)
+			}
+		}
+		buf.Myprintf(" from %v where %s for update", sqlparser.TableName{Name: ins.Table.Name}, strings.Join(predicates, " or "))
+		queryRss = append(queryRss, rs)
+		queries = append(queries, &querypb.BoundQuery{
+			Sql:           buf.String(),
+			BindVariables: queryBindVars,
+		})
+	}
+	if len(queries) == 0 {
+		return conflicts, nil
+	}
+	result, errs := vcursor.ExecuteMultiShard(queryRss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Find the existing row that each inserted row conflicts with. MySQL only
+	// updates one of them if there are several, which one can't be told here.
+	conflictRows := make([]int, len(ksids))
+	for rowNum := range conflictRows {
+		conflictRows[rowNum] = -1
+	}
+	for rowIdx, row := range result.Rows {
+		for rowNum, ksid := range ksids {
+			if ksid == nil {
+				continue
+			}
+			for keyIdx, offsets := range keyOffsets {
+				inserted := insertedKeys[keyIdx][rowNum]
+				if inserted == nil {
+					continue
+				}
+				existing := make([]sqltypes.Value, 0, len(offsets))
+				for _, offset := range offsets {
+					existing = append(existing, row[offset])
+				}
+				match, err := rowsEqual(existing, inserted, vcursor.ConnCollation())
+				if err != nil {
+					return nil, err
+				}
+				if !match {
+					continue
+				}
+				if conflictRows[rowNum] != -1 && conflictRows[rowNum] != rowIdx {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: inserted row %d conflicts with more than one row of %s on duplicate key", rowNum+1, ins.Table.Name.String())
+				}
+				conflictRows[rowNum] = rowIdx
+			}
+		}
+	}
+	// An inserted row that conflicts with a row inserted before it by the same
+	// statement updates that row, whose vindex entries don't exist yet.
+	for rowNum, ksid := range ksids {
+		if ksid == nil {
+			continue
+		}
+		for prevNum := 0; prevNum < rowNum; prevNum++ {
+			if ksids[prevNum] == nil || conflictRows[prevNum] == conflictRows[rowNum] && conflictRows[rowNum] != -1 {
+				continue
+			}
+			for keyIdx := range uniqueKeys {
+				if insertedKeys[keyIdx][rowNum] == nil || insertedKeys[keyIdx][prevNum] == nil {
+					continue
+				}
+				match, err := rowsEqual(insertedKeys[keyIdx][rowNum], insertedKeys[keyIdx][prevNum], vcursor.ConnCollation())
+				if err != nil {
+					return nil, err
+				}
+				if match {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: inserted rows %d and %d conflict with each other on duplicate key", prevNum+1, rowNum+1)
+				}
+			}
+		}
+	}
+
+	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	for rowIdx, row := range result.Rows {
+		// The same row may be updated by more than one inserted row, in which
+		// case every update starts from the values left behind by the previous one.
+		current := append(sqltypes.Row(nil), row...)
+		for rowNum, ksid := range ksids {
+			if ksid == nil || conflictRows[rowNum] != rowIdx {
+				continue
+			}
+			conflicts[rowNum] = true
+			for vIdx, colVindex := range colVindexes {
+				values, ok := ins.OnDupVindexValues[colVindex.Name]
+				if !ok {
+					continue
+				}
+				fromValues := current[values.Offset : values.Offset+len(colVindex.Columns)]
+				toValues := make([]sqltypes.Value, 0, len(colVindex.Columns))
+				for colIdx, col := range colVindex.Columns {
+					switch expr, ok := values.PvMap[col.String()]; {
+					case ok:
+						resolved, err := env.Evaluate(expr)
+						if err != nil {
+							return nil, err
+						}
+						toValues = append(toValues, resolved.Value())
+					case values.Inserted[col.String()]:
+						toValues = append(toValues, vindexRowsValues[vIdx][rowNum][colIdx])
+					default:
+						// The column is not changed, keep its current value.
+						toValues = append(toValues, fromValues[colIdx])
+					}
+				}
+				same, err := rowsEqual(fromValues, toValues, vcursor.ConnCollation())
+				if err != nil {
+					return nil, err
+				}
+				if same {
+					continue
+				}
+				if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, append([]sqltypes.Value(nil), fromValues...), ksid, toValues); err != nil {
+					return nil, err
+				}
+				copy(fromValues, toValues)
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// uniqueKeys returns the columns of the primary key and of the unique keys of the
+// table, read from the schema of the given shard. With the schema tracker, they
+// are read the first time, and then cached until the plan is evicted. Without
+// it, nothing would evict them after a DDL, so they are read every time.
+func (ins *Insert) uniqueKeys(vcursor VCursor, rs *srvtopo.ResolvedShard) ([][]sqlparser.ColIdent, error) {
+	if !vcursor.SchemaTrackingEnabled() {
+		return ins.readUniqueKeys(vcursor, rs)
+	}
+	cache := &ins.onDupUniqueKeys
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.loaded {
+		return cache.keys, nil
+	}
+	uniqueKeys, err := ins.readUniqueKeys(vcursor, rs)
+	if err != nil {
+		return nil, err
+	}
+	cache.loaded, cache.keys = true, uniqueKeys
+	return uniqueKeys, nil
+}
+
+func (ins *Insert) readUniqueKeys(vcursor VCursor, rs *srvtopo.ResolvedShard) ([][]sqlparser.ColIdent, error) {
+	query := &querypb.BoundQuery{
+		Sql: "select index_name, column_name, sub_part from information_schema.statistics " +
+			"where table_schema = database() and table_name = :table_name and non_unique = 0 " +
+			"order by index_name, seq_in_index",
+		BindVariables: map[string]*querypb.BindVariable{
+			"table_name": sqltypes.StringBindVariable(ins.Table.Name.String()),
+		},
+	}
+	result, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, []*querypb.BoundQuery{query}, false /* rollbackOnError */, false /* canAutocommit */)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uniqueKeys [][]sqlparser.ColIdent
+	var indexName string
+	for _, row := range result.Rows {
+		if !row[2].IsNull() {
+			// The key only compares a prefix of the column.
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unique key %s of %s is on a column prefix, can't find the rows conflicting on duplicate key", row[0].ToString(), ins.Table.Name.String())
+		}
+		if len(uniqueKeys) == 0 || row[0].ToString() != indexName {
+			indexName = row[0].ToString()
+			uniqueKeys = append(uniqueKeys, nil)
+		}
+		uniqueKeys[len(uniqueKeys)-1] = append(uniqueKeys[len(uniqueKeys)-1], sqlparser.NewColIdent(row[1].ToString()))
+	}
+	return uniqueKeys, nil
+}
+
+// insertedUniqueKeys returns the inserted values of the unique keys, indexed by key
+// then row. The values of a row are nil if one of them is NULL, since the row can't
+// conflict on the key then.
+func (ins *Insert) insertedUniqueKeys(vcursor VCursor, bindVars map[string]*querypb.BindVariable, uniqueKeys [][]sqlparser.ColIdent, ksids []ksID) ([][]sqltypes.Row, error) {
+	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	insertedKeys := make([][]sqltypes.Row, len(uniqueKeys))
+	for keyIdx, uniqueKey := range uniqueKeys {
+		insertedKeys[keyIdx] = make([]sqltypes.Row, len(ksids))
+		for rowNum, ksid := range ksids {
+			if ksid == nil {
+				continue
+			}
+			values := make(sqltypes.Row, 0, len(uniqueKey))
+			for _, col := range uniqueKey {
+				colNum := -1
+				for i, insCol := range ins.OnDupColumns {
+					if insCol.Equal(col) {
+						colNum = i
+					}
+				}
+				if colNum == -1 || ins.OnDupColumnValues[colNum][rowNum] == nil {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: the inserted value of column %s of a unique key is needed to find the rows conflicting on duplicate key", col.String())
+				}
+				result, err := env.Evaluate(ins.OnDupColumnValues[colNum][rowNum])
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, result.Value())
+			}
+			null := false
+			for _, value := range values {
+				null = null || value.IsNull()
+			}
+			if !null {
+				insertedKeys[keyIdx][rowNum] = values
+			}
+		}
+	}
+	return insertedKeys, nil
+}
+
+// rowsEqual returns true if the values of both rows compare as equal.
+func rowsEqual(left, right []sqltypes.Value, collationID collations.ID) (bool, error) {
+	for i := range left {
+		cmp, err := evalengine.NullsafeCompare(left[i], right[i], collationID)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // processUnowned either reverse maps or validates the values for an unowned column.
 func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys []sqltypes.Row, colVindex *vindexes.ColumnVindex, ksids []ksID) error {
 	var reverseIndexes []int
@@ -880,7 +1318,7 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys []sqltypes.
 		}
 
 		if mismatchVindexKeys != nil {
-			return fmt.Errorf("values %v for column %v does not map to keyspace ids", mismatchVindexKeys, colVindex.Columns)
+			return fmt.Errorf("values %s for column %v does not map to keyspace ids", obfuscation.Rows(mismatchVindexKeys), colVindex.Columns)
 		}
 	}
 
@@ -893,6 +1331,13 @@ func InsertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return fmt.Sprintf("_%s_%d", col.CompliantName(), rowNum)
 }
 
+// onDupVarName returns the name of the bind var of the inserted value of a unique
+// key column, in the query that reads the rows that the inserted rows conflict with.
+// It can't collide with the bind vars of the insert.
+func onDupVarName(col sqlparser.ColIdent, rowNum int) string {
+	return fmt.Sprintf("_ondup_%s_%d", col.CompliantName(), rowNum)
+}
+
 func insertVarOffset(rowNum, colOffset int) string {
 	return fmt.Sprintf("_c%d_%d", rowNum, colOffset)
 }
@@ -945,6 +1390,17 @@ func (ins *Insert) description() PrimitiveDescription {
 	if ins.Ignore {
 		other["InsertIgnore"] = true
 	}
+	if len(ins.OnDupVindexValues) > 0 {
+		var changedVindexes []string
+		for name := range ins.OnDupVindexValues {
+			changedVindexes = append(changedVindexes, name)
+		}
+		sort.Strings(changedVindexes)
+		other["OnDupChangedVindexes"] = changedVindexes
+	}
+	if ins.OnDupSingleConflict {
+		other["OnDupSingleConflict"] = true
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -121,6 +123,53 @@ func TestInsertUnshardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
 }
 
+func TestInsertUnshardedGeneratePinned(t *testing.T) {
+	ins := NewQueryInsert(
+		InsertUnsharded,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		"dummy_insert",
+	)
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: true,
+		},
+		TableName: "seq",
+		Pinned:    []byte("\x80"),
+		Query:     "dummy_generate",
+		Values: evalengine.NewTupleExpr(
+			evalengine.NullExpr,
+		),
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.shardForKsid = []string{"80-"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"4",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The sequence is read from the shard of its keyspace id.
+		`ResolveDestinations ks2 [] Destinations:DestinationKeyspaceID(80)`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1" ks2 80-`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"4"} true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+}
+
 func TestInsertUnshardedGenerate_Zeros(t *testing.T) {
 	ins := NewQueryInsert(
 		InsertUnsharded,
@@ -1000,6 +1049,303 @@ func TestInsertShardedIgnoreOwnedWithNull(t *testing.T) {
 	})
 }
 
+func TestInsertShardedOnDupOwnedVindexChange(t *testing.T) {
+	ks := onDupTestKeyspace()
+	ins := newOnDupTestInsert(ks)
+
+	// Both rows have the same user_id, only row 1 conflicts with an existing row.
+	uniqueKeys := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name|sub_part",
+			"varchar|varchar|int64",
+		),
+		"PRIMARY|id|null",
+	)
+	existing := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"c3|id",
+			"int64|int64",
+		),
+		"10|1",
+	)
+	verified := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"from",
+			"int64",
+		),
+		"14",
+	)
+	noresult := &sqltypes.Result{}
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "-20", "-20", "-20"}
+	vc.results = []*sqltypes.Result{
+		uniqueKeys,
+		existing,
+		// delete and create the lookup entry of row 1
+		noresult,
+		noresult,
+		// create and verify the lookup entry of row 2
+		noresult,
+		verified,
+		// insert
+		noresult,
+	}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select index_name, column_name, sub_part from information_schema.statistics where table_schema = database() and table_name = :table_name and non_unique = 0 order by index_name, seq_in_index {table_name: type:VARCHAR value:"t1"} ` +
+			`false false`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select c3, id from t1 where (id = :_ondup_id_0) or (id = :_ondup_id_1) for update {_ondup_id_0: type:INT64 value:"1" _ondup_id_1: type:INT64 value:"2"} ` +
+			`false false`,
+		// row 1 moves its lookup entry from 10 to 13.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"10" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"13" toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		// only row 2 gets a new lookup entry.
+		`Execute insert ignore into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"14" toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute select from from lkp1 where from = :from and toc = :toc from: type:INT64 value:"14" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" false`,
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: prefix mid1, mid2 suffix {_c3_0: type:INT64 value:"13" _c3_1: type:INT64 value:"14" _user_id_0: type:INT64 value:"1" _user_id_1: type:INT64 value:"1"} ` +
+			`true true`,
+	})
+}
+
+func TestInsertShardedOnDupOwnedVindexChangeAmbiguous(t *testing.T) {
+	ks := onDupTestKeyspace()
+	ins := newOnDupTestInsert(ks)
+
+	// Row 1 conflicts with one row on its primary key and with another one on c3.
+	uniqueKeys := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name|sub_part",
+			"varchar|varchar|int64",
+		),
+		"PRIMARY|id|null",
+		"c3_uk|c3|null",
+	)
+	existing := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"c3|id",
+			"int64|int64",
+		),
+		"10|1",
+		"13|7",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "-20"}
+	vc.results = []*sqltypes.Result{uniqueKeys, existing}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "unsupported: inserted row 1 conflicts with more than one row of t1 on duplicate key")
+}
+
+func TestInsertShardedReplaceConflictsWithSeveralRows(t *testing.T) {
+	ks := onDupTestKeyspace()
+	ins := newOnDupTestInsert(ks)
+	// A REPLACE that doesn't change any lookup vindex still checks its conflicts.
+	ins.OnDupVindexValues = nil
+	ins.OnDupSingleConflict = true
+
+	uniqueKeys := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name|sub_part",
+			"varchar|varchar|int64",
+		),
+		"PRIMARY|id|null",
+		"c3_uk|c3|null",
+	)
+	existing := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c3",
+			"int64|int64",
+		),
+		"1|10",
+		"7|13",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "-20"}
+	vc.results = []*sqltypes.Result{uniqueKeys, existing}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "unsupported: inserted row 1 conflicts with more than one row of t1 on duplicate key")
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select index_name, column_name, sub_part from information_schema.statistics where table_schema = database() and table_name = :table_name and non_unique = 0 order by index_name, seq_in_index {table_name: type:VARCHAR value:"t1"} ` +
+			`false false`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select id, c3 from t1 where (id = :_ondup_id_0) or (c3 = :_ondup_c3_0) or (id = :_ondup_id_1) or (c3 = :_ondup_c3_1) for update {_ondup_c3_0: type:INT64 value:"13" _ondup_c3_1: type:INT64 value:"14" _ondup_id_0: type:INT64 value:"1" _ondup_id_1: type:INT64 value:"2"} ` +
+			`false false`,
+	})
+}
+
+func TestInsertShardedReplaceRowsAffected(t *testing.T) {
+	ks := onDupTestKeyspace()
+	ins := newOnDupTestInsert(ks)
+	ins.OnDupVindexValues = nil
+	ins.OnDupSingleConflict = true
+
+	uniqueKeys := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name|sub_part",
+			"varchar|varchar|int64",
+		),
+		"PRIMARY|id|null",
+		"c3_uk|c3|null",
+	)
+	// Row 1 replaces the row with the same id, row 2 is inserted.
+	existing := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c3",
+			"int64|int64",
+		),
+		"1|10",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "-20"}
+	// The upsert reports 1 row: row 1 already had the inserted values.
+	verified := sqltypes.MakeTestResult(sqltypes.MakeTestFields("from", "int64"), "14")
+	vc.results = []*sqltypes.Result{uniqueKeys, existing, {}, verified, {RowsAffected: 1}}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, result.RowsAffected, "the REPLACE deletes a row and inserts two")
+}
+
+func TestInsertUniqueKeysCached(t *testing.T) {
+	uniqueKeys := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name|sub_part",
+			"varchar|varchar|int64",
+		),
+		"PRIMARY|id|null",
+		"c3_uk|c3|null",
+	)
+	rs := &srvtopo.ResolvedShard{Target: &querypb.Target{Keyspace: "sharded", Shard: "-20"}}
+	readLog := `ExecuteMultiShard ` +
+		`sharded.-20: select index_name, column_name, sub_part from information_schema.statistics where table_schema = database() and table_name = :table_name and non_unique = 0 order by index_name, seq_in_index {table_name: type:VARCHAR value:"t1"} ` +
+		`false false`
+	want := [][]sqlparser.ColIdent{{sqlparser.NewColIdent("id")}, {sqlparser.NewColIdent("c3")}}
+
+	tests := []struct {
+		name           string
+		schemaTracking bool
+		wantLog        []string
+	}{{
+		// The schema tracker evicts the plan on schema change, so the unique
+		// keys are only read from the schema once.
+		name:           "schema tracking",
+		schemaTracking: true,
+		wantLog:        []string{readLog},
+	}, {
+		// Nothing evicts the plan on schema change, so the unique keys are
+		// read every time.
+		name:           "no schema tracking",
+		schemaTracking: false,
+		wantLog:        []string{readLog, readLog},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ins := newOnDupTestInsert(onDupTestKeyspace())
+			vc := newDMLTestVCursor("-20", "20-")
+			vc.schemaTracking = tc.schemaTracking
+			vc.results = []*sqltypes.Result{uniqueKeys, uniqueKeys}
+			for i := 0; i < 2; i++ {
+				keys, err := ins.uniqueKeys(vc, rs)
+				require.NoError(t, err)
+				require.Equal(t, want, keys)
+			}
+			vc.ExpectLog(t, tc.wantLog)
+		})
+	}
+}
+
+// onDupTestKeyspace returns a keyspace with a table t1 sharded by user_id, whose
+// primary key id isn't a vindex column, and with an owned lookup vindex on c3.
+func onDupTestKeyspace() *vindexes.KeyspaceSchema {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"user_id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	return vs.Keyspaces["sharded"]
+}
+
+// newOnDupTestInsert returns the plan of
+// insert into t1(user_id, id, c3) values (1, 1, 13), (1, 2, 14) on duplicate key update c3 = values(c3)
+func newOnDupTestInsert(ks *vindexes.KeyspaceSchema) *Insert {
+	ins := NewInsert(
+		InsertSharded,
+		true,
+		ks.Keyspace,
+		[][][]evalengine.Expr{{
+			// colVindex columns: user_id
+			{
+				// rows for user_id
+				evalengine.NewLiteralInt(1),
+				evalengine.NewLiteralInt(1),
+			},
+		}, {
+			// colVindex columns: c3
+			{
+				// rows for c3
+				evalengine.NewLiteralInt(13),
+				evalengine.NewLiteralInt(14),
+			},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OnDupVindexValues = map[string]*OnDupVindexValues{
+		"onecol": {
+			PvMap:    map[string]evalengine.Expr{},
+			Inserted: map[string]bool{"c3": true},
+			Offset:   0,
+		},
+	}
+	ins.OnDupColumns = sqlparser.Columns{sqlparser.NewColIdent("user_id"), sqlparser.NewColIdent("id"), sqlparser.NewColIdent("c3")}
+	ins.OnDupColumnValues = [][]evalengine.Expr{
+		{evalengine.NewLiteralInt(1), evalengine.NewLiteralInt(1)},
+		{evalengine.NewLiteralInt(1), evalengine.NewLiteralInt(2)},
+		{evalengine.NewLiteralInt(13), evalengine.NewLiteralInt(14)},
+	}
+	return ins
+}
+
 func TestInsertShardedUnownedVerify(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -17,11 +17,15 @@ limitations under the License.
 package engine
 
 import (
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -40,6 +44,12 @@ type Lock struct {
 
 	FieldQuery string
 
+	// ReleaseAllLocksColumns are the columns of the query that call
+	// RELEASE_ALL_LOCKS(). A session holds its locks on the lock sessions
+	// of several targets, so the function is also sent to the other ones,
+	// and these columns add up the locks released on all of them.
+	ReleaseAllLocksColumns []int
+
 	noInputs
 
 	noTxNeeded
@@ -62,10 +72,30 @@ func (l *Lock) GetTableName() string {
 
 // TryExecute is part of the Primitive interface
 func (l *Lock) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
-	return l.execLock(vcursor, l.Query, bindVars)
+	rs, err := l.resolveShard(vcursor)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := vcursor.ExecuteLock(rs, &querypb.BoundQuery{Sql: l.Query, BindVariables: bindVars})
+	if err != nil || len(l.ReleaseAllLocksColumns) == 0 {
+		return qr, err
+	}
+	return l.releaseAllLocks(vcursor, rs.Target, qr)
 }
 
 func (l *Lock) execLock(vcursor VCursor, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rs, err := l.resolveShard(vcursor)
+	if err != nil {
+		return nil, err
+	}
+	boundQuery := &querypb.BoundQuery{
+		Sql:           query,
+		BindVariables: bindVars,
+	}
+	return vcursor.ExecuteLock(rs, boundQuery)
+}
+
+func (l *Lock) resolveShard(vcursor VCursor) (*srvtopo.ResolvedShard, error) {
 	rss, _, err := vcursor.ResolveDestinations(l.Keyspace.Name, nil, []key.Destination{l.TargetDestination})
 	if err != nil {
 		return nil, err
@@ -73,12 +103,41 @@ func (l *Lock) execLock(vcursor VCursor, query string, bindVars map[string]*quer
 	if len(rss) != 1 {
 		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "lock query can be routed to single shard only: %v", rss)
 	}
+	return rss[0], nil
+}
 
-	boundQuery := &querypb.BoundQuery{
-		Sql:           query,
-		BindVariables: bindVars,
+// releaseAllLocks releases the locks held on the lock sessions of the
+// targets other than the one of the query, and adds their number to the
+// RELEASE_ALL_LOCKS() columns of its result.
+func (l *Lock) releaseAllLocks(vcursor VCursor, target *querypb.Target, qr *sqltypes.Result) (*sqltypes.Result, error) {
+	var released int64
+	for _, rs := range vcursor.LockSessionShards() {
+		if proto.Equal(rs.Target, target) {
+			continue
+		}
+		res, err := vcursor.ExecuteLock(rs, &querypb.BoundQuery{Sql: "select release_all_locks() from dual"})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Rows) == 1 && len(res.Rows[0]) == 1 {
+			count, err := evalengine.ToInt64(res.Rows[0][0])
+			if err != nil {
+				return nil, err
+			}
+			released += count
+		}
+	}
+	if released == 0 || len(qr.Rows) != 1 {
+		return qr, nil
 	}
-	return vcursor.ExecuteLock(rss[0], boundQuery)
+	for _, col := range l.ReleaseAllLocksColumns {
+		count, err := evalengine.ToInt64(qr.Rows[0][col])
+		if err != nil {
+			return nil, err
+		}
+		qr.Rows[0][col] = sqltypes.NewInt64(count + released)
+	}
+	return qr, nil
 }
 
 // TryStreamExecute is part of the Primitive interface
@@ -100,6 +159,9 @@ func (l *Lock) description() PrimitiveDescription {
 		"Query":      l.Query,
 		"FieldQuery": l.FieldQuery,
 	}
+	if len(l.ReleaseAllLocksColumns) > 0 {
+		other["ReleaseAllLocksColumns"] = l.ReleaseAllLocksColumns
+	}
 	return PrimitiveDescription{
 		OperatorType:      "Lock",
 		Keyspace:          l.Keyspace,
@@ -217,36 +217,50 @@ func (oa *OrderedAggregate) TryExecute(vcursor VCursor, bindVars map[string]*que
 }
 
 func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	result, err := vcursor.ExecutePrimitive(oa.Input, bindVars, wantfields)
-	if err != nil {
-		return nil, err
-	}
-	out := &sqltypes.Result{
-		Fields: convertFields(result.Fields, oa.PreProcess, oa.Aggregates),
-		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
-	}
+	// The groups are held in memory until they are all returned, so the input
+	// is streamed to fail as soon as they exceed the limits, without holding
+	// the whole input in memory too. The groups of StreamExecute are sent as
+	// soon as they are complete.
+	memory := newAggregationMemory(vcursor)
+	defer memory.done()
+	out := &sqltypes.Result{}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
 	var curDistincts []sqltypes.Value
-	for _, row := range result.Rows {
-		if current == nil {
-			current, curDistincts = convertRow(row, oa.PreProcess, oa.Aggregates)
-			continue
-		}
-		equal, err := oa.keysEqual(current, row, oa.Collations)
-		if err != nil {
-			return nil, err
+	var fields []*querypb.Field
+	// The fields are needed to merge the rows, even when the caller does not want them.
+	err := vcursor.StreamExecutePrimitive(oa.Input, bindVars, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+			out.Fields = convertFields(qr.Fields, oa.PreProcess, oa.Aggregates)
 		}
-
-		if equal {
-			current, curDistincts, err = merge(result.Fields, current, row, curDistincts, oa.Collations, oa.Aggregates)
+		for _, row := range qr.Rows {
+			if current == nil {
+				current, curDistincts = convertRow(row, oa.PreProcess, oa.Aggregates)
+				continue
+			}
+			equal, err := oa.keysEqual(current, row, oa.Collations)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			continue
+
+			if equal {
+				current, curDistincts, err = merge(fields, current, row, curDistincts, oa.Collations, oa.Aggregates)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if err := memory.add(current); err != nil {
+				return err
+			}
+			out.Rows = append(out.Rows, current)
+			current, curDistincts = convertRow(row, oa.PreProcess, oa.Aggregates)
 		}
-		out.Rows = append(out.Rows, current)
-		current, curDistincts = convertRow(row, oa.PreProcess, oa.Aggregates)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if current != nil {
@@ -254,6 +268,9 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		if err != nil {
 			return nil, err
 		}
+		if err := memory.add(final); err != nil {
+			return nil, err
+		}
 		out.Rows = append(out.Rows, final)
 	}
 	return out, nil
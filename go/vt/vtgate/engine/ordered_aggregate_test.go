@@ -70,6 +70,67 @@ func TestOrderedAggregateExecute(t *testing.T) {
 	assert.Equal(wantResult, result)
 }
 
+func TestOrderedAggregateExecuteLimits(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|decimal",
+	)
+	newAggregate := func() *OrderedAggregate {
+		return &OrderedAggregate{
+			Aggregates: []*AggregateParams{{
+				Opcode: AggregateCount,
+				Col:    1,
+			}},
+			GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+			Input: &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					fields,
+					"a|1",
+					"a|1",
+					"b|2",
+					"c|3",
+					"c|4",
+				)},
+			},
+		}
+	}
+
+	result, err := newAggregate().TryExecute(&loggingVCursor{maxAggregationGroups: 3}, nil, false)
+	require.NoError(t, err)
+	assert.Len(t, result.Rows, 3)
+
+	exceeded := aggregationLimitExceeded.Counts()["Groups"]
+	_, err = newAggregate().TryExecute(&loggingVCursor{maxAggregationGroups: 2}, nil, false)
+	require.EqualError(t, err, "the aggregation held more than 2 groups in memory: filter the rows or group them by fewer values, or stream the query")
+	assert.Equal(t, exceeded+1, aggregationLimitExceeded.Counts()["Groups"])
+
+	_, err = newAggregate().TryExecute(&loggingVCursor{maxAggregationBytes: 100}, nil, false)
+	require.EqualError(t, err, "the aggregation held 2 groups of more than 100 bytes in memory: filter the rows or group them by fewer values, or stream the query")
+
+	// The limits fail the query before the whole input is read.
+	aggregate := newAggregate()
+	aggregate.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "a|1", "b|2"),
+			sqltypes.MakeTestResult(fields, "c|3", "d|4"),
+			nil,
+		},
+		sendErr:             errors.New("input not consumed"),
+		allResultsInOneCall: true,
+	}
+	_, err = aggregate.TryExecute(&loggingVCursor{maxAggregationGroups: 2}, nil, false)
+	require.EqualError(t, err, "the aggregation held more than 2 groups in memory: filter the rows or group them by fewer values, or stream the query")
+
+	// The groups of a streaming query are not held in memory.
+	var rows int
+	err = newAggregate().TryStreamExecute(&loggingVCursor{maxAggregationGroups: 1}, nil, true, func(qr *sqltypes.Result) error {
+		rows += len(qr.Rows)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, rows)
+}
+
 func TestOrderedAggregateExecuteTruncate(t *testing.T) {
 	assert := assert.New(t)
 	fp := &fakePrimitive{
@@ -19,6 +19,7 @@ package engine
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -61,6 +62,21 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// MaxInClauseValues returns the maximum number of values of the
+		// IN clause that a route sends to a shard in one query, or 0 if
+		// the values are not batched.
+		MaxInClauseValues() int
+
+		// SchemaTrackingEnabled returns true if the schema tracker evicts the
+		// cached plans of the tables whose schema changed, so that the plans
+		// can cache parts of the schema.
+		SchemaTrackingEnabled() bool
+
+		// AggregationLimits returns the maximum number of groups, and their
+		// size in bytes, that an aggregation may hold in memory. 0 means no
+		// limit.
+		AggregationLimits() (maxGroups int, maxBytes int64)
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -70,6 +86,9 @@ type (
 		// V3 functions.
 		Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 		AutocommitApproval() bool
+		// DisableAutocommit prevents the queries of the current execution
+		// from autocommitting, so that they all run in its transaction.
+		DisableAutocommit()
 
 		// Primitive functions
 		ExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error)
@@ -78,6 +97,9 @@ type (
 		// Shard-level functions.
 		ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error)
 		ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error)
+		// NextSequenceValues reserves count consecutive values of the sequence,
+		// whose table is on the given shard, and returns the first one.
+		NextSequenceValues(gen *Generate, rs *srvtopo.ResolvedShard, count int64) (int64, error)
 		StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error
 
 		// Keyspace ID level functions.
@@ -97,6 +119,9 @@ type (
 
 		ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
 
+		// LockSessionShards returns the shards of the lock sessions of the session.
+		LockSessionShards() []*srvtopo.ResolvedShard
+
 		InTransactionAndIsDML() bool
 
 		LookupRowLockShardSession() vtgatepb.CommitOrder
@@ -137,6 +162,8 @@ type (
 		SetAutocommit(bool) error
 		SetClientFoundRows(bool) error
 		SetSkipQueryPlanCache(bool) error
+		// SetSkipQueryConsolidation keeps the reads of the session from sharing the execution of identical queries
+		SetSkipQueryConsolidation(bool) error
 		SetSQLSelectLimit(int64) error
 		SetTransactionMode(vtgatepb.TransactionMode)
 		SetWorkload(querypb.ExecuteOptions_Workload)
@@ -159,9 +186,23 @@ type (
 		SetReadAfterWriteGTID(string)
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
+		// SetReadYourWrites makes the replica reads wait for the writes of the session
+		SetReadYourWrites(bool) error
+		// SetReadAtTimestamp makes the replica reads wait until they have replicated the writes up to a time
+		SetReadAtTimestamp(string) error
+		// SetIncludeReplicationLag makes the replica reads return the replication lag of their tablet
+		SetIncludeReplicationLag(bool) error
+		// SetAllowCrossCellWrites lets the session write to the primaries of the other cells
+		SetAllowCrossCellWrites(bool) error
+		// SetQueryTags sets the key=value tags that attribute the queries of the session to their owners
+		SetQueryTags(string) error
 
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
+		// AddTempTable records a temporary table that the session created in the keyspace
+		AddTempTable(keyspace, table string)
+		// RemoveTempTable forgets a temporary table that the session dropped from the keyspace
+		RemoveTempTable(keyspace, table string)
 		GetWarnings() []*querypb.QueryWarning
 	}
 
@@ -173,16 +214,29 @@ type (
 	Plan struct {
 		Type         sqlparser.StatementType // The type of query we have
 		Original     string                  // Original is the original query.
+		Target       string                  // Target is the target string of the session the plan was built for.
 		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		// TupleBucket is the size that the tuples of values of the query were padded to, see sqlparser.BucketValTuples
+		TupleBucket int
+
+		// Consolidatable is true if the query is read-only and its result doesn't depend on when it runs,
+		// so that the identical queries that run at the same time can share a single execution
+		Consolidatable bool
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
 		RowsReturned uint64 // Total number of rows
 		RowsAffected uint64 // Total number of rows
 		Errors       uint64 // Total number of errors
+
+		// InClauseValues is the distribution of the number of values of the IN clauses, by InClauseBuckets
+		InClauseValues [len(InClauseBuckets) + 1]uint64
+
+		MaxInClauseValues uint64 // Largest number of values of an IN clause
 	}
 
 	// Match is used to check if a Primitive matches
@@ -247,6 +301,49 @@ func (p *Plan) Stats() (execCount uint64, execTime time.Duration, shardQueries,
 	return
 }
 
+// InClauseBuckets are the upper bounds of the buckets of Plan.InClauseValues.
+// The last bucket counts the IN clauses with more values than all of them.
+var InClauseBuckets = [...]uint64{10, 100, 1000, 10000}
+
+// AddInClauseValues records an IN clause with n values in the plan statistics
+func (p *Plan) AddInClauseValues(n int) {
+	bucket := len(InClauseBuckets)
+	for i, limit := range InClauseBuckets {
+		if uint64(n) <= limit {
+			bucket = i
+			break
+		}
+	}
+	atomic.AddUint64(&p.InClauseValues[bucket], 1)
+	for {
+		max := atomic.LoadUint64(&p.MaxInClauseValues)
+		if uint64(n) <= max || atomic.CompareAndSwapUint64(&p.MaxInClauseValues, max, uint64(n)) {
+			return
+		}
+	}
+}
+
+// inClauseValues returns the distribution of the number of values of the IN
+// clauses, keyed by the upper bound of the buckets, or nil if there was none.
+func (p *Plan) inClauseValues() map[string]uint64 {
+	var values map[string]uint64
+	for i := range p.InClauseValues {
+		count := atomic.LoadUint64(&p.InClauseValues[i])
+		if count == 0 {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]uint64)
+		}
+		if i < len(InClauseBuckets) {
+			values[strconv.FormatUint(InClauseBuckets[i], 10)] = count
+		} else {
+			values["inf"] = count
+		}
+	}
+	return values
+}
+
 // Find will return the first Primitive that matches the evaluate function. If no match is found, nil will be returned
 func Find(isMatch Match, start Primitive) Primitive {
 	if isMatch(start) {
@@ -284,6 +381,10 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsAffected uint64                `json:",omitempty"`
 		RowsReturned uint64                `json:",omitempty"`
 		Errors       uint64                `json:",omitempty"`
+
+		InClauseValues    map[string]uint64 `json:",omitempty"`
+		MaxInClauseValues uint64            `json:",omitempty"`
+		TupleBucket       int               `json:",omitempty"`
 	}{
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
@@ -294,6 +395,10 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsAffected: atomic.LoadUint64(&p.RowsAffected),
 		RowsReturned: atomic.LoadUint64(&p.RowsReturned),
 		Errors:       atomic.LoadUint64(&p.Errors),
+
+		InClauseValues:    p.inClauseValues(),
+		MaxInClauseValues: atomic.LoadUint64(&p.MaxInClauseValues),
+		TupleBucket:       p.TupleBucket,
 	}
 	return json.Marshal(marshalPlan)
 }
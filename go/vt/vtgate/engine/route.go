@@ -144,6 +144,42 @@ var (
 	partialSuccessScatterQueries = stats.NewCounter("PartialSuccessScatterQueries", "Count of partially successful scatter queries")
 )
 
+// ShardDeadlineExceededError is the error of a shard of a multi-shard query
+// that exceeded its deadline budget outside of a transaction. The routes
+// return the results of the shards that finished, with a warning for each
+// shard that didn't. In a transaction, the shards fail with a plain
+// DEADLINE_EXCEEDED error instead, so that the statement fails.
+type ShardDeadlineExceededError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ShardDeadlineExceededError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the error of the shard, which carries its error code.
+func (e *ShardDeadlineExceededError) Cause() error {
+	return e.Err
+}
+
+// partialResult returns true if the route returns the results of the shards
+// that succeeded, and reports the errors of the others as warnings.
+func (route *Route) partialResult(errs []error, numShards int) bool {
+	if len(errs) == numShards {
+		return false
+	}
+	if route.ScatterErrorsAsWarnings {
+		return true
+	}
+	for _, err := range errs {
+		if _, ok := err.(*ShardDeadlineExceededError); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // RouteType returns a description of the query routing type used by the primitive
 func (route *Route) RouteType() string {
 	return route.Opcode.String()
@@ -191,12 +227,37 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 		return &sqltypes.Result{}, nil
 	}
 
+	return route.executeBatches(vcursor, route.inClauseBatches(vcursor, bindVars, rss, bvs))
+}
+
+func (route *Route) executeBatches(vcursor VCursor, batches []inClauseBatch) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for _, batch := range batches {
+		qr, err := route.executeShards(vcursor, batch.rss, batch.bvs)
+		if err != nil {
+			return nil, err
+		}
+		if len(batches) == 1 {
+			result = qr
+			break
+		}
+		result.AppendResult(qr)
+	}
+
+	if len(route.OrderBy) == 0 {
+		return result, nil
+	}
+
+	return route.sort(result)
+}
+
+func (route *Route) executeShards(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	queries := getQueries(route.Query, bvs)
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* autocommit */)
 
 	if errs != nil {
 		errs = filterOutNilErrors(errs)
-		if !route.ScatterErrorsAsWarnings || len(errs) == len(rss) {
+		if !route.partialResult(errs, len(rss)) {
 			return nil, vterrors.Aggregate(errs)
 		}
 
@@ -207,12 +268,7 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 			vcursor.Session().RecordWarning(&querypb.QueryWarning{Code: uint32(serr.Num), Message: err.Error()})
 		}
 	}
-
-	if len(route.OrderBy) == 0 {
-		return result, nil
-	}
-
-	return route.sort(result)
+	return result, nil
 }
 
 func filterOutNilErrors(errs []error) []error {
@@ -248,18 +304,30 @@ func (route *Route) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 		return nil
 	}
 
+	batches := route.inClauseBatches(vcursor, bindVars, rss, bvs)
+	if len(batches) > 1 && len(route.OrderBy) > 0 {
+		// The shards can't be merge-sorted, since a shard may be streaming
+		// more than one batch. Sort the results in memory instead.
+		qr, err := route.executeBatches(vcursor, batches)
+		if err != nil {
+			return err
+		}
+		return callback(qr.Truncate(route.TruncateColumnCount))
+	}
+
 	if len(route.OrderBy) == 0 {
-		errs := vcursor.StreamExecuteMulti(route.Query, rss, bvs, false /* rollbackOnError */, false /* autocommit */, func(qr *sqltypes.Result) error {
-			return callback(qr.Truncate(route.TruncateColumnCount))
-		})
-		if len(errs) > 0 {
-			if !route.ScatterErrorsAsWarnings || len(errs) == len(rss) {
-				return vterrors.Aggregate(errs)
+		for i, batch := range batches {
+			batchCallback := callback
+			if i > 0 {
+				// The fields were sent with the first batch.
+				batchCallback = func(qr *sqltypes.Result) error {
+					rows := *qr
+					rows.Fields = nil
+					return callback(&rows)
+				}
 			}
-			partialSuccessScatterQueries.Add(1)
-			for _, err := range errs {
-				sErr := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
-				vcursor.Session().RecordWarning(&querypb.QueryWarning{Code: uint32(sErr.Num), Message: err.Error()})
+			if err := route.streamExecuteShards(vcursor, batch.rss, batch.bvs, batchCallback); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -269,6 +337,23 @@ func (route *Route) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 	return route.mergeSort(vcursor, bindVars, wantfields, callback, rss, bvs)
 }
 
+func (route *Route) streamExecuteShards(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
+	errs := vcursor.StreamExecuteMulti(route.Query, rss, bvs, false /* rollbackOnError */, false /* autocommit */, func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(route.TruncateColumnCount))
+	})
+	if len(errs) > 0 {
+		if !route.partialResult(errs, len(rss)) {
+			return vterrors.Aggregate(errs)
+		}
+		partialSuccessScatterQueries.Add(1)
+		for _, err := range errs {
+			sErr := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+			vcursor.Session().RecordWarning(&querypb.QueryWarning{Code: uint32(sErr.Num), Message: err.Error()})
+		}
+	}
+	return nil
+}
+
 func (route *Route) mergeSort(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
 	prims := make([]StreamExecutor, 0, len(rss))
 	for i, rs := range rss {
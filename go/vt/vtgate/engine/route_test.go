@@ -474,6 +474,77 @@ func TestINUnique(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestINBatched(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	sel := NewRoute(
+		IN,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []evalengine.Expr{evalengine.NewBindVar("vals", collations.TypedCollation{})}
+	bv := map[string]*querypb.BindVariable{"vals": sqltypes.TestBindVariable([]any{1, 2, 3, 4})}
+
+	// The values of shard -20 are sent in two batches, one after the other,
+	// and the list of all the values is not sent to the shards.
+	vc := &loggingVCursor{
+		shards:            []string{"-20", "20-"},
+		shardForKsid:      []string{"-20", "-20", "-20", "20-"},
+		results:           []*sqltypes.Result{sqltypes.MakeTestResult(defaultSelectResult.Fields, "1"), sqltypes.MakeTestResult(defaultSelectResult.Fields, "2")},
+		maxInClauseValues: 2,
+	}
+	result, err := sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" type:INT64 value:"2" type:INT64 value:"3" type:INT64 value:"4"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ExecuteMultiShard ` +
+			`ks.-20: dummy_select {__vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"}} ` +
+			`ks.20-: dummy_select {__vals: type:TUPLE values:{type:INT64 value:"4"}} ` +
+			`false false`,
+		`ExecuteMultiShard ` +
+			`ks.-20: dummy_select {__vals: type:TUPLE values:{type:INT64 value:"3"}} ` +
+			`false false`,
+	})
+	expectResult(t, "sel.Execute", result, sqltypes.MakeTestResult(defaultSelectResult.Fields, "1", "2"))
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" type:INT64 value:"2" type:INT64 value:"3" type:INT64 value:"4"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`StreamExecuteMulti dummy_select ks.-20: {__vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"}} ks.20-: {__vals: type:TUPLE values:{type:INT64 value:"4"}} `,
+		`StreamExecuteMulti dummy_select ks.-20: {__vals: type:TUPLE values:{type:INT64 value:"3"}} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, sqltypes.MakeTestResult(defaultSelectResult.Fields, "1", "2"))
+
+	// Without a limit, every shard gets all its values at once.
+	vc = &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "-20", "-20", "20-"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	_, err = sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" type:INT64 value:"2" type:INT64 value:"3" type:INT64 value:"4"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ExecuteMultiShard ` +
+			`ks.-20: dummy_select {__vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} values:{type:INT64 value:"3"} vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} values:{type:INT64 value:"3"} values:{type:INT64 value:"4"}} ` +
+			`ks.20-: dummy_select {__vals: type:TUPLE values:{type:INT64 value:"4"} vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} values:{type:INT64 value:"3"} values:{type:INT64 value:"4"}} ` +
+			`false false`,
+	})
+}
+
+func TestUsesBindVar(t *testing.T) {
+	assert.True(t, usesBindVar("select * from t where id in ::vals", "vals"))
+	assert.True(t, usesBindVar("select * from t where id = :vals and a = 1", "vals"))
+	assert.False(t, usesBindVar("select * from t where id in ::vals1", "vals"))
+	assert.False(t, usesBindVar("select * from t where id in ::__vals", "vals"))
+}
+
 func TestINNonUnique(t *testing.T) {
 	vindex, _ := vindexes.NewLookup("", map[string]string{
 		"table": "lkp",
@@ -664,6 +735,49 @@ func TestSelectLike(t *testing.T) {
 
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewTimeBucket("tb", map[string]string{"bucket": "month"})
+	vc := &loggingVCursor{
+		shards:       []string{"-62", "62-"},
+		shardForKsid: []string{"-62", "62-"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+
+	sel := NewRoute(
+		Range,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []evalengine.Expr{
+		evalengine.NewLiteralString([]byte("2022-03-16"), collations.TypedCollation{}),
+		evalengine.NewBindVar("high", collations.TypedCollation{}),
+	}
+	bv := map[string]*querypb.BindVariable{"high": sqltypes.StringBindVariable("2022-04-01")}
+	result, err := sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(00000000621d6200-0000000062464081)`,
+		`ExecuteMultiShard ks.-62: dummy_select {high: type:VARCHAR value:"2022-04-01"} ks.62-: dummy_select {high: type:VARCHAR value:"2022-04-01"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+
+	// The ranges that end before they start do not route anywhere.
+	bv = map[string]*querypb.BindVariable{"high": sqltypes.StringBindVariable("2022-02-01")}
+	result, err = sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+	expectResult(t, "sel.Execute", result, &sqltypes.Result{})
+}
+
 func TestSelectNext(t *testing.T) {
 	sel := NewRoute(
 		Next,
@@ -1370,6 +1484,42 @@ func TestExecFail(t *testing.T) {
 		require.NoError(t, err, "unexpected ScatterErrorsAsWarnings error %v", err)
 		vc.ExpectWarnings(t, []*querypb.QueryWarning{{Code: mysql.ERQueryInterrupted, Message: "query timeout -20 (errno 1317) (sqlstate HY000)"}})
 	})
+
+	t.Run("shard deadline budget exceeded", func(t *testing.T) {
+		// Scatter succeeds if the shards that fail only exceeded their deadline budget
+		sel := NewRoute(
+			Scatter,
+			&vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			"dummy_select",
+			"dummy_select_field",
+		)
+
+		deadlineErr := &ShardDeadlineExceededError{Err: vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "shard ks.-20 exceeded its deadline budget of 1s")}
+		vc := &loggingVCursor{
+			shards:         []string{"-20", "20-"},
+			results:        []*sqltypes.Result{defaultSelectResult},
+			multiShardErrs: []error{deadlineErr, nil},
+		}
+		result, err := sel.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+		require.NoError(t, err)
+		expectResult(t, "sel.Execute", result, defaultSelectResult)
+		vc.ExpectWarnings(t, []*querypb.QueryWarning{{Code: mysql.ERQueryInterrupted, Message: "shard ks.-20 exceeded its deadline budget of 1s"}})
+
+		// But not if another shard failed.
+		vc.Rewind()
+		vc.multiShardErrs = []error{deadlineErr, errors.New("result error 20-")}
+		_, err = sel.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+		require.Error(t, err)
+
+		// Nor if all the shards exceeded their budget.
+		vc.Rewind()
+		vc.multiShardErrs = []error{deadlineErr, deadlineErr}
+		_, err = sel.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+		assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	})
 }
 
 func TestSelectEqualUniqueMultiColumnVindex(t *testing.T) {
@@ -53,6 +53,9 @@ const (
 	// MultiEqual is used for routing queries with IN with tuple clause
 	// Requires: A Vindex, and a multi Tuple Values.
 	MultiEqual
+	// Range is for routing a query to the shards of a range of values.
+	// Requires: A Sequential Vindex, and the low and high Values of the range.
+	Range
 	// Scatter is for routing a scattered statement.
 	Scatter
 	// Next is for fetching from a sequence.
@@ -78,6 +81,7 @@ var opName = map[Opcode]string{
 	Equal:         "Equal",
 	IN:            "IN",
 	MultiEqual:    "MultiEqual",
+	Range:         "Range",
 	Scatter:       "Scatter",
 	DBA:           "DBA",
 	Next:          "Next",
@@ -154,6 +158,8 @@ func (rp *RoutingParameters) findRoute(vcursor VCursor, bindVars map[string]*que
 		default:
 			return rp.multiEqual(vcursor, bindVars)
 		}
+	case Range:
+		return rp.rangeRoute(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported opcode: %v", rp.Opcode)
@@ -361,6 +367,23 @@ func (rp *RoutingParameters) equalMultiCol(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
+func (rp *RoutingParameters) rangeRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	low, err := env.Evaluate(rp.Values[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	high, err := env.Evaluate(rp.Values[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	destination, err := rp.Vindex.(vindexes.Sequential).RangeMap(vcursor, low.Value(), high.Value())
+	if err != nil {
+		return nil, nil, err
+	}
+	return rp.byDestination(vcursor, bindVars, destination)
+}
+
 func (rp *RoutingParameters) in(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
 	value, err := env.Evaluate(rp.Values[0])
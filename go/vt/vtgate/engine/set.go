@@ -435,6 +435,12 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env *evalengine.ExpressionE
 		err = svss.setBoolSysVar(env, vcursor.Session().SetClientFoundRows)
 	case sysvars.SkipQueryPlanCache.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSkipQueryPlanCache)
+	case sysvars.SkipQueryConsolidation.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetSkipQueryConsolidation)
+	case sysvars.IncludeReplicationLag.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetIncludeReplicationLag)
+	case sysvars.AllowCrossCellWrites.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetAllowCrossCellWrites)
 	case sysvars.TxReadOnly.Name,
 		sysvars.TransactionReadOnly.Name:
 		// TODO (4127): This is a dangerous NOP.
@@ -514,6 +520,20 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env *evalengine.ExpressionE
 		default:
 			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "variable 'session_track_gtids' can't be set to the value of '%s'", str)
 		}
+	case sysvars.ReadYourWrites.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetReadYourWrites)
+	case sysvars.ReadAtTimestamp.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		return vcursor.Session().SetReadAtTimestamp(str)
+	case sysvars.QueryTags.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		return vcursor.Session().SetQueryTags(str)
 	default:
 		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.UnknownSystemVariable, "unknown system variable '%s'", svss.Name)
 	}
@@ -27,6 +27,8 @@ import (
 var _ Primitive = (*SQLCalcFoundRows)(nil)
 
 // SQLCalcFoundRows is a primitive to execute limit and count query as per their individual plan.
+// The count query runs the query without its limit, so that the primitive costs
+// the query twice on every shard it routes to.
 type SQLCalcFoundRows struct {
 	LimitPrimitive Primitive
 	CountPrimitive Primitive
@@ -73,6 +73,12 @@ var (
 
 	queriesProcessedByTable = stats.NewCountersWithMultiLabels("QueriesProcessedByTable", "Queries processed at vtgate by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
 	queriesRoutedByTable    = stats.NewCountersWithMultiLabels("QueriesRoutedByTable", "Queries routed from vtgate to vttablet by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
+
+	planCacheHitsByBucket      = stats.NewCountersWithSingleLabel("QueryPlanCacheHitsByBucket", "Query plan cache hits by size of the padded tuples of values of the query", "Bucket")
+	planCacheMissesByBucket    = stats.NewCountersWithSingleLabel("QueryPlanCacheMissesByBucket", "Query plan cache misses by size of the padded tuples of values of the query", "Bucket")
+	planCacheEvictionsByBucket = stats.NewCountersWithSingleLabel("QueryPlanCacheEvictionsByBucket", "Query plan cache evictions by size of the padded tuples of values of the query", "Bucket")
+	planCacheInvalidations     = stats.NewCountersWithSingleLabel("QueryPlanCacheInvalidations", "Query plans evicted from the cache by the changes of the vschema and of the tracked schema, by cause", "Cause")
+	planCacheClears            = stats.NewCountersWithSingleLabel("QueryPlanCacheClears", "Clears of the whole query plan cache by the changes of the vschema and of the tracked schema, by cause", "Cause")
 )
 
 const (
@@ -98,14 +104,59 @@ type Executor struct {
 	plans        cache.Cache
 	vschemaStats *VSchemaStats
 
+	// planIndex maps the tables to their cached plans, which a change of
+	// the vschema or of the schema of the tables evicts
+	planIndex *planIndex
+
 	normalize       bool
 	warnShardedOnly bool
 
+	// bucketValTuples pads the tuples of values of the normalized queries, so that
+	// the queries that only differ by their number of values share a plan
+	bucketValTuples bool
+
+	// asyncLookups applies the writes that the lookup vindexes enqueue
+	// into their async queue.
+	asyncLookups *asyncLookupApplier
+
 	vm            *VSchemaManager
 	schemaTracker SchemaInfo
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// mirror duplicates a part of the queries of some tables to a shadow keyspace
+	mirror *mirrorer
+
+	// scatterLint warns about the scatter queries and reports the ones that run the most
+	scatterLint *scatterLinter
+
+	// resultCache caches the results of the SELECTs that run outside of a transaction
+	resultCache *resultCache
+
+	// consolidator shares the execution of the identical read-only queries that run at the same time
+	consolidator *queryConsolidator
+
+	// inClauseLimit rejects or batches the IN clauses with too many values
+	inClauseLimit *inClauseLimit
+
+	// throttleChecks caches the answers of the throttlers of the tablets to the THROTTLE directives
+	throttleChecks *throttleChecks
+
+	// sessionMemory accounts for the memory that the state of the sessions holds, and limits it
+	sessionMemory *sessionMemoryLimit
+
+	// sequences caches blocks of values of the sequences
+	sequences *sequenceCache
+
+	// crossCellPolicy decides what to do with the writes to the primaries of the other cells
+	crossCellPolicy *crossCellWritePolicy
+
+	// incidents rejects the queries with a large fan-out while vtgate is in incident mode
+	incidents *incidentMode
+
+	// queryTags exports the query stats by the tags of the sessions, and adds the tags to the shard queries
+	queryTags *queryTagging
 }
 
 var executorOnce sync.Once
@@ -113,9 +164,20 @@ var executorOnce sync.Once
 const pathQueryPlans = "/debug/query_plans"
 const pathScatterStats = "/debug/scatter_stats"
 const pathVSchema = "/debug/vschema"
+const pathScatterOffenders = "/debug/scatter_offenders"
+const pathVSchemaLint = "/debug/vschema_lint"
+const pathMirrorMismatches = "/debug/mirror_mismatches"
+const pathSessionMemory = "/debug/session_memory"
 
 // NewExecutor creates a new Executor.
 func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize, warnOnShardedOnly bool, streamSize int, cacheCfg *cache.Config, schemaTracker SchemaInfo, noScatter bool) *Executor {
+	if cacheCfg != nil {
+		cfg := *cacheCfg
+		cfg.OnEvict = func(value any) {
+			planCacheEvictionsByBucket.Add(tupleBucketLabel(value.(*engine.Plan).TupleBucket), 1)
+		}
+		cacheCfg = &cfg
+	}
 	e := &Executor{
 		serv:            serv,
 		cell:            cell,
@@ -123,17 +185,21 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		scatterConn:     resolver.scatterConn,
 		txConn:          resolver.scatterConn.txConn,
 		plans:           cache.NewDefaultCacheImpl(cacheCfg),
+		planIndex:       newPlanIndex(),
 		normalize:       normalize,
 		warnShardedOnly: warnOnShardedOnly,
 		streamSize:      streamSize,
 		schemaTracker:   schemaTracker,
 		allowScatter:    !noScatter,
+		throttleChecks:  newThrottleChecks(),
+		crossCellPolicy: &crossCellWritePolicy{action: crossCellWriteAllow},
 	}
+	e.sequences = newSequenceCache(e, 0, 0, nil)
 
 	vschemaacl.Init()
 	// we subscribe to update from the VSchemaManager
 	e.vm = &VSchemaManager{
-		subscriber: e.SaveVSchema,
+		subscriber: e.saveVSchema,
 		serv:       serv,
 		cell:       cell,
 		schema:     e.schemaTracker,
@@ -162,6 +228,12 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathScatterOffenders, e)
+		http.Handle(pathVSchemaLint, e)
+		http.Handle(pathMirrorMismatches, e)
+		http.Handle(pathSessionMemory, e)
+		http.Handle(pathQueryPlansExport, e)
+		http.Handle(pathQueryPlansImport, e)
 	})
 	return e
 }
@@ -171,25 +243,53 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	span, ctx := trace.NewSpan(ctx, "executor.Execute")
 	span.Annotate("method", method)
 	trace.AnnotateSQL(span, sqlparser.Preview(sql))
+	if tags := safeSession.GetQueryTags(); tags != "" {
+		span.Annotate("query_tags", tags)
+	}
 	defer span.Finish()
 
+	// The result is handed to the client when Execute returns.
+	if safeSession.holdResultMemory() {
+		defer e.scatterConn.memory.release(safeSession)
+	}
+
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	var mirror *mirrorQuery
+	if method != mirrorMethod {
+		mirror = e.mirror.prepare(safeSession, sql)
+	}
 	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
 	logStats.Error = err
+	e.sessionMemory.record(safeSession)
+	e.resultCache.invalidateWrite(safeSession, e.VSchema(), sql)
+	if mirror != nil {
+		source := mirrorSource{latency: time.Since(logStats.StartTime), err: err}
+		if result != nil {
+			source.rows = len(result.Rows)
+			if mirror.checksumsResults() {
+				source.checksum = &resultChecksum{}
+				source.checksum.add(result.Rows, true)
+			}
+		}
+		mirror.run(ctx, safeSession, bindVars, source, false)
+	}
 	if result == nil {
 		saveSessionStats(safeSession, stmtType, 0, 0, 0, err)
 	} else {
 		saveSessionStats(safeSession, stmtType, result.RowsAffected, result.InsertID, len(result.Rows), err)
 	}
-	if result != nil && len(result.Rows) > *warnMemoryRows {
+	if result != nil && len(result.Rows) > warnMemoryRows.Get() {
 		warnings.Add("ResultsExceeded", 1)
 		piiSafeSQL, err := sqlparser.RedactSQLQuery(sql)
 		if err != nil {
 			piiSafeSQL = logStats.StmtType
 		}
-		log.Warningf("%q exceeds warning threshold of max memory rows: %v", piiSafeSQL, *warnMemoryRows)
+		log.Warningf("%q exceeds warning threshold of max memory rows: %v", piiSafeSQL, warnMemoryRows.Get())
 	}
 
+	logStats.setTransaction(safeSession)
+	logStats.QueryTags = safeSession.GetQueryTags()
+	e.queryTags.record(logStats)
 	logStats.Send()
 	return result, err
 }
@@ -208,9 +308,7 @@ func (s *streaminResultReceiver) storeResultStats(typ sqlparser.StatementType, q
 	defer s.mu.Unlock()
 	s.rowsAffected += qr.RowsAffected
 	s.rowsReturned += len(qr.Rows)
-	if qr.InsertID != 0 {
-		s.insertID = qr.InsertID
-	}
+	s.insertID = sqltypes.FirstInsertID(s.insertID, qr.InsertID)
 	s.stmtType = typ
 	return s.callback(qr)
 }
@@ -227,9 +325,36 @@ func (e *Executor) StreamExecute(
 	span, ctx := trace.NewSpan(ctx, "executor.StreamExecute")
 	span.Annotate("method", method)
 	trace.AnnotateSQL(span, sqlparser.Preview(sql))
+	if tags := safeSession.GetQueryTags(); tags != "" {
+		span.Annotate("query_tags", tags)
+	}
 	defer span.Finish()
 
+	// The results that the statement holds are given back every time a
+	// result is handed to the client.
+	if safeSession.holdResultMemory() {
+		defer e.scatterConn.memory.release(safeSession)
+		send := callback
+		callback = func(result *sqltypes.Result) error {
+			defer e.scatterConn.memory.release(safeSession)
+			return send(result)
+		}
+	}
+
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	var mirror *mirrorQuery
+	if method != mirrorMethod {
+		mirror = e.mirror.prepare(safeSession, sql)
+	}
+	var checksum *resultChecksum
+	if mirror.checksumsResults() {
+		checksum = &resultChecksum{}
+		send := callback
+		callback = func(result *sqltypes.Result) error {
+			checksum.add(result.Rows, true)
+			return send(result)
+		}
+	}
 	srr := &streaminResultReceiver{callback: callback}
 	var err error
 
@@ -312,16 +437,22 @@ func (e *Executor) StreamExecute(
 	err = e.newExecute(ctx, safeSession, sql, bindVars, logStats, resultHandler, srr.storeResultStats)
 
 	logStats.Error = err
+	e.sessionMemory.record(safeSession)
+	e.resultCache.invalidateWrite(safeSession, e.VSchema(), sql)
+	mirror.run(ctx, safeSession, bindVars, mirrorSource{latency: time.Since(logStats.StartTime), rows: srr.rowsReturned, checksum: checksum, err: err}, true)
 	saveSessionStats(safeSession, srr.stmtType, srr.rowsAffected, srr.insertID, srr.rowsReturned, err)
-	if srr.rowsReturned > *warnMemoryRows {
+	if srr.rowsReturned > warnMemoryRows.Get() {
 		warnings.Add("ResultsExceeded", 1)
 		piiSafeSQL, err := sqlparser.RedactSQLQuery(sql)
 		if err != nil {
 			piiSafeSQL = logStats.StmtType
 		}
-		log.Warningf("%q exceeds warning threshold of max memory rows: %v", piiSafeSQL, *warnMemoryRows)
+		log.Warningf("%q exceeds warning threshold of max memory rows: %v", piiSafeSQL, warnMemoryRows.Get())
 	}
 
+	logStats.setTransaction(safeSession)
+	logStats.QueryTags = safeSession.GetQueryTags()
+	e.queryTags.record(logStats)
 	logStats.Send()
 	return err
 
@@ -338,10 +469,14 @@ func canReturnRows(stmtType sqlparser.StatementType) bool {
 
 func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType, rowsAffected, insertID uint64, rowsReturned int, err error) {
 	safeSession.RowCount = -1
+	// The found rows handled by the primitives are only for this statement,
+	// not for the next ones that run with the same session.
+	foundRowsHandled := safeSession.foundRowsHandled
+	safeSession.foundRowsHandled = false
 	if err != nil {
 		return
 	}
-	if !safeSession.foundRowsHandled {
+	if !foundRowsHandled {
 		safeSession.FoundRows = uint64(rowsReturned)
 	}
 	if insertID > 0 {
@@ -457,6 +592,12 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = options.ClientFoundRows
 			})
 			bindVars[key] = sqltypes.BoolBindVariable(v)
+		case sysvars.SkipQueryConsolidation.Name:
+			var v bool
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.SkipQueryConsolidation
+			})
+			bindVars[key] = sqltypes.BoolBindVariable(v)
 		case sysvars.SQLSelectLimit.Name:
 			var v int64
 			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
@@ -497,6 +638,28 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				}
 			})
 			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.ReadYourWrites.Name:
+			var v bool
+			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
+				v = raw.ReadYourWrites
+			})
+			bindVars[key] = sqltypes.BoolBindVariable(v)
+		case sysvars.ReadAtTimestamp.Name:
+			var v int64
+			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
+				v = raw.ReadAtTimestamp
+			})
+			bindVars[key] = sqltypes.StringBindVariable(formatReadAtTimestamp(v))
+		case sysvars.IncludeReplicationLag.Name:
+			var v bool
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.IncludeReplicationLag
+			})
+			bindVars[key] = sqltypes.BoolBindVariable(v)
+		case sysvars.AllowCrossCellWrites.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.GetAllowCrossCellWrites())
+		case sysvars.QueryTags.Name:
+			bindVars[key] = sqltypes.StringBindVariable(session.GetQueryTags())
 		case sysvars.Version.Name:
 			bindVars[key] = sqltypes.StringBindVariable(servenv.AppVersion.MySQLVersion())
 		case sysvars.VersionComment.Name:
@@ -657,6 +820,7 @@ func (e *Executor) executeSPInAllSessions(ctx context.Context, safeSession *Safe
 // CloseSession releases the current connection, which rollbacks open transactions and closes reserved connections.
 // It is called then the MySQL servers closes the connection to its client.
 func (e *Executor) CloseSession(ctx context.Context, safeSession *SafeSession) error {
+	e.sessionMemory.forget(safeSession)
 	return e.txConn.ReleaseAll(ctx, safeSession)
 }
 
@@ -1280,15 +1444,24 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 	return e.vschema
 }
 
-// SaveVSchema updates the vschema and stats
+// SaveVSchema updates the vschema and stats, and clears the plan cache.
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
+	change := newVSchemaChange(vschemaChangeVSchema)
+	change.all = true
+	e.saveVSchema(vschema, stats, change)
+}
+
+// saveVSchema updates the vschema and stats, and evicts the plans that the
+// change of the vschema affects.
+func (e *Executor) saveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats, change *vschemaChange) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if vschema != nil {
 		e.vschema = vschema
 	}
 	e.vschemaStats = stats
-	e.plans.Clear()
+	e.invalidatePlans(change)
+	e.asyncLookups.refresh(e.vschema)
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
@@ -1296,16 +1469,43 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 
 }
 
+// invalidatePlans evicts the cached plans that a change of the vschema
+// affects: all of them, or the ones of the changed tables.
+func (e *Executor) invalidatePlans(change *vschemaChange) {
+	if change.all {
+		evicted := e.plans.Len()
+		e.plans.Clear()
+		e.planIndex.clear()
+		planCacheClears.Add(change.cause, 1)
+		planCacheInvalidations.Add(change.cause, int64(evicted))
+		return
+	}
+	keys := e.planIndex.evict(change.tables)
+	for _, key := range keys {
+		e.plans.Delete(key)
+	}
+	planCacheInvalidations.Add(change.cause, int64(len(keys)))
+}
+
+// tablesChanged is called by the schema tracker with the tables whose schema
+// changed, or with nil tables when the schema of a keyspace was reloaded. It
+// evicts their cached plans, which cache parts of the schema, like the unique
+// keys of the upserts, and their cached results.
+func (e *Executor) tablesChanged(keyspace string, tables []string) {
+	change := newVSchemaChange(vschemaChangeSchema)
+	change.all = tables == nil
+	for _, table := range tables {
+		change.addTable(table)
+	}
+	e.invalidatePlans(change)
+	if e.resultCache != nil {
+		e.resultCache.invalidateSchema(keyspace, tables)
+	}
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func (e *Executor) ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error) {
-	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(targetString, defaultTabletType)
-	// Set default keyspace
-	if destKeyspace == "" && len(e.VSchema().Keyspaces) == 1 {
-		for k := range e.VSchema().Keyspaces {
-			destKeyspace = k
-		}
-	}
-	return destKeyspace, destTabletType, dest, err
+	return parseDestinationTarget(targetString, e.VSchema())
 }
 
 type iQueryOption interface {
@@ -1325,11 +1525,28 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, errors.New("vschema not initialized")
 	}
 
+	ps := preparedStatementFromContext(vcursor.ctx, sql)
+	if plan := ps.plan(e, vcursor, bindVars, qo); plan != nil {
+		if logStats != nil {
+			logStats.SQL = comments.Leading + ps.query + comments.Trailing
+		}
+		return plan, nil
+	}
+
 	stmt, reserved, err := sqlparser.Parse2(sql)
 	if err != nil {
 		return nil, err
 	}
 	query := sql
+	// The queries of the tenant databases are planned as the queries of their
+	// keyspace, scoped to their tenants.
+	stmt, scoped, err := scopeToTenants(stmt, vcursor.vschema, vcursor.tenant)
+	if err != nil {
+		return nil, err
+	}
+	if scoped {
+		query = sqlparser.String(stmt)
+	}
 	statement := stmt
 	reservedVars := sqlparser.NewReservedVars("vtg", reserved)
 	bindVarNeeds := &sqlparser.BindVarNeeds{}
@@ -1338,11 +1555,14 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 	ignoreMaxMemoryRows := sqlparser.IgnoreMaxMaxMemoryRowsDirective(stmt)
 	vcursor.SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows)
+	vcursor.SetThrottleApp(sqlparser.ThrottleDirective(stmt))
 
 	setVarComment, err := prepareSetVarComment(vcursor, stmt)
 	if err != nil {
 		return nil, err
 	}
+	params := ps.params(bindVars)
+	tupleBucket := 0
 	// Normalize if possible and retry.
 	if e.canNormalizeStatement(stmt, qo, setVarComment) {
 		parameterize := e.normalize // the public flag is called normalize
@@ -1361,6 +1581,9 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		}
 		statement = result.AST
 		bindVarNeeds = result.BindVarNeeds
+		if parameterize && e.bucketValTuples {
+			tupleBucket = sqlparser.BucketValTuples(statement, reservedVars, bindVars)
+		}
 		query = sqlparser.String(statement)
 	}
 
@@ -1376,24 +1599,138 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	planKey := hex.EncodeToString(planHash.Sum(nil))
 
 	if plan, ok := e.plans.Get(planKey); ok {
+		planCacheHitsByBucket.Add(tupleBucketLabel(tupleBucket), 1)
+		ps.remember(vcursor, qo, planKey, query, params, bindVars)
 		return plan.(*engine.Plan), nil
 	}
+	planCacheMissesByBucket.Add(tupleBucketLabel(tupleBucket), 1)
 
-	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
+	plan, err := e.buildPlan(vcursor, query, statement, reservedVars, bindVarNeeds)
 	if err != nil {
 		return nil, err
 	}
 
-	plan.Warnings = vcursor.warnings
+	plan.Target = vcursor.safeSession.TargetString
+	plan.TupleBucket = tupleBucket
+	plan.Consolidatable = consolidatableStatement(statement)
+	plan.Warnings = append(vcursor.warnings, e.scatterLint.lint(plan)...)
 	vcursor.warnings = nil
 
 	if qo.cachePlan() && sqlparser.CachePlan(statement) {
-		e.plans.Set(planKey, plan)
+		if e.plans.Set(planKey, plan) {
+			e.planIndex.add(e.plans, planKey, plan, planTables(statement))
+		}
+		ps.remember(vcursor, qo, planKey, query, params, bindVars)
 	}
 
 	return e.checkThatPlanIsValid(stmt, plan)
 }
 
+// buildPlan builds the plan of the statement. The DML statements are planned
+// with the write tables of the routing rules. The ones that reference a table
+// whose writes are dual-routed are also planned with the second write tables,
+// and the plan executes both. Both plans must write the same values: the
+// second one inserts the sequence values that the first one generates, and
+// the dual-routed DMLs can't use non-deterministic functions.
+func (e *Executor) buildPlan(vcursor *vcursorImpl, query string, statement sqlparser.Statement, reservedVars *sqlparser.ReservedVars, bindVarNeeds *sqlparser.BindVarNeeds) (*engine.Plan, error) {
+	if !sqlparser.IsDMLStatement(statement) {
+		return planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
+	}
+
+	vschema := vcursor.vschema
+	defer func() {
+		vcursor.vschema = vschema
+	}()
+	var secondaryStatement sqlparser.Statement
+	if vcursor.hasDualWrites(statement) {
+		if err := checkDualWriteDeterministic(statement); err != nil {
+			return nil, err
+		}
+		// The planner can rewrite the statement.
+		secondaryStatement = sqlparser.CloneStatement(statement)
+	}
+	vcursor.vschema = vschema.ForWrites(false)
+	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
+	if err != nil || secondaryStatement == nil {
+		return plan, err
+	}
+	vcursor.vschema = vschema.ForWrites(true)
+	secondary, err := planbuilder.BuildFromStmt(query, secondaryStatement, reservedVars, vcursor, &sqlparser.BindVarNeeds{}, *enableOnlineDDL, *enableDirectDDL)
+	if err != nil {
+		return nil, err
+	}
+	if err := shareSequenceValues(statement, plan.Instructions, secondary.Instructions); err != nil {
+		return nil, err
+	}
+	plan.Instructions = &engine.DualWrite{
+		Primary:   plan.Instructions,
+		Secondary: secondary.Instructions,
+	}
+	return plan, nil
+}
+
+// checkDualWriteDeterministic returns an error if the dual-routed DML calls
+// a function whose result would differ between its two writes.
+func checkDualWriteDeterministic(stmt sqlparser.Statement) error {
+	var found sqlparser.Expr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.CurTimeFuncExpr:
+			found = node
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFunctions[node.Name.Lowered()] {
+				found = node
+			}
+		}
+		return found == nil, nil
+	}, stmt)
+	if found != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the dual-routed write %s can't use the non-deterministic function %s", sqlparser.String(stmt), sqlparser.String(found))
+	}
+	return nil
+}
+
+// shareSequenceValues makes the secondary plan of a dual-routed insert
+// insert the sequence values that the primary one generates, in the bind
+// variables that both share.
+func shareSequenceValues(stmt sqlparser.Statement, primary, secondary engine.Primitive) error {
+	primaryInsert, secondaryInsert := sequenceInsert(primary), sequenceInsert(secondary)
+	if primaryInsert == nil && secondaryInsert == nil {
+		return nil
+	}
+	if primaryInsert == nil || secondaryInsert == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the dual-routed write %s generates sequence values for only one of its tables", sqlparser.String(stmt))
+	}
+	if primaryInsert.Opcode == engine.InsertSelect || secondaryInsert.Opcode == engine.InsertSelect {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the dual-routed write %s can't generate sequence values for the rows of a select", sqlparser.String(stmt))
+	}
+	secondaryInsert.Generate = nil
+	return nil
+}
+
+// sequenceInsert returns the insert of the plan that generates values from a
+// sequence, if any.
+func sequenceInsert(primitive engine.Primitive) *engine.Insert {
+	if ins, ok := primitive.(*engine.Insert); ok && ins.Generate != nil {
+		return ins
+	}
+	for _, input := range primitive.Inputs() {
+		if ins := sequenceInsert(input); ins != nil {
+			return ins
+		}
+	}
+	return nil
+}
+
+// tupleBucketLabel returns the label of the plan cache stats of the queries
+// whose tuples of values were padded to bucket.
+func tupleBucketLabel(bucket int) string {
+	if bucket == 0 {
+		return "none"
+	}
+	return strconv.Itoa(bucket)
+}
+
 func (e *Executor) canNormalizeStatement(stmt sqlparser.Statement, qo iQueryOption, setVarComment string) bool {
 	return (e.normalize && sqlparser.CanNormalize(stmt)) ||
 		sqlparser.MustRewriteAST(stmt, qo.getSelectLimit() > 0) || setVarComment != ""
@@ -1467,11 +1804,43 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathScatterOffenders:
+		limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+		returnAsJSON(response, e.scatterLint.topOffenders(limit))
+	case pathVSchemaLint:
+		returnAsJSON(response, e.lintVSchema(request.URL.Query().Get("keyspace"), request.URL.Query().Get("severity")))
+	case pathMirrorMismatches:
+		returnAsJSON(response, e.mirror.recentMismatches())
+	case pathSessionMemory:
+		limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+		returnAsJSON(response, e.sessionMemory.topSessions(limit))
+	case pathQueryPlansExport, pathQueryPlansImport:
+		e.servePlanCache(response, request)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
 }
 
+// lintVSchema returns the findings of vindexes.LintVSchema on the current
+// vschema, of the keyspace if it's set, and at least as severe as severity.
+func (e *Executor) lintVSchema(keyspace, severity string) []*vindexes.LintFinding {
+	findings := []*vindexes.LintFinding{}
+	srvVSchema := e.vm.GetCurrentSrvVschema()
+	if srvVSchema == nil {
+		return findings
+	}
+	for _, finding := range vindexes.LintVSchema(srvVSchema) {
+		if keyspace != "" && finding.Keyspace != keyspace {
+			continue
+		}
+		if !vindexes.LintSeverityAtLeast(finding.Severity, severity) {
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
 func returnAsJSON(response http.ResponseWriter, stuff any) {
 	response.Header().Set("Content-Type", "application/json; charset=utf-8")
 	buf, err := json.MarshalIndent(stuff, "", " ")
@@ -1555,6 +1924,8 @@ func (e *Executor) Prepare(ctx context.Context, method string, safeSession *Safe
 	// The mysql plugin runs an implicit rollback whenever a connection closes.
 	// To avoid spamming the log with no-op rollback records, ignore it if
 	// it was a no-op record (i.e. didn't issue any queries)
+	logStats.setTransaction(safeSession)
+	logStats.QueryTags = safeSession.GetQueryTags()
 	if !(logStats.StmtType == "ROLLBACK" && logStats.ShardQueries == 0) {
 		logStats.Send()
 	}
@@ -1657,12 +2028,23 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 
 // ExecuteMultiShard implements the IExecutor interface
 func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool, ignoreMaxMemoryRows bool) (qr *sqltypes.Result, errs []error) {
-	return e.scatterConn.ExecuteMultiShard(ctx, rss, queries, session, autocommit, ignoreMaxMemoryRows)
+	qr, errs = e.scatterConn.ExecuteMultiShard(ctx, rss, queries, session, autocommit, ignoreMaxMemoryRows)
+	e.incidents.observe(len(rss), errs)
+	return qr, errs
 }
 
 // StreamExecuteMulti implements the IExecutor interface
 func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, autocommit, callback)
+	errs := e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, autocommit, callback)
+	e.incidents.observe(len(rss), errs)
+	return errs
+}
+
+// CheckIncidentMode implements the IExecutor interface. It rejects the
+// queries to more shards than the maximum fan-out of incident mode while
+// vtgate is in incident mode.
+func (e *Executor) CheckIncidentMode(rss []*srvtopo.ResolvedShard) error {
+	return e.incidents.check(rss)
 }
 
 // ExecuteLock implements the IExecutor interface
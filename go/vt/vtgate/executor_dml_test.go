@@ -32,10 +32,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
-	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -2116,3 +2117,87 @@ func TestTestPartialVindexInsertQueryFailure(t *testing.T) {
 	testQueryLogWithSavepoint(t, logChan, "VindexCreate", "SAVEPOINT_ROLLBACK", "rollback to x", 0, true)
 	testQueryLogWithSavepoint(t, logChan, "TestExecute", "INSERT", "insert into t1(id, unq_col) values (1, 1), (2, 3)", 0, true)
 }
+
+func TestDMLRoutedToWriteTables(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	// The table simple was moved from TestUnsharded to TestExecutor: its
+	// reads are routed to TestExecutor, and its writes to both keyspaces.
+	srvVSchema := getSandboxSrvVSchema()
+	srvVSchema.Keyspaces["TestExecutor"].Tables["simple"] = &vschemapb.Table{
+		ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash_index"}},
+	}
+	srvVSchema.RoutingRules = &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{
+			FromTable:   "simple",
+			ToTables:    []string{"TestExecutor.simple"},
+			WriteTables: []string{"TestUnsharded.simple", "TestExecutor.simple"},
+		}},
+	}
+	executor.SaveVSchema(vindexes.BuildVSchema(srvVSchema), nil)
+
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true, TransactionMode: vtgatepb.TransactionMode_MULTI}
+	_, err := executorExecSession(executor, "select id from simple where id = 1", nil, session)
+	require.NoError(t, err)
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql:           "select id from simple where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+	assertQueries(t, sbclookup, nil)
+
+	// Both writes are committed in the same transaction.
+	sbc1.Queries = nil
+	_, err = executorExecSession(executor, "update simple set a = 2 where id = 1", nil, session)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "update simple set a = 2 where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assertQueries(t, sbclookup, wantQueries)
+	assertQueries(t, sbc1, wantQueries)
+	assertQueries(t, sbc2, nil)
+	assert.EqualValues(t, 1, sbclookup.CommitCount.Get())
+	assert.EqualValues(t, 1, sbc1.CommitCount.Get())
+
+	// A failure of the second write rolls back the first one.
+	sbc1.Queries = nil
+	sbclookup.Queries = nil
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executorExecSession(executor, "delete from simple where id = 1", nil, session)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, sbclookup.CommitCount.Get())
+	assert.EqualValues(t, 1, sbclookup.RollbackCount.Get())
+
+	// The non-deterministic functions would write different values.
+	_, err = executorExecSession(executor, "update simple set a = now() where id = 1", nil, session)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the dual-routed write update simple set a = now() where id = 1 can't use the non-deterministic function now()")
+
+	// The second table inserts the sequence values of the first one.
+	srvVSchema.Keyspaces["TestUnsharded"].Tables["simple"] = &vschemapb.Table{
+		AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "user_seq"},
+	}
+	srvVSchema.Keyspaces["TestExecutor"].Tables["simple"].AutoIncrement = &vschemapb.AutoIncrement{Column: "id", Sequence: "user_seq"}
+	executor.SaveVSchema(vindexes.BuildVSchema(srvVSchema), nil)
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	sbclookup.Queries = nil
+	_, err = executorExecSession(executor, "insert into simple(a) values (2)", nil, session)
+	require.NoError(t, err)
+	assertQueries(t, sbclookup, []*querypb.BoundQuery{{
+		Sql:           "select next :n values from user_seq",
+		BindVariables: map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(1)},
+	}, {
+		Sql: "insert into simple(a, id) values (2, :__seq0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__seq0": sqltypes.Int64BindVariable(1),
+		},
+	}})
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql: "insert into simple(a, id) values (2, :_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_id_0":  sqltypes.Int64BindVariable(1),
+			"__seq0": sqltypes.Int64BindVariable(1),
+		},
+	}})
+}
@@ -51,6 +51,10 @@ import (
 var executorVSchema = `
 {
 	"sharded": true,
+	"databases": {
+		"user_db": "",
+		"tenant_*": "hash_index"
+	},
 	"vindexes": {
 		"hash_index": {
 			"type": "hash"
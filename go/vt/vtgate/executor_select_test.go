@@ -826,6 +826,55 @@ func TestRowCount(t *testing.T) {
 	testRowCount(t, executor, 2)
 }
 
+func TestFoundRowsSQLCalcFoundRows(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+
+	// The shards of ids 1 and 3 run the query with the limit, then the count query.
+	limitResult := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("id", "int64"),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(1)}},
+	}
+	countResult := func(count int64) *sqltypes.Result {
+		return &sqltypes.Result{
+			Fields: sqltypes.MakeTestFields("count(*)", "int64"),
+			Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(count)}},
+		}
+	}
+	sbc1.SetResults([]*sqltypes.Result{limitResult, countResult(5)})
+	sbc2.SetResults([]*sqltypes.Result{limitResult, countResult(3)})
+	_, err := executor.Execute(ctx, "TestExecute", session, "select sql_calc_found_rows id from user where id in (1, 3) limit 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, session.FoundRows)
+
+	// The found rows of the count query do not stick to the session.
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, session.FoundRows)
+}
+
+func TestLastInsertIDExprMultiShard(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+
+	// The shards return the value of LAST_INSERT_ID(expr) as the insert id,
+	// and the first one is kept whatever the order the shards answer in.
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 1, InsertID: 20}})
+	sbc2.SetResults([]*sqltypes.Result{{RowsAffected: 1, InsertID: 10}})
+	_, err := executor.Execute(ctx, "TestExecute", session, "update user set v = last_insert_id(v + 1) where id in (1, 3)", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, session.LastInsertId)
+	assert.EqualValues(t, 2, session.RowCount)
+
+	result, err := executor.Execute(ctx, "TestExecute", session, "select last_insert_id()", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(10)}}, result.Rows)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "select last_insert_id(5)", nil)
+	require.EqualError(t, err, "Argument to last_insert_id() not supported")
+}
+
 func testRowCount(t *testing.T, executor *Executor, wantRowCount int64) {
 	t.Helper()
 	result, err := executorExec(executor, "select row_count()", map[string]*querypb.BindVariable{})
@@ -2805,18 +2854,22 @@ func TestSelectLock(t *testing.T) {
 			TransactionId: 12345,
 			TabletAlias:   sbc1.Tablet().Alias,
 		}},
-		LockSession: &vtgatepb.Session_ShardSession{
-			Target:      &querypb.Target{Keyspace: "TestExecutor", Shard: "-20", TabletType: topodatapb.TabletType_PRIMARY},
-			TabletAlias: sbc1.Tablet().Alias,
-			ReservedId:  1,
-		},
+		LockSessions: []*vtgatepb.Session_LockSession{{
+			ShardSession: &vtgatepb.Session_ShardSession{
+				Target:      &querypb.Target{Keyspace: "TestExecutor", Shard: "-20", TabletType: topodatapb.TabletType_PRIMARY},
+				TabletAlias: sbc1.Tablet().Alias,
+				ReservedId:  1,
+			},
+		}},
 		FoundRows: 1,
 		RowCount:  -1,
 	}
 
 	_, err := exec(executor, session, "select get_lock('lock name', 10) from dual")
 	require.NoError(t, err)
-	wantSession.LastLockHeartbeat = session.Session.LastLockHeartbeat //copying as this is current timestamp value.
+	wantSession.LockSessions[0].LastHeartbeat = session.Session.LockSessions[0].LastHeartbeat //copying as this is current timestamp value.
+	wantSession.LockSession = wantSession.LockSessions[0].ShardSession                        //nolint
+	wantSession.LastLockHeartbeat = wantSession.LockSessions[0].LastHeartbeat                 //nolint
 	utils.MustMatch(t, wantSession, session.Session, "")
 	utils.MustMatch(t, wantQueries, sbc1.Queries, "")
 
@@ -2829,6 +2882,40 @@ func TestSelectLock(t *testing.T) {
 	utils.MustMatch(t, wantSession, session.Session, "")
 }
 
+func TestSelectReleaseAllLocks(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+
+	// The session holds a lock in another keyspace, and takes one in the keyspace of the locking queries.
+	session.LockSessions = []*vtgatepb.Session_LockSession{{
+		ShardSession: &vtgatepb.Session_ShardSession{
+			Target:      &querypb.Target{Keyspace: KsTestUnsharded, Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+			TabletAlias: sbclookup.Tablet().Alias,
+			ReservedId:  1,
+		},
+		LastHeartbeat: time.Now().Unix(),
+	}}
+	_, err := exec(executor, session, "select get_lock('a', 10) from dual")
+	require.NoError(t, err)
+	require.Len(t, session.LockSessions, 2)
+
+	releaseResult := func(count string) []*sqltypes.Result {
+		return []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("release_all_locks()", "int64"), count)}
+	}
+	sbc1.Queries = nil
+	sbclookup.Queries = nil
+	sbc1.SetResults(releaseResult("2"))
+	sbclookup.SetResults(releaseResult("1"))
+
+	// RELEASE_ALL_LOCKS() reaches the lock sessions of both keyspaces,
+	// and returns the locks released on all of them.
+	qr, err := exec(executor, session, "select release_all_locks() from dual")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"select release_all_locks() from dual"}, sbclookup.StringQueries())
+	assert.Equal(t, []string{"select release_all_locks() from dual"}, sbc1.StringQueries())
+	utils.MustMatch(t, releaseResult("3")[0].Rows, qr.Rows)
+}
+
 func TestSelectFromInformationSchema(t *testing.T) {
 	executor, sbc1, _, _ := createExecutorEnv()
 	session := NewSafeSession(nil)
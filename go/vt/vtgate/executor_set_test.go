@@ -197,6 +197,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set skip_query_plan_cache = 0",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set skip_query_consolidation = 1",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{SkipQueryConsolidation: true}},
+	}, {
+		in:  "set skip_query_consolidation = 0",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
 	}, {
 		in:  "set tx_read_only = 2",
 		err: "variable 'tx_read_only' can't be set to the value: 2 is not a boolean",
@@ -245,6 +251,42 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set @@enable_system_settings = false",
 		out: &vtgatepb.Session{Autocommit: true, EnableSystemSettings: false},
+	}, {
+		in:  "set @@read_your_writes = on",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: &vtgatepb.ReadAfterWrite{ReadYourWrites: true}},
+	}, {
+		in:  "set @@read_your_writes = off",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: &vtgatepb.ReadAfterWrite{}},
+	}, {
+		in:  "set @@read_at_timestamp = '2022-03-04 05:06:07.5'",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: &vtgatepb.ReadAfterWrite{ReadAtTimestamp: 1646370367500000000}},
+	}, {
+		in:  "set @@read_at_timestamp = ''",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: &vtgatepb.ReadAfterWrite{}},
+	}, {
+		in:  "set @@read_at_timestamp = 'yesterday'",
+		err: "variable 'read_at_timestamp' can't be set to the value of 'yesterday'",
+	}, {
+		in:  "set include_replication_lag = 1",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{IncludeReplicationLag: true}},
+	}, {
+		in:  "set include_replication_lag = 0",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set @@allow_cross_cell_writes = 1",
+		out: &vtgatepb.Session{Autocommit: true, AllowCrossCellWrites: true},
+	}, {
+		in:  "set allow_cross_cell_writes = off",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set @@vitess_query_tags = 'team = checkout, job=backfill'",
+		out: &vtgatepb.Session{Autocommit: true, QueryTags: "team=checkout,job=backfill"},
+	}, {
+		in:  "set vitess_query_tags = ''",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set @@vitess_query_tags = 'team'",
+		err: "variable 'vitess_query_tags' can't be set to the value of 'team': each tag must be key=value",
 	}, {
 		in:  "set @@socket = '/tmp/change.sock'",
 		err: "variable 'socket' is a read only variable",
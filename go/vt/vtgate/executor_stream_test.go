@@ -82,6 +82,20 @@ func TestStreamSQLSharded(t *testing.T) {
 	}
 }
 
+func TestStreamSQLWindow(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	messageStreamWindow.SetValue(2)
+	defer messageStreamWindow.SetValue(0)
+
+	result, err := executorStreamMessages(executor, "stream * from user_msgs")
+	require.NoError(t, err)
+	require.True(t, result.Equal(sandboxconn.StreamRowResult), "result: %+v, want %+v", result, sandboxconn.StreamRowResult)
+	require.NotEmpty(t, sbclookup.MessageStreamID)
+	require.EqualValues(t, 2, sbclookup.MessageStreamWindowSize)
+	// The window grows as soon as the client processes half of it.
+	require.Equal(t, []int64{1}, sbclookup.MessageStreamIncrements)
+}
+
 func executorStreamMessages(executor *Executor, sql string) (qr *sqltypes.Result, err error) {
 	results := make(chan *sqltypes.Result, 100)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
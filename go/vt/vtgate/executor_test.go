@@ -56,9 +56,9 @@ import (
 )
 
 func TestExecutorResultsExceeded(t *testing.T) {
-	save := *warnMemoryRows
-	*warnMemoryRows = 3
-	defer func() { *warnMemoryRows = save }()
+	save := warnMemoryRows.Get()
+	warnMemoryRows.SetValue(3)
+	defer warnMemoryRows.SetValue(save)
 
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
@@ -79,9 +79,9 @@ func TestExecutorResultsExceeded(t *testing.T) {
 }
 
 func TestExecutorMaxMemoryRowsExceeded(t *testing.T) {
-	save := *maxMemoryRows
-	*maxMemoryRows = 3
-	defer func() { *maxMemoryRows = save }()
+	save := maxMemoryRows.Get()
+	maxMemoryRows.SetValue(3)
+	defer maxMemoryRows.SetValue(save)
 
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
@@ -125,17 +125,23 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	// begin.
 	_, err := executor.Execute(ctx, "TestExecute", session, "begin", nil)
 	require.NoError(t, err)
-	wantSession := &vtgatepb.Session{InTransaction: true, TargetString: "@primary"}
+	transactionID := session.TransactionUuid
+	require.NotEmpty(t, transactionID)
+	wantSession := &vtgatepb.Session{InTransaction: true, TargetString: "@primary", TransactionUuid: transactionID}
 	utils.MustMatch(t, wantSession, session.Session, "session")
 	assert.EqualValues(t, 0, sbclookup.CommitCount.Get(), "commit count")
 	logStats := testQueryLog(t, logChan, "TestExecute", "BEGIN", "begin", 0)
 	assert.EqualValues(t, 0, logStats.CommitTime, "logstats: expected zero CommitTime")
+	assert.Equal(t, transactionID, logStats.TransactionID)
+	assert.Empty(t, logStats.TxOutcome)
 
 	// commit.
 	_, err = executor.Execute(ctx, "TestExecute", session, "select id from main1", nil)
 	require.NoError(t, err)
 	logStats = testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from main1", 1)
 	assert.EqualValues(t, 0, logStats.CommitTime, "logstats: expected zero CommitTime")
+	assert.Equal(t, transactionID, logStats.TransactionID)
+	assert.Empty(t, logStats.TxOutcome)
 
 	_, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
 	if err != nil {
@@ -152,10 +158,14 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	if logStats.CommitTime == 0 {
 		t.Errorf("logstats: expected non-zero CommitTime")
 	}
+	assert.Equal(t, transactionID, logStats.TransactionID)
+	assert.Equal(t, "COMMIT", logStats.TxOutcome)
 
 	// rollback.
 	_, err = executor.Execute(ctx, "TestExecute", session, "begin", nil)
 	require.NoError(t, err)
+	rolledBackID := session.TransactionUuid
+	assert.NotEqual(t, transactionID, rolledBackID)
 	_, err = executor.Execute(ctx, "TestExecute", session, "select id from main1", nil)
 	require.NoError(t, err)
 	_, err = executor.Execute(ctx, "TestExecute", session, "rollback", nil)
@@ -164,11 +174,14 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	utils.MustMatch(t, wantSession, session.Session, "session")
 	assert.EqualValues(t, 1, sbclookup.RollbackCount.Get(), "rollback count")
 	_ = testQueryLog(t, logChan, "TestExecute", "BEGIN", "begin", 0)
-	_ = testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from main1", 1)
+	logStats = testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from main1", 1)
+	assert.Equal(t, rolledBackID, logStats.TransactionID)
 	logStats = testQueryLog(t, logChan, "TestExecute", "ROLLBACK", "rollback", 1)
 	if logStats.CommitTime == 0 {
 		t.Errorf("logstats: expected non-zero CommitTime")
 	}
+	assert.Equal(t, rolledBackID, logStats.TransactionID)
+	assert.Equal(t, "ROLLBACK", logStats.TxOutcome)
 
 	// CloseSession doesn't log anything
 	err = executor.CloseSession(ctx, session)
@@ -213,7 +226,7 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	// begin.
 	_, err := executor.Execute(ctx, "TestExecute", session, "begin", nil)
 	require.NoError(t, err)
-	wantSession := &vtgatepb.Session{InTransaction: true, TargetString: "@primary", Autocommit: true}
+	wantSession := &vtgatepb.Session{InTransaction: true, TargetString: "@primary", Autocommit: true, TransactionUuid: session.TransactionUuid}
 	utils.MustMatch(t, wantSession, session.Session, "session")
 	if commitCount := sbclookup.CommitCount.Get(); commitCount != 0 {
 		t.Errorf("want 0, got %d", commitCount)
@@ -273,10 +286,11 @@ func TestExecutorTransactionsAutoCommitStreaming(t *testing.T) {
 
 	require.NoError(t, err)
 	wantSession := &vtgatepb.Session{
-		InTransaction: true,
-		TargetString:  "@primary",
-		Autocommit:    true,
-		Options:       oltpOptions,
+		InTransaction:   true,
+		TargetString:    "@primary",
+		Autocommit:      true,
+		Options:         oltpOptions,
+		TransactionUuid: session.TransactionUuid,
 	}
 	utils.MustMatch(t, wantSession, session.Session, "session")
 	assert.Zero(t, sbclookup.CommitCount.Get())
@@ -351,7 +365,8 @@ func TestExecutorAutocommit(t *testing.T) {
 	startCount := sbclookup.CommitCount.Get()
 	_, err := executor.Execute(ctx, "TestExecute", session, "select id from main1", nil)
 	require.NoError(t, err)
-	wantSession := &vtgatepb.Session{TargetString: "@primary", InTransaction: true, FoundRows: 1, RowCount: -1}
+	require.NotEmpty(t, session.TransactionUuid)
+	wantSession := &vtgatepb.Session{TargetString: "@primary", InTransaction: true, FoundRows: 1, RowCount: -1, TransactionUuid: session.TransactionUuid}
 	testSession := proto.Clone(session.Session).(*vtgatepb.Session)
 	testSession.ShardSessions = nil
 	utils.MustMatch(t, wantSession, testSession, "session does not match for autocommit=0")
@@ -392,7 +407,7 @@ func TestExecutorAutocommit(t *testing.T) {
 
 	_, err = executor.Execute(ctx, "TestExecute", session, "update main1 set id=1", nil)
 	require.NoError(t, err)
-	wantSession = &vtgatepb.Session{InTransaction: true, Autocommit: true, TargetString: "@primary", FoundRows: 0, RowCount: 1}
+	wantSession = &vtgatepb.Session{InTransaction: true, Autocommit: true, TargetString: "@primary", FoundRows: 0, RowCount: 1, TransactionUuid: session.TransactionUuid}
 	testSession = proto.Clone(session.Session).(*vtgatepb.Session)
 	testSession.ShardSessions = nil
 	utils.MustMatch(t, wantSession, testSession, "session does not match for autocommit=1")
@@ -1080,6 +1095,59 @@ func TestExecutorUse(t *testing.T) {
 	require.EqualError(t, err, "unknown database 'UnexistentKeyspace'")
 }
 
+func TestExecutorUseDatabase(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+
+	// A database is an alias of its keyspace, in USE and as a qualifier.
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@primary"})
+	_, err := executor.Execute(ctx, "TestExecute", session, "use user_db", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "user_db", session.TargetString)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", NewSafeSession(&vtgatepb.Session{}), "select id from user_db.user where id = 3", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"select id from `user` where id = 1"}, sbc1.StringQueries())
+	assert.Equal(t, []string{"select id from `user` where id = 3"}, sbc2.StringQueries())
+
+	// The queries of a tenant database are planned with a predicate on the
+	// column of the vindex of the database, which sends them to the shard of
+	// the tenant.
+	sbc1.Queries, sbc2.Queries = nil, nil
+	session = NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@primary"})
+	_, err = executor.Execute(ctx, "TestExecute", session, "use tenant_3", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", NewSafeSession(&vtgatepb.Session{}), "select u.id, m.id from tenant_3.user as u left join tenant_3.music as m on u.id = m.user_id", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "update user set a = 1 where b = 2", nil)
+	require.NoError(t, err)
+	assert.Empty(t, sbc1.Queries)
+	assert.Equal(t, []string{
+		"select id from `user` where `user`.Id = '3'",
+		"select u.id, m.id from `user` as u left join music as m on u.id = m.user_id and m.user_id = '3' where u.Id = '3'",
+		"update `user` set a = 1 where b = 2 and `user`.Id = '3'",
+	}, sbc2.StringQueries())
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "update user set id = 4", nil)
+	require.EqualError(t, err, "the update of the column id can't be scoped to the tenant of database tenant_3")
+	_, err = executor.Execute(ctx, "TestExecute", session, "insert into user(id, name) values (4, 'x')", nil)
+	require.EqualError(t, err, "the insert of 4 in the column Id can't be scoped to the tenant of database tenant_3")
+	_, err = executor.Execute(ctx, "TestExecute", session, "insert into user(id, name) select id, name from user", nil)
+	require.EqualError(t, err, "the insert of the rows of a select into `user` can't be scoped to the tenant of database tenant_3")
+	_, err = executor.Execute(ctx, "TestExecute", session, "create table t(id int)", nil)
+	require.EqualError(t, err, "the statement create table t (\n\tid int\n) can't be scoped to the tenant of database tenant_3")
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from noauto_table", nil)
+	require.EqualError(t, err, "table noauto_table has no column on vindex hash_index and can't be used by the tenant database tenant_3")
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "use tenant_abc", nil)
+	require.EqualError(t, err, "Unknown tenant 'abc' of database 'tenant_abc'")
+	_, err = executor.Execute(ctx, "TestExecute", session, "use `tenant_3:-20`", nil)
+	require.EqualError(t, err, "the tenant database tenant_3 can't be combined with a shard or keyspace id")
+	assert.Equal(t, "tenant_3", session.TargetString)
+}
+
 func TestExecutorComment(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 
@@ -1808,6 +1876,85 @@ func TestGetPlanNormalized(t *testing.T) {
 	assertCacheContains(t, r, want)
 }
 
+func TestGetPlanTupleBuckets(t *testing.T) {
+	r, _, _, _ := createExecutorEnv()
+	r.normalize = true
+	r.bucketValTuples = true
+	emptyvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	hits, misses := planCacheHitsByBucket.Counts()["4"], planCacheMissesByBucket.Counts()["4"]
+
+	plan1, logStats1 := getPlanCached(t, r, emptyvc, "select * from music_user_map where id in (1, null, 3)", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	plan2, logStats2 := getPlanCached(t, r, emptyvc, "select * from music_user_map where id in (1, null, 3, 4)", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Same(t, plan1, plan2)
+	assert.Equal(t, 4, plan1.TupleBucket)
+	assert.Equal(t, "select * from music_user_map where id in (:vtg1, null, :vtg2, :vtg3)", logStats1.SQL)
+	assert.Equal(t, "select * from music_user_map where id in (:vtg1, null, :vtg2, :vtg3)", logStats2.SQL)
+	assert.Equal(t, logStats1.BindVariables["vtg2"], logStats1.BindVariables["vtg3"])
+	assert.Equal(t, hits+1, planCacheHitsByBucket.Counts()["4"])
+	assert.Equal(t, misses+1, planCacheMissesByBucket.Counts()["4"])
+
+	// a tuple of another bucket gets another plan
+	plan3, _ := getPlanCached(t, r, emptyvc, "select * from music_user_map where id in (1, null, 3, 4, 5)", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.NotSame(t, plan1, plan3)
+	assert.Equal(t, 8, plan3.TupleBucket)
+}
+
+func TestPlanCacheInvalidation(t *testing.T) {
+	r, _, _, _ := createExecutorEnv()
+	r.normalize = true
+	emptyvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	invalidations, clears := planCacheInvalidations.Counts()[vschemaChangeSchema], planCacheClears.Counts()[vschemaChangeVSchema]
+
+	getPlanCached(t, r, emptyvc, "select * from music_user_map where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	getPlanCached(t, r, emptyvc, "select u.id from user as u join music as m on u.id = m.user_id", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	getPlanCached(t, r, emptyvc, "select 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assertCacheSize(t, r.plans, 3)
+
+	// Only the plans of the changed tables are evicted.
+	change := newVSchemaChange(vschemaChangeSchema)
+	change.addTable("Music")
+	r.saveVSchema(r.VSchema(), nil, change)
+	r.plans.Wait()
+	var cached []string
+	for _, item := range r.debugCacheEntries() {
+		cached = append(cached, item.Key)
+	}
+	assert.ElementsMatch(t, []string{"select * from music_user_map where id = :vtg1", "select :vtg1 from dual"}, cached)
+	assert.Equal(t, invalidations+1, planCacheInvalidations.Counts()[vschemaChangeSchema])
+
+	// The plans without tables are only evicted when the whole cache is cleared.
+	change = newVSchemaChange(vschemaChangeSchema)
+	change.addTable("music_user_map")
+	r.saveVSchema(r.VSchema(), nil, change)
+	r.plans.Wait()
+	assertCacheSize(t, r.plans, 1)
+
+	r.SaveVSchema(r.VSchema(), nil)
+	r.plans.Wait()
+	assertCacheSize(t, r.plans, 0)
+	assert.Equal(t, clears+1, planCacheClears.Counts()[vschemaChangeVSchema])
+}
+
+func TestPlanCacheTablesChanged(t *testing.T) {
+	r, _, _, _ := createExecutorEnv()
+	r.normalize = true
+	emptyvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+
+	getPlanCached(t, r, emptyvc, "select * from music_user_map where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	getPlanCached(t, r, emptyvc, "select u.id from user as u join music as m on u.id = m.user_id", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assertCacheSize(t, r.plans, 2)
+
+	// The plans of the tables whose schema changed are evicted.
+	r.tablesChanged("TestExecutor", []string{"Music"})
+	r.plans.Wait()
+	assertCacheSize(t, r.plans, 1)
+
+	// All the plans are evicted when the schema of a keyspace is reloaded.
+	r.tablesChanged("TestExecutor", nil)
+	r.plans.Wait()
+	assertCacheSize(t, r.plans, 0)
+}
+
 func TestPassthroughDDL(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 	primarySession.TargetString = "TestExecutor"
@@ -2449,6 +2596,7 @@ func TestExecutorCallProc(t *testing.T) {
 func TestExecutorTempTable(t *testing.T) {
 	executor, _, _, sbcUnsharded := createExecutorEnv()
 	executor.warnShardedOnly = true
+	sessionsBefore := tempTableSessions.Get()
 	creatQuery := "create temporary table temp_t(id bigint primary key)"
 	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded})
 	ctx := context.Background()
@@ -2463,6 +2611,35 @@ func TestExecutorTempTable(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, before, executor.plans.Len())
+	assert.True(t, session.InReservedConn())
+	assert.Equal(t, []string{KsTestUnsharded + ".temp_t"}, session.TempTables)
+	assert.EqualValues(t, sessionsBefore+1, tempTableSessions.Get())
+
+	_, err = executor.Execute(ctx, "TestExecutorTempTable", session, "drop temporary table temp_t", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.TempTables)
+	assert.EqualValues(t, sessionsBefore, tempTableSessions.Get())
+}
+
+func TestExecutorTempTableTargetedShard(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	sessionsBefore := tempTableSessions.Get()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor:-20"})
+	ctx := context.Background()
+	_, err := executor.Execute(ctx, "TestExecutorTempTableTargetedShard", session, "create temporary table temp_t(id bigint primary key)", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.Equal(t, []string{"TestExecutor.temp_t"}, session.TempTables)
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId)
+
+	err = executor.CloseSession(ctx, session)
+	require.NoError(t, err)
+	assert.Empty(t, session.TempTables)
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.EqualValues(t, sessionsBefore, tempTableSessions.Get())
 }
 
 func TestExecutorShowVitessMigrations(t *testing.T) {
@@ -2483,3 +2660,30 @@ func exec(executor *Executor, session *SafeSession, sql string) (*sqltypes.Resul
 func makeComments(text string) sqlparser.MarginComments {
 	return sqlparser.MarginComments{Trailing: text}
 }
+
+func TestExecutorVSchemaLint(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+
+	lint := func(query string) []string {
+		request := httptest.NewRequest("GET", pathVSchemaLint+query, nil)
+		response := httptest.NewRecorder()
+		executor.ServeHTTP(response, request)
+		var findings []*vindexes.LintFinding
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &findings))
+		var checks []string
+		for _, f := range findings {
+			checks = append(checks, f.Keyspace+" "+f.Check)
+		}
+		return checks
+	}
+	assert.Equal(t, []string{
+		"TestXBadVSchema keyspace_error",
+		"TestXBadVSchema missing_primary_vindex",
+		"TestExecutor unused_vindex",
+	}, lint(""))
+	assert.Equal(t, []string{"TestExecutor unused_vindex"}, lint("?keyspace=TestExecutor"))
+	assert.Equal(t, []string{
+		"TestXBadVSchema keyspace_error",
+		"TestXBadVSchema missing_primary_vindex",
+	}, lint("?severity=error"))
+}
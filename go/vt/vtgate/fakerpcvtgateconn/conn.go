@@ -187,6 +187,16 @@ func (conn *FakeVTGateConn) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// ReadTransactions please see vtgateconn.Impl.ReadTransactions
+func (conn *FakeVTGateConn) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	return nil, nil
+}
+
+// ForceResolveTransaction please see vtgateconn.Impl.ForceResolveTransaction
+func (conn *FakeVTGateConn) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return nil
+}
+
 // VStream streams binlog events.
 func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
@@ -194,6 +204,16 @@ func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.T
 	return nil, fmt.Errorf("NYI")
 }
 
+// VStreamAck please see vtgateconn.Impl.VStreamAck
+func (conn *FakeVTGateConn) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return nil
+}
+
+// VStreamControl please see vtgateconn.Impl.VStreamControl
+func (conn *FakeVTGateConn) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	return 0, nil
+}
+
 // Close please see vtgateconn.Impl.Close
 func (conn *FakeVTGateConn) Close() {
 }
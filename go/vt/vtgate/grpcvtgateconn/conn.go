@@ -35,6 +35,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtgateservicepb "vitess.io/vitess/go/vt/proto/vtgateservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -81,6 +82,19 @@ func DialWithOpts(ctx context.Context, opts ...grpc.DialOption) vtgateconn.Diale
 	}
 }
 
+// fromGRPCTransport converts the error of a gRPC call whose vtgate errors
+// come in its response, or of the opening of a stream, whose vtgate errors
+// come when it is read: their UNAVAILABLE errors are failures to reach the
+// vtgate. The other calls get the vtgate errors as gRPC errors too, so their
+// UNAVAILABLE errors can't be told apart from transport errors.
+func fromGRPCTransport(err error) error {
+	err = vterrors.FromGRPC(err)
+	if vterrors.Code(err) == vtrpcpb.Code_UNAVAILABLE {
+		return vtgateconn.TransportError(err)
+	}
+	return err
+}
+
 func (conn *vtgateConn) Execute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error) {
 	request := &vtgatepb.ExecuteRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
@@ -92,7 +106,7 @@ func (conn *vtgateConn) Execute(ctx context.Context, session *vtgatepb.Session,
 	}
 	response, err := conn.c.Execute(ctx, request)
 	if err != nil {
-		return session, nil, vterrors.FromGRPC(err)
+		return session, nil, fromGRPCTransport(err)
 	}
 	if response.Error != nil {
 		return response.Session, nil, vterrors.FromVTRPC(response.Error)
@@ -116,7 +130,7 @@ func (conn *vtgateConn) ExecuteBatch(ctx context.Context, session *vtgatepb.Sess
 	}
 	response, err := conn.c.ExecuteBatch(ctx, request)
 	if err != nil {
-		return session, nil, vterrors.FromGRPC(err)
+		return session, nil, fromGRPCTransport(err)
 	}
 	if response.Error != nil {
 		return response.Session, nil, vterrors.FromVTRPC(response.Error)
@@ -151,7 +165,7 @@ func (conn *vtgateConn) StreamExecute(ctx context.Context, session *vtgatepb.Ses
 	}
 	stream, err := conn.c.StreamExecute(ctx, req)
 	if err != nil {
-		return nil, vterrors.FromGRPC(err)
+		return nil, fromGRPCTransport(err)
 	}
 	return &streamExecuteAdapter{
 		recv: func() (*querypb.QueryResult, error) {
@@ -175,7 +189,7 @@ func (conn *vtgateConn) Prepare(ctx context.Context, session *vtgatepb.Session,
 	}
 	response, err := conn.c.Prepare(ctx, request)
 	if err != nil {
-		return session, nil, vterrors.FromGRPC(err)
+		return session, nil, fromGRPCTransport(err)
 	}
 	if response.Error != nil {
 		return response.Session, nil, vterrors.FromVTRPC(response.Error)
@@ -190,7 +204,7 @@ func (conn *vtgateConn) CloseSession(ctx context.Context, session *vtgatepb.Sess
 	}
 	response, err := conn.c.CloseSession(ctx, request)
 	if err != nil {
-		return vterrors.FromGRPC(err)
+		return fromGRPCTransport(err)
 	}
 	if response.Error != nil {
 		return vterrors.FromVTRPC(response.Error)
@@ -207,6 +221,29 @@ func (conn *vtgateConn) ResolveTransaction(ctx context.Context, dtid string) err
 	return vterrors.FromGRPC(err)
 }
 
+func (conn *vtgateConn) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	request := &vtgatepb.ReadTransactionsRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		Keyspace: keyspace,
+		Dtid:     dtid,
+	}
+	response, err := conn.c.ReadTransactions(ctx, request)
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response.Transactions, nil
+}
+
+func (conn *vtgateConn) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	request := &vtgatepb.ForceResolveTransactionRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		Dtid:     dtid,
+		Decision: decision,
+	}
+	_, err := conn.c.ForceResolveTransaction(ctx, request)
+	return vterrors.FromGRPC(err)
+}
+
 type vstreamAdapter struct {
 	stream vtgateservicepb.Vitess_VStreamClient
 }
@@ -231,13 +268,36 @@ func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.Table
 	}
 	stream, err := conn.c.VStream(ctx, req)
 	if err != nil {
-		return nil, vterrors.FromGRPC(err)
+		return nil, fromGRPCTransport(err)
 	}
 	return &vstreamAdapter{
 		stream: stream,
 	}, nil
 }
 
+func (conn *vtgateConn) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	request := &vtgatepb.VStreamAckRequest{
+		CallerId:       callerid.EffectiveCallerIDFromContext(ctx),
+		CheckpointName: checkpointName,
+		Vgtid:          vgtid,
+	}
+	_, err := conn.c.VStreamAck(ctx, request)
+	return vterrors.FromGRPC(err)
+}
+
+func (conn *vtgateConn) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	request := &vtgatepb.VStreamControlRequest{
+		CallerId:   callerid.EffectiveCallerIDFromContext(ctx),
+		StreamName: streamName,
+		CopyLimits: limits,
+	}
+	response, err := conn.c.VStreamControl(ctx, request)
+	if err != nil {
+		return 0, vterrors.FromGRPC(err)
+	}
+	return int(response.Streams), nil
+}
+
 func (conn *vtgateConn) Close() {
 	conn.cc.Close()
 }
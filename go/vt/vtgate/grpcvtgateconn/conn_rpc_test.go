@@ -23,13 +23,19 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"context"
 
 	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/grpcvtgateservice"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // TestGRPCVTGateConn makes sure the grpc service works
@@ -153,3 +159,14 @@ func TestGRPCVTGateConnAuth(t *testing.T) {
 	// and clean up again
 	client.Close()
 }
+
+func TestFromGRPCTransport(t *testing.T) {
+	err := fromGRPCTransport(status.Error(codes.Unavailable, "connection refused"))
+	assert.True(t, vtgateconn.IsTransportError(err))
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.EqualError(t, err, "rpc error: code = Unavailable desc = connection refused")
+
+	err = fromGRPCTransport(status.Error(codes.Internal, "panic"))
+	assert.False(t, vtgateconn.IsTransportError(err))
+	assert.Nil(t, fromGRPCTransport(nil))
+}
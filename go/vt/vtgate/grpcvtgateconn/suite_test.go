@@ -249,10 +249,70 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// ReadTransactions is part of the VTGateService interface
+func (f *fakeVTGateService) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	if f.hasError {
+		return nil, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "ReadTransactions")
+	if keyspace != "connection_ks" || dtid != "" {
+		return nil, errors.New("ReadTransactions: keyspace or dtid mismatch")
+	}
+	return distributedTransactions, nil
+}
+
+// ForceResolveTransaction is part of the VTGateService interface
+func (f *fakeVTGateService) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	if f.hasError {
+		return errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "ForceResolveTransaction")
+	if dtid != dtid2 || decision != querypb.TransactionState_ROLLBACK {
+		return errors.New("ForceResolveTransaction: dtid or decision mismatch")
+	}
+	return nil
+}
+
 func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	panic("unimplemented")
 }
 
+// VStreamAck is part of the VTGateService interface
+func (f *fakeVTGateService) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	if f.hasError {
+		return errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "VStreamAck")
+	if checkpointName != "connection_consumer" || !proto.Equal(vgtid, ackVgtid) {
+		return errors.New("VStreamAck: checkpoint name or vgtid mismatch")
+	}
+	return nil
+}
+
+// VStreamControl is part of the VTGateService interface
+func (f *fakeVTGateService) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	if f.hasError {
+		return 0, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "VStreamControl")
+	if streamName != "connection_stream" || !proto.Equal(limits, copyLimits) {
+		return 0, errors.New("VStreamControl: stream name or limits mismatch")
+	}
+	return 2, nil
+}
+
 // CreateFakeServer returns the fake server for the tests
 func CreateFakeServer(t *testing.T) vtgateservice.VTGateService {
 	return &fakeVTGateService{
@@ -295,6 +355,10 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testStreamExecute(t, session)
 	testExecuteBatch(t, session)
 	testPrepare(t, session)
+	testReadTransactions(t, conn)
+	testForceResolveTransaction(t, conn)
+	testVStreamAck(t, conn)
+	testVStreamControl(t, conn)
 
 	// force a panic at every call, then test that works
 	fs.panics = true
@@ -302,6 +366,9 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testExecuteBatchPanic(t, session)
 	testStreamExecutePanic(t, session)
 	testPreparePanic(t, session)
+	testReadTransactionsPanic(t, conn)
+	testVStreamAckPanic(t, conn)
+	testVStreamControlPanic(t, conn)
 	fs.panics = false
 }
 
@@ -527,6 +594,66 @@ func testPreparePanic(t *testing.T, session *vtgateconn.VTGateSession) {
 	expectPanic(t, err)
 }
 
+func testReadTransactions(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	transactions, err := conn.ReadTransactions(ctx, "connection_ks", "")
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	if !proto.Equal(transactions[0], distributedTransactions[0]) {
+		t.Errorf("Unexpected result from ReadTransactions: got %v want %v", transactions[0], distributedTransactions[0])
+	}
+}
+
+func testReadTransactionsPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.ReadTransactions(ctx, "connection_ks", "")
+	expectPanic(t, err)
+}
+
+func testForceResolveTransaction(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	err := conn.ForceResolveTransaction(ctx, dtid2, querypb.TransactionState_ROLLBACK)
+	require.NoError(t, err)
+}
+
+func testVStreamAck(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	err := conn.VStreamAck(ctx, "connection_consumer", ackVgtid)
+	require.NoError(t, err)
+}
+
+func testVStreamAckPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	err := conn.VStreamAck(ctx, "connection_consumer", ackVgtid)
+	expectPanic(t, err)
+}
+
+func testVStreamControl(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	streams, err := conn.VStreamControl(ctx, "connection_stream", copyLimits)
+	require.NoError(t, err)
+	require.Equal(t, 2, streams)
+}
+
+func testVStreamControlPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.VStreamControl(ctx, "connection_stream", copyLimits)
+	expectPanic(t, err)
+}
+
+var copyLimits = &vtgatepb.VStreamCopyLimits{
+	RowsPerSecond: 1000,
+	Parallelism:   2,
+}
+
+var ackVgtid = &binlogdatapb.VGtid{
+	ShardGtids: []*binlogdatapb.ShardGtid{{
+		Keyspace: "connection_ks",
+		Shard:    "0",
+		Gtid:     "MySQL56/12345678-1234-1234-1234-123456789012:1-10",
+	}},
+}
+
 var testCallerID = &vtrpcpb.CallerID{
 	Principal:    "test_principal",
 	Component:    "test_component",
@@ -603,3 +730,13 @@ var streamResultFields = sqltypes.Result{
 }
 
 var dtid2 = "aa"
+
+var distributedTransactions = []*vtgatepb.DistributedTransaction{{
+	Dtid:        dtid2,
+	State:       querypb.TransactionState_ROLLBACK,
+	TimeCreated: 1,
+	Participants: []*vtgatepb.TransactionParticipant{{
+		Target: &querypb.Target{Keyspace: "connection_ks", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY},
+		State:  vtgatepb.TransactionParticipant_PREPARED,
+	}},
+}}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcvtgatedrain contains the gRPC implementation of the server
+// side of the drain service of vtgate.
+package grpcvtgatedrain
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vtgate"
+
+	vtgatedrainpb "vitess.io/vitess/go/vt/proto/vtgatedrain"
+)
+
+// Server is the gRPC server implementation of the Drain service.
+type Server struct {
+	vtgatedrainpb.UnimplementedDrainServer
+	drainer vtgate.Drainer
+}
+
+// NewServer creates a new RPC server for the drain state of a vtgate.
+func NewServer(drainer vtgate.Drainer) *Server {
+	return &Server{drainer: drainer}
+}
+
+// GetDrainStatus implements the gRPC server interface.
+func (s *Server) GetDrainStatus(_ context.Context, request *vtgatedrainpb.GetDrainStatusRequest) (_ *vtgatedrainpb.GetDrainStatusResponse, err error) {
+	defer servenv.HandlePanic("vtgatedrain", &err)
+
+	return &vtgatedrainpb.GetDrainStatusResponse{Status: s.status()}, nil
+}
+
+// Drain implements the gRPC server interface.
+func (s *Server) Drain(_ context.Context, request *vtgatedrainpb.DrainRequest) (_ *vtgatedrainpb.DrainResponse, err error) {
+	defer servenv.HandlePanic("vtgatedrain", &err)
+
+	s.drainer.Drain()
+	return &vtgatedrainpb.DrainResponse{Status: s.status()}, nil
+}
+
+// Resume implements the gRPC server interface.
+func (s *Server) Resume(_ context.Context, request *vtgatedrainpb.ResumeRequest) (_ *vtgatedrainpb.ResumeResponse, err error) {
+	defer servenv.HandlePanic("vtgatedrain", &err)
+
+	s.drainer.Resume()
+	return &vtgatedrainpb.ResumeResponse{Status: s.status()}, nil
+}
+
+func (s *Server) status() *vtgatedrainpb.DrainStatus {
+	state, since := s.drainer.DrainStatus()
+	return &vtgatedrainpb.DrainStatus{
+		State:           vtgatedrainpb.DrainState(vtgatedrainpb.DrainState_value[state]),
+		StateAgeSeconds: time.Since(since).Seconds(),
+	}
+}
+
+// RegisterServer registers a new drain server instance with the gRPC server.
+func RegisterServer(s *grpc.Server, drainer vtgate.Drainer) {
+	vtgatedrainpb.RegisterDrainServer(s, NewServer(drainer))
+}
+
+func init() {
+	vtgate.RegisterDrainers = append(vtgate.RegisterDrainers, func(drainer vtgate.Drainer) {
+		if servenv.GRPCCheckServiceMap("vtgatedrain") {
+			RegisterServer(servenv.GRPCServer, drainer)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtgatedrain
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	vtgatedrainpb "vitess.io/vitess/go/vt/proto/vtgatedrain"
+)
+
+type fakeDrainer struct {
+	state string
+	since time.Time
+}
+
+func (f *fakeDrainer) DrainStatus() (string, time.Time) {
+	return f.state, f.since
+}
+
+func (f *fakeDrainer) Drain() {
+	f.state = "DRAINING"
+	f.since = time.Now()
+}
+
+func (f *fakeDrainer) Resume() {
+	f.state = "SERVING"
+	f.since = time.Now()
+}
+
+func TestDrainServer(t *testing.T) {
+	drainer := &fakeDrainer{state: "SERVING", since: time.Now().Add(-time.Minute)}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	RegisterServer(s, drainer)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := vtgatedrainpb.NewDrainClient(conn)
+	ctx := context.Background()
+
+	status, err := client.GetDrainStatus(ctx, &vtgatedrainpb.GetDrainStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, vtgatedrainpb.DrainState_SERVING, status.Status.State)
+	assert.GreaterOrEqual(t, status.Status.StateAgeSeconds, 60.0)
+
+	drained, err := client.Drain(ctx, &vtgatedrainpb.DrainRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, vtgatedrainpb.DrainState_DRAINING, drained.Status.State)
+	assert.Less(t, drained.Status.StateAgeSeconds, 60.0)
+
+	resumed, err := client.Resume(ctx, &vtgatedrainpb.ResumeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, vtgatedrainpb.DrainState_SERVING, resumed.Status.State)
+}
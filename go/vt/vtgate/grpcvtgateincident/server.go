@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcvtgateincident contains the gRPC implementation of the server
+// side of the incident mode service of vtgate.
+package grpcvtgateincident
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate"
+
+	vtgateincidentpb "vitess.io/vitess/go/vt/proto/vtgateincident"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Server is the gRPC server implementation of the IncidentMode service.
+type Server struct {
+	vtgateincidentpb.UnimplementedIncidentModeServer
+	controller vtgate.IncidentModeController
+}
+
+// NewServer creates a new RPC server for the incident mode of a vtgate.
+func NewServer(controller vtgate.IncidentModeController) *Server {
+	return &Server{controller: controller}
+}
+
+// GetIncidentMode implements the gRPC server interface.
+func (s *Server) GetIncidentMode(_ context.Context, request *vtgateincidentpb.GetIncidentModeRequest) (_ *vtgateincidentpb.GetIncidentModeResponse, err error) {
+	defer servenv.HandlePanic("vtgateincident", &err)
+
+	return &vtgateincidentpb.GetIncidentModeResponse{Status: s.status()}, nil
+}
+
+// ActivateIncidentMode implements the gRPC server interface.
+func (s *Server) ActivateIncidentMode(_ context.Context, request *vtgateincidentpb.ActivateIncidentModeRequest) (_ *vtgateincidentpb.ActivateIncidentModeResponse, err error) {
+	defer servenv.HandlePanic("vtgateincident", &err)
+
+	if request.DurationSeconds < 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid duration: %vs", request.DurationSeconds)
+	}
+	reason := request.Reason
+	if reason == "" {
+		reason = "activated by hand"
+	}
+	s.controller.ActivateIncidentMode(reason, time.Duration(request.DurationSeconds*float64(time.Second)))
+	return &vtgateincidentpb.ActivateIncidentModeResponse{Status: s.status()}, nil
+}
+
+// DeactivateIncidentMode implements the gRPC server interface.
+func (s *Server) DeactivateIncidentMode(_ context.Context, request *vtgateincidentpb.DeactivateIncidentModeRequest) (_ *vtgateincidentpb.DeactivateIncidentModeResponse, err error) {
+	defer servenv.HandlePanic("vtgateincident", &err)
+
+	s.controller.DeactivateIncidentMode()
+	return &vtgateincidentpb.DeactivateIncidentModeResponse{Status: s.status()}, nil
+}
+
+func (s *Server) status() *vtgateincidentpb.IncidentModeStatus {
+	report := s.controller.IncidentModeStatus()
+	return &vtgateincidentpb.IncidentModeStatus{
+		Active:             report.Active,
+		Reason:             report.Reason,
+		AgeSeconds:         report.AgeSeconds,
+		RemainingSeconds:   report.RemainingSeconds,
+		MaxFanout:          int64(report.MaxFanout),
+		ErrorRateThreshold: report.ErrorRateThreshold,
+		ShardQueries:       int64(report.ShardQueries),
+		ShardErrors:        int64(report.ShardErrors),
+	}
+}
+
+// RegisterServer registers a new incident mode server instance with the
+// gRPC server.
+func RegisterServer(s *grpc.Server, controller vtgate.IncidentModeController) {
+	vtgateincidentpb.RegisterIncidentModeServer(s, NewServer(controller))
+}
+
+func init() {
+	vtgate.RegisterIncidentModeControllers = append(vtgate.RegisterIncidentModeControllers, func(controller vtgate.IncidentModeController) {
+		if servenv.GRPCCheckServiceMap("vtgateincident") {
+			RegisterServer(servenv.GRPCServer, controller)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtgateincident
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/vtgate"
+
+	vtgateincidentpb "vitess.io/vitess/go/vt/proto/vtgateincident"
+)
+
+type fakeController struct {
+	report   vtgate.IncidentModeReport
+	duration time.Duration
+}
+
+func (f *fakeController) IncidentModeStatus() *vtgate.IncidentModeReport {
+	report := f.report
+	return &report
+}
+
+func (f *fakeController) ActivateIncidentMode(reason string, duration time.Duration) {
+	f.report.Active = true
+	f.report.Reason = reason
+	f.duration = duration
+}
+
+func (f *fakeController) DeactivateIncidentMode() {
+	f.report.Active = false
+	f.report.Reason = ""
+}
+
+func TestIncidentModeServer(t *testing.T) {
+	controller := &fakeController{report: vtgate.IncidentModeReport{MaxFanout: 2}}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	RegisterServer(s, controller)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := vtgateincidentpb.NewIncidentModeClient(conn)
+	ctx := context.Background()
+
+	status, err := client.GetIncidentMode(ctx, &vtgateincidentpb.GetIncidentModeRequest{})
+	require.NoError(t, err)
+	assert.False(t, status.Status.Active)
+	assert.EqualValues(t, 2, status.Status.MaxFanout)
+
+	activated, err := client.ActivateIncidentMode(ctx, &vtgateincidentpb.ActivateIncidentModeRequest{DurationSeconds: 90})
+	require.NoError(t, err)
+	assert.True(t, activated.Status.Active)
+	assert.Equal(t, "activated by hand", activated.Status.Reason)
+	assert.Equal(t, 90*time.Second, controller.duration)
+
+	_, err = client.ActivateIncidentMode(ctx, &vtgateincidentpb.ActivateIncidentModeRequest{DurationSeconds: -1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid duration")
+
+	deactivated, err := client.DeactivateIncidentMode(ctx, &vtgateincidentpb.DeactivateIncidentModeRequest{})
+	require.NoError(t, err)
+	assert.False(t, deactivated.Status.Active)
+}
@@ -226,6 +226,29 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, request *vtgatepb.Res
 	return nil, vterrors.ToGRPC(vtgErr)
 }
 
+// ReadTransactions is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) ReadTransactions(ctx context.Context, request *vtgatepb.ReadTransactionsRequest) (response *vtgatepb.ReadTransactionsResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	transactions, vtgErr := vtg.server.ReadTransactions(ctx, request.Keyspace, request.Dtid)
+	if vtgErr == nil {
+		return &vtgatepb.ReadTransactionsResponse{Transactions: transactions}, nil
+	}
+	return nil, vterrors.ToGRPC(vtgErr)
+}
+
+// ForceResolveTransaction is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) ForceResolveTransaction(ctx context.Context, request *vtgatepb.ForceResolveTransactionRequest) (response *vtgatepb.ForceResolveTransactionResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	vtgErr := vtg.server.ForceResolveTransaction(ctx, request.Dtid, request.Decision)
+	response = &vtgatepb.ForceResolveTransactionResponse{}
+	if vtgErr == nil {
+		return response, nil
+	}
+	return nil, vterrors.ToGRPC(vtgErr)
+}
+
 // VStream is the RPC version of vtgateservice.VTGateService method
 func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservicepb.Vitess_VStreamServer) (err error) {
 	defer vtg.server.HandlePanic(&err)
@@ -250,6 +273,28 @@ func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservic
 	return vterrors.ToGRPC(vtgErr)
 }
 
+// VStreamAck is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) VStreamAck(ctx context.Context, request *vtgatepb.VStreamAckRequest) (response *vtgatepb.VStreamAckResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	vtgErr := vtg.server.VStreamAck(ctx, request.CheckpointName, request.Vgtid)
+	if vtgErr == nil {
+		return &vtgatepb.VStreamAckResponse{}, nil
+	}
+	return nil, vterrors.ToGRPC(vtgErr)
+}
+
+// VStreamControl is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) VStreamControl(ctx context.Context, request *vtgatepb.VStreamControlRequest) (response *vtgatepb.VStreamControlResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	streams, vtgErr := vtg.server.VStreamControl(ctx, request.StreamName, request.CopyLimits)
+	if vtgErr == nil {
+		return &vtgatepb.VStreamControlResponse{Streams: int32(streams)}, nil
+	}
+	return nil, vterrors.ToGRPC(vtgErr)
+}
+
 func init() {
 	vtgate.RegisterVTGates = append(vtgate.RegisterVTGates, func(vtGate vtgateservice.VTGateService) {
 		if servenv.GRPCCheckServiceMap("vtgateservice") {
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var inClauseValues = stats.NewHistogram(
+	"VtgateInClauseValues",
+	"Number of values of the IN clauses of the queries",
+	[]int64{10, 100, 1000, 10000})
+
+const (
+	inClauseLimitReject = "reject"
+	inClauseLimitBatch  = "batch"
+)
+
+// inClauseLimit protects the tablets from the IN clauses with too many
+// values. The IN clauses are the list bind variables of the queries,
+// which the normalizer makes of the tuples of values.
+// A nil inClauseLimit does not limit anything.
+type inClauseLimit struct {
+	maxValues int
+	// batch is true if the IN clauses over the limit are batched when
+	// they can be, rather than rejected.
+	batch bool
+}
+
+// newInClauseLimit returns nil if maxValues is not positive.
+func newInClauseLimit(maxValues int, action string) (*inClauseLimit, error) {
+	if maxValues <= 0 {
+		return nil, nil
+	}
+	switch action {
+	case inClauseLimitReject:
+		return &inClauseLimit{maxValues: maxValues}, nil
+	case inClauseLimitBatch:
+		return &inClauseLimit{maxValues: maxValues, batch: true}, nil
+	}
+	return nil, fmt.Errorf("invalid IN clause limit action %q, expected %s or %s", action, inClauseLimitReject, inClauseLimitBatch)
+}
+
+// batchSize returns the maximum number of values of the IN clause of a
+// route that are sent to a shard in one query, or 0 if the IN clauses are
+// not batched.
+func (l *inClauseLimit) batchSize() int {
+	if l == nil || !l.batch {
+		return 0
+	}
+	return l.maxValues
+}
+
+// check records the number of values of the IN clauses of an execution of
+// the plan, and returns an error if one of them has too many values and
+// cannot be batched.
+func (l *inClauseLimit) check(plan *engine.Plan, bindVars map[string]*querypb.BindVariable) error {
+	for name, bv := range bindVars {
+		if bv.GetType() != querypb.Type_TUPLE {
+			continue
+		}
+		n := len(bv.Values)
+		inClauseValues.Add(int64(n))
+		plan.AddInClauseValues(n)
+		if l == nil || n <= l.maxValues {
+			continue
+		}
+		if !l.batch {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "IN clause with %d values exceeds the limit of %d values (max_in_clause_values)", n, l.maxValues)
+		}
+		if !routesSelectByList(plan, name) {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "IN clause with %d values exceeds the limit of %d values (max_in_clause_values), and cannot be batched because it does not route a SELECT by a vindex", n, l.maxValues)
+		}
+	}
+	return nil
+}
+
+// routesSelectByList returns true if the plan is a SELECT that routes by
+// the vindex values of the list bind variable name, which its routes can
+// send to every shard in batches.
+func routesSelectByList(plan *engine.Plan, name string) bool {
+	if plan.Type != sqlparser.StmtSelect || plan.Instructions == nil {
+		return false
+	}
+	return engine.Exists(func(p engine.Primitive) bool {
+		route, ok := p.(*engine.Route)
+		if !ok || route.Opcode != engine.IN || len(route.Values) == 0 {
+			return false
+		}
+		bv, ok := route.Values[0].(*evalengine.BindVariable)
+		return ok && bv.Key == name
+	}, plan.Instructions)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestNewInClauseLimit(t *testing.T) {
+	l, err := newInClauseLimit(0, "invalid")
+	require.NoError(t, err)
+	assert.Nil(t, l)
+	assert.Zero(t, l.batchSize())
+
+	l, err = newInClauseLimit(10, inClauseLimitReject)
+	require.NoError(t, err)
+	assert.Zero(t, l.batchSize())
+
+	l, err = newInClauseLimit(10, inClauseLimitBatch)
+	require.NoError(t, err)
+	assert.Equal(t, 10, l.batchSize())
+
+	_, err = newInClauseLimit(10, "invalid")
+	assert.EqualError(t, err, "invalid IN clause limit action \"invalid\", expected reject or batch")
+}
+
+func TestExecutorInClauseLimitReject(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.normalize = true
+	executor.inClauseLimit, _ = newInClauseLimit(3, inClauseLimitReject)
+	count := inClauseValues.Count()
+
+	_, err := executorExec(executor, "select id from user where id in (1, 2, 3)", nil)
+	require.NoError(t, err)
+	_, err = executorExec(executor, "select id from user where id in (1, 2, 3, 4)", nil)
+	require.EqualError(t, err, "IN clause with 4 values exceeds the limit of 3 values (max_in_clause_values)")
+	assert.Equal(t, count+2, inClauseValues.Count())
+
+	var plan *engine.Plan
+	executor.plans.ForEach(func(value any) bool {
+		plan = value.(*engine.Plan)
+		return true
+	})
+	require.NotNil(t, plan)
+	assert.EqualValues(t, 4, plan.MaxInClauseValues)
+}
+
+func TestExecutorInClauseLimitBatch(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	executor.normalize = true
+	executor.inClauseLimit, _ = newInClauseLimit(1, inClauseLimitBatch)
+
+	// 1 and 2 are both in the shard of sbc1, which gets them one at a time.
+	_, err := executorExec(executor, "select id from user where id in (1, 2)", nil)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Queries, 2)
+	for i, want := range []int64{1, 2} {
+		bvs := sbc1.Queries[i].BindVariables
+		assert.Equal(t, []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(want))}, bvs["__vals"].Values)
+		assert.NotContains(t, bvs, "vtg1")
+	}
+	assert.Empty(t, sbc2.Queries)
+
+	_, err = executorExec(executor, "delete from user where id in (1, 2)", nil)
+	require.EqualError(t, err, "IN clause with 2 values exceeds the limit of 1 values (max_in_clause_values), and cannot be batched because it does not route a SELECT by a vindex")
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	incidentModeMaxFanout   = flag.Int("incident_mode_max_fanout", 1, "in incident mode, the queries that target more shards than this are rejected")
+	incidentModeErrorRate   = flag.Float64("incident_mode_error_rate", 0, "the rate of shard queries that fail as unavailable, overloaded or timed out over incident_mode_error_window, from 0 to 1, above which incident mode activates. 0 disables the automatic activation")
+	incidentModeErrorWindow = flag.Duration("incident_mode_error_window", time.Minute, "the window over which the error rate of the shard queries is measured")
+	incidentModeMinQueries  = flag.Int("incident_mode_min_shard_queries", 100, "the number of shard queries over incident_mode_error_window below which the error rate does not activate incident mode")
+	incidentModeDuration    = flag.Duration("incident_mode_duration", 5*time.Minute, "how long incident mode stays active once the error rate turned it on")
+)
+
+const pathIncidentMode = "/debug/incident_mode"
+
+// incidentModeBuckets is the number of buckets of the error window.
+const incidentModeBuckets = 10
+
+var incidentModeRejections = stats.NewCountersWithSingleLabel("VtgateIncidentModeRejections", "Queries rejected by incident mode because of their fan-out, by keyspace", "Keyspace")
+
+// incidentMode sheds the most expensive load while the tablets are in
+// trouble: when it is active, the queries that fan out to more shards than
+// maxFanout are rejected, while the queries of fewer shards keep running.
+// It is activated by hand, or by the rate of the shard queries that fail
+// because the tablets are unavailable, overloaded or slow.
+type incidentMode struct {
+	maxFanout    int
+	errorRate    float64
+	window       time.Duration
+	minQueries   int
+	autoDuration time.Duration
+	now          func() time.Time
+	bucketLength time.Duration
+
+	mu          sync.Mutex
+	active      bool
+	reason      string
+	activeSince time.Time
+	// activeUntil is zero if incident mode stays active until it is
+	// deactivated by hand.
+	activeUntil time.Time
+	buckets     [incidentModeBuckets]incidentModeBucket
+}
+
+// incidentModeBucket counts the shard queries of a slice of the error window.
+type incidentModeBucket struct {
+	start   time.Time
+	queries int
+	errors  int
+}
+
+func newIncidentMode(maxFanout int, errorRate float64, window time.Duration, minQueries int, autoDuration time.Duration) *incidentMode {
+	return &incidentMode{
+		maxFanout:    maxFanout,
+		errorRate:    errorRate,
+		window:       window,
+		minQueries:   minQueries,
+		autoDuration: autoDuration,
+		now:          time.Now,
+		bucketLength: window / incidentModeBuckets,
+	}
+}
+
+// registerStats exports whether incident mode is active.
+func (im *incidentMode) registerStats() {
+	stats.NewGaugeFunc("VtgateIncidentMode", "1 if vtgate is in incident mode and rejects the queries with a large fan-out, 0 otherwise", func() int64 {
+		if active, _ := im.isActive(); active {
+			return 1
+		}
+		return 0
+	})
+}
+
+// isActive returns whether incident mode is active, and why.
+func (im *incidentMode) isActive() (bool, string) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.expireLocked()
+	return im.active, im.reason
+}
+
+// check rejects a query that targets more shards than the maximum fan-out
+// while incident mode is active. It does nothing on a nil incidentMode.
+func (im *incidentMode) check(rss []*srvtopo.ResolvedShard) error {
+	if im == nil || len(rss) <= im.maxFanout {
+		return nil
+	}
+	active, reason := im.isActive()
+	if !active {
+		return nil
+	}
+	keyspace := rss[0].Target.Keyspace
+	incidentModeRejections.Add(keyspace, 1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "vtgate is in incident mode (%s): queries to more than %d shards are rejected, and this query targets %d shards of %s", reason, im.maxFanout, len(rss), keyspace)
+}
+
+// observe records the outcome of the queries to a set of shards. It
+// activates incident mode when too many of the shard queries of the
+// error window failed because of the tablets. It does nothing on a nil
+// incidentMode, or if the automatic activation is disabled.
+func (im *incidentMode) observe(shards int, errs []error) {
+	if im == nil || im.errorRate <= 0 || shards == 0 {
+		return
+	}
+	failed := 0
+	for _, err := range errs {
+		if isIncidentError(err) {
+			failed++
+		}
+	}
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	now := im.now()
+	bucket := im.bucketLocked(now)
+	bucket.queries += shards
+	bucket.errors += failed
+	im.expireLocked()
+	if im.active || failed == 0 {
+		return
+	}
+	queries, errors := im.countLocked(now)
+	if queries < im.minQueries || float64(errors) < im.errorRate*float64(queries) {
+		return
+	}
+	reason := fmt.Sprintf("%d of %d shard queries failed in the last %v", errors, queries, im.window)
+	log.Warningf("vtgate enters incident mode for %v: %s", im.autoDuration, reason)
+	im.activateLocked(reason, im.autoDuration)
+}
+
+// isIncidentError returns true if an error of a shard query means that the
+// tablet could not serve it, rather than that the query is wrong.
+func isIncidentError(err error) bool {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_RESOURCE_EXHAUSTED, vtrpcpb.Code_DEADLINE_EXCEEDED:
+		return true
+	}
+	return false
+}
+
+// bucketLocked returns the bucket of a point in time, which it resets if it
+// counted an older slice of the window.
+func (im *incidentMode) bucketLocked(now time.Time) *incidentModeBucket {
+	start := now.Truncate(im.bucketLength)
+	bucket := &im.buckets[(start.UnixNano()/int64(im.bucketLength))%incidentModeBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = incidentModeBucket{start: start}
+	}
+	return bucket
+}
+
+// countLocked returns the number of shard queries of the error window, and
+// how many of them failed.
+func (im *incidentMode) countLocked(now time.Time) (queries, errors int) {
+	oldest := now.Add(-im.window)
+	for _, bucket := range im.buckets {
+		if bucket.start.After(oldest) {
+			queries += bucket.queries
+			errors += bucket.errors
+		}
+	}
+	return queries, errors
+}
+
+// activate turns incident mode on, for a duration, or until it is
+// deactivated if the duration is 0.
+func (im *incidentMode) activate(reason string, duration time.Duration) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	log.Warningf("vtgate enters incident mode: %s", reason)
+	im.activateLocked(reason, duration)
+}
+
+func (im *incidentMode) activateLocked(reason string, duration time.Duration) {
+	now := im.now()
+	if !im.active {
+		im.activeSince = now
+	}
+	im.active = true
+	im.reason = reason
+	im.activeUntil = time.Time{}
+	if duration > 0 {
+		im.activeUntil = now.Add(duration)
+	}
+}
+
+// deactivate turns incident mode off. It forgets the errors of the window,
+// so that they do not turn it on again right away.
+func (im *incidentMode) deactivate() {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	if im.active {
+		log.Infof("vtgate leaves incident mode")
+	}
+	im.active = false
+	im.reason = ""
+	im.buckets = [incidentModeBuckets]incidentModeBucket{}
+}
+
+// expireLocked turns incident mode off once its duration is over. The
+// errors of the window are kept: if the tablets still fail, the next
+// errors activate it again.
+func (im *incidentMode) expireLocked() {
+	if im.active && !im.activeUntil.IsZero() && !im.now().Before(im.activeUntil) {
+		log.Infof("vtgate leaves incident mode after %v", im.activeUntil.Sub(im.activeSince))
+		im.active = false
+		im.reason = ""
+	}
+}
+
+// IncidentModeController changes the incident mode of vtgate, for the
+// servers of the admin RPCs.
+type IncidentModeController interface {
+	// IncidentModeStatus reports the state of incident mode.
+	IncidentModeStatus() *IncidentModeReport
+	// ActivateIncidentMode turns incident mode on, for a duration, or until
+	// it is deactivated if the duration is 0.
+	ActivateIncidentMode(reason string, duration time.Duration)
+	// DeactivateIncidentMode turns incident mode off.
+	DeactivateIncidentMode()
+}
+
+// IncidentModeStatus is part of the IncidentModeController interface.
+func (vtg *VTGate) IncidentModeStatus() *IncidentModeReport {
+	return vtg.executor.incidents.report()
+}
+
+// ActivateIncidentMode is part of the IncidentModeController interface.
+func (vtg *VTGate) ActivateIncidentMode(reason string, duration time.Duration) {
+	vtg.executor.incidents.activate(reason, duration)
+}
+
+// DeactivateIncidentMode is part of the IncidentModeController interface.
+func (vtg *VTGate) DeactivateIncidentMode() {
+	vtg.executor.incidents.deactivate()
+}
+
+// IncidentModeReport is the body of /debug/incident_mode.
+type IncidentModeReport struct {
+	Active bool
+	Reason string `json:",omitempty"`
+	// AgeSeconds is how long incident mode has been active, and
+	// RemainingSeconds how long it stays active, 0 if it stays active
+	// until it is deactivated.
+	AgeSeconds       float64 `json:",omitempty"`
+	RemainingSeconds float64 `json:",omitempty"`
+	MaxFanout        int
+	// ShardQueries and ShardErrors are counted over the error window, if
+	// the error rate activates incident mode.
+	ErrorRateThreshold float64
+	ShardQueries       int
+	ShardErrors        int
+}
+
+func (im *incidentMode) report() *IncidentModeReport {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.expireLocked()
+	now := im.now()
+	report := &IncidentModeReport{
+		Active:             im.active,
+		Reason:             im.reason,
+		MaxFanout:          im.maxFanout,
+		ErrorRateThreshold: im.errorRate,
+	}
+	if im.active {
+		report.AgeSeconds = now.Sub(im.activeSince).Seconds()
+		if !im.activeUntil.IsZero() {
+			report.RemainingSeconds = im.activeUntil.Sub(now).Seconds()
+		}
+	}
+	if im.errorRate > 0 {
+		report.ShardQueries, report.ShardErrors = im.countLocked(now)
+	}
+	return report
+}
+
+// serveIncidentMode reports the state of incident mode. A POST with
+// action=activate turns it on, for the optional duration, or until a POST
+// with action=deactivate turns it off.
+func (im *incidentMode) serveIncidentMode(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodPost {
+		if err := acl.CheckAccessHTTP(req, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		switch action := req.FormValue("action"); action {
+		case "activate":
+			var duration time.Duration
+			if value := req.FormValue("duration"); value != "" {
+				var err error
+				if duration, err = time.ParseDuration(value); err != nil || duration < 0 {
+					http.Error(w, fmt.Sprintf("invalid duration %q", value), http.StatusBadRequest)
+					return
+				}
+			}
+			im.activate("activated by hand", duration)
+		case "deactivate":
+			im.deactivate()
+		default:
+			http.Error(w, fmt.Sprintf("unknown action %q, expected activate or deactivate", action), http.StatusBadRequest)
+			return
+		}
+	} else if err := acl.CheckAccessHTTP(req, acl.MONITORING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", jsonContentType)
+	if err := json.NewEncoder(w).Encode(im.report()); err != nil {
+		log.Errorf("can't write the incident mode report: %v", err)
+	}
+}
+
+func (im *incidentMode) registerHandlers() {
+	http.HandleFunc(pathIncidentMode, im.serveIncidentMode)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func incidentShards(keyspace string, count int) []*srvtopo.ResolvedShard {
+	rss := make([]*srvtopo.ResolvedShard, count)
+	for i := range rss {
+		rss[i] = &srvtopo.ResolvedShard{Target: &querypb.Target{Keyspace: keyspace}}
+	}
+	return rss
+}
+
+func TestIncidentModeCheck(t *testing.T) {
+	var nilMode *incidentMode
+	assert.NoError(t, nilMode.check(incidentShards("ks", 8)))
+	nilMode.observe(8, nil)
+
+	im := newIncidentMode(2, 0, time.Minute, 100, time.Minute)
+	assert.NoError(t, im.check(incidentShards("ks", 8)))
+
+	rejected := incidentModeRejections.Counts()["ks"]
+	im.activate("activated by hand", 0)
+	assert.NoError(t, im.check(incidentShards("ks", 1)))
+	assert.NoError(t, im.check(incidentShards("ks", 2)))
+	err := im.check(incidentShards("ks", 3))
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "vtgate is in incident mode (activated by hand): queries to more than 2 shards are rejected, and this query targets 3 shards of ks")
+	assert.EqualValues(t, 1, incidentModeRejections.Counts()["ks"]-rejected)
+
+	im.deactivate()
+	assert.NoError(t, im.check(incidentShards("ks", 8)))
+}
+
+func TestIncidentModeErrorRate(t *testing.T) {
+	im := newIncidentMode(1, 0.5, time.Minute, 10, 5*time.Minute)
+	now := time.Now()
+	im.now = func() time.Time { return now }
+	unavailable := vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet")
+
+	// the errors of the queries do not count
+	im.observe(20, []error{vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error")})
+	active, _ := im.isActive()
+	assert.False(t, active)
+
+	// too few shard queries to activate
+	im = newIncidentMode(1, 0.5, time.Minute, 10, 5*time.Minute)
+	im.now = func() time.Time { return now }
+	im.observe(4, []error{unavailable, unavailable, unavailable})
+	active, _ = im.isActive()
+	assert.False(t, active)
+
+	// the old errors leave the window
+	now = now.Add(2 * time.Minute)
+	im.observe(8, []error{unavailable, unavailable})
+	active, _ = im.isActive()
+	assert.False(t, active)
+
+	im.observe(4, []error{unavailable, unavailable, unavailable, unavailable})
+	active, reason := im.isActive()
+	assert.True(t, active)
+	assert.Equal(t, "6 of 12 shard queries failed in the last 1m0s", reason)
+
+	// the automatic activation expires
+	now = now.Add(5 * time.Minute)
+	active, _ = im.isActive()
+	assert.False(t, active)
+
+	// a deactivation forgets the errors
+	im.activate("activated by hand", 0)
+	im.deactivate()
+	im.observe(1, []error{unavailable})
+	active, _ = im.isActive()
+	assert.False(t, active)
+	report := im.report()
+	assert.Equal(t, 1, report.ShardQueries)
+	assert.Equal(t, 1, report.ShardErrors)
+}
+
+func getIncidentModeReport(t *testing.T, im *incidentMode, method, url string) (int, *IncidentModeReport) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	im.serveIncidentMode(w, httptest.NewRequest(method, url, nil))
+	report := &IncidentModeReport{}
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), report))
+	}
+	return w.Code, report
+}
+
+func TestIncidentModeEndpoint(t *testing.T) {
+	im := newIncidentMode(4, 0, time.Minute, 100, time.Minute)
+	now := time.Now()
+	im.now = func() time.Time { return now }
+
+	code, report := getIncidentModeReport(t, im, http.MethodGet, pathIncidentMode)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, &IncidentModeReport{MaxFanout: 4}, report)
+
+	code, report = getIncidentModeReport(t, im, http.MethodPost, pathIncidentMode+"?action=activate&duration=10m")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, report.Active)
+	assert.Equal(t, "activated by hand", report.Reason)
+	assert.EqualValues(t, 600, report.RemainingSeconds)
+
+	now = now.Add(time.Minute)
+	_, report = getIncidentModeReport(t, im, http.MethodGet, pathIncidentMode)
+	assert.EqualValues(t, 60, report.AgeSeconds)
+	assert.EqualValues(t, 540, report.RemainingSeconds)
+
+	code, report = getIncidentModeReport(t, im, http.MethodPost, pathIncidentMode+"?action=deactivate")
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, report.Active)
+
+	code, _ = getIncidentModeReport(t, im, http.MethodPost, pathIncidentMode+"?action=activate&duration=soon")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = getIncidentModeReport(t, im, http.MethodPost, pathIncidentMode+"?action=panic")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestIncidentModeExecutor(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	executor.incidents = newIncidentMode(1, 0, time.Minute, 100, time.Minute)
+	executor.incidents.activate("activated by hand", 0)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+
+	// the single shard queries run
+	_, err := executor.Execute(context.Background(), "TestIncidentMode", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Len(t, sbc1.Queries, 1)
+
+	// the scatter queries are rejected before they reach the tablets
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", session, "select id from user", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vtgate is in incident mode")
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+
+	err = executor.StreamExecute(context.Background(), "TestIncidentMode", session, "select id from user", nil, func(*sqltypes.Result) error { return nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vtgate is in incident mode")
+
+	// the scatter DMLs, and the statements of a transaction open on a shard, run
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", session, "update user set a = 1", nil)
+	require.NoError(t, err)
+	txSession := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", txSession, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", txSession, "select id from user", nil)
+	require.Error(t, err)
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", txSession, "update user set a = 1 where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", txSession, "select id from user", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", txSession, "rollback", nil)
+	require.NoError(t, err)
+
+	executor.incidents.deactivate()
+	_, err = executor.Execute(context.Background(), "TestIncidentMode", session, "select id from user", nil)
+	require.NoError(t, err)
+}
+
+func TestVTGateIncidentModeController(t *testing.T) {
+	var controller IncidentModeController = &VTGate{executor: &Executor{incidents: newIncidentMode(1, 0, time.Minute, 100, time.Minute)}}
+	assert.False(t, controller.IncidentModeStatus().Active)
+	controller.ActivateIncidentMode("activated by hand", time.Minute)
+	report := controller.IncidentModeStatus()
+	assert.True(t, report.Active)
+	assert.Equal(t, "activated by hand", report.Reason)
+	assert.Greater(t, report.RemainingSeconds, 0.0)
+	controller.DeactivateIncidentMode()
+	assert.False(t, controller.IncidentModeStatus().Active)
+}
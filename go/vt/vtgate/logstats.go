@@ -54,6 +54,14 @@ type LogStats struct {
 	ExecuteTime   time.Duration
 	CommitTime    time.Duration
 	Error         error
+	// TransactionID is the id of the transaction the statement ran in,
+	// or ended. TxOutcome is how the statement ended the transaction,
+	// if it did, and DTID is the dtid of its 2PC commit.
+	TransactionID string
+	TxOutcome     string
+	DTID          string
+	// QueryTags are the tags of the session, set with @@vitess_query_tags.
+	QueryTags string
 }
 
 // NewLogStats constructs a new LogStats with supplied Method and ctx
@@ -74,6 +82,19 @@ func (stats *LogStats) Send() {
 	QueryLogger.Send(stats)
 }
 
+// setTransaction annotates the record with the transaction of the session:
+// the transaction that the statement ended, and how, or else the transaction
+// the statement ran in, if any.
+func (stats *LogStats) setTransaction(session *SafeSession) {
+	if endedTx := session.takeEndedTx(); endedTx != nil {
+		stats.TransactionID = endedTx.transactionID
+		stats.TxOutcome = endedTx.outcome
+		stats.DTID = endedTx.dtid
+		return
+	}
+	stats.TransactionID = session.GetTransactionUUID()
+}
+
 // Context returns the context used by LogStats.
 func (stats *LogStats) Context() context.Context {
 	return stats.Ctx
@@ -154,9 +175,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t%q\t%q\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q}\n"
+		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q, \"TransactionID\": %q, \"TxOutcome\": %q, \"DTID\": %q, \"QueryTags\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -182,6 +203,10 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.Keyspace,
 		stats.Table,
 		stats.TabletType,
+		stats.TransactionID,
+		stats.TxOutcome,
+		stats.DTID,
+		stats.QueryTags,
 	)
 	return err
 }
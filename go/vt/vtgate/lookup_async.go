@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// asyncLookupMethod is the method under which the asynchronous writes of
+// the lookup vindexes are executed and logged.
+const asyncLookupMethod = "AsyncLookup"
+
+var asyncLookupWrites = stats.NewCountersWithMultiLabels(
+	"VtgateAsyncLookupWrites",
+	"Number of the writes of lookup vindexes consumed from their async queue, by outcome: applied or error",
+	[]string{"Keyspace", "Queue", "Result"})
+
+// asyncLookupApplier applies the writes that the lookup vindexes with an
+// async_queue enqueue into their message table. Every vtgate consumes the
+// queues, and the tablets spread the messages between them. The writes are
+// acked once they are applied; the ones that fail are delivered again after
+// the ack wait of the queue, so that they can be applied after later writes
+// of the same values. A nil asyncLookupApplier applies nothing.
+type asyncLookupApplier struct {
+	executor   *Executor
+	ctx        context.Context
+	retryDelay time.Duration
+
+	mu sync.Mutex
+	// vschema is the last vschema of refresh, whose vindexes build the
+	// statements of the writes.
+	vschema *vindexes.VSchema
+	// streams is keyed by the qualified name of the queue, <keyspace>.<table>.
+	streams map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// newAsyncLookupApplier returns an applier whose streams run until ctx
+// is done. A stream that fails is restarted after retryDelay.
+func newAsyncLookupApplier(ctx context.Context, executor *Executor, retryDelay time.Duration) *asyncLookupApplier {
+	return &asyncLookupApplier{
+		executor:   executor,
+		ctx:        ctx,
+		retryDelay: retryDelay,
+		streams:    make(map[string]context.CancelFunc),
+	}
+}
+
+// refresh starts consuming the queues of the vschema that are not consumed
+// yet, and stops consuming the ones that are gone.
+func (a *asyncLookupApplier) refresh(vschema *vindexes.VSchema) {
+	if a == nil {
+		return
+	}
+	queues := asyncLookupQueues(vschema)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.vschema = vschema
+	for queue, cancel := range a.streams {
+		if !queues[queue] {
+			cancel()
+			delete(a.streams, queue)
+		}
+	}
+	for queue := range queues {
+		if _, ok := a.streams[queue]; ok {
+			continue
+		}
+		ctx, cancel := context.WithCancel(a.ctx)
+		a.streams[queue] = cancel
+		parts := strings.SplitN(queue, ".", 2)
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			a.consume(ctx, parts[0], parts[1])
+		}()
+	}
+}
+
+// stop stops consuming the queues, and waits for the writes being applied.
+func (a *asyncLookupApplier) stop() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	for queue, cancel := range a.streams {
+		cancel()
+		delete(a.streams, queue)
+	}
+	a.mu.Unlock()
+	a.wg.Wait()
+}
+
+// consume streams the messages of the queue until ctx is done.
+func (a *asyncLookupApplier) consume(ctx context.Context, keyspace, table string) {
+	queue := keyspace + "." + table
+	for {
+		idIdx, messageIdx := -1, -1
+		err := a.executor.MessageStream(ctx, keyspace, "", &topodatapb.KeyRange{}, table, func(qr *sqltypes.Result) error {
+			if qr.Fields != nil {
+				for i, field := range qr.Fields {
+					switch field.Name {
+					case "id":
+						idIdx = i
+					case "message":
+						messageIdx = i
+					}
+				}
+				if idIdx == -1 || messageIdx == -1 {
+					return fmt.Errorf("async queue %s must have an id and a message column", queue)
+				}
+			}
+			a.apply(ctx, keyspace, table, qr.Rows, idIdx, messageIdx)
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Stream of the async lookup queue %s ended, restarting it in %v: %v", queue, a.retryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(a.retryDelay):
+		}
+	}
+}
+
+// apply executes the writes of the messages in the order of their ids, which
+// is the order they were enqueued in, and acks the ones that succeeded. The
+// statement of a write is built by its vindex, from the values of the
+// message. The
+// writes that follow one that failed are not applied either, so that they are
+// delivered again together with it rather than applied before it.
+func (a *asyncLookupApplier) apply(ctx context.Context, keyspace, table string, rows []sqltypes.Row, idIdx, messageIdx int) {
+	if len(rows) == 0 {
+		return
+	}
+	queue := keyspace + "." + table
+	sort.SliceStable(rows, func(i, j int) bool {
+		idi, _ := evalengine.ToInt64(rows[i][idIdx])
+		idj, _ := evalengine.ToInt64(rows[j][idIdx])
+		return idi < idj
+	})
+	a.mu.Lock()
+	vschema := a.vschema
+	a.mu.Unlock()
+	var ids []*querypb.Value
+	for _, row := range rows {
+		query, bindVars, err := asyncLookupWriteQuery(vschema, queue, row[messageIdx].ToString())
+		if err == nil {
+			session := NewAutocommitSession(&vtgatepb.Session{Autocommit: true})
+			_, err = a.executor.Execute(ctx, asyncLookupMethod, session, query, bindVars)
+		}
+		if err != nil {
+			log.Warningf("Cannot apply the write %v of the async lookup queue %s: %v", row[idIdx], queue, err)
+			asyncLookupWrites.Add([]string{keyspace, table, "error"}, 1)
+			break
+		}
+		asyncLookupWrites.Add([]string{keyspace, table, "applied"}, 1)
+		ids = append(ids, sqltypes.ValueToProto(row[idIdx]))
+	}
+	if len(ids) == 0 {
+		return
+	}
+	session := NewAutocommitSession(&vtgatepb.Session{Autocommit: true})
+	ack := fmt.Sprintf("update %s set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null", queue)
+	if _, err := a.executor.Execute(ctx, asyncLookupMethod, session, ack, map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"ids":        {Type: querypb.Type_TUPLE, Values: ids},
+	}); err != nil {
+		// The writes will be applied again, which they can be.
+		log.Warningf("Cannot ack the writes of the async lookup queue %s: %v", queue, err)
+	}
+}
+
+// asyncLookupWriteQuery returns the statement that applies the write of the
+// message, built by the vindex of the write among the ones that use the
+// queue.
+func asyncLookupWriteQuery(vschema *vindexes.VSchema, queue, message string) (string, map[string]*querypb.BindVariable, error) {
+	var write vindexes.AsyncWrite
+	if err := json.Unmarshal([]byte(message), &write); err != nil {
+		return "", nil, fmt.Errorf("invalid async lookup write: %v", err)
+	}
+	var lookup vindexes.LookupAsync
+	if vschema != nil {
+		for keyspace, ks := range vschema.Keyspaces {
+			candidate, ok := ks.Vindexes[write.Vindex].(vindexes.LookupAsync)
+			if !ok || asyncLookupQueue(vschema, keyspace, write.Vindex, candidate) != queue {
+				continue
+			}
+			if lookup != nil {
+				return "", nil, fmt.Errorf("more than one vindex %s uses the async queue %s", write.Vindex, queue)
+			}
+			lookup = candidate
+		}
+	}
+	if lookup == nil {
+		return "", nil, fmt.Errorf("no vindex %s uses the async queue %s", write.Vindex, queue)
+	}
+	return lookup.AsyncWriteQuery(&write)
+}
+
+// asyncLookupQueues returns the async queues of the lookup vindexes of the
+// vschema, qualified by their keyspace.
+func asyncLookupQueues(vschema *vindexes.VSchema) map[string]bool {
+	queues := make(map[string]bool)
+	if vschema == nil {
+		return queues
+	}
+	for keyspace, ks := range vschema.Keyspaces {
+		for name, vindex := range ks.Vindexes {
+			lookup, ok := vindex.(vindexes.LookupAsync)
+			if !ok {
+				continue
+			}
+			if queue := asyncLookupQueue(vschema, keyspace, name, lookup); queue != "" {
+				queues[queue] = true
+			}
+		}
+	}
+	return queues
+}
+
+// asyncLookupQueue returns the async queue of the vindex, qualified by its
+// keyspace, or "" if it has none. An unqualified queue is looked up by its
+// name in the vschema.
+func asyncLookupQueue(vschema *vindexes.VSchema, keyspace, name string, lookup vindexes.LookupAsync) string {
+	queue := lookup.AsyncQueue()
+	if queue == "" || strings.Contains(queue, ".") {
+		return queue
+	}
+	table, err := vschema.FindTable("", queue)
+	if err != nil || table == nil {
+		log.Warningf("Cannot find the async queue %s of vindex %s.%s: %v", queue, keyspace, name, err)
+		return ""
+	}
+	return table.Keyspace.Name + "." + queue
+}
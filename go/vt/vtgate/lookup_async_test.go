@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func asyncLookupVSchema(queue string) *vindexes.VSchema {
+	return asyncLookupTableVSchema(queue, "uks.lkp", "c", "keyspace_id")
+}
+
+func asyncLookupTableVSchema(queue, table, from, to string) *vindexes.VSchema {
+	return vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"lkp": {
+						Type: "consistent_lookup",
+						Params: map[string]string{
+							"table":       table,
+							"from":        from,
+							"to":          to,
+							"async_queue": queue,
+						},
+					},
+				},
+			},
+			"uks": {
+				Tables: map[string]*vschemapb.Table{
+					"lkp":   {},
+					"queue": {},
+				},
+			},
+		},
+	})
+}
+
+func TestAsyncLookupQueues(t *testing.T) {
+	assert.Equal(t, map[string]bool{"other.queue": true}, asyncLookupQueues(asyncLookupVSchema("other.queue")))
+	assert.Equal(t, map[string]bool{"uks.queue": true}, asyncLookupQueues(asyncLookupVSchema("queue")))
+	assert.Empty(t, asyncLookupQueues(asyncLookupVSchema("missing")))
+	assert.Empty(t, asyncLookupQueues(asyncLookupVSchema("")))
+	assert.Empty(t, asyncLookupQueues(nil))
+}
+
+func TestAsyncLookupApply(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	applier := newAsyncLookupApplier(ctx, executor, time.Hour)
+	queue := KsTestUnsharded + ".q"
+	applied := asyncLookupWrites.Counts()[queue+".applied"]
+	failed := asyncLookupWrites.Counts()[queue+".error"]
+
+	insert := `{"vindex":"lkp","op":"insert","from":[{"type":265,"value":"MQ=="}],"to":{"type":265,"value":"Mg=="}}`
+	sbclookup.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "message", Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(5), sqltypes.NewVarChar(`{"vindex":"lkp","op":"delete","from":[{"type":265,"value":"MQ=="}],"to":{"type":265,"value":"Mg=="}}`)},
+			{sqltypes.NewInt64(3), sqltypes.NewVarChar(`{"vindex":"other","op":"insert"}`)},
+			{sqltypes.NewInt64(1), sqltypes.NewVarChar(insert)},
+		},
+	}})
+	vschema := asyncLookupTableVSchema(queue, "music_user_map", "music_id", "user_id")
+	applier.refresh(vschema)
+
+	// The writes are applied in the order of their ids. The write that failed
+	// is not acked, so that it is delivered again, and neither is the one that
+	// follows it, so that it is not applied before it.
+	require.Eventually(t, func() bool {
+		return sbclookup.ExecCount.Get() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	applier.refresh(asyncLookupVSchema(""))
+	assert.Empty(t, applier.streams)
+	applier.stop()
+	assert.Equal(t, "insert into music_user_map(music_id, user_id) values (:music_id_0, :user_id_0) on duplicate key update music_id = values(music_id), user_id = values(user_id)", sbclookup.Queries[0].Sql)
+	assert.Equal(t, "update q set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null", sbclookup.Queries[1].Sql)
+	assert.Equal(t, []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(1))}, sbclookup.Queries[1].BindVariables["ids"].Values)
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+	assert.EqualValues(t, applied+1, asyncLookupWrites.Counts()[queue+".applied"])
+	assert.EqualValues(t, failed+1, asyncLookupWrites.Counts()[queue+".error"])
+
+	// A message can only write the lookup table of a vindex of its queue.
+	_, _, err := asyncLookupWriteQuery(vschema, queue, `insert into t values (1)`)
+	assert.Error(t, err)
+	_, _, err = asyncLookupWriteQuery(vschema, "other.q", insert)
+	assert.EqualError(t, err, "no vindex lkp uses the async queue other.q")
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// lookupTombstonePurgeMethod is the method under which the tombstones of
+// the lookup vindexes are purged and logged.
+const lookupTombstonePurgeMethod = "LookupTombstonePurge"
+
+var lookupTombstonesPurged = stats.NewCountersWithMultiLabels(
+	"VtgateLookupTombstonesPurged",
+	"Number of the tombstones of lookup vindexes purged after their tombstone_ttl",
+	[]string{"Keyspace", "Vindex"})
+
+// lookupTombstonePurger periodically deletes the tombstones that the lookup
+// vindexes with a tombstone_ttl keep in their lookup table, once they are
+// older than the ttl. A nil lookupTombstonePurger purges nothing.
+type lookupTombstonePurger struct {
+	executor *Executor
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newLookupTombstonePurger returns a purger that runs every interval, or nil
+// if the interval is zero.
+func newLookupTombstonePurger(executor *Executor, interval time.Duration) *lookupTombstonePurger {
+	if interval <= 0 {
+		return nil
+	}
+	return &lookupTombstonePurger{
+		executor: executor,
+		interval: interval,
+	}
+}
+
+// start starts purging the tombstones until ctx is done or stop is called.
+func (p *lookupTombstonePurger) start(ctx context.Context) {
+	if p == nil {
+		return
+	}
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.purge(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// stop stops purging the tombstones, and waits for the purge in progress.
+func (p *lookupTombstonePurger) stop() {
+	if p == nil || p.cancel == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+}
+
+// purge deletes the tombstones of the vindexes of the vschema that are
+// older than their ttl at now.
+func (p *lookupTombstonePurger) purge(ctx context.Context, now time.Time) {
+	vschema := p.executor.VSchema()
+	if vschema == nil {
+		return
+	}
+	keyspaces := make([]string, 0, len(vschema.Keyspaces))
+	for keyspace := range vschema.Keyspaces {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	for _, keyspace := range keyspaces {
+		ks := vschema.Keyspaces[keyspace]
+		names := make([]string, 0, len(ks.Vindexes))
+		for name := range ks.Vindexes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			lookup, ok := ks.Vindexes[name].(vindexes.LookupTombstone)
+			if !ok || lookup.TombstonePurgeQuery() == "" {
+				continue
+			}
+			session := NewAutocommitSession(&vtgatepb.Session{Autocommit: true})
+			qr, err := p.executor.Execute(ctx, lookupTombstonePurgeMethod, session, lookup.TombstonePurgeQuery(), map[string]*querypb.BindVariable{
+				"before": sqltypes.Int64BindVariable(now.Add(-lookup.TombstoneTTL()).Unix()),
+			})
+			if err != nil {
+				log.Warningf("Cannot purge the tombstones of the lookup vindex %s.%s: %v", keyspace, name, err)
+				continue
+			}
+			lookupTombstonesPurged.Add([]string{keyspace, name}, int64(qr.RowsAffected))
+		}
+	}
+}
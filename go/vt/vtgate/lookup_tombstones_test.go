@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestLookupTombstonePurge(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	executor.SaveVSchema(vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			KsTestSharded: {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"lkp": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table":         KsTestUnsharded + ".lkp",
+							"from":          "c",
+							"to":            "keyspace_id",
+							"tombstone_ttl": "1h",
+						},
+					},
+					"nolkp": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": KsTestUnsharded + ".nolkp",
+							"from":  "c",
+							"to":    "keyspace_id",
+						},
+					},
+				},
+			},
+			KsTestUnsharded: {
+				Tables: map[string]*vschemapb.Table{
+					"lkp":   {},
+					"nolkp": {},
+				},
+			},
+		},
+	}), nil)
+	purged := lookupTombstonesPurged.Counts()[KsTestSharded+".lkp"]
+	sbclookup.SetResults([]*sqltypes.Result{{RowsAffected: 3}})
+
+	now := time.Now()
+	purger := newLookupTombstonePurger(executor, time.Hour)
+	purger.purge(context.Background(), now)
+
+	require.Len(t, sbclookup.Queries, 1)
+	assert.Equal(t, "delete from lkp where tombstoned_at < :before", sbclookup.Queries[0].Sql)
+	assert.Equal(t, sqltypes.Int64BindVariable(now.Add(-time.Hour).Unix()), sbclookup.Queries[0].BindVariables["before"])
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+	assert.EqualValues(t, purged+3, lookupTombstonesPurged.Counts()[KsTestSharded+".lkp"])
+
+	// The purger is disabled with a zero interval.
+	assert.Nil(t, newLookupTombstonePurger(executor, 0))
+	purger.start(context.Background())
+	purger.stop()
+}
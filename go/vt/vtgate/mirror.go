@@ -0,0 +1,385 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// mirrorMethod is the method under which the mirrored queries are executed and logged.
+const mirrorMethod = "Mirror"
+
+var (
+	mirrorQueries = stats.NewCountersWithMultiLabels(
+		"VtgateMirrorQueries",
+		"Number of queries mirrored to a shadow keyspace, by the outcome of the mirrored query: ok, error, dropped, or routed for the DMLs whose tables the routing rules route to another keyspace",
+		[]string{"Table", "Result"})
+	mirrorLatencies = stats.NewMultiTimings(
+		"VtgateMirrorLatencies",
+		"Latencies of the mirrored queries, on the source keyspace (source) and on the shadow keyspace (shadow)",
+		[]string{"Table", "Target"})
+	mirrorSlowerQueries = stats.NewCountersWithSingleLabel(
+		"VtgateMirrorSlowerQueries",
+		"Number of mirrored queries that were slower on the shadow keyspace than on the source keyspace",
+		"Table")
+	mirrorDivergences = stats.NewCountersWithMultiLabels(
+		"VtgateMirrorDivergences",
+		"Number of mirrored queries whose outcome differs between the source and the shadow keyspaces: only one of them failed (error), or their SELECTs returned different numbers of rows (rows)",
+		[]string{"Table", "Kind"})
+)
+
+// mirrorRule mirrors a percentage of the queries of a table to a shadow keyspace.
+type mirrorRule struct {
+	shadowKeyspace string
+	percent        int
+	// writes also mirrors the DMLs.
+	writes bool
+	// diff compares the checksums of the results of the SELECTs.
+	diff bool
+}
+
+// mirrorSource is the outcome of the original query, that the outcome of
+// the mirrored query is compared with.
+type mirrorSource struct {
+	latency time.Duration
+	rows    int
+	// checksum is only computed when a rule diffs the results.
+	checksum *resultChecksum
+	err      error
+}
+
+// mirrorer duplicates a percentage of the queries that use selected tables
+// to a shadow keyspace, for instance the target keyspace of a resharding or
+// a keyspace running on a newer version of MySQL. The mirrored queries run
+// asynchronously and their results are discarded. Only their latencies are
+// recorded, next to the latencies of the original queries, so that they can
+// be compared before the traffic is cut over. A nil mirrorer mirrors nothing.
+type mirrorer struct {
+	executor *Executor
+	// rules is keyed by the qualified name of the table, <keyspace>.<table>.
+	rules   map[string]*mirrorRule
+	timeout time.Duration
+	// inflight holds a token for every mirrored query that is running.
+	// Queries are dropped when it is full, they never wait for a slot.
+	inflight chan struct{}
+	wg       sync.WaitGroup
+
+	// mismatches are the last mirrored queries whose results differed.
+	mu         sync.Mutex
+	mismatches []*mirrorMismatch
+}
+
+// newMirrorer parses rules of the form <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff],...
+// It returns nil if there is no rule.
+func newMirrorer(executor *Executor, spec string, timeout time.Duration, maxInflight int) (*mirrorer, error) {
+	m := &mirrorer{
+		executor: executor,
+		rules:    make(map[string]*mirrorRule),
+		timeout:  timeout,
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mirror rule %q, expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]", entry)
+		}
+		table, target := parts[0], parts[1]
+		if tableParts := strings.Split(table, "."); len(tableParts) != 2 || tableParts[0] == "" || tableParts[1] == "" {
+			return nil, fmt.Errorf("invalid mirror rule %q, expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]", entry)
+		}
+		parts = strings.Split(target, ":")
+		rule := &mirrorRule{shadowKeyspace: parts[0]}
+		for len(parts) > 2 {
+			switch parts[len(parts)-1] {
+			case "writes":
+				rule.writes = true
+			case "diff":
+				rule.diff = true
+			default:
+				return nil, fmt.Errorf("invalid mirror rule %q, expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]", entry)
+			}
+			parts = parts[:len(parts)-1]
+		}
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid mirror rule %q, expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]", entry)
+		}
+		percent, err := strconv.Atoi(parts[1])
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid percent %q in mirror rule, expected a number between 0 and 100", parts[1])
+		}
+		if _, ok := m.rules[table]; ok {
+			return nil, fmt.Errorf("duplicate mirror rule for table %s", table)
+		}
+		if percent > 0 {
+			rule.percent = percent
+			m.rules[table] = rule
+		}
+	}
+	if len(m.rules) == 0 {
+		return nil, nil
+	}
+	if timeout <= 0 || maxInflight <= 0 {
+		return nil, fmt.Errorf("invalid mirror settings: the query timeout (%v) and the max inflight queries (%d) must be positive", timeout, maxInflight)
+	}
+	m.inflight = make(chan struct{}, maxInflight)
+	return m, nil
+}
+
+// mirrorQuery is a query that is selected to be mirrored to a shadow keyspace.
+// It is selected before the original query runs, so that the results of the
+// original query are only checksummed when its mirrored query diffs them.
+type mirrorQuery struct {
+	m          *mirrorer
+	rule       *mirrorRule
+	table      string
+	isRead     bool
+	sql        string
+	shadowSQL  string
+	tabletType topodatapb.TabletType
+}
+
+// prepare returns the mirrored query of a query that uses a mirrored table,
+// if it is selected by the percentage of its rule, or nil. Only the SELECT
+// and DML statements that are executed outside of a transaction are mirrored,
+// one table at a time: if a query uses several mirrored tables, the rule of
+// the first one is used. The DMLs are only mirrored by the rules that mirror
+// the writes, and if the routing rules route neither the source tables nor
+// the shadow ones to another keyspace, where the mirrored DML would be applied
+// twice.
+func (m *mirrorer) prepare(safeSession *SafeSession, sql string) *mirrorQuery {
+	if m == nil || safeSession.InTransaction() || safeSession.InReservedConn() {
+		return nil
+	}
+	isRead := false
+	switch sqlparser.Preview(sql) {
+	case sqlparser.StmtSelect:
+		isRead = true
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+	default:
+		return nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil
+	}
+	defaultKeyspace, tabletType, _, err := m.executor.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil {
+		return nil
+	}
+
+	var table, sourceKeyspace string
+	var rule *mirrorRule
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableName, ok := node.(sqlparser.TableName)
+		if !ok || rule != nil {
+			return true, nil
+		}
+		keyspace := m.tableKeyspace(tableName, defaultKeyspace)
+		qualified := keyspace + "." + tableName.Name.String()
+		if r, ok := m.rules[qualified]; ok {
+			table, sourceKeyspace, rule = qualified, keyspace, r
+		}
+		return true, nil
+	}, stmt)
+	if rule == nil || (!rule.writes && !isRead) || rand.Intn(100) >= rule.percent {
+		return nil
+	}
+	if !isRead && m.routedAway(stmt, defaultKeyspace, sourceKeyspace, rule.shadowKeyspace) {
+		mirrorQueries.Add([]string{table, "routed"}, 1)
+		return nil
+	}
+
+	// The tables of the source keyspace are moved to the shadow keyspace,
+	// and the unqualified tables are resolved in the shadow keyspace.
+	shadowStmt := sqlparser.Rewrite(sqlparser.CloneStatement(stmt), func(cursor *sqlparser.Cursor) bool {
+		if tableName, ok := cursor.Node().(sqlparser.TableName); ok && tableName.Qualifier.String() == sourceKeyspace {
+			tableName.Qualifier = sqlparser.NewTableIdent(rule.shadowKeyspace)
+			cursor.Replace(tableName)
+		}
+		return true
+	}, nil)
+	return &mirrorQuery{
+		m:          m,
+		rule:       rule,
+		table:      table,
+		isRead:     isRead,
+		sql:        sql,
+		shadowSQL:  sqlparser.String(shadowStmt),
+		tabletType: tabletType,
+	}
+}
+
+// checksumsResults returns true if the results of the original query must be
+// checksummed, to be diffed with the results of the mirrored query.
+func (q *mirrorQuery) checksumsResults() bool {
+	return q != nil && q.isRead && q.rule.diff
+}
+
+// run runs the mirrored query asynchronously, and compares its outcome with
+// source, the outcome of the original query. The DMLs are only mirrored if
+// they succeeded on the source keyspace, and no query is mirrored if the
+// original one began a transaction, as it does without autocommit. Streamed
+// queries are also streamed on the shadow keyspace.
+func (q *mirrorQuery) run(ctx context.Context, safeSession *SafeSession, bindVars map[string]*querypb.BindVariable, source mirrorSource, streaming bool) {
+	if q == nil || (!q.isRead && source.err != nil) || safeSession.InTransaction() || safeSession.InReservedConn() {
+		return
+	}
+	m, rule, table := q.m, q.rule, q.table
+	select {
+	case m.inflight <- struct{}{}:
+	default:
+		mirrorQueries.Add([]string{table, "dropped"}, 1)
+		return
+	}
+	mirrorLatencies.Add([]string{table, "source"}, source.latency)
+
+	// The mirrored query must not be tied to the original one,
+	// which is done by now, but it runs as the same caller.
+	mirrorCtx, cancel := context.WithTimeout(
+		callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx)),
+		m.timeout)
+	shadowBindVars := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		shadowBindVars[k] = v
+	}
+	session := NewAutocommitSession(&vtgatepb.Session{
+		TargetString: rule.shadowKeyspace + "@" + topoproto.TabletTypeLString(q.tabletType),
+		Autocommit:   true,
+		Options:      safeSession.GetOptions(),
+	})
+	m.wg.Add(1)
+	go func() {
+		defer func() {
+			cancel()
+			<-m.inflight
+			m.wg.Done()
+		}()
+		start := time.Now()
+		var err error
+		shadow := &resultChecksum{}
+		if streaming {
+			err = m.executor.StreamExecute(mirrorCtx, mirrorMethod, session, q.shadowSQL, shadowBindVars, func(result *sqltypes.Result) error {
+				shadow.add(result.Rows, q.checksumsResults())
+				return nil
+			})
+		} else {
+			var result *sqltypes.Result
+			result, err = m.executor.Execute(mirrorCtx, mirrorMethod, session, q.shadowSQL, shadowBindVars)
+			if result != nil {
+				shadow.add(result.Rows, q.checksumsResults())
+			}
+		}
+		shadowLatency := time.Since(start)
+		if (err != nil) != (source.err != nil) {
+			log.V(2).Infof("mirrored query on %s diverged: source error %v, shadow error %v", rule.shadowKeyspace, source.err, err)
+			mirrorDivergences.Add([]string{table, "error"}, 1)
+		}
+		if err != nil {
+			mirrorQueries.Add([]string{table, "error"}, 1)
+			return
+		}
+		mirrorQueries.Add([]string{table, "ok"}, 1)
+		mirrorLatencies.Add([]string{table, "shadow"}, shadowLatency)
+		if source.err != nil {
+			return
+		}
+		if q.isRead && shadow.rows != source.rows {
+			log.V(2).Infof("mirrored query on %s diverged: %d rows on the source, %d on the shadow", rule.shadowKeyspace, source.rows, shadow.rows)
+			mirrorDivergences.Add([]string{table, "rows"}, 1)
+		}
+		if q.checksumsResults() && source.checksum != nil {
+			m.diff(table, rule.shadowKeyspace, q.sql, source.checksum, shadow)
+		}
+		if shadowLatency > source.latency {
+			mirrorSlowerQueries.Add(table, 1)
+		}
+	}()
+}
+
+// tableKeyspace returns the keyspace of the table, which is either its
+// qualifier, the default keyspace of the session, or the keyspace of the
+// table in the vschema if its name is unique.
+func (m *mirrorer) tableKeyspace(tableName sqlparser.TableName, defaultKeyspace string) string {
+	if !tableName.Qualifier.IsEmpty() {
+		return tableName.Qualifier.String()
+	}
+	if defaultKeyspace != "" {
+		return defaultKeyspace
+	}
+	vschema := m.executor.VSchema()
+	if vschema == nil {
+		return ""
+	}
+	table, err := vschema.FindTable("", tableName.Name.String())
+	if err != nil || table == nil {
+		return ""
+	}
+	return table.Keyspace.Name
+}
+
+// routedAway returns true if the routing rules route the writes of a table
+// of the source keyspace that the statement uses, or of the same table in the
+// shadow keyspace, to another keyspace.
+func (m *mirrorer) routedAway(stmt sqlparser.Statement, defaultKeyspace, sourceKeyspace, shadowKeyspace string) bool {
+	vschema := m.executor.VSchema()
+	if vschema == nil {
+		return true
+	}
+	vschema = vschema.ForWrites(false)
+	routed := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableName, ok := node.(sqlparser.TableName)
+		if !ok || tableName.Name.IsEmpty() || m.tableKeyspace(tableName, defaultKeyspace) != sourceKeyspace {
+			return !routed, nil
+		}
+		for _, keyspace := range []string{sourceKeyspace, shadowKeyspace} {
+			table, err := vschema.FindRoutedTable(keyspace, tableName.Name.String(), topodatapb.TabletType_PRIMARY)
+			if err != nil || table == nil || table.Keyspace.Name != keyspace {
+				routed = true
+			}
+		}
+		return !routed, nil
+	}, stmt)
+	return routed
+}
+
+// wait waits for the mirrored queries that are running.
+func (m *mirrorer) wait() {
+	if m != nil {
+		m.wg.Wait()
+	}
+}
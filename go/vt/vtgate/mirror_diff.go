@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// maxMirrorMismatches is the number of mismatches that the mirrorer keeps.
+const maxMirrorMismatches = 100
+
+var mirrorMismatchLogger = logutil.NewThrottledLogger("MirrorMismatch", 5*time.Second)
+
+// resultChecksum is a checksum of the rows of a result that doesn't depend
+// on their order, since the shards of the source and of the shadow keyspace
+// don't return them in the same order: it is the sum of the hashes of the
+// rows.
+type resultChecksum struct {
+	rows int
+	sum  uint64
+}
+
+// add counts the rows, and adds them to the checksum if checksum is set.
+func (c *resultChecksum) add(rows []sqltypes.Row, checksum bool) {
+	c.rows += len(rows)
+	if !checksum {
+		return
+	}
+	var prefix [binary.MaxVarintLen64 + 1]byte
+	for _, row := range rows {
+		h := fnv.New64a()
+		for _, value := range row {
+			if value.IsNull() {
+				// A NULL doesn't hash like an empty string.
+				_, _ = h.Write([]byte{0xff})
+				continue
+			}
+			// The length prefix keeps the values apart, so that ('ab', 'c')
+			// doesn't hash like ('a', 'bc').
+			prefix[0] = 0
+			n := binary.PutUvarint(prefix[1:], uint64(len(value.Raw())))
+			_, _ = h.Write(prefix[:n+1])
+			_, _ = h.Write(value.Raw())
+		}
+		c.sum += h.Sum64()
+	}
+}
+
+// mirrorMismatch is a mirrored SELECT whose results differed between the
+// source and the shadow keyspaces.
+type mirrorMismatch struct {
+	Time           time.Time
+	Table          string
+	ShadowKeyspace string
+	// Query is redacted, it has no values.
+	Query          string
+	SourceRows     int
+	ShadowRows     int
+	SourceChecksum string
+	ShadowChecksum string
+}
+
+// diff compares the checksums of the results of a mirrored SELECT, and
+// records their mismatch.
+func (m *mirrorer) diff(table, shadowKeyspace, sql string, source, shadow *resultChecksum) {
+	if *source == *shadow {
+		return
+	}
+	if source.rows == shadow.rows {
+		// The mismatches of the number of rows are already counted.
+		mirrorDivergences.Add([]string{table, "checksum"}, 1)
+	}
+	query, err := sqlparser.RedactSQLQuery(sql)
+	if err != nil {
+		query = sqlparser.Preview(sql).String()
+	}
+	mismatch := &mirrorMismatch{
+		Time:           time.Now(),
+		Table:          table,
+		ShadowKeyspace: shadowKeyspace,
+		Query:          query,
+		SourceRows:     source.rows,
+		ShadowRows:     shadow.rows,
+		SourceChecksum: fmt.Sprintf("%016x", source.sum),
+		ShadowChecksum: fmt.Sprintf("%016x", shadow.sum),
+	}
+	mirrorMismatchLogger.Warningf("results of %s differ on %s: %d rows with checksum %s on the source, %d rows with checksum %s on the shadow",
+		query, shadowKeyspace, mismatch.SourceRows, mismatch.SourceChecksum, mismatch.ShadowRows, mismatch.ShadowChecksum)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.mismatches) == maxMirrorMismatches {
+		m.mismatches = m.mismatches[1:]
+	}
+	m.mismatches = append(m.mismatches, mismatch)
+}
+
+// recentMismatches returns the last mismatches, the most recent first.
+func (m *mirrorer) recentMismatches() []*mirrorMismatch {
+	mismatches := []*mirrorMismatch{}
+	if m == nil {
+		return mismatches
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.mismatches) - 1; i >= 0; i-- {
+		mismatches = append(mismatches, m.mismatches[i])
+	}
+	return mismatches
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestNewMirrorer(t *testing.T) {
+	m, err := newMirrorer(nil, "", time.Second, 1)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	m, err = newMirrorer(nil, "ks.t1=shadow:0", time.Second, 1)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	m, err = newMirrorer(nil, "ks.t1=shadow:10, ks.t2=other:100, ks.t3=other:50:writes, ks.t4=other:1:writes:diff", time.Second, 1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*mirrorRule{
+		"ks.t1": {shadowKeyspace: "shadow", percent: 10},
+		"ks.t2": {shadowKeyspace: "other", percent: 100},
+		"ks.t3": {shadowKeyspace: "other", percent: 50, writes: true},
+		"ks.t4": {shadowKeyspace: "other", percent: 1, writes: true, diff: true},
+	}, m.rules)
+
+	testcases := []struct {
+		spec, err string
+	}{{
+		spec: "ks.t1",
+		err:  `invalid mirror rule "ks.t1", expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]`,
+	}, {
+		spec: "t1=shadow:10",
+		err:  `invalid mirror rule "t1=shadow:10", expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]`,
+	}, {
+		spec: "ks.t1=shadow",
+		err:  `invalid mirror rule "ks.t1=shadow", expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]`,
+	}, {
+		spec: "ks.t1=shadow:10:reads",
+		err:  `invalid mirror rule "ks.t1=shadow:10:reads", expected <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff]`,
+	}, {
+		spec: "ks.t1=shadow:101",
+		err:  `invalid percent "101" in mirror rule, expected a number between 0 and 100`,
+	}, {
+		spec: "ks.t1=shadow:10,ks.t1=other:10",
+		err:  `duplicate mirror rule for table ks.t1`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.spec, func(t *testing.T) {
+			_, err := newMirrorer(nil, tc.spec, time.Second, 1)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+
+	_, err = newMirrorer(nil, "ks.t1=shadow:10", time.Second, 0)
+	assert.EqualError(t, err, "invalid mirror settings: the query timeout (1s) and the max inflight queries (0) must be positive")
+}
+
+func TestExecutorMirror(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	var err error
+	executor.mirror, err = newMirrorer(executor, "TestExecutor.user=TestUnsharded:100:writes", time.Second, 10)
+	require.NoError(t, err)
+	mirrored := func() int64 {
+		return mirrorQueries.Counts()["TestExecutor_user.ok"]
+	}
+	before := mirrored()
+
+	execute := func(sql string, bindVars map[string]*querypb.BindVariable) {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, bindVars)
+		require.NoError(t, err)
+	}
+
+	// Queries on the mirrored table are duplicated to the shadow keyspace.
+	execute("select id from user where id = 1", nil)
+	execute("update TestExecutor.user set a = 2 where id = 1", nil)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	err = executor.StreamExecute(context.Background(), "TestExecuteStream", session, "select id from user where id = 1", nil, func(*sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(t, err)
+	executor.mirror.wait()
+	assert.EqualValues(t, 3, mirrored()-before)
+	assert.Len(t, sbc1.Queries, 3)
+	var shadowQueries []string
+	for _, query := range sbclookup.Queries {
+		shadowQueries = append(shadowQueries, query.Sql)
+	}
+	// The mirrored queries run concurrently.
+	assert.ElementsMatch(t, []string{
+		"select id from `user` where id = 1",
+		"update `user` set a = 2 where id = 1",
+		"select id from `user` where id = 1",
+	}, shadowQueries)
+
+	// Other tables and queries in transactions are not mirrored.
+	sbclookup.Queries = nil
+	execute("select id from user_extra where user_id = 1", nil)
+	_, err = executorExec(executor, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	executor.mirror.wait()
+	assert.Empty(t, sbclookup.Queries)
+
+	// Mirrored queries that fail don't affect the original ones.
+	failed := mirrorQueries.Counts()["TestExecutor_user.error"]
+	sbclookup.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	execute("select id from user where id = :id", map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)})
+	executor.mirror.wait()
+	assert.EqualValues(t, 1, mirrorQueries.Counts()["TestExecutor_user.error"]-failed)
+
+	// The DMLs aren't mirrored while the routing rules route the writes of
+	// the shadow table to the source keyspace, where they would be applied
+	// twice.
+	srvVSchema := getSandboxSrvVSchema()
+	srvVSchema.RoutingRules = &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{
+			FromTable: "TestUnsharded.user",
+			ToTables:  []string{"TestExecutor.user"},
+		}},
+	}
+	executor.SaveVSchema(vindexes.BuildVSchema(srvVSchema), nil)
+	routed := mirrorQueries.Counts()["TestExecutor_user.routed"]
+	sbclookup.Queries = nil
+	execute("update TestExecutor.user set a = 2 where id = 1", nil)
+	executor.mirror.wait()
+	assert.Empty(t, sbclookup.Queries)
+	assert.EqualValues(t, 1, mirrorQueries.Counts()["TestExecutor_user.routed"]-routed)
+}
+
+func TestExecutorMirrorReads(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	var err error
+	executor.mirror, err = newMirrorer(executor, "TestExecutor.user=TestUnsharded:100", time.Second, 10)
+	require.NoError(t, err)
+	divergences := func(kind string) int64 {
+		return mirrorDivergences.Counts()["TestExecutor_user."+kind]
+	}
+	rowsBefore, errorsBefore := divergences("rows"), divergences("error")
+
+	execute := func(sql string) error {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+
+	// The rules mirror the reads only by default.
+	require.NoError(t, execute("update TestExecutor.user set a = 2 where id = 1"))
+	executor.mirror.wait()
+	assert.Empty(t, sbclookup.Queries)
+
+	// The shadow keyspace returns one row more.
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")})
+	require.NoError(t, execute("select id from user where id = 1"))
+	executor.mirror.wait()
+	assert.Len(t, sbclookup.Queries, 1)
+	assert.EqualValues(t, 1, divergences("rows")-rowsBefore)
+
+	// Only the shadow keyspace fails.
+	sbclookup.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	require.NoError(t, execute("select id from user where id = 1"))
+	executor.mirror.wait()
+	assert.EqualValues(t, 1, divergences("error")-errorsBefore)
+	assert.EqualValues(t, 1, divergences("rows")-rowsBefore)
+}
+
+func TestResultChecksum(t *testing.T) {
+	checksum := func(rows ...string) resultChecksum {
+		c := resultChecksum{}
+		c.add(sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|b", "int64|varchar"), rows...).Rows, true)
+		return c
+	}
+	assert.Equal(t, checksum("1|a", "2|b"), checksum("2|b", "1|a"))
+	assert.NotEqual(t, checksum("1|a", "2|b"), checksum("1|b", "2|a"))
+	assert.NotEqual(t, checksum("1|"), checksum("1|null"))
+	assert.NotEqual(t, checksum("1|a", "1|a"), checksum("1|a"))
+	assert.NotEqual(t, checksum("12|3"), checksum("1|23"))
+}
+
+func TestExecutorMirrorDiff(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	var err error
+	executor.mirror, err = newMirrorer(executor, "TestExecutor.user=TestUnsharded:100:diff", time.Second, 10)
+	require.NoError(t, err)
+	checksums := func() int64 {
+		return mirrorDivergences.Counts()["TestExecutor_user.checksum"]
+	}
+	before := checksums()
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+
+	// Only the results of the mirrored SELECTs are checksummed.
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	assert.True(t, executor.mirror.prepare(session, "select id from user").checksumsResults())
+	assert.False(t, executor.mirror.prepare(session, "select id from user_extra").checksumsResults())
+	assert.False(t, executor.mirror.prepare(session, "update user set a = 1").checksumsResults())
+
+	execute := func(sql string, stream bool) {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+		if stream {
+			err := executor.StreamExecute(context.Background(), "TestExecuteStream", session, sql, nil, func(*sqltypes.Result) error {
+				return nil
+			})
+			require.NoError(t, err)
+		} else {
+			_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+			require.NoError(t, err)
+		}
+		executor.mirror.wait()
+	}
+
+	// The same rows match.
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|alice")})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|alice")})
+	execute("select id, name from user where id = 1", false)
+	assert.EqualValues(t, 0, checksums()-before)
+	assert.Empty(t, executor.mirror.recentMismatches())
+
+	// Different rows don't, whether the query is streamed or not.
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|alice")})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|bob")})
+	execute("select id, name from user where id = 1", false)
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|alice")})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|bob")})
+	execute("select id, name from user where id = 1", true)
+	assert.EqualValues(t, 2, checksums()-before)
+
+	mismatches := executor.mirror.recentMismatches()
+	require.Len(t, mismatches, 2)
+	assert.Equal(t, "TestExecutor.user", mismatches[0].Table)
+	assert.Equal(t, "TestUnsharded", mismatches[0].ShadowKeyspace)
+	assert.Equal(t, "select id, `name` from `user` where id = :redacted1", mismatches[0].Query)
+	assert.Equal(t, 1, mismatches[0].SourceRows)
+	assert.Equal(t, 1, mismatches[0].ShadowRows)
+	assert.NotEqual(t, mismatches[0].SourceChecksum, mismatches[0].ShadowChecksum)
+
+	request := httptest.NewRequest("GET", pathMirrorMismatches, nil)
+	response := httptest.NewRecorder()
+	executor.ServeHTTP(response, request)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"Table": "TestExecutor.user"`)
+}
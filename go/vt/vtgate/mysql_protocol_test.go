@@ -55,6 +55,8 @@ func TestMySQLProtocolExecute(t *testing.T) {
 	options := &querypb.ExecuteOptions{
 		IncludedFields: querypb.ExecuteOptions_ALL,
 		Workload:       querypb.ExecuteOptions_OLTP,
+
+		ConnectionAttributes: mysqlClientAttributes(c),
 	}
 	if !proto.Equal(sbc.Options[0], options) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
@@ -82,6 +84,8 @@ func TestMySQLProtocolStreamExecute(t *testing.T) {
 	options := &querypb.ExecuteOptions{
 		IncludedFields: querypb.ExecuteOptions_ALL,
 		Workload:       querypb.ExecuteOptions_OLAP,
+
+		ConnectionAttributes: mysqlClientAttributes(c),
 	}
 	if !proto.Equal(sbc.Options[0], options) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
@@ -157,6 +161,8 @@ func TestMySQLProtocolClientFoundRows(t *testing.T) {
 		IncludedFields:  querypb.ExecuteOptions_ALL,
 		ClientFoundRows: true,
 		Workload:        querypb.ExecuteOptions_OLTP,
+
+		ConnectionAttributes: mysqlClientAttributes(c),
 	}
 
 	if !proto.Equal(sbc.Options[0], options) {
@@ -164,6 +170,14 @@ func TestMySQLProtocolClientFoundRows(t *testing.T) {
 	}
 }
 
+// mysqlClientAttributes returns the connection attributes that vtgate
+// passes on to the tablets for a connection of mysqlConnect: the Go
+// client doesn't send any, so only its host is passed on.
+func mysqlClientAttributes(c *mysql.Conn) map[string]string {
+	host, _, _ := net.SplitHostPort(c.GetRawConn().LocalAddr().String())
+	return map[string]string{"host": host}
+}
+
 // mysqlConnect fills the host & port into params and connects
 // to the mysql protocol port.
 func mysqlConnect(params *mysql.ConnParams) (*mysql.Conn, error) {
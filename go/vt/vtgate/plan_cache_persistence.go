@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The plans can't be serialized, so that the plan cache is exported as the
+// queries of its plans and the targets they were built for, and the plans are
+// rebuilt from them when they are imported, before the queries need them.
+
+const pathQueryPlansExport = "/debug/query_plans/export"
+const pathQueryPlansImport = "/debug/query_plans/import"
+
+var planCacheImports = stats.NewCountersWithSingleLabel(
+	"QueryPlanCacheImports",
+	"Number of plans rebuilt from an imported plan cache, by result: Planned or Failed",
+	"Result")
+
+// PlanCacheEntry is a plan of an exported plan cache.
+type PlanCacheEntry struct {
+	Target string
+	Query  string
+	// ExecCount is the number of executions of the plan, which orders
+	// the entries from the most to the least executed one.
+	ExecCount uint64 `json:",omitempty"`
+}
+
+// PlanCacheImport is the outcome of the import of a plan cache.
+type PlanCacheImport struct {
+	Planned int
+	Failed  int
+}
+
+// exportPlans returns the plans of the plan cache.
+func (e *Executor) exportPlans() []*PlanCacheEntry {
+	var entries []*PlanCacheEntry
+	e.plans.ForEach(func(value any) bool {
+		plan := value.(*engine.Plan)
+		entries = append(entries, &PlanCacheEntry{
+			Target:    plan.Target,
+			Query:     plan.Original,
+			ExecCount: atomic.LoadUint64(&plan.ExecCount),
+		})
+		return true
+	})
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ExecCount > entries[j].ExecCount
+	})
+	return entries
+}
+
+// importPlans builds the plans of the entries and puts them in the plan cache.
+func (e *Executor) importPlans(ctx context.Context, entries []*PlanCacheEntry) PlanCacheImport {
+	var result PlanCacheImport
+	// The most executed plans are imported last, so that they are the last
+	// ones to be evicted if the cache is too small for all of them.
+	for i := len(entries) - 1; i >= 0; i-- {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := e.importPlan(ctx, entries[i]); err != nil {
+			log.Warningf("Cannot rebuild the plan of %q for target %q: %v", sqlparser.TruncateForLog(entries[i].Query), entries[i].Target, err)
+			planCacheImports.Add("Failed", 1)
+			result.Failed++
+			continue
+		}
+		planCacheImports.Add("Planned", 1)
+		result.Planned++
+	}
+	return result
+}
+
+func (e *Executor) importPlan(ctx context.Context, entry *PlanCacheEntry) error {
+	safeSession := NewSafeSession(&vtgatepb.Session{TargetString: entry.Target, Autocommit: true})
+	query, comments := sqlparser.SplitMarginComments(entry.Query)
+	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, nil, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
+	if err != nil {
+		return err
+	}
+	_, err = e.getPlan(vcursor, query, comments, make(map[string]*querypb.BindVariable), safeSession, nil)
+	return err
+}
+
+// savePlans writes the plans of the plan cache to a file. The file is
+// replaced atomically, so that it is never read half written.
+func (e *Executor) savePlans(path string) error {
+	data, err := json.Marshal(e.exportPlans())
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// loadPlans rebuilds the plans of a file written by savePlans, once the
+// vschema is loaded. A missing file is not an error, since there is nothing
+// to load the first time vtgate starts.
+func (e *Executor) loadPlans(ctx context.Context, path string, timeout time.Duration) (PlanCacheImport, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return PlanCacheImport{}, nil
+	}
+	if err != nil {
+		return PlanCacheImport{}, err
+	}
+	var entries []*PlanCacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return PlanCacheImport{}, vterrors.Wrapf(err, "cannot parse the plan cache file %s", path)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for e.VSchema() == nil {
+		select {
+		case <-ctx.Done():
+			return PlanCacheImport{}, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "the vschema was not loaded within %v", timeout)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return e.importPlans(ctx, entries), nil
+}
+
+// servePlanCache exports the plan cache on a GET, and imports the plans of the
+// body on a POST, so that the plan cache of a vtgate can be copied to another:
+//
+//	curl http://vtgate1/debug/query_plans/export | curl --data-binary @- http://vtgate2/debug/query_plans/import
+func (e *Executor) servePlanCache(response http.ResponseWriter, request *http.Request) {
+	switch request.URL.Path {
+	case pathQueryPlansExport:
+		returnAsJSON(response, e.exportPlans())
+	case pathQueryPlansImport:
+		if request.Method != http.MethodPost {
+			http.Error(response, "the plans must be imported with a POST", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		var entries []*PlanCacheEntry
+		if err := json.NewDecoder(request.Body).Decode(&entries); err != nil {
+			http.Error(response, "cannot parse the plans: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		returnAsJSON(response, e.importPlans(request.Context(), entries))
+	}
+}
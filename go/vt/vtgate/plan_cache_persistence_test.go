@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func runPlanCacheQueries(t *testing.T, executor *Executor) {
+	t.Helper()
+	queries := []struct {
+		target string
+		sql    string
+		count  int
+	}{
+		{"@primary", "select id from user where id = 1", 3},
+		{"@primary", "select id from music where user_id = 1", 1},
+		{KsTestUnsharded + "@primary", "select id from music_user_map", 2},
+	}
+	for _, q := range queries {
+		for i := 0; i < q.count; i++ {
+			session := NewSafeSession(&vtgatepb.Session{TargetString: q.target, Autocommit: true})
+			_, err := executor.Execute(context.Background(), "TestPlanCache", session, q.sql, nil)
+			require.NoError(t, err)
+			executor.plans.Wait()
+		}
+	}
+}
+
+// exportedQueries returns the plans of the cache, without their execution counts.
+func exportedQueries(executor *Executor) []string {
+	var queries []string
+	for _, entry := range executor.exportPlans() {
+		queries = append(queries, entry.Target+"|"+entry.Query)
+	}
+	sort.Strings(queries)
+	return queries
+}
+
+func TestPlanCacheExportImport(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.normalize = true
+	runPlanCacheQueries(t, executor)
+
+	entries := executor.exportPlans()
+	require.Len(t, entries, 3)
+	assert.Equal(t, &PlanCacheEntry{Target: "@primary", Query: "select id from `user` where id = :vtg1", ExecCount: 3}, entries[0])
+	assert.Equal(t, &PlanCacheEntry{Target: KsTestUnsharded + "@primary", Query: "select id from music_user_map", ExecCount: 2}, entries[1])
+
+	other, _, _, _ := createExecutorEnv()
+	other.normalize = true
+	entries = append(entries, &PlanCacheEntry{Target: "@primary", Query: "select from"})
+	imported := other.importPlans(context.Background(), entries)
+	other.plans.Wait()
+	assert.Equal(t, PlanCacheImport{Planned: 3, Failed: 1}, imported)
+	assert.Equal(t, exportedQueries(executor), exportedQueries(other))
+
+	// the queries use the imported plans
+	misses := other.plans.Misses()
+	runPlanCacheQueries(t, other)
+	assert.Equal(t, misses, other.plans.Misses())
+}
+
+func TestPlanCacheSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plans.json")
+	executor, _, _, _ := createExecutorEnv()
+
+	// there is nothing to load the first time
+	imported, err := executor.loadPlans(context.Background(), path, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, PlanCacheImport{}, imported)
+
+	runPlanCacheQueries(t, executor)
+	require.NoError(t, executor.savePlans(path))
+
+	other, _, _, _ := createExecutorEnv()
+	imported, err = other.loadPlans(context.Background(), path, time.Second)
+	require.NoError(t, err)
+	other.plans.Wait()
+	assert.Equal(t, PlanCacheImport{Planned: 3}, imported)
+	assert.Equal(t, exportedQueries(executor), exportedQueries(other))
+
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+	_, err = other.loadPlans(context.Background(), path, time.Second)
+	assert.Error(t, err)
+}
+
+func TestPlanCacheHTTP(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	runPlanCacheQueries(t, executor)
+
+	w := httptest.NewRecorder()
+	executor.ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathQueryPlansExport, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	export := w.Body.String()
+
+	other, _, _, _ := createExecutorEnv()
+	w = httptest.NewRecorder()
+	other.ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathQueryPlansImport, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	w = httptest.NewRecorder()
+	other.ServeHTTP(w, httptest.NewRequest(http.MethodPost, pathQueryPlansImport, strings.NewReader("[")))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	other.ServeHTTP(w, httptest.NewRequest(http.MethodPost, pathQueryPlansImport, strings.NewReader(export)))
+	require.Equal(t, http.StatusOK, w.Code)
+	var imported PlanCacheImport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &imported))
+	assert.Equal(t, PlanCacheImport{Planned: 3}, imported)
+	other.plans.Wait()
+	assert.Equal(t, exportedQueries(executor), exportedQueries(other))
+}
@@ -82,17 +82,26 @@ func (e *Executor) newExecute(
 		safeSession.ClearWarnings()
 	}
 
+	sessionMemory, err := e.sessionMemory.check(safeSession, plan.Type)
+	if err != nil {
+		return err
+	}
+
 	// add any warnings that the planner wants to add
 	for _, warning := range plan.Warnings {
 		safeSession.RecordWarning(warning)
 	}
+	e.scatterLint.record(plan)
 
 	result, err := e.handleTransactions(ctx, safeSession, plan, logStats, vcursor)
 	if err != nil {
 		return err
 	}
 	if result != nil {
-		return recResult(plan.Type, result)
+		if err := recResult(plan.Type, result); err != nil {
+			return err
+		}
+		return e.checkSessionGrowth(ctx, safeSession, sessionMemory)
 	}
 
 	// 3: Prepare for execution
@@ -102,14 +111,38 @@ func (e *Executor) newExecute(
 		return err
 	}
 
+	err = e.inClauseLimit.check(plan, bindVars)
+	if err != nil {
+		logStats.Error = err
+		return err
+	}
+
 	if plan.Instructions.NeedsTransaction() {
-		return e.insideTransaction(ctx, safeSession, logStats,
+		err = e.insideTransaction(ctx, safeSession, logStats,
 			func() error {
 				return execPlan(plan, vcursor, bindVars, execStart)
 			})
+	} else {
+		err = execPlan(plan, vcursor, bindVars, execStart)
+	}
+	if err != nil {
+		return err
 	}
+	return e.checkSessionGrowth(ctx, safeSession, sessionMemory)
+}
 
-	return execPlan(plan, vcursor, bindVars, execStart)
+// checkSessionGrowth fails the statement that grew the state of the session
+// beyond the maximum size, and rolls back the transaction of the session,
+// since the effects of the statement on the shards can't be undone alone.
+func (e *Executor) checkSessionGrowth(ctx context.Context, safeSession *SafeSession, snapshot *sessionMemorySnapshot) error {
+	err := e.sessionMemory.checkGrowth(safeSession, snapshot)
+	if err == nil || !safeSession.InTransaction() {
+		return err
+	}
+	if rbErr := e.txConn.Rollback(ctx, safeSession); rbErr != nil {
+		return vterrors.Errorf(vterrors.Code(err), "%s, and the transaction could not be rolled back: %v", err.Error(), rbErr)
+	}
+	return vterrors.Errorf(vterrors.Code(err), "%s: the transaction was rolled back", err.Error())
 }
 
 // handleTransactions deals with transactional queries: begin, commit, rollback and savepoint management
@@ -210,7 +243,11 @@ func (e *Executor) executePlan(
 ) (*sqltypes.Result, error) {
 
 	// 4: Execute!
-	qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	qr, err := e.resultCache.execute(ctx, safeSession, plan, vcursor.vschema, bindVars, func() (*sqltypes.Result, error) {
+		return e.consolidator.execute(ctx, safeSession, plan, bindVars, func() (*sqltypes.Result, error) {
+			return vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+		})
+	})
 
 	// 5: Log and add statistics
 	e.setLogStats(logStats, plan, vcursor, execStart, err, qr)
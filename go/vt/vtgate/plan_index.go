@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// planIndexSlack is how many more plans than the plan cache holds the index
+// can reference before it drops the ones that the cache evicted.
+const planIndexSlack = 1000
+
+// planIndex maps the tables to the cached plans that reference them, so that
+// a change of some tables only evicts their plans from the plan cache.
+//
+// The plan cache does not tell the index about all the plans it drops, so
+// the index can reference plans that are no longer cached. It drops them once
+// it references twice as many plans as the cache holds.
+type planIndex struct {
+	mu sync.Mutex
+	// byTable maps the lowercased name of a table to its plans, and their key.
+	byTable map[string]map[*engine.Plan]string
+	// tables are the tables of each plan of the index.
+	tables map[*engine.Plan][]string
+}
+
+func newPlanIndex() *planIndex {
+	return &planIndex{
+		byTable: make(map[string]map[*engine.Plan]string),
+		tables:  make(map[*engine.Plan][]string),
+	}
+}
+
+// planTables returns the lowercased names of the tables that a statement
+// references, with or without a keyspace.
+func planTables(stmt sqlparser.Statement) []string {
+	var tables []string
+	seen := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !tableName.Name.IsEmpty() {
+			name := strings.ToLower(tableName.Name.String())
+			if !seen[name] {
+				seen[name] = true
+				tables = append(tables, name)
+			}
+		}
+		return true, nil
+	}, stmt)
+	return tables
+}
+
+// add indexes a plan that was added to the plan cache with the key.
+func (pi *planIndex) add(plans cache.Cache, key string, plan *engine.Plan, tables []string) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	if len(tables) == 0 {
+		return
+	}
+	if _, ok := pi.tables[plan]; ok {
+		return
+	}
+	pi.tables[plan] = tables
+	for _, table := range tables {
+		byPlan := pi.byTable[table]
+		if byPlan == nil {
+			byPlan = make(map[*engine.Plan]string)
+			pi.byTable[table] = byPlan
+		}
+		byPlan[plan] = key
+	}
+	if len(pi.tables) > 2*plans.Len()+planIndexSlack {
+		pi.prune(plans)
+	}
+}
+
+// prune drops the plans that are no longer cached.
+func (pi *planIndex) prune(plans cache.Cache) {
+	plans.Wait()
+	cached := make(map[*engine.Plan]bool, plans.Len())
+	plans.ForEach(func(value any) bool {
+		cached[value.(*engine.Plan)] = true
+		return true
+	})
+	for plan := range pi.tables {
+		if !cached[plan] {
+			pi.remove(plan)
+		}
+	}
+}
+
+func (pi *planIndex) remove(plan *engine.Plan) {
+	for _, table := range pi.tables[plan] {
+		delete(pi.byTable[table], plan)
+		if len(pi.byTable[table]) == 0 {
+			delete(pi.byTable, table)
+		}
+	}
+	delete(pi.tables, plan)
+}
+
+// evict removes the plans of the tables from the index, and returns their
+// keys in the plan cache.
+func (pi *planIndex) evict(tables map[string]bool) []string {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	var keys []string
+	for table := range tables {
+		for plan, key := range pi.byTable[table] {
+			keys = append(keys, key)
+			pi.remove(plan)
+		}
+	}
+	return keys
+}
+
+// clear empties the index, when the plan cache is cleared.
+func (pi *planIndex) clear() {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	pi.byTable = make(map[string]map[*engine.Plan]string)
+	pi.tables = make(map[*engine.Plan][]string)
+}
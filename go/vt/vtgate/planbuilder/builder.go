@@ -180,18 +180,33 @@ type selectPlanner func(sqlparser.Statement, *sqlparser.ReservedVars, plancontex
 func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
+		if err := expandViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		configuredPlanner, err := getConfiguredPlanner(vschema, buildSelectPlan, stmt, query)
 		if err != nil {
 			return nil, err
 		}
 		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner)
 	case *sqlparser.Insert:
+		if err := checkDMLViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		return buildRoutePlan(stmt, reservedVars, vschema, buildInsertPlan)
 	case *sqlparser.Update:
+		if err := checkDMLViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		return buildRoutePlan(stmt, reservedVars, vschema, buildUpdatePlan)
 	case *sqlparser.Delete:
+		if err := checkDMLViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDeletePlan)
 	case *sqlparser.Union:
+		if err := expandViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		configuredPlanner, err := getConfiguredPlanner(vschema, buildUnionPlan, stmt, query)
 		if err != nil {
 			return nil, err
@@ -53,7 +53,20 @@ func (fk *fkContraint) FkWalk(node sqlparser.SQLNode) (kontinue bool, err error)
 // and which chooses which of the two to invoke at runtime.
 func buildGeneralDDLPlan(sql string, ddlStatement sqlparser.DDLStatement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
 	if vschema.Destination() != nil {
-		return buildByPassDDLPlan(sql, vschema)
+		byPassPlan, err := buildByPassDDLPlan(sql, vschema)
+		if err != nil || !ddlStatement.IsTemporary() {
+			return byPassPlan, err
+		}
+		// The temporary tables of a session targeting shards are created
+		// on reserved connections of these shards.
+		send := byPassPlan.(*engine.Send)
+		return &engine.DDL{
+			Keyspace:        send.Keyspace,
+			SQL:             sql,
+			DDL:             ddlStatement,
+			NormalDDL:       send,
+			CreateTempTable: true,
+		}, nil
 	}
 	normalDDLPlan, onlineDDLPlan, err := buildDDLPlans(sql, ddlStatement, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	if err != nil {
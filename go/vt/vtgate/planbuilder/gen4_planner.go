@@ -274,13 +274,15 @@ func planOrderByOnUnion(ctx *plancontext.PlanningContext, plan logicalPlan, unio
 	hp := horizonPlanning{
 		qp: qp,
 	}
-	if len(qp.OrderExprs) > 0 {
-		plan, err = hp.planOrderBy(ctx, qp.OrderExprs, plan)
-		if err != nil {
-			return nil, err
-		}
+	if len(qp.OrderExprs) == 0 {
+		return plan, nil
 	}
-	return plan, nil
+	switch plan.(type) {
+	case *distinct, *concatenateGen4:
+		// the union is evaluated at vtgate, so we have to sort its results here
+		return hp.createMemorySortPlanOnUnion(ctx, plan)
+	}
+	return hp.planOrderBy(ctx, qp.OrderExprs, plan)
 }
 
 func pushCommentDirectivesOnPlan(plan logicalPlan, stmt sqlparser.SelectStatement) (logicalPlan, error) {
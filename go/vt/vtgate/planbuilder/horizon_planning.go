@@ -1128,6 +1128,36 @@ func (hp *horizonPlanning) createMemorySortPlan(ctx *plancontext.PlanningContext
 	return ms, nil
 }
 
+// createMemorySortPlanOnUnion sorts the results of a UNION that is evaluated at vtgate.
+// The ORDER BY of a UNION can only refer to the columns of the UNION, so the rows are
+// sorted using the offsets of these columns, and weight strings are not needed.
+func (hp *horizonPlanning) createMemorySortPlanOnUnion(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
+	primitive := &engine.MemorySort{}
+	ms := &memorySort{
+		resultsBuilder: resultsBuilder{
+			logicalPlanCommon: newBuilderCommon(plan),
+			weightStrings:     make(map[*resultColumn]int),
+			truncater:         primitive,
+		},
+		eMemorySort: primitive,
+	}
+
+	for _, order := range hp.qp.OrderExprs {
+		offset, aliasedExpr := hp.qp.FindSelectExprIndexForExpr(order.Inner.Expr)
+		if offset == nil {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'order clause'", sqlparser.String(order.Inner.Expr))
+		}
+		ms.eMemorySort.OrderBy = append(ms.eMemorySort.OrderBy, engine.OrderByParams{
+			Col:               *offset,
+			WeightStringCol:   -1,
+			Desc:              order.Inner.Direction == sqlparser.DescOrder,
+			StarColFixedIndex: *offset,
+			CollationID:       ctx.SemTable.CollationForExpr(aliasedExpr.Expr),
+		})
+	}
+	return ms, nil
+}
+
 func orderExprsDependsOnTableSet(orderExprs []abstract.OrderBy, semTable *semantics.SemTable, ts semantics.TableSet) bool {
 	for _, expr := range orderExprs {
 		exprDependencies := semTable.RecursiveDeps(expr.Inner.Expr)
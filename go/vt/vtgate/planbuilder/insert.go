@@ -60,9 +60,6 @@ func buildInsertPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if !rb.eroute.Keyspace.Sharded {
 		return buildInsertUnshardedPlan(ins, vschemaTable, reservedVars, vschema)
 	}
-	if ins.Action == sqlparser.ReplaceAct {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
 	return buildInsertShardedPlan(ins, vschemaTable, reservedVars, vschema)
 }
 
@@ -119,16 +116,60 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, rese
 	return eins, nil
 }
 
+// rewriteReplaceToInsertOnDup rewrites a REPLACE on a sharded table into an
+// INSERT ... ON DUPLICATE KEY UPDATE that sets every column of the conflicting
+// row to the value it was going to be inserted with. This lets the vindexes of the
+// conflicting row be maintained like for any other upsert. Unlike REPLACE, the
+// conflicting row is updated instead of deleted, so the rewrite is only done when
+// every column of the table is set, and the engine fails the statement if an
+// inserted row conflicts with more than one row, which REPLACE would all delete.
+func rewriteReplaceToInsertOnDup(ins *sqlparser.Insert, table *vindexes.Table) error {
+	if !table.ColumnListAuthoritative {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO on sharded table %s without authoritative columns in vschema", table.Name.String())
+	}
+	if _, ok := ins.Rows.(sqlparser.Values); !ok {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO with select on sharded table %s", table.Name.String())
+	}
+	if len(ins.Columns) == 0 {
+		populateInsertColumnlist(ins, table)
+	}
+	for _, col := range table.Columns {
+		if findColumn(ins, col.Name) == -1 {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO on sharded table %s must set all its columns, missing: %s", table.Name.String(), col.Name.String())
+		}
+	}
+	ins.OnDup = make(sqlparser.OnDup, 0, len(ins.Columns))
+	for _, col := range ins.Columns {
+		ins.OnDup = append(ins.OnDup, &sqlparser.UpdateExpr{
+			Name: &sqlparser.ColName{Name: col},
+			Expr: &sqlparser.ValuesFuncExpr{Name: &sqlparser.ColName{Name: col}},
+		})
+	}
+	ins.Action = sqlparser.InsertAct
+	return nil
+}
+
 func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 	eins := &engine.Insert{
 		Table:    table,
 		Keyspace: table.Keyspace,
 	}
+	if ins.Action == sqlparser.ReplaceAct {
+		if !vschema.ShardedReplaceEnabled() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO on sharded table %s, unless vtgate runs with -enable_sharded_replace", table.Name.String())
+		}
+		if err := rewriteReplaceToInsertOnDup(ins, table); err != nil {
+			return nil, err
+		}
+		eins.OnDupSingleConflict = true
+	}
 	eins.Ignore = bool(ins.Ignore)
 	if ins.OnDup != nil {
-		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
-			return nil, errors.New("unsupported: DML cannot change vindex column")
+		cvv, err := buildOnDupVindexValues(ins, eins.Table)
+		if err != nil {
+			return nil, err
 		}
+		eins.OnDupVindexValues = cvv
 		eins.Ignore = true
 	}
 	if len(ins.Columns) == 0 {
@@ -175,6 +216,22 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reserv
 			}
 		}
 	}
+	if eins.OnDupVindexValues != nil || eins.OnDupSingleConflict {
+		// The engine finds the rows the inserted rows conflict with by the values
+		// of the unique keys, which are only known when the plan is executed.
+		eins.OnDupColumns = append(sqlparser.Columns(nil), ins.Columns...)
+		eins.OnDupColumnValues = make([][]evalengine.Expr, len(ins.Columns))
+		for colNum := range ins.Columns {
+			eins.OnDupColumnValues[colNum] = make([]evalengine.Expr, len(rows))
+			for rowNum, row := range rows {
+				// The values that can't be evaluated by vtgate are left nil, the
+				// engine fails if it needs them.
+				if pv, err := evalengine.Translate(row[colNum], semantics.EmptySemTable()); err == nil {
+					eins.OnDupColumnValues[colNum][rowNum] = pv
+				}
+			}
+		}
+	}
 	for _, colVindex := range colVindexes {
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
@@ -373,9 +430,12 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 		return nil
 	}
 	colNum := findOrAddColumn(ins, eins.Table.AutoIncrement.Column)
+	seq := eins.Table.AutoIncrement.Sequence
 	eins.Generate = &engine.Generate{
-		Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
-		Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+		Keyspace:  seq.Keyspace,
+		TableName: seq.Name.String(),
+		Pinned:    seq.Pinned,
+		Query:     fmt.Sprintf("select next :n values from %s", sqlparser.String(seq.Name)),
 	}
 	switch rows := ins.Rows.(type) {
 	case sqlparser.SelectStatement:
@@ -419,24 +479,74 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	return colOffset
 }
 
-// isVindexChanging returns true if any of the update
-// expressions modify a vindex column.
-func isVindexChanging(setClauses sqlparser.UpdateExprs, colVindexes []*vindexes.ColumnVindex) bool {
-	for _, assignment := range setClauses {
-		for _, vcol := range colVindexes {
-			for _, col := range vcol.Columns {
-				if col.Equal(assignment.Name.Name) {
-					valueExpr, isValuesFuncExpr := assignment.Expr.(*sqlparser.ValuesFuncExpr)
-					if !isValuesFuncExpr {
-						return true
-					}
-					// update on duplicate key is changing the vindex column, not supported.
-					if !valueExpr.Name.Name.Equal(assignment.Name.Name) {
-						return true
-					}
+// buildOnDupVindexValues returns all the owned lookup vindexes whose columns are changed by
+// the ON DUPLICATE KEY UPDATE clause, with the offsets of their columns in the columns the
+// engine selects from the rows the insert conflicts with. The columns of the other vindexes
+// can only be set to the value they were going to be inserted with, using VALUES(col).
+func buildOnDupVindexValues(ins *sqlparser.Insert, table *vindexes.Table) (map[string]*engine.OnDupVindexValues, error) {
+	if len(table.ColumnVindexes) == 0 {
+		return nil, nil
+	}
+	changedVindexes := make(map[string]*engine.OnDupVindexValues)
+	offset := 0
+	for i, vindex := range table.ColumnVindexes {
+		values := &engine.OnDupVindexValues{
+			PvMap:    make(map[string]evalengine.Expr),
+			Inserted: make(map[string]bool),
+			Offset:   offset,
+		}
+		assigned := 0
+		exprs := make(map[string]*sqlparser.UpdateExpr)
+		for _, vcol := range vindex.Columns {
+			found := false
+			for _, assignment := range ins.OnDup {
+				if !vcol.Equal(assignment.Name.Name) {
+					continue
+				}
+				if found {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column has duplicate set values: '%v'", assignment.Name.Name)
 				}
+				found = true
+				assigned++
+				if valuesExpr, ok := assignment.Expr.(*sqlparser.ValuesFuncExpr); ok && valuesExpr.Name.Name.Equal(vcol) {
+					values.Inserted[vcol.String()] = true
+				} else {
+					exprs[vcol.String()] = assignment
+				}
+			}
+		}
+		if assigned == 0 {
+			// Vindex not changing, continue
+			continue
+		}
+		_, isLookup := vindex.Vindex.(vindexes.Lookup)
+		if i == 0 || !isLookup || !vindex.Owned || vindex.IgnoreInDML() {
+			// Setting a column to its inserted value does not change the
+			// vindex, since the conflicting row maps to the same keyspace id.
+			if len(exprs) == 0 {
+				continue
+			}
+			return nil, errors.New("unsupported: DML cannot change vindex column")
+		}
+		if _, ok := ins.Rows.(sqlparser.Values); !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: insert with select cannot change lookup vindex columns on duplicate key: %v", vindex.Name)
+		}
+		for _, vcol := range vindex.Columns {
+			assignment, ok := exprs[vcol.String()]
+			if !ok {
+				continue
 			}
+			pv, err := extractValueFromUpdate(assignment)
+			if err != nil {
+				return nil, err
+			}
+			values.PvMap[vcol.String()] = pv
 		}
+		offset += len(vindex.Columns)
+		changedVindexes[vindex.Name] = values
+	}
+	if len(changedVindexes) == 0 {
+		return nil, nil
 	}
-	return false
+	return changedVindexes, nil
 }
@@ -266,9 +266,7 @@ func transformUnionPlan(ctx *plancontext.PlanningContext, op *physical.Union) (l
 		}
 		result = src
 	} else {
-		if len(op.Ordering) > 0 {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "can't do ORDER BY on top of UNION")
-		}
+		// the ordering of the UNION, if any, is planned on top of it by the horizon planning
 		result = &concatenateGen4{sources: sources}
 	}
 	if op.Distinct {
@@ -355,6 +353,12 @@ func createLogicalPlan(ctx *plancontext.PlanningContext, source abstract.Physica
 	if err != nil {
 		return nil, err
 	}
+	if union, isUnion := source.(*physical.Union); isUnion && len(union.Ordering) > 0 {
+		// a nested UNION has no horizon of its own, so its ordering can only be done by a single route
+		if _, isRoute := plan.(*routeGen4); !isRoute {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "can't do ORDER BY on top of UNION")
+		}
+	}
 	if selStmt != nil {
 		plan, err = planHorizon(ctx, plan, selStmt)
 		if err != nil {
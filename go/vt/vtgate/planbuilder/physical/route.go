@@ -109,6 +109,8 @@ func (r *Route) Cost() int {
 		return 10
 	case engine.MultiEqual:
 		return 10
+	case engine.Range:
+		return 15
 	case engine.Scatter:
 		return 20
 	}
@@ -174,6 +176,9 @@ func (r *Route) searchForNewVindexes(ctx *plancontext.PlanningContext, predicate
 	case *sqlparser.IsExpr:
 		found := r.planIsExpr(ctx, node)
 		newVindexFound = newVindexFound || found
+	case *sqlparser.BetweenExpr:
+		found := r.planBetweenExpr(ctx, node)
+		newVindexFound = newVindexFound || found
 	}
 	return newVindexFound, nil
 }
@@ -205,7 +210,9 @@ func (r *Route) planComparison(ctx *plancontext.PlanningContext, cmp *sqlparser.
 	case sqlparser.LikeOp:
 		found := r.planLikeOp(ctx, cmp)
 		return found, false, nil
-
+	case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+		found := r.planRangeOp(ctx, cmp)
+		return found, false, nil
 	}
 	return false, false, nil
 }
@@ -545,6 +552,105 @@ func (r *Route) planLikeOp(ctx *plancontext.PlanningContext, node *sqlparser.Com
 
 }
 
+// planRangeOp plans the comparisons of a column with '<', '<=', '>' and '>='
+// as a range open on one side.
+func (r *Route) planRangeOp(ctx *plancontext.PlanningContext, node *sqlparser.ComparisonExpr) bool {
+	lowerBound := node.Operator == sqlparser.GreaterThanOp || node.Operator == sqlparser.GreaterEqualOp
+	column, ok := node.Left.(*sqlparser.ColName)
+	vdValue := node.Right
+	if !ok {
+		column, ok = node.Right.(*sqlparser.ColName)
+		if !ok {
+			return false
+		}
+		// 'value < col' is a lower bound of col
+		vdValue = node.Left
+		lowerBound = !lowerBound
+	}
+	val := r.makeEvalEngineExpr(ctx, vdValue)
+	if val == nil {
+		return false
+	}
+	if lowerBound {
+		return r.haveMatchingRange(ctx, node, column, val, nil, vdValue)
+	}
+	return r.haveMatchingRange(ctx, node, column, nil, val, vdValue)
+}
+
+func (r *Route) planBetweenExpr(ctx *plancontext.PlanningContext, node *sqlparser.BetweenExpr) bool {
+	column, ok := node.Left.(*sqlparser.ColName)
+	if !ok || !node.IsBetween {
+		return false
+	}
+	low := r.makeEvalEngineExpr(ctx, node.From)
+	high := r.makeEvalEngineExpr(ctx, node.To)
+	if low == nil || high == nil {
+		return false
+	}
+	return r.haveMatchingRange(ctx, node, column, low, high, node.From, node.To)
+}
+
+// haveMatchingRange adds the range between low and high, where a nil bound
+// leaves its side open, as an option of the Sequential vindexes of column.
+// The range is also combined with the ranges found before that bound it on
+// the other side, like in 'col >= a and col < b'.
+func (r *Route) haveMatchingRange(
+	ctx *plancontext.PlanningContext,
+	node sqlparser.Expr,
+	column *sqlparser.ColName,
+	low, high evalengine.Expr,
+	valueExprs ...sqlparser.Expr,
+) bool {
+	if low == nil {
+		low = evalengine.NullExpr
+	}
+	if high == nil {
+		high = evalengine.NullExpr
+	}
+	newVindexFound := false
+	for _, v := range r.VindexPreds {
+		if !ctx.SemTable.DirectDeps(column).IsSolvedBy(v.TableID) {
+			continue
+		}
+		if _, ok := v.ColVindex.Vindex.(vindexes.Sequential); !ok || !column.Name.Equal(v.ColVindex.Columns[0]) {
+			continue
+		}
+		newOptions := []*VindexOption{{
+			Values:      []evalengine.Expr{low, high},
+			ValueExprs:  valueExprs,
+			Predicates:  []sqlparser.Expr{node},
+			OpCode:      engine.Range,
+			FoundVindex: v.ColVindex.Vindex,
+			Cost:        costFor(v.ColVindex, engine.Range),
+			Ready:       true,
+		}}
+		for _, op := range v.Options {
+			if op.OpCode != engine.Range {
+				continue
+			}
+			narrowsLow := low == evalengine.NullExpr && op.Values[0] != evalengine.NullExpr
+			narrowsHigh := high == evalengine.NullExpr && op.Values[1] != evalengine.NullExpr
+			if !narrowsLow && !narrowsHigh {
+				continue
+			}
+			option := copyOption(op)
+			option.Ready = true
+			if !narrowsLow {
+				option.Values[0] = low
+			}
+			if !narrowsHigh {
+				option.Values[1] = high
+			}
+			option.ValueExprs = append(option.ValueExprs, valueExprs...)
+			option.Predicates = append(option.Predicates, node)
+			newOptions = append(newOptions, option)
+		}
+		v.Options = append(v.Options, newOptions...)
+		newVindexFound = true
+	}
+	return newVindexFound
+}
+
 func (r *Route) planCompositeInOpRecursive(
 	ctx *plancontext.PlanningContext,
 	cmp *sqlparser.ComparisonExpr,
@@ -206,8 +206,9 @@ func makeTestOutput(t *testing.T) string {
 
 func TestPlan(t *testing.T) {
 	vschemaWrapper := &vschemaWrapper{
-		v:             loadSchema(t, "schema_test.json", true),
-		sysVarEnabled: true,
+		v:                     loadSchema(t, "schema_test.json", true),
+		sysVarEnabled:         true,
+		shardedReplaceEnabled: true,
 	}
 	testOutputTempDir := makeTestOutput(t)
 
@@ -231,6 +232,7 @@ func TestPlan(t *testing.T) {
 	testFile(t, "use_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "set_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "view_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper)
@@ -250,6 +252,14 @@ func TestSysVarSetDisabled(t *testing.T) {
 	testFile(t, "set_sysvar_disabled_cases.txt", makeTestOutput(t), vschemaWrapper)
 }
 
+func TestShardedReplaceDisabled(t *testing.T) {
+	vschemaWrapper := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json", true),
+	}
+
+	testFile(t, "sharded_replace_disabled_cases.txt", makeTestOutput(t), vschemaWrapper)
+}
+
 func TestOne(t *testing.T) {
 	vschema := &vschemaWrapper{
 		v: loadSchema(t, "schema_test.json", true),
@@ -438,6 +448,8 @@ type vschemaWrapper struct {
 	dest          key.Destination
 	sysVarEnabled bool
 	version       plancontext.PlannerVersion
+
+	shardedReplaceEnabled bool
 }
 
 func (vw *vschemaWrapper) ConnCollation() collations.ID {
@@ -482,6 +494,10 @@ func (vw *vschemaWrapper) SysVarSetEnabled() bool {
 	return vw.sysVarEnabled
 }
 
+func (vw *vschemaWrapper) ShardedReplaceEnabled() bool {
+	return vw.shardedReplaceEnabled
+}
+
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	var keyspaceName string
 	if vw.keyspace != nil {
@@ -521,6 +537,14 @@ func (vw *vschemaWrapper) FindTable(tab sqlparser.TableName) (*vindexes.Table, s
 	return table, destKeyspace, destTabletType, destTarget, nil
 }
 
+func (vw *vschemaWrapper) FindView(tab sqlparser.TableName) sqlparser.SelectStatement {
+	destKeyspace, _, _, err := topoproto.ParseDestination(tab.Qualifier.String(), topodatapb.TabletType_PRIMARY)
+	if err != nil {
+		return nil
+	}
+	return vw.v.FindView(destKeyspace, tab.Name.String())
+}
+
 func (vw *vschemaWrapper) FindTableOrVindex(tab sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, destTarget, err := topoproto.ParseDestination(tab.Qualifier.String(), topodatapb.TabletType_PRIMARY)
 	if err != nil {
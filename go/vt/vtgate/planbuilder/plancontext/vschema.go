@@ -20,6 +20,8 @@ type PlannerVersion = querypb.ExecuteOptions_PlannerVersion
 type VSchema interface {
 	FindTable(tablename sqlparser.TableName) (*vindexes.Table, string, topodatapb.TabletType, key.Destination, error)
 	FindTableOrVindex(tablename sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error)
+	// FindView returns the SELECT statement of the view, or nil if there is no such view.
+	FindView(name sqlparser.TableName) sqlparser.SelectStatement
 	DefaultKeyspace() (*vindexes.Keyspace, error)
 	TargetString() string
 	Destination() key.Destination
@@ -28,6 +30,8 @@ type VSchema interface {
 	AnyKeyspace() (*vindexes.Keyspace, error)
 	FirstSortedKeyspace() (*vindexes.Keyspace, error)
 	SysVarSetEnabled() bool
+	// ShardedReplaceEnabled returns true if REPLACE INTO is planned on sharded tables.
+	ShardedReplaceEnabled() bool
 	KeyspaceExists(keyspace string) bool
 	AllKeyspace() ([]*vindexes.Keyspace, error)
 	GetSemTable() *semantics.SemTable
@@ -34,27 +34,30 @@ For easy reference, opcodes are:
 	SelectEqual       2
 	SelectIN          3
 	SelectMultiEqual  4
-	SelectScatter     5
-	SelectNext        6
-	SelectDBA         7
-	SelectReference   8
-	SelectNone        9
-	NumRouteOpcodes   10
+	SelectRange       5
+	SelectScatter     6
+	SelectNext        7
+	SelectDBA         8
+	SelectReference   9
+	SelectNone        10
+	ByDestination     11
+	NumRouteOpcodes   12
 */
 
 func TestJoinCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false, false},
-		{false, true, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, true, true, false, false},
-		{true, true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
+		{true, false, false, false, false, false, false, false, false, true, false, false},
+		{false, true, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, true, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -82,17 +85,18 @@ func TestJoinCanMerge(t *testing.T) {
 
 func TestSubqueryCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, true, true, false, false},
-		{true, true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
+		{true, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, true, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -112,17 +116,18 @@ func TestSubqueryCanMerge(t *testing.T) {
 
 func TestUnionCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, true, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, true, false, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
+		{true, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, true, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
 	}
 	ks := &vindexes.Keyspace{}
 	lRoute := &route{}
@@ -231,6 +231,11 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 }
 
 func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
+	if sel.Lock.DoesNotWait() {
+		if err := checkLockDoesNotWait(in, sel.Lock); err != nil {
+			return err
+		}
+	}
 	_, err := visit(in, func(plan logicalPlan) (bool, logicalPlan, error) {
 		switch node := plan.(type) {
 		case *route:
@@ -255,6 +260,27 @@ func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 	return nil
 }
 
+// checkLockDoesNotWait checks that a NOWAIT or SKIP LOCKED lock is pushed
+// down to a single route. The routes of a cross-shard join would skip or fail
+// on different rows, so that the join would not see the rows it locked.
+func checkLockDoesNotWait(in logicalPlan, lock sqlparser.Lock) error {
+	routes := 0
+	_, err := visit(in, func(plan logicalPlan) (bool, logicalPlan, error) {
+		switch plan.(type) {
+		case *route, *routeGen4:
+			routes++
+		}
+		return true, plan, nil
+	})
+	if err != nil {
+		return err
+	}
+	if routes > 1 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported:%s in a cross-shard join", lock.ToString())
+	}
+	return nil
+}
+
 func copyCommentsAndLocks(statement sqlparser.SelectStatement, sel *sqlparser.Select, opcode engine.Opcode) error {
 	query := sqlparser.GetFirstSelect(statement)
 	query.Comments = sel.Comments
@@ -370,12 +396,21 @@ func buildLockingPrimitive(sel *sqlparser.Select, vschema plancontext.VSchema) (
 	if err != nil {
 		return nil, err
 	}
+	var releaseAllLocksColumns []int
+	for i, expr := range sel.SelectExprs {
+		if aExpr, ok := expr.(*sqlparser.AliasedExpr); ok {
+			if fn, ok := aExpr.Expr.(*sqlparser.FuncExpr); ok && fn.Name.EqualString("release_all_locks") {
+				releaseAllLocksColumns = append(releaseAllLocksColumns, i)
+			}
+		}
+	}
 	buf := sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery).WriteNode(sel)
 	return &engine.Lock{
-		Keyspace:          ks,
-		TargetDestination: key.DestinationKeyspaceID{0},
-		Query:             sqlparser.String(sel),
-		FieldQuery:        buf.String(),
+		Keyspace:               ks,
+		TargetDestination:      key.DestinationKeyspaceID{0},
+		Query:                  sqlparser.String(sel),
+		FieldQuery:             buf.String(),
+		ReleaseAllLocksColumns: releaseAllLocksColumns,
 	}, nil
 }
 
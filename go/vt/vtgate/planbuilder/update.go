@@ -39,6 +39,9 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if err != nil {
 		return nil, err
 	}
+	if err := checkOCCColumn(upd, dml); err != nil {
+		return nil, err
+	}
 	eupd := &engine.Update{DML: dml}
 
 	if dml.Opcode == engine.Unsharded {
@@ -58,6 +61,25 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	return eupd, nil
 }
 
+// checkOCCColumn validates the OCC_COLUMN directive of an UPDATE, which the
+// tablets check for optimistic locking. Since a shard without the row would
+// report a conflict, the UPDATE must go to a single shard.
+func checkOCCColumn(upd *sqlparser.Update, dml *engine.DML) error {
+	column, err := sqlparser.OCCColumnDirective(upd)
+	if err != nil {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	if column.IsEmpty() {
+		return nil
+	}
+	// An UPDATE routed by a value of its primary vindex, which is unique,
+	// goes to a single shard.
+	if dml.Opcode != engine.Unsharded && dml.Opcode != engine.Equal {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s directive in an UPDATE that is not routed to a single shard", sqlparser.DirectiveOCCColumn)
+	}
+	return nil
+}
+
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
 // Updates can only be performed to secondary lookup vindexes with no complex expressions
 // in the set clause.
@@ -65,6 +87,9 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 	changedVindexes := make(map[string]*engine.VindexValues)
 	buf, offset := initialQuery(ksidCols, table)
 	for i, vindex := range table.ColumnVindexes {
+		if vindex.IgnoreInDML() {
+			continue
+		}
 		vindexValueMap := make(map[string]evalengine.Expr)
 		first := true
 		for _, vcol := range vindex.Columns {
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+// maxViewDepth is the maximum number of views that can be nested in each other.
+const maxViewDepth = 16
+
+// expandViews replaces the views of the vschema that the statement selects from
+// with derived tables, which have the name of the view as their alias.
+// The statement is modified in place.
+func expandViews(stmt sqlparser.SelectStatement, vschema plancontext.VSchema) error {
+	return expandViewsAtDepth(stmt, vschema, 0)
+}
+
+func expandViewsAtDepth(stmt sqlparser.SelectStatement, vschema plancontext.VSchema, depth int) error {
+	var err error
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		ate, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := ate.Expr.(sqlparser.TableName)
+		if !ok {
+			return true
+		}
+		view := vschema.FindView(tableName)
+		if view == nil {
+			return true
+		}
+		if depth >= maxViewDepth {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "view %s is nested too deeply, or references itself", tableName.Name.String())
+			return false
+		}
+		view = copyView(view)
+		if err = expandViewsAtDepth(view, vschema, depth+1); err != nil {
+			return false
+		}
+		ate.Expr = &sqlparser.DerivedTable{Select: view}
+		if ate.As.IsEmpty() {
+			ate.As = tableName.Name
+		}
+		// the view was expanded above
+		return false
+	}, nil)
+	return err
+}
+
+// checkDMLViews fails if the DML statement references a view. The views
+// can't be modified, and they are only expanded in the SELECTs, so that a
+// DML would otherwise run against a table named like the view.
+func checkDMLViews(stmt sqlparser.Statement, vschema plancontext.VSchema) error {
+	var targets sqlparser.TableExprs
+	var kind string
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		targets = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: stmt.Table}}
+		kind = "INSERT"
+		if stmt.Action == sqlparser.ReplaceAct {
+			kind = "REPLACE"
+		}
+	case *sqlparser.Update:
+		targets = stmt.TableExprs
+		kind = "UPDATE"
+	case *sqlparser.Delete:
+		targets = stmt.TableExprs
+		kind = "DELETE"
+	}
+	var err error
+	findView := func(in sqlparser.SQLNode, subqueries bool, onView func(name sqlparser.TableName) error) {
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if err != nil {
+				return false, nil
+			}
+			switch node := node.(type) {
+			case *sqlparser.Subquery, *sqlparser.DerivedTable:
+				// the tables of the subqueries are not targets
+				return subqueries, nil
+			case *sqlparser.AliasedTableExpr:
+				if tableName, ok := node.Expr.(sqlparser.TableName); ok && vschema.FindView(tableName) != nil {
+					err = onView(tableName)
+					return false, nil
+				}
+			}
+			return true, nil
+		}, in)
+	}
+	findView(targets, false, func(name sqlparser.TableName) error {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUpdateableTable, "The target table %s of the %s is not updatable", name.Name.String(), kind)
+	})
+	if err == nil {
+		findView(stmt, true, func(name sqlparser.TableName) error {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: view %s in a %s", name.Name.String(), kind)
+		})
+	}
+	return err
+}
+
+// copyView returns a copy of the view that the planners can modify.
+// The views of the vschema are shared by all the queries, and cloning an AST
+// doesn't copy its column names, which the planners annotate.
+func copyView(view sqlparser.SelectStatement) sqlparser.SelectStatement {
+	return sqlparser.Rewrite(sqlparser.CloneSelectStatement(view), func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			newCol := *col
+			cursor.Replace(&newCol)
+		}
+		return true
+	}, nil).(sqlparser.SelectStatement)
+}
@@ -78,6 +78,8 @@ var (
 	mysqlDefaultWorkloadName = flag.String("mysql_default_workload", "OLTP", "Default session workload (OLTP, OLAP, DBA)")
 	mysqlDefaultWorkload     int32
 
+	mysqlConnectionAttributes = flag.String("mysql_server_connection_attributes", "program_name,_client_name", "comma separated list of the connection attributes sent by MySQL clients that vtgate passes on to the tablets with every query, along with the host of the client, so that the query logs of vttablet can tell which application sent a query.")
+
 	busyConnections int32
 )
 
@@ -310,6 +312,14 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
+	// The executions of the statement reuse the planning of the first one.
+	ps, _ := prepare.HandlerData.(*preparedStatement)
+	if ps == nil {
+		ps = newPreparedStatement(prepare.PrepareStmt)
+		prepare.HandlerData = ps
+	}
+	ctx = withPreparedStatement(ctx, ps)
+
 	session := vh.session(c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
@@ -363,11 +373,33 @@ func (vh *vtgateHandler) session(c *mysql.Conn) *vtgatepb.Session {
 		if c.Capabilities&mysql.CapabilityClientFoundRows != 0 {
 			session.Options.ClientFoundRows = true
 		}
+		session.Options.ConnectionAttributes = connectionAttributes(c)
 		c.ClientData = session
 	}
 	return session
 }
 
+// connectionAttributes returns the attributes of the client of c that are
+// passed on to the tablets: those of -mysql_server_connection_attributes
+// that the client sent, and the host it connected from.
+func connectionAttributes(c *mysql.Conn) map[string]string {
+	attrs := make(map[string]string)
+	for _, name := range strings.Split(*mysqlConnectionAttributes, ",") {
+		if value, ok := c.Attributes[strings.TrimSpace(name)]; ok {
+			attrs[strings.TrimSpace(name)] = value
+		}
+	}
+	if conn := c.GetRawConn(); conn != nil {
+		if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+			attrs["host"] = host
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+	return attrs
+}
+
 var mysqlListener *mysql.Listener
 var mysqlUnixListener *mysql.Listener
 var sigChan chan os.Signal
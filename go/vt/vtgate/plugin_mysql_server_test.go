@@ -293,3 +293,19 @@ func testInitTLSConfig(t *testing.T, serverCA bool) {
 		t.Fatalf("init tls config should have been recreated after SIGHUP")
 	}
 }
+
+func TestSessionConnectionAttributes(t *testing.T) {
+	defer func(saved string) { *mysqlConnectionAttributes = saved }(*mysqlConnectionAttributes)
+	*mysqlConnectionAttributes = "program_name, _client_name"
+
+	vh := &vtgateHandler{}
+	sess := vh.session(&mysql.Conn{Attributes: map[string]string{
+		"program_name": "app",
+		"_client_name": "libmysql",
+		"_pid":         "1234",
+	}})
+	assert.Equal(t, map[string]string{"program_name": "app", "_client_name": "libmysql"}, sess.Options.ConnectionAttributes)
+
+	sess = vh.session(&mysql.Conn{})
+	assert.Nil(t, sess.Options.ConnectionAttributes)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The executions of a prepared statement run the same query with other values
+// of its parameters. The first one parses and normalizes the query to find its
+// plan in the plan cache, and the next ones reuse what it found, as long as
+// the session would plan the query the same way. The plan itself is still
+// looked up in the plan cache, so that the statement sees the new plans when
+// the vschema changes.
+
+var preparedStatementPlans = stats.NewCountersWithSingleLabel(
+	"VtgatePreparedStatementPlans",
+	"Number of executions of prepared statements, by whether they reused the planning of a previous execution: Reused or Planned",
+	"Result")
+
+// preparedStatement is the state that a prepared statement of a MySQL
+// connection keeps between its executions. It is only used by the
+// connection, one execution at a time.
+type preparedStatement struct {
+	// sql is the query of the statement without its margin comments. The
+	// other queries that run in the context of an execution, such as the
+	// ones of the lookup vindexes, are planned as usual.
+	sql string
+
+	// prefixKey, selectLimit and tenant are the state of the session that
+	// the planning depends on.
+	prefixKey   string
+	selectLimit int
+	tenant      string
+
+	planKey             string
+	query               string
+	ignoreMaxMemoryRows bool
+	throttleApp         string
+	// literals are the bind variables that the normalization extracted
+	// from the query.
+	literals map[string]*querypb.BindVariable
+}
+
+type preparedStatementKey struct{}
+
+func newPreparedStatement(sql string) *preparedStatement {
+	query, _ := sqlparser.SplitMarginComments(sql)
+	return &preparedStatement{sql: query}
+}
+
+// withPreparedStatement returns a context that makes the executor reuse the
+// planning of the previous executions of the prepared statement.
+func withPreparedStatement(ctx context.Context, ps *preparedStatement) context.Context {
+	return context.WithValue(ctx, preparedStatementKey{}, ps)
+}
+
+// preparedStatementFromContext returns the prepared statement of the context
+// if sql is its query, or nil.
+func preparedStatementFromContext(ctx context.Context, sql string) *preparedStatement {
+	ps, _ := ctx.Value(preparedStatementKey{}).(*preparedStatement)
+	if ps == nil || ps.sql != sql {
+		return nil
+	}
+	return ps
+}
+
+// plan returns the plan that the previous executions of the statement found,
+// after adding the literals of the query to the bind variables, or nil if the
+// query must be planned.
+func (ps *preparedStatement) plan(e *Executor, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, qo iQueryOption) *engine.Plan {
+	if ps == nil {
+		return nil
+	}
+	// The system variables of the session change the normalization.
+	if ps.planKey == "" || !qo.cachePlan() || vcursor.Session().HasSystemVariables() ||
+		ps.selectLimit != qo.getSelectLimit() || ps.prefixKey != vcursor.planPrefixKey() || ps.tenant != vcursor.tenantDatabase() {
+		preparedStatementPlans.Add("Planned", 1)
+		return nil
+	}
+	plan, ok := e.plans.Get(ps.planKey)
+	if !ok {
+		preparedStatementPlans.Add("Planned", 1)
+		return nil
+	}
+	for name, bv := range ps.literals {
+		bindVars[name] = bv
+	}
+	vcursor.SetIgnoreMaxMemoryRows(ps.ignoreMaxMemoryRows)
+	vcursor.SetThrottleApp(ps.throttleApp)
+	preparedStatementPlans.Add("Reused", 1)
+	return plan.(*engine.Plan)
+}
+
+// params returns the names of the bind variables of the execution before
+// the normalization, or nil if it is not the execution of a statement.
+func (ps *preparedStatement) params(bindVars map[string]*querypb.BindVariable) map[string]bool {
+	if ps == nil {
+		return nil
+	}
+	params := make(map[string]bool, len(bindVars))
+	for name := range bindVars {
+		params[name] = true
+	}
+	return params
+}
+
+// remember records the planning of the query for the next executions.
+// params are the names that params returned before the normalization.
+func (ps *preparedStatement) remember(vcursor *vcursorImpl, qo iQueryOption, planKey, query string, params map[string]bool, bindVars map[string]*querypb.BindVariable) {
+	if ps == nil {
+		return
+	}
+	literals := make(map[string]*querypb.BindVariable)
+	for name, bv := range bindVars {
+		if !params[name] {
+			literals[name] = bv
+		}
+	}
+	*ps = preparedStatement{
+		sql:                 ps.sql,
+		prefixKey:           vcursor.planPrefixKey(),
+		selectLimit:         qo.getSelectLimit(),
+		tenant:              vcursor.tenantDatabase(),
+		planKey:             planKey,
+		query:               query,
+		ignoreMaxMemoryRows: vcursor.ignoreMaxMemoryRows,
+		throttleApp:         vcursor.throttleApp,
+		literals:            literals,
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestPreparedStatementReusesPlanning(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	session := NewAutocommitSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@primary"})
+
+	const sql = "/* leading */ select id from music_user_map where id = :v1 and user_id = 5"
+	ps := newPreparedStatement(sql)
+	ctx := withPreparedStatement(context.Background(), ps)
+	planned := preparedStatementPlans.Counts()["Planned"]
+	reused := preparedStatementPlans.Counts()["Reused"]
+
+	for i := int64(1); i <= 3; i++ {
+		_, err := executor.Execute(ctx, "TestPreparedStatement", session, sql, map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(i),
+		})
+		require.NoError(t, err)
+		executor.plans.Wait()
+	}
+	assert.Equal(t, planned+1, preparedStatementPlans.Counts()["Planned"])
+	assert.Equal(t, reused+2, preparedStatementPlans.Counts()["Reused"])
+	assert.Equal(t, map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(5)}, ps.literals)
+
+	// The reused planning sends the literals of the query along with the parameters.
+	wantQuery := &querypb.BoundQuery{
+		Sql: "/* leading */ select id from music_user_map where id = :v1 and user_id = :vtg1",
+		BindVariables: map[string]*querypb.BindVariable{
+			"v1":   sqltypes.Int64BindVariable(3),
+			"vtg1": sqltypes.Int64BindVariable(5),
+		},
+	}
+	require.NotEmpty(t, sbclookup.Queries)
+	assert.Equal(t, wantQuery, sbclookup.Queries[len(sbclookup.Queries)-1])
+
+	// Another target plans the query again.
+	session.TargetString = "@primary"
+	_, err := executor.Execute(ctx, "TestPreparedStatement", session, sql, map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, planned+2, preparedStatementPlans.Counts()["Planned"])
+
+	// The other queries that run in the context of the statement are not affected.
+	assert.Nil(t, preparedStatementFromContext(ctx, "select 1 from dual"))
+}
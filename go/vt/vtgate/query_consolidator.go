@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var queryConsolidations = stats.NewCountersWithSingleLabel(
+	"VtgateQueryConsolidations",
+	"Number of read-only queries that waited for the result of an identical query instead of running, by keyspace",
+	"Keyspace")
+
+// queryConsolidator shares the execution of the identical read-only queries
+// that run at the same time outside of a transaction, like the consolidator
+// of vttablet, but before they fan out to the shards: the first query runs,
+// and the queries with the same normalized query, bind variables, target,
+// caller and session variables that arrive while it runs wait for its result
+// instead of sending their own queries to the shards.
+//
+// The sessions that set skip_query_consolidation don't share their queries.
+// A nil queryConsolidator consolidates nothing.
+type queryConsolidator struct {
+	consolidator *sync2.Consolidator
+}
+
+// consolidatedResult is what the first query shares with the ones that
+// waited for it.
+type consolidatedResult struct {
+	result *sqltypes.Result
+	// warnings are the warnings that the query added to its session, such
+	// as the ones of the partial results of the scatter queries.
+	warnings []*querypb.QueryWarning
+	// canceled is true if the context of the query was done, in which case
+	// the queries that waited for it run on their own.
+	canceled bool
+}
+
+// newQueryConsolidator returns nil if the consolidation is disabled.
+func newQueryConsolidator(enabled bool) *queryConsolidator {
+	if !enabled {
+		return nil
+	}
+	return &queryConsolidator{consolidator: sync2.NewConsolidator()}
+}
+
+// execute runs the plan with run, or waits for the result of an identical
+// query that is already running.
+func (qc *queryConsolidator) execute(ctx context.Context, safeSession *SafeSession, plan *engine.Plan, bindVars map[string]*querypb.BindVariable, run func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if qc == nil || !consolidatable(safeSession, plan) {
+		return run()
+	}
+
+	r, created := qc.consolidator.Create(queryKey(ctx, safeSession, plan.Original, bindVars))
+	if !created {
+		if err := waitContext(ctx, r); err != nil {
+			return nil, err
+		}
+		shared, _ := r.Result.(*consolidatedResult)
+		if shared == nil || shared.canceled {
+			return run()
+		}
+		queryConsolidations.Add(plan.Instructions.GetKeyspaceName(), 1)
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		for _, warning := range shared.warnings {
+			safeSession.RecordWarning(warning)
+		}
+		return shared.result.Copy(), nil
+	}
+	defer r.Broadcast()
+
+	warnings := len(safeSession.GetWarnings())
+	qr, err := run()
+	shared := &consolidatedResult{canceled: ctx.Err() != nil}
+	if err == nil {
+		// The result is copied, since the caller may change it while the
+		// queries that waited copy it.
+		shared.result = qr.Copy()
+		shared.warnings = safeSession.GetWarnings()[warnings:]
+	}
+	r.Result, r.Err = shared, err
+	return qr, err
+}
+
+// waitContext waits for the result of the query that runs, or until ctx is
+// done. The goroutine that waits ends when the query broadcasts its result.
+func waitContext(ctx context.Context, r *sync2.Result) error {
+	done := make(chan struct{})
+	go func() {
+		r.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return vterrors.Wrap(ctx.Err(), "the query was done while it waited for an identical query")
+	}
+}
+
+// consolidatable returns true if the plan is a read-only query whose result
+// doesn't depend on when it runs, and the session can share its execution.
+func consolidatable(safeSession *SafeSession, plan *engine.Plan) bool {
+	if !plan.Consolidatable || safeSession.InTransaction() || safeSession.InReservedConn() {
+		return false
+	}
+	return !safeSession.GetOptions().GetSkipQueryConsolidation()
+}
+
+// consolidatableStatement returns true if the statement is a read-only query
+// whose result doesn't depend on when it runs, and that changes nothing in
+// its session. It is computed once, when the plan of the statement is built.
+func consolidatableStatement(stmt sqlparser.Statement) bool {
+	if sqlparser.ASTToStatementType(stmt) != sqlparser.StmtSelect {
+		return false
+	}
+
+	ok := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.Into != nil || node.SQLCalcFoundRows {
+				ok = false
+			}
+		case *sqlparser.Union:
+			if node.Lock != sqlparser.NoLock || node.Into != nil {
+				ok = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			ok = false
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFunctions[node.Name.Lowered()] {
+				ok = false
+			}
+		}
+		return ok, nil
+	}, stmt)
+	return ok
+}
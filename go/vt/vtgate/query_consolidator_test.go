@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestQueryConsolidatable(t *testing.T) {
+	session := NewAutocommitSession(&vtgatepb.Session{})
+	testcases := []struct {
+		query string
+		ok    bool
+	}{
+		{"select * from t1", true},
+		{"select * from t1 union select * from t2", true},
+		{"select 1 from dual", true},
+		{"select * from t1 for update", false},
+		{"select * from t1 lock in share mode", false},
+		{"select sql_calc_found_rows * from t1 limit 1", false},
+		{"select now() from t1", false},
+		{"select * from t1 where id = rand()", false},
+		{"select get_lock('a', 1) from dual", false},
+		{"update t1 set a = 1", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ok, consolidatableStatement(stmt))
+		})
+	}
+
+	plan := &engine.Plan{Type: sqlparser.StmtSelect, Original: "select * from t1", Consolidatable: true}
+	assert.True(t, consolidatable(session, plan))
+	assert.False(t, consolidatable(NewSafeSession(&vtgatepb.Session{InTransaction: true}), plan), "the queries in a transaction are not consolidated")
+	skip := NewAutocommitSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{SkipQueryConsolidation: true}})
+	assert.False(t, consolidatable(skip, plan), "the session opted out")
+	assert.False(t, consolidatable(session, &engine.Plan{Type: sqlparser.StmtSelect, Original: "select now() from t1"}), "the plan is not consolidatable")
+}
+
+// runConsolidated runs n identical queries at the same time, and returns
+// their results once the first one returned its result or err.
+func runConsolidated(t *testing.T, ctx context.Context, qc *queryConsolidator, n int, result *sqltypes.Result, err error) ([]*sqltypes.Result, []error, []*SafeSession, int64) {
+	t.Helper()
+	plan := &engine.Plan{
+		Type:           sqlparser.StmtSelect,
+		Original:       "select id from user",
+		Consolidatable: true,
+		Instructions: &engine.Route{
+			RoutingParameters: &engine.RoutingParameters{Keyspace: &vindexes.Keyspace{Name: "ks"}},
+		},
+	}
+	var runs int64
+	started := make(chan struct{})
+	release := make(chan struct{})
+	results := make([]*sqltypes.Result, n)
+	errs := make([]error, n)
+	sessions := make([]*SafeSession, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sessions[i] = NewAutocommitSession(&vtgatepb.Session{})
+		queryCtx := context.Background()
+		if i == 0 {
+			queryCtx = ctx
+		}
+		wg.Add(1)
+		go func(i int, ctx context.Context) {
+			defer wg.Done()
+			results[i], errs[i] = qc.execute(ctx, sessions[i], plan, nil, func() (*sqltypes.Result, error) {
+				if atomic.AddInt64(&runs, 1) > 1 {
+					return result, nil
+				}
+				close(started)
+				<-release
+				sessions[i].RecordWarning(&querypb.QueryWarning{Code: 1, Message: "partial result"})
+				return result, err
+			})
+		}(i, queryCtx)
+		if i == 0 {
+			<-started
+		}
+	}
+	// Wait until the other queries wait for the first one.
+	require.Eventually(t, func() bool {
+		items := qc.consolidator.Items()
+		return len(items) == 1 && items[0].Count == int64(n-1)
+	}, 10*time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	return results, errs, sessions, atomic.LoadInt64(&runs)
+}
+
+func TestQueryConsolidator(t *testing.T) {
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	consolidations := queryConsolidations.Counts()["ks"]
+
+	results, errs, sessions, runs := runConsolidated(t, context.Background(), newQueryConsolidator(true), 3, result, nil)
+	assert.EqualValues(t, 1, runs, "the queries share a single execution")
+	for i := range results {
+		require.NoError(t, errs[i])
+		assert.True(t, result.Equal(results[i]))
+		assert.Len(t, sessions[i].GetWarnings(), 1, "the warnings are shared")
+	}
+	assert.EqualValues(t, consolidations+2, queryConsolidations.Counts()["ks"])
+
+	// The errors are shared too.
+	_, errs, _, runs = runConsolidated(t, context.Background(), newQueryConsolidator(true), 2, nil, errors.New("shard error"))
+	assert.EqualValues(t, 1, runs)
+	assert.EqualError(t, errs[0], "shard error")
+	assert.EqualError(t, errs[1], "shard error")
+
+	// But not the error of a query that was canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, errs, _, runs = runConsolidated(t, ctx, newQueryConsolidator(true), 2, result, context.Canceled)
+	assert.EqualValues(t, 2, runs)
+	assert.Equal(t, context.Canceled, errs[0])
+	require.NoError(t, errs[1])
+	assert.True(t, result.Equal(results[1]))
+
+	// The queries that wait stop waiting when their context is done.
+	qc := newQueryConsolidator(true)
+	plan := &engine.Plan{Type: sqlparser.StmtSelect, Original: "select id from user", Consolidatable: true}
+	session := NewAutocommitSession(&vtgatepb.Session{})
+	r, created := qc.consolidator.Create(queryKey(context.Background(), session, plan.Original, nil))
+	require.True(t, created)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := qc.execute(ctx, session, plan, nil, func() (*sqltypes.Result, error) {
+		t.Fatal("the query waits for the one that runs")
+		return nil, nil
+	})
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	r.Broadcast()
+
+	// A nil consolidator only runs the queries.
+	qc = nil
+	qr, err := qc.execute(context.Background(), NewAutocommitSession(&vtgatepb.Session{}), &engine.Plan{Type: sqlparser.StmtSelect}, nil, func() (*sqltypes.Result, error) {
+		return result, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, result, qr)
+	assert.Nil(t, newQueryConsolidator(false))
+}
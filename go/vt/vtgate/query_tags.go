@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	queryTagsStatsKeys      = flag.String("query_tags_stats_keys", "", "Comma separated list of the keys of the query tags, set with @@vitess_query_tags, that the query stats by tag are exported for. The tags of the other keys are only in the query log and the traces.")
+	queryTagsStatsMaxValues = flag.Int("query_tags_stats_max_values", 100, "Maximum number of values of each key of -query_tags_stats_keys that have their own query stats by tag. The queries of the values seen after it are counted under the value 'other'.")
+	queryTagsComment        = flag.Bool("query_tags_comment", false, "Add the query tags of the session as a comment to the queries that vtgate sends to the tablets, so that they show in the process list and the slow query log of MySQL")
+)
+
+const (
+	// maxQueryTags is the maximum number of tags of a session.
+	maxQueryTags = 16
+	// maxQueryTagLength is the maximum length of the key and of the value of a tag.
+	maxQueryTagLength = 64
+	// queryTagOtherValue is the value that the stats count the values under,
+	// once a key has queryTagsStatsMaxValues values.
+	queryTagOtherValue = "other"
+)
+
+var (
+	queryTimingsByTag = stats.NewMultiTimings(
+		"VtgateQueryTimingsByTag",
+		"Timings of the queries of the sessions with query tags, by tag key and value",
+		[]string{"Tag", "Value"})
+	shardQueriesByTag = stats.NewCountersWithMultiLabels(
+		"VtgateShardQueriesByTag",
+		"Shard queries sent for the queries of the sessions with query tags, by tag key and value",
+		[]string{"Tag", "Value"})
+	queryErrorsByTag = stats.NewCountersWithMultiLabels(
+		"VtgateQueryErrorsByTag",
+		"Failed queries of the sessions with query tags, by tag key and value",
+		[]string{"Tag", "Value"})
+)
+
+// queryTag is a key=value tag of the queries of a session.
+type queryTag struct {
+	key   string
+	value string
+}
+
+// isQueryTagChar returns true if c can be part of the key or the value of
+// a tag. The other characters are rejected, so that the tags can't close
+// the comment that they are added to.
+func isQueryTagChar(c rune) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.ContainsRune("_-.:/@", c)
+}
+
+func validQueryTagPart(s string) bool {
+	if s == "" || len(s) > maxQueryTagLength {
+		return false
+	}
+	for _, c := range s {
+		if !isQueryTagChar(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseQueryTags parses the comma separated list of key=value of
+// @@vitess_query_tags. The empty string has no tags.
+func parseQueryTags(s string) ([]queryTag, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var tags []queryTag
+	seen := make(map[string]bool)
+	for _, spec := range strings.Split(s, ",") {
+		parts := strings.Split(spec, "=")
+		if len(parts) != 2 {
+			return nil, invalidQueryTags(s, "each tag must be key=value")
+		}
+		tag := queryTag{key: strings.TrimSpace(parts[0]), value: strings.TrimSpace(parts[1])}
+		if !validQueryTagPart(tag.key) || !validQueryTagPart(tag.value) {
+			return nil, invalidQueryTags(s, "the keys and values must be 1 to 64 letters, digits or any of _-.:/@")
+		}
+		if seen[tag.key] {
+			return nil, invalidQueryTags(s, "duplicate key "+tag.key)
+		}
+		seen[tag.key] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxQueryTags {
+		return nil, invalidQueryTags(s, "too many tags")
+	}
+	return tags, nil
+}
+
+func invalidQueryTags(s, reason string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "variable 'vitess_query_tags' can't be set to the value of '%s': %s", s, reason)
+}
+
+// formatQueryTags is the reverse of parseQueryTags.
+func formatQueryTags(tags []queryTag) string {
+	parts := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		parts = append(parts, tag.key+"="+tag.value)
+	}
+	return strings.Join(parts, ",")
+}
+
+// normalizeQueryTags validates the tags of @@vitess_query_tags, and returns
+// them without the whitespace.
+func normalizeQueryTags(s string) (string, error) {
+	tags, err := parseQueryTags(s)
+	if err != nil {
+		return "", err
+	}
+	return formatQueryTags(tags), nil
+}
+
+// queryTagging attributes the queries of the sessions to the owners named
+// by their tags: it exports the query stats by tag, for the keys that are
+// configured, and adds the tags as a comment to the shard queries.
+//
+// The number of values of each key in the stats is bounded, so that the
+// tags that the clients make up can't blow up the cardinality of the stats.
+type queryTagging struct {
+	statsKeys map[string]bool
+	maxValues int
+	comment   bool
+
+	mu sync.Mutex
+	// values are the values of each key that have their own stats
+	values map[string]map[string]bool
+}
+
+func newQueryTagging(statsKeys string, maxValues int, comment bool) *queryTagging {
+	qt := &queryTagging{
+		statsKeys: make(map[string]bool),
+		maxValues: maxValues,
+		comment:   comment,
+		values:    make(map[string]map[string]bool),
+	}
+	for _, key := range strings.Split(statsKeys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			qt.statsKeys[key] = true
+		}
+	}
+	return qt
+}
+
+// statsValue returns the value that the stats count the tag under.
+func (qt *queryTagging) statsValue(tag queryTag) string {
+	qt.mu.Lock()
+	defer qt.mu.Unlock()
+	values, ok := qt.values[tag.key]
+	if !ok {
+		values = make(map[string]bool)
+		qt.values[tag.key] = values
+	}
+	if values[tag.value] {
+		return tag.value
+	}
+	if len(values) >= qt.maxValues {
+		return queryTagOtherValue
+	}
+	values[tag.value] = true
+	return tag.value
+}
+
+// record adds the query of the log record to the stats of its tags.
+func (qt *queryTagging) record(logStats *LogStats) {
+	if qt == nil || len(qt.statsKeys) == 0 || logStats.QueryTags == "" {
+		return
+	}
+	tags, err := parseQueryTags(logStats.QueryTags)
+	if err != nil {
+		return
+	}
+	for _, tag := range tags {
+		if !qt.statsKeys[tag.key] {
+			continue
+		}
+		labels := []string{tag.key, qt.statsValue(tag)}
+		queryTimingsByTag.Record(labels, logStats.StartTime)
+		shardQueriesByTag.Add(labels, int64(logStats.ShardQueries))
+		if logStats.Error != nil {
+			queryErrorsByTag.Add(labels, 1)
+		}
+	}
+}
+
+// commentShardQueries adds the tags of the session as a leading comment of
+// the shard queries, unless they already have it, which is the case of the
+// queries that a primitive runs through the executor on behalf of another.
+func (qt *queryTagging) commentShardQueries(comments sqlparser.MarginComments, tags string) sqlparser.MarginComments {
+	if qt == nil || !qt.comment || tags == "" {
+		return comments
+	}
+	comment := "/* vitess_query_tags: " + tags + " */ "
+	if !strings.Contains(comments.Leading, comment) {
+		comments.Leading += comment
+	}
+	return comments
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestParseQueryTags(t *testing.T) {
+	tags, err := parseQueryTags(" team=checkout , job = backfill,ticket=OPS-123:a/b@c.d")
+	require.NoError(t, err)
+	assert.Equal(t, []queryTag{{"team", "checkout"}, {"job", "backfill"}, {"ticket", "OPS-123:a/b@c.d"}}, tags)
+	assert.Equal(t, "team=checkout,job=backfill,ticket=OPS-123:a/b@c.d", formatQueryTags(tags))
+
+	tags, err = parseQueryTags(" ")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	for _, s := range []string{
+		"team",
+		"team=",
+		"=checkout",
+		"team=a=b",
+		"team=checkout,",
+		"team=check out",
+		"team=*/ drop table t /*",
+		"team=a,team=b",
+		"team=" + strings.Repeat("a", maxQueryTagLength+1),
+		strings.Repeat("a=b,", maxQueryTags) + "c=d",
+	} {
+		_, err := parseQueryTags(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestQueryTaggingRecord(t *testing.T) {
+	qt := newQueryTagging("team, job", 2, false)
+	record := func(tags string, shardQueries uint64) {
+		logStats := NewLogStats(context.Background(), "Execute", "select 1", nil)
+		logStats.QueryTags = tags
+		logStats.ShardQueries = shardQueries
+		qt.record(logStats)
+	}
+	counts := func() map[string]int64 {
+		return shardQueriesByTag.Counts()
+	}
+	before := counts()
+	timingsBefore := queryTimingsByTag.Counts()["team.checkout"]
+
+	record("team=checkout,job=backfill,ticket=OPS-1", 2)
+	record("team=search", 1)
+	record("team=payments", 4)
+	record("team=ads", 8)
+	record("team=checkout", 16)
+	record("", 32)
+
+	after := counts()
+	assert.EqualValues(t, 18, after["team.checkout"]-before["team.checkout"])
+	assert.EqualValues(t, 1, after["team.search"]-before["team.search"])
+	// only the first two values of each key have their own stats
+	assert.EqualValues(t, 12, after["team.other"]-before["team.other"])
+	assert.EqualValues(t, 0, after["team.payments"])
+	assert.EqualValues(t, 2, after["job.backfill"]-before["job.backfill"])
+	// the keys that are not configured have no stats
+	assert.NotContains(t, after, "ticket.OPS-1")
+	assert.EqualValues(t, 2, queryTimingsByTag.Counts()["team.checkout"]-timingsBefore)
+}
+
+func TestQueryTaggingComment(t *testing.T) {
+	comments := sqlparser.MarginComments{Leading: "/* app */ ", Trailing: " /* trailing */"}
+
+	var qt *queryTagging
+	assert.Equal(t, comments, qt.commentShardQueries(comments, "team=checkout"))
+	qt = newQueryTagging("", 1, false)
+	assert.Equal(t, comments, qt.commentShardQueries(comments, "team=checkout"))
+
+	qt = newQueryTagging("", 1, true)
+	assert.Equal(t, comments, qt.commentShardQueries(comments, ""))
+	commented := qt.commentShardQueries(comments, "team=checkout")
+	assert.Equal(t, sqlparser.MarginComments{
+		Leading:  "/* app */ /* vitess_query_tags: team=checkout */ ",
+		Trailing: " /* trailing */",
+	}, commented)
+	// the queries that already have the comment don't get it twice
+	assert.Equal(t, commented, qt.commentShardQueries(commented, "team=checkout"))
+}
+
+func TestQueryTagsExecute(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+	executor.queryTags = newQueryTagging("team", 10, true)
+	logChan := QueryLogger.Subscribe("TestQueryTags")
+	defer QueryLogger.Unsubscribe(logChan)
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	_, err := executor.Execute(context.Background(), "TestQueryTags", session, "set @@vitess_query_tags = 'team=checkout, job=backfill'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "team=checkout,job=backfill", getQueryLog(logChan).QueryTags)
+
+	result, err := executor.Execute(context.Background(), "TestQueryTags", session, "select @@vitess_query_tags", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewVarChar("team=checkout,job=backfill")}}, result.Rows)
+	getQueryLog(logChan)
+
+	before := queryTimingsByTag.Counts()["team.checkout"]
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestQueryTags", session, "/* app */ select id from user where id = 1", nil)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Queries, 1)
+	assert.Equal(t, "/* app */ /* vitess_query_tags: team=checkout,job=backfill */ select id from `user` where id = :vtg1", sbc1.Queries[0].Sql)
+	logStats := getQueryLog(logChan)
+	assert.Equal(t, "team=checkout,job=backfill", logStats.QueryTags)
+	assert.Equal(t, "/* app */ select id from `user` where id = :vtg1", logStats.SQL)
+	assert.EqualValues(t, 1, queryTimingsByTag.Counts()["team.checkout"]-before)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// gtidExecutedQuery returns the GTID set that a primary has executed.
+const gtidExecutedQuery = "select @@global.gtid_executed"
+
+// readAtTimestampLayout is the layout of the read_at_timestamp system
+// variable, a UTC time with an optional fractional part.
+const readAtTimestampLayout = "2006-01-02 15:04:05.999999"
+
+var (
+	readYourWritesTimeout = flag.Duration("read_your_writes_timeout", 1*time.Second, "how long a replica or rdonly tablet waits to catch up with the writes of a session that enabled read_your_writes, before the read is sent to the primary. Can be overridden with the read_after_write_timeout system variable")
+
+	readYourWritesPrimaryFallbacks = stats.NewCountersWithSingleLabel(
+		"VtgateReadYourWritesPrimaryFallbacks",
+		"Number of reads of sessions with read_your_writes or read_after_write_gtid that were sent to the primary because no replica caught up with the GTID set in time",
+		"Keyspace")
+
+	readAtTimestampPrimaryFallbacks = stats.NewCountersWithSingleLabel(
+		"VtgateReadAtTimestampPrimaryFallbacks",
+		"Number of reads of sessions with read_at_timestamp that were sent to the primary because no replica reached the timestamp in time",
+		"Keyspace")
+)
+
+// readYourWritesOptions returns the options of the queries that the session
+// sends to the target. The reads on replica and rdonly tablets wait for the
+// GTID set returned by ReadGtid, which includes the writes of the session on
+// the shard of the target if it enabled read_your_writes, and for the
+// read_at_timestamp of the session.
+func readYourWritesOptions(session *SafeSession, target *querypb.Target) *querypb.ExecuteOptions {
+	if session == nil || session.Session == nil {
+		return nil
+	}
+	options := session.Session.Options
+	if target == nil || target.TabletType == topodatapb.TabletType_PRIMARY {
+		return options
+	}
+	gtid := session.ReadGtid(target.Keyspace, target.Shard)
+	readAt := session.GetReadAtTimestamp()
+	if gtid == "" && readAt == 0 {
+		return options
+	}
+	timeout := session.GetReadAfterWriteTimeout()
+	if timeout <= 0 {
+		timeout = readYourWritesTimeout.Seconds()
+	}
+	if options == nil {
+		options = &querypb.ExecuteOptions{}
+	} else {
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+	}
+	options.ReadAfterWriteGtid = gtid
+	options.ReadAtTimestamp = readAt
+	options.ReadAfterWriteTimeout = timeout
+	return options
+}
+
+// parseReadAtTimestamp returns the time of a read_at_timestamp value in
+// nanoseconds since the epoch. An empty value is 0, which makes the reads
+// stop waiting.
+func parseReadAtTimestamp(timestamp string) (int64, error) {
+	if timestamp == "" {
+		return 0, nil
+	}
+	readAt, err := time.Parse(readAtTimestampLayout, timestamp)
+	if err != nil {
+		return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "variable 'read_at_timestamp' can't be set to the value of '%s'", timestamp)
+	}
+	return readAt.UnixNano(), nil
+}
+
+// formatReadAtTimestamp is the reverse of parseReadAtTimestamp.
+func formatReadAtTimestamp(readAt int64) string {
+	if readAt == 0 {
+		return ""
+	}
+	return time.Unix(0, readAt).UTC().Format(readAtTimestampLayout)
+}
+
+// recordsWrites returns true if the session records the GTID sets of its
+// writes on the target.
+func recordsWrites(session *SafeSession, target *querypb.Target) bool {
+	return target != nil && target.TabletType == topodatapb.TabletType_PRIMARY && session.ReadYourWrites()
+}
+
+// recordWrite records the GTID set of a write that the session committed
+// on the primary of the target. sessionStateChanges is the GTID set that
+// the primary reported with the result of the write, if any. Otherwise,
+// the GTID set that the primary has executed is fetched, which includes the
+// write. If it can't be fetched, the session gets a warning, since its
+// following reads may not see the write.
+func recordWrite(ctx context.Context, session *SafeSession, qs queryservice.QueryService, target *querypb.Target, sessionStateChanges string) {
+	if !recordsWrites(session, target) {
+		return
+	}
+	gtid := sessionStateChanges
+	if gtid == "" {
+		var err error
+		gtid, err = fetchGtidExecuted(ctx, qs, target)
+		if err != nil {
+			log.Warningf("read_your_writes: can't fetch the executed GTID set of %s/%s: %v", target.Keyspace, target.Shard, err)
+			session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("read_your_writes: the next reads on %s/%s may not see this write: %v", target.Keyspace, target.Shard, err)})
+			return
+		}
+	}
+	if err := session.RecordWriteGtid(target.Keyspace, target.Shard, gtid); err != nil {
+		log.Warningf("read_your_writes: can't record the GTID set %q of %s/%s: %v", gtid, target.Keyspace, target.Shard, err)
+		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("read_your_writes: the next reads on %s/%s may not see this write: %v", target.Keyspace, target.Shard, err)})
+	}
+}
+
+// recordReplicationLag gives the session a warning with the replication lag
+// that the replica of the target reported with its result, which it does when
+// the session sets include_replication_lag.
+func recordReplicationLag(session *SafeSession, target *querypb.Target, qr *sqltypes.Result) {
+	if target == nil || qr == nil || qr.ReplicationLag <= 0 {
+		return
+	}
+	session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("replication lag of %s/%s: %v", target.Keyspace, target.Shard, qr.ReplicationLag.Round(time.Microsecond))})
+}
+
+// fetchGtidExecuted returns the GTID set that the primary of the target has executed.
+func fetchGtidExecuted(ctx context.Context, qs queryservice.QueryService, target *querypb.Target) (string, error) {
+	qr, err := qs.Execute(ctx, target, gtidExecutedQuery, nil, 0, 0, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", gtidExecutedQuery, qr.Rows)
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
+
+// mergeGtidSets returns the union of two MySQL 5.6+ GTID sets.
+func mergeGtidSets(set, other string) (string, error) {
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, set)
+	if err != nil {
+		return "", err
+	}
+	if other == "" {
+		return pos.String(), nil
+	}
+	otherPos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, other)
+	if err != nil {
+		return "", err
+	}
+	return pos.GTIDSet.Union(otherPos.GTIDSet).String(), nil
+}
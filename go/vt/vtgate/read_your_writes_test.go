@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+const (
+	rywSID1 = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	rywSID2 = "10111213-1415-1617-1819-1a1b1c1d1e1f"
+)
+
+func gtidExecutedResult(gtid string) []*sqltypes.Result {
+	return []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"),
+		gtid,
+	)}
+}
+
+func TestSafeSessionRecordWriteGtid(t *testing.T) {
+	session := NewSafeSession(nil)
+	session.SetReadYourWrites(true)
+	require.True(t, session.ReadYourWrites())
+
+	require.NoError(t, session.RecordWriteGtid("ks", "-80", rywSID1+":1-5"))
+	require.NoError(t, session.RecordWriteGtid("ks", "-80", rywSID1+":6-7,"+rywSID2+":3"))
+	require.NoError(t, session.RecordWriteGtid("ks", "80-", rywSID2+":1-2"))
+	assert.Equal(t, rywSID1+":1-7,"+rywSID2+":3", session.WriteGtid("ks", "-80"))
+	assert.Equal(t, rywSID2+":1-2", session.WriteGtid("ks", "80-"))
+	assert.Equal(t, "", session.WriteGtid("other", "-80"))
+
+	require.Error(t, session.RecordWriteGtid("ks", "-80", "not a gtid"))
+	assert.Equal(t, rywSID1+":1-7,"+rywSID2+":3", session.WriteGtid("ks", "-80"))
+
+	session.SetReadYourWrites(false)
+	assert.False(t, session.ReadYourWrites())
+	assert.Equal(t, "", session.WriteGtid("ks", "-80"))
+}
+
+func TestReadYourWritesOptions(t *testing.T) {
+	replica := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_REPLICA}
+	primary := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY}
+	options := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	session := NewSafeSession(&vtgatepb.Session{Options: options})
+	require.NoError(t, session.RecordWriteGtid("ks", "-80", rywSID1+":1-5"))
+
+	// read_your_writes is disabled
+	assert.Same(t, options, readYourWritesOptions(session, replica))
+
+	session.SetReadYourWrites(true)
+	require.NoError(t, session.RecordWriteGtid("ks", "-80", rywSID1+":1-5"))
+	assert.Same(t, options, readYourWritesOptions(session, primary))
+	assert.Same(t, options, readYourWritesOptions(session, &querypb.Target{Keyspace: "ks", Shard: "80-", TabletType: topodatapb.TabletType_REPLICA}))
+
+	got := readYourWritesOptions(session, replica)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, got.Workload)
+	assert.Equal(t, rywSID1+":1-5", got.ReadAfterWriteGtid)
+	assert.Equal(t, readYourWritesTimeout.Seconds(), got.ReadAfterWriteTimeout)
+	assert.Empty(t, options.ReadAfterWriteGtid, "the options of the session must not change")
+
+	session.SetReadAfterWriteTimeout(0.5)
+	assert.Equal(t, 0.5, readYourWritesOptions(session, replica).ReadAfterWriteTimeout)
+
+	// The reads of all the shards wait for the read_after_write_gtid of the session.
+	session.SetReadAfterWriteGTID(rywSID2 + ":1-3")
+	assert.Equal(t, rywSID1+":1-5,"+rywSID2+":1-3", readYourWritesOptions(session, replica).ReadAfterWriteGtid)
+	session.SetReadYourWrites(false)
+	assert.Equal(t, rywSID2+":1-3", readYourWritesOptions(session, replica).ReadAfterWriteGtid)
+	assert.Same(t, options, readYourWritesOptions(session, primary))
+	session.SetReadAfterWriteGTID("")
+
+	// And for its read_at_timestamp.
+	session.SetReadAtTimestamp(1646370367500000000)
+	got = readYourWritesOptions(session, replica)
+	assert.Empty(t, got.ReadAfterWriteGtid)
+	assert.EqualValues(t, 1646370367500000000, got.ReadAtTimestamp)
+	assert.Equal(t, 0.5, got.ReadAfterWriteTimeout)
+	assert.Same(t, options, readYourWritesOptions(session, primary))
+}
+
+func TestReadAtTimestamp(t *testing.T) {
+	readAt, err := parseReadAtTimestamp("2022-03-04 05:06:07")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1646370367000000000, readAt)
+	assert.Equal(t, "2022-03-04 05:06:07", formatReadAtTimestamp(readAt))
+
+	readAt, err = parseReadAtTimestamp("2022-03-04 05:06:07.123456")
+	require.NoError(t, err)
+	assert.Equal(t, "2022-03-04 05:06:07.123456", formatReadAtTimestamp(readAt))
+
+	readAt, err = parseReadAtTimestamp("")
+	require.NoError(t, err)
+	assert.Zero(t, readAt)
+	assert.Equal(t, "", formatReadAtTimestamp(readAt))
+
+	_, err = parseReadAtTimestamp("2022-03-04T05:06:07Z")
+	require.EqualError(t, err, "variable 'read_at_timestamp' can't be set to the value of '2022-03-04T05:06:07Z'")
+}
+
+func TestScatterConnReadYourWrites(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, rss01 := newTestTxConnEnv(t, "TestScatterConnReadYourWrites")
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+	session.SetReadYourWrites(true)
+
+	// An autocommitted write records the GTID set reported by the primary,
+	// or fetches the executed GTID set if there is none.
+	sbc0.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: rywSID1 + ":8"}})
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, queries, session, true /* autocommit */, false)
+	require.Empty(t, errs)
+	assert.Equal(t, rywSID1+":8", session.WriteGtid("TestScatterConnReadYourWrites", "0"))
+
+	sbc0.SetResults(append([]*sqltypes.Result{{RowsAffected: 1}}, gtidExecutedResult(rywSID1+":1-9")...))
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, queries, session, true /* autocommit */, false)
+	require.Empty(t, errs)
+	assert.Equal(t, rywSID1+":1-9", session.WriteGtid("TestScatterConnReadYourWrites", "0"))
+	assert.Equal(t, []string{"query1", "query1", gtidExecutedQuery}, sbc0.StringQueries())
+
+	// An autocommitted statement that changed no rows has nothing to record.
+	sbc0.Queries = nil
+	sbc0.SetResults([]*sqltypes.Result{{}})
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, queries, session, true /* autocommit */, false)
+	require.Empty(t, errs)
+	assert.Equal(t, []string{"query1"}, sbc0.StringQueries())
+
+	// Nor does a transaction that changed no rows.
+	session.Session.InTransaction = true
+	sbc0.SetResults([]*sqltypes.Result{{}})
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	require.Empty(t, errs)
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, []string{"query1", "query1"}, sbc0.StringQueries())
+	assert.Equal(t, rywSID1+":1-9", session.WriteGtid("TestScatterConnReadYourWrites", "0"))
+
+	// A transaction records the executed GTID set of the shards it wrote to, once committed.
+	session.Session.InTransaction = true
+	sbc0.SetResults([]*sqltypes.Result{{RowsAffected: 1}})
+	sbc1.SetResults([]*sqltypes.Result{{}})
+	_, errs = sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
+	require.Empty(t, errs)
+	sbc0.SetResults([]*sqltypes.Result{{RowsAffected: 1}})
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 2}})
+	_, errs = sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
+	require.Empty(t, errs)
+	sbc0.SetResults(gtidExecutedResult(rywSID1 + ":1-10"))
+	sbc1.SetResults(gtidExecutedResult(rywSID2 + ":1-3"))
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, rywSID1+":1-10", session.WriteGtid("TestScatterConnReadYourWrites", "0"))
+	assert.Equal(t, rywSID2+":1-3", session.WriteGtid("TestScatterConnReadYourWrites", "1"))
+
+	// The reads on replicas wait for the writes of the session on their shard.
+	replica := &querypb.Target{Keyspace: "TestScatterConnReadYourWrites", Shard: "1", TabletType: topodatapb.TabletType_REPLICA}
+	sbcReplica := sc.gateway.(*TabletGateway).hc.(*discovery.FakeHealthCheck).AddTestTablet("aa", "1", 2, replica.Keyspace, replica.Shard, replica.TabletType, true, 1, nil)
+	_, errs = sc.ExecuteMultiShard(ctx, []*srvtopo.ResolvedShard{{Target: replica, Gateway: sc.gateway}}, queries, NewSafeSession(session.Session), false, false)
+	require.Empty(t, errs)
+	require.Len(t, sbcReplica.Options, 1)
+	assert.Equal(t, rywSID2+":1-3", sbcReplica.Options[0].ReadAfterWriteGtid)
+
+	sbc1.Options = nil
+	_, errs = sc.ExecuteMultiShard(ctx, rss1, queries, session, false, false)
+	require.Empty(t, errs)
+	require.Len(t, sbc1.Options, 1)
+	assert.Empty(t, sbc1.Options[0].GetReadAfterWriteGtid(), "the primary has executed the writes")
+}
+
+func TestScatterConnReplicationLag(t *testing.T) {
+	sc, sbc0, sbc1, _, _, rss01 := newTestTxConnEnv(t, "TestScatterConnReplicationLag")
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+
+	// Each shard that reports its replication lag gives the session a warning.
+	sbc0.SetResults([]*sqltypes.Result{{ReplicationLag: 1500 * time.Millisecond}})
+	sbc1.SetResults([]*sqltypes.Result{{}})
+	_, errs := sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
+	require.Empty(t, errs)
+	assert.Equal(t, []*querypb.QueryWarning{{Message: "replication lag of TestScatterConnReplicationLag/0: 1.5s"}}, session.GetWarnings())
+
+	session.ClearWarnings()
+	sbc0.SetResults([]*sqltypes.Result{{}})
+	sbc1.SetResults([]*sqltypes.Result{{ReplicationLag: 250 * time.Microsecond}})
+	errs = sc.StreamExecuteMulti(ctx, "query", rss01, []map[string]*querypb.BindVariable{nil, nil}, session, false, func(*sqltypes.Result) error { return nil })
+	require.Empty(t, errs)
+	assert.Equal(t, []*querypb.QueryWarning{{Message: "replication lag of TestScatterConnReplicationLag/1: 250µs"}}, session.GetWarnings())
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	drainPeriod      = flag.Duration("drain_period", 30*time.Second, "how long vtgate stays DRAINING, and reported as not ready, before it is considered DRAINED")
+	readinessTimeout = flag.Duration("readiness_timeout", 5*time.Second, "the timeout to resolve the shards of the keyspaces reported by /debug/readiness")
+)
+
+const (
+	pathReadiness = "/debug/readiness"
+	pathDrain     = "/debug/drain"
+)
+
+// drainState is the state of the drain state machine of a vtgate:
+// SERVING -> DRAINING -> DRAINED, and back to SERVING when the drain
+// is cancelled.
+type drainState int64
+
+const (
+	drainServing drainState = iota
+	drainDraining
+	drainDrained
+)
+
+func (s drainState) String() string {
+	switch s {
+	case drainServing:
+		return "SERVING"
+	case drainDraining:
+		return "DRAINING"
+	case drainDrained:
+		return "DRAINED"
+	}
+	return fmt.Sprintf("drainState(%d)", s)
+}
+
+// readiness reports whether a vtgate should receive traffic. It tracks the
+// drain state, and how long each shard has been resolvable to a healthy
+// tablet, or not, as seen by the healthcheck.
+type readiness struct {
+	hc          discovery.HealthCheck
+	resolver    *srvtopo.Resolver
+	drainPeriod time.Duration
+	now         func() time.Time
+	started     time.Time
+
+	mu         sync.Mutex
+	state      drainState
+	stateSince time.Time
+	drainTimer *time.Timer
+	// targets are keyed by keyspace/shard/tablet type.
+	targets map[string]*targetReadiness
+}
+
+// targetReadiness is the last known health of a target.
+type targetReadiness struct {
+	healthy bool
+	// since is when the target became healthy or unhealthy.
+	since time.Time
+}
+
+func newReadiness(hc discovery.HealthCheck, resolver *srvtopo.Resolver, drainPeriod time.Duration) *readiness {
+	now := time.Now()
+	return &readiness{
+		hc:          hc,
+		resolver:    resolver,
+		drainPeriod: drainPeriod,
+		now:         time.Now,
+		started:     now,
+		stateSince:  now,
+		targets:     make(map[string]*targetReadiness),
+	}
+}
+
+// registerStats exports the drain state.
+func (r *readiness) registerStats() {
+	stats.NewGaugeFunc("VtgateDrainState", "The drain state of vtgate: 0 for SERVING, 1 for DRAINING, 2 for DRAINED", func() int64 {
+		state, _ := r.drainState()
+		return int64(state)
+	})
+}
+
+// start follows the health of the targets until the context is done.
+func (r *readiness) start(ctx context.Context) {
+	updates := r.hc.Subscribe()
+	go func() {
+		defer r.hc.Unsubscribe(updates)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case th := <-updates:
+				if th != nil && th.Target != nil {
+					r.observe(th.Target)
+				}
+			}
+		}
+	}()
+}
+
+// observe records the health of a target, and returns the number of its
+// healthy tablets, and since when it is healthy or unhealthy.
+func (r *readiness) observe(target *querypb.Target) (int, time.Time) {
+	healthy := len(r.hc.GetHealthyTabletStats(target))
+	key := fmt.Sprintf("%s/%s/%s", target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tr, ok := r.targets[key]
+	if !ok || tr.healthy != (healthy > 0) {
+		tr = &targetReadiness{healthy: healthy > 0, since: r.now()}
+		r.targets[key] = tr
+	}
+	return healthy, tr.since
+}
+
+// drainState returns the drain state, and since when vtgate is in it.
+func (r *readiness) drainState() (drainState, time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state, r.stateSince
+}
+
+// drain moves a SERVING vtgate to DRAINING, and then to DRAINED after the
+// drain period. It does nothing if vtgate is already draining.
+func (r *readiness) drain() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state != drainServing {
+		return
+	}
+	log.Infof("vtgate is DRAINING for %v", r.drainPeriod)
+	r.setStateLocked(drainDraining)
+	r.drainTimer = time.AfterFunc(r.drainPeriod, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.state == drainDraining {
+			log.Infof("vtgate is DRAINED")
+			r.setStateLocked(drainDrained)
+		}
+	})
+}
+
+// resume moves a draining or drained vtgate back to SERVING.
+func (r *readiness) resume() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state == drainServing {
+		return
+	}
+	if r.drainTimer != nil {
+		r.drainTimer.Stop()
+		r.drainTimer = nil
+	}
+	log.Infof("vtgate is SERVING again")
+	r.setStateLocked(drainServing)
+}
+
+func (r *readiness) setStateLocked(state drainState) {
+	r.state = state
+	r.stateSince = r.now()
+}
+
+// Drainer changes the drain state of vtgate, for the servers of the admin
+// RPCs.
+type Drainer interface {
+	// DrainStatus returns the drain state: SERVING, DRAINING or DRAINED,
+	// and since when vtgate is in it.
+	DrainStatus() (string, time.Time)
+	// Drain moves a SERVING vtgate to DRAINING, and then to DRAINED after
+	// the drain period.
+	Drain()
+	// Resume moves a draining or drained vtgate back to SERVING.
+	Resume()
+}
+
+// DrainStatus is part of the Drainer interface.
+func (vtg *VTGate) DrainStatus() (string, time.Time) {
+	state, since := vtg.readiness.drainState()
+	return state.String(), since
+}
+
+// Drain is part of the Drainer interface.
+func (vtg *VTGate) Drain() {
+	vtg.readiness.drain()
+}
+
+// Resume is part of the Drainer interface.
+func (vtg *VTGate) Resume() {
+	vtg.readiness.resume()
+}
+
+// ReadinessReport is the body of /debug/readiness and /debug/drain.
+type ReadinessReport struct {
+	Ready           bool
+	State           string
+	StateAgeSeconds float64
+	UptimeSeconds   float64
+	// Keyspaces are only reported by /debug/readiness.
+	Keyspaces []*KeyspaceReadiness `json:",omitempty"`
+}
+
+// KeyspaceReadiness is the readiness of the shards of a keyspace.
+type KeyspaceReadiness struct {
+	Keyspace string
+	// Error is set if the shards of the keyspace could not be resolved.
+	Error  string `json:",omitempty"`
+	Shards []*ShardReadiness
+}
+
+// ShardReadiness is the health of the tablets of a type in a shard.
+type ShardReadiness struct {
+	Shard      string
+	TabletType string
+	// Tablets is the number of tablets known to the healthcheck,
+	// and Healthy the number of them that serve queries.
+	Tablets int
+	Healthy int
+	// Resolvable is true if the queries to the shard and the tablet type
+	// can be routed to a tablet.
+	Resolvable bool
+	// AgeSeconds is how long the shard has been resolvable, or not.
+	AgeSeconds float64
+}
+
+// report builds the readiness report. vtgate is ready if it is SERVING and
+// the shards of every keyspace can be resolved. The health of the shards
+// does not affect the readiness, since a shard without a healthy tablet
+// makes every vtgate equally unable to serve it.
+func (r *readiness) report(ctx context.Context, withKeyspaces bool) *ReadinessReport {
+	state, since := r.drainState()
+	now := r.now()
+	report := &ReadinessReport{
+		Ready:           state == drainServing,
+		State:           state.String(),
+		StateAgeSeconds: now.Sub(since).Seconds(),
+		UptimeSeconds:   now.Sub(r.started).Seconds(),
+	}
+	if !withKeyspaces {
+		return report
+	}
+
+	keyspaces, err := r.resolver.GetAllKeyspaces(ctx)
+	if err != nil {
+		report.Ready = false
+		report.Keyspaces = []*KeyspaceReadiness{{Error: err.Error()}}
+		return report
+	}
+	sort.Strings(keyspaces)
+
+	// The healthcheck knows about the tablets of every cell,
+	// and of the tablet types that the shards have.
+	tablets := make(map[string]map[topodatapb.TabletType]int)
+	for _, tcs := range r.hc.CacheStatus() {
+		shard := tcs.Target.Keyspace + "/" + tcs.Target.Shard
+		if tablets[shard] == nil {
+			tablets[shard] = make(map[topodatapb.TabletType]int)
+		}
+		tablets[shard][tcs.Target.TabletType] += len(tcs.TabletsStats)
+	}
+
+	for _, keyspace := range keyspaces {
+		kr := &KeyspaceReadiness{Keyspace: keyspace}
+		report.Keyspaces = append(report.Keyspaces, kr)
+		rss, err := r.resolver.ResolveDestination(ctx, keyspace, topodatapb.TabletType_PRIMARY, key.DestinationAllShards{})
+		if err != nil {
+			report.Ready = false
+			kr.Error = err.Error()
+			continue
+		}
+		for _, rs := range rss {
+			types := tablets[keyspace+"/"+rs.Target.Shard]
+			if types == nil {
+				types = make(map[topodatapb.TabletType]int)
+			}
+			if _, ok := types[topodatapb.TabletType_PRIMARY]; !ok {
+				types[topodatapb.TabletType_PRIMARY] = 0
+			}
+			for tabletType, count := range types {
+				healthy, since := r.observe(&querypb.Target{Keyspace: keyspace, Shard: rs.Target.Shard, TabletType: tabletType})
+				kr.Shards = append(kr.Shards, &ShardReadiness{
+					Shard:      rs.Target.Shard,
+					TabletType: topoproto.TabletTypeLString(tabletType),
+					Tablets:    count,
+					Healthy:    healthy,
+					Resolvable: healthy > 0,
+					AgeSeconds: now.Sub(since).Seconds(),
+				})
+			}
+		}
+		sort.Slice(kr.Shards, func(i, j int) bool {
+			if kr.Shards[i].Shard != kr.Shards[j].Shard {
+				return kr.Shards[i].Shard < kr.Shards[j].Shard
+			}
+			return kr.Shards[i].TabletType < kr.Shards[j].TabletType
+		})
+	}
+	return report
+}
+
+// writeReport writes the report as JSON.
+func writeReport(w http.ResponseWriter, status int, report *ReadinessReport) {
+	w.Header().Set("Content-Type", jsonContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Errorf("can't write the readiness report: %v", err)
+	}
+}
+
+// serveReadiness reports the drain state and the health of every shard,
+// with a 503 status if vtgate is not ready.
+func (r *readiness) serveReadiness(w http.ResponseWriter, req *http.Request) {
+	if err := acl.CheckAccessHTTP(req, acl.MONITORING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), *readinessTimeout)
+	defer cancel()
+	report := r.report(ctx, true)
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeReport(w, status, report)
+}
+
+// serveDrain changes the drain state: a POST with action=drain starts
+// draining, and a POST with action=resume serves again. It always returns
+// the drain state, with a 200 status: unlike /debug/readiness, it can be
+// used to check that vtgate is alive while it drains.
+func (r *readiness) serveDrain(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodPost {
+		if err := acl.CheckAccessHTTP(req, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		switch action := req.FormValue("action"); action {
+		case "drain":
+			r.drain()
+		case "resume":
+			r.resume()
+		default:
+			http.Error(w, fmt.Sprintf("unknown action %q, expected drain or resume", action), http.StatusBadRequest)
+			return
+		}
+	} else if err := acl.CheckAccessHTTP(req, acl.MONITORING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	writeReport(w, http.StatusOK, r.report(req.Context(), false))
+}
+
+func (r *readiness) registerHandlers() {
+	http.HandleFunc(pathReadiness, r.serveReadiness)
+	http.HandleFunc(pathDrain, r.serveDrain)
+}
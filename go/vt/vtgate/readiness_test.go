@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestReadinessDrain(t *testing.T) {
+	r := newReadiness(discovery.NewFakeHealthCheck(nil), nil, 50*time.Millisecond)
+	state, _ := r.drainState()
+	assert.Equal(t, drainServing, state)
+
+	// a drain that is resumed before the drain period never completes
+	r.drain()
+	state, _ = r.drainState()
+	assert.Equal(t, drainDraining, state)
+	r.resume()
+	time.Sleep(100 * time.Millisecond)
+	state, _ = r.drainState()
+	assert.Equal(t, drainServing, state)
+
+	r.drain()
+	assert.Eventually(t, func() bool {
+		state, _ := r.drainState()
+		return state == drainDrained
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// draining again does not go back to DRAINING
+	r.drain()
+	state, _ = r.drainState()
+	assert.Equal(t, drainDrained, state)
+	r.resume()
+	state, _ = r.drainState()
+	assert.Equal(t, drainServing, state)
+}
+
+func TestVTGateDrainer(t *testing.T) {
+	var drainer Drainer = &VTGate{readiness: newReadiness(discovery.NewFakeHealthCheck(nil), nil, time.Hour)}
+	state, _ := drainer.DrainStatus()
+	assert.Equal(t, "SERVING", state)
+	drainer.Drain()
+	state, _ = drainer.DrainStatus()
+	assert.Equal(t, "DRAINING", state)
+	drainer.Resume()
+	state, _ = drainer.DrainStatus()
+	assert.Equal(t, "SERVING", state)
+}
+
+func getReport(t *testing.T, handler http.HandlerFunc, method, url string) (int, *ReadinessReport) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(method, url, nil))
+	report := &ReadinessReport{}
+	if w.Code == http.StatusOK || w.Code == http.StatusServiceUnavailable {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), report))
+	}
+	return w.Code, report
+}
+
+func findKeyspace(report *ReadinessReport, keyspace string) *KeyspaceReadiness {
+	for _, kr := range report.Keyspaces {
+		if kr.Keyspace == keyspace {
+			return kr
+		}
+	}
+	return nil
+}
+
+func TestReadinessEndpoints(t *testing.T) {
+	keyspace := "TestReadinessEndpoints"
+	s := createSandbox(keyspace)
+	s.ShardSpec = "-80-"
+	hc := discovery.NewFakeHealthCheck(nil)
+	hc.AddTestTablet("aa", "1.1.1.1", 1001, keyspace, "-80", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	hc.AddTestTablet("aa", "1.1.1.1", 1002, keyspace, "-80", topodatapb.TabletType_REPLICA, true, 1, nil)
+	hc.AddTestTablet("aa", "1.1.1.1", 1003, keyspace, "-80", topodatapb.TabletType_REPLICA, false, 1, nil)
+	r := newReadiness(hc, srvtopo.NewResolver(new(sandboxTopo), nil, "aa"), time.Hour)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	r.started = now
+
+	code, report := getReport(t, r.serveReadiness, http.MethodGet, pathReadiness)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, report.Ready)
+	assert.Equal(t, "SERVING", report.State)
+	kr := findKeyspace(report, keyspace)
+	require.NotNil(t, kr)
+	assert.Equal(t, []*ShardReadiness{
+		{Shard: "-80", TabletType: "primary", Tablets: 1, Healthy: 1, Resolvable: true},
+		{Shard: "-80", TabletType: "replica", Tablets: 2, Healthy: 1, Resolvable: true},
+		{Shard: "80-", TabletType: "primary"},
+	}, kr.Shards)
+
+	// the ages of the shards are kept until they change
+	now = now.Add(time.Minute)
+	_, report = getReport(t, r.serveReadiness, http.MethodGet, pathReadiness)
+	kr = findKeyspace(report, keyspace)
+	require.NotNil(t, kr)
+	assert.EqualValues(t, 60, kr.Shards[0].AgeSeconds)
+	assert.EqualValues(t, 60, kr.Shards[2].AgeSeconds)
+
+	// a keyspace that can't be resolved makes vtgate not ready
+	s.SrvKeyspaceMustFail = 1
+	code, report = getReport(t, r.serveReadiness, http.MethodGet, pathReadiness)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, report.Ready)
+	kr = findKeyspace(report, keyspace)
+	require.NotNil(t, kr)
+	assert.Contains(t, kr.Error, "topo error GetSrvKeyspace")
+
+	// the drain state is changed with a POST
+	code, _ = getReport(t, r.serveDrain, http.MethodPost, pathDrain+"?action=unknown")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, report = getReport(t, r.serveDrain, http.MethodPost, pathDrain+"?action=drain")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "DRAINING", report.State)
+
+	// a draining vtgate is alive, but not ready
+	code, report = getReport(t, r.serveDrain, http.MethodGet, pathDrain)
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, report.Ready)
+	assert.Empty(t, report.Keyspaces)
+	assert.EqualValues(t, 60, report.UptimeSeconds)
+	code, _ = getReport(t, r.serveReadiness, http.MethodGet, pathReadiness)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	code, report = getReport(t, r.serveDrain, http.MethodPost, pathDrain+"?action=resume")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "SERVING", report.State)
+}
+
+func TestReadinessFollowsHealthCheck(t *testing.T) {
+	hc := discovery.NewFakeHealthCheck(make(chan *discovery.TabletHealth, 10))
+	sbc := hc.AddTestTablet("aa", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	r := newReadiness(hc, nil, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.start(ctx)
+
+	hc.Broadcast(sbc.Tablet())
+	assert.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		tr := r.targets["ks/0/primary"]
+		return tr != nil && tr.healthy
+	}, 5*time.Second, 10*time.Millisecond)
+
+	hc.SetServing(sbc.Tablet(), false)
+	hc.Broadcast(sbc.Tablet())
+	assert.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return !r.targets["ks/0/primary"].healthy
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,561 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/featureflags"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topoprotopb "vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+var (
+	resultCacheRequests = stats.NewCountersWithSingleLabel(
+		"VtgateResultCacheRequests",
+		"Number of SELECTs that could use the result cache, by result: Hit, Miss, Stale (expired or invalidated) or Bypass (not cacheable)",
+		"Result")
+	resultCacheInvalidations = stats.NewCountersWithSingleLabel(
+		"VtgateResultCacheInvalidations",
+		"Number of invalidations of the tables of the result cache, by source: Write (through this vtgate), Commit (at the end of a transaction that wrote them) or Schema (from the schema tracker)",
+		"Source")
+
+	resultCacheStatsOnce sync.Once
+)
+
+// nonDeterministicFunctions are the functions whose results change between
+// two executions of the same query, or depend on the session.
+var nonDeterministicFunctions = map[string]bool{
+	"benchmark":         true,
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_role":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"database":          true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"now":               true,
+	"rand":              true,
+	"release_all_locks": true,
+	"release_lock":      true,
+	"row_count":         true,
+	"schema":            true,
+	"session_user":      true,
+	"sleep":             true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// resultCache caches the results of the SELECTs that run outside of a
+// transaction, keyed by their normalized query, bind variables, target,
+// caller and session variables.
+//
+// A result is valid until its TTL expires, or until one of the tables of the
+// query changes version. The version of a table is incremented by the writes
+// and DDLs that run through this vtgate, including the writes of the lookup
+// vindexes to their tables, and by the schema changes reported by the schema
+// tracker. The tables are keyed by their keyspace and name. The writes that
+// run through other vtgates, or directly on the tablets, are only seen when
+// the TTL expires.
+//
+// The tables written in a transaction are invalidated again when it ends,
+// since the results read until it commits are those of the tables before the
+// transaction. A transaction committed by another vtgate is only seen when the
+// TTL expires. A nil resultCache caches nothing.
+type resultCache struct {
+	entries       *cache.LRUCache
+	ttl           time.Duration
+	maxResultSize int64
+	now           func() time.Time
+
+	mu sync.Mutex
+	// versions are keyed by the keyspace and the lower case name of the
+	// tables, as returned by tableKey.
+	versions map[string]uint64
+	// written has the tables written by the open transactions, keyed by
+	// the id of the transaction.
+	written map[string][]string
+	// generation is incremented to invalidate all the results.
+	generation uint64
+}
+
+// resultCacheEntry is a cached result, with the versions of its tables
+// when the query started.
+type resultCacheEntry struct {
+	result     *sqltypes.Result
+	expires    time.Time
+	generation uint64
+	versions   []uint64
+}
+
+// newResultCache returns nil if the size is not positive.
+func newResultCache(size int64, ttl time.Duration, maxResultSize int64) *resultCache {
+	if size <= 0 || ttl <= 0 {
+		return nil
+	}
+	rc := &resultCache{
+		entries: cache.NewLRUCache(size, func(v any) int64 {
+			return v.(*resultCacheEntry).result.CachedSize(true)
+		}),
+		ttl:           ttl,
+		maxResultSize: maxResultSize,
+		now:           time.Now,
+		versions:      make(map[string]uint64),
+		written:       make(map[string][]string),
+	}
+	resultCacheStatsOnce.Do(func() {
+		stats.NewGaugeFunc("VtgateResultCacheLength", "Number of results in the result cache", func() int64 {
+			return int64(rc.entries.Len())
+		})
+		stats.NewGaugeFunc("VtgateResultCacheSize", "Size of the results in the result cache, in bytes", func() int64 {
+			return rc.entries.UsedCapacity()
+		})
+		stats.NewCounterFunc("VtgateResultCacheEvictions", "Number of results evicted from the result cache to make room for new ones", func() int64 {
+			return rc.entries.Evictions()
+		})
+	})
+	return rc
+}
+
+// execute runs the plan with run, unless its result is in the cache. The
+// results of the cacheable SELECTs are cached.
+func (rc *resultCache) execute(ctx context.Context, safeSession *SafeSession, plan *engine.Plan, vschema *vindexes.VSchema, bindVars map[string]*querypb.BindVariable, run func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if rc == nil || plan.Type != sqlparser.StmtSelect {
+		return run()
+	}
+	tables, ok := rc.cacheableTables(safeSession, plan, vschema)
+	if !ok {
+		resultCacheRequests.Add("Bypass", 1)
+		return run()
+	}
+
+	now := rc.now()
+	generation, versions := rc.snapshot(tables)
+	key := queryKey(ctx, safeSession, plan.Original, bindVars)
+	if v, ok := rc.entries.Get(key); ok {
+		entry := v.(*resultCacheEntry)
+		if now.Before(entry.expires) && entry.generation == generation && equalVersions(entry.versions, versions) {
+			resultCacheRequests.Add("Hit", 1)
+			return entry.result.Copy(), nil
+		}
+		rc.entries.Delete(key)
+		resultCacheRequests.Add("Stale", 1)
+	} else {
+		resultCacheRequests.Add("Miss", 1)
+	}
+
+	warnings := len(safeSession.GetWarnings())
+	qr, err := run()
+	// The partial results of the scatter queries come with warnings.
+	if err != nil || len(safeSession.GetWarnings()) > warnings {
+		return qr, err
+	}
+	entry := &resultCacheEntry{
+		result:     qr.Copy(),
+		expires:    now.Add(rc.ttl),
+		generation: generation,
+		versions:   versions,
+	}
+	if rc.maxResultSize <= 0 || entry.result.CachedSize(true) <= rc.maxResultSize {
+		rc.entries.Set(key, entry)
+	}
+	return qr, nil
+}
+
+// cacheableTables returns the keys of the tables of a SELECT, and whether its
+// result can be cached. The views of the vschema are expanded into the tables
+// of their query, which the writes that change their result go to. A query
+// with a table whose keyspace is unknown is not cacheable.
+func (rc *resultCache) cacheableTables(safeSession *SafeSession, plan *engine.Plan, vschema *vindexes.VSchema) ([]string, bool) {
+	if safeSession.InTransaction() || safeSession.InReservedConn() {
+		return nil, false
+	}
+	// The results of the queries that use the last insert id, the found rows,
+	// or the system and user variables depend on the session.
+	if bvn := plan.BindVarNeeds; bvn != nil && (len(bvn.NeedFunctionResult) > 0 || len(bvn.NeedSystemVariable) > 0 || len(bvn.NeedUserDefinedVariables) > 0) {
+		return nil, false
+	}
+	stmt, err := sqlparser.Parse(plan.Original)
+	if err != nil || sqlparser.SkipResultCacheDirective(stmt) {
+		return nil, false
+	}
+	if !resultCacheEnabled(plan.Instructions) {
+		return nil, false
+	}
+
+	cacheable := true
+	defaultKeyspace := sessionKeyspace(safeSession, vschema)
+	tables := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if (node.Cache != nil && !*node.Cache) || node.Lock != sqlparser.NoLock || node.Into != nil {
+				cacheable = false
+			}
+		case *sqlparser.Union:
+			if node.Lock != sqlparser.NoLock || node.Into != nil {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFunctions[node.Name.Lowered()] {
+				cacheable = false
+			}
+		}
+		return cacheable, nil
+	}, stmt)
+	if !cacheable {
+		return nil, false
+	}
+	for _, table := range statementTables(stmt) {
+		if sqlparser.SystemSchema(table.Qualifier.String()) {
+			return nil, false
+		}
+		if strings.EqualFold(table.Name.String(), "dual") {
+			continue
+		}
+		key, ok := tableKey(table, defaultKeyspace, vschema)
+		if !ok {
+			return nil, false
+		}
+		tables[key] = true
+	}
+	if len(tables) == 0 || !addViewTables(tables, vschema) {
+		return nil, false
+	}
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// addViewTables adds the tables of the queries of the views among tables,
+// whose queries can reference other views. It returns false if the keyspace
+// of one of the tables of the views is unknown.
+func addViewTables(tables map[string]bool, vschema *vindexes.VSchema) bool {
+	if vschema == nil {
+		return true
+	}
+	type view struct {
+		keyspace string
+		query    sqlparser.SelectStatement
+	}
+	views := make(map[string]view)
+	for keyspace, ks := range vschema.Keyspaces {
+		for name, query := range ks.Views {
+			views[keyspace+"."+strings.ToLower(name)] = view{keyspace: keyspace, query: query}
+		}
+	}
+	if len(views) == 0 {
+		return true
+	}
+	var pending []string
+	for table := range tables {
+		pending = append(pending, table)
+	}
+	for len(pending) > 0 {
+		table := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		view, ok := views[table]
+		if !ok {
+			continue
+		}
+		for _, tableName := range statementTables(view.query) {
+			base, ok := tableKey(tableName, view.keyspace, vschema)
+			if !ok {
+				return false
+			}
+			if !tables[base] {
+				tables[base] = true
+				pending = append(pending, base)
+			}
+		}
+	}
+	return true
+}
+
+// statementTables returns the tables of a statement. The qualifiers of the
+// columns, and the aliases of the tables, such as the targets of a multi-table
+// DELETE, are left out.
+func statementTables(stmt sqlparser.SQLNode) []sqlparser.TableName {
+	var names []sqlparser.TableName
+	aliases := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName, *sqlparser.StarExpr:
+			return false, nil
+		case *sqlparser.AliasedTableExpr:
+			if !node.As.IsEmpty() {
+				aliases[node.As.String()] = true
+			}
+		case sqlparser.TableName:
+			if !node.Name.IsEmpty() {
+				names = append(names, node)
+			}
+		}
+		return true, nil
+	}, stmt)
+	tables := names[:0]
+	for _, name := range names {
+		if name.Qualifier.IsEmpty() && aliases[name.Name.String()] {
+			continue
+		}
+		tables = append(tables, name)
+	}
+	return tables
+}
+
+// sessionKeyspace returns the keyspace of the target of the session, which
+// the unqualified tables of its queries are in, or "" if it has none.
+func sessionKeyspace(safeSession *SafeSession, vschema *vindexes.VSchema) string {
+	if vschema == nil {
+		keyspace, _, _, _ := topoprotopb.ParseDestination(safeSession.GetTargetString(), defaultTabletType)
+		return keyspace
+	}
+	keyspace, _, _, _ := parseDestinationTarget(safeSession.GetTargetString(), vschema)
+	return keyspace
+}
+
+// tableKey returns the key of a table in the result cache: its keyspace and
+// its lower case name. An unqualified table is in the default keyspace, or
+// else in the keyspace of the vschema that has a table or a view of its name.
+// It returns false if the keyspace of the table is unknown.
+func tableKey(table sqlparser.TableName, defaultKeyspace string, vschema *vindexes.VSchema) (string, bool) {
+	name := strings.ToLower(table.Name.String())
+	keyspace := defaultKeyspace
+	if !table.Qualifier.IsEmpty() {
+		keyspace = table.Qualifier.String()
+		if vschema != nil {
+			// The qualifier can be the database of a tenant.
+			keyspace, _, _, _ = parseDestinationTarget(keyspace, vschema)
+		}
+	}
+	if keyspace == "" && vschema != nil {
+		if t, err := vschema.FindTable("", table.Name.String()); err == nil {
+			keyspace = t.Keyspace.Name
+		} else if vschema.FindView("", table.Name.String()) != nil {
+			for ksName, ks := range vschema.Keyspaces {
+				if ks.Views[table.Name.String()] != nil {
+					keyspace = ksName
+				}
+			}
+		}
+	}
+	if keyspace == "" {
+		return "", false
+	}
+	return keyspace + "." + name, true
+}
+
+// resultCacheEnabled returns false if the result_cache feature flag of one
+// of the keyspaces that the plan goes to disables the result cache.
+func resultCacheEnabled(primitive engine.Primitive) bool {
+	if primitive == nil {
+		return true
+	}
+	inputs := primitive.Inputs()
+	if len(inputs) == 0 {
+		keyspace := primitive.GetKeyspaceName()
+		return keyspace == "" || featureflags.Enabled(keyspace, featureflags.ResultCache, true)
+	}
+	for _, input := range inputs {
+		if !resultCacheEnabled(input) {
+			return false
+		}
+	}
+	return true
+}
+
+// snapshot returns the generation and the versions of the tables.
+func (rc *resultCache) snapshot(tables []string) (uint64, []uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	versions := make([]uint64, len(tables))
+	for i, table := range tables {
+		versions[i] = rc.versions[table]
+	}
+	return rc.generation, versions
+}
+
+func equalVersions(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// queryKey hashes everything that the result of a query depends on.
+func queryKey(ctx context.Context, safeSession *SafeSession, query string, bindVars map[string]*querypb.BindVariable) string {
+	h := sha256.New()
+	write := func(s string) {
+		fmt.Fprintf(h, "%d:%s", len(s), s)
+	}
+	write(safeSession.GetTargetString())
+	write(callerid.EffectiveCallerIDFromContext(ctx).GetPrincipal())
+	write(callerid.ImmediateCallerIDFromContext(ctx).GetUsername())
+	var sysVars []string
+	safeSession.GetSystemVariables(func(k, v string) {
+		sysVars = append(sysVars, k+"="+v)
+	})
+	sort.Strings(sysVars)
+	for _, sysVar := range sysVars {
+		write(sysVar)
+	}
+	writeProto(h, safeSession.GetOptions())
+	write(query)
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		write(name)
+		writeProto(h, bindVars[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeProto(h hash.Hash, m proto.Message) {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	fmt.Fprintf(h, "%d:", len(data))
+	h.Write(data)
+}
+
+// invalidateWrite invalidates the results of the tables that a write or a
+// DDL uses. It is called whether the statement succeeded or not, since it
+// may have partially succeeded. The tables written in a transaction are
+// invalidated again by endTransaction when it ends. If the keyspace of a
+// table is unknown, all the results are invalidated.
+func (rc *resultCache) invalidateWrite(safeSession *SafeSession, vschema *vindexes.VSchema, sql string) {
+	if rc == nil {
+		return
+	}
+	switch sqlparser.Preview(sql) {
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete, sqlparser.StmtDDL:
+	default:
+		return
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return
+	}
+	defaultKeyspace := sessionKeyspace(safeSession, vschema)
+	var tables []string
+	resolved := true
+	for _, tableName := range statementTables(stmt) {
+		key, ok := tableKey(tableName, defaultKeyspace, vschema)
+		if !ok {
+			resolved = false
+		}
+		tables = append(tables, key)
+	}
+	resultCacheInvalidations.Add("Write", 1)
+
+	transactionID := safeSession.GetTransactionUUID()
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !resolved {
+		rc.generation++
+		return
+	}
+	for _, table := range tables {
+		rc.versions[table]++
+	}
+	if safeSession.InTransaction() && transactionID != "" {
+		rc.written[transactionID] = append(rc.written[transactionID], tables...)
+	}
+}
+
+// endTransaction invalidates the results of the tables that a transaction
+// wrote, when it ends. A failed commit may have committed some of the shards
+// before it rolls back the others, so that the tables are invalidated however
+// the transaction ended. It is called by the TxConn.
+func (rc *resultCache) endTransaction(transactionID string) {
+	if rc == nil || transactionID == "" {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	tables, ok := rc.written[transactionID]
+	if !ok {
+		return
+	}
+	delete(rc.written, transactionID)
+	resultCacheInvalidations.Add("Commit", 1)
+	for _, table := range tables {
+		rc.versions[table]++
+	}
+}
+
+// invalidateSchema invalidates the results of the tables whose schema
+// changed, or of all the tables if the schema of a keyspace was reloaded.
+// It is called by the schema tracker.
+func (rc *resultCache) invalidateSchema(keyspace string, tables []string) {
+	resultCacheInvalidations.Add("Schema", 1)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if tables == nil {
+		rc.generation++
+		return
+	}
+	for _, table := range tables {
+		rc.versions[keyspace+"."+strings.ToLower(table)]++
+	}
+}
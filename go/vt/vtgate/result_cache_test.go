@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/featureflags"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestNewResultCache(t *testing.T) {
+	assert.Nil(t, newResultCache(0, time.Second, 0))
+	assert.Nil(t, newResultCache(1024, 0, 0))
+
+	// a nil cache only runs the queries
+	var rc *resultCache
+	rc.invalidateWrite(NewAutocommitSession(&vtgatepb.Session{}), nil, "update t1 set a = 1")
+	rc.endTransaction("tx")
+}
+
+func TestResultCacheCacheableTables(t *testing.T) {
+	rc := newResultCache(1024, time.Second, 0)
+	session := NewAutocommitSession(&vtgatepb.Session{TargetString: "ks"})
+
+	testcases := []struct {
+		query  string
+		tables []string
+	}{
+		{"select * from t1", []string{"ks.t1"}},
+		{"select * from ks.T1 join t2 on T1.id = t2.id", []string{"ks.t1", "ks.t2"}},
+		{"select * from other.t1 join t1 on other.t1.id = t1.id", []string{"ks.t1", "other.t1"}},
+		{"select a.id, b.* from t1 as a join t2 as b on a.id = b.id", []string{"ks.t1", "ks.t2"}},
+		{"select * from t1 union select * from t2", []string{"ks.t1", "ks.t2"}},
+		{"select * from t1 where id in (select id from t2)", []string{"ks.t1", "ks.t2"}},
+		{"select 1 from dual", nil},
+		{"select sql_no_cache * from t1", nil},
+		{"select /*vt+ SKIP_RESULT_CACHE */ * from t1", nil},
+		{"select * from t1 for update", nil},
+		{"select * from t1 lock in share mode", nil},
+		{"select now() from t1", nil},
+		{"select * from t1 where id = rand()", nil},
+		{"select * from information_schema.tables", nil},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			tables, ok := rc.cacheableTables(session, &engine.Plan{Type: sqlparser.StmtSelect, Original: tc.query}, nil)
+			assert.Equal(t, tc.tables != nil, ok)
+			assert.Equal(t, tc.tables, tables)
+		})
+	}
+
+	plan := &engine.Plan{Type: sqlparser.StmtSelect, Original: "select * from t1", BindVarNeeds: &sqlparser.BindVarNeeds{}}
+	plan.BindVarNeeds.AddUserDefVar("x")
+	_, ok := rc.cacheableTables(session, plan, nil)
+	assert.False(t, ok, "the queries with user variables are not cacheable")
+
+	inTransaction := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	_, ok = rc.cacheableTables(inTransaction, &engine.Plan{Type: sqlparser.StmtSelect, Original: "select * from t1"}, nil)
+	assert.False(t, ok, "the queries in a transaction are not cacheable")
+
+	noTarget := NewAutocommitSession(&vtgatepb.Session{})
+	_, ok = rc.cacheableTables(noTarget, &engine.Plan{Type: sqlparser.StmtSelect, Original: "select * from t1"}, nil)
+	assert.False(t, ok, "the queries of the tables of unknown keyspace are not cacheable")
+	tables, ok := rc.cacheableTables(noTarget, &engine.Plan{Type: sqlparser.StmtSelect, Original: "select * from ks.t1"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"ks.t1"}, tables)
+}
+
+func TestResultCacheCacheableTablesViews(t *testing.T) {
+	rc := newResultCache(1024, time.Second, 0)
+	session := NewAutocommitSession(&vtgatepb.Session{TargetString: "ks"})
+	parseView := func(query string) sqlparser.SelectStatement {
+		stmt, err := sqlparser.Parse(query)
+		require.NoError(t, err)
+		return stmt.(sqlparser.SelectStatement)
+	}
+	vschema := &vindexes.VSchema{Keyspaces: map[string]*vindexes.KeyspaceSchema{
+		"ks": {
+			Keyspace: &vindexes.Keyspace{Name: "ks"},
+			Views: map[string]sqlparser.SelectStatement{
+				"v1": parseView("select * from ks.t1 join other.t2 on t1.id = t2.id"),
+				"v2": parseView("select * from ks.v1 where id > 10"),
+			},
+		},
+		"other": {
+			Keyspace: &vindexes.Keyspace{Name: "other"},
+		},
+	}}
+
+	testcases := []struct {
+		query  string
+		tables []string
+	}{
+		{"select * from v1", []string{"ks.t1", "ks.v1", "other.t2"}},
+		{"select * from V2", []string{"ks.t1", "ks.v1", "ks.v2", "other.t2"}},
+		{"select * from v1 join t3 on v1.id = t3.id", []string{"ks.t1", "ks.t3", "ks.v1", "other.t2"}},
+		{"select * from t1", []string{"ks.t1"}},
+		{"select * from other.v1", []string{"other.v1"}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			tables, ok := rc.cacheableTables(session, &engine.Plan{Type: sqlparser.StmtSelect, Original: tc.query}, vschema)
+			require.True(t, ok)
+			assert.Equal(t, tc.tables, tables)
+		})
+	}
+}
+
+func TestResultCacheFeatureFlag(t *testing.T) {
+	rc := newResultCache(1024, time.Second, 0)
+	session := NewAutocommitSession(&vtgatepb.Session{TargetString: "ks"})
+	plan := &engine.Plan{
+		Type:     sqlparser.StmtSelect,
+		Original: "select * from t1",
+		Instructions: &engine.Route{
+			RoutingParameters: &engine.RoutingParameters{Keyspace: &vindexes.Keyspace{Name: "ks"}},
+		},
+	}
+	_, ok := rc.cacheableTables(session, plan, nil)
+	assert.True(t, ok)
+
+	featureflags.Set("ks", map[string]string{featureflags.ResultCache: "false"})
+	defer featureflags.Set("ks", nil)
+	_, ok = rc.cacheableTables(session, plan, nil)
+	assert.False(t, ok, "the result cache is disabled for the keyspace")
+}
+
+func TestResultCache(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	rc := newResultCache(1024*1024, time.Minute, 0)
+	now := time.Now()
+	rc.now = func() time.Time { return now }
+	executor.resultCache = rc
+	executor.txConn.resultCache = rc
+
+	ctx := context.Background()
+	execCount := func() int64 {
+		return sbc1.ExecCount.Get() + sbc2.ExecCount.Get()
+	}
+	exec := func(ctx context.Context, session *vtgatepb.Session, sql string) {
+		t.Helper()
+		_, err := executor.Execute(ctx, "TestResultCache", NewSafeSession(session), sql, nil)
+		require.NoError(t, err)
+	}
+	autocommit := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	query := "select id from user where id = 1"
+
+	exec(ctx, autocommit, query)
+	count := execCount()
+	hits := resultCacheRequests.Counts()["Hit"]
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count, execCount(), "the result is served from the cache")
+	assert.EqualValues(t, hits+1, resultCacheRequests.Counts()["Hit"])
+
+	// the other bind variables and callers have their own results
+	exec(ctx, autocommit, "select id from user where id = 2")
+	assert.Equal(t, count+1, execCount())
+	exec(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("other")), autocommit, query)
+	assert.Equal(t, count+2, execCount())
+	count = execCount()
+
+	// a write invalidates the results of its tables
+	exec(ctx, autocommit, "update user set a = 1 where id = 3")
+	count = execCount()
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+1, execCount())
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+1, execCount())
+
+	// so do the schema changes
+	rc.invalidateSchema("TestExecutor", []string{"User"})
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+2, execCount())
+	rc.invalidateSchema("TestExecutor", nil)
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+3, execCount())
+	count = execCount()
+
+	// and the TTL
+	now = now.Add(time.Minute)
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+1, execCount())
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+1, execCount())
+	count = execCount()
+
+	// a table written in a transaction is invalidated again when it commits
+	inTransaction := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	exec(ctx, inTransaction, "begin")
+	exec(ctx, inTransaction, "update user set a = 1 where id = 3")
+	count = execCount()
+	exec(ctx, autocommit, query)
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+1, execCount())
+	exec(ctx, inTransaction, "commit")
+	exec(ctx, autocommit, query)
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count+2, execCount())
+	assert.Empty(t, rc.written)
+	count = execCount()
+
+	// a write to a table of the same name in another keyspace does not
+	// invalidate the results
+	exec(ctx, autocommit, "update TestUnsharded.user set a = 1 where id = 3")
+	exec(ctx, autocommit, query)
+	assert.Equal(t, count, execCount())
+
+	// the writes of the lookup vindexes invalidate the results of their tables
+	lookupQuery := "select user_id from name_user_map where name = 'foo'"
+	exec(ctx, autocommit, lookupQuery)
+	lookupCount := sbclookup.ExecCount.Get()
+	exec(ctx, autocommit, lookupQuery)
+	assert.Equal(t, lookupCount, sbclookup.ExecCount.Get())
+	exec(ctx, autocommit, "insert into user(id, name) values (4, 'foo')")
+	lookupCount = sbclookup.ExecCount.Get()
+	exec(ctx, autocommit, lookupQuery)
+	assert.Equal(t, lookupCount+1, sbclookup.ExecCount.Get())
+	count = execCount()
+
+	// the directive bypasses the cache
+	exec(ctx, autocommit, "select /*vt+ SKIP_RESULT_CACHE */ id from user where id = 1")
+	assert.Equal(t, count+1, execCount())
+}
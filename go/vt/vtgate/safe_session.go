@@ -18,13 +18,17 @@ package vtgate
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -49,9 +53,37 @@ type SafeSession struct {
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
 	foundRowsHandled bool
+
+	// resultMemory is the size of the results that the queries of the
+	// statement of the session hold until its result is handed to the
+	// client. It is shared with the autocommit sessions of the statement,
+	// and nil for the sessions of the internal queries.
+	resultMemory *resultMemory
+
+	// dtid is the id of the distributed transaction that commits the
+	// last transaction of the session with 2PC.
+	dtid string
+	// endedTx is how the last transaction of the session ended, until
+	// the query log takes it to annotate the statement that ended it.
+	endedTx *txOutcome
+
 	*vtgatepb.Session
 }
 
+// txOutcome is how a transaction ended, as the query log reports it.
+type txOutcome struct {
+	transactionID string
+	outcome       string
+	dtid          string
+}
+
+// The outcomes of a transaction in the query log.
+const (
+	txOutcomeCommit       = "COMMIT"
+	txOutcomeCommitFailed = "COMMIT_FAILED"
+	txOutcomeRollback     = "ROLLBACK"
+)
+
 // autocommitState keeps track of whether a single round-trip
 // commit to vttablet is possible. It starts as autocommitable
 // if we started a transaction because of the autocommit flag
@@ -97,9 +129,36 @@ func NewSafeSession(sessn *vtgatepb.Session) *SafeSession {
 	if sessn == nil {
 		sessn = &vtgatepb.Session{}
 	}
+	readLegacyLockSession(sessn)
 	return &SafeSession{Session: sessn}
 }
 
+// readLegacyLockSession moves the lock session of a session written by a vtgate
+// of the previous release, which only knows lock_session, into lock_sessions.
+func readLegacyLockSession(sessn *vtgatepb.Session) {
+	if len(sessn.LockSessions) > 0 || sessn.LockSession == nil { //nolint
+		return
+	}
+	sessn.LockSessions = []*vtgatepb.Session_LockSession{{
+		ShardSession:  sessn.LockSession,       //nolint
+		LastHeartbeat: sessn.LastLockHeartbeat, //nolint
+	}}
+}
+
+// writeLegacyLockSessionLocked keeps the deprecated lock_session and last_lock_heartbeat
+// in sync with the first lock session, so that a vtgate of the previous release that
+// receives the session during a rolling upgrade keeps holding and releasing its lock.
+// TODO: remove in the next release, together with the deprecated fields.
+func (session *SafeSession) writeLegacyLockSessionLocked() {
+	if len(session.LockSessions) == 0 {
+		session.LockSession = nil     //nolint
+		session.LastLockHeartbeat = 0 //nolint
+		return
+	}
+	session.LockSession = session.LockSessions[0].ShardSession        //nolint
+	session.LastLockHeartbeat = session.LockSessions[0].LastHeartbeat //nolint
+}
+
 // NewAutocommitSession returns a SafeSession based on the original
 // session, but with autocommit enabled.
 func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
@@ -110,9 +169,22 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	newSession.TransactionUuid = ""
 	return NewSafeSession(newSession)
 }
 
+// holdResultMemory makes the session hold the memory of the results that
+// the queries of its statement accumulate, until they are handed to the
+// client. It returns false if the session already holds it, for a statement
+// that runs within another one.
+func (session *SafeSession) holdResultMemory() bool {
+	if session.resultMemory != nil {
+		return false
+	}
+	session.resultMemory = &resultMemory{}
+	return true
+}
+
 // ResetTx clears the session
 func (session *SafeSession) ResetTx() {
 	session.mu.Lock()
@@ -120,6 +192,7 @@ func (session *SafeSession) ResetTx() {
 	session.mustRollback = false
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
+	session.TransactionUuid = ""
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
 	if !session.Session.InReservedConn {
@@ -136,11 +209,13 @@ func (session *SafeSession) Reset() {
 	session.mustRollback = false
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
+	session.TransactionUuid = ""
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.releaseTempTablesLocked()
 }
 
 // SavePoints returns the save points of the session. It's safe to use concurrently
@@ -228,6 +303,34 @@ func (session *SafeSession) InTransaction() bool {
 	return session.Session.InTransaction
 }
 
+// InReplicaTransaction returns true if the session is in a transaction
+// on replica or rdonly tablets. Such a transaction is read-only.
+func (session *SafeSession) InReplicaTransaction() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.inReplicaTransactionLocked()
+}
+
+func (session *SafeSession) inReplicaTransactionLocked() bool {
+	return session.Session.InTransaction && session.transactionTabletTypeLocked() != topodatapb.TabletType_PRIMARY
+}
+
+// transactionTabletType returns the tablet type that the transaction of
+// the session runs on, which is the one of the target it began with.
+func (session *SafeSession) transactionTabletType() topodatapb.TabletType {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.transactionTabletTypeLocked()
+}
+
+func (session *SafeSession) transactionTabletTypeLocked() topodatapb.TabletType {
+	_, tabletType, _, err := topoproto.ParseDestination(session.TargetString, defaultTabletType)
+	if err != nil {
+		return topodatapb.TabletType_PRIMARY
+	}
+	return tabletType
+}
+
 // Find returns the transactionId and tabletAlias, if any, for a session
 func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) (transactionID int64, reservedID int64, alias *topodatapb.TabletAlias) {
 	session.mu.Lock()
@@ -261,6 +364,7 @@ func addOrUpdate(shardSession *vtgatepb.Session_ShardSession, sessions []*vtgate
 				return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, errorDetails)
 			}
 			// replace the old info with the new one
+			shardSession.Wrote = shardSession.Wrote || sess.Wrote
 			sessions[i] = shardSession
 			appendSession = false
 			break
@@ -273,6 +377,26 @@ func addOrUpdate(shardSession *vtgatepb.Session_ShardSession, sessions []*vtgate
 	return sessions, nil
 }
 
+// MarkWrote records that a statement of the transaction changed rows on the
+// shard of the target, whose commit then has a GTID set to record.
+func (session *SafeSession) MarkWrote(target *querypb.Target) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	sessions := session.ShardSessions
+	switch session.commitOrder {
+	case vtgatepb.CommitOrder_PRE:
+		sessions = session.PreSessions
+	case vtgatepb.CommitOrder_POST:
+		sessions = session.PostSessions
+	}
+	for _, shardSession := range sessions {
+		if target.Keyspace == shardSession.Target.Keyspace && target.TabletType == shardSession.Target.TabletType && target.Shard == shardSession.Target.Shard {
+			shardSession.Wrote = true
+			return
+		}
+	}
+}
+
 // AppendOrUpdate adds a new ShardSession, or updates an existing one if one already exists for the given shard session
 func (session *SafeSession) AppendOrUpdate(shardSession *vtgatepb.Session_ShardSession, txMode vtgatepb.TransactionMode) error {
 	session.mu.Lock()
@@ -299,6 +423,13 @@ func (session *SafeSession) AppendOrUpdate(shardSession *vtgatepb.Session_ShardS
 			return err
 		}
 		session.ShardSessions = newSessions
+		// A read-only transaction on replicas takes a snapshot per shard, at
+		// the replication position of each replica, so it is limited to one
+		// shard: the snapshots of several shards would not be consistent.
+		if session.inReplicaTransactionLocked() && len(session.ShardSessions) > 1 {
+			session.mustRollback = true
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "multi-db read-only transaction attempted on replicas, whose snapshots are not consistent across shards: %v", session.ShardSessions)
+		}
 		// isSingle is enforced only for normmal commit order operations.
 		if session.isSingleDB(txMode) && len(session.ShardSessions) > 1 {
 			session.mustRollback = true
@@ -444,41 +575,98 @@ func (session *SafeSession) SetPreQueries() []string {
 	return result
 }
 
-// SetLockSession sets the lock session.
+// SetLockSession sets the lock session for the target of the shard session,
+// replacing the existing lock session of that target, if any.
 func (session *SafeSession) SetLockSession(lockSession *vtgatepb.Session_ShardSession) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.LockSession = lockSession
-	session.LastLockHeartbeat = time.Now().Unix()
+	ls := &vtgatepb.Session_LockSession{
+		ShardSession:  lockSession,
+		LastHeartbeat: time.Now().Unix(),
+	}
+	defer session.writeLegacyLockSessionLocked()
+	for i, existing := range session.LockSessions {
+		if proto.Equal(existing.ShardSession.Target, lockSession.Target) {
+			session.LockSessions[i] = ls
+			return
+		}
+	}
+	session.LockSessions = append(session.LockSessions, ls)
+}
+
+// FindLockSession returns the lock session of the target, or nil if there is none.
+func (session *SafeSession) FindLockSession(target *querypb.Target) *vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if ls := session.findLockSessionLocked(target); ls != nil {
+		return ls.ShardSession
+	}
+	return nil
 }
 
-// UpdateLockHeartbeat updates the LastLockHeartbeat time
-func (session *SafeSession) UpdateLockHeartbeat() {
+func (session *SafeSession) findLockSessionLocked(target *querypb.Target) *vtgatepb.Session_LockSession {
+	for _, ls := range session.LockSessions {
+		if proto.Equal(ls.ShardSession.Target, target) {
+			return ls
+		}
+	}
+	return nil
+}
+
+// UpdateLockHeartbeat updates the LastHeartbeat time of the lock session of the target.
+func (session *SafeSession) UpdateLockHeartbeat(target *querypb.Target) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.LastLockHeartbeat = time.Now().Unix()
+	if ls := session.findLockSessionLocked(target); ls != nil {
+		ls.LastHeartbeat = time.Now().Unix()
+		session.writeLegacyLockSessionLocked()
+	}
 }
 
-// TriggerLockHeartBeat returns if it time to trigger next lock heartbeat
-func (session *SafeSession) TriggerLockHeartBeat() bool {
+// TriggerLockHeartBeat returns the targets of the lock sessions for which
+// it is time to trigger the next lock heartbeat.
+func (session *SafeSession) TriggerLockHeartBeat() []*querypb.Target {
 	session.mu.Lock()
 	defer session.mu.Unlock()
 	now := time.Now().Unix()
-	return now-session.LastLockHeartbeat >= int64(lockHeartbeatTime.Seconds())
+	var targets []*querypb.Target
+	for _, ls := range session.LockSessions {
+		if now-ls.LastHeartbeat >= int64(lockHeartbeatTime.Seconds()) {
+			targets = append(targets, ls.ShardSession.Target)
+		}
+	}
+	return targets
 }
 
 // InLockSession returns whether locking is used on this session.
 func (session *SafeSession) InLockSession() bool {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	return session.LockSession != nil
+	return len(session.LockSessions) > 0
 }
 
-// ResetLock resets the lock session
-func (session *SafeSession) ResetLock() {
+// ResetLock resets the lock session of the target.
+func (session *SafeSession) ResetLock(target *querypb.Target) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.LockSession = nil
+	defer session.writeLegacyLockSessionLocked()
+	for i, ls := range session.LockSessions {
+		if proto.Equal(ls.ShardSession.Target, target) {
+			session.LockSessions = append(session.LockSessions[:i], session.LockSessions[i+1:]...)
+			return
+		}
+	}
+}
+
+// LockShardSessions returns the shard sessions of all the lock sessions.
+func (session *SafeSession) LockShardSessions() []*vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	shardSessions := make([]*vtgatepb.Session_ShardSession, 0, len(session.LockSessions))
+	for _, ls := range session.LockSessions {
+		shardSessions = append(shardSessions, ls.ShardSession)
+	}
+	return shardSessions
 }
 
 // ResetAll resets the shard sessions and lock session.
@@ -488,12 +676,15 @@ func (session *SafeSession) ResetAll() {
 	session.mustRollback = false
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
+	session.TransactionUuid = ""
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
-	session.LockSession = nil
+	session.LockSessions = nil
+	session.writeLegacyLockSessionLocked()
+	session.releaseTempTablesLocked()
 }
 
 // ResetShard reset the shard session for the provided tablet alias.
@@ -600,6 +791,155 @@ func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.ReadAfterWrite.SessionTrackGtids = enable
 }
 
+// SetReadYourWrites set the ReadYourWrites setting.
+// The GTIDs of the writes of the session are forgotten when it is disabled.
+func (session *SafeSession) SetReadYourWrites(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	session.ReadAfterWrite.ReadYourWrites = enable
+	if !enable {
+		session.ReadAfterWrite.ShardGtids = nil
+	}
+}
+
+// SetReadAtTimestamp set the ReadAtTimestamp setting, in nanoseconds since the epoch.
+func (session *SafeSession) SetReadAtTimestamp(readAt int64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	session.ReadAfterWrite.ReadAtTimestamp = readAt
+}
+
+// GetReadAtTimestamp returns the ReadAtTimestamp setting.
+func (session *SafeSession) GetReadAtTimestamp() int64 {
+	if session == nil {
+		return 0
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite.GetReadAtTimestamp()
+}
+
+// SetAllowCrossCellWrites sets whether the session can write to the primaries of the other cells.
+func (session *SafeSession) SetAllowCrossCellWrites(allow bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.AllowCrossCellWrites = allow
+}
+
+// GetAllowCrossCellWrites returns whether the session can write to the primaries of the other cells.
+func (session *SafeSession) GetAllowCrossCellWrites() bool {
+	if session == nil {
+		return false
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.AllowCrossCellWrites
+}
+
+// SetQueryTags sets the tags of the queries of the session.
+func (session *SafeSession) SetQueryTags(tags string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.QueryTags = tags
+}
+
+// GetQueryTags returns the tags of the queries of the session.
+func (session *SafeSession) GetQueryTags() string {
+	if session == nil {
+		return ""
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.QueryTags
+}
+
+// ReadYourWrites returns true if the reads of the session must see its writes.
+func (session *SafeSession) ReadYourWrites() bool {
+	if session == nil {
+		return false
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite.GetReadYourWrites()
+}
+
+// RecordWriteGtid merges the GTID set of a write into the GTID set of the writes of the session on the shard.
+func (session *SafeSession) RecordWriteGtid(keyspace, shard, gtid string) error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	for _, shardGtid := range session.ReadAfterWrite.ShardGtids {
+		if shardGtid.Keyspace != keyspace || shardGtid.Shard != shard {
+			continue
+		}
+		merged, err := mergeGtidSets(shardGtid.Gtid, gtid)
+		if err != nil {
+			return err
+		}
+		shardGtid.Gtid = merged
+		return nil
+	}
+	gtid, err := mergeGtidSets(gtid, "")
+	if err != nil {
+		return err
+	}
+	session.ReadAfterWrite.ShardGtids = append(session.ReadAfterWrite.ShardGtids, &binlogdatapb.ShardGtid{
+		Keyspace: keyspace,
+		Shard:    shard,
+		Gtid:     gtid,
+	})
+	return nil
+}
+
+// WriteGtid returns the GTID set of the writes of the session on the shard, or an empty string if there is none.
+func (session *SafeSession) WriteGtid(keyspace, shard string) string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, shardGtid := range session.ReadAfterWrite.GetShardGtids() {
+		if shardGtid.Keyspace == keyspace && shardGtid.Shard == shard {
+			return shardGtid.Gtid
+		}
+	}
+	return ""
+}
+
+// ReadGtid returns the GTID set that the replicas of the shard must have
+// executed before they serve the reads of the session: the
+// read_after_write_gtid of the session and, with read_your_writes, the GTID
+// set of the writes of the session on the shard. It is empty if the reads
+// don't need to wait.
+func (session *SafeSession) ReadGtid(keyspace, shard string) string {
+	var gtids []string
+	if session.ReadYourWrites() {
+		if gtid := session.WriteGtid(keyspace, shard); gtid != "" {
+			gtids = append(gtids, gtid)
+		}
+	}
+	session.mu.Lock()
+	readAfterWriteGtid := session.ReadAfterWrite.GetReadAfterWriteGtid()
+	session.mu.Unlock()
+	if readAfterWriteGtid != "" {
+		gtids = append(gtids, readAfterWriteGtid)
+	}
+	// MySQL accepts the GTID sets whose intervals overlap.
+	return strings.Join(gtids, ",")
+}
+
+// GetReadAfterWriteTimeout returns the ReadAfterWriteTimeout setting.
+func (session *SafeSession) GetReadAfterWriteTimeout() float64 {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite.GetReadAfterWriteTimeout()
+}
+
 func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
 	idx := -1
 	for i, session := range sessions {
@@ -648,6 +988,56 @@ func (session *SafeSession) getSelectLimit() int {
 	return int(session.Options.SqlSelectLimit)
 }
 
+// beginTx marks the session as in a transaction, with a new transaction id.
+func (session *SafeSession) beginTx() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.InTransaction = true
+	session.TransactionUuid = uuid.New().String()
+	session.dtid = ""
+}
+
+// GetTransactionUUID returns the id of the open transaction of the session.
+func (session *SafeSession) GetTransactionUUID() string {
+	if session == nil {
+		return ""
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.TransactionUuid
+}
+
+// setTxDTID records the dtid of the distributed transaction that commits the transaction.
+func (session *SafeSession) setTxDTID(dtid string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.dtid = dtid
+}
+
+// endTx records how the transaction transactionID ended.
+func (session *SafeSession) endTx(transactionID string, outcome string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.endedTx = &txOutcome{
+		transactionID: transactionID,
+		outcome:       outcome,
+		dtid:          session.dtid,
+	}
+}
+
+// takeEndedTx returns how the last transaction of the session ended, if it
+// ended since the last call, and forgets it.
+func (session *SafeSession) takeEndedTx() *txOutcome {
+	if session == nil {
+		return nil
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	endedTx := session.endedTx
+	session.endedTx = nil
+	return endedTx
+}
+
 // isTxOpen returns true if there is open connection to any of the shard.
 func (session *SafeSession) isTxOpen() bool {
 	session.mu.Lock()
@@ -67,3 +67,32 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestLegacyLockSession(t *testing.T) {
+	ks1 := &vtgatepb.Session_ShardSession{
+		Target:      &querypb.Target{Keyspace: "ks1", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+		TabletAlias: &topodatapb.TabletAlias{Cell: "cell", Uid: 1},
+		ReservedId:  1,
+	}
+	ks2 := &vtgatepb.Session_ShardSession{
+		Target:      &querypb.Target{Keyspace: "ks2", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+		TabletAlias: &topodatapb.TabletAlias{Cell: "cell", Uid: 2},
+		ReservedId:  2,
+	}
+
+	// A session of an older vtgate only has lock_session.
+	session := NewSafeSession(&vtgatepb.Session{LockSession: ks1, LastLockHeartbeat: 10}) //nolint
+	require.Len(t, session.LockSessions, 1)
+	require.Equal(t, ks1, session.FindLockSession(ks1.Target))
+	require.EqualValues(t, 10, session.LockSessions[0].LastHeartbeat)
+
+	// The deprecated fields mirror the first lock session.
+	session.SetLockSession(ks2)
+	require.Equal(t, ks1, session.LockSession) //nolint
+	session.ResetLock(ks1.Target)
+	require.Equal(t, ks2, session.LockSession)                                         //nolint
+	require.Equal(t, session.LockSessions[0].LastHeartbeat, session.LastLockHeartbeat) //nolint
+	session.ResetAll()
+	require.Nil(t, session.LockSession)        //nolint
+	require.Zero(t, session.LastLockHeartbeat) //nolint
+}
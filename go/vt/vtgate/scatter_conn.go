@@ -19,22 +19,24 @@ package vtgate
 import (
 	"context"
 	"flag"
+	"fmt"
 	"io"
+	"math/rand"
 	"regexp"
 	"sync"
 	"time"
 
-	"google.golang.org/protobuf/proto"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/dynamicconfig"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -44,7 +46,26 @@ import (
 )
 
 var (
-	messageStreamGracePeriod = flag.Duration("message_stream_grace_period", 30*time.Second, "the amount of time to give for a vttablet to resume if it ends a message stream, usually because of a reparent.")
+	shardDeadlineExceeded = stats.NewCountersWithMultiLabels(
+		"VtgateShardDeadlineExceeded",
+		"Number of shard actions of multi-shard queries that were abandoned because they exceeded their deadline budget",
+		[]string{"Operation", "Keyspace", "ShardName", "DbType"})
+
+	messageStreamGracePeriod = dynamicconfig.NewDuration("message_stream_grace_period", 30*time.Second, "the amount of time to give for a vttablet to resume if it ends a message stream, usually because of a reparent.")
+	messageStreamWindow      = dynamicconfig.NewInt("message_stream_window", 0, "the number of messages that a vttablet sends to a message stream of vtgate before it waits for the client to process them. vtgate grows the window by half of it at a time as the client processes the messages, so a slow client pauses the streams instead of letting the messages pile up. 0 disables flow control.")
+
+	scatterMaxConcurrency            = flag.Int("scatter_max_concurrency", 0, "the maximum number of shard actions that vtgate runs concurrently on behalf of multi-shard queries, across all queries. The goroutine that issues a query always works on its shards as well. 0 means unlimited.")
+	scatterMaxConcurrencyPerKeyspace = flag.Int("scatter_max_concurrency_per_keyspace", 0, "the maximum number of shard actions that vtgate runs concurrently on behalf of multi-shard queries against a single keyspace, across all queries. The goroutine that issues a query always works on its shards as well. 0 means unlimited.")
+
+	streamMergeBufferSize = flag.Int("stream_merge_buffer_size", 0, "the number of results that vtgate buffers per shard when it merges the streams of a multi-shard query. A shard whose buffer is full is paused until the client catches up, without holding back the other shards. 0 disables buffering.")
+
+	shardDeadlineReservePercent = flag.Uint("shard_deadline_reserve_percent", 0, "the default percentage (1-99) of the remaining deadline of a multi-shard query that vtgate reserves for aggregating the results. Each shard gets the rest of the deadline as its budget, and a shard that exceeds it is abandoned: the reads outside of transactions return the results of the other shards, with a warning that names it. Can be overridden with ExecuteOptions.shard_deadline_reserve_percent, where 0 disables deadline budgets for the session. 0 disables deadline budgets.")
+
+	queryRetryPolicy         = flag.String("query_retry_policy", "", "comma separated list of <tablet_type>:<statement_class>=<max_retries> entries, which set how many times vtgate retries a shard query that failed with a transient error (UNAVAILABLE, CLUSTER_EVENT or a closed connection) outside of a transaction. The statement class is select or other, e.g. replica:select=3,rdonly:select=3. The DMLs, DDLs and calls of procedures are never retried. Queries that are not in the list are not retried.")
+	queryRetryInitialBackoff = flag.Duration("query_retry_initial_backoff", 10*time.Millisecond, "the time vtgate waits before the first retry of a shard query, see -query_retry_policy. The wait doubles with every retry, and a random jitter of up to half of it is applied.")
+	queryRetryMaxBackoff     = flag.Duration("query_retry_max_backoff", time.Second, "the maximum time vtgate waits between two retries of a shard query, see -query_retry_policy.")
+
+	statsConnectionAttribute = flag.String("stats_connection_attribute", "", "the connection attribute, e.g. program_name, by which vtgate breaks down the timings of its tablet calls in the VttabletCallByClient stats. Queries of clients that did not send the attribute are counted as unknown. Empty disables the breakdown.")
 )
 
 // ScatterConn is used for executing queries across
@@ -54,6 +75,17 @@ type ScatterConn struct {
 	tabletCallErrorCount *stats.CountersWithMultiLabels
 	txConn               *TxConn
 	gateway              Gateway
+	limiter              *shardLimiter
+	// streamBufferSize is the number of results buffered per shard by
+	// StreamExecuteMulti, or 0 if the shards stream into the callback.
+	streamBufferSize int
+	retry            *retryPolicy
+	// memory limits the results that ExecuteMultiShard accumulates.
+	memory resultMemoryAccountant
+	// clientTimings breaks down the timings by the client that sent the
+	// query, see -stats_connection_attribute. It is nil if disabled.
+	clientTimings   *stats.MultiTimings
+	clientAttribute string
 }
 
 // shardActionFunc defines the contract for a shard action
@@ -67,11 +99,12 @@ type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
 // shardActionTransactionFunc defines the contract for a shard action
 // that may be in a transaction. Every such function executes the
 // necessary action on a shard (with an optional Begin call), aggregates
-// the results, and return an error if any.
+// the results, and return an error if any. The action must use the
+// provided context, which carries the deadline budget of the shard.
 // multiGoTransaction is capable of executing multiple
 // shardActionTransactionFunc actions in parallel and consolidating
 // the results and errors for the caller.
-type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, shardActionInfo *shardActionInfo) (*shardActionInfo, error)
+type shardActionTransactionFunc func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shardActionInfo *shardActionInfo) (*shardActionInfo, error)
 
 // NewScatterConn creates a new ScatterConn.
 func NewScatterConn(statsName string, txConn *TxConn, gw *TabletGateway) *ScatterConn {
@@ -80,6 +113,25 @@ func NewScatterConn(statsName string, txConn *TxConn, gw *TabletGateway) *Scatte
 	if statsName != "" {
 		tabletCallErrorCountStatsName = statsName + "ErrorCount"
 	}
+	retry, err := newRetryPolicy(*queryRetryPolicy, *queryRetryInitialBackoff, *queryRetryMaxBackoff)
+	if err != nil {
+		log.Exitf("Invalid value for -query_retry_policy: %v", err)
+	}
+	memory, err := newResultMemoryAccountant(*resultMemoryAccounting, *maxResultMemoryBytesPerSession, *maxResultMemoryBytes)
+	if err != nil {
+		log.Exitf("Invalid result memory accounting: %v", err)
+	}
+	var clientTimings *stats.MultiTimings
+	if *statsConnectionAttribute != "" {
+		clientTimingsStatsName := ""
+		if statsName != "" {
+			clientTimingsStatsName = statsName + "ByClient"
+		}
+		clientTimings = stats.NewMultiTimings(
+			clientTimingsStatsName,
+			"Scatter connection timings by client",
+			[]string{"Operation", "Keyspace", "DbType", "Client"})
+	}
 	return &ScatterConn{
 		timings: stats.NewMultiTimings(
 			statsName,
@@ -89,8 +141,14 @@ func NewScatterConn(statsName string, txConn *TxConn, gw *TabletGateway) *Scatte
 			tabletCallErrorCountStatsName,
 			"Error count from tablet calls in scatter conns",
 			[]string{"Operation", "Keyspace", "ShardName", "DbType"}),
-		txConn:  txConn,
-		gateway: gw,
+		clientTimings:    clientTimings,
+		clientAttribute:  *statsConnectionAttribute,
+		txConn:           txConn,
+		gateway:          gw,
+		limiter:          newShardLimiter(*scatterMaxConcurrency, *scatterMaxConcurrencyPerKeyspace),
+		streamBufferSize: *streamMergeBufferSize,
+		retry:            retry,
+		memory:           memory,
 	}
 }
 
@@ -115,6 +173,13 @@ func (stc *ScatterConn) endAction(startTime time.Time, allErrors *concurrency.Al
 		}
 	}
 	stc.timings.Record(statsKey, startTime)
+	if stc.clientTimings != nil {
+		client := session.GetOptions().GetConnectionAttributes()[stc.clientAttribute]
+		if client == "" {
+			client = "unknown"
+		}
+		stc.clientTimings.Record([]string{statsKey[0], statsKey[1], statsKey[3], client}, startTime)
+	}
 }
 
 type reset int
@@ -144,13 +209,25 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		return nil, []error{vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] got mismatched number of queries and shards")}
 	}
 
-	// mu protects qr
+	// mu protects qr, results and memoryErr
 	var mu sync.Mutex
+	var memoryErr error
+	var results shardResults
 	qr = new(sqltypes.Result)
 
-	if session.InLockSession() && session.TriggerLockHeartBeat() {
-		go stc.runLockQuery(ctx, session)
+	var memory resultMemoryTracker
+	if !ignoreMaxMemoryRows {
+		memory = stc.memory.track(session)
+		defer memory.done()
+	}
+
+	for _, target := range session.TriggerLockHeartBeat() {
+		go stc.runLockQuery(ctx, session, target)
+	}
+	if *replicaSessionAffinity {
+		ctx = withSessionAffinity(ctx, session.GetSessionUUID())
 	}
+	ctx = withReadAtTimestamp(ctx, session.GetReadAtTimestamp())
 
 	allErrors := stc.multiGoTransaction(
 		ctx,
@@ -158,7 +235,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		rss,
 		session,
 		autocommit,
-		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
 			var (
 				innerqr *sqltypes.Result
 				err     error
@@ -169,9 +246,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			transactionID := info.transactionID
 			reservedID := info.reservedID
 
-			if session != nil && session.Session != nil {
-				opts = session.Session.Options
-			}
+			opts = readYourWritesOptions(session, rs.Target)
 
 			if autocommit {
 				// As this is auto-commit, the transactionID is supposed to be zero.
@@ -186,7 +261,8 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			}
 
 			retryRequest := func(exec func()) {
-				retry := checkAndResetShardSession(info, err, session, rs.Target)
+				var retry reset
+				retry, err = checkAndResetShardSession(info, err, session, rs.Target)
 				switch retry {
 				case newQS:
 					// Current tablet is not available, try querying new tablet using gateway.
@@ -201,7 +277,10 @@ func (stc *ScatterConn) ExecuteMultiShard(
 
 			switch info.actionNeeded {
 			case nothing:
-				innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reservedID, opts)
+				err = stc.retry.run(ctx, rs.Target, queries[i].Sql, info, nil, func() (err error) {
+					innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reservedID, opts)
+					return err
+				})
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
@@ -210,18 +289,18 @@ func (stc *ScatterConn) ExecuteMultiShard(
 					})
 				}
 			case begin:
-				innerqr, transactionID, alias, err = qs.BeginExecute(ctx, rs.Target, session.SavePoints(), queries[i].Sql, queries[i].BindVariables, reservedID, opts)
+				innerqr, transactionID, alias, err = qs.BeginExecute(ctx, rs.Target, session.SavePoints(), queries[i].Sql, queries[i].BindVariables, reservedID, beginOptions(opts, rs.Target))
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
 						info.actionNeeded = reserveBegin
-						innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), queries[i].Sql, queries[i].BindVariables, opts)
+						innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), queries[i].Sql, queries[i].BindVariables, beginOptions(opts, rs.Target))
 					})
 				}
 			case reserve:
 				innerqr, reservedID, alias, err = qs.ReserveExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, transactionID, opts)
 			case reserveBegin:
-				innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), queries[i].Sql, queries[i].BindVariables, opts)
+				innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), queries[i].Sql, queries[i].BindVariables, beginOptions(opts, rs.Target))
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on query execution: %v", info.actionNeeded)
 			}
@@ -230,26 +309,44 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if err != nil {
 				return newInfo, err
 			}
+			// Only the statements that changed rows wrote a GTID to record.
+			if innerqr.RowsAffected > 0 {
+				switch {
+				case autocommit:
+					recordWrite(ctx, session, stc.gateway, rs.Target, innerqr.SessionStateChanges)
+				case transactionID == 0 || !recordsWrites(session, rs.Target):
+				case newInfo != nil:
+					newInfo.wrote = true
+				default:
+					session.MarkWrote(rs.Target)
+				}
+			}
+			recordReplicationLag(session, rs.Target, innerqr)
 			mu.Lock()
 			defer mu.Unlock()
 
-			// Don't append more rows if row count is exceeded.
-			if ignoreMaxMemoryRows || len(qr.Rows) <= *maxMemoryRows {
+			// Don't append more rows once the memory budget is exceeded.
+			if memoryErr == nil && memory != nil {
+				memoryErr = memory.add(innerqr)
+			}
+			if memoryErr == nil {
 				qr.AppendResult(innerqr)
+				results.add(innerqr)
 			}
 			return newInfo, nil
 		},
 	)
 
-	if !ignoreMaxMemoryRows && len(qr.Rows) > *maxMemoryRows {
-		return nil, []error{vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "in-memory row count exceeded allowed limit of %d", *maxMemoryRows)}
+	if memoryErr != nil {
+		return nil, []error{memoryErr}
 	}
 
+	results.finish(qr, session)
 	return qr, allErrors.GetErrors()
 }
 
-func (stc *ScatterConn) runLockQuery(ctx context.Context, session *SafeSession) {
-	rs := &srvtopo.ResolvedShard{Target: session.LockSession.Target, Gateway: stc.gateway}
+func (stc *ScatterConn) runLockQuery(ctx context.Context, session *SafeSession, target *querypb.Target) {
+	rs := &srvtopo.ResolvedShard{Target: target, Gateway: stc.gateway}
 	query := &querypb.BoundQuery{Sql: "select 1", BindVariables: nil}
 	_, lockErr := stc.ExecuteLock(ctx, rs, query, session)
 	if lockErr != nil {
@@ -257,7 +354,11 @@ func (stc *ScatterConn) runLockQuery(ctx context.Context, session *SafeSession)
 	}
 }
 
-func checkAndResetShardSession(info *shardActionInfo, err error, session *SafeSession, target *querypb.Target) reset {
+// checkAndResetShardSession resets the shard session if its reserved
+// connection was lost, and returns how the query can be retried on a new
+// reserved connection. The query isn't retried if the lost connection held
+// temporary tables of the session, and the returned error reports them.
+func checkAndResetShardSession(info *shardActionInfo, err error, session *SafeSession, target *querypb.Target) (reset, error) {
 	retry := none
 	if info.reservedID != 0 && info.transactionID == 0 {
 		if wasConnectionClosed(err) {
@@ -269,8 +370,11 @@ func checkAndResetShardSession(info *shardActionInfo, err error, session *SafeSe
 	}
 	if retry != none {
 		_ = session.ResetShard(info.alias)
+		if lost := session.LoseTempTables(target.Keyspace); len(lost) != 0 {
+			return none, lostTempTablesError(target, lost, err)
+		}
 	}
-	return retry
+	return retry, err
 }
 
 func getQueryService(rs *srvtopo.ResolvedShard, info *shardActionInfo) (queryservice.QueryService, error) {
@@ -313,8 +417,17 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	autocommit bool,
 	callback func(reply *sqltypes.Result) error,
 ) []error {
-	if session.InLockSession() && session.TriggerLockHeartBeat() {
-		go stc.runLockQuery(ctx, session)
+	for _, target := range session.TriggerLockHeartBeat() {
+		go stc.runLockQuery(ctx, session, target)
+	}
+	if *replicaSessionAffinity {
+		ctx = withSessionAffinity(ctx, session.GetSessionUUID())
+	}
+	ctx = withReadAtTimestamp(ctx, session.GetReadAtTimestamp())
+
+	var merger *streamMerger
+	if stc.streamBufferSize > 0 && len(rss) > 1 {
+		merger = newStreamMerger(len(rss), stc.streamBufferSize, callback)
 	}
 
 	allErrors := stc.multiGoTransaction(
@@ -323,7 +436,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 		rss,
 		session,
 		autocommit,
-		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
 			var (
 				err   error
 				opts  *querypb.ExecuteOptions
@@ -333,8 +446,16 @@ func (stc *ScatterConn) StreamExecuteMulti(
 			transactionID := info.transactionID
 			reservedID := info.reservedID
 
-			if session != nil && session.Session != nil {
-				opts = session.Session.Options
+			opts = readYourWritesOptions(session, rs.Target)
+
+			callback := callback
+			if merger != nil {
+				callback = merger.shardCallback(ctx, rs, i)
+			}
+			shardCallback := callback
+			callback = func(qr *sqltypes.Result) error {
+				recordReplicationLag(session, rs.Target, qr)
+				return shardCallback(qr)
 			}
 
 			if autocommit {
@@ -350,7 +471,8 @@ func (stc *ScatterConn) StreamExecuteMulti(
 			}
 
 			retryRequest := func(exec func()) {
-				retry := checkAndResetShardSession(info, err, session, rs.Target)
+				var retry reset
+				retry, err = checkAndResetShardSession(info, err, session, rs.Target)
 				switch retry {
 				case newQS:
 					// Current tablet is not available, try querying new tablet using gateway.
@@ -365,7 +487,14 @@ func (stc *ScatterConn) StreamExecuteMulti(
 
 			switch info.actionNeeded {
 			case nothing:
-				err = qs.StreamExecute(ctx, rs.Target, query, bindVars[i], transactionID, reservedID, opts, callback)
+				// Once results were sent, the query can't be retried without sending them twice.
+				var streamed bool
+				err = stc.retry.run(ctx, rs.Target, query, info, func() bool { return !streamed }, func() error {
+					return qs.StreamExecute(ctx, rs.Target, query, bindVars[i], transactionID, reservedID, opts, func(qr *sqltypes.Result) error {
+						streamed = true
+						return callback(qr)
+					})
+				})
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
@@ -374,18 +503,18 @@ func (stc *ScatterConn) StreamExecuteMulti(
 					})
 				}
 			case begin:
-				transactionID, alias, err = qs.BeginStreamExecute(ctx, rs.Target, session.SavePoints(), query, bindVars[i], reservedID, opts, callback)
+				transactionID, alias, err = qs.BeginStreamExecute(ctx, rs.Target, session.SavePoints(), query, bindVars[i], reservedID, beginOptions(opts, rs.Target), callback)
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
 						info.actionNeeded = reserveBegin
-						transactionID, reservedID, alias, err = qs.ReserveBeginStreamExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), query, bindVars[i], opts, callback)
+						transactionID, reservedID, alias, err = qs.ReserveBeginStreamExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), query, bindVars[i], beginOptions(opts, rs.Target), callback)
 					})
 				}
 			case reserve:
 				reservedID, alias, err = qs.ReserveStreamExecute(ctx, rs.Target, session.SetPreQueries(), query, bindVars[i], transactionID, opts, callback)
 			case reserveBegin:
-				transactionID, reservedID, alias, err = qs.ReserveBeginStreamExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), query, bindVars[i], opts, callback)
+				transactionID, reservedID, alias, err = qs.ReserveBeginStreamExecute(ctx, rs.Target, session.SetPreQueries(), session.SavePoints(), query, bindVars[i], beginOptions(opts, rs.Target), callback)
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on query execution: %v", info.actionNeeded)
 			}
@@ -398,6 +527,11 @@ func (stc *ScatterConn) StreamExecuteMulti(
 			return newInfo, nil
 		},
 	)
+	if merger != nil {
+		if err := merger.wait(); err != nil && !allErrors.HasErrors() {
+			allErrors.RecordError(err)
+		}
+	}
 	return allErrors.GetErrors()
 }
 
@@ -447,14 +581,44 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	var mu sync.Mutex
 	fieldSent := false
 	lastErrors := newTimeTracker()
-	allErrors := stc.multiGo("MessageStream", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+	allErrors := stc.multiGo(ctx, "MessageStream", rss, func(rs *srvtopo.ResolvedShard, i int) error {
 		// This loop handles the case where a reparent happens, which can cause
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
 		for {
-			err := rs.Gateway.MessageStream(ctx, rs.Target, name, func(qr *sqltypes.Result) error {
+			var streamID string
+			window := int64(messageStreamWindow.Get())
+			if window > 0 {
+				streamID = fmt.Sprintf("%016x", rand.Uint64())
+			} else {
+				window = 0
+			}
+			processed := int64(0)
+			err := rs.Gateway.MessageStream(ctx, rs.Target, name, streamID, window, func(qr *sqltypes.Result) error {
 				lastErrors.Reset(rs.Target)
-				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+				if err := stc.processOneStreamingResult(&mu, &fieldSent, qr, callback); err != nil {
+					return err
+				}
+				if window == 0 {
+					return nil
+				}
+				// The client processed the messages. Grow the window of the
+				// stream once half of it is processed, for the vttablet to
+				// keep sending the other half in the meantime.
+				processed += int64(len(qr.Rows))
+				if processed < (window+1)/2 {
+					return nil
+				}
+				if err := rs.Gateway.MessageStreamWindow(ctx, rs.Target, name, streamID, processed); err != nil {
+					// The stream would stay paused: end it to start a new one.
+					// NOT_FOUND means that it ended already.
+					if vterrors.Code(err) != vtrpcpb.Code_NOT_FOUND {
+						log.Warningf("Ending message stream from %v: %v", rs.Target, err)
+					}
+					return io.EOF
+				}
+				processed = 0
+				return nil
 			})
 			// nil and EOF are equivalent. UNAVAILABLE can be returned by vttablet if it's demoted
 			// from primary to replica. For any of these conditions, we have to retry.
@@ -472,17 +636,17 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 			default:
 			}
 			firstErrorTimeStamp := lastErrors.Record(rs.Target)
-			if time.Since(firstErrorTimeStamp) >= *messageStreamGracePeriod {
+			if time.Since(firstErrorTimeStamp) >= messageStreamGracePeriod.Get() {
 				// Cancel all streams and return an error.
 				cancel()
-				return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "message stream from %v has repeatedly failed for longer than %v", rs.Target, *messageStreamGracePeriod)
+				return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "message stream from %v has repeatedly failed for longer than %v", rs.Target, messageStreamGracePeriod.Get())
 			}
 
 			// It's not been too long since our last good send. Wait and retry.
 			select {
 			case <-ctx.Done():
 				return nil
-			case <-time.After(*messageStreamGracePeriod / 5):
+			case <-time.After(messageStreamGracePeriod.Get() / 5):
 			}
 		}
 	})
@@ -508,6 +672,7 @@ func (stc *ScatterConn) GetHealthCheckCacheStatus() discovery.TabletsCacheStatus
 // shards in parallel. This does not handle any transaction state.
 // The action function must match the shardActionFunc2 signature.
 func (stc *ScatterConn) multiGo(
+	ctx context.Context,
 	name string,
 	rss []*srvtopo.ResolvedShard,
 	action shardActionFunc,
@@ -526,10 +691,29 @@ func (stc *ScatterConn) multiGo(
 		err = action(rs, i)
 	}
 
+	stc.goShards(ctx, name, rss, oneShard)
+	return allErrors
+}
+
+// goShards runs oneShard for each of the shards, in parallel if there is
+// more than one. If a limiter is configured, the shards are worked on by a
+// bounded number of goroutines, and the time that each shard spends waiting
+// for its turn is recorded in the timings as <name>QueueWait.
+func (stc *ScatterConn) goShards(ctx context.Context, name string, rss []*srvtopo.ResolvedShard, oneShard func(rs *srvtopo.ResolvedShard, i int)) {
 	if len(rss) == 1 {
 		// only one shard, do it synchronously.
 		oneShard(rss[0], 0)
-		return allErrors
+		return
+	}
+
+	if stc.limiter != nil {
+		queueStart := time.Now()
+		stc.limiter.run(ctx, rss, func(rs *srvtopo.ResolvedShard, i int) {
+			_, statsKey := stc.startAction(name+"QueueWait", rs.Target)
+			stc.timings.Record(statsKey, queueStart)
+			oneShard(rs, i)
+		})
+		return
 	}
 
 	var wg sync.WaitGroup
@@ -541,7 +725,28 @@ func (stc *ScatterConn) multiGo(
 		}(rs, i)
 	}
 	wg.Wait()
-	return allErrors
+}
+
+// shardDeadlineContext returns the context to use for the shards of a
+// multi-shard action. If a deadline reserve is configured in the session
+// options or as the vtgate default, and the context has a deadline, the
+// returned context expires earlier: only the reserve is kept for vtgate
+// to aggregate the results and report the shards that did not finish.
+// A reserve of 0 in the session options disables the deadline budgets.
+func shardDeadlineContext(ctx context.Context, session *SafeSession, numShards int) (context.Context, context.CancelFunc) {
+	reservePercent := uint32(*shardDeadlineReservePercent)
+	if session != nil && session.Session != nil {
+		if options := session.Session.GetOptions(); options != nil && options.ShardDeadlineReservePercent != nil {
+			reservePercent = *options.ShardDeadlineReservePercent
+		}
+	}
+	deadline, ok := ctx.Deadline()
+	if numShards < 2 || reservePercent == 0 || reservePercent >= 100 || !ok {
+		return ctx, func() {}
+	}
+	remaining := time.Until(deadline)
+	budget := remaining - remaining*time.Duration(reservePercent)/100
+	return context.WithTimeout(ctx, budget)
 }
 
 // multiGoTransaction performs the requested 'action' on the specified
@@ -569,13 +774,27 @@ func (stc *ScatterConn) multiGoTransaction(
 	if numShards == 0 {
 		return allErrors
 	}
+	shardCtx, cancel := shardDeadlineContext(ctx, session, numShards)
+	defer cancel()
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 		shardActionInfo := actionInfo(rs.Target, session, autocommit)
-		updated, err := action(rs, i, shardActionInfo)
+		updated, err := action(shardCtx, rs, i, shardActionInfo)
+		if err != nil && shardCtx != ctx && shardCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			// The shard ran out of its budget, but the query still has time left.
+			shardDeadlineExceeded.Add(statsKey, 1)
+			deadline, _ := shardCtx.Deadline()
+			err = vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "shard %s.%s exceeded its deadline budget of %v: %s",
+				rs.Target.Keyspace, rs.Target.Shard, deadline.Sub(startTime).Round(time.Millisecond), err.Error())
+			// The routes of the reads return the results of the other shards,
+			// unless a transaction saw only a part of the statement succeed.
+			if !session.InTransaction() {
+				err = &engine.ShardDeadlineExceededError{Err: err}
+			}
+		}
 		if updated == nil {
 			return
 		}
@@ -585,6 +804,7 @@ func (stc *ScatterConn) multiGoTransaction(
 				TransactionId: updated.transactionID,
 				ReservedId:    updated.reservedID,
 				TabletAlias:   updated.alias,
+				Wrote:         updated.wrote,
 			}, stc.txConn.mode)
 			if appendErr != nil {
 				err = appendErr
@@ -592,22 +812,7 @@ func (stc *ScatterConn) multiGoTransaction(
 		}
 	}
 
-	if numShards == 1 {
-		// only one shard, do it synchronously.
-		for i, rs := range rss {
-			oneShard(rs, i)
-		}
-	} else {
-		var wg sync.WaitGroup
-		for i, rs := range rss {
-			wg.Add(1)
-			go func(rs *srvtopo.ResolvedShard, i int) {
-				defer wg.Done()
-				oneShard(rs, i)
-			}(rs, i)
-		}
-		wg.Wait()
-	}
+	stc.goShards(ctx, name, rss, oneShard)
 
 	if session.MustRollback() {
 		_ = stc.txConn.Rollback(ctx, session)
@@ -645,15 +850,9 @@ func (stc *ScatterConn) ExecuteLock(
 	}
 
 	opts = session.Session.Options
-	info, err := lockInfo(rs.Target, session)
-	// Lock session is created on alphabetic sorted keyspace.
-	// This error will occur if the existing session target does not match the current target.
-	// This will happen either due to re-sharding or a new keyspace which comes before the existing order.
-	// In which case, we will try to release old locks and return error.
-	if err != nil {
-		_ = stc.txConn.ReleaseLock(ctx, session)
-		return nil, vterrors.Wrap(err, "Any previous held locks are released")
-	}
+	// Every target has its own lock session, so that the locks taken
+	// on different targets are held independently of each other.
+	info := lockInfo(rs.Target, session)
 	qs, err := getQueryService(rs, info)
 	if err != nil {
 		return nil, err
@@ -667,16 +866,12 @@ func (stc *ScatterConn) ExecuteLock(
 		}
 		qr, err = qs.Execute(ctx, rs.Target, query.Sql, query.BindVariables, 0 /* transactionID */, reservedID, opts)
 		if err != nil && wasConnectionClosed(err) {
-			session.ResetLock()
+			session.ResetLock(rs.Target)
 			err = vterrors.Wrap(err, "held locks released")
 		}
-		session.UpdateLockHeartbeat()
+		session.UpdateLockHeartbeat(rs.Target)
 	case reserve:
 		qr, reservedID, alias, err = qs.ReserveExecute(ctx, rs.Target, session.SetPreQueries(), query.Sql, query.BindVariables, 0 /* transactionID */, opts)
-		if err != nil && reservedID != 0 {
-			_ = stc.txConn.ReleaseLock(ctx, session)
-		}
-
 		if reservedID != 0 {
 			session.SetLockSession(&vtgatepb.Session_ShardSession{
 				Target:      rs.Target,
@@ -684,6 +879,9 @@ func (stc *ScatterConn) ExecuteLock(
 				TabletAlias: alias,
 			})
 		}
+		if err != nil && reservedID != 0 {
+			_ = stc.txConn.ReleaseLock(ctx, session, rs.Target)
+		}
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on lock execution: %v", info.actionNeeded)
 	}
@@ -744,27 +942,26 @@ func actionInfo(target *querypb.Target, session *SafeSession, autocommit bool) *
 	}
 }
 
-// lockInfo looks at the current session, and returns information about what needs to be done for this tablet
-func lockInfo(target *querypb.Target, session *SafeSession) (*shardActionInfo, error) {
-	if session.LockSession == nil {
-		return &shardActionInfo{actionNeeded: reserve}, nil
-	}
-
-	if !proto.Equal(target, session.LockSession.Target) {
-		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "target does match the existing lock session target: (%v, %v)", target, session.LockSession.Target)
+// lockInfo looks at the lock session of the target, and returns information about what needs to be done for this tablet
+func lockInfo(target *querypb.Target, session *SafeSession) *shardActionInfo {
+	ls := session.FindLockSession(target)
+	if ls == nil {
+		return &shardActionInfo{actionNeeded: reserve}
 	}
 
 	return &shardActionInfo{
 		actionNeeded: nothing,
-		reservedID:   session.LockSession.ReservedId,
-		alias:        session.LockSession.TabletAlias,
-	}, nil
+		reservedID:   ls.ReservedId,
+		alias:        ls.TabletAlias,
+	}
 }
 
 type shardActionInfo struct {
 	actionNeeded              actionNeeded
 	reservedID, transactionID int64
 	alias                     *topodatapb.TabletAlias
+	// wrote is set when the statement changed rows in the transaction.
+	wrote bool
 }
 
 func (sai *shardActionInfo) updateTransactionAndReservedID(txID int64, rID int64, alias *topodatapb.TabletAlias) *shardActionInfo {
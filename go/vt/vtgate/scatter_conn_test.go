@@ -17,7 +17,9 @@ limitations under the License.
 package vtgate
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
@@ -29,6 +31,7 @@ import (
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
@@ -37,6 +40,8 @@ import (
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 )
 
 // This file uses the sandbox_test framework.
@@ -286,6 +291,44 @@ func TestReservedBeginTableDriven(t *testing.T) {
 	}
 }
 
+func TestReservedConnFailWithTempTables(t *testing.T) {
+	keyspace := "keyspace"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: false, InReservedConn: true})
+	destinations := []key.Destination{key.DestinationShard("0")}
+
+	executeOnShards(t, res, keyspace, sc, session, destinations)
+	session.AddTempTable(keyspace, "temp_t")
+	session.AddTempTable("other", "temp_t")
+	lostBefore := tempTablesLost.Get()
+
+	sbc0.Queries = nil
+	sbc0.EphemeralShardErr = mysql.NewSQLError(mysql.CRServerGone, mysql.SSUnknownSQLState, "lost connection")
+	err := executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lost with the temporary tables keyspace.temp_t of the session")
+	assert.Equal(t, 1, len(sbc0.Queries), "the query must not be retried on a new reserved connection")
+	assert.Empty(t, session.ShardSessions)
+	assert.Equal(t, []string{"other.temp_t"}, session.TempTables)
+	assert.EqualValues(t, 1, tempTablesLost.Get()-lostBefore)
+
+	// Without temporary tables on the keyspace, the reserved connection is recreated.
+	executeOnShards(t, res, keyspace, sc, session, destinations)
+	sbc0.Queries = nil
+	sbc0.EphemeralShardErr = mysql.NewSQLError(mysql.CRServerGone, mysql.SSUnknownSQLState, "lost connection")
+	_ = executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations)
+	assert.Equal(t, 2, len(sbc0.Queries), "one for the failed attempt, and one for the retry")
+	require.Equal(t, 1, len(session.ShardSessions))
+
+	session.ResetAll()
+	assert.Empty(t, session.TempTables)
+}
+
 func TestReservedConnFail(t *testing.T) {
 	keyspace := "keyspace"
 	createSandbox(keyspace)
@@ -428,3 +471,192 @@ func TestIsConnClosed(t *testing.T) {
 		})
 	}
 }
+
+func TestShardDeadlineContext(t *testing.T) {
+	defer func(old uint) { *shardDeadlineReservePercent = old }(*shardDeadlineReservePercent)
+	session := NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{ShardDeadlineReservePercent: proto.Uint32(50)}})
+
+	// No deadline on the context.
+	shardCtx, cancel := shardDeadlineContext(context.Background(), session, 2)
+	defer cancel()
+	assert.Equal(t, context.Background(), shardCtx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	// Single shard.
+	shardCtx, cancel = shardDeadlineContext(ctx, session, 1)
+	defer cancel()
+	assert.Equal(t, ctx, shardCtx)
+
+	// Disabled.
+	shardCtx, cancel = shardDeadlineContext(ctx, NewSafeSession(nil), 2)
+	defer cancel()
+	assert.Equal(t, ctx, shardCtx)
+
+	// Reserve from the session options.
+	shardCtx, cancel = shardDeadlineContext(ctx, session, 2)
+	defer cancel()
+	shardDeadline, ok := shardCtx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, deadline.Add(-5*time.Second), shardDeadline, time.Second)
+
+	// Reserve from the vtgate default.
+	*shardDeadlineReservePercent = 10
+	shardCtx, cancel = shardDeadlineContext(ctx, NewSafeSession(nil), 2)
+	defer cancel()
+	shardDeadline, ok = shardCtx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, deadline.Add(-time.Second), shardDeadline, time.Second)
+
+	// Disabled by the session options.
+	disabled := NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{ShardDeadlineReservePercent: proto.Uint32(0)}})
+	shardCtx, cancel = shardDeadlineContext(ctx, disabled, 2)
+	defer cancel()
+	assert.Equal(t, ctx, shardCtx)
+}
+
+// slowSandboxConn blocks every Execute until its context is done.
+type slowSandboxConn struct {
+	*sandboxconn.SandboxConn
+}
+
+func (sbc *slowSandboxConn) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	<-ctx.Done()
+	return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "%v", ctx.Err())
+}
+
+func TestExecuteMultiShardDeadlineBudget(t *testing.T) {
+	keyspace := "TestExecuteMultiShardDeadlineBudget"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbc0,
+	}, {
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "1", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: &slowSandboxConn{SandboxConn: sbc1},
+	}}
+	queries := []*querypb.BoundQuery{{Sql: "query"}, {Sql: "query"}}
+	session := NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{ShardDeadlineReservePercent: proto.Uint32(50)}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	qr, errs := sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, ctx.Err(), "the query should return before its deadline")
+	require.Len(t, errs, 1)
+	assert.IsType(t, &engine.ShardDeadlineExceededError{}, errs[0])
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(errs[0]))
+	assert.Equal(t, sandboxconn.SingleRowResult.Rows, qr.Rows, "the result of the shard that finished is kept")
+	assert.Contains(t, errs[0].Error(), "shard TestExecuteMultiShardDeadlineBudget.1 exceeded its deadline budget")
+	assert.EqualValues(t, 1, shardDeadlineExceeded.Counts()["Execute."+keyspace+".1.replica"])
+
+	// In a transaction, the shard fails the statement.
+	primary0 := hc.AddTestTablet("aa", "2", 1, keyspace, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	primary1 := hc.AddTestTablet("aa", "3", 1, keyspace, "1", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	rss = []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+		Gateway: primary0,
+	}, {
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "1", TabletType: topodatapb.TabletType_PRIMARY},
+		Gateway: &slowSandboxConn{SandboxConn: primary1},
+	}}
+	session = NewSafeSession(&vtgatepb.Session{
+		InTransaction: true,
+		Options:       &querypb.ExecuteOptions{ShardDeadlineReservePercent: proto.Uint32(50)},
+		ShardSessions: []*vtgatepb.Session_ShardSession{{
+			Target:        rss[0].Target,
+			TransactionId: 1,
+		}, {
+			Target:        rss[1].Target,
+			TransactionId: 1,
+		}},
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.Len(t, errs, 1)
+	_, partial := errs[0].(*engine.ShardDeadlineExceededError)
+	assert.False(t, partial, "a transaction doesn't return partial results")
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(errs[0]))
+}
+
+func TestExecuteLockMultipleTargets(t *testing.T) {
+	createSandbox("TestExecuteLockMultipleTargets1")
+	createSandbox("TestExecuteLockMultipleTargets2")
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc1 := hc.AddTestTablet("aa", "1", 1, "TestExecuteLockMultipleTargets1", "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	sbc2 := hc.AddTestTablet("aa", "2", 1, "TestExecuteLockMultipleTargets2", "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	rs1 := &srvtopo.ResolvedShard{
+		Target:  &querypb.Target{Keyspace: "TestExecuteLockMultipleTargets1", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+		Gateway: sc.gateway,
+	}
+	rs2 := &srvtopo.ResolvedShard{
+		Target:  &querypb.Target{Keyspace: "TestExecuteLockMultipleTargets2", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+		Gateway: sc.gateway,
+	}
+	session := NewSafeSession(nil)
+	lock := func(rs *srvtopo.ResolvedShard, sql string) {
+		_, err := sc.ExecuteLock(ctx, rs, &querypb.BoundQuery{Sql: sql}, session)
+		require.NoError(t, err)
+	}
+
+	// Every target gets its own reserved connection, and taking a lock
+	// on the second target keeps the lock session of the first one.
+	lock(rs1, "select get_lock('a', 10) from dual")
+	lock(rs2, "select get_lock('b', 10) from dual")
+	lock(rs1, "select get_lock('c', 10) from dual")
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 1, sbc2.ReserveCount.Get())
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+	require.Len(t, session.LockSessions, 2)
+	utils.MustMatch(t, rs1.Target, session.LockSessions[0].ShardSession.Target)
+	utils.MustMatch(t, rs2.Target, session.LockSessions[1].ShardSession.Target)
+
+	// A lock session that is released doesn't affect the other one.
+	require.NoError(t, sc.txConn.ReleaseLock(ctx, session, rs1.Target))
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	require.Len(t, session.LockSessions, 1)
+	utils.MustMatch(t, rs2.Target, session.LockSessions[0].ShardSession.Target)
+
+	lock(rs1, "select get_lock('a', 10) from dual")
+	require.NoError(t, sc.txConn.ReleaseAll(ctx, session))
+	assert.EqualValues(t, 2, sbc1.ReleaseCount.Get())
+	assert.EqualValues(t, 1, sbc2.ReleaseCount.Get())
+	assert.False(t, session.InLockSession())
+}
+
+func TestExecuteMultiShardClientTimings(t *testing.T) {
+	defer func(saved string) { *statsConnectionAttribute = saved }(*statsConnectionAttribute)
+	*statsConnectionAttribute = "program_name"
+
+	keyspace := "TestExecuteMultiShardClientTimings"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbc,
+	}}
+	queries := []*querypb.BoundQuery{{Sql: "query"}}
+	attrs := map[string]string{"program_name": "app", "host": "10.0.0.1"}
+
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{ConnectionAttributes: attrs}}), false, false)
+	require.Empty(t, errs)
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, false)
+	require.Empty(t, errs)
+
+	// The attributes reach the tablet, and the timings are broken down by client.
+	require.Len(t, sbc.Options, 2)
+	utils.MustMatch(t, attrs, sbc.Options[0].ConnectionAttributes)
+	assert.EqualValues(t, 1, sc.clientTimings.Counts()["Execute."+keyspace+".replica.app"])
+	assert.EqualValues(t, 1, sc.clientTimings.Counts()["Execute."+keyspace+".replica.unknown"])
+}
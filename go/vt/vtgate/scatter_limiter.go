@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/vt/srvtopo"
+)
+
+// shardLimiter bounds the number of shard actions that ScatterConn runs
+// concurrently, across all queries of this vtgate and per keyspace.
+// A limited scatter runs its shard actions on a bounded pool of workers,
+// each of which must hold a slot while it executes an action.
+//
+// The goroutine that initiates a scatter also works through the shards,
+// without holding a slot. This guarantees that every scatter makes progress,
+// even if all the slots are held by outer scatters that are waiting for
+// it to finish, as it happens with streaming joins.
+type shardLimiter struct {
+	global      chan struct{}
+	perKeyspace int
+
+	mu        sync.Mutex
+	keyspaces map[string]chan struct{}
+}
+
+// newShardLimiter creates a shardLimiter. A limit of 0 or less means unlimited.
+// It returns nil if neither of the limits is set.
+func newShardLimiter(global, perKeyspace int) *shardLimiter {
+	if global <= 0 && perKeyspace <= 0 {
+		return nil
+	}
+	l := &shardLimiter{
+		perKeyspace: perKeyspace,
+		keyspaces:   make(map[string]chan struct{}),
+	}
+	if global > 0 {
+		l.global = make(chan struct{}, global)
+	}
+	return l
+}
+
+// keyspaceSlots returns the slots for the keyspace, or nil if there is no per keyspace limit.
+func (l *shardLimiter) keyspaceSlots(keyspace string) chan struct{} {
+	if l.perKeyspace <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slots, ok := l.keyspaces[keyspace]
+	if !ok {
+		slots = make(chan struct{}, l.perKeyspace)
+		l.keyspaces[keyspace] = slots
+	}
+	return slots
+}
+
+// acquire waits for a global slot and a keyspace slot. It returns false,
+// without holding any slot, if done or the context are closed first.
+func (l *shardLimiter) acquire(ctx context.Context, done <-chan struct{}, keyspaceSlots chan struct{}) bool {
+	if l.global != nil {
+		select {
+		case l.global <- struct{}{}:
+		case <-done:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if keyspaceSlots != nil {
+		select {
+		case keyspaceSlots <- struct{}{}:
+		case <-done:
+			l.releaseGlobal()
+			return false
+		case <-ctx.Done():
+			l.releaseGlobal()
+			return false
+		}
+	}
+	return true
+}
+
+func (l *shardLimiter) release(keyspaceSlots chan struct{}) {
+	if keyspaceSlots != nil {
+		<-keyspaceSlots
+	}
+	l.releaseGlobal()
+}
+
+func (l *shardLimiter) releaseGlobal() {
+	if l.global != nil {
+		<-l.global
+	}
+}
+
+// workers returns the number of workers to use for n shards of a keyspace.
+func (l *shardLimiter) workers(n int, keyspaceSlots chan struct{}) int {
+	if l.global != nil && cap(l.global) < n {
+		n = cap(l.global)
+	}
+	if keyspaceSlots != nil && cap(keyspaceSlots) < n {
+		n = cap(keyspaceSlots)
+	}
+	return n
+}
+
+// shardQueue hands out the shards of a keyspace to the workers.
+type shardQueue struct {
+	keyspace string
+	indexes  []int
+
+	mu   sync.Mutex
+	next int
+	// done is closed once all the shards have been handed out.
+	done chan struct{}
+}
+
+func (q *shardQueue) pop() (int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.next >= len(q.indexes) {
+		return 0, false
+	}
+	i := q.indexes[q.next]
+	q.next++
+	if q.next == len(q.indexes) {
+		close(q.done)
+	}
+	return i, true
+}
+
+// run executes the action for every shard and waits for all of them to finish.
+func (l *shardLimiter) run(ctx context.Context, rss []*srvtopo.ResolvedShard, action func(rs *srvtopo.ResolvedShard, i int)) {
+	var queues []*shardQueue
+	byKeyspace := make(map[string]*shardQueue)
+	for i, rs := range rss {
+		q, ok := byKeyspace[rs.Target.Keyspace]
+		if !ok {
+			q = &shardQueue{keyspace: rs.Target.Keyspace, done: make(chan struct{})}
+			byKeyspace[rs.Target.Keyspace] = q
+			queues = append(queues, q)
+		}
+		q.indexes = append(q.indexes, i)
+	}
+
+	var wg sync.WaitGroup
+	for _, q := range queues {
+		keyspaceSlots := l.keyspaceSlots(q.keyspace)
+		for w := l.workers(len(q.indexes), keyspaceSlots); w > 0; w-- {
+			wg.Add(1)
+			go func(q *shardQueue) {
+				defer wg.Done()
+				// Slots are acquired before taking a shard off the queue, so that
+				// a shard is never stuck behind a worker that is waiting for a slot.
+				for l.acquire(ctx, q.done, keyspaceSlots) {
+					i, ok := q.pop()
+					if !ok {
+						l.release(keyspaceSlots)
+						return
+					}
+					action(rss[i], i)
+					l.release(keyspaceSlots)
+				}
+			}(q)
+		}
+	}
+	for _, q := range queues {
+		for {
+			i, ok := q.pop()
+			if !ok {
+				break
+			}
+			action(rss[i], i)
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func limiterTestShards(keyspace string, n int) []*srvtopo.ResolvedShard {
+	rss := make([]*srvtopo.ResolvedShard, n)
+	for i := range rss {
+		rss[i] = &srvtopo.ResolvedShard{Target: &querypb.Target{Keyspace: keyspace, Shard: fmt.Sprint(i)}}
+	}
+	return rss
+}
+
+func TestNewShardLimiter(t *testing.T) {
+	assert.Nil(t, newShardLimiter(0, 0))
+	assert.NotNil(t, newShardLimiter(1, 0))
+	assert.NotNil(t, newShardLimiter(0, 1))
+}
+
+func TestShardLimiterBoundsConcurrency(t *testing.T) {
+	testcases := []struct {
+		global, perKeyspace int
+		want                int
+	}{
+		{global: 4, perKeyspace: 0, want: 4},
+		{global: 0, perKeyspace: 3, want: 3},
+		{global: 4, perKeyspace: 2, want: 2},
+	}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("global=%d,keyspace=%d", tc.global, tc.perKeyspace), func(t *testing.T) {
+			l := newShardLimiter(tc.global, tc.perKeyspace)
+			rss := limiterTestShards("ks", 64)
+
+			var running, maxRunning int64
+			seen := make([]int64, len(rss))
+			l.run(context.Background(), rss, func(rs *srvtopo.ResolvedShard, i int) {
+				n := atomic.AddInt64(&running, 1)
+				for {
+					max := atomic.LoadInt64(&maxRunning)
+					if n <= max || atomic.CompareAndSwapInt64(&maxRunning, max, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt64(&seen[i], 1)
+				atomic.AddInt64(&running, -1)
+			})
+			for i, count := range seen {
+				assert.EqualValues(t, 1, count, "shard %d", i)
+			}
+			// The calling goroutine works on the shards without holding a slot.
+			assert.LessOrEqual(t, maxRunning, int64(tc.want+1))
+			assert.Empty(t, l.global)
+			for _, slots := range l.keyspaces {
+				assert.Empty(t, slots)
+			}
+		})
+	}
+}
+
+func TestShardLimiterNestedProgress(t *testing.T) {
+	l := newShardLimiter(2, 0)
+	outer := limiterTestShards("outer", 8)
+	inner := limiterTestShards("inner", 8)
+
+	var innerCount int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// Every outer action holds on to its slot while it runs an inner scatter,
+		// like a streaming join does. The inner scatters must still complete.
+		l.run(context.Background(), outer, func(*srvtopo.ResolvedShard, int) {
+			l.run(context.Background(), inner, func(*srvtopo.ResolvedShard, int) {
+				atomic.AddInt64(&innerCount, 1)
+			})
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("nested scatters did not complete")
+	}
+	assert.EqualValues(t, len(outer)*len(inner), innerCount)
+}
+
+func TestShardLimiterMultipleKeyspaces(t *testing.T) {
+	l := newShardLimiter(0, 1)
+	rss := append(limiterTestShards("ks1", 4), limiterTestShards("ks2", 4)...)
+
+	var mu sync.Mutex
+	running := map[string]int{}
+	maxRunning := map[string]int{}
+	l.run(context.Background(), rss, func(rs *srvtopo.ResolvedShard, i int) {
+		mu.Lock()
+		running[rs.Target.Keyspace]++
+		if running[rs.Target.Keyspace] > maxRunning[rs.Target.Keyspace] {
+			maxRunning[rs.Target.Keyspace] = running[rs.Target.Keyspace]
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		running[rs.Target.Keyspace]--
+		mu.Unlock()
+	})
+	assert.LessOrEqual(t, maxRunning["ks1"], 2)
+	assert.LessOrEqual(t, maxRunning["ks2"], 2)
+	assert.Len(t, l.keyspaces, 2)
+}
+
+func TestScatterConnExecuteMultiLimited(t *testing.T) {
+	keyspace := "TestScatterConnExecuteMultiLimited"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sc.limiter = newShardLimiter(2, 0)
+
+	var rss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	for i := 0; i < 8; i++ {
+		shard := fmt.Sprint(i)
+		sbc := hc.AddTestTablet("aa", shard, 1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+		rss = append(rss, &srvtopo.ResolvedShard{
+			Target:  &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_REPLICA},
+			Gateway: sbc,
+		})
+		queries = append(queries, &querypb.BoundQuery{Sql: "query"})
+	}
+
+	qr, errs := sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Len(t, qr.Rows, len(rss))
+	assert.EqualValues(t, 1, sc.timings.Counts()[fmt.Sprintf("ExecuteQueueWait.%s.0.replica", keyspace)])
+
+	var count int64
+	errs = sc.StreamExecuteMulti(ctx, "query", rss, make([]map[string]*querypb.BindVariable, len(rss)), NewSafeSession(nil), false, func(qr *sqltypes.Result) error {
+		atomic.AddInt64(&count, int64(len(qr.Rows)))
+		return nil
+	})
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, len(rss), count)
+	assert.EqualValues(t, 1, sc.timings.Counts()[fmt.Sprintf("StreamExecuteQueueWait.%s.7.replica", keyspace)])
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// scatterOffender describes a query that is sent to all the shards of a
+// keyspace because none of its predicates matched a vindex.
+type scatterOffender struct {
+	Query    string
+	Keyspace string
+	Tables   []string
+	// Predicates are the predicates of the WHERE clause that were examined.
+	Predicates []string `json:",omitempty"`
+	// VindexColumns are the columns of the vindexes of the tables, which
+	// would have let the query be routed to fewer shards.
+	VindexColumns []string `json:",omitempty"`
+	Count         uint64
+}
+
+// warning returns the warning that is attached to the executions of the query.
+func (o *scatterOffender) warning() *querypb.QueryWarning {
+	return &querypb.QueryWarning{
+		Message: fmt.Sprintf("scatter query on keyspace %s: no vindex of %s matched the predicates [%s], the vindex columns are [%s]",
+			o.Keyspace, strings.Join(o.Tables, ", "), strings.Join(o.Predicates, ", "), strings.Join(o.VindexColumns, ", ")),
+	}
+}
+
+// scatterLinter warns about the queries that scatter because no vindex
+// matched their predicates, and keeps track of the ones that run the most.
+// A nil scatterLinter does nothing.
+type scatterLinter struct {
+	executor *Executor
+
+	mu sync.Mutex
+	// offenders is keyed by the normalized query.
+	offenders    map[string][]*scatterOffender
+	maxOffenders int
+}
+
+func newScatterLinter(executor *Executor, maxOffenders int) *scatterLinter {
+	return &scatterLinter{
+		executor:     executor,
+		offenders:    make(map[string][]*scatterOffender),
+		maxOffenders: maxOffenders,
+	}
+}
+
+// lint returns the warnings of a new plan, one for every scatter route.
+func (l *scatterLinter) lint(plan *engine.Plan) []*querypb.QueryWarning {
+	if l == nil || plan.Instructions == nil {
+		return nil
+	}
+	var warnings []*querypb.QueryWarning
+	for _, offender := range l.describe(plan) {
+		warnings = append(warnings, offender.warning())
+	}
+	return warnings
+}
+
+// record counts an execution of the plan, if it scatters.
+func (l *scatterLinter) record(plan *engine.Plan) {
+	if l == nil || plan.Instructions == nil || !engine.Exists(isScatter, plan.Instructions) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	offenders, ok := l.offenders[plan.Original]
+	if !ok {
+		if len(l.offenders) >= l.maxOffenders {
+			l.evictLocked()
+		}
+		offenders = l.describe(plan)
+		l.offenders[plan.Original] = offenders
+	}
+	for _, offender := range offenders {
+		offender.Count++
+	}
+}
+
+// evictLocked forgets the query that ran the least, to make room for a new one.
+func (l *scatterLinter) evictLocked() {
+	var evicted string
+	var minCount uint64
+	for query, offenders := range l.offenders {
+		var count uint64
+		if len(offenders) > 0 {
+			count = offenders[0].Count
+		}
+		if evicted == "" || count < minCount {
+			evicted, minCount = query, count
+		}
+	}
+	delete(l.offenders, evicted)
+}
+
+// topOffenders returns the scatter queries that ran the most, up to limit
+// of them, or all of them if limit is 0.
+func (l *scatterLinter) topOffenders(limit int) []scatterOffender {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	var top []scatterOffender
+	for _, offenders := range l.offenders {
+		for _, offender := range offenders {
+			top = append(top, *offender)
+		}
+	}
+	l.mu.Unlock()
+
+	sort.SliceStable(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Query < top[j].Query
+	})
+	if limit > 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
+
+// describe returns the offenders of the scatter routes of the plan.
+func (l *scatterLinter) describe(plan *engine.Plan) []*scatterOffender {
+	var offenders []*scatterOffender
+	var visit func(p engine.Primitive)
+	visit = func(p engine.Primitive) {
+		switch p := p.(type) {
+		case *engine.Route:
+			if p.Opcode == engine.Scatter {
+				offenders = append(offenders, l.newOffender(plan.Original, p.Keyspace, routeTables(p), p.Query))
+			}
+		case *engine.Update:
+			if p.Opcode == engine.Scatter {
+				offenders = append(offenders, l.newOffender(plan.Original, p.Keyspace, dmlTables(p.DML), p.Query))
+			}
+		case *engine.Delete:
+			if p.Opcode == engine.Scatter {
+				offenders = append(offenders, l.newOffender(plan.Original, p.Keyspace, dmlTables(p.DML), p.Query))
+			}
+		}
+		for _, input := range p.Inputs() {
+			visit(input)
+		}
+	}
+	visit(plan.Instructions)
+	return offenders
+}
+
+func (l *scatterLinter) newOffender(query string, keyspace *vindexes.Keyspace, tables []string, routeQuery string) *scatterOffender {
+	offender := &scatterOffender{
+		Query:      query,
+		Tables:     tables,
+		Predicates: wherePredicates(routeQuery),
+	}
+	if keyspace == nil {
+		return offender
+	}
+	offender.Keyspace = keyspace.Name
+	vschema := l.executor.VSchema()
+	if vschema == nil {
+		return offender
+	}
+	for _, name := range tables {
+		table, err := vschema.FindTable(keyspace.Name, name)
+		if err != nil || table == nil {
+			continue
+		}
+		for _, cv := range table.ColumnVindexes {
+			columns := make([]string, 0, len(cv.Columns))
+			for _, column := range cv.Columns {
+				columns = append(columns, column.String())
+			}
+			offender.VindexColumns = append(offender.VindexColumns, strings.Join(columns, "+"))
+		}
+	}
+	return offender
+}
+
+// routeTables returns the names of the tables of the route, which may be escaped.
+func routeTables(route *engine.Route) []string {
+	var tables []string
+	for _, name := range strings.Split(route.TableName, ", ") {
+		if _, table, err := sqlparser.ParseTable(name); err == nil {
+			name = table
+		}
+		tables = append(tables, name)
+	}
+	return tables
+}
+
+func dmlTables(dml *engine.DML) []string {
+	if dml.Table == nil {
+		return nil
+	}
+	return []string{dml.Table.Name.String()}
+}
+
+// wherePredicates returns the predicates of the WHERE clauses of the query
+// that use a column.
+func wherePredicates(query string) []string {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil
+	}
+	var predicates []string
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		where, ok := node.(*sqlparser.Where)
+		if !ok || where.Type != sqlparser.WhereClause {
+			return true, nil
+		}
+		for _, predicate := range sqlparser.SplitAndExpression(nil, where.Expr) {
+			hasColumn := false
+			_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				if _, ok := node.(*sqlparser.ColName); ok {
+					hasColumn = true
+					return false, nil
+				}
+				return true, nil
+			}, predicate)
+			if hasColumn {
+				predicates = append(predicates, sqlparser.String(predicate))
+			}
+		}
+		return true, nil
+	}, stmt)
+	return predicates
+}
+
+func isScatter(p engine.Primitive) bool {
+	switch p := p.(type) {
+	case *engine.Route:
+		return p.Opcode == engine.Scatter
+	case *engine.Update:
+		return p.Opcode == engine.Scatter
+	case *engine.Delete:
+		return p.Opcode == engine.Scatter
+	}
+	return false
+}
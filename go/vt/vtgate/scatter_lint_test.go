@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestWherePredicates(t *testing.T) {
+	testcases := []struct {
+		query string
+		want  []string
+	}{{
+		query: "select id from `user` where textcol1 = :vtg1 and 1 = 1 and (a = 1 or b in ::list)",
+		want:  []string{"textcol1 = :vtg1", "a = 1 or b in ::list"},
+	}, {
+		query: "select id from `user` group by id having count(*) > 1",
+	}, {
+		query: "delete from `user` where name like :vtg1",
+		want:  []string{"`name` like :vtg1"},
+	}, {
+		query: "select id from `user` where a = 1 union all select id from music where b = 2",
+		want:  []string{"a = 1", "b = 2"},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			assert.Equal(t, tc.want, wherePredicates(tc.query))
+		})
+	}
+}
+
+func TestExecutorScatterLint(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.scatterLint = newScatterLinter(executor, 2)
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+
+	execute := func(sql string) *vtgatepb.Session {
+		t.Helper()
+		session := NewAutocommitSession(session)
+		_, err := executor.Execute(context.Background(), "TestExecutorScatterLint", session, sql, nil)
+		require.NoError(t, err)
+		return session.Session
+	}
+
+	// a query that uses a vindex doesn't get a warning
+	got := execute("select id from user where id = 1")
+	assert.Empty(t, got.Warnings)
+
+	scatterWarning := []*querypb.QueryWarning{{
+		Message: "scatter query on keyspace TestExecutor: no vindex of user matched the predicates [textcol1 = 'a'], the vindex columns are [Id, name]",
+	}}
+	for i := 0; i < 3; i++ {
+		got = execute("select id from user where textcol1 = 'a'")
+		assert.Equal(t, scatterWarning, got.Warnings)
+	}
+	got = execute("delete from user_extra where extra = 'b'")
+	assert.Equal(t, []*querypb.QueryWarning{{
+		Message: "scatter query on keyspace TestExecutor: no vindex of user_extra matched the predicates [extra = 'b'], the vindex columns are [user_id]",
+	}}, got.Warnings)
+
+	offenders := executor.scatterLint.topOffenders(0)
+	require.Len(t, offenders, 2)
+	assert.Equal(t, scatterOffender{
+		Query:         "select id from user where textcol1 = 'a'",
+		Keyspace:      "TestExecutor",
+		Tables:        []string{"user"},
+		Predicates:    []string{"textcol1 = 'a'"},
+		VindexColumns: []string{"Id", "name"},
+		Count:         3,
+	}, offenders[0])
+	assert.EqualValues(t, 1, offenders[1].Count)
+
+	// the query that ran the least is evicted to make room for a new one
+	execute("select id from user where predef1 = 'c'")
+	offenders = executor.scatterLint.topOffenders(0)
+	require.Len(t, offenders, 2)
+	assert.Equal(t, "select id from user where textcol1 = 'a'", offenders[0].Query)
+	assert.Equal(t, "select id from user where predef1 = 'c'", offenders[1].Query)
+
+	// the report is served as JSON, optionally limited to the top offenders
+	request := httptest.NewRequest("GET", pathScatterOffenders+"?limit=1", nil)
+	response := httptest.NewRecorder()
+	executor.ServeHTTP(response, request)
+	var report []scatterOffender
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &report))
+	require.Len(t, report, 1)
+	assert.EqualValues(t, 3, report[0].Count)
+}
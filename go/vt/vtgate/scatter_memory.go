@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"sync/atomic"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	resultMemoryAccounting         = flag.String("result_memory_accounting", "rows", "How the memory of the results that multi-shard queries accumulate is limited. rows: the number of rows is limited to -max_memory_rows. bytes: the size of the results is limited to -max_result_memory_bytes_per_session and -max_result_memory_bytes")
+	maxResultMemoryBytesPerSession = flag.Int64("max_result_memory_bytes_per_session", 64*1024*1024, "With -result_memory_accounting=bytes, the maximum size in bytes of the results that the statement of a session holds until they are handed to the client. 0 means no limit")
+	maxResultMemoryBytes           = flag.Int64("max_result_memory_bytes", 1024*1024*1024, "With -result_memory_accounting=bytes, the maximum size in bytes of the results that all the statements of the vtgate hold at the same time. 0 means no limit")
+
+	resultMemoryBytes = stats.NewGauge(
+		"VtgateResultMemoryBytes",
+		"Size in bytes of the results that multi-shard queries are accumulating, with -result_memory_accounting=bytes")
+	resultMemoryExceeded = stats.NewCountersWithSingleLabel(
+		"VtgateResultMemoryExceeded",
+		"Number of multi-shard queries that failed because their results exceeded a memory budget",
+		"Budget")
+)
+
+// resultMemory is the size of the results that the queries of a statement
+// accumulate, which are held until the result of the statement is handed to
+// the client.
+type resultMemory struct {
+	// bytes is accessed atomically.
+	bytes int64
+}
+
+// resultMemoryAccountant limits the memory used by the results that
+// multi-shard queries accumulate from their shards.
+type resultMemoryAccountant interface {
+	// track returns the tracker of the results of a query of the session.
+	track(session *SafeSession) resultMemoryTracker
+	// release gives back the memory of the results that the session holds,
+	// once they are handed to the client.
+	release(session *SafeSession)
+}
+
+// resultMemoryTracker accounts for the results of a single query.
+type resultMemoryTracker interface {
+	// add accounts for a result before it is accumulated.
+	// It returns a RESOURCE_EXHAUSTED error if a budget is exceeded.
+	add(qr *sqltypes.Result) error
+	// done is called once the query no longer accumulates results. The
+	// memory of the results is given back then, unless the session holds it
+	// until they are handed to the client.
+	done()
+}
+
+// newResultMemoryAccountant returns the accountant of the given kind.
+func newResultMemoryAccountant(kind string, maxBytesPerSession, maxBytes int64) (resultMemoryAccountant, error) {
+	switch kind {
+	case "rows":
+		return rowsAccountant{}, nil
+	case "bytes":
+		if maxBytesPerSession < 0 || maxBytes < 0 {
+			return nil, fmt.Errorf("the result memory budgets can't be negative: %d bytes per session, %d bytes", maxBytesPerSession, maxBytes)
+		}
+		return &bytesAccountant{maxBytesPerSession: maxBytesPerSession, maxBytes: maxBytes}, nil
+	}
+	return nil, fmt.Errorf("unknown result memory accounting %q, expected rows or bytes", kind)
+}
+
+// rowsAccountant limits the number of rows of every query to -max_memory_rows.
+type rowsAccountant struct{}
+
+func (rowsAccountant) track(*SafeSession) resultMemoryTracker {
+	return &rowsTracker{}
+}
+
+func (rowsAccountant) release(*SafeSession) {}
+
+type rowsTracker struct {
+	rows int
+}
+
+func (t *rowsTracker) add(qr *sqltypes.Result) error {
+	t.rows += len(qr.Rows)
+	if t.rows > maxMemoryRows.Get() {
+		resultMemoryExceeded.Add("rows", 1)
+		return vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "in-memory row count exceeded allowed limit of %d", maxMemoryRows.Get())
+	}
+	return nil
+}
+
+func (t *rowsTracker) done() {}
+
+// bytesAccountant limits the size of the results that the statement of a
+// session, and all the statements of the vtgate, hold at the same time.
+// Unlike row counts, it accounts for the actual size of the rows, which
+// matters when they hold large blobs. The results are held until they are
+// handed to the client, since the primitives that join, sort or aggregate
+// them keep them until then.
+type bytesAccountant struct {
+	maxBytesPerSession int64
+	maxBytes           int64
+	// used is the size of the results that all the statements hold.
+	used int64
+}
+
+func (a *bytesAccountant) track(session *SafeSession) resultMemoryTracker {
+	if session.resultMemory != nil {
+		return &bytesTracker{accountant: a, memory: session.resultMemory, held: true}
+	}
+	// The internal queries, which don't hand their results to a client,
+	// give back their memory when they are done.
+	return &bytesTracker{accountant: a, memory: &resultMemory{}}
+}
+
+func (a *bytesAccountant) release(session *SafeSession) {
+	if session.resultMemory != nil {
+		a.free(session.resultMemory)
+	}
+}
+
+// free gives back the memory of the results of memory.
+func (a *bytesAccountant) free(memory *resultMemory) {
+	size := atomic.SwapInt64(&memory.bytes, 0)
+	atomic.AddInt64(&a.used, -size)
+	resultMemoryBytes.Add(-size)
+}
+
+type bytesTracker struct {
+	accountant *bytesAccountant
+	memory     *resultMemory
+	// held is true if the session holds the memory until the results are
+	// handed to the client.
+	held bool
+}
+
+func (t *bytesTracker) add(qr *sqltypes.Result) error {
+	size := qr.CachedSize(true)
+	sessionUsed := atomic.AddInt64(&t.memory.bytes, size)
+	used := atomic.AddInt64(&t.accountant.used, size)
+	resultMemoryBytes.Add(size)
+
+	if limit := t.accountant.maxBytesPerSession; limit > 0 && sessionUsed > limit {
+		resultMemoryExceeded.Add("session", 1)
+		return vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "in-memory result size of %d bytes exceeded the session limit of %d bytes", sessionUsed, limit)
+	}
+	if limit := t.accountant.maxBytes; limit > 0 && used > limit {
+		resultMemoryExceeded.Add("vtgate", 1)
+		return vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "in-memory result size of %d bytes exceeded the vtgate limit of %d bytes", used, limit)
+	}
+	return nil
+}
+
+func (t *bytesTracker) done() {
+	if !t.held {
+		t.accountant.free(t.memory)
+	}
+}
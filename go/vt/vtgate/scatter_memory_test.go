@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestNewResultMemoryAccountant(t *testing.T) {
+	a, err := newResultMemoryAccountant("rows", 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rowsAccountant{}, a)
+
+	a, err = newResultMemoryAccountant("bytes", 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, &bytesAccountant{maxBytesPerSession: 10, maxBytes: 100}, a)
+
+	_, err = newResultMemoryAccountant("bytes", -1, 100)
+	assert.EqualError(t, err, "the result memory budgets can't be negative: -1 bytes per session, 100 bytes")
+	_, err = newResultMemoryAccountant("blobs", 10, 100)
+	assert.EqualError(t, err, `unknown result memory accounting "blobs", expected rows or bytes`)
+}
+
+func TestBytesAccountant(t *testing.T) {
+	blob := &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewVarBinary(strings.Repeat("x", 1000))}}}
+	size := blob.CachedSize(true)
+	a := &bytesAccountant{maxBytesPerSession: 3 * size, maxBytes: 4 * size}
+
+	// The queries of a statement share the budget of its session, and their
+	// results are held until they are handed to the client.
+	session1 := NewSafeSession(nil)
+	require.True(t, session1.holdResultMemory())
+	require.False(t, session1.holdResultMemory())
+	t1 := a.track(session1)
+	t2 := a.track(session1)
+	require.NoError(t, t1.add(blob))
+	require.NoError(t, t1.add(blob))
+	t1.done()
+	require.NoError(t, t2.add(blob))
+	err := t2.add(blob)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualError(t, err, fmt.Sprintf("in-memory result size of %d bytes exceeded the session limit of %d bytes", 4*size, 3*size))
+	t2.done()
+	assert.Equal(t, 4*size, session1.resultMemory.bytes)
+	a.release(session1)
+	assert.Zero(t, session1.resultMemory.bytes)
+
+	// The autocommit sessions of the statement share its memory.
+	autocommit := NewAutocommitSession(session1.Session)
+	autocommit.resultMemory = session1.resultMemory
+	t3 := a.track(autocommit)
+	require.NoError(t, t3.add(blob))
+	t3.done()
+	assert.Equal(t, size, session1.resultMemory.bytes)
+
+	// All the sessions share the budget of the vtgate. The internal queries
+	// give back their memory when they are done.
+	t4 := a.track(NewSafeSession(nil))
+	require.NoError(t, t4.add(blob))
+	require.NoError(t, t4.add(blob))
+	require.NoError(t, t4.add(blob))
+	t5 := a.track(NewSafeSession(nil))
+	err = t5.add(blob)
+	assert.EqualError(t, err, fmt.Sprintf("in-memory result size of %d bytes exceeded the vtgate limit of %d bytes", 5*size, 4*size))
+	assert.EqualValues(t, 5*size, resultMemoryBytes.Get())
+	t4.done()
+	t5.done()
+	assert.EqualValues(t, size, a.used)
+
+	a.release(session1)
+	assert.Zero(t, a.used)
+	assert.Zero(t, resultMemoryBytes.Get())
+}
+
+func TestExecuteMultiShardResultMemory(t *testing.T) {
+	keyspace := "TestExecuteMultiShardResultMemory"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbc0,
+	}, {
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "1", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbc1,
+	}}
+	queries := []*querypb.BoundQuery{{Sql: "query"}, {Sql: "query"}}
+
+	// A single row with a large blob is within the row limit, but not within the byte budget.
+	blob := &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewVarBinary(strings.Repeat("x", 1<<20))}}}
+	sc.memory = &bytesAccountant{maxBytesPerSession: 1 << 20}
+	sbc0.SetResults([]*sqltypes.Result{blob})
+	sbc1.SetResults([]*sqltypes.Result{blob})
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, false)
+	require.Len(t, errs, 1)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(errs[0]))
+	assert.Contains(t, errs[0].Error(), "exceeded the session limit of 1048576 bytes")
+
+	// The budget doesn't apply when the limits are ignored, and the memory is released.
+	sbc0.SetResults([]*sqltypes.Result{blob})
+	sbc1.SetResults([]*sqltypes.Result{blob})
+	qr, errs := sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, true)
+	require.Empty(t, errs)
+	assert.Len(t, qr.Rows, 2)
+	assert.Zero(t, resultMemoryBytes.Get())
+}
+
+func TestExecuteResultMemory(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	blob := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varbinary"), "1|"+strings.Repeat("x", 100000))
+	size := blob.CachedSize(true)
+	a := &bytesAccountant{maxBytesPerSession: 3 * size}
+	executor.scatterConn.memory = a
+
+	// The results of the queries of a join are held until the result of the
+	// statement is handed to the client, so they share the budget, although
+	// every scatter is within the budget.
+	sbc1.SetResults([]*sqltypes.Result{blob, blob})
+	sbc2.SetResults([]*sqltypes.Result{blob, blob})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	_, err := executor.Execute(ctx, "TestExecuteResultMemory", session, "select u.name, m.id from user u join music m", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded the session limit")
+	assert.Zero(t, a.used)
+
+	// A single scatter within the budget releases its memory once it returns.
+	sbc1.SetResults([]*sqltypes.Result{blob})
+	sbc2.SetResults([]*sqltypes.Result{blob})
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	qr, err := executor.Execute(ctx, "TestExecuteResultMemory", session, "select id, name from user", nil)
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 8)
+	assert.Zero(t, a.used)
+	assert.Zero(t, resultMemoryBytes.Get())
+
+	// The streamed results are given back as they are handed to the client.
+	a.maxBytesPerSession = 0
+	sbc1.SetResults([]*sqltypes.Result{blob, blob})
+	sbc2.SetResults([]*sqltypes.Result{blob, blob})
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	err = executor.StreamExecute(ctx, "TestExecuteResultMemory", session, "select u.name, m.id from user u join music m", nil, func(*sqltypes.Result) error {
+		// The results are handed to the client after the callback.
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, a.used)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var queryRetries = stats.NewCountersWithMultiLabels(
+	"VtgateQueryRetries",
+	"Number of times a shard query was retried after a transient error",
+	[]string{"Keyspace", "ShardName", "DbType", "StatementClass"})
+
+// statementClass groups statements by how safe they are to retry. The DMLs
+// and DDLs are never retried: one that failed with a transient error, such as
+// a closed connection, may have been applied anyway.
+type statementClass string
+
+const (
+	selectClass statementClass = "select"
+	dmlClass    statementClass = "dml"
+	ddlClass    statementClass = "ddl"
+	otherClass  statementClass = "other"
+)
+
+// classifyStatement parses the statement, so that the DMLs that start with a
+// WITH clause are DMLs. The statements that can't be parsed, and the calls of
+// procedures and the loads that may write, are DMLs too. The other statements
+// are the reads that are not selects, such as SHOW.
+func classifyStatement(sql string) statementClass {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return dmlClass
+	}
+	switch stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union:
+		return selectClass
+	case *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete, *sqlparser.CallProc, *sqlparser.Load:
+		return dmlClass
+	case *sqlparser.Show, sqlparser.Explain, *sqlparser.OtherRead, *sqlparser.Use, *sqlparser.Set:
+		return otherClass
+	default:
+		return ddlClass
+	}
+}
+
+// neverRetried returns true for the statements that may have been applied
+// when they failed.
+func (class statementClass) neverRetried() bool {
+	return class == dmlClass || class == ddlClass
+}
+
+type retryPolicyKey struct {
+	tabletType topodatapb.TabletType
+	class      statementClass
+}
+
+// retryPolicy decides which shard queries ScatterConn retries when they fail
+// with a transient error outside of a transaction, and how long it waits
+// between the attempts. A nil retryPolicy never retries.
+type retryPolicy struct {
+	maxRetries     map[retryPolicyKey]int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// newRetryPolicy parses a policy of the form <tablet_type>:<statement_class>=<max_retries>,...
+// It returns nil if the policy doesn't retry any query.
+func newRetryPolicy(spec string, initialBackoff, maxBackoff time.Duration) (*retryPolicy, error) {
+	p := &retryPolicy{
+		maxRetries:     make(map[retryPolicyKey]int),
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid retry policy entry %q, expected <tablet_type>:<statement_class>=<max_retries>", entry)
+		}
+		target, retries := parts[0], parts[1]
+		parts = strings.Split(target, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid retry policy entry %q, expected <tablet_type>:<statement_class>=<max_retries>", entry)
+		}
+		tabletTypeName, class := parts[0], parts[1]
+		tabletType, err := topoproto.ParseTabletType(tabletTypeName)
+		if err != nil {
+			return nil, err
+		}
+		switch statementClass(class) {
+		case selectClass, otherClass:
+		case dmlClass, ddlClass:
+			return nil, fmt.Errorf("invalid retry policy entry %q, the DMLs and DDLs are never retried since they may have been applied", entry)
+		default:
+			return nil, fmt.Errorf("invalid statement class %q in retry policy, expected select or other", class)
+		}
+		n, err := strconv.Atoi(retries)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid number of retries %q in retry policy", retries)
+		}
+		if n > 0 {
+			p.maxRetries[retryPolicyKey{tabletType: tabletType, class: statementClass(class)}] = n
+		}
+	}
+	if len(p.maxRetries) == 0 {
+		return nil, nil
+	}
+	if initialBackoff <= 0 || maxBackoff < initialBackoff {
+		return nil, fmt.Errorf("invalid retry backoff: the initial backoff (%v) must be positive and not greater than the max backoff (%v)", initialBackoff, maxBackoff)
+	}
+	return p, nil
+}
+
+// backoff returns how long to wait before the given retry, starting at 1.
+// The backoff doubles with every retry, up to the max backoff, and a random
+// jitter of up to half of it spreads the retries of concurrent queries.
+func (p *retryPolicy) backoff(retry int) time.Duration {
+	backoff := p.maxBackoff
+	if retry <= 32 {
+		if b := p.initialBackoff << (retry - 1); b > 0 && b < backoff {
+			backoff = b
+		}
+	}
+	half := backoff / 2
+	return backoff - half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isTransientError returns true if the query may succeed if it is retried.
+// Closed connections are only transient outside of transactions.
+func isTransientError(err error) bool {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_CLUSTER_EVENT:
+		return true
+	}
+	return wasConnectionClosed(err)
+}
+
+// run executes the action, and retries it as long as it fails with a transient
+// error and the policy allows it. Queries that run in a transaction or on a
+// reserved connection are never retried, they are handled by the session.
+// If canRetry is not nil, it must also return true for the action to be retried.
+func (p *retryPolicy) run(ctx context.Context, target *querypb.Target, sql string, info *shardActionInfo, canRetry func() bool, action func() error) error {
+	err := action()
+	if err == nil || p == nil || info.transactionID != 0 || info.reservedID != 0 {
+		return err
+	}
+	class := classifyStatement(sql)
+	if class.neverRetried() {
+		return err
+	}
+	maxRetries := p.maxRetries[retryPolicyKey{tabletType: target.TabletType, class: class}]
+	for retry := 1; err != nil && retry <= maxRetries && isTransientError(err); retry++ {
+		if canRetry != nil && !canRetry() {
+			break
+		}
+		timer := time.NewTimer(p.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		queryRetries.Add([]string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType), string(class)}, 1)
+		err = action()
+	}
+	return err
+}
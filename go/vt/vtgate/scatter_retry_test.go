@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	p, err := newRetryPolicy("", time.Millisecond, time.Second)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = newRetryPolicy("replica:select=0", time.Millisecond, time.Second)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = newRetryPolicy("replica:select=3, rdonly:select=1,primary:other=2", time.Millisecond, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, map[retryPolicyKey]int{
+		{tabletType: topodatapb.TabletType_REPLICA, class: selectClass}: 3,
+		{tabletType: topodatapb.TabletType_RDONLY, class: selectClass}:  1,
+		{tabletType: topodatapb.TabletType_PRIMARY, class: otherClass}:  2,
+	}, p.maxRetries)
+
+	testcases := []struct {
+		spec, err string
+	}{{
+		spec: "replica=3",
+		err:  `invalid retry policy entry "replica=3", expected <tablet_type>:<statement_class>=<max_retries>`,
+	}, {
+		spec: "replica:select",
+		err:  `invalid retry policy entry "replica:select", expected <tablet_type>:<statement_class>=<max_retries>`,
+	}, {
+		spec: "replica:call=1",
+		err:  `invalid statement class "call" in retry policy, expected select or other`,
+	}, {
+		spec: "primary:dml=1",
+		err:  `invalid retry policy entry "primary:dml=1", the DMLs and DDLs are never retried since they may have been applied`,
+	}, {
+		spec: "replica:ddl=1",
+		err:  `invalid retry policy entry "replica:ddl=1", the DMLs and DDLs are never retried since they may have been applied`,
+	}, {
+		spec: "replica:select=-1",
+		err:  `invalid number of retries "-1" in retry policy`,
+	}, {
+		spec: "foo:select=1",
+		err:  `unknown TabletType foo`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.spec, func(t *testing.T) {
+			_, err := newRetryPolicy(tc.spec, time.Millisecond, time.Second)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+
+	_, err = newRetryPolicy("replica:select=1", time.Second, time.Millisecond)
+	assert.EqualError(t, err, "invalid retry backoff: the initial backoff (1s) must be positive and not greater than the max backoff (1ms)")
+}
+
+func TestClassifyStatement(t *testing.T) {
+	testcases := []struct {
+		sql   string
+		class statementClass
+	}{
+		{sql: "select 1", class: selectClass},
+		{sql: "select a from t union select b from u", class: selectClass},
+		{sql: "with x as (select 1) select * from x", class: selectClass},
+		{sql: "with x as (select 1) update t join x set t.a = 1", class: dmlClass},
+		{sql: "with x as (select 1) delete t from t join x", class: dmlClass},
+		{sql: "insert into t values (1)", class: dmlClass},
+		{sql: "call p()", class: dmlClass},
+		{sql: "not a statement", class: dmlClass},
+		{sql: "alter table t add column b int", class: ddlClass},
+		{sql: "create database d", class: ddlClass},
+		{sql: "optimize table t", class: ddlClass},
+		{sql: "show tables", class: otherClass},
+		{sql: "describe t", class: otherClass},
+		{sql: "set @a = 1", class: otherClass},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			assert.Equal(t, tc.class, classifyStatement(tc.sql))
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &retryPolicy{initialBackoff: 10 * time.Millisecond, maxBackoff: 100 * time.Millisecond}
+	testcases := []struct {
+		retry    int
+		min, max time.Duration
+	}{
+		{retry: 1, min: 5 * time.Millisecond, max: 10 * time.Millisecond},
+		{retry: 2, min: 10 * time.Millisecond, max: 20 * time.Millisecond},
+		{retry: 4, min: 40 * time.Millisecond, max: 80 * time.Millisecond},
+		{retry: 5, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{retry: 100, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+	}
+	for _, tc := range testcases {
+		for i := 0; i < 20; i++ {
+			backoff := p.backoff(tc.retry)
+			assert.GreaterOrEqual(t, backoff, tc.min, "retry %d", tc.retry)
+			assert.LessOrEqual(t, backoff, tc.max, "retry %d", tc.retry)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	assert.True(t, isTransientError(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available")))
+	assert.True(t, isTransientError(vterrors.New(vtrpcpb.Code_CLUSTER_EVENT, "operation not allowed in state NOT_SERVING")))
+	assert.True(t, isTransientError(mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "lost connection")))
+	assert.False(t, isTransientError(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error")))
+	assert.False(t, isTransientError(vterrors.New(vtrpcpb.Code_ALREADY_EXISTS, "duplicate entry")))
+}
+
+func TestScatterConnRetry(t *testing.T) {
+	keyspace := "TestScatterConnRetry"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	var err error
+	sc.retry, err = newRetryPolicy("replica:select=2", time.Millisecond, time.Millisecond)
+	require.NoError(t, err)
+
+	sbc := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbc,
+	}}
+	retries := func() int64 {
+		return queryRetries.Counts()[keyspace+".0.replica.select"]
+	}
+	execute := func(sql string, session *SafeSession) error {
+		_, errs := sc.ExecuteMultiShard(ctx, rss, []*querypb.BoundQuery{{Sql: sql}}, session, false, false)
+		return vterrors.Aggregate(errs)
+	}
+
+	// transient errors are retried up to the limit of the policy
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 2
+	require.NoError(t, execute("select 1", NewSafeSession(nil)))
+	assert.EqualValues(t, 3, sbc.ExecCount.Get())
+	assert.EqualValues(t, 2, retries())
+
+	sbc.ExecCount.Set(0)
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 3
+	require.Error(t, execute("select 1", NewSafeSession(nil)))
+	assert.EqualValues(t, 3, sbc.ExecCount.Get())
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 0
+
+	// other errors, other statement classes and transactions are not retried
+	sbc.ExecCount.Set(0)
+	sbc.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	require.Error(t, execute("select 1", NewSafeSession(nil)))
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	require.Error(t, execute("update t set a = 1", NewSafeSession(nil)))
+	// the DMLs are not retried even if the policy has an entry for them
+	sc.retry.maxRetries[retryPolicyKey{tabletType: topodatapb.TabletType_REPLICA, class: dmlClass}] = 2
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	require.Error(t, execute("insert into t values (1)", NewSafeSession(nil)))
+	delete(sc.retry.maxRetries, retryPolicyKey{tabletType: topodatapb.TabletType_REPLICA, class: dmlClass})
+	// nor the DMLs that start with a WITH clause
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	require.Error(t, execute("with x as (select 1) delete t from t join x", NewSafeSession(nil)))
+	// nor the DDLs
+	sc.retry.maxRetries[retryPolicyKey{tabletType: topodatapb.TabletType_REPLICA, class: otherClass}] = 2
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	require.Error(t, execute("alter table t add column b int", NewSafeSession(nil)))
+	delete(sc.retry.maxRetries, retryPolicyKey{tabletType: topodatapb.TabletType_REPLICA, class: otherClass})
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, ShardSessions: []*vtgatepb.Session_ShardSession{{
+		Target:        rss[0].Target,
+		TransactionId: 1,
+	}}})
+	require.Error(t, execute("select 1", session))
+	assert.EqualValues(t, 6, sbc.ExecCount.Get())
+	assert.EqualValues(t, 4, retries())
+
+	// streams are retried until they send results
+	sbc.ExecCount.Set(0)
+	sbc.MustFailCodes[vtrpcpb.Code_CLUSTER_EVENT] = 1
+	var rows int
+	errs := sc.StreamExecuteMulti(ctx, "select 1", rss, make([]map[string]*querypb.BindVariable, 1), NewSafeSession(nil), false, func(qr *sqltypes.Result) error {
+		rows += len(qr.Rows)
+		return nil
+	})
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Equal(t, 1, rows)
+	assert.EqualValues(t, 2, sbc.ExecCount.Get())
+}
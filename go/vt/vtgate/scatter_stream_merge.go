@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/srvtopo"
+)
+
+var (
+	streamMergeBufferedResults = stats.NewGauge(
+		"VtgateStreamMergeBufferedResults",
+		"Number of shard results buffered by the streams of multi-shard queries that are waiting to be sent to the client")
+	streamMergeBufferFull = stats.NewCountersWithMultiLabels(
+		"VtgateStreamMergeBufferFull",
+		"Number of times a shard stream of a multi-shard query was paused because its buffer was full",
+		[]string{"Keyspace", "ShardName"})
+)
+
+// streamMerger merges the results that the shards of a multi-shard query
+// stream into a single callback. Every shard can get ahead of the callback by
+// up to a fixed number of results, which are buffered. A shard whose buffer is
+// full is paused until the callback catches up, without holding back the other
+// shards, and since results are sent in the order in which they arrived,
+// a fast shard can't starve the others.
+type streamMerger struct {
+	callback func(*sqltypes.Result) error
+
+	// slots holds one token for every buffered result of a shard.
+	slots   []chan struct{}
+	results chan shardResult
+	// done is closed when the callback fails.
+	done     chan struct{}
+	finished chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+type shardResult struct {
+	shard  int
+	result *sqltypes.Result
+}
+
+// newStreamMerger creates a streamMerger for n shards that buffers
+// up to bufferSize results per shard, and starts sending the results.
+func newStreamMerger(n, bufferSize int, callback func(*sqltypes.Result) error) *streamMerger {
+	m := &streamMerger{
+		callback: callback,
+		slots:    make([]chan struct{}, n),
+		// Every shard holds a slot for each of its results in the channel,
+		// so sending to it never blocks.
+		results:  make(chan shardResult, n*bufferSize),
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	for i := range m.slots {
+		m.slots[i] = make(chan struct{}, bufferSize)
+	}
+	go m.send()
+	return m
+}
+
+func (m *streamMerger) send() {
+	defer close(m.finished)
+	for sr := range m.results {
+		if m.error() == nil {
+			if err := m.callback(sr.result); err != nil {
+				m.mu.Lock()
+				m.err = err
+				m.mu.Unlock()
+				close(m.done)
+			}
+		}
+		// Once the callback failed, the remaining results are dropped.
+		streamMergeBufferedResults.Add(-1)
+		<-m.slots[sr.shard]
+	}
+}
+
+func (m *streamMerger) error() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+// shardCallback returns the callback for the results of a shard.
+func (m *streamMerger) shardCallback(ctx context.Context, rs *srvtopo.ResolvedShard, i int) func(*sqltypes.Result) error {
+	return func(qr *sqltypes.Result) error {
+		select {
+		case m.slots[i] <- struct{}{}:
+		default:
+			streamMergeBufferFull.Add([]string{rs.Target.Keyspace, rs.Target.Shard}, 1)
+			select {
+			case m.slots[i] <- struct{}{}:
+			case <-m.done:
+				return m.error()
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := m.error(); err != nil {
+			<-m.slots[i]
+			return err
+		}
+		streamMergeBufferedResults.Add(1)
+		m.results <- shardResult{shard: i, result: qr}
+		return nil
+	}
+}
+
+// wait must be called once all the shards are done. It waits for the buffered
+// results to be sent, and returns the error of the callback, if any.
+func (m *streamMerger) wait() error {
+	close(m.results)
+	<-m.finished
+	return m.error()
+}
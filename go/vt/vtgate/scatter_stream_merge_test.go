@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestStreamMergerBackpressure(t *testing.T) {
+	rss := limiterTestShards("TestStreamMergerBackpressure", 2)
+	bufferFull := streamMergeBufferFull.Counts()["TestStreamMergerBackpressure.0"]
+	release := make(chan struct{})
+	var received int64
+	m := newStreamMerger(len(rss), 2, func(*sqltypes.Result) error {
+		<-release
+		atomic.AddInt64(&received, 1)
+		return nil
+	})
+
+	// Results hold on to their slot until the callback is done with them.
+	fast := m.shardCallback(context.Background(), rss[0], 0)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, fast(&sqltypes.Result{}))
+	}
+	paused := make(chan error)
+	go func() {
+		paused <- fast(&sqltypes.Result{})
+	}()
+	select {
+	case <-paused:
+		t.Fatal("shard with a full buffer was not paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.EqualValues(t, bufferFull+1, streamMergeBufferFull.Counts()["TestStreamMergerBackpressure.0"])
+
+	// The other shard has its own buffer.
+	slow := m.shardCallback(context.Background(), rss[1], 1)
+	require.NoError(t, slow(&sqltypes.Result{}))
+	require.NoError(t, slow(&sqltypes.Result{}))
+
+	close(release)
+	require.NoError(t, <-paused)
+	require.NoError(t, m.wait())
+	assert.EqualValues(t, 5, received)
+	assert.EqualValues(t, 0, streamMergeBufferedResults.Get())
+}
+
+func TestStreamMergerCallbackError(t *testing.T) {
+	rss := limiterTestShards("TestStreamMergerCallbackError", 1)
+	var calls int64
+	m := newStreamMerger(len(rss), 1, func(*sqltypes.Result) error {
+		atomic.AddInt64(&calls, 1)
+		return errors.New("client went away")
+	})
+
+	send := m.shardCallback(context.Background(), rss[0], 0)
+	require.NoError(t, send(&sqltypes.Result{}))
+	// Once the callback fails, the shard gets its error.
+	var err error
+	for err == nil {
+		err = send(&sqltypes.Result{})
+	}
+	assert.EqualError(t, err, "client went away")
+	assert.EqualError(t, m.wait(), "client went away")
+	assert.EqualValues(t, 1, calls)
+}
+
+func TestScatterConnStreamExecuteMultiBuffered(t *testing.T) {
+	keyspace := "TestScatterConnStreamExecuteMultiBuffered"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sc.streamBufferSize = 1
+
+	var rss []*srvtopo.ResolvedShard
+	for i := 0; i < 4; i++ {
+		shard := fmt.Sprint(i)
+		sbc := hc.AddTestTablet("aa", shard, 1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+		rss = append(rss, &srvtopo.ResolvedShard{
+			Target:  &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_REPLICA},
+			Gateway: sbc,
+		})
+	}
+
+	var count int
+	errs := sc.StreamExecuteMulti(ctx, "query", rss, make([]map[string]*querypb.BindVariable, len(rss)), NewSafeSession(nil), false, func(qr *sqltypes.Result) error {
+		// The callback is never called concurrently.
+		count += len(qr.Rows)
+		return nil
+	})
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Equal(t, len(rss), count)
+
+	errs = sc.StreamExecuteMulti(ctx, "query", rss, make([]map[string]*querypb.BindVariable, len(rss)), NewSafeSession(nil), false, func(qr *sqltypes.Result) error {
+		return errors.New("client went away")
+	})
+	require.NotEmpty(t, errs)
+	assert.Contains(t, vterrors.Aggregate(errs).Error(), "client went away")
+}
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 
@@ -48,6 +49,9 @@ type (
 		tables *tableMap
 		ctx    context.Context
 		signal func() // a function that we'll call whenever we have new schema data
+		// tablesChanged is called with the tables whose schema changed, or with
+		// nil tables when the whole schema of the keyspace was reloaded.
+		tablesChanged func(keyspace string, tables []string)
 
 		// map of keyspace currently tracked
 		tracked      map[keyspaceStr]*updateController
@@ -83,7 +87,6 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 		return err
 	}
 	t.mu.Lock()
-	defer t.mu.Unlock()
 	// We must clear out any previous schema before loading it here as this is called
 	// whenever a shard's primary tablet starts and sends the initial signal. Without
 	// clearing out the previous schema we can end up with duplicate entries when the
@@ -91,7 +94,12 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 	t.clearKeyspaceTables(target.Keyspace)
 	t.updateTables(target.Keyspace, res)
 	t.tracked[target.Keyspace].setLoaded(true)
+	tablesChanged := t.tablesChanged
+	t.mu.Unlock()
 	log.Infof("finished loading schema for keyspace %s. Found %d columns in total across the tables", target.Keyspace, len(res.Rows))
+	if tablesChanged != nil {
+		tablesChanged(target.Keyspace, nil)
+	}
 	return nil
 }
 
@@ -170,6 +178,11 @@ func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
 
 func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 	tablesUpdated := th.Stats.TableSchemaChanged
+	if th.Stats.SchemaGeneration > 0 {
+		// The primary pushed the columns of the changed tables.
+		t.updatePushedSchema(th.Target.Keyspace, tablesUpdated, th.Stats.TableSchemaColumns)
+		return true
+	}
 	tables, err := sqltypes.BuildBindVariable(tablesUpdated)
 	if err != nil {
 		log.Errorf("failed to read updated tables from TabletHealth: %v", err)
@@ -185,17 +198,46 @@ func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 	}
 
 	t.mu.Lock()
-	defer t.mu.Unlock()
-
 	// first we empty all prior schema. deleted tables will not show up in the result,
 	// so this is the only chance to delete
 	for _, tbl := range tablesUpdated {
 		t.tables.delete(th.Target.Keyspace, tbl)
 	}
 	t.updateTables(th.Target.Keyspace, res)
+	tablesChanged := t.tablesChanged
+	t.mu.Unlock()
+
+	if tablesChanged != nil {
+		tablesChanged(th.Target.Keyspace, tablesUpdated)
+	}
 	return true
 }
 
+// updatePushedSchema replaces the columns of the tables with the ones that
+// their primary pushed. The tables without columns were dropped.
+func (t *Tracker) updatePushedSchema(keyspace string, tablesUpdated []string, columns []*querypb.Field) {
+	t.mu.Lock()
+	for _, tbl := range tablesUpdated {
+		t.tables.delete(keyspace, tbl)
+	}
+	for _, column := range columns {
+		col := vindexes.Column{Name: sqlparser.NewColIdent(column.Name), Type: column.Type}
+		if column.Charset != 0 {
+			if collation := collations.Local().LookupByID(collations.ID(column.Charset)); collation != nil {
+				col.CollationName = collation.Name()
+			}
+		}
+		cols := t.tables.get(keyspace, column.Table)
+		t.tables.set(keyspace, column.Table, append(cols, col))
+	}
+	tablesChanged := t.tablesChanged
+	t.mu.Unlock()
+
+	if tablesChanged != nil {
+		tablesChanged(keyspace, tablesUpdated)
+	}
+}
+
 func (t *Tracker) updateTables(keyspace string, res *sqltypes.Result) {
 	for _, row := range res.Rows {
 		tbl := row[0].ToString()
@@ -221,6 +263,15 @@ func (t *Tracker) RegisterSignalReceiver(f func()) {
 	t.signal = f
 }
 
+// RegisterTablesChangedReceiver allows a function to register to be called
+// with the tables whose schema changed, or with nil tables when the schema of
+// a whole keyspace was reloaded.
+func (t *Tracker) RegisterTablesChangedReceiver(f func(keyspace string, tables []string)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tablesChanged = f
+}
+
 // AddNewKeyspace adds keyspace to the tracker.
 func (t *Tracker) AddNewKeyspace(conn queryservice.QueryService, target *querypb.Target) error {
 	updateController := t.newUpdateController()
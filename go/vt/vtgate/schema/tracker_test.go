@@ -308,3 +308,77 @@ func TestTrackerGetKeyspaceUpdateController(t *testing.T) {
 	assert.NotNil(t, ks2.reloadKeyspace, "ks2 needs to be initialized")
 	assert.Nil(t, ks3.reloadKeyspace, "ks3 already initialized")
 }
+
+func TestTrackerTablesChangedReceiver(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY}
+	tablet := &topodatapb.Tablet{Keyspace: target.Keyspace, Shard: target.Shard, Type: target.TabletType}
+	fields := sqltypes.MakeTestFields("table_name|col_name|col_type|collation_name", "varchar|varchar|varchar|varchar")
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "t1|id|int|"),
+		sqltypes.MakeTestResult(fields, "t1|id|int|", "t1|name|varchar|utf8_bin"),
+	})
+
+	type change struct {
+		keyspace string
+		tables   []string
+	}
+	var changes []change
+	tracker := NewTracker(nil, nil)
+	tracker.RegisterTablesChangedReceiver(func(keyspace string, tables []string) {
+		changes = append(changes, change{keyspace, tables})
+	})
+
+	// loading the keyspace changes all of its tables
+	require.NoError(t, tracker.AddNewKeyspace(sbc, target))
+	updated := tracker.updateSchema(&discovery.TabletHealth{
+		Conn:   sbc,
+		Tablet: tablet,
+		Target: target,
+		Stats:  &querypb.RealtimeStats{TableSchemaChanged: []string{"t1"}},
+	})
+	require.True(t, updated)
+	assert.Equal(t, []change{{"ks", nil}, {"ks", []string{"t1"}}}, changes)
+}
+
+func TestTrackerPushedSchema(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY}
+	tablet := &topodatapb.Tablet{Keyspace: target.Keyspace, Shard: target.Shard, Type: target.TabletType}
+	fields := sqltypes.MakeTestFields("table_name|col_name|col_type|collation_name", "varchar|varchar|varchar|varchar")
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "t1|id|int|", "t2|id|int|"),
+	})
+
+	var changed []string
+	tracker := NewTracker(nil, nil)
+	tracker.RegisterTablesChangedReceiver(func(keyspace string, tables []string) {
+		changed = tables
+	})
+	require.NoError(t, tracker.AddNewKeyspace(sbc, target))
+
+	// the pushed columns are applied without querying the tablet
+	updated := tracker.updateSchema(&discovery.TabletHealth{
+		Tablet: tablet,
+		Target: target,
+		Stats: &querypb.RealtimeStats{
+			SchemaGeneration:   1,
+			TableSchemaChanged: []string{"t1", "t2", "t3"},
+			TableSchemaColumns: []*querypb.Field{
+				{Table: "t1", Name: "id", Type: querypb.Type_INT32},
+				{Table: "t1", Name: "name", Type: querypb.Type_VARCHAR, Charset: 45},
+				{Table: "t3", Name: "id", Type: querypb.Type_INT64},
+			},
+		},
+	})
+	require.True(t, updated)
+	assert.Equal(t, []string{"t1", "t2", "t3"}, changed)
+	assert.EqualValues(t, 1, sbc.ExecCount.Get())
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32},
+		{Name: sqlparser.NewColIdent("name"), Type: querypb.Type_VARCHAR, CollationName: "utf8mb4_general_ci"},
+	}, tracker.GetColumns("ks", "t1"))
+	// t2 was dropped
+	assert.Empty(t, tracker.GetColumns("ks", "t2"))
+	assert.Equal(t, []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT64}}, tracker.GetColumns("ks", "t3"))
+}
@@ -20,8 +20,12 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
 	"vitess.io/vitess/go/vt/discovery"
@@ -41,6 +45,10 @@ type (
 		signal         func()
 		loaded         bool
 
+		// generations are the last schema generations of the primaries that
+		// push their schema changes, by tablet alias.
+		generations map[string]int64
+
 		// we'll only log a failed keyspace loading once
 		ignore bool
 	}
@@ -96,9 +104,13 @@ func (u *updateController) getItemFromQueueLocked() *discovery.TabletHealth {
 	item := u.queue.items[0]
 	itemsCount := len(u.queue.items)
 	// Only when we want to update selected tables.
-	if u.loaded {
+	if u.loaded && itemsCount > 1 {
+		merged := *item
+		merged.Stats = proto.Clone(item.Stats).(*querypb.RealtimeStats)
+		item = &merged
 		for i := 1; i < itemsCount; i++ {
-			for _, table := range u.queue.items[i].Stats.TableSchemaChanged {
+			stats := u.queue.items[i].Stats
+			for _, table := range stats.TableSchemaChanged {
 				found := false
 				for _, itemTable := range item.Stats.TableSchemaChanged {
 					if itemTable == table {
@@ -110,6 +122,7 @@ func (u *updateController) getItemFromQueueLocked() *discovery.TabletHealth {
 					item.Stats.TableSchemaChanged = append(item.Stats.TableSchemaChanged, table)
 				}
 			}
+			mergePushedColumns(item.Stats, stats)
 		}
 	}
 	// emptying queue's items as all items from 0 to i (length of the queue) are merged
@@ -117,6 +130,49 @@ func (u *updateController) getItemFromQueueLocked() *discovery.TabletHealth {
 	return item
 }
 
+// mergePushedColumns merges the columns of the tables that changed in
+// stats, pushed by their primary, into the ones of merged, which then has the
+// latest columns of each table. If either wasn't pushed, the columns must be
+// fetched from the tablet, so merged loses its pushed columns.
+func mergePushedColumns(merged, stats *querypb.RealtimeStats) {
+	if merged.SchemaGeneration == 0 || stats.SchemaGeneration == 0 {
+		merged.SchemaGeneration = 0
+		merged.TableSchemaColumns = nil
+		return
+	}
+	changed := make(map[string]bool, len(stats.TableSchemaChanged))
+	for _, table := range stats.TableSchemaChanged {
+		changed[table] = true
+	}
+	columns := merged.TableSchemaColumns[:0]
+	for _, column := range merged.TableSchemaColumns {
+		if !changed[column.Table] {
+			columns = append(columns, column)
+		}
+	}
+	merged.TableSchemaColumns = append(columns, stats.TableSchemaColumns...)
+}
+
+// checkGenerationLocked records the schema generation of a primary that
+// pushes its schema changes, and returns false if it skipped generations,
+// i.e. if changes were missed.
+func (u *updateController) checkGenerationLocked(th *discovery.TabletHealth) bool {
+	generation := th.Stats.SchemaGeneration
+	if generation == 0 {
+		return true
+	}
+	if u.generations == nil {
+		u.generations = make(map[string]int64)
+	}
+	alias := topoproto.TabletAliasString(th.Tablet.Alias)
+	last, seen := u.generations[alias]
+	u.generations[alias] = generation
+	if !seen || generation == last {
+		return true
+	}
+	return generation == last+1 && len(th.Stats.TableSchemaChanged) > 0
+}
+
 func (u *updateController) add(th *discovery.TabletHealth) {
 	// For non-primary tablet health, there is no schema tracking.
 	if th.Tablet.Type != topodatapb.TabletType_PRIMARY {
@@ -130,9 +186,15 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 	// The connection will get reset and the tracker needs to reload the schema for the keyspace.
 	if !th.Serving {
 		u.loaded = false
+		delete(u.generations, topoproto.TabletAliasString(th.Tablet.Alias))
 		return
 	}
 
+	// The schema will be reloaded if changes were missed.
+	if !u.checkGenerationLocked(th) {
+		u.loaded = false
+	}
+
 	// If the keyspace schema is loaded and there is no schema change detected. Then there is nothing to process.
 	if len(th.Stats.TableSchemaChanged) == 0 && u.loaded {
 		return
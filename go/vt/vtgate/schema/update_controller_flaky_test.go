@@ -177,3 +177,42 @@ func TestMultipleUpdatesFromDifferentShards(t *testing.T) {
 		})
 	}
 }
+
+func TestMergePushedColumns(t *testing.T) {
+	a := &querypb.Field{Table: "t1", Name: "a", Type: querypb.Type_INT32}
+	b := &querypb.Field{Table: "t1", Name: "b", Type: querypb.Type_INT32}
+	c := &querypb.Field{Table: "t2", Name: "c", Type: querypb.Type_INT32}
+
+	merged := &querypb.RealtimeStats{SchemaGeneration: 1, TableSchemaChanged: []string{"t1", "t2"}, TableSchemaColumns: []*querypb.Field{a, c}}
+	mergePushedColumns(merged, &querypb.RealtimeStats{SchemaGeneration: 2, TableSchemaChanged: []string{"t1"}, TableSchemaColumns: []*querypb.Field{a, b}})
+	assert.EqualValues(t, 1, merged.SchemaGeneration)
+	assert.Equal(t, []*querypb.Field{c, a, b}, merged.TableSchemaColumns)
+
+	// the columns of a tablet that doesn't push them must be fetched
+	mergePushedColumns(merged, &querypb.RealtimeStats{TableSchemaChanged: []string{"t3"}})
+	assert.Zero(t, merged.SchemaGeneration)
+	assert.Nil(t, merged.TableSchemaColumns)
+}
+
+func TestCheckGeneration(t *testing.T) {
+	u := &updateController{}
+	health := func(uid uint32, generation int64, tables ...string) *discovery.TabletHealth {
+		return &discovery.TabletHealth{
+			Tablet: &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: uid}},
+			Stats:  &querypb.RealtimeStats{SchemaGeneration: generation, TableSchemaChanged: tables},
+		}
+	}
+
+	assert.True(t, u.checkGenerationLocked(health(1, 0)))
+	assert.True(t, u.checkGenerationLocked(health(1, 3)))
+	assert.True(t, u.checkGenerationLocked(health(1, 3)))
+	assert.True(t, u.checkGenerationLocked(health(1, 4, "t1")))
+	assert.True(t, u.checkGenerationLocked(health(2, 7)))
+	// generation 5 was missed
+	assert.False(t, u.checkGenerationLocked(health(1, 6, "t1")))
+	assert.True(t, u.checkGenerationLocked(health(1, 7, "t2")))
+	// a new generation must have changes
+	assert.False(t, u.checkGenerationLocked(health(1, 8)))
+	// the tablet restarted
+	assert.False(t, u.checkGenerationLocked(health(1, 1, "t1")))
+}
@@ -0,0 +1,424 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// sequencePrefetchTimeout bounds the reads of the blocks of values that are
+// prefetched in the background.
+const sequencePrefetchTimeout = 10 * time.Second
+
+var (
+	sequenceRequests = stats.NewCountersWithMultiLabels(
+		"VtgateSequenceRequests",
+		"Number of requests for values of the sequences, by whether the values were cached by vtgate (hit) or read from a sequence table (miss)",
+		[]string{"Sequence", "Result"})
+	sequenceFetches = stats.NewCountersWithMultiLabels(
+		"VtgateSequenceFetches",
+		"Number of blocks of values of the sequences read from their tables, by sequence table",
+		[]string{"Sequence", "Backend"})
+	sequencePrefetches = stats.NewCountersWithSingleLabel(
+		"VtgateSequencePrefetches",
+		"Number of blocks of values read in the background because the values cached for a sequence went below its low watermark",
+		"Sequence")
+	sequenceDiscardedValues = stats.NewCountersWithSingleLabel(
+		"VtgateSequenceDiscardedValues",
+		"Number of values cached for the sequences that were skipped because a request needed more consecutive values than were left",
+		"Sequence")
+	_ = stats.NewRates("VtgateSequenceFetchRates", stats.CounterForDimension(sequenceFetches, "Sequence"), 15, time.Minute)
+)
+
+// sequenceBackend is a sequence table from which the values of a sequence
+// are read.
+type sequenceBackend struct {
+	keyspace string
+	table    string
+	query    string
+	// start and end bound the values that the backend may return, end
+	// excluded, so that the backends of a sequence never overlap. An end
+	// of 0 is unbounded.
+	start, end int64
+	// pinned is set for the sequence table of the vschema when it is pinned
+	// in a sharded keyspace. Its shard is the one the engine resolved.
+	pinned bool
+	// exhausted is set once the backend went beyond its range.
+	exhausted bool
+}
+
+func (b *sequenceBackend) String() string {
+	return b.keyspace + "." + b.table
+}
+
+// sequenceRule overrides the cache size of a sequence, and can spread its
+// values across several backends.
+type sequenceRule struct {
+	cacheSize int64
+	backends  []*sequenceBackend
+}
+
+// cachedSequence holds the values of a sequence that vtgate reserved, but did
+// not hand out yet.
+type cachedSequence struct {
+	name      string
+	cacheSize int64
+
+	// mu protects the following fields. It is held while a block is read
+	// for a request, so that the requests that run out of values at the same
+	// time read a single block.
+	mu       sync.Mutex
+	backends []*sequenceBackend
+	// lastBackend is the index of the last backend that was read.
+	lastBackend int
+	// shard is where a pinned sequence table was last resolved.
+	shard *srvtopo.ResolvedShard
+	// next and end bound the values left in the current block, end excluded.
+	next, end int64
+	// prefetched is the block read in the background once the current block
+	// went below the low watermark.
+	prefetched  *sequenceBlock
+	prefetching bool
+}
+
+type sequenceBlock struct {
+	next, end int64
+}
+
+// sequenceCache reserves blocks of values of the sequences, and hands them
+// out to the inserts, so that the single shard of a sequence table is not
+// read by every insert. The values that vtgate reserved but did not hand out
+// are lost when it restarts, like the values cached by vttablet.
+type sequenceCache struct {
+	executor *Executor
+	// cacheSize is the number of values reserved at once for each sequence,
+	// unless its rule says otherwise. 0 reads the values of every request.
+	cacheSize int64
+	// lowWatermark is the ratio of the cache size below which the next block
+	// is read in the background.
+	lowWatermark float64
+	// rules is keyed by the qualified name of the sequence, <keyspace>.<sequence>.
+	rules map[string]*sequenceRule
+
+	mu        sync.Mutex
+	sequences map[string]*cachedSequence
+}
+
+func newSequenceCache(executor *Executor, cacheSize int64, lowWatermark float64, rules map[string]*sequenceRule) *sequenceCache {
+	return &sequenceCache{
+		executor:     executor,
+		cacheSize:    cacheSize,
+		lowWatermark: lowWatermark,
+		rules:        rules,
+		sequences:    make(map[string]*cachedSequence),
+	}
+}
+
+// parseSequenceRules parses rules of the form
+// <keyspace>.<sequence>=<cache_size>[:<keyspace>.<table>@<start>-<end>]...,...
+func parseSequenceRules(spec string) (map[string]*sequenceRule, error) {
+	const format = "<keyspace>.<sequence>=<cache_size>[:<keyspace>.<table>@<start>-<end>]..."
+	rules := make(map[string]*sequenceRule)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid sequence rule %q, expected %s", entry, format)
+		}
+		if _, _, ok := splitQualifiedName(parts[0]); !ok {
+			return nil, fmt.Errorf("invalid sequence rule %q, expected %s", entry, format)
+		}
+		parts = append([]string{parts[0]}, strings.Split(parts[1], ":")...)
+		cacheSize, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || cacheSize < 0 {
+			return nil, fmt.Errorf("invalid cache size in sequence rule %q, expected %s", entry, format)
+		}
+		rule := &sequenceRule{cacheSize: cacheSize}
+		for _, spec := range parts[2:] {
+			backend, err := parseSequenceBackend(spec)
+			if err != nil {
+				return nil, fmt.Errorf("invalid backend in sequence rule %q: %v", entry, err)
+			}
+			for _, other := range rule.backends {
+				if backend.start < other.end && other.start < backend.end {
+					return nil, fmt.Errorf("the ranges of the backends %v and %v of sequence rule %q overlap", other, backend, entry)
+				}
+			}
+			rule.backends = append(rule.backends, backend)
+		}
+		rules[parts[0]] = rule
+	}
+	return rules, nil
+}
+
+// parseSequenceBackend parses <keyspace>.<table>@<start>-<end>.
+func parseSequenceBackend(spec string) (*sequenceBackend, error) {
+	table, bounds, ok := strings.Cut(spec, "@")
+	if !ok {
+		return nil, fmt.Errorf("%q has no range, expected <keyspace>.<table>@<start>-<end>", spec)
+	}
+	keyspace, name, ok := splitQualifiedName(table)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a qualified table name", table)
+	}
+	startSpec, endSpec, ok := strings.Cut(bounds, "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid range %q, expected <start>-<end>", bounds)
+	}
+	start, err := strconv.ParseInt(startSpec, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %v", bounds, err)
+	}
+	end, err := strconv.ParseInt(endSpec, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %v", bounds, err)
+	}
+	if start >= end {
+		return nil, fmt.Errorf("invalid range %q, the start must be below the end", bounds)
+	}
+	return &sequenceBackend{
+		keyspace: keyspace,
+		table:    name,
+		query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(sqlparser.NewTableIdent(name))),
+		start:    start,
+		end:      end,
+	}, nil
+}
+
+func splitQualifiedName(name string) (string, string, bool) {
+	parts := strings.Split(name, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// sequence returns the cache of the sequence of the Generate.
+func (c *sequenceCache) sequence(gen *engine.Generate) *cachedSequence {
+	name := gen.Keyspace.Name + "." + gen.TableName
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cs, ok := c.sequences[name]; ok {
+		return cs
+	}
+	cs := &cachedSequence{name: name, cacheSize: c.cacheSize, lastBackend: -1}
+	if rule, ok := c.rules[name]; ok {
+		cs.cacheSize = rule.cacheSize
+		// The backends are copied, because they keep track of their exhaustion.
+		for _, b := range rule.backends {
+			backend := *b
+			cs.backends = append(cs.backends, &backend)
+		}
+	}
+	if len(cs.backends) == 0 {
+		cs.backends = []*sequenceBackend{{
+			keyspace: gen.Keyspace.Name,
+			table:    gen.TableName,
+			query:    gen.Query,
+			pinned:   gen.Pinned != nil,
+		}}
+	}
+	c.sequences[name] = cs
+	return cs
+}
+
+// next reserves count consecutive values of the sequence of the Generate,
+// and returns the first one. rs is the shard of its table.
+func (c *sequenceCache) next(ctx context.Context, gen *engine.Generate, rs *srvtopo.ResolvedShard, count int64) (int64, error) {
+	cs := c.sequence(gen)
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.shard = rs
+
+	if cs.end-cs.next < count && cs.prefetched != nil && cs.prefetched.end-cs.prefetched.next >= count {
+		sequenceDiscardedValues.Add(cs.name, cs.end-cs.next)
+		cs.next, cs.end = cs.prefetched.next, cs.prefetched.end
+		cs.prefetched = nil
+	}
+	if cs.end-cs.next >= count {
+		sequenceRequests.Add([]string{cs.name, "hit"}, 1)
+	} else {
+		sequenceRequests.Add([]string{cs.name, "miss"}, 1)
+		sequenceDiscardedValues.Add(cs.name, cs.end-cs.next)
+		size := cs.cacheSize
+		if size < count {
+			size = count
+		}
+		block, err := c.fetch(ctx, cs, size, count)
+		if err != nil {
+			return 0, err
+		}
+		cs.next, cs.end = block.next, block.end
+	}
+	first := cs.next
+	cs.next += count
+
+	if cs.cacheSize > 0 && cs.prefetched == nil && !cs.prefetching && float64(cs.end-cs.next) < c.lowWatermark*float64(cs.cacheSize) {
+		cs.prefetching = true
+		go c.prefetch(callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx)), cs)
+	}
+	return first, nil
+}
+
+// prefetch reads the next block of the sequence in the background. It does
+// not hold the lock of the sequence while it reads.
+func (c *sequenceCache) prefetch(ctx context.Context, cs *cachedSequence) {
+	ctx, cancel := context.WithTimeout(ctx, sequencePrefetchTimeout)
+	defer cancel()
+
+	cs.mu.Lock()
+	backend, shard := cs.pickBackend()
+	cs.mu.Unlock()
+
+	var block sequenceBlock
+	var exhausted bool
+	var err error
+	if backend == nil {
+		err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "all the backends of sequence %s are exhausted", cs.name)
+	} else {
+		sequencePrefetches.Add(cs.name, 1)
+		block, exhausted, err = c.read(ctx, cs.name, backend, shard, cs.cacheSize)
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.prefetching = false
+	if exhausted {
+		backend.exhausted = true
+	}
+	if err != nil {
+		// The next request that runs out of values reads them itself.
+		log.Warningf("cannot prefetch the values of sequence %s: %v", cs.name, err)
+		return
+	}
+	cs.prefetched = &block
+}
+
+// pickBackend returns the next backend of the sequence that is not
+// exhausted, in turn, or nil if they all are. It must be called while
+// holding cs.mu.
+func (cs *cachedSequence) pickBackend() (*sequenceBackend, *srvtopo.ResolvedShard) {
+	for range cs.backends {
+		cs.lastBackend = (cs.lastBackend + 1) % len(cs.backends)
+		if b := cs.backends[cs.lastBackend]; !b.exhausted {
+			return b, cs.shard
+		}
+	}
+	return nil, nil
+}
+
+// fetch reads a block of size values of the sequence, with at least need
+// values left within the range of its backend. It must be called while
+// holding cs.mu.
+func (c *sequenceCache) fetch(ctx context.Context, cs *cachedSequence, size, need int64) (sequenceBlock, error) {
+	for {
+		backend, shard := cs.pickBackend()
+		if backend == nil {
+			return sequenceBlock{}, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "all the backends of sequence %s are exhausted", cs.name)
+		}
+		block, exhausted, err := c.read(ctx, cs.name, backend, shard, size)
+		if err != nil {
+			return sequenceBlock{}, err
+		}
+		if exhausted {
+			backend.exhausted = true
+		}
+		if block.end-block.next >= need {
+			return block, nil
+		}
+		// The backend went beyond its range: read from the next one.
+		sequenceDiscardedValues.Add(cs.name, block.end-block.next)
+	}
+}
+
+// read reads a block of size values from the backend, and clips it to the
+// range of the backend. It returns whether the backend reached the end of
+// its range, for the caller to mark it as exhausted under cs.mu.
+func (c *sequenceCache) read(ctx context.Context, name string, backend *sequenceBackend, shard *srvtopo.ResolvedShard, size int64) (block sequenceBlock, exhausted bool, err error) {
+	rs := shard
+	if !backend.pinned {
+		// A sequence table must be the only source of its values: refuse
+		// to read it from one of several shards, which would hand out the
+		// same values as the others, for instance once its keyspace was
+		// sharded without pinning it.
+		rss, _, err := c.executor.resolver.resolver.ResolveDestinations(ctx, backend.keyspace, topodatapb.TabletType_PRIMARY, nil, []key.Destination{key.DestinationAllShards{}})
+		if err != nil {
+			return sequenceBlock{}, false, err
+		}
+		if len(rss) != 1 {
+			return sequenceBlock{}, false, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "sequence table %v must be in an unsharded keyspace or pinned, but keyspace %s has %d shards", backend, backend.keyspace, len(rss))
+		}
+		rs = rss[0]
+	}
+
+	sequenceFetches.Add([]string{name, backend.String()}, 1)
+	queries := []*querypb.BoundQuery{{
+		Sql:           backend.query,
+		BindVariables: map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(size)},
+	}}
+	qr, errs := c.executor.ExecuteMultiShard(ctx, []*srvtopo.ResolvedShard{rs}, queries, NewAutocommitSession(&vtgatepb.Session{}), false /* autocommit */, false /* ignoreMaxMemoryRows */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return sequenceBlock{}, false, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) == 0 {
+		return sequenceBlock{}, false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result of the sequence table %v: %d rows", backend, len(qr.Rows))
+	}
+	first, err := evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return sequenceBlock{}, false, err
+	}
+
+	block = sequenceBlock{next: first, end: first + size}
+	if backend.end == 0 {
+		return block, false, nil
+	}
+	if first < backend.start {
+		return sequenceBlock{}, false, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "sequence table %v returned %d, below the start of its range %d-%d: its next_id must be initialized to the start of its range", backend, first, backend.start, backend.end)
+	}
+	if block.end >= backend.end {
+		exhausted = true
+		if block.next > backend.end {
+			block.next = backend.end
+		}
+		block.end = backend.end
+	}
+	return block, exhausted, nil
+}
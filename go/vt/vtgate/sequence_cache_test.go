@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestParseSequenceRules(t *testing.T) {
+	rules, err := parseSequenceRules("ks.seq=100, ks.big_seq=1000:seqks1.big_seq@1-1000000:seqks2.big_seq@1000000-2000000")
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.EqualValues(t, 100, rules["ks.seq"].cacheSize)
+	assert.Empty(t, rules["ks.seq"].backends)
+	rule := rules["ks.big_seq"]
+	assert.EqualValues(t, 1000, rule.cacheSize)
+	require.Len(t, rule.backends, 2)
+	assert.Equal(t, &sequenceBackend{
+		keyspace: "seqks1",
+		table:    "big_seq",
+		query:    "select next :n values from big_seq",
+		start:    1,
+		end:      1000000,
+	}, rule.backends[0])
+	assert.Equal(t, "seqks2.big_seq", rule.backends[1].String())
+
+	rules, err = parseSequenceRules("")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	for _, spec := range []string{
+		"seq=100",
+		"ks.seq",
+		"ks.seq=-1",
+		"ks.seq=a",
+		"ks.seq=100:seqks.seq",
+		"ks.seq=100:seq@1-10",
+		"ks.seq=100:seqks.seq@10",
+		"ks.seq=100:seqks.seq@10-1",
+		"ks.seq=100:seqks1.seq@1-10:seqks2.seq@5-20",
+	} {
+		_, err := parseSequenceRules(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func sequenceResult(first int64) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("nextval", "int64"), sqltypes.NewInt64(first).ToString())
+}
+
+// insertMain1 inserts rows into main1, whose ids come from user_seq, and
+// returns the queries that the insert sent to the sequence tables and the
+// ids it generated.
+func insertMain1(t *testing.T, executor *Executor, sbc *sandboxconn.SandboxConn, results []*sqltypes.Result, sql string) ([]*querypb.BoundQuery, []int64) {
+	t.Helper()
+	sbc.Queries = nil
+	sbc.SetResults(append(results, &sqltypes.Result{RowsAffected: 1}))
+	_, err := executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	var queries []*querypb.BoundQuery
+	for _, query := range sbc.Queries[:len(sbc.Queries)-1] {
+		if strings.HasPrefix(query.Sql, "select next") {
+			queries = append(queries, query)
+		}
+	}
+	insert := sbc.Queries[len(sbc.Queries)-1]
+	var ids []int64
+	for i := 0; ; i++ {
+		bv, ok := insert.BindVariables["__seq"+sqltypes.NewInt64(int64(i)).ToString()]
+		if !ok {
+			break
+		}
+		id, err := sqltypes.BindVariableToValue(bv)
+		require.NoError(t, err)
+		n, err := id.ToInt64()
+		require.NoError(t, err)
+		ids = append(ids, n)
+	}
+	return queries, ids
+}
+
+func nextValuesQuery(table string, n int64) *querypb.BoundQuery {
+	return &querypb.BoundQuery{
+		Sql:           "select next :n values from " + table,
+		BindVariables: map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(n)},
+	}
+}
+
+func TestSequenceCache(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.sequences = newSequenceCache(executor, 10, 0, nil)
+	hits := sequenceRequests.Counts()["TestUnsharded_user_seq.hit"]
+	discarded := sequenceDiscardedValues.Counts()["TestUnsharded.user_seq"]
+
+	// The first insert reserves a block of 10 values.
+	queries, ids := insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(100)},
+		"insert into main1(id, name) values (null, 'a'), (null, 'b'), (null, 'c')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("user_seq", 10)}, queries)
+	assert.Equal(t, []int64{100, 101, 102}, ids)
+
+	// The next one is served from the cache.
+	queries, ids = insertMain1(t, executor, sbclookup, nil,
+		"insert into main1(id, name) values (null, 'd'), (null, 'e')")
+	assert.Empty(t, queries)
+	assert.Equal(t, []int64{103, 104}, ids)
+	assert.EqualValues(t, 1, sequenceRequests.Counts()["TestUnsharded_user_seq.hit"]-hits)
+
+	// The 5 values left are not enough for 6 consecutive ones: they are
+	// skipped, and a new block is reserved.
+	queries, ids = insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(200)},
+		"insert into main1(id, name) values (null, 'f'), (null, 'g'), (null, 'h'), (null, 'i'), (null, 'j'), (null, 'k')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("user_seq", 10)}, queries)
+	assert.Equal(t, []int64{200, 201, 202, 203, 204, 205}, ids)
+	assert.EqualValues(t, 5, sequenceDiscardedValues.Counts()["TestUnsharded.user_seq"]-discarded)
+
+	// A request bigger than the cache size reads as many values as it needs.
+	queries, ids = insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(300)},
+		"insert into main1(id, name) values (null, 'a'), (null, 'b'), (null, 'c'), (null, 'd'), (null, 'e'), (null, 'f'), (null, 'g'), (null, 'h'), (null, 'i'), (null, 'j'), (null, 'k')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("user_seq", 11)}, queries)
+	assert.Len(t, ids, 11)
+	assert.EqualValues(t, 300, ids[0])
+}
+
+func TestSequenceCachePrefetch(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.sequences = newSequenceCache(executor, 4, 0.5, nil)
+	prefetches := sequencePrefetches.Counts()["TestUnsharded.user_seq"]
+
+	// Only one value is left after the first insert, which is below the low
+	// watermark: the next block is read in the background. The sandbox
+	// returns 1 to it.
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{sequenceResult(100)})
+	_, err := executorExec(executor, "insert into main1(id, name) values (null, 'a'), (null, 'b'), (null, 'c')", nil)
+	require.NoError(t, err)
+	cs := executor.sequences.sequences["TestUnsharded.user_seq"]
+	require.Eventually(t, func() bool {
+		cs.mu.Lock()
+		defer cs.mu.Unlock()
+		return cs.prefetched != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, nextValuesQuery("user_seq", 4), sbclookup.Queries[len(sbclookup.Queries)-1])
+	assert.EqualValues(t, 1, sequencePrefetches.Counts()["TestUnsharded.user_seq"]-prefetches)
+
+	// The next insert needs more values than are left, and gets them from
+	// the prefetched block.
+	queries, ids := insertMain1(t, executor, sbclookup, nil,
+		"insert into main1(id, name) values (null, 'd'), (null, 'e')")
+	assert.Empty(t, queries)
+	assert.Equal(t, []int64{1, 2}, ids)
+}
+
+func TestSequenceBackends(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	rules, err := parseSequenceRules("TestUnsharded.user_seq=10:TestUnsharded.seq_a@1-15:TestUnsharded.seq_b@100-200")
+	require.NoError(t, err)
+	executor.sequences = newSequenceCache(executor, 0, 0, rules)
+
+	// The blocks are read from the backends in turn.
+	queries, ids := insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(1)},
+		"insert into main1(id, name) values (null, 'a')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("seq_a", 10)}, queries)
+	assert.Equal(t, []int64{1}, ids)
+
+	queries, ids = insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(100)},
+		"insert into main1(id, name) values (null, 'a'), (null, 'b'), (null, 'c'), (null, 'd'), (null, 'e'), (null, 'f'), (null, 'g'), (null, 'h'), (null, 'i'), (null, 'j')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("seq_b", 10)}, queries)
+	assert.Len(t, ids, 10)
+	assert.EqualValues(t, 100, ids[0])
+
+	// The block of seq_a is clipped to the end of its range, and seq_a is
+	// not read anymore.
+	queries, ids = insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(11)},
+		"insert into main1(id, name) values (null, 'a')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("seq_a", 10)}, queries)
+	assert.Equal(t, []int64{11}, ids)
+
+	queries, ids = insertMain1(t, executor, sbclookup, []*sqltypes.Result{sequenceResult(110)},
+		"insert into main1(id, name) values (null, 'a'), (null, 'b'), (null, 'c'), (null, 'd'), (null, 'e')")
+	assert.Equal(t, []*querypb.BoundQuery{nextValuesQuery("seq_b", 10)}, queries)
+	assert.Equal(t, []int64{110, 111, 112, 113, 114}, ids)
+
+	// A backend that returns values below its range is misconfigured.
+	executor.sequences = newSequenceCache(executor, 0, 0, rules)
+	sbclookup.SetResults([]*sqltypes.Result{sequenceResult(0)})
+	_, err = executorExec(executor, "insert into main1(id, name) values (null, 'a')", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sequence table TestUnsharded.seq_a returned 0, below the start of its range 1-15")
+}
+
+func TestSequenceShardingProtection(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	rules, err := parseSequenceRules("TestUnsharded.user_seq=10:TestExecutor.user_seq@1-100")
+	require.NoError(t, err)
+	executor.sequences = newSequenceCache(executor, 0, 0, rules)
+
+	sbclookup.Queries = nil
+	_, err = executorExec(executor, "insert into main1(id, name) values (null, 'a')", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sequence table TestExecutor.user_seq must be in an unsharded keyspace or pinned, but keyspace TestExecutor has 8 shards")
+	assert.Empty(t, sbclookup.Queries)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	sessionMemoryWarningBytes = flag.Int64("session_memory_warning_bytes", 0, "Size in bytes of the state of a session (shard sessions, savepoints, warnings, variables) above which a warning is added to its statements. 0 means no warning")
+	maxSessionMemoryBytes     = flag.Int64("max_session_memory_bytes", 0, "Maximum size in bytes of the state of a session. The statement that grows the session beyond it fails, and rolls back the transaction of the session. 0 means no limit")
+
+	sessionMemoryExceeded = stats.NewCountersWithSingleLabel(
+		"VtgateSessionMemoryExceeded",
+		"Number of statements of the sessions whose state exceeded a memory threshold",
+		"Threshold")
+)
+
+// sessionMemoryTrackedSessions is the number of sessions whose size
+// /debug/session_memory keeps track of. The least recently used are dropped.
+const sessionMemoryTrackedSessions = 10000
+
+// sessionMemoryLimit accounts for the memory that the state of the sessions
+// holds, warns about the sessions that grow too large, and stops them from
+// growing further. A nil sessionMemoryLimit does not account for anything.
+type sessionMemoryLimit struct {
+	warningBytes int64
+	maxBytes     int64
+	// sessions are the last sizes of the sessions, keyed by their UUID.
+	sessions *cache.LRUCache
+}
+
+// sessionMemoryUsage is the size of the state of a session, after its last
+// statement.
+type sessionMemoryUsage struct {
+	SessionUUID          string
+	Bytes                int64
+	ShardSessions        int
+	Savepoints           int
+	Warnings             int
+	UserDefinedVariables int
+	SystemVariables      int
+	InTransaction        bool
+	Updated              time.Time
+}
+
+// newSessionMemoryLimit returns the limit of the sessions, or nil if both
+// thresholds are 0.
+func newSessionMemoryLimit(warningBytes, maxBytes int64) *sessionMemoryLimit {
+	if warningBytes <= 0 && maxBytes <= 0 {
+		return nil
+	}
+	return &sessionMemoryLimit{
+		warningBytes: warningBytes,
+		maxBytes:     maxBytes,
+		sessions: cache.NewLRUCache(sessionMemoryTrackedSessions, func(any) int64 {
+			return 1
+		}),
+	}
+}
+
+// sessionMemorySnapshot is the size of the state of a session before a
+// statement, which tells whether the statement grew it, and the part of the
+// state that is restored if the statement grew it beyond the maximum size.
+type sessionMemorySnapshot struct {
+	bytes                int64
+	userDefinedVariables map[string]*querypb.BindVariable
+}
+
+// measureSession returns the size of the state of the session, which is the
+// size of its proto.
+func measureSession(session *SafeSession) *sessionMemoryUsage {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return &sessionMemoryUsage{
+		SessionUUID:          session.SessionUUID,
+		Bytes:                int64(session.Session.SizeVT()),
+		ShardSessions:        len(session.ShardSessions) + len(session.PreSessions) + len(session.PostSessions) + len(session.LockSessions),
+		Savepoints:           len(session.Savepoints),
+		Warnings:             len(session.Warnings),
+		UserDefinedVariables: len(session.UserDefinedVariables),
+		SystemVariables:      len(session.SystemVariables),
+		InTransaction:        session.Session.InTransaction,
+		Updated:              time.Now(),
+	}
+}
+
+// check returns a RESOURCE_EXHAUSTED error if the session already exceeds
+// the maximum size, unless the statement can shrink it. Otherwise, it returns
+// the snapshot of the session that checkGrowth compares it with after the
+// statement, or nil if there is no maximum size.
+func (l *sessionMemoryLimit) check(session *SafeSession, stmtType sqlparser.StatementType) (*sessionMemorySnapshot, error) {
+	if l == nil || l.maxBytes <= 0 {
+		return nil, nil
+	}
+	usage := measureSession(session)
+	switch stmtType {
+	case sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtRelease, sqlparser.StmtSRollback, sqlparser.StmtSet:
+	default:
+		if usage.Bytes > l.maxBytes {
+			sessionMemoryExceeded.Add("Max", 1)
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "session state of %d bytes exceeds the limit of %d bytes (max_session_memory_bytes): commit or roll back the transaction, or open a new session", usage.Bytes, l.maxBytes)
+		}
+	}
+	snapshot := &sessionMemorySnapshot{bytes: usage.Bytes}
+	if stmtType == sqlparser.StmtSet {
+		session.mu.Lock()
+		snapshot.userDefinedVariables = make(map[string]*querypb.BindVariable, len(session.UserDefinedVariables))
+		for name, value := range session.UserDefinedVariables {
+			snapshot.userDefinedVariables[name] = value
+		}
+		session.mu.Unlock()
+	}
+	return snapshot, nil
+}
+
+// checkGrowth returns a RESOURCE_EXHAUSTED error if the statement grew the
+// session beyond the maximum size since the snapshot, and restores the user
+// defined variables that it set. The caller rolls back the transaction of
+// the session, since the effects of the statement on the shards can't be
+// undone alone.
+func (l *sessionMemoryLimit) checkGrowth(session *SafeSession, snapshot *sessionMemorySnapshot) error {
+	if l == nil || snapshot == nil {
+		return nil
+	}
+	usage := measureSession(session)
+	if usage.Bytes <= l.maxBytes || usage.Bytes <= snapshot.bytes {
+		return nil
+	}
+	sessionMemoryExceeded.Add("Max", 1)
+	if snapshot.userDefinedVariables != nil {
+		session.mu.Lock()
+		session.UserDefinedVariables = snapshot.userDefinedVariables
+		session.mu.Unlock()
+	}
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "the statement grows the session state to %d bytes, beyond the limit of %d bytes (max_session_memory_bytes)", usage.Bytes, l.maxBytes)
+}
+
+// record accounts for the size of the session after a statement, and adds a
+// warning to the session if it exceeds the warning threshold.
+func (l *sessionMemoryLimit) record(session *SafeSession) {
+	if l == nil {
+		return
+	}
+	usage := measureSession(session)
+	if usage.SessionUUID != "" {
+		l.sessions.Set(usage.SessionUUID, usage)
+	}
+	if l.warningBytes > 0 && usage.Bytes > l.warningBytes {
+		sessionMemoryExceeded.Add("Warning", 1)
+		session.RecordWarning(&querypb.QueryWarning{
+			Message: fmt.Sprintf("session state of %d bytes exceeds the warning threshold of %d bytes (session_memory_warning_bytes)", usage.Bytes, l.warningBytes),
+		})
+	}
+}
+
+// forget stops accounting for a session, once it is closed.
+func (l *sessionMemoryLimit) forget(session *SafeSession) {
+	if l == nil || session.SessionUUID == "" {
+		return
+	}
+	l.sessions.Delete(session.SessionUUID)
+}
+
+// topSessions returns the largest sessions, up to limit of them, or all of
+// them if limit is 0.
+func (l *sessionMemoryLimit) topSessions(limit int) []sessionMemoryUsage {
+	if l == nil {
+		return nil
+	}
+	var top []sessionMemoryUsage
+	l.sessions.ForEach(func(value any) bool {
+		top = append(top, *value.(*sessionMemoryUsage))
+		return true
+	})
+	sort.SliceStable(top, func(i, j int) bool {
+		if top[i].Bytes != top[j].Bytes {
+			return top[i].Bytes > top[j].Bytes
+		}
+		return top[i].SessionUUID < top[j].SessionUUID
+	})
+	if limit > 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestNewSessionMemoryLimit(t *testing.T) {
+	assert.Nil(t, newSessionMemoryLimit(0, 0))
+	assert.NotNil(t, newSessionMemoryLimit(100, 0))
+	assert.NotNil(t, newSessionMemoryLimit(0, 100))
+
+	// a nil limit does not account for anything
+	var l *sessionMemoryLimit
+	snapshot, err := l.check(NewSafeSession(&vtgatepb.Session{}), sqlparser.StmtSelect)
+	require.NoError(t, err)
+	require.NoError(t, l.checkGrowth(NewSafeSession(&vtgatepb.Session{}), snapshot))
+	assert.Nil(t, l.topSessions(0))
+}
+
+func TestSessionMemoryLimit(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.sessionMemory = newSessionMemoryLimit(150, 350)
+	ctx := context.Background()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", SessionUUID: "big"})
+	other := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", SessionUUID: "small"})
+
+	_, err := executor.Execute(ctx, "TestSessionMemoryLimit", other, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+
+	// A session over the warning threshold gets a warning.
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "set @a = '"+strings.Repeat("a", 200)+"'", nil)
+	require.NoError(t, err)
+	require.Len(t, session.Warnings, 1)
+	assert.Contains(t, session.Warnings[0].Message, "exceeds the warning threshold of 150 bytes")
+
+	// The statement that grows a session beyond the limit fails, and rolls
+	// back the transaction of the session.
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "set @b = '"+strings.Repeat("b", 200)+"'", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "beyond the limit of 350 bytes (max_session_memory_bytes): the transaction was rolled back")
+	assert.NotContains(t, session.UserDefinedVariables, "b")
+	assert.False(t, session.InTransaction())
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+
+	// A session that is already over the limit can't grow further, but can shrink.
+	executor.sessionMemory.maxBytes = 200
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "select id from user where id = 1", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "exceeds the limit of 200 bytes (max_session_memory_bytes)")
+
+	top := executor.sessionMemory.topSessions(0)
+	require.Len(t, top, 2)
+	assert.Equal(t, "big", top[0].SessionUUID)
+	assert.Equal(t, 1, top[0].UserDefinedVariables)
+	assert.Greater(t, top[0].Bytes, int64(200))
+	assert.Equal(t, "small", top[1].SessionUUID)
+	assert.Len(t, executor.sessionMemory.topSessions(1), 1)
+
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "set @a = null", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestSessionMemoryLimit", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+
+	// The closed sessions are no longer accounted for.
+	require.NoError(t, executor.CloseSession(ctx, session))
+	top = executor.sessionMemory.topSessions(0)
+	require.Len(t, top, 1)
+	assert.Equal(t, "small", top[0].SessionUUID)
+}
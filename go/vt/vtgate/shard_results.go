@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var shardResultDiscrepancies = stats.NewCountersWithSingleLabel(
+	"VtgateShardResultDiscrepancies",
+	"Number of statements whose shards returned results that can't be aggregated as a single MySQL would return them",
+	"Discrepancy")
+
+// shardResults aggregates what the results of the shards of a DML report
+// besides their affected rows and insert id, and notes the discrepancies
+// between the shards that the aggregated result can't express. The affected
+// rows of the shards add up, including the 2 of the rows that an ON DUPLICATE
+// KEY UPDATE updates and the found rows of CLIENT_FOUND_ROWS, and the insert
+// id is the first one of the shards.
+type shardResults struct {
+	// dmls is the number of results without fields.
+	dmls int
+	// info is the sum of the infos of the shards, and infos the number of
+	// shards that returned one.
+	info  string
+	infos int
+	// infoMismatch is the first info that could not be added up.
+	infoMismatch string
+	// insertIDs is the number of shards that generated an insert id.
+	insertIDs int
+}
+
+// add accounts for the result of a shard.
+func (r *shardResults) add(qr *sqltypes.Result) {
+	if len(qr.Fields) != 0 {
+		return
+	}
+	r.dmls++
+	if qr.Info != "" {
+		r.infos++
+		if r.infoMismatch == "" {
+			info, ok := sqltypes.MergeInfo(r.info, qr.Info)
+			if ok {
+				r.info = info
+			} else {
+				r.infoMismatch = qr.Info
+			}
+		}
+	}
+	if qr.InsertID != 0 {
+		r.insertIDs++
+	}
+}
+
+// finish sets the info of the aggregated result, and records the
+// discrepancies between the shards as warnings of the session.
+func (r *shardResults) finish(qr *sqltypes.Result, session *SafeSession) {
+	if r.dmls == 0 {
+		return
+	}
+	switch {
+	case r.infoMismatch != "":
+		shardResultDiscrepancies.Add("Info", 1)
+		session.RecordWarning(&querypb.QueryWarning{
+			Message: fmt.Sprintf("the shards returned infos that can't be added up (%q and %q): the statement has no info", r.info, r.infoMismatch),
+		})
+	case r.infos != 0 && r.infos != r.dmls:
+		// A shard that got a single row of a multi-row INSERT returns no info.
+		shardResultDiscrepancies.Add("Info", 1)
+		session.RecordWarning(&querypb.QueryWarning{
+			Message: fmt.Sprintf("only %d of the %d shards returned an info: the statement has no info", r.infos, r.dmls),
+		})
+	default:
+		qr.Info = r.info
+	}
+	if r.insertIDs > 1 {
+		shardResultDiscrepancies.Add("InsertID", 1)
+		session.RecordWarning(&querypb.QueryWarning{
+			Message: fmt.Sprintf("%d shards generated insert ids with their own auto-increments: the insert id of the statement is the smallest one, %d", r.insertIDs, qr.InsertID),
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestShardResults(t *testing.T) {
+	testcases := []struct {
+		name     string
+		results  []*sqltypes.Result
+		info     string
+		warnings []string
+	}{{
+		name: "infos add up",
+		results: []*sqltypes.Result{
+			{RowsAffected: 1, Info: "Rows matched: 2  Changed: 1  Warnings: 0"},
+			{RowsAffected: 0, Info: "Rows matched: 0  Changed: 0  Warnings: 0"},
+			{RowsAffected: 3, Info: "Rows matched: 3  Changed: 3  Warnings: 1"},
+		},
+		info: "Rows matched: 5  Changed: 4  Warnings: 1",
+	}, {
+		name: "selects are ignored",
+		results: []*sqltypes.Result{
+			{Fields: []*querypb.Field{{Name: "id"}}},
+			{RowsAffected: 2, Info: "Records: 2  Duplicates: 0  Warnings: 0"},
+		},
+		info: "Records: 2  Duplicates: 0  Warnings: 0",
+	}, {
+		name: "infos of different kinds",
+		results: []*sqltypes.Result{
+			{RowsAffected: 1, Info: "Records: 2  Duplicates: 0  Warnings: 0"},
+			{RowsAffected: 1, Info: "Rows matched: 1  Changed: 1  Warnings: 0"},
+		},
+		warnings: []string{`the shards returned infos that can't be added up ("Records: 2  Duplicates: 0  Warnings: 0" and "Rows matched: 1  Changed: 1  Warnings: 0"): the statement has no info`},
+	}, {
+		name: "missing info",
+		results: []*sqltypes.Result{
+			{RowsAffected: 2, Info: "Records: 2  Duplicates: 0  Warnings: 0"},
+			{RowsAffected: 1},
+		},
+		warnings: []string{"only 1 of the 2 shards returned an info: the statement has no info"},
+	}, {
+		name: "insert ids of several shards",
+		results: []*sqltypes.Result{
+			{RowsAffected: 1, InsertID: 7},
+			{RowsAffected: 1},
+			{RowsAffected: 1, InsertID: 5},
+		},
+		warnings: []string{"2 shards generated insert ids with their own auto-increments: the insert id of the statement is the smallest one, 5"},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			session := NewSafeSession(&vtgatepb.Session{})
+			qr := &sqltypes.Result{}
+			var results shardResults
+			for _, result := range tc.results {
+				qr.AppendResult(result)
+				results.add(result)
+			}
+			results.finish(qr, session)
+			assert.Equal(t, tc.info, qr.Info)
+			var warnings []string
+			for _, warning := range session.Warnings {
+				warnings = append(warnings, warning.Message)
+			}
+			assert.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
+
+func TestMultiShardDMLInfo(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 1, Info: "Rows matched: 2  Changed: 1  Warnings: 0"}})
+	sbc2.SetResults([]*sqltypes.Result{{RowsAffected: 2, Info: "Rows matched: 2  Changed: 2  Warnings: 0"}})
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	qr, err := executorExecSession(executor, "update user set a = 2 where id in (1, 3)", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, qr.RowsAffected)
+	assert.Equal(t, "Rows matched: 4  Changed: 3  Warnings: 0", qr.Info)
+	assert.Empty(t, session.Warnings)
+}
+
+func TestClientFoundRowsWithShardConnections(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	ctx := context.Background()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+
+	_, err := executor.Execute(ctx, "TestClientFoundRows", session, "begin", nil)
+	require.NoError(t, err)
+	// The transaction has no connection to the shards yet.
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "set client_found_rows = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "update user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "set client_found_rows = 0", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "client_found_rows can't change while the session has connections to the shards")
+	// Setting the same value is harmless.
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "set client_found_rows = 1", nil)
+	require.NoError(t, err)
+
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "commit", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestClientFoundRows", session, "set client_found_rows = 0", nil)
+	require.NoError(t, err)
+	assert.False(t, session.GetOptions().GetClientFoundRows())
+}
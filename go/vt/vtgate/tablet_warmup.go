@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+// tabletWarmupQuery is the query that validates the connection to a tablet.
+const tabletWarmupQuery = "select 1"
+
+var (
+	tabletWarmupKeyspaces = flag.String("tablet_warmup_keyspaces", "", "comma-separated list of keyspaces whose serving tablets are queried as soon as they are discovered, and then every -tablet_warmup_interval, so that the connections to them are established and validated before the first query")
+	tabletWarmupInterval  = flag.Duration("tablet_warmup_interval", 30*time.Second, "how often the connections to the tablets of -tablet_warmup_keyspaces are validated")
+	tabletWarmupTimeout   = flag.Duration("tablet_warmup_timeout", 5*time.Second, "the timeout of the queries that validate the connections to the tablets of -tablet_warmup_keyspaces")
+
+	tabletWarmups = stats.NewCountersWithMultiLabels(
+		"VtgateTabletWarmups",
+		"Number of queries that validated the connections to the tablets of the warmed up keyspaces, by result: ok or error",
+		[]string{"Keyspace", "Result"})
+)
+
+// tabletWarmer queries the serving tablets of some keyspaces as soon as the
+// healthcheck discovers them, and then periodically. The first query that a
+// vtgate sends to a tablet otherwise pays for establishing the connection
+// on both sides, which adds up on the first scatter query after a deploy.
+// The periodic queries also keep the connections from going idle.
+//
+// The subscription to the healthcheck only makes the discovery faster: it
+// drops the updates when its buffer is full, so the tablets are also
+// reconciled with the cache of the healthcheck on every tick.
+type tabletWarmer struct {
+	hc        discovery.HealthCheck
+	keyspaces map[string]bool
+	interval  time.Duration
+	timeout   time.Duration
+
+	mu sync.Mutex
+	// tablets are the serving tablets of the keyspaces, keyed by alias.
+	tablets map[string]*discovery.TabletHealth
+	// warming is set while the periodic queries run, so that the ticks
+	// don't pile them up when the tablets are slow to answer.
+	warming bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newTabletWarmer returns a warmer for the tablets of a comma-separated
+// list of keyspaces, or nil if the list is empty.
+func newTabletWarmer(hc discovery.HealthCheck, keyspaces string, interval, timeout time.Duration) *tabletWarmer {
+	w := &tabletWarmer{
+		hc:        hc,
+		keyspaces: make(map[string]bool),
+		interval:  interval,
+		timeout:   timeout,
+		tablets:   make(map[string]*discovery.TabletHealth),
+	}
+	for _, keyspace := range strings.Split(keyspaces, ",") {
+		if keyspace = strings.TrimSpace(keyspace); keyspace != "" {
+			w.keyspaces[keyspace] = true
+		}
+	}
+	if len(w.keyspaces) == 0 {
+		return nil
+	}
+	return w
+}
+
+// start watches the healthcheck until stop is called.
+func (w *tabletWarmer) start(ctx context.Context) {
+	if w == nil {
+		return
+	}
+	ctx, w.cancel = context.WithCancel(ctx)
+	updates := w.hc.Subscribe()
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer w.hc.Unsubscribe(updates)
+		w.reconcile(ctx)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case th := <-updates:
+				if th != nil {
+					w.update(ctx, th)
+				}
+			case <-ticker.C:
+				w.reconcile(ctx)
+				w.warmAll(ctx)
+			}
+		}
+	}()
+}
+
+// update keeps track of the serving tablets of the keyspaces,
+// and warms up the ones that were not serving before.
+func (w *tabletWarmer) update(ctx context.Context, th *discovery.TabletHealth) {
+	if th.Target == nil || !w.keyspaces[th.Target.Keyspace] {
+		return
+	}
+	alias := topoproto.TabletAliasString(th.Tablet.Alias)
+	w.mu.Lock()
+	previous, known := w.tablets[alias]
+	if !th.Serving || th.Conn == nil {
+		delete(w.tablets, alias)
+		w.mu.Unlock()
+		return
+	}
+	w.tablets[alias] = th
+	w.mu.Unlock()
+
+	// A tablet that changed type, after a reparent, serves a new target.
+	if !known || previous.Target.TabletType != th.Target.TabletType || previous.Conn != th.Conn {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.warm(ctx, th)
+		}()
+	}
+}
+
+// reconcile replaces the tablets with the serving tablets of the keyspaces
+// in the cache of the healthcheck, which has the updates that the
+// subscription dropped, and warms up the ones that were not serving before.
+func (w *tabletWarmer) reconcile(ctx context.Context) {
+	serving := make(map[string]bool)
+	for _, tcs := range w.hc.CacheStatus() {
+		if tcs.Target == nil || !w.keyspaces[tcs.Target.Keyspace] {
+			continue
+		}
+		for _, th := range tcs.TabletsStats {
+			if th.Serving && th.Conn != nil {
+				serving[topoproto.TabletAliasString(th.Tablet.Alias)] = true
+				w.update(ctx, th)
+			}
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for alias := range w.tablets {
+		if !serving[alias] {
+			delete(w.tablets, alias)
+		}
+	}
+}
+
+// warmAll warms up all the serving tablets of the keyspaces, concurrently.
+// It doesn't wait for the queries, and does nothing if the ones of the
+// previous call are still running.
+func (w *tabletWarmer) warmAll(ctx context.Context) {
+	w.mu.Lock()
+	if w.warming {
+		w.mu.Unlock()
+		return
+	}
+	w.warming = true
+	tablets := make([]*discovery.TabletHealth, 0, len(w.tablets))
+	for _, th := range w.tablets {
+		tablets = append(tablets, th)
+	}
+	w.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, th := range tablets {
+		wg.Add(1)
+		go func(th *discovery.TabletHealth) {
+			defer wg.Done()
+			w.warm(ctx, th)
+		}(th)
+	}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		wg.Wait()
+		w.mu.Lock()
+		w.warming = false
+		w.mu.Unlock()
+	}()
+}
+
+// warm sends the validation query to the tablet.
+func (w *tabletWarmer) warm(ctx context.Context, th *discovery.TabletHealth) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	if _, err := th.Conn.Execute(ctx, th.Target, tabletWarmupQuery, nil, 0, 0, nil); err != nil {
+		log.Warningf("tablet warm-up: query on %s failed: %v", topoproto.TabletAliasString(th.Tablet.Alias), err)
+		tabletWarmups.Add([]string{th.Target.Keyspace, "error"}, 1)
+		return
+	}
+	tabletWarmups.Add([]string{th.Target.Keyspace, "ok"}, 1)
+}
+
+// stop stops watching the healthcheck and waits for the queries that are running.
+func (w *tabletWarmer) stop() {
+	if w == nil || w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+}
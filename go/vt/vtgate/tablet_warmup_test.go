@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestNewTabletWarmer(t *testing.T) {
+	assert.Nil(t, newTabletWarmer(nil, " , ", time.Second, time.Second))
+	w := newTabletWarmer(nil, "ks1, ks2", time.Second, time.Second)
+	require.NotNil(t, w)
+	assert.Equal(t, map[string]bool{"ks1": true, "ks2": true}, w.keyspaces)
+
+	// a nil warmer does nothing
+	w = nil
+	w.start(context.Background())
+	w.stop()
+}
+
+func TestTabletWarmer(t *testing.T) {
+	hc := discovery.NewFakeHealthCheck(make(chan *discovery.TabletHealth, 10))
+	replica := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1002, "ks", "0", topodatapb.TabletType_PRIMARY, true, 10, nil)
+	other := hc.AddTestTablet("cell", "1.1.1.1", 1003, "other", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	w := newTabletWarmer(hc, "ks", time.Hour, time.Second)
+	w.start(context.Background())
+	before := tabletWarmups.Counts()["ks.ok"]
+
+	// the tablets of the keyspace are queried as soon as they are discovered
+	hc.Broadcast(replica.Tablet())
+	hc.Broadcast(primary.Tablet())
+	hc.Broadcast(other.Tablet())
+	assert.Eventually(t, func() bool {
+		return replica.ExecCount.Get() == 1 && primary.ExecCount.Get() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// but only once
+	hc.Broadcast(replica.Tablet())
+	assert.Eventually(t, func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return len(w.tablets) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a tablet that no longer serves is forgotten
+	hc.SetServing(primary.Tablet(), false)
+	hc.Broadcast(primary.Tablet())
+	assert.Eventually(t, func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return len(w.tablets) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the tablets that serve are queried periodically
+	w.warmAll(context.Background())
+	assert.Eventually(t, func() bool {
+		return tabletWarmups.Counts()["ks.ok"] == before+3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 2, replica.ExecCount.Get())
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+	assert.EqualValues(t, 0, other.ExecCount.Get())
+
+	w.stop()
+	assert.Equal(t, []string{tabletWarmupQuery, tabletWarmupQuery}, replica.StringQueries())
+}
+
+func TestTabletWarmerReconcile(t *testing.T) {
+	// The updates of the healthcheck are not broadcast, as if the
+	// subscription had dropped them.
+	hc := discovery.NewFakeHealthCheck(make(chan *discovery.TabletHealth, 10))
+	replica := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1002, "ks", "0", topodatapb.TabletType_PRIMARY, true, 10, nil)
+	other := hc.AddTestTablet("cell", "1.1.1.1", 1003, "other", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	w := newTabletWarmer(hc, "ks", time.Hour, time.Second)
+	ctx := context.Background()
+
+	// the serving tablets of the keyspace are found in the cache of the healthcheck
+	w.reconcile(ctx)
+	assert.Eventually(t, func() bool {
+		return replica.ExecCount.Get() == 1 && primary.ExecCount.Get() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 0, other.ExecCount.Get())
+
+	// and forgotten when they no longer serve
+	hc.SetServing(primary.Tablet(), false)
+	w.reconcile(ctx)
+	w.mu.Lock()
+	assert.Len(t, w.tablets, 1)
+	w.mu.Unlock()
+
+	// the periodic queries don't pile up while the previous ones run
+	w.mu.Lock()
+	w.warming = true
+	w.mu.Unlock()
+	w.warmAll(ctx)
+	w.wg.Wait()
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+}
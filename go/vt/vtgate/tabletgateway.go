@@ -20,13 +20,17 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/srvtopo"
@@ -45,8 +49,56 @@ var (
 	_ discovery.HealthCheck = (*discovery.HealthCheckImpl)(nil)
 	// CellsToWatch is the list of cells the healthcheck operates over. If it is empty, only the local cell is watched
 	CellsToWatch = flag.String("cells_to_watch", "", "comma-separated list of cells for watching tablets")
+	// replicaSessionAffinity enables consistent hashing of sessions to replica and rdonly tablets.
+	replicaSessionAffinity = flag.Bool("replica_session_affinity", false, "when set, non-transactional reads on replica and rdonly tablets are routed by consistent hashing of the session id, so that a session keeps using the same healthy tablet. Tablets in the local cell are still preferred.")
+	// maxLagPrimaryFallback retries on the primary the reads that no replica is fresh enough to serve.
+	maxLagPrimaryFallback = flag.Bool("max_lag_primary_fallback", true, "when set, the reads with a MAX_LAG directive that no replica or rdonly tablet is fresh enough to serve are retried on the primary")
+
+	maxLagPrimaryFallbacks = stats.NewCountersWithSingleLabel(
+		"VtgateMaxLagPrimaryFallbacks",
+		"Number of reads with a MAX_LAG directive that were retried on the primary because no replica was fresh enough",
+		"Keyspace")
+	versionSkewPrimaryFallbacks = stats.NewCountersWithSingleLabel(
+		"VtgateVersionSkewPrimaryFallbacks",
+		"Number of reads that were retried on the primary because no replica runs a version that supports their options",
+		"Keyspace")
 )
 
+type sessionAffinityKey struct{}
+
+// withSessionAffinity returns a context that makes the TabletGateway pick
+// replica and rdonly tablets by consistent hashing of the session id.
+// The context is returned unchanged if the session has no id.
+func withSessionAffinity(ctx context.Context, sessionID string) context.Context {
+	if sessionID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, sessionAffinityKey{}, sessionID)
+}
+
+func sessionAffinityFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionAffinityKey{}).(string)
+	return sessionID
+}
+
+type readAtTimestampKey struct{}
+
+// withReadAtTimestamp returns a context that makes the TabletGateway try first
+// the replica and rdonly tablets that have replicated the writes committed up
+// to readAt, in nanoseconds since the epoch, according to the replication lag
+// of their last health check. The context is returned unchanged if readAt is 0.
+func withReadAtTimestamp(ctx context.Context, readAt int64) context.Context {
+	if readAt == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, readAtTimestampKey{}, readAt)
+}
+
+func readAtTimestampFromContext(ctx context.Context) int64 {
+	readAt, _ := ctx.Value(readAtTimestampKey{}).(int64)
+	return readAt
+}
+
 // TabletGateway implements the Gateway interface.
 // This implementation uses the new healthcheck module.
 type TabletGateway struct {
@@ -66,6 +118,9 @@ type TabletGateway struct {
 
 	// buffer, if enabled, buffers requests during a detected PRIMARY failover.
 	buffer *buffer.Buffer
+
+	// warmer, if enabled, validates the connections to the tablets of some keyspaces.
+	warmer *tabletWarmer
 }
 
 func createHealthCheck(ctx context.Context, retryDelay, timeout time.Duration, ts *topo.Server, cell, cellsToWatch string) discovery.HealthCheck {
@@ -95,6 +150,8 @@ func NewTabletGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtop
 		statusAggregators: make(map[string]*TabletStatusAggregator),
 	}
 	gw.setupBuffering(ctx)
+	gw.warmer = newTabletWarmer(hc, *tabletWarmupKeyspaces, *tabletWarmupInterval, *tabletWarmupTimeout)
+	gw.warmer.start(ctx)
 	gw.QueryService = queryservice.Wrap(nil, gw.withRetry)
 	return gw
 }
@@ -183,6 +240,7 @@ func (gw *TabletGateway) WaitForTablets(ctx context.Context, tabletTypesToWait [
 // Close shuts down underlying connections.
 // This function hides the inner implementation.
 func (gw *TabletGateway) Close(_ context.Context) error {
+	gw.warmer.stop()
 	gw.buffer.Shutdown()
 	return gw.hc.Close()
 }
@@ -274,7 +332,14 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
 			break
 		}
-		gw.shuffleTablets(gw.localCell, tablets)
+		if sessionID := sessionAffinityFromContext(ctx); sessionID != "" && target.TabletType != topodatapb.TabletType_PRIMARY {
+			gw.sortTabletsBySession(gw.localCell, sessionID, tablets)
+		} else {
+			gw.shuffleTablets(gw.localCell, tablets)
+		}
+		if readAt := readAtTimestampFromContext(ctx); readAt != 0 && target.TabletType != topodatapb.TabletType_PRIMARY {
+			sortTabletsByReadAtTimestamp(tablets, readAt, time.Now())
+		}
 
 		var th *discovery.TabletHealth
 		// skip tablets we tried before
@@ -312,6 +377,27 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		}
 		break
 	}
+	// The replicas we tried lag behind more than the query allows, didn't
+	// catch up with the GTID set or the timestamp of the session in time, or
+	// run a version that doesn't support the options of the query, so only
+	// the primary can serve it.
+	if err != nil && !inTransaction && target.TabletType != topodatapb.TabletType_PRIMARY {
+		switch {
+		case *maxLagPrimaryFallback && vterrors.RxReplicationLagTooHigh.MatchString(err.Error()):
+			maxLagPrimaryFallbacks.Add(target.Keyspace, 1)
+		case vterrors.RxReadAfterWriteTimeout.MatchString(err.Error()):
+			readYourWritesPrimaryFallbacks.Add(target.Keyspace, 1)
+		case vterrors.RxReadAtTimestampTimeout.MatchString(err.Error()):
+			readAtTimestampPrimaryFallbacks.Add(target.Keyspace, 1)
+		case vterrors.RxUnsupportedByTablet.MatchString(err.Error()):
+			versionSkewPrimaryFallbacks.Add(target.Keyspace, 1)
+		default:
+			return NewShardError(err, target)
+		}
+		primary := proto.Clone(target).(*querypb.Target)
+		primary.TabletType = topodatapb.TabletType_PRIMARY
+		return gw.withRetry(ctx, primary, nil, "", false, inner)
+	}
 	return NewShardError(err, target)
 }
 
@@ -375,6 +461,46 @@ func (gw *TabletGateway) shuffleTablets(cell string, tablets []*discovery.Tablet
 	}
 }
 
+// sortTabletsBySession orders the tablets for a session using rendezvous
+// hashing: every tablet gets a score from the hash of the session id and
+// the tablet alias, and tablets are tried in decreasing order of score.
+// Same cell tablets still come first. A session therefore keeps using the
+// same tablet, and only the sessions of a tablet that goes away move.
+func (gw *TabletGateway) sortTabletsBySession(cell, sessionID string, tablets []*discovery.TabletHealth) {
+	scores := make(map[*discovery.TabletHealth]uint64, len(tablets))
+	for _, th := range tablets {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(sessionID))
+		_, _ = h.Write([]byte(topoproto.TabletAliasString(th.Tablet.Alias)))
+		scores[th] = h.Sum64()
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		iSameCell, jSameCell := tablets[i].Tablet.Alias.Cell == cell, tablets[j].Tablet.Alias.Cell == cell
+		if iSameCell != jSameCell {
+			return iSameCell
+		}
+		return scores[tablets[i]] > scores[tablets[j]]
+	})
+}
+
+// sortTabletsByReadAtTimestamp moves first the tablets whose replication
+// position covers readAt: the ones whose last reported replication lag is
+// lower than the time elapsed since readAt. The order of the tablets is kept
+// otherwise. The other tablets are still tried, since they may catch up
+// while the read waits for them.
+func sortTabletsByReadAtTimestamp(tablets []*discovery.TabletHealth, readAt int64, now time.Time) {
+	covers := func(th *discovery.TabletHealth) bool {
+		if th.Stats == nil {
+			return false
+		}
+		lag := time.Duration(th.Stats.ReplicationLagSeconds) * time.Second
+		return now.Add(-lag).UnixNano() >= readAt
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		return covers(tablets[i]) && !covers(tablets[j])
+	})
+}
+
 func (gw *TabletGateway) nextTablet(cell string, tablets []*discovery.TabletHealth, offset, length int, sameCell bool) int {
 	for ; offset < length; offset++ {
 		if (tablets[offset].Tablet.Alias.Cell == cell) == sameCell {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -140,6 +141,214 @@ func TestTabletGatewayShuffleTablets(t *testing.T) {
 	}
 }
 
+func TestTabletGatewaySortTabletsBySession(t *testing.T) {
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "local")
+
+	var tablets []*discovery.TabletHealth
+	for i := 1; i <= 6; i++ {
+		cell := "cell1"
+		if i > 4 {
+			cell = "cell2"
+		}
+		tablets = append(tablets, &discovery.TabletHealth{
+			Tablet:  topo.NewTablet(uint32(i), cell, fmt.Sprintf("host%d", i)),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Serving: true,
+		})
+	}
+	sortedFor := func(sessionID string, tablets []*discovery.TabletHealth) []*discovery.TabletHealth {
+		sorted := append([]*discovery.TabletHealth(nil), tablets...)
+		tg.sortTabletsBySession("cell1", sessionID, sorted)
+		return sorted
+	}
+
+	chosen := map[*discovery.TabletHealth]int{}
+	for i := 0; i < 100; i++ {
+		sessionID := fmt.Sprintf("session-%d", i)
+		sorted := sortedFor(sessionID, tablets)
+		// Same cell tablets come first.
+		for _, th := range sorted[:4] {
+			assert.Equal(t, "cell1", th.Tablet.Alias.Cell)
+		}
+		// A session always gets the same order, irrespective of the input order.
+		reversed := append([]*discovery.TabletHealth(nil), tablets...)
+		for l, r := 0, len(reversed)-1; l < r; l, r = l+1, r-1 {
+			reversed[l], reversed[r] = reversed[r], reversed[l]
+		}
+		assert.Equal(t, sorted, sortedFor(sessionID, reversed))
+
+		// Removing another tablet does not move the session.
+		var others []*discovery.TabletHealth
+		for _, th := range tablets {
+			if th != sorted[1] {
+				others = append(others, th)
+			}
+		}
+		assert.Equal(t, sorted[0], sortedFor(sessionID, others)[0])
+		chosen[sorted[0]]++
+	}
+	// Sessions are spread across all the local tablets.
+	assert.Len(t, chosen, 4)
+}
+
+func TestTabletGatewaySessionAffinity(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc3 := hc.AddTestTablet("cell", "1.1.1.1", 1003, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	ctx := withSessionAffinity(context.Background(), "session")
+	for i := 0; i < 10; i++ {
+		_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	counts := []int64{sc1.ExecCount.Get(), sc2.ExecCount.Get(), sc3.ExecCount.Get()}
+	assert.ElementsMatch(t, []int64{0, 0, 10}, counts)
+}
+
+func TestTabletGatewayReadAtTimestamp(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	lagging := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	current := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	for _, th := range hc.GetHealthyTabletStats(target) {
+		th.Stats.ReplicationLagSeconds = 1
+		if th.Tablet.PortMap["vt"] == 1001 {
+			th.Stats.ReplicationLagSeconds = 100
+		}
+	}
+
+	// Only the tablet that lags by 1s has replicated the writes up to 10s ago.
+	ctx := withReadAtTimestamp(context.Background(), time.Now().Add(-10*time.Second).UnixNano())
+	for i := 0; i < 10; i++ {
+		_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 0, lagging.ExecCount.Get())
+	assert.EqualValues(t, 10, current.ExecCount.Get())
+
+	// When it fails, the other tablet is still tried.
+	current.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, lagging.ExecCount.Get())
+}
+
+func TestTabletGatewayMaxLagPrimaryFallback(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, new(sandboxTopo), "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1003, keyspace, shard, topodatapb.TabletType_PRIMARY, true, 10, nil)
+	lagErr := vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: 10s exceeds the max lag of 5s", vterrors.ReplicationLagTooHigh)
+
+	// no replica is fresh enough, the primary serves the query
+	sc1.EphemeralShardErr = lagErr
+	sc2.EphemeralShardErr = lagErr
+	before := maxLagPrimaryFallbacks.Counts()[keyspace]
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+	assert.EqualValues(t, 1, sc2.ExecCount.Get())
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+	assert.EqualValues(t, before+1, maxLagPrimaryFallbacks.Counts()[keyspace])
+
+	// without the fallback, the error is returned
+	defer func(fallback bool) {
+		*maxLagPrimaryFallback = fallback
+	}(*maxLagPrimaryFallback)
+	*maxLagPrimaryFallback = false
+	sc1.EphemeralShardErr = lagErr
+	sc2.EphemeralShardErr = lagErr
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "replication lag too high", vtrpcpb.Code_FAILED_PRECONDITION)
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+}
+
+func TestTabletGatewayReadAfterWritePrimaryFallback(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, new(sandboxTopo), "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_PRIMARY, true, 10, nil)
+
+	// the replica didn't catch up with the writes of the session, the
+	// primary serves the query even without the MAX_LAG fallback
+	defer func(fallback bool) {
+		*maxLagPrimaryFallback = fallback
+	}(*maxLagPrimaryFallback)
+	*maxLagPrimaryFallback = false
+	sc1.EphemeralShardErr = vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: 00010203-0405-0607-0809-0a0b0c0d0e0f:1-5", vterrors.ReadAfterWriteTimeout)
+	before := readYourWritesPrimaryFallbacks.Counts()[keyspace]
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+	assert.EqualValues(t, before+1, readYourWritesPrimaryFallbacks.Counts()[keyspace])
+
+	// same if it didn't reach the read_at_timestamp of the session
+	sc1.EphemeralShardErr = vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: 2022-03-04T05:06:07Z", vterrors.ReadAtTimestampTimeout)
+	before = readAtTimestampPrimaryFallbacks.Counts()[keyspace]
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sc1.ExecCount.Get())
+	assert.EqualValues(t, 2, primary.ExecCount.Get())
+	assert.EqualValues(t, before+1, readAtTimestampPrimaryFallbacks.Counts()[keyspace])
+}
+
+func TestTabletGatewayVersionSkewPrimaryFallback(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, new(sandboxTopo), "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_PRIMARY, true, 10, nil)
+
+	// the replica runs a version that doesn't support the options of the
+	// query, the primary serves it
+	sc1.EphemeralShardErr = vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s read_after_write: tablet cell-0000001001 runs version 13.0.0", vterrors.UnsupportedByTablet)
+	before := versionSkewPrimaryFallbacks.Counts()[keyspace]
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+	assert.EqualValues(t, before+1, versionSkewPrimaryFallbacks.Counts()[keyspace])
+}
+
 func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	keyspace := "ks"
 	shard := "0"
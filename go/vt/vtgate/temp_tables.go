@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	tempTableSessions = stats.NewGauge(
+		"VtgateTempTableSessions",
+		"Number of sessions that hold temporary tables on their reserved connections")
+	tempTablesLost = stats.NewCounter(
+		"VtgateTempTablesLost",
+		"Number of temporary tables lost with the reserved connection that held them")
+)
+
+// AddTempTable records a temporary table that the session created in the keyspace.
+func (session *SafeSession) AddTempTable(keyspace, table string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	name := keyspace + "." + table
+	for _, tempTable := range session.TempTables {
+		if tempTable == name {
+			return
+		}
+	}
+	if len(session.TempTables) == 0 {
+		tempTableSessions.Add(1)
+	}
+	session.TempTables = append(session.TempTables, name)
+}
+
+// RemoveTempTable forgets a temporary table that the session dropped from the keyspace.
+func (session *SafeSession) RemoveTempTable(keyspace, table string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	name := keyspace + "." + table
+	session.removeTempTablesLocked(func(tempTable string) bool {
+		return tempTable == name
+	})
+}
+
+// LoseTempTables forgets the temporary tables that the session created in
+// the keyspace, because the reserved connections that held them were lost.
+// It returns the lost tables.
+func (session *SafeSession) LoseTempTables(keyspace string) []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	lost := session.removeTempTablesLocked(func(tempTable string) bool {
+		return strings.HasPrefix(tempTable, keyspace+".")
+	})
+	tempTablesLost.Add(int64(len(lost)))
+	return lost
+}
+
+// releaseTempTablesLocked forgets all the temporary tables of the session,
+// once its reserved connections are released. The caller must hold the lock.
+func (session *SafeSession) releaseTempTablesLocked() {
+	session.removeTempTablesLocked(func(string) bool {
+		return true
+	})
+}
+
+// removeTempTablesLocked removes the temporary tables that match from the
+// session, and returns them. The caller must hold the lock.
+func (session *SafeSession) removeTempTablesLocked(match func(tempTable string) bool) []string {
+	if len(session.TempTables) == 0 {
+		return nil
+	}
+	var kept, removed []string
+	for _, tempTable := range session.TempTables {
+		if match(tempTable) {
+			removed = append(removed, tempTable)
+		} else {
+			kept = append(kept, tempTable)
+		}
+	}
+	if len(kept) == 0 {
+		tempTableSessions.Add(-1)
+	}
+	session.TempTables = kept
+	return removed
+}
+
+// lostTempTablesError returns the error of a query whose reserved connection
+// was lost with the temporary tables it held. The query can't be retried on a
+// new reserved connection, where the temporary tables don't exist.
+func lostTempTablesError(target *querypb.Target, lost []string, err error) error {
+	return vterrors.Errorf(vtrpcpb.Code_ABORTED, "the reserved connection to %s was lost with the temporary tables %s of the session: %v",
+		topoproto.KeyspaceShardString(target.Keyspace, target.Shard), strings.Join(lost, ", "), err)
+}
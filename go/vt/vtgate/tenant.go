@@ -0,0 +1,357 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// scopeToTenants rewrites a statement that uses tenant databases, the one of
+// the session or the qualifiers of its tables, so that it only reads and
+// writes the rows of their tenants, and can be planned like any other query
+// of their keyspace. The tables of a tenant database are qualified with its
+// keyspace, and the statement gets a predicate on the column of the vindex of
+// the database for each of them. An insert must write the tenant in that
+// column, and the statements that can't be scoped are rejected.
+//
+// It returns the statement itself when it doesn't use a tenant database.
+func scopeToTenants(stmt sqlparser.Statement, vschema *vindexes.VSchema, session *vindexes.Tenant) (sqlparser.Statement, bool, error) {
+	if !vschema.HasTenants() {
+		return stmt, false, nil
+	}
+	s := &tenantScope{
+		vschema: vschema,
+		session: session,
+		tables:  make(map[*sqlparser.AliasedTableExpr]*vindexes.Tenant),
+		ctes:    make(map[string]bool),
+	}
+	if err := s.qualify(stmt); err != nil {
+		return nil, false, err
+	}
+	if s.session == nil && len(s.tables) == 0 && s.insert == nil {
+		return stmt, false, nil
+	}
+	if err := s.scope(stmt); err != nil {
+		return nil, false, err
+	}
+	return stmt, true, nil
+}
+
+// tenantScope is the state of scopeToTenants for one statement.
+type tenantScope struct {
+	vschema *vindexes.VSchema
+	session *vindexes.Tenant
+
+	// tables are the tables of the statement qualified with a tenant
+	// database, before their qualifier was replaced by its keyspace.
+	tables map[*sqlparser.AliasedTableExpr]*vindexes.Tenant
+	// insert is the tenant of the table of an insert, if it was qualified
+	// with a tenant database.
+	insert *vindexes.Tenant
+	// ctes are the names of the common table expressions, which aren't
+	// tables.
+	ctes map[string]bool
+}
+
+// qualify replaces the tenant databases that qualify the tables and columns
+// of the statement by their keyspace, and records the tenant of the tables.
+func (s *tenantScope) qualify(stmt sqlparser.Statement) error {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		tenant, name, err := s.qualifyTableName(stmt.Table)
+		if err != nil {
+			return err
+		}
+		s.insert, stmt.Table = tenant, name
+	case *sqlparser.Delete:
+		// The targets are also in the table expressions.
+		for i, target := range stmt.Targets {
+			_, name, err := s.qualifyTableName(target)
+			if err != nil {
+				return err
+			}
+			stmt.Targets[i] = name
+		}
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			s.ctes[node.TableID.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			name, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true, nil
+			}
+			tenant, name, err := s.qualifyTableName(name)
+			if err != nil {
+				return false, err
+			}
+			if tenant != nil {
+				s.tables[node] = tenant
+				node.Expr = name
+			}
+		case *sqlparser.ColName:
+			if node.Qualifier.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			_, name, err := s.qualifyTableName(node.Qualifier)
+			if err != nil {
+				return false, err
+			}
+			node.Qualifier = name
+		}
+		return true, nil
+	}, stmt)
+}
+
+// qualifyTableName returns the tenant of the database of the table name, if
+// it is a tenant database, and the name qualified with its keyspace.
+func (s *tenantScope) qualifyTableName(name sqlparser.TableName) (*vindexes.Tenant, sqlparser.TableName, error) {
+	if name.Qualifier.IsEmpty() {
+		return nil, name, nil
+	}
+	keyspace, tenant, err := s.vschema.FindDatabase(name.Qualifier.String())
+	if err != nil || tenant == nil {
+		return nil, name, err
+	}
+	name.Qualifier = sqlparser.NewTableIdent(keyspace.Name)
+	return tenant, name, nil
+}
+
+// scope adds the predicates on the tenants to the statement, and checks that
+// its writes stay in their tenants.
+func (s *tenantScope) scope(stmt sqlparser.Statement) error {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.Set:
+	case *sqlparser.Insert:
+		if err := s.scopeInsert(stmt); err != nil {
+			return err
+		}
+	case *sqlparser.Update:
+		tenantColumns, err := s.scopeTableExprs(stmt.TableExprs, true, stmt.AddWhere)
+		if err != nil {
+			return err
+		}
+		for _, expr := range stmt.Exprs {
+			if tenantColumns[expr.Name.Name.Lowered()] {
+				return s.errorf("the update of the column %s", sqlparser.String(expr.Name))
+			}
+		}
+	case *sqlparser.Delete:
+		if _, err := s.scopeTableExprs(stmt.TableExprs, true, func(expr sqlparser.Expr) {
+			if stmt.Where == nil {
+				stmt.Where = sqlparser.NewWhere(sqlparser.WhereClause, expr)
+				return
+			}
+			stmt.Where.Expr = sqlparser.AndExpressions(stmt.Where.Expr, expr)
+		}); err != nil {
+			return err
+		}
+	case *sqlparser.ExplainStmt:
+		switch stmt.Statement.(type) {
+		case *sqlparser.Select, *sqlparser.Union:
+		default:
+			return s.errorf("the statement %s", sqlparser.String(stmt))
+		}
+	case *sqlparser.Show, *sqlparser.ExplainTab, *sqlparser.Use, *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback,
+		*sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release, *sqlparser.SetTransaction, *sqlparser.UnlockTables:
+		return nil
+	default:
+		return s.errorf("the statement %s", sqlparser.String(stmt))
+	}
+	// The selects include the subqueries of the writes.
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		sel, ok := node.(*sqlparser.Select)
+		if !ok {
+			return true, nil
+		}
+		_, err := s.scopeTableExprs(sel.From, false, sel.AddWhere)
+		return err == nil, err
+	}, stmt)
+}
+
+// scopeInsert checks that the insert writes the tenant of its table in the
+// column of the vindex of the tenant database, which is added to the
+// columns of the insert if it doesn't list it.
+func (s *tenantScope) scopeInsert(ins *sqlparser.Insert) error {
+	tenant := s.insert
+	if tenant == nil && ins.Table.Qualifier.IsEmpty() {
+		tenant = s.session
+	}
+	if tenant == nil {
+		return nil
+	}
+	column, err := s.tenantColumn(tenant, ins.Table, true)
+	if err != nil {
+		return err
+	}
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if !ok {
+		return s.errorf("the insert of the rows of a select into %s", sqlparser.String(ins.Table))
+	}
+	for _, expr := range ins.OnDup {
+		if expr.Name.Name.Equal(column) {
+			return s.errorf("the update of the column %s", sqlparser.String(expr.Name))
+		}
+	}
+	index := ins.Columns.FindColumn(column)
+	if index == -1 {
+		if len(ins.Columns) == 0 {
+			return s.errorf("the insert into %s without a list of columns", sqlparser.String(ins.Table))
+		}
+		ins.Columns = append(ins.Columns, column)
+		for i := range rows {
+			rows[i] = append(rows[i], sqlparser.NewStrLiteral(tenant.Value))
+		}
+		return nil
+	}
+	for _, row := range rows {
+		if index >= len(row) {
+			return s.errorf("the insert into %s", sqlparser.String(ins.Table))
+		}
+		if lit, ok := row[index].(*sqlparser.Literal); !ok || lit.Val != tenant.Value {
+			return s.errorf("the insert of %s in the column %s", sqlparser.String(row[index]), column.String())
+		}
+	}
+	return nil
+}
+
+// scopeTableExprs adds the predicates on the tenants of the tables of the
+// table expressions, and returns the names of their tenant columns. The
+// predicates on the tables of the inner side of an outer join go to its ON
+// condition, and the others to add.
+func (s *tenantScope) scopeTableExprs(exprs sqlparser.TableExprs, write bool, add func(sqlparser.Expr)) (map[string]bool, error) {
+	columns := make(map[string]bool)
+	for _, expr := range exprs {
+		if err := s.scopeTableExpr(expr, write, add, columns); err != nil {
+			return nil, err
+		}
+	}
+	return columns, nil
+}
+
+func (s *tenantScope) scopeTableExpr(expr sqlparser.TableExpr, write bool, add func(sqlparser.Expr), columns map[string]bool) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return s.scopeTable(expr, write, add, columns)
+	case *sqlparser.ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			if err := s.scopeTableExpr(expr, write, add, columns); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.JoinTableExpr:
+		outer, inner := expr.LeftExpr, expr.RightExpr
+		switch expr.Join {
+		case sqlparser.RightJoinType, sqlparser.NaturalRightJoinType:
+			outer, inner = inner, outer
+		case sqlparser.LeftJoinType, sqlparser.NaturalLeftJoinType:
+		default:
+			if err := s.scopeTableExpr(outer, write, add, columns); err != nil {
+				return err
+			}
+			return s.scopeTableExpr(inner, write, add, columns)
+		}
+		if err := s.scopeTableExpr(outer, write, add, columns); err != nil {
+			return err
+		}
+		var on []sqlparser.Expr
+		if err := s.scopeTableExpr(inner, write, func(expr sqlparser.Expr) { on = append(on, expr) }, columns); err != nil {
+			return err
+		}
+		if len(on) == 0 {
+			return nil
+		}
+		if expr.Condition == nil || len(expr.Condition.Using) > 0 {
+			return s.errorf("the outer join %s", sqlparser.String(expr))
+		}
+		if expr.Condition.On != nil {
+			on = append([]sqlparser.Expr{expr.Condition.On}, on...)
+		}
+		expr.Condition.On = sqlparser.AndExpressions(on...)
+	}
+	return nil
+}
+
+// scopeTable adds the predicate on the tenant of a table, if it is a table
+// of a tenant database.
+func (s *tenantScope) scopeTable(expr *sqlparser.AliasedTableExpr, write bool, add func(sqlparser.Expr), columns map[string]bool) error {
+	name, ok := expr.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil
+	}
+	tenant := s.tables[expr]
+	if tenant == nil && name.Qualifier.IsEmpty() && !s.ctes[name.Name.String()] {
+		tenant = s.session
+	}
+	if tenant == nil {
+		return nil
+	}
+	column, err := s.tenantColumn(tenant, name, write)
+	if err != nil || column.IsEmpty() {
+		return err
+	}
+	columns[column.Lowered()] = true
+	qualifier := name
+	if !expr.As.IsEmpty() {
+		qualifier = sqlparser.TableName{Name: expr.As}
+	}
+	add(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.EqualOp,
+		Left:     &sqlparser.ColName{Name: column, Qualifier: qualifier},
+		Right:    sqlparser.NewStrLiteral(tenant.Value),
+	})
+	return nil
+}
+
+// tenantColumn returns the column of the table on the vindex of the tenant
+// database, or an empty column for the reference tables and the sequences,
+// which the tenants share and only read.
+func (s *tenantScope) tenantColumn(tenant *vindexes.Tenant, name sqlparser.TableName, write bool) (sqlparser.ColIdent, error) {
+	table, err := s.vschema.FindTable(tenant.Keyspace.Name, name.Name.String())
+	if err != nil {
+		return sqlparser.ColIdent{}, err
+	}
+	if table.Type == vindexes.TypeReference || table.Type == vindexes.TypeSequence {
+		if write {
+			return sqlparser.ColIdent{}, s.errorf("the write of the shared table %s", name.Name.String())
+		}
+		return sqlparser.ColIdent{}, nil
+	}
+	for _, cv := range table.ColumnVindexes {
+		if cv.Name == tenant.VindexName && len(cv.Columns) == 1 {
+			return cv.Columns[0], nil
+		}
+	}
+	return sqlparser.ColIdent{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s has no column on vindex %s and can't be used by the tenant database %s", name.Name.String(), tenant.VindexName, tenant.Database)
+}
+
+func (s *tenantScope) errorf(format string, args ...interface{}) error {
+	tenant := s.session
+	if s.insert != nil {
+		tenant = s.insert
+	}
+	for _, table := range s.tables {
+		if tenant == nil {
+			tenant = table
+		}
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, format+" can't be scoped to the tenant of database %s", append(args, tenant.Database)...)
+}
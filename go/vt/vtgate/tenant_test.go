@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestScopeToTenants(t *testing.T) {
+	vschema := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded:  true,
+				Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+				Tables: map[string]*vschemapb.Table{
+					"t":   {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant_id", Name: "hash"}}},
+					"u":   {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tid", Name: "hash"}}},
+					"ref": {Type: vindexes.TypeReference},
+				},
+				Databases: map[string]string{"app": "", "tenant_*": "hash"},
+			},
+		},
+	})
+	require.NoError(t, vschema.Keyspaces["ks"].Error)
+	_, session, err := vschema.FindDatabase("tenant_7")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		session *vindexes.Tenant
+		in      string
+		out     string
+		err     string
+	}{{
+		in:  "select * from t",
+		out: "select * from t",
+	}, {
+		in:  "select * from app.t",
+		out: "select * from app.t",
+	}, {
+		session: session,
+		in:      "select * from t where a = 1 or b = 2",
+		out:     "select * from t where (a = 1 or b = 2) and t.tenant_id = '7'",
+	}, {
+		in:  "select tenant_8.t.a from tenant_8.t join ref on t.a = ref.a where t.b in (select tid from u)",
+		out: "select ks.t.a from ks.t join ref on t.a = ref.a where t.b in (select tid from u) and ks.t.tenant_id = '8'",
+	}, {
+		session: session,
+		in:      "select * from t as x right join u on x.a = u.a",
+		out:     "select * from t as x right join u on x.a = u.a and x.tenant_id = '7' where u.tid = '7'",
+	}, {
+		session: session,
+		in:      "with c as (select a from t) select * from c",
+		out:     "with c as (select a from t where t.tenant_id = '7') select * from c",
+	}, {
+		session: session,
+		in:      "delete from t where a = 1",
+		out:     "delete from t where a = 1 and t.tenant_id = '7'",
+	}, {
+		session: session,
+		in:      "insert into t(a) values (1), (2)",
+		out:     "insert into t(a, tenant_id) values (1, '7'), (2, '7')",
+	}, {
+		in:  "insert into tenant_7.t(tenant_id, a) values (7, 1)",
+		out: "insert into ks.t(tenant_id, a) values (7, 1)",
+	}, {
+		in:  "insert into tenant_7.t(tenant_id, a) values (8, 1)",
+		err: "the insert of 8 in the column tenant_id can't be scoped to the tenant of database tenant_7",
+	}, {
+		session: session,
+		in:      "insert into t values (1)",
+		err:     "the insert into t without a list of columns can't be scoped to the tenant of database tenant_7",
+	}, {
+		session: session,
+		in:      "update ref set a = 1",
+		err:     "the write of the shared table ref can't be scoped to the tenant of database tenant_7",
+	}, {
+		session: session,
+		in:      "select * from t join u using (a)",
+		out:     "select * from t join u using (a) where t.tenant_id = '7' and u.tid = '7'",
+	}, {
+		session: session,
+		in:      "select * from t left join u using (a)",
+		err:     "the outer join t left join u using (a) can't be scoped to the tenant of database tenant_7",
+	}, {
+		session: session,
+		in:      "call p()",
+		err:     "the statement call p() can't be scoped to the tenant of database tenant_7",
+	}, {
+		session: session,
+		in:      "show tables",
+		out:     "show tables",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.in)
+			require.NoError(t, err)
+			stmt, _, err = scopeToTenants(stmt, vschema, tc.session)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.out, sqlparser.String(stmt))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	throttleDirectiveMaxWait       = flag.Duration("throttle_directive_max_wait", 10*time.Second, "how long a DML with a THROTTLE directive waits for the throttlers of its shards before it is rejected. 0 rejects it as soon as a throttler refuses it")
+	throttleDirectiveCheckInterval = flag.Duration("throttle_directive_check_interval", 250*time.Millisecond, "how long vtgate reuses the answer of the throttler of a tablet to a THROTTLE directive, and waits before asking again")
+
+	throttledQueries = stats.NewCountersWithMultiLabels(
+		"VtgateThrottledQueries",
+		"Statements with a THROTTLE directive that the throttlers of their shards delayed or rejected",
+		[]string{"Keyspace", "Outcome"})
+
+	throttleCheckLogger = logutil.NewThrottledLogger("ThrottleDirective", 5*time.Second)
+)
+
+// checkTabletThrottler asks the throttler of a tablet whether the app can
+// write to it. It is a variable for the tests.
+var checkTabletThrottler = func(ctx context.Context, tablet *topodatapb.Tablet, app string) (bool, string, error) {
+	checkURL := fmt.Sprintf("http://%s/throttler/check?app=%s", netutil.JoinHostPort(tablet.Hostname, tablet.PortMap["vt"]), url.QueryEscape(app))
+	req, err := http.NewRequestWithContext(ctx, "GET", checkURL, nil)
+	if err != nil {
+		return false, "", err
+	}
+	client := http.Client{
+		Timeout: 100 * time.Millisecond,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, "", err
+	}
+	defer resp.Body.Close()
+	var check struct {
+		StatusCode int
+		Message    string
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&check); err != nil {
+		return false, "", err
+	}
+	return check.StatusCode == http.StatusOK, check.Message, nil
+}
+
+// throttleCheck is an answer of the throttler of a tablet.
+type throttleCheck struct {
+	time    time.Time
+	ok      bool
+	message string
+}
+
+// throttleChecks caches the answers of the throttlers of the tablets for
+// -throttle_directive_check_interval, so that a batch job doesn't ask them
+// for each of its statements.
+type throttleChecks struct {
+	mu     sync.Mutex
+	checks map[string]*throttleCheck
+}
+
+func newThrottleChecks() *throttleChecks {
+	return &throttleChecks{checks: make(map[string]*throttleCheck)}
+}
+
+// check returns whether the throttler of the tablet lets the app write.
+// A throttler that can't be reached doesn't hold the writes.
+func (tc *throttleChecks) check(ctx context.Context, tablet *topodatapb.Tablet, app string) (bool, string) {
+	key := topoproto.TabletAliasString(tablet.Alias) + "/" + app
+	tc.mu.Lock()
+	cached, ok := tc.checks[key]
+	tc.mu.Unlock()
+	if ok && time.Since(cached.time) < *throttleDirectiveCheckInterval {
+		return cached.ok, cached.message
+	}
+
+	ok, message, err := checkTabletThrottler(ctx, tablet, app)
+	if err != nil {
+		throttleCheckLogger.Warningf("cannot check the throttler of %v for %v, the statement is not throttled: %v", topoproto.TabletAliasString(tablet.Alias), app, err)
+		ok, message = true, ""
+	}
+	tc.mu.Lock()
+	tc.checks[key] = &throttleCheck{time: time.Now(), ok: ok, message: message}
+	tc.mu.Unlock()
+	return ok, message
+}
+
+// WaitForThrottlers waits until the throttlers of the primaries of the
+// shards let the app write, for at most -throttle_directive_max_wait. It
+// is called for the statements that have a THROTTLE directive.
+func (e *Executor) WaitForThrottlers(ctx context.Context, app string, rss []*srvtopo.ResolvedShard) error {
+	gw, ok := e.scatterConn.gateway.(*TabletGateway)
+	if !ok {
+		return nil
+	}
+	var deadline time.Time
+	delayed := make(map[string]bool)
+	for {
+		var refused *srvtopo.ResolvedShard
+		var message string
+		for _, rs := range rss {
+			if rs.Target.TabletType != topodatapb.TabletType_PRIMARY {
+				continue
+			}
+			for _, th := range gw.hc.GetHealthyTabletStats(rs.Target) {
+				if ok, msg := e.throttleChecks.check(ctx, th.Tablet, app); !ok {
+					refused, message = rs, msg
+					break
+				}
+			}
+			if refused != nil {
+				break
+			}
+		}
+		if refused == nil {
+			return nil
+		}
+
+		keyspace := refused.Target.Keyspace
+		if deadline.IsZero() {
+			deadline = time.Now().Add(*throttleDirectiveMaxWait)
+		}
+		if !time.Now().Before(deadline) {
+			throttledQueries.Add([]string{keyspace, "Rejected"}, 1)
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "throttled by the throttler of %s/%s for %s: %s", keyspace, refused.Target.Shard, app, message)
+		}
+		if !delayed[keyspace] {
+			delayed[keyspace] = true
+			throttledQueries.Add([]string{keyspace, "Delayed"}, 1)
+		}
+		select {
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "throttled by the throttler of %s/%s for %s: %v", keyspace, refused.Target.Shard, app, ctx.Err())
+		case <-time.After(*throttleDirectiveCheckInterval):
+		}
+	}
+}
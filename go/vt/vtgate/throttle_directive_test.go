@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeThrottlers replaces checkTabletThrottler with throttlers that refuse
+// the writes to the tablets of a keyspace until they are opened.
+type fakeThrottlers struct {
+	mu     sync.Mutex
+	closed map[string]bool
+	apps   []string
+}
+
+func setFakeThrottlers(t *testing.T) *fakeThrottlers {
+	ft := &fakeThrottlers{closed: make(map[string]bool)}
+	saved := checkTabletThrottler
+	savedInterval := *throttleDirectiveCheckInterval
+	checkTabletThrottler = func(ctx context.Context, tablet *topodatapb.Tablet, app string) (bool, string, error) {
+		ft.mu.Lock()
+		defer ft.mu.Unlock()
+		ft.apps = append(ft.apps, app)
+		if ft.closed[tablet.Keyspace] {
+			return false, "replication lag is too high", nil
+		}
+		return true, "", nil
+	}
+	*throttleDirectiveCheckInterval = time.Millisecond
+	t.Cleanup(func() {
+		checkTabletThrottler = saved
+		*throttleDirectiveCheckInterval = savedInterval
+	})
+	return ft
+}
+
+func (ft *fakeThrottlers) set(keyspace string, closed bool) {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	ft.closed[keyspace] = closed
+}
+
+func (ft *fakeThrottlers) checkedApps() []string {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	return ft.apps
+}
+
+func TestExecutorThrottleDirectiveDelay(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	ft := setFakeThrottlers(t)
+	ft.set("TestExecutor", true)
+	delayed := throttledQueries.Counts()["TestExecutor.Delayed"]
+
+	time.AfterFunc(20*time.Millisecond, func() { ft.set("TestExecutor", false) })
+	start := time.Now()
+	_, err := executorExec(executor, "delete /*vt+ THROTTLE=purge */ from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	require.NotEmpty(t, sbc1.Queries)
+	assert.Contains(t, sbc1.Queries[len(sbc1.Queries)-1].Sql, "delete /*vt+ THROTTLE=purge */")
+	assert.Equal(t, delayed+1, throttledQueries.Counts()["TestExecutor.Delayed"])
+	assert.Contains(t, ft.checkedApps(), "purge")
+
+	// The statements without the directive don't ask the throttlers.
+	checks := len(ft.checkedApps())
+	ft.set("TestExecutor", true)
+	_, err = executorExec(executor, "delete from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Len(t, ft.checkedApps(), checks)
+}
+
+func TestExecutorThrottleDirectiveReject(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	ft := setFakeThrottlers(t)
+	ft.set("TestExecutor", true)
+	savedMaxWait := *throttleDirectiveMaxWait
+	*throttleDirectiveMaxWait = 10 * time.Millisecond
+	defer func() { *throttleDirectiveMaxWait = savedMaxWait }()
+	rejected := throttledQueries.Counts()["TestExecutor.Rejected"]
+
+	_, err := executorExec(executor, "update /*vt+ THROTTLE */ user set a = 2 where id = 1", nil)
+	require.EqualError(t, err, "throttled by the throttler of TestExecutor/-20 for vtgate: replication lag is too high")
+	assert.Empty(t, sbc1.Queries)
+	assert.Equal(t, rejected+1, throttledQueries.Counts()["TestExecutor.Rejected"])
+
+	ft.set("TestExecutor", false)
+	_, err = executorExec(executor, "update /*vt+ THROTTLE */ user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, sbc1.Queries)
+	assert.Contains(t, sbc1.Queries[len(sbc1.Queries)-1].Sql, "update /*vt+ THROTTLE */")
+}
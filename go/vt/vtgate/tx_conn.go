@@ -18,8 +18,12 @@ package vtgate
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
+	"google.golang.org/protobuf/proto"
+
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
@@ -32,6 +36,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
@@ -39,6 +44,9 @@ import (
 type TxConn struct {
 	gateway Gateway
 	mode    vtgatepb.TransactionMode
+	// resultCache is told when the transactions end, to invalidate the
+	// results of the tables that they wrote.
+	resultCache *resultCache
 }
 
 // NewTxConn builds a new TxConn.
@@ -57,17 +65,51 @@ func (txc *TxConn) Begin(ctx context.Context, session *SafeSession) error {
 			return err
 		}
 	}
-	session.Session.InTransaction = true
+	session.beginTx()
 	return nil
 }
 
+// beginOptions returns the options of the query that begins a transaction on
+// the target. A transaction on a replica or rdonly tablet takes a consistent
+// snapshot of the shard when it begins, so that its reads of the shard are
+// repeatable. Such a snapshot is taken at the replication position of the
+// replica, so that the session rejects a second shard in the transaction.
+func beginOptions(options *querypb.ExecuteOptions, target *querypb.Target) *querypb.ExecuteOptions {
+	if target == nil || target.TabletType == topodatapb.TabletType_PRIMARY || options.GetTransactionIsolation() != querypb.ExecuteOptions_DEFAULT {
+		return options
+	}
+	if options == nil {
+		options = &querypb.ExecuteOptions{}
+	} else {
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+	}
+	options.TransactionIsolation = querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	return options
+}
+
 // Commit commits the current transaction. The type of commit can be
-// best effort or 2pc depending on the session setting.
-func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
+// best effort or 2pc depending on the session setting. A transaction on
+// replicas is read-only, so that it is always committed with a best effort
+// commit.
+func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) (err error) {
 	defer session.ResetTx()
 	if !session.InTransaction() {
 		return nil
 	}
+	defer txc.resultCache.endTransaction(session.GetTransactionUUID())
+	// A transaction that ran no query has no outcome to log, like the implicit
+	// transaction that a BEGIN commits. The outcome is recorded by a defer,
+	// because a failed commit may release the session before returning.
+	if session.isTxOpen() {
+		transactionID := session.GetTransactionUUID()
+		defer func() {
+			if err != nil {
+				session.endTx(transactionID, txOutcomeCommitFailed)
+				return
+			}
+			session.endTx(transactionID, txOutcomeCommit)
+		}()
+	}
 
 	twopc := false
 	switch session.TransactionMode {
@@ -76,7 +118,7 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	case vtgatepb.TransactionMode_UNSPECIFIED:
 		twopc = txc.mode == vtgatepb.TransactionMode_TWOPC
 	}
-	if twopc {
+	if twopc && !session.InReplicaTransaction() {
 		return txc.commit2PC(ctx, session)
 	}
 	return txc.commitNormal(ctx, session)
@@ -109,20 +151,32 @@ func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSes
 }
 
 func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error {
-	if err := txc.runSessions(ctx, session.PreSessions, txc.commitShard); err != nil {
+	commitShard := func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		// A transaction that didn't change rows has no GTID set to record.
+		wrote := s.TransactionId != 0 && s.Wrote
+		if err := txc.commitShard(ctx, s); err != nil {
+			return err
+		}
+		if wrote {
+			recordWrite(ctx, session, txc.gateway, s.Target, "")
+		}
+		return nil
+	}
+
+	if err := txc.runSessions(ctx, session.PreSessions, commitShard); err != nil {
 		_ = txc.Release(ctx, session)
 		return err
 	}
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
-		if err := txc.commitShard(ctx, shardSession); err != nil {
+		if err := commitShard(ctx, shardSession); err != nil {
 			_ = txc.Release(ctx, session)
 			return err
 		}
 	}
 
-	if err := txc.runSessions(ctx, session.PostSessions, txc.commitShard); err != nil {
+	if err := txc.runSessions(ctx, session.PostSessions, commitShard); err != nil {
 		// If last commit fails, there will be nothing to rollback.
 		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("post-operation transaction had an error: %v", err)})
 		// With reserved connection we should release them.
@@ -151,6 +205,7 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 	}
 	mmShard := session.ShardSessions[0]
 	dtid := dtids.New(mmShard)
+	session.setTxDTID(dtid)
 	err := txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
 	if err != nil {
 		// Normal rollback is safe because nothing was prepared yet.
@@ -183,7 +238,15 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
-	return txc.gateway.ConcludeTransaction(ctx, mmShard.Target, dtid)
+	if err := txc.gateway.ConcludeTransaction(ctx, mmShard.Target, dtid); err != nil {
+		return err
+	}
+	for _, s := range session.ShardSessions {
+		if s.Wrote {
+			recordWrite(ctx, session, txc.gateway, s.Target, "")
+		}
+	}
+	return nil
 }
 
 // Rollback rolls back the current transaction. There are no retries on this operation.
@@ -192,6 +255,10 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.ResetTx()
+	defer txc.resultCache.endTransaction(session.GetTransactionUUID())
+	if session.isTxOpen() {
+		session.endTx(session.GetTransactionUUID(), txOutcomeRollback)
+	}
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -227,6 +294,10 @@ func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.Reset()
+	defer txc.resultCache.endTransaction(session.GetTransactionUUID())
+	if session.InTransaction() && session.isTxOpen() {
+		session.endTx(session.GetTransactionUUID(), txOutcomeRollback)
+	}
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -249,14 +320,14 @@ func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
 	})
 }
 
-//ReleaseLock releases the reserved connection used for locking.
-func (txc *TxConn) ReleaseLock(ctx context.Context, session *SafeSession) error {
-	if !session.InLockSession() {
+//ReleaseLock releases the reserved connection used for locking on the target.
+func (txc *TxConn) ReleaseLock(ctx context.Context, session *SafeSession, target *querypb.Target) error {
+	ls := session.FindLockSession(target)
+	if ls == nil {
 		return nil
 	}
-	defer session.ResetLock()
+	defer session.ResetLock(target)
 
-	ls := session.LockSession
 	if ls.ReservedId == 0 {
 		return nil
 	}
@@ -279,12 +350,14 @@ func (txc *TxConn) ReleaseAll(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.ResetAll()
+	defer txc.resultCache.endTransaction(session.GetTransactionUUID())
+	if session.InTransaction() && session.isTxOpen() {
+		session.endTx(session.GetTransactionUUID(), txOutcomeRollback)
+	}
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
-	if session.LockSession != nil {
-		allsessions = append(allsessions, session.LockSession)
-	}
+	allsessions = append(allsessions, session.LockShardSessions()...)
 
 	return txc.runSessions(ctx, allsessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
 		if s.ReservedId == 0 && s.TransactionId == 0 {
@@ -346,6 +419,134 @@ func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	return nil
 }
 
+// ReadTransactions returns the unresolved distributed transactions
+// coordinated by the given primaries, with the state of each of their
+// participants. If dtid is set, only that transaction is read, from the
+// primary that coordinates it.
+func (txc *TxConn) ReadTransactions(ctx context.Context, primaries []*querypb.Target, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	if dtid != "" {
+		mmShard, err := dtids.ShardSession(dtid)
+		if err != nil {
+			return nil, err
+		}
+		primaries = []*querypb.Target{mmShard.Target}
+	}
+
+	var (
+		mu           sync.Mutex
+		transactions []*querypb.TransactionMetadata
+		// prepared has the transactions prepared on each shard, by dtid.
+		prepared = make(map[string]map[string]*querypb.PreparedTransaction)
+	)
+	readShard := func(target *querypb.Target) error {
+		shardTransactions, shardPrepared, err := txc.gateway.UnresolvedTransactions(ctx, target)
+		if err != nil {
+			return err
+		}
+		byDtid := make(map[string]*querypb.PreparedTransaction, len(shardPrepared))
+		for _, ptx := range shardPrepared {
+			byDtid[ptx.Dtid] = ptx
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, transaction := range shardTransactions {
+			if dtid == "" || transaction.Dtid == dtid {
+				transactions = append(transactions, transaction)
+			}
+		}
+		prepared[topoproto.KeyspaceShardString(target.Keyspace, target.Shard)] = byDtid
+		return nil
+	}
+	if err := txc.runTargets(primaries, readShard); err != nil {
+		return nil, err
+	}
+
+	// Participants outside of the requested primaries are read too. A
+	// participant that cannot be read shows up in the UNKNOWN state.
+	var participants []*querypb.Target
+	for _, transaction := range transactions {
+		for _, participant := range transaction.Participants {
+			key := topoproto.KeyspaceShardString(participant.Keyspace, participant.Shard)
+			if _, ok := prepared[key]; !ok {
+				prepared[key] = nil
+				participants = append(participants, participant)
+			}
+		}
+	}
+	_ = txc.runTargets(participants, func(target *querypb.Target) error {
+		if err := readShard(target); err != nil {
+			log.Warningf("Could not read the prepared transactions of %v: %v", target, err)
+		}
+		return nil
+	})
+
+	sort.Slice(transactions, func(i, j int) bool {
+		return transactions[i].TimeCreated < transactions[j].TimeCreated
+	})
+	result := make([]*vtgatepb.DistributedTransaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		dtx := &vtgatepb.DistributedTransaction{
+			Dtid:        transaction.Dtid,
+			State:       transaction.State,
+			TimeCreated: transaction.TimeCreated,
+		}
+		for _, participant := range transaction.Participants {
+			state := vtgatepb.TransactionParticipant_UNKNOWN
+			if byDtid := prepared[topoproto.KeyspaceShardString(participant.Keyspace, participant.Shard)]; byDtid != nil {
+				switch ptx, ok := byDtid[transaction.Dtid]; {
+				case !ok:
+					state = vtgatepb.TransactionParticipant_NOT_PREPARED
+				case ptx.Failed:
+					state = vtgatepb.TransactionParticipant_FAILED
+				default:
+					state = vtgatepb.TransactionParticipant_PREPARED
+				}
+			}
+			dtx.Participants = append(dtx.Participants, &vtgatepb.TransactionParticipant{
+				Target: participant,
+				State:  state,
+			})
+		}
+		result = append(result, dtx)
+	}
+	return result, nil
+}
+
+// ForceResolve resolves the specified 2PC transaction with the decision
+// of an operator. A transaction can only be committed if the decision to
+// commit was recorded, and it cannot be rolled back after that.
+func (txc *TxConn) ForceResolve(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	if decision != querypb.TransactionState_COMMIT && decision != querypb.TransactionState_ROLLBACK {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid decision for distributed transaction %s: %v", dtid, decision)
+	}
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := txc.gateway.ReadTransaction(ctx, mmShard.Target, dtid)
+	if err != nil {
+		return err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "distributed transaction %s not found, it may already be resolved", dtid)
+	}
+	log.Infof("Resolving distributed transaction %s in state %v: %v", dtid, transaction.State, decision)
+	switch {
+	case decision == querypb.TransactionState_COMMIT && transaction.State == querypb.TransactionState_COMMIT:
+		return txc.resumeCommit(ctx, mmShard.Target, transaction)
+	case decision == querypb.TransactionState_ROLLBACK && transaction.State == querypb.TransactionState_PREPARE:
+		if err := txc.gateway.SetRollback(ctx, mmShard.Target, transaction.Dtid, mmShard.TransactionId); err != nil {
+			return err
+		}
+		return txc.resumeRollback(ctx, mmShard.Target, transaction)
+	case decision == querypb.TransactionState_ROLLBACK && transaction.State == querypb.TransactionState_ROLLBACK:
+		return txc.resumeRollback(ctx, mmShard.Target, transaction)
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot %v distributed transaction %s in state %v", strings.ToLower(decision.String()), dtid, transaction.State)
+	}
+}
+
 func (txc *TxConn) resumeRollback(ctx context.Context, target *querypb.Target, transaction *querypb.TransactionMetadata) error {
 	err := txc.runTargets(transaction.Participants, func(t *querypb.Target) error {
 		return txc.gateway.RollbackPrepared(ctx, t, transaction.Dtid, 0)
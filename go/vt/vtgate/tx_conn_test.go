@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/dtids"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -50,16 +52,22 @@ func TestTxConnBegin(t *testing.T) {
 	safeSession := NewSafeSession(session)
 	err := sc.txConn.Begin(ctx, safeSession)
 	require.NoError(t, err)
-	wantSession := vtgatepb.Session{InTransaction: true}
+	require.NotEmpty(t, session.TransactionUuid)
+	wantSession := vtgatepb.Session{InTransaction: true, TransactionUuid: session.TransactionUuid}
 	utils.MustMatch(t, &wantSession, session, "Session")
 	_, errors := sc.ExecuteMultiShard(ctx, rss0, queries, safeSession, false, false)
 	require.Empty(t, errors)
 
 	// Begin again should cause a commit and a new begin.
+	transactionID := session.TransactionUuid
 	require.NoError(t,
 		sc.txConn.Begin(ctx, safeSession))
+	assert.NotEqual(t, transactionID, session.TransactionUuid)
+	wantSession = vtgatepb.Session{InTransaction: true, TransactionUuid: session.TransactionUuid}
 	utils.MustMatch(t, &wantSession, session, "Session")
 	assert.EqualValues(t, 1, sbc0.CommitCount.Get(), "sbc0.CommitCount")
+	assert.Equal(t, &txOutcome{transactionID: transactionID, outcome: txOutcomeCommit}, safeSession.takeEndedTx())
+	assert.Nil(t, safeSession.takeEndedTx())
 }
 
 func TestTxConnCommitSuccess(t *testing.T) {
@@ -381,6 +389,51 @@ func TestTxConnReservedOn2ShardTxOn1ShardAndRollback(t *testing.T) {
 	assert.EqualValues(t, 0, sbc1.RollbackCount.Get(), "sbc1.RollbackCount")
 }
 
+func TestTxConnReplicaTransaction(t *testing.T) {
+	createSandbox("TestTxConn")
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, "TestTxConn", "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, "TestTxConn", "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	rss01, err := res.ResolveDestination(ctx, "TestTxConn", topodatapb.TabletType_REPLICA, key.DestinationShards([]string{"0", "1"}))
+	require.NoError(t, err)
+
+	rss0, err := res.ResolveDestination(ctx, "TestTxConn", topodatapb.TabletType_REPLICA, key.DestinationShard("0"))
+	require.NoError(t, err)
+
+	// A read-only transaction takes a consistent snapshot of its shard.
+	sc.txConn.mode = vtgatepb.TransactionMode_TWOPC
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestTxConn@replica", InTransaction: true})
+	assert.True(t, session.InReplicaTransaction())
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	require.Empty(t, errs)
+	require.Len(t, session.ShardSessions, 1)
+	require.Len(t, sbc0.Options, 1)
+	assert.Equal(t, querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY, sbc0.Options[0].TransactionIsolation)
+
+	// The next queries run in the transaction that began.
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	require.Empty(t, errs)
+	assert.Nil(t, sbc0.Options[1])
+	assert.EqualValues(t, 1, sbc0.BeginCount.Get(), "sbc0.BeginCount")
+
+	// There is nothing to prepare in 2pc mode.
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.EqualValues(t, 1, sbc0.CommitCount.Get(), "sbc0.CommitCount")
+	assert.EqualValues(t, 0, sbc0.PrepareCount.Get(), "sbc0.PrepareCount")
+	assert.False(t, session.InTransaction())
+
+	// The snapshots of several shards are not consistent, whatever the mode.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "TestTxConn@replica", InTransaction: true})
+	_, errs = sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "multi-db read-only transaction attempted on replicas")
+	assert.False(t, session.InTransaction())
+	assert.EqualValues(t, 1, sbc0.RollbackCount.Get(), "sbc0.RollbackCount")
+	assert.EqualValues(t, 1, sbc1.RollbackCount.Get(), "sbc1.RollbackCount")
+}
+
 func TestTxConnCommitOrderFailure1(t *testing.T) {
 	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
@@ -719,10 +772,11 @@ func TestTxConnReservedCommitOrderSuccess(t *testing.T) {
 func TestTxConnCommit2PC(t *testing.T) {
 	sc, sbc0, sbc1, rss0, _, rss01 := newTestTxConnEnv(t, "TestTxConnCommit2PC")
 
-	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, TransactionUuid: "tx1"})
 	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
 	sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
 	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
+	dtid := dtids.New(session.ShardSessions[0])
 	require.NoError(t,
 		sc.txConn.Commit(ctx, session))
 	assert.EqualValues(t, 1, sbc0.CreateTransactionCount.Get(), "sbc0.CreateTransactionCount")
@@ -730,6 +784,8 @@ func TestTxConnCommit2PC(t *testing.T) {
 	assert.EqualValues(t, 1, sbc0.StartCommitCount.Get(), "sbc0.StartCommitCount")
 	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+	assert.Equal(t, &txOutcome{transactionID: "tx1", outcome: txOutcomeCommit, dtid: dtid}, session.takeEndedTx())
+	assert.Empty(t, session.TransactionUuid)
 }
 
 func TestTxConnCommit2PCOneParticipant(t *testing.T) {
@@ -745,13 +801,15 @@ func TestTxConnCommit2PCOneParticipant(t *testing.T) {
 func TestTxConnCommit2PCCreateTransactionFail(t *testing.T) {
 	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConnCommit2PCCreateTransactionFail")
 
-	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, TransactionUuid: "tx1"})
 	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
 	sc.ExecuteMultiShard(ctx, rss1, queries, session, false, false)
 
 	sbc0.MustFailCreateTransaction = 1
 	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
+	dtid := dtids.New(session.ShardSessions[0])
 	err := sc.txConn.Commit(ctx, session)
+	assert.Equal(t, &txOutcome{transactionID: "tx1", outcome: txOutcomeCommitFailed, dtid: dtid}, session.takeEndedTx())
 	want := "error: err"
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), want, "Commit")
@@ -1113,6 +1171,147 @@ func TestTxConnResolveConcludeTransactionFail(t *testing.T) {
 	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
 }
 
+func TestTxConnReadTransactions(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
+
+	participants := []*querypb.Target{{
+		Keyspace:   "TestTxConn",
+		Shard:      "1",
+		TabletType: topodatapb.TabletType_PRIMARY,
+	}}
+	sbc0.UnresolvedTransactionsResult = []*querypb.TransactionMetadata{{
+		Dtid:         "TestTxConn:0:1235",
+		State:        querypb.TransactionState_COMMIT,
+		TimeCreated:  2,
+		Participants: participants,
+	}, {
+		Dtid:         "TestTxConn:0:1234",
+		State:        querypb.TransactionState_PREPARE,
+		TimeCreated:  1,
+		Participants: participants,
+	}}
+	sbc1.PreparedTransactionsResult = []*querypb.PreparedTransaction{{
+		Dtid:        "TestTxConn:0:1234",
+		TimeCreated: 1,
+	}}
+	transactions, err := sc.txConn.ReadTransactions(ctx, []*querypb.Target{rss0[0].Target, rss1[0].Target}, "")
+	require.NoError(t, err)
+	want := []*vtgatepb.DistributedTransaction{{
+		Dtid:        "TestTxConn:0:1234",
+		State:       querypb.TransactionState_PREPARE,
+		TimeCreated: 1,
+		Participants: []*vtgatepb.TransactionParticipant{{
+			Target: participants[0],
+			State:  vtgatepb.TransactionParticipant_PREPARED,
+		}},
+	}, {
+		Dtid:        "TestTxConn:0:1235",
+		State:       querypb.TransactionState_COMMIT,
+		TimeCreated: 2,
+		Participants: []*vtgatepb.TransactionParticipant{{
+			Target: participants[0],
+			State:  vtgatepb.TransactionParticipant_NOT_PREPARED,
+		}},
+	}}
+	utils.MustMatch(t, want, transactions, "ReadTransactions")
+
+	// A dtid is only read from its coordinator and its participants.
+	sbc1.PreparedTransactionsResult[0].Failed = true
+	transactions, err = sc.txConn.ReadTransactions(ctx, nil, "TestTxConn:0:1234")
+	require.NoError(t, err)
+	want = want[:1]
+	want[0].Participants[0].State = vtgatepb.TransactionParticipant_FAILED
+	utils.MustMatch(t, want, transactions, "ReadTransactions")
+	assert.EqualValues(t, 2, sbc0.UnresolvedTransactionsCount.Get(), "sbc0.UnresolvedTransactionsCount")
+	assert.EqualValues(t, 2, sbc1.UnresolvedTransactionsCount.Get(), "sbc1.UnresolvedTransactionsCount")
+
+	// A participant that cannot be read is in the UNKNOWN state.
+	sbc1.MustFailCodes[vtrpcpb.Code_INTERNAL] = 1
+	transactions, err = sc.txConn.ReadTransactions(ctx, nil, "TestTxConn:0:1234")
+	require.NoError(t, err)
+	want[0].Participants[0].State = vtgatepb.TransactionParticipant_UNKNOWN
+	utils.MustMatch(t, want, transactions, "ReadTransactions")
+
+	// But the coordinator must be read.
+	sbc0.MustFailCodes[vtrpcpb.Code_INTERNAL] = 1
+	_, err = sc.txConn.ReadTransactions(ctx, nil, "TestTxConn:0:1234")
+	require.Error(t, err)
+}
+
+func TestTxConnForceResolve(t *testing.T) {
+	dtid := "TestTxConn:0:1234"
+	participants := []*querypb.Target{{
+		Keyspace:   "TestTxConn",
+		Shard:      "1",
+		TabletType: topodatapb.TabletType_PRIMARY,
+	}}
+	testcases := []struct {
+		state    querypb.TransactionState
+		decision querypb.TransactionState
+		wantErr  string
+
+		setRollback, rollbackPrepared, commitPrepared int64
+	}{{
+		state:            querypb.TransactionState_PREPARE,
+		decision:         querypb.TransactionState_ROLLBACK,
+		setRollback:      1,
+		rollbackPrepared: 1,
+	}, {
+		state:            querypb.TransactionState_ROLLBACK,
+		decision:         querypb.TransactionState_ROLLBACK,
+		rollbackPrepared: 1,
+	}, {
+		state:          querypb.TransactionState_COMMIT,
+		decision:       querypb.TransactionState_COMMIT,
+		commitPrepared: 1,
+	}, {
+		state:    querypb.TransactionState_PREPARE,
+		decision: querypb.TransactionState_COMMIT,
+		wantErr:  "cannot commit distributed transaction TestTxConn:0:1234 in state PREPARE",
+	}, {
+		state:    querypb.TransactionState_ROLLBACK,
+		decision: querypb.TransactionState_COMMIT,
+		wantErr:  "cannot commit distributed transaction TestTxConn:0:1234 in state ROLLBACK",
+	}, {
+		state:    querypb.TransactionState_COMMIT,
+		decision: querypb.TransactionState_ROLLBACK,
+		wantErr:  "cannot rollback distributed transaction TestTxConn:0:1234 in state COMMIT",
+	}}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%v/%v", tc.state, tc.decision), func(t *testing.T) {
+			sc, sbc0, sbc1, _, _, _ := newTestTxConnEnv(t, "TestTxConn")
+			sbc0.ReadTransactionResults = []*querypb.TransactionMetadata{{
+				Dtid:         dtid,
+				State:        tc.state,
+				Participants: participants,
+			}}
+			err := sc.txConn.ForceResolve(ctx, dtid, tc.decision)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+				assert.EqualValues(t, 0, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+			} else {
+				require.NoError(t, err)
+				assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+			}
+			assert.EqualValues(t, tc.setRollback, sbc0.SetRollbackCount.Get(), "sbc0.SetRollbackCount")
+			assert.EqualValues(t, tc.rollbackPrepared, sbc1.RollbackPreparedCount.Get(), "sbc1.RollbackPreparedCount")
+			assert.EqualValues(t, tc.commitPrepared, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
+		})
+	}
+}
+
+func TestTxConnForceResolveNotFound(t *testing.T) {
+	sc, _, _, _, _, _ := newTestTxConnEnv(t, "TestTxConn")
+
+	err := sc.txConn.ForceResolve(ctx, "TestTxConn:0:1234", querypb.TransactionState_ROLLBACK)
+	require.EqualError(t, err, "distributed transaction TestTxConn:0:1234 not found, it may already be resolved")
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+
+	err = sc.txConn.ForceResolve(ctx, "TestTxConn:0:1234", querypb.TransactionState_PREPARE)
+	require.EqualError(t, err, "invalid decision for distributed transaction TestTxConn:0:1234: PREPARE")
+}
+
 func TestTxConnMultiGoSessions(t *testing.T) {
 	txc := &TxConn{}
 
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/featureflags"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -51,6 +52,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -66,6 +68,9 @@ var _ vindexes.VCursor = (*vcursorImpl)(nil)
 type iExecute interface {
 	Execute(ctx context.Context, method string, session *SafeSession, s string, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
 	ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool, ignoreMaxMemoryRows bool) (qr *sqltypes.Result, errs []error)
+	WaitForThrottlers(ctx context.Context, app string, rss []*srvtopo.ResolvedShard) error
+	CheckCrossCellWrites(session *SafeSession, rss []*srvtopo.ResolvedShard) error
+	CheckIncidentMode(rss []*srvtopo.ResolvedShard) error
 	StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, autocommit bool, callback func(reply *sqltypes.Result) error) []error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
@@ -91,6 +96,7 @@ type vcursorImpl struct {
 	keyspace       string
 	tabletType     topodatapb.TabletType
 	destination    key.Destination
+	tenant         *vindexes.Tenant // the tenant of the database of the session, if any
 	marginComments sqlparser.MarginComments
 	executor       iExecute
 	resolver       *srvtopo.Resolver
@@ -99,7 +105,9 @@ type vcursorImpl struct {
 	collation      collations.ID
 
 	ignoreMaxMemoryRows bool
+	throttleApp         string // the app of the THROTTLE directive of the statement, if any
 	vschema             *vindexes.VSchema
+	routingKey          string // identifies the routing rule alternates in effect for this session
 	vm                  VSchemaOperator
 	semTable            *semantics.SemTable
 	warnShardedOnly     bool // when using sharded only features, a warning will be warnings field
@@ -123,10 +131,11 @@ func newVCursorImpl(
 	serv srvtopo.Server,
 	warnShardedOnly bool,
 ) (*vcursorImpl, error) {
-	keyspace, tabletType, destination, err := parseDestinationTarget(safeSession.TargetString, vschema)
+	keyspace, tabletType, destination, tenant, err := parseTenantTarget(safeSession.TargetString, vschema)
 	if err != nil {
 		return nil, err
 	}
+	vschema, routingKey := vschema.ForSession(safeSession.GetSessionUUID(), time.Now())
 
 	var ts *topo.Server
 	// We don't have access to the underlying TopoServer if this vtgate is
@@ -149,18 +158,24 @@ func newVCursorImpl(
 		connCollation = collations.Default()
 	}
 
+	if executor != nil {
+		marginComments = executor.queryTags.commentShardQueries(marginComments, safeSession.GetQueryTags())
+	}
+
 	return &vcursorImpl{
 		ctx:             ctx,
 		safeSession:     safeSession,
 		keyspace:        keyspace,
 		tabletType:      tabletType,
 		destination:     destination,
+		tenant:          tenant,
 		marginComments:  marginComments,
 		executor:        executor,
 		logStats:        logStats,
 		collation:       connCollation,
 		resolver:        resolver,
 		vschema:         vschema,
+		routingKey:      routingKey,
 		vm:              vm,
 		topoServer:      ts,
 		warnShardedOnly: warnShardedOnly,
@@ -189,13 +204,40 @@ func (vc *vcursorImpl) Context() context.Context {
 
 // MaxMemoryRows returns the maxMemoryRows flag value.
 func (vc *vcursorImpl) MaxMemoryRows() int {
-	return *maxMemoryRows
+	return maxMemoryRows.Get()
 }
 
 // ExceedsMaxMemoryRows returns a boolean indicating whether the maxMemoryRows value has been exceeded.
 // Returns false if the max memory rows override directive is set to true.
 func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
-	return !vc.ignoreMaxMemoryRows && numRows > *maxMemoryRows
+	return !vc.ignoreMaxMemoryRows && numRows > maxMemoryRows.Get()
+}
+
+// AggregationLimits returns the maximum number of groups, and their size in
+// bytes, that an aggregation may hold in memory. The max memory rows override
+// directive lifts them too.
+func (vc *vcursorImpl) AggregationLimits() (int, int64) {
+	if vc.ignoreMaxMemoryRows {
+		return 0, 0
+	}
+	return *maxAggregationGroups, *maxAggregationMemoryBytes
+}
+
+// MaxInClauseValues returns the maximum number of values of the IN clause
+// that a route sends to a shard in one query, or 0 if they are not batched.
+func (vc *vcursorImpl) MaxInClauseValues() int {
+	if executor, ok := vc.executor.(*Executor); ok {
+		return executor.inClauseLimit.batchSize()
+	}
+	return 0
+}
+
+// SchemaTrackingEnabled implements the VCursor interface.
+func (vc *vcursorImpl) SchemaTrackingEnabled() bool {
+	if executor, ok := vc.executor.(*Executor); ok {
+		return executor.schemaTracker != nil
+	}
+	return false
 }
 
 // SetIgnoreMaxMemoryRows sets the ignoreMaxMemoryRows value.
@@ -203,6 +245,12 @@ func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
 }
 
+// SetThrottleApp sets the app that the throttlers of the shards are checked
+// for before the queries of the statement are sent to them.
+func (vc *vcursorImpl) SetThrottleApp(app string) {
+	vc.throttleApp = app
+}
+
 // SetContextTimeout updates context and sets a timeout.
 func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	ctx, cancel := context.WithTimeout(vc.ctx, timeout)
@@ -242,6 +290,19 @@ func (vc *vcursorImpl) FindTable(name sqlparser.TableName) (*vindexes.Table, str
 	return table, destKeyspace, destTabletType, dest, err
 }
 
+// FindView finds the specified view. If the keyspace what specified in the input, it gets used as qualifier.
+// Otherwise, the keyspace from the request is used, if one was provided.
+func (vc *vcursorImpl) FindView(name sqlparser.TableName) sqlparser.SelectStatement {
+	destKeyspace, _, _, err := vc.executor.ParseDestinationTarget(name.Qualifier.String())
+	if err != nil {
+		return nil
+	}
+	if destKeyspace == "" {
+		destKeyspace = vc.keyspace
+	}
+	return vc.vschema.FindView(destKeyspace, name.Name.String())
+}
+
 func (vc *vcursorImpl) FindRoutedTable(name sqlparser.TableName) (*vindexes.Table, error) {
 	destKeyspace, destTabletType, _, err := vc.executor.ParseDestinationTarget(name.Qualifier.String())
 	if err != nil {
@@ -275,6 +336,28 @@ func (vc *vcursorImpl) FindTableOrVindex(name sqlparser.TableName) (*vindexes.Ta
 	return table, vindex, destKeyspace, destTabletType, dest, nil
 }
 
+// hasDualWrites returns true if the statement references a table whose
+// writes are dual-routed by the routing rules.
+func (vc *vcursorImpl) hasDualWrites(stmt sqlparser.Statement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		name, ok := node.(sqlparser.TableName)
+		if !ok || name.Name.IsEmpty() {
+			return !found, nil
+		}
+		destKeyspace, _, _, err := vc.executor.ParseDestinationTarget(name.Qualifier.String())
+		if err != nil {
+			return true, nil
+		}
+		if destKeyspace == "" {
+			destKeyspace = vc.getActualKeyspace()
+		}
+		found = found || vc.vschema.HasDualWrites(destKeyspace, name.Name.String(), vc.tabletType)
+		return !found, nil
+	}, stmt)
+	return found
+}
+
 func (vc *vcursorImpl) getActualKeyspace() string {
 	if !sqlparser.SystemSchema(vc.keyspace) {
 		return vc.keyspace
@@ -352,6 +435,11 @@ func (vc *vcursorImpl) SysVarSetEnabled() bool {
 	return vc.GetSessionEnableSystemSettings()
 }
 
+// ShardedReplaceEnabled implements the ContextVSchema interface
+func (vc *vcursorImpl) ShardedReplaceEnabled() bool {
+	return *enableShardedReplace
+}
+
 // KeyspaceExists provides whether the keyspace exists or not.
 func (vc *vcursorImpl) KeyspaceExists(ks string) bool {
 	return vc.vschema.Keyspaces[ks] != nil
@@ -375,6 +463,9 @@ func (vc *vcursorImpl) Planner() plancontext.PlannerVersion {
 		vc.safeSession.Options.PlannerVersion != querypb.ExecuteOptions_DEFAULT_PLANNER {
 		return vc.safeSession.Options.PlannerVersion
 	}
+	if version, ok := vc.featureFlagPlanner(); ok {
+		return version
+	}
 	version, done := plancontext.PlannerNameToVersion(*plannerVersion)
 	if done {
 		return version
@@ -384,6 +475,19 @@ func (vc *vcursorImpl) Planner() plancontext.PlannerVersion {
 	return planbuilder.V3
 }
 
+// featureFlagPlanner returns the planner that the gen4_planner feature flag
+// of the keyspace of the session selects, if it's set.
+func (vc *vcursorImpl) featureFlagPlanner() (plancontext.PlannerVersion, bool) {
+	gen4, ok := featureflags.Bool(vc.keyspace, featureflags.Gen4Planner)
+	if !ok {
+		return 0, false
+	}
+	if gen4 {
+		return planbuilder.Gen4, true
+	}
+	return planbuilder.V3, true
+}
+
 // GetSemTable implements the ContextVSchema interface
 func (vc *vcursorImpl) GetSemTable() *semantics.SemTable {
 	return vc.semTable
@@ -431,6 +535,13 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 	}
 
 	qr, err := vc.executor.Execute(vc.ctx, method, session, vc.marginComments.Leading+query+vc.marginComments.Trailing, bindVars)
+	if co == vtgatepb.CommitOrder_AUTOCOMMIT {
+		// The warnings of the writes of the lookup vindexes, such as the
+		// cross-cell writes, are reported to the session of the statement.
+		for _, warning := range session.GetWarnings() {
+			vc.safeSession.RecordWarning(warning)
+		}
+	}
 	return qr, err
 }
 
@@ -455,10 +566,22 @@ const txRollback = "Rollback Transaction"
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(queries)))
+	if err := vc.checkIncidentMode(rss); err != nil {
+		return nil, []error{err}
+	}
+	if vc.throttleApp != "" {
+		if err := vc.executor.WaitForThrottlers(vc.ctx, vc.throttleApp, rss); err != nil {
+			return nil, []error{err}
+		}
+	}
 	uID, err := vc.markSavepoint(rollbackOnError, map[string]*querypb.BindVariable{})
 	if err != nil {
 		return nil, []error{err}
 	}
+	// The check comes after the savepoint, whose execution clears the warnings of the session.
+	if err := vc.checkCrossCellWrites(rss); err != nil {
+		return nil, []error{err}
+	}
 
 	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, commentedShardQueries(queries, vc.marginComments), vc.safeSession, autocommit, vc.ignoreMaxMemoryRows)
 	vc.setRollbackOnPartialExecIfRequired(errs, rss, rollbackOnError, uID)
@@ -470,6 +593,32 @@ func (vc *vcursorImpl) InTransactionAndIsDML() bool {
 	if !vc.safeSession.InTransaction() {
 		return false
 	}
+	return vc.isDML()
+}
+
+// checkCrossCellWrites applies the cross-cell write policy to the queries
+// that a DML sends to the shards, with the session of the DML. The reads
+// are not checked.
+func (vc *vcursorImpl) checkCrossCellWrites(rss []*srvtopo.ResolvedShard) error {
+	if !vc.isDML() {
+		return nil
+	}
+	return vc.executor.CheckCrossCellWrites(vc.safeSession, rss)
+}
+
+// checkIncidentMode rejects the reads that scatter to too many shards while
+// vtgate is in incident mode. The DMLs, and the statements of a transaction
+// that is open on some shards, are not checked: rejecting them would fail a
+// transaction that may already hold locks, instead of shedding new load.
+func (vc *vcursorImpl) checkIncidentMode(rss []*srvtopo.ResolvedShard) error {
+	if vc.isDML() || vc.safeSession.isTxOpen() {
+		return nil
+	}
+	return vc.executor.CheckIncidentMode(rss)
+}
+
+// isDML returns true if the statement of the vcursor writes rows.
+func (vc *vcursorImpl) isDML() bool {
 	switch vc.logStats.StmtType {
 	case "INSERT", "REPLACE", "UPDATE", "DELETE":
 		return true
@@ -485,6 +634,16 @@ func (vc *vcursorImpl) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	return vtgatepb.CommitOrder_PRE
 }
 
+// LockSessionShards implements the VCursor interface.
+func (vc *vcursorImpl) LockSessionShards() []*srvtopo.ResolvedShard {
+	shardSessions := vc.safeSession.LockShardSessions()
+	rss := make([]*srvtopo.ResolvedShard, 0, len(shardSessions))
+	for _, shardSession := range shardSessions {
+		rss = append(rss, &srvtopo.ResolvedShard{Target: shardSession.Target, Gateway: vc.resolver.GetGateway()})
+	}
+	return rss
+}
+
 func (vc *vcursorImpl) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
 	query.Sql = vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing
 	return vc.executor.ExecuteLock(vc.ctx, rs, query, vc.safeSession)
@@ -495,9 +654,19 @@ func (vc *vcursorImpl) AutocommitApproval() bool {
 	return vc.safeSession.AutocommitApproval()
 }
 
+// DisableAutocommit is part of the engine.VCursor interface.
+func (vc *vcursorImpl) DisableAutocommit() {
+	vc.safeSession.SetAutocommittable(false)
+}
+
 // ExecuteStandalone is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	rss := []*srvtopo.ResolvedShard{rs}
+	// The queries of a DML that run outside of its transaction, such as the
+	// reservation of sequence values, still write to the primary.
+	if err := vc.checkCrossCellWrites(rss); err != nil {
+		return nil, err
+	}
 	bqs := []*querypb.BoundQuery{
 		{
 			Sql:           vc.marginComments.Leading + query + vc.marginComments.Trailing,
@@ -506,13 +675,33 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	}
 	// The autocommit flag is always set to false because we currently don't
 	// execute DMLs through ExecuteStandalone.
-	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, bqs, NewAutocommitSession(vc.safeSession.Session), false /* autocommit */, vc.ignoreMaxMemoryRows)
+	session := NewAutocommitSession(vc.safeSession.Session)
+	// The results of the standalone queries are held with the ones of the statement.
+	session.resultMemory = vc.safeSession.resultMemory
+	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, bqs, session, false /* autocommit */, vc.ignoreMaxMemoryRows)
 	return qr, vterrors.Aggregate(errs)
 }
 
+// NextSequenceValues implements the VCursor interface.
+func (vc *vcursorImpl) NextSequenceValues(gen *engine.Generate, rs *srvtopo.ResolvedShard, count int64) (int64, error) {
+	if executor, ok := vc.executor.(*Executor); ok {
+		return executor.sequences.next(vc.ctx, gen, rs, count)
+	}
+	qr, err := vc.ExecuteStandalone(gen.Query, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}, rs)
+	if err != nil {
+		return 0, err
+	}
+	// If no rows are returned, it's an internal error, and the code
+	// must panic, which will be caught and reported.
+	return evalengine.ToInt64(qr.Rows[0][0])
+}
+
 // StreamExecuteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
+	if err := vc.checkIncidentMode(rss); err != nil {
+		return []error{err}
+	}
 	uID, err := vc.markSavepoint(rollbackOnError, map[string]*querypb.BindVariable{})
 	if err != nil {
 		return []error{err}
@@ -549,6 +738,8 @@ func (vc *vcursorImpl) ExecuteKeyspaceID(keyspace string, ksid []byte, query str
 		Sql:           query,
 		BindVariables: bindVars,
 	}}
+	// ExecuteMultiShard applies the cross-cell write policy to the queries
+	// of the DMLs, such as the locks of the owner rows of the lookup vindexes.
 	qr, errs := vc.ExecuteMultiShard(rss, queries, rollbackOnError, autocommit)
 
 	return qr, vterrors.Aggregate(errs)
@@ -567,7 +758,11 @@ func (vc *vcursorImpl) Session() engine.SessionActions {
 }
 
 func (vc *vcursorImpl) SetTarget(target string) error {
-	keyspace, tabletType, _, err := topoprotopb.ParseDestination(target, defaultTabletType)
+	keyspace, tabletType, dest, err := topoprotopb.ParseDestination(target, defaultTabletType)
+	if err != nil {
+		return err
+	}
+	keyspace, _, err = resolveDatabase(keyspace, dest, vc.vschema)
 	if err != nil {
 		return err
 	}
@@ -575,7 +770,9 @@ func (vc *vcursorImpl) SetTarget(target string) error {
 		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "unknown database '%s'", keyspace)
 	}
 
-	if vc.safeSession.InTransaction() && tabletType != topodatapb.TabletType_PRIMARY {
+	// A transaction runs on the tablet type it began on: the primary, or the
+	// replicas for a read-only transaction.
+	if vc.safeSession.InTransaction() && tabletType != vc.safeSession.transactionTabletType() {
 		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.LockOrActiveTransaction, "can't execute the given command because you have an active transaction")
 	}
 	vc.safeSession.SetTargetString(target)
@@ -650,8 +847,13 @@ func commentedShardQueries(shardQueries []*querypb.BoundQuery, marginComments sq
 // TargetDestination implements the ContextVSchema interface
 func (vc *vcursorImpl) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	keyspaceName := vc.keyspace
+	destination := vc.destination
 	if vc.destination == nil && qualifier != "" {
-		keyspaceName = qualifier
+		var err error
+		keyspaceName, _, err = resolveDatabase(qualifier, nil, vc.vschema)
+		if err != nil {
+			return nil, nil, 0, err
+		}
 	}
 	if keyspaceName == "" {
 		return nil, nil, 0, errNoKeyspace
@@ -660,7 +862,7 @@ func (vc *vcursorImpl) TargetDestination(qualifier string) (key.Destination, *vi
 	if keyspace == nil {
 		return nil, nil, 0, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s' in vschema", keyspaceName)
 	}
-	return vc.destination, keyspace.Keyspace, vc.tabletType, nil
+	return destination, keyspace.Keyspace, vc.tabletType, nil
 }
 
 // SetAutocommit implements the SessionActions interface
@@ -676,7 +878,13 @@ func (vc *vcursorImpl) SetAutocommit(autocommit bool) error {
 
 // SetClientFoundRows implements the SessionActions interface
 func (vc *vcursorImpl) SetClientFoundRows(clientFoundRows bool) error {
-	vc.safeSession.GetOrCreateOptions().ClientFoundRows = clientFoundRows
+	options := vc.safeSession.GetOrCreateOptions()
+	// The connections to the shards keep the semantics they were opened with,
+	// which would mix with the new ones in the affected rows of a statement.
+	if options.ClientFoundRows != clientFoundRows && vc.safeSession.isTxOpen() {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "client_found_rows can't change while the session has connections to the shards, in a transaction or with reserved connections")
+	}
+	options.ClientFoundRows = clientFoundRows
 	return nil
 }
 
@@ -686,6 +894,12 @@ func (vc *vcursorImpl) SetSkipQueryPlanCache(skipQueryPlanCache bool) error {
 	return nil
 }
 
+// SetSkipQueryConsolidation implements the SessionActions interface
+func (vc *vcursorImpl) SetSkipQueryConsolidation(skipQueryConsolidation bool) error {
+	vc.safeSession.GetOrCreateOptions().SkipQueryConsolidation = skipQueryConsolidation
+	return nil
+}
+
 // SetSkipQueryPlanCache implements the SessionActions interface
 func (vc *vcursorImpl) SetSQLSelectLimit(limit int64) error {
 	vc.safeSession.GetOrCreateOptions().SqlSelectLimit = limit
@@ -759,11 +973,59 @@ func (vc *vcursorImpl) SetSessionTrackGTIDs(enable bool) {
 	vc.safeSession.SetSessionTrackGtids(enable)
 }
 
+// SetReadYourWrites implements the SessionActions interface
+func (vc *vcursorImpl) SetReadYourWrites(enable bool) error {
+	vc.safeSession.SetReadYourWrites(enable)
+	return nil
+}
+
+// SetReadAtTimestamp implements the SessionActions interface
+func (vc *vcursorImpl) SetReadAtTimestamp(timestamp string) error {
+	readAt, err := parseReadAtTimestamp(timestamp)
+	if err != nil {
+		return err
+	}
+	vc.safeSession.SetReadAtTimestamp(readAt)
+	return nil
+}
+
+// SetIncludeReplicationLag implements the SessionActions interface
+func (vc *vcursorImpl) SetIncludeReplicationLag(include bool) error {
+	vc.safeSession.GetOrCreateOptions().IncludeReplicationLag = include
+	return nil
+}
+
+// SetQueryTags implements the SessionActions interface
+func (vc *vcursorImpl) SetQueryTags(tags string) error {
+	normalized, err := normalizeQueryTags(tags)
+	if err != nil {
+		return err
+	}
+	vc.safeSession.SetQueryTags(normalized)
+	return nil
+}
+
+// SetAllowCrossCellWrites implements the SessionActions interface
+func (vc *vcursorImpl) SetAllowCrossCellWrites(allow bool) error {
+	vc.safeSession.SetAllowCrossCellWrites(allow)
+	return nil
+}
+
 // HasCreatedTempTable implements the SessionActions interface
 func (vc *vcursorImpl) HasCreatedTempTable() {
 	vc.safeSession.GetOrCreateOptions().HasCreatedTempTables = true
 }
 
+// AddTempTable implements the SessionActions interface
+func (vc *vcursorImpl) AddTempTable(keyspace, table string) {
+	vc.safeSession.AddTempTable(keyspace, table)
+}
+
+// RemoveTempTable implements the SessionActions interface
+func (vc *vcursorImpl) RemoveTempTable(keyspace, table string) {
+	vc.safeSession.RemoveTempTable(keyspace, table)
+}
+
 // GetWarnings implements the SessionActions interface
 func (vc *vcursorImpl) GetWarnings() []*querypb.QueryWarning {
 	return vc.safeSession.GetWarnings()
@@ -819,19 +1081,72 @@ func (vc *vcursorImpl) ForeignKeyMode() string {
 	return strings.ToLower(*foreignKeyMode)
 }
 
-// ParseDestinationTarget parses destination target string and sets default keyspace if possible.
+// ParseDestinationTarget parses destination target string, maps its database to a keyspace
+// and sets default keyspace if possible.
 func parseDestinationTarget(targetString string, vschema *vindexes.VSchema) (string, topodatapb.TabletType, key.Destination, error) {
+	destKeyspace, destTabletType, dest, _, err := parseTenantTarget(targetString, vschema)
+	return destKeyspace, destTabletType, dest, err
+}
+
+// parseTenantTarget is parseDestinationTarget that also returns the tenant
+// of the database of the target, if it is a tenant database.
+func parseTenantTarget(targetString string, vschema *vindexes.VSchema) (string, topodatapb.TabletType, key.Destination, *vindexes.Tenant, error) {
+	var tenant *vindexes.Tenant
 	destKeyspace, destTabletType, dest, err := topoprotopb.ParseDestination(targetString, defaultTabletType)
+	if err == nil {
+		destKeyspace, tenant, err = resolveDatabase(destKeyspace, dest, vschema)
+	}
 	// Set default keyspace
 	if destKeyspace == "" && len(vschema.Keyspaces) == 1 {
 		for k := range vschema.Keyspaces {
 			destKeyspace = k
 		}
 	}
-	return destKeyspace, destTabletType, dest, err
+	return destKeyspace, destTabletType, dest, tenant, err
+}
+
+// resolveDatabase returns the keyspace that a database maps to in the vschema,
+// or the database itself if it is a keyspace or maps to none, and the tenant
+// of a tenant database. The queries of a tenant database are planned with its
+// tenant (see scopeToTenants), so it can't be combined with a destination.
+func resolveDatabase(database string, dest key.Destination, vschema *vindexes.VSchema) (string, *vindexes.Tenant, error) {
+	if _, ok := vschema.Keyspaces[database]; ok || ignoreKeyspace(database) {
+		return database, nil, nil
+	}
+	keyspace, tenant, err := vschema.FindDatabase(database)
+	if err != nil || keyspace == nil {
+		return database, nil, err
+	}
+	if tenant != nil && dest != nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the tenant database %s can't be combined with a shard or keyspace id", database)
+	}
+	return keyspace.Name, tenant, nil
+}
+
+// tenantDatabase returns the tenant database of the session, or "". The
+// plans of the tenants are the same, but the predicate on the tenant is a
+// bind variable of the query.
+func (vc *vcursorImpl) tenantDatabase() string {
+	if vc.tenant == nil {
+		return ""
+	}
+	return vc.tenant.Database
 }
 
 func (vc *vcursorImpl) planPrefixKey() string {
+	prefix := vc.destinationPrefixKey()
+	if vc.routingKey != "" {
+		prefix += "|routed(" + vc.routingKey + ")"
+	}
+	// The plans built while a feature flag selected the planner must not be
+	// reused when it's changed.
+	if version, ok := vc.featureFlagPlanner(); ok {
+		prefix += "|planner(" + version.String() + ")"
+	}
+	return prefix
+}
+
+func (vc *vcursorImpl) destinationPrefixKey() string {
 	if vc.destination != nil {
 		switch vc.destination.(type) {
 		case key.DestinationKeyspaceID, key.DestinationKeyspaceIDs:
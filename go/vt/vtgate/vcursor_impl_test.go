@@ -218,9 +218,10 @@ var vschemaWith2KS = &vindexes.VSchema{
 
 func TestSetTarget(t *testing.T) {
 	type testCase struct {
-		vschema       *vindexes.VSchema
-		targetString  string
-		expectedError string
+		vschema        *vindexes.VSchema
+		txTargetString string
+		targetString   string
+		expectedError  string
 	}
 
 	tests := []testCase{{
@@ -240,11 +241,20 @@ func TestSetTarget(t *testing.T) {
 		vschema:       vschemaWith2KS,
 		targetString:  "ks2@replica",
 		expectedError: "can't execute the given command because you have an active transaction",
+	}, {
+		vschema:        vschemaWith2KS,
+		txTargetString: "ks1@replica",
+		targetString:   "ks2@replica",
+	}, {
+		vschema:        vschemaWith2KS,
+		txTargetString: "ks1@replica",
+		targetString:   "ks2",
+		expectedError:  "can't execute the given command because you have an active transaction",
 	}}
 
 	for i, tc := range tests {
 		t.Run(fmt.Sprintf("%d#%s", i, tc.targetString), func(t *testing.T) {
-			vc, _ := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{InTransaction: true}), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: tc.vschema}, tc.vschema, nil, nil, false)
+			vc, _ := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{TargetString: tc.txTargetString, InTransaction: true}), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: tc.vschema}, tc.vschema, nil, nil, false)
 			vc.vschema = tc.vschema
 			err := vc.SetTarget(tc.targetString)
 			if tc.expectedError == "" {
@@ -294,6 +304,32 @@ func TestPlanPrefixKey(t *testing.T) {
 	}
 }
 
+func TestPlanPrefixKeyRoutingAlternate(t *testing.T) {
+	vschema := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable: "t1",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks2.t1"},
+				},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+			"ks2": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+		},
+	})
+	ss := NewSafeSession(&vtgatepb.Session{TargetString: "ks1"})
+	vc, err := newVCursorImpl(context.Background(), ss, sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vschema}, vschema, srvtopo.NewResolver(&fakeTopoServer{}, nil, ""), nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "ks1@primary|routed(t1)", vc.planPrefixKey())
+
+	table, err := vc.vschema.FindRoutedTable("", "t1", topodatapb.TabletType_PRIMARY)
+	require.NoError(t, err)
+	require.Equal(t, "ks2", table.Keyspace.Name)
+}
+
 func TestFirstSortedKeyspace(t *testing.T) {
 	ks1Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "xks1"}}
 	ks2Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "aks2"}}
@@ -138,6 +138,18 @@ func (cached *ConsistentLookup) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *ConsistentLookupMultiCol) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field clCommon *vitess.io/vitess/go/vt/vtgate/vindexes.clCommon
+	size += cached.clCommon.CachedSize(true)
+	return size
+}
 func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -150,6 +162,18 @@ func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *GeoHash) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
 func (cached *Hash) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -180,7 +204,7 @@ func (cached *LookupHash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -194,7 +218,7 @@ func (cached *LookupHashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -208,7 +232,7 @@ func (cached *LookupNonUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -222,7 +246,7 @@ func (cached *LookupUnicodeLooseMD5Hash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -236,7 +260,7 @@ func (cached *LookupUnicodeLooseMD5HashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -250,7 +274,7 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -450,6 +474,20 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *TimeBucket) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field bucket string
+	size += hack.RuntimeAllocSize(int64(len(cached.bucket)))
+	return size
+}
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -492,7 +530,7 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(256)
+		size += int64(416)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -525,7 +563,7 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(256)
 	}
 	// field Table string
 	size += hack.RuntimeAllocSize(int64(len(cached.Table)))
@@ -538,12 +576,31 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	// field To string
 	size += hack.RuntimeAllocSize(int64(len(cached.To)))
+	// field AsyncQueue string
+	size += hack.RuntimeAllocSize(int64(len(cached.AsyncQueue)))
+	// field TombstoneColumn string
+	size += hack.RuntimeAllocSize(int64(len(cached.TombstoneColumn)))
+	// field Collations []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Collations)) * int64(16))
+		for _, elem := range cached.Collations {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field collationIDs []vitess.io/vitess/go/mysql/collations.ID
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.collationIDs)) * int64(2))
+	}
 	// field sel string
 	size += hack.RuntimeAllocSize(int64(len(cached.sel)))
 	// field ver string
 	size += hack.RuntimeAllocSize(int64(len(cached.ver)))
 	// field del string
 	size += hack.RuntimeAllocSize(int64(len(cached.del)))
+	// field tomb string
+	size += hack.RuntimeAllocSize(int64(len(cached.tomb)))
+	// field asyncVindex string
+	size += hack.RuntimeAllocSize(int64(len(cached.asyncVindex)))
 	return size
 }
 func (cached *prefixCFC) CachedSize(alloc bool) int64 {
@@ -22,7 +22,7 @@ import (
 	"fmt"
 	"strings"
 
-	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -41,11 +41,17 @@ var (
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
+	_ MultiColumn   = (*ConsistentLookupMultiCol)(nil)
+	_ Lookup        = (*ConsistentLookupMultiCol)(nil)
+	_ WantOwnerInfo = (*ConsistentLookupMultiCol)(nil)
+	_ LookupAsync   = (*ConsistentLookup)(nil)
+	_ LookupAsync   = (*ConsistentLookupMultiCol)(nil)
 )
 
 func init() {
 	Register("consistent_lookup", NewConsistentLookup)
 	Register("consistent_lookup_unique", NewConsistentLookupUnique)
+	Register("consistent_lookup_multicol", NewConsistentLookupMultiCol)
 }
 
 // ConsistentLookup is a non-unique lookup vindex that can stay
@@ -59,8 +65,17 @@ type ConsistentLookup struct {
 //   table: name of the backing table. It can be qualified by the keyspace.
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
+//
+// The following fields are optional, and are also supported by the other
+// consistent lookup vindexes:
+//   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   async_queue: the message table into which the writes are enqueued, for the vtgates to
+//     apply them asynchronously. The values that are not found fall back to a full scatter.
+//     It is not supported by consistent_lookup_unique.
+//   batch_size: the maximum number of values looked up by a query. The values of a Map are
+//     split into as many queries as needed, rather than sent in one IN clause.
 func NewConsistentLookup(name string, m map[string]string) (Vindex, error) {
-	clc, err := newCLCommon(name, m)
+	clc, err := newCLCommon(name, m, false /* unique */)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +121,7 @@ func (lu *ConsistentLookup) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.De
 	}
 	for _, result := range results {
 		if len(result.Rows) == 0 {
-			out = append(out, key.DestinationNone{})
+			out = append(out, lu.lkp.missDestination())
 			continue
 		}
 		ksids := make([][]byte, 0, len(result.Rows))
@@ -137,7 +152,7 @@ type ConsistentLookupUnique struct {
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
 func NewConsistentLookupUnique(name string, m map[string]string) (Vindex, error) {
-	clc, err := newCLCommon(name, m)
+	clc, err := newCLCommon(name, m, true /* unique */)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +199,7 @@ func (lu *ConsistentLookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]
 			}
 			out = append(out, key.DestinationKeyspaceID(rowBytes))
 		default:
-			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, ids[i])
+			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, obfuscation.Value(ids[i]))
 		}
 	}
 	return out, nil
@@ -192,6 +207,145 @@ func (lu *ConsistentLookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]
 
 //====================================================================
 
+// ConsistentLookupMultiCol is a non-unique consistent lookup vindex
+// whose lookup table is keyed by all its from columns. Unlike
+// ConsistentLookup, which only looks up the first from column, it's a
+// MultiColumn vindex that maps the values of all the from columns, or of
+// a prefix of them. For example, with a lookup table keyed by
+// (tenant_id, object_id), the queries by tenant_id alone only go to the
+// shards of the tenant.
+type ConsistentLookupMultiCol struct {
+	*clCommon
+}
+
+// NewConsistentLookupMultiCol creates a ConsistentLookupMultiCol vindex.
+// The supplied map has the following required fields:
+//   table: name of the backing table. It can be qualified by the keyspace.
+//   from: list of columns in the table that have the 'from' values of the lookup vindex.
+//   to: The 'to' column name of the table.
+func NewConsistentLookupMultiCol(name string, m map[string]string) (Vindex, error) {
+	clc, err := newCLCommon(name, m, false /* unique */)
+	if err != nil {
+		return nil, err
+	}
+	return &ConsistentLookupMultiCol{clCommon: clc}, nil
+}
+
+// Cost returns the cost of this vindex as 20.
+func (lu *ConsistentLookupMultiCol) Cost() int {
+	return 20
+}
+
+// IsUnique returns false since the Vindex is non unique.
+func (lu *ConsistentLookupMultiCol) IsUnique() bool {
+	return false
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (lu *ConsistentLookupMultiCol) NeedsVCursor() bool {
+	return true
+}
+
+// PartialVindex returns true since the values of a prefix of the from
+// columns can be mapped.
+func (lu *ConsistentLookupMultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. Every row has the values of all the from
+// columns, or of a prefix of them.
+func (lu *ConsistentLookupMultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		if lu.writeOnly || len(row) == 0 || (lu.lkp.IgnoreNulls && hasNull(row)) {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+			continue
+		}
+		if len(row) > len(lu.lkp.FromColumns) {
+			return nil, fmt.Errorf("lookup.Map: got %d values for the %d columns of vindex %s", len(row), len(lu.lkp.FromColumns), lu.name)
+		}
+		sel := lu.generateLookupPrefix(len(row))
+		if vcursor.InTransactionAndIsDML() {
+			sel = sel + " for update"
+		}
+		result, err := vcursor.Execute("VindexLookup", sel, lu.prefixBindVars(row), false /* rollbackOnError */, vcursor.LookupRowLockShardSession())
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Map: %v", err)
+		}
+		if len(result.Rows) == 0 {
+			out = append(out, lu.lkp.missDestination())
+			continue
+		}
+		ksids := make([][]byte, 0, len(result.Rows))
+		for _, row := range result.Rows {
+			rowBytes, err := row[0].ToBytes()
+			if err != nil {
+				return nil, err
+			}
+			ksids = append(ksids, rowBytes)
+		}
+		out = append(out, key.DestinationKeyspaceIDs(ksids))
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn. It returns true for every row whose
+// values map to the keyspace id.
+func (lu *ConsistentLookupMultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if lu.writeOnly {
+			out[i] = true
+			continue
+		}
+		if len(row) == 0 || len(row) > len(lu.lkp.FromColumns) {
+			return nil, fmt.Errorf("lookup.Verify: got %d values for the %d columns of vindex %s", len(row), len(lu.lkp.FromColumns), lu.name)
+		}
+		bindVars := lu.prefixBindVars(row)
+		bindVars[lu.lkp.To] = sqltypes.BytesBindVariable(ksids[i])
+		result, err := vcursor.Execute("VindexVerify", lu.generateVerifyPrefix(len(row)), bindVars, false /* rollbackOnError */, vtgatepb.CommitOrder_PRE)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Verify: %v", err)
+		}
+		out[i] = len(result.Rows) != 0
+	}
+	return out, nil
+}
+
+func (lu *ConsistentLookupMultiCol) prefixBindVars(values []sqltypes.Value) map[string]*querypb.BindVariable {
+	bindVars := make(map[string]*querypb.BindVariable, len(values)+1)
+	for colnum, val := range values {
+		bindVars[lu.lkp.FromColumns[colnum]] = sqltypes.ValueBindVariable(val)
+	}
+	return bindVars
+}
+
+func (lu *ConsistentLookupMultiCol) generateLookupPrefix(n int) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "select %s from %s", lu.lkp.To, lu.lkp.Table)
+	lu.addWhere(&buf, lu.lkp.FromColumns[:n])
+	return buf.String()
+}
+
+func (lu *ConsistentLookupMultiCol) generateVerifyPrefix(n int) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "select %s from %s", lu.lkp.FromColumns[0], lu.lkp.Table)
+	lu.addWhere(&buf, lu.lkp.FromColumns[:n])
+	fmt.Fprintf(&buf, " and %s = :%s", lu.lkp.To, lu.lkp.To)
+	return buf.String()
+}
+
+func hasNull(values []sqltypes.Value) bool {
+	for _, val := range values {
+		if val.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+//====================================================================
+
 // clCommon defines a vindex that uses a lookup table.
 // The table is expected to define the id column as unique. It's
 // Unique and a Lookup.
@@ -210,7 +364,7 @@ type clCommon struct {
 }
 
 // newCLCommon is commone code for the consistent lookup vindexes.
-func newCLCommon(name string, m map[string]string) (*clCommon, error) {
+func newCLCommon(name string, m map[string]string, unique bool) (*clCommon, error) {
 	lu := &clCommon{name: name}
 	var err error
 	lu.writeOnly, err = boolFromMap(m, "write_only")
@@ -221,6 +375,10 @@ func newCLCommon(name string, m map[string]string) (*clCommon, error) {
 	if err := lu.lkp.Init(m, false /* autocommit */, false /* upsert */); err != nil {
 		return nil, err
 	}
+	lu.lkp.unique = unique
+	if err := lu.lkp.initAsync(name, m, unique); err != nil {
+		return nil, err
+	}
 	return lu, nil
 }
 
@@ -312,7 +470,7 @@ func (lu *clCommon) handleDup(vcursor VCursor, values []sqltypes.Value, ksid []b
 			return err
 		}
 	default:
-		return fmt.Errorf("unexpected rows: %v from consistent lookup vindex", qr.Rows)
+		return fmt.Errorf("unexpected rows: %s from consistent lookup vindex", obfuscation.Rows(qr.Rows))
 	}
 	return nil
 }
@@ -326,8 +484,7 @@ func (lu *clCommon) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
 	equal := true
 	for i := range oldValues {
-		// TODO(king-11) make collation aware
-		result, err := evalengine.NullsafeCompare(oldValues[i], newValues[i], collations.Unknown)
+		result, err := evalengine.NullsafeCompare(oldValues[i], newValues[i], lu.lkp.collation(i))
 		// errors from NullsafeCompare can be ignored. if they are real problems, we'll see them in the Create/Update
 		if err != nil || result != 0 {
 			equal = false
@@ -343,6 +500,16 @@ func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []b
 	return lu.Create(vcursor, [][]sqltypes.Value{newValues}, [][]byte{ksid}, false /* ignoreMode */)
 }
 
+// AsyncQueue returns the message table of the asynchronous writes.
+func (lu *clCommon) AsyncQueue() string {
+	return lu.lkp.AsyncQueue
+}
+
+// AsyncWriteQuery returns the statement that applies an asynchronous write.
+func (lu *clCommon) AsyncWriteQuery(write *AsyncWrite) (string, map[string]*querypb.BindVariable, error) {
+	return lu.lkp.asyncWriteQuery(write)
+}
+
 // MarshalJSON returns a JSON representation of clCommon.
 func (lu *clCommon) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
@@ -30,6 +30,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/obfuscation"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -375,6 +376,35 @@ func TestConsistentLookupCreateThenBadRows(t *testing.T) {
 	})
 }
 
+// lengthObfuscator obfuscates the ids into their length.
+type lengthObfuscator struct{}
+
+func (lengthObfuscator) Obfuscate(id []byte) string {
+	return fmt.Sprintf("len%d", len(id))
+}
+
+func TestConsistentLookupCreateThenBadRowsObfuscated(t *testing.T) {
+	obfuscation.Set(lengthObfuscator{})
+	defer obfuscation.Set(nil)
+
+	lookup := createConsistentLookup(t, "consistent_lookup", false)
+	vc := &loggingVCursor{}
+	vc.AddResult(nil, errors.New("Duplicate entry"))
+	vc.AddResult(makeTestResult(2), nil)
+
+	err := lookup.(Lookup).Create(vc,
+		[][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(2),
+		}},
+		[][]byte{[]byte("test1")},
+		false /* ignoreMode */)
+	want := "unexpected rows: [[INT64(len1) VARBINARY(len1)] [INT64(len1) VARBINARY(len1)]] from consistent lookup vindex"
+	if err == nil || err.Error() != want {
+		t.Errorf("lookup(query fail) err: %v, want %s", err, want)
+	}
+}
+
 func TestConsistentLookupDelete(t *testing.T) {
 	lookup := createConsistentLookup(t, "consistent_lookup", false)
 	vc := &loggingVCursor{}
@@ -438,6 +468,28 @@ func TestConsistentLookupNoUpdate(t *testing.T) {
 	vc.verifyLog(t, []string{})
 }
 
+func TestConsistentLookupNoUpdateCollation(t *testing.T) {
+	lookup, err := CreateVindex("consistent_lookup", "consistent_lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc1,fromc2",
+		"to":         "toc",
+		"collations": ",utf8mb4_general_ci",
+	})
+	require.NoError(t, err)
+	vc := &loggingVCursor{}
+
+	// Only the second column is case-insensitive.
+	err = lookup.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("abc")}, []byte("test"), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("ABC")})
+	require.NoError(t, err)
+	assert.Empty(t, vc.log)
+
+	vc.AddResult(&sqltypes.Result{}, nil)
+	vc.AddResult(&sqltypes.Result{}, nil)
+	err = lookup.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("abc")}, []byte("test"), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("abd")})
+	require.NoError(t, err)
+	assert.Len(t, vc.log, 2)
+}
+
 func TestConsistentLookupUpdateBecauseUncomparableTypes(t *testing.T) {
 	lookup := createConsistentLookup(t, "consistent_lookup", false)
 	vc := &loggingVCursor{}
@@ -469,7 +521,124 @@ func TestConsistentLookupUpdateBecauseUncomparableTypes(t *testing.T) {
 	}
 }
 
+func TestConsistentLookupMultiColMap(t *testing.T) {
+	lookup := createConsistentLookupMultiCol(t, false)
+	assert.True(t, lookup.PartialVindex())
+	vc := &loggingVCursor{}
+	ksidResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("toc", "varbinary"), "1", "2")
+	vc.AddResult(ksidResult, nil)
+	vc.AddResult(&sqltypes.Result{}, nil)
+
+	got, err := lookup.Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1)},
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("1"), []byte("2")}),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+	vc.verifyLog(t, []string{
+		"ExecutePre select toc from t where fromc1 = :fromc1 [{fromc1 1}] false",
+		"ExecutePre select toc from t where fromc1 = :fromc1 and fromc2 = :fromc2 [{fromc1 1} {fromc2 2}] false",
+	})
+
+	// Test query fail.
+	vc.AddResult(nil, fmt.Errorf("execute failed"))
+	_, err = lookup.Map(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}})
+	assert.EqualError(t, err, "lookup.Map: execute failed")
+
+	// Test too many values.
+	_, err = lookup.Map(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)}})
+	assert.EqualError(t, err, "lookup.Map: got 3 values for the 2 columns of vindex consistent_lookup_multicol")
+
+	// Test write_only.
+	lookup = createConsistentLookupMultiCol(t, true)
+	got, err = lookup.Map(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1)}})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}}}, got)
+}
+
+func TestConsistentLookupMultiColVerify(t *testing.T) {
+	lookup := createConsistentLookupMultiCol(t, false)
+	vc := &loggingVCursor{}
+	vc.AddResult(makeTestResult(1), nil)
+	vc.AddResult(&sqltypes.Result{}, nil)
+
+	got, err := lookup.Verify(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+		{sqltypes.NewInt64(3)},
+	}, [][]byte{[]byte("test1"), []byte("test2")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+	vc.verifyLog(t, []string{
+		"ExecutePre select fromc1 from t where fromc1 = :fromc1 and fromc2 = :fromc2 and toc = :toc [{fromc1 1} {fromc2 2} {toc test1}] false",
+		"ExecutePre select fromc1 from t where fromc1 = :fromc1 and toc = :toc [{fromc1 3} {toc test2}] false",
+	})
+
+	// Test write_only.
+	lookup = createConsistentLookupMultiCol(t, true)
+	got, err = lookup.Verify(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, got)
+}
+
+func TestConsistentLookupAsync(t *testing.T) {
+	_, err := CreateVindex("consistent_lookup_unique", "consistent_lookup_unique", map[string]string{
+		"table":       "t",
+		"from":        "fromc1",
+		"to":          "toc",
+		"async_queue": "q",
+	})
+	assert.EqualError(t, err, "async_queue is not supported by the unique lookup vindexes")
+
+	l, err := CreateVindex("consistent_lookup", "consistent_lookup", map[string]string{
+		"table":       "t",
+		"from":        "fromc1",
+		"to":          "toc",
+		"async_queue": "q",
+	})
+	require.NoError(t, err)
+	require.NoError(t, l.(WantOwnerInfo).SetOwnerInfo("ks", "t1", []sqlparser.ColIdent{sqlparser.NewColIdent("fc1")}))
+	lookup := l.(SingleColumn)
+	vc := &loggingVCursor{}
+	vc.AddResult(makeTestResult(0), nil)
+
+	got, err := lookup.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}}}, got)
+
+	// The insert is enqueued, and applied as an upsert, whose duplicates
+	// don't need to be handled.
+	vc = &loggingVCursor{}
+	vc.AddResult(&sqltypes.Result{}, nil)
+	err = lookup.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("test1")}, false /* ignoreMode */)
+	require.NoError(t, err)
+	require.Len(t, vc.log, 1)
+	assert.True(t, strings.HasPrefix(vc.log[0], "ExecutePre insert into q(id, message) values(:id, :message) [{id "), vc.log[0])
+	assert.Contains(t, vc.log[0], `{message {"vindex":"consistent_lookup","op":"insert",`)
+	query, _, err := l.(LookupAsync).AsyncWriteQuery(&AsyncWrite{
+		Vindex: "consistent_lookup",
+		Op:     AsyncInsert,
+		From:   []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(1))},
+		To:     sqltypes.ValueToProto(sqltypes.NewVarBinary("test1")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "insert into t(fromc1, toc) values(:fromc1_0, :toc_0) on duplicate key update fromc1=values(fromc1), toc=values(toc)", query)
+}
+
 func createConsistentLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
+	t.Helper()
+	return createConsistentLookupVindex(t, name, writeOnly).(SingleColumn)
+}
+
+func createConsistentLookupMultiCol(t *testing.T, writeOnly bool) MultiColumn {
+	t.Helper()
+	return createConsistentLookupVindex(t, "consistent_lookup_multicol", writeOnly).(MultiColumn)
+}
+
+func createConsistentLookupVindex(t *testing.T, name string, writeOnly bool) Vindex {
 	t.Helper()
 	write := "false"
 	if writeOnly {
@@ -491,7 +660,7 @@ func createConsistentLookup(t *testing.T, name string, writeOnly bool) SingleCol
 	if err := l.(WantOwnerInfo).SetOwnerInfo("ks", "dot.t1", cols); err != nil {
 		t.Fatal(err)
 	}
-	return l.(SingleColumn)
+	return l
 }
 
 var _ VCursor = (*loggingVCursor)(nil)
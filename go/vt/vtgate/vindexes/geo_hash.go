@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*GeoHash)(nil)
+)
+
+const (
+	geoHashDefaultPrecision = 32
+	// geoHashPartialBits is the number of bits of the latitude that a
+	// partial Map uses to compute the key ranges of the latitude, so that
+	// it returns at most 2^geoHashPartialBits key ranges.
+	geoHashPartialBits = 8
+)
+
+func init() {
+	Register("geo_hash", NewGeoHash)
+}
+
+// GeoHash is a multi-column unique vindex for the latitude and the longitude
+// of a location, in degrees. The keyspace id interleaves their bits, starting
+// with the latitude, like a geohash does, so that the locations that are
+// close to each other are likely to be in the same shard. The keyspace id is
+// a big-endian uint64 of which only the precision first bits are set.
+// The latitude alone maps to the key ranges of the locations that it can have.
+type GeoHash struct {
+	name    string
+	latBits int
+	lngBits int
+}
+
+// NewGeoHash creates a GeoHash vindex.
+// The supplied map has one optional parameter:
+// precision: the number of bits of the keyspace id, between 2 and 64. The
+// default is 32. The latitude gets the extra bit of an odd precision.
+func NewGeoHash(name string, m map[string]string) (Vindex, error) {
+	precision := geoHashDefaultPrecision
+	if p, ok := m["precision"]; ok {
+		var err error
+		precision, err = strconv.Atoi(p)
+		if err != nil || precision < 2 || precision > 64 {
+			return nil, fmt.Errorf("geo_hash: invalid precision '%s', expected a number of bits between 2 and 64", p)
+		}
+	}
+	return &GeoHash{
+		name:    name,
+		latBits: (precision + 1) / 2,
+		lngBits: precision / 2,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (gh *GeoHash) String() string {
+	return gh.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (gh *GeoHash) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (gh *GeoHash) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (gh *GeoHash) NeedsVCursor() bool {
+	return false
+}
+
+// Map satisfies MultiColumn.
+func (gh *GeoHash) Map(_ VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	for _, colValues := range rowsColValues {
+		switch len(colValues) {
+		case 1:
+			lat, err := geoHashCell(colValues[0], 90, gh.latBits)
+			if err != nil {
+				out = append(out, key.DestinationNone{})
+				continue
+			}
+			out = append(out, gh.latitudeKeyRanges(lat))
+		case 2:
+			ksid, err := gh.ksid(colValues)
+			if err != nil {
+				out = append(out, key.DestinationNone{})
+				continue
+			}
+			out = append(out, key.DestinationKeyspaceID(ksid))
+		default:
+			out = append(out, key.DestinationNone{})
+		}
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn.
+func (gh *GeoHash) Verify(_ VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, colValues := range rowsColValues {
+		if len(colValues) != 2 {
+			continue
+		}
+		ksid, err := gh.ksid(colValues)
+		if err != nil {
+			continue
+		}
+		out[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// PartialVindex returns true since the latitude alone maps to key ranges.
+func (gh *GeoHash) PartialVindex() bool {
+	return true
+}
+
+func (gh *GeoHash) ksid(colValues []sqltypes.Value) ([]byte, error) {
+	lat, err := geoHashCell(colValues[0], 90, gh.latBits)
+	if err != nil {
+		return nil, err
+	}
+	lng, err := geoHashCell(colValues[1], 180, gh.lngBits)
+	if err != nil {
+		return nil, err
+	}
+	ksid := make([]byte, 8)
+	binary.BigEndian.PutUint64(ksid, geoHashInterleave(lat, lng, gh.latBits, gh.lngBits)<<(64-gh.latBits-gh.lngBits))
+	return ksid, nil
+}
+
+// latitudeKeyRanges returns the key ranges of the keyspace ids whose first
+// bits of latitude are the ones of lat, one for each of the values of the
+// same number of first bits of the longitude.
+func (gh *GeoHash) latitudeKeyRanges(lat uint64) key.Destination {
+	bits := geoHashPartialBits
+	if gh.lngBits < bits {
+		bits = gh.lngBits
+	}
+	lat >>= gh.latBits - bits
+	prefixBits := 2 * bits
+	keyRanges := make(key.DestinationKeyRanges, 0, 1<<bits)
+	for lng := uint64(0); lng < 1<<bits; lng++ {
+		prefix := geoHashInterleave(lat, lng, bits, bits)
+		keyRange := &topodatapb.KeyRange{Start: make([]byte, 8)}
+		binary.BigEndian.PutUint64(keyRange.Start, prefix<<(64-prefixBits))
+		if prefix+1 < 1<<prefixBits {
+			keyRange.End = make([]byte, 8)
+			binary.BigEndian.PutUint64(keyRange.End, (prefix+1)<<(64-prefixBits))
+		}
+		keyRanges = append(keyRanges, keyRange)
+	}
+	return keyRanges
+}
+
+// geoHashCell returns the cell of a coordinate between -limit and limit,
+// when the range is divided in 2^bits cells.
+func geoHashCell(v sqltypes.Value, limit float64, bits int) (uint64, error) {
+	if v.IsNull() {
+		return 0, fmt.Errorf("geo_hash: NULL coordinate")
+	}
+	f, err := strconv.ParseFloat(v.ToString(), 64)
+	if err != nil {
+		return 0, fmt.Errorf("geo_hash: invalid coordinate '%s'", v.ToString())
+	}
+	if math.IsNaN(f) || f < -limit || f > limit {
+		return 0, fmt.Errorf("geo_hash: coordinate %v out of range [%v, %v]", f, -limit, limit)
+	}
+	cells := uint64(1) << bits
+	cell := uint64((f + limit) / (2 * limit) * float64(cells))
+	if cell >= cells {
+		cell = cells - 1
+	}
+	return cell, nil
+}
+
+// geoHashInterleave interleaves the bits of lat and lng, starting with the
+// first bit of lat. lat has either as many bits as lng or one more.
+func geoHashInterleave(lat, lng uint64, latBits, lngBits int) uint64 {
+	var out uint64
+	for i := 0; i < latBits+lngBits; i++ {
+		out <<= 1
+		if i%2 == 0 {
+			out |= (lat >> (latBits - 1 - i/2)) & 1
+		} else {
+			out |= (lng >> (lngBits - 1 - i/2)) & 1
+		}
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createGeoHash(t *testing.T, precision string) MultiColumn {
+	t.Helper()
+	params := map[string]string{}
+	if precision != "" {
+		params["precision"] = precision
+	}
+	vindex, err := CreateVindex("geo_hash", "gh", params)
+	require.NoError(t, err)
+	return vindex.(MultiColumn)
+}
+
+func TestGeoHashInfo(t *testing.T) {
+	gh := createGeoHash(t, "")
+	assert.Equal(t, 1, gh.Cost())
+	assert.Equal(t, "gh", gh.String())
+	assert.True(t, gh.IsUnique())
+	assert.False(t, gh.NeedsVCursor())
+	assert.True(t, gh.PartialVindex())
+	assert.Equal(t, 16, gh.(*GeoHash).latBits)
+	assert.Equal(t, 16, gh.(*GeoHash).lngBits)
+
+	gh = createGeoHash(t, "5")
+	assert.Equal(t, 3, gh.(*GeoHash).latBits)
+	assert.Equal(t, 2, gh.(*GeoHash).lngBits)
+
+	for _, precision := range []string{"1", "65", "high"} {
+		_, err := CreateVindex("geo_hash", "gh", map[string]string{"precision": precision})
+		assert.EqualError(t, err, "geo_hash: invalid precision '"+precision+"', expected a number of bits between 2 and 64")
+	}
+}
+
+func TestGeoHashMap(t *testing.T) {
+	gh := createGeoHash(t, "")
+	got, err := gh.Map(nil, [][]sqltypes.Value{
+		{sqltypes.NewFloat64(37.7749), sqltypes.NewFloat64(-122.4194)},
+		// A location close to the previous one has the same keyspace id.
+		{sqltypes.NewVarChar("37.7750"), sqltypes.NewVarChar("-122.4180")},
+		{sqltypes.NewFloat64(90.1), sqltypes.NewFloat64(0)},
+		{sqltypes.NewFloat64(0), sqltypes.NewFloat64(-180.1)},
+		{sqltypes.NULL, sqltypes.NewFloat64(0)},
+		{sqltypes.NewVarChar("north"), sqltypes.NewFloat64(0)},
+		{sqltypes.NewFloat64(0), sqltypes.NewFloat64(0), sqltypes.NewFloat64(0)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+		key.DestinationKeyspaceID("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}, got)
+
+	gh = createGeoHash(t, "4")
+	got, err = gh.Map(nil, [][]sqltypes.Value{
+		{sqltypes.NewInt64(45), sqltypes.NewInt64(90)},
+		{sqltypes.NewInt64(-90), sqltypes.NewInt64(180)},
+		{sqltypes.NewInt64(0), sqltypes.NewInt64(0)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID("\xf0\x00\x00\x00\x00\x00\x00\x00"),
+		key.DestinationKeyspaceID("\x50\x00\x00\x00\x00\x00\x00\x00"),
+		key.DestinationKeyspaceID("\xc0\x00\x00\x00\x00\x00\x00\x00"),
+	}, got)
+}
+
+func TestGeoHashMapLatitude(t *testing.T) {
+	gh := createGeoHash(t, "4")
+	got, err := gh.Map(nil, [][]sqltypes.Value{
+		{sqltypes.NewInt64(45)},
+		{sqltypes.NewInt64(91)},
+	})
+	require.NoError(t, err)
+	keyRange := func(start, end string) *topodatapb.KeyRange {
+		return &topodatapb.KeyRange{Start: []byte(start), End: []byte(end)}
+	}
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyRanges{
+			keyRange("\xa0\x00\x00\x00\x00\x00\x00\x00", "\xb0\x00\x00\x00\x00\x00\x00\x00"),
+			keyRange("\xb0\x00\x00\x00\x00\x00\x00\x00", "\xc0\x00\x00\x00\x00\x00\x00\x00"),
+			keyRange("\xe0\x00\x00\x00\x00\x00\x00\x00", "\xf0\x00\x00\x00\x00\x00\x00\x00"),
+			{Start: []byte("\xf0\x00\x00\x00\x00\x00\x00\x00")},
+		},
+		key.DestinationNone{},
+	}, got)
+
+	// The key ranges of the latitude cover the keyspace ids of its locations,
+	// with 8 bits of latitude at most.
+	gh = createGeoHash(t, "")
+	got, err = gh.Map(nil, [][]sqltypes.Value{{sqltypes.NewFloat64(37.7749)}})
+	require.NoError(t, err)
+	keyRanges := got[0].(key.DestinationKeyRanges)
+	assert.Len(t, keyRanges, 256)
+	ksid := []byte("\x8e\x62\xdf\x86\x00\x00\x00\x00")
+	covered := 0
+	for _, kr := range keyRanges {
+		if key.KeyRangeContains(kr, ksid) {
+			covered++
+		}
+	}
+	assert.Equal(t, 1, covered)
+}
+
+func TestGeoHashVerify(t *testing.T) {
+	gh := createGeoHash(t, "")
+	got, err := gh.Verify(nil, [][]sqltypes.Value{
+		{sqltypes.NewFloat64(37.7749), sqltypes.NewFloat64(-122.4194)},
+		{sqltypes.NewFloat64(37.7749), sqltypes.NewFloat64(122.4194)},
+		{sqltypes.NewFloat64(37.7749)},
+		{sqltypes.NULL, sqltypes.NULL},
+	}, [][]byte{
+		[]byte("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+		[]byte("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+		[]byte("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+		[]byte("\x8e\x62\xdf\x86\x00\x00\x00\x00"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false, false}, got)
+}
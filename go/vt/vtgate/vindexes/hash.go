@@ -21,10 +21,10 @@ import (
 	"crypto/cipher"
 	"crypto/des"
 	"encoding/binary"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -151,7 +151,7 @@ func vhash(shardKey uint64) []byte {
 
 func vunhash(k []byte) (uint64, error) {
 	if len(k) != 8 {
-		return 0, fmt.Errorf("invalid keyspace id: %v", hex.EncodeToString(k))
+		return 0, fmt.Errorf("invalid keyspace id: %v", obfuscation.KeyspaceID(k))
 	}
 	var unhashed [8]byte
 	blockDES.Decrypt(unhashed[:], k)
@@ -0,0 +1,391 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// The severities of the lint warnings, from the most to the least severe.
+const (
+	// LintError is a mistake that breaks queries.
+	LintError = "ERROR"
+	// LintWarning is a likely mistake.
+	LintWarning = "WARNING"
+	// LintInfo is worth knowing, but can be intended.
+	LintInfo = "INFO"
+)
+
+// The checks of LintVSchema.
+const (
+	LintKeyspaceError           = "keyspace_error"
+	LintMissingPrimaryVindex    = "missing_primary_vindex"
+	LintUnownedLookupVindex     = "unowned_lookup_vindex"
+	LintUnusedVindex            = "unused_vindex"
+	LintDuplicateVindex         = "duplicate_vindex"
+	LintSharedLookupTable       = "shared_lookup_table"
+	LintUnusedSequence          = "unused_sequence"
+	LintAutoIncrementNoSequence = "auto_increment_not_sequence"
+	LintRoutingRuleError        = "routing_rule_error"
+	LintUnreachableTable        = "unreachable_table"
+	LintAmbiguousTable          = "ambiguous_table"
+)
+
+var lintSeverityRanks = map[string]int{
+	LintError:   0,
+	LintWarning: 1,
+	LintInfo:    2,
+}
+
+// LintFinding is a problem that LintVSchema found in a vschema, with what
+// to do about it.
+type LintFinding struct {
+	Severity string `json:"severity"`
+	Check    string `json:"check"`
+	Keyspace string `json:"keyspace,omitempty"`
+	Table    string `json:"table,omitempty"`
+	Vindex   string `json:"vindex,omitempty"`
+	Message  string `json:"message"`
+}
+
+// String returns the finding on one line.
+func (f *LintFinding) String() string {
+	var location []string
+	for _, part := range []string{f.Keyspace, f.Table, f.Vindex} {
+		if part != "" {
+			location = append(location, part)
+		}
+	}
+	return fmt.Sprintf("%s %s %s: %s", f.Severity, f.Check, strings.Join(location, "."), f.Message)
+}
+
+// LintSeverityAtLeast returns true if the severity is at least as severe
+// as min. An unknown min is the least severe.
+func LintSeverityAtLeast(severity, min string) bool {
+	minRank, ok := lintSeverityRanks[strings.ToUpper(min)]
+	if !ok {
+		minRank = lintSeverityRanks[LintInfo]
+	}
+	return lintSeverityRanks[severity] <= minRank
+}
+
+// LintVSchema analyzes a SrvVSchema, and returns the mistakes and likely
+// mistakes that it has beyond the errors of BuildVSchema, sorted by
+// severity, keyspace, table and vindex.
+func LintVSchema(source *vschemapb.SrvVSchema) []*LintFinding {
+	l := &linter{source: source, vschema: BuildVSchema(source)}
+	for _, keyspace := range sortedKeys(source.Keyspaces) {
+		l.lintKeyspace(keyspace, source.Keyspaces[keyspace])
+	}
+	l.lintLookupTables()
+	l.lintRoutingRules()
+	l.lintAmbiguousTables()
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		a, b := l.findings[i], l.findings[j]
+		if a.Severity != b.Severity {
+			return lintSeverityRanks[a.Severity] < lintSeverityRanks[b.Severity]
+		}
+		if a.Keyspace != b.Keyspace {
+			return a.Keyspace < b.Keyspace
+		}
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		return a.Vindex < b.Vindex
+	})
+	return l.findings
+}
+
+type linter struct {
+	source   *vschemapb.SrvVSchema
+	vschema  *VSchema
+	findings []*LintFinding
+}
+
+func (l *linter) add(f *LintFinding) {
+	l.findings = append(l.findings, f)
+}
+
+func (l *linter) lintKeyspace(keyspace string, ks *vschemapb.Keyspace) {
+	if built := l.vschema.Keyspaces[keyspace]; built != nil && built.Error != nil {
+		l.add(&LintFinding{
+			Severity: LintError,
+			Check:    LintKeyspaceError,
+			Keyspace: keyspace,
+			Message:  fmt.Sprintf("vtgate can't build the vschema of the keyspace: %s", built.Error.Error()),
+		})
+	}
+
+	usedVindexes := make(map[string]bool)
+	for _, name := range sortedKeys(ks.Tables) {
+		table := ks.Tables[name]
+		for _, cv := range table.ColumnVindexes {
+			usedVindexes[cv.Name] = true
+		}
+		if ks.Sharded && len(table.ColumnVindexes) == 0 && table.Type != TypeReference && table.Type != TypeSequence && table.Pinned == "" {
+			l.add(&LintFinding{
+				Severity: LintError,
+				Check:    LintMissingPrimaryVindex,
+				Keyspace: keyspace,
+				Table:    name,
+				Message:  "the table of a sharded keyspace has no primary vindex: add a unique column vindex, or make it a reference or pinned table",
+			})
+		}
+		if table.AutoIncrement != nil {
+			l.lintAutoIncrement(keyspace, name, table.AutoIncrement)
+		}
+		if table.Type == TypeSequence && !l.sequenceUsed(keyspace, name) {
+			l.add(&LintFinding{
+				Severity: LintInfo,
+				Check:    LintUnusedSequence,
+				Keyspace: keyspace,
+				Table:    name,
+				Message:  "no auto_increment uses the sequence table: drop it, or add it to the auto_increment of its table",
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(ks.Vindexes) {
+		vindex := ks.Vindexes[name]
+		if !usedVindexes[name] {
+			l.add(&LintFinding{
+				Severity: LintInfo,
+				Check:    LintUnusedVindex,
+				Keyspace: keyspace,
+				Vindex:   name,
+				Message:  "no table uses the vindex: remove it, or add it to the column vindexes of a table",
+			})
+		}
+		if _, ok := l.builtVindex(keyspace, name).(Lookup); ok && vindex.Owner == "" {
+			if vindex.Params["write_only"] == "true" {
+				l.add(&LintFinding{
+					Severity: LintInfo,
+					Check:    LintUnownedLookupVindex,
+					Keyspace: keyspace,
+					Vindex:   name,
+					Message:  "the write_only lookup vindex has no owner: nothing writes its lookup table but its backfill",
+				})
+			} else {
+				l.add(&LintFinding{
+					Severity: LintWarning,
+					Check:    LintUnownedLookupVindex,
+					Keyspace: keyspace,
+					Vindex:   name,
+					Message:  "the lookup vindex has no owner, so vtgate doesn't write its lookup table: set its owner, or make sure that it is kept up to date outside of vtgate",
+				})
+			}
+		}
+	}
+
+	// The vindexes with the same type and parameters compute the same
+	// keyspace ids, or read the same lookup table.
+	names := sortedKeys(ks.Vindexes)
+	for i, name := range names {
+		for _, other := range names[:i] {
+			a, b := ks.Vindexes[other], ks.Vindexes[name]
+			if a.Type == b.Type && len(a.Params) > 0 && proto.Equal(&vschemapb.Vindex{Params: a.Params}, &vschemapb.Vindex{Params: b.Params}) {
+				l.add(&LintFinding{
+					Severity: LintWarning,
+					Check:    LintDuplicateVindex,
+					Keyspace: keyspace,
+					Vindex:   name,
+					Message:  fmt.Sprintf("the vindex has the same type and parameters as %s: use %s instead", other, other),
+				})
+				break
+			}
+		}
+	}
+}
+
+func (l *linter) builtVindex(keyspace, name string) Vindex {
+	if ks := l.vschema.Keyspaces[keyspace]; ks != nil {
+		return ks.Vindexes[name]
+	}
+	return nil
+}
+
+// lintAutoIncrement checks that the sequence of an auto_increment is a
+// sequence table.
+func (l *linter) lintAutoIncrement(keyspace, table string, autoIncrement *vschemapb.AutoIncrement) {
+	seqKeyspace, seqTable, err := sqlparser.ParseTable(autoIncrement.Sequence)
+	if err != nil || seqKeyspace == "" {
+		// BuildVSchema reports the sequences that don't resolve.
+		return
+	}
+	ks, ok := l.source.Keyspaces[seqKeyspace]
+	if !ok {
+		return
+	}
+	seq, ok := ks.Tables[seqTable]
+	switch {
+	case !ok:
+		l.add(&LintFinding{
+			Severity: LintWarning,
+			Check:    LintAutoIncrementNoSequence,
+			Keyspace: keyspace,
+			Table:    table,
+			Message:  fmt.Sprintf("the sequence %s of the auto_increment is not in the vschema of its keyspace: add it as a table of type sequence", autoIncrement.Sequence),
+		})
+	case seq.Type != TypeSequence:
+		l.add(&LintFinding{
+			Severity: LintError,
+			Check:    LintAutoIncrementNoSequence,
+			Keyspace: keyspace,
+			Table:    table,
+			Message:  fmt.Sprintf("the sequence %s of the auto_increment is not a sequence table: set its type to sequence", autoIncrement.Sequence),
+		})
+	}
+}
+
+// sequenceUsed returns true if an auto_increment uses the sequence table.
+func (l *linter) sequenceUsed(keyspace, table string) bool {
+	for _, ks := range l.source.Keyspaces {
+		for _, t := range ks.Tables {
+			if t.AutoIncrement == nil {
+				continue
+			}
+			seqKeyspace, seqTable, err := sqlparser.ParseTable(t.AutoIncrement.Sequence)
+			if err == nil && seqTable == table && (seqKeyspace == keyspace || seqKeyspace == "") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lintLookupTables checks that the lookup vindexes don't share their lookup
+// table, since they would delete each other's rows.
+func (l *linter) lintLookupTables() {
+	users := make(map[string][]string)
+	for _, keyspace := range sortedKeys(l.source.Keyspaces) {
+		ks := l.source.Keyspaces[keyspace]
+		for _, name := range sortedKeys(ks.Vindexes) {
+			table := ks.Vindexes[name].Params["table"]
+			if _, ok := l.builtVindex(keyspace, name).(Lookup); !ok || table == "" {
+				continue
+			}
+			users[table] = append(users[table], keyspace+"."+name)
+		}
+	}
+	for _, table := range sortedKeys(users) {
+		vindexes := users[table]
+		for i := 1; i < len(vindexes); i++ {
+			keyspace, name := splitQualified(vindexes[i])
+			l.add(&LintFinding{
+				Severity: LintError,
+				Check:    LintSharedLookupTable,
+				Keyspace: keyspace,
+				Vindex:   name,
+				Message:  fmt.Sprintf("the lookup vindex shares its lookup table %s with %s, and they would overwrite each other's rows: give it its own lookup table", table, vindexes[0]),
+			})
+		}
+	}
+}
+
+// lintRoutingRules checks that the routing rules resolve, and reports the
+// tables that they make unreachable.
+func (l *linter) lintRoutingRules() {
+	for _, from := range sortedKeys(l.vschema.RoutingRules) {
+		rule := l.vschema.RoutingRules[from]
+		keyspace, table := splitQualified(from)
+		if rule.Error != nil {
+			l.add(&LintFinding{
+				Severity: LintError,
+				Check:    LintRoutingRuleError,
+				Keyspace: keyspace,
+				Table:    table,
+				Message:  fmt.Sprintf("the routing rule doesn't resolve, and the queries of the table fail: %s", rule.Error.Error()),
+			})
+			continue
+		}
+		if keyspace == "" {
+			continue
+		}
+		// A qualified rule that goes to another keyspace makes the table
+		// of the keyspace unreachable.
+		if _, ok := l.source.Keyspaces[keyspace].GetTables()[table]; !ok {
+			continue
+		}
+		for _, target := range rule.Tables {
+			if target.Keyspace.Name != keyspace {
+				l.add(&LintFinding{
+					Severity: LintInfo,
+					Check:    LintUnreachableTable,
+					Keyspace: keyspace,
+					Table:    table,
+					Message:  fmt.Sprintf("a routing rule sends the queries of the table to %s.%s, so it can't be queried: remove the rule once it isn't needed", target.Keyspace.Name, target.Name.String()),
+				})
+				break
+			}
+		}
+	}
+}
+
+// lintAmbiguousTables reports the tables that are in several keyspaces
+// without a routing rule, whose unqualified references fail.
+func (l *linter) lintAmbiguousTables() {
+	keyspacesByTable := make(map[string][]string)
+	for _, keyspace := range sortedKeys(l.source.Keyspaces) {
+		ks := l.source.Keyspaces[keyspace]
+		if ks.RequireExplicitRouting {
+			continue
+		}
+		for name := range ks.Tables {
+			keyspacesByTable[name] = append(keyspacesByTable[name], keyspace)
+		}
+	}
+	for _, table := range sortedKeys(keyspacesByTable) {
+		keyspaces := keyspacesByTable[table]
+		if _, ok := l.vschema.RoutingRules[table]; len(keyspaces) < 2 || ok {
+			continue
+		}
+		l.add(&LintFinding{
+			Severity: LintInfo,
+			Check:    LintAmbiguousTable,
+			Table:    table,
+			Message:  fmt.Sprintf("the table is in the keyspaces %s, so its unqualified references fail: qualify them, or add a routing rule", strings.Join(keyspaces, ", ")),
+		})
+	}
+}
+
+func splitQualified(name string) (string, string) {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// sortedKeys returns the sorted keys of a map keyed by strings.
+func sortedKeys(m any) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	sorted := make([]string, len(keys))
+	for i, key := range keys {
+		sorted[i] = key.String()
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/json2"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestLintVSchema(t *testing.T) {
+	source := &vschemapb.SrvVSchema{}
+	require.NoError(t, json2.Unmarshal([]byte(`{
+		"keyspaces": {
+			"main": {
+				"tables": {
+					"user_seq": {"type": "sequence"},
+					"order_seq": {"type": "sequence"},
+					"not_seq": {},
+					"name_lkp": {}
+				}
+			},
+			"user": {
+				"sharded": true,
+				"vindexes": {
+					"hash": {"type": "hash"},
+					"unused": {"type": "xxhash"},
+					"name_lkp": {"type": "lookup", "params": {"table": "main.name_lkp", "from": "name", "to": "keyspace_id"}, "owner": "user"},
+					"name_lkp2": {"type": "lookup", "params": {"table": "main.name_lkp", "from": "name", "to": "keyspace_id"}, "owner": "user"},
+					"email_lkp": {"type": "lookup_unique", "params": {"table": "main.email_lkp", "from": "email", "to": "keyspace_id"}}
+				},
+				"tables": {
+					"user": {
+						"column_vindexes": [{"column": "id", "name": "hash"}, {"column": "name", "name": "name_lkp"}, {"column": "name", "name": "name_lkp2"}, {"column": "email", "name": "email_lkp"}],
+						"auto_increment": {"column": "id", "sequence": "main.user_seq"}
+					},
+					"orders": {
+						"auto_increment": {"column": "id", "sequence": "main.not_seq"}
+					},
+					"ref": {"type": "reference"}
+				}
+			},
+			"other": {
+				"tables": {
+					"ref": {},
+					"moved": {}
+				}
+			}
+		},
+		"routing_rules": {
+			"rules": [
+				{"from_table": "other.moved", "to_tables": ["main.not_seq"]},
+				{"from_table": "broken", "to_tables": ["nope.broken"]}
+			]
+		}
+	}`), source))
+
+	var findings []string
+	for _, finding := range LintVSchema(source) {
+		findings = append(findings, finding.String())
+	}
+	assert.Equal(t, []string{
+		"ERROR routing_rule_error broken: the routing rule doesn't resolve, and the queries of the table fail: Unknown database 'nope' in vschema",
+		"ERROR keyspace_error user: vtgate can't build the vschema of the keyspace: missing primary col vindex for table: orders",
+		"ERROR shared_lookup_table user.name_lkp2: the lookup vindex shares its lookup table main.name_lkp with user.name_lkp, and they would overwrite each other's rows: give it its own lookup table",
+		"ERROR missing_primary_vindex user.orders: the table of a sharded keyspace has no primary vindex: add a unique column vindex, or make it a reference or pinned table",
+		"ERROR auto_increment_not_sequence user.orders: the sequence main.not_seq of the auto_increment is not a sequence table: set its type to sequence",
+		"WARNING unowned_lookup_vindex user.email_lkp: the lookup vindex has no owner, so vtgate doesn't write its lookup table: set its owner, or make sure that it is kept up to date outside of vtgate",
+		"WARNING duplicate_vindex user.name_lkp2: the vindex has the same type and parameters as name_lkp: use name_lkp instead",
+		"INFO ambiguous_table ref: the table is in the keyspaces other, user, so its unqualified references fail: qualify them, or add a routing rule",
+		"INFO unused_sequence main.order_seq: no auto_increment uses the sequence table: drop it, or add it to the auto_increment of its table",
+		"INFO unreachable_table other.moved: a routing rule sends the queries of the table to main.not_seq, so it can't be queried: remove the rule once it isn't needed",
+		"INFO unused_vindex user.unused: no table uses the vindex: remove it, or add it to the column vindexes of a table",
+	}, findings)
+
+	assert.True(t, LintSeverityAtLeast(LintError, LintWarning))
+	assert.False(t, LintSeverityAtLeast(LintInfo, "warning"))
+	assert.True(t, LintSeverityAtLeast(LintInfo, ""))
+}
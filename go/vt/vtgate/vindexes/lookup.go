@@ -19,18 +19,24 @@ package vindexes
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/obfuscation"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 var (
-	_ SingleColumn = (*LookupUnique)(nil)
-	_ Lookup       = (*LookupUnique)(nil)
-	_ SingleColumn = (*LookupNonUnique)(nil)
-	_ Lookup       = (*LookupNonUnique)(nil)
+	_ SingleColumn    = (*LookupUnique)(nil)
+	_ Lookup          = (*LookupUnique)(nil)
+	_ LookupTombstone = (*LookupUnique)(nil)
+	_ SingleColumn    = (*LookupNonUnique)(nil)
+	_ Lookup          = (*LookupNonUnique)(nil)
+	_ LookupAsync     = (*LookupNonUnique)(nil)
+	_ LookupTombstone = (*LookupNonUnique)(nil)
 )
 
 func init() {
@@ -90,7 +96,7 @@ func (ln *LookupNonUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Des
 	}
 	for _, result := range results {
 		if len(result.Rows) == 0 {
-			out = append(out, key.DestinationNone{})
+			out = append(out, ln.lkp.missDestination())
 			continue
 		}
 		ksids := make([][]byte, 0, len(result.Rows))
@@ -133,6 +139,26 @@ func (ln *LookupNonUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return ln.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
 }
 
+// AsyncQueue returns the message table of the asynchronous writes.
+func (ln *LookupNonUnique) AsyncQueue() string {
+	return ln.lkp.AsyncQueue
+}
+
+// AsyncWriteQuery returns the statement that applies an asynchronous write.
+func (ln *LookupNonUnique) AsyncWriteQuery(write *AsyncWrite) (string, map[string]*querypb.BindVariable, error) {
+	return ln.lkp.asyncWriteQuery(write)
+}
+
+// TombstoneTTL returns how long the tombstones of the deleted rows are kept.
+func (ln *LookupNonUnique) TombstoneTTL() time.Duration {
+	return ln.lkp.TombstoneTTL
+}
+
+// TombstonePurgeQuery returns the query that purges the old tombstones.
+func (ln *LookupNonUnique) TombstonePurgeQuery() string {
+	return ln.lkp.tombstonePurgeQuery()
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ln.lkp)
@@ -147,6 +173,16 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   async_queue: the message table into which the writes are enqueued, for the vtgates to
+//     apply them asynchronously. The values that are not found fall back to a full scatter.
+//   batch_size: the maximum number of values looked up by a query. The values of a Map are
+//     split into as many queries as needed, rather than sent in one IN clause.
+//   batch_concurrency: the number of the queries of a Map executed in parallel. It requires
+//     autocommit, since the queries of the other lookups share the session of the client.
+//   tombstone_ttl: how long the entries of the deleted rows are kept, as tombstones that
+//     still route their values, before vtgate purges them.
+//   tombstone_column: the column of the table that holds the time of deletion of the
+//     tombstones, as a unix timestamp. Defaults to tombstoned_at.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -163,6 +199,12 @@ func NewLookup(name string, m map[string]string) (Vindex, error) {
 	if err := lookup.lkp.Init(m, autocommit, autocommit /* upsert */); err != nil {
 		return nil, err
 	}
+	if err := lookup.lkp.initAsync(name, m, false /* unique */); err != nil {
+		return nil, err
+	}
+	if err := lookup.lkp.initTombstones(m); err != nil {
+		return nil, err
+	}
 	return lookup, nil
 }
 
@@ -194,6 +236,14 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   batch_size: the maximum number of values looked up by a query. The values of a Map are
+//     split into as many queries as needed, rather than sent in one IN clause.
+//   batch_concurrency: the number of the queries of a Map executed in parallel. It requires
+//     autocommit, since the queries of the other lookups share the session of the client.
+//   tombstone_ttl: how long the entries of the deleted rows are kept, as tombstones that
+//     still route their values, before vtgate purges them.
+//   tombstone_column: the column of the table that holds the time of deletion of the
+//     tombstones, as a unix timestamp. Defaults to tombstoned_at.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -210,6 +260,13 @@ func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	if err := lu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
+	lu.lkp.unique = true
+	if err := lu.lkp.initAsync(name, m, true /* unique */); err != nil {
+		return nil, err
+	}
+	if err := lu.lkp.initTombstones(m); err != nil {
+		return nil, err
+	}
 	return lu, nil
 }
 
@@ -257,7 +314,7 @@ func (lu *LookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destin
 			}
 			out = append(out, key.DestinationKeyspaceID(rowBytes))
 		default:
-			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, ids[i])
+			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, obfuscation.Value(ids[i]))
 		}
 	}
 	return out, nil
@@ -290,6 +347,16 @@ func (lu *LookupUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value
 	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
 }
 
+// TombstoneTTL returns how long the tombstones of the deleted rows are kept.
+func (lu *LookupUnique) TombstoneTTL() time.Duration {
+	return lu.lkp.TombstoneTTL
+}
+
+// TombstonePurgeQuery returns the query that purges the old tombstones.
+func (lu *LookupUnique) TombstonePurgeQuery() string {
+	return lu.lkp.tombstonePurgeQuery()
+}
+
 // MarshalJSON returns a JSON representation of LookupUnique.
 func (lu *LookupUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
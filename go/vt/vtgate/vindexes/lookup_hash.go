@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -241,6 +242,7 @@ func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	if err := lhu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
+	lhu.lkp.unique = true
 	return lhu, nil
 }
 
@@ -290,7 +292,7 @@ func (lhu *LookupHashUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.D
 			}
 			out = append(out, key.DestinationKeyspaceID(vhash(num)))
 		default:
-			return nil, fmt.Errorf("LookupHash.Map: unexpected multiple results from vindex %s: %v", lhu.lkp.Table, ids[i])
+			return nil, fmt.Errorf("LookupHash.Map: unexpected multiple results from vindex %s: %v", lhu.lkp.Table, obfuscation.Value(ids[i]))
 		}
 	}
 	return out, nil
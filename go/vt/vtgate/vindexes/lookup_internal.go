@@ -18,27 +18,53 @@ package vindexes
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"math/rand"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 // lookupInternal implements the functions for the Lookup vindexes.
 type lookupInternal struct {
-	Table         string   `json:"table"`
-	FromColumns   []string `json:"from_columns"`
-	To            string   `json:"to"`
-	Autocommit    bool     `json:"autocommit,omitempty"`
-	Upsert        bool     `json:"upsert,omitempty"`
-	IgnoreNulls   bool     `json:"ignore_nulls,omitempty"`
-	BatchLookup   bool     `json:"batch_lookup,omitempty"`
-	sel, ver, del string
+	Table            string   `json:"table"`
+	FromColumns      []string `json:"from_columns"`
+	To               string   `json:"to"`
+	Autocommit       bool     `json:"autocommit,omitempty"`
+	Upsert           bool     `json:"upsert,omitempty"`
+	IgnoreNulls      bool     `json:"ignore_nulls,omitempty"`
+	BatchLookup      bool     `json:"batch_lookup,omitempty"`
+	BatchSize        int      `json:"batch_size,omitempty"`
+	BatchConcurrency int      `json:"batch_concurrency,omitempty"`
+	AsyncQueue       string   `json:"async_queue,omitempty"`
+	// TombstoneTTL is how long the entries of the deleted rows are kept in
+	// the lookup table, as tombstones that hold their time of deletion in
+	// TombstoneColumn. The entries are deleted right away if it's zero.
+	TombstoneTTL    time.Duration `json:"tombstone_ttl,omitempty"`
+	TombstoneColumn string        `json:"tombstone_column,omitempty"`
+	// Collations are the collations of the FromColumns. The values of a
+	// column without collation are compared as bytes.
+	Collations          []string `json:"collations,omitempty"`
+	collationIDs        []collations.ID
+	sel, ver, del, tomb string
+	// asyncVindex is the name of the vindex in the messages of AsyncQueue.
+	asyncVindex string
+	// unique is set for the unique vindexes, whose duplicate entries must
+	// fail.
+	unique bool
 }
 
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
@@ -59,9 +85,24 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	if err != nil {
 		return err
 	}
+	lkp.BatchSize, err = intFromMap(lookupQueryParams, "batch_size")
+	if err != nil {
+		return err
+	}
+	lkp.BatchConcurrency, err = intFromMap(lookupQueryParams, "batch_concurrency")
+	if err != nil {
+		return err
+	}
+	lkp.Collations, lkp.collationIDs, err = collationsFromMap(lookupQueryParams, "collations", len(fromColumns))
+	if err != nil {
+		return err
+	}
 
 	lkp.Autocommit = autocommit
 	lkp.Upsert = upsert
+	if _, ok := lookupQueryParams["batch_concurrency"]; ok && !autocommit {
+		return fmt.Errorf("batch_concurrency is only supported with autocommit, the lookups of the other vindexes share the session of the client")
+	}
 
 	// TODO @rafael: update sel and ver to support multi column vindexes. This will be done
 	// as part of face 2 of https://github.com/vitessio/vitess/issues/3481
@@ -72,6 +113,48 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	return nil
 }
 
+// initTombstones sets up the tombstones of the entries of the deleted rows,
+// from the tombstone_ttl and tombstone_column params. It must be called
+// after Init.
+func (lkp *lookupInternal) initTombstones(m map[string]string) error {
+	val, ok := m["tombstone_ttl"]
+	if !ok {
+		return nil
+	}
+	ttl, err := time.ParseDuration(val)
+	if err != nil || ttl <= 0 {
+		return fmt.Errorf("tombstone_ttl value must be a positive duration: '%s'", val)
+	}
+	if lkp.Autocommit {
+		return fmt.Errorf("tombstone_ttl is not supported with autocommit, whose deletes are ignored")
+	}
+	lkp.TombstoneTTL = ttl
+	lkp.TombstoneColumn = m["tombstone_column"]
+	if lkp.TombstoneColumn == "" {
+		lkp.TombstoneColumn = "tombstoned_at"
+	}
+
+	// The tombstones still route their values, but they don't verify them.
+	lkp.ver = fmt.Sprintf("%s and %s is null", lkp.ver, lkp.TombstoneColumn)
+	var tombBuffer bytes.Buffer
+	fmt.Fprintf(&tombBuffer, "update %s set %s = :%s where ", lkp.Table, lkp.TombstoneColumn, lkp.TombstoneColumn)
+	for _, column := range lkp.FromColumns {
+		tombBuffer.WriteString(column + " = :" + column + " and ")
+	}
+	fmt.Fprintf(&tombBuffer, "%s = :%s and %s is null", lkp.To, lkp.To, lkp.TombstoneColumn)
+	lkp.tomb = tombBuffer.String()
+	return nil
+}
+
+// tombstonePurgeQuery returns the query that deletes the tombstones that
+// are older than the :before bind variable, as a unix timestamp.
+func (lkp *lookupInternal) tombstonePurgeQuery() string {
+	if lkp.TombstoneTTL == 0 {
+		return ""
+	}
+	return fmt.Sprintf("delete from %s where %s < :before", lkp.Table, lkp.TombstoneColumn)
+}
+
 // Lookup performs a lookup for the ids.
 func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtgatepb.CommitOrder) ([]*sqltypes.Result, error) {
 	if vcursor == nil {
@@ -87,22 +170,10 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 	}
 	if ids[0].IsIntegral() || lkp.BatchLookup {
 		// for integral types, batch query all ids and then map them back to the input order
-		vars, err := sqltypes.BuildBindVariable(ids)
-		if err != nil {
-			return nil, fmt.Errorf("lookup.Map: %v", err)
-		}
-		bindVars := map[string]*querypb.BindVariable{
-			lkp.FromColumns[0]: vars,
-		}
-		result, err := vcursor.Execute("VindexLookup", sel, bindVars, false /* rollbackOnError */, co)
+		resultMap, err := lkp.lookupBatches(vcursor, sel, ids, co)
 		if err != nil {
 			return nil, fmt.Errorf("lookup.Map: %v", err)
 		}
-		resultMap := make(map[string][][]sqltypes.Value)
-		for _, row := range result.Rows {
-			resultMap[row[0].ToString()] = append(resultMap[row[0].ToString()], []sqltypes.Value{row[1]})
-		}
-
 		for _, id := range ids {
 			results = append(results, &sqltypes.Result{
 				Rows: resultMap[id.ToString()],
@@ -135,6 +206,73 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 	return results, nil
 }
 
+// lookupBatches queries the ids in IN clauses of at most BatchSize ids, and
+// returns the 'to' values of the ids by their string value. Up to
+// BatchConcurrency batches are executed at a time in autocommit, where every
+// query gets a session of its own, which Init requires for BatchConcurrency;
+// otherwise they share the session of the vcursor, and are executed one
+// after the other.
+func (lkp *lookupInternal) lookupBatches(vcursor VCursor, sel string, ids []sqltypes.Value, co vtgatepb.CommitOrder) (map[string][][]sqltypes.Value, error) {
+	batches := [][]sqltypes.Value{ids}
+	if lkp.BatchSize > 0 && len(ids) > lkp.BatchSize {
+		batches = make([][]sqltypes.Value, 0, (len(ids)+lkp.BatchSize-1)/lkp.BatchSize)
+		for start := 0; start < len(ids); start += lkp.BatchSize {
+			end := start + lkp.BatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			batches = append(batches, ids[start:end])
+		}
+	}
+	results := make([]*sqltypes.Result, len(batches))
+	execute := func(i int) error {
+		vars, err := sqltypes.BuildBindVariable(batches[i])
+		if err != nil {
+			return err
+		}
+		bindVars := map[string]*querypb.BindVariable{
+			lkp.FromColumns[0]: vars,
+		}
+		results[i], err = vcursor.Execute("VindexLookup", sel, bindVars, false /* rollbackOnError */, co)
+		return err
+	}
+
+	if lkp.BatchConcurrency > 1 && len(batches) > 1 && co == vtgatepb.CommitOrder_AUTOCOMMIT {
+		var wg sync.WaitGroup
+		var rec concurrency.FirstErrorRecorder
+		slots := make(chan struct{}, lkp.BatchConcurrency)
+		for i := range batches {
+			slots <- struct{}{}
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				rec.RecordError(execute(i))
+			}(i)
+		}
+		wg.Wait()
+		if err := rec.Error(); err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range batches {
+			if err := execute(i); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resultMap := make(map[string][][]sqltypes.Value)
+	for _, result := range results {
+		for _, row := range result.Rows {
+			resultMap[row[0].ToString()] = append(resultMap[row[0].ToString()], []sqltypes.Value{row[1]})
+		}
+	}
+	return resultMap, nil
+}
+
 // Verify returns true if ids map to values.
 func (lkp *lookupInternal) Verify(vcursor VCursor, ids, values []sqltypes.Value) ([]bool, error) {
 	co := vtgatepb.CommitOrder_NORMAL
@@ -161,6 +299,7 @@ func (lkp *lookupInternal) VerifyCustom(vcursor VCursor, ids, values []sqltypes.
 }
 
 type sorter struct {
+	lkp           *lookupInternal
 	rowsColValues [][]sqltypes.Value
 	toValues      []sqltypes.Value
 }
@@ -170,23 +309,22 @@ func (v *sorter) Len() int {
 }
 
 func (v *sorter) Less(i, j int) bool {
+	return v.compare(i, j) < 0
+}
+
+// compare compares the rows i and j, by their columns and then their
+// to value. The rows that compare equal are duplicates.
+func (v *sorter) compare(i, j int) int {
 	leftRow := v.rowsColValues[i]
 	rightRow := v.rowsColValues[j]
 	for cell, left := range leftRow {
-		right := rightRow[cell]
-		lBytes, _ := left.ToBytes()
-		rBytes, _ := right.ToBytes()
-		compare := bytes.Compare(lBytes, rBytes)
-		if compare < 0 {
-			return true
-		}
-		if compare > 0 {
-			return false
+		if compare := v.lkp.compare(cell, left, rightRow[cell]); compare != 0 {
+			return compare
 		}
 	}
 	iBytes, _ := v.toValues[i].ToBytes()
 	jBytes, _ := v.toValues[j].ToBytes()
-	return bytes.Compare(iBytes, jBytes) < 0
+	return bytes.Compare(iBytes, jBytes)
 }
 
 func (v *sorter) Swap(i, j int) {
@@ -236,8 +374,49 @@ nextRow:
 	if len(trimmedRowsCols[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Create: column vindex count does not match the columns in the lookup: %d vs %v", len(trimmedRowsCols[0]), lkp.FromColumns)
 	}
-	sort.Sort(&sorter{rowsColValues: trimmedRowsCols, toValues: trimmedToValues})
+	rows := &sorter{lkp: lkp, rowsColValues: trimmedRowsCols, toValues: trimmedToValues}
+	sort.Sort(rows)
+	// Remove the duplicates of the non-unique lookups, which the lookup table
+	// would reject. The ones of a unique lookup are two rows of the same value,
+	// which must fail.
+	if !lkp.unique {
+		unique := 1
+		for i := 1; i < rows.Len(); i++ {
+			if rows.compare(unique-1, i) != 0 {
+				rows.Swap(unique, i)
+				unique++
+			}
+		}
+		trimmedRowsCols, trimmedToValues = trimmedRowsCols[:unique], trimmedToValues[:unique]
+	}
 
+	if lkp.AsyncQueue != "" {
+		for i, row := range trimmedRowsCols {
+			if err := lkp.enqueue(vcursor, "VindexCreate", AsyncInsert, row, trimmedToValues[i], 0, co); err != nil {
+				return fmt.Errorf("lookup.Create: %v", err)
+			}
+		}
+		return nil
+	}
+
+	query, bindVars := lkp.insertQuery(trimmedRowsCols, trimmedToValues, ignoreMode, lkp.Upsert)
+	if !lkp.Upsert && lkp.TombstoneTTL > 0 {
+		// The new entries replace the tombstones of their values, which the
+		// insert would otherwise fail on, or ignore.
+		if _, err := vcursor.Execute("VindexCreate", lkp.tombstoneDelStmt(len(trimmedRowsCols)), bindVars, true /* rollbackOnError */, co); err != nil {
+			return fmt.Errorf("lookup.Create: %v", err)
+		}
+	}
+	if _, err := vcursor.Execute("VindexCreate", query, bindVars, true /* rollbackOnError */, co); err != nil {
+		return fmt.Errorf("lookup.Create: %v", err)
+	}
+	return nil
+}
+
+// insertQuery returns the insert of the entries of the rows into the lookup
+// table, and its bind variables. An upsert replaces the tombstones of the
+// values.
+func (lkp *lookupInternal) insertQuery(rowsColValues [][]sqltypes.Value, toValues []sqltypes.Value, ignoreMode, upsert bool) (string, map[string]*querypb.BindVariable) {
 	buf := new(bytes.Buffer)
 	if ignoreMode {
 		fmt.Fprintf(buf, "insert ignore into %s(", lkp.Table)
@@ -249,9 +428,9 @@ nextRow:
 	}
 	fmt.Fprintf(buf, "%s) values(", lkp.To)
 
-	bindVars := make(map[string]*querypb.BindVariable, 2*len(trimmedRowsCols))
-	for rowIdx := range trimmedToValues {
-		colIds := trimmedRowsCols[rowIdx]
+	bindVars := make(map[string]*querypb.BindVariable, 2*len(rowsColValues))
+	for rowIdx := range toValues {
+		colIds := rowsColValues[rowIdx]
 		if rowIdx != 0 {
 			buf.WriteString(", (")
 		}
@@ -262,24 +441,24 @@ nextRow:
 		}
 		toStr := lkp.To + "_" + strconv.Itoa(rowIdx)
 		buf.WriteString(":" + toStr + ")")
-		bindVars[toStr] = sqltypes.ValueBindVariable(trimmedToValues[rowIdx])
+		bindVars[toStr] = sqltypes.ValueBindVariable(toValues[rowIdx])
 	}
 
-	if lkp.Upsert {
+	if upsert {
 		fmt.Fprintf(buf, " on duplicate key update ")
 		for _, col := range lkp.FromColumns {
 			fmt.Fprintf(buf, "%s=values(%s), ", col, col)
 		}
 		fmt.Fprintf(buf, "%s=values(%s)", lkp.To, lkp.To)
+		if lkp.TombstoneTTL > 0 {
+			fmt.Fprintf(buf, ", %s=null", lkp.TombstoneColumn)
+		}
 	}
-
-	if _, err := vcursor.Execute("VindexCreate", buf.String(), bindVars, true /* rollbackOnError */, co); err != nil {
-		return fmt.Errorf("lookup.Create: %v", err)
-	}
-	return nil
+	return buf.String(), bindVars
 }
 
-// Delete deletes the association between ids and value.
+// Delete deletes the association between ids and value. With a
+// TombstoneTTL, the entries are kept as tombstones instead.
 // rowsColValues contains all the rows that are being deleted.
 // For each row, we store the value of each column defined in the vindex.
 // value cointains the keyspace_id of the vindex entry being deleted.
@@ -295,6 +474,12 @@ nextRow:
 // A call to Delete would look like this:
 // Delete(vcursor, [[valuea, valueb]], 52CB7B1B31B2222E)
 func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, value sqltypes.Value, co vtgatepb.CommitOrder) error {
+	return lkp.deleteEntries(vcursor, rowsColValues, value, co, lkp.TombstoneTTL > 0)
+}
+
+// deleteEntries deletes the association between ids and value, or marks the
+// entries as tombstones.
+func (lkp *lookupInternal) deleteEntries(vcursor VCursor, rowsColValues [][]sqltypes.Value, value sqltypes.Value, co vtgatepb.CommitOrder, tombstone bool) error {
 	// In autocommit mode, it's not safe to delete. So, it's a no-op.
 	if lkp.Autocommit {
 		return nil
@@ -309,12 +494,20 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
 	for _, column := range rowsColValues {
-		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
-		for colIdx, columnValue := range column {
-			bindVars[lkp.FromColumns[colIdx]] = sqltypes.ValueBindVariable(columnValue)
+		var tombstonedAt int64
+		if tombstone {
+			tombstonedAt = time.Now().Unix()
+		}
+		var err error
+		switch {
+		case lkp.AsyncQueue == "":
+			query, bindVars := lkp.deleteQuery(column, value, tombstonedAt)
+			_, err = vcursor.Execute("VindexDelete", query, bindVars, true /* rollbackOnError */, co)
+		case tombstone:
+			err = lkp.enqueue(vcursor, "VindexDelete", AsyncTombstone, column, value, tombstonedAt, co)
+		default:
+			err = lkp.enqueue(vcursor, "VindexDelete", AsyncDelete, column, value, 0, co)
 		}
-		bindVars[lkp.To] = sqltypes.ValueBindVariable(value)
-		_, err := vcursor.Execute("VindexDelete", lkp.del, bindVars, true /* rollbackOnError */, co)
 		if err != nil {
 			return fmt.Errorf("lookup.Delete: %v", err)
 		}
@@ -322,14 +515,122 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	return nil
 }
 
-// Update implements the update functionality.
+// deleteQuery returns the delete of the entry of the values, or the query
+// that marks it as a tombstone deleted at tombstonedAt if it's not zero, and
+// its bind variables.
+func (lkp *lookupInternal) deleteQuery(values []sqltypes.Value, to sqltypes.Value, tombstonedAt int64) (string, map[string]*querypb.BindVariable) {
+	bindVars := make(map[string]*querypb.BindVariable, len(values)+2)
+	for colIdx, columnValue := range values {
+		bindVars[lkp.FromColumns[colIdx]] = sqltypes.ValueBindVariable(columnValue)
+	}
+	bindVars[lkp.To] = sqltypes.ValueBindVariable(to)
+	if tombstonedAt == 0 {
+		return lkp.del, bindVars
+	}
+	bindVars[lkp.TombstoneColumn] = sqltypes.Int64BindVariable(tombstonedAt)
+	return lkp.tomb, bindVars
+}
+
+// Update implements the update functionality. The old entry is deleted
+// rather than kept as a tombstone, since its row was not deleted.
 func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, toValue sqltypes.Value, newValues []sqltypes.Value) error {
-	if err := lkp.Delete(vcursor, [][]sqltypes.Value{oldValues}, toValue, vtgatepb.CommitOrder_NORMAL); err != nil {
+	if err := lkp.deleteEntries(vcursor, [][]sqltypes.Value{oldValues}, toValue, vtgatepb.CommitOrder_NORMAL, false /* tombstone */); err != nil {
 		return err
 	}
 	return lkp.Create(vcursor, [][]sqltypes.Value{newValues}, []sqltypes.Value{toValue}, false /* ignoreMode */)
 }
 
+// initAsync sets up the asynchronous writes of the vindex name, from the
+// async_queue param. The unique lookups don't support them: their applied
+// inserts would have to overwrite the entry of another owner row of the
+// same value.
+func (lkp *lookupInternal) initAsync(name string, m map[string]string, unique bool) error {
+	queue := m["async_queue"]
+	if queue == "" {
+		return nil
+	}
+	if unique {
+		return fmt.Errorf("async_queue is not supported by the unique lookup vindexes")
+	}
+	lkp.AsyncQueue, lkp.asyncVindex = queue, name
+	return nil
+}
+
+// enqueue inserts the write of the entry of the values into the AsyncQueue
+// message table, as part of the transaction of the owner table, for the
+// vtgates to apply it asynchronously. The message only holds the operation
+// and the values, as an AsyncWrite: the vtgates build the statement from the
+// vindex, so that a message can only write the lookup table of its vindex.
+// The ids of the messages grow with the time of their enqueueing, with random
+// low bits that keep the ids of different vtgates apart, and the vtgates apply
+// the messages of a batch in the order of their ids. A message that fails is
+// delivered again after the ack wait of the queue though, so that it can be
+// applied after a later write of the same values: the inserts are upserts,
+// which only the non-unique lookups can afford, and the deletes match the
+// 'to' value.
+func (lkp *lookupInternal) enqueue(vcursor VCursor, method, op string, values []sqltypes.Value, to sqltypes.Value, tombstonedAt int64, co vtgatepb.CommitOrder) error {
+	write := &AsyncWrite{
+		Vindex:       lkp.asyncVindex,
+		Op:           op,
+		To:           sqltypes.ValueToProto(to),
+		TombstonedAt: tombstonedAt,
+	}
+	for _, value := range values {
+		write.From = append(write.From, sqltypes.ValueToProto(value))
+	}
+	message, err := json.Marshal(write)
+	if err != nil {
+		return err
+	}
+	_, err = vcursor.Execute(method, fmt.Sprintf("insert into %s(id, message) values(:id, :message)", lkp.AsyncQueue), map[string]*querypb.BindVariable{
+		"id":      sqltypes.Int64BindVariable(time.Now().UnixMicro()<<asyncIDRandomBits | rand.Int63n(1<<asyncIDRandomBits)),
+		"message": sqltypes.StringBindVariable(string(message)),
+	}, true /* rollbackOnError */, co)
+	return err
+}
+
+// asyncIDRandomBits is the number of random low bits of the ids of the
+// messages of an AsyncQueue.
+const asyncIDRandomBits = 11
+
+// asyncWriteQuery returns the statement that applies a write that enqueue
+// enqueued, and its bind variables.
+func (lkp *lookupInternal) asyncWriteQuery(write *AsyncWrite) (string, map[string]*querypb.BindVariable, error) {
+	if len(write.From) != len(lkp.FromColumns) || write.To == nil {
+		return "", nil, fmt.Errorf("async write of vindex %s must have %d from values and a to value", lkp.asyncVindex, len(lkp.FromColumns))
+	}
+	values := make([]sqltypes.Value, len(write.From))
+	for i, value := range write.From {
+		values[i] = sqltypes.ProtoToValue(value)
+	}
+	to := sqltypes.ProtoToValue(write.To)
+	switch write.Op {
+	case AsyncInsert:
+		query, bindVars := lkp.insertQuery([][]sqltypes.Value{values}, []sqltypes.Value{to}, false /* ignoreMode */, true /* upsert */)
+		return query, bindVars, nil
+	case AsyncDelete:
+		query, bindVars := lkp.deleteQuery(values, to, 0)
+		return query, bindVars, nil
+	case AsyncTombstone:
+		if lkp.TombstoneTTL == 0 || write.TombstonedAt <= 0 {
+			return "", nil, fmt.Errorf("async tombstone of vindex %s without tombstones or time", lkp.asyncVindex)
+		}
+		query, bindVars := lkp.deleteQuery(values, to, write.TombstonedAt)
+		return query, bindVars, nil
+	}
+	return "", nil, fmt.Errorf("unknown async write operation '%s' of vindex %s", write.Op, lkp.asyncVindex)
+}
+
+// missDestination returns the destination of a value that is not in the
+// lookup table. If the writes are asynchronous, the value may not have been
+// applied yet, so it can be in any shard.
+func (lkp *lookupInternal) missDestination() key.Destination {
+	if lkp.AsyncQueue != "" {
+		return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}}
+	}
+	return key.DestinationNone{}
+}
+
 func (lkp *lookupInternal) initDelStmt() string {
 	var delBuffer bytes.Buffer
 	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
@@ -343,6 +644,94 @@ func (lkp *lookupInternal) initDelStmt() string {
 	return delBuffer.String()
 }
 
+// tombstoneDelStmt returns the statement that deletes the tombstones of the
+// values of the rows that Create inserts, with the same bind variables.
+func (lkp *lookupInternal) tombstoneDelStmt(rows int) string {
+	var delBuffer bytes.Buffer
+	fmt.Fprintf(&delBuffer, "delete from %s where %s is not null and (", lkp.Table, lkp.TombstoneColumn)
+	for rowIdx := 0; rowIdx < rows; rowIdx++ {
+		if rowIdx != 0 {
+			delBuffer.WriteString(" or ")
+		}
+		delBuffer.WriteString("(")
+		for colIdx, column := range lkp.FromColumns {
+			if colIdx != 0 {
+				delBuffer.WriteString(" and ")
+			}
+			delBuffer.WriteString(column + " = :" + column + "_" + strconv.Itoa(rowIdx))
+		}
+		delBuffer.WriteString(")")
+	}
+	delBuffer.WriteString(")")
+	return delBuffer.String()
+}
+
+// collation returns the collation of the from column col, or collations.Unknown
+// if it has none.
+func (lkp *lookupInternal) collation(col int) collations.ID {
+	if col < len(lkp.collationIDs) {
+		return lkp.collationIDs[col]
+	}
+	return collations.Unknown
+}
+
+// compare compares two values of the from column col with its collation,
+// or as bytes if it has none.
+func (lkp *lookupInternal) compare(col int, v1, v2 sqltypes.Value) int {
+	if collation := lkp.collation(col); collation != collations.Unknown {
+		if compare, err := evalengine.NullsafeCompare(v1, v2, collation); err == nil {
+			return compare
+		}
+	}
+	b1, _ := v1.ToBytes()
+	b2, _ := v2.ToBytes()
+	return bytes.Compare(b1, b2)
+}
+
+// collationsFromMap returns the collations of the columns, listed by the
+// comma-separated collation names of the key: one for all the columns, or one
+// for each column. An empty name leaves its column without collation.
+func collationsFromMap(m map[string]string, key string, columns int) ([]string, []collations.ID, error) {
+	val, ok := m[key]
+	if !ok {
+		return nil, nil, nil
+	}
+	names := strings.Split(val, ",")
+	if len(names) == 1 {
+		for len(names) < columns {
+			names = append(names, names[0])
+		}
+	}
+	if len(names) != columns {
+		return nil, nil, fmt.Errorf("%s must have one collation or one per column: '%s'", key, val)
+	}
+	ids := make([]collations.ID, len(names))
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+		if names[i] == "" {
+			continue
+		}
+		collation := collations.Local().LookupByName(names[i])
+		if collation == nil {
+			return nil, nil, fmt.Errorf("%s has an unknown collation: '%s'", key, names[i])
+		}
+		ids[i] = collation.ID()
+	}
+	return names, ids, nil
+}
+
+func intFromMap(m map[string]string, key string) (int, error) {
+	val, ok := m[key]
+	if !ok {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%s value must be a non-negative integer: '%s'", key, val)
+	}
+	return i, nil
+}
+
 func boolFromMap(m map[string]string, key string) (bool, error) {
 	val, ok := m[key]
 	if !ok {
@@ -17,9 +17,13 @@ limitations under the License.
 package vindexes
 
 import (
+	"encoding/json"
 	"errors"
 	"reflect"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/test/utils"
 
@@ -34,6 +38,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	"vitess.io/vitess/go/vt/sqlparser"
 )
 
 // LookupNonUnique tests are more comprehensive than others.
@@ -42,6 +47,9 @@ import (
 var _ VCursor = (*vcursor)(nil)
 
 type vcursor struct {
+	// mu protects the vcursor from the lookups that execute their
+	// queries concurrently.
+	mu          sync.Mutex
 	mustFail    bool
 	numRows     int
 	result      *sqltypes.Result
@@ -60,6 +68,8 @@ func (vc *vcursor) InTransactionAndIsDML() bool {
 }
 
 func (vc *vcursor) Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
 	switch co {
 	case vtgatepb.CommitOrder_PRE:
 		vc.pre++
@@ -106,7 +116,7 @@ func (vc *vcursor) execute(method string, query string, bindvars map[string]*que
 		return result, nil
 	case strings.HasPrefix(query, "insert"):
 		return &sqltypes.Result{InsertID: 1}, nil
-	case strings.HasPrefix(query, "delete"):
+	case strings.HasPrefix(query, "delete"), strings.HasPrefix(query, "update"):
 		return &sqltypes.Result{}, nil
 	}
 	panic("unexpected")
@@ -239,6 +249,89 @@ func TestLookupNonUniqueMapAutocommit(t *testing.T) {
 	}
 }
 
+func TestLookupNonUniqueMapBatch(t *testing.T) {
+	_, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"batch_size": "-1",
+	})
+	require.EqualError(t, err, "batch_size value must be a non-negative integer: '-1'")
+
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3), sqltypes.NewInt64(4), sqltypes.NewInt64(5)}
+	var wantqueries []*querypb.BoundQuery
+	for _, batch := range [][]sqltypes.Value{ids[:2], ids[2:4], ids[4:]} {
+		vars, err := sqltypes.BuildBindVariable(batch)
+		require.NoError(t, err)
+		wantqueries = append(wantqueries, &querypb.BoundQuery{
+			Sql: "select fromc, toc from t where fromc in ::fromc",
+			BindVariables: map[string]*querypb.BindVariable{
+				"fromc": vars,
+			},
+		})
+	}
+	wantDestinations := []key.Destination{key.DestinationNone{}, key.DestinationNone{}, key.DestinationNone{}, key.DestinationNone{}, key.DestinationNone{}}
+
+	// The batches share the session, so they are executed in order, and
+	// they can't be executed in parallel.
+	_, err = CreateVindex("lookup", "lookup", map[string]string{
+		"table":             "t",
+		"from":              "fromc",
+		"to":                "toc",
+		"batch_size":        "2",
+		"batch_concurrency": "2",
+	})
+	require.EqualError(t, err, "batch_concurrency is only supported with autocommit, the lookups of the other vindexes share the session of the client")
+	_, err = CreateVindex("consistent_lookup", "lookup", map[string]string{
+		"table":             "t",
+		"from":              "fromc",
+		"to":                "toc",
+		"batch_concurrency": "2",
+	})
+	require.Error(t, err)
+	vindex, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"batch_size": "2",
+	})
+	require.NoError(t, err)
+	vc := &vcursor{}
+	got, err := vindex.(SingleColumn).Map(vc, ids)
+	require.NoError(t, err)
+	assert.Equal(t, wantDestinations, got)
+	utils.MustMatch(t, wantqueries, vc.queries, "lookup.Map")
+
+	vc = &vcursor{mustFail: true}
+	_, err = vindex.(SingleColumn).Map(vc, ids)
+	require.EqualError(t, err, "lookup.Map: execute failed")
+	assert.Len(t, vc.queries, 1)
+
+	// In autocommit, every batch has a session of its own.
+	vindex, err = CreateVindex("lookup", "lookup", map[string]string{
+		"table":             "t",
+		"from":              "fromc",
+		"to":                "toc",
+		"autocommit":        "true",
+		"batch_size":        "2",
+		"batch_concurrency": "2",
+	})
+	require.NoError(t, err)
+	vc = &vcursor{}
+	got, err = vindex.(SingleColumn).Map(vc, ids)
+	require.NoError(t, err)
+	assert.Equal(t, wantDestinations, got)
+	sort.Slice(vc.queries, func(i, j int) bool {
+		return string(vc.queries[i].BindVariables["fromc"].Values[0].Value) < string(vc.queries[j].BindVariables["fromc"].Values[0].Value)
+	})
+	utils.MustMatch(t, wantqueries, vc.queries, "lookup.Map")
+	assert.Equal(t, 3, vc.autocommits)
+
+	vc = &vcursor{mustFail: true}
+	_, err = vindex.(SingleColumn).Map(vc, ids)
+	require.EqualError(t, err, "lookup.Map: execute failed")
+}
+
 func TestLookupNonUniqueMapWriteOnly(t *testing.T) {
 	lookupNonUnique := createLookup(t, "lookup", true)
 	vc := &vcursor{numRows: 0}
@@ -555,6 +648,197 @@ func TestLookupNonUniqueUpdate(t *testing.T) {
 	}
 }
 
+func TestLookupNonUniqueAsync(t *testing.T) {
+	lookupNonUnique, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":       "t",
+		"from":        "fromc",
+		"to":          "toc",
+		"async_queue": "ks.q",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ks.q", lookupNonUnique.(LookupAsync).AsyncQueue())
+	vc := &vcursor{}
+
+	// The values that are not found may not have been applied yet.
+	got, err := lookupNonUnique.(SingleColumn).Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}}}, got)
+
+	vc.queries = nil
+	err = lookupNonUnique.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewInt64(1)}, []byte("test"), []sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	wantMessages := []string{
+		"delete from t where fromc = 1 and toc = 'test'",
+		"insert into t(fromc, toc) values (2, 'test') on duplicate key update fromc = values(fromc), toc = values(toc)",
+	}
+	require.Len(t, vc.queries, len(wantMessages))
+	for i, query := range vc.queries {
+		assert.Equal(t, "insert into ks.q(id, message) values(:id, :message)", query.Sql)
+		assert.Equal(t, wantMessages[i], asyncWriteSQL(t, lookupNonUnique.(LookupAsync), query.BindVariables["message"]))
+	}
+
+	// The messages only hold values, whose statement is built by the vindex.
+	_, _, err = lookupNonUnique.(LookupAsync).AsyncWriteQuery(&AsyncWrite{Vindex: "lookup", Op: "drop"})
+	assert.EqualError(t, err, "async write of vindex lookup must have 1 from values and a to value")
+	_, _, err = lookupNonUnique.(LookupAsync).AsyncWriteQuery(&AsyncWrite{
+		Vindex: "lookup",
+		Op:     "drop",
+		From:   []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(1))},
+		To:     sqltypes.ValueToProto(sqltypes.NewVarBinary("test")),
+	})
+	assert.EqualError(t, err, "unknown async write operation 'drop' of vindex lookup")
+}
+
+// asyncWriteSQL returns the statement that applies the async write of the
+// message, with its bind variables substituted.
+func asyncWriteSQL(t *testing.T, lookup LookupAsync, message *querypb.BindVariable) string {
+	t.Helper()
+	var write AsyncWrite
+	require.NoError(t, json.Unmarshal(message.Value, &write))
+	query, bindVars, err := lookup.AsyncWriteQuery(&write)
+	require.NoError(t, err)
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	sql, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(bindVars, nil)
+	require.NoError(t, err)
+	return sql
+}
+
+func TestLookupUniqueTombstones(t *testing.T) {
+	lookupUnique, err := CreateVindex("lookup_unique", "lookup_unique", map[string]string{
+		"table":         "t",
+		"from":          "fromc",
+		"to":            "toc",
+		"tombstone_ttl": "24h",
+	})
+	require.NoError(t, err)
+	tombstones := lookupUnique.(LookupTombstone)
+	assert.Equal(t, 24*time.Hour, tombstones.TombstoneTTL())
+	assert.Equal(t, "delete from t where tombstoned_at < :before", tombstones.TombstonePurgeQuery())
+	vc := &vcursor{}
+
+	// The entries of the deleted rows are kept as tombstones.
+	err = lookupUnique.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, []byte("test"))
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, "update t set tombstoned_at = :tombstoned_at where fromc = :fromc and toc = :toc and tombstoned_at is null", vc.queries[0].Sql)
+	assert.Contains(t, vc.queries[0].BindVariables, "tombstoned_at")
+
+	// The inserted entries replace the tombstones of their values.
+	vc.queries = nil
+	err = lookupUnique.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}}, [][]byte{[]byte("test1"), []byte("test2")}, false /* ignoreMode */)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 2)
+	assert.Equal(t, "delete from t where tombstoned_at is not null and ((fromc = :fromc_0) or (fromc = :fromc_1))", vc.queries[0].Sql)
+	assert.Equal(t, "insert into t(fromc, toc) values(:fromc_0, :toc_0), (:fromc_1, :toc_1)", vc.queries[1].Sql)
+	assert.Equal(t, vc.queries[0].BindVariables, vc.queries[1].BindVariables)
+
+	// The old entries of the updated rows are deleted.
+	vc.queries = nil
+	err = lookupUnique.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewInt64(1)}, []byte("test"), []sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 3)
+	assert.Equal(t, "delete from t where fromc = :fromc and toc = :toc", vc.queries[0].Sql)
+
+	// The tombstones don't verify their values.
+	vc.queries = nil
+	_, err = lookupUnique.(SingleColumn).Verify(vc, []sqltypes.Value{sqltypes.NewInt64(1)}, [][]byte{[]byte("test")})
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, "select fromc from t where fromc = :fromc and toc = :toc and tombstoned_at is null", vc.queries[0].Sql)
+
+	_, err = CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "tombstone_ttl": "0s"})
+	assert.EqualError(t, err, "tombstone_ttl value must be a positive duration: '0s'")
+	_, err = CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "tombstone_ttl": "1h", "autocommit": "true"})
+	assert.EqualError(t, err, "tombstone_ttl is not supported with autocommit, whose deletes are ignored")
+	l, err := CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc"})
+	require.NoError(t, err)
+	assert.Empty(t, l.(LookupTombstone).TombstonePurgeQuery())
+}
+
+func TestLookupNonUniqueTombstonesAsync(t *testing.T) {
+	lookupNonUnique, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":            "t",
+		"from":             "fromc",
+		"to":               "toc",
+		"async_queue":      "ks.q",
+		"tombstone_ttl":    "1h",
+		"tombstone_column": "deleted_at",
+	})
+	require.NoError(t, err)
+	vc := &vcursor{}
+
+	// The asynchronous inserts are upserts that revive the tombstones.
+	err = lookupNonUnique.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("test")}, false /* ignoreMode */)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, "insert into t(fromc, toc) values (1, 'test') on duplicate key update fromc = values(fromc), toc = values(toc), deleted_at = null", asyncWriteSQL(t, lookupNonUnique.(LookupAsync), vc.queries[0].BindVariables["message"]))
+
+	// The deletes keep the tombstones, at the time of the delete.
+	vc.queries = nil
+	err = lookupNonUnique.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, []byte("test"))
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	var write AsyncWrite
+	require.NoError(t, json.Unmarshal(vc.queries[0].BindVariables["message"].Value, &write))
+	assert.Equal(t, AsyncTombstone, write.Op)
+	assert.NotZero(t, write.TombstonedAt)
+}
+
+func TestLookupCollations(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"collations": "utf8mb4_general_ci",
+	})
+	require.NoError(t, err)
+	vc := &vcursor{}
+
+	// The values that are equal in the collation of their column are
+	// inserted once for the same keyspace id.
+	err = l.(Lookup).Create(vc, [][]sqltypes.Value{
+		{sqltypes.NewVarChar("abc")},
+		{sqltypes.NewVarChar("ABC")},
+		{sqltypes.NewVarChar("abd")},
+		{sqltypes.NewVarChar("Abc")},
+	}, [][]byte{[]byte("test1"), []byte("test1"), []byte("test1"), []byte("test2")}, false /* ignoreMode */)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, "insert into t(fromc, toc) values(:fromc_0, :toc_0), (:fromc_1, :toc_1), (:fromc_2, :toc_2)", vc.queries[0].Sql)
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"fromc_0": sqltypes.StringBindVariable("abc"),
+		"toc_0":   sqltypes.BytesBindVariable([]byte("test1")),
+		"fromc_1": sqltypes.StringBindVariable("Abc"),
+		"toc_1":   sqltypes.BytesBindVariable([]byte("test2")),
+		"fromc_2": sqltypes.StringBindVariable("abd"),
+		"toc_2":   sqltypes.BytesBindVariable([]byte("test1")),
+	}, vc.queries[0].BindVariables)
+
+	// The equal values of a unique lookup are all inserted, so that the
+	// lookup table rejects them.
+	lu, err := CreateVindex("lookup_unique", "lookup_unique", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"collations": "utf8mb4_general_ci",
+	})
+	require.NoError(t, err)
+	vc = &vcursor{}
+	err = lu.(Lookup).Create(vc, [][]sqltypes.Value{
+		{sqltypes.NewVarChar("abc")},
+		{sqltypes.NewVarChar("ABC")},
+	}, [][]byte{[]byte("test1"), []byte("test1")}, false /* ignoreMode */)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, "insert into t(fromc, toc) values(:fromc_0, :toc_0), (:fromc_1, :toc_1)", vc.queries[0].Sql)
+
+	_, err = CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "collations": "utf8mb4_bin,binary"})
+	assert.EqualError(t, err, "collations must have one collation or one per column: 'utf8mb4_bin,binary'")
+	_, err = CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "collations": "utf8mb4_nope"})
+	assert.EqualError(t, err, "collations has an unknown collation: 'utf8mb4_nope'")
+}
+
 func createLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
 	t.Helper()
 	write := "false"
@@ -572,3 +856,15 @@ func createLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
 	}
 	return l.(SingleColumn)
 }
+
+func TestLookupUniqueAsync(t *testing.T) {
+	// The applied inserts of a unique lookup would overwrite the entry of
+	// another owner row of the same value.
+	_, err := CreateVindex("lookup_unique", "lookup_unique", map[string]string{
+		"table":       "t",
+		"from":        "fromc",
+		"to":          "toc",
+		"async_queue": "ks.q",
+	})
+	assert.EqualError(t, err, "async_queue is not supported by the unique lookup vindexes")
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -253,6 +254,7 @@ func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vinde
 	if err := lhu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
+	lhu.lkp.unique = true
 	return lhu, nil
 }
 
@@ -306,7 +308,7 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) Map(vcursor VCursor, ids []sqltypes.
 			}
 			out = append(out, key.DestinationKeyspaceID(vhash(num)))
 		default:
-			return nil, fmt.Errorf("LookupUnicodeLooseMD5HashUnique.Map: unexpected multiple results from vindex %s: %v", lhu.lkp.Table, ids[i])
+			return nil, fmt.Errorf("LookupUnicodeLooseMD5HashUnique.Map: unexpected multiple results from vindex %s: %v", lhu.lkp.Table, obfuscation.Value(ids[i]))
 		}
 	}
 	return out, nil
@@ -19,10 +19,10 @@ package vindexes
 import (
 	"bytes"
 	"encoding/binary"
-	"encoding/hex"
 	"fmt"
 	"math/bits"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -126,7 +126,7 @@ func reverse(shardKey uint64) []byte {
 
 func unreverse(k []byte) (uint64, error) {
 	if len(k) != 8 {
-		return 0, fmt.Errorf("invalid keyspace id: %v", hex.EncodeToString(k))
+		return 0, fmt.Errorf("invalid keyspace id: %v", obfuscation.KeyspaceID(k))
 	}
 	return bits.Reverse64(binary.BigEndian.Uint64(k)), nil
 }
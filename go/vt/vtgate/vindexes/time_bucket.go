@@ -0,0 +1,216 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/obfuscation"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ SingleColumn = (*TimeBucket)(nil)
+	_ Sequential   = (*TimeBucket)(nil)
+)
+
+const (
+	timeBucketDay   = "day"
+	timeBucketWeek  = "week"
+	timeBucketMonth = "month"
+)
+
+// timeBucketLayouts are the layouts of the DATE, DATETIME and TIMESTAMP
+// values the TimeBucket vindex maps.
+var timeBucketLayouts = []string{"2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// TimeBucket maps DATE, DATETIME and TIMESTAMP values to the start of their
+// day, week (starting on Monday) or month. The keyspace id is the Unix time
+// of the start of the bucket, in seconds, as a big-endian uint64, so that the
+// keyspace ids are in the order of the values and a keyspace can be range
+// sharded by date. The values are in UTC, and cannot be before 1970.
+// It's Unique and Sequential.
+type TimeBucket struct {
+	name   string
+	bucket string
+}
+
+// NewTimeBucket creates a TimeBucket vindex.
+// The supplied map has one optional parameter:
+// bucket: day, week or month. The default is day.
+func NewTimeBucket(name string, m map[string]string) (Vindex, error) {
+	bucket := m["bucket"]
+	switch bucket {
+	case "":
+		bucket = timeBucketDay
+	case timeBucketDay, timeBucketWeek, timeBucketMonth:
+	default:
+		return nil, fmt.Errorf("time_bucket: invalid bucket '%s', expected %s, %s or %s", bucket, timeBucketDay, timeBucketWeek, timeBucketMonth)
+	}
+	return &TimeBucket{name: name, bucket: bucket}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *TimeBucket) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*TimeBucket) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*TimeBucket) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*TimeBucket) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids maps to ksids.
+func (vind *TimeBucket) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i, id := range ids {
+		ksid, err := vind.keyspaceID(id)
+		if err != nil {
+			continue
+		}
+		out[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects. The values that are not
+// dates map to all the shards, since MySQL converts them to dates when it
+// compares them to the column, while NULL and the dates before 1970 match
+// no row.
+func (vind *TimeBucket) Map(_ VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		if id.IsNull() {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		t, err := parseTimeBucketValue(id)
+		switch {
+		case err != nil:
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		case t.Unix() < 0:
+			out = append(out, key.DestinationNone{})
+		default:
+			out = append(out, key.DestinationKeyspaceID(vind.bucketKeyspaceID(t)))
+		}
+	}
+	return out, nil
+}
+
+// RangeMap maps the range of values between low and high to the keyspace
+// range of their buckets. The range cannot be narrowed if a bound is not a
+// valid date.
+func (vind *TimeBucket) RangeMap(_ VCursor, low, high sqltypes.Value) (key.Destination, error) {
+	var start, end []byte
+	if !low.IsNull() {
+		t, err := parseTimeBucketValue(low)
+		if err != nil {
+			return key.DestinationAllShards{}, nil
+		}
+		if t.Unix() >= 0 {
+			start = vind.bucketKeyspaceID(t)
+		}
+	}
+	if !high.IsNull() {
+		t, err := parseTimeBucketValue(high)
+		if err != nil {
+			return key.DestinationAllShards{}, nil
+		}
+		if t.Unix() < 0 {
+			// No value of the vindex is before 1970.
+			return key.DestinationNone{}, nil
+		}
+		end = addOne(vind.bucketKeyspaceID(t))
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return key.DestinationNone{}, nil
+	}
+	return key.DestinationKeyRange{
+		KeyRange: &topodatapb.KeyRange{
+			Start: start,
+			End:   end,
+		},
+	}, nil
+}
+
+func (vind *TimeBucket) keyspaceID(id sqltypes.Value) ([]byte, error) {
+	t, err := parseTimeBucketValue(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Unix() < 0 {
+		return nil, fmt.Errorf("time_bucket: value is before 1970: %s", obfuscation.Value(id))
+	}
+	return vind.bucketKeyspaceID(t), nil
+}
+
+// bucketKeyspaceID returns the keyspace id of the bucket of t, which must
+// not be before 1970.
+func (vind *TimeBucket) bucketKeyspaceID(t time.Time) []byte {
+	year, month, day := t.Date()
+	var start time.Time
+	switch vind.bucket {
+	case timeBucketWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		start = time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case timeBucketMonth:
+		start = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		start = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	seconds := start.Unix()
+	if seconds < 0 {
+		// The first week of 1970 started in 1969.
+		seconds = 0
+	}
+	var keybytes [8]byte
+	binary.BigEndian.PutUint64(keybytes[:], uint64(seconds))
+	return keybytes[:]
+}
+
+func parseTimeBucketValue(id sqltypes.Value) (time.Time, error) {
+	if id.IsNull() {
+		return time.Time{}, fmt.Errorf("time_bucket: value is NULL")
+	}
+	s := id.ToString()
+	for _, layout := range timeBucketLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("time_bucket: value is not a date: %s", obfuscation.Value(id))
+}
+
+func init() {
+	Register("time_bucket", NewTimeBucket)
+}
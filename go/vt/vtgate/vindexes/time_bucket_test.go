@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createTimeBucket(t *testing.T, bucket string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("time_bucket", "tb", map[string]string{"bucket": bucket})
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestTimeBucketInfo(t *testing.T) {
+	tb := createTimeBucket(t, "")
+	assert.Equal(t, 1, tb.Cost())
+	assert.Equal(t, "tb", tb.String())
+	assert.True(t, tb.IsUnique())
+	assert.False(t, tb.NeedsVCursor())
+	assert.Equal(t, "day", tb.(*TimeBucket).bucket)
+
+	_, err := CreateVindex("time_bucket", "tb", map[string]string{"bucket": "year"})
+	assert.EqualError(t, err, "time_bucket: invalid bucket 'year', expected day, week or month")
+}
+
+func TestTimeBucketMap(t *testing.T) {
+	ids := []sqltypes.Value{
+		sqltypes.NewVarChar("2022-03-16 10:20:30"),
+		sqltypes.NewVarChar("2022-03-16"),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2022-03-16 23:59:59.999999")),
+		sqltypes.NewVarChar("1970-01-01"),
+		sqltypes.NewVarChar("1969-12-31 23:59:59"),
+		sqltypes.NewVarChar("not a date"),
+		sqltypes.NewInt64(1),
+		sqltypes.NULL,
+	}
+	invalid := []key.Destination{
+		key.DestinationNone{},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+		key.DestinationNone{},
+	}
+	tcases := []struct {
+		bucket string
+		want   []key.Destination
+	}{{
+		bucket: "day",
+		want: append([]key.Destination{
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x31\x28\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x31\x28\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x31\x28\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x00\x00\x00\x00"),
+		}, invalid...),
+	}, {
+		bucket: "week",
+		want: append([]key.Destination{
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x2e\x85\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x2e\x85\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x2e\x85\x80"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x00\x00\x00\x00"),
+		}, invalid...),
+	}, {
+		bucket: "month",
+		want: append([]key.Destination{
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x1d\x62\x00"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x1d\x62\x00"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x62\x1d\x62\x00"),
+			key.DestinationKeyspaceID("\x00\x00\x00\x00\x00\x00\x00\x00"),
+		}, invalid...),
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.bucket, func(t *testing.T) {
+			got, err := createTimeBucket(t, tcase.bucket).Map(nil, ids)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestTimeBucketVerify(t *testing.T) {
+	got, err := createTimeBucket(t, "day").Verify(nil,
+		[]sqltypes.Value{sqltypes.NewVarChar("2022-03-16 10:20:30"), sqltypes.NewVarChar("2022-03-17"), sqltypes.NewVarChar("not a date")},
+		[][]byte{[]byte("\x00\x00\x00\x00\x62\x31\x28\x80"), []byte("\x00\x00\x00\x00\x62\x31\x28\x80"), []byte("\x00\x00\x00\x00\x62\x31\x28\x80")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestTimeBucketRangeMap(t *testing.T) {
+	keyRange := func(start, end string) key.Destination {
+		kr := &topodatapb.KeyRange{}
+		if start != "" {
+			kr.Start = []byte(start)
+		}
+		if end != "" {
+			kr.End = []byte(end)
+		}
+		return key.DestinationKeyRange{KeyRange: kr}
+	}
+	tcases := []struct {
+		bucket    string
+		low, high sqltypes.Value
+		want      key.Destination
+	}{{
+		bucket: "day",
+		low:    sqltypes.NewVarChar("2022-03-16 10:20:30"),
+		high:   sqltypes.NewVarChar("2022-03-17 00:00:00"),
+		want:   keyRange("\x00\x00\x00\x00\x62\x31\x28\x80", "\x00\x00\x00\x00\x62\x32\x7a\x01"),
+	}, {
+		bucket: "week",
+		low:    sqltypes.NewVarChar("2022-03-16"),
+		high:   sqltypes.NewVarChar("2022-03-21"),
+		want:   keyRange("\x00\x00\x00\x00\x62\x2e\x85\x80", "\x00\x00\x00\x00\x62\x37\xc0\x01"),
+	}, {
+		bucket: "month",
+		low:    sqltypes.NewVarChar("2022-03-16"),
+		high:   sqltypes.NewVarChar("2022-04-01"),
+		want:   keyRange("\x00\x00\x00\x00\x62\x1d\x62\x00", "\x00\x00\x00\x00\x62\x46\x40\x81"),
+	}, {
+		bucket: "month",
+		low:    sqltypes.NULL,
+		high:   sqltypes.NewVarChar("2022-04-01"),
+		want:   keyRange("", "\x00\x00\x00\x00\x62\x46\x40\x81"),
+	}, {
+		bucket: "month",
+		low:    sqltypes.NewVarChar("2022-03-16"),
+		high:   sqltypes.NULL,
+		want:   keyRange("\x00\x00\x00\x00\x62\x1d\x62\x00", ""),
+	}, {
+		bucket: "day",
+		low:    sqltypes.NewVarChar("1969-01-01"),
+		high:   sqltypes.NewVarChar("1970-01-01"),
+		want:   keyRange("", "\x00\x00\x00\x00\x00\x00\x00\x01"),
+	}, {
+		bucket: "day",
+		low:    sqltypes.NewVarChar("2022-03-17"),
+		high:   sqltypes.NewVarChar("2022-03-16"),
+		want:   key.DestinationNone{},
+	}, {
+		bucket: "day",
+		low:    sqltypes.NULL,
+		high:   sqltypes.NewVarChar("1969-12-31"),
+		want:   key.DestinationNone{},
+	}, {
+		bucket: "day",
+		low:    sqltypes.NewVarChar("not a date"),
+		high:   sqltypes.NewVarChar("2022-03-16"),
+		want:   key.DestinationAllShards{},
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.bucket+" "+tcase.low.String()+" "+tcase.high.String(), func(t *testing.T) {
+			got, err := createTimeBucket(t, tcase.bucket).(Sequential).RangeMap(nil, tcase.low, tcase.high)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package vindexes
 
 import (
 	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
@@ -116,6 +117,16 @@ type Prefixable interface {
 	PrefixVindex() SingleColumn
 }
 
+// A Sequential vindex is one that maps the ids to keyspace ids in their
+// order, so that a range of ids maps to a keyspace range. It's being used
+// to reduce the fan out for range expressions like 'BETWEEN', '<' and '>'.
+type Sequential interface {
+	SingleColumn
+	// RangeMap maps the ids between low and high, both included, to a
+	// key.Destination. A NULL bound leaves its side of the range open.
+	RangeMap(vcursor VCursor, low, high sqltypes.Value) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -140,6 +151,52 @@ type LookupBackfill interface {
 	IsBackfilling() bool
 }
 
+// LookupAsync interfaces all lookup vindexes that can enqueue the writes of
+// their lookup table into a message table, which the vtgates consume to
+// apply them asynchronously.
+type LookupAsync interface {
+	// AsyncQueue returns the message table, or "" if the writes are synchronous.
+	AsyncQueue() string
+
+	// AsyncWriteQuery returns the statement that applies a write that the
+	// vindex enqueued, and its bind variables.
+	AsyncWriteQuery(write *AsyncWrite) (string, map[string]*querypb.BindVariable, error)
+}
+
+// The operations of an AsyncWrite.
+const (
+	AsyncInsert    = "insert"
+	AsyncDelete    = "delete"
+	AsyncTombstone = "tombstone"
+)
+
+// AsyncWrite is a write of the lookup table of a LookupAsync vindex, which
+// is enqueued as the JSON message of its queue.
+type AsyncWrite struct {
+	// Vindex is the name of the vindex, in the keyspaces whose vindexes
+	// use the queue.
+	Vindex string           `json:"vindex"`
+	Op     string           `json:"op"`
+	From   []*querypb.Value `json:"from"`
+	To     *querypb.Value   `json:"to"`
+	// TombstonedAt is the time of deletion of an AsyncTombstone, as a unix
+	// timestamp.
+	TombstonedAt int64 `json:"tombstoned_at,omitempty"`
+}
+
+// LookupTombstone interfaces all lookup vindexes that can keep the entries
+// of the deleted rows in their lookup table, as tombstones that still route
+// their values until they are purged, e.g. to restore the rows without a
+// scatter.
+type LookupTombstone interface {
+	// TombstonePurgeQuery returns the query that purges the tombstones
+	// older than the :before bind variable, as a unix timestamp, or "" if
+	// the entries are not kept.
+	TombstonePurgeQuery() string
+	// TombstoneTTL returns how long the tombstones are kept.
+	TombstoneTTL() time.Duration
+}
+
 // WantOwnerInfo defines the interface that a vindex must
 // satisfy to request info about the owner table. This information can
 // be used to query the owner's table for the owning row's presence.
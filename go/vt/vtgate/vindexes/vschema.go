@@ -20,9 +20,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/sqlescape"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -30,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -59,16 +62,43 @@ const (
 // VSchema represents the denormalized version of SrvVSchema,
 // used for building routing plans.
 type VSchema struct {
-	RoutingRules   map[string]*RoutingRule `json:"routing_rules"`
-	uniqueTables   map[string]*Table
-	uniqueVindexes map[string]Vindex
-	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+	RoutingRules map[string]*RoutingRule `json:"routing_rules"`
+	// ScheduledRoutingRules are the routing rules that are in effect from a
+	// time, sorted by it. ForSession applies the ones in effect.
+	ScheduledRoutingRules map[string][]*RoutingRule `json:"scheduled_routing_rules,omitempty"`
+	uniqueTables          map[string]*Table
+	uniqueVindexes        map[string]Vindex
+	uniqueViews           map[string]sqlparser.SelectStatement
+	Keyspaces             map[string]*KeyspaceSchema `json:"keyspaces"`
+	// databases is keyed by the names of the databases, which can end with a wildcard.
+	databases  map[string]*Database
+	hasTenants bool
+
+	// hasRoutingAlternates is set if any routing rule has an alternate.
+	hasRoutingAlternates bool
+	// hasRoutingWrites is set if any routing rule has write tables.
+	hasRoutingWrites bool
 }
 
 // RoutingRule represents one routing rule.
 type RoutingRule struct {
-	Tables []*Table
-	Error  error
+	Tables    []*Table
+	Alternate *RoutingRuleAlternate
+	// WriteTables are the tables that the DML statements are routed to
+	// instead of Tables. A second table dual-writes them.
+	WriteTables []*Table
+	// EffectiveFrom is the time from which a scheduled rule is in effect.
+	EffectiveFrom time.Time
+	Error         error
+}
+
+// RoutingRuleAlternate represents the alternate target of a routing rule.
+// It is in effect for a percentage of the sessions and/or during a time window.
+type RoutingRuleAlternate struct {
+	Tables      []*Table
+	Percent     uint32
+	ActiveFrom  time.Time
+	ActiveUntil time.Time
 }
 
 // MarshalJSON returns a JSON representation of Column.
@@ -76,12 +106,86 @@ func (rr *RoutingRule) MarshalJSON() ([]byte, error) {
 	if rr.Error != nil {
 		return json.Marshal(rr.Error.Error())
 	}
-	tables := make([]string, 0, len(rr.Tables))
-	for _, t := range rr.Tables {
-		tables = append(tables, t.Keyspace.Name+"."+t.Name.String())
+	tables := tableNames(rr.Tables)
+	if rr.Alternate == nil && rr.WriteTables == nil && rr.EffectiveFrom.IsZero() {
+		return json.Marshal(tables)
+	}
+	var writeTables []string
+	if rr.WriteTables != nil {
+		writeTables = tableNames(rr.WriteTables)
+	}
+	var effectiveFrom *time.Time
+	if !rr.EffectiveFrom.IsZero() {
+		effectiveFrom = &rr.EffectiveFrom
+	}
+	if rr.Alternate == nil {
+		return json.Marshal(struct {
+			Tables        []string   `json:"tables"`
+			WriteTables   []string   `json:"write_tables,omitempty"`
+			EffectiveFrom *time.Time `json:"effective_from,omitempty"`
+		}{
+			Tables:        tables,
+			WriteTables:   writeTables,
+			EffectiveFrom: effectiveFrom,
+		})
+	}
+
+	alternate := struct {
+		Tables      []string   `json:"tables"`
+		Percent     uint32     `json:"percent,omitempty"`
+		ActiveFrom  *time.Time `json:"active_from,omitempty"`
+		ActiveUntil *time.Time `json:"active_until,omitempty"`
+	}{
+		Tables:  tableNames(rr.Alternate.Tables),
+		Percent: rr.Alternate.Percent,
+	}
+	if !rr.Alternate.ActiveFrom.IsZero() {
+		alternate.ActiveFrom = &rr.Alternate.ActiveFrom
+	}
+	if !rr.Alternate.ActiveUntil.IsZero() {
+		alternate.ActiveUntil = &rr.Alternate.ActiveUntil
+	}
+	return json.Marshal(struct {
+		Tables        []string    `json:"tables"`
+		Alternate     interface{} `json:"alternate"`
+		WriteTables   []string    `json:"write_tables,omitempty"`
+		EffectiveFrom *time.Time  `json:"effective_from,omitempty"`
+	}{
+		Tables:        tables,
+		Alternate:     alternate,
+		WriteTables:   writeTables,
+		EffectiveFrom: effectiveFrom,
+	})
+}
+
+func tableNames(tables []*Table) []string {
+	names := make([]string, 0, len(tables))
+	for _, t := range tables {
+		names = append(names, t.Keyspace.Name+"."+t.Name.String())
 	}
+	return names
+}
 
-	return json.Marshal(tables)
+// IsActive returns true if the alternate is in effect for the session at
+// the specified time. Sessions are selected deterministically by hashing
+// their id, so a session keeps its routing for as long as the alternate
+// does not change. Sessions without an id are never selected by percentage.
+func (alt *RoutingRuleAlternate) IsActive(sessionID string, now time.Time) bool {
+	if !alt.ActiveFrom.IsZero() && now.Before(alt.ActiveFrom) {
+		return false
+	}
+	if !alt.ActiveUntil.IsZero() && !now.Before(alt.ActiveUntil) {
+		return false
+	}
+	if alt.Percent == 0 || alt.Percent >= 100 {
+		return true
+	}
+	if sessionID == "" {
+		return false
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(sessionID))
+	return h.Sum32()%100 < alt.Percent
 }
 
 // Table represents a table in VSchema.
@@ -157,6 +261,7 @@ type KeyspaceSchema struct {
 	Keyspace *Keyspace
 	Tables   map[string]*Table
 	Vindexes map[string]Vindex
+	Views    map[string]sqlparser.SelectStatement
 	Error    error
 }
 
@@ -166,11 +271,22 @@ func (ks *KeyspaceSchema) MarshalJSON() ([]byte, error) {
 		Sharded  bool              `json:"sharded,omitempty"`
 		Tables   map[string]*Table `json:"tables,omitempty"`
 		Vindexes map[string]Vindex `json:"vindexes,omitempty"`
+		Views    map[string]string `json:"views,omitempty"`
 		Error    string            `json:"error,omitempty"`
 	}{
 		Sharded:  ks.Keyspace.Sharded,
 		Tables:   ks.Tables,
 		Vindexes: ks.Vindexes,
+		Views: func(ks *KeyspaceSchema) map[string]string {
+			if len(ks.Views) == 0 {
+				return nil
+			}
+			views := make(map[string]string, len(ks.Views))
+			for name, view := range ks.Views {
+				views[name] = sqlparser.String(view)
+			}
+			return views
+		}(ks),
 		Error: func(ks *KeyspaceSchema) string {
 			if ks.Error == nil {
 				return ""
@@ -180,6 +296,26 @@ func (ks *KeyspaceSchema) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Database is a database that the clients can use to refer to a keyspace.
+// The queries of a tenant database, whose name matched a wildcard, only see
+// the rows of its tenant, if the wildcard has a Vindex.
+type Database struct {
+	Keyspace   *Keyspace
+	VindexName string
+	Vindex     SingleColumn
+	Error      error
+}
+
+// Tenant is the tenant of a tenant database. Its queries only see the rows
+// of the tables whose column of the vindex is the tenant.
+type Tenant struct {
+	Database   string
+	Value      string
+	Keyspace   *Keyspace
+	VindexName string
+	Vindex     SingleColumn
+}
+
 // AutoIncrement contains the auto-inc information for a table.
 type AutoIncrement struct {
 	Column   sqlparser.ColIdent `json:"column"`
@@ -197,6 +333,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
 	addDual(vschema)
+	resolveViewCollisions(vschema)
 	buildRoutingRule(source, vschema)
 	return vschema
 }
@@ -242,6 +379,107 @@ func buildKeyspaces(source *vschemapb.SrvVSchema, vschema *VSchema) {
 		}
 		vschema.Keyspaces[ksname] = ksvschema
 		ksvschema.Error = buildTables(ks, vschema, ksvschema)
+		if ksvschema.Error == nil {
+			ksvschema.Error = buildViews(ks, vschema, ksvschema)
+		}
+		if ksvschema.Error == nil {
+			ksvschema.Error = buildDatabases(ks, vschema, ksvschema)
+		}
+	}
+}
+
+// buildDatabases adds the databases of the keyspace to the vschema. A
+// database of more than one keyspace maps to an error.
+func buildDatabases(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSchema) error {
+	for name, vindexName := range ks.Databases {
+		wildcard := strings.Index(name, "*")
+		switch {
+		case name == "" || name == "*":
+			return fmt.Errorf("invalid database name '%s'", name)
+		case wildcard != -1 && wildcard != len(name)-1:
+			return fmt.Errorf("database %s can only end with a wildcard", name)
+		case wildcard == -1 && vindexName != "":
+			return fmt.Errorf("database %s has a vindex but no wildcard", name)
+		}
+		database := &Database{Keyspace: ksvschema.Keyspace}
+		if vindexName != "" {
+			if !ks.Sharded {
+				return fmt.Errorf("database %s has a vindex but keyspace %s is not sharded", name, ksvschema.Keyspace.Name)
+			}
+			vindex, ok := ksvschema.Vindexes[vindexName].(SingleColumn)
+			if !ok {
+				return fmt.Errorf("vindex %s of database %s not found or not a single column vindex", vindexName, name)
+			}
+			if !vindex.IsUnique() || vindex.NeedsVCursor() {
+				return fmt.Errorf("vindex %s of database %s must be unique and not need a lookup", vindexName, name)
+			}
+			database.VindexName, database.Vindex = vindexName, vindex
+			vschema.hasTenants = true
+		}
+		if vschema.databases == nil {
+			vschema.databases = make(map[string]*Database)
+		}
+		if _, ok := vschema.databases[name]; ok {
+			database = &Database{Error: fmt.Errorf("database %s maps to more than one keyspace", name)}
+		}
+		vschema.databases[name] = database
+	}
+	return nil
+}
+
+// buildViews parses the views of the keyspace. The unqualified tables
+// that the views select from are qualified with the keyspace.
+func buildViews(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSchema) error {
+	for vname, query := range ks.Views {
+		if _, ok := ksvschema.Tables[vname]; ok {
+			return fmt.Errorf("view %s has the same name as a table", vname)
+		}
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return fmt.Errorf("could not parse the query of view %s: %s", vname, err.Error())
+		}
+		view, ok := stmt.(sqlparser.SelectStatement)
+		if !ok {
+			return fmt.Errorf("the query of view %s is not a SELECT statement: %s", vname, query)
+		}
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if ate, ok := node.(*sqlparser.AliasedTableExpr); ok {
+				if tableName, ok := ate.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() {
+					tableName.Qualifier = sqlparser.NewTableIdent(ksvschema.Keyspace.Name)
+					ate.Expr = tableName
+				}
+			}
+			return true, nil
+		}, view)
+
+		// If the keyspace requires explicit routing, don't include it in global routing
+		if !ks.RequireExplicitRouting {
+			if vschema.uniqueViews == nil {
+				vschema.uniqueViews = make(map[string]sqlparser.SelectStatement)
+			}
+			if _, ok := vschema.uniqueViews[vname]; ok {
+				vschema.uniqueViews[vname] = nil
+			} else {
+				vschema.uniqueViews[vname] = view
+			}
+		}
+		if ksvschema.Views == nil {
+			ksvschema.Views = make(map[string]sqlparser.SelectStatement)
+		}
+		ksvschema.Views[vname] = view
+	}
+	return nil
+}
+
+// resolveViewCollisions makes the unqualified names of the views that are
+// also the names of tables of other keyspaces ambiguous, so that neither
+// the view nor the table shadows the other one.
+func resolveViewCollisions(vschema *VSchema) {
+	for vname := range vschema.uniqueViews {
+		if _, ok := vschema.uniqueTables[vname]; ok {
+			vschema.uniqueViews[vname] = nil
+			vschema.uniqueTables[vname] = nil
+		}
 	}
 }
 
@@ -361,8 +599,8 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			if !isMultiColumn {
 				continue
 			}
-			if i != 0 {
-				return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-column vindex %s should be a primary vindex for table %s", ind.Name, tname)
+			if _, isLookup := vindex.(Lookup); i != 0 && !isLookup {
+				return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-column vindex %s should be a primary vindex or a lookup vindex for table %s", ind.Name, tname)
 			}
 			if !mcv.PartialVindex() {
 				// Partial column selection not allowed.
@@ -469,61 +707,249 @@ func escapeQualifiedTable(qualifiedTableName string) (string, error) {
 }
 
 func buildRoutingRule(source *vschemapb.SrvVSchema, vschema *VSchema) {
-	var err error
 	if source.RoutingRules == nil {
 		return
 	}
-outer:
+	scheduled := make(map[string][]*vschemapb.RoutingRule)
 	for _, rule := range source.RoutingRules.Rules {
-		rr := &RoutingRule{}
-		if len(rule.ToTables) > 1 {
+		if rule.EffectiveFrom != 0 {
+			scheduled[rule.FromTable] = append(scheduled[rule.FromTable], rule)
+			continue
+		}
+		if _, ok := vschema.RoutingRules[rule.FromTable]; ok && len(rule.ToTables) == 1 {
 			vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-				Error: fmt.Errorf("table %v has more than one target: %v", rule.FromTable, rule.ToTables),
+				Error: fmt.Errorf("duplicate rule for entry %s", rule.FromTable),
 			}
 			continue
 		}
-		for _, toTable := range rule.ToTables {
-			if _, ok := vschema.RoutingRules[rule.FromTable]; ok {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: fmt.Errorf("duplicate rule for entry %s", rule.FromTable),
-				}
-				continue outer
-			}
+		rr, err := vschema.buildOneRoutingRule(rule)
+		if err != nil {
+			rr = &RoutingRule{Error: err}
+		}
+		vschema.RoutingRules[rule.FromTable] = rr
+	}
+	for fromTable, rules := range scheduled {
+		vschema.buildScheduledRoutingRules(fromTable, rules)
+	}
+}
 
-			// we need to backtick the keyspace and table name before calling ParseTable
-			toTable, err = escapeQualifiedTable(toTable)
-			if err != nil {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: err,
-				}
-				continue outer
+func (vschema *VSchema) buildOneRoutingRule(rule *vschemapb.RoutingRule) (*RoutingRule, error) {
+	rr := &RoutingRule{}
+	if len(rule.ToTables) > 1 {
+		return nil, fmt.Errorf("table %v has more than one target: %v", rule.FromTable, rule.ToTables)
+	}
+	for _, toTable := range rule.ToTables {
+		t, err := vschema.findRoutingTarget(toTable)
+		if err != nil {
+			return nil, err
+		}
+		rr.Tables = append(rr.Tables, t)
+	}
+	if rule.Alternate != nil {
+		alternate, err := vschema.buildRoutingRuleAlternate(rule)
+		if err != nil {
+			return nil, err
+		}
+		rr.Alternate = alternate
+		vschema.hasRoutingAlternates = true
+	}
+	if len(rule.WriteTables) > 2 {
+		return nil, fmt.Errorf("table %v has more than two write tables: %v", rule.FromTable, rule.WriteTables)
+	}
+	for _, writeTable := range rule.WriteTables {
+		t, err := vschema.findRoutingTarget(writeTable)
+		if err != nil {
+			return nil, err
+		}
+		rr.WriteTables = append(rr.WriteTables, t)
+	}
+	if len(rr.WriteTables) == 2 && rr.WriteTables[0] == rr.WriteTables[1] {
+		return nil, fmt.Errorf("table %v dual-writes twice to the same table: %v", rule.FromTable, rule.WriteTables)
+	}
+	if len(rr.WriteTables) != 0 {
+		vschema.hasRoutingWrites = true
+	}
+	return rr, nil
+}
+
+// buildScheduledRoutingRules builds the rules of the table that have an
+// effective_from, sorted by it. If one of them is invalid, the table gets a
+// rule with the error.
+func (vschema *VSchema) buildScheduledRoutingRules(fromTable string, rules []*vschemapb.RoutingRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].EffectiveFrom < rules[j].EffectiveFrom
+	})
+	schedule := make([]*RoutingRule, 0, len(rules))
+	for i, rule := range rules {
+		if i > 0 && rule.EffectiveFrom == rules[i-1].EffectiveFrom {
+			vschema.RoutingRules[fromTable] = &RoutingRule{
+				Error: fmt.Errorf("duplicate rule for entry %s effective from %d", fromTable, rule.EffectiveFrom),
 			}
+			return
+		}
+		rr, err := vschema.buildOneRoutingRule(rule)
+		if err != nil {
+			vschema.RoutingRules[fromTable] = &RoutingRule{Error: err}
+			return
+		}
+		rr.EffectiveFrom = time.Unix(rule.EffectiveFrom, 0)
+		schedule = append(schedule, rr)
+	}
+	if rr := vschema.RoutingRules[fromTable]; rr != nil && rr.Error != nil {
+		return
+	}
+	if vschema.ScheduledRoutingRules == nil {
+		vschema.ScheduledRoutingRules = make(map[string][]*RoutingRule)
+	}
+	vschema.ScheduledRoutingRules[fromTable] = schedule
+}
 
-			toKeyspace, toTableName, err := sqlparser.ParseTable(toTable)
+// scheduledRoutingRule returns the rule of the schedule that is in effect at
+// the specified time, or nil if none is.
+func scheduledRoutingRule(schedule []*RoutingRule, now time.Time) *RoutingRule {
+	var effective *RoutingRule
+	for _, rr := range schedule {
+		if now.Before(rr.EffectiveFrom) {
+			break
+		}
+		effective = rr
+	}
+	return effective
+}
 
-			if err != nil {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: err,
-				}
-				continue outer
-			}
-			if toKeyspace == "" {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: fmt.Errorf("table %s must be qualified", toTable),
-				}
-				continue outer
-			}
-			t, err := vschema.FindTable(toKeyspace, toTableName)
-			if err != nil {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: err,
-				}
-				continue outer
+func (vschema *VSchema) buildRoutingRuleAlternate(rule *vschemapb.RoutingRule) (*RoutingRuleAlternate, error) {
+	source := rule.Alternate
+	if len(source.ToTables) == 0 {
+		return nil, fmt.Errorf("alternate for table %v has no target", rule.FromTable)
+	}
+	if len(source.ToTables) > 1 {
+		return nil, fmt.Errorf("alternate for table %v has more than one target: %v", rule.FromTable, source.ToTables)
+	}
+	if source.Percent > 100 {
+		return nil, fmt.Errorf("alternate for table %v has an invalid percent: %d", rule.FromTable, source.Percent)
+	}
+	if source.ActiveUntil != 0 && source.ActiveUntil <= source.ActiveFrom {
+		return nil, fmt.Errorf("alternate for table %v has an empty time window", rule.FromTable)
+	}
+	alternate := &RoutingRuleAlternate{
+		Percent: source.Percent,
+	}
+	if source.ActiveFrom != 0 {
+		alternate.ActiveFrom = time.Unix(source.ActiveFrom, 0)
+	}
+	if source.ActiveUntil != 0 {
+		alternate.ActiveUntil = time.Unix(source.ActiveUntil, 0)
+	}
+	for _, toTable := range source.ToTables {
+		t, err := vschema.findRoutingTarget(toTable)
+		if err != nil {
+			return nil, err
+		}
+		alternate.Tables = append(alternate.Tables, t)
+	}
+	return alternate, nil
+}
+
+// findRoutingTarget finds the table referenced by the target of a routing rule.
+// The target must be qualified with its keyspace.
+func (vschema *VSchema) findRoutingTarget(toTable string) (*Table, error) {
+	// we need to backtick the keyspace and table name before calling ParseTable
+	toTable, err := escapeQualifiedTable(toTable)
+	if err != nil {
+		return nil, err
+	}
+	toKeyspace, toTableName, err := sqlparser.ParseTable(toTable)
+	if err != nil {
+		return nil, err
+	}
+	if toKeyspace == "" {
+		return nil, fmt.Errorf("table %s must be qualified", toTable)
+	}
+	return vschema.FindTable(toKeyspace, toTableName)
+}
+
+// ForSession returns a VSchema whose routing rules use the scheduled rules
+// and the alternates that are in effect for the session at the specified
+// time, along with a key that identifies them. The key must be part of any
+// cache key that depends on the routing. If no scheduled rule nor alternate
+// is in effect, the receiver itself is returned along with an empty key.
+func (vschema *VSchema) ForSession(sessionID string, now time.Time) (*VSchema, string) {
+	if !vschema.hasRoutingAlternates && len(vschema.ScheduledRoutingRules) == 0 {
+		return vschema, ""
+	}
+	rules := vschema.RoutingRules
+	var keys []string
+	route := func(fromTable string, rr *RoutingRule, key string) {
+		if len(keys) == 0 {
+			rules = copyRoutingRules(vschema.RoutingRules)
+		}
+		rules[fromTable] = rr
+		keys = append(keys, key)
+	}
+	for fromTable, schedule := range vschema.ScheduledRoutingRules {
+		if rr := scheduledRoutingRule(schedule, now); rr != nil {
+			route(fromTable, rr, fmt.Sprintf("%s@%d", fromTable, rr.EffectiveFrom.Unix()))
+		}
+	}
+	if vschema.hasRoutingAlternates {
+		for fromTable := range rules {
+			rr := rules[fromTable]
+			if rr.Alternate != nil && rr.Alternate.IsActive(sessionID, now) {
+				route(fromTable, &RoutingRule{Tables: rr.Alternate.Tables, WriteTables: rr.WriteTables}, fromTable)
 			}
-			rr.Tables = append(rr.Tables, t)
 		}
-		vschema.RoutingRules[rule.FromTable] = rr
 	}
+	if len(keys) == 0 {
+		return vschema, ""
+	}
+	sort.Strings(keys)
+
+	routed := *vschema
+	routed.RoutingRules = rules
+	return &routed, strings.Join(keys, ",")
+}
+
+func copyRoutingRules(rules map[string]*RoutingRule) map[string]*RoutingRule {
+	copied := make(map[string]*RoutingRule, len(rules))
+	for fromTable, rr := range rules {
+		copied[fromTable] = rr
+	}
+	return copied
+}
+
+// ForWrites returns a VSchema whose routing rules route the tables that have
+// write tables to them, to plan the DML statements. The tables whose writes
+// are dual-routed are routed to their first write table, or to their second
+// one if secondary is set. If no routing rule has write tables, the receiver
+// itself is returned.
+func (vschema *VSchema) ForWrites(secondary bool) *VSchema {
+	if !vschema.hasRoutingWrites {
+		return vschema
+	}
+	routed := *vschema
+	routed.RoutingRules = make(map[string]*RoutingRule, len(vschema.RoutingRules))
+	for fromTable, rr := range vschema.RoutingRules {
+		switch {
+		case len(rr.WriteTables) == 0:
+			routed.RoutingRules[fromTable] = rr
+		case secondary && len(rr.WriteTables) == 2:
+			routed.RoutingRules[fromTable] = &RoutingRule{Tables: rr.WriteTables[1:]}
+		default:
+			routed.RoutingRules[fromTable] = &RoutingRule{Tables: rr.WriteTables[:1]}
+		}
+	}
+	routed.hasRoutingWrites = false
+	return &routed
+}
+
+// HasDualWrites returns true if the writes of the table are dual-routed to
+// two tables by the routing rules.
+func (vschema *VSchema) HasDualWrites(keyspace, tablename string, tabletType topodatapb.TabletType) bool {
+	if !vschema.hasRoutingWrites {
+		return false
+	}
+	rr := vschema.findRoutingRule(keyspace, tablename, tabletType)
+	return rr != nil && len(rr.WriteTables) == 2
 }
 
 // FindTable returns a pointer to the Table. If a keyspace is specified, only tables
@@ -580,8 +1006,83 @@ func (vschema *VSchema) findTable(keyspace, tablename string) (*Table, error) {
 	return table, nil
 }
 
+// FindView returns the SELECT statement of a view, or nil if there is no such view.
+// If no keyspace is specified, the view is returned only if its name is unique
+// across all keyspaces. The returned statement must not be modified.
+func (vschema *VSchema) FindView(keyspace, name string) sqlparser.SelectStatement {
+	if keyspace == "" {
+		return vschema.uniqueViews[name]
+	}
+	ks, ok := vschema.Keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	return ks.Views[name]
+}
+
+// FindDatabase returns the keyspace that a database maps to, or nil if it
+// maps to none. The tenant is the one of a tenant database, if its wildcard
+// has a vindex. The exact name of a database takes precedence over the
+// wildcards, and a longer wildcard over a shorter one.
+func (vschema *VSchema) FindDatabase(name string) (*Keyspace, *Tenant, error) {
+	database, tenant := vschema.databases[name], ""
+	if database == nil {
+		for pattern, candidate := range vschema.databases {
+			prefix := strings.TrimSuffix(pattern, "*")
+			if prefix == pattern || len(name) <= len(prefix) || !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if database == nil || len(prefix) > len(name)-len(tenant) {
+				database, tenant = candidate, name[len(prefix):]
+			}
+		}
+	}
+	if database == nil {
+		return nil, nil, nil
+	}
+	if database.Error != nil {
+		return nil, nil, database.Error
+	}
+	if database.Vindex == nil {
+		return database.Keyspace, nil, nil
+	}
+	dests, err := database.Vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(tenant)})
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := dests[0].(key.DestinationNone); ok {
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "Unknown tenant '%s' of database '%s'", tenant, name)
+	}
+	return database.Keyspace, &Tenant{
+		Database:   name,
+		Value:      tenant,
+		Keyspace:   database.Keyspace,
+		VindexName: database.VindexName,
+		Vindex:     database.Vindex,
+	}, nil
+}
+
+// HasTenants returns true if a database of the vschema has tenants.
+func (vschema *VSchema) HasTenants() bool {
+	return vschema.hasTenants
+}
+
 // FindRoutedTable finds a table checking the routing rules.
 func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType topodatapb.TabletType) (*Table, error) {
+	if rr := vschema.findRoutingRule(keyspace, tablename, tabletType); rr != nil {
+		if rr.Error != nil {
+			return nil, rr.Error
+		}
+		if len(rr.Tables) == 0 {
+			return nil, fmt.Errorf("table %s has been disabled", tablename)
+		}
+		return rr.Tables[0], nil
+	}
+	return vschema.findTable(keyspace, tablename)
+}
+
+// findRoutingRule returns the routing rule of the table, if any.
+func (vschema *VSchema) findRoutingRule(keyspace, tablename string, tabletType topodatapb.TabletType) *RoutingRule {
 	qualified := tablename
 	if keyspace != "" {
 		qualified = keyspace + "." + tablename
@@ -590,18 +1091,11 @@ func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType t
 	// First look for a fully qualified table name: keyspace.table@tablet_type.
 	// Then look for one without tablet type: keyspace.table.
 	for _, name := range []string{fqtn, qualified} {
-		rr, ok := vschema.RoutingRules[name]
-		if ok {
-			if rr.Error != nil {
-				return nil, rr.Error
-			}
-			if len(rr.Tables) == 0 {
-				return nil, fmt.Errorf("table %s has been disabled", tablename)
-			}
-			return rr.Tables[0], nil
+		if rr, ok := vschema.RoutingRules[name]; ok {
+			return rr
 		}
 	}
-	return vschema.findTable(keyspace, tablename)
+	return nil
 }
 
 // FindTableOrVindex finds a table or a Vindex by name using Find and FindVindex.
@@ -19,9 +19,11 @@ package vindexes
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/test/utils"
 
@@ -837,6 +839,304 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaRoutingRuleAlternates(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable: "percent",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks2.t1"},
+					Percent:  50,
+				},
+			}, {
+				FromTable: "window",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables:    []string{"ks2.t1"},
+					ActiveFrom:  1000,
+					ActiveUntil: 2000,
+				},
+			}, {
+				FromTable: "multi",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks1.t1", "ks2.t1"},
+				},
+			}, {
+				FromTable: "notarget",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					Percent: 50,
+				},
+			}, {
+				FromTable: "badpercent",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks2.t1"},
+					Percent:  101,
+				},
+			}, {
+				FromTable: "badwindow",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables:    []string{"ks2.t1"},
+					ActiveFrom:  2000,
+					ActiveUntil: 1000,
+				},
+			}, {
+				FromTable: "notfound",
+				ToTables:  []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks3.t1"},
+				},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+
+	assert.EqualError(t, vschema.RoutingRules["multi"].Error, "alternate for table multi has more than one target: [ks1.t1 ks2.t1]")
+	assert.EqualError(t, vschema.RoutingRules["notarget"].Error, "alternate for table notarget has no target")
+	assert.EqualError(t, vschema.RoutingRules["badpercent"].Error, "alternate for table badpercent has an invalid percent: 101")
+	assert.EqualError(t, vschema.RoutingRules["badwindow"].Error, "alternate for table badwindow has an empty time window")
+	assert.EqualError(t, vschema.RoutingRules["notfound"].Error, "Unknown database 'ks3' in vschema")
+
+	gotb, err := json.Marshal(vschema.RoutingRules["percent"])
+	require.NoError(t, err)
+	assert.Equal(t, `{"tables":["ks1.t1"],"alternate":{"tables":["ks2.t1"],"percent":50}}`, string(gotb))
+
+	// Outside of the window, the regular target is used.
+	routed, key := vschema.ForSession("", time.Unix(500, 0))
+	assert.Equal(t, vschema, routed)
+	assert.Empty(t, key)
+
+	routed, key = vschema.ForSession("", time.Unix(1500, 0))
+	assert.Equal(t, "window", key)
+	table, err := routed.FindRoutedTable("", "window", topodatapb.TabletType_PRIMARY)
+	require.NoError(t, err)
+	assert.Equal(t, "ks2", table.Keyspace.Name)
+	table, err = vschema.FindRoutedTable("", "window", topodatapb.TabletType_PRIMARY)
+	require.NoError(t, err)
+	assert.Equal(t, "ks1", table.Keyspace.Name)
+
+	// The same session always gets the same routing, and roughly
+	// half of the sessions are routed to the alternate.
+	alternate := vschema.RoutingRules["percent"].Alternate
+	selected := 0
+	for i := 0; i < 1000; i++ {
+		sessionID := fmt.Sprintf("session-%d", i)
+		active := alternate.IsActive(sessionID, time.Unix(0, 0))
+		assert.Equal(t, active, alternate.IsActive(sessionID, time.Unix(0, 0)))
+		if active {
+			selected++
+			routed, key = vschema.ForSession(sessionID, time.Unix(0, 0))
+			assert.Equal(t, "percent", key)
+			table, err = routed.FindRoutedTable("", "percent", topodatapb.TabletType_PRIMARY)
+			require.NoError(t, err)
+			assert.Equal(t, "ks2", table.Keyspace.Name)
+		}
+	}
+	assert.InDelta(t, 500, selected, 100)
+}
+
+func TestVSchemaRoutingRuleWriteTables(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:   "source_only",
+				ToTables:    []string{"ks2.t1"},
+				WriteTables: []string{"ks1.t1"},
+			}, {
+				FromTable:   "dual",
+				ToTables:    []string{"ks2.t2"},
+				WriteTables: []string{"ks1.t2", "ks2.t2"},
+			}, {
+				FromTable: "reads",
+				ToTables:  []string{"ks2.t1"},
+			}, {
+				FromTable:   "alternate",
+				ToTables:    []string{"ks1.t1"},
+				WriteTables: []string{"ks1.t1"},
+				Alternate: &vschemapb.RoutingRuleAlternate{
+					ToTables: []string{"ks2.t1"},
+				},
+			}, {
+				FromTable:   "three",
+				ToTables:    []string{"ks2.t1"},
+				WriteTables: []string{"ks1.t1", "ks2.t1", "ks1.t2"},
+			}, {
+				FromTable:   "twice",
+				ToTables:    []string{"ks2.t1"},
+				WriteTables: []string{"ks1.t1", "ks1.t1"},
+			}, {
+				FromTable:   "notfound",
+				ToTables:    []string{"ks2.t1"},
+				WriteTables: []string{"ks3.t1"},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+
+	assert.EqualError(t, vschema.RoutingRules["three"].Error, "table three has more than two write tables: [ks1.t1 ks2.t1 ks1.t2]")
+	assert.EqualError(t, vschema.RoutingRules["twice"].Error, "table twice dual-writes twice to the same table: [ks1.t1 ks1.t1]")
+	assert.EqualError(t, vschema.RoutingRules["notfound"].Error, "Unknown database 'ks3' in vschema")
+
+	gotb, err := json.Marshal(vschema.RoutingRules["dual"])
+	require.NoError(t, err)
+	assert.Equal(t, `{"tables":["ks2.t2"],"write_tables":["ks1.t2","ks2.t2"]}`, string(gotb))
+
+	assert.True(t, vschema.HasDualWrites("", "dual", topodatapb.TabletType_PRIMARY))
+	assert.False(t, vschema.HasDualWrites("", "source_only", topodatapb.TabletType_PRIMARY))
+	assert.False(t, vschema.HasDualWrites("", "reads", topodatapb.TabletType_PRIMARY))
+
+	routedKeyspace := func(vschema *VSchema, name string) string {
+		t.Helper()
+		table, err := vschema.FindRoutedTable("", name, topodatapb.TabletType_PRIMARY)
+		require.NoError(t, err)
+		return table.Keyspace.Name
+	}
+	// The reads are routed to to_tables, and the writes to write_tables.
+	assert.Equal(t, "ks2", routedKeyspace(vschema, "source_only"))
+	assert.Equal(t, "ks2", routedKeyspace(vschema, "dual"))
+	writes := vschema.ForWrites(false)
+	assert.Equal(t, "ks1", routedKeyspace(writes, "source_only"))
+	assert.Equal(t, "ks1", routedKeyspace(writes, "dual"))
+	assert.Equal(t, "ks2", routedKeyspace(writes, "reads"))
+	secondaryWrites := vschema.ForWrites(true)
+	assert.Equal(t, "ks1", routedKeyspace(secondaryWrites, "source_only"))
+	assert.Equal(t, "ks2", routedKeyspace(secondaryWrites, "dual"))
+	assert.False(t, writes.HasDualWrites("", "dual", topodatapb.TabletType_PRIMARY))
+
+	// An alternate changes the reads, but not the writes.
+	routed, key := vschema.ForSession("", time.Unix(0, 0))
+	assert.Equal(t, "alternate", key)
+	assert.Equal(t, "ks2", routedKeyspace(routed, "alternate"))
+	assert.Equal(t, "ks1", routedKeyspace(routed.ForWrites(false), "alternate"))
+
+	// Without write tables, the vschema is used for the writes too.
+	vschema = BuildVSchema(&vschemapb.SrvVSchema{Keyspaces: input.Keyspaces})
+	assert.Equal(t, vschema, vschema.ForWrites(true))
+}
+
+func TestVSchemaScheduledRoutingRules(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:     "cutover",
+				ToTables:      []string{"ks2.t1"},
+				WriteTables:   []string{"ks2.t1"},
+				EffectiveFrom: 1000,
+			}, {
+				FromTable: "cutover",
+				ToTables:  []string{"ks1.t1"},
+			}, {
+				FromTable:     "cutover",
+				ToTables:      []string{"ks1.t1"},
+				EffectiveFrom: 2000,
+			}, {
+				FromTable:     "later",
+				ToTables:      []string{"ks2.t2"},
+				EffectiveFrom: 1000,
+			}, {
+				FromTable:     "dup",
+				ToTables:      []string{"ks2.t1"},
+				EffectiveFrom: 1000,
+			}, {
+				FromTable:     "dup",
+				ToTables:      []string{"ks1.t1"},
+				EffectiveFrom: 1000,
+			}, {
+				FromTable:     "notfound",
+				ToTables:      []string{"ks1.t1"},
+				EffectiveFrom: 1000,
+			}, {
+				FromTable:     "notfound",
+				ToTables:      []string{"ks3.t1"},
+				EffectiveFrom: 2000,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+
+	assert.EqualError(t, vschema.RoutingRules["dup"].Error, "duplicate rule for entry dup effective from 1000")
+	assert.EqualError(t, vschema.RoutingRules["notfound"].Error, "Unknown database 'ks3' in vschema")
+	assert.NotContains(t, vschema.ScheduledRoutingRules, "dup")
+	assert.NotContains(t, vschema.ScheduledRoutingRules, "notfound")
+
+	gotb, err := json.Marshal(vschema.ScheduledRoutingRules["later"])
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`[{"tables":["ks2.t2"],"effective_from":%q}]`, time.Unix(1000, 0).Format(time.RFC3339Nano)), string(gotb))
+
+	routedKeyspace := func(vschema *VSchema, name string) string {
+		t.Helper()
+		table, err := vschema.FindRoutedTable("", name, topodatapb.TabletType_PRIMARY)
+		require.NoError(t, err)
+		if table == nil {
+			return ""
+		}
+		return table.Keyspace.Name
+	}
+	// Before the first cutover, the rule without effective_from is in
+	// effect, and the table without one has no rule.
+	routed, key := vschema.ForSession("", time.Unix(999, 0))
+	assert.Equal(t, vschema, routed)
+	assert.Empty(t, key)
+	assert.Equal(t, "ks1", routedKeyspace(routed, "cutover"))
+	assert.Equal(t, "", routedKeyspace(routed, "later"))
+
+	// Then the reads and the writes switch together.
+	routed, key = vschema.ForSession("", time.Unix(1000, 0))
+	assert.Equal(t, "cutover@1000,later@1000", key)
+	assert.Equal(t, "ks2", routedKeyspace(routed, "cutover"))
+	assert.Equal(t, "ks2", routedKeyspace(routed.ForWrites(false), "cutover"))
+	assert.Equal(t, "ks2", routedKeyspace(routed, "later"))
+	assert.Equal(t, "ks1", routedKeyspace(vschema, "cutover"), "the vschema itself doesn't change")
+
+	// And the latest rule that is in effect wins.
+	routed, key = vschema.ForSession("", time.Unix(2500, 0))
+	assert.Equal(t, "cutover@2000,later@1000", key)
+	assert.Equal(t, "ks1", routedKeyspace(routed, "cutover"))
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -2393,6 +2693,182 @@ func TestFindTableOrVindex(t *testing.T) {
 	}
 }
 
+func TestFindView(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Tables: map[string]*vschemapb.Table{"t1": {}},
+				Views: map[string]string{
+					"v1":  "select id from t1 where id > 10",
+					"dup": "select 1 from dual",
+					"t2":  "select 3 from dual",
+				},
+			},
+			"ksb": {
+				Tables: map[string]*vschemapb.Table{"t2": {}},
+				Views:  map[string]string{"dup": "select 2 from dual"},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+	require.NoError(t, vschema.Keyspaces["ksa"].Error)
+	require.NoError(t, vschema.Keyspaces["ksb"].Error)
+
+	assert.Equal(t, "select id from ksa.t1 where id > 10", sqlparser.String(vschema.FindView("", "v1")))
+	assert.Equal(t, "select id from ksa.t1 where id > 10", sqlparser.String(vschema.FindView("ksa", "v1")))
+	assert.Nil(t, vschema.FindView("ksb", "v1"))
+	assert.Nil(t, vschema.FindView("", "dup"))
+	assert.Equal(t, "select 2 from ksb.dual", sqlparser.String(vschema.FindView("ksb", "dup")))
+	assert.Nil(t, vschema.FindView("", "t1"))
+	assert.Nil(t, vschema.FindView("nokeyspace", "v1"))
+
+	// A view named like a table of another keyspace makes the name ambiguous.
+	assert.Nil(t, vschema.FindView("", "t2"))
+	assert.Equal(t, "select 3 from ksa.dual", sqlparser.String(vschema.FindView("ksa", "t2")))
+	_, err := vschema.FindTable("", "t2")
+	assert.EqualError(t, err, "ambiguous table reference: t2")
+	table, err := vschema.FindTable("ksb", "t2")
+	require.NoError(t, err)
+	assert.Equal(t, "t2", table.Name.String())
+}
+
+func TestBuildVSchemaViewsFail(t *testing.T) {
+	testcases := []struct {
+		views map[string]string
+		err   string
+	}{{
+		views: map[string]string{"t1": "select 1 from dual"},
+		err:   "view t1 has the same name as a table",
+	}, {
+		views: map[string]string{"v1": "select from"},
+		err:   "could not parse the query of view v1: syntax error at position 12 near 'from'",
+	}, {
+		views: map[string]string{"v1": "delete from t1"},
+		err:   "the query of view v1 is not a SELECT statement: delete from t1",
+	}}
+	for _, tc := range testcases {
+		input := vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				"ksa": {
+					Tables: map[string]*vschemapb.Table{"t1": {}},
+					Views:  tc.views,
+				},
+			},
+		}
+		vschema := BuildVSchema(&input)
+		assert.EqualError(t, vschema.Keyspaces["ksa"].Error, tc.err)
+	}
+}
+
+func TestFindDatabase(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Sharded:  true,
+				Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+				Databases: map[string]string{
+					"app":         "",
+					"tenant_*":    "hash",
+					"tenant_1*":   "",
+					"tenant_dup*": "",
+				},
+			},
+			"ksb": {
+				Databases: map[string]string{
+					"tenant_dup*": "",
+					"tenant_2":    "",
+				},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+	require.NoError(t, vschema.Keyspaces["ksa"].Error)
+	require.NoError(t, vschema.Keyspaces["ksb"].Error)
+
+	ks, tenant, err := vschema.FindDatabase("app")
+	require.NoError(t, err)
+	assert.Equal(t, "ksa", ks.Name)
+	assert.Nil(t, tenant)
+
+	ks, tenant, err = vschema.FindDatabase("tenant_1")
+	require.NoError(t, err)
+	assert.Equal(t, "ksa", ks.Name)
+	assert.True(t, vschema.HasTenants())
+	require.NotNil(t, tenant)
+	assert.Equal(t, "tenant_1", tenant.Database)
+	assert.Equal(t, "1", tenant.Value)
+	assert.Equal(t, "hash", tenant.VindexName)
+
+	// The longer wildcard wins, and the exact name over both.
+	ks, tenant, err = vschema.FindDatabase("tenant_12")
+	require.NoError(t, err)
+	assert.Equal(t, "ksa", ks.Name)
+	assert.Nil(t, tenant)
+	ks, tenant, err = vschema.FindDatabase("tenant_2")
+	require.NoError(t, err)
+	assert.Equal(t, "ksb", ks.Name)
+	assert.Nil(t, tenant)
+
+	_, _, err = vschema.FindDatabase("tenant_abc")
+	assert.EqualError(t, err, "Unknown tenant 'abc' of database 'tenant_abc'")
+	_, _, err = vschema.FindDatabase("tenant_dup1")
+	assert.EqualError(t, err, "database tenant_dup* maps to more than one keyspace")
+
+	ks, _, err = vschema.FindDatabase("tenant_")
+	require.NoError(t, err)
+	assert.Nil(t, ks)
+	ks, _, err = vschema.FindDatabase("other")
+	require.NoError(t, err)
+	assert.Nil(t, ks)
+}
+
+func TestBuildVSchemaDatabasesFail(t *testing.T) {
+	testcases := []struct {
+		sharded   bool
+		databases map[string]string
+		err       string
+	}{{
+		sharded:   true,
+		databases: map[string]string{"*": ""},
+		err:       "invalid database name '*'",
+	}, {
+		sharded:   true,
+		databases: map[string]string{"tenant_*_db": ""},
+		err:       "database tenant_*_db can only end with a wildcard",
+	}, {
+		sharded:   true,
+		databases: map[string]string{"app": "hash"},
+		err:       "database app has a vindex but no wildcard",
+	}, {
+		databases: map[string]string{"tenant_*": "hash"},
+		err:       "database tenant_* has a vindex but keyspace ksa is not sharded",
+	}, {
+		sharded:   true,
+		databases: map[string]string{"tenant_*": "missing"},
+		err:       "vindex missing of database tenant_* not found or not a single column vindex",
+	}, {
+		sharded:   true,
+		databases: map[string]string{"tenant_*": "lookup"},
+		err:       "vindex lookup of database tenant_* must be unique and not need a lookup",
+	}}
+	for _, tc := range testcases {
+		input := vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				"ksa": {
+					Sharded: tc.sharded,
+					Vindexes: map[string]*vschemapb.Vindex{
+						"hash":   {Type: "hash"},
+						"lookup": {Type: "lookup_hash_unique", Params: map[string]string{"table": "t", "from": "f", "to": "t"}},
+					},
+					Databases: tc.databases,
+				},
+			},
+		}
+		vschema := BuildVSchema(&input)
+		assert.EqualError(t, vschema.Keyspaces["ksa"].Error, tc.err)
+	}
+}
+
 func TestBuildKeyspaceSchema(t *testing.T) {
 	good := &vschemapb.Keyspace{
 		Tables: map[string]*vschemapb.Table{
@@ -2760,3 +3236,56 @@ func TestMultiColVindexPartialNotAllowed(t *testing.T) {
 	require.True(t, table.ColumnVindexes[0].IsUnique())
 	require.EqualValues(t, 1, table.ColumnVindexes[0].Cost())
 }
+
+func TestMultiColLookupVindexSecondary(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"stfu": {
+						Type: "stfu",
+					},
+					"tenant_object_vdx": {
+						Type: "consistent_lookup_multicol",
+						Params: map[string]string{
+							"table": "tenant_object_lookup",
+							"from":  "tenant_id,object_id",
+							"to":    "keyspace_id",
+						},
+						Owner: "objects",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"objects": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{
+							{
+								Column: "id",
+								Name:   "stfu",
+							},
+							{
+								Columns: []string{"tenant_id", "object_id"},
+								Name:    "tenant_object_vdx",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	vschema := BuildVSchema(&input)
+	table, err := vschema.FindTable("ksa", "objects")
+	require.NoError(t, err)
+	require.Len(t, table.ColumnVindexes, 3)
+	require.Len(t, table.Owned, 1)
+	assert.Len(t, table.Owned[0].Columns, 2)
+	// The vindex can map the tenant_id alone, but is not maintained twice.
+	assert.Equal(t, "tenant_id", table.ColumnVindexes[2].Columns[0].String())
+	assert.True(t, table.ColumnVindexes[2].IgnoreInDML())
+	assert.EqualValues(t, 21, table.ColumnVindexes[2].Cost())
+
+	// Other multi-column vindexes must be primary.
+	input.Keyspaces["ksa"].Vindexes["tenant_object_vdx"] = &vschemapb.Vindex{Type: "region_experimental_test", Params: map[string]string{"region_bytes": "1"}}
+	vschema = BuildVSchema(&input)
+	require.EqualError(t, vschema.Keyspaces["ksa"].Error, "multi-column vindex tenant_object_vdx should be a primary vindex or a lookup vindex for table objects")
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// The causes of the changes of the vschema, which label the plan cache
+// invalidation stats.
+const (
+	vschemaChangeVSchema = "VSchema"
+	vschemaChangeSchema  = "Schema"
+)
+
+// vschemaChange tells the subscriber of the VSchemaManager which queries can
+// be planned differently with a new vschema.
+type vschemaChange struct {
+	// cause is vschemaChangeVSchema when the SrvVSchema changed, and
+	// vschemaChangeSchema when the schema tracker found new columns, or that
+	// the schema of tables changed.
+	cause string
+	// all is set when the change can affect any query, e.g. a change of the
+	// routing rules, or of the attributes or the vindexes of a keyspace.
+	all bool
+	// tables are the lowercased names of the tables whose definition changed,
+	// in any keyspace, since a query can reference them without a keyspace.
+	tables map[string]bool
+}
+
+func newVSchemaChange(cause string) *vschemaChange {
+	return &vschemaChange{
+		cause:  cause,
+		tables: make(map[string]bool),
+	}
+}
+
+func (change *vschemaChange) addTable(name string) {
+	change.tables[strings.ToLower(name)] = true
+}
+
+// diffSrvVSchemas returns the change between two versions of the SrvVSchema.
+// Only the changes of the tables are selective.
+func diffSrvVSchemas(old, new *vschemapb.SrvVSchema) *vschemaChange {
+	change := newVSchemaChange(vschemaChangeVSchema)
+	if old == nil || new == nil || len(old.Keyspaces) != len(new.Keyspaces) || !proto.Equal(old.RoutingRules, new.RoutingRules) {
+		change.all = true
+		return change
+	}
+	for ksName, oldKs := range old.Keyspaces {
+		newKs, ok := new.Keyspaces[ksName]
+		if !ok || !proto.Equal(keyspaceWithoutTables(oldKs), keyspaceWithoutTables(newKs)) {
+			change.all = true
+			return change
+		}
+		for name, table := range oldKs.Tables {
+			if !proto.Equal(table, newKs.Tables[name]) {
+				change.addTable(name)
+			}
+		}
+		for name := range newKs.Tables {
+			if _, ok := oldKs.Tables[name]; !ok {
+				change.addTable(name)
+			}
+		}
+	}
+	return change
+}
+
+func keyspaceWithoutTables(ks *vschemapb.Keyspace) *vschemapb.Keyspace {
+	clone := proto.Clone(ks).(*vschemapb.Keyspace)
+	clone.Tables = nil
+	return clone
+}
+
+// diffTrackedSchemas returns the change between two vschemas built from the
+// same SrvVSchema, with different columns from the schema tracker.
+func diffTrackedSchemas(old, new *vindexes.VSchema) *vschemaChange {
+	change := newVSchemaChange(vschemaChangeSchema)
+	if old == nil || len(old.Keyspaces) != len(new.Keyspaces) {
+		change.all = true
+		return change
+	}
+	for ksName, oldKs := range old.Keyspaces {
+		newKs, ok := new.Keyspaces[ksName]
+		if !ok {
+			change.all = true
+			return change
+		}
+		for name, table := range oldKs.Tables {
+			if !sameTrackedColumns(table, newKs.Tables[name]) {
+				change.addTable(name)
+			}
+		}
+		for name := range newKs.Tables {
+			if _, ok := oldKs.Tables[name]; !ok {
+				change.addTable(name)
+			}
+		}
+	}
+	return change
+}
+
+// sameTrackedColumns returns true if the tables have the same columns, in
+// the same order. The collations are compared too: the plans of the
+// comparisons and of the ordering of a text column depend on them.
+func sameTrackedColumns(a, b *vindexes.Table) bool {
+	if b == nil || a.ColumnListAuthoritative != b.ColumnListAuthoritative || len(a.Columns) != len(b.Columns) {
+		return false
+	}
+	for i, col := range a.Columns {
+		other := b.Columns[i]
+		if !col.Name.Equal(other.Name) || col.Type != other.Type || col.CollationName != other.CollationName {
+			return false
+		}
+	}
+	return true
+}
+
+// addDependents adds the tables whose queries are planned with the changed
+// tables of the vschema: the tables routed to them, the tables whose
+// sequence they are, and the views whose query references them.
+func (change *vschemaChange) addDependents(vschema *vindexes.VSchema) {
+	if change.all || len(change.tables) == 0 || vschema == nil {
+		return
+	}
+	changed := func(tables []*vindexes.Table) bool {
+		for _, table := range tables {
+			if change.tables[strings.ToLower(table.Name.String())] {
+				return true
+			}
+		}
+		return false
+	}
+	var routed []string
+	addRule := func(fromTable string, rule *vindexes.RoutingRule) {
+		if changed(rule.Tables) || changed(rule.WriteTables) || (rule.Alternate != nil && changed(rule.Alternate.Tables)) {
+			routed = append(routed, fromTable)
+		}
+	}
+	for fromTable, rule := range vschema.RoutingRules {
+		addRule(fromTable, rule)
+	}
+	for fromTable, schedule := range vschema.ScheduledRoutingRules {
+		for _, rule := range schedule {
+			addRule(fromTable, rule)
+		}
+	}
+	for _, ks := range vschema.Keyspaces {
+		for name, table := range ks.Tables {
+			if table.AutoIncrement != nil && table.AutoIncrement.Sequence != nil && changed([]*vindexes.Table{table.AutoIncrement.Sequence}) {
+				routed = append(routed, name)
+			}
+		}
+	}
+	for _, fromTable := range routed {
+		// The rules are keyed by [keyspace.]table[@tablet_type].
+		if i := strings.IndexByte(fromTable, '@'); i >= 0 {
+			fromTable = fromTable[:i]
+		}
+		if i := strings.LastIndexByte(fromTable, '.'); i >= 0 {
+			fromTable = fromTable[i+1:]
+		}
+		change.addTable(fromTable)
+	}
+	// The queries of the views can reference other views.
+	for added := true; added; {
+		added = false
+		for _, ks := range vschema.Keyspaces {
+			for name, view := range ks.Views {
+				if change.tables[strings.ToLower(name)] {
+					continue
+				}
+				for _, table := range planTables(view) {
+					if change.tables[table] {
+						change.addTable(name)
+						added = true
+						break
+					}
+				}
+			}
+		}
+	}
+}
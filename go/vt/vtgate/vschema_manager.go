@@ -42,7 +42,7 @@ type VSchemaManager struct {
 	currentVschema    *vindexes.VSchema
 	serv              srvtopo.Server
 	cell              string
-	subscriber        func(vschema *vindexes.VSchema, stats *VSchemaStats)
+	subscriber        func(vschema *vindexes.VSchema, stats *VSchemaStats, change *vschemaChange)
 	schema            SchemaInfo
 }
 
@@ -119,6 +119,7 @@ func (vm *VSchemaManager) VSchemaUpdate(v *vschemapb.SrvVSchema, err error) bool
 	defer vm.mu.Unlock()
 
 	// keep a copy of the latest SrvVschema and Vschema
+	change := diffSrvVSchemas(vm.currentSrvVschema, v)
 	vm.currentSrvVschema = v // TODO: should we do this locking?
 	vschema := vm.currentVschema
 
@@ -133,7 +134,8 @@ func (vm *VSchemaManager) VSchemaUpdate(v *vschemapb.SrvVSchema, err error) bool
 	}
 
 	if vm.subscriber != nil {
-		vm.subscriber(vschema, vSchemaStats(err, vschema))
+		change.addDependents(vschema)
+		vm.subscriber(vschema, vSchemaStats(err, vschema), change)
 	}
 	return true
 }
@@ -169,11 +171,17 @@ func (vm *VSchemaManager) Rebuild() {
 
 	vschema := vm.buildAndEnhanceVSchema(v)
 	vm.mu.Lock()
+	change := diffTrackedSchemas(vm.currentVschema, vschema)
+	if vm.currentSrvVschema != v {
+		// The SrvVSchema changed while the vschema was rebuilt.
+		change.all = true
+	}
 	vm.currentVschema = vschema
 	vm.mu.Unlock()
 
 	if vm.subscriber != nil {
-		vm.subscriber(vschema, vSchemaStats(nil, vschema))
+		change.addDependents(vschema)
+		vm.subscriber(vschema, vSchemaStats(nil, vschema), change)
 		log.Infof("Sent vschema to subscriber")
 	}
 }
@@ -3,6 +3,9 @@ package vtgate
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/test/utils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -99,7 +102,7 @@ func TestVSchemaUpdate(t *testing.T) {
 
 	vm := &VSchemaManager{}
 	var vs *vindexes.VSchema
-	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats) {
+	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats, _ *vschemaChange) {
 		vs = vschema
 	}
 	for _, tcase := range tcases {
@@ -199,7 +202,7 @@ func TestRebuildVSchema(t *testing.T) {
 
 	vm := &VSchemaManager{}
 	var vs *vindexes.VSchema
-	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats) {
+	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats, _ *vschemaChange) {
 		vs = vschema
 	}
 	for _, tcase := range tcases {
@@ -218,6 +221,105 @@ func TestRebuildVSchema(t *testing.T) {
 	}
 }
 
+func TestDiffSrvVSchemas(t *testing.T) {
+	tbl := &vschemapb.Table{Columns: []*vschemapb.Column{{Name: "id", Type: querypb.Type_INT64}}}
+	tbl2 := &vschemapb.Table{Columns: []*vschemapb.Column{{Name: "uid", Type: querypb.Type_INT64}}}
+	withRoutingRules := makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl})
+	withRoutingRules.RoutingRules = &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{{FromTable: "t2", ToTables: []string{"ks.t1"}}}}
+
+	tcases := []struct {
+		name     string
+		old, new *vschemapb.SrvVSchema
+		all      bool
+		tables   map[string]bool
+	}{{
+		name: "first vschema",
+		new:  makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		all:  true,
+	}, {
+		name:   "no change",
+		old:    makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		new:    makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		tables: map[string]bool{},
+	}, {
+		name:   "changed, added and removed tables",
+		old:    makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl, "T2": tbl, "t3": tbl}),
+		new:    makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl, "T2": tbl2, "t4": tbl}),
+		tables: map[string]bool{"t2": true, "t3": true, "t4": true},
+	}, {
+		name: "keyspace attributes",
+		old:  makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		new:  makeTestSrvVSchema("ks", true, map[string]*vschemapb.Table{"t1": tbl}),
+		all:  true,
+	}, {
+		name: "keyspaces",
+		old:  makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		new:  makeTestSrvVSchema("ks2", false, map[string]*vschemapb.Table{"t1": tbl}),
+		all:  true,
+	}, {
+		name: "routing rules",
+		old:  makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"t1": tbl}),
+		new:  withRoutingRules,
+		all:  true,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			change := diffSrvVSchemas(tcase.old, tcase.new)
+			assert.Equal(t, vschemaChangeVSchema, change.cause)
+			assert.Equal(t, tcase.all, change.all)
+			if !tcase.all {
+				assert.Equal(t, tcase.tables, change.tables)
+			}
+		})
+	}
+}
+
+func TestDiffTrackedSchemas(t *testing.T) {
+	cols1 := []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT64}}
+	cols2 := []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_VARCHAR}}
+	cols3 := []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_VARCHAR, CollationName: "utf8mb4_bin"}}
+	old := makeTestVSchema("ks", false, map[string]*vindexes.Table{
+		"t1": {Name: sqlparser.NewTableIdent("t1"), Columns: cols1, ColumnListAuthoritative: true},
+		"t2": {Name: sqlparser.NewTableIdent("t2"), Columns: cols1, ColumnListAuthoritative: true},
+		"t3": {Name: sqlparser.NewTableIdent("t3"), Columns: cols1},
+		"t8": {Name: sqlparser.NewTableIdent("t8"), Columns: cols2, ColumnListAuthoritative: true},
+	})
+	new := makeTestVSchema("ks", false, map[string]*vindexes.Table{
+		"t1": {Name: sqlparser.NewTableIdent("t1"), Columns: cols1, ColumnListAuthoritative: true},
+		"t2": {Name: sqlparser.NewTableIdent("t2"), Columns: cols2, ColumnListAuthoritative: true},
+		"t3": {Name: sqlparser.NewTableIdent("t3"), Columns: cols1, ColumnListAuthoritative: true},
+		"t4": {Name: sqlparser.NewTableIdent("t4"), Columns: cols1, ColumnListAuthoritative: true},
+		"t8": {Name: sqlparser.NewTableIdent("t8"), Columns: cols3, ColumnListAuthoritative: true},
+	})
+	change := diffTrackedSchemas(old, new)
+	assert.Equal(t, vschemaChangeSchema, change.cause)
+	assert.False(t, change.all)
+	assert.Equal(t, map[string]bool{"t2": true, "t3": true, "t4": true, "t8": true}, change.tables)
+
+	// The tables routed to the changed tables, and the tables that use
+	// them as their sequence, are planned with them.
+	seq := new.Keyspaces["ks"].Tables["t4"]
+	new.Keyspaces["ks"].Tables["t5"] = &vindexes.Table{Name: sqlparser.NewTableIdent("t5"), AutoIncrement: &vindexes.AutoIncrement{Sequence: seq}}
+	new.RoutingRules["other.t6@replica"] = &vindexes.RoutingRule{Tables: []*vindexes.Table{new.Keyspaces["ks"].Tables["t2"]}}
+	new.RoutingRules["t7"] = &vindexes.RoutingRule{Tables: []*vindexes.Table{new.Keyspaces["ks"].Tables["t1"]}}
+	// The views are planned with the tables of their query.
+	new.Keyspaces["ks"].Views = map[string]sqlparser.SelectStatement{
+		"v1": parseView(t, "select id from t8"),
+		"v2": parseView(t, "select id from v1 where id = 1"),
+		"v3": parseView(t, "select id from t1"),
+	}
+	change.addDependents(new)
+	assert.Equal(t, map[string]bool{"t2": true, "t3": true, "t4": true, "t5": true, "t6": true, "t8": true, "v1": true, "v2": true}, change.tables)
+
+	assert.True(t, diffTrackedSchemas(nil, new).all)
+}
+
+func parseView(t *testing.T, query string) sqlparser.SelectStatement {
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	return stmt.(sqlparser.SelectStatement)
+}
+
 func makeTestVSchema(ks string, sharded bool, tbls map[string]*vindexes.Table) *vindexes.VSchema {
 	keyspaceSchema := &vindexes.KeyspaceSchema{
 		Keyspace: &vindexes.Keyspace{
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/withddl"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	vstreamCheckpointsKeyspace = flag.String("vstream_checkpoints_keyspace", "", "the unsharded keyspace in whose _vt database vtgate saves the checkpoints of the VStream consumers. Named checkpoints are disabled if empty")
+
+	vstreamCheckpointsCount = stats.NewCountersWithSingleLabel(
+		"VtgateVStreamCheckpoints",
+		"Checkpoints of VStream consumers saved and resumed from",
+		"Operation")
+)
+
+const (
+	sqlCreateVStreamCheckpoints = `create table if not exists _vt.vstream_checkpoints (
+  name varbinary(255) not null,
+  vgtid blob not null,
+  time_updated bigint not null,
+  primary key (name)
+) engine=InnoDB`
+	sqlSelectVStreamCheckpoint = "select vgtid from _vt.vstream_checkpoints where name = %a"
+	sqlSaveVStreamCheckpoint   = "insert into _vt.vstream_checkpoints(name, vgtid, time_updated) values (%a, %a, %a) on duplicate key update vgtid = values(vgtid), time_updated = values(time_updated)"
+)
+
+var withDDLVStreamCheckpoints = withddl.New([]string{sqlCreateVStreamCheckpoints})
+
+// vstreamCheckpoints saves the last VGTID that each named VStream consumer
+// acknowledged, in the _vt database of the primary of an unsharded keyspace.
+type vstreamCheckpoints struct {
+	resolver *srvtopo.Resolver
+	keyspace string
+}
+
+func newVStreamCheckpoints(resolver *srvtopo.Resolver, keyspace string) *vstreamCheckpoints {
+	return &vstreamCheckpoints{
+		resolver: resolver,
+		keyspace: keyspace,
+	}
+}
+
+// exec runs a query on the primary of the checkpoints keyspace.
+func (vc *vstreamCheckpoints) exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	rss, _, err := vc.resolver.GetAllShards(ctx, vc.keyspace, topodatapb.TabletType_PRIMARY)
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the VStream checkpoints keyspace %s must be unsharded, it has %d shards", vc.keyspace, len(rss))
+	}
+	qr, err := rss[0].Gateway.Execute(ctx, rss[0].Target, query, nil, 0, 0, nil)
+	if err != nil {
+		// withddl only recognizes the schema errors of a *mysql.SQLError.
+		if sqlErr := mysql.NewSQLErrorFromError(err); sqlErr != nil {
+			return nil, sqlErr
+		}
+		return nil, err
+	}
+	return qr, nil
+}
+
+// get returns the checkpoint of a consumer, or nil if it has none.
+func (vc *vstreamCheckpoints) get(ctx context.Context, name string) (*binlogdatapb.VGtid, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectVStreamCheckpoint, sqltypes.StringBindVariable(name))
+	if err != nil {
+		return nil, err
+	}
+	qr, err := withDDLVStreamCheckpoints.ExecIgnore(ctx, query, vc.exec)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := prototext.Unmarshal(qr.Rows[0][0].Raw(), vgtid); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid checkpoint for VStream consumer %s", name)
+	}
+	vstreamCheckpointsCount.Add("Resumed", 1)
+	return vgtid, nil
+}
+
+// save stores the checkpoint of a consumer.
+func (vc *vstreamCheckpoints) save(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error {
+	buf, err := prototext.Marshal(vgtid)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.ParseAndBind(sqlSaveVStreamCheckpoint,
+		sqltypes.StringBindVariable(name),
+		sqltypes.BytesBindVariable(buf),
+		sqltypes.Int64BindVariable(time.Now().UnixNano()),
+	)
+	if err != nil {
+		return err
+	}
+	if _, err := withDDLVStreamCheckpoints.Exec(ctx, query, vc.exec, vc.exec); err != nil {
+		return err
+	}
+	vstreamCheckpointsCount.Add("Saved", 1)
+	return nil
+}
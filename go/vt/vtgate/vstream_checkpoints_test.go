@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestVStreamCheckpoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+
+	vsm := newTestVStreamManager(hc, st, cell)
+	vsm.checkpoints = newVStreamCheckpoints(vsm.resolver, KsTestUnsharded)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+	sbcCheckpoints := hc.AddTestTablet(cell, "1.1.1.2", 1002, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	acked := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "gtid01",
+		}},
+	}
+	err := vsm.VStreamAck(ctx, "consumer", acked)
+	require.NoError(t, err)
+	require.Len(t, sbcCheckpoints.Queries, 1)
+	assert.True(t, strings.HasPrefix(sbcCheckpoints.Queries[0].Sql, "insert into _vt.vstream_checkpoints(name, vgtid, time_updated) values ('consumer', "), sbcCheckpoints.Queries[0].Sql)
+
+	// The consumer resumes from its checkpoint instead of the requested position.
+	buf, err := prototext.Marshal(acked)
+	require.NoError(t, err)
+	sbcCheckpoints.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("vgtid", "varbinary"), string(buf))})
+	sbc0.StartPos = "gtid01"
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid02"},
+		{Type: binlogdatapb.VEventType_DDL},
+	}, nil)
+	want := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: ks,
+				Shard:    "-20",
+				Gtid:     "gtid02",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_DDL},
+	}}
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{CheckpointName: "consumer"})
+	verifyEvents(t, ch, want)
+	assert.Equal(t, "select vgtid from _vt.vstream_checkpoints where name = 'consumer'", sbcCheckpoints.Queries[1].Sql)
+}
+
+func TestVStreamCheckpointsDisabled(t *testing.T) {
+	ctx := context.Background()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{CheckpointName: "consumer"}, func([]*binlogdatapb.VEvent) error {
+		return nil
+	})
+	assert.EqualError(t, err, "VStream checkpoints are disabled, vtgate must be started with -vstream_checkpoints_keyspace")
+	err = vsm.VStreamAck(ctx, "consumer", vgtid)
+	assert.EqualError(t, err, "VStream checkpoints are disabled, vtgate must be started with -vstream_checkpoints_keyspace")
+
+	vsm.checkpoints = newVStreamCheckpoints(vsm.resolver, KsTestUnsharded)
+	err = vsm.VStreamAck(ctx, "", vgtid)
+	assert.EqualError(t, err, "the checkpoint name must be set")
+	err = vsm.VStreamAck(ctx, "consumer", &binlogdatapb.VGtid{})
+	assert.EqualError(t, err, "vgtid must have at least one value with a position")
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var vstreamCopyThrottled = stats.NewCounterDuration(
+	"VStreamCopyThrottled",
+	"Time that the copy phases of the VStreams waited for their rows_per_second and bytes_per_second limits")
+
+// vstreamCopyLimiter limits the copy phase of a VStream over all its shards:
+// the rate of the rows and bytes that they copy, and how many of them copy
+// their tables at the same time. VStreamControl can change its limits while
+// the stream runs. A nil vstreamCopyLimiter does not limit anything.
+type vstreamCopyLimiter struct {
+	mu     sync.Mutex
+	limits *vtgatepb.VStreamCopyLimits
+	// changed is closed when the limits change, to wake up the shards that
+	// wait for them.
+	changed chan struct{}
+	// released is closed when a shard stops copying, to wake up the shards
+	// that wait for their turn.
+	released chan struct{}
+	// copying is the number of shards that copy their tables.
+	copying int
+	// next is the time from which the next copied rows can be sent.
+	next time.Time
+}
+
+func newVStreamCopyLimiter(limits *vtgatepb.VStreamCopyLimits) *vstreamCopyLimiter {
+	return &vstreamCopyLimiter{
+		limits:   limits,
+		changed:  make(chan struct{}),
+		released: make(chan struct{}),
+	}
+}
+
+// validateCopyLimits returns an INVALID_ARGUMENT error if a limit is negative.
+func validateCopyLimits(limits *vtgatepb.VStreamCopyLimits) error {
+	if limits.GetRowsPerSecond() < 0 || limits.GetBytesPerSecond() < 0 || limits.GetParallelism() < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the copy limits must not be negative: %v", limits)
+	}
+	return nil
+}
+
+// setLimits replaces the limits. The pacing starts over from the new rates.
+func (l *vstreamCopyLimiter) setLimits(limits *vtgatepb.VStreamCopyLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = limits
+	l.next = time.Time{}
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// acquire waits until a shard can start copying its tables, which is when
+// fewer shards than the parallelism copy theirs.
+func (l *vstreamCopyLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		parallelism := int(l.limits.GetParallelism())
+		if parallelism == 0 || l.copying < parallelism {
+			l.copying++
+			l.mu.Unlock()
+			return nil
+		}
+		changed, released := l.changed, l.released
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-released:
+		}
+	}
+}
+
+// release lets another shard copy its tables, once a shard that acquired
+// its turn is done.
+func (l *vstreamCopyLimiter) release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.copying--
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// throttle waits until the copied rows of a shard can be sent, so that all
+// the shards together copy no faster than the rates. Since the tablet stream
+// waits for its callback, the tablet also slows down its copy.
+func (l *vstreamCopyLimiter) throttle(ctx context.Context, rows, bytes int64) error {
+	if l == nil || rows == 0 {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		var cost time.Duration
+		if rps := l.limits.GetRowsPerSecond(); rps > 0 {
+			cost = time.Duration(rows) * time.Second / time.Duration(rps)
+		}
+		if bps := l.limits.GetBytesPerSecond(); bps > 0 {
+			if bytesCost := time.Duration(bytes) * time.Second / time.Duration(bps); bytesCost > cost {
+				cost = bytesCost
+			}
+		}
+		if cost == 0 {
+			l.mu.Unlock()
+			return nil
+		}
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		wait := l.next.Sub(now)
+		l.next = l.next.Add(cost)
+		changed := l.changed
+		l.mu.Unlock()
+
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+			vstreamCopyThrottled.Add(wait)
+			return nil
+		case <-changed:
+			// The new limits apply to the rows that were waiting.
+			timer.Stop()
+			vstreamCopyThrottled.Add(time.Since(now))
+		}
+	}
+}
+
+// copiedRows returns the number of rows and bytes that a batch of events of
+// the copy phase contains.
+func copiedRows(events []*binlogdatapb.VEvent) (rows, bytes int64) {
+	for _, event := range events {
+		if event.Type != binlogdatapb.VEventType_ROW {
+			continue
+		}
+		for _, change := range event.RowEvent.RowChanges {
+			rows++
+			bytes += int64(len(change.After.GetValues()))
+		}
+	}
+	return rows, bytes
+}
+
+// registerStream adds a running stream to the streams of its name, for
+// VStreamControl to change its limits.
+func (vsm *vstreamManager) registerStream(name string, vs *vstream) {
+	vsm.streamsMu.Lock()
+	defer vsm.streamsMu.Unlock()
+	if vsm.streams[name] == nil {
+		vsm.streams[name] = make(map[*vstream]bool)
+	}
+	vsm.streams[name][vs] = true
+}
+
+// unregisterStream removes a stream that ended from the streams of its name.
+func (vsm *vstreamManager) unregisterStream(name string, vs *vstream) {
+	vsm.streamsMu.Lock()
+	defer vsm.streamsMu.Unlock()
+	delete(vsm.streams[name], vs)
+	if len(vsm.streams[name]) == 0 {
+		delete(vsm.streams, name)
+	}
+}
+
+// VStreamControl replaces the copy limits of the running streams of a name,
+// and returns how many of them it changed.
+func (vsm *vstreamManager) VStreamControl(ctx context.Context, name string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	if name == "" {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the stream name must be set")
+	}
+	if err := validateCopyLimits(limits); err != nil {
+		return 0, err
+	}
+	vsm.streamsMu.Lock()
+	defer vsm.streamsMu.Unlock()
+	streams := vsm.streams[name]
+	if len(streams) == 0 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no running VStream named %s", name)
+	}
+	for vs := range streams {
+		vs.copyLimiter.setLimits(limits)
+	}
+	log.Infof("Changed the copy limits of %d VStreams named %s to %v", len(streams), name, limits)
+	return len(streams), nil
+}
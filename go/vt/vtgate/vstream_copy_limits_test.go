@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestVStreamCopyLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := newVStreamCopyLimiter(&vtgatepb.VStreamCopyLimits{Parallelism: 1})
+
+	// Only one shard copies at a time.
+	require.NoError(t, l.acquire(ctx))
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.acquire(shortCtx))
+	acquired := make(chan error)
+	go func() {
+		acquired <- l.acquire(ctx)
+	}()
+	l.release()
+	require.NoError(t, <-acquired)
+
+	// A higher parallelism wakes up the waiting shards.
+	go func() {
+		acquired <- l.acquire(ctx)
+	}()
+	l.setLimits(&vtgatepb.VStreamCopyLimits{Parallelism: 2})
+	require.NoError(t, <-acquired)
+
+	// The rows of the shards are paced at the rates.
+	l.setLimits(&vtgatepb.VStreamCopyLimits{RowsPerSecond: 100, BytesPerSecond: 1000})
+	start := time.Now()
+	require.NoError(t, l.throttle(ctx, 10, 0))
+	require.NoError(t, l.throttle(ctx, 1, 200))
+	require.NoError(t, l.throttle(ctx, 1, 0))
+	// 10 rows at 100/s, then 200 bytes at 1000 bytes/s.
+	assert.GreaterOrEqual(t, time.Since(start), 250*time.Millisecond)
+
+	// Lifting the limits wakes up the waiting rows.
+	l.setLimits(&vtgatepb.VStreamCopyLimits{RowsPerSecond: 1})
+	require.NoError(t, l.throttle(ctx, 60, 0))
+	throttled := make(chan error)
+	go func() {
+		throttled <- l.throttle(ctx, 1, 0)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	l.setLimits(&vtgatepb.VStreamCopyLimits{})
+	select {
+	case err := <-throttled:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the throttled rows were not woken up by the new limits")
+	}
+
+	// A nil limiter does not limit anything.
+	var none *vstreamCopyLimiter
+	require.NoError(t, none.acquire(ctx))
+	require.NoError(t, none.throttle(ctx, 1000, 1000))
+	none.release()
+}
+
+func TestVStreamControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStreamControl"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20", "20-40"})
+	vsm := newTestVStreamManager(hc, st, cell)
+
+	vgtid := &binlogdatapb.VGtid{}
+	for _, shard := range []string{"-20", "20-40"} {
+		sbc := hc.AddTestTablet(cell, shard, 1, ks, shard, topodatapb.TabletType_PRIMARY, true, 1, nil)
+		addTabletToSandboxTopo(t, st, ks, shard, sbc.Tablet())
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid" + shard},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+		// An empty position makes the shards copy their tables first.
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{Keyspace: ks, Shard: shard})
+	}
+
+	_, err := vsm.VStreamControl(ctx, "copy", &vtgatepb.VStreamCopyLimits{Parallelism: 2})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+	_, err = vsm.VStreamControl(ctx, "", &vtgatepb.VStreamCopyLimits{Parallelism: 2})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	// Only one shard copies, until the parallelism is raised.
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{
+		StreamName: "copy",
+		CopyLimits: &vtgatepb.VStreamCopyLimits{Parallelism: 1},
+	})
+	<-ch
+	select {
+	case got := <-ch:
+		t.Fatalf("the second shard copied with a parallelism of 1: %v", got)
+	case <-time.After(200 * time.Millisecond):
+	}
+	_, err = vsm.VStreamControl(ctx, "copy", &vtgatepb.VStreamCopyLimits{Parallelism: -1})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	streams, err := vsm.VStreamControl(ctx, "copy", &vtgatepb.VStreamCopyLimits{Parallelism: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, streams)
+	<-ch
+}
+
+func TestVStreamCopyCompleted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStreamCopyCompleted"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+	}, nil)
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{
+		StreamName: "copy",
+		CopyLimits: &vtgatepb.VStreamCopyLimits{Parallelism: 1},
+	})
+	// The event is sent to the client, and the shard no longer counts as copying.
+	verifyEvents(t, ch, &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+	}})
+	vsm.streamsMu.Lock()
+	defer vsm.streamsMu.Unlock()
+	for vs := range vsm.streams["copy"] {
+		vs.copyLimiter.mu.Lock()
+		assert.Zero(t, vs.copyLimiter.copying)
+		vs.copyLimiter.mu.Unlock()
+	}
+}
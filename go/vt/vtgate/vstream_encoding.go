@@ -0,0 +1,400 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var vstreamSchemaRegistryURL = flag.String("vstream_schema_registry_url", "", "the URL of the Confluent schema registry in which vtgate registers the Avro schemas of the tables of the VStreams with the avro encoding. The encoded rows are then prefixed with the ID of their schema, in the Confluent wire format")
+
+// The encodings of the rows of a VStream, other than the querypb rows.
+const (
+	vstreamEncodingJSON = "json"
+	vstreamEncodingAvro = "avro"
+)
+
+// rowEncoder encodes the rows of the row events of a VStream, as set by its
+// encoding flag. It is used by the goroutine that sends the events.
+type rowEncoder struct {
+	encoding string
+	// registry is nil if the Avro schemas are not registered.
+	registry *schemaRegistry
+	// tables are keyed by the qualified name of the table and its shard,
+	// since the shards can send different fields during a schema change.
+	tables map[string]*encodedTable
+}
+
+// encodedTable are the fields of the rows of a table.
+type encodedTable struct {
+	fields []*querypb.Field
+	// schemaID is the ID of the schema in the registry, if it is registered.
+	schemaID int32
+}
+
+func newRowEncoder(encoding string, registry *schemaRegistry) (*rowEncoder, error) {
+	switch encoding {
+	case vstreamEncodingJSON:
+		registry = nil
+	case vstreamEncodingAvro:
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported VStream encoding %q, expected %s or %s", encoding, vstreamEncodingJSON, vstreamEncodingAvro)
+	}
+	return &rowEncoder{
+		encoding: encoding,
+		registry: registry,
+		tables:   make(map[string]*encodedTable),
+	}, nil
+}
+
+// encode replaces the rows of the row events with their encoding, and adds
+// the Avro schemas to the field events.
+func (re *rowEncoder) encode(ctx context.Context, events []*binlogdatapb.VEvent) error {
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_FIELD:
+			if err := re.addTable(ctx, event.FieldEvent); err != nil {
+				return err
+			}
+		case binlogdatapb.VEventType_ROW:
+			table := re.tables[event.RowEvent.TableName+"/"+event.RowEvent.Shard]
+			if table == nil {
+				return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "VStream row event of table %s before its field event", event.RowEvent.TableName)
+			}
+			for _, change := range event.RowEvent.RowChanges {
+				var err error
+				if change.Before != nil {
+					if change.EncodedBefore, err = re.encodeRow(table, change.Before); err != nil {
+						return err
+					}
+					change.Before = nil
+				}
+				if change.After != nil {
+					if change.EncodedAfter, err = re.encodeRow(table, change.After); err != nil {
+						return err
+					}
+					change.After = nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (re *rowEncoder) addTable(ctx context.Context, fieldEvent *binlogdatapb.FieldEvent) error {
+	table := &encodedTable{fields: fieldEvent.Fields}
+	if re.encoding == vstreamEncodingAvro {
+		// The table names of the events are qualified by their keyspace.
+		keyspace, name := "", fieldEvent.TableName
+		if i := strings.IndexByte(name, '.'); i >= 0 {
+			keyspace, name = name[:i], name[i+1:]
+		}
+		schema, err := avroSchema(keyspace, name, fieldEvent.Fields)
+		if err != nil {
+			return err
+		}
+		if re.registry != nil {
+			if table.schemaID, err = re.registry.register(ctx, fieldEvent.TableName+"-value", schema); err != nil {
+				return vterrors.Wrapf(err, "cannot register the Avro schema of table %s", fieldEvent.TableName)
+			}
+		}
+		fieldEvent.EncodedSchema = schema
+	}
+	re.tables[fieldEvent.TableName+"/"+fieldEvent.Shard] = table
+	return nil
+}
+
+func (re *rowEncoder) encodeRow(table *encodedTable, row *querypb.Row) ([]byte, error) {
+	values := sqltypes.MakeRowTrusted(table.fields, row)
+	if len(values) != len(table.fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "VStream row has %d values, but its table has %d fields", len(values), len(table.fields))
+	}
+	if re.encoding == vstreamEncodingJSON {
+		return jsonRow(table.fields, values)
+	}
+	var buf bytes.Buffer
+	if re.registry != nil {
+		// The Confluent wire format: a zero byte and the ID of the schema.
+		buf.WriteByte(0)
+		_ = binary.Write(&buf, binary.BigEndian, table.schemaID)
+	}
+	if err := avroRow(&buf, table.fields, values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonRow encodes a row as a JSON object of its columns. The numbers are
+// JSON numbers, except for the decimals, the binary values are encoded in
+// base64, the JSON values are embedded, and the others are strings.
+func jsonRow(fields []*querypb.Field, values []sqltypes.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(fields[i].Name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		var encoded []byte
+		var err error
+		switch typ := fields[i].Type; {
+		case value.IsNull():
+			encoded = []byte("null")
+		case sqltypes.IsIntegral(typ), sqltypes.IsFloat(typ):
+			encoded = value.Raw()
+		case typ == sqltypes.TypeJSON && json.Valid(value.Raw()):
+			encoded = value.Raw()
+		case sqltypes.IsBinary(typ) || typ == sqltypes.Bit:
+			encoded, err = json.Marshal(value.Raw())
+		default:
+			encoded, err = json.Marshal(value.ToString())
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoded)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// avroType returns the Avro type of the values of a field.
+func avroType(typ querypb.Type) string {
+	switch {
+	case typ == sqltypes.Uint64:
+		// Avro has no unsigned long.
+		return "string"
+	case sqltypes.IsIntegral(typ):
+		return "long"
+	case typ == sqltypes.Float32:
+		return "float"
+	case typ == sqltypes.Float64:
+		return "double"
+	case sqltypes.IsBinary(typ) || typ == sqltypes.Bit:
+		return "bytes"
+	}
+	// The decimals, the texts and the temporal values are strings.
+	return "string"
+}
+
+func avroNullable(field *querypb.Field) bool {
+	return field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0
+}
+
+// avroName replaces the characters that Avro does not allow in names.
+func avroName(name string) string {
+	var b strings.Builder
+	for i, c := range name {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', i > 0 && '0' <= c && c <= '9':
+			b.WriteRune(c)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// avroSchema returns the Avro schema of the rows of a table: a record of its
+// columns, which can be null unless the column is NOT NULL.
+func avroSchema(keyspace, table string, fields []*querypb.Field) (string, error) {
+	type avroField struct {
+		Name string `json:"name"`
+		Type any    `json:"type"`
+	}
+	type avroNullableField struct {
+		Name    string `json:"name"`
+		Type    any    `json:"type"`
+		Default any    `json:"default"`
+	}
+	record := struct {
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Namespace string `json:"namespace,omitempty"`
+		Fields    []any  `json:"fields"`
+	}{
+		Type:      "record",
+		Name:      avroName(table),
+		Namespace: avroName(keyspace),
+		Fields:    make([]any, 0, len(fields)),
+	}
+	for _, field := range fields {
+		if avroNullable(field) {
+			record.Fields = append(record.Fields, avroNullableField{
+				Name: avroName(field.Name),
+				Type: []string{"null", avroType(field.Type)},
+			})
+			continue
+		}
+		record.Fields = append(record.Fields, avroField{
+			Name: avroName(field.Name),
+			Type: avroType(field.Type),
+		})
+	}
+	schema, err := json.Marshal(record)
+	return string(schema), err
+}
+
+// avroRow writes a row in the Avro binary encoding of the record of avroSchema.
+func avroRow(w *bytes.Buffer, fields []*querypb.Field, values []sqltypes.Value) error {
+	var varint [binary.MaxVarintLen64]byte
+	writeLong := func(v int64) {
+		// Avro longs are zig-zag varints, like the signed varints of Go.
+		n := binary.PutVarint(varint[:], v)
+		w.Write(varint[:n])
+	}
+	writeBytes := func(b []byte) {
+		writeLong(int64(len(b)))
+		w.Write(b)
+	}
+	for i, value := range values {
+		field := fields[i]
+		if avroNullable(field) {
+			// The index of the branch of the ["null", type] union.
+			if value.IsNull() {
+				writeLong(0)
+				continue
+			}
+			writeLong(1)
+		} else if value.IsNull() {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "NULL value in NOT NULL column %s", field.Name)
+		}
+		switch avroType(field.Type) {
+		case "long":
+			var v int64
+			var err error
+			if sqltypes.IsUnsigned(field.Type) {
+				var u uint64
+				u, err = value.ToUint64()
+				v = int64(u)
+			} else {
+				v, err = value.ToInt64()
+			}
+			if err != nil {
+				return err
+			}
+			writeLong(v)
+		case "float":
+			v, err := value.ToFloat64()
+			if err != nil {
+				return err
+			}
+			var b [4]byte
+			binary.LittleEndian.PutUint32(b[:], math.Float32bits(float32(v)))
+			w.Write(b[:])
+		case "double":
+			v, err := value.ToFloat64()
+			if err != nil {
+				return err
+			}
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+			w.Write(b[:])
+		default:
+			// The Avro strings and bytes are both encoded as their length
+			// and their bytes.
+			writeBytes(value.Raw())
+		}
+	}
+	return nil
+}
+
+// schemaRegistry registers the Avro schemas of the VStreams in a Confluent
+// schema registry.
+type schemaRegistry struct {
+	url    string
+	client *http.Client
+
+	mu sync.Mutex
+	// ids are the IDs of the registered schemas, keyed by their subject and schema.
+	ids map[string]int32
+}
+
+func newSchemaRegistry(registryURL string) *schemaRegistry {
+	return &schemaRegistry{
+		url:    strings.TrimSuffix(registryURL, "/"),
+		client: &http.Client{Timeout: 10 * time.Second},
+		ids:    make(map[string]int32),
+	}
+}
+
+// register registers the schema under the subject, unless it already did,
+// and returns its ID. Registering a schema that the registry already has
+// returns its existing ID.
+func (sr *schemaRegistry) register(ctx context.Context, subject, schema string) (int32, error) {
+	key := subject + "\n" + schema
+	sr.mu.Lock()
+	id, ok := sr.ids[key]
+	sr.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/subjects/%s/versions", sr.url, url.PathEscape(subject)), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	resp, err := sr.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("schema registry returned %s: %s", resp.Status, respBody)
+	}
+	var registered struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &registered); err != nil {
+		return 0, err
+	}
+
+	sr.mu.Lock()
+	sr.ids[key] = registered.ID
+	sr.mu.Unlock()
+	return registered.ID, nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var encodingTestFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+	{Name: "name", Type: sqltypes.VarChar},
+	{Name: "data", Type: sqltypes.VarBinary},
+	{Name: "price", Type: sqltypes.Decimal},
+	{Name: "doc", Type: sqltypes.TypeJSON},
+	{Name: "ratio", Type: sqltypes.Float64},
+}
+
+func encodingTestRow(values ...sqltypes.Value) *querypb.Row {
+	return sqltypes.RowToProto3(values)
+}
+
+func TestVStreamJSONEncoding(t *testing.T) {
+	row := encodingTestRow(
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar(`a "b"`),
+		sqltypes.NewVarBinary("\x00\x01"),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"k": [1, 2]}`)),
+		sqltypes.NULL,
+	)
+	encoded, err := jsonRow(encodingTestFields, sqltypes.MakeRowTrusted(encodingTestFields, row))
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1,"name":"a \"b\"","data":"AAE=","price":"1.50","doc":{"k": [1, 2]},"ratio":null}`, string(encoded))
+	assert.True(t, json.Valid(encoded))
+}
+
+func TestVStreamAvroEncoding(t *testing.T) {
+	schema, err := avroSchema("ks-1", "t", encodingTestFields)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"record","name":"t","namespace":"ks_1","fields":[`+
+		`{"name":"id","type":"long"},`+
+		`{"name":"name","type":["null","string"],"default":null},`+
+		`{"name":"data","type":["null","bytes"],"default":null},`+
+		`{"name":"price","type":["null","string"],"default":null},`+
+		`{"name":"doc","type":["null","string"],"default":null},`+
+		`{"name":"ratio","type":["null","double"],"default":null}]}`, schema)
+
+	encoder, err := newRowEncoder(vstreamEncodingAvro, nil)
+	require.NoError(t, err)
+	events := []*binlogdatapb.VEvent{{
+		Type:       binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks-1.t", Fields: encodingTestFields, Shard: "0"},
+	}, {
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks-1.t", Shard: "0", RowChanges: []*binlogdatapb.RowChange{{
+			After: encodingTestRow(
+				sqltypes.NewInt64(-2),
+				sqltypes.NewVarChar("ab"),
+				sqltypes.NULL,
+				sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5")),
+				sqltypes.NULL,
+				sqltypes.NewFloat64(1),
+			),
+		}}},
+	}}
+	err = encoder.encode(context.Background(), events)
+	require.NoError(t, err)
+	assert.Equal(t, schema, events[0].FieldEvent.EncodedSchema)
+	change := events[1].RowEvent.RowChanges[0]
+	assert.Nil(t, change.After)
+	assert.Nil(t, change.EncodedBefore)
+	assert.Equal(t, []byte{
+		0x03,                 // id: -2
+		0x02, 0x04, 'a', 'b', // name: "ab"
+		0x00,                      // data: null
+		0x02, 0x06, '1', '.', '5', // price: "1.5"
+		0x00,                                                 // doc: null
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, // ratio: 1.0
+	}, change.EncodedAfter)
+
+	// A row of a table without fields can't be encoded.
+	err = encoder.encode(context.Background(), []*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks-1.t2", Shard: "0"},
+	}})
+	assert.EqualError(t, err, "VStream row event of table ks-1.t2 before its field event")
+
+	_, err = newRowEncoder("xml", nil)
+	assert.EqualError(t, err, `unsupported VStream encoding "xml", expected json or avro`)
+}
+
+func TestVStreamSchemaRegistry(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.URL.Path)
+		assert.Equal(t, "application/vnd.schemaregistry.v1+json", r.Header.Get("Content-Type"))
+		assert.Contains(t, string(body), `"schema":"{\"type\":\"record\",\"name\":\"t\"`)
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+
+	encoder, err := newRowEncoder(vstreamEncodingAvro, newSchemaRegistry(server.URL+"/"))
+	require.NoError(t, err)
+	fields := encodingTestFields[:1]
+	events := []*binlogdatapb.VEvent{{
+		Type:       binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t", Fields: fields, Shard: "-80"},
+	}, {
+		Type:       binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t", Fields: fields, Shard: "80-"},
+	}, {
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t", Shard: "80-", RowChanges: []*binlogdatapb.RowChange{{
+			Before: encodingTestRow(sqltypes.NewInt64(1)),
+		}}},
+	}}
+	err = encoder.encode(context.Background(), events)
+	require.NoError(t, err)
+	// The schema is only registered once.
+	assert.Equal(t, []string{"/subjects/ks.t-value/versions"}, requests)
+	assert.Equal(t, []byte{0x00, 0x00, 0x00, 0x00, 0x07, 0x02}, events[2].RowEvent.RowChanges[0].EncodedBefore)
+}
+
+func TestVStreamEncodedEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+
+	fields := encodingTestFields[:2]
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t0", Fields: fields, Shard: "-20"}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t0", Shard: "-20", RowChanges: []*binlogdatapb.RowChange{{
+			After: encodingTestRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+		}}}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	want := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: ks,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t0", Fields: fields, Shard: "-20"}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0", Shard: "-20", RowChanges: []*binlogdatapb.RowChange{{
+			EncodedAfter: []byte(`{"id":1,"name":"a"}`),
+		}}}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{Encoding: vstreamEncodingJSON})
+	verifyEvents(t, ch, want)
+
+	err := vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{Encoding: "xml"}, func([]*binlogdatapb.VEvent) error {
+		return nil
+	})
+	assert.EqualError(t, err, `unsupported VStream encoding "xml", expected json or avro`)
+}
@@ -20,12 +20,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/discovery"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 
@@ -44,11 +49,26 @@ type vstreamManager struct {
 	resolver *srvtopo.Resolver
 	toposerv srvtopo.Server
 	cell     string
+
+	// checkpoints is nil if the checkpoints of named consumers are disabled.
+	checkpoints *vstreamCheckpoints
+	// schemaRegistry is nil if the Avro schemas of the encoded rows are not registered.
+	schemaRegistry *schemaRegistry
+
+	// streamsMu protects streams, the running streams that have a name, for
+	// VStreamControl to change their copy limits.
+	streamsMu sync.Mutex
+	streams   map[string]map[*vstream]bool
 }
 
 // maxSkewTimeoutSeconds is the maximum allowed skew between two streams when the MinimizeSkew flag is set
 const maxSkewTimeoutSeconds = 10 * 60
 
+var vstreamTabletReselections = stats.NewCountersWithMultiLabels(
+	"VStreamTabletReselections",
+	"Number of times the VStreams streamed a shard from another tablet because theirs became unhealthy or lagged",
+	[]string{"Keyspace", "Shard"})
+
 // vstream contains the metadata for one VStream request.
 type vstream struct {
 	// mu protects parts of vgtid, the semantics of a send, and journaler.
@@ -104,6 +124,17 @@ type vstream struct {
 	eventCh           chan []*binlogdatapb.VEvent
 	heartbeatInterval uint32
 	ts                *topo.Server
+
+	// copyLimiter limits the copy phase of the shards. It is nil if the
+	// stream has neither copy limits nor a name.
+	copyLimiter *vstreamCopyLimiter
+
+	// cells are the cells, or cell aliases, from which the shards stream,
+	// in order of preference.
+	cells []string
+	// maxReplicationLag is the replication lag beyond which a shard streams
+	// from another tablet. It is 0 if there is no limit.
+	maxReplicationLag time.Duration
 }
 
 type journalEvent struct {
@@ -113,19 +144,58 @@ type journalEvent struct {
 }
 
 func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell string) *vstreamManager {
-	return &vstreamManager{
+	vsm := &vstreamManager{
 		resolver: resolver,
 		toposerv: serv,
 		cell:     cell,
+		streams:  make(map[string]map[*vstream]bool),
 	}
+	if *vstreamCheckpointsKeyspace != "" {
+		vsm.checkpoints = newVStreamCheckpoints(resolver, *vstreamCheckpointsKeyspace)
+	}
+	if *vstreamSchemaRegistryURL != "" {
+		vsm.schemaRegistry = newSchemaRegistry(*vstreamSchemaRegistryURL)
+	}
+	return vsm
 }
 
 func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+	if name := flags.GetCheckpointName(); name != "" {
+		// A named consumer resumes from the last VGTID it acknowledged,
+		// and only starts from the requested one on its first stream.
+		if vsm.checkpoints == nil {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream checkpoints are disabled, vtgate must be started with -vstream_checkpoints_keyspace")
+		}
+		checkpoint, err := vsm.checkpoints.get(ctx, name)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot read the checkpoint of VStream consumer %s", name)
+		}
+		if checkpoint != nil {
+			log.Infof("Resuming VStream consumer %s from %v", name, checkpoint)
+			vgtid = checkpoint
+		}
+	}
 	vgtid, filter, flags, err := vsm.resolveParams(ctx, tabletType, vgtid, filter, flags)
 	if err != nil {
 		return err
 	}
+	if err := validateCopyLimits(flags.GetCopyLimits()); err != nil {
+		return err
+	}
+	if encoding := flags.GetEncoding(); encoding != "" {
+		encoder, err := newRowEncoder(encoding, vsm.schemaRegistry)
+		if err != nil {
+			return err
+		}
+		sendRows := send
+		send = func(events []*binlogdatapb.VEvent) error {
+			if err := encoder.encode(ctx, events); err != nil {
+				return err
+			}
+			return sendRows(events)
+		}
+	}
 	ts, err := vsm.toposerv.GetTopoServer()
 	if err != nil {
 		return err
@@ -149,10 +219,49 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		eventCh:            make(chan []*binlogdatapb.VEvent),
 		heartbeatInterval:  flags.GetHeartbeatInterval(),
 		ts:                 ts,
+		cells:              vstreamCells(flags, vsm.cell),
+		maxReplicationLag:  time.Duration(flags.GetMaxReplicationLagSeconds()) * time.Second,
+	}
+	if flags.GetCopyLimits() != nil || flags.GetStreamName() != "" {
+		vs.copyLimiter = newVStreamCopyLimiter(flags.GetCopyLimits())
+	}
+	if name := flags.GetStreamName(); name != "" {
+		vsm.registerStream(name, vs)
+		defer vsm.unregisterStream(name, vs)
 	}
 	return vs.stream(ctx)
 }
 
+// vstreamCells returns the cells from which the shards of a stream stream,
+// in order of preference: the ones of its flags, or else the cell of vtgate.
+func vstreamCells(flags *vtgatepb.VStreamFlags, cell string) []string {
+	var cells []string
+	for _, c := range strings.Split(flags.GetCells(), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			cells = append(cells, c)
+		}
+	}
+	if len(cells) == 0 {
+		return []string{cell}
+	}
+	return cells
+}
+
+// VStreamAck saves the VGTID up to which a named consumer has processed its
+// stream, so that its next VStream resumes from there.
+func (vsm *vstreamManager) VStreamAck(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error {
+	if vsm.checkpoints == nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "VStream checkpoints are disabled, vtgate must be started with -vstream_checkpoints_keyspace")
+	}
+	if name == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the checkpoint name must be set")
+	}
+	if len(vgtid.GetShardGtids()) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a position")
+	}
+	return vsm.checkpoints.save(ctx, name, vgtid)
+}
+
 // resolveParams provides defaults for the inputs if they're not specified.
 func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (*binlogdatapb.VGtid, *binlogdatapb.Filter, *vtgatepb.VStreamFlags, error) {
@@ -414,6 +523,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	// It will be closed when all journal events converge.
 	var journalDone chan struct{}
 
+	// avoid has the tablets that failed or lagged, for the shard to stream
+	// from other ones if it can.
+	avoid := make(map[string]bool)
 	errCount := 0
 	for {
 		select {
@@ -429,34 +541,30 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 
 		var eventss [][]*binlogdatapb.VEvent
 		var err error
-		tp, err := discovery.NewTabletPicker(vs.ts, []string{vs.vsm.cell}, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
+		tablet, tabletConn, anyLag, err := vs.pickTablet(ctx, sgtid, avoid)
 		if err != nil {
 			log.Errorf(err.Error())
 			return err
 		}
-		tablet, err := tp.PickForStreaming(ctx)
-		if err != nil {
-			log.Errorf(err.Error())
-			return err
+		maxLag := vs.maxReplicationLag
+		if anyLag {
+			maxLag = 0
 		}
-		log.Infof("Picked tablet %s for for %s/%s/%s/%s", tablet.Alias.String(), vs.vsm.cell, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
+		log.Infof("Picked tablet %s for for %v/%s/%s/%s", tablet.Alias.String(), vs.cells, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
 		target := &querypb.Target{
 			Keyspace:   sgtid.Keyspace,
 			Shard:      sgtid.Shard,
 			TabletType: vs.tabletType,
-			Cell:       vs.vsm.cell,
-		}
-		tabletConn, err := vs.vsm.resolver.GetGateway().QueryServiceByAlias(tablet.Alias, target)
-		if err != nil {
-			log.Errorf(err.Error())
-			return err
+			Cell:       tablet.Alias.Cell,
 		}
 
+		// The health stream ends with the stream from the tablet.
+		healthCtx, cancelHealth := context.WithCancel(ctx)
 		errCh := make(chan error, 1)
 		go func() {
-			_ = tabletConn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+			_ = tabletConn.StreamHealth(healthCtx, func(shr *querypb.StreamHealthResponse) error {
 				var err error
-				if ctx.Err() != nil {
+				if healthCtx.Err() != nil {
 					err = fmt.Errorf("context has ended")
 				} else if shr == nil || shr.RealtimeStats == nil || shr.Target == nil {
 					err = fmt.Errorf("health check failed")
@@ -466,16 +574,38 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 				} else if shr.RealtimeStats.HealthError != "" {
 					err = fmt.Errorf("tablet %s is no longer healthy: %s, restarting vstream",
 						tablet.Alias, shr.RealtimeStats.HealthError)
+				} else if lag := time.Duration(shr.RealtimeStats.ReplicationLagSeconds) * time.Second; maxLag > 0 && lag > maxLag {
+					err = fmt.Errorf("tablet %s lags by %v, more than %v, restarting vstream",
+						tablet.Alias, lag, maxLag)
 				}
 				if err != nil {
-					errCh <- err
+					select {
+					case errCh <- err:
+					default:
+					}
 				}
 				return nil
 			})
 		}()
 
+		// The shard copies its tables first if it has no position yet, or
+		// if it resumes a copy. Safe to access sgtid here (because it can't
+		// change until streaming begins).
+		copying := sgtid.Gtid == "" || len(sgtid.TablePKs) > 0
+		if copying {
+			if err := vs.copyLimiter.acquire(ctx); err != nil {
+				cancelHealth()
+				return err
+			}
+		}
+		stopCopying := func() {
+			if copying {
+				copying = false
+				vs.copyLimiter.release()
+			}
+		}
+
 		log.Infof("Starting to vstream from %s", tablet.Alias.String())
-		// Safe to access sgtid.Gtid here (because it can't change until streaming begins).
 		err = tabletConn.VStream(ctx, target, sgtid.Gtid, sgtid.TablePKs, vs.filter, func(events []*binlogdatapb.VEvent) error {
 			// We received a valid event. Reset error count.
 			errCount = 0
@@ -485,6 +615,7 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 				return ctx.Err()
 			case streamErr := <-errCh:
 				log.Warningf("Tablet state changed: %s, attempting to restart", streamErr)
+				vstreamTabletReselections.Add([]string{sgtid.Keyspace, sgtid.Shard}, 1)
 				return vterrors.New(vtrpcpb.Code_UNAVAILABLE, streamErr.Error())
 			case <-journalDone:
 				// Unreachable.
@@ -556,6 +687,16 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 							return io.EOF
 						}
 					}
+				case binlogdatapb.VEventType_COPY_COMPLETED:
+					// The shard copied all its tables: another one can copy theirs.
+					stopCopying()
+					sendevents = append(sendevents, event)
+					eventss = append(eventss, sendevents)
+					if err := vs.sendAll(sgtid, eventss); err != nil {
+						return err
+					}
+					eventss = nil
+					sendevents = nil
 				default:
 					sendevents = append(sendevents, event)
 				}
@@ -563,8 +704,14 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			if len(sendevents) != 0 {
 				eventss = append(eventss, sendevents)
 			}
+			if copying {
+				rows, bytes := copiedRows(events)
+				return vs.copyLimiter.throttle(ctx, rows, bytes)
+			}
 			return nil
 		})
+		stopCopying()
+		cancelHealth()
 		// If stream was ended (by a journal event), return nil without checking for error.
 		select {
 		case <-journalDone:
@@ -579,6 +726,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			log.Errorf("vstream for %s/%s error: %v", sgtid.Keyspace, sgtid.Shard, err)
 			return err
 		}
+		// The shard resumes from its last position, on another tablet if
+		// it has one.
+		avoid[topoproto.TabletAliasString(tablet.Alias)] = true
 		errCount++
 		if errCount >= 3 {
 			log.Errorf("vstream for %s/%s had three consecutive failures: %v", sgtid.Keyspace, sgtid.Shard, err)
@@ -588,6 +738,67 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	}
 }
 
+// pickTablet picks the tablet from which a shard streams: one of the first
+// cell, in order of preference, that has a tablet that vtgate can reach and
+// that is not in avoid. If all such tablets are in avoid, it empties avoid
+// and picks one of them again, and returns true for the shard to stream
+// from it however much it lags. If there is no tablet, it waits for one in
+// any of the cells.
+func (vs *vstream) pickTablet(ctx context.Context, sgtid *binlogdatapb.ShardGtid, avoid map[string]bool) (*topodatapb.Tablet, queryservice.QueryService, bool, error) {
+	target := &querypb.Target{
+		Keyspace:   sgtid.Keyspace,
+		Shard:      sgtid.Shard,
+		TabletType: vs.tabletType,
+	}
+	for pass := 0; pass < 2; pass++ {
+		avoided := false
+		for _, cell := range vs.cells {
+			tp, err := discovery.NewTabletPicker(vs.ts, []string{cell}, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
+			if err != nil {
+				return nil, nil, false, err
+			}
+			candidates := tp.GetMatchingTablets(ctx)
+			rand.Shuffle(len(candidates), func(i, j int) {
+				candidates[i], candidates[j] = candidates[j], candidates[i]
+			})
+			for _, ti := range candidates {
+				if avoid[topoproto.TabletAliasString(ti.Alias)] {
+					avoided = true
+					continue
+				}
+				target.Cell = ti.Alias.Cell
+				conn, err := vs.vsm.resolver.GetGateway().QueryServiceByAlias(ti.Alias, target)
+				if err != nil {
+					log.Warningf("unable to stream %s/%s from tablet %s: %v", sgtid.Keyspace, sgtid.Shard, ti.AliasString(), err)
+					continue
+				}
+				return ti.Tablet, conn, pass > 0, nil
+			}
+		}
+		if !avoided {
+			break
+		}
+		log.Infof("All the tablets of %s/%s failed or lagged, streaming from them again", sgtid.Keyspace, sgtid.Shard)
+		for alias := range avoid {
+			delete(avoid, alias)
+		}
+	}
+	tp, err := discovery.NewTabletPicker(vs.ts, vs.cells, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
+	if err != nil {
+		return nil, nil, false, err
+	}
+	tablet, err := tp.PickForStreaming(ctx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	target.Cell = tablet.Alias.Cell
+	conn, err := vs.vsm.resolver.GetGateway().QueryServiceByAlias(tablet.Alias, target)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return tablet, conn, false, nil
+}
+
 // sendAll sends a group of events together while holding the lock.
 func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdatapb.VEvent) error {
 	vs.mu.Lock()
@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/srvtopo"
 )
@@ -1072,6 +1073,127 @@ func TestVStreamIdleHeartbeat(t *testing.T) {
 	}
 }
 
+func TestVStreamCells(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopoForCells(ctx, []string{"aa", "bb"}, ks, []string{"-20"})
+
+	vsm := newTestVStreamManager(hc, st, "aa")
+	sbcA := hc.AddTestTablet("aa", "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	require.NoError(t, st.topoServer.CreateTablet(ctx, sbcA.Tablet()))
+	sbcB := hc.AddTestTablet("bb", "1.1.1.2", 1001, ks, "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	require.NoError(t, st.topoServer.CreateTablet(ctx, sbcB.Tablet()))
+
+	// The shard streams from the first cell that has a tablet, even if
+	// it is not the cell of vtgate.
+	sbcB.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	flags := &vtgatepb.VStreamFlags{Cells: "cc, bb,aa"}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_REPLICA, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	verifyEvents(t, ch, &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: ks,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}})
+
+	assert.Equal(t, []string{"aa"}, vstreamCells(&vtgatepb.VStreamFlags{}, "aa"))
+	assert.Equal(t, []string{"cc", "bb", "aa"}, vstreamCells(flags, "aa"))
+}
+
+func TestVStreamReselectLaggingTablet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopoForCells(ctx, []string{"aa", "bb"}, ks, []string{"-20"})
+
+	vsm := newTestVStreamManager(hc, st, "aa")
+	sbcA := hc.AddTestTablet("aa", "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	require.NoError(t, st.topoServer.CreateTablet(ctx, sbcA.Tablet()))
+	sbcB := hc.AddTestTablet("bb", "1.1.1.2", 1001, ks, "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	require.NoError(t, st.topoServer.CreateTablet(ctx, sbcB.Tablet()))
+
+	sbcA.VStreamCh = make(chan *binlogdatapb.VEvent)
+	sbcA.StreamHealthCh = make(chan *querypb.StreamHealthResponse)
+	// The shard resumes on the tablet of the other cell from where it
+	// stopped on the lagging one.
+	sbcB.ExpectVStreamStartPos("gtid01")
+	sbcB.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid02"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	flags := &vtgatepb.VStreamFlags{Cells: "aa,bb", MaxReplicationLagSeconds: 10}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_REPLICA, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	vgtidEvent := func(gtid string) *binlogdatapb.VEvent {
+		return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: ks,
+				Shard:    "-20",
+				Gtid:     gtid,
+			}},
+		}}
+	}
+	commitEvent := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+
+	reselections := vstreamTabletReselections.Counts()["TestVStream.-20"]
+	sbcA.VStreamCh <- &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"}
+	sbcA.VStreamCh <- &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+	// The lagging tablet still reads the events it sent, which
+	// verifyEvents would change.
+	for _, want := range [][]*binlogdatapb.VEvent{{vgtidEvent("gtid01")}, {commitEvent}} {
+		got := <-ch
+		assert.True(t, proto.Equal(got, &binlogdatapb.VStreamResponse{Events: want}), "vstream: %v, want %v", got, want)
+	}
+
+	sbcA.StreamHealthCh <- &querypb.StreamHealthResponse{
+		Target:        &querypb.Target{Keyspace: ks, Shard: "-20", TabletType: topodatapb.TabletType_REPLICA},
+		RealtimeStats: &querypb.RealtimeStats{ReplicationLagSeconds: 60},
+	}
+	// The next event of the lagging tablet has the shard stream from
+	// another one.
+	sbcA.VStreamCh <- &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT}
+	verifyEvents(t, ch, &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{vgtidEvent("gtid02"), commitEvent}})
+	assert.Equal(t, reselections+1, vstreamTabletReselections.Counts()["TestVStream.-20"])
+}
+
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
 	gw := NewTabletGateway(context.Background(), hc, serv, cell)
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
@@ -1144,6 +1266,19 @@ func getSandboxTopo(ctx context.Context, cell string, keyspace string, shards []
 	return st
 }
 
+func getSandboxTopoForCells(ctx context.Context, cells []string, keyspace string, shards []string) *sandboxTopo {
+	st := newSandboxForCells(cells)
+	ts := st.topoServer
+	for _, cell := range cells {
+		ts.CreateCellInfo(ctx, cell, &topodatapb.CellInfo{})
+	}
+	ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{})
+	for _, shard := range shards {
+		ts.CreateShard(ctx, keyspace, shard)
+	}
+	return st
+}
+
 func addTabletToSandboxTopo(t *testing.T, st *sandboxTopo, ks, shard string, tablet *topodatapb.Tablet) {
 	_, err := st.topoServer.UpdateShardFields(ctx, ks, shard, func(si *topo.ShardInfo) error {
 		si.PrimaryAlias = tablet.Alias
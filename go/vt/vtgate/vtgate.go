@@ -36,6 +36,8 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/dynamicconfig"
+	"vitess.io/vitess/go/vt/featureflags"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/schema"
@@ -58,14 +60,15 @@ import (
 var (
 	transactionMode      = flag.String("transaction_mode", "MULTI", "SINGLE: disallow multi-db transactions, MULTI: allow multi-db transactions with best effort commit, TWOPC: allow multi-db transactions with 2pc commit")
 	normalizeQueries     = flag.Bool("normalize_queries", true, "Rewrite queries with bind vars. Turn this off if the app itself sends normalized queries with bind vars.")
+	bucketValTuples      = flag.Bool("normalize_tuple_buckets", false, "Pad the tuples of values of the IN clauses that the normalizer cannot turn into a list bind var, such as tuples of tuples or with NULLs, to the next power of two by repeating their last value, so that the queries that only differ by their number of values share a plan. This changes the queries sent to the tablets.")
 	terseErrors          = flag.Bool("vtgate-config-terse-errors", false, "prevent bind vars from escaping in returned errors")
 	streamBufferSize     = flag.Int("stream_buffer_size", 32*1024, "the number of bytes sent from vtgate for each stream call. It's recommended to keep this value in sync with vttablet's query-server-config-stream-buffer-size.")
 	queryPlanCacheSize   = flag.Int64("gate_query_cache_size", cache.DefaultConfig.MaxEntries, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a cache. This config controls the expected amount of unique entries in the cache.")
 	queryPlanCacheMemory = flag.Int64("gate_query_cache_memory", cache.DefaultConfig.MaxMemoryUsage, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	queryPlanCacheLFU    = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
-	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
-	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	maxMemoryRows        = dynamicconfig.NewInt("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
+	warnMemoryRows       = dynamicconfig.NewInt("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
@@ -84,6 +87,9 @@ var (
 	setVarEnabled    = flag.Bool("enable_set_var", true, "This will enable the use of MySQL's SET_VAR query hint for certain system variables instead of using reserved connections")
 	plannerVersion   = flag.String("planner_version", "gen4", "Sets the default planner to use when the session has not changed it. Valid values are: V3, Gen4, Gen4Greedy and Gen4Fallback. Gen4Fallback tries the gen4 planner and falls back to the V3 planner if the gen4 fails.")
 
+	// enableShardedReplace opts in to REPLACE INTO on sharded tables, which vtgate runs as an upsert.
+	enableShardedReplace = flag.Bool("enable_sharded_replace", false, "If set, REPLACE INTO is supported on sharded tables whose columns are authoritative in the vschema, when it sets all of their columns. vtgate runs it as an INSERT ... ON DUPLICATE KEY UPDATE, which updates the conflicting row instead of deleting it: unlike a real REPLACE, it does not fire the DELETE and INSERT triggers of the replaced row, but its UPDATE triggers, and it does not run the ON DELETE foreign key actions, such as CASCADE or SET NULL, but the ON UPDATE ones if the referenced columns change.")
+
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
 	warnShardedOnly   = flag.Bool("warn_sharded_only", false, "If any features that are only available in unsharded mode are used, query execution warnings will be added to the session")
@@ -96,6 +102,51 @@ var (
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
 	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+
+	// flags to mirror a part of the traffic of some tables to a shadow keyspace
+	mirrorRules        = flag.String("mirror_rules", "", "Comma separated list of tables whose queries are mirrored to a shadow keyspace, in the format <keyspace>.<table>=<shadow_keyspace>:<percent>[:writes][:diff], where :writes also mirrors the DMLs of the tables that no routing rule routes to another keyspace, and :diff compares the checksums of the results of the SELECTs. The mirrored queries run asynchronously, their results are discarded, their latencies are recorded in the VtgateMirrorLatencies stat and their divergences from the original queries in VtgateMirrorDivergences.")
+	mirrorQueryTimeout = flag.Duration("mirror_query_timeout", 10*time.Second, "Timeout of the queries that are mirrored to a shadow keyspace")
+	mirrorMaxInflight  = flag.Int("mirror_max_inflight", 100, "Maximum number of mirrored queries that run at the same time. The queries that would go beyond it are not mirrored.")
+
+	// flags to warn about the scatter queries
+	warnScatterQueries  = flag.Bool("warn_scatter_queries", false, "If set, a warning with the predicates that were examined is added to the queries that are sent to all the shards of a keyspace because no vindex matched, and the queries that do it the most are reported on /debug/scatter_offenders")
+	maxScatterOffenders = flag.Int("max_scatter_offenders", 1000, "Maximum number of scatter queries that /debug/scatter_offenders keeps track of, with -warn_scatter_queries")
+
+	// flags to cache the results of the SELECTs
+	resultCacheSize          = flag.Int64("result_cache_size", 0, "Maximum size in bytes of the results of the SELECTs that are cached, or 0 to disable the result cache. The results are invalidated by the writes that run through this vtgate, by the schema changes seen by -schema_change_signal, and after -result_cache_ttl.")
+	resultCacheTTL           = flag.Duration("result_cache_ttl", 10*time.Second, "How long a result stays in the result cache at most, which bounds how stale it can be after a write that did not run through this vtgate")
+	resultCacheMaxResultSize = flag.Int64("result_cache_max_result_size", 1024*1024, "Maximum size in bytes of a result that is put in the result cache")
+
+	// flag to share the execution of the identical read-only queries
+	enableQueryConsolidation = flag.Bool("enable_query_consolidation", false, "If set, the identical read-only queries that run at the same time outside of a transaction share a single execution, and a single fan-out to the shards. Sessions opt out with skip_query_consolidation.")
+
+	// flags to protect the tablets from the IN clauses with too many values
+	maxInClauseValues   = flag.Int("max_in_clause_values", 0, "Maximum number of values of an IN clause, or 0 for no limit. The queries with an IN clause that goes beyond it are rejected, or batched with -in_clause_limit_action=batch")
+	inClauseLimitAction = flag.String("in_clause_limit_action", "reject", "What vtgate does with the queries whose IN clause has more values than -max_in_clause_values: reject them, or batch them, which splits the values that are sent to every shard into batches of at most -max_in_clause_values values when the IN clause routes a SELECT by a vindex, and rejects the other queries")
+
+	// flags to persist the query plan cache across restarts
+	planCacheFile          = flag.String("plan_cache_file", "", "If set, the queries of the query plan cache are saved to this file when vtgate shuts down, and their plans are rebuilt from it when vtgate starts, before it serves queries")
+	planCacheWarmupTimeout = flag.Duration("plan_cache_warmup_timeout", 30*time.Second, "How long vtgate waits at most for the vschema and for the plans of -plan_cache_file to be rebuilt when it starts")
+
+	// flags of the lookup vindexes whose writes are asynchronous
+	asyncLookupApply      = flag.Bool("async_lookup_apply", true, "Consume the message tables into which the lookup vindexes with an async_queue enqueue their writes, and apply the writes. The messages are spread between the vtgates that consume them.")
+	asyncLookupRetryDelay = flag.Duration("async_lookup_retry_delay", 5*time.Second, "How long vtgate waits before it consumes again the async queue of a lookup vindex whose message stream failed")
+
+	// flags of the lookup vindexes that keep tombstones
+	lookupTombstonePurgeInterval = flag.Duration("lookup_tombstone_purge_interval", time.Minute, "How often vtgate deletes the tombstones of the lookup vindexes with a tombstone_ttl that are older than their ttl, or 0 to not purge them from this vtgate")
+
+	// flags to protect vtgate from the aggregations with too many groups
+	maxAggregationGroups      = flag.Int("max_aggregation_groups", 0, "Maximum number of groups that an aggregation executed at vtgate, like the GROUP BY of a scatter query, holds in memory, or 0 for no limit. The query fails as soon as it goes beyond it. The streaming queries send their groups as soon as they are complete.")
+	maxAggregationMemoryBytes = flag.Int64("max_aggregation_memory_bytes", 256*1024*1024, "Maximum size in bytes of the groups that an aggregation executed at vtgate, like the GROUP BY of a scatter query, holds in memory, or 0 for no limit. The query fails as soon as it goes beyond it. The streaming queries send their groups as soon as they are complete.")
+
+	// flags of the cache of the values of the sequences
+	sequenceCacheSize         = flag.Int64("sequence_cache_size", 0, "Number of values of each sequence that vtgate reserves at once and hands out to the inserts, instead of reading the sequence table for every insert, or 0 to not cache them. The reserved values that are not handed out are lost when vtgate restarts.")
+	sequenceCacheLowWatermark = flag.Float64("sequence_cache_low_watermark", 0.25, "Ratio of the cache size of a sequence below which vtgate reserves the next block of values in the background")
+	sequenceRules             = flag.String("sequence_rules", "", "Comma separated list of sequences with their own cache size, in the format <keyspace>.<sequence>=<cache_size>[:<keyspace>.<table>@<start>-<end>]..., where each <keyspace>.<table>@<start>-<end> is a backend sequence table that hands out the values from start to end, excluded. The blocks of values are read from the backends in turn, and a backend is skipped once it reaches the end of its range. The ranges must not overlap, and the next_id of each backend must be initialized to the start of its range.")
+
+	// flags of the writes to the primaries of the other cells
+	crossCellPrimaryWrites          = flag.String("cross_cell_primary_writes", "allow", "What vtgate does with the writes to the primary of a shard that is in another cell than vtgate: allow them, warn about them, or reject them. The sessions that set allow_cross_cell_writes can always write to the other cells.")
+	crossCellPrimaryWritesKeyspaces = flag.String("cross_cell_primary_writes_keyspaces", "", "Comma separated list of <keyspace>:<allow|warn|reject> that override -cross_cell_primary_writes for the writes to the keyspaces")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -141,6 +192,8 @@ type VTGate struct {
 	txConn   *TxConn
 	gw       Gateway
 
+	readiness *readiness
+
 	// stats objects.
 	// TODO(sougou): This needs to be cleaned up. There
 	// are global vars that depend on this member var.
@@ -159,6 +212,21 @@ type RegisterVTGate func(vtgateservice.VTGateService)
 // RegisterVTGates stores register funcs for VTGate server.
 var RegisterVTGates []RegisterVTGate
 
+// RegisterDrainer defines the type of registration mechanism of the servers
+// that change the drain state of vtgate.
+type RegisterDrainer func(Drainer)
+
+// RegisterDrainers stores register funcs for the drain servers.
+var RegisterDrainers []RegisterDrainer
+
+// RegisterIncidentModeController defines the type of registration mechanism
+// of the servers that change the incident mode of vtgate.
+type RegisterIncidentModeController func(IncidentModeController)
+
+// RegisterIncidentModeControllers stores register funcs for the incident
+// mode servers.
+var RegisterIncidentModeControllers []RegisterIncidentModeController
+
 // Init initializes VTGate server.
 func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, cell string, tabletTypesToWait []topodatapb.TabletType) *VTGate {
 	if rpcVTGate != nil {
@@ -202,6 +270,8 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
 	resolver := NewResolver(srvResolver, serv, cell, sc)
 	vsm := newVStreamManager(srvResolver, serv, cell)
+	readiness := newReadiness(gw.hc, srvResolver, *drainPeriod)
+	readiness.start(ctx)
 
 	var si SchemaInfo // default nil
 	var st *vtschema.Tracker
@@ -230,6 +300,48 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 		*noScatter,
 	)
 
+	mirror, err := newMirrorer(executor, *mirrorRules, *mirrorQueryTimeout, *mirrorMaxInflight)
+	if err != nil {
+		log.Exitf("invalid mirror_rules: %v", err)
+	}
+	executor.mirror = mirror
+	executor.bucketValTuples = *bucketValTuples
+	if *warnScatterQueries {
+		executor.scatterLint = newScatterLinter(executor, *maxScatterOffenders)
+	}
+	executor.resultCache = newResultCache(*resultCacheSize, *resultCacheTTL, *resultCacheMaxResultSize)
+	tc.resultCache = executor.resultCache
+	executor.consolidator = newQueryConsolidator(*enableQueryConsolidation)
+	executor.inClauseLimit, err = newInClauseLimit(*maxInClauseValues, *inClauseLimitAction)
+	if err != nil {
+		log.Exitf("invalid in_clause_limit_action: %v", err)
+	}
+	rules, err := parseSequenceRules(*sequenceRules)
+	if err != nil {
+		log.Exitf("invalid sequence_rules: %v", err)
+	}
+	executor.sequences = newSequenceCache(executor, *sequenceCacheSize, *sequenceCacheLowWatermark, rules)
+	executor.crossCellPolicy, err = parseCrossCellWritePolicy(*crossCellPrimaryWrites, *crossCellPrimaryWritesKeyspaces)
+	if err != nil {
+		log.Exitf("invalid cross_cell_primary_writes: %v", err)
+	}
+	if *queryTagsStatsMaxValues < 1 {
+		log.Exitf("invalid query_tags_stats_max_values: %v, it must be at least 1", *queryTagsStatsMaxValues)
+	}
+	executor.queryTags = newQueryTagging(*queryTagsStatsKeys, *queryTagsStatsMaxValues, *queryTagsComment)
+	executor.sessionMemory = newSessionMemoryLimit(*sessionMemoryWarningBytes, *maxSessionMemoryBytes)
+	if *incidentModeErrorRate > 0 && *incidentModeErrorWindow < incidentModeBuckets*time.Millisecond {
+		log.Exitf("invalid incident_mode_error_window: %v, it must be at least %v", *incidentModeErrorWindow, incidentModeBuckets*time.Millisecond)
+	}
+	executor.incidents = newIncidentMode(*incidentModeMaxFanout, *incidentModeErrorRate, *incidentModeErrorWindow, *incidentModeMinQueries, *incidentModeDuration)
+	if *asyncLookupApply {
+		executor.asyncLookups = newAsyncLookupApplier(ctx, executor, *asyncLookupRetryDelay)
+	}
+	tombstonePurger := newLookupTombstonePurger(executor, *lookupTombstonePurgeInterval)
+	if st != nil {
+		st.RegisterTablesChangedReceiver(executor.tablesChanged)
+	}
+
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
 		st.RegisterSignalReceiver(executor.vm.Rebuild)
@@ -238,11 +350,12 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 	// TODO: call serv.WatchSrvVSchema here
 
 	rpcVTGate = &VTGate{
-		executor: executor,
-		resolver: resolver,
-		vsm:      vsm,
-		txConn:   tc,
-		gw:       gw,
+		executor:  executor,
+		resolver:  resolver,
+		vsm:       vsm,
+		txConn:    tc,
+		gw:        gw,
+		readiness: readiness,
 		timings: stats.NewMultiTimings(
 			"VtgateApi",
 			"VtgateApi timings",
@@ -273,22 +386,60 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 
 	warnings = stats.NewCountersWithSingleLabel("VtGateWarnings", "Vtgate warnings", "type", "IgnoredSet", "ResultsExceeded", "WarnPayloadSizeExceeded")
 
+	registerDynamicConfig()
+	watchCtx, cancelWatches := context.WithCancel(ctx)
 	servenv.OnRun(func() {
+		if ts, err := serv.GetTopoServer(); err == nil {
+			go dynamicconfig.WatchTopo(watchCtx, ts, "vtgate")
+			go featureflags.WatchKeyspaces(watchCtx, ts, discovery.KeyspacesToWatch)
+		}
+		if *planCacheFile != "" {
+			start := time.Now()
+			imported, err := executor.loadPlans(ctx, *planCacheFile, *planCacheWarmupTimeout)
+			if err != nil {
+				log.Warningf("Cannot warm up the plan cache from %s: %v", *planCacheFile, err)
+			}
+			log.Infof("Rebuilt %d plans from %s in %v, %d failed", imported.Planned, *planCacheFile, time.Since(start), imported.Failed)
+		}
+		executor.asyncLookups.refresh(executor.VSchema())
+		tombstonePurger.start(ctx)
 		for _, f := range RegisterVTGates {
 			f(rpcVTGate)
 		}
+		for _, f := range RegisterDrainers {
+			f(rpcVTGate)
+		}
+		for _, f := range RegisterIncidentModeControllers {
+			f(rpcVTGate)
+		}
 		if st != nil && *enableSchemaChangeSignal {
 			st.Start()
 		}
 	})
 	servenv.OnTerm(func() {
+		// Report vtgate as not ready for the lameduck period.
+		readiness.drain()
+		cancelWatches()
+		executor.asyncLookups.stop()
+		tombstonePurger.stop()
 		if st != nil && *enableSchemaChangeSignal {
 			st.Stop()
 		}
 	})
+	servenv.OnTermSync(func() {
+		if *planCacheFile != "" {
+			if err := executor.savePlans(*planCacheFile); err != nil {
+				log.Warningf("Cannot save the plan cache to %s: %v", *planCacheFile, err)
+			}
+		}
+	})
+	readiness.registerStats()
+	readiness.registerHandlers()
+	executor.incidents.registerStats()
+	executor.incidents.registerHandlers()
 	rpcVTGate.registerDebugHealthHandler()
 	rpcVTGate.registerDebugEnvHandler()
-	err := initQueryLogger(rpcVTGate)
+	err = initQueryLogger(rpcVTGate)
 	if err != nil {
 		log.Fatalf("error initializing query logger: %v", err)
 	}
@@ -474,6 +625,39 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
 }
 
+// ReadTransactions returns the unresolved distributed transactions coordinated
+// by the primaries of the keyspace, or of all keyspaces if keyspace is empty.
+// If dtid is set, only that transaction is returned.
+func (vtg *VTGate) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	var primaries []*querypb.Target
+	if dtid == "" {
+		keyspaces := []string{keyspace}
+		if keyspace == "" {
+			var err error
+			if keyspaces, err = vtg.resolver.resolver.GetAllKeyspaces(ctx); err != nil {
+				return nil, formatError(err)
+			}
+		}
+		for _, ks := range keyspaces {
+			shards, _, err := vtg.resolver.resolver.GetAllShards(ctx, ks, topodatapb.TabletType_PRIMARY)
+			if err != nil {
+				return nil, formatError(err)
+			}
+			for _, shard := range shards {
+				primaries = append(primaries, shard.Target)
+			}
+		}
+	}
+	transactions, err := vtg.txConn.ReadTransactions(ctx, primaries, dtid)
+	return transactions, formatError(err)
+}
+
+// ForceResolveTransaction commits or rolls back the specified 2PC transaction,
+// as decided by an operator.
+func (vtg *VTGate) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return formatError(vtg.txConn.ForceResolve(ctx, dtid, decision))
+}
+
 // Prepare supports non-streaming prepare statement query with multi shards
 func (vtg *VTGate) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (newSession *vtgatepb.Session, fld []*querypb.Field, err error) {
 	// In this context, we don't care if we can't fully parse destination
@@ -506,6 +690,17 @@ func (vtg *VTGate) VStream(ctx context.Context, tabletType topodatapb.TabletType
 	return vtg.vsm.VStream(ctx, tabletType, vgtid, filter, flags, send)
 }
 
+// VStreamAck saves the checkpoint of a named VStream consumer.
+func (vtg *VTGate) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return formatError(vtg.vsm.VStreamAck(ctx, checkpointName, vgtid))
+}
+
+// VStreamControl changes the copy limits of the running VStreams of a name.
+func (vtg *VTGate) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	streams, err := vtg.vsm.VStreamControl(ctx, streamName, limits)
+	return streams, formatError(err)
+}
+
 // GetGatewayCacheStatus returns a displayable version of the Gateway cache.
 func (vtg *VTGate) GetGatewayCacheStatus() TabletCacheStatusList {
 	return vtg.resolver.GetGatewayCacheStatus()
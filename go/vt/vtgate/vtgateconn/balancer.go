@@ -0,0 +1,600 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgateconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Discovery returns the addresses of the vtgates that a balanced
+// connection spreads its queries over.
+type Discovery interface {
+	Addresses(ctx context.Context) ([]string, error)
+}
+
+// StaticDiscovery is a fixed list of vtgate addresses.
+type StaticDiscovery []string
+
+// Addresses implements Discovery.
+func (d StaticDiscovery) Addresses(context.Context) ([]string, error) {
+	return d, nil
+}
+
+// DNSDiscovery resolves a host name to the addresses of the vtgates,
+// which all listen on the same port.
+type DNSDiscovery struct {
+	Host string
+	Port string
+	// Resolver is the resolver to use, net.DefaultResolver if nil.
+	Resolver *net.Resolver
+}
+
+// Addresses implements Discovery.
+func (d *DNSDiscovery) Addresses(ctx context.Context) ([]string, error) {
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	hosts, err := resolver.LookupHost(ctx, d.Host)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		addresses = append(addresses, net.JoinHostPort(host, d.Port))
+	}
+	return addresses, nil
+}
+
+// BalancerOptions configures a balanced connection.
+type BalancerOptions struct {
+	// Protocol is the protocol used to dial every vtgate,
+	// -vtgate_protocol if empty.
+	Protocol string
+	// RefreshInterval is how often the addresses of the vtgates are
+	// discovered again. The addresses are only discovered when dialing
+	// if it is 0.
+	RefreshInterval time.Duration
+	// FailureThreshold is the number of consecutive failures to reach a
+	// vtgate, i.e. transport errors, after which its circuit opens, and it
+	// is no longer used. The circuits never open if it is 0.
+	FailureThreshold int
+	// Cooldown is how long the circuit of a vtgate stays open. After that,
+	// a single request is sent to the vtgate: its circuit closes again if
+	// the request reaches it, and stays open for another cooldown otherwise.
+	Cooldown time.Duration
+}
+
+// DefaultBalancerOptions returns the default options of a balanced connection.
+func DefaultBalancerOptions() BalancerOptions {
+	return BalancerOptions{
+		RefreshInterval:  30 * time.Second,
+		FailureThreshold: 3,
+		Cooldown:         10 * time.Second,
+	}
+}
+
+// TransportError marks err as a failure to reach a vtgate, like a
+// connection that can't be established or that broke, as opposed to the
+// errors that a vtgate returns. The Impls return the errors of their
+// transport this way, since a balanced connection only opens the circuit of
+// a vtgate, and sends a request to another one, on these errors.
+func TransportError(err error) error {
+	if err == nil || IsTransportError(err) {
+		return err
+	}
+	return &transportError{err: err}
+}
+
+// IsTransportError returns true if err is a failure to reach a vtgate.
+func IsTransportError(err error) bool {
+	var transportErr *transportError
+	return errors.As(err, &transportErr)
+}
+
+// transportError keeps the message and the code of the error it marks.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+// Cause lets vterrors find the code of the error.
+func (e *transportError) Cause() error {
+	return e.err
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// DialBalanced returns a connection that spreads the requests over the
+// vtgates returned by the discovery, in a round-robin fashion, so that the
+// clients don't need a proxy in front of the vtgates.
+//
+// A request that can't reach a vtgate, because of a transport error, is sent
+// to the next one when it is safe to run it again: the session must not be
+// in a transaction, nor hold reserved connections or locks, and the queries
+// must be reads that take no lock. The other requests return the error, but
+// the session remains valid and its next requests are sent to another
+// vtgate, since a vtgate keeps no state of its own about a session. A
+// stream is only resent before it returned any result.
+func DialBalanced(ctx context.Context, discovery Discovery, options BalancerOptions) (*VTGateConn, error) {
+	protocol := options.Protocol
+	if protocol == "" {
+		protocol = *VtgateProtocol
+	}
+	dialer, ok := dialers[protocol]
+	if !ok {
+		return nil, fmt.Errorf("no dialer registered for VTGate protocol %s", protocol)
+	}
+	addresses, err := discovery.Addresses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't discover the vtgates: %v", err)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no vtgate discovered")
+	}
+
+	b := &balancer{
+		discovery: discovery,
+		options:   options,
+		dialer:    dialer,
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+	b.update(addresses)
+	var refreshCtx context.Context
+	refreshCtx, b.cancel = context.WithCancel(context.Background())
+	go b.refresh(refreshCtx)
+	return &VTGateConn{impl: b}, nil
+}
+
+// balancer is an Impl that spreads the requests over several vtgates.
+type balancer struct {
+	discovery Discovery
+	options   BalancerOptions
+	dialer    DialerFunc
+	now       func() time.Time
+	cancel    context.CancelFunc
+	done      chan struct{}
+
+	mu sync.Mutex
+	// endpoints is sorted by address.
+	endpoints []*endpoint
+	// next is the index of the next endpoint to use.
+	next int
+}
+
+// endpoint is a vtgate of a balanced connection, and its circuit.
+type endpoint struct {
+	address string
+
+	// dialMu protects impl, which is dialed on first use.
+	dialMu sync.Mutex
+	impl   Impl
+	closed bool
+
+	// The circuit is protected by the mutex of the balancer.
+	failures  int
+	openUntil time.Time
+	// probing is set while the single request that follows a cooldown is running.
+	probing bool
+}
+
+// conn returns the connection to the vtgate, which is dialed if needed.
+// Dial errors are UNAVAILABLE transport errors.
+func (ep *endpoint) conn(ctx context.Context, dialer DialerFunc) (Impl, error) {
+	ep.dialMu.Lock()
+	defer ep.dialMu.Unlock()
+	if ep.closed {
+		return nil, TransportError(vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "vtgate %s was removed", ep.address))
+	}
+	if ep.impl == nil {
+		impl, err := dialer(ctx, ep.address)
+		if err != nil {
+			return nil, TransportError(vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "can't dial vtgate %s: %v", ep.address, err))
+		}
+		ep.impl = impl
+	}
+	return ep.impl, nil
+}
+
+func (ep *endpoint) close() {
+	ep.dialMu.Lock()
+	defer ep.dialMu.Unlock()
+	ep.closed = true
+	if ep.impl != nil {
+		ep.impl.Close()
+		ep.impl = nil
+	}
+}
+
+// refresh discovers the vtgates every RefreshInterval, until the balancer is closed.
+func (b *balancer) refresh(ctx context.Context) {
+	defer close(b.done)
+	if b.options.RefreshInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(b.options.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		addresses, err := b.discovery.Addresses(ctx)
+		switch {
+		case err != nil:
+			log.Warningf("can't discover the vtgates, keeping the current ones: %v", err)
+		case len(addresses) == 0:
+			log.Warningf("no vtgate discovered, keeping the current ones")
+		default:
+			b.update(addresses)
+		}
+	}
+}
+
+// update replaces the endpoints by the given addresses. The endpoints of
+// the addresses that are kept retain their connection and their circuit.
+func (b *balancer) update(addresses []string) {
+	sorted := append([]string(nil), addresses...)
+	sort.Strings(sorted)
+
+	b.mu.Lock()
+	current := make(map[string]*endpoint, len(b.endpoints))
+	for _, ep := range b.endpoints {
+		current[ep.address] = ep
+	}
+	endpoints := make([]*endpoint, 0, len(sorted))
+	for _, address := range sorted {
+		if len(endpoints) > 0 && endpoints[len(endpoints)-1].address == address {
+			continue
+		}
+		ep, ok := current[address]
+		if ok {
+			delete(current, address)
+		} else {
+			ep = &endpoint{address: address}
+		}
+		endpoints = append(endpoints, ep)
+	}
+	b.endpoints = endpoints
+	b.mu.Unlock()
+
+	for _, ep := range current {
+		ep.close()
+	}
+}
+
+// pick returns the next endpoint whose circuit lets a request through,
+// skipping the ones that were already tried.
+func (b *balancer) pick(tried map[*endpoint]bool) *endpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	for i := 0; i < len(b.endpoints); i++ {
+		ep := b.endpoints[(b.next+i)%len(b.endpoints)]
+		if tried[ep] {
+			continue
+		}
+		if b.options.FailureThreshold > 0 && ep.failures >= b.options.FailureThreshold {
+			// The circuit is open: only a single request goes through after the cooldown.
+			if ep.probing || now.Before(ep.openUntil) {
+				continue
+			}
+			ep.probing = true
+		}
+		b.next = (b.next + i + 1) % len(b.endpoints)
+		return ep
+	}
+	return nil
+}
+
+// report updates the circuit of the endpoint with the outcome of a request.
+// Only the failures to reach the vtgate count, not the errors of the queries,
+// even UNAVAILABLE ones: those come from the tablets behind the vtgate,
+// which the other vtgates reach too.
+func (b *balancer) report(ep *endpoint, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ep.probing = false
+	if !IsTransportError(err) {
+		ep.failures = 0
+		return
+	}
+	ep.failures++
+	if b.options.FailureThreshold > 0 && ep.failures >= b.options.FailureThreshold {
+		ep.openUntil = b.now().Add(b.options.Cooldown)
+	}
+}
+
+// do runs the request on a vtgate. If failover is set, a request that can't
+// reach a vtgate is sent to the next one, until every vtgate was tried.
+func (b *balancer) do(ctx context.Context, failover bool, request func(impl Impl) error) error {
+	tried := make(map[*endpoint]bool)
+	var lastErr error
+	for {
+		ep := b.pick(tried)
+		if ep == nil {
+			if lastErr != nil {
+				return lastErr
+			}
+			return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no vtgate available")
+		}
+		tried[ep] = true
+		impl, err := ep.conn(ctx, b.dialer)
+		if err == nil {
+			err = request(impl)
+		}
+		b.report(ep, err)
+		if !failover || !IsTransportError(err) || ctx.Err() != nil {
+			return err
+		}
+		log.V(2).Infof("vtgate %s is unreachable, trying the next one: %v", ep.address, err)
+		lastErr = err
+	}
+}
+
+// canFailover returns true if the queries can run again on another vtgate
+// after an attempt that may have reached a vtgate.
+func canFailover(session *vtgatepb.Session, queries ...string) bool {
+	if holdsTabletState(session) {
+		return false
+	}
+	for _, query := range queries {
+		switch sqlparser.Preview(query) {
+		case sqlparser.StmtSelect:
+			if isLockingRead(query) {
+				return false
+			}
+		case sqlparser.StmtShow, sqlparser.StmtUse, sqlparser.StmtExplain, sqlparser.StmtComment:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// holdsTabletState returns true if the session is in a transaction, or
+// starts one with its next query since it doesn't autocommit, or holds
+// reserved connections or locks on the tablets. An attempt that reached a
+// vtgate may have changed that state, which is lost with its response.
+func holdsTabletState(session *vtgatepb.Session) bool {
+	return session.GetInTransaction() ||
+		!session.GetAutocommit() ||
+		len(session.GetShardSessions()) > 0 ||
+		session.GetInReservedConn() ||
+		len(session.GetLockSessions()) > 0 ||
+		session.GetLockSession() != nil //nolint
+}
+
+// isLockingRead returns true if the query locks rows, with FOR UPDATE or
+// LOCK IN SHARE MODE, or takes advisory locks, or can't be parsed.
+func isLockingRead(query string) bool {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return true
+	}
+	locking := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			locking = locking || node.Lock != sqlparser.NoLock
+		case *sqlparser.Union:
+			locking = locking || node.Lock != sqlparser.NoLock
+		case sqlparser.Expr:
+			locking = locking || sqlparser.IsLockingFunc(node)
+		}
+		return !locking, nil
+	}, stmt)
+	return locking
+}
+
+// Execute implements Impl.
+func (b *balancer) Execute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error) {
+	var newSession *vtgatepb.Session
+	var qr *sqltypes.Result
+	err := b.do(ctx, canFailover(session, query), func(impl Impl) (err error) {
+		newSession, qr, err = impl.Execute(ctx, session, query, bindVars)
+		return err
+	})
+	if newSession == nil {
+		newSession = session
+	}
+	return newSession, qr, err
+}
+
+// ExecuteBatch implements Impl.
+func (b *balancer) ExecuteBatch(ctx context.Context, session *vtgatepb.Session, queryList []string, bindVarsList []map[string]*querypb.BindVariable) (*vtgatepb.Session, []sqltypes.QueryResponse, error) {
+	var newSession *vtgatepb.Session
+	var qrs []sqltypes.QueryResponse
+	err := b.do(ctx, canFailover(session, queryList...), func(impl Impl) (err error) {
+		newSession, qrs, err = impl.ExecuteBatch(ctx, session, queryList, bindVarsList)
+		return err
+	})
+	if newSession == nil {
+		newSession = session
+	}
+	return newSession, qrs, err
+}
+
+// StreamExecute implements Impl. The first result is read before the
+// stream is returned, so that the stream can be resent to another vtgate
+// if it fails to reach one.
+func (b *balancer) StreamExecute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
+	var stream *peekedStream
+	err := b.do(ctx, canFailover(session, query), func(impl Impl) error {
+		rs, err := impl.StreamExecute(ctx, session, query, bindVars)
+		if err != nil {
+			return err
+		}
+		first, err := rs.Recv()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		stream = &peekedStream{ResultStream: rs, first: first, firstErr: err}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// peekedStream is a stream whose first result was already received.
+type peekedStream struct {
+	sqltypes.ResultStream
+	first    *sqltypes.Result
+	firstErr error
+	peeked   bool
+}
+
+// Recv implements sqltypes.ResultStream.
+func (s *peekedStream) Recv() (*sqltypes.Result, error) {
+	if !s.peeked {
+		s.peeked = true
+		return s.first, s.firstErr
+	}
+	return s.ResultStream.Recv()
+}
+
+// Prepare implements Impl.
+func (b *balancer) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error) {
+	var newSession *vtgatepb.Session
+	var fields []*querypb.Field
+	err := b.do(ctx, !holdsTabletState(session), func(impl Impl) (err error) {
+		newSession, fields, err = impl.Prepare(ctx, session, sql, bindVariables)
+		return err
+	})
+	if newSession == nil {
+		newSession = session
+	}
+	return newSession, fields, err
+}
+
+// CloseSession implements Impl. Rolling back a session can be done again.
+func (b *balancer) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	return b.do(ctx, true, func(impl Impl) error {
+		return impl.CloseSession(ctx, session)
+	})
+}
+
+// ResolveTransaction implements Impl. Resolving a transaction can be done again.
+func (b *balancer) ResolveTransaction(ctx context.Context, dtid string) error {
+	return b.do(ctx, true, func(impl Impl) error {
+		return impl.ResolveTransaction(ctx, dtid)
+	})
+}
+
+// ReadTransactions implements Impl.
+func (b *balancer) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	var transactions []*vtgatepb.DistributedTransaction
+	err := b.do(ctx, true, func(impl Impl) (err error) {
+		transactions, err = impl.ReadTransactions(ctx, keyspace, dtid)
+		return err
+	})
+	return transactions, err
+}
+
+// ForceResolveTransaction implements Impl. Like ResolveTransaction, it can
+// be done again.
+func (b *balancer) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return b.do(ctx, true, func(impl Impl) error {
+		return impl.ForceResolveTransaction(ctx, dtid, decision)
+	})
+}
+
+// VStream implements Impl.
+func (b *balancer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (VStreamReader, error) {
+	var reader VStreamReader
+	err := b.do(ctx, true, func(impl Impl) (err error) {
+		reader, err = impl.VStream(ctx, tabletType, vgtid, filter, flags)
+		return err
+	})
+	return reader, err
+}
+
+// VStreamAck implements Impl. Saving the same vgtid again is harmless.
+func (b *balancer) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return b.do(ctx, true, func(impl Impl) error {
+		return impl.VStreamAck(ctx, checkpointName, vgtid)
+	})
+}
+
+// VStreamControl implements Impl. The streams of a name can run on any of
+// the vtgates, so the limits are sent to all of them, whatever their circuit.
+func (b *balancer) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	b.mu.Lock()
+	endpoints := append([]*endpoint(nil), b.endpoints...)
+	b.mu.Unlock()
+
+	var streams int
+	var lastErr error
+	for _, ep := range endpoints {
+		impl, err := ep.conn(ctx, b.dialer)
+		n := 0
+		if err == nil {
+			n, err = impl.VStreamControl(ctx, streamName, limits)
+		}
+		b.report(ep, err)
+		switch {
+		case err == nil:
+			streams += n
+		case vterrors.Code(err) != vtrpcpb.Code_NOT_FOUND:
+			lastErr = err
+		}
+	}
+	if streams == 0 && lastErr == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no running VStream named %s", streamName)
+	}
+	return streams, lastErr
+}
+
+// Close implements Impl.
+func (b *balancer) Close() {
+	b.cancel()
+	<-b.done
+	b.mu.Lock()
+	endpoints := b.endpoints
+	b.endpoints = nil
+	b.mu.Unlock()
+	for _, ep := range endpoints {
+		ep.close()
+	}
+}
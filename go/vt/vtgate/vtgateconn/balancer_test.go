@@ -0,0 +1,407 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgateconn
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// fakeVTGate is an Impl that answers with its address, or fails with err.
+type fakeVTGate struct {
+	Impl
+	address string
+
+	mu     sync.Mutex
+	err    error
+	calls  int
+	closed bool
+}
+
+func (f *fakeVTGate) call() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return f.err
+}
+
+func (f *fakeVTGate) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+func (f *fakeVTGate) Execute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error) {
+	if err := f.call(); err != nil {
+		return session, nil, err
+	}
+	return session, &sqltypes.Result{Info: f.address}, nil
+}
+
+func (f *fakeVTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
+	return &fakeStream{err: f.call(), address: f.address}, nil
+}
+
+// VStreamControl runs a stream named after the address of the vtgate.
+func (f *fakeVTGate) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	if err := f.call(); err != nil {
+		return 0, err
+	}
+	if streamName != f.address {
+		return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no running VStream named %s", streamName)
+	}
+	return 1, nil
+}
+
+func (f *fakeVTGate) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+}
+
+func (f *fakeVTGate) isClosed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.closed
+}
+
+// fakeStream returns a single result, or fails with err on the first Recv.
+type fakeStream struct {
+	address string
+	err     error
+	done    bool
+}
+
+func (s *fakeStream) Recv() (*sqltypes.Result, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.done {
+		return nil, io.EOF
+	}
+	s.done = true
+	return &sqltypes.Result{Info: s.address}, nil
+}
+
+// fakeVTGates registers a dialer for the fake vtgates.
+func fakeVTGates(t *testing.T, protocol string, addresses ...string) map[string]*fakeVTGate {
+	vtgates := make(map[string]*fakeVTGate)
+	for _, address := range addresses {
+		vtgates[address] = &fakeVTGate{address: address}
+	}
+	RegisterDialer(protocol, func(ctx context.Context, address string) (Impl, error) {
+		vtgate, ok := vtgates[address]
+		if !ok {
+			return nil, fmt.Errorf("unknown vtgate %s", address)
+		}
+		return vtgate, nil
+	})
+	return vtgates
+}
+
+var errUnreachable = TransportError(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "connection refused"))
+
+func execute(t *testing.T, session *VTGateSession, query string) (string, error) {
+	t.Helper()
+	qr, err := session.Execute(context.Background(), query, nil)
+	if err != nil {
+		return "", err
+	}
+	return qr.Info, nil
+}
+
+func TestBalancerRoundRobinAndFailover(t *testing.T) {
+	vtgates := fakeVTGates(t, "balancer_failover", "a", "b", "c")
+	conn, err := DialBalanced(context.Background(), StaticDiscovery{"c", "a", "b"}, BalancerOptions{Protocol: "balancer_failover"})
+	require.NoError(t, err)
+	defer conn.Close()
+	session := conn.Session("", nil)
+
+	var got []string
+	for i := 0; i < 4; i++ {
+		address, err := execute(t, session, "select 1")
+		require.NoError(t, err)
+		got = append(got, address)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, got)
+
+	// a read that can't reach a vtgate is sent to the next one
+	vtgates["b"].setErr(errUnreachable)
+	address, err := execute(t, session, "select 1")
+	require.NoError(t, err)
+	assert.Equal(t, "c", address)
+
+	// a write may have reached the vtgate, it is not sent again
+	address, err = execute(t, session, "insert into t values (1)")
+	require.NoError(t, err)
+	assert.Equal(t, "a", address)
+	_, err = execute(t, session, "insert into t values (1)")
+	require.ErrorIs(t, err, errUnreachable)
+
+	// neither is a read in a transaction
+	session.SessionPb().InTransaction = true
+	_, err = execute(t, session, "select 1")
+	require.NoError(t, err)
+	_, err = execute(t, session, "select 1")
+	require.NoError(t, err)
+	_, err = execute(t, session, "select 1")
+	require.ErrorIs(t, err, errUnreachable)
+	session.SessionPb().InTransaction = false
+
+	// nor a locking read
+	_, err = execute(t, session, "select 1")
+	require.NoError(t, err)
+	_, err = execute(t, session, "select 1")
+	require.NoError(t, err)
+	_, err = execute(t, session, "select * from t for update")
+	require.ErrorIs(t, err, errUnreachable)
+
+	// other errors are returned as is, even the UNAVAILABLE errors that the
+	// vtgate returns
+	vtgates["c"].setErr(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error"))
+	_, err = execute(t, session, "select 1")
+	assert.EqualError(t, err, "syntax error")
+	vtgates["c"].setErr(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"))
+	var errs []string
+	for i := 0; i < 3; i++ {
+		if _, err := execute(t, session, "select 1"); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	assert.Equal(t, []string{"no healthy tablet"}, errs)
+
+	// every vtgate failed
+	vtgates["a"].setErr(errUnreachable)
+	vtgates["c"].setErr(errUnreachable)
+	_, err = execute(t, session, "select 1")
+	require.ErrorIs(t, err, errUnreachable)
+}
+
+func TestCanFailover(t *testing.T) {
+	autocommit := &vtgatepb.Session{Autocommit: true}
+	tcases := []struct {
+		session *vtgatepb.Session
+		queries []string
+		want    bool
+	}{{
+		session: autocommit,
+		queries: []string{"select 1", "show tables", "use ks", "explain select 1"},
+		want:    true,
+	}, {
+		session: autocommit,
+		queries: []string{"select 1", "insert into t values (1)"},
+	}, {
+		session: autocommit,
+		queries: []string{"select * from t for update"},
+	}, {
+		session: autocommit,
+		queries: []string{"select * from t lock in share mode"},
+	}, {
+		session: autocommit,
+		queries: []string{"select 1 union select id from t for update"},
+	}, {
+		session: autocommit,
+		queries: []string{"select get_lock('l', 1)"},
+	}, {
+		session: autocommit,
+		queries: []string{"select * from t where id in (select id from u for update)"},
+	}, {
+		session: autocommit,
+		queries: []string{"select from"},
+	}, {
+		session: &vtgatepb.Session{Autocommit: true, InTransaction: true},
+		queries: []string{"select 1"},
+	}, {
+		session: &vtgatepb.Session{},
+		queries: []string{"select 1"},
+	}, {
+		session: &vtgatepb.Session{Autocommit: true, InReservedConn: true},
+		queries: []string{"select 1"},
+	}, {
+		session: &vtgatepb.Session{Autocommit: true, ShardSessions: []*vtgatepb.Session_ShardSession{{}}},
+		queries: []string{"select 1"},
+	}, {
+		session: &vtgatepb.Session{Autocommit: true, LockSessions: []*vtgatepb.Session_LockSession{{}}},
+		queries: []string{"select 1"},
+	}}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.want, canFailover(tcase.session, tcase.queries...), "%v %v", tcase.session, tcase.queries)
+	}
+}
+
+func TestBalancerStreamFailover(t *testing.T) {
+	vtgates := fakeVTGates(t, "balancer_stream", "a", "b")
+	conn, err := DialBalanced(context.Background(), StaticDiscovery{"a", "b"}, BalancerOptions{Protocol: "balancer_stream"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	vtgates["a"].setErr(errUnreachable)
+	stream, err := conn.Session("", nil).StreamExecute(context.Background(), "select 1", nil)
+	require.NoError(t, err)
+	qr, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "b", qr.Info)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestBalancerCircuitBreaker(t *testing.T) {
+	vtgates := fakeVTGates(t, "balancer_circuit", "a", "b")
+	conn, err := DialBalanced(context.Background(), StaticDiscovery{"a", "b"}, BalancerOptions{
+		Protocol:         "balancer_circuit",
+		FailureThreshold: 2,
+		Cooldown:         time.Minute,
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+	b := conn.impl.(*balancer)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	session := conn.Session("", nil)
+
+	// a fails twice, which opens its circuit
+	vtgates["a"].setErr(errUnreachable)
+	for i := 0; i < 4; i++ {
+		address, err := execute(t, session, "select 1")
+		require.NoError(t, err)
+		assert.Equal(t, "b", address)
+	}
+	assert.Equal(t, 2, vtgates["a"].calls)
+
+	// after the cooldown, a single request goes to a, which is still unreachable
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		_, err := execute(t, session, "select 1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, vtgates["a"].calls)
+
+	// a is back after another cooldown
+	vtgates["a"].setErr(nil)
+	now = now.Add(time.Minute)
+	var got []string
+	for i := 0; i < 4; i++ {
+		address, err := execute(t, session, "select 1")
+		require.NoError(t, err)
+		got = append(got, address)
+	}
+	assert.ElementsMatch(t, []string{"a", "a", "b", "b"}, got)
+
+	// when all the circuits are open, the requests fail fast
+	vtgates["a"].setErr(errUnreachable)
+	vtgates["b"].setErr(errUnreachable)
+	for i := 0; i < 2; i++ {
+		_, err := execute(t, session, "select 1")
+		require.Error(t, err)
+	}
+	_, err = execute(t, session, "select 1")
+	assert.EqualError(t, err, "no vtgate available")
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+}
+
+// fakeDiscovery returns the addresses it was last given.
+type fakeDiscovery struct {
+	mu        sync.Mutex
+	addresses []string
+}
+
+func (d *fakeDiscovery) Addresses(context.Context) ([]string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.addresses, nil
+}
+
+func (d *fakeDiscovery) set(addresses ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.addresses = addresses
+}
+
+func TestBalancerDiscovery(t *testing.T) {
+	vtgates := fakeVTGates(t, "balancer_discovery", "a", "b")
+	discovery := &fakeDiscovery{}
+	_, err := DialBalanced(context.Background(), discovery, BalancerOptions{Protocol: "balancer_discovery"})
+	require.EqualError(t, err, "no vtgate discovered")
+
+	discovery.set("a")
+	conn, err := DialBalanced(context.Background(), discovery, BalancerOptions{
+		Protocol:        "balancer_discovery",
+		RefreshInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	session := conn.Session("", nil)
+	address, err := execute(t, session, "select 1")
+	require.NoError(t, err)
+	assert.Equal(t, "a", address)
+
+	// a is replaced by b, and its connection is closed
+	discovery.set("b")
+	assert.Eventually(t, func() bool {
+		address, err := execute(t, session, "select 1")
+		return err == nil && address == "b"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, vtgates["a"].isClosed())
+
+	// an empty discovery keeps the current vtgates
+	discovery.set()
+	time.Sleep(50 * time.Millisecond)
+	address, err = execute(t, session, "select 1")
+	require.NoError(t, err)
+	assert.Equal(t, "b", address)
+
+	conn.Close()
+	assert.True(t, vtgates["b"].isClosed())
+}
+
+func TestBalancerVStreamControl(t *testing.T) {
+	vtgates := fakeVTGates(t, "balancer_vstream_control", "a", "b", "c")
+	conn, err := DialBalanced(context.Background(), StaticDiscovery{"a", "b", "c"}, BalancerOptions{Protocol: "balancer_vstream_control"})
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := context.Background()
+
+	// The limits are sent to every vtgate, and only one of them runs the stream.
+	streams, err := conn.VStreamControl(ctx, "b", &vtgatepb.VStreamCopyLimits{Parallelism: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, streams)
+	for _, vtgate := range vtgates {
+		assert.Equal(t, 1, vtgate.calls)
+	}
+
+	_, err = conn.VStreamControl(ctx, "d", &vtgatepb.VStreamCopyLimits{Parallelism: 1})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+
+	// The streams of the reachable vtgates are changed even if another one is unreachable.
+	vtgates["a"].setErr(errUnreachable)
+	streams, err = conn.VStreamControl(ctx, "b", &vtgatepb.VStreamCopyLimits{Parallelism: 1})
+	assert.Equal(t, 1, streams)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+}
@@ -72,6 +72,18 @@ func (conn *VTGateConn) ResolveTransaction(ctx context.Context, dtid string) err
 	return conn.impl.ResolveTransaction(ctx, dtid)
 }
 
+// ReadTransactions returns the unresolved 2pc transactions coordinated by
+// the keyspace, or by all keyspaces if it is empty. If dtid is set, only
+// that transaction is returned.
+func (conn *VTGateConn) ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error) {
+	return conn.impl.ReadTransactions(ctx, keyspace, dtid)
+}
+
+// ForceResolveTransaction commits or rolls back the 2pc transaction.
+func (conn *VTGateConn) ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error {
+	return conn.impl.ForceResolveTransaction(ctx, dtid, decision)
+}
+
 // Close must be called for releasing resources.
 func (conn *VTGateConn) Close() {
 	conn.impl.Close()
@@ -91,6 +103,19 @@ func (conn *VTGateConn) VStream(ctx context.Context, tabletType topodatapb.Table
 	return conn.impl.VStream(ctx, tabletType, vgtid, filter, flags)
 }
 
+// VStreamAck saves the vgtid of the last VGTID event that the consumer
+// processed in its checkpoint. The streams that have the checkpoint name
+// in their flags resume from it.
+func (conn *VTGateConn) VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error {
+	return conn.impl.VStreamAck(ctx, checkpointName, vgtid)
+}
+
+// VStreamControl replaces the copy limits of the running streams that have
+// the stream name in their flags, and returns how many of them it changed.
+func (conn *VTGateConn) VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error) {
+	return conn.impl.VStreamControl(ctx, streamName, limits)
+}
+
 // VTGateSession exposes the V3 API to the clients.
 // The object maintains client-side state and is comparable to a native MySQL connection.
 // For example, if you enable autocommit on a Session object, all subsequent calls will respect this.
@@ -159,9 +184,21 @@ type Impl interface {
 	// ResolveTransaction resolves the specified 2pc transaction.
 	ResolveTransaction(ctx context.Context, dtid string) error
 
+	// ReadTransactions returns the unresolved 2pc transactions.
+	ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error)
+
+	// ForceResolveTransaction commits or rolls back the specified 2pc transaction.
+	ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error
+
 	// VStream streams binlogevents
 	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (VStreamReader, error)
 
+	// VStreamAck saves the vgtid in the checkpoint of a VStream consumer.
+	VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error
+
+	// VStreamControl changes the copy limits of the running streams of a name.
+	VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error)
+
 	// Close must be called for releasing resources.
 	Close()
 }
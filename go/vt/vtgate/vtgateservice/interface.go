@@ -46,9 +46,13 @@ type VTGateService interface {
 
 	// 2PC support
 	ResolveTransaction(ctx context.Context, dtid string) error
+	ReadTransactions(ctx context.Context, keyspace, dtid string) ([]*vtgatepb.DistributedTransaction, error)
+	ForceResolveTransaction(ctx context.Context, dtid string, decision querypb.TransactionState) error
 
 	// Update Stream methods
 	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error
+	VStreamAck(ctx context.Context, checkpointName string, vgtid *binlogdatapb.VGtid) error
+	VStreamControl(ctx context.Context, streamName string, limits *vtgatepb.VStreamCopyLimits) (int, error)
 
 	// HandlePanic should be called with defer at the beginning of each
 	// RPC implementation method, before calling any of the previous methods
@@ -272,7 +272,7 @@ func (client *QueryClient) Stream(query string, bindvars map[string]*querypb.Bin
 
 // MessageStream streams messages from the message table.
 func (client *QueryClient) MessageStream(name string, callback func(*sqltypes.Result) error) (err error) {
-	return client.server.MessageStream(client.ctx, client.target, name, callback)
+	return client.server.MessageStream(client.ctx, client.target, name, "", 0, callback)
 }
 
 // MessageAck acks messages
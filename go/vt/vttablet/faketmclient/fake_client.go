@@ -251,6 +251,21 @@ func (client *FakeTabletManagerClient) VExec(ctx context.Context, tablet *topoda
 	return sqltypes.ResultToProto3(result), nil
 }
 
+// GetTableGCStatus is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetTableGCStatus(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error) {
+	return nil, nil
+}
+
+// AdvanceTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) AdvanceTableGC(ctx context.Context, tablet *topodatapb.Tablet, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error) {
+	return nil, "", nil
+}
+
+// UpgradeMysqld is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) UpgradeMysqld(ctx context.Context, tablet *topodatapb.Tablet, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	return &tabletmanagerdatapb.UpgradeMysqldResponse{}, nil
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	// This result satisfies 'select pos from _vt.vreplication...' called from split clone unit tests in go/vt/worker.
@@ -266,6 +281,11 @@ func (client *FakeTabletManagerClient) VReplicationWaitForPos(ctx context.Contex
 	return nil
 }
 
+// VReplicationProgress is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) VReplicationProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	return nil, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -41,6 +41,13 @@ type query struct {
 
 var _ queryservicepb.QueryServer = (*query)(nil)
 
+// resultToProto3 converts a result, and compresses its rows if the options ask for it.
+func resultToProto3(qr *sqltypes.Result, options *querypb.ExecuteOptions) *querypb.QueryResult {
+	result := sqltypes.ResultToProto3(qr)
+	queryservice.CompressResult(result, options)
+	return result
+}
+
 // Execute is part of the queryservice.QueryServer interface
 func (q *query) Execute(ctx context.Context, request *querypb.ExecuteRequest) (response *querypb.ExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
@@ -53,7 +60,7 @@ func (q *query) Execute(ctx context.Context, request *querypb.ExecuteRequest) (r
 		return nil, vterrors.ToGRPC(err)
 	}
 	return &querypb.ExecuteResponse{
-		Result: sqltypes.ResultToProto3(result),
+		Result: resultToProto3(result, request.Options),
 	}, nil
 }
 
@@ -66,7 +73,7 @@ func (q *query) StreamExecute(request *querypb.StreamExecuteRequest, stream quer
 	)
 	err = q.server.StreamExecute(ctx, request.Target, request.Query.Sql, request.Query.BindVariables, request.TransactionId, request.ReservedId, request.Options, func(reply *sqltypes.Result) error {
 		return stream.Send(&querypb.StreamExecuteResponse{
-			Result: sqltypes.ResultToProto3(reply),
+			Result: resultToProto3(reply, request.Options),
 		})
 	})
 	return vterrors.ToGRPC(err)
@@ -232,6 +239,21 @@ func (q *query) ReadTransaction(ctx context.Context, request *querypb.ReadTransa
 	return &querypb.ReadTransactionResponse{Metadata: result}, nil
 }
 
+// UnresolvedTransactions is part of the queryservice.QueryServer interface
+func (q *query) UnresolvedTransactions(ctx context.Context, request *querypb.UnresolvedTransactionsRequest) (response *querypb.UnresolvedTransactionsResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	transactions, prepared, err := q.server.UnresolvedTransactions(ctx, request.Target)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+
+	return &querypb.UnresolvedTransactionsResponse{Transactions: transactions, Prepared: prepared}, nil
+}
+
 // BeginExecute is part of the queryservice.QueryServer interface
 func (q *query) BeginExecute(ctx context.Context, request *querypb.BeginExecuteRequest) (response *querypb.BeginExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
@@ -252,7 +274,7 @@ func (q *query) BeginExecute(ctx context.Context, request *querypb.BeginExecuteR
 		return nil, vterrors.ToGRPC(err)
 	}
 	return &querypb.BeginExecuteResponse{
-		Result:        sqltypes.ResultToProto3(result),
+		Result:        resultToProto3(result, request.Options),
 		TransactionId: transactionID,
 		TabletAlias:   alias,
 	}, nil
@@ -267,7 +289,7 @@ func (q *query) BeginStreamExecute(request *querypb.BeginStreamExecuteRequest, s
 	)
 	transactionID, alias, err := q.server.BeginStreamExecute(ctx, request.Target, request.PreQueries, request.Query.Sql, request.Query.BindVariables, request.ReservedId, request.Options, func(reply *sqltypes.Result) error {
 		return stream.Send(&querypb.BeginStreamExecuteResponse{
-			Result: sqltypes.ResultToProto3(reply),
+			Result: resultToProto3(reply, request.Options),
 		})
 	})
 	errInLastPacket := stream.Send(&querypb.BeginStreamExecuteResponse{
@@ -288,7 +310,7 @@ func (q *query) MessageStream(request *querypb.MessageStreamRequest, stream quer
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	err = q.server.MessageStream(ctx, request.Target, request.Name, func(qr *sqltypes.Result) error {
+	err = q.server.MessageStream(ctx, request.Target, request.Name, request.StreamId, request.Window, func(qr *sqltypes.Result) error {
 		return stream.Send(&querypb.MessageStreamResponse{
 			Result: sqltypes.ResultToProto3(qr),
 		})
@@ -314,6 +336,19 @@ func (q *query) MessageAck(ctx context.Context, request *querypb.MessageAckReque
 	}, nil
 }
 
+// MessageStreamWindow is part of the queryservice.QueryServer interface
+func (q *query) MessageStreamWindow(ctx context.Context, request *querypb.MessageStreamWindowRequest) (response *querypb.MessageStreamWindowResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	if err := q.server.MessageStreamWindow(ctx, request.Target, request.Name, request.StreamId, request.Increment); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.MessageStreamWindowResponse{}, nil
+}
+
 // StreamHealth is part of the queryservice.QueryServer interface
 func (q *query) StreamHealth(request *querypb.StreamHealthRequest, stream queryservicepb.Query_StreamHealthServer) (err error) {
 	defer q.server.HandlePanic(&err)
@@ -378,7 +413,7 @@ func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExec
 		return nil, vterrors.ToGRPC(err)
 	}
 	return &querypb.ReserveExecuteResponse{
-		Result:      sqltypes.ResultToProto3(result),
+		Result:      resultToProto3(result, request.Options),
 		ReservedId:  reservedID,
 		TabletAlias: alias,
 	}, nil
@@ -393,7 +428,7 @@ func (q *query) ReserveStreamExecute(request *querypb.ReserveStreamExecuteReques
 	)
 	reservedID, alias, err := q.server.ReserveStreamExecute(ctx, request.Target, request.PreQueries, request.Query.Sql, request.Query.BindVariables, request.TransactionId, request.Options, func(reply *sqltypes.Result) error {
 		return stream.Send(&querypb.ReserveStreamExecuteResponse{
-			Result: sqltypes.ResultToProto3(reply),
+			Result: resultToProto3(reply, request.Options),
 		})
 	})
 	errInLastPacket := stream.Send(&querypb.ReserveStreamExecuteResponse{
@@ -428,7 +463,7 @@ func (q *query) ReserveBeginExecute(ctx context.Context, request *querypb.Reserv
 		return nil, vterrors.ToGRPC(err)
 	}
 	return &querypb.ReserveBeginExecuteResponse{
-		Result:        sqltypes.ResultToProto3(result),
+		Result:        resultToProto3(result, request.Options),
 		TransactionId: transactionID,
 		ReservedId:    reservedID,
 		TabletAlias:   alias,
@@ -444,7 +479,7 @@ func (q *query) ReserveBeginStreamExecute(request *querypb.ReserveBeginStreamExe
 	)
 	transactionID, reservedID, alias, err := q.server.ReserveBeginStreamExecute(ctx, request.Target, request.PreQueries, request.PostBeginQueries, request.Query.Sql, request.Query.BindVariables, request.Options, func(reply *sqltypes.Result) error {
 		return stream.Send(&querypb.ReserveBeginStreamExecuteResponse{
-			Result: sqltypes.ResultToProto3(reply),
+			Result: resultToProto3(reply, request.Options),
 		})
 	})
 	errInLastPacket := stream.Send(&querypb.ReserveBeginStreamExecuteResponse{
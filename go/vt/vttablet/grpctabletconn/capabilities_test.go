@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"vitess.io/vitess/go/vt/grpccommon"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/grpcqueryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	queryservicepb "vitess.io/vitess/go/vt/proto/queryservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// healthService sends a single health response.
+type healthService struct {
+	queryservice.QueryService
+}
+
+func (s *healthService) HandlePanic(err *error) {}
+
+func (s *healthService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
+	return callback(&querypb.StreamHealthResponse{Serving: true})
+}
+
+func TestGRPCTabletConnCapabilities(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// the tablet announces that it only supports the result compression
+	server := grpc.NewServer(grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_ = stream.SetHeader(metadata.Pairs(
+			grpccommon.VersionHeader, "14.0.0",
+			grpccommon.CapabilitiesHeader, grpccommon.CapabilityResultCompression,
+		))
+		return handler(srv, stream)
+	}))
+	grpcqueryservice.Register(server, &healthService{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	cc, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	tablet := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "cell", Uid: 1}}
+	conn := &gRPCQueryClient{tablet: tablet, cc: cc, c: queryservicepb.NewQueryClient(cc)}
+	defer conn.Close(context.Background())
+
+	// nothing new is supported before the tablet announced its capabilities
+	options, err := conn.negotiateOptions(&querypb.ExecuteOptions{ResultCompression: querypb.ExecuteOptions_ZSTD})
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_NONE, options.ResultCompression)
+	_, err = conn.negotiateOptions(&querypb.ExecuteOptions{ReadAfterWriteGtid: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"})
+	assert.EqualError(t, err, "option not supported by the tablet read_after_write: tablet cell-0000000001 runs version unknown")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	err = conn.StreamHealth(context.Background(), func(*querypb.StreamHealthResponse) error {
+		return io.EOF
+	})
+	require.NoError(t, err)
+
+	// the options that the tablet supports are sent as they are, the
+	// options of the caller are not modified
+	requested := &querypb.ExecuteOptions{ResultCompression: querypb.ExecuteOptions_ZSTD}
+	options, err = conn.negotiateOptions(requested)
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_ZSTD, options.ResultCompression)
+	_, err = conn.negotiateOptions(&querypb.ExecuteOptions{ReadAfterWriteGtid: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"})
+	assert.EqualError(t, err, "option not supported by the tablet read_after_write: tablet cell-0000000001 runs version 14.0.0")
+	_, err = conn.negotiateOptions(&querypb.ExecuteOptions{ReadAtTimestamp: 1646370367000000000})
+	assert.EqualError(t, err, "option not supported by the tablet read_at_timestamp: tablet cell-0000000001 runs version 14.0.0")
+	assert.Equal(t, querypb.ExecuteOptions_ZSTD, requested.ResultCompression)
+
+	options, err = conn.negotiateOptions(nil)
+	require.NoError(t, err)
+	assert.Nil(t, options)
+}
@@ -19,16 +19,24 @@ package grpctabletconn
 import (
 	"flag"
 	"io"
+	"strings"
 	"sync"
 
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/grpccommon"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 
@@ -36,6 +44,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	queryservicepb "vitess.io/vitess/go/vt/proto/queryservice"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 const protocolName = "grpc"
@@ -46,12 +55,50 @@ var (
 	ca   = flag.String("tablet_grpc_ca", "", "the server ca to use to validate servers when connecting")
 	crl  = flag.String("tablet_grpc_crl", "", "the server crl to use to validate server certificates when connecting")
 	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate")
+
+	resultCompression          = flag.String("tablet_grpc_result_compression", "", "the algorithm that the tablets compress the rows of large results with, to save bandwidth at the cost of CPU. Default: nothing. Supported: zstd, snappy, lz4")
+	resultCompressionThreshold = flag.Int64("tablet_grpc_result_compression_threshold", 64*1024, "the size in bytes above which the tablets compress the rows of the results, with -tablet_grpc_result_compression")
+
+	resultCompressionOnce sync.Once
+	resultCompressionAlgo querypb.ExecuteOptions_ResultCompression
+
+	unsupportedOptions = stats.NewCountersWithSingleLabel(
+		"TabletUnsupportedOptions",
+		"Options that were left out or refused because the tablet does not support them, by capability",
+		"Capability")
 )
 
 func init() {
 	tabletconn.RegisterDialer(protocolName, DialTablet)
 }
 
+// withResultCompression asks the tablet to compress the rows of the results
+// with -tablet_grpc_result_compression, unless the options already do.
+func withResultCompression(options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	resultCompressionOnce.Do(func() {
+		if *resultCompression == "" {
+			return
+		}
+		algo, ok := querypb.ExecuteOptions_ResultCompression_value[strings.ToUpper(*resultCompression)]
+		if !ok {
+			log.Errorf("unsupported -tablet_grpc_result_compression %q, the results are not compressed", *resultCompression)
+			return
+		}
+		resultCompressionAlgo = querypb.ExecuteOptions_ResultCompression(algo)
+	})
+	if resultCompressionAlgo == querypb.ExecuteOptions_NONE || options.GetResultCompression() != querypb.ExecuteOptions_NONE {
+		return options
+	}
+	if options == nil {
+		options = &querypb.ExecuteOptions{}
+	} else {
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+	}
+	options.ResultCompression = resultCompressionAlgo
+	options.ResultCompressionThreshold = *resultCompressionThreshold
+	return options
+}
+
 // gRPCQueryClient implements a gRPC implementation for QueryService
 type gRPCQueryClient struct {
 	// tablet is set at construction time, and never changed
@@ -61,6 +108,12 @@ type gRPCQueryClient struct {
 	mu sync.RWMutex
 	cc *grpc.ClientConn
 	c  queryservicepb.QueryClient
+
+	// capabilitiesMu protects the version and the capabilities that the
+	// tablet announced in the headers of its health stream.
+	capabilitiesMu sync.Mutex
+	version        string
+	capabilities   map[string]bool
 }
 
 var _ queryservice.QueryService = (*gRPCQueryClient)(nil)
@@ -93,8 +146,62 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 	return result, nil
 }
 
+// setCapabilities records the version and the capabilities that the tablet
+// announced in the headers of a response.
+func (conn *gRPCQueryClient) setCapabilities(md metadata.MD) {
+	version := "unknown"
+	if values := md.Get(grpccommon.VersionHeader); len(values) > 0 {
+		version = values[0]
+	}
+	conn.capabilitiesMu.Lock()
+	defer conn.capabilitiesMu.Unlock()
+	conn.version = version
+	conn.capabilities = grpccommon.CapabilitiesFromHeader(md)
+}
+
+// negotiateOptions adapts the options to the capabilities of the tablet.
+// The tablet is assumed to support nothing new until it announced its
+// capabilities in the headers of its health stream. The options that only
+// change how the results are sent are left out for tablets that don't support
+// them, while the ones that change the results are refused, because an older
+// tablet would silently ignore them.
+func (conn *gRPCQueryClient) negotiateOptions(options *querypb.ExecuteOptions) (*querypb.ExecuteOptions, error) {
+	options = withResultCompression(options)
+	if options == nil {
+		return nil, nil
+	}
+	conn.capabilitiesMu.Lock()
+	version, capabilities := conn.version, conn.capabilities
+	conn.capabilitiesMu.Unlock()
+	if version == "" {
+		version = "unknown"
+	}
+
+	if options.ReadAfterWriteGtid != "" && !capabilities[grpccommon.CapabilityReadAfterWrite] {
+		unsupportedOptions.Add(grpccommon.CapabilityReadAfterWrite, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s %s: tablet %s runs version %s",
+			vterrors.UnsupportedByTablet, grpccommon.CapabilityReadAfterWrite, topoproto.TabletAliasString(conn.tablet.Alias), version)
+	}
+	if options.ReadAtTimestamp != 0 && !capabilities[grpccommon.CapabilityReadAtTimestamp] {
+		unsupportedOptions.Add(grpccommon.CapabilityReadAtTimestamp, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s %s: tablet %s runs version %s",
+			vterrors.UnsupportedByTablet, grpccommon.CapabilityReadAtTimestamp, topoproto.TabletAliasString(conn.tablet.Alias), version)
+	}
+	if options.ResultCompression != querypb.ExecuteOptions_NONE && !capabilities[grpccommon.CapabilityResultCompression] {
+		unsupportedOptions.Add(grpccommon.CapabilityResultCompression, 1)
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+		options.ResultCompression = querypb.ExecuteOptions_NONE
+		options.ResultCompressionThreshold = 0
+	}
+	return options, nil
+}
+
 // Execute sends the query to VTTablet.
 func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	options, err := conn.negotiateOptions(options)
+	if err != nil {
+		return nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -117,6 +224,9 @@ func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target
 	if err != nil {
 		return nil, tabletconn.ErrorFromGRPC(err)
 	}
+	if err := queryservice.DecompressResult(er.Result); err != nil {
+		return nil, err
+	}
 	return sqltypes.Proto3ToResult(er.Result), nil
 }
 
@@ -130,6 +240,10 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 	// no direct API to end a stream from the client side. If callback
 	// returns an error, we return from the function. The deferred
 	// cancel will then cause the stream to be terminated.
+	options, err := conn.negotiateOptions(options)
+	if err != nil {
+		return err
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -167,6 +281,9 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 		if err != nil {
 			return tabletconn.ErrorFromGRPC(err)
 		}
+		if err := queryservice.DecompressResult(ser.Result); err != nil {
+			return err
+		}
 		if fields == nil {
 			fields = ser.Result.Fields
 		}
@@ -418,8 +535,32 @@ func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *queryp
 	return response.Metadata, nil
 }
 
+// UnresolvedTransactions returns the in-flight 2pc transactions of the shard.
+func (conn *gRPCQueryClient) UnresolvedTransactions(ctx context.Context, target *querypb.Target) ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, nil, tabletconn.ConnClosed
+	}
+
+	req := &querypb.UnresolvedTransactionsRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+	}
+	response, err := conn.c.UnresolvedTransactions(ctx, req)
+	if err != nil {
+		return nil, nil, tabletconn.ErrorFromGRPC(err)
+	}
+	return response.Transactions, response.Prepared, nil
+}
+
 // BeginExecute starts a transaction and runs an Execute.
 func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
+	options, err = conn.negotiateOptions(options)
+	if err != nil {
+		return nil, 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -445,11 +586,18 @@ func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.T
 	if reply.Error != nil {
 		return nil, reply.TransactionId, conn.tablet.Alias, tabletconn.ErrorFromVTRPC(reply.Error)
 	}
+	if err := queryservice.DecompressResult(reply.Result); err != nil {
+		return nil, reply.TransactionId, conn.tablet.Alias, err
+	}
 	return sqltypes.Proto3ToResult(reply.Result), reply.TransactionId, conn.tablet.Alias, nil
 }
 
 // BeginStreamExecute starts a transaction and runs an Execute.
 func (conn *gRPCQueryClient) BeginStreamExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (transactionID int64, alias *topodatapb.TabletAlias, err error) {
+	options, err = conn.negotiateOptions(options)
+	if err != nil {
+		return 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -503,6 +651,9 @@ func (conn *gRPCQueryClient) BeginStreamExecute(ctx context.Context, target *que
 			return transactionID, alias, nil
 		}
 
+		if err := queryservice.DecompressResult(ser.Result); err != nil {
+			return transactionID, alias, err
+		}
 		if fields == nil {
 			fields = ser.Result.Fields
 		}
@@ -516,7 +667,7 @@ func (conn *gRPCQueryClient) BeginStreamExecute(ctx context.Context, target *que
 }
 
 // MessageStream streams messages.
-func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) error {
 	// Please see comments in StreamExecute to see how this works.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -533,6 +684,8 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Name:              name,
+			StreamId:          streamID,
+			Window:            window,
 		}
 		stream, err := conn.c.MessageStream(ctx, req)
 		if err != nil {
@@ -582,6 +735,27 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 	return int64(reply.Result.RowsAffected), nil
 }
 
+// MessageStreamWindow grows the window of a message stream.
+func (conn *gRPCQueryClient) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return tabletconn.ConnClosed
+	}
+	req := &querypb.MessageStreamWindowRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Name:              name,
+		StreamId:          streamID,
+		Increment:         increment,
+	}
+	if _, err := conn.c.MessageStreamWindow(ctx, req); err != nil {
+		return tabletconn.ErrorFromGRPC(err)
+	}
+	return nil
+}
+
 // StreamHealth starts a streaming RPC for VTTablet health status updates.
 func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	// Please see comments in StreamExecute to see how this works.
@@ -604,6 +778,14 @@ func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*qu
 	if err != nil {
 		return err
 	}
+	// The health stream is the first one opened to a tablet, and it is
+	// reopened whenever the tablet restarts, so its headers tell what the
+	// tablet currently supports.
+	md, err := stream.Header()
+	if err != nil {
+		return tabletconn.ErrorFromGRPC(err)
+	}
+	conn.setCapabilities(md)
 	for {
 		shr, err := stream.Recv()
 		if err != nil {
@@ -751,6 +933,10 @@ func (conn *gRPCQueryClient) HandlePanic(err *error) {
 
 // ReserveBeginExecute implements the queryservice interface
 func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, postBeginQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
+	options, err := conn.negotiateOptions(options)
+	if err != nil {
+		return nil, 0, 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -777,11 +963,18 @@ func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *qu
 		return nil, reply.TransactionId, reply.ReservedId, conn.tablet.Alias, tabletconn.ErrorFromVTRPC(reply.Error)
 	}
 
+	if err := queryservice.DecompressResult(reply.Result); err != nil {
+		return nil, reply.TransactionId, reply.ReservedId, conn.tablet.Alias, err
+	}
 	return sqltypes.Proto3ToResult(reply.Result), reply.TransactionId, reply.ReservedId, conn.tablet.Alias, nil
 }
 
 // ReserveBeginStreamExecute implements the queryservice interface
 func (conn *gRPCQueryClient) ReserveBeginStreamExecute(ctx context.Context, target *querypb.Target, preQueries []string, postBeginQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (transactionID int64, reservedID int64, alias *topodatapb.TabletAlias, err error) {
+	options, err = conn.negotiateOptions(options)
+	if err != nil {
+		return 0, 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -839,6 +1032,9 @@ func (conn *gRPCQueryClient) ReserveBeginStreamExecute(ctx context.Context, targ
 			return transactionID, reservedID, alias, nil
 		}
 
+		if err := queryservice.DecompressResult(ser.Result); err != nil {
+			return transactionID, reservedID, alias, err
+		}
 		if fields == nil {
 			fields = ser.Result.Fields
 		}
@@ -853,6 +1049,10 @@ func (conn *gRPCQueryClient) ReserveBeginStreamExecute(ctx context.Context, targ
 
 // ReserveExecute implements the queryservice interface
 func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
+	options, err := conn.negotiateOptions(options)
+	if err != nil {
+		return nil, 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -879,11 +1079,18 @@ func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb
 		return nil, reply.ReservedId, conn.tablet.Alias, tabletconn.ErrorFromVTRPC(reply.Error)
 	}
 
+	if err := queryservice.DecompressResult(reply.Result); err != nil {
+		return nil, reply.ReservedId, conn.tablet.Alias, err
+	}
 	return sqltypes.Proto3ToResult(reply.Result), reply.ReservedId, conn.tablet.Alias, nil
 }
 
 // ReserveStreamExecute implements the queryservice interface
 func (conn *gRPCQueryClient) ReserveStreamExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (reservedID int64, alias *topodatapb.TabletAlias, err error) {
+	options, err = conn.negotiateOptions(options)
+	if err != nil {
+		return 0, nil, err
+	}
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -938,6 +1145,9 @@ func (conn *gRPCQueryClient) ReserveStreamExecute(ctx context.Context, target *q
 			return reservedID, alias, nil
 		}
 
+		if err := queryservice.DecompressResult(ser.Result); err != nil {
+			return reservedID, alias, err
+		}
 		if fields == nil {
 			fields = ser.Result.Fields
 		}
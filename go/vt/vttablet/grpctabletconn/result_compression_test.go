@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpccommon"
+	"vitess.io/vitess/go/vt/vttablet/grpcqueryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	queryservicepb "vitess.io/vitess/go/vt/proto/queryservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// wideResultService returns a large result, and records the options it was given.
+type wideResultService struct {
+	queryservice.QueryService
+	result  *sqltypes.Result
+	options *querypb.ExecuteOptions
+}
+
+func (s *wideResultService) HandlePanic(err *error) {}
+
+func (s *wideResultService) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	s.options = options
+	return s.result, nil
+}
+
+func (s *wideResultService) StreamExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) error {
+	s.options = options
+	if err := callback(&sqltypes.Result{Fields: s.result.Fields}); err != nil {
+		return err
+	}
+	return callback(&sqltypes.Result{Rows: s.result.Rows})
+}
+
+func TestGRPCTabletConnResultCompression(t *testing.T) {
+	result := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("id|payload", "int64|varchar"),
+	}
+	for i := 0; i < 100; i++ {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewInt64(int64(i)),
+			sqltypes.NewVarChar(strings.Repeat("payload", 100)),
+		})
+	}
+	service := &wideResultService{result: result}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	grpcqueryservice.Register(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// The client is not dialed with DialTablet, whose dial options depend on
+	// the other tests of the package.
+	cc, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	conn := &gRPCQueryClient{tablet: &topodatapb.Tablet{}, cc: cc, c: queryservicepb.NewQueryClient(cc)}
+	defer conn.Close(context.Background())
+	conn.setCapabilities(metadata.Pairs(grpccommon.CapabilitiesHeader, grpccommon.CapabilityResultCompression))
+
+	resultCompressionOnce.Do(func() {})
+	resultCompressionAlgo = querypb.ExecuteOptions_ZSTD
+	defer func() { resultCompressionAlgo = querypb.ExecuteOptions_NONE }()
+
+	// the options of the caller are not modified
+	options := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	qr, err := conn.Execute(context.Background(), &querypb.Target{}, "select", nil, 0, 0, options)
+	require.NoError(t, err)
+	assert.Equal(t, result, qr)
+	assert.Equal(t, querypb.ExecuteOptions_ZSTD, service.options.ResultCompression)
+	assert.EqualValues(t, *resultCompressionThreshold, service.options.ResultCompressionThreshold)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, service.options.Workload)
+	assert.Equal(t, querypb.ExecuteOptions_NONE, options.ResultCompression)
+
+	var streamed sqltypes.Result
+	err = conn.StreamExecute(context.Background(), &querypb.Target{}, "select", nil, 0, 0, nil, func(qr *sqltypes.Result) error {
+		streamed.AppendResult(qr)
+		if qr.Fields != nil {
+			streamed.Fields = qr.Fields
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, result.Rows, streamed.Rows)
+	assert.Equal(t, querypb.ExecuteOptions_ZSTD, service.options.ResultCompression)
+
+	// the caller can ask for another algorithm
+	_, err = conn.Execute(context.Background(), &querypb.Target{}, "select", nil, 0, 0, &querypb.ExecuteOptions{ResultCompression: querypb.ExecuteOptions_SNAPPY})
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_SNAPPY, service.options.ResultCompression)
+}
@@ -681,6 +681,51 @@ func (client *Client) VExec(ctx context.Context, tablet *topodatapb.Tablet, quer
 	return response.Result, nil
 }
 
+// GetTableGCStatus is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetTableGCStatus(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetTableGCStatus(ctx, &tabletmanagerdatapb.GetTableGCStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tables, nil
+}
+
+// AdvanceTableGC is part of the tmclient.TabletManagerClient interface.
+func (client *Client) AdvanceTableGC(ctx context.Context, tablet *topodatapb.Tablet, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, "", err
+	}
+	defer closer.Close()
+	response, err := c.AdvanceTableGC(ctx, &tabletmanagerdatapb.AdvanceTableGCRequest{
+		TableName:        tableName,
+		Cancel:           cancel,
+		RestoreTableName: restoreTableName,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Table, response.NewTableName, nil
+}
+
+// UpgradeMysqld is part of the tmclient.TabletManagerClient interface.
+func (client *Client) UpgradeMysqld(ctx context.Context, tablet *topodatapb.Tablet, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.UpgradeMysqld(ctx, &tabletmanagerdatapb.UpgradeMysqldRequest{
+		SwapBinariesParameters: swapBinariesParams,
+		SkipMysqlUpgrade:       skipMysqlUpgrade,
+	})
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *Client) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -708,6 +753,20 @@ func (client *Client) VReplicationWaitForPos(ctx context.Context, tablet *topoda
 	return nil
 }
 
+// VReplicationProgress is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VReplicationProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.VReplicationProgress(ctx, &tabletmanagerdatapb.VReplicationProgressRequest{Workflow: workflow})
+	if err != nil {
+		return nil, err
+	}
+	return response.Streams, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -363,6 +363,28 @@ func (s *server) VExec(ctx context.Context, request *tabletmanagerdatapb.VExecRe
 	return response, err
 }
 
+func (s *server) GetTableGCStatus(ctx context.Context, request *tabletmanagerdatapb.GetTableGCStatusRequest) (response *tabletmanagerdatapb.GetTableGCStatusResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetTableGCStatus", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetTableGCStatusResponse{}
+	response.Tables, err = s.tm.GetTableGCStatus(ctx)
+	return response, err
+}
+
+func (s *server) AdvanceTableGC(ctx context.Context, request *tabletmanagerdatapb.AdvanceTableGCRequest) (response *tabletmanagerdatapb.AdvanceTableGCResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "AdvanceTableGC", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.AdvanceTableGCResponse{}
+	response.Table, response.NewTableName, err = s.tm.AdvanceTableGC(ctx, request.TableName, request.Cancel, request.RestoreTableName)
+	return response, err
+}
+
+func (s *server) UpgradeMysqld(ctx context.Context, request *tabletmanagerdatapb.UpgradeMysqldRequest) (response *tabletmanagerdatapb.UpgradeMysqldResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "UpgradeMysqld", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.UpgradeMysqld(ctx, request.SwapBinariesParameters, request.SkipMysqlUpgrade)
+}
+
 func (s *server) VReplicationExec(ctx context.Context, request *tabletmanagerdatapb.VReplicationExecRequest) (response *tabletmanagerdatapb.VReplicationExecResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "VReplicationExec", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -378,6 +400,14 @@ func (s *server) VReplicationWaitForPos(ctx context.Context, request *tabletmana
 	return &tabletmanagerdatapb.VReplicationWaitForPosResponse{}, err
 }
 
+func (s *server) VReplicationProgress(ctx context.Context, request *tabletmanagerdatapb.VReplicationProgressRequest) (response *tabletmanagerdatapb.VReplicationProgressResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "VReplicationProgress", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.VReplicationProgressResponse{}
+	response.Streams, err = s.tm.VReplicationProgress(ctx, request.Workflow)
+	return response, err
+}
+
 //
 // Reparenting related functions
 //
@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/log"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// abortedMigrationTables returns the tables that an aborted migration leaves behind, and that should be
+// moved to table GC. existingTables are the tables of the schema that are either artifacts of the
+// migration, or carry its UUID in their name. These are:
+// - the artifacts of the migration, e.g. the gh-ost _gho/_ghc/_del tables or the vreplication _vrepl table
+// - the tables that the migration created but did not get to record as artifacts
+// - the GC tables of the migration that are still on HOLD
+// GC tables already in PURGE, EVAC or DROP state are left to the table GC, which is on its way to drop them.
+func abortedMigrationTables(uuid string, artifacts []string, existingTables []string) (tables []string) {
+	isArtifact := map[string]bool{}
+	for _, artifact := range artifacts {
+		isArtifact[artifact] = true
+	}
+	exists := map[string]bool{}
+	for _, tableName := range existingTables {
+		exists[tableName] = true
+	}
+	isAbortedTable := func(tableName string) bool {
+		isGCTable, state, gcUUID, _, err := schema.AnalyzeGCTableName(tableName)
+		if err != nil {
+			return false
+		}
+		if isGCTable {
+			if state != schema.HoldTableGCState {
+				return false
+			}
+			return isArtifact[tableName] || gcUUID == schema.OnlineDDLToGCUUID(uuid)
+		}
+		return isArtifact[tableName] || strings.HasPrefix(tableName, "_"+uuid+"_")
+	}
+
+	added := map[string]bool{}
+	// Artifacts first, in the order the migration recorded them, then the rest in alphabetical order
+	candidates := append([]string{}, artifacts...)
+	sortedTables := append([]string{}, existingTables...)
+	sort.Strings(sortedTables)
+	candidates = append(candidates, sortedTables...)
+	for _, tableName := range candidates {
+		if !exists[tableName] || added[tableName] || !isAbortedTable(tableName) {
+			continue
+		}
+		added[tableName] = true
+		tables = append(tables, tableName)
+	}
+	return tables
+}
+
+// readMigrationTables returns the tables of the migration's schema whose names carry the migration's UUID,
+// either as online DDL tables or as GC tables.
+func (e *Executor) readMigrationTables(ctx context.Context, onlineDDL *schema.OnlineDDL) (tableNames []string, err error) {
+	escapeLike := func(s string) string {
+		return strings.ReplaceAll(s, `_`, `\_`)
+	}
+	query, err := sqlparser.ParseAndBind(sqlSelectMigrationTables,
+		sqltypes.StringBindVariable(onlineDDL.Schema),
+		sqltypes.StringBindVariable(escapeLike("_"+onlineDDL.UUID+"_")+"%"),
+		sqltypes.StringBindVariable(escapeLike("_vt_")+"%"+escapeLike("_"+schema.OnlineDDLToGCUUID(onlineDDL.UUID)+"_")+"%"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		tableNames = append(tableNames, row.AsString("table_name", ""))
+	}
+	return tableNames, nil
+}
+
+// removeFileIfExists removes a file or a directory, and reports whether it existed
+func removeFileIfExists(fileName string) (existed bool, err error) {
+	if _, err := os.Stat(fileName); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, os.RemoveAll(fileName)
+}
+
+// AbortMigration force-aborts a migration, whatever its strategy, and removes everything it left behind:
+// its running process or vreplication stream, the pt-osc triggers, the gh-ost flag files, its artifact
+// and shadow tables (which move to table GC), its GC tables still on HOLD, and its logs. The migration
+// is then marked as cancelled (if it hadn't started) or failed (if it had), and its artifacts are cleared.
+// Unlike CancelMigration, it also cleans up the debris of migrations that already failed or were cancelled.
+// The result has a row per removed item. Aborting a partially aborted migration again resumes its cleanup.
+func (e *Executor) AbortMigration(ctx context.Context, uuid string, message string) (result *sqltypes.Result, err error) {
+	if !e.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "online ddl is disabled")
+	}
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in ABORT: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	// All shards return the same fields, including those that do not have the migration
+	result = &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "artifact_type", Type: sqltypes.VarChar},
+			{Name: "artifact", Type: sqltypes.VarChar},
+			{Name: "action", Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{},
+	}
+	report := func(artifactType, artifact, action string) {
+		log.Infof("AbortMigration: %s: %s %s %s", uuid, artifactType, artifact, action)
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(artifactType),
+			sqltypes.NewVarChar(artifact),
+			sqltypes.NewVarChar(action),
+		})
+		result.RowsAffected++
+	}
+
+	onlineDDL, row, err := e.readMigration(ctx, uuid)
+	if err == ErrMigrationNotFound {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if onlineDDL.Status == schema.OnlineDDLStatusComplete {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "migration %s is complete and its artifacts may be needed to revert it; use cleanup to remove them", uuid)
+	}
+	defer e.triggerNextCheckInterval()
+
+	// The stream is read before terminating the migration, which deletes it for vreplication based migrations
+	stream, err := e.readVReplStream(ctx, uuid, true)
+	if err != nil {
+		return result, err
+	}
+
+	// Stop the migration
+	foundRunning := false
+	abortedStatus := onlineDDL.Status
+	switch onlineDDL.Status {
+	case schema.OnlineDDLStatusQueued, schema.OnlineDDLStatusReady:
+		abortedStatus = schema.OnlineDDLStatusCancelled
+		if foundRunning, err = e.terminateMigration(ctx, onlineDDL); err != nil {
+			return result, err
+		}
+	case schema.OnlineDDLStatusRunning:
+		abortedStatus = schema.OnlineDDLStatusFailed
+		if foundRunning, err = e.terminateMigration(ctx, onlineDDL); err != nil {
+			return result, err
+		}
+	}
+	if foundRunning {
+		report("migration", uuid, "terminated "+string(onlineDDL.Strategy)+" migration")
+	}
+	if stream != nil {
+		if err := e.deleteVReplicationEntry(ctx, uuid); err != nil {
+			return result, err
+		}
+		report("vreplication", stream.workflow, "deleted stream")
+	}
+
+	// Strategy specific artifacts
+	switch onlineDDL.Strategy {
+	case schema.DDLStrategyPTOSC:
+		triggerNames, err := e.dropPTOSCMigrationTriggers(ctx, onlineDDL)
+		for _, triggerName := range triggerNames {
+			report("trigger", triggerName, "dropped")
+		}
+		if err != nil {
+			return result, err
+		}
+	case schema.DDLStrategyGhost:
+		flagFileNames := []string{e.ghostPostponeFlagFileName(uuid)}
+		if !foundRunning {
+			// A running gh-ost process needs its panic flag file to terminate
+			flagFileNames = append(flagFileNames, e.ghostPanicFlagFileName(uuid))
+		}
+		for _, flagFileName := range flagFileNames {
+			existed, err := removeFileIfExists(flagFileName)
+			if err != nil {
+				return result, err
+			}
+			if existed {
+				report("flag file", flagFileName, "removed")
+			}
+		}
+	}
+
+	// Tables
+	artifacts := textutil.SplitDelimitedList(row["artifacts"].ToString())
+	existingTables, err := e.readMigrationTables(ctx, onlineDDL)
+	if err != nil {
+		return result, err
+	}
+	for _, artifact := range artifacts {
+		exists, err := e.tableExists(ctx, artifact)
+		if err != nil {
+			return result, err
+		}
+		if exists {
+			existingTables = append(existingTables, artifact)
+		}
+	}
+	timeNow := time.Now()
+	for i, tableName := range abortedMigrationTables(uuid, artifacts, existingTables) {
+		// Distinct timestamps, because all the tables are renamed with the UUID of the migration
+		t := timeNow.Add(time.Duration(i) * time.Second).UTC()
+		renameStatement, toTableName, err := schema.GenerateRenameStatementWithUUID(tableName, schema.PurgeTableGCState, schema.OnlineDDLToGCUUID(uuid), t)
+		if err != nil {
+			return result, err
+		}
+		if _, err := e.execQuery(ctx, renameStatement); err != nil {
+			return result, err
+		}
+		report("table", tableName, "renamed to "+toTableName)
+	}
+
+	// Logs
+	if logPath := row["log_path"].ToString(); logPath != "" {
+		// logPath is in 'hostname:/path/to/logs' format
+		tokens := strings.SplitN(logPath, ":", 2)
+		logPath = tokens[len(tokens)-1]
+		existed, err := removeFileIfExists(logPath)
+		if err != nil {
+			return result, err
+		}
+		if existed {
+			report("logs", logPath, "removed")
+		}
+	}
+
+	// _vt.schema_migrations
+	if err := e.clearArtifacts(ctx, uuid); err != nil {
+		return result, err
+	}
+	if abortedStatus != onlineDDL.Status {
+		if err := e.updateMigrationStatus(ctx, uuid, abortedStatus); err != nil {
+			return result, err
+		}
+		if err := e.updateMigrationTimestamp(ctx, "completed_timestamp", uuid); err != nil {
+			return result, err
+		}
+		report("migration", uuid, "marked "+string(abortedStatus))
+	}
+	if err := e.updateMigrationMessage(ctx, uuid, message); err != nil {
+		return result, err
+	}
+	// The artifacts are gone: gcArtifacts has nothing left to collect
+	if err := e.updateMigrationTimestamp(ctx, "cleanup_timestamp", uuid); err != nil {
+		return result, err
+	}
+	return result, nil
+}
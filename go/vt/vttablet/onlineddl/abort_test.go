@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbortedMigrationTables(t *testing.T) {
+	uuid := "6ace8bcef73211ea87e9f875a4d24e90"
+	onlineDDLUUID := "6ace8bce_f732_11ea_87e9_f875a4d24e90"
+
+	tt := []struct {
+		name           string
+		uuid           string
+		artifacts      []string
+		existingTables []string
+		tables         []string
+	}{
+		{
+			name:      "gh-ost artifacts",
+			uuid:      onlineDDLUUID,
+			artifacts: []string{"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_gho", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_ghc", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_del"},
+			existingTables: []string{
+				"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_ghc",
+				"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_gho",
+			},
+			tables: []string{"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_gho", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_ghc"},
+		},
+		{
+			name:      "tables of an earlier attempt",
+			uuid:      onlineDDLUUID,
+			artifacts: []string{"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_vrepl"},
+			existingTables: []string{
+				"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_vrepl",
+				"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220228000000_vrepl",
+			},
+			tables: []string{"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_vrepl", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220228000000_vrepl"},
+		},
+		{
+			name:      "pt-osc artifacts",
+			uuid:      onlineDDLUUID,
+			artifacts: []string{"_t_old", "__t_old", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_new"},
+			existingTables: []string{
+				"_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_new",
+				"_t_old",
+			},
+			tables: []string{"_t_old", "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_new"},
+		},
+		{
+			name:      "GC tables",
+			uuid:      onlineDDLUUID,
+			artifacts: []string{"_vt_HOLD_11111111f73211ea87e9f875a4d24e90_20220301000000"},
+			existingTables: []string{
+				"_vt_HOLD_11111111f73211ea87e9f875a4d24e90_20220301000000",
+				"_vt_HOLD_" + uuid + "_20220301000000",
+				"_vt_PURGE_" + uuid + "_20220301000001",
+				"_vt_EVAC_" + uuid + "_20220301000002",
+				"_vt_DROP_" + uuid + "_20220301000003",
+				"_vt_HOLD_22222222f73211ea87e9f875a4d24e90_20220301000000",
+			},
+			tables: []string{"_vt_HOLD_11111111f73211ea87e9f875a4d24e90_20220301000000", "_vt_HOLD_" + uuid + "_20220301000000"},
+		},
+		{
+			name:           "other migrations",
+			uuid:           onlineDDLUUID,
+			existingTables: []string{"_7ace8bce_f732_11ea_87e9_f875a4d24e90_20220301000000_vrepl", "t"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.tables, abortedMigrationTables(tc.uuid, tc.artifacts, tc.existingTables))
+		})
+	}
+}
+
+func TestRemoveFileIfExists(t *testing.T) {
+	fileName := path.Join(t.TempDir(), "ghost.flag")
+	existed, err := removeFileIfExists(fileName)
+	require.NoError(t, err)
+	assert.False(t, existed)
+
+	require.NoError(t, os.WriteFile(fileName, nil, 0644))
+	existed, err = removeFileIfExists(fileName)
+	require.NoError(t, err)
+	assert.True(t, existed)
+	_, err = os.Stat(fileName)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -106,6 +106,7 @@ var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-onli
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
 var migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
+var maxConcurrentMigrations = flag.Int("online_ddl_max_concurrent_migrations", 1, "Maximum number of migrations that run at the same time, on different tables. Migrations on the same table run one at a time, gh-ost and pt-osc migrations run alone, and CREATE, DROP and REVERT migrations with -allow-concurrent are not counted")
 
 const (
 	maxPasswordLength                        = 32 // MySQL's *replication* password may not exceed 32 characters
@@ -200,6 +201,42 @@ func newGCTableRetainTime() time.Time {
 	return time.Now().UTC().Add(*retainOnlineDDLTables)
 }
 
+// retainArtifactsDuration returns how long the artifacts of a new migration are to be retained. The table dropped
+// by a DROP TABLE migration is retained for its HOLD period in the table GC configuration of the keyspace, and the
+// other artifacts for -retain_online_ddl_tables. The duration is stored with the migration, so that the
+// configuration is only read when the migration is submitted.
+func (e *Executor) retainArtifactsDuration(ctx context.Context, onlineDDL *schema.OnlineDDL, action sqlparser.DDLAction) time.Duration {
+	if e.ts == nil || action != sqlparser.DropDDLAction || onlineDDL.IsView() {
+		return *retainOnlineDDLTables
+	}
+	config, err := e.ts.GetTableGCConfig(ctx, e.keyspace)
+	if err != nil {
+		log.Errorf("Error reading the table GC configuration of keyspace %s, using -retain_online_ddl_tables: %+v", e.keyspace, err)
+		return *retainOnlineDDLTables
+	}
+	retain, err := schema.TableGCConfig(config).Duration(onlineDDL.Table, schema.TableGCHoldSetting, *retainOnlineDDLTables)
+	if err != nil {
+		log.Errorf("Error parsing the HOLD period of table %s in the table GC configuration, using -retain_online_ddl_tables: %+v", onlineDDL.Table, err)
+	}
+	return retain
+}
+
+// droppedTableRetainTime returns the time until which the table dropped by a migration is to be retained, as
+// stored with the migration when it was submitted
+func (e *Executor) droppedTableRetainTime(ctx context.Context, uuid string) time.Time {
+	_, row, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		log.Errorf("Error reading the retention of migration %s, using -retain_online_ddl_tables: %+v", uuid, err)
+		return newGCTableRetainTime()
+	}
+	retainSeconds := row.AsInt64("retain_artifacts_seconds", 0)
+	if retainSeconds < 0 {
+		// The cleanup of the artifacts was requested.
+		retainSeconds = 0
+	}
+	return time.Now().UTC().Add(time.Duration(retainSeconds) * time.Second)
+}
+
 // NewExecutor creates a new gh-ost executor.
 func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *topo.Server,
 	tabletTypeFunc func() topodatapb.TabletType,
@@ -339,60 +376,101 @@ func (e *Executor) allowConcurrentMigration(onlineDDL *schema.OnlineDDL) bool {
 	return false
 }
 
-// isAnyNonConcurrentMigrationRunning sees if there's any migration running right now
-// that does not have -allow-concurrent.
-// such a running migration will for example prevent a new non-concurrent migration from running.
-func (e *Executor) isAnyNonConcurrentMigrationRunning() bool {
-	nonConcurrentMigrationFound := false
+// runsWithExternalTool tells whether the migration runs gh-ost or pt-online-schema-change. These migrations
+// share the online DDL user, which each of them creates and drops, and so they never run alongside another
+// migration that does not run concurrently.
+func runsWithExternalTool(onlineDDL *schema.OnlineDDL) bool {
+	switch onlineDDL.Strategy {
+	case schema.DDLStrategyGhost, schema.DDLStrategyPTOSC:
+		action, err := onlineDDL.GetAction()
+		return err != nil || action == sqlparser.AlterDDLAction
+	}
+	return false
+}
+
+// schedulingState describes the migrations that a ready migration competes with when the scheduler
+// considers starting it: the running migrations, and the ready migrations ahead of it in the queue.
+type schedulingState struct {
+	// tables maps the tables of these migrations to the UUID of the migration that holds them
+	tables map[string]string
+	// nonConcurrent counts the running migrations that do not run concurrently
+	nonConcurrent int
+	// externalToolUUID is the UUID of a running gh-ost or pt-osc migration, if any
+	externalToolUUID string
+}
 
-	e.ownedRunningMigrations.Range(func(_, val any) bool {
-		onlineDDL, ok := val.(*schema.OnlineDDL)
-		if !ok {
-			return true
-		}
-		if !e.allowConcurrentMigration(onlineDDL) {
-			// The migratoin may have declared itself to be --allow-concurrent, but our scheduler
-			// reserves the right to say "no, you're NOT in fact allowed to run concurrently"
-			// (as example, think a `gh-ost` ALTER migration that says --allow-concurrent)
-			nonConcurrentMigrationFound = true
-			return false // stop iteration, no need to review other migrations
-		}
-		return true
-	})
+func newSchedulingState() *schedulingState {
+	return &schedulingState{tables: map[string]string{}}
+}
 
-	return nonConcurrentMigrationFound
+// holdTable makes the migrations that come next on the table wait for the given migration.
+func (s *schedulingState) holdTable(onlineDDL *schema.OnlineDDL) {
+	if onlineDDL.Table == "" {
+		return
+	}
+	if _, ok := s.tables[onlineDDL.Table]; !ok {
+		s.tables[onlineDDL.Table] = onlineDDL.UUID
+	}
 }
 
-// isAnyMigrationRunningOnTable sees if there's any migration running right now
-// operating on given table.
-func (e *Executor) isAnyMigrationRunningOnTable(tableName string) bool {
-	sameTableMigrationFound := false
+// addRunning accounts for a running migration.
+func (s *schedulingState) addRunning(onlineDDL *schema.OnlineDDL, allowConcurrent bool) {
+	s.holdTable(onlineDDL)
+	if allowConcurrent {
+		return
+	}
+	s.nonConcurrent++
+	if runsWithExternalTool(onlineDDL) {
+		s.externalToolUUID = onlineDDL.UUID
+	}
+}
+
+// conflict returns the reason why the migration cannot start right now, or an empty string if it can.
+// Migrations on the same table always run one at a time, in the order of their submission. Otherwise,
+// up to maxConcurrent migrations that do not run concurrently run at the same time, on different tables.
+func (s *schedulingState) conflict(onlineDDL *schema.OnlineDDL, allowConcurrent bool, maxConcurrent int) string {
+	if uuid, ok := s.tables[onlineDDL.Table]; ok && onlineDDL.Table != "" {
+		return fmt.Sprintf("waiting for migration %s on table %s", uuid, onlineDDL.Table)
+	}
+	if allowConcurrent {
+		return ""
+	}
+	if s.externalToolUUID != "" {
+		return fmt.Sprintf("waiting for migration %s, which runs alone", s.externalToolUUID)
+	}
+	if s.nonConcurrent > 0 && runsWithExternalTool(onlineDDL) {
+		return fmt.Sprintf("waiting for %d running migrations, as %s runs alone", s.nonConcurrent, onlineDDL.Strategy)
+	}
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	if s.nonConcurrent >= maxConcurrent {
+		return fmt.Sprintf("waiting for %d running migrations (online_ddl_max_concurrent_migrations=%d)", s.nonConcurrent, maxConcurrent)
+	}
+	return ""
+}
+
+// runningSchedulingState returns the scheduling state of the migrations running right now.
+func (e *Executor) runningSchedulingState() *schedulingState {
+	state := newSchedulingState()
 	e.ownedRunningMigrations.Range(func(_, val any) bool {
 		onlineDDL, ok := val.(*schema.OnlineDDL)
 		if !ok {
 			return true
 		}
-		if onlineDDL.Table == tableName {
-			sameTableMigrationFound = true
-			return false // stop iteration, no need to review other migrations
-		}
+		// The migration may have declared itself to be --allow-concurrent, but our scheduler
+		// reserves the right to say "no, you're NOT in fact allowed to run concurrently"
+		// (as example, think a `gh-ost` ALTER migration that says --allow-concurrent)
+		state.addRunning(onlineDDL, e.allowConcurrentMigration(onlineDDL))
 		return true
 	})
-	return sameTableMigrationFound
+	return state
 }
 
 // isAnyConflictingMigrationRunning checks if there's any running migration that conflicts with the
 // given migration, such that they can't both run concurrently.
 func (e *Executor) isAnyConflictingMigrationRunning(onlineDDL *schema.OnlineDDL) bool {
-
-	if e.isAnyNonConcurrentMigrationRunning() && !e.allowConcurrentMigration(onlineDDL) {
-		return true
-	}
-	if e.isAnyMigrationRunningOnTable(onlineDDL.Table) {
-		return true
-	}
-
-	return false
+	return e.runningSchedulingState().conflict(onlineDDL, e.allowConcurrentMigration(onlineDDL), *maxConcurrentMigrations) != ""
 }
 
 func (e *Executor) ghostPanicFlagFileName(uuid string) string {
@@ -832,6 +910,13 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	// Tables are now swapped! Migration is successful
 	reenableWritesOnce() // this function is also deferred, in case of early return; but now would be a good time to resume writes, before we publish the migration as "complete"
 	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow, s.rowsCopied, emptyHint)
+	if !isVreplicationTestSuite {
+		// The migration is complete either way. Without a revert window, a revert needs to catch up from the cut-over.
+		if err := e.openRevertWindow(ctx, onlineDDL, vreplTable, mysql.EncodePosition(postWritesPos)); err != nil {
+			log.Errorf("cannot open the revert window of migration %s: %v", onlineDDL.UUID, err)
+			_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, fmt.Sprintf("cannot open revert window: %v", err))
+		}
+	}
 	return nil
 
 	// deferred function will re-enable writes now
@@ -1034,6 +1119,17 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 			return err
 		}
 
+		if revertMigration != nil {
+			// A revert within the revert window of the reverted migration takes over the reverse stream,
+			// which is already up to date.
+			tookOver, err := e.takeOverRevertWindow(ctx, onlineDDL, revertMigration)
+			if err != nil {
+				return err
+			}
+			if tookOver {
+				return nil
+			}
+		}
 		// create vreplication entry
 		insertVReplicationQuery, err := v.generateInsertStatement(ctx)
 		if err != nil {
@@ -1482,7 +1578,7 @@ export MYSQL_PWD
 			_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
 			_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, err.Error())
 			_ = e.updateMigrationTimestamp(ctx, "completed_timestamp", onlineDDL.UUID)
-			_ = e.dropPTOSCMigrationTriggers(ctx, onlineDDL)
+			_, _ = e.dropPTOSCMigrationTriggers(ctx, onlineDDL)
 			failedMigrations.Add(1)
 			log.Errorf("Error running pt-online-schema-change: %+v", err)
 			return err
@@ -1573,7 +1669,7 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 			if err := e.dropOnlineDDLUser(ctx); err != nil {
 				return foundRunning, nil
 			}
-			if err := e.dropPTOSCMigrationTriggers(ctx, onlineDDL); err != nil {
+			if _, err := e.dropPTOSCMigrationTriggers(ctx, onlineDDL); err != nil {
 				return foundRunning, nil
 			}
 		}
@@ -2125,7 +2221,7 @@ func (e *Executor) executeDropDDLActionMigration(ctx context.Context, onlineDDL
 	}
 
 	var toTableName string
-	onlineDDL.SQL, toTableName, err = schema.GenerateRenameStatementWithUUID(onlineDDL.Table, schema.HoldTableGCState, onlineDDL.GetGCUUID(), newGCTableRetainTime())
+	onlineDDL.SQL, toTableName, err = schema.GenerateRenameStatementWithUUID(onlineDDL.Table, schema.HoldTableGCState, onlineDDL.GetGCUUID(), e.droppedTableRetainTime(ctx, onlineDDL.UUID))
 	if err != nil {
 		return failMigration(err)
 	}
@@ -2538,13 +2634,13 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 	return nil
 }
 
-// runNextMigration picks up to one 'ready' migration that is able to run, and executes it.
-// Possible scenarios:
-// - no migration is in 'ready' state -- nothing to be done
-// - a migration is 'ready', but conflicts with other running migrations -- try another 'ready' migration
-// - multiple migrations are 'ready' -- we just handle one here
-// Note that per the above breakdown, and due to potential conflicts, it is possible to have one or
-// more 'ready' migration, and still none is executed.
+// runNextMigration starts the 'ready' migrations that are able to run, in the order of their submission.
+// A 'ready' migration waits when:
+// - there's another migration running, or 'ready' ahead of it, on the exact same table
+// - it is not set to run _concurrently_, and -online_ddl_max_concurrent_migrations such migrations are running
+// - it runs gh-ost or pt-osc and any migration that is not set to run concurrently is running, or vice versa
+// It also records the position of the 'queued' and 'ready' migrations in the queue, and why the 'ready' ones
+// wait, in the queue_position and queue_message columns.
 func (e *Executor) runNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
@@ -2559,47 +2655,52 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 		return nil
 	}
 
-	// getNonConflictingMigration finds a single 'ready' migration which does not conflict with running migrations.
-	// Conflicts are:
-	// - a migration is 'ready' but is not set to run _concurrently_, and there's a running migration that is also non-concurrent
-	// - a migration is 'ready' but there's another migration 'running' on the exact same table
-	getNonConflictingMigration := func() (*schema.OnlineDDL, error) {
-		r, err := e.execQuery(ctx, sqlSelectReadyMigrations)
-		if err != nil {
-			return nil, err
-		}
-		for _, row := range r.Named().Rows {
-			uuid := row["migration_uuid"].ToString()
+	if _, err := e.execQuery(ctx, sqlClearQueueOfNonPendingMigrations); err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, sqlSelectQueuedAndReadyMigrations)
+	if err != nil {
+		return err
+	}
+	state := e.runningSchedulingState()
+	queuePosition := 0
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		queueMessage := ""
+		if schema.OnlineDDLStatus(row["migration_status"].ToString()) == schema.OnlineDDLStatusReady {
 			onlineDDL, _, err := e.readMigration(ctx, uuid)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			if !e.isAnyConflictingMigrationRunning(onlineDDL) {
+			allowConcurrent := e.allowConcurrentMigration(onlineDDL)
+			queueMessage = state.conflict(onlineDDL, allowConcurrent, *maxConcurrentMigrations)
+			if queueMessage == "" {
 				// This migration seems good to go
-				return onlineDDL, err
+				if err := e.updateMigrationQueue(ctx, uuid, 0, ""); err != nil {
+					return err
+				}
+				state.addRunning(onlineDDL, allowConcurrent)
+				{
+					// We strip out any VT query comments because our simplified parser doesn't work well with comments
+					ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+					if err == nil {
+						ddlStmt.SetComments(sqlparser.Comments{})
+						onlineDDL.SQL = sqlparser.String(ddlStmt)
+					}
+				}
+				e.executeMigration(ctx, onlineDDL)
+				continue
 			}
+			// The migrations that come next on the same table wait for this one
+			state.holdTable(onlineDDL)
 		}
-		// no non-conflicting migration found...
-		// Either all ready migrations are conflicting, or there are no ready migrations...
-		return nil, nil
-	}
-	onlineDDL, err := getNonConflictingMigration()
-	if err != nil {
-		return err
-	}
-	if onlineDDL == nil {
-		// nothing to do
-		return nil
-	}
-	{
-		// We strip out any VT query comments because our simplified parser doesn't work well with comments
-		ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
-		if err == nil {
-			ddlStmt.SetComments(sqlparser.Comments{})
-			onlineDDL.SQL = sqlparser.String(ddlStmt)
+		queuePosition++
+		if row.AsInt64("queue_position", 0) != int64(queuePosition) || row["queue_message"].ToString() != queueMessage {
+			if err := e.updateMigrationQueue(ctx, uuid, queuePosition, queueMessage); err != nil {
+				return err
+			}
 		}
 	}
-	e.executeMigration(ctx, onlineDDL)
 	return nil
 }
 
@@ -2634,11 +2735,11 @@ func (e *Executor) isPTOSCMigrationRunning(ctx context.Context, uuid string) (is
 	return true, pid, nil
 }
 
-// dropOnlineDDLUser drops the given ddl user account at the end of migration
-func (e *Executor) dropPTOSCMigrationTriggers(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+// dropPTOSCMigrationTriggers drops the triggers pt-osc created on the migrated table, and returns their names
+func (e *Executor) dropPTOSCMigrationTriggers(ctx context.Context, onlineDDL *schema.OnlineDDL) (triggerNames []string, err error) {
 	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaConnector())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Close()
 
@@ -2649,11 +2750,11 @@ func (e *Executor) dropPTOSCMigrationTriggers(ctx context.Context, onlineDDL *sc
 	}
 	bound, err := parsed.GenerateQuery(bindVars, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	r, err := e.execQuery(ctx, bound)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for _, row := range r.Named().Rows {
 		// iterate pt-osc triggers and drop them
@@ -2662,11 +2763,12 @@ func (e *Executor) dropPTOSCMigrationTriggers(ctx context.Context, onlineDDL *sc
 
 		dropParsed := sqlparser.BuildParsedQuery(sqlDropTrigger, triggerSchema, triggerName)
 		if _, err := conn.ExecuteFetch(dropParsed.Query, 0, false); err != nil {
-			return err
+			return triggerNames, err
 		}
+		triggerNames = append(triggerNames, triggerName)
 	}
 
-	return err
+	return triggerNames, err
 }
 
 // readVReplStream reads _vt.vreplication entries for given workflow
@@ -3173,6 +3275,9 @@ func (e *Executor) onMigrationCheckTick() {
 	if err := e.reviewStaleMigrations(ctx); err != nil {
 		log.Error(err)
 	}
+	if err := e.closeRevertWindows(ctx); err != nil {
+		log.Error(err)
+	}
 	if err := e.gcArtifacts(ctx); err != nil {
 		log.Error(err)
 	}
@@ -3450,6 +3555,19 @@ func (e *Executor) updateMigrationReadyToComplete(ctx context.Context, uuid stri
 	return err
 }
 
+func (e *Executor) updateMigrationQueue(ctx context.Context, uuid string, queuePosition int, queueMessage string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationQueue,
+		sqltypes.Int64BindVariable(int64(queuePosition)),
+		sqltypes.StringBindVariable(queueMessage),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationStowawayTable(ctx context.Context, uuid string, tableName string) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationStowawayTable,
 		sqltypes.StringBindVariable(tableName),
@@ -3560,13 +3678,13 @@ func (e *Executor) SubmitMigration(
 	if err != nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error submitting migration %s: %v", sqlparser.String(stmt), err)
 	}
-	_, actionStr, err := onlineDDL.GetActionStr()
+	action, actionStr, err := onlineDDL.GetActionStr()
 	if err != nil {
 		return nil, err
 	}
 	revertedUUID, _ := onlineDDL.GetRevertUUID() // Empty value if the migration is not actually a REVERT. Safe to ignore error.
 
-	retainArtifactsSeconds := int64((*retainOnlineDDLTables).Seconds())
+	retainArtifactsSeconds := int64(e.retainArtifactsDuration(ctx, onlineDDL, action).Seconds())
 	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
 		sqltypes.StringBindVariable(onlineDDL.UUID),
 		sqltypes.StringBindVariable(e.keyspace),
@@ -3581,7 +3699,7 @@ func (e *Executor) SubmitMigration(
 		sqltypes.StringBindVariable(string(schema.OnlineDDLStatusQueued)),
 		sqltypes.StringBindVariable(e.TabletAliasString()),
 		sqltypes.Int64BindVariable(retainArtifactsSeconds),
-		sqltypes.BoolBindVariable(onlineDDL.StrategySetting().IsPostponeCompletion()),
+		sqltypes.BoolBindVariable(onlineDDL.IsPostponeCompletion()),
 		sqltypes.BoolBindVariable(e.allowConcurrentMigration(onlineDDL)),
 		sqltypes.StringBindVariable(revertedUUID),
 		sqltypes.BoolBindVariable(onlineDDL.IsView()),
@@ -3840,6 +3958,16 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
 			}
 			return response(e.CompleteMigration(ctx, uuid))
+		case cutOverMigrationHint:
+			// A postponed cut-over is a postponed completion.
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.CompleteMigration(ctx, uuid))
 		case cancelMigrationHint:
 			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
 			if err != nil {
@@ -3855,9 +3983,18 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, fmt.Errorf("Unexpetced UUID: %s", uuid)
 			}
 			return response(e.CancelPendingMigrations(ctx, "cancel-all by user"))
+		case abortMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.AbortMigration(ctx, uuid, "abort by user"))
 		default:
-			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s",
-				statusVal, retryMigrationHint, cancelMigrationHint)
+			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s, %s",
+				statusVal, retryMigrationHint, cancelMigrationHint, abortMigrationHint)
 		}
 	default:
 		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
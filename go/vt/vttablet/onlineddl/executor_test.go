@@ -19,3 +19,54 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/schema"
+)
+
+func TestSchedulingStateConflict(t *testing.T) {
+	alter := func(uuid, table string, strategy schema.DDLStrategy) *schema.OnlineDDL {
+		return &schema.OnlineDDL{UUID: uuid, Table: table, SQL: "alter table " + table + " engine=innodb", Strategy: strategy}
+	}
+	drop := &schema.OnlineDDL{UUID: "d1", Table: "t1", SQL: "drop table t1", Strategy: schema.DDLStrategyVitess}
+
+	t.Run("same table", func(t *testing.T) {
+		state := newSchedulingState()
+		state.addRunning(alter("a1", "t1", schema.DDLStrategyVitess), false)
+		assert.Equal(t, "waiting for migration a1 on table t1", state.conflict(alter("a2", "t1", schema.DDLStrategyVitess), false, 10))
+		assert.Equal(t, "waiting for migration a1 on table t1", state.conflict(drop, true, 10))
+		assert.Empty(t, state.conflict(alter("a3", "t2", schema.DDLStrategyVitess), false, 10))
+	})
+	t.Run("ready migration ahead on the same table", func(t *testing.T) {
+		state := newSchedulingState()
+		state.holdTable(alter("a1", "t1", schema.DDLStrategyVitess))
+		assert.Equal(t, "waiting for migration a1 on table t1", state.conflict(drop, true, 10))
+	})
+	t.Run("concurrency limit", func(t *testing.T) {
+		state := newSchedulingState()
+		assert.Empty(t, state.conflict(alter("a1", "t1", schema.DDLStrategyVitess), false, 2))
+		state.addRunning(alter("a1", "t1", schema.DDLStrategyVitess), false)
+		assert.Equal(t, "waiting for 1 running migrations (online_ddl_max_concurrent_migrations=1)", state.conflict(alter("a2", "t2", schema.DDLStrategyVitess), false, 1))
+		assert.Empty(t, state.conflict(alter("a2", "t2", schema.DDLStrategyOnline), false, 2))
+		state.addRunning(alter("a2", "t2", schema.DDLStrategyOnline), false)
+		assert.Equal(t, "waiting for 2 running migrations (online_ddl_max_concurrent_migrations=2)", state.conflict(alter("a3", "t3", schema.DDLStrategyVitess), false, 2))
+		// Concurrent migrations are not limited
+		state.addRunning(&schema.OnlineDDL{UUID: "c1", Table: "t4", SQL: "create table t4 (id int primary key)"}, true)
+		assert.Equal(t, 2, state.nonConcurrent)
+		assert.Empty(t, state.conflict(&schema.OnlineDDL{UUID: "d2", Table: "t5", SQL: "drop table t5"}, true, 2))
+	})
+	t.Run("external tools", func(t *testing.T) {
+		state := newSchedulingState()
+		state.addRunning(alter("a1", "t1", schema.DDLStrategyVitess), false)
+		assert.Equal(t, "waiting for 1 running migrations, as gh-ost runs alone", state.conflict(alter("g1", "t2", schema.DDLStrategyGhost), false, 10))
+
+		state = newSchedulingState()
+		state.addRunning(alter("p1", "t1", schema.DDLStrategyPTOSC), false)
+		assert.Equal(t, "waiting for migration p1, which runs alone", state.conflict(alter("a1", "t2", schema.DDLStrategyVitess), false, 10))
+		assert.Empty(t, state.conflict(&schema.OnlineDDL{UUID: "d2", Table: "t5", SQL: "drop table t5"}, true, 10))
+	})
+}
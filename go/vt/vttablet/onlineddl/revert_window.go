@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+// openRevertWindow is called right after the cut-over of a vreplication migration with -revert-window.
+// It keeps the original table, which the cut-over swapped out into originalTable, in sync with the
+// migrated table until the window expires. The reverse stream is the very stream a revert would
+// create: it replicates from the position of the cut-over into the original table. A revert within
+// the window takes the stream over, and only has to cut over.
+func (e *Executor) openRevertWindow(ctx context.Context, onlineDDL *schema.OnlineDDL, originalTable string, pos string) error {
+	window, err := onlineDDL.StrategySetting().RevertWindow()
+	if err != nil || window == 0 {
+		return err
+	}
+	reverseWorkflow, err := schema.CreateOnlineDDLUUID()
+	if err != nil {
+		return err
+	}
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	v := NewVRepl(reverseWorkflow, e.keyspace, e.shard, e.dbName, onlineDDL.Table, originalTable, "")
+	v.pos = pos
+	if err := v.analyze(ctx, conn); err != nil {
+		return err
+	}
+	// The window is recorded before the stream is created, so that closeRevertWindows() removes the
+	// stream even if we fail half way.
+	if err := e.updateMigrationRevertWindow(ctx, onlineDDL.UUID, reverseWorkflow, window); err != nil {
+		return err
+	}
+
+	tmClient := tmclient.NewTabletManagerClient()
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	insertVReplicationQuery, err := v.generateInsertStatement(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tmClient, tablet.Tablet, insertVReplicationQuery); err != nil {
+		return err
+	}
+	startVReplicationQuery, err := v.generateStartStatement(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tmClient, tablet.Tablet, startVReplicationQuery); err != nil {
+		return err
+	}
+	log.Infof("Executor.openRevertWindow: migration %s keeps %s in sync with %s for %v, via workflow %s", onlineDDL.UUID, originalTable, onlineDDL.Table, window, reverseWorkflow)
+	return nil
+}
+
+// takeOverRevertWindow makes the reverse stream of the revert window of a migration the stream of the
+// migration that reverts it, by renaming its workflow. It returns false if the reverted migration has
+// no open revert window, in which case the revert creates its own stream.
+func (e *Executor) takeOverRevertWindow(ctx context.Context, onlineDDL *schema.OnlineDDL, revertMigration *schema.OnlineDDL) (tookOver bool, err error) {
+	_, row, err := e.readMigration(ctx, revertMigration.UUID)
+	if err != nil {
+		return false, err
+	}
+	reverseWorkflow := row.AsString("reverse_workflow", "")
+	if reverseWorkflow == "" {
+		return false, nil
+	}
+	s, err := e.readVReplStream(ctx, reverseWorkflow, true)
+	if err != nil || s == nil {
+		return false, err
+	}
+	query, err := sqlparser.ParseAndBind(sqlRenameVReplStream,
+		sqltypes.StringBindVariable(onlineDDL.UUID),
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(reverseWorkflow),
+	)
+	if err != nil {
+		return false, err
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return false, err
+	}
+	if _, err := e.vreplicationExec(ctx, tmClient, tablet.Tablet, query); err != nil {
+		return false, err
+	}
+	if err := e.clearMigrationRevertWindow(ctx, revertMigration.UUID); err != nil {
+		return false, err
+	}
+	log.Infof("Executor.takeOverRevertWindow: migration %s reverts %s with the stream of its revert window", onlineDDL.UUID, revertMigration.UUID)
+	return true, nil
+}
+
+// revertWindowCloseReason returns why the revert window of a migration should be closed, or an empty
+// string if it stays open. lastCompleteUUID is the last migration to complete on the table of the
+// migration: once another migration completed on the table, the migration cannot be reverted anymore.
+func revertWindowCloseReason(uuid string, lastCompleteUUID string, isExpired bool, isCleanupRequested bool) string {
+	switch {
+	case isExpired:
+		return "the revert window expired"
+	case isCleanupRequested:
+		return "cleanup was requested"
+	case lastCompleteUUID == "":
+		return "the migration is no longer complete"
+	case lastCompleteUUID != uuid:
+		return fmt.Sprintf("migration %s completed on the same table", lastCompleteUUID)
+	}
+	return ""
+}
+
+// closeRevertWindows removes the reverse streams of the revert windows that expired, or that can no
+// longer be used. The original tables they kept in sync are then garbage-collected by gcArtifacts().
+func (e *Executor) closeRevertWindows(ctx context.Context) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	r, err := e.execQuery(ctx, sqlSelectOpenRevertWindows)
+	if err != nil {
+		return err
+	}
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		reverseWorkflow := row["reverse_workflow"].ToString()
+
+		query, err := sqlparser.ParseAndBind(sqlSelectCompleteMigrationsOnTable,
+			sqltypes.StringBindVariable(e.keyspace),
+			sqltypes.StringBindVariable(row["mysql_table"].ToString()),
+		)
+		if err != nil {
+			return err
+		}
+		rc, err := e.execQuery(ctx, query)
+		if err != nil {
+			return err
+		}
+		lastCompleteUUID := ""
+		if completeRow := rc.Named().Row(); completeRow != nil {
+			lastCompleteUUID = completeRow["migration_uuid"].ToString()
+		}
+		reason := revertWindowCloseReason(uuid, lastCompleteUUID, row.AsBool("is_expired", false), row.AsBool("is_cleanup_requested", false))
+		if reason == "" {
+			continue
+		}
+		if err := e.deleteVReplicationEntry(ctx, reverseWorkflow); err != nil {
+			return err
+		}
+		if err := e.clearMigrationRevertWindow(ctx, uuid); err != nil {
+			return err
+		}
+		log.Infof("Executor.closeRevertWindows: closed the revert window of migration %s: %s", uuid, reason)
+	}
+	return nil
+}
+
+func (e *Executor) updateMigrationRevertWindow(ctx context.Context, uuid string, reverseWorkflow string, window time.Duration) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationRevertWindow,
+		sqltypes.StringBindVariable(reverseWorkflow),
+		sqltypes.Int64BindVariable(int64(math.Ceil(window.Seconds()))),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) clearMigrationRevertWindow(ctx context.Context, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlClearMigrationRevertWindow,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
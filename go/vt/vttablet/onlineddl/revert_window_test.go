@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeVReplicationTMC records the queries of VReplicationExec.
+type fakeVReplicationTMC struct {
+	tmclient.TabletManagerClient
+
+	mu      sync.Mutex
+	queries []string
+}
+
+// revertWindowTMC is the client of the tablet manager protocol
+// "revert_window_test".
+var revertWindowTMC *fakeVReplicationTMC
+
+func init() {
+	tmclient.RegisterTabletManagerClientFactory("revert_window_test", func() tmclient.TabletManagerClient {
+		return revertWindowTMC
+	})
+}
+
+func (tmc *fakeVReplicationTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	if query != sqlImpossibleSelectVreplication {
+		tmc.queries = append(tmc.queries, query)
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func (tmc *fakeVReplicationTMC) executed() []string {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	return tmc.queries
+}
+
+// newRevertWindowTestExecutor returns an executor of the shard ks/0, whose
+// database is db, and which runs its vreplication queries on tmc.
+func newRevertWindowTestExecutor(t *testing.T) (*Executor, *fakesqldb.DB, *fakeVReplicationTMC) {
+	db := fakesqldb.New(t)
+	t.Cleanup(db.Close)
+	db.AddQuery("use `vt_ks`", &sqltypes.Result{})
+
+	tmc := &fakeVReplicationTMC{}
+	revertWindowTMC = tmc
+	saveProtocol := *tmclient.TabletManagerProtocol
+	*tmclient.TabletManagerProtocol = "revert_window_test"
+	t.Cleanup(func() { *tmclient.TabletManagerProtocol = saveProtocol })
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{Alias: alias, Keyspace: "ks", Shard: "0"}))
+
+	params, err := db.ConnParams().MysqlParams()
+	require.NoError(t, err)
+	config := tabletenv.NewDefaultConfig()
+	config.DB = dbconfigs.NewTestDBConfigs(*params, *params, "vt_ks")
+	env := tabletenv.NewEnv(config, t.Name())
+
+	e := NewExecutor(env, alias, ts, func() topodatapb.TabletType { return topodatapb.TabletType_PRIMARY }, nil)
+	e.InitDBConfig("ks", "0", "vt_ks")
+	e.pool.Open(config.DB.AppWithDB(), config.DB.DbaWithDB(), config.DB.AppDebugWithDB())
+	t.Cleanup(e.pool.Close)
+	return e, db, tmc
+}
+
+// queryPattern returns a pattern that matches the queries that contain all
+// the parts, in their order, whatever their white space.
+func queryPattern(parts ...string) string {
+	return ".*" + strings.Join(quoteQueryParts(parts), ".*") + ".*"
+}
+
+// queried returns true if db received a statement that contains all the
+// parts, in their order.
+func queried(db *fakesqldb.DB, parts ...string) bool {
+	re := regexp.MustCompile("(?i)" + strings.Join(quoteQueryParts(parts), "[^;]*"))
+	return re.MatchString(db.QueryLog())
+}
+
+func quoteQueryParts(parts []string) []string {
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		quoted[i] = strings.Join(strings.Fields(regexp.QuoteMeta(part)), `\s+`)
+	}
+	return quoted
+}
+
+func TestRevertWindowCloseReason(t *testing.T) {
+	uuid := "6f9c6b3e_9e5f_11ec_b0a7_0a43f95f28a3"
+	other := "7a1d2c4f_9e5f_11ec_b0a7_0a43f95f28a3"
+	tt := []struct {
+		name               string
+		lastCompleteUUID   string
+		isExpired          bool
+		isCleanupRequested bool
+		reason             string
+	}{
+		{
+			name:             "open",
+			lastCompleteUUID: uuid,
+		},
+		{
+			name:             "expired",
+			lastCompleteUUID: uuid,
+			isExpired:        true,
+			reason:           "the revert window expired",
+		},
+		{
+			name:               "cleanup",
+			lastCompleteUUID:   uuid,
+			isCleanupRequested: true,
+			reason:             "cleanup was requested",
+		},
+		{
+			name:             "superseded",
+			lastCompleteUUID: other,
+			reason:           "migration " + other + " completed on the same table",
+		},
+		{
+			name:   "no longer complete",
+			reason: "the migration is no longer complete",
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			assert.Equal(t, ts.reason, revertWindowCloseReason(uuid, ts.lastCompleteUUID, ts.isExpired, ts.isCleanupRequested))
+		})
+	}
+}
+
+func TestOpenRevertWindow(t *testing.T) {
+	e, db, tmc := newRevertWindowTestExecutor(t)
+	ctx := context.Background()
+	uuid := "6f9c6b3e_9e5f_11ec_b0a7_0a43f95f28a3"
+	originalTable := "_6f9c6b3e_9e5f_11ec_b0a7_0a43f95f28a3_20220310120000_vrp"
+	pos := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-615"
+
+	// Without -revert-window, the original table is left as it is.
+	onlineDDL := &schema.OnlineDDL{UUID: uuid, Table: "t1", Strategy: schema.DDLStrategyVitess}
+	require.NoError(t, e.openRevertWindow(ctx, onlineDDL, originalTable, pos))
+	assert.Empty(t, tmc.executed())
+
+	columns := sqltypes.MakeTestResult(sqltypes.MakeTestFields("Field|Type|Key|Extra", "varchar|varchar|varchar|varchar"),
+		"id|bigint|PRI|",
+		"name|varchar(64)||",
+	)
+	uniqueKeys := sqltypes.MakeTestResult(sqltypes.MakeTestFields("index_name|column_names|has_nullable|is_float|is_auto_increment", "varchar|varchar|int64|int64|int64"),
+		"PRIMARY|id|0|0|0",
+	)
+	db.AddQuery("SHOW TABLE STATUS LIKE 't1'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Rows", "int64"), "10"))
+	db.AddQuery("SHOW COLUMNS FROM `t1`", columns)
+	db.AddQuery("SHOW COLUMNS FROM `"+originalTable+"`", columns)
+	db.AddQueryPattern(queryPattern("UNIQUES.INDEX_NAME as index_name"), uniqueKeys)
+	db.AddQueryPattern(queryPattern("COLUMN_DEFAULT IS NULL AS is_default_null"), &sqltypes.Result{})
+	db.AddQueryPattern(queryPattern("SELECT AUTO_INCREMENT"), &sqltypes.Result{})
+	db.AddQueryPattern(queryPattern("UPDATE _vt.schema_migrations", "reverse_workflow="), &sqltypes.Result{})
+
+	// The window is recorded with a new reverse workflow, whose stream
+	// replicates from the position of the cut-over into the original table.
+	onlineDDL.Options = "--revert-window=1h"
+	require.NoError(t, e.openRevertWindow(ctx, onlineDDL, originalTable, pos))
+	queries := tmc.executed()
+	require.Len(t, queries, 2)
+	reverseWorkflow := regexp.MustCompile(`values \('([^']+)'`).FindStringSubmatch(queries[0])
+	require.Len(t, reverseWorkflow, 2, queries[0])
+	assert.NotEqual(t, uuid, reverseWorkflow[1])
+	assert.Contains(t, queries[0], "insert into _vt.vreplication")
+	assert.Contains(t, queries[0], pos)
+	assert.Contains(t, queries[0], originalTable)
+	assert.Equal(t, "UPDATE _vt.vreplication set state='Running' where db_name='vt_ks' and workflow='"+reverseWorkflow[1]+"'", queries[1])
+	assert.True(t, queried(db, "SET reverse_workflow='"+reverseWorkflow[1]+"', revert_window_expires_timestamp=NOW() + INTERVAL 3600 SECOND", "migration_uuid='"+uuid+"'"))
+
+	// An invalid window fails the migration before anything is created.
+	onlineDDL.Options = "--revert-window=forever"
+	assert.Error(t, e.openRevertWindow(ctx, onlineDDL, originalTable, pos))
+	assert.Len(t, tmc.executed(), 2)
+}
+
+func TestTakeOverRevertWindow(t *testing.T) {
+	e, db, tmc := newRevertWindowTestExecutor(t)
+	ctx := context.Background()
+	uuid := "7a1d2c4f_9e5f_11ec_b0a7_0a43f95f28a3"
+	reverted := &schema.OnlineDDL{UUID: "6f9c6b3e_9e5f_11ec_b0a7_0a43f95f28a3", Table: "t1"}
+	revert := &schema.OnlineDDL{UUID: uuid, Table: "t1"}
+
+	migration := func(reverseWorkflow string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("migration_uuid|mysql_table|reverse_workflow", "varchar|varchar|varchar"),
+			reverted.UUID+"|t1|"+reverseWorkflow,
+		)
+	}
+	selectMigration := queryPattern("FROM _vt.schema_migrations", "migration_uuid='"+reverted.UUID+"'")
+	selectStream := queryPattern("FROM _vt.vreplication", "workflow='reverse_wf'")
+
+	// The reverted migration has no revert window.
+	db.AddQueryPattern(selectMigration, migration(""))
+	tookOver, err := e.takeOverRevertWindow(ctx, revert, reverted)
+	require.NoError(t, err)
+	assert.False(t, tookOver)
+
+	// Its revert window has no stream anymore.
+	db.ClearQueryPattern()
+	db.AddQueryPattern(selectMigration, migration("reverse_wf"))
+	db.AddQueryPattern(selectStream, &sqltypes.Result{})
+	tookOver, err = e.takeOverRevertWindow(ctx, revert, reverted)
+	require.NoError(t, err)
+	assert.False(t, tookOver)
+	assert.Empty(t, tmc.executed())
+
+	// The stream of the revert window is renamed after the revert.
+	db.ClearQueryPattern()
+	db.AddQueryPattern(selectMigration, migration("reverse_wf"))
+	db.AddQueryPattern(selectStream, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|workflow|source|state", "int64|varchar|varchar|varchar"),
+		`1|reverse_wf|keyspace:"ks" shard:"0"|Running`,
+	))
+	db.AddQueryPattern(queryPattern("UPDATE _vt.schema_migrations", "SET reverse_workflow=''"), &sqltypes.Result{})
+	tookOver, err = e.takeOverRevertWindow(ctx, revert, reverted)
+	require.NoError(t, err)
+	assert.True(t, tookOver)
+	assert.Equal(t, []string{
+		"UPDATE _vt.vreplication set workflow='" + uuid + "', state='Running', message='' where db_name='vt_ks' and workflow='reverse_wf'",
+	}, tmc.executed())
+	assert.True(t, queried(db, "SET reverse_workflow='', revert_window_expires_timestamp=NULL", "migration_uuid='"+reverted.UUID+"'"))
+}
+
+func TestCloseRevertWindows(t *testing.T) {
+	e, db, tmc := newRevertWindowTestExecutor(t)
+	ctx := context.Background()
+
+	db.AddQueryPattern(queryPattern("FROM _vt.schema_migrations", "reverse_workflow != ''"), sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|mysql_table|reverse_workflow|is_expired|is_cleanup_requested", "varchar|varchar|varchar|int64|int64"),
+		"open|t1|wf_open|0|0",
+		"expired|t2|wf_expired|1|0",
+		"cleanup|t3|wf_cleanup|0|1",
+		"superseded|t4|wf_superseded|0|0",
+	))
+	completeMigration := func(table, uuid string) {
+		db.AddQueryPattern(queryPattern("migration_status='complete'", "mysql_table='"+table+"'"), sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("migration_uuid|strategy", "varchar|varchar"),
+			uuid+"|vitess",
+		))
+	}
+	completeMigration("t1", "open")
+	completeMigration("t2", "expired")
+	completeMigration("t3", "cleanup")
+	completeMigration("t4", "later")
+	db.AddQueryPattern(queryPattern("UPDATE _vt.schema_migrations", "SET reverse_workflow=''"), &sqltypes.Result{})
+
+	require.NoError(t, e.closeRevertWindows(ctx))
+
+	// The streams of the windows that expired, or can't be used anymore, are
+	// removed, and their windows cleared.
+	assert.Equal(t, []string{
+		"DELETE FROM _vt.vreplication where db_name='vt_ks' and workflow='wf_expired'",
+		"DELETE FROM _vt.vreplication where db_name='vt_ks' and workflow='wf_cleanup'",
+		"DELETE FROM _vt.vreplication where db_name='vt_ks' and workflow='wf_superseded'",
+	}, tmc.executed())
+	for _, uuid := range []string{"expired", "cleanup", "superseded"} {
+		assert.True(t, queried(db, "SET reverse_workflow=''", "migration_uuid='"+uuid+"'"), uuid)
+	}
+	assert.False(t, queried(db, "SET reverse_workflow=''", "migration_uuid='open'"))
+}
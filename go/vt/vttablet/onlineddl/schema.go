@@ -74,6 +74,10 @@ const (
 	alterSchemaMigrationsTableIsView                   = "ALTER TABLE _vt.schema_migrations add column is_view tinyint unsigned NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTableReadyToComplete          = "ALTER TABLE _vt.schema_migrations add column ready_to_complete tinyint unsigned NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTableStowawayTable            = "ALTER TABLE _vt.schema_migrations add column stowaway_table tinytext NOT NULL"
+	alterSchemaMigrationsTableQueuePosition            = "ALTER TABLE _vt.schema_migrations add column queue_position int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableQueueMessage             = "ALTER TABLE _vt.schema_migrations add column queue_message varchar(1024) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableReverseWorkflow          = "ALTER TABLE _vt.schema_migrations add column reverse_workflow varchar(64) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableRevertWindowExpires      = "ALTER TABLE _vt.schema_migrations add column revert_window_expires_timestamp timestamp NULL DEFAULT NULL"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -147,6 +151,16 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateMigrationRevertWindow = `UPDATE _vt.schema_migrations
+			SET reverse_workflow=%a, revert_window_expires_timestamp=NOW() + INTERVAL %a SECOND
+		WHERE
+			migration_uuid=%a
+	`
+	sqlClearMigrationRevertWindow = `UPDATE _vt.schema_migrations
+			SET reverse_workflow='', revert_window_expires_timestamp=NULL
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMigrationStartedTimestamp = `UPDATE _vt.schema_migrations SET
 			started_timestamp =IFNULL(started_timestamp,  NOW()),
 			liveness_timestamp=IFNULL(liveness_timestamp, NOW())
@@ -336,11 +350,22 @@ const (
 		WHERE
 			migration_status IN ('complete', 'failed')
 			AND cleanup_timestamp IS NULL
+			AND reverse_workflow=''
 			AND completed_timestamp <= IF(retain_artifacts_seconds=0,
 				NOW() - INTERVAL %a SECOND,
 				NOW() - INTERVAL retain_artifacts_seconds SECOND
 			)
 	`
+	sqlSelectOpenRevertWindows = `SELECT
+			migration_uuid,
+			mysql_table,
+			reverse_workflow,
+			revert_window_expires_timestamp <= NOW() AS is_expired,
+			retain_artifacts_seconds < 0 AS is_cleanup_requested
+		FROM _vt.schema_migrations
+		WHERE
+			reverse_workflow != ''
+	`
 	sqlFixCompletedTimestamp = `UPDATE _vt.schema_migrations
 		SET
 			completed_timestamp=NOW()
@@ -382,13 +407,27 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
-	sqlSelectReadyMigrations = `SELECT
-			migration_uuid
+	sqlSelectQueuedAndReadyMigrations = `SELECT
+			migration_uuid,
+			migration_status,
+			queue_position,
+			queue_message
 		FROM _vt.schema_migrations
 		WHERE
-			migration_status='ready'
+			migration_status IN ('queued', 'ready')
 		ORDER BY id
 	`
+	sqlUpdateMigrationQueue = `UPDATE _vt.schema_migrations
+			SET queue_position=%a, queue_message=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlClearQueueOfNonPendingMigrations = `UPDATE _vt.schema_migrations
+			SET queue_position=0, queue_message=''
+		WHERE
+			migration_status NOT IN ('queued', 'ready')
+			AND (queue_position > 0 OR queue_message != '')
+	`
 	sqlSelectPTOSCMigrationTriggers = `SELECT
 			TRIGGER_SCHEMA as trigger_schema,
 			TRIGGER_NAME as trigger_name
@@ -399,6 +438,13 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
+	sqlSelectMigrationTables = `SELECT
+			TABLE_NAME as table_name
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND (TABLE_NAME LIKE %a OR TABLE_NAME LIKE %a)
+		`
 	sqlSelectColumnTypes = `
 		select
 				*,
@@ -505,6 +551,7 @@ const (
 	sqlStartVReplStream             = "UPDATE _vt.vreplication set state='Running' where db_name=%a and workflow=%a"
 	sqlStopVReplStream              = "UPDATE _vt.vreplication set state='Stopped' where db_name=%a and workflow=%a"
 	sqlDeleteVReplStream            = "DELETE FROM _vt.vreplication where db_name=%a and workflow=%a"
+	sqlRenameVReplStream            = "UPDATE _vt.vreplication set workflow=%a, state='Running', message='' where db_name=%a and workflow=%a"
 	sqlReadVReplStream              = `SELECT
 			id,
 			workflow,
@@ -535,6 +582,8 @@ const (
 	cancelMigrationHint    = "cancel"
 	cancelAllMigrationHint = "cancel-all"
 	completeMigrationHint  = "complete"
+	cutOverMigrationHint   = "cutover"
+	abortMigrationHint     = "abort"
 )
 
 var (
@@ -585,4 +634,8 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableIsView,
 	alterSchemaMigrationsTableReadyToComplete,
 	alterSchemaMigrationsTableStowawayTable,
+	alterSchemaMigrationsTableQueuePosition,
+	alterSchemaMigrationsTableQueueMessage,
+	alterSchemaMigrationsTableReverseWorkflow,
+	alterSchemaMigrationsTableRevertWindowExpires,
 }
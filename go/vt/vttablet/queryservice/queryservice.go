@@ -78,6 +78,10 @@ type QueryService interface {
 	// ReadTransaction returns the metadata for the specified dtid.
 	ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (metadata *querypb.TransactionMetadata, err error)
 
+	// UnresolvedTransactions returns the 2pc transactions coordinated by
+	// the shard, and the ones prepared on it as a participant.
+	UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error)
+
 	// Execute for query execution
 	Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error)
 	// StreamExecute for query execution with streaming
@@ -91,8 +95,14 @@ type QueryService interface {
 	BeginStreamExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (int64, *topodatapb.TabletAlias, error)
 
 	// Messaging methods.
-	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
+	// MessageStream streams the messages of a table. If window is not 0,
+	// the tablet sends window messages at most before it waits for
+	// MessageStreamWindow to grow the window of the stream of streamID.
+	MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) error
 	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
+	// MessageStreamWindow grows the window of a MessageStream by increment
+	// messages. It returns a NOT_FOUND error if the stream ended.
+	MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error
 
 	// VStream streams VReplication events based on the specified filter.
 	VStream(ctx context.Context, target *querypb.Target, startPos string, tableLastPKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
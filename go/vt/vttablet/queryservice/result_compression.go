@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryservice
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The rows of the results are compressed by the tablet when the caller asks
+// for it, and decompressed by the caller before they reach the rest of the
+// code, so that only the gRPC layers need to know about it.
+
+var (
+	resultCompressionInputBytes = stats.NewCountersWithSingleLabel(
+		"ResultCompressionInputBytes",
+		"Size of the rows of the results before they were compressed, by algorithm",
+		"Algorithm")
+	resultCompressionOutputBytes = stats.NewCountersWithSingleLabel(
+		"ResultCompressionOutputBytes",
+		"Size of the rows of the results after they were compressed, by algorithm",
+		"Algorithm")
+	resultCompressionTimings = stats.NewTimings(
+		"ResultCompressionTimings",
+		"Time spent compressing and decompressing the rows of the results, by algorithm and operation",
+		"Operation")
+
+	// zstd encoders and decoders can be used concurrently with EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// CompressResult compresses the rows of the result with the algorithm of the
+// options, if they are larger than the threshold of the options. The rows are
+// left as they are if the algorithm is unknown, which lets the callers ask for
+// algorithms that older tablets don't support.
+func CompressResult(qr *querypb.QueryResult, options *querypb.ExecuteOptions) {
+	compression := options.GetResultCompression()
+	switch {
+	case qr == nil || len(qr.Rows) == 0:
+		return
+	case compression != querypb.ExecuteOptions_ZSTD && compression != querypb.ExecuteOptions_SNAPPY && compression != querypb.ExecuteOptions_LZ4:
+		return
+	}
+	rows := &querypb.QueryResult{Rows: qr.Rows}
+	if int64(rows.SizeVT()) <= options.GetResultCompressionThreshold() {
+		return
+	}
+
+	defer resultCompressionTimings.Record(compression.String()+".Compress", time.Now())
+	data, err := rows.MarshalVT()
+	if err != nil {
+		return
+	}
+	var compressed []byte
+	switch compression {
+	case querypb.ExecuteOptions_ZSTD:
+		compressed = zstdEncoder.EncodeAll(data, nil)
+	case querypb.ExecuteOptions_SNAPPY:
+		compressed = snappy.Encode(nil, data)
+	case querypb.ExecuteOptions_LZ4:
+		if compressed, err = lz4Encode(data); err != nil || compressed == nil {
+			return
+		}
+	}
+	resultCompressionInputBytes.Add(compression.String(), int64(len(data)))
+	resultCompressionOutputBytes.Add(compression.String(), int64(len(compressed)))
+
+	qr.Rows = nil
+	qr.RowsCompression = compression
+	qr.CompressedRows = compressed
+}
+
+// DecompressResult restores the rows of a result that CompressResult compressed.
+func DecompressResult(qr *querypb.QueryResult) error {
+	if qr == nil || qr.RowsCompression == querypb.ExecuteOptions_NONE {
+		return nil
+	}
+	compression := qr.RowsCompression
+	defer resultCompressionTimings.Record(compression.String()+".Decompress", time.Now())
+
+	var data []byte
+	var err error
+	switch compression {
+	case querypb.ExecuteOptions_ZSTD:
+		data, err = zstdDecoder.DecodeAll(qr.CompressedRows, nil)
+	case querypb.ExecuteOptions_SNAPPY:
+		data, err = snappy.Decode(nil, qr.CompressedRows)
+	case querypb.ExecuteOptions_LZ4:
+		data, err = lz4Decode(qr.CompressedRows)
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported result compression: %v", compression)
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot decompress the rows of the result")
+	}
+	rows := &querypb.QueryResult{}
+	if err := rows.UnmarshalVT(data); err != nil {
+		return vterrors.Wrapf(err, "cannot unmarshal the rows of the result")
+	}
+
+	qr.Rows = rows.Rows
+	qr.RowsCompression = querypb.ExecuteOptions_NONE
+	qr.CompressedRows = nil
+	return nil
+}
+
+// lz4Encode compresses data as an lz4 block, prefixed with the size of data
+// as a uvarint since the block format doesn't record it. It returns nil if
+// the data is incompressible.
+func lz4Encode(data []byte) ([]byte, error) {
+	compressed := make([]byte, binary.MaxVarintLen64+lz4.CompressBlockBound(len(data)))
+	n := binary.PutUvarint(compressed, uint64(len(data)))
+	size, err := lz4.CompressBlock(data, compressed[n:], nil)
+	if err != nil || size == 0 {
+		return nil, err
+	}
+	return compressed[:n+size], nil
+}
+
+// lz4Decode decompresses the output of lz4Encode.
+func lz4Decode(compressed []byte) ([]byte, error) {
+	size, n := binary.Uvarint(compressed)
+	if n <= 0 || size > uint64(len(compressed))*255 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid lz4 block header")
+	}
+	data := make([]byte, size)
+	decompressed, err := lz4.UncompressBlock(compressed[n:], data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:decompressed], nil
+}
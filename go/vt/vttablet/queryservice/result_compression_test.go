@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func wideResult() *querypb.QueryResult {
+	result := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("id|payload", "int64|varchar"),
+	}
+	for i := 0; i < 100; i++ {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewInt64(int64(i)),
+			sqltypes.NewVarChar(strings.Repeat("payload", 100)),
+		})
+	}
+	return sqltypes.ResultToProto3(result)
+}
+
+func TestResultCompression(t *testing.T) {
+	for _, compression := range []querypb.ExecuteOptions_ResultCompression{querypb.ExecuteOptions_ZSTD, querypb.ExecuteOptions_SNAPPY, querypb.ExecuteOptions_LZ4} {
+		t.Run(compression.String(), func(t *testing.T) {
+			want := wideResult()
+			qr := wideResult()
+			inputBefore := resultCompressionInputBytes.Counts()[compression.String()]
+			outputBefore := resultCompressionOutputBytes.Counts()[compression.String()]
+
+			CompressResult(qr, &querypb.ExecuteOptions{ResultCompression: compression, ResultCompressionThreshold: 1024})
+			assert.Empty(t, qr.Rows)
+			assert.Equal(t, compression, qr.RowsCompression)
+			assert.Equal(t, want.Fields, qr.Fields)
+			input := resultCompressionInputBytes.Counts()[compression.String()] - inputBefore
+			output := resultCompressionOutputBytes.Counts()[compression.String()] - outputBefore
+			assert.EqualValues(t, len(qr.CompressedRows), output)
+			assert.Less(t, 10*output, input)
+
+			require.NoError(t, DecompressResult(qr))
+			assert.True(t, proto.Equal(want, qr), "got: %v", qr)
+		})
+	}
+}
+
+func TestResultCompressionSkipped(t *testing.T) {
+	want := wideResult()
+
+	// below the threshold
+	qr := wideResult()
+	CompressResult(qr, &querypb.ExecuteOptions{ResultCompression: querypb.ExecuteOptions_ZSTD, ResultCompressionThreshold: 1 << 20})
+	assert.True(t, proto.Equal(want, qr))
+
+	// not asked for, or unknown
+	CompressResult(qr, nil)
+	assert.True(t, proto.Equal(want, qr))
+	CompressResult(qr, &querypb.ExecuteOptions{ResultCompression: 42})
+	assert.True(t, proto.Equal(want, qr))
+
+	// not compressed
+	require.NoError(t, DecompressResult(qr))
+	assert.True(t, proto.Equal(want, qr))
+}
+
+func TestDecompressResultErrors(t *testing.T) {
+	err := DecompressResult(&querypb.QueryResult{RowsCompression: querypb.ExecuteOptions_ZSTD, CompressedRows: []byte("garbage")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot decompress the rows of the result")
+
+	err = DecompressResult(&querypb.QueryResult{RowsCompression: 42})
+	assert.EqualError(t, err, "unsupported result compression: 42")
+}
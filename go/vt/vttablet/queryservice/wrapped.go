@@ -178,6 +178,15 @@ func (ws *wrappedService) ReadTransaction(ctx context.Context, target *querypb.T
 	return metadata, err
 }
 
+func (ws *wrappedService) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "UnresolvedTransactions", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		transactions, prepared, innerErr = conn.UnresolvedTransactions(ctx, target)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return transactions, prepared, err
+}
+
 func (ws *wrappedService) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (qr *sqltypes.Result, err error) {
 	inDedicatedConn := transactionID != 0 || reservedID != 0
 	err = ws.wrapper(ctx, target, ws.impl, "Execute", inDedicatedConn, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
@@ -226,9 +235,9 @@ func (ws *wrappedService) BeginStreamExecute(ctx context.Context, target *queryp
 	return transactionID, alias, err
 }
 
-func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "MessageStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.MessageStream(ctx, target, name, callback)
+		innerErr := conn.MessageStream(ctx, target, name, streamID, window, callback)
 		return canRetry(ctx, innerErr), innerErr
 	})
 }
@@ -242,6 +251,15 @@ func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target
 	return count, err
 }
 
+func (ws *wrappedService) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error {
+	return ws.wrapper(ctx, target, ws.impl, "MessageStreamWindow", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		// The stream lives on a single tablet, and a retry could grow its
+		// window twice.
+		innerErr := conn.MessageStreamWindow(ctx, target, name, streamID, increment)
+		return false, innerErr
+	})
+}
+
 func (ws *wrappedService) VStream(ctx context.Context, target *querypb.Target, startPos string, tableLastPKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "VStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.VStream(ctx, target, startPos, tableLastPKs, filter, send)
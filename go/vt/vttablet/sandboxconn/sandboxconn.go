@@ -62,21 +62,22 @@ type SandboxConn struct {
 
 	// These Count vars report how often the corresponding
 	// functions were called.
-	ExecCount                sync2.AtomicInt64
-	BeginCount               sync2.AtomicInt64
-	CommitCount              sync2.AtomicInt64
-	RollbackCount            sync2.AtomicInt64
-	AsTransactionCount       sync2.AtomicInt64
-	PrepareCount             sync2.AtomicInt64
-	CommitPreparedCount      sync2.AtomicInt64
-	RollbackPreparedCount    sync2.AtomicInt64
-	CreateTransactionCount   sync2.AtomicInt64
-	StartCommitCount         sync2.AtomicInt64
-	SetRollbackCount         sync2.AtomicInt64
-	ConcludeTransactionCount sync2.AtomicInt64
-	ReadTransactionCount     sync2.AtomicInt64
-	ReserveCount             sync2.AtomicInt64
-	ReleaseCount             sync2.AtomicInt64
+	ExecCount                   sync2.AtomicInt64
+	BeginCount                  sync2.AtomicInt64
+	CommitCount                 sync2.AtomicInt64
+	RollbackCount               sync2.AtomicInt64
+	AsTransactionCount          sync2.AtomicInt64
+	PrepareCount                sync2.AtomicInt64
+	CommitPreparedCount         sync2.AtomicInt64
+	RollbackPreparedCount       sync2.AtomicInt64
+	CreateTransactionCount      sync2.AtomicInt64
+	StartCommitCount            sync2.AtomicInt64
+	SetRollbackCount            sync2.AtomicInt64
+	ConcludeTransactionCount    sync2.AtomicInt64
+	ReadTransactionCount        sync2.AtomicInt64
+	UnresolvedTransactionsCount sync2.AtomicInt64
+	ReserveCount                sync2.AtomicInt64
+	ReleaseCount                sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -96,14 +97,29 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// UnresolvedTransactionsResult and PreparedTransactionsResult are
+	// returned by UnresolvedTransactions.
+	UnresolvedTransactionsResult []*querypb.TransactionMetadata
+	PreparedTransactionsResult   []*querypb.PreparedTransaction
+
 	MessageIDs []*querypb.Value
 
+	// MessageStreamID and MessageStreamWindowSize store the stream id and
+	// the window of the last MessageStream, and MessageStreamIncrements
+	// the increments of its window.
+	MessageStreamID         string
+	MessageStreamWindowSize int64
+	MessageStreamIncrements []int64
+
 	// vstream expectations.
 	StartPos      string
 	VStreamEvents [][]*binlogdatapb.VEvent
 	VStreamErrors []error
 	VStreamCh     chan *binlogdatapb.VEvent
 
+	// StreamHealthCh, if set, has the responses of StreamHealth.
+	StreamHealthCh chan *querypb.StreamHealthResponse
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 
@@ -359,6 +375,15 @@ func (sbc *SandboxConn) ReadTransaction(ctx context.Context, target *querypb.Tar
 	return nil, nil
 }
 
+// UnresolvedTransactions returns the in-flight 2pc transactions of the shard.
+func (sbc *SandboxConn) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	sbc.UnresolvedTransactionsCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return nil, nil, err
+	}
+	return sbc.UnresolvedTransactionsResult, sbc.PreparedTransactionsResult, nil
+}
+
 // BeginExecute is part of the QueryService interface.
 func (sbc *SandboxConn) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	transactionID, alias, err := sbc.begin(ctx, target, preQueries, reservedID, options)
@@ -386,10 +411,12 @@ func (sbc *SandboxConn) BeginStreamExecute(ctx context.Context, target *querypb.
 }
 
 // MessageStream is part of the QueryService interface.
-func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
 		return err
 	}
+	sbc.MessageStreamID = streamID
+	sbc.MessageStreamWindowSize = window
 	r := sbc.getNextResult(nil)
 	if r == nil {
 		return nil
@@ -404,12 +431,34 @@ func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target,
 	return int64(len(ids)), nil
 }
 
+// MessageStreamWindow is part of the QueryService interface.
+func (sbc *SandboxConn) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error {
+	if streamID != sbc.MessageStreamID {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "message stream %s not found", streamID)
+	}
+	sbc.MessageStreamIncrements = append(sbc.MessageStreamIncrements, increment)
+	return nil
+}
+
 // SandboxSQRowCount is the default number of fake splits returned.
 var SandboxSQRowCount = int64(10)
 
-// StreamHealth is not implemented.
+// StreamHealth sends the responses of StreamHealthCh. It is not
+// implemented if StreamHealthCh is not set.
 func (sbc *SandboxConn) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
-	return fmt.Errorf("not implemented in test")
+	if sbc.StreamHealthCh == nil {
+		return fmt.Errorf("not implemented in test")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case shr := <-sbc.StreamHealthCh:
+			if err := callback(shr); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 // ExpectVStreamStartPos makes the conn verify that that the next vstream request has the right startPos.
@@ -358,6 +358,28 @@ func (f *FakeQueryService) ReadTransaction(ctx context.Context, target *querypb.
 	return Metadata, nil
 }
 
+// PreparedTransactions is a test list of prepared transactions.
+var PreparedTransactions = []*querypb.PreparedTransaction{{
+	Dtid:        "bb",
+	TimeCreated: 2,
+}, {
+	Dtid:        "cc",
+	TimeCreated: 3,
+	Failed:      true,
+}}
+
+// UnresolvedTransactions is part of the queryservice.QueryService interface
+func (f *FakeQueryService) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	if f.HasError {
+		return nil, nil, f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "UnresolvedTransactions", target)
+	return []*querypb.TransactionMetadata{Metadata}, PreparedTransactions, nil
+}
+
 // ExecuteQuery is a fake test query.
 const ExecuteQuery = "executeQuery"
 
@@ -574,6 +596,11 @@ var (
 	// MessageName is a test message name.
 	MessageName = "vitess_message"
 
+	// MessageStreamID and MessageStreamWindow are the test stream id and
+	// window of a message stream.
+	MessageStreamID     = "test_stream"
+	MessageStreamWindow = int64(10)
+
 	// MessageStreamResult is a test stream result.
 	MessageStreamResult = &sqltypes.Result{
 		Fields: []*querypb.Field{{
@@ -600,7 +627,7 @@ var (
 )
 
 // MessageStream is part of the queryservice.QueryService interface
-func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) (err error) {
 	if f.HasError {
 		return f.TabletError
 	}
@@ -610,6 +637,9 @@ func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Ta
 	if name != MessageName {
 		f.t.Errorf("name: %s, want %s", name, MessageName)
 	}
+	if streamID != MessageStreamID || window != MessageStreamWindow {
+		f.t.Errorf("stream: %s, %d, want %s, %d", streamID, window, MessageStreamID, MessageStreamWindow)
+	}
 	if err := callback(MessageStreamResult); err != nil {
 		f.t.Logf("MessageStream callback failed: %v", err)
 	}
@@ -633,6 +663,23 @@ func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Targe
 	return 1, nil
 }
 
+// MessageStreamWindow is part of the queryservice.QueryService interface
+func (f *FakeQueryService) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if name != MessageName {
+		f.t.Errorf("name: %s, want %s", name, MessageName)
+	}
+	if streamID != MessageStreamID || increment != MessageStreamWindow {
+		f.t.Errorf("stream: %s, %d, want %s, %d", streamID, increment, MessageStreamID, MessageStreamWindow)
+	}
+	return nil
+}
+
 // TestStreamHealthStreamHealthResponse is a test stream health response.
 var TestStreamHealthStreamHealthResponse = &querypb.StreamHealthResponse{
 	Target: &querypb.Target{
@@ -430,6 +430,45 @@ func testExecutePanics(t *testing.T, conn queryservice.QueryService, f *FakeQuer
 	})
 }
 
+func testUnresolvedTransactions(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUnresolvedTransactions")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	transactions, prepared, err := conn.UnresolvedTransactions(ctx, TestTarget)
+	if err != nil {
+		t.Fatalf("UnresolvedTransactions failed: %v", err)
+	}
+	if len(transactions) != 1 || !proto.Equal(transactions[0], Metadata) {
+		t.Errorf("Unexpected transactions from UnresolvedTransactions: got %v wanted %v", transactions, Metadata)
+	}
+	if len(prepared) != len(PreparedTransactions) {
+		t.Fatalf("Unexpected prepared transactions from UnresolvedTransactions: got %v wanted %v", prepared, PreparedTransactions)
+	}
+	for i := range prepared {
+		if !proto.Equal(prepared[i], PreparedTransactions[i]) {
+			t.Errorf("Unexpected prepared transaction from UnresolvedTransactions: got %v wanted %v", prepared[i], PreparedTransactions[i])
+		}
+	}
+}
+
+func testUnresolvedTransactionsError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUnresolvedTransactionsError")
+	f.HasError = true
+	testErrorHelper(t, f, "UnresolvedTransactions", func(ctx context.Context) error {
+		_, _, err := conn.UnresolvedTransactions(ctx, TestTarget)
+		return err
+	})
+	f.HasError = false
+}
+
+func testUnresolvedTransactionsPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUnresolvedTransactionsPanics")
+	testPanicHelper(t, f, "UnresolvedTransactions", func(ctx context.Context) error {
+		_, _, err := conn.UnresolvedTransactions(ctx, TestTarget)
+		return err
+	})
+}
+
 func testBeginExecute(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testBeginExecute")
 	f.ExpectedTransactionID = beginTransactionID
@@ -588,7 +627,7 @@ func testMessageStream(t *testing.T, conn queryservice.QueryService, f *FakeQuer
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
 	var got *sqltypes.Result
-	err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error {
+	err := conn.MessageStream(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow, func(qr *sqltypes.Result) error {
 		got = qr
 		return nil
 	})
@@ -605,7 +644,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 	f.HasError = true
 	testErrorHelper(t, f, "MessageStream", func(ctx context.Context) error {
 		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-		return conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		return conn.MessageStream(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow, func(qr *sqltypes.Result) error { return nil })
 	})
 	f.HasError = false
 }
@@ -613,7 +652,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 func testMessageStreamPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testMessageStreamPanics")
 	testPanicHelper(t, f, "MessageStream", func(ctx context.Context) error {
-		err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		err := conn.MessageStream(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow, func(qr *sqltypes.Result) error { return nil })
 		return err
 	})
 }
@@ -650,6 +689,32 @@ func testMessageAckPanics(t *testing.T, conn queryservice.QueryService, f *FakeQ
 	})
 }
 
+func testMessageStreamWindow(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessageStreamWindow")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	if err := conn.MessageStreamWindow(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow); err != nil {
+		t.Fatalf("MessageStreamWindow failed: %v", err)
+	}
+}
+
+func testMessageStreamWindowError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessageStreamWindowError")
+	f.HasError = true
+	testErrorHelper(t, f, "MessageStreamWindow", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		return conn.MessageStreamWindow(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow)
+	})
+	f.HasError = false
+}
+
+func testMessageStreamWindowPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testMessageStreamWindowPanics")
+	testPanicHelper(t, f, "MessageStreamWindow", func(ctx context.Context) error {
+		return conn.MessageStreamWindow(ctx, TestTarget, MessageName, MessageStreamID, MessageStreamWindow)
+	})
+}
+
 // this test is a bit of a hack: we write something on the channel
 // upon registration, and we also return an error, so the streaming query
 // ends right there. Otherwise we have no real way to trigger a real
@@ -712,11 +777,13 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testSetRollback,
 		testConcludeTransaction,
 		testReadTransaction,
+		testUnresolvedTransactions,
 		testExecute,
 		testBeginExecute,
 		testStreamExecute,
 		testMessageStream,
 		testMessageAck,
+		testMessageStreamWindow,
 
 		// error test cases
 		testBeginError,
@@ -730,12 +797,14 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testSetRollbackError,
 		testConcludeTransactionError,
 		testReadTransactionError,
+		testUnresolvedTransactionsError,
 		testExecuteError,
 		testBeginExecuteErrorInBegin,
 		testBeginExecuteErrorInExecute,
 		testStreamExecuteError,
 		testMessageStreamError,
 		testMessageAckError,
+		testMessageStreamWindowError,
 
 		// panic test cases
 		testBeginPanics,
@@ -749,11 +818,13 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testSetRollbackPanics,
 		testConcludeTransactionPanics,
 		testReadTransactionPanics,
+		testUnresolvedTransactionsPanics,
 		testExecutePanics,
 		testBeginExecutePanics,
 		testStreamExecutePanics,
 		testMessageStreamPanics,
 		testMessageAckPanics,
+		testMessageStreamWindowPanics,
 	}
 
 	if !fake.TestingGateway {
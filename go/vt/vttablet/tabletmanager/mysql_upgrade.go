@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// UpgradeMysqld stops mysqld, swaps its binaries, starts it again and
+// upgrades its system tables, then rejoins replication. Only a DRAINED tablet
+// can upgrade, so that it serves no queries meanwhile. The response lists the
+// steps that were taken.
+func (tm *TabletManager) UpgradeMysqld(ctx context.Context, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	if err := tm.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer tm.unlock()
+
+	if tm.Cnf == nil {
+		return nil, fmt.Errorf("tablet %v doesn't manage its mysqld", topoproto.TabletAliasString(tm.tabletAlias))
+	}
+	if tabletType := tm.Tablet().Type; tabletType != topodatapb.TabletType_DRAINED {
+		return nil, fmt.Errorf("tablet %v is %v: only a DRAINED tablet can upgrade its mysqld", topoproto.TabletAliasString(tm.tabletAlias), tabletType)
+	}
+
+	response := &tabletmanagerdatapb.UpgradeMysqldResponse{
+		OldVersion: tm.MysqlDaemon.GetVersionString(),
+	}
+	step := func(format string, args ...any) {
+		msg := fmt.Sprintf(format, args...)
+		log.Infof("UpgradeMysqld: %v", msg)
+		response.Steps = append(response.Steps, msg)
+	}
+	// The steps are in the error too, since the response isn't returned
+	// with it.
+	fail := func(format string, args ...any) error {
+		return fmt.Errorf("%v, after the steps: [%v]", fmt.Sprintf(format, args...), strings.Join(response.Steps, "; "))
+	}
+
+	step("upgrading mysqld %v", response.OldVersion)
+	if err := tm.MysqlDaemon.StopReplication(tm.hookExtraEnv()); err != nil {
+		return nil, fail("cannot stop replication: %v", err)
+	}
+	step("stopped replication")
+	if err := tm.MysqlDaemon.Shutdown(ctx, tm.Cnf, true); err != nil {
+		return nil, fail("cannot stop mysqld: %v", err)
+	}
+	step("stopped mysqld")
+
+	swap := hook.NewHookWithEnv(hook.SwapMysqldBinariesHook, swapBinariesParams, tm.hookExtraEnv())
+	if err := swap.ExecuteOptional(); err != nil {
+		return nil, fail("%v", err)
+	}
+	step("ran the %v hook, if any", hook.SwapMysqldBinariesHook)
+
+	if skipMysqlUpgrade {
+		if err := tm.MysqlDaemon.Start(ctx, tm.Cnf); err != nil {
+			return nil, fail("cannot start mysqld: %v", err)
+		}
+		step("started mysqld")
+	} else {
+		// mysql_upgrade runs against a mysqld that doesn't serve yet, the
+		// same way a restore from an older version does.
+		if err := tm.MysqlDaemon.Start(ctx, tm.Cnf, "--skip-grant-tables", "--skip-networking"); err != nil {
+			return nil, fail("cannot start mysqld to upgrade it: %v", err)
+		}
+		step("started mysqld without grants and networking")
+		if err := tm.MysqlDaemon.RunMysqlUpgrade(); err != nil {
+			return nil, fail("mysql_upgrade failed: %v", err)
+		}
+		step("ran mysql_upgrade")
+		if err := tm.MysqlDaemon.Shutdown(ctx, tm.Cnf, true); err != nil {
+			return nil, fail("cannot stop mysqld after mysql_upgrade: %v", err)
+		}
+		if err := tm.MysqlDaemon.Start(ctx, tm.Cnf); err != nil {
+			return nil, fail("cannot start mysqld: %v", err)
+		}
+		step("restarted mysqld")
+	}
+
+	if err := tm.MysqlDaemon.StartReplication(tm.hookExtraEnv()); err != nil {
+		return nil, fail("cannot start replication: %v", err)
+	}
+	step("started replication")
+	response.NewVersion = tm.MysqlDaemon.GetVersionString()
+	step("upgraded mysqld to %v", response.NewVersion)
+	return response, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestUpgradeMysqld(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 100, keyspace, shard)
+	defer tm.Stop()
+	tm.Cnf = &mysqlctl.Mycnf{}
+	mysqld := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	mysqld.ExpectedExecuteSuperQueryList = []string{"STOP SLAVE", "START SLAVE"}
+
+	// A serving tablet can't upgrade.
+	_, err := tm.UpgradeMysqld(ctx, nil, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only a DRAINED tablet can upgrade its mysqld")
+	assert.True(t, mysqld.Running)
+
+	require.NoError(t, tm.ChangeType(ctx, topodatapb.TabletType_DRAINED, false))
+	response, err := tm.UpgradeMysqld(ctx, []string{"--version=8.0.29"}, true)
+	require.NoError(t, err)
+	assert.Contains(t, response.Steps, "stopped mysqld")
+	assert.Contains(t, strings.Join(response.Steps, "\n"), "\nstarted mysqld\nstarted replication\n")
+	assert.True(t, mysqld.Running)
+	assert.True(t, mysqld.Replicating)
+	assert.Equal(t, len(mysqld.ExpectedExecuteSuperQueryList), mysqld.ExpectedExecuteSuperQueryCurrent)
+}
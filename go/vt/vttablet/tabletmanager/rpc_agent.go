@@ -103,9 +103,17 @@ type RPCTM interface {
 	// VExec generic API
 	VExec(ctx context.Context, query, workflow, keyspace string) (*querypb.QueryResult, error)
 
+	// Table GC API
+	GetTableGCStatus(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error)
+	AdvanceTableGC(ctx context.Context, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error)
+
+	// MySQL upgrade API
+	UpgradeMysqld(ctx context.Context, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error)
+
 	// VReplication API
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
+	VReplicationProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error)
 
 	// Reparenting related functions
 
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
+)
+
+func (tm *TabletManager) tableGC() (*gc.TableGC, error) {
+	collector := tm.QueryServiceControl.TableGC()
+	if collector == nil {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "table GC is not available on this tablet")
+	}
+	return collector, nil
+}
+
+// GetTableGCStatus lists the tables in the lifecycle of table GC.
+func (tm *TabletManager) GetTableGCStatus(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error) {
+	collector, err := tm.tableGC()
+	if err != nil {
+		return nil, err
+	}
+	return collector.GCTables(ctx)
+}
+
+// AdvanceTableGC moves a GC table on to its next state right away, or takes it out of its lifecycle.
+func (tm *TabletManager) AdvanceTableGC(ctx context.Context, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error) {
+	collector, err := tm.tableGC()
+	if err != nil {
+		return nil, "", err
+	}
+	return collector.AdvanceTable(ctx, tableName, cancel, restoreTableName)
+}
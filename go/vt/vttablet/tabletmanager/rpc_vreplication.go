@@ -22,6 +22,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // VReplicationExec executes a vreplication command.
@@ -37,3 +38,8 @@ func (tm *TabletManager) VReplicationExec(ctx context.Context, query string) (*q
 func (tm *TabletManager) VReplicationWaitForPos(ctx context.Context, id int, pos string) error {
 	return tm.VREngine.WaitForPos(ctx, id, pos)
 }
+
+// VReplicationProgress returns the progress of the streams of a workflow.
+func (tm *TabletManager) VReplicationProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	return tm.VREngine.Progress(ctx, workflow)
+}
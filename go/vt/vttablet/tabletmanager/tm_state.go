@@ -278,6 +278,12 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	// The diffs stop and restart the streams of their workflow, so they
+	// close before the vreplication engine and open after it.
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type != topodatapb.TabletType_PRIMARY {
+		ts.tm.VDiffEngine.Close()
+	}
+
 	if ts.tm.VREngine != nil {
 		if ts.tablet.Type == topodatapb.TabletType_PRIMARY {
 			ts.tm.VREngine.Open(ts.tm.BatchCtx)
@@ -286,6 +292,10 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type == topodatapb.TabletType_PRIMARY {
+		ts.tm.VDiffEngine.Open(ts.tm.BatchCtx)
+	}
+
 	if ts.isShardServing[ts.tablet.Type] {
 		ts.isInSrvKeyspace = true
 		statsIsInSrvKeyspace.Set(1)
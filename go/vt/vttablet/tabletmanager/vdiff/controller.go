@@ -0,0 +1,311 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// maxErrorLength is the maximum length of the error saved in
+// _vt.vdiff.last_error.
+const maxErrorLength = 500
+
+// stream is a stream of the workflow on the target shard.
+type stream struct {
+	id  int64
+	bls *binlogdatapb.BinlogSource
+}
+
+// controller runs one diff, until it completes, fails or is stopped.
+type controller struct {
+	vde      *Engine
+	id       int64
+	uuid     string
+	workflow string
+	options  *Options
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newController(ctx context.Context, vde *Engine, id int64, uuid, workflow string, options *Options) *controller {
+	ctx, cancel := context.WithCancel(ctx)
+	ct := &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     uuid,
+		workflow: workflow,
+		options:  options,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go ct.run(ctx)
+	return ct
+}
+
+// Stop stops the diff and waits for it to exit. Its state is left as is, so
+// that it resumes when the engine opens again.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("VDiff %s: could not connect to the database: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+	exec := func(query string) (*sqltypes.Result, error) {
+		return withDDL.Exec(ctx, query, dbClient.ExecuteFetch, dbClient.ExecuteFetch)
+	}
+
+	log.Infof("VDiff %s: starting the diff of workflow %s", ct.uuid, ct.workflow)
+	err := ct.diff(ctx, exec)
+	switch {
+	case ctx.Err() != nil:
+		log.Infof("VDiff %s: stopped", ct.uuid)
+	case err != nil:
+		log.Errorf("VDiff %s: failed: %v", ct.uuid, err)
+		msg := err.Error()
+		if len(msg) > maxErrorLength {
+			msg = msg[:maxErrorLength]
+		}
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlFailVDiff, encodeString(msg), ct.id), 1); err != nil {
+			log.Errorf("VDiff %s: could not save the error: %v", ct.uuid, err)
+		}
+	default:
+		log.Infof("VDiff %s: completed", ct.uuid)
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlCompleteVDiff, ct.id), 1); err != nil {
+			log.Errorf("VDiff %s: could not save the completion: %v", ct.uuid, err)
+		}
+	}
+}
+
+// diff compares the tables of the workflow one after the other, skipping
+// the ones already compared by an earlier run of the diff.
+func (ct *controller) diff(ctx context.Context, exec func(string) (*sqltypes.Result, error)) error {
+	if _, err := exec(fmt.Sprintf(sqlStartVDiff, ct.id)); err != nil {
+		return err
+	}
+	streams, err := ct.loadStreams(exec)
+	if err != nil {
+		return err
+	}
+	differs, err := ct.buildTableDiffers(ctx, streams)
+	if err != nil {
+		return err
+	}
+	for _, td := range differs {
+		if _, err := exec(fmt.Sprintf(sqlInsertVDiffTable, ct.id, encodeString(td.table))); err != nil {
+			return err
+		}
+	}
+
+	qr, err := exec(fmt.Sprintf(sqlGetVDiffTables, ct.id))
+	if err != nil {
+		return err
+	}
+	progress := make(map[string]sqltypes.RowNamedValues)
+	for _, row := range qr.Named().Rows {
+		progress[row.AsString("table_name", "")] = row
+	}
+	for _, td := range differs {
+		row := progress[td.table]
+		if row.AsString("state", "") == StateCompleted {
+			continue
+		}
+		if err := td.loadProgress(row); err != nil {
+			return err
+		}
+		if err := td.run(ctx, exec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadStreams returns the streams of the workflow on the target shard.
+func (ct *controller) loadStreams(exec func(string) (*sqltypes.Result, error)) ([]*stream, error) {
+	qr, err := exec(fmt.Sprintf(sqlGetStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	var streams []*stream
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		source, err := row[1].ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		bls := &binlogdatapb.BinlogSource{}
+		if err := prototext.Unmarshal(source, bls); err != nil {
+			return nil, err
+		}
+		if bls.ExternalCluster != "" {
+			return nil, fmt.Errorf("workflow %s streams from the external cluster %s, which is not supported", ct.workflow, bls.ExternalCluster)
+		}
+		streams = append(streams, &stream{id: id, bls: bls})
+	}
+	if len(streams) == 0 {
+		return nil, fmt.Errorf("no streams found for workflow %s on shard %s/%s", ct.workflow, ct.vde.tablet.Keyspace, ct.vde.tablet.Shard)
+	}
+	return streams, nil
+}
+
+// buildTableDiffers builds the differs of the tables of the workflow, or
+// of the tables of the options, sorted by name.
+func (ct *controller) buildTableDiffers(ctx context.Context, streams []*stream) ([]*tableDiffer, error) {
+	var tables []string
+	if ct.options.Tables != "" {
+		tables = strings.Split(ct.options.Tables, ",")
+	}
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, tables, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	var differs []*tableDiffer
+	for _, table := range schm.TableDefinitions {
+		rule, err := vreplication.MatchTable(table.Name, streams[0].bls.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		td, err := newTableDiffer(ct, table, rule, streams)
+		if err != nil {
+			return nil, err
+		}
+		differs = append(differs, td)
+	}
+	if len(tables) > 0 && len(tables) != len(differs) {
+		return nil, fmt.Errorf("one or more tables are not present in workflow %s: %s", ct.workflow, ct.options.Tables)
+	}
+	sort.Slice(differs, func(i, j int) bool {
+		return differs[i].table < differs[j].table
+	})
+	return differs, nil
+}
+
+// pickSourceTablet picks the tablet of the source shard of a stream to read
+// the rows from.
+func (ct *controller) pickSourceTablet(ctx context.Context, bls *binlogdatapb.BinlogSource) (*topodatapb.Tablet, error) {
+	cells := []string{ct.options.SourceCell}
+	if ct.options.SourceCell == "" {
+		var err error
+		cells, err = ct.vde.ts.GetKnownCells(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tp, err := discovery.NewTabletPicker(ct.vde.ts, cells, bls.Keyspace, bls.Shard, ct.options.tabletTypes())
+	if err != nil {
+		return nil, err
+	}
+	return tp.PickForStreaming(ctx)
+}
+
+// saveProgress saves the progress and the report of a table.
+func (ct *controller) saveProgress(exec func(string) (*sqltypes.Result, error), td *tableDiffer, state string) error {
+	lastpk := "null"
+	if td.lastpk != nil {
+		buf, err := prototext.Marshal(td.lastpk)
+		if err != nil {
+			return err
+		}
+		lastpk = encodeString(string(buf))
+	}
+	report, err := json.Marshal(td.report)
+	if err != nil {
+		return err
+	}
+	mismatch := 0
+	if td.report.HasMismatch() {
+		mismatch = 1
+	}
+	_, err = exec(fmt.Sprintf(sqlUpdateTableReport, encodeString(state), lastpk, td.report.ProcessedRows, mismatch,
+		encodeString(string(report)), ct.id, encodeString(td.table)))
+	return err
+}
+
+// stopStreams stops the streams of the workflow.
+func (ct *controller) stopStreams(streams []*stream) error {
+	_, err := ct.vde.vre.Exec(fmt.Sprintf("update _vt.vreplication set state = '%s', message = 'for vdiff' where id in %s",
+		binlogplayer.BlpStopped, streamIDList(streams)))
+	return err
+}
+
+// syncStream lets a stream of the workflow run until it reaches the position
+// of the snapshot of its source shard.
+func (ct *controller) syncStream(ctx context.Context, st *stream, pos string) error {
+	query := fmt.Sprintf("update _vt.vreplication set state = '%s', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d",
+		binlogplayer.BlpRunning, encodeString(pos), st.id)
+	if _, err := ct.vde.vre.Exec(query); err != nil {
+		return err
+	}
+	return ct.vde.vre.WaitForPos(ctx, int(st.id), pos)
+}
+
+// restartStreams restarts the streams of the workflow once a chunk is
+// compared.
+func (ct *controller) restartStreams(streams []*stream) {
+	query := fmt.Sprintf("update _vt.vreplication set state = '%s', message = '', stop_pos = '' where id in %s",
+		binlogplayer.BlpRunning, streamIDList(streams))
+	if _, err := ct.vde.vre.Exec(query); err != nil {
+		log.Errorf("VDiff %s: could not restart the streams of workflow %s: %v", ct.uuid, ct.workflow, err)
+	}
+}
+
+func streamIDList(streams []*stream) string {
+	ids := make([]int64, 0, len(streams))
+	for _, st := range streams {
+		ids = append(ids, st.id)
+	}
+	return idList(ids)
+}
+
+// lastPKResult returns the primary key values of a row, as passed to
+// VStreamRows to resume after the row.
+func lastPKResult(fields []*querypb.Field, row []sqltypes.Value) *querypb.QueryResult {
+	return sqltypes.ResultToProto3(&sqltypes.Result{
+		Fields: fields,
+		Rows:   [][]sqltypes.Value{row},
+	})
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vdiff runs the diffs of the vreplication workflows on the primaries
+// of their target shards. Each primary compares the rows of its shard with
+// the rows of its key range in the source shards, in chunks of rows, and
+// saves its progress and its report in the _vt.vdiff and _vt.vdiff_table
+// tables, so that a diff resumes where it was when it's interrupted, and its
+// report can be read later. The diffs are created, stopped, resumed and
+// deleted through VExec.
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const throttlerAppName = "vdiff"
+
+// resumeRetryInterval is the time to wait before retrying to resume the
+// pending diffs when the engine opens.
+var resumeRetryInterval = 5 * time.Second
+
+// vrEngine is the part of the vreplication engine used to stop the streams
+// of a workflow and to let them catch up with the source snapshots.
+type vrEngine interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the diffs of the workflows whose target shard is the shard of
+// the tablet, while it's a primary.
+type Engine struct {
+	ts              *topo.Server
+	tablet          *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	vre             vrEngine
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+	throttlerClient *throttle.Client
+
+	mu     sync.Mutex
+	isOpen bool
+	// ctx is the context of the running diffs, canceled when the engine closes.
+	ctx         context.Context
+	cancel      context.CancelFunc
+	controllers map[int64]*controller
+}
+
+// NewEngine creates a new Engine.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine, lagThrottler *throttle.Throttler) *Engine {
+	return &Engine{
+		ts:              ts,
+		tablet:          tablet,
+		mysqld:          mysqld,
+		vre:             vre,
+		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		controllers:     make(map[int64]*controller),
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initialized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the engine, and resumes the diffs that were pending or running
+// when it was closed, possibly on another primary of the shard.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+	go vde.resume(vde.ctx)
+}
+
+// resume starts the diffs that are pending or running, retrying until it
+// succeeds or the engine closes.
+func (vde *Engine) resume(ctx context.Context) {
+	for {
+		err := vde.resumeOnce(ctx)
+		if err == nil {
+			return
+		}
+		log.Errorf("VDiff Engine: could not resume the diffs, will retry: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resumeRetryInterval):
+		}
+	}
+}
+
+func (vde *Engine) resumeOnce(ctx context.Context) error {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf(sqlGetVDiffsToResume, encodeString(vde.dbName)), dbClient.ExecuteFetch, dbClient.ExecuteFetch)
+	if err != nil {
+		return err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen || ctx.Err() != nil {
+		return nil
+	}
+	for _, row := range qr.Named().Rows {
+		if err := vde.startControllerLocked(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if the engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops the running diffs, which keep their state and resume when
+// the engine opens again.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return
+	}
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// startControllerLocked starts the diff of a row of _vt.vdiff, stopping the
+// earlier run of the same diff if there is one.
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) error {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return err
+	}
+	options := &Options{}
+	if err := json.Unmarshal(row.AsBytes("options", nil), options); err != nil {
+		return vterrors.Wrapf(err, "invalid options of vdiff %s", row.AsString("vdiff_uuid", ""))
+	}
+	if ct := vde.controllers[id]; ct != nil {
+		ct.Stop()
+	}
+	vde.controllers[id] = newController(vde.ctx, vde, id, row.AsString("vdiff_uuid", ""), row.AsString("workflow", ""), options)
+	return nil
+}
+
+// stopControllersLocked stops the diffs of the given ids, if they are running.
+func (vde *Engine) stopControllersLocked(ids []int64) {
+	for _, id := range ids {
+		if ct := vde.controllers[id]; ct != nil {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+	}
+}
+
+// VExec runs a VExec query on _vt.vdiff or _vt.vdiff_table. The query
+// creates a diff with an insert, stops or resumes diffs by updating their
+// state to stopped or pending, deletes diffs along with their reports, or
+// selects their progress and reports.
+func (vde *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	exec := func(query string) (*sqltypes.Result, error) {
+		return withDDL.Exec(ctx, query, dbClient.ExecuteFetch, dbClient.ExecuteFetch)
+	}
+	response := func(qr *sqltypes.Result, err error) (*querypb.QueryResult, error) {
+		if err != nil {
+			return nil, err
+		}
+		return sqltypes.ResultToProto3(qr), nil
+	}
+
+	if _, ok := vx.Stmt.(*sqlparser.Select); ok {
+		return response(exec(vx.Query))
+	}
+	if vx.TableName != TableName {
+		return nil, fmt.Errorf("only SELECT statements are supported on %s. query=%s", vx.TableName, vx.Query)
+	}
+
+	switch stmt := vx.Stmt.(type) {
+	case *sqlparser.Insert:
+		return response(vde.create(vx, exec))
+	case *sqlparser.Update:
+		state, err := vx.ColumnStringVal(vx.UpdateCols, "state")
+		if err != nil || len(stmt.Exprs) != 1 {
+			return nil, fmt.Errorf("only the state of a vdiff can be updated. query=%s", vx.Query)
+		}
+		var fromStates string
+		switch state {
+		case StateStopped:
+			fromStates = "'pending', 'started'"
+		case StatePending:
+			fromStates = "'stopped', 'error'"
+		default:
+			return nil, fmt.Errorf("the state of a vdiff can only be set to %s or %s. query=%s", StateStopped, StatePending, vx.Query)
+		}
+		ids, err := selectIDs(exec, stmt.Where, fromStates)
+		if err != nil || len(ids) == 0 {
+			return response(&sqltypes.Result{}, err)
+		}
+		vde.stopControllersLocked(ids)
+		qr, err := exec(fmt.Sprintf("update _vt.vdiff set state = %s, last_error = '' where id in %s", encodeString(state), idList(ids)))
+		if err != nil {
+			return nil, err
+		}
+		if state == StatePending {
+			for _, id := range ids {
+				vdiff, err := exec(fmt.Sprintf(sqlGetVDiffByID, id))
+				if err != nil {
+					return nil, err
+				}
+				for _, row := range vdiff.Named().Rows {
+					if err := vde.startControllerLocked(row); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		return response(qr, nil)
+	case *sqlparser.Delete:
+		ids, err := selectIDs(exec, stmt.Where, "")
+		if err != nil || len(ids) == 0 {
+			return response(&sqltypes.Result{}, err)
+		}
+		vde.stopControllersLocked(ids)
+		if _, err := exec(fmt.Sprintf(sqlDeleteVDiffTables, idList(ids))); err != nil {
+			return nil, err
+		}
+		return response(exec(fmt.Sprintf(sqlDeleteVDiffs, idList(ids))))
+	default:
+		return nil, fmt.Errorf("query not supported by vdiff: %s", vx.Query)
+	}
+}
+
+// create inserts a new diff, filling in the keyspace, the shard and the
+// database of the tablet, and starts it.
+func (vde *Engine) create(vx *vexec.TabletVExec, exec func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	uuid, err := vx.ColumnStringVal(vx.InsertCols, "vdiff_uuid")
+	if err != nil {
+		return nil, err
+	}
+	options, _ := vx.ColumnStringVal(vx.InsertCols, "options")
+	if err := json.Unmarshal([]byte(options), &Options{}); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid options of vdiff %s", uuid)
+	}
+	// The diff is sent to all the primaries of the keyspace, and only the
+	// target shards of the workflow run it.
+	streams, err := exec(fmt.Sprintf(sqlGetStreams, encodeString(vde.dbName), encodeString(vx.Workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(streams.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	for col, val := range map[string]string{
+		"keyspace": vde.tablet.Keyspace,
+		"shard":    vde.tablet.Shard,
+		"db_name":  vde.dbName,
+		"state":    StatePending,
+	} {
+		if err := vx.AddOrReplaceInsertColumnVal(col, vx.ToStringVal(val)); err != nil {
+			return nil, err
+		}
+	}
+	qr, err := exec(vx.Query)
+	if err != nil {
+		return nil, err
+	}
+	vdiff, err := exec(fmt.Sprintf(sqlGetVDiffByID, qr.InsertID))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range vdiff.Named().Rows {
+		if err := vde.startControllerLocked(row); err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
+}
+
+// selectIDs returns the ids of the diffs selected by a where clause, and in
+// one of the given states if any.
+func selectIDs(exec func(string) (*sqltypes.Result, error), where *sqlparser.Where, states string) ([]int64, error) {
+	var conds []string
+	if where != nil {
+		conds = append(conds, fmt.Sprintf("(%s)", sqlparser.String(where.Expr)))
+	}
+	if states != "" {
+		conds = append(conds, fmt.Sprintf("state in (%s)", states))
+	}
+	query := "select id from _vt.vdiff"
+	if len(conds) > 0 {
+		query += " where " + strings.Join(conds, " and ")
+	}
+	qr, err := exec(query)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func idList(ids []int64) string {
+	list := make([]string, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, fmt.Sprintf("%d", id))
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
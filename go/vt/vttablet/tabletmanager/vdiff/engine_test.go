@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestEngine(dbClient *binlogplayer.MockDBClient) *Engine {
+	return &Engine{
+		tablet:          &topodatapb.Tablet{Keyspace: "ks", Shard: "0"},
+		dbClientFactory: func() binlogplayer.DBClient { return dbClient },
+		dbName:          "db",
+		isOpen:          true,
+		controllers:     make(map[int64]*controller),
+	}
+}
+
+func testVExec(t *testing.T, vde *Engine, query string) (*sqltypes.Result, error) {
+	t.Helper()
+	vx := vexec.NewTabletVExec("wf", "ks")
+	require.NoError(t, vx.AnalyzeQuery(context.Background(), query))
+	qr, err := vde.VExec(context.Background(), vx)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+func TestEngineVExec(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	ids := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+
+	// Stop.
+	dbClient.ExpectRequest("select id from _vt.vdiff where (db_name = 'db' and workflow = 'wf' and vdiff_uuid = 'u1') and state in ('pending', 'started')", ids, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped', last_error = '' where id in (1, 2)", &sqltypes.Result{RowsAffected: 2}, nil)
+	qr, err := testVExec(t, vde, "update _vt.vdiff set state = 'stopped' where db_name = 'db' and workflow = 'wf' and vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), qr.RowsAffected)
+	dbClient.Wait()
+
+	// Nothing to resume.
+	dbClient.ExpectRequest("select id from _vt.vdiff where (db_name = 'db' and workflow = 'wf' and vdiff_uuid = 'u1') and state in ('stopped', 'error')", &sqltypes.Result{}, nil)
+	qr, err = testVExec(t, vde, "update _vt.vdiff set state = 'pending' where db_name = 'db' and workflow = 'wf' and vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	// Delete.
+	dbClient.ExpectRequest("select id from _vt.vdiff where (db_name = 'db' and workflow = 'wf')", ids, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where vdiff_id in (1, 2)", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff where id in (1, 2)", &sqltypes.Result{RowsAffected: 2}, nil)
+	qr, err = testVExec(t, vde, "delete from _vt.vdiff where db_name = 'db' and workflow = 'wf'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), qr.RowsAffected)
+	dbClient.Wait()
+
+	// Create on a shard that isn't a target of the workflow.
+	dbClient.ExpectRequest("select id, source from _vt.vreplication where db_name = 'db' and workflow = 'wf'", &sqltypes.Result{}, nil)
+	qr, err = testVExec(t, vde, `insert into _vt.vdiff(vdiff_uuid, workflow, options) values ('u2', 'wf', '{}')`)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	// Select.
+	report := sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name|state", "varchar|varchar"), "t1|completed")
+	dbClient.ExpectRequest("select table_name, state from _vt.vdiff_table where vdiff_id = 1", report, nil)
+	qr, err = testVExec(t, vde, "select table_name, state from _vt.vdiff_table where vdiff_id = 1")
+	require.NoError(t, err)
+	assert.Equal(t, report.Rows, qr.Rows)
+	dbClient.Wait()
+}
+
+func TestEngineVExecErrors(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+
+	testcases := []struct {
+		query string
+		err   string
+	}{{
+		query: "delete from _vt.vdiff_table where vdiff_id = 1",
+		err:   "only SELECT statements are supported on _vt.vdiff_table. query=delete from _vt.vdiff_table where vdiff_id = 1",
+	}, {
+		query: "update _vt.vdiff set state = 'completed' where vdiff_uuid = 'u1'",
+		err:   "the state of a vdiff can only be set to stopped or pending. query=update _vt.vdiff set state = 'completed' where vdiff_uuid = 'u1'",
+	}, {
+		query: "update _vt.vdiff set options = '{}' where vdiff_uuid = 'u1'",
+		err:   "only the state of a vdiff can be updated. query=update _vt.vdiff set options = '{}' where vdiff_uuid = 'u1'",
+	}, {
+		query: `insert into _vt.vdiff(vdiff_uuid, workflow, options) values ('u1', 'wf', 'x')`,
+		err:   "invalid options of vdiff u1: invalid character 'x' looking for beginning of value",
+	}}
+	for _, tcase := range testcases {
+		_, err := testVExec(t, vde, tcase.query)
+		assert.EqualError(t, err, tcase.err, tcase.query)
+	}
+
+	vde.isOpen = false
+	_, err := testVExec(t, vde, "select * from _vt.vdiff")
+	assert.EqualError(t, err, "vdiff engine is closed")
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// MaxReportSampleRows is the maximum number of sample rows kept for each kind
+// of difference in the report of a table.
+const MaxReportSampleRows = 10
+
+// Options are the options of a vdiff, stored as json in _vt.vdiff.
+type Options struct {
+	// SourceCell is the cell to pick the source tablets from, any cell by default.
+	SourceCell string `json:"source_cell,omitempty"`
+	// TabletTypes are the types of the source tablets.
+	TabletTypes string `json:"tablet_types,omitempty"`
+	// Tables are the tables to diff (comma-separated), all the tables of the
+	// workflow by default.
+	Tables string `json:"tables,omitempty"`
+	// MaxRows is the maximum number of rows to compare for each table.
+	MaxRows int64 `json:"max_rows,omitempty"`
+	// ChunkSize is the number of rows compared in each chunk, between which
+	// the progress is saved and the throttler is checked.
+	ChunkSize int64 `json:"chunk_size,omitempty"`
+	// WaitTimeSeconds is the maximum time to wait for the streams of the
+	// workflow to catch up with the source snapshot of a chunk.
+	WaitTimeSeconds int64 `json:"wait_time_seconds,omitempty"`
+	// OnlyPKs only keeps the primary key values in the sample rows.
+	OnlyPKs bool `json:"only_pks,omitempty"`
+	// DebugQuery adds a query to the sample rows that selects them.
+	DebugQuery bool `json:"debug_query,omitempty"`
+}
+
+const (
+	defaultTabletTypes = "primary,replica,rdonly"
+	defaultChunkSize   = 100000
+	defaultWaitTime    = 30 * time.Second
+)
+
+func (o *Options) tabletTypes() string {
+	if o.TabletTypes == "" {
+		return defaultTabletTypes
+	}
+	return o.TabletTypes
+}
+
+func (o *Options) chunkSize() int64 {
+	if o.ChunkSize <= 0 {
+		return defaultChunkSize
+	}
+	return o.ChunkSize
+}
+
+func (o *Options) waitTime() time.Duration {
+	if o.WaitTimeSeconds <= 0 {
+		return defaultWaitTime
+	}
+	return time.Duration(o.WaitTimeSeconds) * time.Second
+}
+
+// TableReport is the report of the diff of a table, stored as json in
+// _vt.vdiff_table. It covers all the chunks compared so far.
+type TableReport struct {
+	TableName             string
+	ProcessedRows         int64
+	MatchingRows          int64
+	MismatchedRows        int64
+	ExtraRowsSource       int64
+	ExtraRowsTarget       int64
+	MismatchedRowsSample  []*DiffMismatch `json:",omitempty"`
+	ExtraRowsSourceSample []*RowDiff      `json:",omitempty"`
+	ExtraRowsTargetSample []*RowDiff      `json:",omitempty"`
+}
+
+// DiffMismatch is a sample of a row that differs between the source and the
+// target.
+type DiffMismatch struct {
+	Source *RowDiff
+	Target *RowDiff
+}
+
+// RowDiff is a sample row of a difference, with its formatted values.
+type RowDiff struct {
+	Row   map[string]string
+	Query string `json:",omitempty"`
+}
+
+// HasMismatch returns true if the table differs between the source and the
+// target.
+func (tr *TableReport) HasMismatch() bool {
+	return tr.MismatchedRows > 0 || tr.ExtraRowsSource > 0 || tr.ExtraRowsTarget > 0
+}
+
+// Merge adds the counts and the sample rows of another report, of another
+// chunk or of another shard, to the report.
+func (tr *TableReport) Merge(other *TableReport) {
+	if other == nil {
+		return
+	}
+	tr.ProcessedRows += other.ProcessedRows
+	tr.MatchingRows += other.MatchingRows
+	tr.MismatchedRows += other.MismatchedRows
+	tr.ExtraRowsSource += other.ExtraRowsSource
+	tr.ExtraRowsTarget += other.ExtraRowsTarget
+	for _, mismatch := range other.MismatchedRowsSample {
+		if len(tr.MismatchedRowsSample) >= MaxReportSampleRows {
+			break
+		}
+		tr.MismatchedRowsSample = append(tr.MismatchedRowsSample, mismatch)
+	}
+	tr.ExtraRowsSourceSample = appendSample(tr.ExtraRowsSourceSample, other.ExtraRowsSourceSample)
+	tr.ExtraRowsTargetSample = appendSample(tr.ExtraRowsTargetSample, other.ExtraRowsTargetSample)
+}
+
+func appendSample(sample, rows []*RowDiff) []*RowDiff {
+	for _, row := range rows {
+		if len(sample) >= MaxReportSampleRows {
+			break
+		}
+		sample = append(sample, row)
+	}
+	return sample
+}
+
+// formatValue formats a value of a sample row, with its type.
+func formatValue(val sqltypes.Value) string {
+	if val.Type() == sqltypes.Null {
+		return "null (NULL_TYPE)"
+	}
+	if val.IsQuoted() || val.Type() == sqltypes.Bit {
+		if len(val.Raw()) >= 20 {
+			rawBytes := append([]byte{}, val.Raw()[:20]...)
+			rawBytes = append(rawBytes, []byte("...[TRUNCATED]")...)
+			return fmt.Sprintf("%q (%v)", rawBytes, val.Type())
+		}
+		return fmt.Sprintf("%q (%v)", val.Raw(), val.Type())
+	}
+	return fmt.Sprintf("%s (%v)", val.Raw(), val.Type())
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestTableReportMerge(t *testing.T) {
+	sample := func(n int) []*RowDiff {
+		var rows []*RowDiff
+		for i := 0; i < n; i++ {
+			rows = append(rows, &RowDiff{Row: map[string]string{"id": fmt.Sprintf("%d", i)}})
+		}
+		return rows
+	}
+	tr := &TableReport{TableName: "t1"}
+	assert.False(t, tr.HasMismatch())
+	tr.Merge(nil)
+	tr.Merge(&TableReport{
+		TableName:             "t1",
+		ProcessedRows:         10,
+		MatchingRows:          7,
+		MismatchedRows:        1,
+		ExtraRowsSource:       2,
+		MismatchedRowsSample:  []*DiffMismatch{{Source: sample(1)[0], Target: sample(1)[0]}},
+		ExtraRowsSourceSample: sample(2),
+	})
+	tr.Merge(&TableReport{
+		TableName:             "t1",
+		ProcessedRows:         20,
+		MatchingRows:          8,
+		ExtraRowsSource:       12,
+		ExtraRowsSourceSample: sample(12),
+	})
+	assert.True(t, tr.HasMismatch())
+	assert.Equal(t, int64(30), tr.ProcessedRows)
+	assert.Equal(t, int64(15), tr.MatchingRows)
+	assert.Equal(t, int64(1), tr.MismatchedRows)
+	assert.Equal(t, int64(14), tr.ExtraRowsSource)
+	assert.Equal(t, int64(0), tr.ExtraRowsTarget)
+	assert.Len(t, tr.MismatchedRowsSample, 1)
+	assert.Len(t, tr.ExtraRowsSourceSample, MaxReportSampleRows)
+	assert.Len(t, tr.ExtraRowsTargetSample, 0)
+}
+
+func TestFormatValue(t *testing.T) {
+	testcases := []struct {
+		val  sqltypes.Value
+		want string
+	}{{
+		val:  sqltypes.NULL,
+		want: "null (NULL_TYPE)",
+	}, {
+		val:  sqltypes.NewInt64(1),
+		want: "1 (INT64)",
+	}, {
+		val:  sqltypes.NewVarChar("abc"),
+		want: `"abc" (VARCHAR)`,
+	}, {
+		val:  sqltypes.NewVarChar("abcdefghijklmnopqrstuvwxyz"),
+		want: `"abcdefghijklmnopqrst...[TRUNCATED]" (VARCHAR)`,
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, formatValue(tcase.val))
+	}
+
+	// Truncating must not overwrite the bytes of the value.
+	val := sqltypes.NewVarChar("abcdefghijklmnopqrstuvwxyz")
+	formatValue(val)
+	assert.Equal(t, "abcdefghijklmnopqrstuvwxyz", val.ToString())
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"vitess.io/vitess/go/vt/withddl"
+)
+
+const (
+	// TableName is the table of the vdiffs, one row per vdiff and shard.
+	TableName = "_vt.vdiff"
+	// TablesTableName is the table of the progress and the reports of the
+	// tables of the vdiffs.
+	TablesTableName = "_vt.vdiff_table"
+)
+
+// The states of a vdiff, in _vt.vdiff.state.
+const (
+	StatePending   = "pending"
+	StateStarted   = "started"
+	StateStopped   = "stopped"
+	StateCompleted = "completed"
+	StateError     = "error"
+)
+
+const (
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) not null auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1024) not null,
+  keyspace varbinary(256) not null,
+  shard varchar(255) not null,
+  db_name varbinary(1024) not null,
+  state varbinary(64) not null,
+  options json not null,
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(512) not null default '',
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name(64), workflow(64))
+) engine=InnoDB`
+	sqlCreateVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  rows_compared bigint(20) not null default 0,
+  mismatch tinyint(1) not null default 0,
+  report json,
+  created_at timestamp not null default current_timestamp,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name)
+) engine=InnoDB`
+
+	sqlGetVDiffByID      = "select id, vdiff_uuid, workflow, options from _vt.vdiff where id = %d"
+	sqlGetVDiffsToResume = "select id, vdiff_uuid, workflow, options from _vt.vdiff where db_name = %s and state in ('pending', 'started')"
+	sqlStartVDiff        = "update _vt.vdiff set state = 'started', started_at = ifnull(started_at, now()), completed_at = null, last_error = '' where id = %d"
+	sqlCompleteVDiff     = "update _vt.vdiff set state = 'completed', completed_at = now() where id = %d"
+	sqlFailVDiff         = "update _vt.vdiff set state = 'error', last_error = %s where id = %d"
+	sqlDeleteVDiffTables = "delete from _vt.vdiff_table where vdiff_id in %s"
+	sqlDeleteVDiffs      = "delete from _vt.vdiff where id in %s"
+
+	sqlGetStreams = "select id, source from _vt.vreplication where db_name = %s and workflow = %s"
+
+	sqlInsertVDiffTable  = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state) values (%d, %s, 'pending')"
+	sqlGetVDiffTables    = "select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id = %d"
+	sqlUpdateTableReport = "update _vt.vdiff_table set state = %s, lastpk = %s, rows_compared = %d, mismatch = %d, report = %s where vdiff_id = %d and table_name = %s"
+)
+
+var withDDL = withddl.New([]string{
+	"create database if not exists _vt",
+	sqlCreateVDiffTable,
+	sqlCreateVDiffTableTable,
+})
@@ -0,0 +1,638 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// compareColInfo contains the metadata for a column of the table being diffed.
+type compareColInfo struct {
+	colIndex  int           // index of the column in the select
+	collation collations.ID // collation of the column, binary if it has none
+	isPK      bool          // is this column part of the primary key
+}
+
+// tableDiffer compares the rows of a table of the target shard with the rows
+// of its key range in the source shards, in chunks ordered by primary key.
+type tableDiffer struct {
+	ct      *controller
+	streams []*stream
+	table   string
+
+	// sourceQueries are the queries that select the rows of the key range of
+	// the target shard in the source shard of each stream, by stream id.
+	sourceQueries map[int64]string
+	// targetQuery selects the same columns from the target table.
+	targetQuery string
+	// columns are the names of the selected columns in the target table.
+	columns []string
+
+	compareCols []compareColInfo
+	comparePKs  []compareColInfo
+	// pkCols are the indexes of the primary key columns in the select.
+	pkCols   []int
+	pkFields []*querypb.Field
+
+	// lastpk is the primary key of the last row compared, after which the
+	// next chunk starts.
+	lastpk *querypb.QueryResult
+	report *TableReport
+}
+
+// newTableDiffer builds the differ of a table of the target shard, from the
+// rules of the streams of the workflow that match it.
+func newTableDiffer(ct *controller, table *tabletmanagerdatapb.TableDefinition, rule *binlogdatapb.Rule, streams []*stream) (*tableDiffer, error) {
+	td := &tableDiffer{
+		ct:            ct,
+		streams:       streams,
+		table:         table.Name,
+		sourceQueries: make(map[int64]string),
+		report:        &TableReport{TableName: table.Name},
+	}
+	for _, st := range streams {
+		streamRule := rule
+		if st != streams[0] {
+			var err error
+			if streamRule, err = vreplication.MatchTable(table.Name, st.bls.Filter); err != nil {
+				return nil, err
+			}
+			if streamRule == nil {
+				return nil, fmt.Errorf("table %s is not streamed from shard %s/%s", table.Name, st.bls.Keyspace, st.bls.Shard)
+			}
+		}
+		sourceSelect, columns, err := buildSourceSelect(table, streamRule)
+		if err != nil {
+			return nil, err
+		}
+		if td.columns == nil {
+			td.columns = columns
+		} else if strings.Join(td.columns, ",") != strings.Join(columns, ",") {
+			return nil, fmt.Errorf("the streams of table %s select different columns: %v and %v", table.Name, td.columns, columns)
+		}
+		td.sourceQueries[st.id] = sqlparser.String(sourceSelect)
+	}
+	if err := td.buildPlan(table); err != nil {
+		return nil, err
+	}
+	return td, nil
+}
+
+// buildSourceSelect returns the select of the rows of a rule in the source
+// shard, with its '*' expanded from the columns of the target table, and the
+// names of the columns they go to in the target table.
+func buildSourceSelect(table *tabletmanagerdatapb.TableDefinition, rule *binlogdatapb.Rule) (*sqlparser.Select, []string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	switch {
+	case rule.Filter == "":
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+	case key.IsKeyRange(rule.Filter):
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table.Name), sqlparser.NewStrLiteral(rule.Filter))
+	default:
+		buf.WriteString(rule.Filter)
+	}
+	statement, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if len(sel.GroupBy) > 0 {
+		return nil, nil, fmt.Errorf("table %s is aggregated, which is not supported: %v", table.Name, sqlparser.String(sel))
+	}
+	sourceSelect := &sqlparser.Select{
+		From:  sel.From,
+		Where: sel.Where,
+	}
+	var columns []string
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			for _, fld := range table.Fields {
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}})
+				columns = append(columns, fld.Name)
+			}
+		case *sqlparser.AliasedExpr:
+			switch {
+			case !selExpr.As.IsEmpty():
+				columns = append(columns, selExpr.As.String())
+			case isColName(selExpr.Expr):
+				columns = append(columns, selExpr.Expr.(*sqlparser.ColName).Name.String())
+			default:
+				return nil, nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+			}
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+		default:
+			return nil, nil, fmt.Errorf("unexpected: %v", sqlparser.String(sel))
+		}
+	}
+	return sourceSelect, columns, nil
+}
+
+func isColName(expr sqlparser.Expr) bool {
+	_, ok := expr.(*sqlparser.ColName)
+	return ok
+}
+
+// buildPlan builds the target query and finds the columns to compare.
+func (td *tableDiffer) buildPlan(table *tabletmanagerdatapb.TableDefinition) error {
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+	targetSelect := &sqlparser.Select{
+		From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: &sqlparser.TableName{Name: sqlparser.NewTableIdent(table.Name)}}},
+	}
+	td.compareCols = make([]compareColInfo, len(td.columns))
+	for i, column := range td.columns {
+		field, ok := fields[strings.ToLower(column)]
+		if !ok {
+			return fmt.Errorf("column %v not found in table %v", column, table.Name)
+		}
+		targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(column)}})
+		td.compareCols[i] = compareColInfo{colIndex: i, collation: fieldCollation(field)}
+	}
+	td.targetQuery = sqlparser.String(targetSelect)
+
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, column := range td.columns {
+			if strings.EqualFold(pk, column) {
+				td.compareCols[i].isPK = true
+				td.comparePKs = append(td.comparePKs, td.compareCols[i])
+				td.pkCols = append(td.pkCols, i)
+				td.pkFields = append(td.pkFields, &querypb.Field{Name: column, Type: fields[strings.ToLower(column)].Type})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("primary key column %v of table %v is not selected by the workflow", pk, table.Name)
+		}
+	}
+	if len(td.pkCols) == 0 {
+		return fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	return nil
+}
+
+// fieldCollation returns the collation the rows of a column are compared
+// with, which is binary unless it's a text column with a known collation.
+func fieldCollation(field *querypb.Field) collations.ID {
+	if sqltypes.IsText(field.Type) && collations.Local().LookupByID(collations.ID(field.Charset)) != nil {
+		return collations.ID(field.Charset)
+	}
+	return collations.CollationBinaryID
+}
+
+// loadProgress loads the primary key after which the table resumes, and the
+// report of the rows compared so far.
+func (td *tableDiffer) loadProgress(row sqltypes.RowNamedValues) error {
+	if lastpk := row.AsBytes("lastpk", nil); len(lastpk) > 0 {
+		td.lastpk = &querypb.QueryResult{}
+		if err := prototext.Unmarshal(lastpk, td.lastpk); err != nil {
+			return err
+		}
+	}
+	if report := row.AsBytes("report", nil); len(report) > 0 {
+		if err := json.Unmarshal(report, td.report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run compares the table chunk by chunk, saving its progress after each
+// chunk, and waits for the throttler before each chunk.
+func (td *tableDiffer) run(ctx context.Context, exec func(string) (*sqltypes.Result, error)) error {
+	log.Infof("VDiff %s: comparing table %s from %d rows", td.ct.uuid, td.table, td.report.ProcessedRows)
+	for {
+		td.ct.vde.throttlerClient.Throttle(ctx)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		limit := td.ct.options.chunkSize()
+		if maxRows := td.ct.options.MaxRows; maxRows > 0 && maxRows-td.report.ProcessedRows < limit {
+			limit = maxRows - td.report.ProcessedRows
+		}
+		done := limit <= 0
+		if !done {
+			var err error
+			if done, err = td.diffChunk(ctx, limit); err != nil {
+				return err
+			}
+		}
+		state := StateStarted
+		if done {
+			state = StateCompleted
+		}
+		if err := td.ct.saveProgress(exec, td, state); err != nil {
+			return err
+		}
+		if done {
+			log.Infof("VDiff %s: compared table %s: %d rows", td.ct.uuid, td.table, td.report.ProcessedRows)
+			return nil
+		}
+	}
+}
+
+// diffChunk compares up to limit rows of the table after lastpk. It stops the
+// streams of the workflow, takes a snapshot of each source shard, lets each
+// stream catch up with its snapshot, and compares the rows of the snapshots
+// with the rows of the target shard. It returns true if all the rows of the
+// table were compared.
+func (td *tableDiffer) diffChunk(ctx context.Context, limit int64) (bool, error) {
+	ct := td.ct
+	if err := ct.stopStreams(td.streams); err != nil {
+		return false, err
+	}
+	defer ct.restartStreams(td.streams)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sources := make([]*shardStreamer, 0, len(td.streams))
+	for _, st := range td.streams {
+		tablet, err := ct.pickSourceTablet(ctx, st.bls)
+		if err != nil {
+			return false, err
+		}
+		source := newShardStreamer()
+		go source.stream(ctx, tablet, td.sourceQueries[st.id], td.lastpk)
+		sources = append(sources, source)
+	}
+	waitCtx, cancelWait := context.WithTimeout(ctx, ct.options.waitTime())
+	defer cancelWait()
+	for i, st := range td.streams {
+		pos, err := sources[i].snapshotPosition(waitCtx)
+		if err != nil {
+			return false, vterrors.Wrapf(err, "snapshot of shard %s/%s", st.bls.Keyspace, st.bls.Shard)
+		}
+		if err := ct.syncStream(waitCtx, st, pos); err != nil {
+			return false, vterrors.Wrapf(err, "stream %d did not catch up with shard %s/%s", st.id, st.bls.Keyspace, st.bls.Shard)
+		}
+	}
+
+	ti, err := ct.vde.ts.GetTablet(ctx, ct.vde.tablet.Alias)
+	if err != nil {
+		return false, err
+	}
+	target := newShardStreamer()
+	go target.stream(ctx, ti.Tablet, td.targetQuery, td.lastpk)
+
+	report, lastRow, done, err := td.compare(
+		newPrimitiveExecutor(ctx, td.newMergeSorter(sources)),
+		newPrimitiveExecutor(ctx, td.newMergeSorter([]*shardStreamer{target})),
+		limit)
+	if err != nil {
+		return false, err
+	}
+	td.report.Merge(report)
+	if lastRow != nil {
+		pk := make([]sqltypes.Value, 0, len(td.pkCols))
+		for _, i := range td.pkCols {
+			pk = append(pk, lastRow[i])
+		}
+		td.lastpk = lastPKResult(td.pkFields, pk)
+	}
+	return done, nil
+}
+
+// compare compares the rows of the source and of the target, both ordered by
+// primary key, until both are exhausted or limit rows are processed. It
+// returns the report of the compared rows, the last of them, and true if
+// both are exhausted.
+func (td *tableDiffer) compare(source, target *primitiveExecutor, limit int64) (*TableReport, []sqltypes.Value, bool, error) {
+	dr := &TableReport{TableName: td.table}
+	var sourceRow, targetRow, lastRow []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	for dr.ProcessedRows < limit {
+		if advanceSource {
+			if sourceRow, err = source.next(); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = target.next(); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			return dr, lastRow, true, nil
+		}
+		advanceSource = true
+		advanceTarget = true
+		dr.ProcessedRows++
+
+		// A missing row sorts after all the rows of the other side.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = td.compareRows(sourceRow, targetRow, td.comparePKs, false); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		switch {
+		case c < 0:
+			dr.ExtraRowsSource++
+			dr.ExtraRowsSourceSample = appendSample(dr.ExtraRowsSourceSample, []*RowDiff{td.genRowDiff(sourceRow)})
+			advanceTarget = false
+			lastRow = sourceRow
+			continue
+		case c > 0:
+			dr.ExtraRowsTarget++
+			dr.ExtraRowsTargetSample = appendSample(dr.ExtraRowsTargetSample, []*RowDiff{td.genRowDiff(targetRow)})
+			advanceSource = false
+			lastRow = targetRow
+			continue
+		}
+
+		// The primary keys are equal, compare the other columns.
+		lastRow = targetRow
+		c, err = td.compareRows(sourceRow, targetRow, td.compareCols, true)
+		switch {
+		case err != nil:
+			return nil, nil, false, err
+		case c != 0:
+			if dr.MismatchedRows < MaxReportSampleRows {
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{Source: td.genRowDiff(sourceRow), Target: td.genRowDiff(targetRow)})
+			}
+			dr.MismatchedRows++
+		default:
+			dr.MatchingRows++
+		}
+	}
+	return dr, lastRow, false, nil
+}
+
+func (td *tableDiffer) compareRows(sourceRow, targetRow []sqltypes.Value, cols []compareColInfo, compareOnlyNonPKs bool) (int, error) {
+	for _, col := range cols {
+		if col.isPK && compareOnlyNonPKs {
+			continue
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[col.colIndex], targetRow[col.colIndex], col.collation)
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// genRowDiff returns the sample of a row for the report.
+func (td *tableDiffer) genRowDiff(row []sqltypes.Value) *RowDiff {
+	rd := &RowDiff{Row: make(map[string]string)}
+	if td.ct.options.OnlyPKs {
+		for _, i := range td.pkCols {
+			rd.Row[td.columns[i]] = formatValue(row[i])
+		}
+	} else {
+		for i, column := range td.columns {
+			rd.Row[column] = formatValue(row[i])
+		}
+	}
+	if td.ct.options.DebugQuery {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select ")
+		for i, column := range td.columns {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", sqlparser.NewColIdent(column))
+		}
+		buf.Myprintf(" from %v where ", sqlparser.NewTableIdent(td.table))
+		for i, pk := range td.pkCols {
+			if i > 0 {
+				buf.Myprintf(" and ")
+			}
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(td.columns[pk]))
+			row[pk].EncodeSQL(buf)
+		}
+		rd.Query = buf.String()
+	}
+	return rd
+}
+
+// newMergeSorter creates an engine.MergeSort that merges the rows of shard
+// streamers by primary key.
+func (td *tableDiffer) newMergeSorter(participants []*shardStreamer) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderByParams, 0, len(td.comparePKs))
+	for _, cpk := range td.comparePKs {
+		ob = append(ob, engine.OrderByParams{Col: cpk.colIndex, WeightStringCol: -1, CollationID: cpk.collation})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams the rows of a table from a tablet, with VStreamRows.
+// It satisfies engine.StreamExecutor, and can be added to the Primitives of
+// an engine.MergeSort.
+type shardStreamer struct {
+	// gtidch receives the position of the snapshot the rows are read from.
+	gtidch chan string
+	result chan *sqltypes.Result
+	err    error
+}
+
+func newShardStreamer() *shardStreamer {
+	return &shardStreamer{
+		gtidch: make(chan string, 1),
+		result: make(chan *sqltypes.Result, 1),
+	}
+}
+
+// stream streams the rows of a query after lastpk, ordered by primary key.
+func (sm *shardStreamer) stream(ctx context.Context, tablet *topodatapb.Tablet, query string, lastpk *querypb.QueryResult) {
+	defer close(sm.result)
+	defer close(sm.gtidch)
+
+	// The error is set before the channels are closed.
+	sm.err = func() error {
+		conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		target := &querypb.Target{
+			Keyspace:   tablet.Keyspace,
+			Shard:      tablet.Shard,
+			TabletType: tablet.Type,
+		}
+		var fields []*querypb.Field
+		return conn.VStreamRows(ctx, target, query, lastpk, func(vrs *binlogdatapb.VStreamRowsResponse) error {
+			if vrs.Fields != nil {
+				fields = vrs.Fields
+				sm.gtidch <- vrs.Gtid
+			} else if len(vrs.Rows) == 0 {
+				return nil
+			}
+			result := sqltypes.Proto3ToResult(&querypb.QueryResult{
+				Fields: fields,
+				Rows:   vrs.Rows,
+			})
+			// Fields should be received only once, and sent only once.
+			if vrs.Fields == nil {
+				result.Fields = nil
+			}
+			select {
+			case sm.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamRows")
+			}
+			return nil
+		})
+	}()
+}
+
+// snapshotPosition waits for the position of the snapshot of the stream.
+func (sm *shardStreamer) snapshotPosition(ctx context.Context) (string, error) {
+	select {
+	case pos, ok := <-sm.gtidch:
+		if !ok {
+			if sm.err != nil {
+				return "", sm.err
+			}
+			return "", fmt.Errorf("the stream ended without a snapshot position")
+		}
+		return pos, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// StreamExecute implements engine.StreamExecutor.
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = vcursor.StreamExecutePrimitive(pe.prim, make(map[string]*querypb.BindVariable), true, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) ConnCollation() collations.ID {
+	return collations.CollationBinaryID
+}
+
+func (vc *contextVCursor) ExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return primitive.TryExecute(vc, bindVars, wantfields)
+}
+
+func (vc *contextVCursor) StreamExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return primitive.TryStreamExecute(vc, bindVars, wantfields, callback)
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
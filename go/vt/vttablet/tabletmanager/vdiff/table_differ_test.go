@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testTable = &tabletmanagerdatapb.TableDefinition{
+	Name:              "t1",
+	Columns:           []string{"c1", "c2"},
+	PrimaryKeyColumns: []string{"c1"},
+	Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+}
+
+func newTestController(options *Options) *controller {
+	return &controller{uuid: "u1", workflow: "wf", options: options}
+}
+
+func TestNewTableDiffer(t *testing.T) {
+	testcases := []struct {
+		filter  string
+		source  string
+		target  string
+		columns []string
+		err     string
+	}{{
+		filter:  "",
+		source:  "select c1, c2 from t1",
+		target:  "select c1, c2 from t1",
+		columns: []string{"c1", "c2"},
+	}, {
+		filter:  "-80",
+		source:  "select c1, c2 from t1 where in_keyrange('-80')",
+		target:  "select c1, c2 from t1",
+		columns: []string{"c1", "c2"},
+	}, {
+		filter:  "select * from t1 where in_keyrange(c1, 'hash', '80-')",
+		source:  "select c1, c2 from t1 where in_keyrange(c1, 'hash', '80-')",
+		target:  "select c1, c2 from t1",
+		columns: []string{"c1", "c2"},
+	}, {
+		filter:  "select c2 as c1, c1 as c2 from t1",
+		source:  "select c2 as c1, c1 as c2 from t1",
+		target:  "select c1, c2 from t1",
+		columns: []string{"c1", "c2"},
+	}, {
+		filter: "select c1, c2 + 1 from t1",
+		err:    "expression needs an alias: c2 + 1",
+	}, {
+		filter: "select c1, count(*) as c2 from t1 group by c1",
+		err:    "table t1 is aggregated, which is not supported: select c1, count(*) as c2 from t1 group by c1",
+	}, {
+		filter: "select c2 from t1",
+		err:    "primary key column c1 of table t1 is not selected by the workflow",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			streams := []*stream{{id: 1, bls: &binlogdatapb.BinlogSource{Keyspace: "ks", Shard: "0"}}}
+			rule := &binlogdatapb.Rule{Match: "t1", Filter: tcase.filter}
+			td, err := newTableDiffer(newTestController(&Options{}), testTable, rule, streams)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.source, td.sourceQueries[1])
+			assert.Equal(t, tcase.target, td.targetQuery)
+			assert.Equal(t, tcase.columns, td.columns)
+			assert.Equal(t, []int{0}, td.pkCols)
+		})
+	}
+}
+
+func TestTableDifferCompare(t *testing.T) {
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	sourceResults := [][]*sqltypes.Result{
+		sqltypes.MakeTestStreamingResults(fields, "1|1", "3|3", "---", "5|5"),
+		sqltypes.MakeTestStreamingResults(fields, "2|2", "4|4", "6|6"),
+	}
+	targetResults := sqltypes.MakeTestStreamingResults(fields, "1|1", "2|2", "3|4", "5|5", "6|6", "7|7")
+
+	testcases := []struct {
+		name    string
+		options *Options
+		limit   int64
+		want    *TableReport
+		lastRow string
+		done    bool
+	}{{
+		name:    "all",
+		options: &Options{},
+		limit:   100,
+		want: &TableReport{
+			TableName:       "t1",
+			ProcessedRows:   7,
+			MatchingRows:    4,
+			MismatchedRows:  1,
+			ExtraRowsSource: 1,
+			ExtraRowsTarget: 1,
+			MismatchedRowsSample: []*DiffMismatch{{
+				Source: &RowDiff{Row: map[string]string{"c1": "3 (INT64)", "c2": "3 (INT64)"}},
+				Target: &RowDiff{Row: map[string]string{"c1": "3 (INT64)", "c2": "4 (INT64)"}},
+			}},
+			ExtraRowsSourceSample: []*RowDiff{{Row: map[string]string{"c1": "4 (INT64)", "c2": "4 (INT64)"}}},
+			ExtraRowsTargetSample: []*RowDiff{{Row: map[string]string{"c1": "7 (INT64)", "c2": "7 (INT64)"}}},
+		},
+		lastRow: "7",
+		done:    true,
+	}, {
+		name:    "chunk",
+		options: &Options{OnlyPKs: true, DebugQuery: true},
+		limit:   3,
+		want: &TableReport{
+			TableName:      "t1",
+			ProcessedRows:  3,
+			MatchingRows:   2,
+			MismatchedRows: 1,
+			MismatchedRowsSample: []*DiffMismatch{{
+				Source: &RowDiff{Row: map[string]string{"c1": "3 (INT64)"}, Query: "select c1, c2 from t1 where c1 = 3"},
+				Target: &RowDiff{Row: map[string]string{"c1": "3 (INT64)"}, Query: "select c1, c2 from t1 where c1 = 3"},
+			}},
+		},
+		lastRow: "3",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}}}
+			streams := []*stream{{id: 1, bls: &binlogdatapb.BinlogSource{Filter: filter}}, {id: 2, bls: &binlogdatapb.BinlogSource{Filter: filter}}}
+			td, err := newTableDiffer(newTestController(tcase.options), testTable, filter.Rules[0], streams)
+			require.NoError(t, err)
+
+			var sources []*shardStreamer
+			for _, results := range sourceResults {
+				sources = append(sources, newTestShardStreamer(ctx, results))
+			}
+			target := newTestShardStreamer(ctx, targetResults)
+			report, lastRow, done, err := td.compare(
+				newPrimitiveExecutor(ctx, td.newMergeSorter(sources)),
+				newPrimitiveExecutor(ctx, td.newMergeSorter([]*shardStreamer{target})),
+				tcase.limit)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, report)
+			assert.Equal(t, tcase.lastRow, lastRow[0].ToString())
+			assert.Equal(t, tcase.done, done)
+		})
+	}
+}
+
+// newTestShardStreamer returns a shardStreamer that streams the results,
+// as its stream would.
+func newTestShardStreamer(ctx context.Context, results []*sqltypes.Result) *shardStreamer {
+	sm := newShardStreamer()
+	go func() {
+		defer close(sm.result)
+		defer close(sm.gtidch)
+		sm.gtidch <- "pos"
+		for _, result := range results {
+			select {
+			case sm.result <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return sm
+}
@@ -96,6 +96,10 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 	ct.workflow = params["workflow"]
 
 	blpStats.State.Set(params["state"])
+	// The rows copied before the tablet restarted are only in _vt.vreplication.
+	if rowsCopied, err := strconv.ParseInt(params["rows_copied"], 10, 64); err == nil && blpStats.CopyRowCount.Get() == 0 {
+		blpStats.CopyRowCount.Add(rowsCopied)
+	}
 	// Nothing to do if replication is stopped.
 	if params["state"] == binlogplayer.BlpStopped {
 		ct.cancel = func() {}
@@ -148,8 +152,12 @@ func (ct *controller) run(ctx context.Context) {
 		close(ct.done)
 	}()
 
-	for {
-		err := ct.runBlp(ctx)
+	for attempt := 1; ; attempt++ {
+		span, spanCtx := newStreamSpan(ctx, "VReplication.Stream", ct.workflow, ct.id)
+		span.Annotate("attempt", attempt)
+		err := ct.runBlp(spanCtx)
+		annotateError(span, err)
+		span.Finish()
 		if err == nil {
 			return
 		}
@@ -267,7 +275,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		}
 		defer vsClient.Close(ctx)
 
-		vr := newVReplicator(ct.id, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		vr := newVReplicator(ct.id, ct.workflow, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
 		return vr.Replicate(ctx)
 	}
 	ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	// PhaseCopying is the phase of the streams that have tables left to copy.
+	PhaseCopying = "Copying"
+	// PhaseReplicating is the phase of the streams that copied all their tables.
+	PhaseReplicating = "Replicating"
+)
+
+// Progress returns the progress of the streams of the workflow: the rows
+// that they copied, the tables that they have left to copy, their copy
+// throughput and their replication lag. It leaves the estimates of the rows
+// to copy to the callers, which can read the table statistics of the sources.
+func (vre *Engine) Progress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	vre.mu.Lock()
+	if !vre.isOpen {
+		vre.mu.Unlock()
+		return nil, errors.New("vreplication engine is closed")
+	}
+	blpStats := make(map[int32]*binlogplayer.Stats, len(vre.controllers))
+	for id, ct := range vre.controllers {
+		blpStats[int32(id)] = ct.blpStats
+	}
+	vre.mu.Unlock()
+
+	dbClient := vre.dbClientFactoryFiltered()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	query := fmt.Sprintf("select id, source, state, message, rows_copied, transaction_timestamp, time_heartbeat from _vt.vreplication where db_name=%s and workflow=%s order by id",
+		encodeString(vre.dbName), encodeString(workflow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+
+	var streams []*tabletmanagerdatapb.VReplicationStreamProgress
+	var ids []string
+	sources := make(map[int32]*binlogdatapb.BinlogSource)
+	lastEvents := make(map[int32]int64)
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		source := &binlogdatapb.BinlogSource{}
+		if err := prototext.Unmarshal(row.AsBytes("source", nil), source); err != nil {
+			return nil, err
+		}
+		rowsCopied, err := row.ToInt64("rows_copied")
+		if err != nil {
+			return nil, err
+		}
+		// The lag is the time since the last event, or the last heartbeat if
+		// it is more recent, in which case there are no events to replicate.
+		lastEvent, _ := row.ToInt64("transaction_timestamp")
+		if heartbeat, _ := row.ToInt64("time_heartbeat"); heartbeat > lastEvent {
+			lastEvent = heartbeat
+		}
+		stream := &tabletmanagerdatapb.VReplicationStreamProgress{
+			Id:             int32(id),
+			Workflow:       workflow,
+			SourceKeyspace: source.Keyspace,
+			SourceShard:    source.Shard,
+			State:          row.AsString("state", ""),
+			Message:        row.AsString("message", ""),
+			RowsCopied:     rowsCopied,
+		}
+		if stats := blpStats[stream.Id]; stats != nil {
+			if copied := stats.CopyRowCount.Get(); copied > stream.RowsCopied {
+				stream.RowsCopied = copied
+			}
+			stream.RowsPerSecond = stats.CopyRowsPerSecond()
+		}
+		streams = append(streams, stream)
+		ids = append(ids, fmt.Sprintf("%d", id))
+		sources[stream.Id] = source
+		lastEvents[stream.Id] = lastEvent
+	}
+
+	query = fmt.Sprintf("select vrepl_id, table_name from %s where vrepl_id in (%s) order by table_name", copyStateTableName, strings.Join(ids, ", "))
+	qr, err = dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	tablesToCopy := make(map[int32][]string)
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("vrepl_id")
+		if err != nil {
+			return nil, err
+		}
+		tablesToCopy[int32(id)] = append(tablesToCopy[int32(id)], row.AsString("table_name", ""))
+	}
+
+	// The regexp rules, which copy all the tables of a keyspace, match the
+	// tables of the target, which are the same as the ones of the source.
+	var tables []string
+	if hasRegexpRule(sources) {
+		query = fmt.Sprintf("select table_name from information_schema.tables where table_schema=%s", encodeString(vre.dbName))
+		qr, err = dbClient.ExecuteFetch(query, 10000)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range qr.Rows {
+			tables = append(tables, row[0].ToString())
+		}
+	}
+
+	now := time.Now().Unix()
+	for _, stream := range streams {
+		stream.TablesToCopy = tablesToCopy[stream.Id]
+		stream.SourceTables, err = sourceTables(sources[stream.Id].Filter, tables)
+		if err != nil {
+			return nil, err
+		}
+		if len(stream.TablesToCopy) > 0 {
+			stream.Phase = PhaseCopying
+			stream.LagSeconds = -1
+			stream.EtaSeconds = -1
+			continue
+		}
+		stream.Phase = PhaseReplicating
+		stream.LagSeconds = -1
+		if lastEvent := lastEvents[stream.Id]; lastEvent > 0 {
+			stream.LagSeconds = now - lastEvent
+		}
+	}
+	return streams, nil
+}
+
+func hasRegexpRule(sources map[int32]*binlogdatapb.BinlogSource) bool {
+	for _, source := range sources {
+		for _, rule := range source.Filter.GetRules() {
+			if strings.HasPrefix(rule.Match, "/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sourceTables returns the source tables of the rules of the filter. The
+// rules that select from a table copy it, the other ones copy the table that
+// they match.
+func sourceTables(filter *binlogdatapb.Filter, tables []string) ([]string, error) {
+	seen := make(map[string]bool)
+	for _, rule := range filter.GetRules() {
+		if strings.HasPrefix(rule.Match, "/") {
+			expr, err := regexp.Compile(strings.Trim(rule.Match, "/"))
+			if err != nil {
+				return nil, err
+			}
+			for _, table := range tables {
+				if expr.MatchString(table) {
+					seen[table] = true
+				}
+			}
+			continue
+		}
+		table := rule.Match
+		if stmt, err := sqlparser.Parse(rule.Filter); err == nil {
+			if sel, ok := stmt.(*sqlparser.Select); ok && len(sel.From) == 1 {
+				if expr, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+					if name, ok := expr.Expr.(sqlparser.TableName); ok {
+						table = name.Name.String()
+					}
+				}
+			}
+		}
+		seen[table] = true
+	}
+	result := make([]string, 0, len(seen))
+	for table := range seen {
+		result = append(result, table)
+	}
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestProgress(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	mysqld := &fakemysqldaemon.FakeMysqlDaemon{MysqlPort: sync2.NewAtomicInt32(3306)}
+	dbClientFactory := func() binlogplayer.DBClient { return dbClient }
+	vre := NewTestEngine(env.TopoServ, env.Cells[0], mysqld, dbClientFactory, dbClientFactory, dbClient.DBName(), nil)
+
+	_, err := vre.Progress(context.Background(), "wf")
+	assert.EqualError(t, err, "vreplication engine is closed")
+
+	dbClient.ExpectRequest("select * from _vt.vreplication where db_name='db'", &sqltypes.Result{}, nil)
+	vre.Open(context.Background())
+	defer vre.Close()
+
+	dbClient.ExpectRequest("select id, source, state, message, rows_copied, transaction_timestamp, time_heartbeat from _vt.vreplication where db_name='db' and workflow='wf' order by id", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|source|state|message|rows_copied|transaction_timestamp|time_heartbeat",
+			"int64|varchar|varchar|varchar|int64|int64|int64",
+		),
+		`1|keyspace:"ks" shard:"-80" filter:{rules:{match:"t1"} rules:{match:"t2" filter:"select * from t3"}}|Copying||20|0|0`,
+		`2|keyspace:"ks" shard:"80-" filter:{rules:{match:"t1"} rules:{match:"t2" filter:"select * from t3"}}|Running||30|0|0`,
+	), nil)
+	dbClient.ExpectRequest("select vrepl_id, table_name from _vt.copy_state where vrepl_id in (1, 2) order by table_name", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"vrepl_id|table_name",
+			"int64|varchar",
+		),
+		"1|t2",
+	), nil)
+	streams, err := vre.Progress(context.Background(), "wf")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	require.Len(t, streams, 2)
+	assert.EqualValues(t, 1, streams[0].Id)
+	assert.Equal(t, "-80", streams[0].SourceShard)
+	assert.Equal(t, PhaseCopying, streams[0].Phase)
+	assert.Equal(t, []string{"t1", "t3"}, streams[0].SourceTables)
+	assert.Equal(t, []string{"t2"}, streams[0].TablesToCopy)
+	assert.EqualValues(t, 20, streams[0].RowsCopied)
+	assert.EqualValues(t, -1, streams[0].EtaSeconds)
+	assert.EqualValues(t, 2, streams[1].Id)
+	assert.Equal(t, PhaseReplicating, streams[1].Phase)
+	assert.Empty(t, streams[1].TablesToCopy)
+	assert.EqualValues(t, 30, streams[1].RowsCopied)
+	assert.EqualValues(t, -1, streams[1].LagSeconds)
+}
+
+func TestSourceTables(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/t.*",
+		}, {
+			Match:  "u",
+			Filter: "select id from v where in_keyrange('-80')",
+		}, {
+			Match:  "w",
+			Filter: "-80",
+		}},
+	}
+	tables, err := sourceTables(filter, []string{"t1", "t2", "x"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "v", "w"}, tables)
+
+	_, err = sourceTables(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/("}}}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"io"
+
+	"vitess.io/vitess/go/trace"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The spans of a stream are all annotated with its workflow and id, so
+// that the traces of a workflow can be found with a tag search:
+//
+//	VReplication.Stream            one attempt to run the stream
+//	  VCopier.CopyTable            the copy of a table, until the copy phase times out
+//	    VCopier.CopyChunk          the rows of one VStreamRows packet, in one transaction
+//	  VPlayer.ApplyTransaction     one transaction applied to the target
+//
+// Errors are recorded on the span where they happen, and lock wait
+// retries on the span of the transaction that retried.
+
+func newStreamSpan(ctx context.Context, label, workflow string, id uint32) (trace.Span, context.Context) {
+	span, ctx := trace.NewSpan(ctx, label)
+	span.Annotate("workflow", workflow)
+	span.Annotate("vreplication_id", id)
+	return span, ctx
+}
+
+// newSpan starts a span for a unit of work of the stream.
+func (vr *vreplicator) newSpan(ctx context.Context, label string) (trace.Span, context.Context) {
+	return newStreamSpan(ctx, label, vr.workflow, vr.id)
+}
+
+// annotateError records err on the span. io.EOF, which the player uses
+// to stop, is not an error.
+func annotateError(span trace.Span, err error) {
+	if err != nil && err != io.EOF {
+		span.Annotate("error", err.Error())
+	}
+}
+
+// txTracer traces the transactions that the vplayer applies. A span starts
+// with the first event that changes the target, and ends when that change
+// is committed, so that it covers all the source transactions that the
+// vplayer grouped into one.
+type txTracer struct {
+	vr     *vreplicator
+	span   trace.Span
+	ctx    context.Context
+	events int
+}
+
+// apply returns the context in which event must be applied, starting a
+// span if the event is the first change of a transaction.
+func (t *txTracer) apply(ctx context.Context, event *binlogdatapb.VEvent) context.Context {
+	if t.span == nil {
+		switch event.Type {
+		case binlogdatapb.VEventType_GTID, binlogdatapb.VEventType_BEGIN, binlogdatapb.VEventType_COMMIT,
+			binlogdatapb.VEventType_OTHER, binlogdatapb.VEventType_HEARTBEAT, binlogdatapb.VEventType_VERSION:
+			return ctx
+		}
+		t.span, t.ctx = t.vr.newSpan(ctx, "VPlayer.ApplyTransaction")
+		t.events = 0
+	}
+	t.events++
+	return t.ctx
+}
+
+// applied ends the span of the transaction if event committed it.
+func (t *txTracer) applied(event *binlogdatapb.VEvent, position string, lagSeconds int64) {
+	if t.span == nil {
+		return
+	}
+	switch event.Type {
+	case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_JOURNAL:
+	default:
+		return
+	}
+	t.span.Annotate("events", t.events)
+	t.span.Annotate("position", position)
+	t.span.Annotate("lag_seconds", lagSeconds)
+	t.finish(nil)
+}
+
+// finish ends the span of the current transaction, if any.
+func (t *txTracer) finish(err error) {
+	if t.span == nil {
+		return
+	}
+	annotateError(t.span, err)
+	t.span.Finish()
+	t.span, t.ctx = nil, nil
+}
+
+// annotateRetry records a retry of a statement after a lock error on the
+// span of ctx.
+func annotateRetry(ctx context.Context, retries int, err error) {
+	if span, ok := trace.FromContext(ctx); ok {
+		span.Annotate("lock_retries", retries)
+		span.Annotate("lock_retry_error", err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestTxTracer(t *testing.T) {
+	ctx := context.Background()
+	tracer := &txTracer{vr: &vreplicator{id: 1, workflow: "wf"}}
+	apply := func(typ binlogdatapb.VEventType) {
+		event := &binlogdatapb.VEvent{Type: typ}
+		tracer.apply(ctx, event)
+		tracer.applied(event, "MariaDB/0-1-1", 0)
+	}
+
+	// An empty transaction is not traced.
+	apply(binlogdatapb.VEventType_GTID)
+	apply(binlogdatapb.VEventType_BEGIN)
+	apply(binlogdatapb.VEventType_COMMIT)
+	assert.Nil(t, tracer.span)
+
+	// Grouped transactions are traced until their commit.
+	apply(binlogdatapb.VEventType_BEGIN)
+	apply(binlogdatapb.VEventType_FIELD)
+	apply(binlogdatapb.VEventType_ROW)
+	assert.NotNil(t, tracer.span)
+	apply(binlogdatapb.VEventType_GTID)
+	apply(binlogdatapb.VEventType_ROW)
+	assert.Equal(t, 4, tracer.events)
+	apply(binlogdatapb.VEventType_COMMIT)
+	assert.Nil(t, tracer.span)
+
+	// A DDL is its own transaction.
+	apply(binlogdatapb.VEventType_DDL)
+	assert.Nil(t, tracer.span)
+
+	// A transaction that fails is ended by finish.
+	apply(binlogdatapb.VEventType_ROW)
+	assert.NotNil(t, tracer.span)
+	tracer.finish(context.Canceled)
+	assert.Nil(t, tracer.span)
+}
@@ -213,6 +213,10 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	ctx, cancel := context.WithTimeout(ctx, *copyPhaseDuration)
 	defer cancel()
 
+	span, ctx := vc.vr.newSpan(ctx, "VCopier.CopyTable")
+	defer span.Finish()
+	span.Annotate("table", tableName)
+
 	var lastpkpb *querypb.QueryResult
 	if lastpkqr := copyState[tableName]; lastpkqr != nil {
 		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
@@ -225,7 +229,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	var updateCopyState *sqlparser.ParsedQuery
 	var bv map[string]*querypb.BindVariable
 	var sqlbuffer bytes2.Buffer
-	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) (err error) {
 		for {
 			select {
 			case <-rowsCopiedTicker.C:
@@ -264,6 +268,14 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			return nil
 		}
 
+		chunkSpan, ctx := vc.vr.newSpan(ctx, "VCopier.CopyChunk")
+		defer func() {
+			annotateError(chunkSpan, err)
+			chunkSpan.Finish()
+		}()
+		chunkSpan.Annotate("table", tableName)
+		chunkSpan.Annotate("rows", len(rows.Rows))
+
 		// The number of rows we receive depends on the packet size set
 		// for the row streamer. Since the packet size is roughly equivalent
 		// to data size, this should map to a uniform amount of pages affected
@@ -319,10 +331,12 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	select {
 	case <-ctx.Done():
 		log.Infof("Copy of %v stopped at lastpk: %v", tableName, bv)
+		span.Annotate("timed_out", true)
 		return nil
 	default:
 	}
 	if err != nil {
+		annotateError(span, err)
 		return err
 	}
 	log.Infof("Copy of %v finished at lastpk: %v", tableName, bv)
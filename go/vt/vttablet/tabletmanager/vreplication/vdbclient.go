@@ -99,9 +99,10 @@ func (vc *vdbClient) Execute(query string) (*sqltypes.Result, error) {
 
 func (vc *vdbClient) ExecuteWithRetry(ctx context.Context, query string) (*sqltypes.Result, error) {
 	qr, err := vc.Execute(query)
-	for err != nil {
+	for retries := 1; err != nil; retries++ {
 		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERLockDeadlock || sqlErr.Number() == mysql.ERLockWaitTimeout {
 			log.Infof("retryable error: %v, waiting for %v and retrying", sqlErr, dbLockRetryDelay)
+			annotateRetry(ctx, retries, sqlErr)
 			if err := vc.Rollback(); err != nil {
 				return nil, err
 			}
@@ -334,6 +334,8 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	defer vp.vr.stats.ReplicationLagSeconds.Set(math.MaxInt64)
 	defer vp.vr.stats.VReplicationLags.Add(strconv.Itoa(int(vp.vr.id)), math.MaxInt64)
 	var sbm int64 = -1
+	tracer := &txTracer{vr: vp.vr}
+	defer func() { tracer.finish(ctx.Err()) }()
 	for {
 		if ctx.Err() != nil {
 			return ctx.Err()
@@ -396,13 +398,15 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 						continue
 					}
 				}
-				if err := vp.applyEvent(ctx, event, mustSave); err != nil {
+				if err := vp.applyEvent(tracer.apply(ctx, event), event, mustSave); err != nil {
+					tracer.finish(err)
 					if err != io.EOF {
 						vp.vr.stats.ErrorCounts.Add([]string{"Apply"}, 1)
 						log.Errorf("Error applying event: %s", err.Error())
 					}
 					return err
 				}
+				tracer.applied(event, vp.pos.String(), sbm)
 			}
 		}
 
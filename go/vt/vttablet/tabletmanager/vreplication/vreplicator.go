@@ -89,6 +89,7 @@ const (
 type vreplicator struct {
 	vre      *Engine
 	id       uint32
+	workflow string
 	dbClient *vdbClient
 	// source
 	source          *binlogdatapb.BinlogSource
@@ -124,7 +125,7 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+func newVReplicator(id uint32, workflow string, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	if *vreplicationHeartbeatUpdateInterval > vreplicationMinimumHeartbeatUpdateInterval {
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
@@ -132,6 +133,7 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
+		workflow:        workflow,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
@@ -212,6 +214,7 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 		}
 		switch {
 		case numTablesToCopy != 0:
+			vr.stats.RecordCopyStart()
 			if err := vr.clearFKCheck(); err != nil {
 				log.Warningf("Unable to clear FK check %v", err)
 				return err
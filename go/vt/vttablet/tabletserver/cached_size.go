@@ -17,15 +17,22 @@ limitations under the License.
 
 package tabletserver
 
-import hack "vitess.io/vitess/go/hack"
+import (
+	"math"
+	"reflect"
+	"unsafe"
 
+	hack "vitess.io/vitess/go/hack"
+)
+
+//go:nocheckptr
 func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(144)
 	}
 	// field Plan *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.Plan
 	size += cached.Plan.CachedSize(true)
@@ -47,5 +54,22 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field consolidationQuery *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
+	size += cached.consolidationQuery.CachedSize(true)
+	// field consolidationBindVars map[string]*vitess.io/vitess/go/vt/proto/query.BindVariable
+	if cached.consolidationBindVars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.consolidationBindVars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.consolidationBindVars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k, v := range cached.consolidationBindVars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			size += v.CachedSize(true)
+		}
+	}
 	return size
 }
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+)
+
+var (
+	purgeMinBatchSize        = flag.Int64("gc_purge_min_batch_size", 50, "Smallest number of rows that a table GC purge DELETE removes")
+	purgeMaxBatchSize        = flag.Int64("gc_purge_max_batch_size", 10000, "Largest number of rows that a table GC purge DELETE removes")
+	purgeTargetBatchDuration = flag.Duration("gc_purge_batch_duration", 500*time.Millisecond, "Longest time that a table GC purge DELETE should take; slower batches are made smaller")
+)
+
+const (
+	// purgeRateWindow is the period over which the current purge rate is measured
+	purgeRateWindow = time.Minute
+	// purgeLagRatio is the ratio of the throttler threshold above which the purge batches stop growing
+	purgeLagRatio = 0.5
+	// purgeProgressRetention is how long the progress of a completed purge is still reported
+	purgeProgressRetention = 24 * time.Hour
+)
+
+var (
+	purgedRows     = stats.NewCounter("TableGCPurgedRows", "Number of rows purged by table GC")
+	purgeBatchSize = stats.NewGauge("TableGCPurgeBatchSize", "Number of rows that the last table GC purge DELETE was allowed to remove")
+	purgeThrottled = stats.NewCounter("TableGCPurgeThrottled", "Number of times the table GC purge was throttled")
+)
+
+// purgeSample is the number of rows purged from a table at some point in time
+type purgeSample struct {
+	at   time.Time
+	rows int64
+}
+
+// PurgeProgress is the progress of the purge of a table, as reported by /debug/tablegc/purge
+type PurgeProgress struct {
+	TableName   string
+	StartedAt   time.Time
+	CompletedAt *time.Time `json:",omitempty"`
+	// EstimatedRows is the number of rows of the table when the purge started, as estimated by
+	// information_schema. The ETA is therefore an estimate, too.
+	EstimatedRows int64
+	RowsPurged    int64
+	BatchSize     int64
+	Throttled     int64
+	RowsPerSecond float64
+	ETASeconds    *float64 `json:",omitempty"`
+
+	samples []purgeSample
+}
+
+// newPurgeProgress starts tracking the purge of a table
+func newPurgeProgress(tableName string, estimatedRows int64, now time.Time) *PurgeProgress {
+	return &PurgeProgress{
+		TableName:     tableName,
+		StartedAt:     now,
+		EstimatedRows: estimatedRows,
+		BatchSize:     *purgeMinBatchSize,
+		samples:       []purgeSample{{at: now}},
+	}
+}
+
+// recordBatch accounts for a DELETE that removed rows out of a batch of batchSize rows,
+// and updates the current rate and ETA.
+func (p *PurgeProgress) recordBatch(now time.Time, rows int64, batchSize int64) {
+	p.RowsPurged += rows
+	p.BatchSize = batchSize
+	p.samples = append(p.samples, purgeSample{at: now, rows: p.RowsPurged})
+	// Keep one sample older than the window, so that the rate covers the whole window
+	for len(p.samples) > 2 && now.Sub(p.samples[1].at) > purgeRateWindow {
+		p.samples = p.samples[1:]
+	}
+	first, last := p.samples[0], p.samples[len(p.samples)-1]
+	p.RowsPerSecond = 0
+	if elapsed := last.at.Sub(first.at).Seconds(); elapsed > 0 {
+		p.RowsPerSecond = float64(last.rows-first.rows) / elapsed
+	}
+	p.ETASeconds = nil
+	if p.RowsPerSecond > 0 && p.EstimatedRows > p.RowsPurged {
+		eta := float64(p.EstimatedRows-p.RowsPurged) / p.RowsPerSecond
+		p.ETASeconds = &eta
+	}
+}
+
+// complete marks the end of the purge
+func (p *PurgeProgress) complete(now time.Time) {
+	p.CompletedAt = &now
+	p.RowsPerSecond = 0
+	p.ETASeconds = nil
+	p.samples = nil
+}
+
+// nextPurgeBatchSize adapts the size of the next purge DELETE to the throttler check and to the
+// time the last DELETE took:
+// - when the throttler rejects the check, or the DELETE was slow, the batch shrinks
+// - when the replication lag (or other throttler metric) approaches its threshold, the batch keeps its size
+// - otherwise, the batch doubles
+// The result is always within -gc_purge_min_batch_size and -gc_purge_max_batch_size.
+func nextPurgeBatchSize(batchSize int64, checkResult *throttle.CheckResult, batchDuration time.Duration) int64 {
+	switch {
+	case checkResult.StatusCode != http.StatusOK:
+		batchSize /= 2
+	case batchDuration > *purgeTargetBatchDuration:
+		batchSize = int64(float64(batchSize) * float64(*purgeTargetBatchDuration) / float64(batchDuration))
+	case checkResult.Threshold > 0 && checkResult.Value >= checkResult.Threshold*purgeLagRatio:
+		// keep the batch size
+	default:
+		batchSize *= 2
+	}
+	if batchSize > *purgeMaxBatchSize {
+		batchSize = *purgeMaxBatchSize
+	}
+	if batchSize < *purgeMinBatchSize {
+		batchSize = *purgeMinBatchSize
+	}
+	return batchSize
+}
+
+// purgeProgress returns the progress of the purge of a table, starting to track it if needed.
+// It also forgets the purges that completed long ago.
+func (collector *TableGC) purgeProgress(tableName string, estimatedRows int64) *PurgeProgress {
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+
+	now := time.Now()
+	for name, progress := range collector.purgeProgresses {
+		if progress.CompletedAt != nil && now.Sub(*progress.CompletedAt) > purgeProgressRetention {
+			delete(collector.purgeProgresses, name)
+		}
+	}
+	progress, ok := collector.purgeProgresses[tableName]
+	if !ok || progress.CompletedAt != nil {
+		progress = newPurgeProgress(tableName, estimatedRows, now)
+		collector.purgeProgresses[tableName] = progress
+	}
+	return progress
+}
+
+// updatePurgeProgress applies a change to the progress of a purge, which the status endpoint reads concurrently
+func (collector *TableGC) updatePurgeProgress(update func()) {
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+
+	update()
+}
+
+// PurgeProgresses returns the progress of the ongoing and recently completed purges, the most recent first
+func (collector *TableGC) PurgeProgresses() []PurgeProgress {
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+
+	progresses := make([]PurgeProgress, 0, len(collector.purgeProgresses))
+	for _, progress := range collector.purgeProgresses {
+		p := *progress
+		p.samples = nil
+		progresses = append(progresses, p)
+	}
+	sort.SliceStable(progresses, func(i, j int) bool {
+		return progresses[i].StartedAt.After(progresses[j].StartedAt)
+	})
+	return progresses
+}
+
+func (collector *TableGC) handlePurgeProgress(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(collector.PurgeProgresses(), "", " ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	response.Write(b)
+}
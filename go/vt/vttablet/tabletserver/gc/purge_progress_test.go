@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+)
+
+func TestNextPurgeBatchSize(t *testing.T) {
+	ok := throttle.NewCheckResult(http.StatusOK, 0.1, 1, nil)
+	lagging := throttle.NewCheckResult(http.StatusOK, 0.7, 1, nil)
+	throttled := throttle.NewCheckResult(http.StatusTooManyRequests, 2, 1, nil)
+	fast := 100 * time.Millisecond
+
+	tt := []struct {
+		name        string
+		batchSize   int64
+		checkResult *throttle.CheckResult
+		duration    time.Duration
+		next        int64
+	}{
+		{name: "grows", batchSize: 50, checkResult: ok, duration: fast, next: 100},
+		{name: "capped", batchSize: 8000, checkResult: ok, duration: fast, next: 10000},
+		{name: "lag approaching threshold", batchSize: 800, checkResult: lagging, duration: fast, next: 800},
+		{name: "throttled", batchSize: 800, checkResult: throttled, duration: 0, next: 400},
+		{name: "throttled at minimum", batchSize: 50, checkResult: throttled, duration: 0, next: 50},
+		{name: "slow", batchSize: 1000, checkResult: ok, duration: 2 * time.Second, next: 250},
+		{name: "no threshold", batchSize: 100, checkResult: throttle.NewCheckResult(http.StatusOK, 0, 0, nil), duration: fast, next: 200},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.next, nextPurgeBatchSize(tc.batchSize, tc.checkResult, tc.duration))
+		})
+	}
+}
+
+func TestPurgeProgress(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	p := newPurgeProgress("_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", 10000, start)
+	assert.EqualValues(t, 50, p.BatchSize)
+
+	p.recordBatch(start.Add(10*time.Second), 1000, 100)
+	assert.EqualValues(t, 1000, p.RowsPurged)
+	assert.EqualValues(t, 100, p.BatchSize)
+	assert.Equal(t, 100.0, p.RowsPerSecond)
+	require.NotNil(t, p.ETASeconds)
+	assert.Equal(t, 90.0, *p.ETASeconds)
+
+	// The rate only covers the last minute.
+	p.recordBatch(start.Add(70*time.Second), 6000, 200)
+	p.recordBatch(start.Add(80*time.Second), 0, 200)
+	assert.EqualValues(t, 7000, p.RowsPurged)
+	assert.Equal(t, 6000.0/70, p.RowsPerSecond)
+	require.NotNil(t, p.ETASeconds)
+	assert.InDelta(t, 35.0, *p.ETASeconds, 0.001)
+
+	// The estimate of the rows was too low: there's no ETA.
+	p.recordBatch(start.Add(90*time.Second), 4000, 200)
+	assert.Nil(t, p.ETASeconds)
+
+	p.complete(start.Add(100 * time.Second))
+	require.NotNil(t, p.CompletedAt)
+	assert.Zero(t, p.RowsPerSecond)
+}
+
+func TestPurgeProgresses(t *testing.T) {
+	collector := &TableGC{
+		purgeProgresses: map[string]*PurgeProgress{},
+	}
+	old := collector.purgeProgress("_vt_PURGE_2ace8bcef73211ea87e9f875a4d24e90_20200915120411", 100)
+	collector.updatePurgeProgress(func() {
+		old.StartedAt = old.StartedAt.Add(-2 * purgeProgressRetention)
+		old.complete(old.StartedAt)
+	})
+	recent := collector.purgeProgress("_vt_PURGE_3ace8bcef73211ea87e9f875a4d24e90_20200915120412", 100)
+	collector.updatePurgeProgress(func() {
+		recent.StartedAt = recent.StartedAt.Add(-time.Minute)
+		recent.complete(time.Now())
+	})
+	current := collector.purgeProgress("_vt_PURGE_4ace8bcef73211ea87e9f875a4d24e90_20200915120413", 100)
+
+	// The progress of an ongoing purge is resumed, and the purges that completed long ago are forgotten.
+	assert.Same(t, current, collector.purgeProgress(current.TableName, 100))
+	progresses := collector.PurgeProgresses()
+	require.Len(t, progresses, 2)
+	assert.Equal(t, current.TableName, progresses[0].TableName)
+	assert.Equal(t, recent.TableName, progresses[1].TableName)
+	assert.NotNil(t, progresses[1].CompletedAt)
+
+	// A table that is purged again starts over.
+	assert.NotSame(t, recent, collector.purgeProgress(recent.TableName, 100))
+}
@@ -22,12 +22,15 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net/http"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
@@ -42,9 +45,12 @@ import (
 
 const (
 	leaderCheckInterval = 5 * time.Second
-	// evacHours is a hard coded, reasonable time for a table to spend in EVAC state
+	// evacHours is a reasonable time for a table to spend in EVAC state, unless the
+	// table GC configuration of the keyspace sets another one
 	evacHours        = 72
 	throttlerAppName = "tablegc"
+	// purgeThrottledInterval is the pause of the purge after the throttler rejects a check
+	purgeThrottledInterval = 250 * time.Millisecond
 )
 
 // checkInterval marks the interval between looking for tables in mysql server/schema
@@ -53,13 +59,21 @@ var checkInterval = flag.Duration("gc_check_interval", 1*time.Hour, "Interval be
 // purgeReentranceInterval marks the interval between searching tables to purge
 var purgeReentranceInterval = flag.Duration("gc_purge_check_interval", 1*time.Minute, "Interval between purge discovery checks")
 
+// smallTableMaxBytes and smallTableMaxRows define the small tables, which are dropped as soon as they are out of HOLD
+// state, skipping PURGE and EVAC
+var smallTableMaxBytes = flag.Int64("gc_small_table_max_bytes", 0, "Tables whose data and index length is at most this size skip the PURGE and EVAC states and are dropped directly. 0 means no size limit, but at least one of -gc_small_table_max_bytes and -gc_small_table_max_rows must be set for tables to be considered small")
+var smallTableMaxRows = flag.Int64("gc_small_table_max_rows", 0, "Tables with at most this many rows skip the PURGE and EVAC states and are dropped directly. 0 means no row limit, but at least one of -gc_small_table_max_bytes and -gc_small_table_max_rows must be set for tables to be considered small")
+
 // gcLifecycle is the sequence of steps the table goes through in the process of getting dropped
 var gcLifecycle = flag.String("table_gc_lifecycle", "hold,purge,evac,drop", "States for a DROP TABLE garbage collection cycle. Default is 'hold,purge,evac,drop', use any subset ('drop' implcitly always included)")
 
 var (
-	sqlPurgeTable       = `delete from %a limit 50`
+	sqlPurgeTable       = `delete from %a limit %d`
+	sqlTableRows        = `select table_rows from information_schema.tables where table_schema = database() and table_name = %a`
 	sqlShowVtTables     = `show full tables like '\_vt\_%'`
+	sqlVtTableSizes     = `select table_name, table_rows, data_length + index_length from information_schema.tables where table_schema = database() and table_name like '\_vt\_%'`
 	sqlDropTable        = "drop table if exists `%a`"
+	sqlSelectDropped    = "select replace(migration_uuid, '_', ''), mysql_table from _vt.schema_migrations where replace(migration_uuid, '_', '') in %a"
 	purgeReentranceFlag int64
 )
 
@@ -69,6 +83,26 @@ type transitionRequest struct {
 	isBaseTable   bool
 	toGCState     schema.TableGCState
 	uuid          string
+	settings      *tableSettings
+}
+
+// tableSize is the size of a GC table, as estimated by information_schema
+type tableSize struct {
+	rows  int64
+	bytes int64
+}
+
+var (
+	smallTableDrops = stats.NewCounter("TableGCSmallTableDrops", "Number of small tables dropped by table GC without going through the PURGE and EVAC states")
+	skippedGCStates = stats.NewCountersWithSingleLabel("TableGCSkippedStates", "Number of table GC states that small tables skipped", "State")
+)
+
+// tableSettings are the lifecycle and the retention of a GC table: the ones
+// of the table GC configuration of the keyspace in the topo, or else the
+// ones of the flags.
+type tableSettings struct {
+	lifecycleStates map[schema.TableGCState]bool
+	evac            time.Duration
 }
 
 func init() {
@@ -103,13 +137,19 @@ type TableGC struct {
 
 	tickers [](*timer.SuspendableTicker)
 
-	purgingTables          map[string]bool
+	// purgingTables has the settings of the tables to purge.
+	purgingTables map[string]*tableSettings
+	// purgeProgresses has the progress of the ongoing and recently completed purges.
+	purgeProgresses        map[string]*PurgeProgress
 	dropTablesChan         chan string
 	transitionRequestsChan chan *transitionRequest
 	purgeRequestsChan      chan bool
 	// lifecycleStates indicates what states a GC table goes through. The user can set
 	// this with -table_gc_lifecycle, such that some states can be skipped.
+	// The table GC configuration of the keyspace overrides it.
 	lifecycleStates map[schema.TableGCState]bool
+	// serverSupportsFastDrops means that the tables skip the PURGE and EVAC states.
+	serverSupportsFastDrops bool
 }
 
 // GCStatus published some status valus from the collector
@@ -140,11 +180,13 @@ func NewTableGC(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topoda
 
 		tickers: [](*timer.SuspendableTicker){},
 
-		purgingTables:          map[string]bool{},
+		purgingTables:          map[string]*tableSettings{},
+		purgeProgresses:        map[string]*PurgeProgress{},
 		dropTablesChan:         make(chan string),
 		transitionRequestsChan: make(chan *transitionRequest),
 		purgeRequestsChan:      make(chan bool),
 	}
+	env.Exporter().HandleFunc("/debug/tablegc/purge", collector.handlePurgeProgress)
 
 	return collector
 }
@@ -184,20 +226,25 @@ func (collector *TableGC) Open() (err error) {
 		return err
 	}
 	defer conn.Close()
-	serverSupportsFastDrops, err := conn.SupportsFastDropTable()
+	collector.serverSupportsFastDrops, err = conn.SupportsFastDropTable()
 	if err != nil {
 		return err
 	}
-	if serverSupportsFastDrops {
+	collector.skipSlowDropStates(collector.lifecycleStates)
+
+	return nil
+}
+
+// skipSlowDropStates removes the PURGE and EVAC states of a lifecycle if the server supports fast drops.
+func (collector *TableGC) skipSlowDropStates(lifecycleStates map[schema.TableGCState]bool) {
+	if collector.serverSupportsFastDrops {
 		// MySQL 8.0.23 and onwards supports fast DROP TABLE operations. This means we don't have to
 		// go through the purging & evac cycle: once the table has been held for long enough, we can just
 		// move on to dropping it. Dropping a large table in 8.0.23 is expected to take several seconds, and
 		// should not block other queries or place any locks on the buffer pool.
-		delete(collector.lifecycleStates, schema.PurgeTableGCState)
-		delete(collector.lifecycleStates, schema.EvacTableGCState)
+		delete(lifecycleStates, schema.PurgeTableGCState)
+		delete(lifecycleStates, schema.EvacTableGCState)
 	}
-
-	return nil
 }
 
 // Close frees resources
@@ -287,7 +334,7 @@ func (collector *TableGC) Operate(ctx context.Context) {
 			}
 		case transition := <-collector.transitionRequestsChan:
 			{
-				if err := collector.transitionTable(ctx, transition); err != nil {
+				if _, err := collector.transitionTable(ctx, transition); err != nil {
 					log.Errorf("TableGC: error transitioning table %s to %+v: %+v", transition.fromTableName, transition.toGCState, err)
 				}
 			}
@@ -296,8 +343,8 @@ func (collector *TableGC) Operate(ctx context.Context) {
 }
 
 // nextState evaluates what the next state should be, given a state; this takes into account
-// lifecycleStates (as generated by user supplied -table_gc_lifecycle flag)
-func (collector *TableGC) nextState(fromState schema.TableGCState) *schema.TableGCState {
+// lifecycleStates (as generated by user supplied -table_gc_lifecycle flag, or the table GC configuration)
+func (collector *TableGC) nextState(lifecycleStates map[schema.TableGCState]bool, fromState schema.TableGCState) *schema.TableGCState {
 	var state schema.TableGCState
 	switch fromState {
 	case schema.HoldTableGCState:
@@ -311,16 +358,16 @@ func (collector *TableGC) nextState(fromState schema.TableGCState) *schema.Table
 	default:
 		return nil
 	}
-	if _, ok := collector.lifecycleStates[state]; !ok {
-		return collector.nextState(state)
+	if _, ok := lifecycleStates[state]; !ok {
+		return collector.nextState(lifecycleStates, state)
 	}
 	return &state
 }
 
 // generateTansition creates a transition request, based on current state and taking configured lifecycleStates
 // into consideration (we may skip some states)
-func (collector *TableGC) generateTansition(ctx context.Context, fromState schema.TableGCState, fromTableName string, isBaseTable bool, uuid string) *transitionRequest {
-	nextState := collector.nextState(fromState)
+func (collector *TableGC) generateTansition(ctx context.Context, fromState schema.TableGCState, fromTableName string, isBaseTable bool, uuid string, settings *tableSettings) *transitionRequest {
+	nextState := collector.nextState(settings.lifecycleStates, fromState)
 	if nextState == nil {
 		return nil
 	}
@@ -329,14 +376,15 @@ func (collector *TableGC) generateTansition(ctx context.Context, fromState schem
 		isBaseTable:   isBaseTable,
 		toGCState:     *nextState,
 		uuid:          uuid,
+		settings:      settings,
 	}
 }
 
 // submitTransitionRequest generates and queues a transition request for a given table
-func (collector *TableGC) submitTransitionRequest(ctx context.Context, fromState schema.TableGCState, fromTableName string, isBaseTable bool, uuid string) {
+func (collector *TableGC) submitTransitionRequest(ctx context.Context, fromState schema.TableGCState, fromTableName string, isBaseTable bool, uuid string, settings *tableSettings) {
 	log.Infof("TableGC: submitting transition request for %s", fromTableName)
 	go func() {
-		transition := collector.generateTansition(ctx, fromState, fromTableName, isBaseTable, uuid)
+		transition := collector.generateTansition(ctx, fromState, fromTableName, isBaseTable, uuid, settings)
 		if transition != nil {
 			collector.transitionRequestsChan <- transition
 		}
@@ -344,7 +392,7 @@ func (collector *TableGC) submitTransitionRequest(ctx context.Context, fromState
 }
 
 // shouldTransitionTable checks if the given table is a GC table and if it's time to transition it to next state
-func (collector *TableGC) shouldTransitionTable(tableName string) (shouldTransition bool, state schema.TableGCState, uuid string, err error) {
+func (collector *TableGC) shouldTransitionTable(tableName string, lifecycleStates map[schema.TableGCState]bool) (shouldTransition bool, state schema.TableGCState, uuid string, err error) {
 	isGCTable, state, uuid, t, err := schema.AnalyzeGCTableName(tableName)
 	if err != nil {
 		return false, state, uuid, err
@@ -353,7 +401,7 @@ func (collector *TableGC) shouldTransitionTable(tableName string) (shouldTransit
 		// irrelevant table
 		return false, state, uuid, nil
 	}
-	if _, ok := collector.lifecycleStates[state]; ok {
+	if _, ok := lifecycleStates[state]; ok {
 		// this state is in our expected lifecycle. Let's check table's time hint:
 		timeNow := time.Now().UTC()
 		if timeNow.Before(t) {
@@ -365,6 +413,78 @@ func (collector *TableGC) shouldTransitionTable(tableName string) (shouldTransit
 	return true, state, uuid, nil
 }
 
+// isSmallTable checks whether a table is below the thresholds of -gc_small_table_max_bytes and -gc_small_table_max_rows
+func isSmallTable(size tableSize) bool {
+	if *smallTableMaxBytes <= 0 && *smallTableMaxRows <= 0 {
+		return false
+	}
+	if *smallTableMaxBytes > 0 && size.bytes > *smallTableMaxBytes {
+		return false
+	}
+	if *smallTableMaxRows > 0 && size.rows > *smallTableMaxRows {
+		return false
+	}
+	return true
+}
+
+// smallTableSkippedStates returns the PURGE and EVAC states that a small table can skip, given its current
+// state: the ones that remain in its lifecycle. A table in HOLD state only skips them once it's out of HOLD,
+// since the hold period is there to restore the table. A table in PURGE or EVAC state skips the rest of
+// its lifecycle without waiting for its time hint.
+func (collector *TableGC) smallTableSkippedStates(tableName string, lifecycleStates map[schema.TableGCState]bool) (skippedStates []schema.TableGCState) {
+	isGCTable, state, _, t, err := schema.AnalyzeGCTableName(tableName)
+	if err != nil || !isGCTable {
+		return nil
+	}
+	switch state {
+	case schema.HoldTableGCState:
+		if _, ok := lifecycleStates[state]; ok && time.Now().UTC().Before(t) {
+			// still on hold
+			return nil
+		}
+		for _, slowState := range []schema.TableGCState{schema.PurgeTableGCState, schema.EvacTableGCState} {
+			if lifecycleStates[slowState] {
+				skippedStates = append(skippedStates, slowState)
+			}
+		}
+	case schema.PurgeTableGCState:
+		skippedStates = append(skippedStates, state)
+		if lifecycleStates[schema.EvacTableGCState] {
+			skippedStates = append(skippedStates, schema.EvacTableGCState)
+		}
+	case schema.EvacTableGCState:
+		skippedStates = append(skippedStates, state)
+	}
+	return skippedStates
+}
+
+// readTableSizes returns the sizes of the GC tables, if small tables are to be dropped directly.
+// The sizes are estimates, and are missing if they can't be read, in which case the tables go
+// through their usual lifecycle.
+func (collector *TableGC) readTableSizes(ctx context.Context, conn *connpool.DBConn) map[string]tableSize {
+	sizes := map[string]tableSize{}
+	if *smallTableMaxBytes <= 0 && *smallTableMaxRows <= 0 {
+		return sizes
+	}
+	res, err := conn.Exec(ctx, sqlVtTableSizes, math.MaxInt32, true)
+	if err != nil {
+		log.Errorf("TableGC: error reading the sizes of the tables: %+v", err)
+		return sizes
+	}
+	for _, row := range res.Rows {
+		rows, err := row[1].ToInt64()
+		if err != nil {
+			continue
+		}
+		bytes, err := row[2].ToInt64()
+		if err != nil {
+			continue
+		}
+		sizes[row[0].ToString()] = tableSize{rows: rows, bytes: bytes}
+	}
+	return sizes
+}
+
 // checkTables looks for potential GC tables in the MySQL server+schema.
 // It lists _vt_% tables, then filters through those which are due-date.
 // It then applies the necessary operation per table.
@@ -385,13 +505,34 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	config := collector.readConfig(ctx)
+	sizes := collector.readTableSizes(ctx, conn)
+	droppedTables := collector.readDroppedTables(ctx, conn, config, res.Rows)
 
 	for _, row := range res.Rows {
 		tableName := row[0].ToString()
 		tableType := row[1].ToString()
 		isBaseTable := (tableType == "BASE TABLE")
 
-		shouldTransition, state, uuid, err := collector.shouldTransitionTable(tableName)
+		isGCTable, _, uuid, _, _ := schema.AnalyzeGCTableName(tableName)
+		if !isGCTable {
+			continue
+		}
+		settings := collector.tableSettings(config, droppedTables[uuid], uuid)
+		if size, ok := sizes[tableName]; ok && isBaseTable && isSmallTable(size) {
+			if skippedStates := collector.smallTableSkippedStates(tableName, settings.lifecycleStates); len(skippedStates) > 0 {
+				// Purging and evacuating a small table is not worth the wait: drop it right away.
+				log.Infof("TableGC: dropping small table %s (%d rows, %d bytes), skipping states %v", tableName, size.rows, size.bytes, skippedStates)
+				for _, state := range skippedStates {
+					skippedGCStates.Add(string(state), 1)
+				}
+				smallTableDrops.Add(1)
+				collector.removePurgingTable(tableName)
+				go func() { collector.dropTablesChan <- tableName }()
+				continue
+			}
+		}
+		shouldTransition, state, uuid, err := collector.shouldTransitionTable(tableName, settings.lifecycleStates)
 
 		if err != nil {
 			log.Errorf("TableGC: error while checking tables: %+v", err)
@@ -406,20 +547,20 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 
 		if state == schema.HoldTableGCState {
 			// Hold period expired. Moving to next state
-			collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid)
+			collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid, settings)
 		}
 		if state == schema.PurgeTableGCState {
 			if isBaseTable {
 				// This table needs to be purged. Make sure to enlist it (we may already have)
-				collector.addPurgingTable(tableName)
+				collector.addPurgingTable(tableName, settings)
 			} else {
 				// This is a view. We don't need to delete rows from views. Just transition into next phase
-				collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid)
+				collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid, settings)
 			}
 		}
 		if state == schema.EvacTableGCState {
 			// This table was in EVAC state for the required period. It will transition into DROP state
-			collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid)
+			collector.submitTransitionRequest(ctx, state, tableName, isBaseTable, uuid, settings)
 		}
 		if state == schema.DropTableGCState {
 			// This table needs to be dropped immediately.
@@ -430,6 +571,80 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 	return nil
 }
 
+// readConfig returns the table GC configuration of the keyspace, which is
+// empty if it can't be read.
+func (collector *TableGC) readConfig(ctx context.Context) schema.TableGCConfig {
+	if collector.ts == nil {
+		return schema.TableGCConfig{}
+	}
+	config, err := collector.ts.GetTableGCConfig(ctx, collector.keyspace)
+	if err != nil {
+		log.Errorf("TableGC: error reading the table GC configuration of keyspace %s, using the flags: %+v", collector.keyspace, err)
+		return schema.TableGCConfig{}
+	}
+	return config
+}
+
+// readDroppedTables returns the tables that online DDL dropped into the GC
+// tables of a cycle, by the uuid of their migration, so that the settings of
+// these tables apply to their GC tables. The tables are looked up with a
+// single query, and only if the configuration has settings by table.
+func (collector *TableGC) readDroppedTables(ctx context.Context, conn *connpool.DBConn, config schema.TableGCConfig, rows [][]sqltypes.Value) map[string]string {
+	droppedTables := map[string]string{}
+	if !config.HasTableSettings() {
+		return droppedTables
+	}
+	var uuids []string
+	for _, row := range rows {
+		if isGCTable, _, uuid, _, _ := schema.AnalyzeGCTableName(row[0].ToString()); isGCTable && uuid != "" {
+			uuids = append(uuids, uuid)
+		}
+	}
+	if len(uuids) == 0 {
+		return droppedTables
+	}
+	bindVar, err := sqltypes.BuildBindVariable(uuids)
+	if err != nil {
+		log.Errorf("TableGC: error looking up the dropped tables: %+v", err)
+		return droppedTables
+	}
+	query, err := sqlparser.ParseAndBind(sqlSelectDropped, bindVar)
+	if err != nil {
+		log.Errorf("TableGC: error looking up the dropped tables: %+v", err)
+		return droppedTables
+	}
+	res, err := conn.Exec(ctx, query, math.MaxInt32, true)
+	if err != nil {
+		log.Errorf("TableGC: error looking up the dropped tables: %+v", err)
+		return droppedTables
+	}
+	for _, row := range res.Rows {
+		droppedTables[row[0].ToString()] = row[1].ToString()
+	}
+	return droppedTables
+}
+
+// tableSettings returns the settings of a GC table, given the table that
+// online DDL dropped into it, if any: the settings of that table apply to the
+// GC table, and the other GC tables use the settings of the keyspace.
+func (collector *TableGC) tableSettings(config schema.TableGCConfig, table string, uuid string) *tableSettings {
+	settings := &tableSettings{
+		lifecycleStates: collector.lifecycleStates,
+		evac:            evacHours * time.Hour,
+	}
+	lifecycleStates, err := config.Lifecycle(table, *gcLifecycle)
+	if err != nil {
+		log.Errorf("TableGC: error parsing the lifecycle of %s in the table GC configuration, using -table_gc_lifecycle: %+v", uuid, err)
+	} else {
+		collector.skipSlowDropStates(lifecycleStates)
+		settings.lifecycleStates = lifecycleStates
+	}
+	if settings.evac, err = config.Duration(table, schema.TableGCEvacSetting, settings.evac); err != nil {
+		log.Errorf("TableGC: error parsing the evac period of %s in the table GC configuration: %+v", uuid, err)
+	}
+	return settings
+}
+
 // purge continuously purges rows from a table.
 // This function is non-reentrant: there's only one instance of this function running at any given time.
 // A timer keeps calling this function, so if it bails out (e.g. on error) it will later resume work
@@ -441,7 +656,7 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 		return "", nil
 	}
 
-	tableName, found := collector.nextTableToPurge()
+	tableName, settings, found := collector.nextTableToPurge()
 	if !found {
 		// Nothing do do here...
 		return "", nil
@@ -494,24 +709,39 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 	}()
 
 	log.Infof("TableGC: purge begin for %s", tableName)
+	progress := collector.purgeProgress(tableName, collector.estimateTableRows(conn, tableName))
+	batchSize := progress.BatchSize
 	for {
-		if !collector.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+		// The size of the batches adapts to the throttler, i.e. to the replication lag and load,
+		// and to how long the DELETEs take.
+		checkResult := collector.throttlerClient.ThrottleCheckResult(ctx)
+		if checkResult.StatusCode != http.StatusOK {
+			purgeThrottled.Add(1)
+			batchSize = nextPurgeBatchSize(batchSize, checkResult, 0)
+			collector.updatePurgeProgress(func() { progress.Throttled++ })
+			time.Sleep(purgeThrottledInterval)
 			continue
 		}
 		// OK, we're clear to go!
 
 		// Issue a DELETE
-		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, tableName)
+		purgeBatchSize.Set(batchSize)
+		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, tableName, batchSize)
+		start := time.Now()
 		res, err := conn.ExecuteFetch(parsed.Query, 1, true)
 		if err != nil {
 			return tableName, err
 		}
+		purgedRows.Add(int64(res.RowsAffected))
+		collector.updatePurgeProgress(func() { progress.recordBatch(time.Now(), int64(res.RowsAffected), batchSize) })
+		batchSize = nextPurgeBatchSize(batchSize, checkResult, time.Since(start))
 		if res.RowsAffected == 0 {
+			collector.updatePurgeProgress(func() { progress.complete(time.Now()) })
 			// The table is now empty!
 			// we happen to know at this time that the table is in PURGE state,
 			// I mean, that's why we're here. We can hard code that.
 			_, _, uuid, _, _ := schema.AnalyzeGCTableName(tableName)
-			collector.submitTransitionRequest(ctx, schema.PurgeTableGCState, tableName, true, uuid)
+			collector.submitTransitionRequest(ctx, schema.PurgeTableGCState, tableName, true, uuid, settings)
 			collector.removePurgingTable(tableName)
 			// finished with this table. Maybe more tables are looking to be purged.
 			// Trigger another call to purge(), instead of waiting a full purgeReentranceInterval cycle
@@ -522,6 +752,24 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 	}
 }
 
+// estimateTableRows returns the number of rows of a table as estimated by information_schema,
+// or 0 if it can't be read: the estimate only serves the ETA of the purge.
+func (collector *TableGC) estimateTableRows(conn *dbconnpool.DBConnection, tableName string) int64 {
+	query, err := sqlparser.ParseAndBind(sqlTableRows, sqltypes.StringBindVariable(tableName))
+	if err != nil {
+		return 0
+	}
+	res, err := conn.ExecuteFetch(query, 1, false)
+	if err != nil || len(res.Rows) == 0 {
+		return 0
+	}
+	rows, err := res.Rows[0][0].ToInt64()
+	if err != nil {
+		return 0
+	}
+	return rows
+}
+
 // dropTable runs an actual DROP TABLE statement, and marks the end of the line for the
 // tables' GC lifecycle.
 func (collector *TableGC) dropTable(ctx context.Context, tableName string) error {
@@ -547,16 +795,16 @@ func (collector *TableGC) dropTable(ctx context.Context, tableName string) error
 }
 
 // transitionTable is called upon a transition request. The actual implementation of a transition
-// is a RENAME TABLE statement.
-func (collector *TableGC) transitionTable(ctx context.Context, transition *transitionRequest) error {
+// is a RENAME TABLE statement. It returns the new name of the table.
+func (collector *TableGC) transitionTable(ctx context.Context, transition *transitionRequest) (toTableName string, err error) {
 
 	if atomic.LoadInt64(&collector.isPrimary) == 0 {
-		return nil
+		return "", nil
 	}
 
 	conn, err := collector.pool.Get(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer conn.Recycle()
 
@@ -569,31 +817,31 @@ func (collector *TableGC) transitionTable(ctx context.Context, transition *trans
 		if transition.isBaseTable {
 			// in EVAC state  we want the table pages to evacuate from the buffer pool. We therefore
 			// set the timestamp to some point the future, which we self determine
-			t = t.Add(evacHours * time.Hour)
+			t = t.Add(transition.settings.evac)
 		}
 		// Views don't need evac. t remains "now"
 	}
 
 	renameStatement, toTableName, err := schema.GenerateRenameStatementWithUUID(transition.fromTableName, transition.toGCState, transition.uuid, t)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	log.Infof("TableGC: renaming table: %s to %s", transition.fromTableName, toTableName)
 	_, err = conn.Exec(ctx, renameStatement, 1, true)
 	if err != nil {
-		return err
+		return "", err
 	}
 	log.Infof("TableGC: renamed table: %s", transition.fromTableName)
-	return nil
+	return toTableName, nil
 }
 
 // addPurgingTable adds a table to the list of droppingpurging (or pending purging) tables
-func (collector *TableGC) addPurgingTable(tableName string) {
+func (collector *TableGC) addPurgingTable(tableName string, settings *tableSettings) {
 	collector.purgeMutex.Lock()
 	defer collector.purgeMutex.Unlock()
 
-	collector.purgingTables[tableName] = true
+	collector.purgingTables[tableName] = settings
 }
 
 // removePurgingTable removes a table from the purging list; likely this is called when
@@ -607,12 +855,12 @@ func (collector *TableGC) removePurgingTable(tableName string) {
 
 // nextTableToPurge returns the name of the next table we should start purging.
 // We pick the table with the oldest timestamp.
-func (collector *TableGC) nextTableToPurge() (tableName string, ok bool) {
+func (collector *TableGC) nextTableToPurge() (tableName string, settings *tableSettings, ok bool) {
 	collector.purgeMutex.Lock()
 	defer collector.purgeMutex.Unlock()
 
 	if len(collector.purgingTables) == 0 {
-		return "", false
+		return "", nil, false
 	}
 	tableNames := []string{}
 	for tableName := range collector.purgingTables {
@@ -624,7 +872,7 @@ func (collector *TableGC) nextTableToPurge() (tableName string, ok bool) {
 
 		return ti.Before(tj)
 	})
-	return tableNames[0], true
+	return tableNames[0], collector.purgingTables[tableNames[0]], true
 }
 
 // Status exports a status breakdown
@@ -18,6 +18,7 @@ package gc
 
 import (
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/schema"
 
@@ -57,15 +58,16 @@ func TestNextTableToPurge(t *testing.T) {
 	}
 	for _, ts := range tt {
 		collector := &TableGC{
-			purgingTables: make(map[string]bool),
+			purgingTables: make(map[string]*tableSettings),
 		}
 		for _, table := range ts.tables {
-			collector.purgingTables[table] = true
+			collector.purgingTables[table] = &tableSettings{evac: time.Duration(len(table)) * time.Hour}
 		}
-		next, ok := collector.nextTableToPurge()
+		next, settings, ok := collector.nextTableToPurge()
 		assert.Equal(t, ts.ok, ok)
 		if ok {
 			assert.Equal(t, ts.next, next)
+			assert.Equal(t, collector.purgingTables[next], settings)
 		}
 	}
 }
@@ -142,11 +144,11 @@ func TestNextState(t *testing.T) {
 		var err error
 		collector.lifecycleStates, err = schema.ParseGCLifecycle(ts.lifecycle)
 		assert.NoError(t, err)
-		next := collector.nextState(ts.state)
+		next := collector.nextState(collector.lifecycleStates, ts.state)
 		assert.NotNil(t, next)
 		assert.Equal(t, ts.next, *next)
 
-		postDrop := collector.nextState(schema.DropTableGCState)
+		postDrop := collector.nextState(collector.lifecycleStates, schema.DropTableGCState)
 		assert.Nil(t, postDrop)
 	}
 }
@@ -208,7 +210,7 @@ func TestShouldTransitionTable(t *testing.T) {
 		lifecycleStates: lifecycleStates,
 	}
 	for _, ts := range tt {
-		shouldTransition, state, uuid, err := collector.shouldTransitionTable(ts.table)
+		shouldTransition, state, uuid, err := collector.shouldTransitionTable(ts.table, collector.lifecycleStates)
 		if ts.isError {
 			assert.Error(t, err)
 		} else {
@@ -219,3 +221,175 @@ func TestShouldTransitionTable(t *testing.T) {
 		}
 	}
 }
+
+func TestTableSettings(t *testing.T) {
+	defaultLifecycle, err := schema.ParseGCLifecycle(*gcLifecycle)
+	assert.NoError(t, err)
+	collector := &TableGC{
+		lifecycleStates: defaultLifecycle,
+	}
+
+	settings := collector.tableSettings(schema.TableGCConfig{}, "", "6ace8bcef73211ea87e9f875a4d24e90")
+	assert.Equal(t, defaultLifecycle, settings.lifecycleStates)
+	assert.Equal(t, evacHours*time.Hour, settings.evac)
+
+	config := schema.TableGCConfig{
+		schema.TableGCLifecycleSetting: "hold,evac",
+		schema.TableGCEvacSetting:      "1h",
+	}
+	settings = collector.tableSettings(config, "", "6ace8bcef73211ea87e9f875a4d24e90")
+	assert.Equal(t, map[schema.TableGCState]bool{
+		schema.HoldTableGCState: true,
+		schema.EvacTableGCState: true,
+		schema.DropTableGCState: true,
+	}, settings.lifecycleStates)
+	assert.Equal(t, time.Hour, settings.evac)
+
+	// The lifecycle of the configuration skips PURGE and EVAC too when the
+	// server drops tables fast, and invalid settings fall back to the flags.
+	collector.serverSupportsFastDrops = true
+	config[schema.TableGCEvacSetting] = "soon"
+	settings = collector.tableSettings(config, "", "6ace8bcef73211ea87e9f875a4d24e90")
+	assert.Equal(t, map[schema.TableGCState]bool{
+		schema.HoldTableGCState: true,
+		schema.DropTableGCState: true,
+	}, settings.lifecycleStates)
+	assert.Equal(t, evacHours*time.Hour, settings.evac)
+
+	// The settings of the dropped table override those of the keyspace.
+	config[schema.TableGCConfigKey("t1", schema.TableGCEvacSetting)] = "2h"
+	settings = collector.tableSettings(config, "t1", "6ace8bcef73211ea87e9f875a4d24e90")
+	assert.Equal(t, 2*time.Hour, settings.evac)
+	settings = collector.tableSettings(config, "t2", "6ace8bcef73211ea87e9f875a4d24e90")
+	assert.Equal(t, evacHours*time.Hour, settings.evac)
+
+	// A table that overrides the lifecycle skips the states of the keyspace.
+	lifecycleStates := map[schema.TableGCState]bool{schema.DropTableGCState: true}
+	shouldTransition, state, _, err := collector.shouldTransitionTable("_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_29990915120410", lifecycleStates)
+	assert.NoError(t, err)
+	assert.True(t, shouldTransition)
+	next := collector.nextState(lifecycleStates, state)
+	assert.Equal(t, schema.DropTableGCState, *next)
+}
+
+func TestIsSmallTable(t *testing.T) {
+	defer func(maxBytes, maxRows int64) {
+		*smallTableMaxBytes, *smallTableMaxRows = maxBytes, maxRows
+	}(*smallTableMaxBytes, *smallTableMaxRows)
+
+	tt := []struct {
+		maxBytes int64
+		maxRows  int64
+		size     tableSize
+		small    bool
+	}{
+		{size: tableSize{rows: 0, bytes: 0}, small: false},
+		{maxBytes: 1024, size: tableSize{rows: 1000000, bytes: 1024}, small: true},
+		{maxBytes: 1024, size: tableSize{rows: 1, bytes: 1025}, small: false},
+		{maxRows: 100, size: tableSize{rows: 100, bytes: 1 << 30}, small: true},
+		{maxRows: 100, size: tableSize{rows: 101, bytes: 0}, small: false},
+		{maxBytes: 1024, maxRows: 100, size: tableSize{rows: 10, bytes: 512}, small: true},
+		{maxBytes: 1024, maxRows: 100, size: tableSize{rows: 1000, bytes: 512}, small: false},
+	}
+	for _, ts := range tt {
+		*smallTableMaxBytes, *smallTableMaxRows = ts.maxBytes, ts.maxRows
+		assert.Equal(t, ts.small, isSmallTable(ts.size), "%+v", ts)
+	}
+}
+
+func TestSmallTableSkippedStates(t *testing.T) {
+	tt := []struct {
+		lifecycle string
+		table     string
+		skipped   []schema.TableGCState
+	}{
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			skipped:   []schema.TableGCState{schema.PurgeTableGCState, schema.EvacTableGCState},
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_29990915120410",
+		},
+		{
+			lifecycle: "purge,evac,drop",
+			table:     "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_29990915120410",
+			skipped:   []schema.TableGCState{schema.PurgeTableGCState, schema.EvacTableGCState},
+		},
+		{
+			lifecycle: "hold,evac,drop",
+			table:     "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			skipped:   []schema.TableGCState{schema.EvacTableGCState},
+		},
+		{
+			lifecycle: "hold,drop",
+			table:     "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_29990915120410",
+			skipped:   []schema.TableGCState{schema.PurgeTableGCState, schema.EvacTableGCState},
+		},
+		{
+			lifecycle: "hold,purge,drop",
+			table:     "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			skipped:   []schema.TableGCState{schema.PurgeTableGCState},
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "_vt_EVAC_6ace8bcef73211ea87e9f875a4d24e90_29990915120410",
+			skipped:   []schema.TableGCState{schema.EvacTableGCState},
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "_vt_DROP_6ace8bcef73211ea87e9f875a4d24e90_29990915120410",
+		},
+		{
+			lifecycle: "hold,purge,evac,drop",
+			table:     "t",
+		},
+	}
+	collector := &TableGC{}
+	for _, ts := range tt {
+		lifecycleStates, err := schema.ParseGCLifecycle(ts.lifecycle)
+		assert.NoError(t, err)
+		assert.Equal(t, ts.skipped, collector.smallTableSkippedStates(ts.table, lifecycleStates), "%s in %s", ts.table, ts.lifecycle)
+	}
+}
+
+func TestGCTable(t *testing.T) {
+	lifecycleStates, err := schema.ParseGCLifecycle("hold,purge,evac,drop")
+	assert.NoError(t, err)
+	collector := &TableGC{
+		purgingTables: map[string]*tableSettings{
+			"_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410": {},
+		},
+	}
+
+	table, err := collector.gcTable("_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", true, 1000, lifecycleStates)
+	assert.NoError(t, err)
+	assert.Equal(t, "PURGE", table.State)
+	assert.Equal(t, "6ace8bcef73211ea87e9f875a4d24e90", table.Uuid)
+	assert.Equal(t, time.Date(2020, 9, 15, 12, 4, 10, 0, time.UTC).Unix(), table.ScheduledTime.Seconds)
+	assert.Equal(t, "EVAC", table.NextState)
+	assert.False(t, table.IsView)
+	assert.EqualValues(t, 1000, table.EstimatedRows)
+	assert.True(t, table.Purging)
+
+	// Skipped states are skipped, and DROP is the last state.
+	lifecycleStates, err = schema.ParseGCLifecycle("hold")
+	assert.NoError(t, err)
+	table, err = collector.gcTable("_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", false, 0, lifecycleStates)
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP", table.NextState)
+	assert.True(t, table.IsView)
+	assert.False(t, table.Purging)
+
+	table, err = collector.gcTable("_vt_DROP_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", true, 0, lifecycleStates)
+	assert.NoError(t, err)
+	assert.Empty(t, table.NextState)
+
+	_, err = collector.gcTable("t", true, 0, lifecycleStates)
+	assert.Error(t, err)
+}
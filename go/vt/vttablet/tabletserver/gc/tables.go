@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+)
+
+var sqlRenameTable = "rename table `%a` to `%a`"
+
+// gcTable describes a GC table. lifecycleStates are the states of its lifecycle, and rows
+// is the estimate of its number of rows.
+func (collector *TableGC) gcTable(tableName string, isBaseTable bool, rows int64, lifecycleStates map[schema.TableGCState]bool) (*tabletmanagerdatapb.GCTable, error) {
+	isGCTable, state, uuid, t, err := schema.AnalyzeGCTableName(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if !isGCTable {
+		return nil, fmt.Errorf("%s is not a GC table", tableName)
+	}
+	table := &tabletmanagerdatapb.GCTable{
+		Name:          tableName,
+		State:         string(state),
+		Uuid:          uuid,
+		ScheduledTime: logutil.TimeToProto(t),
+		IsView:        !isBaseTable,
+		EstimatedRows: rows,
+	}
+	if nextState := collector.nextState(lifecycleStates, state); nextState != nil {
+		table.NextState = string(*nextState)
+	}
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+	_, table.Purging = collector.purgingTables[tableName]
+	return table, nil
+}
+
+// readGCTables reads the GC tables of the schema, along with their settings.
+func (collector *TableGC) readGCTables(ctx context.Context, conn *connpool.DBConn) (tables []*tabletmanagerdatapb.GCTable, settings map[string]*tableSettings, err error) {
+	res, err := conn.Exec(ctx, sqlShowVtTables, math.MaxInt32, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	sizes, err := conn.Exec(ctx, sqlVtTableSizes, math.MaxInt32, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := map[string]int64{}
+	for _, row := range sizes.Rows {
+		// table_rows is NULL for views
+		tableRows, _ := row[1].ToInt64()
+		rows[row[0].ToString()] = tableRows
+	}
+	config := collector.readConfig(ctx)
+	droppedTables := collector.readDroppedTables(ctx, conn, config, res.Rows)
+
+	settings = map[string]*tableSettings{}
+	for _, row := range res.Rows {
+		tableName := row[0].ToString()
+		isBaseTable := (row[1].ToString() == "BASE TABLE")
+
+		isGCTable, _, uuid, _, _ := schema.AnalyzeGCTableName(tableName)
+		if !isGCTable {
+			continue
+		}
+		settings[tableName] = collector.tableSettings(config, droppedTables[uuid], uuid)
+		table, err := collector.gcTable(tableName, isBaseTable, rows[tableName], settings[tableName].lifecycleStates)
+		if err != nil {
+			return nil, nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, settings, nil
+}
+
+// GCTables lists the tables in the lifecycle of table GC, with their state, the time of their
+// next transition and the state they move on to.
+func (collector *TableGC) GCTables(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error) {
+	if atomic.LoadInt64(&collector.isOpen) == 0 {
+		return nil, fmt.Errorf("table GC is closed")
+	}
+	conn, err := collector.pool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	tables, _, err := collector.readGCTables(ctx, conn)
+	return tables, err
+}
+
+// AdvanceTable moves a GC table on to the next state of its lifecycle right away, regardless
+// of its scheduled time: a table in DROP state is dropped. With cancel, the table is instead
+// taken out of its lifecycle, and renamed to restoreTableName.
+// It returns the table as it was before, and its new name, which is empty if it was dropped.
+func (collector *TableGC) AdvanceTable(ctx context.Context, tableName string, cancel bool, restoreTableName string) (table *tabletmanagerdatapb.GCTable, newTableName string, err error) {
+	if atomic.LoadInt64(&collector.isOpen) == 0 {
+		return nil, "", fmt.Errorf("table GC is closed")
+	}
+	if atomic.LoadInt64(&collector.isPrimary) == 0 {
+		return nil, "", fmt.Errorf("table GC only operates on primary tablets")
+	}
+	if cancel {
+		if restoreTableName == "" {
+			return nil, "", fmt.Errorf("cancelling the lifecycle of %s requires the name to restore it to", tableName)
+		}
+		if schema.IsGCTableName(restoreTableName) {
+			return nil, "", fmt.Errorf("cannot restore %s to GC table name %s", tableName, restoreTableName)
+		}
+	}
+
+	conn, err := collector.pool.Get(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer conn.Recycle()
+
+	tables, settings, err := collector.readGCTables(ctx, conn)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, t := range tables {
+		if t.Name == tableName {
+			table = t
+		}
+	}
+	if table == nil {
+		return nil, "", fmt.Errorf("GC table %s not found", tableName)
+	}
+
+	switch {
+	case cancel:
+		parsed := sqlparser.BuildParsedQuery(sqlRenameTable, tableName, restoreTableName)
+		log.Infof("TableGC: cancelling the lifecycle of table %s, restoring it to %s", tableName, restoreTableName)
+		if _, err := conn.Exec(ctx, parsed.Query, 1, true); err != nil {
+			return nil, "", err
+		}
+		collector.removePurgingTable(tableName)
+		return table, restoreTableName, nil
+	case table.NextState == "":
+		if err := collector.dropTable(ctx, tableName); err != nil {
+			return nil, "", err
+		}
+		collector.removePurgingTable(tableName)
+		return table, "", nil
+	}
+
+	transition := &transitionRequest{
+		fromTableName: tableName,
+		isBaseTable:   !table.IsView,
+		toGCState:     schema.TableGCState(table.NextState),
+		uuid:          table.Uuid,
+		settings:      settings[tableName],
+	}
+	log.Infof("TableGC: advancing table %s to %s", tableName, transition.toGCState)
+	if newTableName, err = collector.transitionTable(ctx, transition); err != nil {
+		return nil, "", err
+	}
+	collector.removePurgingTable(tableName)
+	if transition.toGCState == schema.PurgeTableGCState && transition.isBaseTable {
+		collector.addPurgingTable(newTableName, transition.settings)
+		go func() { collector.purgeRequestsChan <- true }()
+	}
+	return table, newTableName, nil
+}
@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"math"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 
@@ -46,6 +48,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -78,6 +81,13 @@ type healthStreamer struct {
 	conns                  *connpool.Pool
 	initSuccess            bool
 	signalWhenSchemaChange bool
+
+	// dataSize returns the data size of the tablet and its growth rate.
+	// It is nil in tests.
+	dataSize func() (int64, float64)
+	// se is the schema engine, whose reloads trigger the detection of the
+	// schema changes. It is nil in tests.
+	se *schema.Engine
 }
 
 func newHealthStreamer(env tabletenv.Env, alias *topodatapb.TabletAlias) *healthStreamer {
@@ -228,6 +238,9 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 
 	hs.state.RealtimeStats.FilteredReplicationLagSeconds, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
+	if hs.dataSize != nil {
+		hs.state.RealtimeStats.DataSizeBytes, hs.state.RealtimeStats.DataGrowthBytesPerHour = hs.dataSize()
+	}
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 
@@ -309,12 +322,38 @@ func (hs *healthStreamer) SetUnhealthyThreshold(v time.Duration) {
 	}
 }
 
+// watchSchema registers for the reloads of the schema engine, so that the
+// changes that it finds, e.g. after a DDL that ran through this tablet, are
+// pushed to the clients right away instead of at the next periodic detection.
+// The schema engine forgets its notifiers when it closes, so this must be
+// called every time it opens.
+func (hs *healthStreamer) watchSchema() {
+	if hs.se == nil || !hs.signalWhenSchemaChange {
+		return
+	}
+	hs.se.RegisterNotifier("healthStreamer", hs.schemaChanged)
+}
+
+// schemaChanged is the notifier of the schema engine. The notifiers are
+// called with the schema engine locked, so the detection runs in the
+// background.
+func (hs *healthStreamer) schemaChanged(_ map[string]*schema.Table, created, altered, dropped []string) {
+	if len(created) == 0 && len(altered) == 0 && len(dropped) == 0 {
+		return
+	}
+	go func() {
+		if err := hs.reload(); err != nil {
+			log.Errorf("schema reload failed in health stream after a schema change: %v", err)
+		}
+	}()
+}
+
 // reload reloads the schema from the underlying mysql
 func (hs *healthStreamer) reload() error {
 	hs.mu.Lock()
 	defer hs.mu.Unlock()
-	// Schema Reload to happen only on primary.
-	if hs.state.Target.TabletType != topodatapb.TabletType_PRIMARY {
+	// Schema Reload to happen only on primary, while the health streamer is open.
+	if hs.cancel == nil || hs.state.Target.TabletType != topodatapb.TabletType_PRIMARY {
 		return nil
 	}
 
@@ -379,19 +418,65 @@ func (hs *healthStreamer) reload() error {
 		return err
 	}
 
+	columns, err := hs.fetchColumnsLocked(conn, tables)
+	if err != nil {
+		return err
+	}
+
 	_, err = conn.Exec(ctx, "commit", 1, false)
 	if err != nil {
 		return err
 	}
 
+	// The changes are pushed with their columns, so that vtgate doesn't need
+	// to fetch them, and numbered, so that it can tell when it missed some.
+	hs.state.RealtimeStats.SchemaGeneration++
 	hs.state.RealtimeStats.TableSchemaChanged = tables
+	hs.state.RealtimeStats.TableSchemaColumns = columns
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 	hs.broadCastToClients(shr)
 	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.state.RealtimeStats.TableSchemaColumns = nil
 
 	return nil
 }
 
+// fetchColumnsLocked returns the columns of the tables from the schema copy,
+// as pushed in the health stream.
+func (hs *healthStreamer) fetchColumnsLocked(conn *connpool.DBConn, tables []string) ([]*querypb.Field, error) {
+	stmt, err := sqlparser.Parse(mysql.FetchUpdatedTables)
+	if err != nil {
+		return nil, err
+	}
+	tableNames, err := sqltypes.BuildBindVariable(tables)
+	if err != nil {
+		return nil, err
+	}
+	query, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(map[string]*querypb.BindVariable{"tableNames": tableNames}, nil)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := conn.Exec(hs.ctx, query, math.MaxInt32, false)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]*querypb.Field, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		cType := sqlparser.ColumnType{Type: row[2].ToString()}
+		column := &querypb.Field{
+			Table:      row[0].ToString(),
+			Name:       row[1].ToString(),
+			Type:       cType.SQLType(),
+			ColumnType: cType.Type,
+		}
+		if collation := collations.Local().LookupByName(row[3].ToString()); collation != nil {
+			column.Charset = uint32(collation.ID())
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
 func (hs *healthStreamer) InitSchemaLocked(conn *connpool.DBConn) (bool, error) {
 	for _, query := range mysql.VTDatabaseInit {
 		_, err := conn.Exec(hs.ctx, query, 1, false)
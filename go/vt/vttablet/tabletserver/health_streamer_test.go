@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
@@ -176,6 +177,7 @@ func TestReloadSchema(t *testing.T) {
 	db.AddQuery("begin", &sqltypes.Result{})
 	db.AddQuery("commit", &sqltypes.Result{})
 	db.AddQuery("rollback", &sqltypes.Result{})
+	addSchemaCopyColumnsQuery(db)
 	db.AddQuery(mysql.DetectSchemaChange, sqltypes.MakeTestResult(
 		sqltypes.MakeTestFields(
 			"table_name",
@@ -194,6 +196,7 @@ func TestReloadSchema(t *testing.T) {
 		hs.Stream(ctx, func(response *querypb.StreamHealthResponse) error {
 			if response.RealtimeStats.TableSchemaChanged != nil {
 				assert.Equal(t, []string{"product", "users"}, response.RealtimeStats.TableSchemaChanged)
+				assert.Equal(t, schemaCopyColumns, response.RealtimeStats.TableSchemaColumns)
 				wg.Done()
 			}
 			return nil
@@ -289,6 +292,7 @@ func TestInitialReloadSchema(t *testing.T) {
 	db.AddQuery("begin", &sqltypes.Result{})
 	db.AddQuery("commit", &sqltypes.Result{})
 	db.AddQuery("rollback", &sqltypes.Result{})
+	addSchemaCopyColumnsQuery(db)
 	db.AddQuery(mysql.DetectSchemaChange, sqltypes.MakeTestResult(
 		sqltypes.MakeTestFields(
 			"table_name",
@@ -307,6 +311,7 @@ func TestInitialReloadSchema(t *testing.T) {
 		hs.Stream(ctx, func(response *querypb.StreamHealthResponse) error {
 			if response.RealtimeStats.TableSchemaChanged != nil {
 				assert.Equal(t, []string{"product", "users"}, response.RealtimeStats.TableSchemaChanged)
+				assert.Equal(t, schemaCopyColumns, response.RealtimeStats.TableSchemaColumns)
 				wg.Done()
 			}
 			return nil
@@ -326,6 +331,94 @@ func TestInitialReloadSchema(t *testing.T) {
 	}
 }
 
+func TestReloadSchemaOnSchemaEngineChange(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := newConfig(db)
+	// The periodic detection doesn't run during the test.
+	config.SignalSchemaChangeReloadIntervalSeconds.Set(1 * time.Minute)
+	config.SignalWhenSchemaChange = true
+
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := &topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias)
+
+	target := &querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	configs := config.DB
+
+	db.AddQuery(mysql.CreateVTDatabase, &sqltypes.Result{})
+	db.AddQuery(mysql.CreateSchemaCopyTable, &sqltypes.Result{})
+	db.AddQueryPattern(mysql.ClearSchemaCopy+".*", &sqltypes.Result{})
+	db.AddQueryPattern(mysql.InsertIntoSchemaCopy+".*", &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	addSchemaCopyColumnsQuery(db)
+	noChanges := sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name", "varchar"))
+	db.AddQuery(mysql.DetectSchemaChange, noChanges)
+
+	hs.InitDBConfig(target, configs.DbaWithDB())
+	hs.Open()
+	defer hs.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changed := make(chan *querypb.RealtimeStats, 10)
+	go func() {
+		hs.Stream(ctx, func(response *querypb.StreamHealthResponse) error {
+			if response.RealtimeStats.TableSchemaChanged != nil {
+				changed <- response.RealtimeStats
+			}
+			return nil
+		})
+	}()
+	// wait for the initial detection of the stream
+	require.Eventually(t, func() bool {
+		return db.GetQueryCalledNum(mysql.DetectSchemaChange) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a reload of the schema engine that found no change doesn't run the detection
+	called := db.GetQueryCalledNum(mysql.DetectSchemaChange)
+	hs.schemaChanged(nil, nil, nil, nil)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, called, db.GetQueryCalledNum(mysql.DetectSchemaChange))
+
+	db.AddQuery(mysql.DetectSchemaChange, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name", "varchar"),
+		"product",
+	))
+	hs.schemaChanged(nil, nil, []string{"product"}, nil)
+	select {
+	case stats := <-changed:
+		assert.Equal(t, []string{"product"}, stats.TableSchemaChanged)
+		// the changes are numbered from the first one
+		assert.EqualValues(t, 1, stats.SchemaGeneration)
+	case <-time.After(1 * time.Second):
+		t.Errorf("timed out")
+	}
+}
+
+var schemaCopyColumns = []*querypb.Field{
+	{Table: "product", Name: "id", Type: querypb.Type_INT32, ColumnType: "int"},
+	{Table: "users", Name: "name", Type: querypb.Type_VARCHAR, ColumnType: "varchar", Charset: 45},
+}
+
+// addSchemaCopyColumnsQuery adds the query of the columns of the changed
+// tables, which the primary pushes with the changes.
+func addSchemaCopyColumnsQuery(db *fakesqldb.DB) {
+	db.AddQueryPattern(`select table_name, column_name, data_type, collation_name from _vt\.schemacopy .*`, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"table_name|column_name|data_type|collation_name",
+			"varchar|varchar|varchar|varchar",
+		),
+		"product|id|int|",
+		"users|name|varchar|utf8mb4_general_ci",
+	))
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
@@ -118,7 +118,15 @@ func (me *Engine) GetGenerator(name string) (QueryGenerator, error) {
 // usually triggered by Close. It's the responsibility of the send
 // function to promptly return if the done channel is closed. Otherwise,
 // the engine's Close function will hang indefinitely.
-func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
+// If window is not 0, the subscription is sent window messages at most
+// until UpdateWindow grows its window.
+func (me *Engine) Subscribe(ctx context.Context, name string, streamID string, window int64, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
+	if window < 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid window of message stream: %d", window)
+	}
+	if window != 0 && streamID == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a message stream with a window needs a stream id")
+	}
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	if !me.isOpen {
@@ -128,7 +136,25 @@ func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltype
 	if mm == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found", name)
 	}
-	return mm.Subscribe(ctx, send), nil
+	return mm.Subscribe(ctx, streamID, window, send), nil
+}
+
+// UpdateWindow grows the window of the subscription of streamID to the
+// requested table by increment messages.
+func (me *Engine) UpdateWindow(name string, streamID string, increment int64) error {
+	if increment <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid increment of the window of message stream %s: %d", streamID, increment)
+	}
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	if !me.isOpen {
+		return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "messager engine is closed, probably because this is not a primary any more")
+	}
+	mm := me.managers[name]
+	if mm == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found", name)
+	}
+	return mm.UpdateWindow(streamID, increment)
 }
 
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
@@ -113,9 +113,9 @@ func TestSubscribe(t *testing.T) {
 	f1, ch1 := newEngineReceiver()
 	f2, ch2 := newEngineReceiver()
 	// Each receiver is subscribed to different managers.
-	engine.Subscribe(context.Background(), "t1", f1)
+	engine.Subscribe(context.Background(), "t1", "", 0, f1)
 	<-ch1
-	engine.Subscribe(context.Background(), "t2", f2)
+	engine.Subscribe(context.Background(), "t2", "", 0, f2)
 	<-ch2
 	engine.managers["t1"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	engine.managers["t2"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -124,14 +124,27 @@ func TestSubscribe(t *testing.T) {
 
 	// Error case.
 	want := "message table t3 not found"
-	_, err := engine.Subscribe(context.Background(), "t3", f1)
+	_, err := engine.Subscribe(context.Background(), "t3", "", 0, f1)
 	if err == nil || err.Error() != want {
 		t.Errorf("Subscribe: %v, want %s", err, want)
 	}
 
+	_, err = engine.Subscribe(context.Background(), "t1", "", 10, f1)
+	if got, want := vterrors.Code(err), vtrpcpb.Code_INVALID_ARGUMENT; got != want {
+		t.Errorf("Subscribe with a window and no stream id error code: %v, want %v", got, want)
+	}
+	err = engine.UpdateWindow("t1", "s1", 0)
+	if got, want := vterrors.Code(err), vtrpcpb.Code_INVALID_ARGUMENT; got != want {
+		t.Errorf("UpdateWindow with no increment error code: %v, want %v", got, want)
+	}
+	err = engine.UpdateWindow("t1", "s1", 10)
+	if got, want := vterrors.Code(err), vtrpcpb.Code_NOT_FOUND; got != want {
+		t.Errorf("UpdateWindow of no stream error code: %v, want %v", got, want)
+	}
+
 	// After close, Subscribe should return a closed channel.
 	engine.Close()
-	_, err = engine.Subscribe(context.Background(), "t1", nil)
+	_, err = engine.Subscribe(context.Background(), "t1", "", 0, nil)
 	if got, want := vterrors.Code(err), vtrpcpb.Code_UNAVAILABLE; got != want {
 		t.Errorf("Subscribed on closed engine error code: %v, want %v", got, want)
 	}
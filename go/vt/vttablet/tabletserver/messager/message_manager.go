@@ -33,7 +33,9 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -91,11 +93,23 @@ func (rcv *messageReceiver) Send(qr *sqltypes.Result) error {
 }
 
 // receiverWithStatus is a separate struct to signify
-// that the busy flag is controlled by the messageManager
-// mutex.
+// that the busy flag and the credits are controlled by
+// the messageManager mutex.
 type receiverWithStatus struct {
 	receiver *messageReceiver
 	busy     bool
+
+	// streamID identifies the receiver for UpdateWindow. If windowed is
+	// set, credits is the number of messages that the receiver can still
+	// be sent before its window grows.
+	streamID string
+	windowed bool
+	credits  int64
+}
+
+// available returns true if the receiver can be sent messages.
+func (rcv *receiverWithStatus) available() bool {
+	return !rcv.busy && (!rcv.windowed || rcv.credits > 0)
 }
 
 // messageManager manages messages for a message table.
@@ -156,6 +170,13 @@ type receiverWithStatus struct {
 // The postpone functions also needs to obtain a semaphore that limits
 // the number of tx pool connections they can occupy.
 //
+// Flow control
+// A client that subscribes with a window is sent that many messages
+// at most, until UpdateWindow grows its window as the client processes
+// them. A client without credits is not available, like a busy one, so
+// a slow client pauses its stream instead of letting messages pile up
+// on the way to it, and the messages go to the other clients.
+//
 // Client load balancing
 // The messages are sent to the clients in a round-robin fashion.
 // If, for some reason, a client is closed, the load balancer resets
@@ -372,7 +393,9 @@ func (mm *messageManager) Close() {
 // and returns a 'done' channel that will be closed when the subscription
 // ends. There are many reasons for a subscription to end: a grpc context
 // cancel or timeout, or tabletserver shutdown, etc.
-func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Result) error) <-chan struct{} {
+// If window is not 0, the receiver is sent window messages at most until
+// UpdateWindow grows its window.
+func (mm *messageManager) Subscribe(ctx context.Context, streamID string, window int64, send func(*sqltypes.Result) error) <-chan struct{} {
 	receiver, done := newMessageReceiver(ctx, send)
 
 	mm.mu.Lock()
@@ -390,6 +413,9 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 
 	withStatus := &receiverWithStatus{
 		receiver: receiver,
+		streamID: streamID,
+		windowed: window != 0,
+		credits:  window,
 	}
 	if len(mm.receivers) == 0 {
 		mm.startVStream()
@@ -431,6 +457,27 @@ func (mm *messageManager) unsubscribe(receiver *messageReceiver) {
 	}
 }
 
+// UpdateWindow grows the window of the receiver of streamID by increment
+// messages. It returns a NOT_FOUND error if there's no such receiver,
+// usually because its subscription ended.
+func (mm *messageManager) UpdateWindow(streamID string, increment int64) error {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	for _, rcv := range mm.receivers {
+		if !rcv.windowed || rcv.streamID != streamID {
+			continue
+		}
+		rcv.credits += increment
+		// Rescan if there were no available receivers, because this one
+		// may have become available.
+		if mm.curReceiver == -1 {
+			mm.rescanReceivers(-1)
+		}
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "message stream %s of %v not found", streamID, mm.name)
+}
+
 // rescanReceivers finds the next available receiver
 // using start as the starting point. If one was found,
 // it sets curReceiver to that index. If curReceiver
@@ -441,7 +488,7 @@ func (mm *messageManager) rescanReceivers(start int) {
 	cur := start
 	for range mm.receivers {
 		cur = (cur + 1) % len(mm.receivers)
-		if !mm.receivers[cur].busy {
+		if mm.receivers[cur].available() {
 			if mm.curReceiver == -1 {
 				mm.cond.Broadcast()
 			}
@@ -516,9 +563,14 @@ func (mm *messageManager) runSend() {
 				continue
 			}
 
-			// Fetch rows from cache.
+			// Fetch rows from cache, no more than the window of the
+			// receiver allows.
+			batchSize := mm.batchSize
+			if rcv := mm.receivers[mm.curReceiver]; rcv.windowed && rcv.credits < int64(batchSize) {
+				batchSize = int(rcv.credits)
+			}
 			lateCount := int64(0)
-			for i := 0; i < mm.batchSize; i++ {
+			for i := 0; i < batchSize; i++ {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
@@ -540,6 +592,12 @@ func (mm *messageManager) runSend() {
 		// to send. Reserve the receiver and find the next one.
 		receiver := mm.receivers[mm.curReceiver]
 		receiver.busy = true
+		if receiver.windowed {
+			receiver.credits -= int64(len(rows))
+			if receiver.credits == 0 {
+				MessageStats.Add([]string{mm.name.String(), "Paused"}, 1)
+			}
+		}
 		mm.rescanReceivers(mm.curReceiver)
 
 		// Send the message asynchronously.
@@ -32,15 +32,18 @@ import (
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -142,7 +145,7 @@ func TestReceiverCancel(t *testing.T) {
 	r1 := newTestReceiver(0)
 	ctx, cancel := context.WithCancel(context.Background())
 	go cancel()
-	_ = mm.Subscribe(ctx, r1.rcv)
+	_ = mm.Subscribe(ctx, "", 0, r1.rcv)
 
 	// r1 should eventually be unsubscribed.
 	for i := 0; i < 10; i++ {
@@ -188,7 +191,7 @@ func TestMessageManagerAdd(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 
 	if !mm.Add(row1) {
 		t.Error("Add(1 receiver): false, want true")
@@ -211,7 +214,7 @@ func TestMessageManagerSend(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 
 	want := &sqltypes.Result{
 		Fields: testFields,
@@ -266,7 +269,7 @@ func TestMessageManagerSend(t *testing.T) {
 	// Test that mm stops sending to a canceled receiver.
 	r2 := newTestReceiver(1)
 	ctx, cancel := context.WithCancel(context.Background())
-	mm.Subscribe(ctx, r2.rcv)
+	mm.Subscribe(ctx, "", 0, r2.rcv)
 	<-r2.ch
 
 	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -305,7 +308,7 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 	<-r1.ch
 
 	// Set the channel to verify call to Postpone.
@@ -320,7 +323,7 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 
 	// Set up a second subsriber, add a message.
 	r2 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r2.rcv)
+	mm.Subscribe(context.Background(), "", 0, r2.rcv)
 	<-r2.ch
 
 	// Wait.
@@ -356,7 +359,7 @@ func TestMessageManagerSendError(t *testing.T) {
 	ch := make(chan *sqltypes.Result)
 	go func() { <-ch }()
 	fieldSent := false
-	mm.Subscribe(ctx, func(qr *sqltypes.Result) error {
+	mm.Subscribe(ctx, "", 0, func(qr *sqltypes.Result) error {
 		ch <- qr
 		if !fieldSent {
 			fieldSent = true
@@ -384,7 +387,7 @@ func TestMessageManagerFieldSendError(t *testing.T) {
 
 	ch := make(chan *sqltypes.Result)
 	go func() { <-ch }()
-	done := mm.Subscribe(ctx, func(qr *sqltypes.Result) error {
+	done := mm.Subscribe(ctx, "", 0, func(qr *sqltypes.Result) error {
 		ch <- qr
 		return errors.New("non-eof")
 	})
@@ -402,7 +405,7 @@ func TestMessageManagerBatchSend(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 	<-r1.ch
 
 	row1 := &MessageRow{
@@ -437,6 +440,47 @@ func TestMessageManagerBatchSend(t *testing.T) {
 	}
 }
 
+func TestMessageManagerWindow(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.BatchSize = 2
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(10)
+	mm.Subscribe(context.Background(), "s1", 3, r1.rcv)
+	<-r1.ch
+
+	for i := 1; i <= 5; i++ {
+		mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary(fmt.Sprint(i)), sqltypes.NULL}})
+	}
+	receive := func(n int) {
+		t.Helper()
+		for n > 0 {
+			select {
+			case qr := <-r1.ch:
+				require.LessOrEqual(t, len(qr.Rows), n)
+				n -= len(qr.Rows)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%d messages were not received", n)
+			}
+		}
+	}
+	// The window lets 3 messages through, and then the stream pauses.
+	receive(3)
+	select {
+	case qr := <-r1.ch:
+		t.Fatalf("received %v past the window", qr)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, mm.UpdateWindow("s1", 2))
+	receive(2)
+
+	err := mm.UpdateWindow("s2", 2)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+}
+
 func TestMessageManagerStreamerSimple(t *testing.T) {
 	fvs := newFakeVStreamer()
 	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
@@ -472,7 +516,7 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 	<-r1.ch
 
 	want := &sqltypes.Result{
@@ -497,7 +541,7 @@ func TestMessageManagerStreamerAndPoller(t *testing.T) {
 	defer mm.Close()
 
 	r1 := newTestReceiver(1)
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 	<-r1.ch
 
 	for {
@@ -597,7 +641,7 @@ func TestMessageManagerPoller(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	r1 := newTestReceiver(1)
-	mm.Subscribe(ctx, r1.rcv)
+	mm.Subscribe(ctx, "", 0, r1.rcv)
 	<-r1.ch
 
 	want := [][]sqltypes.Value{{
@@ -653,7 +697,7 @@ func TestMessagesPending1(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 
 	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	// Make sure the first message is enqueued.
@@ -702,7 +746,7 @@ func TestMessagesPending2(t *testing.T) {
 
 	r1 := newTestReceiver(0)
 	go func() { <-r1.ch }()
-	mm.Subscribe(context.Background(), r1.rcv)
+	mm.Subscribe(context.Background(), "", 0, r1.rcv)
 
 	// Now, let's pull more than 1 item. It should
 	// trigger the poller every time cache gets empty.
@@ -76,6 +76,10 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		plan.WhereClause = buf.ParsedQuery()
 	}
 
+	if err := analyzeOCCColumn(upd, plan); err != nil {
+		return nil, err
+	}
+
 	// Situations when we pass-through:
 	// PassthroughDMLs flag is set.
 	// plan.Table==nil: it's likely a multi-table statement. MySQL doesn't allow limit clauses for multi-table dmls.
@@ -92,6 +96,25 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// analyzeOCCColumn makes an UPDATE with an OCC_COLUMN directive only change
+// the rows whose version column still has the expected value. The hot row
+// protection keeps serializing the UPDATEs by their original WHERE clause.
+func analyzeOCCColumn(upd *sqlparser.Update, plan *Plan) error {
+	column, err := sqlparser.OCCColumnDirective(upd)
+	if err != nil {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	if column.IsEmpty() {
+		return nil
+	}
+	if plan.Table == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s directive: the UPDATE must change a single table", sqlparser.DirectiveOCCColumn)
+	}
+	sqlparser.AddOCCCondition(upd, column)
+	plan.OCCColumn = column
+	return nil
+}
+
 // analyzeDelete code is almost identical to analyzeUpdate.
 func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -175,6 +176,14 @@ type Plan struct {
 
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
+
+	// MaxLag is the maximum replication lag of the tablet that serves the
+	// query, as set by the MAX_LAG directive of SELECTs. 0 means no bound.
+	MaxLag time.Duration
+
+	// OCCColumn is the version column that an UPDATE with an OCC_COLUMN
+	// directive checks: the UPDATE fails if it matches no row.
+	OCCColumn sqlparser.ColIdent
 }
 
 // TableName returns the table name for the plan.
@@ -264,6 +273,9 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 	if err != nil {
 		return nil, err
 	}
+	if plan.MaxLag, err = sqlparser.MaxLagDirective(statement); err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
 	plan.Permissions = BuildPermissions(statement)
 	return plan, nil
 }
@@ -299,6 +311,9 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "'%v' not allowed for streaming", sqlparser.String(stmt))
 	}
+	if plan.MaxLag, err = sqlparser.MaxLagDirective(statement); err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
 
 	return plan, nil
 }
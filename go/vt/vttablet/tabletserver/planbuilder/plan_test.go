@@ -48,6 +48,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
 		NextCount   string                 `json:",omitempty"`
 		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		MaxLag      string                 `json:",omitempty"`
+		OCCColumn   string                 `json:",omitempty"`
 	}{
 		PlanID:      p.PlanID,
 		TableName:   p.TableName(),
@@ -55,10 +57,14 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FieldQuery:  p.FieldQuery,
 		FullQuery:   p.FullQuery,
 		WhereClause: p.WhereClause,
+		OCCColumn:   p.OCCColumn.String(),
 	}
 	if p.NextCount != nil {
 		mplan.NextCount = evalengine.FormatExpr(p.NextCount)
 	}
+	if p.MaxLag != 0 {
+		mplan.MaxLag = p.MaxLag.String()
+	}
 	return json.Marshal(&mplan)
 }
 
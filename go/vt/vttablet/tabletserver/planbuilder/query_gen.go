@@ -18,6 +18,8 @@ package planbuilder
 
 import (
 	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // GenerateFullQuery generates the full query from the ast.
@@ -64,3 +66,20 @@ func GenerateLimitQuery(selStmt sqlparser.SelectStatement) *sqlparser.ParsedQuer
 	buf.Myprintf("%v", selStmt)
 	return buf.ParsedQuery()
 }
+
+// GenerateConsolidationQuery generates the query that the consolidator keys
+// the executions of a select on: the query of generate, run on the select
+// without its comments and with the literals of its WHERE and LIMIT clauses
+// replaced by bind variables, and the values of those bind variables. The
+// literals of the select expressions are kept, since they name the columns
+// of the result. It changes the select, and returns a nil query if the select
+// can't be normalized.
+func GenerateConsolidationQuery(selStmt sqlparser.SelectStatement, generate func(sqlparser.SelectStatement) *sqlparser.ParsedQuery) (*sqlparser.ParsedQuery, map[string]*querypb.BindVariable) {
+	selStmt.SetComments(nil)
+	bindVars := make(map[string]*querypb.BindVariable)
+	reserved := sqlparser.NewReservedVars("vtc", sqlparser.GetBindvars(selStmt))
+	if err := sqlparser.NormalizeFilters(selStmt, reserved, bindVars); err != nil {
+		return nil, nil
+	}
+	return generate(selStmt), bindVars
+}
@@ -61,6 +61,12 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
 
+	// consolidationQuery is the normalized query that the consolidator keys
+	// the executions of a select on, with the values of its literals in
+	// consolidationBindVars. It's only built with consolidator_normalize_queries.
+	consolidationQuery    *sqlparser.ParsedQuery
+	consolidationBindVars map[string]*querypb.BindVariable
+
 	QueryCount   uint64
 	Time         uint64
 	MysqlTime    uint64
@@ -69,6 +75,27 @@ type TabletPlan struct {
 	ErrorCount   uint64
 }
 
+// buildConsolidationQuery builds the normalized query of a select, that the
+// consolidator keys its executions on. It changes the statement.
+func (ep *TabletPlan) buildConsolidationQuery(stmt sqlparser.Statement) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		if ep.PlanID == planbuilder.PlanSelectStream {
+			ep.consolidationQuery, ep.consolidationBindVars = planbuilder.GenerateConsolidationQuery(stmt, generateFullSelect)
+			return
+		}
+		ep.consolidationQuery, ep.consolidationBindVars = planbuilder.GenerateConsolidationQuery(stmt, planbuilder.GenerateLimitQuery)
+	case *sqlparser.Union:
+		if ep.PlanID != planbuilder.PlanSelectStream {
+			ep.consolidationQuery, ep.consolidationBindVars = planbuilder.GenerateConsolidationQuery(stmt, planbuilder.GenerateLimitQuery)
+		}
+	}
+}
+
+func generateFullSelect(sel sqlparser.SelectStatement) *sqlparser.ParsedQuery {
+	return planbuilder.GenerateFullQuery(sel)
+}
+
 // AddStats updates the stats for the current TabletPlan.
 func (ep *TabletPlan) AddStats(queryCount uint64, duration, mysqlTime time.Duration, rowsAffected, rowsReturned, errorCount uint64) {
 	atomic.AddUint64(&ep.QueryCount, queryCount)
@@ -147,6 +174,7 @@ type QueryEngine struct {
 	strictTransTables bool
 
 	consolidatorMode            sync2.AtomicString
+	consolidatorNormalize       bool
 	enableQueryPlanFieldCaching bool
 
 	// stats
@@ -178,6 +206,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
 	qe.consolidatorMode.Set(config.Consolidator)
+	qe.consolidatorNormalize = config.ConsolidatorNormalizeQueries
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
 	if config.ConsolidatorStreamTotalSize > 0 && config.ConsolidatorStreamQuerySize > 0 {
@@ -315,6 +344,9 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableNames()...)
 	plan.buildAuthorized()
+	if qe.consolidatorNormalize {
+		plan.buildConsolidationQuery(sqlparser.CloneStatement(statement))
+	}
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
 			conn, err := qe.conns.Get(ctx)
@@ -353,6 +385,11 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	if qe.consolidatorNormalize && qe.streamConsolidator != nil {
+		if statement, err := sqlparser.Parse(sql); err == nil {
+			plan.buildConsolidationQuery(statement)
+		}
+	}
 	return plan, nil
 }
 
@@ -18,8 +18,11 @@ package tabletserver
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -129,6 +132,18 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if err := qre.checkReplicationLag(); err != nil {
+		return nil, err
+	}
+	if err := qre.checkReadAfterWrite(); err != nil {
+		return nil, err
+	}
+	if err := qre.checkReadAtTimestamp(); err != nil {
+		return nil, err
+	}
+	if err := qre.checkOCCOldValue(); err != nil {
+		return nil, err
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -235,12 +250,12 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result, error) {
 	switch qre.plan.PlanID {
 	case p.PlanInsert, p.PlanUpdate, p.PlanDelete, p.PlanSet:
-		return qre.txFetch(conn, true)
+		return qre.checkOCCConflict(qre.txFetch(conn, true))
 	case p.PlanInsertMessage:
 		qre.bindVars["#time_now"] = sqltypes.Int64BindVariable(time.Now().UnixNano())
 		return qre.txFetch(conn, true)
 	case p.PlanUpdateLimit, p.PlanDeleteLimit:
-		return qre.execDMLLimit(conn)
+		return qre.checkOCCConflict(qre.execDMLLimit(conn))
 	case p.PlanOtherRead, p.PlanOtherAdmin, p.PlanFlush:
 		return qre.execStatefulConn(conn, qre.query, true)
 	case p.PlanSavepoint, p.PlanRelease, p.PlanSRollback:
@@ -281,6 +296,15 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	if err := qre.checkReplicationLag(); err != nil {
+		return err
+	}
+	if err := qre.checkReadAfterWrite(); err != nil {
+		return err
+	}
+	if err := qre.checkReadAtTimestamp(); err != nil {
+		return err
+	}
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -294,7 +318,8 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 
 	if consolidator := qre.tsv.qe.streamConsolidator; consolidator != nil {
 		if qre.connID == 0 && qre.plan.PlanID == p.PlanSelectStream && qre.shouldConsolidate() {
-			return consolidator.Consolidate(qre.logStats, sqlWithoutComments, callback,
+			key, _ := qre.consolidationKey(sqlWithoutComments)
+			return consolidator.Consolidate(qre.logStats, key, callback,
 				func(callback StreamCallback) error {
 					dbConn, err := qre.getStreamConn()
 					if err != nil {
@@ -345,8 +370,10 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	})
 }
 
-// MessageStream streams messages from a message table.
-func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
+// MessageStream streams messages from a message table. If window is not 0,
+// the stream of streamID is sent window messages at most until its window
+// grows.
+func (qre *QueryExecutor) MessageStream(streamID string, window int64, callback StreamCallback) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
@@ -359,7 +386,7 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 		return err
 	}
 
-	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
+	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), streamID, window, func(r *sqltypes.Result) error {
 		select {
 		case <-qre.ctx.Done():
 			return io.EOF
@@ -374,6 +401,135 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	return nil
 }
 
+// checkReplicationLag returns an error if the replication lag of the tablet
+// exceeds the MAX_LAG of the query, so that vtgate retries it on a fresher
+// tablet. The lag of a primary is always 0.
+func (qre *QueryExecutor) checkReplicationLag() error {
+	if qre.plan.MaxLag == 0 {
+		return nil
+	}
+	lag, err := qre.tsv.sm.rt.Lag()
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: %v", vterrors.ReplicationLagTooHigh, err)
+	}
+	if lag > qre.plan.MaxLag {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: %v exceeds the max lag of %v", vterrors.ReplicationLagTooHigh, lag, qre.plan.MaxLag)
+	}
+	return nil
+}
+
+// checkOCCOldValue returns an error if an UPDATE with an OCC_COLUMN directive
+// lacks the bind variable with the value that its version column must have.
+func (qre *QueryExecutor) checkOCCOldValue() error {
+	if qre.plan.OCCColumn.IsEmpty() {
+		return nil
+	}
+	if qre.bindVars[sqlparser.OCCOldValueBindVar] == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s directive: the bind variable %s must hold the expected value of %s", sqlparser.DirectiveOCCColumn, sqlparser.OCCOldValueBindVar, qre.plan.OCCColumn)
+	}
+	return nil
+}
+
+// checkOCCConflict turns the result of an UPDATE with an OCC_COLUMN directive
+// that matched no row into an optimistic lock conflict error: another
+// transaction changed the version of the row, or deleted it, since it was
+// read. The rows matched by the UPDATE come from its info when the tablet
+// parses it, and otherwise from its affected rows, which only count the
+// matched rows that didn't change if the session sets client_found_rows.
+func (qre *QueryExecutor) checkOCCConflict(qr *sqltypes.Result, err error) (*sqltypes.Result, error) {
+	if err != nil || qre.plan.OCCColumn.IsEmpty() {
+		return qr, err
+	}
+	matched := qr.RowsAffected
+	if rows, ok := sqltypes.InfoCount(qr.Info, "Rows matched"); ok {
+		matched = rows
+	}
+	if matched == 0 {
+		qre.tsv.Stats().OptimisticLockConflicts.Add(qre.plan.TableName().String(), 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "%s: no row of %s has the expected %s", vterrors.OptimisticLockConflict, qre.plan.TableName(), qre.plan.OCCColumn)
+	}
+	return qr, nil
+}
+
+// checkReadAfterWrite waits until the tablet has executed the GTID set of
+// the writes that the read must see, for at most the timeout of the read.
+// It returns an error if the tablet did not catch up in time, so that vtgate
+// sends the read to the primary. A primary has executed all the writes.
+func (qre *QueryExecutor) checkReadAfterWrite() error {
+	gtid := qre.options.GetReadAfterWriteGtid()
+	if gtid == "" || qre.tsv.sm.target.TabletType == topodatapb.TabletType_PRIMARY {
+		return nil
+	}
+	conn, err := qre.getConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	sql := fmt.Sprintf("SELECT WAIT_FOR_EXECUTED_GTID_SET(%s, %v)", sqltypes.EncodeStringSQL(gtid), qre.options.GetReadAfterWriteTimeout())
+	qr, err := conn.Exec(qre.ctx, sql, 1, false)
+	if err != nil {
+		return err
+	}
+	// WAIT_FOR_EXECUTED_GTID_SET returns 0 once the GTID set is executed, and 1 on timeout.
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 || qr.Rows[0][0].ToString() != "0" {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: %s", vterrors.ReadAfterWriteTimeout, gtid)
+	}
+	return nil
+}
+
+// replicationLag returns the replication lag of the tablet when it runs the
+// query, if the query asked for it with include_replication_lag, or 0. The
+// lag of a primary, or of a tablet whose replication is broken, is not
+// returned.
+func (qre *QueryExecutor) replicationLag() time.Duration {
+	if !qre.options.GetIncludeReplicationLag() || qre.tsv.sm.target.TabletType == topodatapb.TabletType_PRIMARY {
+		return 0
+	}
+	lag, err := qre.tsv.sm.rt.Lag()
+	if err != nil {
+		return 0
+	}
+	return lag
+}
+
+// readAtTimestampPollInterval is how often a tablet checks its replication
+// lag while it waits to reach the read_at_timestamp of a read.
+var readAtTimestampPollInterval = 100 * time.Millisecond
+
+// checkReadAtTimestamp waits until the tablet has replicated the writes
+// committed up to the read_at_timestamp of the read, that is until its
+// replication lag is lower than the time elapsed since then, for at most
+// the timeout of the read. It returns an error if the tablet did not reach
+// the timestamp in time, so that vtgate sends the read to the primary.
+// The timestamp is a freshness floor, not a snapshot: the read sees the data
+// of the tablet when it runs, which may include later writes.
+func (qre *QueryExecutor) checkReadAtTimestamp() error {
+	if qre.options.GetReadAtTimestamp() == 0 {
+		return nil
+	}
+	readAt := time.Unix(0, qre.options.GetReadAtTimestamp())
+	deadline := time.Now().Add(time.Duration(qre.options.GetReadAfterWriteTimeout() * float64(time.Second)))
+	for {
+		lag, err := qre.tsv.sm.rt.Lag()
+		now := time.Now()
+		if err == nil && !now.Add(-lag).Before(readAt) {
+			return nil
+		}
+		if !now.Before(deadline) {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s: %s", vterrors.ReadAtTimestampTimeout, readAt.UTC().Format(time.RFC3339Nano))
+		}
+		wait := deadline.Sub(now)
+		if wait > readAtTimestampPollInterval {
+			wait = readAtTimestampPollInterval
+		}
+		select {
+		case <-qre.ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "waiting for the read timestamp: %v", qre.ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
 // checkPermissions returns an error if the query does not pass all checks
 // (denied query, table ACL).
 func (qre *QueryExecutor) checkPermissions() error {
@@ -722,7 +878,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 	}
 	// Check tablet type.
 	if qre.shouldConsolidate() {
-		q, original := qre.tsv.qe.consolidator.Create(sqlWithoutComments)
+		q, original := qre.tsv.qe.consolidator.CreateWithFingerprint(qre.consolidationKey(sqlWithoutComments))
 		if original {
 			defer q.Broadcast()
 			conn, err := qre.getConn()
@@ -756,6 +912,63 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 	return res, nil
 }
 
+// consolidationKey returns the key that the consolidator consolidates the
+// query on, and the fingerprint that it counts the consolidations under.
+// With consolidator_normalize_queries, the selects are keyed on the normalized
+// query of their plan and a hash of the SQL encoding of the canonical values of
+// its bind variables, so that the selects share their execution when they only
+// differ by their comments, the text of the integer literals of their WHERE and
+// LIMIT clauses, or the types of their integer bind variables. The other
+// queries are keyed on their SQL without comments.
+func (qre *QueryExecutor) consolidationKey(sqlWithoutComments string) (key, fingerprint string) {
+	query := qre.plan.consolidationQuery
+	if query == nil {
+		return sqlWithoutComments, sqlWithoutComments
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(qre.bindVars)+len(qre.plan.consolidationBindVars))
+	for name, bv := range qre.bindVars {
+		bindVars[name] = canonicalBindVar(bv)
+	}
+	// The bind variables of the literals are named so that they don't
+	// collide with the ones of the query, but the request can have more.
+	for name, bv := range qre.plan.consolidationBindVars {
+		bindVars[name] = canonicalBindVar(bv)
+	}
+	values, err := query.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return sqlWithoutComments, sqlWithoutComments
+	}
+	hash := sha256.Sum256([]byte(values))
+	return query.Query + " /* " + hex.EncodeToString(hash[:]) + " */", query.Query
+}
+
+// canonicalBindVar returns the bind variable with its integral values in
+// their decimal representation, e.g. 1 for 01.
+func canonicalBindVar(bv *querypb.BindVariable) *querypb.BindVariable {
+	if bv.Type != querypb.Type_TUPLE {
+		return &querypb.BindVariable{Type: bv.Type, Value: canonicalValue(bv.Type, bv.Value)}
+	}
+	canonical := &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: make([]*querypb.Value, 0, len(bv.Values))}
+	for _, v := range bv.Values {
+		canonical.Values = append(canonical.Values, &querypb.Value{Type: v.Type, Value: canonicalValue(v.Type, v.Value)})
+	}
+	return canonical
+}
+
+func canonicalValue(typ querypb.Type, val []byte) []byte {
+	switch {
+	case sqltypes.IsSigned(typ):
+		if i, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+			return strconv.AppendInt(nil, i, 10)
+		}
+	case sqltypes.IsUnsigned(typ):
+		if u, err := strconv.ParseUint(string(val), 10, 64); err == nil {
+			return strconv.AppendUint(nil, u, 10)
+		}
+	}
+	return val
+}
+
 // txFetch fetches from a TxConnection.
 func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqltypes.Result, error) {
 	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
@@ -884,6 +1097,9 @@ func (qre *QueryExecutor) execAlterMigration() (*sqltypes.Result, error) {
 		return qre.tsv.onlineDDLExecutor.CleanupMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CompleteMigrationType:
 		return qre.tsv.onlineDDLExecutor.CompleteMigration(qre.ctx, alterMigration.UUID)
+	case sqlparser.CutOverMigrationType:
+		// -postpone-cut-over postpones the completion of the migration.
+		return qre.tsv.onlineDDLExecutor.CompleteMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CancelMigrationType:
 		return qre.tsv.onlineDDLExecutor.CancelMigration(qre.ctx, alterMigration.UUID, "CANCEL issued by user")
 	case sqlparser.CancelAllMigrationType:
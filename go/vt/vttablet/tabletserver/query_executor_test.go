@@ -17,12 +17,14 @@ limitations under the License.
 package tabletserver
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -652,6 +654,173 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQueryExecutorMaxLag(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select /*vt+ MAX_LAG=5s */ * from test_table"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select /*vt+ MAX_LAG=5s */ * from test_table limit 10001", want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rt := &testReplTracker{lag: 10 * time.Second}
+	tsv.sm.rt = rt
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	assert.Equal(t, 5*time.Second, qre.plan.MaxLag)
+	_, err := qre.Execute()
+	require.EqualError(t, err, "replication lag too high: 10s exceeds the max lag of 5s")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	err = newTestQueryExecutor(ctx, tsv, query, 0).Stream(func(*sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "replication lag too high: 10s exceeds the max lag of 5s")
+
+	rt.lag, rt.err = 0, errors.New("replication is not running")
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.EqualError(t, err, "replication lag too high: replication is not running")
+
+	rt.lag, rt.err = time.Second, nil
+	got, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestQueryExecutorOCCColumn(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "update /*vt+ OCC_COLUMN=b */ test_table set a = 1, b = b + 1 where pk = 1"
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.EqualError(t, err, "OCC_COLUMN directive: the bind variable old must hold the expected value of b")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	// The row still has the version 3.
+	db.AddQuery("update /*vt+ OCC_COLUMN=b */ test_table set a = 1, b = b + 1 where pk = 1 and b = 3 limit 10001", &sqltypes.Result{RowsAffected: 1})
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.bindVars["old"] = sqltypes.Int64BindVariable(3)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, got.RowsAffected)
+
+	// Another transaction changed the version to 4.
+	db.AddQuery("update /*vt+ OCC_COLUMN=b */ test_table set a = 1, b = b + 1 where pk = 1 and b = 4 limit 10001", &sqltypes.Result{})
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.bindVars["old"] = sqltypes.Int64BindVariable(4)
+	_, err = qre.Execute()
+	require.EqualError(t, err, "optimistic lock conflict: no row of test_table has the expected b")
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	assert.EqualValues(t, 1, tsv.stats.OptimisticLockConflicts.Counts()["test_table"])
+}
+
+func TestQueryExecutorReadAfterWrite(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table limit 10001", want)
+	waitResult := func(result string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("result", "int64"), result)
+	}
+	waitQuery := "SELECT WAIT_FOR_EXECUTED_GTID_SET('00010203-0405-0607-0809-0a0b0c0d0e0f:1-5', 0.5)"
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	options := &querypb.ExecuteOptions{
+		ReadAfterWriteGtid:    "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		ReadAfterWriteTimeout: 0.5,
+	}
+	newExecutor := func() *QueryExecutor {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.options = options
+		return qre
+	}
+
+	// a primary has executed all the writes
+	tsv.sm.target.TabletType = topodatapb.TabletType_PRIMARY
+	_, err := newExecutor().Execute()
+	require.NoError(t, err)
+	assert.Zero(t, db.GetQueryCalledNum(waitQuery))
+
+	tsv.sm.target.TabletType = topodatapb.TabletType_REPLICA
+	db.AddQuery(waitQuery, waitResult("0"))
+	got, err := newExecutor().Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum(waitQuery))
+
+	db.AddQuery(waitQuery, waitResult("1"))
+	_, err = newExecutor().Execute()
+	require.EqualError(t, err, "timed out waiting for the writes of the session: 00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	err = newExecutor().Stream(func(*sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "timed out waiting for the writes of the session: 00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+}
+
+func TestQueryExecutorReadAtTimestamp(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table limit 10001", want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rt := &testReplTracker{lag: 10 * time.Second}
+	tsv.sm.rt = rt
+	readAt := time.Now().Add(-5 * time.Second)
+	newExecutor := func(readAt time.Time) *QueryExecutor {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.options = &querypb.ExecuteOptions{
+			ReadAtTimestamp:       readAt.UnixNano(),
+			ReadAfterWriteTimeout: 0.2,
+		}
+		return qre
+	}
+
+	// the tablet only replicated the writes up to 10s ago
+	wantErr := fmt.Sprintf("timed out waiting for the read timestamp: %s", readAt.UTC().Format(time.RFC3339Nano))
+	_, err := newExecutor(readAt).Execute()
+	require.EqualError(t, err, wantErr)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	err = newExecutor(readAt).Stream(func(*sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, wantErr)
+
+	rt.lag, rt.err = 0, errors.New("replication is not running")
+	_, err = newExecutor(readAt).Execute()
+	require.EqualError(t, err, wantErr)
+
+	rt.lag, rt.err = time.Second, nil
+	got, err := newExecutor(readAt).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// the tablet waits for a timestamp to come
+	rt.lag = 0
+	_, err = newExecutor(time.Now().Add(50 * time.Millisecond)).Execute()
+	require.NoError(t, err)
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -814,7 +983,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 
 	// Should not fail because u1 has permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", 0, func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 	if err != nil {
@@ -827,7 +996,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 	qre.ctx = callerid.NewContext(context.Background(), nil, callerID)
 	// Should fail because u2 does not have permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", 0, func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 
@@ -1222,6 +1391,67 @@ func TestQueryExecutorDenyListQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorConsolidationKey(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// Without consolidator_normalize_queries, the selects are keyed on their SQL.
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table where pk = 1", 0)
+	key0, fingerprint := qre.consolidationKey("select * from test_table where pk = 1 limit 10001")
+	assert.Equal(t, "select * from test_table where pk = 1 limit 10001", key0)
+	assert.Equal(t, key0, fingerprint)
+
+	tsv.qe.consolidatorNormalize = true
+	key := func(sql string, bindVars map[string]*querypb.BindVariable) (string, string) {
+		qre := newTestQueryExecutor(ctx, tsv, sql, 0)
+		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(10001)
+		for name, bv := range bindVars {
+			qre.bindVars[name] = bv
+		}
+		return qre.consolidationKey(sql)
+	}
+	names := func(values ...string) map[string]*querypb.BindVariable {
+		var list []any
+		for _, v := range values {
+			list = append(list, v)
+		}
+		bv, err := sqltypes.BuildBindVariable(list)
+		require.NoError(t, err)
+		return map[string]*querypb.BindVariable{"names": bv}
+	}
+
+	key1, fingerprint := key("select * from test_table where pk = 1 and name in ::names", names("a", "b"))
+	assert.Equal(t, "select * from test_table where pk = :vtc1 and `name` in ::names limit :#maxLimit", fingerprint)
+	key2, _ := key("select /* app */ * from test_table where pk = 01 and name in ::names", names("a", "b"))
+	assert.Equal(t, key1, key2)
+	// the values of the IN lists are kept in their order
+	key11, _ := key("select * from test_table where pk = 1 and name in ::names", names("b", "a"))
+	assert.NotEqual(t, key1, key11)
+	key3, _ := key("select * from test_table where pk = 2 and name in ::names", names("a", "b"))
+	assert.NotEqual(t, key1, key3)
+	key4, _ := key("select * from test_table where pk = 1 and name in ::names", names("a", "c"))
+	assert.NotEqual(t, key1, key4)
+
+	key5, _ := key("select * from test_table where pk = :pk", map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)})
+	key6, _ := key("select * from test_table where pk = :pk", map[string]*querypb.BindVariable{"pk": sqltypes.Uint64BindVariable(1)})
+	assert.Equal(t, key5, key6)
+	key7, _ := key("select * from test_table where pk = :pk", map[string]*querypb.BindVariable{"pk": sqltypes.StringBindVariable("1")})
+	assert.NotEqual(t, key5, key7)
+
+	// the literals of the select expressions name the result columns
+	key9, _ := key("select 01 from test_table where pk = 1", nil)
+	key10, _ := key("select 1 from test_table where pk = 1", nil)
+	assert.NotEqual(t, key9, key10)
+
+	// the other queries are keyed on their SQL
+	key8, fingerprint := key("insert into test_table(pk) values (1)", nil)
+	assert.Equal(t, "insert into test_table(pk) values (1)", key8)
+	assert.Equal(t, key8, fingerprint)
+}
+
 type executorFlags int64
 
 const (
@@ -61,9 +61,11 @@ type heartbeatReader struct {
 	pool   *connpool.Pool
 	ticks  *timer.Timer
 
-	lagMu          sync.Mutex
-	lastKnownLag   time.Duration
-	lastKnownError error
+	lagMu        sync.Mutex
+	lastKnownLag time.Duration
+	// lastKnownHeartbeat is the time of the most recent heartbeat read.
+	lastKnownHeartbeat time.Time
+	lastKnownError     error
 }
 
 // newHeartbeatReader returns a new heartbeatReader.
@@ -136,6 +138,22 @@ func (r *heartbeatReader) Status() (time.Duration, error) {
 	return r.lastKnownLag, nil
 }
 
+// Lag returns the replication lag at the current time, which is the time
+// elapsed since the most recent heartbeat read. Unlike the lag of Status,
+// which is measured when the heartbeat is read, it keeps growing until the
+// next read, so that it bounds the staleness of the data a query reads now.
+func (r *heartbeatReader) Lag() (time.Duration, error) {
+	r.lagMu.Lock()
+	defer r.lagMu.Unlock()
+	if r.lastKnownError != nil {
+		return 0, r.lastKnownError
+	}
+	if r.lastKnownHeartbeat.IsZero() {
+		return r.lastKnownLag, nil
+	}
+	return r.now().Sub(r.lastKnownHeartbeat), nil
+}
+
 // readHeartbeat reads from the heartbeat table exactly once, updating
 // the last known lag and/or error, and incrementing counters.
 func (r *heartbeatReader) readHeartbeat() {
@@ -155,7 +173,8 @@ func (r *heartbeatReader) readHeartbeat() {
 		return
 	}
 
-	lag := r.now().Sub(time.Unix(0, ts))
+	heartbeat := time.Unix(0, ts)
+	lag := r.now().Sub(heartbeat)
 	cumulativeLagNs.Add(lag.Nanoseconds())
 	currentLagNs.Set(lag.Nanoseconds())
 	heartbeatLagNsHistogram.Add(lag.Nanoseconds())
@@ -163,6 +182,7 @@ func (r *heartbeatReader) readHeartbeat() {
 
 	r.lagMu.Lock()
 	r.lastKnownLag = lag
+	r.lastKnownHeartbeat = heartbeat
 	r.lastKnownError = nil
 	r.lagMu.Unlock()
 }
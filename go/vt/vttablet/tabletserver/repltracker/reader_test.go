@@ -77,6 +77,15 @@ func TestReaderReadHeartbeat(t *testing.T) {
 		">1000s": int64(0),
 	}
 	utils.MustMatch(t, expectedHisto, heartbeatLagNsHistogram.Counts(), "wrong counts in histogram")
+
+	// The lag at the current time keeps growing until the next read.
+	tr.now = func() time.Time { return now.Add(250 * time.Millisecond) }
+	lag, err = tr.Lag()
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second+250*time.Millisecond, lag)
+	lag, err = tr.Status()
+	require.NoError(t, err)
+	assert.Equal(t, expectedLag, lag)
 }
 
 // TestReaderReadHeartbeatError tests that we properly account for errors
@@ -96,6 +105,8 @@ func TestReaderReadHeartbeatError(t *testing.T) {
 	require.Error(t, err)
 	assert.EqualError(t, err, tr.lastKnownError.Error(), "expected error")
 	assert.Equal(t, 0*time.Second, lag, "wrong lastKnownLag")
+	_, err = tr.Lag()
+	assert.EqualError(t, err, tr.lastKnownError.Error(), "expected error")
 	assert.Equal(t, int64(0), cumulativeLagNs.Get(), "wrong cumulative lag")
 	assert.Equal(t, int64(1), readErrors.Get(), "wrong read error count")
 }
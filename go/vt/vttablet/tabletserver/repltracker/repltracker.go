@@ -137,6 +137,24 @@ func (rt *ReplTracker) Status() (time.Duration, error) {
 	return rt.poller.Status()
 }
 
+// Lag reports the replication lag at the current time. With heartbeats, it
+// is the time elapsed since the most recent heartbeat, with their resolution,
+// rather than the lag when that heartbeat was read. Otherwise it is the lag
+// of Status.
+func (rt *ReplTracker) Lag() (time.Duration, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	switch {
+	case rt.isPrimary || rt.mode == tabletenv.Disable:
+		return 0, nil
+	case rt.mode == tabletenv.Heartbeat:
+		return rt.hr.Lag()
+	}
+	// rt.mode == tabletenv.Poller
+	return rt.poller.Status()
+}
+
 // EnableHeartbeat enables or disables writes of heartbeat. This functionality
 // is only used by tests.
 func (rt *ReplTracker) EnableHeartbeat(enable bool) {
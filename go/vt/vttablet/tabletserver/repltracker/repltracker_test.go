@@ -74,6 +74,11 @@ func TestReplTracker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1*time.Second, lag)
 
+	rt.hr.lastKnownHeartbeat = time.Now().Add(-2 * time.Second)
+	lag, err = rt.Lag()
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, lag, 2*time.Second)
+
 	rt.Close()
 	assert.False(t, rt.hw.isOpen)
 	assert.False(t, rt.hr.isOpen)
@@ -94,4 +99,6 @@ func TestReplTracker(t *testing.T) {
 	mysqld.ReplicationStatusError = errors.New("err")
 	_, err = rt.Status()
 	assert.Equal(t, "err", err.Error())
+	_, err = rt.Lag()
+	assert.Equal(t, "err", err.Error())
 }
@@ -82,6 +82,9 @@ type Engine struct {
 	tableFileSizeGauge      *stats.GaugesWithSingleLabel
 	tableAllocatedSizeGauge *stats.GaugesWithSingleLabel
 	innoDbReadRowsCounter   *stats.Counter
+
+	// sizeHistory keeps the total data size as of the recent reloads.
+	sizeHistory sizeHistory
 }
 
 // NewEngine creates a new Engine.
@@ -399,6 +402,7 @@ func (se *Engine) reload(ctx context.Context) error {
 		se.tables[k] = t
 	}
 	se.lastChange = curTime
+	se.recordDataSize()
 	if len(created) > 0 || len(altered) > 0 || len(dropped) > 0 {
 		log.Infof("schema engine created %v, altered %v, dropped %v", created, altered, dropped)
 	}
@@ -526,6 +530,22 @@ func (se *Engine) UnregisterNotifier(name string) {
 	delete(se.notifiers, name)
 }
 
+// recordDataSize adds the total file size of the tables to the size history.
+// It must be called while holding a lock on se.mu.
+func (se *Engine) recordDataSize() {
+	var total int64
+	for _, t := range se.tables {
+		total += int64(t.FileSize)
+	}
+	se.sizeHistory.add(time.Now(), total)
+}
+
+// DataSize returns the total file size of the tables as of the last reload,
+// and the rate at which it grew over the recent reloads, in bytes per hour.
+func (se *Engine) DataSize() (bytes int64, growthPerHour float64) {
+	return se.sizeHistory.current()
+}
+
 // broadcast must be called while holding a lock on se.mu.
 func (se *Engine) broadcast(created, altered, dropped []string) {
 	if !se.isOpen {
@@ -83,6 +83,8 @@ func TestOpenAndReload(t *testing.T) {
 	mustMatch(t, want, se.GetSchema())
 	assert.Equal(t, int64(100), se.tableFileSizeGauge.Counts()["msg"])
 	assert.Equal(t, int64(150), se.tableAllocatedSizeGauge.Counts()["msg"])
+	dataSize, _ := se.DataSize()
+	assert.Equal(t, totalFileSize(want), dataSize)
 
 	// Advance time some more.
 	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
@@ -200,6 +202,8 @@ func TestOpenAndReload(t *testing.T) {
 	assert.Equal(t, want, se.GetSchema())
 	assert.Equal(t, int64(0), se.tableAllocatedSizeGauge.Counts()["msg"])
 	assert.Equal(t, int64(0), se.tableFileSizeGauge.Counts()["msg"])
+	dataSize, _ = se.DataSize()
+	assert.Equal(t, totalFileSize(want), dataSize)
 
 	//ReloadAt tests
 	pos1, err := mysql.DecodePosition("MariaDB/0-41983-20")
@@ -609,3 +613,11 @@ func AddFakeInnoDBReadRowsResult(db *fakesqldb.DB, value int) *fakesqldb.Expecte
 		fmt.Sprintf("Innodb_rows_read|%d", value),
 	))
 }
+
+func totalFileSize(tables map[string]*Table) int64 {
+	var total int64
+	for _, t := range tables {
+		total += int64(t.FileSize)
+	}
+	return total
+}
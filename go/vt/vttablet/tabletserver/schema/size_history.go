@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// sizeHistoryWindow is how far back the growth rate of the data size looks.
+	sizeHistoryWindow = 24 * time.Hour
+	// sizeHistoryMaxSamples bounds the history when the schema is reloaded
+	// often, e.g. after every DDL.
+	sizeHistoryMaxSamples = 1000
+	// minGrowthSpan is the shortest span of samples from which a growth rate
+	// is computed, so that a couple of close reloads don't produce noise.
+	minGrowthSpan = 10 * time.Minute
+)
+
+type sizeSample struct {
+	time  time.Time
+	bytes int64
+}
+
+// sizeHistory keeps the total data size of the tablet as of the recent
+// schema reloads, from which it computes how fast the data grows.
+type sizeHistory struct {
+	mu      sync.Mutex
+	samples []sizeSample
+}
+
+// add records the data size at the given time, and forgets the samples that
+// fell out of the window.
+func (h *sizeHistory) add(now time.Time, bytes int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples = append(h.samples, sizeSample{time: now, bytes: bytes})
+	cutoff := now.Add(-sizeHistoryWindow)
+	first := 0
+	for first < len(h.samples)-1 && h.samples[first].time.Before(cutoff) {
+		first++
+	}
+	if len(h.samples)-first > sizeHistoryMaxSamples {
+		first = len(h.samples) - sizeHistoryMaxSamples
+	}
+	h.samples = h.samples[first:]
+}
+
+// current returns the last recorded data size, and the rate at which it grew
+// since the oldest sample of the window, in bytes per hour. The rate is zero
+// until the samples span at least minGrowthSpan.
+func (h *sizeHistory) current() (bytes int64, growthPerHour float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.samples) == 0 {
+		return 0, 0
+	}
+	oldest, last := h.samples[0], h.samples[len(h.samples)-1]
+	span := last.time.Sub(oldest.time)
+	if span < minGrowthSpan {
+		return last.bytes, 0
+	}
+	return last.bytes, float64(last.bytes-oldest.bytes) / span.Hours()
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeHistory(t *testing.T) {
+	h := &sizeHistory{}
+	bytes, growth := h.current()
+	assert.Zero(t, bytes)
+	assert.Zero(t, growth)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	h.add(start, 1000)
+	h.add(start.Add(time.Minute), 2000)
+	// The samples are too close for a growth rate.
+	bytes, growth = h.current()
+	assert.EqualValues(t, 2000, bytes)
+	assert.Zero(t, growth)
+
+	h.add(start.Add(2*time.Hour), 5000)
+	bytes, growth = h.current()
+	assert.EqualValues(t, 5000, bytes)
+	assert.Equal(t, 2000.0, growth)
+
+	// The first samples fall out of the window.
+	h.add(start.Add(sizeHistoryWindow+time.Hour+30*time.Minute), 5000)
+	assert.Len(t, h.samples, 2)
+	_, growth = h.current()
+	assert.Zero(t, growth)
+
+	// Shrinking data yields a negative rate.
+	h.add(start.Add(sizeHistoryWindow+3*time.Hour+30*time.Minute), 3000)
+	_, growth = h.current()
+	assert.InDelta(t, -1000.0, growth, 0.001)
+
+	for i := 0; i < 2*sizeHistoryMaxSamples; i++ {
+		h.add(start.Add(sizeHistoryWindow+4*time.Hour+time.Duration(i)*time.Second), 3000)
+	}
+	assert.Len(t, h.samples, sizeHistoryMaxSamples)
+}
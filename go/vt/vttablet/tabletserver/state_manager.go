@@ -142,6 +142,7 @@ type (
 		MakeNonPrimary()
 		Close()
 		Status() (time.Duration, error)
+		Lag() (time.Duration, error)
 	}
 
 	queryEngine interface {
@@ -485,6 +486,7 @@ func (sm *stateManager) connect(tabletType topodatapb.TabletType) error {
 	if err := sm.se.Open(); err != nil {
 		return err
 	}
+	sm.hs.watchSchema()
 	sm.vstreamer.Open()
 	if err := sm.qe.Open(); err != nil {
 		return err
@@ -800,6 +800,10 @@ func (te *testReplTracker) Status() (time.Duration, error) {
 	return te.lag, te.err
 }
 
+func (te *testReplTracker) Lag() (time.Duration, error) {
+	return te.lag, te.err
+}
+
 type testQueryEngine struct {
 	testOrderState
 
@@ -155,6 +155,7 @@ func init() {
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
+	flagutil.DualFormatBoolVar(&currentConfig.ConsolidatorNormalizeQueries, "consolidator_normalize_queries", defaultConfig.ConsolidatorNormalizeQueries, "If true, the query consolidator keys the selects on their normalized query and the values of their literals and bind variables, so that the selects that only differ by their comments, the text of their integer literals or the types of their integer bind variables share their execution. The normalized query is built with the plan of the select.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
@@ -250,6 +251,7 @@ type TabletConfig struct {
 	// Consolidator can be enable, disable, or notOnPrimary. Default is enable.
 	// notOnMaster is the deprecated value that is the same as notOnPrimary.
 	Consolidator                            string  `json:"consolidator,omitempty"`
+	ConsolidatorNormalizeQueries            bool    `json:"consolidatorNormalizeQueries,omitempty"`
 	PassthroughDML                          bool    `json:"passthroughDML,omitempty"`
 	StreamBufferSize                        int     `json:"streamBufferSize,omitempty"`
 	ConsolidatorStreamTotalSize             int64   `json:"consolidatorStreamTotalSize,omitempty"`
@@ -21,6 +21,7 @@ import (
 	"html/template"
 	"io"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -62,6 +63,9 @@ type LogStats struct {
 	ReservedID           int64
 	Error                error
 	CachedPlan           bool
+	// ConnectionAttributes identify the client application that sent
+	// the query to vtgate, see ExecuteOptions.connection_attributes.
+	ConnectionAttributes map[string]string
 }
 
 // NewLogStats constructs a new LogStats with supplied Method and ctx
@@ -155,6 +159,20 @@ func (stats *LogStats) FmtQuerySources() string {
 	return strings.Join(sources[:n], ",")
 }
 
+// FmtConnectionAttributes returns the connection attributes of the
+// client as a comma separated list of name=value pairs, sorted by name.
+func (stats *LogStats) FmtConnectionAttributes() string {
+	names := make([]string, 0, len(stats.ConnectionAttributes))
+	for name := range stats.ConnectionAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = name + "=" + stats.ConnectionAttributes[name]
+	}
+	return strings.Join(names, ",")
+}
+
 // ContextHTML returns the HTML version of the context that was used, or "".
 // This is a method on LogStats instead of a field so that it doesn't need
 // to be passed by value everywhere.
@@ -207,9 +225,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%v\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v,\"TransactionID\": %v,\"ResponseSize\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v,\"TransactionID\": %v,\"ResponseSize\": %v, \"Error\": %q, \"ConnectionAttributes\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -235,6 +253,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.TransactionID,
 		stats.SizeOfResponse(),
 		stats.ErrorStr(),
+		stats.FmtConnectionAttributes(),
 	)
 	return err
 }
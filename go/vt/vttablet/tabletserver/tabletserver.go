@@ -41,6 +41,7 @@ import (
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dynamicconfig"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -173,6 +174,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
 	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
+	tsv.hs.dataSize = tsv.se.DataSize
+	tsv.hs.se = tsv.se
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
@@ -222,6 +225,9 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
+	if name == "" {
+		tsv.registerDynamicConfig()
+	}
 
 	return tsv
 }
@@ -702,6 +708,26 @@ func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Ta
 	return metadata, err
 }
 
+// UnresolvedTransactions returns the 2pc transactions that are in flight on
+// this tablet, as a coordinator or as a participant.
+func (tsv *TabletServer) UnresolvedTransactions(ctx context.Context, target *querypb.Target) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"UnresolvedTransactions", "unresolved_transactions", nil,
+		target, nil, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			txe := &TxExecutor{
+				ctx:      ctx,
+				logStats: logStats,
+				te:       tsv.te,
+			}
+			transactions, prepared, err = txe.UnresolvedTransactions()
+			return err
+		},
+	)
+	return transactions, prepared, err
+}
+
 // Execute executes the query and returns the result as response.
 func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, err error) {
 	span, ctx := trace.NewSpan(ctx, "TabletServer.Execute")
@@ -752,11 +778,18 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				tsv:            tsv,
 				tabletType:     target.GetTabletType(),
 			}
+			lag := qre.replicationLag()
 			result, err = qre.Execute()
 			if err != nil {
 				return err
 			}
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
+			if lag != 0 {
+				// The result may be shared with the consolidated queries.
+				withLag := *result
+				withLag.ReplicationLag = lag
+				result = &withLag
+			}
 
 			// Change database name in mysql output to the keyspace name
 			if tsv.sm.target.Keyspace != tsv.config.DB.DBName && sqltypes.IncludeFieldsOrDefault(options) == querypb.ExecuteOptions_ALL {
@@ -838,6 +871,16 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				logStats:       logStats,
 				tsv:            tsv,
 			}
+			if lag := qre.replicationLag(); lag != 0 {
+				return qre.Stream(func(qr *sqltypes.Result) error {
+					if lag != 0 {
+						withLag := *qr
+						withLag.ReplicationLag = lag
+						qr, lag = &withLag, 0
+					}
+					return callback(qr)
+				})
+			}
 			return qre.Stream(callback)
 		},
 	)
@@ -965,7 +1008,7 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 }
 
 // MessageStream streams messages from the requested table.
-func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, streamID string, window int64, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
 		ctx, 0,
 		"MessageStream", "stream", nil,
@@ -982,7 +1025,19 @@ func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Targ
 				logStats: logStats,
 				tsv:      tsv,
 			}
-			return qre.MessageStream(callback)
+			return qre.MessageStream(streamID, window, callback)
+		},
+	)
+}
+
+// MessageStreamWindow grows the window of a stream of the requested table.
+func (tsv *TabletServer) MessageStreamWindow(ctx context.Context, target *querypb.Target, name string, streamID string, increment int64) (err error) {
+	return tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"MessageStreamWindow", "message_stream_window", nil,
+		target, nil, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			return tsv.messager.UpdateWindow(name, streamID, increment)
 		},
 	)
 }
@@ -1293,6 +1348,7 @@ func (tsv *TabletServer) execRequest(
 	logStats.Target = target
 	logStats.OriginalSQL = sql
 	logStats.BindVariables = bindVariables
+	logStats.ConnectionAttributes = options.GetConnectionAttributes()
 	defer tsv.handlePanicAndSendLogStats(sql, bindVariables, logStats)
 
 	if err = tsv.sm.StartRequest(ctx, target, allowOnShutdown); err != nil {
@@ -1728,6 +1784,19 @@ func (tsv *TabletServer) registerDebugEnvHandler() {
 	})
 }
 
+// registerDynamicConfig lets the pool sizes, the timeouts and the limits of
+// the tablet server be changed at runtime, through the dynamic configuration.
+func (tsv *TabletServer) registerDynamicConfig() {
+	dynamicconfig.RegisterPoolSize("queryserver-config-pool-size", "query server read pool size", tsv.PoolSize, tsv.SetPoolSize)
+	dynamicconfig.RegisterPoolSize("queryserver-config-stream-pool-size", "query server stream connection pool size", tsv.StreamPoolSize, tsv.SetStreamPoolSize)
+	dynamicconfig.RegisterPoolSize("queryserver-config-transaction-cap", "query server transaction cap", tsv.TxPoolSize, tsv.SetTxPoolSize)
+	dynamicconfig.RegisterInt("queryserver-config-query-cache-size", "query server query cache size", tsv.QueryPlanCacheCap, tsv.SetQueryPlanCacheCap)
+	dynamicconfig.RegisterInt("queryserver-config-max-result-size", "query server max result size", tsv.MaxResultSize, tsv.SetMaxResultSize)
+	dynamicconfig.RegisterInt("queryserver-config-warn-result-size", "query server result size warning threshold", tsv.WarnResultSize, tsv.SetWarnResultSize)
+	dynamicconfig.RegisterDuration("queryserver-config-query-timeout", "query server query timeout", tsv.QueryTimeout.Get, tsv.QueryTimeout.Set)
+	dynamicconfig.RegisterDuration("queryserver-config-transaction-timeout", "query server transaction timeout", tsv.TxTimeout, tsv.SetTxTimeout)
+}
+
 // EnableHeartbeat forces heartbeat to be on or off.
 // Only to be used for testing.
 func (tsv *TabletServer) EnableHeartbeat(enabled bool) {
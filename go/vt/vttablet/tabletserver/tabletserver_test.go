@@ -223,7 +223,10 @@ func TestTabletServerRedoLogIsKeptBetweenRestarts(t *testing.T) {
 	assert.Empty(t, tsv.te.preparedPool.conns, "tsv.te.preparedPool.conns")
 
 	tsv.te.txPool.scp.lastID.Set(1)
-	// Ensure we continue past errors.
+	// Ensure we continue past errors, and mark the transactions
+	// that can't be replayed as failed.
+	db.AddQuery("update _vt.redo_state set state = 0 where dtid = 'bogus'", &sqltypes.Result{})
+	replayFailed := tsv.te.redoRecoveries.Counts()["ReplayFailed"]
 	db.AddQuery(tpc.readAllRedo, &sqltypes.Result{
 		Fields: []*querypb.Field{
 			{Type: sqltypes.VarBinary},
@@ -253,10 +256,16 @@ func TestTabletServerRedoLogIsKeptBetweenRestarts(t *testing.T) {
 	got = tsv.te.preparedPool.conns["a:b:10"].TxProperties().Queries
 	want = []string{"update test_table set `name` = 2 where pk = 1 limit 10001"}
 	utils.MustMatch(t, want, got, "Prepared queries")
-	wantFailed := map[string]error{"a:b:20": errPrepFailed}
+	wantFailed := map[string]error{"a:b:20": errPrepFailed, "bogus": errPrepFailed}
 	if !reflect.DeepEqual(tsv.te.preparedPool.reserved, wantFailed) {
 		t.Errorf("Failed dtids: %v, want %v", tsv.te.preparedPool.reserved, wantFailed)
 	}
+	assert.EqualValues(t, replayFailed+1, tsv.te.redoRecoveries.Counts()["ReplayFailed"])
+	assert.Equal(t, 1, db.GetQueryCalledNum("update _vt.redo_state set state = 0 where dtid = 'bogus'"))
+	// A transaction that could not be replayed can't be committed.
+	err := tsv.CommitPrepared(ctx, &querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}, "bogus")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot commit dtid bogus, state: failed")
 	// Verify last id got adjusted.
 	assert.EqualValues(t, 20, tsv.te.txPool.scp.lastID.Get(), "tsv.te.txPool.lastID.Get()")
 	turnOffTxEngine()
@@ -761,6 +770,46 @@ func TestTabletServerStreamExecuteComments(t *testing.T) {
 	}
 }
 
+func TestTabletServerIncludeReplicationLag(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+	tsv.sm.rt = &testReplTracker{lag: 1500 * time.Millisecond}
+	options := &querypb.ExecuteOptions{IncludeReplicationLag: true}
+
+	// A primary does not return its lag.
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	qr, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.NoError(t, err)
+	assert.Zero(t, qr.ReplicationLag)
+
+	target = querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	err = tsv.SetServingType(topodatapb.TabletType_REPLICA, time.Time{}, true, "")
+	require.NoError(t, err)
+	qr, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, qr.ReplicationLag)
+
+	qr, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Zero(t, qr.ReplicationLag)
+
+	// Only the first result of a stream has the lag.
+	var lags []time.Duration
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, 0, options, func(qr *sqltypes.Result) error {
+		lags = append(lags, qr.ReplicationLag)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{1500 * time.Millisecond, 0}, lags)
+}
+
 func TestSerializeTransactionsSameRow(t *testing.T) {
 	// This test runs three transaction in parallel:
 	// tx1 | tx2 | tx3
@@ -1233,7 +1282,7 @@ func TestMessageStream(t *testing.T) {
 	defer tsv.StopService()
 	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
 
-	err := tsv.MessageStream(ctx, &target, "nomsg", func(qr *sqltypes.Result) error {
+	err := tsv.MessageStream(ctx, &target, "nomsg", "", 0, func(qr *sqltypes.Result) error {
 		return nil
 	})
 	wantErr := "table nomsg not found in schema"
@@ -1243,7 +1292,7 @@ func TestMessageStream(t *testing.T) {
 
 	// Check that the streaming mechanism works.
 	called := false
-	err = tsv.MessageStream(ctx, &target, "msg", func(qr *sqltypes.Result) error {
+	err = tsv.MessageStream(ctx, &target, "msg", "", 0, func(qr *sqltypes.Result) error {
 		called = true
 		return io.EOF
 	})
@@ -108,6 +108,22 @@ func (c *Client) ThrottleCheckOK(ctx context.Context) (throttleCheckOK bool) {
 
 }
 
+// ThrottleCheckResult checks the throttler, and returns the result of the check.
+// Unlike ThrottleCheckOK, it does not skip the check after a recent successful one, so that the
+// caller can see how close the metric (e.g. replication lag) is to its threshold and pace itself.
+// The function is not thread safe.
+func (c *Client) ThrottleCheckResult(ctx context.Context) *CheckResult {
+	if c == nil || c.throttler == nil {
+		// no client or no throttler
+		return okMetricCheckResult
+	}
+	checkResult := c.throttler.CheckByType(ctx, c.appName, "", &c.flags, c.checkType)
+	if checkResult.StatusCode == http.StatusOK {
+		c.lastSuccessfulThrottle = atomic.LoadInt64(&throttleTicks)
+	}
+	return checkResult
+}
+
 // ThrottleCheckOKOrWait checks the throttler; if throttler is satisfied, the function returns 'true' mmediately,
 // otherwise it briefly sleeps and returns 'false'.
 // The function is not thread safe.
@@ -27,6 +27,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -87,6 +88,9 @@ type TxEngine struct {
 
 	// reservedConnStats keeps statistics about reserved connections
 	reservedConnStats *servenv.TimingsWrapper
+	// redoRecoveries counts the transactions of the redo log
+	// recovered when the tablet becomes a primary.
+	redoRecoveries *stats.CountersWithSingleLabel
 
 	txPool       *TxPool
 	preparedPool *TxPreparedPool
@@ -101,6 +105,7 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 		env:                 env,
 		shutdownGracePeriod: config.GracePeriods.ShutdownSeconds.Get(),
 		reservedConnStats:   env.Exporter().NewTimings("ReservedConnections", "Reserved connections stats", "operation"),
+		redoRecoveries:      env.Exporter().NewCountersWithSingleLabel("TwoPCRedoRecoveries", "Prepared transactions recovered from the redo log, by result: Prepared, Failed or ReplayFailed", "Result"),
 	}
 	limiter := txlimiter.New(env)
 	te.txPool = NewTxPool(env, limiter)
@@ -358,6 +363,9 @@ func (te *TxEngine) shutdownLocked() {
 // from the redo log, loads previously failed transactions
 // into the reserved list, and adjusts the txPool LastID
 // to ensure there are no future collisions.
+// A transaction that can't be replayed is marked as failed in the
+// redo log, so that it can't be committed as if it had been prepared,
+// and is left for the watchdog or an operator to resolve.
 func (te *TxEngine) prepareFromRedo() error {
 	ctx := tabletenv.LocalContext()
 	var allErr concurrency.AllErrorRecorder
@@ -367,7 +375,7 @@ func (te *TxEngine) prepareFromRedo() error {
 	}
 
 	maxid := int64(0)
-outer:
+	replayFailures := 0
 	for _, preparedTx := range prepared {
 		txid, err := dtids.TransactionID(preparedTx.Dtid)
 		if err != nil {
@@ -376,27 +384,17 @@ outer:
 		if txid > maxid {
 			maxid = txid
 		}
-		conn, _, err := te.txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
-		if err != nil {
+		if err := te.replayRedo(ctx, preparedTx); err != nil {
+			log.Errorf("TwoPC: could not replay the prepared transaction %s, marking it as failed: %v", preparedTx.Dtid, err)
 			allErr.RecordError(err)
-			continue
-		}
-		for _, stmt := range preparedTx.Queries {
-			conn.TxProperties().RecordQuery(stmt)
-			_, err := conn.Exec(ctx, stmt, 1, false)
-			if err != nil {
+			replayFailures++
+			te.redoRecoveries.Add("ReplayFailed", 1)
+			if err := te.markRedoFailed(ctx, preparedTx.Dtid); err != nil {
 				allErr.RecordError(err)
-				te.txPool.RollbackAndRelease(ctx, conn)
-				continue outer
 			}
-		}
-		// We should not use the external Prepare because
-		// we don't want to write again to the redo log.
-		err = te.preparedPool.Put(conn, preparedTx.Dtid)
-		if err != nil {
-			allErr.RecordError(err)
 			continue
 		}
+		te.redoRecoveries.Add("Prepared", 1)
 	}
 	for _, preparedTx := range failed {
 		txid, err := dtids.TransactionID(preparedTx.Dtid)
@@ -407,12 +405,52 @@ outer:
 			maxid = txid
 		}
 		te.preparedPool.SetFailed(preparedTx.Dtid)
+		te.redoRecoveries.Add("Failed", 1)
 	}
 	te.txPool.AdjustLastID(maxid)
-	log.Infof("TwoPC: Prepared %d transactions, and registered %d failures.", len(prepared), len(failed))
+	log.Infof("TwoPC: Prepared %d transactions, and registered %d failures.", len(prepared)-replayFailures, len(failed)+replayFailures)
 	return allErr.Error()
 }
 
+// replayRedo runs the statements of a prepared transaction again,
+// and puts it in the prepared pool.
+func (te *TxEngine) replayRedo(ctx context.Context, preparedTx *tx.PreparedTx) error {
+	conn, _, err := te.txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range preparedTx.Queries {
+		conn.TxProperties().RecordQuery(stmt)
+		if _, err := conn.Exec(ctx, stmt, 1, false); err != nil {
+			te.txPool.RollbackAndRelease(ctx, conn)
+			return err
+		}
+	}
+	// We should not use the external Prepare because
+	// we don't want to write again to the redo log.
+	if err := te.preparedPool.Put(conn, preparedTx.Dtid); err != nil {
+		te.txPool.RollbackAndRelease(ctx, conn)
+		return err
+	}
+	return nil
+}
+
+// markRedoFailed marks a prepared transaction as failed,
+// in the prepared pool and in the redo log.
+func (te *TxEngine) markRedoFailed(ctx context.Context, dtid string) error {
+	te.preparedPool.SetFailed(dtid)
+	conn, _, err := te.txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer te.txPool.RollbackAndRelease(ctx, conn)
+	if err := te.twoPC.UpdateRedo(ctx, conn, dtid, RedoStateFailed); err != nil {
+		return err
+	}
+	_, err = te.txPool.Commit(ctx, conn)
+	return err
+}
+
 // shutdownTransactions rolls back all open transactions
 // including the prepared ones.
 // This is used for transitioning from a primary to a non-primary
@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -244,6 +245,40 @@ func (txe *TxExecutor) ReadTransaction(dtid string) (*querypb.TransactionMetadat
 	return txe.te.twoPC.ReadTransaction(txe.ctx, dtid)
 }
 
+// UnresolvedTransactions returns the distributed transactions coordinated
+// by this shard, and the transactions prepared on it as a participant.
+func (txe *TxExecutor) UnresolvedTransactions() ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	distributed, prepared, failed, err := txe.ReadTwopcInflight()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactions := make([]*querypb.TransactionMetadata, 0, len(distributed))
+	for _, dtx := range distributed {
+		participants := make([]*querypb.Target, 0, len(dtx.Participants))
+		for i := range dtx.Participants {
+			participants = append(participants, &querypb.Target{
+				Keyspace:   dtx.Participants[i].Keyspace,
+				Shard:      dtx.Participants[i].Shard,
+				TabletType: topodatapb.TabletType_PRIMARY,
+			})
+		}
+		transactions = append(transactions, &querypb.TransactionMetadata{
+			Dtid:         dtx.Dtid,
+			State:        querypb.TransactionState(querypb.TransactionState_value[dtx.State]),
+			TimeCreated:  dtx.Created.UnixNano(),
+			Participants: participants,
+		})
+	}
+	var preparedTxs []*querypb.PreparedTransaction
+	for _, ptx := range prepared {
+		preparedTxs = append(preparedTxs, &querypb.PreparedTransaction{Dtid: ptx.Dtid, TimeCreated: ptx.Time.UnixNano()})
+	}
+	for _, ptx := range failed {
+		preparedTxs = append(preparedTxs, &querypb.PreparedTransaction{Dtid: ptx.Dtid, TimeCreated: ptx.Time.UnixNano(), Failed: true})
+	}
+	return transactions, preparedTxs, nil
+}
+
 // ReadTwopcInflight returns info about all in-flight 2pc transactions.
 func (txe *TxExecutor) ReadTwopcInflight() (distributed []*tx.DistributedTx, prepared, failed []*tx.PreparedTx, err error) {
 	if !txe.te.twopcEnabled {
@@ -32,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/vtgate/fakerpcvtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -398,6 +399,70 @@ func TestExecutorReadAllTransactions(t *testing.T) {
 	}
 }
 
+func TestExecutorUnresolvedTransactions(t *testing.T) {
+	txe, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	db.AddQuery(tsv.te.twoPC.readAllRedo, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("bb"),
+			sqltypes.NewInt64(RedoStatePrepared),
+			sqltypes.NewVarBinary("2"),
+			sqltypes.NewVarBinary("stmt01"),
+		}, {
+			sqltypes.NewVarBinary("cc"),
+			sqltypes.NewInt64(RedoStateFailed),
+			sqltypes.NewVarBinary("3"),
+			sqltypes.NewVarBinary("stmt02"),
+		}},
+	})
+	db.AddQuery(tsv.te.twoPC.readAllTransactions, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("aa"),
+			sqltypes.NewInt64(int64(querypb.TransactionState_COMMIT)),
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NewVarBinary("test1"),
+			sqltypes.NewVarBinary("0"),
+		}},
+	})
+	transactions, prepared, err := txe.UnresolvedTransactions()
+	require.NoError(t, err)
+	wantTransactions := []*querypb.TransactionMetadata{{
+		Dtid:        "aa",
+		State:       querypb.TransactionState_COMMIT,
+		TimeCreated: 1,
+		Participants: []*querypb.Target{{
+			Keyspace:   "test1",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_PRIMARY,
+		}},
+	}}
+	utils.MustMatch(t, wantTransactions, transactions, "UnresolvedTransactions")
+	wantPrepared := []*querypb.PreparedTransaction{{
+		Dtid:        "bb",
+		TimeCreated: 2,
+	}, {
+		Dtid:        "cc",
+		TimeCreated: 3,
+		Failed:      true,
+	}}
+	utils.MustMatch(t, wantPrepared, prepared, "UnresolvedTransactions")
+}
+
 // These vars and types are used only for TestExecutorResolveTransaction
 var dtidCh = make(chan string)
 
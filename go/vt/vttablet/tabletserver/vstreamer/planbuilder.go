@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"vitess.io/vitess/go/vt/obfuscation"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
 	"vitess.io/vitess/go/mysql/collations"
@@ -76,6 +77,14 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// In is used to filter a comparable column if it is one of specific values
+	In
+	// NotIn is used to filter a comparable column if it is none of specific values
+	NotIn
+	// IsNull is used to filter a column if it is null
+	IsNull
+	// IsNotNull is used to filter a column if it is not null
+	IsNotNull
 )
 
 // Filter contains opcodes for filtering.
@@ -83,6 +92,8 @@ type Filter struct {
 	Opcode Opcode
 	ColNum int
 	Value  sqltypes.Value
+	// Values are the values of In and NotIn.
+	Values []sqltypes.Value
 
 	// Parameters for VindexMatch.
 	// Vindex, VindexColumns and KeyRange, if set, will be used
@@ -159,6 +170,22 @@ func getOpcode(comparison *sqlparser.ComparisonExpr) (Opcode, error) {
 	return opcode, nil
 }
 
+// reverseOpcode returns the opcode of a comparison whose operands were swapped,
+// so that 1 < id filters like id > 1
+func reverseOpcode(opcode Opcode) Opcode {
+	switch opcode {
+	case LessThan:
+		return GreaterThan
+	case LessThanEqual:
+		return GreaterThanEqual
+	case GreaterThan:
+		return LessThan
+	case GreaterThanEqual:
+		return LessThanEqual
+	}
+	return opcode
+}
+
 // compare returns true after applying the comparison specified in the Filter to the actual data in the column
 func compare(comparison Opcode, columnValue, filterValue sqltypes.Value, charset collations.ID) (bool, error) {
 	// use null semantics: return false if either value is null
@@ -203,6 +230,39 @@ func compare(comparison Opcode, columnValue, filterValue sqltypes.Value, charset
 	return false, nil
 }
 
+// compareList returns true if the column value is (for In) or isn't (for NotIn)
+// one of the values of the Filter
+func compareList(comparison Opcode, columnValue sqltypes.Value, filterValues []sqltypes.Value, charset collations.ID) (bool, error) {
+	// use null semantics: a null is neither in nor not in a list, and no value
+	// is not in a list that has a null
+	if columnValue.IsNull() {
+		return false, nil
+	}
+	found := false
+	for _, filterValue := range filterValues {
+		if filterValue.IsNull() {
+			if comparison == NotIn {
+				return false, nil
+			}
+			continue
+		}
+		result, err := evalengine.NullsafeCompare(columnValue, filterValue, charset)
+		if err != nil {
+			return false, err
+		}
+		if result == 0 {
+			found = true
+		}
+	}
+	switch comparison {
+	case In:
+		return found, nil
+	case NotIn:
+		return !found, nil
+	}
+	return false, fmt.Errorf("comparison operator %d not supported", comparison)
+}
+
 // filter filters the row against the plan. It returns false if the row did not match.
 // The output of the filtering operation is stored in the 'result' argument because
 // filtering cannot be performed in-place. The result argument must be a slice of
@@ -221,6 +281,18 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case IsNull, IsNotNull:
+			if values[filter.ColNum].IsNull() != (filter.Opcode == IsNull) {
+				return false, nil
+			}
+		case In, NotIn:
+			match, err := compareList(filter.Opcode, values[filter.ColNum], filter.Values, charsets[filter.ColNum])
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value, charsets[filter.ColNum])
 			if err != nil {
@@ -266,7 +338,7 @@ func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumn
 	}
 	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
 	if !ok || len(ksid) == 0 {
-		return nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", vindexValues, destinations[0])
+		return nil, fmt.Errorf("could not map %s to a keyspace id, got destination %v", obfuscation.Values(vindexValues), destinations[0])
 	}
 	return ksid, nil
 }
@@ -482,42 +554,51 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
+			if expr.Operator == sqlparser.InOp || expr.Operator == sqlparser.NotInOp {
+				if err := plan.analyzeInList(expr); err != nil {
+					return err
+				}
+				continue
+			}
 			opcode, err := getOpcode(expr)
 			if err != nil {
 				return err
 			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			column, value := expr.Left, expr.Right
+			if _, ok := value.(*sqlparser.ColName); ok {
+				// 42 = tenant_id filters like tenant_id = 42.
+				column, value = value, column
+				opcode = reverseOpcode(opcode)
 			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			colnum, err := plan.filterColumn(column, expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := evalengine.Translate(val, semantics.EmptySemTable())
+			resolved, err := filterValue(value, expr)
 			if err != nil {
 				return err
 			}
-			env := evalengine.EmptyExpressionEnv()
-			resolved, err := env.Evaluate(pv)
+			plan.Filters = append(plan.Filters, Filter{
+				Opcode: opcode,
+				ColNum: colnum,
+				Value:  resolved,
+			})
+		case *sqlparser.IsExpr:
+			opcode := IsNull
+			switch expr.Right {
+			case sqlparser.IsNullOp:
+			case sqlparser.IsNotNullOp:
+				opcode = IsNotNull
+			default:
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
+			colnum, err := plan.filterColumn(expr.Left, expr)
 			if err != nil {
 				return err
 			}
 			plan.Filters = append(plan.Filters, Filter{
 				Opcode: opcode,
 				ColNum: colnum,
-				Value:  resolved.Value(),
 			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
@@ -533,6 +614,79 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	return nil
 }
 
+// analyzeInList adds the Filter of a column IN or NOT IN a list of values.
+func (plan *Plan) analyzeInList(expr *sqlparser.ComparisonExpr) error {
+	opcode := In
+	if expr.Operator == sqlparser.NotInOp {
+		opcode = NotIn
+	}
+	colnum, err := plan.filterColumn(expr.Left, expr)
+	if err != nil {
+		return err
+	}
+	tuple, ok := expr.Right.(sqlparser.ValTuple)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	filter := Filter{
+		Opcode: opcode,
+		ColNum: colnum,
+	}
+	for _, val := range tuple {
+		resolved, err := filterValue(val, expr)
+		if err != nil {
+			return err
+		}
+		filter.Values = append(filter.Values, resolved)
+	}
+	plan.Filters = append(plan.Filters, filter)
+	return nil
+}
+
+// filterColumn returns the column number of the column of a constraint.
+func (plan *Plan) filterColumn(column sqlparser.Expr, constraint sqlparser.Expr) (int, error) {
+	qualifiedName, ok := column.(*sqlparser.ColName)
+	if !ok {
+		return 0, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	return findColumn(plan.Table, qualifiedName.Name)
+}
+
+// filterValue returns the value of the literal of a constraint.
+func filterValue(value sqlparser.Expr, constraint sqlparser.Expr) (sqltypes.Value, error) {
+	val, ok := value.(*sqlparser.Literal)
+	switch expr := value.(type) {
+	case *sqlparser.NullVal:
+		return sqltypes.NULL, nil
+	case *sqlparser.UnaryExpr:
+		// A negative number is parsed as a unary minus.
+		if expr.Operator == sqlparser.UMinusOp {
+			val, ok = expr.Expr.(*sqlparser.Literal)
+			ok = ok && val.Type == sqlparser.IntVal
+		}
+	}
+	if !ok {
+		return sqltypes.Value{}, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return sqltypes.Value{}, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	pv, err := evalengine.Translate(value, semantics.EmptySemTable())
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	env := evalengine.EmptyExpressionEnv()
+	resolved, err := env.Evaluate(pv)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return resolved.Value(), nil
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -644,6 +644,41 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 			{Opcode: Equal, ColNum: 0, Value: sqltypes.NewInt64(2)},
 			{Opcode: NotEqual, ColNum: 1, Value: sqltypes.NewVarChar("xyz")},
 		},
+	}, {
+		name:       "value-on-the-left",
+		inFilter:   "select * from t1 where -1 < id",
+		outFilters: []Filter{{Opcode: GreaterThan, ColNum: 0, Value: sqltypes.NewInt64(-1)}},
+	}, {
+		name:       "negative-value",
+		inFilter:   "select * from t1 where id = -42",
+		outFilters: []Filter{{Opcode: Equal, ColNum: 0, Value: sqltypes.NewInt64(-42)}},
+	}, {
+		name:       "in",
+		inFilter:   "select * from t1 where id in (1, 2)",
+		outFilters: []Filter{{Opcode: In, ColNum: 0, Values: []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}}},
+	}, {
+		name:       "not-in",
+		inFilter:   "select * from t1 where val not in ('abc')",
+		outFilters: []Filter{{Opcode: NotIn, ColNum: 1, Values: []sqltypes.Value{sqltypes.NewVarChar("abc")}}},
+	}, {
+		name:     "is-null",
+		inFilter: "select * from t1 where val is null and id is not null",
+		outFilters: []Filter{
+			{Opcode: IsNull, ColNum: 1},
+			{Opcode: IsNotNull, ColNum: 0},
+		},
+	}, {
+		name:     "in-subquery",
+		inFilter: "select * from t1 where id in (select id from t2)",
+		outErr:   "unexpected: id in (select id from t2)",
+	}, {
+		name:     "is-true",
+		inFilter: "select * from t1 where id is true",
+		outErr:   "unsupported constraint: id is true",
+	}, {
+		name:     "column-on-both-sides",
+		inFilter: "select * from t1 where id = val",
+		outErr:   "unexpected: id = val",
 	}}
 
 	for _, tcase := range testcases {
@@ -663,6 +698,56 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 	}
 }
 
+func TestPlanFilterColumnPredicates(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "tenant_id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	charsets := []collations.ID{collations.CollationBinaryID, collations.CollationBinaryID}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(42), sqltypes.NewVarBinary("a")},
+		{sqltypes.NewInt64(43), sqltypes.NULL},
+		{sqltypes.NULL, sqltypes.NewVarBinary("b")},
+	}
+	testcases := []struct {
+		filter string
+		// matches are the indexes of the rows that match
+		matches []int
+	}{
+		{filter: "tenant_id = 42", matches: []int{0}},
+		{filter: "42 < tenant_id", matches: []int{1}},
+		{filter: "tenant_id in (42, 44)", matches: []int{0}},
+		{filter: "tenant_id not in (42, 44)", matches: []int{1}},
+		{filter: "tenant_id not in (42, null)", matches: nil},
+		{filter: "val is null", matches: []int{1}},
+		{filter: "val is not null and tenant_id in (42, 43)", matches: []int{0}},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1 where " + tcase.filter}},
+			})
+			require.NoError(t, err)
+			var matches []int
+			for i, row := range rows {
+				result := make([]sqltypes.Value, len(plan.ColExprs))
+				ok, err := plan.filter(row, result, charsets)
+				require.NoError(t, err)
+				if ok {
+					matches = append(matches, i)
+				}
+			}
+			assert.Equal(t, tcase.matches, matches)
+		})
+	}
+}
+
 func TestCompare(t *testing.T) {
 	type testcase struct {
 		opcode                   Opcode
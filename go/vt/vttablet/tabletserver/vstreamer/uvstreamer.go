@@ -375,6 +375,10 @@ func (uvs *uvstreamer) Stream() error {
 			uvs.vse.errorCounts.Add("Copy", 1)
 			return err
 		}
+		// Let the client know that the rows that follow are changes, not copied rows.
+		if err := uvs.send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_COPY_COMPLETED}}); err != nil {
+			return err
+		}
 		uvs.sendTestEvent("Copy Done")
 	}
 	vs := newVStreamer(uvs.ctx, uvs.cp, uvs.se, mysql.EncodePosition(uvs.pos), mysql.EncodePosition(uvs.stopPos), uvs.filter, uvs.getVSchema(), uvs.send, "replicate", uvs.vse)
@@ -112,6 +112,8 @@ type streamerPlan struct {
 //   "select col1, col2 from t where...",
 //   "select col1, keyspace_id() from t where...".
 //   Only "in_keyrange" and limited comparison operators (see enum Opcode in planbuilder.go) are supported in the where clause.
+//   A column can be compared to an integer or a varbinary literal, be IN or NOT IN a list of literals, or be IS [NOT] NULL:
+//   "select * from t where tenant_id = 42 and status in ('new', 'paid') and deleted_at is null".
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
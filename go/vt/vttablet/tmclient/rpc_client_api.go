@@ -146,10 +146,39 @@ type TabletManagerClient interface {
 	// VExec executes a generic VExec command
 	VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error)
 
+	//
+	// Table GC related methods
+	//
+
+	// GetTableGCStatus lists the tables in the lifecycle of table GC
+	GetTableGCStatus(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error)
+
+	// AdvanceTableGC moves a GC table on to its next state right away, or,
+	// with cancel, takes it out of its lifecycle by renaming it to
+	// restoreTableName. It returns the table before the request, and its new
+	// name, which is empty if the table was dropped.
+	AdvanceTableGC(ctx context.Context, tablet *topodatapb.Tablet, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error)
+
+	//
+	// MySQL upgrade related methods
+	//
+
+	// UpgradeMysqld upgrades the mysqld of a DRAINED tablet in place: it
+	// stops mysqld, runs the swap_mysqld_binaries hook with
+	// swapBinariesParams, starts mysqld again, runs mysql_upgrade unless
+	// skipMysqlUpgrade, and rejoins replication.
+	UpgradeMysqld(ctx context.Context, tablet *topodatapb.Tablet, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error)
+
 	// VReplicationExec executes a VReplication command
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	// VReplicationProgress reports the copy progress, the throughput and the
+	// lag of the streams of a workflow on the tablet. It leaves RowsTotal and
+	// EtaSeconds to the caller, which can read the table statistics of the
+	// sources.
+	VReplicationProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error)
+
 	//
 	// Reparenting related functions
 	//
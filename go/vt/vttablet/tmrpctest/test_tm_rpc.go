@@ -41,6 +41,7 @@ import (
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // fakeRPCTM implements tabletmanager.RPCTM and fills in all
@@ -861,6 +862,90 @@ func (fra *fakeRPCTM) VExec(ctx context.Context, query, workflow, keyspace strin
 	return testExecuteFetchResult, nil
 }
 
+//
+// Table GC related methods
+//
+
+var testGCTable = &tabletmanagerdatapb.GCTable{
+	Name:          "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+	State:         "HOLD",
+	Uuid:          "6ace8bcef73211ea87e9f875a4d24e90",
+	ScheduledTime: &vttimepb.Time{Seconds: 1600171450},
+	NextState:     "PURGE",
+	EstimatedRows: 1000,
+}
+
+func (fra *fakeRPCTM) GetTableGCStatus(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return []*tabletmanagerdatapb.GCTable{testGCTable}, nil
+}
+
+func tmRPCTestGetTableGCStatus(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	tables, err := client.GetTableGCStatus(ctx, tablet)
+	compareError(t, "GetTableGCStatus", err, tables, []*tabletmanagerdatapb.GCTable{testGCTable})
+}
+
+func tmRPCTestGetTableGCStatusPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetTableGCStatus(ctx, tablet)
+	expectHandleRPCPanic(t, "GetTableGCStatus", false /*verbose*/, err)
+}
+
+var testRestoreTableName = "t"
+
+func (fra *fakeRPCTM) AdvanceTableGC(ctx context.Context, tableName string, cancel bool, restoreTableName string) (*tabletmanagerdatapb.GCTable, string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "AdvanceTableGC tableName", tableName, testGCTable.Name)
+	compareBool(fra.t, "AdvanceTableGC cancel", cancel)
+	compare(fra.t, "AdvanceTableGC restoreTableName", restoreTableName, testRestoreTableName)
+	return testGCTable, testRestoreTableName, nil
+}
+
+func tmRPCTestAdvanceTableGC(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	table, newTableName, err := client.AdvanceTableGC(ctx, tablet, testGCTable.Name, true, testRestoreTableName)
+	compareError(t, "AdvanceTableGC", err, table, testGCTable)
+	compare(t, "AdvanceTableGC newTableName", newTableName, testRestoreTableName)
+}
+
+func tmRPCTestAdvanceTableGCPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.AdvanceTableGC(ctx, tablet, testGCTable.Name, true, testRestoreTableName)
+	expectHandleRPCPanic(t, "AdvanceTableGC", true /*verbose*/, err)
+}
+
+//
+// MySQL upgrade related methods
+//
+
+var testSwapBinariesParams = []string{"--version=8.0.29"}
+
+var testUpgradeMysqldResponse = &tabletmanagerdatapb.UpgradeMysqldResponse{
+	Steps:      []string{"stopped mysqld", "started mysqld"},
+	OldVersion: "8.0.28",
+	NewVersion: "8.0.29",
+}
+
+func (fra *fakeRPCTM) UpgradeMysqld(ctx context.Context, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "UpgradeMysqld swapBinariesParams", swapBinariesParams, testSwapBinariesParams)
+	compareBool(fra.t, "UpgradeMysqld skipMysqlUpgrade", skipMysqlUpgrade)
+	return testUpgradeMysqldResponse, nil
+}
+
+func tmRPCTestUpgradeMysqld(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.UpgradeMysqld(ctx, tablet, testSwapBinariesParams, true)
+	compareError(t, "UpgradeMysqld", err, response, testUpgradeMysqldResponse)
+}
+
+func tmRPCTestUpgradeMysqldPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.UpgradeMysqld(ctx, tablet, testSwapBinariesParams, true)
+	expectHandleRPCPanic(t, "UpgradeMysqld", true /*verbose*/, err)
+}
+
 var testVRQuery = "query"
 
 func (fra *fakeRPCTM) VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error) {
@@ -905,6 +990,39 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var testVRStreamProgress = &tabletmanagerdatapb.VReplicationStreamProgress{
+	Id:             1,
+	Workflow:       "wf",
+	SourceKeyspace: "ks",
+	SourceShard:    "0",
+	State:          "Running",
+	Phase:          "Copying",
+	SourceTables:   []string{"t1", "t2"},
+	TablesToCopy:   []string{"t2"},
+	RowsCopied:     1000,
+	RowsPerSecond:  100,
+	LagSeconds:     -1,
+	EtaSeconds:     -1,
+}
+
+func (fra *fakeRPCTM) VReplicationProgress(ctx context.Context, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VReplicationProgress workflow", workflow, testVRStreamProgress.Workflow)
+	return []*tabletmanagerdatapb.VReplicationStreamProgress{testVRStreamProgress}, nil
+}
+
+func tmRPCTestVReplicationProgress(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	streams, err := client.VReplicationProgress(ctx, tablet, testVRStreamProgress.Workflow)
+	compareError(t, "VReplicationProgress", err, streams, []*tabletmanagerdatapb.VReplicationStreamProgress{testVRStreamProgress})
+}
+
+func tmRPCTestVReplicationProgressPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VReplicationProgress(ctx, tablet, testVRStreamProgress.Workflow)
+	expectHandleRPCPanic(t, "VReplicationProgress", false /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1314,9 +1432,17 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestStartReplicationUntilAfter(ctx, t, client, tablet)
 	tmRPCTestGetReplicas(ctx, t, client, tablet)
 
+	// Table GC methods
+	tmRPCTestGetTableGCStatus(ctx, t, client, tablet)
+	tmRPCTestAdvanceTableGC(ctx, t, client, tablet)
+
+	// MySQL upgrade methods
+	tmRPCTestUpgradeMysqld(ctx, t, client, tablet)
+
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVReplicationProgress(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1365,9 +1491,15 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestStopReplicationMinimumPanic(ctx, t, client, tablet)
 	tmRPCTestStartReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestGetReplicasPanic(ctx, t, client, tablet)
+	// Table GC methods
+	tmRPCTestGetTableGCStatusPanic(ctx, t, client, tablet)
+	tmRPCTestAdvanceTableGCPanic(ctx, t, client, tablet)
+	// MySQL upgrade methods
+	tmRPCTestUpgradeMysqldPanic(ctx, t, client, tablet)
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVReplicationProgressPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
@@ -70,6 +70,7 @@ func (hw *horizontalReshardingWorkflow) runCopySchema(ctx context.Context, t *wo
 	destShard := t.Attributes["destination_shard"]
 	excludeTables := strings.Split(t.Attributes["exclude_tables"], ",")
 	return hw.wr.CopySchemaShardFromShard(ctx, nil /* tableArray*/, excludeTables /* excludeTableArray */, true, /*includeViews*/
+		false /*includeRoutines*/, false, /*includeTriggers*/
 		keyspace, sourceShard, keyspace, destShard, wrangler.DefaultWaitReplicasTimeout, false)
 }
 
@@ -0,0 +1,719 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// The states of the backfill of a lookup vindex.
+const (
+	// LookupBackfillCopying is the state of a backfill whose streams are
+	// still copying the rows of the owner table.
+	LookupBackfillCopying = "Copying"
+	// LookupBackfillCopied is the state of a backfill whose streams have
+	// copied all the rows, and whose vindex is still write_only.
+	LookupBackfillCopied = "Copied"
+	// LookupBackfillError is the state of a backfill that has a stream in
+	// error.
+	LookupBackfillError = "Error"
+	// LookupBackfillExternalized is the state of a backfill whose vindex
+	// was externalized.
+	LookupBackfillExternalized = "Externalized"
+)
+
+// LookupBackfillProgress is the progress of the backfill of a lookup vindex
+// that CreateLookupVindex set up.
+type LookupBackfillProgress struct {
+	// Vindex is the vindex, as keyspace.vindex.
+	Vindex string
+	// Workflow is the workflow that backfills the lookup table, as
+	// keyspace.workflow.
+	Workflow string
+	// State is the state of the backfill: Copying, Copied, Error or
+	// Externalized.
+	State string
+	// OwnerRows and LookupRows are the estimated numbers of rows of the
+	// owner table and of the lookup table, from information_schema.
+	OwnerRows, LookupRows int64
+	// PercentCopied is the estimated percentage of the rows that were copied.
+	PercentCopied float64
+	// ETA is the estimated time left until the copy completes, from the
+	// rate of the copy since the lookup table was created. It's empty when
+	// it can't be estimated.
+	ETA string `json:",omitempty"`
+	// Streams are the states of the streams, by shard/tablet/id.
+	Streams map[string]string
+}
+
+// lookupBackfill describes the lookup vindex of a backfill and the tables
+// that it copies from and into.
+type lookupBackfill struct {
+	sourceKeyspace string
+	vindexName     string
+	vindex         *vschemapb.Vindex
+	targetKeyspace string
+	targetTable    string
+	workflow       string
+	// ownerTable is the table of the source keyspace whose rows are copied,
+	// ownerColumns its columns of the vindex, and toColumns its columns
+	// that determine the to column of the lookup table.
+	ownerTable   string
+	ownerColumns []string
+	toColumns    []string
+	// fromColumns and toColumn are the columns of the lookup table. If
+	// toKeyspaceID is set, toColumn holds the keyspace ids of the rows of
+	// the owner table, from its primary vindex, and toColumns are the
+	// columns of that vindex.
+	fromColumns   []string
+	toColumn      string
+	toKeyspaceID  bool
+	primaryVindex *vschemapb.Vindex
+	primaryName   string
+}
+
+// getLookupBackfill returns the backfill of a lookup vindex, given as
+// keyspace.vindex.
+func (wr *Wrangler) getLookupBackfill(ctx context.Context, qualifiedVindexName string) (*lookupBackfill, *vschemapb.Keyspace, error) {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return nil, nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+	}
+	lb := &lookupBackfill{sourceKeyspace: splits[0], vindexName: splits[1]}
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, lb.sourceKeyspace)
+	if err != nil {
+		return nil, nil, err
+	}
+	lb.vindex = sourceVSchema.Vindexes[lb.vindexName]
+	if lb.vindex == nil {
+		return nil, nil, fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
+	}
+	if !strings.Contains(lb.vindex.Type, "lookup") {
+		return nil, nil, fmt.Errorf("vindex %s is not a lookup type: %s", qualifiedVindexName, lb.vindex.Type)
+	}
+	splits = strings.Split(lb.vindex.Params["table"], ".")
+	if len(splits) != 2 {
+		return nil, nil, fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", lb.vindex.Params["table"])
+	}
+	lb.targetKeyspace, lb.targetTable = splits[0], splits[1]
+	lb.workflow = lb.targetTable + "_vdx"
+	for _, col := range strings.Split(lb.vindex.Params["from"], ",") {
+		lb.fromColumns = append(lb.fromColumns, strings.TrimSpace(col))
+	}
+	lb.toColumn = lb.vindex.Params["to"]
+
+	// The owner table is the table that uses the vindex, which
+	// CreateLookupVindex added to its column vindexes.
+	tableNames := make([]string, 0, len(sourceVSchema.Tables))
+	for name := range sourceVSchema.Tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+	for _, name := range tableNames {
+		if lb.vindex.Owner != "" && name != lb.vindex.Owner {
+			continue
+		}
+		table := sourceVSchema.Tables[name]
+		for _, cv := range table.ColumnVindexes {
+			if cv.Name != lb.vindexName {
+				continue
+			}
+			lb.ownerTable = name
+			lb.ownerColumns = cv.Columns
+			if len(lb.ownerColumns) == 0 {
+				lb.ownerColumns = []string{cv.Column}
+			}
+			break
+		}
+		if lb.ownerTable == "" {
+			continue
+		}
+		to := lb.vindex.Params["to"]
+		if strings.EqualFold(to, "keyspace_id") || strings.HasPrefix(strings.ToLower(lb.vindex.Type), "consistent_lookup") {
+			// The keyspace id is determined by the primary vindex.
+			primary := table.ColumnVindexes[0]
+			lb.toColumns = primary.Columns
+			if len(lb.toColumns) == 0 {
+				lb.toColumns = []string{primary.Column}
+			}
+			lb.toKeyspaceID = true
+			lb.primaryName = primary.Name
+			lb.primaryVindex = sourceVSchema.Vindexes[primary.Name]
+		} else {
+			lb.toColumns = []string{to}
+		}
+		break
+	}
+	if lb.ownerTable == "" {
+		return nil, nil, fmt.Errorf("no table of keyspace %s uses the vindex %s", lb.sourceKeyspace, lb.vindexName)
+	}
+	return lb, sourceVSchema, nil
+}
+
+// GetLookupBackfillProgress returns the progress of the backfill of a lookup
+// vindex that CreateLookupVindex set up, given as keyspace.vindex.
+func (wr *Wrangler) GetLookupBackfillProgress(ctx context.Context, qualifiedVindexName string) (*LookupBackfillProgress, error) {
+	lb, _, err := wr.getLookupBackfill(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+	progress := &LookupBackfillProgress{
+		Vindex:   qualifiedVindexName,
+		Workflow: lb.targetKeyspace + "." + lb.workflow,
+		Streams:  make(map[string]string),
+	}
+
+	targetShards, err := wr.ts.GetServingShards(ctx, lb.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	sourceShards, err := wr.ts.GetServingShards(ctx, lb.sourceKeyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu                sync.Mutex
+		copying, errored  bool
+		lookupElapsedSecs int64
+	)
+	err = forAllShards(targetShards, func(targetShard *topo.ShardInfo) error {
+		targetPrimary, err := wr.ts.GetTablet(ctx, targetShard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("select id, state from _vt.vreplication where workflow=%s and db_name=%s", encodeString(lb.workflow), encodeString(targetPrimary.DbName()))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, targetPrimary.Tablet, query)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		streams := make(map[string]string, len(qr.Rows))
+		var ids []string
+		for _, row := range qr.Rows {
+			id := row[0].ToString()
+			state := row[1].ToString()
+			if state == binlogplayer.BlpError {
+				state = LookupBackfillError
+			}
+			streams[id] = state
+			ids = append(ids, id)
+		}
+		if len(ids) > 0 {
+			query = fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", "))
+			p3qr, err = wr.tmc.VReplicationExec(ctx, targetPrimary.Tablet, query)
+			if err != nil {
+				return err
+			}
+			for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+				if state := streams[row[0].ToString()]; state == binlogplayer.BlpRunning {
+					streams[row[0].ToString()] = LookupBackfillCopying
+				}
+			}
+		}
+
+		query = fmt.Sprintf("select table_rows, unix_timestamp() - unix_timestamp(create_time) from information_schema.tables where table_schema=%s and table_name=%s", encodeString(targetPrimary.DbName()), encodeString(lb.targetTable))
+		rows, elapsedSecs, err := wr.tableRowsEstimate(ctx, targetPrimary, query)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for id, state := range streams {
+			progress.Streams[fmt.Sprintf("%s/%s/%s", targetShard.ShardName(), targetPrimary.AliasString(), id)] = state
+			switch state {
+			case LookupBackfillCopying:
+				copying = true
+			case LookupBackfillError:
+				errored = true
+			}
+		}
+		progress.LookupRows += rows
+		if elapsedSecs > lookupElapsedSecs {
+			lookupElapsedSecs = elapsedSecs
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = forAllShards(sourceShards, func(sourceShard *topo.ShardInfo) error {
+		sourcePrimary, err := wr.ts.GetTablet(ctx, sourceShard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("select table_rows, 0 from information_schema.tables where table_schema=%s and table_name=%s", encodeString(sourcePrimary.DbName()), encodeString(lb.ownerTable))
+		rows, _, err := wr.tableRowsEstimate(ctx, sourcePrimary, query)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		progress.OwnerRows += rows
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, writeOnly := lb.vindex.Params["write_only"]
+	switch {
+	case errored:
+		progress.State = LookupBackfillError
+	case len(progress.Streams) == 0 && writeOnly:
+		return nil, fmt.Errorf("no streams found for workflow %s", progress.Workflow)
+	case copying:
+		progress.State = LookupBackfillCopying
+	case writeOnly:
+		progress.State = LookupBackfillCopied
+	default:
+		progress.State = LookupBackfillExternalized
+	}
+
+	switch {
+	case progress.State == LookupBackfillCopied || progress.State == LookupBackfillExternalized || progress.OwnerRows == 0:
+		progress.PercentCopied = 100
+	case progress.LookupRows < progress.OwnerRows:
+		progress.PercentCopied = float64(progress.LookupRows) * 100 / float64(progress.OwnerRows)
+	default:
+		// The estimates can be off, but the copy isn't complete.
+		progress.PercentCopied = 99
+	}
+	if progress.State == LookupBackfillCopying && progress.LookupRows > 0 && lookupElapsedSecs > 0 && progress.LookupRows < progress.OwnerRows {
+		secsLeft := (progress.OwnerRows - progress.LookupRows) * lookupElapsedSecs / progress.LookupRows
+		progress.ETA = (time.Duration(secsLeft) * time.Second).String()
+	}
+	return progress, nil
+}
+
+// tableRowsEstimate returns the two values of the single row of a query
+// on information_schema.tables, or zeros if the table doesn't exist.
+func (wr *Wrangler) tableRowsEstimate(ctx context.Context, tablet *topo.TabletInfo, query string) (int64, int64, error) {
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, tablet.Tablet, true, []byte(query), 1, false, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return 0, 0, nil
+	}
+	var values [2]int64
+	for i := range values {
+		if qr.Rows[0][i].IsNull() {
+			continue
+		}
+		if values[i], err = evalengine.ToInt64(qr.Rows[0][i]); err != nil {
+			return 0, 0, err
+		}
+	}
+	return values[0], values[1], nil
+}
+
+// lookupBackfillVerifyTimeout bounds the verification of the lookup table
+// of a backfill.
+var lookupBackfillVerifyTimeout = 10 * time.Minute
+
+// lookupBackfillSampleSize is the number of rows of the owner table that
+// the verification of a backfill looks up in the lookup table.
+const lookupBackfillSampleSize = 1000
+
+// VerifyLookupBackfill verifies the lookup table of a lookup vindex, given as
+// keyspace.vindex, against the owner table. The lookup table must have as
+// many rows as the owner table has distinct values of the vindex and to
+// columns, and a sample of those values must all be in the lookup table.
+func (wr *Wrangler) VerifyLookupBackfill(ctx context.Context, qualifiedVindexName string) error {
+	ctx, cancel := context.WithTimeout(ctx, lookupBackfillVerifyTimeout)
+	defer cancel()
+
+	lb, _, err := wr.getLookupBackfill(ctx, qualifiedVindexName)
+	if err != nil {
+		return err
+	}
+	targetShards, err := wr.ts.GetServingShards(ctx, lb.targetKeyspace)
+	if err != nil {
+		return err
+	}
+	sourceShards, err := wr.ts.GetServingShards(ctx, lb.sourceKeyspace)
+	if err != nil {
+		return err
+	}
+
+	ownerValues := lb.ownerValuesQuery(lb.ownerColumns)
+	ownerQuery := "select count(*) from (" + ownerValues + ") as t"
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select count(*) from %v", sqlparser.NewTableIdent(lb.targetTable))
+	lookupQuery := buf.String()
+
+	var (
+		mu                    sync.Mutex
+		ownerRows, lookupRows int64
+	)
+	count := func(shards []*topo.ShardInfo, query string, total *int64) error {
+		return forAllShards(shards, func(shard *topo.ShardInfo) error {
+			primary, err := wr.ts.GetTablet(ctx, shard.PrimaryAlias)
+			if err != nil {
+				return err
+			}
+			p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, primary.Tablet, true, []byte(query), 1, false, false)
+			if err != nil {
+				return err
+			}
+			qr := sqltypes.Proto3ToResult(p3qr)
+			if len(qr.Rows) != 1 {
+				return fmt.Errorf("unexpected result of %s on %v: %v", query, primary.AliasString(), qr.Rows)
+			}
+			n, err := evalengine.ToInt64(qr.Rows[0][0])
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			*total += n
+			return nil
+		})
+	}
+	if err := count(sourceShards, ownerQuery, &ownerRows); err != nil {
+		return err
+	}
+	if err := count(targetShards, lookupQuery, &lookupRows); err != nil {
+		return err
+	}
+	if ownerRows != lookupRows {
+		return fmt.Errorf("the lookup table %s.%s has %d rows, while the table %s.%s has %d rows for the vindex %s", lb.targetKeyspace, lb.targetTable, lookupRows, lb.sourceKeyspace, lb.ownerTable, ownerRows, qualifiedVindexName)
+	}
+	if ownerRows == 0 {
+		return nil
+	}
+
+	// The same numbers of rows could still be different rows, so a sample
+	// of the rows of the owner table is looked up in the lookup table.
+	fraction := lookupBackfillSampleFraction(ownerRows)
+	var missing []string
+	var sampled int
+	err = forAllShards(sourceShards, func(sourceShard *topo.ShardInfo) error {
+		expected, err := wr.sampleLookupRows(ctx, lb, sourceShard, ownerValues, fraction)
+		if err != nil || len(expected) == 0 {
+			return err
+		}
+		found, err := wr.findLookupRows(ctx, lb, targetShards, expected)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		sampled += len(expected)
+		for _, row := range expected {
+			if !row.foundIn(found, sourceShard.KeyRange) {
+				missing = append(missing, fmt.Sprintf("%v", row.from))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("the lookup table %s.%s misses %d of %d rows sampled from the table %s.%s for the vindex %s, e.g. %s", lb.targetKeyspace, lb.targetTable, len(missing), sampled, lb.sourceKeyspace, lb.ownerTable, qualifiedVindexName, missing[0])
+	}
+	return nil
+}
+
+// ownerValuesQuery returns the query of the distinct values of the columns
+// of the owner table and of its to columns. The rows whose columns are NULL
+// are not in the lookup tables.
+func (lb *lookupBackfill) ownerValuesQuery(columns []string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select distinct ")
+	prefix := ""
+	for _, col := range append(append([]string{}, columns...), lb.toColumns...) {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	buf.Myprintf(" from %v where ", sqlparser.NewTableIdent(lb.ownerTable))
+	prefix = ""
+	for _, col := range columns {
+		buf.Myprintf("%s%v is not null", prefix, sqlparser.NewColIdent(col))
+		prefix = " and "
+	}
+	return buf.String()
+}
+
+// lookupBackfillSampleFraction returns the fraction of the rows of the owner
+// table to sample, out of its number of rows.
+func lookupBackfillSampleFraction(ownerRows int64) float64 {
+	if ownerRows > lookupBackfillSampleSize {
+		return float64(lookupBackfillSampleSize) / float64(ownerRows)
+	}
+	return 1
+}
+
+// lookupRow is a row that the lookup table of a backfill must have.
+type lookupRow struct {
+	from []sqltypes.Value
+	// to is the value of the to column, or nil if it's a keyspace id
+	// that can't be computed without lookups.
+	to []byte
+}
+
+// foundIn returns whether the row is in found, the values of the to column
+// of the rows of the lookup table by from values. A keyspace id that can't
+// be computed only needs to be in the key range of the source shard.
+func (row *lookupRow) foundIn(found map[string][][]byte, keyRange *topodatapb.KeyRange) bool {
+	for _, to := range found[lookupRowKey(row.from)] {
+		if (row.to == nil && key.KeyRangeContains(keyRange, to)) || (row.to != nil && bytes.Equal(row.to, to)) {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupRowKey(from []sqltypes.Value) string {
+	values := make([]string, 0, len(from))
+	for _, value := range from {
+		values = append(values, value.ToString())
+	}
+	return fmt.Sprintf("%q", values)
+}
+
+// sampleLookupRows returns the rows of the lookup table for a sample of the
+// rows of the owner table on the source shard, whose distinct values are
+// selected by ownerValues. The from values of the rows are the values of
+// all the columns but the to columns.
+func (wr *Wrangler) sampleLookupRows(ctx context.Context, lb *lookupBackfill, sourceShard *topo.ShardInfo, ownerValues string, fraction float64) ([]*lookupRow, error) {
+	primary, err := wr.ts.GetTablet(ctx, sourceShard.PrimaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	query := ownerValues
+	if fraction < 1 {
+		query += fmt.Sprintf(" and rand() < %v", fraction)
+	}
+	query += fmt.Sprintf(" limit %d", lookupBackfillSampleSize)
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, primary.Tablet, true, []byte(query), lookupBackfillSampleSize, false, false)
+	if err != nil {
+		return nil, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	numFrom := len(qr.Rows[0]) - len(lb.toColumns)
+	rows := make([]*lookupRow, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		rows = append(rows, &lookupRow{from: row[:numFrom], to: []byte(row[numFrom].ToString())})
+	}
+	if !lb.toKeyspaceID {
+		return rows, nil
+	}
+
+	// The keyspace ids are computed with the primary vindex of the owner
+	// table, unless it needs lookups itself.
+	if lb.primaryVindex == nil {
+		return nil, fmt.Errorf("primary vindex %s of table %s.%s not found in vschema", lb.primaryName, lb.sourceKeyspace, lb.ownerTable)
+	}
+	vindex, err := vindexes.CreateVindex(lb.primaryVindex.Type, lb.primaryName, lb.primaryVindex.Params)
+	if err != nil {
+		return nil, err
+	}
+	if vindex.NeedsVCursor() {
+		for _, row := range rows {
+			row.to = nil
+		}
+		return rows, nil
+	}
+	toValues := make([][]sqltypes.Value, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		toValues = append(toValues, row[numFrom:])
+	}
+	destinations, err := vindexes.Map(vindex, nil, toValues)
+	if err != nil {
+		return nil, err
+	}
+	for i, destination := range destinations {
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("the primary vindex %s of table %s.%s maps %v to %v instead of a keyspace id", lb.primaryName, lb.sourceKeyspace, lb.ownerTable, toValues[i], destination)
+		}
+		rows[i].to = ksid
+	}
+	return rows, nil
+}
+
+// findLookupRows returns the values of the to column of the rows of the
+// lookup table that have the from values of the rows, by from values.
+func (wr *Wrangler) findLookupRows(ctx context.Context, lb *lookupBackfill, targetShards []*topo.ShardInfo, rows []*lookupRow) (map[string][][]byte, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for _, col := range lb.fromColumns {
+		buf.Myprintf("%v, ", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf("%v from %v where ", sqlparser.NewColIdent(lb.toColumn), sqlparser.NewTableIdent(lb.targetTable))
+	tuple := len(lb.fromColumns) > 1
+	if tuple {
+		buf.Myprintf("(")
+	}
+	prefix := ""
+	for _, col := range lb.fromColumns {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	if tuple {
+		buf.Myprintf(")")
+	}
+	buf.Myprintf(" in (")
+	for i, row := range rows {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		if tuple {
+			buf.Myprintf("(")
+		}
+		for j, value := range row.from {
+			if j > 0 {
+				buf.Myprintf(", ")
+			}
+			value.EncodeSQL(buf)
+		}
+		if tuple {
+			buf.Myprintf(")")
+		}
+	}
+	buf.Myprintf(")")
+	query := buf.String()
+
+	var mu sync.Mutex
+	found := make(map[string][][]byte, len(rows))
+	err := forAllShards(targetShards, func(targetShard *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, targetShard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		// A non-unique lookup table can have several rows for the same from values.
+		p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, primary.Tablet, true, []byte(query), math.MaxInt32, false, false)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+			k := lookupRowKey(row[:len(lb.fromColumns)])
+			found[k] = append(found[k], []byte(row[len(lb.fromColumns)].ToString()))
+		}
+		return nil
+	})
+	return found, err
+}
+
+// CompleteLookupVindex waits until the backfill of a lookup vindex that
+// CreateLookupVindex set up, given as keyspace.vindex, has copied all the
+// rows, logging its progress every checkInterval. It then verifies the
+// lookup table, which it retries until it succeeds, in case of concurrent
+// writes, and externalizes the vindex.
+func (wr *Wrangler) CompleteLookupVindex(ctx context.Context, qualifiedVindexName string, checkInterval time.Duration) error {
+	for {
+		progress, err := wr.GetLookupBackfillProgress(ctx, qualifiedVindexName)
+		if err != nil {
+			return err
+		}
+		switch progress.State {
+		case LookupBackfillError:
+			return fmt.Errorf("the backfill of %s has streams in error: %v", qualifiedVindexName, progress.Streams)
+		case LookupBackfillExternalized:
+			wr.Logger().Infof("The vindex %s is already externalized", qualifiedVindexName)
+			return nil
+		case LookupBackfillCopying:
+			eta := progress.ETA
+			if eta == "" {
+				eta = "unknown"
+			}
+			wr.Logger().Infof("Backfilling %s: %.1f%% of %d rows copied, ETA %s", qualifiedVindexName, progress.PercentCopied, progress.OwnerRows, eta)
+		case LookupBackfillCopied:
+			err := wr.VerifyLookupBackfill(ctx, qualifiedVindexName)
+			if err == nil {
+				wr.Logger().Infof("The backfill of %s is verified, externalizing it", qualifiedVindexName)
+				return wr.ExternalizeVindex(ctx, qualifiedVindexName)
+			}
+			wr.Logger().Warningf("The backfill of %s is not verified yet: %v", qualifiedVindexName, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the backfill of %s did not complete: %v", qualifiedVindexName, ctx.Err())
+		case <-time.After(checkInterval):
+		}
+	}
+}
+
+// lookupBackfillOfWorkflow returns the progress of the backfill of the
+// workflow, if it backfills a lookup vindex of the source keyspace, or nil.
+func (wr *Wrangler) lookupBackfillOfWorkflow(ctx context.Context, workflow, keyspace, sourceKeyspace string) (*LookupBackfillProgress, error) {
+	if !strings.HasSuffix(workflow, "_vdx") || sourceKeyspace == "" {
+		return nil, nil
+	}
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, sourceKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	table := keyspace + "." + strings.TrimSuffix(workflow, "_vdx")
+	for name, vindex := range sourceVSchema.Vindexes {
+		if strings.Contains(vindex.Type, "lookup") && vindex.Params["table"] == table {
+			return wr.GetLookupBackfillProgress(ctx, sourceKeyspace+"."+name)
+		}
+	}
+	return nil, nil
+}
+
+// forAllShards runs f concurrently for all the shards.
+func forAllShards(shards []*topo.ShardInfo, f func(*topo.ShardInfo) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard *topo.ShardInfo) {
+			defer wg.Done()
+
+			if err := f(shard); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(shard)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
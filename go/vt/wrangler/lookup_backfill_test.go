@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	backfillStreamsQuery   = "select id, state from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	backfillCopyStateQuery = "select distinct vrepl_id from _vt.copy_state where vrepl_id in (1)"
+	backfillLookupRows     = "select table_rows, unix_timestamp() - unix_timestamp(create_time) from information_schema.tables where table_schema='vt_targetks' and table_name='lkp'"
+	backfillOwnerRows      = "select table_rows, 0 from information_schema.tables where table_schema='vt_sourceks' and table_name='t1'"
+	backfillLookupCount    = "select count(*) from lkp"
+	backfillOwnerCount     = "select count(*) from (select distinct c1, c2 from t1 where c1 is not null) as t"
+	backfillOwnerSample    = "select distinct c1, c2 from t1 where c1 is not null limit 1000"
+	backfillLookupSample   = "select c1, c2 from lkp where c1 in (1, 2)"
+)
+
+func newLookupBackfillEnv(t *testing.T) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	sourceVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+			"v": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table":      "targetks.lkp",
+					"from":       "c1",
+					"to":         "c2",
+					"write_only": "true",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "hash",
+					Column: "c2",
+				}, {
+					Name:   "v",
+					Column: "c1",
+				}},
+			},
+		},
+	}
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, sourceVSchema))
+	return env
+}
+
+func (env *testMaterializerEnv) expectBackfillProgress(state string, copying bool, lookupRows string, ownerRows string) {
+	fields := sqltypes.MakeTestFields("id|state", "int64|varbinary")
+	rowsFields := sqltypes.MakeTestFields("table_rows|elapsed", "int64|int64")
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, backfillStreamsQuery, sqltypes.MakeTestResult(fields, "1|"+state))
+		copyState := &sqltypes.Result{}
+		if copying {
+			copyState = sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"), "1")
+		}
+		env.tmc.expectVRQuery(tabletID, backfillCopyStateQuery, copyState)
+		env.tmc.expectVRQuery(tabletID, backfillLookupRows, sqltypes.MakeTestResult(rowsFields, lookupRows))
+	}
+	env.tmc.expectVRQuery(100, backfillOwnerRows, sqltypes.MakeTestResult(rowsFields, ownerRows))
+}
+
+// expectBackfillCounts expects the verification of the lookup table, whose
+// rows of the sample are all found if the counts match.
+func (env *testMaterializerEnv) expectBackfillCounts(lookupRows, ownerRows string) {
+	countFields := sqltypes.MakeTestFields("count(*)", "int64")
+	env.tmc.expectVRQuery(100, backfillOwnerCount, sqltypes.MakeTestResult(countFields, ownerRows))
+	env.tmc.expectVRQuery(200, backfillLookupCount, sqltypes.MakeTestResult(countFields, lookupRows))
+	env.tmc.expectVRQuery(210, backfillLookupCount, sqltypes.MakeTestResult(countFields, lookupRows))
+	perShard, _ := strconv.Atoi(lookupRows)
+	if total, _ := strconv.Atoi(ownerRows); 2*perShard != total {
+		return
+	}
+	env.expectBackfillSample("1|10", "2|20")
+}
+
+func (env *testMaterializerEnv) expectBackfillSample(lookupRows1, lookupRows2 string) {
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	env.tmc.expectVRQuery(100, backfillOwnerSample, sqltypes.MakeTestResult(fields, "1|10", "2|20"))
+	env.tmc.expectVRQuery(200, backfillLookupSample, sqltypes.MakeTestResult(fields, lookupRows1))
+	env.tmc.expectVRQuery(210, backfillLookupSample, sqltypes.MakeTestResult(fields, lookupRows2))
+}
+
+func TestGetLookupBackfillProgress(t *testing.T) {
+	env := newLookupBackfillEnv(t)
+	defer env.close()
+
+	env.expectBackfillProgress("Running", true, "30|60", "120|0")
+	progress, err := env.wr.GetLookupBackfillProgress(context.Background(), "sourceks.v")
+	require.NoError(t, err)
+	assert.Equal(t, &LookupBackfillProgress{
+		Vindex:        "sourceks.v",
+		Workflow:      "targetks.lkp_vdx",
+		State:         LookupBackfillCopying,
+		OwnerRows:     120,
+		LookupRows:    60,
+		PercentCopied: 50,
+		ETA:           "1m0s",
+		Streams: map[string]string{
+			"-80/cell-0000000200/1": LookupBackfillCopying,
+			"80-/cell-0000000210/1": LookupBackfillCopying,
+		},
+	}, progress)
+
+	env.expectBackfillProgress("Stopped", false, "60|120", "120|0")
+	progress, err = env.wr.GetLookupBackfillProgress(context.Background(), "sourceks.v")
+	require.NoError(t, err)
+	assert.Equal(t, LookupBackfillCopied, progress.State)
+	assert.Equal(t, float64(100), progress.PercentCopied)
+	assert.Empty(t, progress.ETA)
+
+	env.expectBackfillProgress("Error", false, "60|120", "120|0")
+	progress, err = env.wr.GetLookupBackfillProgress(context.Background(), "sourceks.v")
+	require.NoError(t, err)
+	assert.Equal(t, LookupBackfillError, progress.State)
+
+	_, err = env.wr.GetLookupBackfillProgress(context.Background(), "sourceks.hash")
+	assert.EqualError(t, err, "vindex sourceks.hash is not a lookup type: hash")
+	env.tmc.verifyQueries(t)
+}
+
+func TestVerifyLookupBackfill(t *testing.T) {
+	env := newLookupBackfillEnv(t)
+	defer env.close()
+
+	env.expectBackfillCounts("60", "120")
+	require.NoError(t, env.wr.VerifyLookupBackfill(context.Background(), "sourceks.v"))
+	env.tmc.verifyQueries(t)
+
+	// The lookup table has as many rows as the owner table, but not the
+	// same ones.
+	env.expectBackfillCounts("60", "119")
+	env.tmc.expectVRQuery(100, backfillOwnerCount, sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "120"))
+	env.tmc.expectVRQuery(200, backfillLookupCount, sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "60"))
+	env.tmc.expectVRQuery(210, backfillLookupCount, sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "60"))
+	env.expectBackfillSample("1|10", "2|21")
+	err := env.wr.VerifyLookupBackfill(context.Background(), "sourceks.v")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the table sourceks.t1 has 119 rows")
+	err = env.wr.VerifyLookupBackfill(context.Background(), "sourceks.v")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the lookup table targetks.lkp misses 1 of 2 rows sampled from the table sourceks.t1 for the vindex sourceks.v, e.g. [INT64(2)]")
+	env.tmc.verifyQueries(t)
+}
+
+func TestVerifyLookupBackfillKeyspaceID(t *testing.T) {
+	env := newLookupBackfillEnv(t)
+	defer env.close()
+	vschema, err := env.topoServ.GetVSchema(context.Background(), "sourceks")
+	require.NoError(t, err)
+	vschema.Vindexes["v"].Params["to"] = "keyspace_id"
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "sourceks", vschema))
+
+	// The keyspace ids of the rows are those of their primary vindex.
+	hash, err := vindexes.CreateVindex("hash", "hash", nil)
+	require.NoError(t, err)
+	destinations, err := vindexes.Map(hash, nil, [][]sqltypes.Value{{sqltypes.NewInt64(10)}, {sqltypes.NewInt64(20)}})
+	require.NoError(t, err)
+	ksid := func(i int) sqltypes.Value {
+		return sqltypes.NewVarBinary(string(destinations[i].(key.DestinationKeyspaceID)))
+	}
+	lookupFields := []*querypb.Field{{Name: "c1", Type: sqltypes.Int64}, {Name: "keyspace_id", Type: sqltypes.VarBinary}}
+	lookupRows := func(rows ...[]sqltypes.Value) *sqltypes.Result {
+		return &sqltypes.Result{Fields: lookupFields, Rows: rows}
+	}
+	expect := func(lookupRows1, lookupRows2 *sqltypes.Result) {
+		countFields := sqltypes.MakeTestFields("count(*)", "int64")
+		env.tmc.expectVRQuery(100, backfillOwnerCount, sqltypes.MakeTestResult(countFields, "2"))
+		env.tmc.expectVRQuery(200, backfillLookupCount, sqltypes.MakeTestResult(countFields, "1"))
+		env.tmc.expectVRQuery(210, backfillLookupCount, sqltypes.MakeTestResult(countFields, "1"))
+		env.tmc.expectVRQuery(100, backfillOwnerSample, sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|c2", "int64|int64"), "1|10", "2|20"))
+		env.tmc.expectVRQuery(200, "select c1, keyspace_id from lkp where c1 in (1, 2)", lookupRows1)
+		env.tmc.expectVRQuery(210, "select c1, keyspace_id from lkp where c1 in (1, 2)", lookupRows2)
+	}
+
+	expect(lookupRows([]sqltypes.Value{sqltypes.NewInt64(1), ksid(0)}), lookupRows([]sqltypes.Value{sqltypes.NewInt64(2), ksid(1)}))
+	require.NoError(t, env.wr.VerifyLookupBackfill(context.Background(), "sourceks.v"))
+
+	expect(lookupRows([]sqltypes.Value{sqltypes.NewInt64(1), ksid(0)}), lookupRows([]sqltypes.Value{sqltypes.NewInt64(2), ksid(0)}))
+	err = env.wr.VerifyLookupBackfill(context.Background(), "sourceks.v")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "misses 1 of 2 rows sampled")
+	env.tmc.verifyQueries(t)
+}
+
+func TestCompleteLookupVindex(t *testing.T) {
+	env := newLookupBackfillEnv(t)
+	defer env.close()
+
+	// The copy is still in progress on the first check, and the lookup table
+	// misses rows that were written concurrently on the second one.
+	env.expectBackfillProgress("Running", true, "30|60", "120|0")
+	env.expectBackfillProgress("Stopped", false, "60|120", "120|0")
+	env.expectBackfillCounts("59", "120")
+	env.expectBackfillProgress("Stopped", false, "60|120", "120|0")
+	env.expectBackfillCounts("60", "120")
+
+	// ExternalizeVindex
+	fields := sqltypes.MakeTestFields("id|state|message|source", "int64|varbinary|varbinary|blob")
+	stopped := sqltypes.MakeTestResult(fields, `1|Stopped|Stopped after copy|keyspace:"sourceks" shard:"0" stop_after_copy:true`)
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, "select id, state, message, source from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'", stopped)
+		env.tmc.expectVRQuery(tabletID, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+	}
+
+	require.NoError(t, env.wr.CompleteLookupVindex(context.Background(), "sourceks.v", time.Millisecond))
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.topoServ.GetVSchema(context.Background(), "sourceks")
+	require.NoError(t, err)
+	assert.NotContains(t, vschema.Vindexes["v"].Params, "write_only")
+
+	// The externalized vindex has no streams left.
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, backfillStreamsQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, backfillLookupRows, &sqltypes.Result{})
+	}
+	env.tmc.expectVRQuery(100, backfillOwnerRows, &sqltypes.Result{})
+	require.NoError(t, env.wr.CompleteLookupVindex(context.Background(), "sourceks.v", time.Millisecond))
+	env.tmc.verifyQueries(t)
+
+	// A stream in error fails the backfill.
+	vschema.Vindexes["v"].Params["write_only"] = "true"
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "sourceks", vschema))
+	env.expectBackfillProgress("Error", false, "60|120", "120|0")
+	err = env.wr.CompleteLookupVindex(context.Background(), "sourceks.v", time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the backfill of sourceks.v has streams in error")
+	env.tmc.verifyQueries(t)
+}
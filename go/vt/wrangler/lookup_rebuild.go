@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// RebuildLookupVindex rebuilds the lookup vindex oldVindexName of the
+// keyspace into a new lookup table, e.g. to change its from columns. The
+// specs are those of CreateLookupVindex for the new vindex, which must be
+// owned by the same table as the old one.
+//
+// The new vindex is created write_only, with a workflow that backfills its
+// lookup table, so that vtgate writes both lookup tables meanwhile. Once the
+// backfill has copied all the rows, both lookup tables are verified against
+// the owner table every checkInterval, and compared with each other for a
+// sample of the rows of the owner table. After convergedChecks successive
+// verifications, the new vindex replaces the old one in the vschema, with a
+// single update of the vschema, and the workflow is cleaned up. The old
+// lookup table is then left to be dropped.
+//
+// If the new vindex already exists, the rebuild resumes where it left off.
+func (wr *Wrangler) RebuildLookupVindex(ctx context.Context, keyspace, oldVindexName string, specs *vschemapb.Keyspace, cell, tabletTypes string, checkInterval time.Duration, convergedChecks int) error {
+	if len(specs.Vindexes) != 1 {
+		return fmt.Errorf("only one vindex must be specified in the specs: %v", specs.Vindexes)
+	}
+	var newVindexName string
+	for name := range specs.Vindexes {
+		newVindexName = name
+	}
+	if newVindexName == oldVindexName {
+		return fmt.Errorf("the new vindex must be named differently from the vindex %s that it rebuilds", oldVindexName)
+	}
+	oldQualifiedName, newQualifiedName := keyspace+"."+oldVindexName, keyspace+"."+newVindexName
+
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if _, ok := sourceVSchema.Vindexes[oldVindexName]; !ok {
+		if newVindex, ok := sourceVSchema.Vindexes[newVindexName]; ok {
+			if _, writeOnly := newVindex.Params["write_only"]; !writeOnly {
+				wr.Logger().Infof("The vindex %s is already rebuilt into %s", oldQualifiedName, newQualifiedName)
+				return nil
+			}
+		}
+		return fmt.Errorf("vindex %s not found in vschema", oldQualifiedName)
+	}
+	old, _, err := wr.getLookupBackfill(ctx, oldQualifiedName)
+	if err != nil {
+		return err
+	}
+	if old.vindex.Owner == "" {
+		return fmt.Errorf("the vindex %s has no owner, whose writes would keep its lookup table in sync while it's rebuilt", oldQualifiedName)
+	}
+	if owner := specs.Vindexes[newVindexName].Owner; owner != old.vindex.Owner {
+		return fmt.Errorf("the owner of the new vindex %s must be the owner of the vindex %s: %q vs %q", newQualifiedName, oldQualifiedName, owner, old.vindex.Owner)
+	}
+	if to := specs.Vindexes[newVindexName].Params["to"]; to != old.vindex.Params["to"] {
+		return fmt.Errorf("the to column of the new vindex %s must be the to column of the vindex %s, so that their lookup tables can be compared: %q vs %q", newQualifiedName, oldQualifiedName, to, old.vindex.Params["to"])
+	}
+
+	if _, ok := sourceVSchema.Vindexes[newVindexName]; ok {
+		wr.Logger().Infof("Resuming the rebuild of %s into %s", oldQualifiedName, newQualifiedName)
+	} else {
+		if err := wr.CreateLookupVindex(ctx, keyspace, specs, cell, tabletTypes, false); err != nil {
+			return err
+		}
+		wr.Logger().Infof("Rebuilding %s into %s, the lookup tables of both are written meanwhile", oldQualifiedName, newQualifiedName)
+	}
+
+	converged := 0
+	for {
+		progress, err := wr.GetLookupBackfillProgress(ctx, newQualifiedName)
+		if err != nil {
+			return err
+		}
+		switch progress.State {
+		case LookupBackfillError:
+			return fmt.Errorf("the backfill of %s has streams in error: %v", newQualifiedName, progress.Streams)
+		case LookupBackfillExternalized:
+			return fmt.Errorf("the vindex %s was externalized before the rebuild of %s completed", newQualifiedName, oldQualifiedName)
+		case LookupBackfillCopying:
+			eta := progress.ETA
+			if eta == "" {
+				eta = "unknown"
+			}
+			wr.Logger().Infof("Backfilling %s: %.1f%% of %d rows copied, ETA %s", newQualifiedName, progress.PercentCopied, progress.OwnerRows, eta)
+		case LookupBackfillCopied:
+			err := wr.VerifyLookupBackfill(ctx, newQualifiedName)
+			if err == nil {
+				err = wr.VerifyLookupBackfill(ctx, oldQualifiedName)
+			}
+			if err == nil {
+				err = wr.diffLookupTables(ctx, oldQualifiedName, newQualifiedName)
+			}
+			if err != nil {
+				converged = 0
+				wr.Logger().Warningf("The lookup tables of %s and %s have not converged yet: %v", oldQualifiedName, newQualifiedName, err)
+				break
+			}
+			converged++
+			wr.Logger().Infof("The lookup tables of %s and %s are verified (%d/%d)", oldQualifiedName, newQualifiedName, converged, convergedChecks)
+			if converged < convergedChecks {
+				break
+			}
+			if err := wr.externalizeVindex(ctx, newQualifiedName, func(sourceVSchema *vschemapb.Keyspace, vindexName string) error {
+				return replaceLookupVindex(sourceVSchema, old.ownerTable, oldVindexName, vindexName)
+			}); err != nil {
+				return err
+			}
+			wr.Logger().Infof("The vindex %s replaced %s, whose lookup table %s.%s can now be dropped", newQualifiedName, oldQualifiedName, old.targetKeyspace, old.targetTable)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the rebuild of %s did not complete: %v", oldQualifiedName, ctx.Err())
+		case <-time.After(checkInterval):
+		}
+	}
+}
+
+// diffLookupTables compares the lookup tables of two lookup vindexes of the
+// same owner table, given as keyspace.vindex, for a sample of the rows of the
+// owner table: a row must be either in both lookup tables or in neither.
+func (wr *Wrangler) diffLookupTables(ctx context.Context, oldQualifiedName, newQualifiedName string) error {
+	ctx, cancel := context.WithTimeout(ctx, lookupBackfillVerifyTimeout)
+	defer cancel()
+
+	old, _, err := wr.getLookupBackfill(ctx, oldQualifiedName)
+	if err != nil {
+		return err
+	}
+	rebuilt, _, err := wr.getLookupBackfill(ctx, newQualifiedName)
+	if err != nil {
+		return err
+	}
+	if old.ownerTable != rebuilt.ownerTable || old.toKeyspaceID != rebuilt.toKeyspaceID || strings.Join(old.toColumns, ",") != strings.Join(rebuilt.toColumns, ",") {
+		return fmt.Errorf("the lookup tables of %s and %s can't be compared, they don't map the same columns of the same table", oldQualifiedName, newQualifiedName)
+	}
+	sourceShards, err := wr.ts.GetServingShards(ctx, old.sourceKeyspace)
+	if err != nil {
+		return err
+	}
+	oldTargetShards, err := wr.ts.GetServingShards(ctx, old.targetKeyspace)
+	if err != nil {
+		return err
+	}
+	newTargetShards, err := wr.ts.GetServingShards(ctx, rebuilt.targetKeyspace)
+	if err != nil {
+		return err
+	}
+
+	// The rows of both lookup tables were counted by their verification, so
+	// the fraction to sample is estimated from information_schema.
+	var (
+		mu        sync.Mutex
+		ownerRows int64
+	)
+	err = forAllShards(sourceShards, func(sourceShard *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, sourceShard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("select table_rows, 0 from information_schema.tables where table_schema=%s and table_name=%s", encodeString(primary.DbName()), encodeString(old.ownerTable))
+		rows, _, err := wr.tableRowsEstimate(ctx, primary, query)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		ownerRows += rows
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fraction := lookupBackfillSampleFraction(ownerRows)
+
+	numOld := len(old.ownerColumns)
+	ownerValues := old.ownerValuesQuery(append(append([]string{}, old.ownerColumns...), rebuilt.ownerColumns...))
+	var diffs []string
+	err = forAllShards(sourceShards, func(sourceShard *topo.ShardInfo) error {
+		sampled, err := wr.sampleLookupRows(ctx, old, sourceShard, ownerValues, fraction)
+		if err != nil || len(sampled) == 0 {
+			return err
+		}
+		oldRows := make([]*lookupRow, 0, len(sampled))
+		newRows := make([]*lookupRow, 0, len(sampled))
+		for _, row := range sampled {
+			oldRows = append(oldRows, &lookupRow{from: row.from[:numOld], to: row.to})
+			newRows = append(newRows, &lookupRow{from: row.from[numOld:], to: row.to})
+		}
+		oldFound, err := wr.findLookupRows(ctx, old, oldTargetShards, oldRows)
+		if err != nil {
+			return err
+		}
+		newFound, err := wr.findLookupRows(ctx, rebuilt, newTargetShards, newRows)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range sampled {
+			inOld, inNew := oldRows[i].foundIn(oldFound, sourceShard.KeyRange), newRows[i].foundIn(newFound, sourceShard.KeyRange)
+			if inOld != inNew {
+				diffs = append(diffs, fmt.Sprintf("%v in %s: %v, %v in %s: %v", oldRows[i].from, oldQualifiedName, inOld, newRows[i].from, newQualifiedName, inNew))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return fmt.Errorf("the lookup tables of %s and %s differ on %d sampled rows of the table %s.%s, e.g. %s", oldQualifiedName, newQualifiedName, len(diffs), old.sourceKeyspace, old.ownerTable, diffs[0])
+	}
+	return nil
+}
+
+// replaceLookupVindex replaces the old vindex of the owner table with the
+// new one, which CreateLookupVindex appended to its column vindexes, and
+// makes the new one readable. The old vindex is removed from the vschema
+// unless other tables still use it.
+func replaceLookupVindex(vschema *vschemapb.Keyspace, ownerTable, oldVindexName, newVindexName string) error {
+	table := vschema.Tables[ownerTable]
+	if table == nil {
+		return fmt.Errorf("table %s not found in vschema", ownerTable)
+	}
+	oldIndex, newIndex := -1, -1
+	for i, cv := range table.ColumnVindexes {
+		switch cv.Name {
+		case oldVindexName:
+			oldIndex = i
+		case newVindexName:
+			newIndex = i
+		}
+	}
+	if oldIndex < 0 || newIndex < 0 {
+		return fmt.Errorf("the table %s must use both the vindexes %s and %s: %v", ownerTable, oldVindexName, newVindexName, table.ColumnVindexes)
+	}
+	table.ColumnVindexes[oldIndex] = table.ColumnVindexes[newIndex]
+	table.ColumnVindexes = append(table.ColumnVindexes[:newIndex], table.ColumnVindexes[newIndex+1:]...)
+	delete(vschema.Vindexes[newVindexName].Params, "write_only")
+
+	for _, t := range vschema.Tables {
+		for _, cv := range t.ColumnVindexes {
+			if cv.Name == oldVindexName {
+				return nil
+			}
+		}
+	}
+	delete(vschema.Vindexes, oldVindexName)
+	return nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// newLookupRebuildEnv returns an environment where the vindex v of the owner
+// table t1 is being rebuilt into the vindex v2, from the column c3.
+func newLookupRebuildEnv(t *testing.T) (*testMaterializerEnv, *vschemapb.Keyspace) {
+	t.Helper()
+	env := newLookupBackfillEnv(t)
+	vschema, err := env.topoServ.GetVSchema(context.Background(), "sourceks")
+	require.NoError(t, err)
+	delete(vschema.Vindexes["v"].Params, "write_only")
+	vschema.Vindexes["v2"] = &vschemapb.Vindex{
+		Type: "lookup_unique",
+		Params: map[string]string{
+			"table":      "targetks.lkp2",
+			"from":       "c3",
+			"to":         "c2",
+			"write_only": "true",
+		},
+		Owner: "t1",
+	}
+	vschema.Tables["t1"].ColumnVindexes = append(vschema.Tables["t1"].ColumnVindexes, &vschemapb.ColumnVindex{
+		Name:   "v2",
+		Column: "c3",
+	})
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "sourceks", vschema))
+
+	specs := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"v2": vschema.Vindexes["v2"],
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "v2",
+					Column: "c3",
+				}},
+			},
+		},
+	}
+	return env, specs
+}
+
+func (env *testMaterializerEnv) expectRebuildProgress(state string, copying bool) {
+	fields := sqltypes.MakeTestFields("id|state", "int64|varbinary")
+	rowsFields := sqltypes.MakeTestFields("table_rows|elapsed", "int64|int64")
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, "select id, state from _vt.vreplication where workflow='lkp2_vdx' and db_name='vt_targetks'", sqltypes.MakeTestResult(fields, "1|"+state))
+		copyState := &sqltypes.Result{}
+		if copying {
+			copyState = sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"), "1")
+		}
+		env.tmc.expectVRQuery(tabletID, backfillCopyStateQuery, copyState)
+		env.tmc.expectVRQuery(tabletID, "select table_rows, unix_timestamp() - unix_timestamp(create_time) from information_schema.tables where table_schema='vt_targetks' and table_name='lkp2'", sqltypes.MakeTestResult(rowsFields, "30|60"))
+	}
+	env.tmc.expectVRQuery(100, backfillOwnerRows, sqltypes.MakeTestResult(rowsFields, "120|0"))
+}
+
+// expectRebuildCounts expects the verification of both lookup tables, and
+// their comparison if they are verified, where the sampled row is in the new
+// lookup table if inNew is set.
+func (env *testMaterializerEnv) expectRebuildCounts(newLookupRows, oldLookupRows string, inNew bool) {
+	countFields := sqltypes.MakeTestFields("count(*)", "int64")
+	env.tmc.expectVRQuery(100, "select count(*) from (select distinct c3, c2 from t1 where c3 is not null) as t", sqltypes.MakeTestResult(countFields, "120"))
+	env.tmc.expectVRQuery(200, "select count(*) from lkp2", sqltypes.MakeTestResult(countFields, newLookupRows))
+	env.tmc.expectVRQuery(210, "select count(*) from lkp2", sqltypes.MakeTestResult(countFields, newLookupRows))
+	if newLookupRows != "60" {
+		return
+	}
+	fields := sqltypes.MakeTestFields("c3|c2", "int64|int64")
+	env.tmc.expectVRQuery(100, "select distinct c3, c2 from t1 where c3 is not null limit 1000", sqltypes.MakeTestResult(fields, "3|10"))
+	env.tmc.expectVRQuery(200, "select c3, c2 from lkp2 where c3 in (3)", sqltypes.MakeTestResult(fields, "3|10"))
+	env.tmc.expectVRQuery(210, "select c3, c2 from lkp2 where c3 in (3)", &sqltypes.Result{})
+	if oldLookupRows != "" {
+		env.expectBackfillCounts(oldLookupRows, "120")
+	}
+	if oldLookupRows == "60" {
+		env.expectRebuildDiff(inNew)
+	}
+}
+
+// expectRebuildDiff expects the comparison of the lookup tables, for a sampled
+// row that is in the old lookup table, and in the new one if inNew is set.
+func (env *testMaterializerEnv) expectRebuildDiff(inNew bool) {
+	env.tmc.expectVRQuery(100, backfillOwnerRows, sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_rows|elapsed", "int64|int64"), "120|0"))
+	env.tmc.expectVRQuery(100, "select distinct c1, c3, c2 from t1 where c1 is not null and c3 is not null limit 1000", sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|c3|c2", "int64|int64|int64"), "1|3|10"))
+	newRows := &sqltypes.Result{}
+	if inNew {
+		newRows = sqltypes.MakeTestResult(sqltypes.MakeTestFields("c3|c2", "int64|int64"), "3|10")
+	}
+	env.tmc.expectVRQuery(200, "select c1, c2 from lkp where c1 in (1)", sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|c2", "int64|int64"), "1|10"))
+	env.tmc.expectVRQuery(210, "select c1, c2 from lkp where c1 in (1)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "select c3, c2 from lkp2 where c3 in (3)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, "select c3, c2 from lkp2 where c3 in (3)", newRows)
+}
+
+func TestRebuildLookupVindex(t *testing.T) {
+	env, specs := newLookupRebuildEnv(t)
+	defer env.close()
+
+	// The copy is still in progress on the first check, the new lookup
+	// table misses rows on the second one, the lookup tables differ on
+	// the third one, and they then converge on two successive checks.
+	env.expectRebuildProgress("Running", true)
+	env.expectRebuildProgress("Stopped", false)
+	env.expectRebuildCounts("59", "", false)
+	env.expectRebuildProgress("Stopped", false)
+	env.expectRebuildCounts("60", "60", false)
+	env.expectRebuildProgress("Stopped", false)
+	env.expectRebuildCounts("60", "60", true)
+	env.expectRebuildProgress("Stopped", false)
+	env.expectRebuildCounts("60", "60", true)
+
+	// The workflow is cleaned up.
+	fields := sqltypes.MakeTestFields("id|state|message|source", "int64|varbinary|varbinary|blob")
+	stopped := sqltypes.MakeTestResult(fields, `1|Stopped|Stopped after copy|keyspace:"sourceks" shard:"0" stop_after_copy:true`)
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, "select id, state, message, source from _vt.vreplication where workflow='lkp2_vdx' and db_name='vt_targetks'", stopped)
+		env.tmc.expectVRQuery(tabletID, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp2_vdx'", &sqltypes.Result{})
+	}
+
+	require.NoError(t, env.wr.RebuildLookupVindex(context.Background(), "sourceks", "v", specs, "", "", time.Millisecond, 2))
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.topoServ.GetVSchema(context.Background(), "sourceks")
+	require.NoError(t, err)
+	assert.NotContains(t, vschema.Vindexes, "v")
+	assert.NotContains(t, vschema.Vindexes["v2"].Params, "write_only")
+	assert.Equal(t, []*vschemapb.ColumnVindex{{
+		Name:   "hash",
+		Column: "c2",
+	}, {
+		Name:   "v2",
+		Column: "c3",
+	}}, vschema.Tables["t1"].ColumnVindexes)
+
+	// Rerunning the rebuild is a no-op.
+	require.NoError(t, env.wr.RebuildLookupVindex(context.Background(), "sourceks", "v", specs, "", "", time.Millisecond, 2))
+
+	assert.EqualError(t, env.wr.RebuildLookupVindex(context.Background(), "sourceks", "v2", specs, "", "", time.Millisecond, 2),
+		"the new vindex must be named differently from the vindex v2 that it rebuilds")
+}
+
+func TestReplaceLookupVindex(t *testing.T) {
+	vschema := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"v":    {Type: "lookup_unique"},
+			"v2":   {Type: "lookup_unique", Params: map[string]string{"write_only": "true"}},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash", Column: "c2"},
+					{Name: "v", Column: "c1"},
+					{Name: "hash", Column: "c4"},
+					{Name: "v2", Column: "c3"},
+				},
+			},
+			"t2": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash", Column: "c2"},
+					{Name: "v", Column: "c1"},
+				},
+			},
+		},
+	}
+	require.NoError(t, replaceLookupVindex(vschema, "t1", "v", "v2"))
+	assert.Equal(t, []*vschemapb.ColumnVindex{
+		{Name: "hash", Column: "c2"},
+		{Name: "v2", Column: "c3"},
+		{Name: "hash", Column: "c4"},
+	}, vschema.Tables["t1"].ColumnVindexes)
+	assert.Empty(t, vschema.Vindexes["v2"].Params)
+	// The table t2 still uses the old vindex.
+	assert.Contains(t, vschema.Vindexes, "v")
+
+	err := replaceLookupVindex(vschema, "t1", "v", "v2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the table t1 must use both the vindexes v and v2")
+}
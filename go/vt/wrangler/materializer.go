@@ -201,6 +201,12 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		}
 	}
 	if externalTopo == nil {
+		// The sequences and owned lookup vindexes of the moved tables follow them.
+		var updatedVSchemas map[string]*vschemapb.Keyspace
+		tables, updatedVSchemas, err = wr.prepareMoveTablesVSchemas(ctx, sourceKeyspace, targetKeyspace, vschema, tables)
+		if err != nil {
+			return err
+		}
 		// Save routing rules before vschema. If we save vschema first, and routing rules
 		// fails to save, we may generate duplicate table errors.
 		rules, err := topotools.GetRoutingRules(ctx, wr.ts)
@@ -228,6 +234,11 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 				return err
 			}
 		}
+		for _, keyspace := range sortedKeyspaces(updatedVSchemas) {
+			if err := wr.ts.SaveVSchema(ctx, keyspace, updatedVSchemas[keyspace]); err != nil {
+				return err
+			}
+		}
 	}
 	if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
 		return err
@@ -714,6 +725,15 @@ func generateColDef(lines []string, sourceVindexCol, vindexFromCol string) (stri
 
 // ExternalizeVindex externalizes a lookup vindex that's finished backfilling or has caught up.
 func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+	return wr.externalizeVindex(ctx, qualifiedVindexName, func(sourceVSchema *vschemapb.Keyspace, vindexName string) error {
+		delete(sourceVSchema.Vindexes[vindexName].Params, "write_only")
+		return nil
+	})
+}
+
+// externalizeVindex checks and cleans up the backfill workflow of a lookup
+// vindex, then applies update to the source vschema and saves it.
+func (wr *Wrangler) externalizeVindex(ctx context.Context, qualifiedVindexName string, update func(sourceVSchema *vschemapb.Keyspace, vindexName string) error) error {
 	splits := strings.Split(qualifiedVindexName, ".")
 	if len(splits) != 2 {
 		return fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
@@ -820,8 +840,10 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 		}
 	}
 
-	// Remove the write_only param and save the source vschema.
-	delete(sourceVindex.Params, "write_only")
+	// Update and save the source vschema.
+	if err := update(sourceVSchema, vindexName); err != nil {
+		return err
+	}
 	if err := wr.ts.SaveVSchema(ctx, sourceKeyspace, sourceVSchema); err != nil {
 		return err
 	}
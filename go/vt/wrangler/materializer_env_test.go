@@ -25,6 +25,8 @@ import (
 	"sync"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
@@ -165,6 +167,7 @@ type testMaterializerTMClient struct {
 	vrQueries       map[int][]*queryResult
 	getSchemaCounts map[string]int
 	muSchemaCount   sync.Mutex
+	progress        map[int][]*tabletmanagerdatapb.VReplicationStreamProgress
 }
 
 func newTestMaterializerTMClient() *testMaterializerTMClient {
@@ -172,6 +175,7 @@ func newTestMaterializerTMClient() *testMaterializerTMClient {
 		schema:          make(map[string]*tabletmanagerdatapb.SchemaDefinition),
 		vrQueries:       make(map[int][]*queryResult),
 		getSchemaCounts: make(map[string]int),
+		progress:        make(map[int][]*tabletmanagerdatapb.VReplicationStreamProgress),
 	}
 }
 
@@ -255,6 +259,24 @@ func (tmc *testMaterializerTMClient) ExecuteFetchAsDba(ctx context.Context, tabl
 	return tmc.VReplicationExec(ctx, tablet, string(query))
 }
 
+func (tmc *testMaterializerTMClient) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
+	// Reuse VReplicationExec
+	return tmc.VReplicationExec(ctx, tablet, query)
+}
+
+func (tmc *testMaterializerTMClient) VReplicationProgress(ctx context.Context, tablet *topodatapb.Tablet, workflow string) ([]*tabletmanagerdatapb.VReplicationStreamProgress, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+
+	var streams []*tabletmanagerdatapb.VReplicationStreamProgress
+	for _, stream := range tmc.progress[int(tablet.Alias.Uid)] {
+		if stream.Workflow == workflow {
+			streams = append(streams, proto.Clone(stream).(*tabletmanagerdatapb.VReplicationStreamProgress))
+		}
+	}
+	return streams, nil
+}
+
 func (tmc *testMaterializerTMClient) verifyQueries(t *testing.T) {
 	t.Helper()
 
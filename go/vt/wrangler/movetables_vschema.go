@@ -0,0 +1,420 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// The vindex param that names the lookup table of the lookup vindexes.
+const lookupTableParam = "table"
+
+// prepareMoveTablesVSchemas prepares the vschemas for moving tables, so that
+// their sequences and owned lookup vindexes follow them without manual steps:
+//
+//   - the sequences of the moved tables and the lookup tables of the lookup
+//     vindexes they own are moved with them, if they are in the source
+//     keyspace. A sequence that can't be moved stays in the source keyspace.
+//   - the moved sequence tables are sequences in the target keyspace, which
+//     must then be unsharded unless they are pinned.
+//   - the references of all the keyspaces to the moved sequences and lookup
+//     tables are qualified with the source keyspace, since both keyspaces have
+//     them until the workflow completes. SwitchWrites points them to the target
+//     keyspace.
+//   - the moved tables get the auto increments of the source in the target
+//     keyspace, if they don't have one.
+//   - the lookup vindexes that the moved tables own are added to the target
+//     keyspace, if it is sharded, and to the column vindexes of their owners.
+//     Their owners must have the same primary vindex in both keyspaces, or the
+//     keyspace ids of the lookup tables would not match their rows.
+//
+// It updates the target vschema in place, and returns the tables to move and
+// the vschemas of the other keyspaces that it updated.
+func (wr *Wrangler) prepareMoveTablesVSchemas(ctx context.Context, sourceKeyspace, targetKeyspace string, targetVSchema *vschemapb.Keyspace, tables []string) ([]string, map[string]*vschemapb.Keyspace, error) {
+	vschemas, err := wr.getAllVSchemas(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	vschemas[targetKeyspace] = targetVSchema
+	sourceVSchema := vschemas[sourceKeyspace]
+	if sourceVSchema == nil {
+		return nil, nil, fmt.Errorf("no vschema found for source keyspace %s", sourceKeyspace)
+	}
+	if targetVSchema.Tables == nil {
+		targetVSchema.Tables = make(map[string]*vschemapb.Table)
+	}
+	moved := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		moved[table] = true
+	}
+
+	// The sequences and lookup tables that the moved tables use are moved
+	// with them.
+	var added []string
+	addTable := func(table string) {
+		if !moved[table] {
+			moved[table] = true
+			added = append(added, table)
+		}
+	}
+	for _, table := range tables {
+		autoIncrement := sourceVSchema.Tables[table].GetAutoIncrement()
+		if autoIncrement == nil {
+			continue
+		}
+		sequence, ok := sourceTableReference(sourceVSchema, sourceKeyspace, autoIncrement.Sequence)
+		if !ok || moved[sequence] {
+			continue
+		}
+		if targetVSchema.Sharded && sourceVSchema.Tables[sequence].Pinned == "" {
+			wr.Logger().Warningf("The sequence %s of table %s stays in keyspace %s, since it can't be moved to the sharded keyspace %s", sequence, table, sourceKeyspace, targetKeyspace)
+			continue
+		}
+		addTable(sequence)
+	}
+	for _, name := range sortedVindexNames(sourceVSchema) {
+		vindex := sourceVSchema.Vindexes[name]
+		if !moved[vindex.Owner] || !targetVSchema.Sharded {
+			continue
+		}
+		if !samePrimaryVindex(sourceVSchema, targetVSchema, vindex.Owner) {
+			return nil, nil, fmt.Errorf("the lookup vindex %s owned by table %s can't be moved, since the primary vindex of %s differs between keyspaces %s and %s: create it with CreateLookupVindex once the tables are moved instead", name, vindex.Owner, vindex.Owner, sourceKeyspace, targetKeyspace)
+		}
+		if lookupTable, ok := sourceTableReference(sourceVSchema, sourceKeyspace, vindex.Params[lookupTableParam]); ok {
+			addTable(lookupTable)
+		}
+	}
+	for _, table := range added {
+		if _, ok := targetVSchema.Tables[table]; ok {
+			continue
+		}
+		sourceTable := sourceVSchema.Tables[table]
+		if !targetVSchema.Sharded || sourceTable.GetType() == vindexes.TypeSequence {
+			targetVSchema.Tables[table] = &vschemapb.Table{}
+			continue
+		}
+		if len(sourceTable.GetColumnVindexes()) == 0 {
+			return nil, nil, fmt.Errorf("table %s has no vindex in keyspace %s to be moved to the sharded keyspace %s with the tables that use it", table, sourceKeyspace, targetKeyspace)
+		}
+		for _, colVindex := range sourceTable.ColumnVindexes {
+			if err := copyVindex(sourceVSchema, targetVSchema, colVindex.Name, targetKeyspace); err != nil {
+				return nil, nil, err
+			}
+		}
+		targetVSchema.Tables[table] = proto.Clone(sourceTable).(*vschemapb.Table)
+	}
+	if len(added) > 0 {
+		wr.Logger().Infof("Moving the sequences and lookup tables %s with the tables that use them", strings.Join(added, ","))
+		tables = append(tables, added...)
+	}
+
+	for _, table := range tables {
+		sourceTable := sourceVSchema.Tables[table]
+		if sourceTable.GetType() != vindexes.TypeSequence {
+			continue
+		}
+		if targetVSchema.Sharded && sourceTable.Pinned == "" {
+			return nil, nil, fmt.Errorf("sequence table %s can't be moved to the sharded keyspace %s", table, targetKeyspace)
+		}
+		targetTable, ok := targetVSchema.Tables[table]
+		if !ok {
+			targetTable = &vschemapb.Table{}
+			targetVSchema.Tables[table] = targetTable
+		}
+		targetTable.Type = vindexes.TypeSequence
+		targetTable.Pinned = sourceTable.Pinned
+	}
+
+	updated := make(map[string]*vschemapb.Keyspace)
+	for keyspace, vschema := range vschemas {
+		if qualifyMovedTableReferences(vschema, sourceVSchema, sourceKeyspace, moved) && keyspace != targetKeyspace {
+			updated[keyspace] = vschema
+		}
+	}
+
+	for _, table := range tables {
+		sourceTable, ok := sourceVSchema.Tables[table]
+		if !ok || sourceTable.AutoIncrement == nil {
+			continue
+		}
+		if targetTable, ok := targetVSchema.Tables[table]; ok && targetTable.AutoIncrement == nil {
+			targetTable.AutoIncrement = proto.Clone(sourceTable.AutoIncrement).(*vschemapb.AutoIncrement)
+		}
+	}
+
+	for _, name := range sortedVindexNames(sourceVSchema) {
+		vindex := sourceVSchema.Vindexes[name]
+		if !moved[vindex.Owner] {
+			continue
+		}
+		if !targetVSchema.Sharded {
+			wr.Logger().Warningf("The lookup vindex %s owned by table %s can't be maintained in the unsharded keyspace %s, it will not be updated once writes are switched", name, vindex.Owner, targetKeyspace)
+			continue
+		}
+		if targetVindex, ok := targetVSchema.Vindexes[name]; ok {
+			if !proto.Equal(targetVindex, vindex) {
+				return nil, nil, fmt.Errorf("vindex %s owned by table %s already exists in keyspace %s with a different definition", name, vindex.Owner, targetKeyspace)
+			}
+		} else {
+			if targetVSchema.Vindexes == nil {
+				targetVSchema.Vindexes = make(map[string]*vschemapb.Vindex)
+			}
+			targetVSchema.Vindexes[name] = proto.Clone(vindex).(*vschemapb.Vindex)
+		}
+		targetTable, ok := targetVSchema.Tables[vindex.Owner]
+		if !ok {
+			continue
+		}
+		for _, colVindex := range sourceVSchema.Tables[vindex.Owner].GetColumnVindexes() {
+			if colVindex.Name == name && !hasColumnVindex(targetTable, name) {
+				targetTable.ColumnVindexes = append(targetTable.ColumnVindexes, proto.Clone(colVindex).(*vschemapb.ColumnVindex))
+			}
+		}
+	}
+	return tables, updated, nil
+}
+
+// sourceTableReference returns the table of the source keyspace that a
+// sequence or lookup table reference names, if any.
+func sourceTableReference(sourceVSchema *vschemapb.Keyspace, sourceKeyspace, ref string) (string, bool) {
+	keyspace, table, err := sqlparser.ParseTable(ref)
+	if err != nil || table == "" || (keyspace != "" && keyspace != sourceKeyspace) {
+		return "", false
+	}
+	if _, ok := sourceVSchema.Tables[table]; !ok {
+		return "", false
+	}
+	return table, true
+}
+
+// samePrimaryVindex returns whether a table has the same primary vindex, on
+// the same columns, in the source and target vschemas.
+func samePrimaryVindex(sourceVSchema, targetVSchema *vschemapb.Keyspace, table string) bool {
+	sourceColVindexes := sourceVSchema.Tables[table].GetColumnVindexes()
+	targetColVindexes := targetVSchema.Tables[table].GetColumnVindexes()
+	if len(sourceColVindexes) == 0 || len(targetColVindexes) == 0 {
+		return false
+	}
+	source, target := sourceColVindexes[0], targetColVindexes[0]
+	if source.Column != target.Column || !reflect.DeepEqual(source.Columns, target.Columns) {
+		return false
+	}
+	sourceVindex, targetVindex := sourceVSchema.Vindexes[source.Name], targetVSchema.Vindexes[target.Name]
+	if sourceVindex == nil || targetVindex == nil {
+		return false
+	}
+	return sourceVindex.Type == targetVindex.Type && reflect.DeepEqual(sourceVindex.Params, targetVindex.Params)
+}
+
+// copyVindex adds a vindex of the source vschema to the target vschema, unless
+// the target already has the same definition.
+func copyVindex(sourceVSchema, targetVSchema *vschemapb.Keyspace, name, targetKeyspace string) error {
+	vindex, ok := sourceVSchema.Vindexes[name]
+	if !ok {
+		return fmt.Errorf("vindex %s not found in the source keyspace", name)
+	}
+	if targetVindex, ok := targetVSchema.Vindexes[name]; ok {
+		if !proto.Equal(targetVindex, vindex) {
+			return fmt.Errorf("vindex %s already exists in keyspace %s with a different definition", name, targetKeyspace)
+		}
+		return nil
+	}
+	if targetVSchema.Vindexes == nil {
+		targetVSchema.Vindexes = make(map[string]*vschemapb.Vindex)
+	}
+	targetVSchema.Vindexes[name] = proto.Clone(vindex).(*vschemapb.Vindex)
+	return nil
+}
+
+// qualifyMovedTableReferences qualifies with the source keyspace the
+// references of a vschema to the moved sequence and lookup tables that are
+// not qualified, and returns whether it updated the vschema.
+func qualifyMovedTableReferences(vschema, sourceVSchema *vschemapb.Keyspace, sourceKeyspace string, moved map[string]bool) bool {
+	qualify := func(ref string) (string, bool) {
+		keyspace, table, err := sqlparser.ParseTable(ref)
+		if err != nil || keyspace != "" || !moved[table] {
+			return ref, false
+		}
+		if _, ok := sourceVSchema.Tables[table]; !ok {
+			return ref, false
+		}
+		return sourceKeyspace + "." + table, true
+	}
+	return updateTableReferences(vschema, qualify)
+}
+
+// switchedTableReferences returns the vschemas whose references to the moved
+// sequence and lookup tables of the source keyspace have to point to the
+// target keyspace, updated, and the descriptions of the changes.
+func (ts *trafficSwitcher) switchedTableReferences(ctx context.Context) (map[string]*vschemapb.Keyspace, []string, error) {
+	if ts.externalCluster != "" {
+		return nil, nil, nil
+	}
+	vschemas, err := ts.wr.getAllVSchemas(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	moved := make(map[string]bool, len(ts.Tables()))
+	for _, table := range ts.Tables() {
+		moved[table] = true
+	}
+	var changes []string
+	updated := make(map[string]*vschemapb.Keyspace)
+	for _, keyspace := range sortedKeyspaces(vschemas) {
+		vschema := vschemas[keyspace]
+		switchRef := func(ref string) (string, bool) {
+			refKeyspace, table, err := sqlparser.ParseTable(ref)
+			if err != nil || refKeyspace != ts.SourceKeyspaceName() || !moved[table] {
+				return ref, false
+			}
+			switched := ts.TargetKeyspaceName() + "." + table
+			changes = append(changes, fmt.Sprintf("Keyspace %s: %s will refer to %s", keyspace, ref, switched))
+			return switched, true
+		}
+		if updateTableReferences(vschema, switchRef) {
+			updated[keyspace] = vschema
+		}
+	}
+	sort.Strings(changes)
+	return updated, changes, nil
+}
+
+// switchTableReferences points the references of all the keyspaces to the
+// moved sequence and lookup tables to the target keyspace.
+func (ts *trafficSwitcher) switchTableReferences(ctx context.Context) error {
+	updated, changes, err := ts.switchedTableReferences(ctx)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		ts.Logger().Infof("%s", change)
+	}
+	for _, keyspace := range sortedKeyspaces(updated) {
+		if err := ts.TopoServer().SaveVSchema(ctx, keyspace, updated[keyspace]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateTableReferences updates the sequences of the auto increments and the
+// lookup tables of the vindexes of a vschema, and returns whether it updated
+// any.
+func updateTableReferences(vschema *vschemapb.Keyspace, update func(ref string) (string, bool)) bool {
+	changed := false
+	for _, table := range vschema.Tables {
+		if table.AutoIncrement == nil {
+			continue
+		}
+		if ref, ok := update(table.AutoIncrement.Sequence); ok {
+			table.AutoIncrement.Sequence = ref
+			changed = true
+		}
+	}
+	for _, vindex := range vschema.Vindexes {
+		lookupTable, ok := vindex.Params[lookupTableParam]
+		if !ok {
+			continue
+		}
+		if ref, ok := update(lookupTable); ok {
+			vindex.Params[lookupTableParam] = ref
+			changed = true
+		}
+	}
+	return changed
+}
+
+// dropOrphanedOwnedVindexes drops the vindexes owned by tables that are no
+// longer in the vschema, unless other tables still use them.
+func dropOrphanedOwnedVindexes(vschema *vschemapb.Keyspace) {
+	for name, vindex := range vschema.Vindexes {
+		if vindex.Owner == "" {
+			continue
+		}
+		if _, ok := vschema.Tables[vindex.Owner]; ok {
+			continue
+		}
+		used := false
+		for _, table := range vschema.Tables {
+			if hasColumnVindex(table, name) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			delete(vschema.Vindexes, name)
+		}
+	}
+}
+
+func (wr *Wrangler) getAllVSchemas(ctx context.Context) (map[string]*vschemapb.Keyspace, error) {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vschemas := make(map[string]*vschemapb.Keyspace, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+		if topo.IsErrType(err, topo.NoNode) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vschemas[keyspace] = vschema
+	}
+	return vschemas, nil
+}
+
+func hasColumnVindex(table *vschemapb.Table, name string) bool {
+	for _, colVindex := range table.GetColumnVindexes() {
+		if colVindex.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedVindexNames(vschema *vschemapb.Keyspace) []string {
+	names := make([]string, 0, len(vschema.Vindexes))
+	for name := range vschema.Vindexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeyspaces(vschemas map[string]*vschemapb.Keyspace) []string {
+	keyspaces := make([]string, 0, len(vschemas))
+	for keyspace := range vschemas {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	return keyspaces
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func newMoveTablesVSchemaEnv(t *testing.T, targetSharded bool) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	targets := []string{"0"}
+	if targetSharded {
+		targets = []string{"-80", "80-"}
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, targets)
+	ctx := context.Background()
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "sourceks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"t1_lkp": {
+				Type:   "consistent_lookup_unique",
+				Params: map[string]string{"table": "t1_lkp", "from": "c1", "to": "keyspace_id"},
+				Owner:  "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"seq": {Type: vindexes.TypeSequence, Pinned: "80"},
+			"t1_lkp": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}},
+			},
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}, {Column: "c1", Name: "t1_lkp"}},
+				AutoIncrement:  &vschemapb.AutoIncrement{Column: "id", Sequence: "seq"},
+			},
+		},
+	}))
+	return env
+}
+
+func TestPrepareMoveTablesVSchemas(t *testing.T) {
+	env := newMoveTablesVSchemaEnv(t, true)
+	defer env.close()
+	ctx := context.Background()
+
+	newTargetVSchema := func() *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded:  true,
+			Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+			Tables: map[string]*vschemapb.Table{
+				"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			},
+		}
+	}
+	// The pinned sequence and the lookup table move with the table.
+	targetVSchema := newTargetVSchema()
+	tables, updated, err := env.wr.prepareMoveTablesVSchemas(ctx, "sourceks", "targetks", targetVSchema, []string{"t1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "seq", "t1_lkp"}, tables)
+
+	require.Contains(t, updated, "sourceks")
+	sourceVSchema := updated["sourceks"]
+	assert.Equal(t, "sourceks.t1_lkp", sourceVSchema.Vindexes["t1_lkp"].Params["table"])
+	assert.Equal(t, "sourceks.seq", sourceVSchema.Tables["t1"].AutoIncrement.Sequence)
+
+	assert.Equal(t, "sourceks.seq", targetVSchema.Tables["t1"].AutoIncrement.Sequence)
+	assert.Equal(t, vindexes.TypeSequence, targetVSchema.Tables["seq"].Type)
+	assert.Equal(t, "80", targetVSchema.Tables["seq"].Pinned)
+	require.Contains(t, targetVSchema.Vindexes, "t1_lkp")
+	assert.Equal(t, "sourceks.t1_lkp", targetVSchema.Vindexes["t1_lkp"].Params["table"])
+	assert.Equal(t, []string{"hash", "t1_lkp"}, columnVindexNames(targetVSchema.Tables["t1"]))
+	assert.Equal(t, []string{"hash"}, columnVindexNames(targetVSchema.Tables["t1_lkp"]))
+
+	// The keyspace ids of the lookup table only match with the same primary vindex.
+	targetVSchema = newTargetVSchema()
+	targetVSchema.Vindexes["hash"] = &vschemapb.Vindex{Type: "xxhash"}
+	_, _, err = env.wr.prepareMoveTablesVSchemas(ctx, "sourceks", "targetks", targetVSchema, []string{"t1"})
+	assert.EqualError(t, err, "the lookup vindex t1_lkp owned by table t1 can't be moved, since the primary vindex of t1 differs between keyspaces sourceks and targetks: create it with CreateLookupVindex once the tables are moved instead")
+
+	// An owned lookup vindex can't replace another definition in the target.
+	targetVSchema = newTargetVSchema()
+	targetVSchema.Vindexes["t1_lkp"] = &vschemapb.Vindex{Type: "lookup_unique"}
+	_, _, err = env.wr.prepareMoveTablesVSchemas(ctx, "sourceks", "targetks", targetVSchema, []string{"t1"})
+	assert.EqualError(t, err, "vindex t1_lkp owned by table t1 already exists in keyspace targetks with a different definition")
+}
+
+func TestPrepareMoveTablesVSchemasSequence(t *testing.T) {
+	env := newMoveTablesVSchemaEnv(t, false)
+	defer env.close()
+	ctx := context.Background()
+
+	targetVSchema := &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{"seq": {}},
+	}
+	_, updated, err := env.wr.prepareMoveTablesVSchemas(ctx, "sourceks", "targetks", targetVSchema, []string{"seq"})
+	require.NoError(t, err)
+	assert.Equal(t, vindexes.TypeSequence, targetVSchema.Tables["seq"].Type)
+	require.Contains(t, updated, "sourceks")
+	assert.Equal(t, "sourceks.seq", updated["sourceks"].Tables["t1"].AutoIncrement.Sequence)
+}
+
+func TestSwitchTableReferences(t *testing.T) {
+	env := newMoveTablesVSchemaEnv(t, false)
+	defer env.close()
+	ctx := context.Background()
+
+	sourceVSchema, err := env.topoServ.GetVSchema(ctx, "sourceks")
+	require.NoError(t, err)
+	sourceVSchema.Tables["t1"].AutoIncrement.Sequence = "sourceks.seq"
+	sourceVSchema.Vindexes["t1_lkp"].Params["table"] = "sourceks.t1_lkp"
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "sourceks", sourceVSchema))
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "targetks", &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"seq": {Type: vindexes.TypeSequence},
+		},
+	}))
+
+	ts := &trafficSwitcher{
+		wr:             env.wr,
+		sourceKSSchema: &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "sourceks"}},
+		targetKeyspace: "targetks",
+		tables:         []string{"seq"},
+	}
+	_, changes, err := ts.switchedTableReferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Keyspace sourceks: sourceks.seq will refer to targetks.seq"}, changes)
+
+	require.NoError(t, ts.switchTableReferences(ctx))
+	sourceVSchema, err = env.topoServ.GetVSchema(ctx, "sourceks")
+	require.NoError(t, err)
+	assert.Equal(t, "targetks.seq", sourceVSchema.Tables["t1"].AutoIncrement.Sequence)
+	assert.Equal(t, "sourceks.t1_lkp", sourceVSchema.Vindexes["t1_lkp"].Params["table"])
+
+	// Workflows from external clusters don't touch the vschemas.
+	ts.externalCluster = "ext"
+	_, changes, err = ts.switchedTableReferences(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDropOrphanedOwnedVindexes(t *testing.T) {
+	vschema := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":   {Type: "hash"},
+			"t1_lkp": {Type: "consistent_lookup_unique", Owner: "t1"},
+			"t2_lkp": {Type: "consistent_lookup_unique", Owner: "t2"},
+			"t3_lkp": {Type: "consistent_lookup_unique", Owner: "t3"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "t2_lkp"}}},
+			"t4": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "t3_lkp"}}},
+		},
+	}
+	dropOrphanedOwnedVindexes(vschema)
+	assert.Equal(t, []string{"hash", "t2_lkp", "t3_lkp"}, sortedVindexNames(vschema))
+}
+
+func columnVindexNames(table *vschemapb.Table) []string {
+	var names []string
+	for _, colVindex := range table.ColumnVindexes {
+		names = append(names, colVindex.Name)
+	}
+	return names
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// UpgradeShardMysqlOptions are the options of UpgradeShardMysql.
+type UpgradeShardMysqlOptions struct {
+	// IncludePrimary also upgrades the primary, after a planned reparent
+	// to an upgraded replica.
+	IncludePrimary bool
+	// SkipMysqlUpgrade doesn't run mysql_upgrade after the new mysqld starts.
+	SkipMysqlUpgrade bool
+	// SwapBinariesParams are passed to the swap_mysqld_binaries hook of the
+	// tablets.
+	SwapBinariesParams []string
+	// HealthyTimeout is how long an upgraded tablet has to replicate again,
+	// within MaxReplicationLag.
+	HealthyTimeout    time.Duration
+	MaxReplicationLag time.Duration
+	// WaitReplicasTimeout is passed to the planned reparent.
+	WaitReplicasTimeout time.Duration
+}
+
+// UpgradeShardMysql upgrades the mysqld of the replica and rdonly tablets of
+// the shard in place, one tablet at a time: each tablet is drained, upgraded
+// by its UpgradeMysqld RPC, and only goes back to serving once it replicates
+// again. It stops at the first tablet that fails, and leaves it
+// DRAINED for an operator to look at.
+func (wr *Wrangler) UpgradeShardMysql(ctx context.Context, keyspace, shard string, options UpgradeShardMysqlOptions) error {
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	var primary *topodatapb.Tablet
+	var tablets []*topodatapb.Tablet
+	for _, ti := range tabletMap {
+		switch ti.Type {
+		case topodatapb.TabletType_PRIMARY:
+			primary = ti.Tablet
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+			tablets = append(tablets, ti.Tablet)
+		}
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		return topoproto.TabletAliasString(tablets[i].Alias) < topoproto.TabletAliasString(tablets[j].Alias)
+	})
+
+	for _, tablet := range tablets {
+		if err := wr.upgradeTabletMysql(ctx, tablet, tablet.Type, options); err != nil {
+			return err
+		}
+	}
+	if !options.IncludePrimary || primary == nil {
+		return nil
+	}
+
+	wr.Logger().Infof("moving the primary of %v/%v away from %v", keyspace, shard, topoproto.TabletAliasString(primary.Alias))
+	if err := wr.PlannedReparentShard(ctx, keyspace, shard, nil, primary.Alias, options.WaitReplicasTimeout); err != nil {
+		return fmt.Errorf("cannot reparent away from %v before upgrading it: %v", topoproto.TabletAliasString(primary.Alias), err)
+	}
+	ti, err := wr.ts.GetTablet(ctx, primary.Alias)
+	if err != nil {
+		return err
+	}
+	return wr.upgradeTabletMysql(ctx, ti.Tablet, ti.Type, options)
+}
+
+// upgradeTabletMysql drains the tablet, upgrades its mysqld, and puts it
+// back to tabletType once it is healthy. Only the type changes hold the shard
+// lock, so that the upgrade itself, which can take a while, doesn't block the
+// reparents and the other changes of the shard meanwhile.
+func (wr *Wrangler) upgradeTabletMysql(ctx context.Context, tablet *topodatapb.Tablet, tabletType topodatapb.TabletType, options UpgradeShardMysqlOptions) error {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	wr.Logger().Infof("upgrading the mysqld of %v", alias)
+	if err := wr.changeTabletTypeLocked(ctx, tablet, tabletType, topodatapb.TabletType_DRAINED); err != nil {
+		return fmt.Errorf("cannot drain %v: %v", alias, err)
+	}
+	response, err := wr.tmc.UpgradeMysqld(ctx, tablet, options.SwapBinariesParams, options.SkipMysqlUpgrade)
+	if err != nil {
+		return fmt.Errorf("cannot upgrade %v, which is left DRAINED: %v", alias, err)
+	}
+	for _, step := range response.Steps {
+		wr.Logger().Printf("%v: %v\n", alias, step)
+	}
+	if err := wr.waitForHealthyReplication(ctx, tablet, options); err != nil {
+		return fmt.Errorf("%v, which is left DRAINED, is not healthy after its upgrade: %v", alias, err)
+	}
+	if err := wr.changeTabletTypeLocked(ctx, tablet, topodatapb.TabletType_DRAINED, tabletType); err != nil {
+		return fmt.Errorf("cannot put the upgraded %v back to %v: %v", alias, tabletType, err)
+	}
+	wr.Logger().Infof("upgraded the mysqld of %v from %v to %v", alias, response.OldVersion, response.NewVersion)
+	return nil
+}
+
+// changeTabletTypeLocked changes the type of the tablet from fromType to
+// toType while it holds the shard lock, and fails if the tablet isn't of
+// fromType anymore.
+func (wr *Wrangler) changeTabletTypeLocked(ctx context.Context, tablet *topodatapb.Tablet, fromType, toType topodatapb.TabletType) (err error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	ctx, unlock, lockErr := wr.ts.LockShard(ctx, tablet.Keyspace, tablet.Shard, fmt.Sprintf("UpgradeShardMysql(%v)", alias))
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	ti, err := wr.ts.GetTablet(ctx, tablet.Alias)
+	if err != nil {
+		return err
+	}
+	if ti.Type != fromType {
+		return fmt.Errorf("tablet %v is %v instead of %v", alias, ti.Type, fromType)
+	}
+	return wr.ChangeTabletType(ctx, tablet.Alias, toType)
+}
+
+// waitForHealthyReplication waits until the tablet replicates, with a lag
+// of at most MaxReplicationLag, or until HealthyTimeout.
+func (wr *Wrangler) waitForHealthyReplication(ctx context.Context, tablet *topodatapb.Tablet, options UpgradeShardMysqlOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, options.HealthyTimeout)
+	defer cancel()
+	var lastErr error
+	for {
+		status, err := wr.tmc.ReplicationStatus(ctx, tablet)
+		var replicationStatus mysql.ReplicationStatus
+		if err == nil {
+			replicationStatus = mysql.ProtoToReplicationStatus(status)
+		}
+		switch {
+		case err != nil:
+			lastErr = err
+		case !replicationStatus.Running():
+			lastErr = fmt.Errorf("replication is not running: %v%v", status.LastIoError, status.LastSqlError)
+		case time.Duration(status.ReplicationLagSeconds)*time.Second > options.MaxReplicationLag:
+			lastErr = fmt.Errorf("replication lag is %vs", status.ReplicationLagSeconds)
+		default:
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-time.After(time.Second):
+		}
+	}
+}
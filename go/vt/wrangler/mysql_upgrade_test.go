@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// upgradeTMClient records the calls of UpgradeShardMysql, and fails the
+// upgrade of the tablets in failures.
+type upgradeTMClient struct {
+	tmclient.TabletManagerClient
+	ts       *topo.Server
+	calls    []string
+	failures map[string]bool
+}
+
+func (c *upgradeTMClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, tabletType topodatapb.TabletType, semiSync bool) error {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	c.calls = append(c.calls, fmt.Sprintf("%v %v", alias, tabletType))
+	_, err := c.ts.UpdateTabletFields(ctx, tablet.Alias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = tabletType
+		return nil
+	})
+	return err
+}
+
+func (c *upgradeTMClient) UpgradeMysqld(ctx context.Context, tablet *topodatapb.Tablet, swapBinariesParams []string, skipMysqlUpgrade bool) (*tabletmanagerdatapb.UpgradeMysqldResponse, error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	c.calls = append(c.calls, fmt.Sprintf("%v UpgradeMysqld %v %v", alias, swapBinariesParams, skipMysqlUpgrade))
+	// The shard isn't locked during the upgrade.
+	_, unlock, err := c.ts.LockShard(ctx, tablet.Keyspace, tablet.Shard, "test")
+	if err != nil {
+		return nil, err
+	}
+	unlock(&err)
+	if err != nil {
+		return nil, err
+	}
+	if c.failures[alias] {
+		return nil, fmt.Errorf("cannot start mysqld")
+	}
+	return &tabletmanagerdatapb.UpgradeMysqldResponse{OldVersion: "8.0.28", NewVersion: "8.0.29"}, nil
+}
+
+func (c *upgradeTMClient) ReplicationStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return &replicationdatapb.Status{IoState: int32(mysql.ReplicationStateRunning), SqlState: int32(mysql.ReplicationStateRunning)}, nil
+}
+
+func TestUpgradeShardMysql(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, reparentutil.SetDurabilityPolicy("none"))
+	ts := memorytopo.NewServer("cell1")
+	for uid, tabletType := range map[uint32]topodatapb.TabletType{
+		1: topodatapb.TabletType_PRIMARY,
+		2: topodatapb.TabletType_REPLICA,
+		3: topodatapb.TabletType_RDONLY,
+		4: topodatapb.TabletType_BACKUP,
+	} {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     tabletType,
+		}
+		require.NoError(t, ts.InitTablet(ctx, tablet, true, true, false))
+	}
+	_, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}
+		return nil
+	})
+	require.NoError(t, err)
+	tmc := &upgradeTMClient{ts: ts, failures: map[string]bool{}}
+	wr := New(logutil.NewMemoryLogger(), ts, tmc)
+	options := UpgradeShardMysqlOptions{
+		SkipMysqlUpgrade:   true,
+		SwapBinariesParams: []string{"--version=8.0.29"},
+		HealthyTimeout:     time.Second,
+		MaxReplicationLag:  time.Second,
+	}
+
+	require.NoError(t, wr.UpgradeShardMysql(ctx, "ks", "0", options))
+	assert.Equal(t, []string{
+		"cell1-0000000002 DRAINED",
+		"cell1-0000000002 UpgradeMysqld [--version=8.0.29] true",
+		"cell1-0000000002 REPLICA",
+		"cell1-0000000003 DRAINED",
+		"cell1-0000000003 UpgradeMysqld [--version=8.0.29] true",
+		"cell1-0000000003 RDONLY",
+	}, tmc.calls)
+
+	// The upgrade stops at the first failure, and leaves the tablet drained.
+	tmc.calls = nil
+	tmc.failures["cell1-0000000002"] = true
+	err = wr.UpgradeShardMysql(ctx, "ks", "0", options)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot upgrade cell1-0000000002, which is left DRAINED: cannot start mysqld")
+	assert.Equal(t, []string{
+		"cell1-0000000002 DRAINED",
+		"cell1-0000000002 UpgradeMysqld [--version=8.0.29] true",
+	}, tmc.calls)
+	ti, err := ts.GetTablet(ctx, &topodatapb.TabletAlias{Cell: "cell1", Uid: 2})
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.TabletType_DRAINED, ti.Type)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// ReshardPlan is a proposal of target shards for a Reshard workflow, whose
+// split points balance the rows of a table according to a sample of their
+// keyspace ids.
+type ReshardPlan struct {
+	Keyspace string
+	// Table is the sampled table, and Vindex its primary vindex.
+	Table, Vindex string
+	// EstimatedRows are the rows of the table on the source shards, from
+	// their table statistics, and SampledRows the rows whose keyspace ids
+	// were sampled.
+	EstimatedRows, SampledRows int64
+	SourceShards               []string
+	TargetShards               []*ReshardPlanShard
+}
+
+// ReshardPlanShard is a proposed target shard.
+type ReshardPlanShard struct {
+	Shard string
+	// SampledRows are the sampled rows that fall in the shard, and
+	// EstimatedRows the rows of the table that it would get.
+	SampledRows, EstimatedRows int64
+	// Share is the share of the rows of the table that the shard would get.
+	Share float64
+}
+
+// TargetShardNames returns the names of the proposed target shards.
+func (plan *ReshardPlan) TargetShardNames() []string {
+	shards := make([]string, 0, len(plan.TargetShards))
+	for _, target := range plan.TargetShards {
+		shards = append(shards, target.Shard)
+	}
+	return shards
+}
+
+// PlanReshard proposes numShards target shards for resharding the source
+// shards of a keyspace, all its serving shards if none are given. Rather than
+// splitting the key range evenly, it samples the keyspace ids of a table on
+// the source primaries, and picks the split points that give each target
+// shard the same share of its rows. The split points are rounded to
+// boundaryBytes bytes, so that the shard names stay short.
+//
+// The sampled table must have a primary vindex that doesn't need lookups. If
+// no table is given, the largest such table of the source shards is sampled.
+func (wr *Wrangler) PlanReshard(ctx context.Context, keyspace string, sourceShards []string, numShards int, table string, sampleSize, boundaryBytes int) (*ReshardPlan, error) {
+	if numShards < 1 {
+		return nil, fmt.Errorf("the number of target shards must be positive")
+	}
+	if sampleSize < 1 {
+		return nil, fmt.Errorf("the sample size must be positive")
+	}
+	if boundaryBytes < 1 {
+		return nil, fmt.Errorf("the number of bytes of the split points must be positive")
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if !vschema.Sharded {
+		return nil, fmt.Errorf("keyspace %s is not sharded", keyspace)
+	}
+	sources, err := wr.reshardPlanSources(ctx, keyspace, sourceShards)
+	if err != nil {
+		return nil, err
+	}
+	keyRange, err := sourcesKeyRange(sources)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string]*vschemapb.ColumnVindex)
+	for name, vtab := range vschema.Tables {
+		if vtab.Type != "" || len(vtab.ColumnVindexes) == 0 {
+			continue
+		}
+		if table != "" && name != table {
+			continue
+		}
+		candidates[name] = vtab.ColumnVindexes[0]
+	}
+	if table != "" && candidates[table] == nil {
+		return nil, fmt.Errorf("table %s has no primary vindex in the vschema of keyspace %s", table, keyspace)
+	}
+	tables := make([]string, 0, len(candidates))
+	for name := range candidates {
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+	rows := make(map[string]int64)
+	for _, source := range sources {
+		shardRows, _, err := wr.sourceTableRows(ctx, keyspace, source.ShardName(), tables)
+		if err != nil {
+			return nil, err
+		}
+		for name, count := range shardRows {
+			rows[name] += count
+		}
+	}
+	if table == "" {
+		for _, name := range tables {
+			if rows[name] > rows[table] {
+				table = name
+			}
+		}
+		if table == "" {
+			return nil, fmt.Errorf("no table with rows and a primary vindex found in keyspace %s, choose the table to sample", keyspace)
+		}
+	}
+	colVindex := candidates[table]
+	vindexDef := vschema.Vindexes[colVindex.Name]
+	if vindexDef == nil {
+		return nil, fmt.Errorf("vindex %s of table %s not found in the vschema of keyspace %s", colVindex.Name, table, keyspace)
+	}
+	vindex, err := vindexes.CreateVindex(vindexDef.Type, colVindex.Name, vindexDef.Params)
+	if err != nil {
+		return nil, err
+	}
+	if vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("primary vindex %s of table %s needs lookups, choose a table whose keyspace ids can be computed from its columns", colVindex.Name, table)
+	}
+	columns := colVindex.Columns
+	if len(columns) == 0 {
+		columns = []string{colVindex.Column}
+	}
+
+	plan := &ReshardPlan{
+		Keyspace:      keyspace,
+		Table:         table,
+		Vindex:        colVindex.Name,
+		EstimatedRows: rows[table],
+	}
+	// The same fraction of the rows of each source is sampled, so that the
+	// sample keeps the distribution of the keyspace ids across the sources.
+	fraction := 1.0
+	if plan.EstimatedRows > int64(sampleSize) {
+		fraction = float64(sampleSize) / float64(plan.EstimatedRows)
+	}
+	var ksids [][]byte
+	for _, source := range sources {
+		plan.SourceShards = append(plan.SourceShards, source.ShardName())
+		sampled, err := wr.sampleKeyspaceIDs(ctx, source, table, columns, vindex, fraction, sampleSize)
+		if err != nil {
+			return nil, err
+		}
+		ksids = append(ksids, sampled...)
+	}
+	plan.SampledRows = int64(len(ksids))
+	if len(ksids) < numShards {
+		return nil, fmt.Errorf("only %d keyspace ids of table %s were sampled, not enough to plan %d shards", len(ksids), table, numShards)
+	}
+	sort.Slice(ksids, func(i, j int) bool {
+		return bytes.Compare(ksids[i], ksids[j]) < 0
+	})
+	keyRanges, err := splitKeyRange(keyRange, ksids, numShards, boundaryBytes)
+	if err != nil {
+		return nil, err
+	}
+	i := 0
+	for _, kr := range keyRanges {
+		target := &ReshardPlanShard{Shard: key.KeyRangeString(kr)}
+		for ; i < len(ksids) && key.KeyRangeContains(kr, ksids[i]); i++ {
+			target.SampledRows++
+		}
+		target.Share = float64(target.SampledRows) / float64(len(ksids))
+		target.EstimatedRows = int64(target.Share * float64(plan.EstimatedRows))
+		plan.TargetShards = append(plan.TargetShards, target)
+	}
+	return plan, nil
+}
+
+// reshardPlanSources returns the source shards of a plan, sorted by key
+// range.
+func (wr *Wrangler) reshardPlanSources(ctx context.Context, keyspace string, shards []string) ([]*topo.ShardInfo, error) {
+	var sources []*topo.ShardInfo
+	if len(shards) == 0 {
+		var err error
+		if sources, err = wr.ts.GetServingShards(ctx, keyspace); err != nil {
+			return nil, err
+		}
+	}
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, si)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return key.KeyRangeStartSmaller(sources[i].KeyRange, sources[j].KeyRange)
+	})
+	return sources, nil
+}
+
+// sourcesKeyRange returns the key range that the source shards cover, which
+// must be contiguous.
+func sourcesKeyRange(sources []*topo.ShardInfo) (*topodatapb.KeyRange, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no source shards")
+	}
+	keyRange := sources[0].KeyRange
+	for _, source := range sources[1:] {
+		union, ok := key.KeyRangeAdd(keyRange, source.KeyRange)
+		if !ok {
+			return nil, fmt.Errorf("source shards don't cover a contiguous key range: %s doesn't follow %s", source.ShardName(), key.KeyRangeString(keyRange))
+		}
+		keyRange = union
+	}
+	if keyRange == nil {
+		keyRange = &topodatapb.KeyRange{}
+	}
+	return keyRange, nil
+}
+
+// sampleKeyspaceIDs returns the keyspace ids of a random fraction of the rows
+// of a table on the primary of a source shard, at most limit of them. The
+// rows that don't belong to the key range of the shard, like those left over
+// by an earlier resharding, are skipped.
+func (wr *Wrangler) sampleKeyspaceIDs(ctx context.Context, source *topo.ShardInfo, table string, columns []string, vindex vindexes.Vindex, fraction float64, limit int) ([][]byte, error) {
+	if source.PrimaryAlias == nil {
+		return nil, fmt.Errorf("no primary found for shard %s/%s", source.Keyspace(), source.ShardName())
+	}
+	primary, err := wr.ts.GetTablet(ctx, source.PrimaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	escaped := make([]string, 0, len(columns))
+	for _, column := range columns {
+		escaped = append(escaped, sqlescape.EscapeID(column))
+	}
+	query := fmt.Sprintf("select %s from %s.%s", strings.Join(escaped, ", "), sqlescape.EscapeID(primary.DbName()), sqlescape.EscapeID(table))
+	if fraction < 1 {
+		query += fmt.Sprintf(" where rand() < %v", fraction)
+	}
+	query += fmt.Sprintf(" limit %d", limit)
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, primary.Tablet, true, []byte(query), limit, false, false)
+	if err != nil {
+		return nil, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	destinations, err := vindexes.Map(vindex, nil, qr.Rows)
+	if err != nil {
+		return nil, err
+	}
+	ksids := make([][]byte, 0, len(destinations))
+	for _, destination := range destinations {
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok || !key.KeyRangeContains(source.KeyRange, ksid) {
+			continue
+		}
+		ksids = append(ksids, ksid)
+	}
+	return ksids, nil
+}
+
+// splitKeyRange splits a key range into numShards key ranges that get the
+// same number of the sorted keyspace ids, with split points of at most
+// boundaryBytes bytes.
+func splitKeyRange(keyRange *topodatapb.KeyRange, ksids [][]byte, numShards, boundaryBytes int) ([]*topodatapb.KeyRange, error) {
+	var keyRanges []*topodatapb.KeyRange
+	start := trimKeyRangeBound(keyRange.Start)
+	end := trimKeyRangeBound(keyRange.End)
+	for i := 1; i < numShards; i++ {
+		split := ksids[i*len(ksids)/numShards]
+		if len(split) > boundaryBytes {
+			split = split[:boundaryBytes]
+		}
+		split = trimKeyRangeBound(split)
+		if bytes.Compare(split, start) <= 0 || (len(end) > 0 && bytes.Compare(split, end) >= 0) {
+			return nil, fmt.Errorf("can't split key range %s into %d shards: the keyspace ids of the sample are too concentrated around %x, use fewer shards or longer split points", key.KeyRangeString(keyRange), numShards, split)
+		}
+		keyRanges = append(keyRanges, &topodatapb.KeyRange{Start: start, End: split})
+		start = split
+	}
+	return append(keyRanges, &topodatapb.KeyRange{Start: start, End: end}), nil
+}
+
+// trimKeyRangeBound drops the trailing zero bytes of a key range bound, which
+// don't change the key range.
+func trimKeyRangeBound(bound []byte) []byte {
+	return bytes.TrimRight(bound, "\x00")
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const planTableRowsQuery = "select table_name, table_rows from information_schema.tables where table_schema='vt_ks' and table_name in ('t1', 't2')"
+
+func newReshardPlanEnv(t *testing.T) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"-80", "80-"}, nil)
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "ks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"numeric": {Type: "numeric"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "numeric"}}},
+			"t2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "numeric"}}},
+		},
+	}))
+	return env
+}
+
+// sampleResult returns the ids whose keyspace ids, with the numeric vindex,
+// start with the given bytes.
+func sampleResult(prefixes ...uint64) *sqltypes.Result {
+	var rows []string
+	for _, prefix := range prefixes {
+		rows = append(rows, fmt.Sprintf("%d", prefix<<56))
+	}
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "uint64"), rows...)
+}
+
+func TestPlanReshard(t *testing.T) {
+	env := newReshardPlanEnv(t)
+	defer env.close()
+
+	rows := sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name|table_rows", "varchar|int64"), "t1|100", "t2|10")
+	env.tmc.expectVRQuery(100, planTableRowsQuery, rows)
+	env.tmc.expectVRQuery(110, planTableRowsQuery, rows)
+	// Most rows are at the start of -80, and the row of 0x95 is left over in
+	// -80 from an earlier resharding.
+	env.tmc.expectVRQuery(100, "select `id` from `vt_ks`.`t1` where rand() < 0.5 limit 100", sampleResult(0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x95))
+	env.tmc.expectVRQuery(110, "select `id` from `vt_ks`.`t1` where rand() < 0.5 limit 100", sampleResult(0x90, 0xa0))
+
+	plan, err := env.wr.PlanReshard(context.Background(), "ks", nil, 4, "", 100, 1)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	assert.Equal(t, "t1", plan.Table)
+	assert.Equal(t, "numeric", plan.Vindex)
+	assert.EqualValues(t, 200, plan.EstimatedRows)
+	assert.EqualValues(t, 8, plan.SampledRows)
+	assert.Equal(t, []string{"-80", "80-"}, plan.SourceShards)
+	assert.Equal(t, []string{"-12", "12-14", "14-90", "90-"}, plan.TargetShardNames())
+	for _, target := range plan.TargetShards {
+		assert.EqualValues(t, 2, target.SampledRows, target.Shard)
+		assert.EqualValues(t, 50, target.EstimatedRows, target.Shard)
+		assert.Equal(t, 0.25, target.Share, target.Shard)
+	}
+}
+
+func TestPlanReshardErrors(t *testing.T) {
+	env := newReshardPlanEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	_, err := env.wr.PlanReshard(ctx, "ks", nil, 0, "", 100, 1)
+	assert.EqualError(t, err, "the number of target shards must be positive")
+	_, err = env.wr.PlanReshard(ctx, "ks", nil, 2, "t3", 100, 1)
+	assert.EqualError(t, err, "table t3 has no primary vindex in the vschema of keyspace ks")
+
+	// The table is small enough to be read whole, but has too few rows.
+	query := "select table_name, table_rows from information_schema.tables where table_schema='vt_ks' and table_name in ('t1')"
+	rows := sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name|table_rows", "varchar|int64"), "t1|1")
+	env.tmc.expectVRQuery(100, query, rows)
+	env.tmc.expectVRQuery(100, "select `id` from `vt_ks`.`t1` limit 100", sampleResult(0x10))
+	_, err = env.wr.PlanReshard(ctx, "ks", []string{"-80"}, 2, "t1", 100, 1)
+	assert.EqualError(t, err, "only 1 keyspace ids of table t1 were sampled, not enough to plan 2 shards")
+	env.tmc.verifyQueries(t)
+}
+
+func TestSplitKeyRange(t *testing.T) {
+	ksids := func(prefixes ...byte) [][]byte {
+		var ids [][]byte
+		for _, prefix := range prefixes {
+			ids = append(ids, []byte{prefix, 0x34, 0x56})
+		}
+		return ids
+	}
+	shardNames := func(keyRanges []*topodatapb.KeyRange) []string {
+		var names []string
+		for _, kr := range keyRanges {
+			names = append(names, key.KeyRangeString(kr))
+		}
+		return names
+	}
+
+	keyRanges, err := splitKeyRange(&topodatapb.KeyRange{}, ksids(0x10, 0x20, 0x30, 0x40), 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-30", "30-"}, shardNames(keyRanges))
+
+	keyRanges, err = splitKeyRange(&topodatapb.KeyRange{}, ksids(0x10, 0x20, 0x30, 0x40), 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-3034", "3034-"}, shardNames(keyRanges))
+
+	keyRanges, err = splitKeyRange(&topodatapb.KeyRange{Start: []byte{0x40}, End: []byte{0x80, 0x00}}, ksids(0x40, 0x50, 0x60, 0x70), 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"40-60", "60-80"}, shardNames(keyRanges))
+
+	// A single keyspace id prefix can't be split.
+	_, err = splitKeyRange(&topodatapb.KeyRange{}, ksids(0x10, 0x30, 0x30, 0x30), 2, 1)
+	require.NoError(t, err)
+	_, err = splitKeyRange(&topodatapb.KeyRange{}, ksids(0x10, 0x30, 0x30, 0x30), 3, 1)
+	assert.EqualError(t, err, "can't split key range - into 3 shards: the keyspace ids of the sample are too concentrated around 30, use fewer shards or longer split points")
+}
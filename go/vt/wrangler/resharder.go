@@ -37,6 +37,7 @@ import (
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -148,6 +149,11 @@ func (wr *Wrangler) buildResharder(ctx context.Context, keyspace, workflow strin
 	if err := rs.validateTargets(ctx); err != nil {
 		return nil, vterrors.Wrap(err, "validateTargets")
 	}
+	if rs.isMerge() {
+		if err := rs.validateSourcePositions(ctx); err != nil {
+			return nil, vterrors.Wrap(err, "validateSourcePositions")
+		}
+	}
 
 	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
 	if err != nil {
@@ -177,6 +183,66 @@ func (rs *resharder) validateTargets(ctx context.Context) error {
 	return err
 }
 
+// isMerge returns true if a target shard gets the rows of more than one
+// source shard, and so applies several source streams at once.
+func (rs *resharder) isMerge() bool {
+	for _, target := range rs.targetShards {
+		count := 0
+		for _, source := range rs.sourceShards {
+			if key.KeyRangesIntersect(target.KeyRange, source.KeyRange) {
+				count++
+			}
+		}
+		if count > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSourcePositions checks that the source shards of a merge can be
+// replicated together into a target: each one must have a GTID position,
+// and all of them the same GTID flavor, so that the positions that the
+// target records for its streams are journaled and compared the same way
+// when the traffic is switched.
+func (rs *resharder) validateSourcePositions(ctx context.Context) error {
+	var mu sync.Mutex
+	flavors := make(map[string]string)
+	err := rs.forAll(rs.sourceShards, func(source *topo.ShardInfo) error {
+		sourcePrimary := rs.sourcePrimaries[source.ShardName()]
+		pos, err := rs.wr.tmc.PrimaryPosition(ctx, sourcePrimary.Tablet)
+		if err != nil {
+			return vterrors.Wrapf(err, "PrimaryPosition(%v)", topoproto.TabletAliasString(sourcePrimary.Alias))
+		}
+		mpos, err := binlogplayer.DecodePosition(pos)
+		if err != nil {
+			return vterrors.Wrapf(err, "source shard %v", source.ShardName())
+		}
+		if mpos.IsZero() {
+			return fmt.Errorf("source shard %v has no GTID position", source.ShardName())
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		flavors[source.ShardName()] = mpos.GTIDSet.Flavor()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var first string
+	for _, source := range rs.sourceShards {
+		shard := source.ShardName()
+		if first == "" {
+			first = shard
+			continue
+		}
+		if flavors[shard] != flavors[first] {
+			return fmt.Errorf("the source shards of a merge must have the same GTID flavor: %v has %v, and %v has %v", first, flavors[first], shard, flavors[shard])
+		}
+	}
+	return nil
+}
+
 func (rs *resharder) readRefStreams(ctx context.Context) error {
 	var mu sync.Mutex
 	err := rs.forAll(rs.sourceShards, func(source *topo.ShardInfo) error {
@@ -290,7 +356,7 @@ func (rs *resharder) identifyRuleType(rule *binlogdatapb.Rule) (workflow.StreamT
 func (rs *resharder) copySchema(ctx context.Context) error {
 	oneSource := rs.sourceShards[0].PrimaryAlias
 	err := rs.forAll(rs.targetShards, func(target *topo.ShardInfo) error {
-		return rs.wr.CopySchemaShard(ctx, oneSource, []string{"/.*"}, nil, false, rs.keyspace, target.ShardName(), 1*time.Second, false)
+		return rs.wr.CopySchemaShard(ctx, oneSource, []string{"/.*"}, nil, false, false, false, rs.keyspace, target.ShardName(), 1*time.Second, false)
 	})
 	return err
 }
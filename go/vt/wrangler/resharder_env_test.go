@@ -188,8 +188,13 @@ type testResharderTMClient struct {
 
 	mu        sync.Mutex
 	vrQueries map[int][]*queryResult
+	// positions are the primary positions of the tablets, that default
+	// to resharderPosition.
+	positions map[int]string
 }
 
+const resharderPosition = "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"
+
 type queryResult struct {
 	query  string
 	result *querypb.QueryResult
@@ -198,6 +203,7 @@ type queryResult struct {
 func newTestResharderTMClient() *testResharderTMClient {
 	return &testResharderTMClient{
 		vrQueries: make(map[int][]*queryResult),
+		positions: make(map[int]string),
 	}
 }
 
@@ -205,6 +211,15 @@ func (tmc *testResharderTMClient) GetSchema(ctx context.Context, tablet *topodat
 	return tmc.schema, nil
 }
 
+func (tmc *testResharderTMClient) PrimaryPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	if pos, ok := tmc.positions[int(tablet.Alias.Uid)]; ok {
+		return pos, nil
+	}
+	return resharderPosition, nil
+}
+
 func (tmc *testResharderTMClient) expectVRQuery(tabletID int, query string, result *sqltypes.Result) {
 	tmc.mu.Lock()
 	defer tmc.mu.Unlock()
@@ -147,6 +147,23 @@ func TestResharderManyToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestResharderMergeSourcePositions(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-80", "80-"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.positions[110] = "MariaDB/0-1-1083"
+	env.expectValidation()
+	err := env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.EqualError(t, err, "buildResharder: validateSourcePositions: the source shards of a merge must have the same GTID flavor: -80 has MySQL56, and 80- has MariaDB")
+	env.tmc.verifyQueries(t)
+
+	env.tmc.positions[110] = ""
+	env.expectValidation()
+	err = env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.EqualError(t, err, "buildResharder: validateSourcePositions: source shard 80- has no GTID position")
+	env.tmc.verifyQueries(t)
+}
+
 func TestResharderManyToMany(t *testing.T) {
 	env := newTestResharderEnv(t, []string{"-40", "40-"}, []string{"-80", "80-"})
 	defer env.close()
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// RestoreReshard copies the tables of a snapshot keyspace, whose tablets
+// restored the backups of the shards of its base keyspace, into a target
+// keyspace with another number of shards. The rows are streamed from the
+// restored tablets by a workflow that stops after its copy phase, and each
+// target shard gets the rows whose keyspace ids, computed with the vindexes
+// of the target keyspace, fall in its key range. This restores a keyspace
+// into a cluster of another size, without resharding the production
+// keyspace.
+//
+// If the vschema of the target keyspace has no tables, it gets the vschema of
+// the base keyspace. The tables are created in the target keyspace from the
+// schema of the snapshot keyspace. All its tables are copied, unless some are
+// given.
+func (wr *Wrangler) RestoreReshard(ctx context.Context, workflow, snapshotKeyspace, targetKeyspace string, tables, excludeTables []string, cell, tabletTypes string) error {
+	ki, err := wr.ts.GetKeyspace(ctx, snapshotKeyspace)
+	if err != nil {
+		return err
+	}
+	if ki.KeyspaceType != topodatapb.KeyspaceType_SNAPSHOT {
+		return fmt.Errorf("keyspace %s is not a snapshot keyspace, restore the backups into a SNAPSHOT keyspace first", snapshotKeyspace)
+	}
+	if targetKeyspace == snapshotKeyspace || targetKeyspace == ki.BaseKeyspace {
+		return fmt.Errorf("the target keyspace must differ from the snapshot keyspace %s and from its base keyspace %s", snapshotKeyspace, ki.BaseKeyspace)
+	}
+	if err := wr.prepareRestoreReshardVSchema(ctx, ki.BaseKeyspace, targetKeyspace); err != nil {
+		return err
+	}
+
+	ddls, err := wr.snapshotTableDDLs(ctx, snapshotKeyspace)
+	if err != nil {
+		return err
+	}
+	ksTables := make([]string, 0, len(ddls))
+	for table := range ddls {
+		ksTables = append(ksTables, table)
+	}
+	sort.Strings(ksTables)
+	if len(tables) > 0 {
+		if err := wr.validateSourceTablesExist(ctx, snapshotKeyspace, ksTables, tables); err != nil {
+			return err
+		}
+	} else {
+		tables = ksTables
+	}
+	if err := wr.validateSourceTablesExist(ctx, snapshotKeyspace, ksTables, excludeTables); err != nil {
+		return err
+	}
+
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       workflow,
+		SourceKeyspace: snapshotKeyspace,
+		TargetKeyspace: targetKeyspace,
+		Cell:           cell,
+		TabletTypes:    tabletTypes,
+		StopAfterCopy:  true,
+	}
+	for _, table := range tables {
+		if !shouldInclude(table, excludeTables) {
+			continue
+		}
+		ms.TableSettings = append(ms.TableSettings, &vtctldatapb.TableMaterializeSettings{
+			TargetTable:      table,
+			SourceExpression: fmt.Sprintf("select * from %s", sqlescape.EscapeID(table)),
+			CreateDdl:        ddls[table],
+		})
+	}
+	if len(ms.TableSettings) == 0 {
+		return fmt.Errorf("no tables to restore")
+	}
+	return wr.Materialize(ctx, ms)
+}
+
+// prepareRestoreReshardVSchema gives the target keyspace of RestoreReshard
+// the vschema of the base keyspace, unless it already has tables.
+func (wr *Wrangler) prepareRestoreReshardVSchema(ctx context.Context, baseKeyspace, targetKeyspace string) error {
+	targetVSchema, err := wr.ts.GetVSchema(ctx, targetKeyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+	if len(targetVSchema.GetTables()) > 0 {
+		return nil
+	}
+	baseVSchema, err := wr.ts.GetVSchema(ctx, baseKeyspace)
+	if err != nil {
+		return err
+	}
+	targetVSchema = proto.Clone(baseVSchema).(*vschemapb.Keyspace)
+	targetVSchema.RequireExplicitRouting = false
+	if err := wr.ts.SaveVSchema(ctx, targetKeyspace, targetVSchema); err != nil {
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// snapshotTableDDLs returns the create statements of the tables of a snapshot
+// keyspace. Its tablets usually have no primary, so they are read from the
+// primary of its first shard if there is one, or from another of its
+// tablets.
+func (wr *Wrangler) snapshotTableDDLs(ctx context.Context, snapshotKeyspace string) (map[string]string, error) {
+	shards, err := wr.ts.GetServingShards(ctx, snapshotKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("keyspace %s has no shards", snapshotKeyspace)
+	}
+	var tablet *topodatapb.Tablet
+	if shards[0].PrimaryAlias != nil {
+		ti, err := wr.ts.GetTablet(ctx, shards[0].PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+		tablet = ti.Tablet
+	} else {
+		tablets, err := wr.ts.GetTabletMapForShard(ctx, snapshotKeyspace, shards[0].ShardName())
+		if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+			return nil, err
+		}
+		aliases := make([]string, 0, len(tablets))
+		for alias, ti := range tablets {
+			if topoproto.IsServingType(ti.Type) {
+				aliases = append(aliases, alias)
+			}
+		}
+		if len(aliases) == 0 {
+			return nil, fmt.Errorf("no serving tablet found in shard %s/%s", snapshotKeyspace, shards[0].ShardName())
+		}
+		sort.Strings(aliases)
+		tablet = tablets[aliases[0]].Tablet
+	}
+	schema, err := wr.tmc.GetSchema(ctx, tablet, []string{"/.*/"}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	ddls := make(map[string]string, len(schema.TableDefinitions))
+	for _, td := range schema.TableDefinitions {
+		ddls[td.Name] = td.Schema
+	}
+	return ddls, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func newRestoreReshardEnv(t *testing.T) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "snapks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+	}
+	// The backups of a keyspace of two shards are restored into three.
+	env := newTestMaterializerEnv(t, ms, []string{"-80", "80-"}, []string{"-40", "40-c0", "c0-"})
+	// Only the snapshot keyspace has t2.
+	env.tmc.schema["snapks.t2"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t2",
+			Schema: "t2_schema",
+		}},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, env.topoServ.CreateKeyspace(ctx, "baseks", &topodatapb.Keyspace{}))
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "baseks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}}},
+			"t2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c2", Name: "hash"}}},
+		},
+	}))
+	ctx, unlock, err := env.topoServ.LockKeyspace(ctx, "snapks", "test")
+	require.NoError(t, err)
+	defer unlock(&err)
+	ki, err := env.topoServ.GetKeyspace(ctx, "snapks")
+	require.NoError(t, err)
+	ki.KeyspaceType = topodatapb.KeyspaceType_SNAPSHOT
+	ki.BaseKeyspace = "baseks"
+	require.NoError(t, env.topoServ.UpdateKeyspace(ctx, ki))
+	return env
+}
+
+func TestRestoreReshard(t *testing.T) {
+	env := newRestoreReshardEnv(t)
+	defer env.close()
+
+	for _, target := range []struct {
+		tabletID int
+		keyRange string
+	}{{200, "-40"}, {210, "40-c0"}, {220, "c0-"}} {
+		env.tmc.expectVRQuery(target.tabletID, mzSelectFrozenQuery, &sqltypes.Result{})
+		// The target keyspace doesn't have t2 yet.
+		env.tmc.expectVRQuery(target.tabletID, "t2_schema", &sqltypes.Result{})
+		// Each target shard gets the rows of its key range from both sources.
+		rules := `filter:{rules:{match:\\"t1\\" filter:\\"select \* from t1 where in_keyrange\(c1, .*targetks\.hash.*, .*` + target.keyRange + `.*\)\\"} ` +
+			`rules:{match:\\"t2\\" filter:\\"select \* from t2 where in_keyrange\(c2, .*targetks\.hash.*, .*` + target.keyRange + `.*\)\\"}} stop_after_copy:true`
+		env.tmc.expectVRQuery(target.tabletID, insertPrefix+
+			`.*keyspace:\\"snapks\\" shard:\\"-80\\" `+rules+
+			`.*keyspace:\\"snapks\\" shard:\\"80-\\" `+rules, &sqltypes.Result{})
+		env.tmc.expectVRQuery(target.tabletID, mzUpdateQuery, &sqltypes.Result{})
+	}
+
+	ctx := context.Background()
+	err := env.wr.RestoreReshard(ctx, "workflow", "snapks", "targetks", nil, nil, "", "REPLICA,RDONLY")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	// The target keyspace got the vschema of the base keyspace.
+	vschema, err := env.topoServ.GetVSchema(ctx, "targetks")
+	require.NoError(t, err)
+	assert.True(t, vschema.Sharded)
+	assert.Contains(t, vschema.Tables, "t2")
+}
+
+func TestRestoreReshardErrors(t *testing.T) {
+	env := newRestoreReshardEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	err := env.wr.RestoreReshard(ctx, "workflow", "targetks", "snapks", nil, nil, "", "")
+	assert.EqualError(t, err, "keyspace targetks is not a snapshot keyspace, restore the backups into a SNAPSHOT keyspace first")
+	err = env.wr.RestoreReshard(ctx, "workflow", "snapks", "baseks", nil, nil, "", "")
+	assert.EqualError(t, err, "the target keyspace must differ from the snapshot keyspace snapks and from its base keyspace baseks")
+	err = env.wr.RestoreReshard(ctx, "workflow", "snapks", "targetks", []string{"t3"}, nil, "", "")
+	assert.EqualError(t, err, "table(s) not found in source keyspace snapks: t3")
+	err = env.wr.RestoreReshard(ctx, "workflow", "snapks", "targetks", nil, []string{"t1", "t2"}, "", "")
+	assert.EqualError(t, err, "no tables to restore")
+}
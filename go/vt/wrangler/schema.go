@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,6 +43,10 @@ import (
 const (
 	// DefaultWaitReplicasTimeout is the default value for waitReplicasTimeout, which is used when calling method CopySchemaShardFromShard.
 	DefaultWaitReplicasTimeout = 10 * time.Second
+
+	// copySchemaBatchSize is the number of tables and views that
+	// CopySchemaShard creates with each schema change of the destination.
+	copySchemaBatchSize = 100
 )
 
 // helper method to asynchronously diff a schema
@@ -186,7 +191,7 @@ func (wr *Wrangler) PreflightSchema(ctx context.Context, tabletAlias *topodatapb
 
 // CopySchemaShardFromShard copies the schema from a source shard to the specified destination shard.
 // For both source and destination it picks the primary tablet. See also CopySchemaShard.
-func (wr *Wrangler) CopySchemaShardFromShard(ctx context.Context, tables, excludeTables []string, includeViews bool, sourceKeyspace, sourceShard, destKeyspace, destShard string, waitReplicasTimeout time.Duration, skipVerify bool) error {
+func (wr *Wrangler) CopySchemaShardFromShard(ctx context.Context, tables, excludeTables []string, includeViews, includeRoutines, includeTriggers bool, sourceKeyspace, sourceShard, destKeyspace, destShard string, waitReplicasTimeout time.Duration, skipVerify bool) error {
 	sourceShardInfo, err := wr.ts.GetShard(ctx, sourceKeyspace, sourceShard)
 	if err != nil {
 		return fmt.Errorf("GetShard(%v, %v) failed: %v", sourceKeyspace, sourceShard, err)
@@ -195,14 +200,17 @@ func (wr *Wrangler) CopySchemaShardFromShard(ctx context.Context, tables, exclud
 		return fmt.Errorf("no primary in shard record %v/%v. Consider running 'vtctl InitShardPrimary' in case of a new shard or reparenting the shard to fix the topology data, or providing a non-primary tablet alias", sourceKeyspace, sourceShard)
 	}
 
-	return wr.CopySchemaShard(ctx, sourceShardInfo.PrimaryAlias, tables, excludeTables, includeViews, destKeyspace, destShard, waitReplicasTimeout, skipVerify)
+	return wr.CopySchemaShard(ctx, sourceShardInfo.PrimaryAlias, tables, excludeTables, includeViews, includeRoutines, includeTriggers, destKeyspace, destShard, waitReplicasTimeout, skipVerify)
 }
 
 // CopySchemaShard copies the schema from a source tablet to the
 // specified shard.  The schema is applied directly on the primary of
 // the destination shard, and is propagated to the replicas through
-// binlogs.
-func (wr *Wrangler) CopySchemaShard(ctx context.Context, sourceTabletAlias *topodatapb.TabletAlias, tables, excludeTables []string, includeViews bool, destKeyspace, destShard string, waitReplicasTimeout time.Duration, skipVerify bool) error {
+// binlogs. The tables are created in batches, and the stored procedures,
+// functions and triggers are only copied if includeRoutines and
+// includeTriggers are set. The tables and excludeTables filters select the
+// routines by their name, and the triggers by their table.
+func (wr *Wrangler) CopySchemaShard(ctx context.Context, sourceTabletAlias *topodatapb.TabletAlias, tables, excludeTables []string, includeViews, includeRoutines, includeTriggers bool, destKeyspace, destShard string, waitReplicasTimeout time.Duration, skipVerify bool) error {
 	destShardInfo, err := wr.ts.GetShard(ctx, destKeyspace, destShard)
 	if err != nil {
 		return fmt.Errorf("GetShard(%v, %v) failed: %v", destKeyspace, destShard, err)
@@ -217,34 +225,64 @@ func (wr *Wrangler) CopySchemaShard(ctx context.Context, sourceTabletAlias *topo
 		return fmt.Errorf("copyShardMetadata(%v, %v) failed: %v", sourceTabletAlias, destShardInfo.PrimaryAlias, err)
 	}
 
-	diffs, err := schematools.CompareSchemas(ctx, wr.ts, wr.tmc, sourceTabletAlias, destShardInfo.PrimaryAlias, tables, excludeTables, includeViews)
+	wr.Logger().Infof("Gathering the schemas of %v and %v", topoproto.TabletAliasString(sourceTabletAlias), topoproto.TabletAliasString(destShardInfo.PrimaryAlias))
+	schemas, err := schematools.GetSchemas(ctx, wr.ts, wr.tmc, []*topodatapb.TabletAlias{sourceTabletAlias, destShardInfo.PrimaryAlias}, tables, excludeTables, includeViews)
 	if err != nil {
 		return fmt.Errorf("CopySchemaShard failed because schemas could not be compared initially: %v", err)
 	}
-	if diffs == nil {
+	sourceSd := schemas[0]
+	diffs := tmutils.DiffSchemaToArray("source", sourceSd, "dest", schemas[1])
+	if diffs == nil && !includeRoutines && !includeTriggers {
 		// Return early because dest has already the same schema as source.
 		return nil
 	}
 
-	sourceSd, err := schematools.GetSchema(ctx, wr.ts, wr.tmc, sourceTabletAlias, tables, excludeTables, includeViews)
+	destTabletInfo, err := wr.ts.GetTablet(ctx, destShardInfo.PrimaryAlias)
 	if err != nil {
-		return fmt.Errorf("GetSchema(%v, %v, %v, %v) failed: %v", sourceTabletAlias, tables, excludeTables, includeViews, err)
+		return fmt.Errorf("GetTablet(%v) failed: %v", destShardInfo.PrimaryAlias, err)
 	}
 
-	createSQLstmts := tmutils.SchemaDefinitionToSQLStrings(sourceSd)
+	// The database is created on its own, then the stored routines, which
+	// the views can use, then the tables and the views in batches, the
+	// views last, and finally the triggers.
+	var createTableStmts []string
+	if diffs != nil {
+		createSQLstmts := tmutils.SchemaDefinitionToSQLStrings(sourceSd)
+		if err := wr.applySQLShard(ctx, destTabletInfo, createSQLstmts[0]); err != nil {
+			return fmt.Errorf("creating the database failed: %v", err)
+		}
+		createTableStmts = createSQLstmts[1:]
+	}
 
-	destTabletInfo, err := wr.ts.GetTablet(ctx, destShardInfo.PrimaryAlias)
-	if err != nil {
-		return fmt.Errorf("GetTablet(%v) failed: %v", destShardInfo.PrimaryAlias, err)
+	if includeRoutines {
+		copied, err := schematools.CopyRoutines(ctx, wr.ts, wr.tmc, sourceTabletAlias, destTabletInfo.Alias, tables, excludeTables)
+		if err != nil {
+			return fmt.Errorf("CopyRoutines(%v, %v) failed: %v", sourceTabletAlias, destTabletInfo.Alias, err)
+		}
+		wr.Logger().Infof("Created %d stored procedures and functions on %v", copied, topoproto.TabletAliasString(destTabletInfo.Alias))
 	}
-	for _, createSQL := range createSQLstmts {
-		err = wr.applySQLShard(ctx, destTabletInfo, createSQL)
+
+	for start := 0; start < len(createTableStmts); start += copySchemaBatchSize {
+		end := start + copySchemaBatchSize
+		if end > len(createTableStmts) {
+			end = len(createTableStmts)
+		}
+		err = wr.applySQLShardBatch(ctx, destTabletInfo, createTableStmts[start:end])
 		if err != nil {
 			return fmt.Errorf("creating a table failed."+
 				" Most likely some tables already exist on the destination and differ from the source."+
 				" Please remove all to be copied tables from the destination manually and run this command again."+
 				" Full error: %v", err)
 		}
+		wr.Logger().Infof("Created %d/%d tables and views on %v", end, len(createTableStmts), topoproto.TabletAliasString(destTabletInfo.Alias))
+	}
+
+	if includeTriggers {
+		copied, err := schematools.CopyTriggers(ctx, wr.ts, wr.tmc, sourceTabletAlias, destTabletInfo.Alias, tables, excludeTables)
+		if err != nil {
+			return fmt.Errorf("CopyTriggers(%v, %v) failed: %v", sourceTabletAlias, destTabletInfo.Alias, err)
+		}
+		wr.Logger().Infof("Created %d triggers on %v", copied, topoproto.TabletAliasString(destTabletInfo.Alias))
 	}
 
 	// Remember the replication position after all the above were applied.
@@ -295,15 +333,26 @@ func (wr *Wrangler) CopySchemaShard(ctx context.Context, sourceTabletAlias *topo
 // it shouldn't be used for anything that will require a pivot.
 // The SQL statement string is expected to have {{.DatabaseName}} in place of the actual db name.
 func (wr *Wrangler) applySQLShard(ctx context.Context, tabletInfo *topo.TabletInfo, change string) error {
-	filledChange, err := fillStringTemplate(change, map[string]string{"DatabaseName": tabletInfo.DbName()})
-	if err != nil {
-		return fmt.Errorf("fillStringTemplate failed: %v", err)
+	return wr.applySQLShardBatch(ctx, tabletInfo, []string{change})
+}
+
+// applySQLShardBatch is like applySQLShard, but it applies several SQL
+// statements at once, in their order, which saves the schema reloads of
+// the tablet between them.
+func (wr *Wrangler) applySQLShardBatch(ctx context.Context, tabletInfo *topo.TabletInfo, changes []string) error {
+	filledChanges := make([]string, 0, len(changes))
+	for _, change := range changes {
+		filledChange, err := fillStringTemplate(change, map[string]string{"DatabaseName": tabletInfo.DbName()})
+		if err != nil {
+			return fmt.Errorf("fillStringTemplate failed: %v", err)
+		}
+		filledChanges = append(filledChanges, filledChange)
 	}
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	// Need to make sure that replication is enabled since we're only applying the statement on primaries
-	_, err = wr.tmc.ApplySchema(ctx, tabletInfo.Tablet, &tmutils.SchemaChange{
-		SQL:              filledChange,
+	_, err := wr.tmc.ApplySchema(ctx, tabletInfo.Tablet, &tmutils.SchemaChange{
+		SQL:              strings.Join(filledChanges, ";\n"),
 		Force:            false,
 		AllowReplication: true,
 		SQLMode:          vreplication.SQLMode,
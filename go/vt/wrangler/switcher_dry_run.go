@@ -104,6 +104,17 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 	if dr.ts.MigrationType() == binlogdatapb.MigrationType_TABLES {
 		tables := strings.Join(dr.ts.Tables(), ",")
 		dr.drLog.Log(fmt.Sprintf("Routing rules for tables [%s] will be updated", tables))
+		_, changes, err := dr.ts.switchedTableReferences(ctx)
+		if err != nil {
+			return err
+		}
+		if len(changes) > 0 {
+			dr.drLog.Log("Sequence and lookup table references will be switched:")
+			for i := range changes {
+				changes[i] = "\t" + changes[i]
+			}
+			dr.drLog.LogSlice(changes)
+		}
 		return nil
 	}
 	deleteLogs = nil
@@ -17,6 +17,7 @@ limitations under the License.
 package testlib
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path"
@@ -162,6 +163,17 @@ func TestBackupRestore(t *testing.T) {
 	assert.True(t, sourceTablet.FakeMysqlDaemon.Replicating)
 	assert.True(t, sourceTablet.FakeMysqlDaemon.Running)
 
+	// verify the replication state recorded in the MANIFEST
+	manifest := readBackupManifest(ctx, t, sourceTablet.Tablet.Keyspace, sourceTablet.Tablet.Shard)
+	assert.Equal(t, topoproto.TabletAliasString(sourceTablet.Tablet.Alias), manifest.TabletAlias)
+	assert.Equal(t, sourceTablet.Tablet.Keyspace, manifest.Keyspace)
+	assert.Equal(t, sourceTablet.Tablet.Shard, manifest.Shard)
+	require.NotNil(t, manifest.ReplicationSource)
+	assert.Equal(t, topoproto.TabletAliasString(primary.Tablet.Alias), manifest.ReplicationSource.PrimaryAlias)
+	assert.Equal(t, primary.FakeMysqlDaemon.CurrentPrimaryPosition, manifest.ReplicationSource.PrimaryPosition)
+	assert.True(t, manifest.ReplicationSource.ReplicationStopped)
+	assert.Empty(t, manifest.ReplicationSource.ErrantGTIDs)
+
 	backupTime := time.Now()
 
 	// create a destination tablet, set it up so we can do restores
@@ -780,3 +792,134 @@ func TestDisableActiveReparents(t *testing.T) {
 	assert.False(t, destTablet.FakeMysqlDaemon.Replicating)
 	assert.True(t, destTablet.FakeMysqlDaemon.Running)
 }
+
+func TestBackupReplicaChecks(t *testing.T) {
+	_ = reparentutil.SetDurabilityPolicy("none")
+
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`ALTER TABLE _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`ALTER TABLE _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`UPDATE _vt\.local_metadata SET db_name=.*`, &sqltypes.Result{})
+	db.AddQueryPattern(`UPDATE _vt\.shard_metadata SET db_name=.*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// Initialize our temp dirs
+	root, err := os.MkdirTemp("", "backuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	fbsRoot := path.Join(root, "fbs")
+	*filebackupstorage.FileBackupStorageRoot = fbsRoot
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		require.NoError(t, os.MkdirAll(s, os.ModePerm))
+	}
+	require.NoError(t, os.WriteFile(path.Join(sourceInnodbDataDir, "innodb_data_1"), []byte("innodb data 1 contents"), os.ModePerm))
+	require.NoError(t, os.WriteFile(path.Join(sourceInnodbLogDir, "innodb_log_1"), []byte("innodb log 1 contents"), os.ModePerm))
+	require.NoError(t, os.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm))
+
+	// create a primary tablet, set its primary position
+	primary := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_PRIMARY, db)
+	primary.FakeMysqlDaemon.ReadOnly = false
+	primary.FakeMysqlDaemon.Replicating = false
+	primary.FakeMysqlDaemon.CurrentPrimaryPosition = mysql.MustParsePosition(mysql.Mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	primary.StartActionLoop(t, wr)
+	defer primary.StopActionLoop(t)
+
+	// create a replica that executed a GTID that the primary doesn't have
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.SetReplicationSourceInputs = []string{fmt.Sprintf("%s:%d", primary.Tablet.MysqlHostname, primary.Tablet.MysqlPort)}
+	sourceTablet.FakeMysqlDaemon.CurrentPrimaryPosition = mysql.MustParsePosition(mysql.Mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100,00010203-0405-0607-0809-0a0b0c0d0eff:1-2")
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+
+	sourceTablet.TM.Cnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+
+	// the backup of a replica that lags too much is rejected
+	flag.Set("backup_max_replication_lag", "1m")
+	sourceTablet.FakeMysqlDaemon.ReplicationLagSeconds = 120
+	err = vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)})
+	flag.Set("backup_max_replication_lag", "0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "replication lag 2m0s is higher than -backup_max_replication_lag 1m0s")
+	sourceTablet.FakeMysqlDaemon.ReplicationLagSeconds = 0
+
+	// the backup of a replica with errant GTIDs is rejected before the
+	// replication is stopped
+	err = vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "replica has errant GTIDs 00010203-0405-0607-0809-0a0b0c0d0eff:1-2")
+	require.NoError(t, sourceTablet.FakeMysqlDaemon.CheckSuperQueryList())
+	assert.True(t, sourceTablet.FakeMysqlDaemon.Replicating)
+
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBackupDir(sourceTablet.Tablet.Keyspace, sourceTablet.Tablet.Shard))
+	require.NoError(t, err)
+	assert.Empty(t, bhs)
+
+	// the backup is taken with -backup_allow_errant_gtids, which records them.
+	// The replication is stopped, so that the backup doesn't wait for the
+	// replica to catch up with the primary.
+	flag.Set("backup_allow_errant_gtids", "true")
+	defer flag.Set("backup_allow_errant_gtids", "false")
+	sourceTablet.FakeMysqlDaemon.Replicating = false
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		// stopped by the builtinBackupEngine while taking the backup
+		"STOP SLAVE",
+		// SetReplicationSource called after the backup
+		"STOP SLAVE",
+		"FAKE SET MASTER",
+	}
+	require.NoError(t, vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}))
+	require.NoError(t, sourceTablet.FakeMysqlDaemon.CheckSuperQueryList())
+
+	manifest := readBackupManifest(ctx, t, sourceTablet.Tablet.Keyspace, sourceTablet.Tablet.Shard)
+	require.NotNil(t, manifest.ReplicationSource)
+	assert.Equal(t, "00010203-0405-0607-0809-0a0b0c0d0eff:1-2", manifest.ReplicationSource.ErrantGTIDs)
+	assert.Equal(t, primary.FakeMysqlDaemon.CurrentPrimaryPosition, manifest.ReplicationSource.PrimaryPosition)
+}
+
+// readBackupManifest returns the MANIFEST of the latest backup of a shard.
+func readBackupManifest(ctx context.Context, t *testing.T, keyspace, shard string) *mysqlctl.BackupManifest {
+	t.Helper()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBackupDir(keyspace, shard))
+	require.NoError(t, err)
+	require.NotEmpty(t, bhs)
+	manifest, err := mysqlctl.GetBackupManifest(ctx, bhs[len(bhs)-1])
+	require.NoError(t, err)
+	return manifest
+}
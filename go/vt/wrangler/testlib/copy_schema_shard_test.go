@@ -21,6 +21,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/vt/discovery"
 
 	"context"
@@ -154,11 +157,12 @@ func copySchema(t *testing.T, useShardAsSource bool) {
 	destinationPrimaryDb.AddQuery(setSQLMode, &sqltypes.Result{})
 	destinationPrimaryDb.AddQuery(createDb, &sqltypes.Result{})
 	destinationPrimaryDb.AddQuery(changeToDb, &sqltypes.Result{})
-	destinationPrimaryDb.AddQuery(createTable, &sqltypes.Result{})
-	destinationPrimaryDb.AddQuery(createTableView, &sqltypes.Result{})
+	// The tables and the views are created in a batch.
+	createTables := createTable + ";\n" + createTableView
+	destinationPrimaryDb.AddQuery(createTables, &sqltypes.Result{})
 
 	destinationPrimary.FakeMysqlDaemon.SchemaFunc = func() (*tabletmanagerdatapb.SchemaDefinition, error) {
-		if destinationPrimaryDb.GetQueryCalledNum(createTableView) == 1 {
+		if destinationPrimaryDb.GetQueryCalledNum(createTables) == 1 {
 			return schema, nil
 		}
 		return schemaEmptyDb, nil
@@ -187,10 +191,83 @@ func copySchema(t *testing.T, useShardAsSource bool) {
 	if count := destinationPrimaryDb.GetQueryCalledNum(createDb); count != 1 {
 		t.Errorf("CopySchemaShard did not create the db exactly once. Query count: %v", count)
 	}
-	if count := destinationPrimaryDb.GetQueryCalledNum(createTable); count != 1 {
-		t.Errorf("CopySchemaShard did not create the table exactly once. Query count: %v", count)
+	if count := destinationPrimaryDb.GetQueryCalledNum(createTables); count != 1 {
+		t.Errorf("CopySchemaShard did not create the table and the table view exactly once. Query count: %v", count)
 	}
-	if count := destinationPrimaryDb.GetQueryCalledNum(createTableView); count != 1 {
-		t.Errorf("CopySchemaShard did not create the table view exactly once. Query count: %v", count)
+}
+
+func TestCopySchemaShard_RoutinesAndTriggers(t *testing.T) {
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	require.NoError(t, ts.CreateKeyspace(context.Background(), "ks", &topodatapb.Keyspace{}))
+
+	sourcePrimaryDb := fakesqldb.New(t).SetName("sourcePrimaryDb")
+	defer sourcePrimaryDb.Close()
+	sourcePrimary := NewFakeTablet(t, wr, "cell1", 0,
+		topodatapb.TabletType_PRIMARY, sourcePrimaryDb, TabletKeyspaceShard(t, "ks", "-80"))
+
+	destinationPrimaryDb := fakesqldb.New(t).SetName("destinationPrimaryDb")
+	defer destinationPrimaryDb.Close()
+	destinationPrimary := NewFakeTablet(t, wr, "cell1", 10,
+		topodatapb.TabletType_PRIMARY, destinationPrimaryDb, TabletKeyspaceShard(t, "ks", "-40"))
+
+	for _, ft := range []*FakeTablet{sourcePrimary, destinationPrimary} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
 	}
+
+	// The tables are the same, so only the routines and the triggers are copied.
+	schema := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "table1",
+			Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	sourcePrimary.FakeMysqlDaemon.Schema = schema
+	destinationPrimary.FakeMysqlDaemon.Schema = schema
+
+	changeToDb := "USE `vt_ks`"
+	selectInformationSchema := "SELECT 1 FROM information_schema.tables WHERE table_schema = '_vt' AND table_name = 'shard_metadata'"
+	selectRoutines := "SELECT routine_type, routine_name FROM information_schema.routines WHERE routine_schema = DATABASE() ORDER BY routine_type, routine_name"
+	selectTriggers := "SELECT 'TRIGGER', trigger_name, event_object_table FROM information_schema.triggers WHERE trigger_schema = DATABASE() ORDER BY event_object_table, action_timing, event_manipulation, action_order"
+	objects := func(rows ...string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("type|name", "varchar|varchar"), rows...)
+	}
+	triggers := func(rows ...string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("type|name|table", "varchar|varchar|varchar"), rows...)
+	}
+	createProcedure := "CREATE DEFINER=`root`@`localhost` PROCEDURE `p1`() SELECT 1"
+	createTrigger := "CREATE DEFINER=`root`@`localhost` TRIGGER `t1` BEFORE INSERT ON `table1` FOR EACH ROW SET NEW.id = NEW.id"
+	showCreate := func(name, create string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("name|sql_mode|create", "varchar|varchar|varchar"), name+"||"+create)
+	}
+
+	for _, db := range []*fakesqldb.DB{sourcePrimaryDb, destinationPrimaryDb} {
+		db.AddQuery(changeToDb, &sqltypes.Result{})
+	}
+	sourcePrimaryDb.AddQuery(selectInformationSchema, &sqltypes.Result{})
+	// The procedure p2 and the trigger t3 of table2 are left out by the filters.
+	sourcePrimaryDb.AddQuery(selectRoutines, objects("PROCEDURE|p1", "PROCEDURE|p2"))
+	sourcePrimaryDb.AddQuery(selectTriggers, triggers("TRIGGER|t1|table1", "TRIGGER|t2|table1", "TRIGGER|t3|table2"))
+	sourcePrimaryDb.AddQuery("SHOW CREATE PROCEDURE `p1`", showCreate("p1", createProcedure))
+	sourcePrimaryDb.AddQuery("SHOW CREATE TRIGGER `t1`", showCreate("t1", createTrigger))
+	// The trigger t2 already exists on the destination.
+	destinationPrimaryDb.AddQuery(selectRoutines, objects())
+	destinationPrimaryDb.AddQuery(selectTriggers, triggers("TRIGGER|t2|table1"))
+	destinationPrimaryDb.AddQuery(createProcedure, &sqltypes.Result{})
+	destinationPrimaryDb.AddQuery(createTrigger, &sqltypes.Result{})
+
+	err := vp.Run([]string{"CopySchemaShard", "-include-routines", "-include-triggers", "-exclude_tables=p2,table2", topoproto.TabletAliasString(sourcePrimary.Tablet.Alias), "ks/-40"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, destinationPrimaryDb.GetQueryCalledNum(createProcedure))
+	assert.Equal(t, 1, destinationPrimaryDb.GetQueryCalledNum(createTrigger))
+	assert.Zero(t, sourcePrimaryDb.GetQueryCalledNum("SHOW CREATE TRIGGER `t2`"))
+	assert.Zero(t, sourcePrimaryDb.GetQueryCalledNum("SHOW CREATE PROCEDURE `p2`"))
+	assert.Zero(t, sourcePrimaryDb.GetQueryCalledNum("SHOW CREATE TRIGGER `t3`"))
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package testlib
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,6 +25,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/vt/discovery"
 
 	"vitess.io/vitess/go/vt/logutil"
@@ -108,3 +112,59 @@ func TestVersion(t *testing.T) {
 		t.Fatalf("ValidateVersionKeyspace(different) returned an unexpected error: %v", err)
 	}
 }
+
+func TestGetVersions(t *testing.T) {
+	// Initialize our environment
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	primary := NewFakeTablet(t, wr, "cell1", 10, topodatapb.TabletType_PRIMARY, nil,
+		TabletKeyspaceShard(t, "source", "0"),
+		StartHTTPServer())
+	replica := NewFakeTablet(t, wr, "cell2", 11, topodatapb.TabletType_REPLICA, nil,
+		TabletKeyspaceShard(t, "source", "0"),
+		StartHTTPServer())
+
+	// the primary runs the current version, the replica predates the
+	// capabilities
+	primaryGitRev := "fake git rev"
+	primary.StartActionLoop(t, wr)
+	defer primary.StopActionLoop(t)
+	primary.HTTPServer.Handler.(*http.ServeMux).HandleFunc("/debug/vars", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintf(w, `{"Version": "14.0.0", "BuildGitRev": %q, "Capabilities": "read_after_write,result_compression"}`+"\n", primaryGitRev)
+	})
+	replicaGitRev := "older fake git rev"
+	replica.StartActionLoop(t, wr)
+	defer replica.StopActionLoop(t)
+	replica.HTTPServer.Handler.(*http.ServeMux).HandleFunc("/debug/vars", expvarHandler(&replicaGitRev))
+
+	versions, err := wr.GetVersions(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	assert.Equal(t, "vtctld", versions[0].Component)
+	assert.Equal(t, &wrangler.ComponentVersion{
+		Component:    "vttablet",
+		Alias:        "cell1-0000000010",
+		Keyspace:     "source",
+		Shard:        "0",
+		TabletType:   "PRIMARY",
+		Version:      "14.0.0",
+		BuildGitRev:  primaryGitRev,
+		Capabilities: "read_after_write,result_compression",
+	}, versions[1])
+	assert.Equal(t, &wrangler.ComponentVersion{
+		Component:   "vttablet",
+		Alias:       "cell2-0000000011",
+		Keyspace:    "source",
+		Shard:       "0",
+		TabletType:  "REPLICA",
+		BuildGitRev: replicaGitRev,
+	}, versions[2])
+
+	// only the tablets of the given cells are reported
+	versions, err = wr.GetVersions(context.Background(), []string{"cell2"})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "cell2-0000000011", versions[1].Alias)
+}
@@ -1307,6 +1307,9 @@ func (ts *trafficSwitcher) changeWriteRoute(ctx context.Context) error {
 		rules[ts.SourceKeyspaceName()+"."+table] = []string{ts.TargetKeyspaceName() + "." + table}
 		ts.Logger().Infof("Add routing: %v %v", table, ts.SourceKeyspaceName()+"."+table)
 	}
+	if err := ts.switchTableReferences(ctx); err != nil {
+		return err
+	}
 	if err := topotools.SaveRoutingRules(ctx, ts.TopoServer(), rules); err != nil {
 		return err
 	}
@@ -1493,6 +1496,7 @@ func (ts *trafficSwitcher) dropParticipatingTablesFromKeyspace(ctx context.Conte
 	for _, tableName := range ts.Tables() {
 		delete(vschema.Tables, tableName)
 	}
+	dropOrphanedOwnedVindexes(vschema)
 	return ts.TopoServer().SaveVSchema(ctx, keyspace, vschema)
 }
 
@@ -0,0 +1,306 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+)
+
+// This file contains the client side of VDiff v2, whose diffs are run by the
+// primaries of the target shards of the workflows, see the vdiff package.
+// The diffs are created, stopped, resumed and deleted, and their reports
+// read, through VExec on _vt.vdiff.
+
+// VDiffSummary is the summary of a vdiff over all the target shards of its
+// workflow.
+type VDiffSummary struct {
+	Workflow     string
+	Keyspace     string
+	UUID         string
+	State        string
+	RowsCompared int64
+	HasMismatch  bool
+	CreatedAt    string `json:",omitempty"`
+	StartedAt    string `json:",omitempty"`
+	CompletedAt  string `json:",omitempty"`
+	// Shards are the states of the vdiff on the target shards.
+	Shards map[string]string
+	// Errors are the errors of the target shards where the vdiff failed.
+	Errors map[string]string `json:",omitempty"`
+	// Tables are the summaries of the tables, when a single vdiff is shown.
+	Tables map[string]*VDiffTableSummary `json:",omitempty"`
+}
+
+// VDiffTableSummary is the summary of the diff of a table over all the
+// target shards, with a sample of the rows that differ.
+type VDiffTableSummary struct {
+	State        string
+	RowsCompared int64
+	HasMismatch  bool
+	Report       *tabletvdiff.TableReport
+
+	states []string
+}
+
+// VDiff2Create creates a vdiff of a workflow, run by the primaries of its
+// target shards, and returns its uuid. A uuid is generated if none is given.
+func (wr *Wrangler) VDiff2Create(ctx context.Context, keyspace, workflow, uuid string, options *tabletvdiff.Options) (string, error) {
+	if uuid == "" {
+		var err error
+		if uuid, err = schema.CreateUUID(); err != nil {
+			return "", err
+		}
+	}
+	buf, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf("insert into _vt.vdiff(vdiff_uuid, workflow, options) values (%s, %s, %s)",
+		encodeString(uuid), encodeString(workflow), encodeString(string(buf)))
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return "", err
+	}
+	if vdiffRowsAffected(results) == 0 {
+		return "", fmt.Errorf("workflow %s not found in keyspace %s", workflow, keyspace)
+	}
+	return uuid, nil
+}
+
+// VDiff2Stop stops a running vdiff. Its progress is kept, and it can be
+// resumed.
+func (wr *Wrangler) VDiff2Stop(ctx context.Context, keyspace, workflow, uuid string) error {
+	if err := wr.vdiff2SetState(ctx, keyspace, workflow, uuid, tabletvdiff.StateStopped); err != nil {
+		return fmt.Errorf("can't stop vdiff %s of workflow %s.%s: %v", uuid, keyspace, workflow, err)
+	}
+	return nil
+}
+
+// VDiff2Resume resumes a stopped or failed vdiff from where it was.
+func (wr *Wrangler) VDiff2Resume(ctx context.Context, keyspace, workflow, uuid string) error {
+	if err := wr.vdiff2SetState(ctx, keyspace, workflow, uuid, tabletvdiff.StatePending); err != nil {
+		return fmt.Errorf("can't resume vdiff %s of workflow %s.%s: %v", uuid, keyspace, workflow, err)
+	}
+	return nil
+}
+
+func (wr *Wrangler) vdiff2SetState(ctx context.Context, keyspace, workflow, uuid, state string) error {
+	query := fmt.Sprintf("update _vt.vdiff set state=%s where vdiff_uuid=%s", encodeString(state), encodeString(uuid))
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return err
+	}
+	if vdiffRowsAffected(results) == 0 {
+		return fmt.Errorf("vdiff not found, or already %s", map[string]string{
+			tabletvdiff.StateStopped: "completed, stopped or failed",
+			tabletvdiff.StatePending: "running or completed",
+		}[state])
+	}
+	return nil
+}
+
+// VDiff2Delete deletes a vdiff with its reports, stopping it if it's
+// running, or all the vdiffs of the workflow if uuid is "all".
+func (wr *Wrangler) VDiff2Delete(ctx context.Context, keyspace, workflow, uuid string) error {
+	query := "delete from _vt.vdiff"
+	if uuid != "all" {
+		query += fmt.Sprintf(" where vdiff_uuid=%s", encodeString(uuid))
+	}
+	_, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	return err
+}
+
+// VDiff2List returns the summaries of the vdiffs of a workflow, without
+// their tables, in the order they were created.
+func (wr *Wrangler) VDiff2List(ctx context.Context, keyspace, workflow string) ([]*VDiffSummary, error) {
+	summaries, err := wr.vdiff2Summaries(ctx, keyspace, workflow, "select * from _vt.vdiff", false)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt < summaries[j].CreatedAt
+	})
+	return summaries, nil
+}
+
+// VDiff2Show returns the summary of a vdiff, with the reports of its tables
+// merged over the target shards. The uuid can be "last" for the last vdiff
+// of the workflow.
+func (wr *Wrangler) VDiff2Show(ctx context.Context, keyspace, workflow, uuid string) (*VDiffSummary, error) {
+	if uuid == "last" {
+		summaries, err := wr.VDiff2List(ctx, keyspace, workflow)
+		if err != nil {
+			return nil, err
+		}
+		if len(summaries) == 0 {
+			return nil, fmt.Errorf("no vdiff found for workflow %s.%s", keyspace, workflow)
+		}
+		uuid = summaries[len(summaries)-1].UUID
+	}
+	query := fmt.Sprintf("select * from _vt.vdiff where vdiff_uuid=%s", encodeString(uuid))
+	summaries, err := wr.vdiff2Summaries(ctx, keyspace, workflow, query, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) == 0 {
+		return nil, fmt.Errorf("vdiff %s not found for workflow %s.%s", uuid, keyspace, workflow)
+	}
+	return summaries[0], nil
+}
+
+// vdiff2Summaries returns the summaries of the vdiffs selected by a query on
+// _vt.vdiff, along with the summaries of their tables if withTables is set.
+func (wr *Wrangler) vdiff2Summaries(ctx context.Context, keyspace, workflow, query string, withTables bool) ([]*VDiffSummary, error) {
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return nil, err
+	}
+	// The shards are merged in order, for the report samples to be stable.
+	tablets := make([]*topo.TabletInfo, 0, len(results))
+	for tablet := range results {
+		tablets = append(tablets, tablet)
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		return tablets[i].Shard < tablets[j].Shard
+	})
+
+	var summaries []*VDiffSummary
+	byUUID := make(map[string]*VDiffSummary)
+	shardStates := make(map[string][]string)
+	for _, tablet := range tablets {
+		for _, row := range results[tablet].Named().Rows {
+			uuid := row.AsString("vdiff_uuid", "")
+			summary := byUUID[uuid]
+			if summary == nil {
+				summary = &VDiffSummary{
+					Workflow: workflow,
+					Keyspace: keyspace,
+					UUID:     uuid,
+					Shards:   make(map[string]string),
+				}
+				if withTables {
+					summary.Tables = make(map[string]*VDiffTableSummary)
+				}
+				byUUID[uuid] = summary
+				summaries = append(summaries, summary)
+			}
+			state := row.AsString("state", "")
+			summary.Shards[tablet.Shard] = state
+			shardStates[uuid] = append(shardStates[uuid], state)
+			if lastError := row.AsString("last_error", ""); state == tabletvdiff.StateError && lastError != "" {
+				if summary.Errors == nil {
+					summary.Errors = make(map[string]string)
+				}
+				summary.Errors[tablet.Shard] = lastError
+			}
+			if createdAt := row.AsString("created_at", ""); summary.CreatedAt == "" || createdAt < summary.CreatedAt {
+				summary.CreatedAt = createdAt
+			}
+			if startedAt := row.AsString("started_at", ""); startedAt != "" && (summary.StartedAt == "" || startedAt < summary.StartedAt) {
+				summary.StartedAt = startedAt
+			}
+			if completedAt := row.AsString("completed_at", ""); completedAt > summary.CompletedAt {
+				summary.CompletedAt = completedAt
+			}
+			if !withTables {
+				continue
+			}
+			if err := wr.vdiff2AddTables(ctx, keyspace, workflow, tablet, row.AsInt64("id", 0), summary); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, summary := range summaries {
+		summary.State = aggregateVDiffState(shardStates[summary.UUID])
+		if summary.State != tabletvdiff.StateCompleted {
+			summary.CompletedAt = ""
+		}
+		for _, table := range summary.Tables {
+			table.State = aggregateVDiffState(table.states)
+			summary.RowsCompared += table.RowsCompared
+			summary.HasMismatch = summary.HasMismatch || table.HasMismatch
+		}
+	}
+	return summaries, nil
+}
+
+// vdiff2AddTables adds the progress and the reports of the tables of a vdiff
+// on a target shard to its summary.
+func (wr *Wrangler) vdiff2AddTables(ctx context.Context, keyspace, workflow string, tablet *topo.TabletInfo, id int64, summary *VDiffSummary) error {
+	query := fmt.Sprintf("select table_name, state, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id = %d", id)
+	p3qr, err := wr.GenericVExec(ctx, tablet.Alias, query, workflow, keyspace)
+	if err != nil {
+		return err
+	}
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Named().Rows {
+		name := row.AsString("table_name", "")
+		table := summary.Tables[name]
+		if table == nil {
+			table = &VDiffTableSummary{Report: &tabletvdiff.TableReport{TableName: name}}
+			summary.Tables[name] = table
+		}
+		table.states = append(table.states, row.AsString("state", ""))
+		table.RowsCompared += row.AsInt64("rows_compared", 0)
+		table.HasMismatch = table.HasMismatch || row.AsInt64("mismatch", 0) != 0
+		if buf := row.AsBytes("report", nil); len(buf) > 0 {
+			report := &tabletvdiff.TableReport{}
+			if err := json.Unmarshal(buf, report); err != nil {
+				return fmt.Errorf("invalid report of table %s on shard %s: %v", name, tablet.Shard, err)
+			}
+			table.Report.Merge(report)
+		}
+	}
+	return nil
+}
+
+// aggregateVDiffState returns the state of a vdiff, or of the diff of a
+// table, from its states on the target shards.
+func aggregateVDiffState(states []string) string {
+	counts := make(map[string]int)
+	for _, state := range states {
+		counts[state]++
+	}
+	switch {
+	case counts[tabletvdiff.StateError] > 0:
+		return tabletvdiff.StateError
+	case counts[tabletvdiff.StateCompleted] == len(states):
+		return tabletvdiff.StateCompleted
+	case counts[tabletvdiff.StateStopped] > 0:
+		return tabletvdiff.StateStopped
+	case counts[tabletvdiff.StateStarted] > 0 || counts[tabletvdiff.StateCompleted] > 0:
+		return tabletvdiff.StateStarted
+	default:
+		return tabletvdiff.StatePending
+	}
+}
+
+func vdiffRowsAffected(results map[*topo.TabletInfo]*sqltypes.Result) uint64 {
+	var rowsAffected uint64
+	for _, result := range results {
+		rowsAffected += result.RowsAffected
+	}
+	return rowsAffected
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	vdiff2SelectQuery      = "select * from _vt.vdiff where db_name = 'vt_targetks' and workflow = 'wf'"
+	vdiff2SelectFields     = "id|vdiff_uuid|state|created_at|started_at|completed_at|last_error"
+	vdiff2SelectTypes      = "int64|varchar|varchar|timestamp|timestamp|timestamp|varchar"
+	vdiff2TablesFields     = "table_name|state|rows_compared|mismatch|report"
+	vdiff2TablesFieldTypes = "varchar|varchar|int64|int64|json"
+)
+
+func newVDiff2Env(t *testing.T) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	return newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+}
+
+func TestVDiff2Create(t *testing.T) {
+	env := newVDiff2Env(t)
+	defer env.close()
+
+	query := `insert into _vt.vdiff(vdiff_uuid, workflow, options) values ('u1', 'wf', '{\"tables\":\"t1\",\"chunk_size\":10}')`
+	env.tmc.expectVRQuery(200, query, &sqltypes.Result{RowsAffected: 1})
+	env.tmc.expectVRQuery(210, query, &sqltypes.Result{RowsAffected: 1})
+	uuid, err := env.wr.VDiff2Create(context.Background(), "targetks", "wf", "u1", &tabletvdiff.Options{Tables: "t1", ChunkSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "u1", uuid)
+	env.tmc.verifyQueries(t)
+
+	env.tmc.expectVRQuery(200, "/insert into _vt.vdiff", &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, "/insert into _vt.vdiff", &sqltypes.Result{})
+	_, err = env.wr.VDiff2Create(context.Background(), "targetks", "nosuchwf", "", &tabletvdiff.Options{})
+	assert.EqualError(t, err, "workflow nosuchwf not found in keyspace targetks")
+	env.tmc.verifyQueries(t)
+}
+
+func TestVDiff2StopResume(t *testing.T) {
+	env := newVDiff2Env(t)
+	defer env.close()
+
+	query := "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1' and db_name = 'vt_targetks' and workflow = 'wf'"
+	env.tmc.expectVRQuery(200, query, &sqltypes.Result{RowsAffected: 1})
+	env.tmc.expectVRQuery(210, query, &sqltypes.Result{})
+	require.NoError(t, env.wr.VDiff2Stop(context.Background(), "targetks", "wf", "u1"))
+	env.tmc.verifyQueries(t)
+
+	query = "update _vt.vdiff set state = 'pending' where vdiff_uuid = 'u1' and db_name = 'vt_targetks' and workflow = 'wf'"
+	env.tmc.expectVRQuery(200, query, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, query, &sqltypes.Result{})
+	err := env.wr.VDiff2Resume(context.Background(), "targetks", "wf", "u1")
+	assert.EqualError(t, err, "can't resume vdiff u1 of workflow targetks.wf: vdiff not found, or already running or completed")
+	env.tmc.verifyQueries(t)
+}
+
+func TestVDiff2Show(t *testing.T) {
+	env := newVDiff2Env(t)
+	defer env.close()
+
+	query := "select * from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'vt_targetks' and workflow = 'wf'"
+	env.tmc.expectVRQuery(200, query, sqltypes.MakeTestResult(sqltypes.MakeTestFields(vdiff2SelectFields, vdiff2SelectTypes),
+		"1|u1|completed|2022-01-01 00:00:00|2022-01-01 00:00:01|2022-01-01 00:10:00|",
+	))
+	env.tmc.expectVRQuery(210, query, sqltypes.MakeTestResult(sqltypes.MakeTestFields(vdiff2SelectFields, vdiff2SelectTypes),
+		"3|u1|started|2022-01-01 00:00:00|2022-01-01 00:00:02|null|",
+	))
+	tablesFields := sqltypes.MakeTestFields(vdiff2TablesFields, vdiff2TablesFieldTypes)
+	env.tmc.expectVRQuery(200, "select table_name, state, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id = 1", sqltypes.MakeTestResult(tablesFields,
+		`t1|completed|10|0|{"TableName":"t1","ProcessedRows":10,"MatchingRows":10}`,
+		`t2|completed|5|1|{"TableName":"t2","ProcessedRows":5,"MatchingRows":4,"ExtraRowsTarget":1,"ExtraRowsTargetSample":[{"Row":{"id":"5 (INT64)"}}]}`,
+	))
+	env.tmc.expectVRQuery(210, "select table_name, state, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id = 3", sqltypes.MakeTestResult(tablesFields,
+		`t1|completed|20|0|{"TableName":"t1","ProcessedRows":20,"MatchingRows":20}`,
+		`t2|started|3|0|{"TableName":"t2","ProcessedRows":3,"MatchingRows":3}`,
+	))
+
+	summary, err := env.wr.VDiff2Show(context.Background(), "targetks", "wf", "u1")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	assert.Equal(t, "u1", summary.UUID)
+	assert.Equal(t, tabletvdiff.StateStarted, summary.State)
+	assert.Equal(t, map[string]string{"-80": "completed", "80-": "started"}, summary.Shards)
+	assert.Equal(t, "2022-01-01 00:00:01", summary.StartedAt)
+	assert.Equal(t, "", summary.CompletedAt)
+	assert.EqualValues(t, 38, summary.RowsCompared)
+	assert.True(t, summary.HasMismatch)
+
+	require.Len(t, summary.Tables, 2)
+	t1 := summary.Tables["t1"]
+	assert.Equal(t, tabletvdiff.StateCompleted, t1.State)
+	assert.EqualValues(t, 30, t1.RowsCompared)
+	assert.False(t, t1.HasMismatch)
+	assert.EqualValues(t, 30, t1.Report.MatchingRows)
+	t2 := summary.Tables["t2"]
+	assert.Equal(t, tabletvdiff.StateStarted, t2.State)
+	assert.True(t, t2.HasMismatch)
+	assert.EqualValues(t, 1, t2.Report.ExtraRowsTarget)
+	assert.Equal(t, []*tabletvdiff.RowDiff{{Row: map[string]string{"id": "5 (INT64)"}}}, t2.Report.ExtraRowsTargetSample)
+}
+
+func TestVDiff2ShowLast(t *testing.T) {
+	env := newVDiff2Env(t)
+	defer env.close()
+
+	fields := sqltypes.MakeTestFields(vdiff2SelectFields, vdiff2SelectTypes)
+	env.tmc.expectVRQuery(200, vdiff2SelectQuery, sqltypes.MakeTestResult(fields,
+		"2|u2|completed|2022-01-02 00:00:00|null|null|",
+		"1|u1|error|2022-01-01 00:00:00|null|null|boom",
+	))
+	env.tmc.expectVRQuery(210, vdiff2SelectQuery, sqltypes.MakeTestResult(fields,
+		"1|u1|completed|2022-01-01 00:00:00|null|null|",
+		"2|u2|pending|2022-01-02 00:00:00|null|null|",
+	))
+	summaries, err := env.wr.VDiff2List(context.Background(), "targetks", "wf")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "u1", summaries[0].UUID)
+	assert.Equal(t, tabletvdiff.StateError, summaries[0].State)
+	assert.Equal(t, map[string]string{"-80": "boom"}, summaries[0].Errors)
+	assert.Equal(t, "u2", summaries[1].UUID)
+	assert.Equal(t, tabletvdiff.StateStarted, summaries[1].State)
+
+	env.tmc.expectVRQuery(200, vdiff2SelectQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, vdiff2SelectQuery, &sqltypes.Result{})
+	_, err = env.wr.VDiff2Show(context.Background(), "targetks", "wf", "last")
+	assert.EqualError(t, err, "no vdiff found for workflow targetks.wf")
+	env.tmc.verifyQueries(t)
+}
+
+func TestAggregateVDiffState(t *testing.T) {
+	testcases := []struct {
+		states []string
+		want   string
+	}{{
+		states: []string{"pending", "pending"},
+		want:   "pending",
+	}, {
+		states: []string{"pending", "completed"},
+		want:   "started",
+	}, {
+		states: []string{"started", "stopped"},
+		want:   "stopped",
+	}, {
+		states: []string{"completed", "completed"},
+		want:   "completed",
+	}, {
+		states: []string{"error", "stopped"},
+		want:   "error",
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, aggregateVDiffState(tcase.states), tcase.states)
+	}
+}
@@ -21,13 +21,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 
 	"context"
 
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/grpccommon"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -61,6 +67,33 @@ var getVersionFromTabletDebugVars = func(tabletAddr string) (string, error) {
 
 var getVersionFromTablet = getVersionFromTabletDebugVars
 
+// getComponentVersionFromDebugVars fills the version and the capabilities of
+// a component from its /debug/vars.
+var getComponentVersionFromDebugVars = func(addr string, cv *ComponentVersion) error {
+	resp, err := http.Get("http://" + addr + "/debug/vars")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var vars struct {
+		Version      string
+		BuildGitRev  string
+		Capabilities string
+	}
+	if err := json.Unmarshal(body, &vars); err != nil {
+		return err
+	}
+	cv.Version = vars.Version
+	cv.BuildGitRev = vars.BuildGitRev
+	cv.Capabilities = vars.Capabilities
+	return nil
+}
+
 // ResetDebugVarsGetVersion is used by tests to reset the
 // getVersionFromTablet variable to the default one. That way we can
 // run the unit tests in testlib/ even when another implementation of
@@ -148,3 +181,73 @@ func (wr *Wrangler) ValidateVersionKeyspace(ctx context.Context, keyspace string
 	}
 	return err
 }
+
+// ComponentVersion is the version of a component of the fleet, as reported
+// by GetVersions. The components that predate the capabilities have neither
+// a Version nor Capabilities.
+type ComponentVersion struct {
+	Component    string
+	Alias        string `json:",omitempty"`
+	Keyspace     string `json:",omitempty"`
+	Shard        string `json:",omitempty"`
+	TabletType   string `json:",omitempty"`
+	Version      string
+	BuildGitRev  string
+	Capabilities string
+	Error        string `json:",omitempty"`
+}
+
+// GetVersions returns the versions of this vtctld and of all the tablets of
+// the given cells, or of all the known cells, so that version skew can be
+// spotted before it matters. The tablets that cannot be reached are reported
+// with an Error.
+func (wr *Wrangler) GetVersions(ctx context.Context, cells []string) ([]*ComponentVersion, error) {
+	var tablets []*topo.TabletInfo
+	var err error
+	if len(cells) == 0 {
+		tablets, err = topotools.GetAllTabletsAcrossCells(ctx, wr.ts)
+	} else {
+		for _, cell := range cells {
+			var cellTablets []*topo.TabletInfo
+			cellTablets, err = wr.ts.GetTabletsByCell(ctx, cell)
+			if err != nil {
+				break
+			}
+			tablets = append(tablets, cellTablets...)
+		}
+	}
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return nil, err
+	}
+
+	buildInfo := servenv.AppVersion.ToStringMap()
+	versions := []*ComponentVersion{{
+		Component:    "vtctld",
+		Version:      buildInfo["version"],
+		BuildGitRev:  buildInfo["build_git_rev"],
+		Capabilities: strings.Join(grpccommon.Capabilities(), ","),
+	}}
+	tabletVersions := make([]*ComponentVersion, len(tablets))
+	wg := sync.WaitGroup{}
+	for i, tablet := range tablets {
+		tabletVersions[i] = &ComponentVersion{
+			Component:  "vttablet",
+			Alias:      tablet.AliasString(),
+			Keyspace:   tablet.Keyspace,
+			Shard:      tablet.Shard,
+			TabletType: tablet.Type.String(),
+		}
+		wg.Add(1)
+		go func(tablet *topo.TabletInfo, cv *ComponentVersion) {
+			defer wg.Done()
+			if err := getComponentVersionFromDebugVars(tablet.Addr(), cv); err != nil {
+				cv.Error = err.Error()
+			}
+		}(tablet, tabletVersions[i])
+	}
+	wg.Wait()
+	sort.Slice(tabletVersions, func(i, j int) bool {
+		return tabletVersions[i].Alias < tabletVersions[j].Alias
+	})
+	return append(versions, tabletVersions...), err
+}
@@ -47,6 +47,7 @@ import (
 const (
 	vexecTableQualifier   = "_vt"
 	vreplicationTableName = "vreplication"
+	vdiffTableName        = "vdiff"
 )
 
 // vexec is the construct by which we run a query against backend shards. vexec is created by user-facing
@@ -292,6 +293,10 @@ func (wr *Wrangler) WorkflowAction(ctx context.Context, workflow, keyspace, acti
 		if err != nil {
 			return nil, err
 		}
+		replStatus.LookupBackfill, err = wr.lookupBackfillOfWorkflow(ctx, workflow, keyspace, replStatus.SourceLocation.Keyspace)
+		if err != nil {
+			return nil, err
+		}
 		err = dumpStreamListAsJSON(replStatus, wr)
 		return nil, err
 	} else if action == "listall" {
@@ -358,6 +363,9 @@ type ReplicationStatusResult struct {
 	Frozen bool
 	// Statuses is a map of <shard>/<primary tablet alias> : ShardReplicationStatus (for the given shard).
 	ShardStatuses map[string]*ShardReplicationStatus
+	// LookupBackfill is the progress of the backfill of a lookup vindex, if the workflow was created by
+	// CreateLookupVindex.
+	LookupBackfill *LookupBackfillProgress `json:",omitempty"`
 }
 
 // ReplicationLocation represents a location that data is either replicating from, or replicating into.
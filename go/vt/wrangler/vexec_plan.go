@@ -157,9 +157,37 @@ func (p schemaMigrationsPlanner) exec(ctx context.Context, primaryAlias *topodat
 }
 func (p schemaMigrationsPlanner) dryRun(ctx context.Context) error { return nil }
 
+// vdiffPlanner is a vexecPlanner implementation, specific to _vt.vdiff table
+type vdiffPlanner struct {
+	vx *vexec
+	d  *vexecPlannerParams
+}
+
+func newVDiffPlanner(vx *vexec) vexecPlanner {
+	return &vdiffPlanner{
+		vx: vx,
+		d: &vexecPlannerParams{
+			dbNameColumn:   "db_name",
+			workflowColumn: "workflow",
+			updateTemplates: []string{
+				`update _vt.vdiff set state='val1' where vdiff_uuid='val2'`,
+			},
+			insertTemplates: []string{
+				`insert into _vt.vdiff(vdiff_uuid, workflow, options) values ('val', 'val', 'val')`,
+			},
+		},
+	}
+}
+func (p vdiffPlanner) params() *vexecPlannerParams { return p.d }
+func (p vdiffPlanner) exec(ctx context.Context, primaryAlias *topodatapb.TabletAlias, query string) (*querypb.QueryResult, error) {
+	return p.vx.wr.GenericVExec(ctx, primaryAlias, query, p.vx.workflow, p.vx.keyspace)
+}
+func (p vdiffPlanner) dryRun(ctx context.Context) error { return nil }
+
 // make sure these planners implement vexecPlanner interface
 var _ vexecPlanner = vreplicationPlanner{}
 var _ vexecPlanner = schemaMigrationsPlanner{}
+var _ vexecPlanner = vdiffPlanner{}
 
 const (
 	updateQuery = iota
@@ -195,6 +223,8 @@ func (vx *vexec) getPlanner(ctx context.Context) error {
 		vx.planner = newSchemaMigrationsPlanner(vx)
 	case qualifiedTableName(vreplicationTableName):
 		vx.planner = newVReplicationPlanner(vx)
+	case qualifiedTableName(vdiffTableName):
+		vx.planner = newVDiffPlanner(vx)
 	default:
 		return fmt.Errorf("table not supported by vexec: %v", vx.tableName)
 	}
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// WorkflowProgress is the progress of the streams of a workflow.
+type WorkflowProgress struct {
+	// Workflow is the workflow, as keyspace.workflow.
+	Workflow string
+	// RowsCopied and RowsTotal add up the rows that the streams copied, and
+	// the estimates of the rows that they copy.
+	RowsCopied, RowsTotal int64
+	// PercentCopied is the estimated percentage of the rows that were
+	// copied.
+	PercentCopied float64
+	// EtaSeconds is the estimated time until all the streams end their copy
+	// phase, 0 if they all did, or -1 if it can't be estimated.
+	EtaSeconds int64
+	// MaxLagSeconds is the largest replication lag of the streams that
+	// replicate, or -1 if none does.
+	MaxLagSeconds int64
+	// Streams are the progress of the streams, by shard/tablet.
+	Streams map[string][]*tabletmanagerdatapb.VReplicationStreamProgress
+}
+
+// WorkflowProgress returns the progress of the streams of a workflow: the
+// rows that they copied, against the estimates of the rows that they copy,
+// their phase, their throughput, their replication lag, and the estimated
+// time until they end their copy phase.
+//
+// The rows to copy are estimated from the table statistics of the source
+// primaries, in the share of the key range of each source shard that the
+// target shard of the stream covers.
+func (wr *Wrangler) WorkflowProgress(ctx context.Context, keyspace, workflow string) (*WorkflowProgress, error) {
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	targetShards := make([]*topo.ShardInfo, 0, len(shards))
+	for _, si := range shards {
+		if si.PrimaryAlias == nil {
+			return nil, fmt.Errorf("no primary found for shard %s/%s", keyspace, si.ShardName())
+		}
+		targetShards = append(targetShards, si)
+	}
+
+	progress := &WorkflowProgress{
+		Workflow:      keyspace + "." + workflow,
+		MaxLagSeconds: -1,
+		Streams:       make(map[string][]*tabletmanagerdatapb.VReplicationStreamProgress),
+	}
+	var mu sync.Mutex
+	targetKeyRanges := make(map[*tabletmanagerdatapb.VReplicationStreamProgress]*topodatapb.KeyRange)
+	err = forAllShards(targetShards, func(targetShard *topo.ShardInfo) error {
+		targetPrimary, err := wr.ts.GetTablet(ctx, targetShard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		streams, err := wr.tmc.VReplicationProgress(ctx, targetPrimary.Tablet, workflow)
+		if err != nil {
+			return err
+		}
+		if len(streams) == 0 {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		progress.Streams[fmt.Sprintf("%s/%s", targetShard.ShardName(), targetPrimary.AliasString())] = streams
+		for _, stream := range streams {
+			targetKeyRanges[stream] = targetShard.KeyRange
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(progress.Streams) == 0 {
+		return nil, fmt.Errorf("no streams found for workflow %s", progress.Workflow)
+	}
+
+	// The estimates are best effort: the sources can be in another cluster.
+	sourceRows := make(map[string]map[string]int64)
+	sourceKeyRanges := make(map[string]*topodatapb.KeyRange)
+	for _, streams := range progress.Streams {
+		for _, stream := range streams {
+			source := stream.SourceKeyspace + "/" + stream.SourceShard
+			if _, ok := sourceRows[source]; ok {
+				continue
+			}
+			rows, keyRange, err := wr.sourceTableRows(ctx, stream.SourceKeyspace, stream.SourceShard, sourceTablesOfStreams(progress.Streams, stream))
+			if err != nil {
+				wr.Logger().Warningf("can't estimate the rows of the source %s: %v", source, err)
+			}
+			sourceRows[source] = rows
+			sourceKeyRanges[source] = keyRange
+		}
+	}
+
+	copying := false
+	for _, streams := range progress.Streams {
+		for _, stream := range streams {
+			source := stream.SourceKeyspace + "/" + stream.SourceShard
+			var rows int64
+			for _, table := range stream.SourceTables {
+				rows += sourceRows[source][table]
+			}
+			stream.RowsTotal = int64(math.Round(float64(rows) * keyRangeShare(targetKeyRanges[stream], sourceKeyRanges[source])))
+			stream.EtaSeconds = streamEtaSeconds(stream)
+
+			progress.RowsCopied += stream.RowsCopied
+			progress.RowsTotal += stream.RowsTotal
+			if stream.Phase == vreplication.PhaseCopying {
+				copying = true
+			}
+			if stream.EtaSeconds < 0 || progress.EtaSeconds < 0 {
+				progress.EtaSeconds = -1
+			} else if stream.EtaSeconds > progress.EtaSeconds {
+				progress.EtaSeconds = stream.EtaSeconds
+			}
+			if stream.LagSeconds > progress.MaxLagSeconds {
+				progress.MaxLagSeconds = stream.LagSeconds
+			}
+		}
+	}
+	switch {
+	case !copying || progress.RowsTotal == 0:
+		progress.PercentCopied = 100
+	case progress.RowsCopied < progress.RowsTotal:
+		progress.PercentCopied = float64(progress.RowsCopied) * 100 / float64(progress.RowsTotal)
+	default:
+		// The estimates can be off, but the copy isn't complete.
+		progress.PercentCopied = 99
+	}
+	return progress, nil
+}
+
+// streamEtaSeconds returns the estimated time until a stream ends its copy
+// phase, from its throughput, 0 if it did, or -1 if it can't be estimated.
+func streamEtaSeconds(stream *tabletmanagerdatapb.VReplicationStreamProgress) int64 {
+	switch {
+	case stream.Phase != vreplication.PhaseCopying:
+		return 0
+	case stream.RowsPerSecond <= 0 || stream.RowsTotal <= stream.RowsCopied:
+		return -1
+	}
+	return int64(math.Ceil(float64(stream.RowsTotal-stream.RowsCopied) / stream.RowsPerSecond))
+}
+
+// sourceTablesOfStreams returns the source tables of all the streams from the
+// source shard of stream.
+func sourceTablesOfStreams(allStreams map[string][]*tabletmanagerdatapb.VReplicationStreamProgress, stream *tabletmanagerdatapb.VReplicationStreamProgress) []string {
+	seen := make(map[string]bool)
+	for _, streams := range allStreams {
+		for _, s := range streams {
+			if s.SourceKeyspace != stream.SourceKeyspace || s.SourceShard != stream.SourceShard {
+				continue
+			}
+			for _, table := range s.SourceTables {
+				seen[table] = true
+			}
+		}
+	}
+	tables := make([]string, 0, len(seen))
+	for table := range seen {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// sourceTableRows returns the estimated numbers of rows of the tables of a
+// source shard, from information_schema, and the key range of the shard.
+func (wr *Wrangler) sourceTableRows(ctx context.Context, keyspace, shard string, tables []string) (map[string]int64, *topodatapb.KeyRange, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tables) == 0 {
+		return nil, si.KeyRange, nil
+	}
+	if si.PrimaryAlias == nil {
+		return nil, si.KeyRange, fmt.Errorf("no primary found for shard %s/%s", keyspace, shard)
+	}
+	sourcePrimary, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return nil, si.KeyRange, err
+	}
+	tableList := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableList = append(tableList, encodeString(table))
+	}
+	query := fmt.Sprintf("select table_name, table_rows from information_schema.tables where table_schema=%s and table_name in (%s)",
+		encodeString(sourcePrimary.DbName()), strings.Join(tableList, ", "))
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, sourcePrimary.Tablet, true, []byte(query), len(tables), false, false)
+	if err != nil {
+		return nil, si.KeyRange, err
+	}
+	rows := make(map[string]int64, len(tables))
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		if row[1].IsNull() {
+			continue
+		}
+		count, err := evalengine.ToInt64(row[1])
+		if err != nil {
+			return nil, si.KeyRange, err
+		}
+		rows[row[0].ToString()] = count
+	}
+	return rows, si.KeyRange, nil
+}
+
+// keyRangeShare returns the share of the key range of a source shard that
+// the key range of a target shard covers, which is the share of the rows of
+// the source that the target gets.
+func keyRangeShare(target, source *topodatapb.KeyRange) float64 {
+	if !key.KeyRangeIsPartial(target) {
+		return 1
+	}
+	overlap, err := key.KeyRangesOverlap(target, source)
+	if err != nil {
+		return 0
+	}
+	sourceSize := keyRangeSize(source)
+	if sourceSize == 0 {
+		return 0
+	}
+	return keyRangeSize(overlap) / sourceSize
+}
+
+// keyRangeSize returns the share of the keyspace that a key range covers,
+// from the first 8 bytes of its bounds.
+func keyRangeSize(kr *topodatapb.KeyRange) float64 {
+	if !key.KeyRangeIsPartial(kr) {
+		return 1
+	}
+	end := 1.0
+	if len(kr.End) > 0 {
+		end = keyRangeBound(kr.End)
+	}
+	return end - keyRangeBound(kr.Start)
+}
+
+func keyRangeBound(b []byte) float64 {
+	var buf [8]byte
+	copy(buf[:], b)
+	return float64(binary.BigEndian.Uint64(buf[:])) / math.Pow(2, 64)
+}
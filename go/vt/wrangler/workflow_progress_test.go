@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const progressSourceRowsQuery = "select table_name, table_rows from information_schema.tables where table_schema='vt_sourceks' and table_name in ('t1', 't2')"
+
+func newWorkflowProgressEnv(t *testing.T) *testMaterializerEnv {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	env.tmc.progress[200] = []*tabletmanagerdatapb.VReplicationStreamProgress{{
+		Id:             1,
+		Workflow:       "wf",
+		SourceKeyspace: "sourceks",
+		SourceShard:    "0",
+		State:          "Running",
+		Phase:          vreplication.PhaseCopying,
+		SourceTables:   []string{"t1", "t2"},
+		TablesToCopy:   []string{"t2"},
+		RowsCopied:     20,
+		RowsPerSecond:  10,
+		LagSeconds:     -1,
+		EtaSeconds:     -1,
+	}}
+	env.tmc.progress[210] = []*tabletmanagerdatapb.VReplicationStreamProgress{{
+		Id:             1,
+		Workflow:       "wf",
+		SourceKeyspace: "sourceks",
+		SourceShard:    "0",
+		State:          "Running",
+		Phase:          vreplication.PhaseReplicating,
+		SourceTables:   []string{"t1", "t2"},
+		RowsCopied:     50,
+		LagSeconds:     2,
+	}}
+	return env
+}
+
+func TestWorkflowProgress(t *testing.T) {
+	env := newWorkflowProgressEnv(t)
+	defer env.close()
+	env.tmc.expectVRQuery(100, progressSourceRowsQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"table_name|table_rows",
+		"varchar|int64"),
+		"t1|60",
+		"t2|40",
+	))
+
+	progress, err := env.wr.WorkflowProgress(context.Background(), "targetks", "wf")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	assert.Equal(t, "targetks.wf", progress.Workflow)
+	// Each target shard gets half of the rows of the source.
+	assert.EqualValues(t, 70, progress.RowsCopied)
+	assert.EqualValues(t, 100, progress.RowsTotal)
+	assert.Equal(t, float64(70), progress.PercentCopied)
+	assert.EqualValues(t, 3, progress.EtaSeconds)
+	assert.EqualValues(t, 2, progress.MaxLagSeconds)
+
+	copying := progress.Streams["-80/cell-0000000200"]
+	require.Len(t, copying, 1)
+	assert.EqualValues(t, 50, copying[0].RowsTotal)
+	assert.EqualValues(t, 3, copying[0].EtaSeconds)
+	replicating := progress.Streams["80-/cell-0000000210"]
+	require.Len(t, replicating, 1)
+	assert.EqualValues(t, 50, replicating[0].RowsTotal)
+	assert.EqualValues(t, 0, replicating[0].EtaSeconds)
+}
+
+func TestWorkflowProgressWithoutSourceStats(t *testing.T) {
+	env := newWorkflowProgressEnv(t)
+	defer env.close()
+
+	// The source doesn't expect the query, so the estimates fail.
+	progress, err := env.wr.WorkflowProgress(context.Background(), "targetks", "wf")
+	require.NoError(t, err)
+	assert.EqualValues(t, 70, progress.RowsCopied)
+	assert.EqualValues(t, 0, progress.RowsTotal)
+	assert.EqualValues(t, -1, progress.EtaSeconds)
+	assert.EqualValues(t, -1, progress.Streams["-80/cell-0000000200"][0].EtaSeconds)
+}
+
+func TestWorkflowProgressNotFound(t *testing.T) {
+	env := newWorkflowProgressEnv(t)
+	defer env.close()
+
+	_, err := env.wr.WorkflowProgress(context.Background(), "targetks", "nosuchwf")
+	assert.EqualError(t, err, "no streams found for workflow targetks.nosuchwf")
+}
+
+func TestStreamEtaSeconds(t *testing.T) {
+	tcases := []struct {
+		stream *tabletmanagerdatapb.VReplicationStreamProgress
+		want   int64
+	}{{
+		stream: &tabletmanagerdatapb.VReplicationStreamProgress{Phase: vreplication.PhaseReplicating},
+		want:   0,
+	}, {
+		stream: &tabletmanagerdatapb.VReplicationStreamProgress{Phase: vreplication.PhaseCopying, RowsCopied: 10, RowsTotal: 100},
+		want:   -1,
+	}, {
+		stream: &tabletmanagerdatapb.VReplicationStreamProgress{Phase: vreplication.PhaseCopying, RowsCopied: 100, RowsTotal: 100, RowsPerSecond: 10},
+		want:   -1,
+	}, {
+		stream: &tabletmanagerdatapb.VReplicationStreamProgress{Phase: vreplication.PhaseCopying, RowsCopied: 10, RowsTotal: 100, RowsPerSecond: 4},
+		want:   23,
+	}}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.want, streamEtaSeconds(tcase.stream), tcase.stream)
+	}
+}
+
+func TestKeyRangeShare(t *testing.T) {
+	tcases := []struct {
+		target, source string
+		want           float64
+	}{
+		{target: "-", source: "-80", want: 1},
+		{target: "-80", source: "-", want: 0.5},
+		{target: "-40", source: "-80", want: 0.5},
+		{target: "40-c0", source: "80-", want: 0.5},
+		{target: "80-", source: "-80", want: 0},
+		{target: "-20", source: "-", want: 0.125},
+	}
+	for _, tcase := range tcases {
+		target, err := key.ParseShardingSpec(tcase.target)
+		require.NoError(t, err)
+		source, err := key.ParseShardingSpec(tcase.source)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, keyRangeShare(target[0], source[0]), "%s in %s", tcase.target, tcase.source)
+	}
+}